@@ -3,13 +3,33 @@ package main
 import (
 	"context"
 	"log"
+	"os"
 
 	"github.com/8thgencore/microservice-auth/internal/app"
 )
 
+// verifyAuditChainCommand verifies the audit log instead of serving requests,
+// it exits with status 1 when the hash chain is broken.
+const verifyAuditChainCommand = "verify-audit-chain"
+
 func main() {
 	ctx := context.Background()
 
+	if len(os.Args) > 1 && os.Args[1] == verifyAuditChainCommand {
+		// The remaining arguments are the flags of the configuration.
+		os.Args = append(os.Args[:1], os.Args[2:]...)
+
+		verified, err := app.VerifyAuditChain(ctx, os.Stdout)
+		if err != nil {
+			log.Fatal("failed to verify audit chain: ", error.Error(err))
+		}
+		if !verified {
+			os.Exit(1)
+		}
+
+		return
+	}
+
 	a, err := app.NewApp(ctx)
 	if err != nil {
 		log.Fatal("failed to init app: ", error.Error(err))
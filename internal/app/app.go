@@ -23,6 +23,7 @@ type App struct {
 
 	serviceProvider  *provider.ServiceProvider
 	grpcServer       *grpc.Server
+	extAuthzServer   *grpc.Server
 	httpServer       *http.Server
 	swaggerServer    *http.Server
 	prometheusServer *http.Server
@@ -55,6 +56,17 @@ func (a *App) Run() error {
 		}
 	}()
 
+	if a.extAuthzServer != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := a.runExtAuthzServer(); err != nil {
+				a.logger.Error("failed to run ext_authz server: ", sl.Err(err))
+			}
+		}()
+	}
+
 	go func() {
 		defer wg.Done()
 
@@ -106,6 +118,23 @@ func (a *App) runGrpcServer() error {
 	return nil
 }
 
+func (a *App) runExtAuthzServer() error {
+	cfg := a.serviceProvider.Config.ExtAuthz
+
+	a.logger.Info("ext_authz server running on ", slog.String("address", cfg.Address()))
+
+	lis, err := net.Listen("tcp", cfg.Address())
+	if err != nil {
+		return err
+	}
+
+	if err = a.extAuthzServer.Serve(lis); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (a *App) runHTTPServer() error {
 	a.logger.Info("HTTP server running on ", slog.String("address", a.serviceProvider.Config.HTTP.Address()))
 
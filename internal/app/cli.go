@@ -0,0 +1,42 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/8thgencore/microservice-common/pkg/closer"
+)
+
+// VerifyAuditChain walks the hash chain of the audit log and writes the report to out,
+// without serving requests. It returns false when a link of the chain is broken.
+func VerifyAuditChain(ctx context.Context, out io.Writer) (bool, error) {
+	a := &App{}
+	for _, f := range []func(context.Context) error{a.initConfig, a.initLogger, a.initServiceProvider} {
+		if err := f(ctx); err != nil {
+			return false, err
+		}
+	}
+	defer func() {
+		closer.CloseAll()
+		closer.Wait()
+	}()
+
+	verification, err := a.serviceProvider.AuditService(ctx).VerifyChain(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	fmt.Fprintf(out, "checked events:   %d\n", verification.Checked)
+	fmt.Fprintf(out, "unchained events: %d\n", verification.Unchained)
+	fmt.Fprintf(out, "head:             %d %s\n", verification.HeadSeq, verification.HeadHash)
+	if verification.Break != nil {
+		fmt.Fprintf(out, "broken link:      %d %s %s\n",
+			verification.Break.Seq, verification.Break.EventID, verification.Break.Reason)
+
+		return false, nil
+	}
+	fmt.Fprintln(out, "audit chain verified")
+
+	return true, nil
+}
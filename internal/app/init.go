@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 	"log"
+	"math"
 	"net/http"
 	"os"
 	"strconv"
@@ -12,16 +13,22 @@ import (
 
 	"github.com/8thgencore/microservice-auth/internal/app/provider"
 	"github.com/8thgencore/microservice-auth/internal/config"
+	"github.com/8thgencore/microservice-auth/internal/delivery/jwks"
 	"github.com/8thgencore/microservice-auth/internal/interceptor"
 	"github.com/8thgencore/microservice-auth/internal/metrics"
 	"github.com/8thgencore/microservice-auth/internal/tracing"
 	accessv1 "github.com/8thgencore/microservice-auth/pkg/pb/access/v1"
+	auditv1 "github.com/8thgencore/microservice-auth/pkg/pb/audit/v1"
 	authv1 "github.com/8thgencore/microservice-auth/pkg/pb/auth/v1"
+	permissionv1 "github.com/8thgencore/microservice-auth/pkg/pb/permission/v1"
+	rolev1 "github.com/8thgencore/microservice-auth/pkg/pb/role/v1"
 	userv1 "github.com/8thgencore/microservice-auth/pkg/pb/user/v1"
 	"github.com/8thgencore/microservice-auth/pkg/swagger"
+	"github.com/8thgencore/microservice-auth/pkg/utils"
 	"github.com/8thgencore/microservice-common/pkg/closer"
 	"github.com/8thgencore/microservice-common/pkg/logger"
 	"github.com/8thgencore/microservice-common/pkg/logger/sl"
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
@@ -31,10 +38,12 @@ import (
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 )
 
 func (a *App) initDeps(ctx context.Context) error {
@@ -43,6 +52,7 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initLogger,
 		a.initServiceProvider,
 		a.initGRPCServer,
+		a.initExtAuthzServer,
 		a.initHTTPServer,
 		a.initSwaggerServer,
 	}
@@ -100,11 +110,21 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 		creds = insecure.NewCredentials()
 	}
 
+	proxies, err := utils.ParseTrustedProxies(a.cfg.GRPC.TrustedProxies)
+	if err != nil {
+		a.logger.Error("[grpc-server] Failed to parse trusted proxies", sl.Err(err))
+		return err
+	}
+
 	interceptors := []grpc.UnaryServerInterceptor{
+		interceptor.ClientIPInterceptorFactory(proxies),
 		interceptor.LogInterceptorFactory(a.logger),
 		interceptor.ValidateInterceptor,
 		a.serviceProvider.AuthInterceptorFactory(ctx).AuthInterceptor,
 	}
+	if a.cfg.RateLimit.Enabled {
+		interceptors = append(interceptors, a.serviceProvider.RateLimitInterceptorFactory(ctx).RateLimitInterceptor)
+	}
 	if a.cfg.Env == config.Prod {
 		interceptors = append(interceptors, interceptor.MetricsInterceptor, interceptor.TracingInterceptor)
 	}
@@ -119,12 +139,51 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 	userv1.RegisterUserV1Server(a.grpcServer, a.serviceProvider.UserImpl(ctx))
 	authv1.RegisterAuthV1Server(a.grpcServer, a.serviceProvider.AuthImpl(ctx))
 	accessv1.RegisterAccessV1Server(a.grpcServer, a.serviceProvider.AccessImpl(ctx))
+	auditv1.RegisterAuditV1Server(a.grpcServer, a.serviceProvider.AuditImpl(ctx))
+	rolev1.RegisterRoleV1Server(a.grpcServer, a.serviceProvider.RoleImpl(ctx))
+	permissionv1.RegisterPermissionV1Server(a.grpcServer, a.serviceProvider.PermissionImpl(ctx))
 
 	a.logger.Info("[grpc-server] Initialized successfully.")
 
 	return nil
 }
 
+// initExtAuthzServer creates the Envoy external authorization server when it is enabled.
+// It is served on its own listener, which only Envoy should be able to reach.
+func (a *App) initExtAuthzServer(ctx context.Context) error {
+	if !a.cfg.ExtAuthz.Enabled {
+		return nil
+	}
+
+	a.logger.Info("[ext-authz-server] Initializing...")
+
+	var creds credentials.TransportCredentials
+	var err error
+
+	if a.cfg.TLS.Enable {
+		a.logger.Info("[ext-authz-server] Enabling TLS.")
+		creds, err = credentials.NewServerTLSFromFile(a.cfg.TLS.CertPath, a.cfg.TLS.KeyPath)
+		if err != nil {
+			a.logger.Error("[ext-authz-server] Failed to create TLS credentials", sl.Err(err))
+			return err
+		}
+	} else {
+		a.logger.Info("[ext-authz-server] Using insecure credentials.")
+		creds = insecure.NewCredentials()
+	}
+
+	a.extAuthzServer = grpc.NewServer(
+		grpc.Creds(creds),
+		grpc.ChainUnaryInterceptor(interceptor.LogInterceptorFactory(a.logger)),
+	)
+
+	authv3.RegisterAuthorizationServer(a.extAuthzServer, a.serviceProvider.ExtAuthzServer(ctx))
+
+	a.logger.Info("[ext-authz-server] Initialized successfully.")
+
+	return nil
+}
+
 func (a *App) initHTTPServer(ctx context.Context) error {
 	a.logger.Info("[http-server] Initializing...")
 
@@ -147,7 +206,7 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 		grpc.WithTransportCredentials(creds),
 	}
 
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(runtime.WithErrorHandler(gatewayErrorHandler))
 
 	if err := userv1.RegisterUserV1HandlerFromEndpoint(ctx, mux, a.cfg.GRPC.Address(), opts); err != nil {
 		return err
@@ -158,11 +217,26 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 	if err := accessv1.RegisterAccessV1HandlerFromEndpoint(ctx, mux, a.cfg.GRPC.Address(), opts); err != nil {
 		return err
 	}
+	if err := auditv1.RegisterAuditV1HandlerFromEndpoint(ctx, mux, a.cfg.GRPC.Address(), opts); err != nil {
+		return err
+	}
+	if err := rolev1.RegisterRoleV1HandlerFromEndpoint(ctx, mux, a.cfg.GRPC.Address(), opts); err != nil {
+		return err
+	}
+	if err := permissionv1.RegisterPermissionV1HandlerFromEndpoint(ctx, mux, a.cfg.GRPC.Address(), opts); err != nil {
+		return err
+	}
+
+	jwksHandler := jwks.NewHandler(a.serviceProvider.TokenOperations(ctx))
+	if err := mux.HandlePath(http.MethodGet, jwks.Path, jwksHandler.ServeHTTP); err != nil {
+		return err
+	}
 
 	corsMiddleware := cors.New(cors.Options{
 		AllowedOrigins:   []string{"*"},
 		AllowedMethods:   []string{"GET", "POST", "DELETE", "PATCH", "OPTIONS"},
 		AllowedHeaders:   []string{"Accept", "Content-Type", "Content-Length", "Authorization"},
+		ExposedHeaders:   []string{"Retry-After"},
 		AllowCredentials: true,
 	})
 
@@ -299,3 +373,25 @@ func (a *App) initTracing(ctx context.Context) error {
 
 	return nil
 }
+
+// gatewayErrorHandler writes errors like the default handler of the gateway,
+// adding a Retry-After header to the errors that tell when to retry.
+func gatewayErrorHandler(
+	ctx context.Context,
+	mux *runtime.ServeMux,
+	marshaler runtime.Marshaler,
+	w http.ResponseWriter,
+	r *http.Request,
+	err error,
+) {
+	if st, ok := status.FromError(err); ok {
+		for _, detail := range st.Details() {
+			if info, ok := detail.(*errdetails.RetryInfo); ok {
+				seconds := math.Ceil(info.GetRetryDelay().AsDuration().Seconds())
+				w.Header().Set("Retry-After", strconv.Itoa(int(seconds)))
+			}
+		}
+	}
+
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
@@ -3,14 +3,14 @@ package provider
 import (
 	"context"
 
-	"github.com/8thgencore/microservice-common/pkg/cache"
-	redisClient "github.com/8thgencore/microservice-common/pkg/cache/redis"
 	"github.com/8thgencore/microservice-common/pkg/closer"
 	"github.com/8thgencore/microservice-common/pkg/db"
 	"github.com/8thgencore/microservice-common/pkg/db/pg"
 	"github.com/8thgencore/microservice-common/pkg/db/transaction"
 	"github.com/8thgencore/microservice-common/pkg/logger/sl"
 	"github.com/redis/go-redis/v9"
+
+	"github.com/8thgencore/microservice-auth/internal/cache"
 )
 
 // DatabaseClient returns a database client.
@@ -47,24 +47,28 @@ func (s *ServiceProvider) TxManager(ctx context.Context) db.TxManager {
 }
 
 // CacheClient returns a cache client.
+// The repositories and the rate limiter share it, so the service keeps a single pool of connections to Redis.
+// The client is closed when the application shuts down.
 func (s *ServiceProvider) CacheClient(ctx context.Context) cache.Client {
-	cfg := s.Config.Redis
-	opt := &redis.Options{
-		Addr:        cfg.Address(),
-		Password:    cfg.Password,
-		DB:          0, // use default DB
-		DialTimeout: cfg.ConnectionTimeout,
-		ReadTimeout: cfg.IdleTimeout,
-		PoolSize:    cfg.MaxIdle,
-	}
-
 	if s.cache == nil {
-		c := redisClient.NewClient(opt, s.logger)
+		cfg := s.Config.Redis
+		opt := &redis.Options{
+			Addr:        cfg.Address(),
+			Password:    cfg.Password,
+			DB:          0, // use default DB
+			DialTimeout: cfg.ConnectionTimeout,
+			ReadTimeout: cfg.IdleTimeout,
+			PoolSize:    cfg.MaxIdle,
+		}
+
+		c := cache.NewClient(opt, s.logger)
 
 		if err := c.Ping(ctx); err != nil {
 			s.logger.Error("failed to connect to redis: ", sl.Err(err))
 		}
 
+		closer.Add(c.Close)
+
 		s.cache = c
 	}
 
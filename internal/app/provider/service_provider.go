@@ -3,26 +3,55 @@ package provider
 import (
 	"context"
 	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
+	"github.com/8thgencore/microservice-auth/internal/cache"
 	"github.com/8thgencore/microservice-auth/internal/config"
 	"github.com/8thgencore/microservice-auth/internal/delivery/access"
+	"github.com/8thgencore/microservice-auth/internal/delivery/audit"
 	"github.com/8thgencore/microservice-auth/internal/delivery/auth"
+	"github.com/8thgencore/microservice-auth/internal/delivery/extauthz"
+	"github.com/8thgencore/microservice-auth/internal/delivery/permission"
+	"github.com/8thgencore/microservice-auth/internal/delivery/role"
 	"github.com/8thgencore/microservice-auth/internal/delivery/user"
 	"github.com/8thgencore/microservice-auth/internal/interceptor"
+	"github.com/8thgencore/microservice-auth/internal/notifier"
+	"github.com/8thgencore/microservice-auth/internal/ratelimit"
 	"github.com/8thgencore/microservice-auth/internal/repository"
 	"github.com/8thgencore/microservice-auth/internal/service"
 	"github.com/8thgencore/microservice-auth/internal/tokens"
 	"github.com/8thgencore/microservice-auth/internal/tokens/jwt"
-	"github.com/8thgencore/microservice-common/pkg/cache"
+	"github.com/8thgencore/microservice-common/pkg/closer"
 	"github.com/8thgencore/microservice-common/pkg/db"
 	"github.com/8thgencore/microservice-common/pkg/logger/sl"
+	"github.com/go-webauthn/webauthn/webauthn"
 
 	accessRepository "github.com/8thgencore/microservice-auth/internal/repository/access"
-	logRepository "github.com/8thgencore/microservice-auth/internal/repository/log"
+	attemptRepository "github.com/8thgencore/microservice-auth/internal/repository/attempt"
+	auditRepository "github.com/8thgencore/microservice-auth/internal/repository/audit"
+	ceremonyRepository "github.com/8thgencore/microservice-auth/internal/repository/ceremony"
+	familyRepository "github.com/8thgencore/microservice-auth/internal/repository/family"
+	mfaRepository "github.com/8thgencore/microservice-auth/internal/repository/mfa"
+	notificationRepository "github.com/8thgencore/microservice-auth/internal/repository/notification"
+	passkeyRepository "github.com/8thgencore/microservice-auth/internal/repository/passkey"
+	permissionRepository "github.com/8thgencore/microservice-auth/internal/repository/permission"
+	policyRepository "github.com/8thgencore/microservice-auth/internal/repository/policy"
+	relationRepository "github.com/8thgencore/microservice-auth/internal/repository/relation"
+	resetRepository "github.com/8thgencore/microservice-auth/internal/repository/reset"
+	roleRepository "github.com/8thgencore/microservice-auth/internal/repository/role"
 	tokenRepository "github.com/8thgencore/microservice-auth/internal/repository/token"
 	userRepository "github.com/8thgencore/microservice-auth/internal/repository/user"
+	verificationRepository "github.com/8thgencore/microservice-auth/internal/repository/verification"
 	accessService "github.com/8thgencore/microservice-auth/internal/service/access"
+	auditService "github.com/8thgencore/microservice-auth/internal/service/audit"
 	authService "github.com/8thgencore/microservice-auth/internal/service/auth"
+	notificationService "github.com/8thgencore/microservice-auth/internal/service/notification"
+	permissionService "github.com/8thgencore/microservice-auth/internal/service/permission"
+	relationService "github.com/8thgencore/microservice-auth/internal/service/relation"
+	roleService "github.com/8thgencore/microservice-auth/internal/service/role"
 	userService "github.com/8thgencore/microservice-auth/internal/service/user"
 )
 
@@ -35,24 +64,51 @@ type ServiceProvider struct {
 	dbClient  db.Client
 	txManager db.TxManager
 
-	authInterceptor *interceptor.Auth
+	authInterceptor      *interceptor.Auth
+	rateLimitInterceptor *interceptor.RateLimit
 
 	cache cache.Client
 
-	userRepository   repository.UserRepository
-	accessRepository repository.AccessRepository
-	logRepository    repository.LogRepository
-	tokenRepository  repository.TokenRepository
+	userRepository     repository.UserRepository
+	accessRepository   repository.AccessRepository
+	policyRepository   repository.PolicyVersionRepository
+	relationRepository repository.RelationRepository
+	roleRepository     repository.RoleRepository
+	permissionRepo     repository.PermissionRepository
+	auditRepository    repository.AuditRepository
+	tokenRepository    repository.TokenRepository
+	familyRepository   repository.TokenFamilyRepository
+	mfaRepository      repository.MfaRepository
+	passkeyRepository  repository.PasskeyRepository
+	ceremonyRepository repository.PasskeyCeremonyRepository
+	resetRepository    repository.PasswordResetRepository
+	outboxRepository   repository.NotificationRepository
+	verifyRepository   repository.EmailVerificationRepository
+	attemptRepository  repository.LoginAttemptRepository
 
-	userService   service.UserService
-	authService   service.AuthService
-	accessService service.AccessService
+	userService         service.UserService
+	authService         service.AuthService
+	accessService       service.AccessService
+	relationService     service.RelationService
+	roleService         service.RoleService
+	permissionService   service.PermissionService
+	auditService        service.AuditService
+	notificationService service.NotificationService
 
-	userImpl   *user.Implementation
-	authImpl   *auth.Implementation
-	accessImpl *access.Implementation
+	userImpl       *user.Implementation
+	authImpl       *auth.Implementation
+	accessImpl     *access.Implementation
+	roleImpl       *role.Implementation
+	permissionImpl *permission.Implementation
+	auditImpl      *audit.Implementation
+	extAuthzServer *extauthz.Server
 
+	keyring         *tokens.Keyring
+	serviceKeys     *tokens.ServiceKeys
 	tokenOperations tokens.TokenOperations
+	webAuthn        *webauthn.WebAuthn
+	sender          notifier.Sender
+	templates       *notifier.Templates
 }
 
 // NewServiceProvider creates a new instance of ServiceProvider with the given configuration.
@@ -79,12 +135,44 @@ func (s *ServiceProvider) AccessRepository(ctx context.Context) repository.Acces
 	return s.accessRepository
 }
 
-// LogRepository returns a log repository.
-func (s *ServiceProvider) LogRepository(ctx context.Context) repository.LogRepository {
-	if s.logRepository == nil {
-		s.logRepository = logRepository.NewRepository(s.DatabaseClient(ctx))
+// PolicyVersionRepository returns a repository of the access policy versions shared by the replicas.
+func (s *ServiceProvider) PolicyVersionRepository(ctx context.Context) repository.PolicyVersionRepository {
+	if s.policyRepository == nil {
+		s.policyRepository = policyRepository.NewRepository(s.CacheClient(ctx))
 	}
-	return s.logRepository
+	return s.policyRepository
+}
+
+// RelationRepository returns a relation tuples repository.
+func (s *ServiceProvider) RelationRepository(ctx context.Context) repository.RelationRepository {
+	if s.relationRepository == nil {
+		s.relationRepository = relationRepository.NewRepository(s.DatabaseClient(ctx))
+	}
+	return s.relationRepository
+}
+
+// RoleRepository returns a role repository.
+func (s *ServiceProvider) RoleRepository(ctx context.Context) repository.RoleRepository {
+	if s.roleRepository == nil {
+		s.roleRepository = roleRepository.NewRepository(s.DatabaseClient(ctx))
+	}
+	return s.roleRepository
+}
+
+// PermissionRepository returns a permission repository.
+func (s *ServiceProvider) PermissionRepository(ctx context.Context) repository.PermissionRepository {
+	if s.permissionRepo == nil {
+		s.permissionRepo = permissionRepository.NewRepository(s.DatabaseClient(ctx))
+	}
+	return s.permissionRepo
+}
+
+// AuditRepository returns an audit log repository.
+func (s *ServiceProvider) AuditRepository(ctx context.Context) repository.AuditRepository {
+	if s.auditRepository == nil {
+		s.auditRepository = auditRepository.NewRepository(s.DatabaseClient(ctx))
+	}
+	return s.auditRepository
 }
 
 // TokenRepository returns a Token repository.
@@ -100,18 +188,97 @@ func (s *ServiceProvider) TokenRepository(ctx context.Context) repository.TokenR
 	return s.tokenRepository
 }
 
+// TokenFamilyRepository returns a refresh token family repository.
+func (s *ServiceProvider) TokenFamilyRepository(ctx context.Context) repository.TokenFamilyRepository {
+	if s.familyRepository == nil {
+		s.familyRepository = familyRepository.NewRepository(
+			s.DatabaseClient(ctx),
+			s.Config.JWT.RefreshTokenTTL,
+		)
+	}
+	return s.familyRepository
+}
+
+// MfaRepository returns a multi-factor authentication repository.
+func (s *ServiceProvider) MfaRepository(ctx context.Context) repository.MfaRepository {
+	if s.mfaRepository == nil {
+		s.mfaRepository = mfaRepository.NewRepository(s.DatabaseClient(ctx))
+	}
+	return s.mfaRepository
+}
+
+// PasskeyRepository returns a passkey repository.
+func (s *ServiceProvider) PasskeyRepository(ctx context.Context) repository.PasskeyRepository {
+	if s.passkeyRepository == nil {
+		s.passkeyRepository = passkeyRepository.NewRepository(s.DatabaseClient(ctx))
+	}
+	return s.passkeyRepository
+}
+
+// PasskeyCeremonyRepository returns a pending passkey ceremony repository.
+func (s *ServiceProvider) PasskeyCeremonyRepository(ctx context.Context) repository.PasskeyCeremonyRepository {
+	if s.ceremonyRepository == nil {
+		s.ceremonyRepository = ceremonyRepository.NewRepository(
+			s.CacheClient(ctx),
+			s.Config.WebAuthn.CeremonyTTL,
+		)
+	}
+	return s.ceremonyRepository
+}
+
+// PasswordResetRepository returns a password reset token repository.
+func (s *ServiceProvider) PasswordResetRepository(ctx context.Context) repository.PasswordResetRepository {
+	if s.resetRepository == nil {
+		s.resetRepository = resetRepository.NewRepository(s.DatabaseClient(ctx))
+	}
+	return s.resetRepository
+}
+
+// EmailVerificationRepository returns an email verification token repository.
+func (s *ServiceProvider) EmailVerificationRepository(ctx context.Context) repository.EmailVerificationRepository {
+	if s.verifyRepository == nil {
+		s.verifyRepository = verificationRepository.NewRepository(s.DatabaseClient(ctx))
+	}
+	return s.verifyRepository
+}
+
+// LoginAttemptRepository returns a failed logins repository.
+func (s *ServiceProvider) LoginAttemptRepository(ctx context.Context) repository.LoginAttemptRepository {
+	if s.attemptRepository == nil {
+		// The failures must be kept for as long as the lockout lasts, or it would end early.
+		s.attemptRepository = attemptRepository.NewRepository(
+			s.CacheClient(ctx),
+			max(s.Config.LoginThrottle.FailureWindow, s.Config.LoginThrottle.LockoutDuration),
+		)
+	}
+	return s.attemptRepository
+}
+
+// NotificationRepository returns a notification outbox repository.
+func (s *ServiceProvider) NotificationRepository(ctx context.Context) repository.NotificationRepository {
+	if s.outboxRepository == nil {
+		s.outboxRepository = notificationRepository.NewRepository(s.DatabaseClient(ctx))
+	}
+	return s.outboxRepository
+}
+
 // UserService returns a user service.
 func (s *ServiceProvider) UserService(ctx context.Context) service.UserService {
 	if s.userService == nil {
 		s.userService = userService.NewService(
 			s.logger,
 			s.UserRepository(ctx),
-			s.LogRepository(ctx),
+			s.AuditRepository(ctx),
 			s.TokenRepository(ctx),
+			s.EmailVerificationRepository(ctx),
 			s.TokenOperations(ctx),
+			s.NotificationService(ctx),
 			s.TxManager(ctx),
 			&s.Config.Admin,
+			&s.Config.Verification,
+			&s.Config.UserRetention,
 		)
+		s.purgeDeletedUsers()
 	}
 
 	return s.userService
@@ -121,9 +288,25 @@ func (s *ServiceProvider) UserService(ctx context.Context) service.UserService {
 func (s *ServiceProvider) AuthService(ctx context.Context) service.AuthService {
 	if s.authService == nil {
 		s.authService = authService.NewService(
+			s.logger,
 			s.UserRepository(ctx),
 			s.TokenRepository(ctx),
+			s.TokenFamilyRepository(ctx),
+			s.MfaRepository(ctx),
+			s.PasskeyRepository(ctx),
+			s.PasskeyCeremonyRepository(ctx),
+			s.PasswordResetRepository(ctx),
+			s.LoginAttemptRepository(ctx),
+			s.AuditRepository(ctx),
 			s.TokenOperations(ctx),
+			s.NotificationService(ctx),
+			s.embeddedPermissions(ctx),
+			s.TxManager(ctx),
+			&s.Config.MFA,
+			&s.Config.PasswordReset,
+			&s.Config.Verification,
+			&s.Config.LoginThrottle,
+			s.WebAuthn(ctx),
 		)
 	}
 
@@ -137,7 +320,14 @@ func (s *ServiceProvider) AccessService(ctx context.Context) service.AccessServi
 		s.accessService, err = accessService.NewService(
 			ctx,
 			s.AccessRepository(ctx),
+			s.PolicyVersionRepository(ctx),
+			s.UserRepository(ctx),
+			s.TokenRepository(ctx),
+			s.AuditRepository(ctx),
+			s.RoleService(ctx),
+			s.PermissionService(ctx),
 			s.TokenOperations(ctx),
+			s.TxManager(ctx),
 		)
 		if err != nil {
 			s.logger.Error("failed to run access service: ", sl.Err(err))
@@ -147,6 +337,109 @@ func (s *ServiceProvider) AccessService(ctx context.Context) service.AccessServi
 	return s.accessService
 }
 
+// RelationService returns a relation service.
+// The relations of the tuples are defined by the schema, so the application can't run without it.
+func (s *ServiceProvider) RelationService(ctx context.Context) service.RelationService {
+	if s.relationService == nil {
+		schema, err := relationService.LoadSchema(s.Config.Relation.SchemaPath)
+		if err != nil {
+			s.logger.Error("failed to load relation schema: ", sl.Err(err))
+			os.Exit(1)
+		}
+
+		s.relationService = relationService.NewService(
+			s.RelationRepository(ctx),
+			s.AccessService(ctx),
+			s.TxManager(ctx),
+			schema,
+			s.Config.Relation.MaxDepth,
+		)
+	}
+
+	return s.relationService
+}
+
+// RoleService returns a role service.
+// The role hierarchy is needed to authorize every call, so the application can't run without it.
+func (s *ServiceProvider) RoleService(ctx context.Context) service.RoleService {
+	if s.roleService == nil {
+		roleSrv, err := roleService.NewService(
+			ctx,
+			s.logger,
+			s.RoleRepository(ctx),
+			s.AccessRepository(ctx),
+			s.AuditRepository(ctx),
+			s.TxManager(ctx),
+		)
+		if err != nil {
+			s.logger.Error("failed to run role service", sl.Err(err))
+			os.Exit(1)
+		}
+		s.roleService = roleSrv
+	}
+
+	return s.roleService
+}
+
+// PermissionService returns a permission service.
+// The permissions of the roles are needed to authorize calls, so the application can't run without them.
+func (s *ServiceProvider) PermissionService(ctx context.Context) service.PermissionService {
+	if s.permissionService == nil {
+		permissionSrv, err := permissionService.NewService(
+			ctx,
+			s.logger,
+			s.PermissionRepository(ctx),
+			s.AuditRepository(ctx),
+			s.RoleService(ctx),
+			s.TxManager(ctx),
+		)
+		if err != nil {
+			s.logger.Error("failed to run permission service", sl.Err(err))
+			os.Exit(1)
+		}
+		s.permissionService = permissionSrv
+	}
+
+	return s.permissionService
+}
+
+// embeddedPermissions returns the permission service resolving the permissions embedded in access tokens,
+// or nil when they are not embedded.
+func (s *ServiceProvider) embeddedPermissions(ctx context.Context) service.PermissionService {
+	if !s.Config.JWT.EmbedPermissions {
+		return nil
+	}
+
+	return s.PermissionService(ctx)
+}
+
+// AuditService returns an audit log service.
+func (s *ServiceProvider) AuditService(ctx context.Context) service.AuditService {
+	if s.auditService == nil {
+		s.auditService = auditService.NewService(s.logger, s.AuditRepository(ctx))
+		s.sealAuditChain()
+	}
+
+	return s.auditService
+}
+
+// NotificationService returns a notification service.
+// The outbox is dispatched in the background for as long as the application runs.
+func (s *ServiceProvider) NotificationService(ctx context.Context) service.NotificationService {
+	if s.notificationService == nil {
+		s.notificationService = notificationService.NewService(
+			s.logger,
+			s.NotificationRepository(ctx),
+			s.Sender(ctx),
+			s.Templates(ctx),
+			&s.Config.Notifier,
+		)
+		s.dispatchNotifications()
+	}
+
+	return s.notificationService
+}
+
 // UserImpl returns a user implementation.
 func (s *ServiceProvider) UserImpl(ctx context.Context) *user.Implementation {
 	if s.userImpl == nil {
@@ -166,18 +459,56 @@ func (s *ServiceProvider) AuthImpl(ctx context.Context) *auth.Implementation {
 // AccessImpl returns a access implementation.
 func (s *ServiceProvider) AccessImpl(ctx context.Context) *access.Implementation {
 	if s.accessImpl == nil {
-		s.accessImpl = access.NewImplementation(s.AccessService(ctx))
+		s.accessImpl = access.NewImplementation(s.AccessService(ctx), s.RelationService(ctx))
 	}
 	return s.accessImpl
 }
 
+// ExtAuthzServer returns the Envoy external authorization server.
+func (s *ServiceProvider) ExtAuthzServer(ctx context.Context) *extauthz.Server {
+	if s.extAuthzServer == nil {
+		routes, err := extauthz.LoadRoutes(s.Config.ExtAuthz.RoutesPath)
+		if err != nil {
+			s.logger.Error("failed to load ext_authz routes: ", sl.Err(err))
+			os.Exit(1)
+		}
+		s.extAuthzServer = extauthz.NewServer(s.AccessService(ctx), s.TokenOperations(ctx), routes)
+	}
+	return s.extAuthzServer
+}
+
+// RoleImpl returns a role implementation.
+func (s *ServiceProvider) RoleImpl(ctx context.Context) *role.Implementation {
+	if s.roleImpl == nil {
+		s.roleImpl = role.NewImplementation(s.RoleService(ctx))
+	}
+	return s.roleImpl
+}
+
+// PermissionImpl returns a permission implementation.
+func (s *ServiceProvider) PermissionImpl(ctx context.Context) *permission.Implementation {
+	if s.permissionImpl == nil {
+		s.permissionImpl = permission.NewImplementation(s.PermissionService(ctx))
+	}
+	return s.permissionImpl
+}
+
+// AuditImpl returns an audit implementation.
+func (s *ServiceProvider) AuditImpl(ctx context.Context) *audit.Implementation {
+	if s.auditImpl == nil {
+		s.auditImpl = audit.NewImplementation(s.AuditService(ctx))
+	}
+	return s.auditImpl
+}
+
 // TokenOperations returns a token operation service.
 func (s *ServiceProvider) TokenOperations(ctx context.Context) tokens.TokenOperations {
 	if s.tokenOperations == nil {
 		s.tokenOperations = jwt.NewTokenOperations(
-			[]byte(s.Config.JWT.SecretKey),
+			s.Keyring(ctx),
 			s.Config.JWT.AccessTokenTTL,
 			s.Config.JWT.RefreshTokenTTL,
+			s.Config.MFA.ChallengeTTL,
 			s.TokenRepository(ctx),
 		)
 	}
@@ -185,14 +516,238 @@ func (s *ServiceProvider) TokenOperations(ctx context.Context) tokens.TokenOpera
 	return s.tokenOperations
 }
 
+// WebAuthn returns the relying party of the passkey ceremonies.
+func (s *ServiceProvider) WebAuthn(_ context.Context) *webauthn.WebAuthn {
+	if s.webAuthn == nil {
+		cfg := s.Config.WebAuthn
+		timeout := webauthn.TimeoutConfig{
+			Enforce:    true,
+			Timeout:    cfg.CeremonyTTL,
+			TimeoutUVD: cfg.CeremonyTTL,
+		}
+
+		webAuthn, err := webauthn.New(&webauthn.Config{
+			RPID:          cfg.RPID,
+			RPDisplayName: cfg.RPDisplayName,
+			RPOrigins:     cfg.RPOrigins,
+			Timeouts: webauthn.TimeoutsConfig{
+				Login:        timeout,
+				Registration: timeout,
+			},
+		})
+		if err != nil {
+			s.logger.Error("failed to configure WebAuthn: ", sl.Err(err))
+			os.Exit(1)
+		}
+		s.webAuthn = webAuthn
+	}
+
+	return s.webAuthn
+}
+
+// Sender returns the sender of the notifications chosen in the configuration.
+func (s *ServiceProvider) Sender(_ context.Context) notifier.Sender {
+	if s.sender == nil {
+		sender, err := notifier.NewSender(&s.Config.Notifier)
+		if err != nil {
+			s.logger.Error("failed to configure notification sender: ", sl.Err(err))
+			os.Exit(1)
+		}
+		s.sender = sender
+	}
+
+	return s.sender
+}
+
+// Templates returns the templates of the notifications.
+func (s *ServiceProvider) Templates(_ context.Context) *notifier.Templates {
+	if s.templates == nil {
+		templates, err := notifier.NewTemplates(s.Config.Notifier.DefaultLocale)
+		if err != nil {
+			s.logger.Error("failed to load notification templates: ", sl.Err(err))
+			os.Exit(1)
+		}
+		s.templates = templates
+	}
+
+	return s.templates
+}
+
+// Keyring returns the token signing keyring.
+// A keyring file is reloaded on SIGHUP so signing keys can be rotated without a restart.
+func (s *ServiceProvider) Keyring(_ context.Context) *tokens.Keyring {
+	if s.keyring == nil {
+		keyring, err := s.loadKeyring()
+		if err != nil {
+			s.logger.Error("failed to load JWT signing keys: ", sl.Err(err))
+			os.Exit(1)
+		}
+		s.keyring = keyring
+
+		if s.Config.JWT.KeyringPath != "" {
+			s.watchKeyring()
+		}
+	}
+
+	return s.keyring
+}
+
+// ServiceKeys returns the keys of the trusted services.
+func (s *ServiceProvider) ServiceKeys(_ context.Context) *tokens.ServiceKeys {
+	if s.serviceKeys == nil {
+		serviceKeys, err := tokens.LoadServiceKeys(s.Config.ServiceAuth.KeysPath)
+		if err != nil {
+			s.logger.Error("failed to load service keys: ", sl.Err(err))
+			os.Exit(1)
+		}
+		s.serviceKeys = serviceKeys
+	}
+
+	return s.serviceKeys
+}
+
+func (s *ServiceProvider) loadKeyring() (*tokens.Keyring, error) {
+	cfg := s.Config.JWT
+	if cfg.KeyringPath != "" {
+		return jwt.LoadKeyring(cfg.KeyringPath, cfg.KeyRetention())
+	}
+
+	key, err := jwt.LoadSigningKey(cfg.KeyID, cfg.SigningAlgorithm, cfg.SecretKey, cfg.PrivateKeyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return tokens.NewKeyring(key, cfg.KeyRetention()), nil
+}
+
+func (s *ServiceProvider) watchKeyring() {
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+	closer.Add(func() error {
+		signal.Stop(reload)
+		close(reload)
+		return nil
+	})
+
+	go func() {
+		for range reload {
+			keyring, err := s.loadKeyring()
+			if err != nil {
+				s.logger.Error("failed to reload JWT signing keys: ", sl.Err(err))
+				continue
+			}
+
+			s.keyring.Replace(keyring)
+			s.logger.Info("JWT signing keys reloaded", slog.String("active_kid", keyring.SigningKey().ID))
+		}
+	}()
+}
+
 // AuthInterceptorFactory returns an instance of interceptor.Auth.
 func (s *ServiceProvider) AuthInterceptorFactory(ctx context.Context) *interceptor.Auth {
 	if s.authInterceptor == nil {
 		s.authInterceptor = &interceptor.Auth{
 			TokenOperations: s.TokenOperations(ctx),
 			TokenRepository: s.TokenRepository(ctx),
+			UserRepository:  s.UserRepository(ctx),
+			RoleService:     s.RoleService(ctx),
+			ServiceKeys:     s.ServiceKeys(ctx),
 		}
 	}
 
 	return s.authInterceptor
 }
+
+// RateLimitInterceptorFactory returns an instance of interceptor.RateLimit.
+func (s *ServiceProvider) RateLimitInterceptorFactory(ctx context.Context) *interceptor.RateLimit {
+	if s.rateLimitInterceptor == nil {
+		rules, err := ratelimit.ParseRules(s.Config.RateLimit.Rules)
+		if err != nil {
+			s.logger.Error("failed to parse rate limit rules: ", sl.Err(err))
+			os.Exit(1)
+		}
+
+		s.rateLimitInterceptor = &interceptor.RateLimit{
+			Limiter: ratelimit.NewLimiter(s.CacheClient(ctx)),
+			Rules:   rules,
+			Logger:  s.logger,
+		}
+	}
+
+	return s.rateLimitInterceptor
+}
+
+func (s *ServiceProvider) dispatchNotifications() {
+	ticker := time.NewTicker(s.Config.Notifier.PollInterval)
+	done := make(chan struct{})
+	closer.Add(func() error {
+		ticker.Stop()
+		close(done)
+		return nil
+	})
+
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := s.notificationService.DispatchPending(context.Background()); err != nil {
+					s.logger.Error("failed to dispatch notifications: ", sl.Err(err))
+				}
+			}
+		}
+	}()
+}
+
+// purgeDeletedUsers periodically purges the users deleted longer than the retention period ago.
+func (s *ServiceProvider) purgeDeletedUsers() {
+	ticker := time.NewTicker(s.Config.UserRetention.PurgeInterval)
+	done := make(chan struct{})
+	closer.Add(func() error {
+		ticker.Stop()
+		close(done)
+		return nil
+	})
+
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				purged, err := s.userService.PurgeExpired(context.Background())
+				if err != nil {
+					s.logger.Error("failed to purge deleted users: ", sl.Err(err))
+				}
+				if purged > 0 {
+					s.logger.Info("purged deleted users", slog.Int("count", purged))
+				}
+			}
+		}
+	}()
+}
+
+// sealAuditChain periodically links the recorded audit events to the hash chain.
+func (s *ServiceProvider) sealAuditChain() {
+	ticker := time.NewTicker(s.Config.Audit.SealInterval)
+	done := make(chan struct{})
+	closer.Add(func() error {
+		ticker.Stop()
+		close(done)
+		return nil
+	})
+
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if _, err := s.auditService.SealChain(context.Background()); err != nil {
+					s.logger.Error("failed to seal audit chain: ", sl.Err(err))
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,69 @@
+// Package audit builds audit events from the context of the request they are recorded in.
+package audit
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/pkg/utils"
+)
+
+type actorKey struct{}
+
+// WithActor returns a context of a request made by the user.
+func WithActor(ctx context.Context, actorID string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actorID)
+}
+
+// Actor returns the ID of the user the request is made by, empty for anonymous requests.
+func Actor(ctx context.Context) string {
+	actorID, _ := ctx.Value(actorKey{}).(string)
+
+	return actorID
+}
+
+// NewEvent returns an event of the action on the target, made by the actor of the request
+// and carrying the client and the trace of the request.
+func NewEvent(ctx context.Context, action model.AuditAction, target model.AuditTarget) (*model.AuditEvent, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	event := &model.AuditEvent{
+		ID:        id.String(),
+		ActorID:   Actor(ctx),
+		Action:    action,
+		Target:    target,
+		IPAddress: utils.ExtractClientIP(ctx),
+		UserAgent: utils.ExtractUserAgent(ctx),
+	}
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.HasTraceID() {
+		event.TraceID = spanContext.TraceID().String()
+	}
+
+	return event, nil
+}
+
+// Changes collects the changes of the fields of a target, unchanged fields are left out.
+type Changes map[string]model.AuditChange
+
+// Add adds the change of the field if the old and the new value differ.
+func (c Changes) Add(field string, from, to any) Changes {
+	if !reflect.DeepEqual(from, to) {
+		c[field] = model.AuditChange{Old: from, New: to}
+	}
+
+	return c
+}
+
+// Secret adds a change of the field whose values must not be recorded.
+func (c Changes) Secret(field string) Changes {
+	c[field] = model.AuditChange{}
+
+	return c
+}
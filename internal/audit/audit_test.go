@@ -0,0 +1,68 @@
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/pkg/utils"
+)
+
+func TestNewEvent(t *testing.T) {
+	t.Parallel()
+
+	traceID := trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  trace.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+	})
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-agent", "test-agent"))
+	ctx = utils.WithClientIP(ctx, "203.0.113.7")
+	ctx = trace.ContextWithSpanContext(WithActor(ctx, "actor-id"), spanContext)
+
+	target := model.AuditTarget{Type: model.AuditTargetUser, ID: "user-id"}
+	event, err := NewEvent(ctx, model.AuditActionUserUpdated, target)
+	require.NoError(t, err)
+
+	require.NotEmpty(t, event.ID)
+	require.Equal(t, "actor-id", event.ActorID)
+	require.Equal(t, model.AuditActionUserUpdated, event.Action)
+	require.Equal(t, target, event.Target)
+	require.Equal(t, "203.0.113.7", event.IPAddress)
+	require.Equal(t, "test-agent", event.UserAgent)
+	require.Equal(t, traceID.String(), event.TraceID)
+}
+
+func TestNewEventAnonymous(t *testing.T) {
+	t.Parallel()
+
+	event, err := NewEvent(context.Background(), model.AuditActionLoginFailed, model.AuditTarget{})
+	require.NoError(t, err)
+
+	require.Empty(t, event.ActorID)
+	require.Empty(t, event.IPAddress)
+	require.Empty(t, event.UserAgent)
+	require.Empty(t, event.TraceID)
+}
+
+func TestChanges(t *testing.T) {
+	t.Parallel()
+
+	changes := Changes{}.
+		Add("name", "old", "new").
+		Add("email", "same", "same").
+		Add("roles", []string{"USER"}, []string{"USER"}).
+		Add("role", nil, "ADMIN").
+		Secret("password")
+
+	require.Equal(t, Changes{
+		"name":     {Old: "old", New: "new"},
+		"role":     {New: "ADMIN"},
+		"password": {},
+	}, changes)
+}
@@ -0,0 +1,86 @@
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+)
+
+// chainedContent is the content of an event covered by its hash. The fields are encoded
+// in a fixed order, so that the hash only depends on their values.
+type chainedContent struct {
+	Seq        int64             `json:"seq"`
+	PrevHash   string            `json:"prev_hash"`
+	ID         string            `json:"id"`
+	OccurredAt string            `json:"occurred_at"`
+	ActorID    string            `json:"actor_id"`
+	Action     string            `json:"action"`
+	TargetType string            `json:"target_type"`
+	TargetID   string            `json:"target_id"`
+	Changes    any               `json:"changes"`
+	Metadata   map[string]string `json:"metadata"`
+	IPAddress  string            `json:"ip_address"`
+	UserAgent  string            `json:"user_agent"`
+	TraceID    string            `json:"trace_id"`
+}
+
+// Hash returns the hash linking the event to the chain: the hex encoded SHA-256 of its
+// content, sequence number and the hash of the previous event.
+//
+// The changes are hashed as decoded from JSON, the way they are read back from storage,
+// so an event hashes the same before it is stored and after it is loaded.
+func Hash(event *model.AuditEvent) (string, error) {
+	changes, err := normalize(event.Changes)
+	if err != nil {
+		return "", err
+	}
+
+	metadata := event.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+
+	data, err := json.Marshal(&chainedContent{
+		Seq:        event.Seq,
+		PrevHash:   event.PrevHash,
+		ID:         event.ID,
+		OccurredAt: event.OccurredAt.UTC().Format(time.RFC3339Nano),
+		ActorID:    event.ActorID,
+		Action:     string(event.Action),
+		TargetType: string(event.Target.Type),
+		TargetID:   event.Target.ID,
+		Changes:    changes,
+		Metadata:   metadata,
+		IPAddress:  event.IPAddress,
+		UserAgent:  event.UserAgent,
+		TraceID:    event.TraceID,
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// normalize round-trips the changes through JSON.
+func normalize(changes map[string]model.AuditChange) (any, error) {
+	if changes == nil {
+		changes = map[string]model.AuditChange{}
+	}
+
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	var normalized any
+	if err = json.Unmarshal(data, &normalized); err != nil {
+		return nil, err
+	}
+
+	return normalized, nil
+}
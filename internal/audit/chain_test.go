@@ -0,0 +1,80 @@
+package audit
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+)
+
+func chainedEvent() *model.AuditEvent {
+	return &model.AuditEvent{
+		ID:         "0196a1b2-0000-7000-8000-000000000001",
+		OccurredAt: time.Date(2025, 5, 11, 12, 0, 0, 123456000, time.UTC),
+		ActorID:    "actor-id",
+		Action:     model.AuditActionUserUpdated,
+		Target:     model.AuditTarget{Type: model.AuditTargetUser, ID: "user-id"},
+		Changes: Changes{}.
+			Add("name", "old name", "new name").
+			Add("role", 1, 2).
+			Secret("password"),
+		Metadata: map[string]string{"session_id": "session"},
+		Seq:      2,
+		PrevHash: "prev-hash",
+	}
+}
+
+func TestHashStableAcrossStorage(t *testing.T) {
+	t.Parallel()
+
+	event := chainedEvent()
+	hash, err := Hash(event)
+	require.NoError(t, err)
+	require.Len(t, hash, 64)
+
+	// Changes are read back from storage as decoded JSON.
+	data, err := json.Marshal(event.Changes)
+	require.NoError(t, err)
+	var loaded map[string]model.AuditChange
+	require.NoError(t, json.Unmarshal(data, &loaded))
+
+	stored := chainedEvent()
+	stored.Changes = loaded
+	stored.OccurredAt = stored.OccurredAt.In(time.FixedZone("UTC+3", 3*60*60))
+
+	storedHash, err := Hash(stored)
+	require.NoError(t, err)
+	require.Equal(t, hash, storedHash)
+}
+
+func TestHashCoversContent(t *testing.T) {
+	t.Parallel()
+
+	hash, err := Hash(chainedEvent())
+	require.NoError(t, err)
+
+	alterations := map[string]func(event *model.AuditEvent){
+		"seq":       func(event *model.AuditEvent) { event.Seq++ },
+		"prev hash": func(event *model.AuditEvent) { event.PrevHash = "other" },
+		"time":      func(event *model.AuditEvent) { event.OccurredAt = event.OccurredAt.Add(time.Microsecond) },
+		"actor":     func(event *model.AuditEvent) { event.ActorID = "other" },
+		"target":    func(event *model.AuditEvent) { event.Target.ID = "other" },
+		"changes":   func(event *model.AuditEvent) { event.Changes["name"] = model.AuditChange{New: "other"} },
+		"metadata":  func(event *model.AuditEvent) { event.Metadata["session_id"] = "other" },
+	}
+	for name, alter := range alterations {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			event := chainedEvent()
+			alter(event)
+
+			altered, err := Hash(event)
+			require.NoError(t, err)
+			require.NotEqual(t, hash, altered)
+		})
+	}
+}
@@ -0,0 +1,400 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	commonCache "github.com/8thgencore/microservice-common/pkg/cache"
+	redisClient "github.com/8thgencore/microservice-common/pkg/cache/redis"
+	"github.com/8thgencore/microservice-common/pkg/logger/sl"
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrKeyNotFound is returned when a key is not in the cache, as by the common cache client.
+var ErrKeyNotFound = redisClient.ErrKeyNotFound
+
+// Client extends the common cache client with the atomic commands that the revoked tokens, the failed
+// logins and the rate limiter need: a conditional set and scripts, which Redis runs as a whole.
+type Client interface {
+	commonCache.Client
+	redis.Scripter
+
+	// SetNX sets the key with a TTL unless it exists and reports whether it was set.
+	SetNX(ctx context.Context, key string, value interface{}, duration time.Duration) (bool, error)
+	// Close closes the connections to the cache.
+	Close() error
+}
+
+type client struct {
+	redis.Scripter
+	rdb *redis.Client
+	log *slog.Logger
+}
+
+// NewClient creates a client for Redis communication. All its commands share a single connection pool.
+func NewClient(opt *redis.Options, log *slog.Logger) Client {
+	rdb := redis.NewClient(opt)
+	return &client{Scripter: rdb, rdb: rdb, log: log}
+}
+
+// SetNX sets the key with a TTL unless it exists.
+func (c *client) SetNX(ctx context.Context, key string, value interface{}, duration time.Duration) (bool, error) {
+	set, err := c.rdb.SetNX(ctx, key, value, duration).Result()
+	if err != nil {
+		c.log.Error("unable to setnx key in the cache", slog.String("key", key))
+		return false, err
+	}
+
+	return set, nil
+}
+
+// Close closes the connections to the cache.
+func (c *client) Close() error {
+	return c.rdb.Close()
+}
+
+// String commands
+func (c *client) Set(ctx context.Context, key string, value interface{}) error {
+	if err := c.rdb.Set(ctx, key, value, 0).Err(); err != nil {
+		c.log.Error("unable to set key in the cache", slog.String("key", key))
+		return err
+	}
+
+	return nil
+}
+
+func (c *client) SetEx(ctx context.Context, key string, value interface{}, duration time.Duration) error {
+	if err := c.rdb.SetEx(ctx, key, value, duration).Err(); err != nil {
+		c.log.Error("unable to set key in the cache", slog.String("key", key))
+		return err
+	}
+
+	return nil
+}
+
+func (c *client) Get(ctx context.Context, key string) (string, error) {
+	val, err := c.rdb.Get(ctx, key).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", ErrKeyNotFound
+		}
+		c.log.Error("unable to get key from the cache", slog.String("key", key))
+		return "", err
+	}
+
+	return val, nil
+}
+
+func (c *client) Del(ctx context.Context, key string) error {
+	if err := c.rdb.Del(ctx, key).Err(); err != nil {
+		c.log.Error("unable to del key in the cache", slog.String("key", key))
+		return err
+	}
+
+	return nil
+}
+
+func (c *client) DelAll(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	if err := c.rdb.Del(ctx, keys...).Err(); err != nil {
+		c.log.Error("unable to del keys in the cache")
+		return err
+	}
+
+	return nil
+}
+
+func (c *client) Incr(ctx context.Context, key string) error {
+	if err := c.rdb.Incr(ctx, key).Err(); err != nil {
+		c.log.Error("unable to incr key in the cache", slog.String("key", key))
+		return err
+	}
+
+	return nil
+}
+
+func (c *client) Decr(ctx context.Context, key string) error {
+	if err := c.rdb.Decr(ctx, key).Err(); err != nil {
+		c.log.Error("unable to decr key in the cache", slog.String("key", key))
+		return err
+	}
+
+	return nil
+}
+
+func (c *client) TTL(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := c.rdb.TTL(ctx, key).Result()
+	if err != nil {
+		c.log.Error("unable to ttl key in the cache", slog.String("key", key))
+		return ttl, err
+	}
+
+	return ttl, nil
+}
+
+func (c *client) Expire(ctx context.Context, key string, duration time.Duration) error {
+	if err := c.rdb.Expire(ctx, key, duration).Err(); err != nil {
+		c.log.Error("unable to expire key in the cache", slog.String("key", key))
+		return err
+	}
+
+	return nil
+}
+
+func (c *client) ExpireAt(ctx context.Context, key string, tm time.Time) error {
+	if err := c.rdb.ExpireAt(ctx, key, tm).Err(); err != nil {
+		c.log.Error("unable to expire key in the cache", slog.String("key", key))
+		return err
+	}
+
+	return nil
+}
+
+// Hash commands
+func (c *client) HSet(ctx context.Context, key, field string, value interface{}) error {
+	if err := c.rdb.HSet(ctx, key, field, value).Err(); err != nil {
+		c.log.Error("unable to set field in the hash", slog.String("key", key), slog.String("field", field))
+		return err
+	}
+
+	return nil
+}
+
+func (c *client) HGet(ctx context.Context, key, field string) (string, error) {
+	val, err := c.rdb.HGet(ctx, key, field).Result()
+	if err != nil {
+		c.log.Error("unable to get field from the hash", slog.String("key", key), slog.String("field", field))
+		return "", err
+	}
+
+	return val, nil
+}
+
+func (c *client) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	val, err := c.rdb.HGetAll(ctx, key).Result()
+	if err != nil {
+		c.log.Error("unable to get all fields from the hash", slog.String("key", key))
+		return nil, err
+	}
+
+	return val, nil
+}
+
+func (c *client) HIncrBy(ctx context.Context, key, field string, incr int64) error {
+	if err := c.rdb.HIncrBy(ctx, key, field, incr).Err(); err != nil {
+		c.log.Error("unable to increment field in the hash", slog.String("key", key), slog.String("field", field))
+		return err
+	}
+
+	return nil
+}
+
+// List commands
+func (c *client) LPush(ctx context.Context, key string, value interface{}) error {
+	if err := c.rdb.LPush(ctx, key, value).Err(); err != nil {
+		c.log.Error("unable to lpush key in the cache", slog.String("key", key))
+		return err
+	}
+
+	return nil
+}
+
+func (c *client) LPushAll(ctx context.Context, key string, values ...interface{}) (int64, error) {
+	val, err := c.rdb.LPush(ctx, key, values...).Result()
+	if err != nil {
+		c.log.Error("unable to lpush keys in the cache", slog.String("key", key))
+		return 0, err
+	}
+
+	return val, nil
+}
+
+func (c *client) LPop(ctx context.Context, key string) (string, error) {
+	val, err := c.rdb.LPop(ctx, key).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", ErrKeyNotFound
+		}
+		c.log.Error("unable to lpop key in the cache", slog.String("key", key))
+		return "", err
+	}
+
+	return val, nil
+}
+
+func (c *client) RPop(ctx context.Context, key string) (string, error) {
+	val, err := c.rdb.RPop(ctx, key).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", ErrKeyNotFound
+		}
+		c.log.Error("unable to rpop key in the cache", slog.String("key", key))
+		return "", err
+	}
+
+	return val, nil
+}
+
+func (c *client) LTrim(ctx context.Context, key string, start, stop int64) error {
+	if err := c.rdb.LTrim(ctx, key, start, stop).Err(); err != nil {
+		c.log.Error("unable to ltrim key in the cache", slog.String("key", key))
+		return err
+	}
+
+	return nil
+}
+
+func (c *client) LLen(ctx context.Context, key string) (int64, error) {
+	val, err := c.rdb.LLen(ctx, key).Result()
+	if err != nil {
+		c.log.Error("unable to llen key in the cache", slog.String("key", key))
+		return 0, err
+	}
+
+	return val, nil
+}
+
+func (c *client) LRange(ctx context.Context, key string) ([]string, error) {
+	val, err := c.rdb.LRange(ctx, key, 0, -1).Result()
+	if err != nil {
+		c.log.Error("unable to lrange key in the cache", slog.String("key", key))
+		return nil, err
+	}
+
+	return val, nil
+}
+
+// Set commands
+func (c *client) SAdd(ctx context.Context, key string, value interface{}) (int64, error) {
+	val, err := c.rdb.SAdd(ctx, key, value).Result()
+	if err != nil {
+		c.log.Error("unable to sadd key in the cache", slog.String("key", key))
+		return 0, err
+	}
+
+	return val, nil
+}
+
+func (c *client) SAddAll(ctx context.Context, key string, values ...interface{}) (int64, error) {
+	val, err := c.rdb.SAdd(ctx, key, values...).Result()
+	if err != nil {
+		c.log.Error("unable to sadd keys in the cache", slog.String("key", key))
+		return 0, err
+	}
+
+	return val, nil
+}
+
+func (c *client) SRem(ctx context.Context, key string, value interface{}) (int64, error) {
+	val, err := c.rdb.SRem(ctx, key, value).Result()
+	if err != nil {
+		c.log.Error("unable to srem key in the cache", slog.String("key", key))
+		return 0, err
+	}
+
+	return val, nil
+}
+
+func (c *client) SCard(ctx context.Context, key string) (int64, error) {
+	val, err := c.rdb.SCard(ctx, key).Result()
+	if err != nil {
+		c.log.Error("unable to scard key in the cache", slog.String("key", key))
+		return 0, err
+	}
+
+	return val, nil
+}
+
+func (c *client) SIsMember(ctx context.Context, key string, value interface{}) (bool, error) {
+	val, err := c.rdb.SIsMember(ctx, key, value).Result()
+	if err != nil {
+		c.log.Error("unable to sismember key in the cache", slog.String("key", key))
+		return false, err
+	}
+
+	return val, nil
+}
+
+func (c *client) SMembers(ctx context.Context, key string) ([]string, error) {
+	val, err := c.rdb.SMembers(ctx, key).Result()
+	if err != nil {
+		c.log.Error("unable to smembers key in the cache", slog.String("key", key))
+		return nil, err
+	}
+
+	return val, nil
+}
+
+// Sorted Set commands
+func (c *client) ZAdd(ctx context.Context, key string, value interface{}) error {
+	return c.ZAddWithScore(ctx, key, float64(time.Now().UnixMilli()), value)
+}
+
+func (c *client) ZAddWithScore(ctx context.Context, key string, score float64, value interface{}) error {
+	if err := c.rdb.ZAdd(ctx, key, redis.Z{Score: score, Member: value}).Err(); err != nil {
+		c.log.Error("unable to zadd key in the cache", slog.String("key", key))
+		return err
+	}
+
+	return nil
+}
+
+func (c *client) ZRem(ctx context.Context, key string, value interface{}) (int64, error) {
+	val, err := c.rdb.ZRem(ctx, key, value).Result()
+	if err != nil {
+		c.log.Error("unable to zrem key in the cache", slog.String("key", key))
+		return 0, err
+	}
+
+	return val, nil
+}
+
+func (c *client) ZPopMin(ctx context.Context, key string, count int64) ([]string, error) {
+	val, err := c.rdb.ZPopMin(ctx, key, count).Result()
+	if err != nil {
+		c.log.Error("unable to zpopmin key in the cache", slog.String("key", key))
+		return nil, err
+	}
+
+	members := make([]string, 0, len(val))
+	for _, z := range val {
+		member, _ := z.Member.(string)
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+func (c *client) ZCount(ctx context.Context, key string) (int64, error) {
+	val, err := c.rdb.ZCount(ctx, key, "-inf", "+inf").Result()
+	if err != nil {
+		c.log.Error("unable to zcount key in the cache", slog.String("key", key))
+		return 0, err
+	}
+
+	return val, nil
+}
+
+func (c *client) ZRange(ctx context.Context, key string) ([]string, error) {
+	val, err := c.rdb.ZRange(ctx, key, 0, -1).Result()
+	if err != nil {
+		c.log.Error("unable to zrange key in the cache", slog.String("key", key))
+		return nil, err
+	}
+
+	return val, nil
+}
+
+// Connection management
+func (c *client) Ping(ctx context.Context) error {
+	if err := c.rdb.Ping(ctx).Err(); err != nil {
+		c.log.Error("unable to ping redis", sl.Err(err))
+		return err
+	}
+
+	return nil
+}
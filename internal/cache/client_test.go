@@ -0,0 +1,59 @@
+package cache
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T) Client {
+	client := NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()}, slog.New(slog.DiscardHandler))
+	t.Cleanup(func() { _ = client.Close() })
+
+	return client
+}
+
+func TestSetNX(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		client = newTestClient(t)
+	)
+
+	_, err := client.Get(ctx, "key")
+	require.ErrorIs(t, err, ErrKeyNotFound)
+
+	set, err := client.SetNX(ctx, "key", "first", time.Minute)
+	require.NoError(t, err)
+	require.True(t, set)
+
+	set, err = client.SetNX(ctx, "key", "second", time.Minute)
+	require.NoError(t, err)
+	require.False(t, set)
+
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	require.Equal(t, "first", value)
+}
+
+func TestScript(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		client = newTestClient(t)
+		script = redis.NewScript(`return redis.call('INCRBY', KEYS[1], ARGV[1])`)
+	)
+
+	require.NoError(t, client.Set(ctx, "counter", 1))
+
+	count, err := script.Run(ctx, client, []string{"counter"}, 2).Int()
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+}
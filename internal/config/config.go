@@ -27,17 +27,29 @@ const (
 
 // Config represents the configuration for the application.
 type Config struct {
-	Env        Env `env:"ENV" env-default:"local"`
-	GRPC       GRPC
-	HTTP       HTTPConfig
-	JWT        JWTConfig
-	TLS        TLSConfig
-	Swagger    SwaggerConfig
-	Database   DatabaseConfig
-	Redis      RedisConfig
-	Prometheus PrometheusConfig
-	Tracing    TracingConfig
-	Admin      AdminConfig
+	Env           Env `env:"ENV" env-default:"local"`
+	GRPC          GRPC
+	HTTP          HTTPConfig
+	ExtAuthz      ExtAuthzConfig
+	JWT           JWTConfig
+	MFA           MFAConfig
+	WebAuthn      WebAuthnConfig
+	PasswordReset PasswordResetConfig
+	Verification  EmailVerificationConfig
+	LoginThrottle LoginThrottleConfig
+	RateLimit     RateLimitConfig
+	UserRetention UserRetentionConfig
+	Audit         AuditConfig
+	Notifier      NotifierConfig
+	Relation      RelationConfig
+	ServiceAuth   ServiceAuthConfig
+	TLS           TLSConfig
+	Swagger       SwaggerConfig
+	Database      DatabaseConfig
+	Redis         RedisConfig
+	Prometheus    PrometheusConfig
+	Tracing       TracingConfig
+	Admin         AdminConfig
 }
 
 // GRPC represents the configuration for the GRPC server.
@@ -46,6 +58,9 @@ type GRPC struct {
 	Port      int           `env:"GRPC_PORT" env-default:"50051"`
 	Transport string        `env:"GRPC_TRANSPORT" env-default:"tcp"`
 	Timeout   time.Duration `env:"GRPC_TIMEOUT"`
+	// TrustedProxies are the CIDRs of the proxies whose x-forwarded-for header gives the client IP address,
+	// the HTTP gateway calls the gRPC server from the loopback address.
+	TrustedProxies []string `env:"GRPC_TRUSTED_PROXIES" env-default:"127.0.0.1/32,::1/128"`
 }
 
 // Address returns the address of the GRPC server in the format "host:port".
@@ -65,6 +80,23 @@ func (c *HTTPConfig) Address() string {
 	return net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
 }
 
+// ExtAuthzConfig represents the configuration for the Envoy external authorization server.
+type ExtAuthzConfig struct {
+	Enabled bool `env:"EXT_AUTHZ_ENABLED" env-default:"false"`
+	// Host is loopback by default: the server trusts the client address and headers Envoy sends,
+	// so only Envoy may reach it.
+	Host string `env:"EXT_AUTHZ_HOST" env-default:"127.0.0.1"`
+	Port int    `env:"EXT_AUTHZ_PORT" env-default:"50061"`
+	// RoutesPath is a JSON file mapping HTTP methods and paths to endpoints.
+	// gRPC requests are checked against the endpoint of their method and need no route.
+	RoutesPath string `env:"EXT_AUTHZ_ROUTES_PATH"`
+}
+
+// Address returns the address of the external authorization server in the format "host:port".
+func (c *ExtAuthzConfig) Address() string {
+	return net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
+}
+
 // SwaggerConfig represents the configuration for the Swagger server.
 type SwaggerConfig struct {
 	Host string `env:"SWAGGER_HOST" env-default:"0.0.0.0"`
@@ -131,9 +163,183 @@ func (c *TracingConfig) Address() string {
 
 // JWTConfig represents the configuration for the JWT.
 type JWTConfig struct {
-	SecretKey       string        `env:"JWT_SECRET_KEY" env-required:"true"`
-	AccessTokenTTL  time.Duration `env:"JWT_ACCESS_TTL" env-default:"15m"`
-	RefreshTokenTTL time.Duration `env:"JWT_REFRESH_TTL" env-default:"7d"`
+	// SigningAlgorithm is one of HS256, RS256, ES256 or EdDSA.
+	SigningAlgorithm string `env:"JWT_SIGNING_ALGORITHM" env-default:"HS256"`
+	// SecretKey is the shared secret used by HS256.
+	SecretKey string `env:"JWT_SECRET_KEY"`
+	// PrivateKeyPath is the PEM encoded private key used by asymmetric algorithms.
+	PrivateKeyPath string `env:"JWT_PRIVATE_KEY_PATH"`
+	// KeyID is the "kid" of the single configured key. Defaults to the key thumbprint.
+	KeyID string `env:"JWT_SIGNING_KEY_ID"`
+	// KeyringPath is a JSON keyring with active, next and retired keys.
+	// When set it replaces the single key settings above and is reloaded on SIGHUP.
+	KeyringPath     string        `env:"JWT_KEYRING_PATH"`
+	AccessTokenTTL  time.Duration `env:"JWT_ACCESS_TTL"        env-default:"15m"`
+	RefreshTokenTTL time.Duration `env:"JWT_REFRESH_TTL"       env-default:"7d"`
+	// EmbedPermissions adds the permissions of the user to access tokens as the "permissions" claim.
+	EmbedPermissions bool `env:"JWT_EMBED_PERMISSIONS" env-default:"false"`
+}
+
+// KeyRetention returns how long a retired signing key must keep verifying tokens.
+func (c *JWTConfig) KeyRetention() time.Duration {
+	return max(c.AccessTokenTTL, c.RefreshTokenTTL)
+}
+
+// MFAConfig represents the configuration for the multi-factor authentication.
+type MFAConfig struct {
+	// Issuer is shown next to the account in authenticator apps.
+	Issuer string `env:"MFA_ISSUER" env-default:"microservice-auth"`
+	// ChallengeTTL is how long a login may wait for the second factor.
+	ChallengeTTL time.Duration `env:"MFA_CHALLENGE_TTL" env-default:"5m"`
+	// MaxChallengeFailures is the number of wrong codes after which a challenge token is revoked.
+	MaxChallengeFailures int `env:"MFA_MAX_CHALLENGE_FAILURES" env-default:"5"`
+	// MaxFailures is the number of wrong codes of a user, over all challenges, after which codes are refused
+	// for the lockout duration of the logins.
+	MaxFailures int `env:"MFA_MAX_FAILURES" env-default:"10"`
+}
+
+// WebAuthnConfig represents the configuration for the passkey authentication.
+type WebAuthnConfig struct {
+	// RPID is the domain passkeys are bound to.
+	RPID string `env:"WEBAUTHN_RP_ID" env-default:"localhost"`
+	// RPDisplayName is shown to the user when a passkey is created.
+	RPDisplayName string `env:"WEBAUTHN_RP_DISPLAY_NAME" env-default:"microservice-auth"`
+	// RPOrigins are the comma separated origins allowed to run the ceremonies.
+	RPOrigins []string `env:"WEBAUTHN_RP_ORIGINS" env-default:"http://localhost:8480"`
+	// CeremonyTTL is how long a registration or login ceremony may take.
+	CeremonyTTL time.Duration `env:"WEBAUTHN_CEREMONY_TTL" env-default:"5m"`
+}
+
+// PasswordResetConfig represents the configuration for the self-service password reset.
+type PasswordResetConfig struct {
+	// TokenTTL is how long a reset token can be used.
+	TokenTTL time.Duration `env:"PASSWORD_RESET_TTL" env-default:"30m"`
+	// URL is the page of the client the reset token is appended to.
+	URL string `env:"PASSWORD_RESET_URL" env-default:"http://localhost:8480/reset-password?token="`
+}
+
+// EmailVerificationConfig represents the configuration for the email address verification.
+type EmailVerificationConfig struct {
+	// Required blocks the login of users whose email is not verified.
+	Required bool `env:"EMAIL_VERIFICATION_REQUIRED" env-default:"false"`
+	// TokenTTL is how long a verification token can be used.
+	TokenTTL time.Duration `env:"EMAIL_VERIFICATION_TTL" env-default:"24h"`
+	// URL is the page of the client the verification token is appended to.
+	URL string `env:"EMAIL_VERIFICATION_URL" env-default:"http://localhost:8480/verify-email?token="`
+}
+
+// LoginThrottleConfig represents the configuration for the protection of the login against password guessing.
+// Failed logins are counted for each username and for each client IP; a count of zero disables the check.
+type LoginThrottleConfig struct {
+	// BackoffAfter is the number of failures of a username after which every further attempt has to wait.
+	BackoffAfter int `env:"LOGIN_BACKOFF_AFTER" env-default:"3"`
+	// LockoutAfter is the number of failures of a username after which it is locked out.
+	LockoutAfter int `env:"LOGIN_LOCKOUT_AFTER" env-default:"10"`
+	// IPBackoffAfter is the number of failures from a client IP after which every further attempt has to wait.
+	IPBackoffAfter int `env:"LOGIN_IP_BACKOFF_AFTER" env-default:"20"`
+	// IPLockoutAfter is the number of failures from a client IP after which it is locked out.
+	IPLockoutAfter int `env:"LOGIN_IP_LOCKOUT_AFTER" env-default:"100"`
+	// BackoffBase is the wait after the first failure past the backoff threshold; it doubles with every further one.
+	BackoffBase time.Duration `env:"LOGIN_BACKOFF_BASE" env-default:"1s"`
+	// BackoffMax caps the wait between attempts.
+	BackoffMax time.Duration `env:"LOGIN_BACKOFF_MAX" env-default:"5m"`
+	// LockoutDuration is how long a lockout lasts after the last failure.
+	LockoutDuration time.Duration `env:"LOGIN_LOCKOUT_DURATION" env-default:"15m"`
+	// FailureWindow is how long failures are remembered after the last one.
+	FailureWindow time.Duration `env:"LOGIN_FAILURE_WINDOW" env-default:"1h"`
+}
+
+// RateLimitConfig represents the configuration for the limits of the calls to the gRPC methods.
+type RateLimitConfig struct {
+	Enabled bool `env:"RATE_LIMIT_ENABLED" env-default:"true"`
+	// Rules are written as "<method> <scope> <limit>/<period> [<algorithm>]", see ratelimit.ParseRule.
+	// A call has to be within every rule that matches its method.
+	Rules []string `env:"RATE_LIMIT_RULES" env-separator:";"`
+}
+
+// UserRetentionConfig represents the configuration for the purge of deleted users.
+type UserRetentionConfig struct {
+	// Period is how long deleted and deactivated users are kept, so they can still be restored.
+	Period time.Duration `env:"USER_RETENTION_PERIOD" env-default:"720h"`
+	// PurgeInterval is how often users past the retention period are purged.
+	PurgeInterval time.Duration `env:"USER_PURGE_INTERVAL" env-default:"1h"`
+	// PurgeBatchSize is how many users are purged at once.
+	PurgeBatchSize int `env:"USER_PURGE_BATCH_SIZE" env-default:"100"`
+}
+
+// validate checks that the purge runs, a zero interval or batch size would stop it for good.
+func (c *UserRetentionConfig) validate() error {
+	if c.PurgeInterval <= 0 {
+		return fmt.Errorf("USER_PURGE_INTERVAL must be positive, got %s", c.PurgeInterval)
+	}
+	if c.PurgeBatchSize <= 0 {
+		return fmt.Errorf("USER_PURGE_BATCH_SIZE must be positive, got %d", c.PurgeBatchSize)
+	}
+
+	return nil
+}
+
+// AuditConfig represents the configuration for the hash chain of the audit log.
+type AuditConfig struct {
+	// SealInterval is how often the recorded events are linked to the hash chain.
+	SealInterval time.Duration `env:"AUDIT_SEAL_INTERVAL" env-default:"1s"`
+}
+
+// validate checks that the events are sealed, a zero interval would stop it for good.
+func (c *AuditConfig) validate() error {
+	if c.SealInterval <= 0 {
+		return fmt.Errorf("AUDIT_SEAL_INTERVAL must be positive, got %s", c.SealInterval)
+	}
+
+	return nil
+}
+
+// NotifierConfig represents the configuration for the outbound notifications.
+type NotifierConfig struct {
+	// Sender is either "smtp" or "file".
+	Sender string `env:"NOTIFIER_SENDER" env-default:"file"`
+	// FilePath is the file the file sender appends messages to. Messages go to stdout when it is empty.
+	FilePath string `env:"NOTIFIER_FILE_PATH"`
+	// DefaultLocale is used when there is no template for the locale of the recipient.
+	DefaultLocale string `env:"NOTIFIER_DEFAULT_LOCALE" env-default:"en"`
+	// PollInterval is how often the outbox is checked for messages to send.
+	PollInterval time.Duration `env:"NOTIFIER_POLL_INTERVAL" env-default:"5s"`
+	// BatchSize is how many messages are taken from the outbox at once.
+	BatchSize int `env:"NOTIFIER_BATCH_SIZE" env-default:"20"`
+	// MaxAttempts is how many times a message is tried before it is given up.
+	MaxAttempts int `env:"NOTIFIER_MAX_ATTEMPTS" env-default:"8"`
+	// RetryBackoff is the delay before the first retry, it doubles with every further attempt.
+	RetryBackoff time.Duration `env:"NOTIFIER_RETRY_BACKOFF" env-default:"30s"`
+	SMTP         SMTPConfig
+}
+
+// SMTPConfig represents the configuration for the SMTP server.
+type SMTPConfig struct {
+	Host     string `env:"SMTP_HOST"     env-default:"localhost"`
+	Port     int    `env:"SMTP_PORT"     env-default:"587"`
+	Username string `env:"SMTP_USERNAME"`
+	Password string `env:"SMTP_PASSWORD"`
+	From     string `env:"SMTP_FROM"     env-default:"no-reply@localhost"`
+}
+
+// Address returns the address of the SMTP server in the format "host:port".
+func (c *SMTPConfig) Address() string {
+	return net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
+}
+
+// RelationConfig represents the configuration for the relationship-based authorization of resources.
+type RelationConfig struct {
+	// SchemaPath is a JSON schema of the namespaces and of their relations. No relation is defined when it is empty.
+	SchemaPath string `env:"RELATION_SCHEMA_PATH"`
+	// MaxDepth is how deep the relation graph is traversed to check a relation.
+	MaxDepth int `env:"RELATION_MAX_DEPTH" env-default:"10"`
+}
+
+// ServiceAuthConfig represents the configuration for the authentication of trusted services.
+type ServiceAuthConfig struct {
+	// KeysPath is a JSON file of the services and of the SHA-256 hashes of their keys.
+	// No service can authenticate when it is empty.
+	KeysPath string `env:"SERVICE_KEYS_PATH"`
 }
 
 // TLSConfig represents the configuration for the TLSConfig.
@@ -169,6 +375,12 @@ func NewConfig() (*Config, error) {
 	if err = cleanenv.ReadEnv(cfg); err != nil {
 		return nil, fmt.Errorf("error reading env: %w", err)
 	}
+	if err = cfg.UserRetention.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	if err = cfg.Audit.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
 	log.Printf("Load environment: %s", cfg.Env)
 
 	return cfg, nil
@@ -1,6 +1,8 @@
 package converter
 
 import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/8thgencore/microservice-auth/internal/model"
 	accessv1 "github.com/8thgencore/microservice-auth/pkg/pb/access/v1"
 )
@@ -18,8 +20,9 @@ func ToEndpointPermissionsMap(endpointPermissions []*model.EndpointPermissions)
 // ToEndpointPermissionsFromAPI converts structure of API layer to service layer model.
 func ToEndpointPermissionsFromAPI(endpointPermissions *accessv1.EndpointPermissions) *model.EndpointPermissions {
 	return &model.EndpointPermissions{
-		Endpoint: endpointPermissions.Endpoint,
-		Roles:    ToRoleStrings(endpointPermissions.AllowedRoles),
+		Endpoint:  endpointPermissions.Endpoint,
+		Roles:     ToRoleNames(endpointPermissions.Roles, endpointPermissions.AllowedRoles),
+		Condition: endpointPermissions.Condition,
 	}
 }
 
@@ -28,5 +31,82 @@ func ToEndpointPermissionsService(endpointPermissions *model.EndpointPermissions
 	return &accessv1.EndpointPermissions{
 		Endpoint:     endpointPermissions.Endpoint,
 		AllowedRoles: ToRoleEnumsAPI(endpointPermissions.Roles),
+		Roles:        endpointPermissions.Roles,
+		Condition:    endpointPermissions.Condition,
+	}
+}
+
+// ToDenyRuleFromAPI converts the request to add a deny rule to service layer model.
+func ToDenyRuleFromAPI(req *accessv1.AddDenyRuleRequest) *model.DenyRule {
+	return &model.DenyRule{
+		Name:        req.GetName(),
+		Endpoint:    req.GetEndpoint(),
+		Roles:       req.GetRoles(),
+		UserIDs:     req.GetUserIds(),
+		ExceptRoles: req.GetExceptRoles(),
+		Reason:      req.GetReason(),
 	}
 }
+
+// ToDenyRuleAPI converts service layer model to structure of API layer.
+func ToDenyRuleAPI(rule *model.DenyRule) *accessv1.DenyRule {
+	return &accessv1.DenyRule{
+		Name:        rule.Name,
+		Endpoint:    rule.Endpoint,
+		Roles:       rule.Roles,
+		UserIds:     rule.UserIDs,
+		ExceptRoles: rule.ExceptRoles,
+		Reason:      rule.Reason,
+		CreatedAt:   timestamppb.New(rule.CreatedAt),
+	}
+}
+
+// ToAccessChecksFromAPI converts structures of API layer to service layer models.
+func ToAccessChecksFromAPI(checks []*accessv1.AccessCheck) []*model.AccessCheck {
+	res := make([]*model.AccessCheck, 0, len(checks))
+	for _, check := range checks {
+		res = append(res, &model.AccessCheck{
+			Endpoint:   check.GetEndpoint(),
+			Attributes: check.GetAttributes(),
+		})
+	}
+
+	return res
+}
+
+// ToAccessPrincipalFromAPI converts the principal of a check request to service layer model,
+// nil when the request has none.
+func ToAccessPrincipalFromAPI(req *accessv1.CheckRequest) *model.AccessPrincipal {
+	switch principal := req.GetPrincipal().(type) {
+	case *accessv1.CheckRequest_AccessToken:
+		return &model.AccessPrincipal{AccessToken: principal.AccessToken}
+	case *accessv1.CheckRequest_Subject:
+		return &model.AccessPrincipal{
+			UserID: principal.Subject.GetUserId(),
+			Roles:  principal.Subject.GetRoles(),
+		}
+	default:
+		return nil
+	}
+}
+
+// ToAccessDecisionAPI converts service layer model to structure of API layer, with its explanation if requested.
+func ToAccessDecisionAPI(decision *model.AccessDecision, explain bool) *accessv1.AccessDecision {
+	res := &accessv1.AccessDecision{
+		Endpoint: decision.Endpoint,
+		Allowed:  decision.Allowed,
+	}
+	if explain {
+		res.Explanation = &accessv1.AccessExplanation{
+			Reason:       accessv1.AccessDecisionReason(accessv1.AccessDecisionReason_value[string(decision.Reason)]),
+			Policy:       decision.Policy,
+			AllowedRoles: decision.AllowedRoles,
+			Condition:    decision.Condition,
+			Permissions:  decision.Permissions,
+			Roles:        decision.Roles,
+			DenyRule:     decision.DenyRule,
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,116 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	auditv1 "github.com/8thgencore/microservice-auth/pkg/pb/audit/v1"
+)
+
+// ToAuditEventFromService converts service layer model to structure of API layer.
+func ToAuditEventFromService(event *model.AuditEvent) *auditv1.AuditEvent {
+	changes := make(map[string]*auditv1.FieldChange, len(event.Changes))
+	for field, change := range event.Changes {
+		changes[field] = &auditv1.FieldChange{
+			Old: toValue(change.Old),
+			New: toValue(change.New),
+		}
+	}
+
+	return &auditv1.AuditEvent{
+		Id:         event.ID,
+		OccurredAt: timestamppb.New(event.OccurredAt),
+		ActorId:    event.ActorID,
+		Action:     string(event.Action),
+		TargetType: string(event.Target.Type),
+		TargetId:   event.Target.ID,
+		Changes:    changes,
+		Metadata:   event.Metadata,
+		IpAddress:  event.IPAddress,
+		UserAgent:  event.UserAgent,
+		TraceId:    event.TraceID,
+		Seq:        event.Seq,
+		PrevHash:   event.PrevHash,
+		Hash:       event.Hash,
+	}
+}
+
+// ToAuditListParamsFromAPI converts structure of API layer to service layer model.
+func ToAuditListParamsFromAPI(req *auditv1.ListAuditEventsRequest) *model.AuditListParams {
+	params := &model.AuditListParams{
+		Filter: model.AuditFilter{
+			ActorID: req.GetActorId(),
+			Target: model.AuditTarget{
+				Type: model.AuditTargetType(req.GetTargetType()),
+				ID:   req.GetTargetId(),
+			},
+			Action: model.AuditAction(req.GetAction()),
+		},
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	}
+
+	if req.From != nil {
+		from := req.GetFrom().AsTime()
+		params.Filter.From = &from
+	}
+	if req.To != nil {
+		to := req.GetTo().AsTime()
+		params.Filter.To = &to
+	}
+
+	return params
+}
+
+// ToListAuditEventsResponseFromService converts service layer model to structure of API layer.
+func ToListAuditEventsResponseFromService(page *model.AuditPage) *auditv1.ListAuditEventsResponse {
+	events := make([]*auditv1.AuditEvent, 0, len(page.Events))
+	for _, event := range page.Events {
+		events = append(events, ToAuditEventFromService(event))
+	}
+
+	return &auditv1.ListAuditEventsResponse{
+		Events:        events,
+		NextPageToken: page.NextPageToken,
+	}
+}
+
+// ToVerifyAuditChainResponseFromService converts service layer model to structure of API layer.
+func ToVerifyAuditChainResponseFromService(
+	verification *model.AuditChainVerification,
+) *auditv1.VerifyAuditChainResponse {
+	res := &auditv1.VerifyAuditChainResponse{
+		Verified:  verification.Verified,
+		Checked:   verification.Checked,
+		Unchained: verification.Unchained,
+		HeadSeq:   verification.HeadSeq,
+		HeadHash:  verification.HeadHash,
+	}
+
+	if verification.Break != nil {
+		res.Break = &auditv1.AuditChainBreak{
+			Seq:     verification.Break.Seq,
+			EventId: verification.Break.EventID,
+			Reason: auditv1.AuditChainBreakReason(
+				auditv1.AuditChainBreakReason_value[string(verification.Break.Reason)],
+			),
+		}
+	}
+
+	return res
+}
+
+// toValue converts a value decoded from JSON to a protobuf value, nil when there is no value.
+func toValue(v any) *structpb.Value {
+	if v == nil {
+		return nil
+	}
+
+	value, err := structpb.NewValue(v)
+	if err != nil {
+		return nil
+	}
+
+	return value
+}
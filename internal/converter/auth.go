@@ -1,6 +1,8 @@
 package converter
 
 import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/8thgencore/microservice-auth/internal/model"
 	authv1 "github.com/8thgencore/microservice-auth/pkg/pb/auth/v1"
 )
@@ -12,3 +14,26 @@ func ToUserLoginFromAPI(creds *authv1.Creds) *model.UserCreds {
 		Password: creds.Password,
 	}
 }
+
+// ToSessionsFromService converts service layer models to structures of API layer.
+// The session with currentSessionID is marked as the current one.
+func ToSessionsFromService(sessions []*model.TokenFamily, currentSessionID string) []*authv1.Session {
+	res := make([]*authv1.Session, 0, len(sessions))
+	for _, session := range sessions {
+		var lastRefreshedAt *timestamppb.Timestamp
+		if session.LastRefreshedAt.Valid {
+			lastRefreshedAt = timestamppb.New(session.LastRefreshedAt.Time)
+		}
+
+		res = append(res, &authv1.Session{
+			Id:              session.ID,
+			IpAddress:       session.IPAddress,
+			UserAgent:       session.UserAgent,
+			CreatedAt:       timestamppb.New(session.CreatedAt),
+			LastRefreshedAt: lastRefreshedAt,
+			Current:         currentSessionID != "" && session.ID == currentSessionID,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,69 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	permissionv1 "github.com/8thgencore/microservice-auth/pkg/pb/permission/v1"
+)
+
+// ToPermissionFromService converts service layer model to structure of API layer.
+func ToPermissionFromService(permission *model.Permission) *permissionv1.Permission {
+	var updatedAt *timestamppb.Timestamp
+	if permission.UpdatedAt.Valid {
+		updatedAt = timestamppb.New(permission.UpdatedAt.Time)
+	}
+
+	return &permissionv1.Permission{
+		Name:        permission.Name,
+		Description: permission.Description,
+		Endpoints:   permission.Endpoints,
+		Roles:       permission.Roles,
+		CreatedAt:   timestamppb.New(permission.CreatedAt),
+		UpdatedAt:   updatedAt,
+	}
+}
+
+// ToPermissionCreateFromAPI converts structure of API layer to service layer model.
+func ToPermissionCreateFromAPI(req *permissionv1.CreatePermissionRequest) *model.Permission {
+	return &model.Permission{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Endpoints:   req.GetEndpoints(),
+		Roles:       req.GetRoles(),
+	}
+}
+
+// ToPermissionUpdateFromAPI converts structure of API layer to service layer model.
+func ToPermissionUpdateFromAPI(req *permissionv1.UpdatePermissionRequest) *model.PermissionUpdate {
+	update := &model.PermissionUpdate{
+		Name: req.GetName(),
+	}
+
+	if req.Description != nil {
+		description := req.GetDescription().GetValue()
+		update.Description = &description
+	}
+	if req.Endpoints != nil {
+		endpoints := req.GetEndpoints().GetEndpoints()
+		update.Endpoints = &endpoints
+	}
+	if req.Roles != nil {
+		roles := req.GetRoles().GetNames()
+		update.Roles = &roles
+	}
+
+	return update
+}
+
+// ToListPermissionsResponseFromService converts service layer model to structure of API layer.
+func ToListPermissionsResponseFromService(permissions []*model.Permission) *permissionv1.ListPermissionsResponse {
+	res := make([]*permissionv1.Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		res = append(res, ToPermissionFromService(permission))
+	}
+
+	return &permissionv1.ListPermissionsResponse{
+		Permissions: res,
+	}
+}
@@ -0,0 +1,65 @@
+package converter
+
+import (
+	"strings"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	accessv1 "github.com/8thgencore/microservice-auth/pkg/pb/access/v1"
+)
+
+// ToRelationTuplesFromAPI converts structures of API layer to service layer models.
+func ToRelationTuplesFromAPI(tuples []*accessv1.RelationTuple) []*model.RelationTuple {
+	res := make([]*model.RelationTuple, 0, len(tuples))
+	for _, tuple := range tuples {
+		res = append(res, ToRelationTupleFromAPI(tuple))
+	}
+
+	return res
+}
+
+// ToRelationTupleFromAPI converts structure of API layer to service layer model.
+func ToRelationTupleFromAPI(tuple *accessv1.RelationTuple) *model.RelationTuple {
+	return &model.RelationTuple{
+		Object:   ToRelationObjectFromAPI(tuple.GetObject()),
+		Relation: tuple.GetRelation(),
+		Subject:  ToRelationSubjectFromAPI(tuple.GetSubject()),
+	}
+}
+
+// ToRelationObjectFromAPI converts an object written namespace:id to service layer model.
+func ToRelationObjectFromAPI(object string) model.RelationObject {
+	namespace, id, _ := strings.Cut(object, ":")
+
+	return model.RelationObject{Namespace: namespace, ID: id}
+}
+
+// ToRelationSubjectFromAPI converts a subject written namespace:id or namespace:id#relation
+// to service layer model.
+func ToRelationSubjectFromAPI(subject string) model.RelationSubject {
+	object, relation, _ := strings.Cut(subject, "#")
+	o := ToRelationObjectFromAPI(object)
+
+	return model.RelationSubject{Namespace: o.Namespace, ID: o.ID, Relation: relation}
+}
+
+// ToRelationObjectsParamsFromAPI converts the request of API layer to service layer model.
+func ToRelationObjectsParamsFromAPI(req *accessv1.ListObjectsRequest) *model.RelationObjectsParams {
+	return &model.RelationObjectsParams{
+		Namespace:        req.GetNamespace(),
+		Relation:         req.GetRelation(),
+		Subject:          ToRelationSubjectFromAPI(req.GetSubject()),
+		ConsistencyToken: req.GetConsistencyToken(),
+		PageSize:         int(req.GetPageSize()),
+		PageToken:        req.GetPageToken(),
+	}
+}
+
+// ToRelationObjectsAPI converts the IDs of objects of the namespace to objects written namespace:id.
+func ToRelationObjectsAPI(namespace string, ids []string) []string {
+	res := make([]string, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, namespace+":"+id)
+	}
+
+	return res
+}
@@ -0,0 +1,63 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	rolev1 "github.com/8thgencore/microservice-auth/pkg/pb/role/v1"
+)
+
+// ToRoleFromService converts service layer model to structure of API layer.
+func ToRoleFromService(role *model.Role) *rolev1.Role {
+	var updatedAt *timestamppb.Timestamp
+	if role.UpdatedAt.Valid {
+		updatedAt = timestamppb.New(role.UpdatedAt.Time)
+	}
+
+	return &rolev1.Role{
+		Name:        role.Name,
+		Description: role.Description,
+		Parents:     role.Parents,
+		CreatedAt:   timestamppb.New(role.CreatedAt),
+		UpdatedAt:   updatedAt,
+	}
+}
+
+// ToRoleCreateFromAPI converts structure of API layer to service layer model.
+func ToRoleCreateFromAPI(req *rolev1.CreateRoleRequest) *model.Role {
+	return &model.Role{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Parents:     req.GetParents(),
+	}
+}
+
+// ToRoleUpdateFromAPI converts structure of API layer to service layer model.
+func ToRoleUpdateFromAPI(req *rolev1.UpdateRoleRequest) *model.RoleUpdate {
+	update := &model.RoleUpdate{
+		Name: req.GetName(),
+	}
+
+	if req.Description != nil {
+		description := req.GetDescription().GetValue()
+		update.Description = &description
+	}
+	if req.Parents != nil {
+		parents := req.GetParents().GetNames()
+		update.Parents = &parents
+	}
+
+	return update
+}
+
+// ToListRolesResponseFromService converts service layer model to structure of API layer.
+func ToListRolesResponseFromService(roles []*model.Role) *rolev1.ListRolesResponse {
+	res := make([]*rolev1.Role, 0, len(roles))
+	for _, role := range roles {
+		res = append(res, ToRoleFromService(role))
+	}
+
+	return &rolev1.ListRolesResponse{
+		Roles: res,
+	}
+}
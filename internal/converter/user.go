@@ -1,6 +1,8 @@
 package converter
 
 import (
+	"slices"
+
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/8thgencore/microservice-auth/internal/model"
@@ -9,18 +11,30 @@ import (
 
 // ToUserFromService converts service layer model to structure of API layer.
 func ToUserFromService(user *model.User) *userv1.User {
-	var updatedAt *timestamppb.Timestamp
+	var updatedAt, suspendedUntil, deletedAt *timestamppb.Timestamp
 	if user.UpdatedAt.Valid {
 		updatedAt = timestamppb.New(user.UpdatedAt.Time)
 	}
+	if user.SuspendedUntil.Valid {
+		suspendedUntil = timestamppb.New(user.SuspendedUntil.Time)
+	}
+	if user.DeletedAt.Valid {
+		deletedAt = timestamppb.New(user.DeletedAt.Time)
+	}
 
 	return &userv1.User{
-		Id:      user.ID,
-		Name:    user.Name,
-		Email:   user.Email,
-		Role:    userv1.Role(userv1.Role_value[user.Role]),
-		Created: timestamppb.New(user.CreatedAt),
-		Updated: updatedAt,
+		Id:             user.ID,
+		Name:           user.Name,
+		Email:          user.Email,
+		Role:           toLegacyRole(user.Roles),
+		Roles:          user.Roles,
+		Created:        timestamppb.New(user.CreatedAt),
+		Updated:        updatedAt,
+		EmailVerified:  user.EmailVerified,
+		PendingEmail:   user.PendingEmail.String,
+		Status:         userv1.UserStatus(userv1.UserStatus_value[string(user.Status)]),
+		SuspendedUntil: suspendedUntil,
+		DeletedAt:      deletedAt,
 	}
 }
 
@@ -31,7 +45,7 @@ func ToUserCreateFromAPI(user *userv1.UserCreate) *model.UserCreate {
 		Email:           user.Email,
 		Password:        user.Password,
 		PasswordConfirm: user.PasswordConfirm,
-		Role:            userv1.Role_name[int32(user.Role)],
+		Roles:           ToRoleNames(user.Roles, []userv1.Role{user.Role}),
 	}
 }
 
@@ -49,24 +63,17 @@ func ToUserUpdateFromAPI(user *userv1.UserUpdate) *model.UserUpdate {
 		email := user.Email.GetValue()
 		update.Email = &email
 	}
-	if user.Role != 0 {
-		role := userv1.Role_name[int32(user.Role)]
-		update.Role = &role
+	if user.Roles != nil {
+		roles := user.Roles.GetNames()
+		update.Roles = &roles
+	} else if user.Role != userv1.Role_UNKNOWN_UNSPECIFIED {
+		roles := []string{userv1.Role_name[int32(user.Role)]}
+		update.Roles = &roles
 	}
 
 	return update
 }
 
-// ToRoleStrings converts a list of Role enum values to a list of role strings.
-func ToRoleStrings(roles []userv1.Role) []string {
-	var roleStrings []string
-	for _, role := range roles {
-		roleStrings = append(roleStrings, userv1.Role_name[int32(role)])
-	}
-
-	return roleStrings
-}
-
 // ToRoleEnumsAPI converts a list of role strings to a list of Role enum values.
 func ToRoleEnumsAPI(roleStrings []string) []userv1.Role {
 	var roles []userv1.Role
@@ -78,3 +85,85 @@ func ToRoleEnumsAPI(roleStrings []string) []userv1.Role {
 
 	return roles
 }
+
+// ToRoleNames returns the role names together with the names of the deprecated Role enum values.
+func ToRoleNames(names []string, roles []userv1.Role) []string {
+	merged := slices.Clone(names)
+	for _, role := range roles {
+		if role == userv1.Role_UNKNOWN_UNSPECIFIED {
+			continue
+		}
+		if name := userv1.Role_name[int32(role)]; !slices.Contains(merged, name) {
+			merged = append(merged, name)
+		}
+	}
+
+	return merged
+}
+
+// toLegacyRole returns the deprecated role enum value describing the roles of a user.
+func toLegacyRole(roles []string) userv1.Role {
+	if slices.Contains(roles, string(model.UserRoleAdmin)) {
+		return userv1.Role_ADMIN
+	}
+
+	return userv1.Role_USER
+}
+
+// ToUserListParamsFromAPI converts structure of API layer to service layer model.
+func ToUserListParamsFromAPI(req *userv1.ListUsersRequest) *model.UserListParams {
+	params := &model.UserListParams{
+		Filter: model.UserFilter{
+			NamePrefix:  req.GetNamePrefix(),
+			EmailPrefix: req.GetEmailPrefix(),
+		},
+		OrderBy:    userOrderBy[req.GetOrderBy()],
+		Descending: req.GetDescending(),
+		PageSize:   int(req.GetPageSize()),
+		PageToken:  req.GetPageToken(),
+	}
+
+	if req.GetRoleName() != "" {
+		params.Filter.Role = req.GetRoleName()
+	} else if req.GetRole() != userv1.Role_UNKNOWN_UNSPECIFIED {
+		params.Filter.Role = userv1.Role_name[int32(req.GetRole())]
+	}
+	if req.CreatedAfter != nil {
+		createdAfter := req.GetCreatedAfter().AsTime()
+		params.Filter.CreatedAfter = &createdAfter
+	}
+	if req.CreatedBefore != nil {
+		createdBefore := req.GetCreatedBefore().AsTime()
+		params.Filter.CreatedBefore = &createdBefore
+	}
+	if req.EmailVerified != nil {
+		emailVerified := req.GetEmailVerified().GetValue()
+		params.Filter.EmailVerified = &emailVerified
+	}
+	if req.GetStatus() != userv1.UserStatus_USER_STATUS_UNSPECIFIED {
+		params.Filter.Status = model.UserStatus(userv1.UserStatus_name[int32(req.GetStatus())])
+	}
+
+	return params
+}
+
+// ToListUsersResponseFromService converts service layer model to structure of API layer.
+func ToListUsersResponseFromService(page *model.UserPage) *userv1.ListUsersResponse {
+	users := make([]*userv1.User, 0, len(page.Users))
+	for _, user := range page.Users {
+		users = append(users, ToUserFromService(user))
+	}
+
+	return &userv1.ListUsersResponse{
+		Users:         users,
+		NextPageToken: page.NextPageToken,
+		TotalSize:     page.TotalSize,
+	}
+}
+
+var userOrderBy = map[userv1.UserOrderBy]model.UserOrderBy{
+	userv1.UserOrderBy_USER_ORDER_BY_ID_UNSPECIFIED: model.UserOrderByID,
+	userv1.UserOrderBy_USER_ORDER_BY_NAME:           model.UserOrderByName,
+	userv1.UserOrderBy_USER_ORDER_BY_EMAIL:          model.UserOrderByEmail,
+	userv1.UserOrderBy_USER_ORDER_BY_CREATED_AT:     model.UserOrderByCreatedAt,
+}
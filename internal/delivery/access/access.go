@@ -2,18 +2,37 @@ package access
 
 import (
 	"context"
+	"errors"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/8thgencore/microservice-auth/internal/converter"
+	"github.com/8thgencore/microservice-auth/internal/service/access"
 	accessv1 "github.com/8thgencore/microservice-auth/pkg/pb/access/v1"
 )
 
-// Check performs user authorization.
+// Check performs user authorization for an endpoint or a named permission,
+// of the caller or of the principal of the request.
 func (i *Implementation) Check(ctx context.Context, req *accessv1.CheckRequest) (*empty.Empty, error) {
-	err := i.accessService.Check(ctx, req.GetEndpoint())
+	if (req.GetEndpoint() == "") == (req.GetPermission() == "") {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of endpoint and permission must be set")
+	}
+
+	principal := converter.ToAccessPrincipalFromAPI(req)
+
+	var err error
+	switch {
+	case principal != nil && req.GetPermission() != "":
+		err = i.accessService.CheckPermissionAs(ctx, principal, req.GetPermission())
+	case principal != nil:
+		err = i.accessService.CheckAs(ctx, principal, req.GetEndpoint(), req.GetAttributes())
+	case req.GetPermission() != "":
+		err = i.accessService.CheckPermission(ctx, req.GetPermission())
+	default:
+		err = i.accessService.Check(ctx, req.GetEndpoint(), req.GetAttributes())
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.PermissionDenied, "%s", err.Error())
 	}
@@ -21,13 +40,44 @@ func (i *Implementation) Check(ctx context.Context, req *accessv1.CheckRequest)
 	return &empty.Empty{}, nil
 }
 
+// BatchCheck decides on access to many endpoints at once.
+func (i *Implementation) BatchCheck(
+	ctx context.Context,
+	req *accessv1.BatchCheckRequest,
+) (*accessv1.BatchCheckResponse, error) {
+	decisions, err := i.accessService.BatchCheck(ctx, req.GetUserId(), converter.ToAccessChecksFromAPI(req.GetChecks()))
+	if err != nil {
+		switch {
+		case errors.Is(err, access.ErrUserNotFound):
+			return nil, status.Errorf(codes.NotFound, "%s", err.Error())
+		case errors.Is(err, access.ErrFailedToGetUser):
+			return nil, status.Errorf(codes.Internal, "%s", err.Error())
+		}
+
+		return nil, status.Errorf(codes.PermissionDenied, "%s", err.Error())
+	}
+
+	res := make([]*accessv1.AccessDecision, 0, len(decisions))
+	for _, decision := range decisions {
+		res = append(res, converter.ToAccessDecisionAPI(decision, req.GetExplain()))
+	}
+
+	return &accessv1.BatchCheckResponse{Decisions: res}, nil
+}
+
 // AddRoleEndpoint adds a new role-endpoint permission.
 func (i *Implementation) AddRoleEndpoint(
 	ctx context.Context,
 	req *accessv1.AddRoleEndpointRequest,
 ) (*empty.Empty, error) {
-	err := i.accessService.AddRoleEndpoint(ctx, req.GetEndpoint(), converter.ToRoleStrings(req.GetAllowedRoles()))
+	roles := converter.ToRoleNames(req.GetRoles(), req.GetAllowedRoles())
+	err := i.accessService.AddRoleEndpoint(ctx, req.GetEndpoint(), roles, req.GetCondition())
 	if err != nil {
+		if errors.Is(err, access.ErrNoRoles) || errors.Is(err, access.ErrUnknownRole) ||
+			errors.Is(err, access.ErrInvalidEndpointPattern) || errors.Is(err, access.ErrInvalidCondition) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+
 		return nil, status.Errorf(codes.Internal, "%s", err.Error())
 	}
 
@@ -39,8 +89,19 @@ func (i *Implementation) UpdateRoleEndpoint(
 	ctx context.Context,
 	req *accessv1.UpdateRoleEndpointRequest,
 ) (*empty.Empty, error) {
-	err := i.accessService.UpdateRoleEndpoint(ctx, req.GetEndpoint(), converter.ToRoleStrings(req.GetAllowedRoles()))
+	roles := converter.ToRoleNames(req.GetRoles(), req.GetAllowedRoles())
+	// An unset condition keeps the current one.
+	var condition *string
+	if req.GetCondition() != nil {
+		condition = &req.GetCondition().Value
+	}
+	err := i.accessService.UpdateRoleEndpoint(ctx, req.GetEndpoint(), roles, condition)
 	if err != nil {
+		if errors.Is(err, access.ErrNoRoles) || errors.Is(err, access.ErrUnknownRole) ||
+			errors.Is(err, access.ErrInvalidEndpointPattern) || errors.Is(err, access.ErrInvalidCondition) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+
 		return nil, status.Errorf(codes.Internal, "%s", err.Error())
 	}
 
@@ -60,12 +121,12 @@ func (i *Implementation) DeleteRoleEndpoint(
 	return &empty.Empty{}, nil
 }
 
-// GetRoleEndpoints retrieves the list of role-endpoint permissions.
+// GetRoleEndpoints retrieves the list of role-endpoint permissions, or the permissions matching an endpoint.
 func (i *Implementation) GetRoleEndpoints(
 	ctx context.Context,
-	_ *empty.Empty,
+	req *accessv1.GetRoleEndpointsRequest,
 ) (*accessv1.GetRoleEndpointsResponse, error) {
-	endpoints, err := i.accessService.GetRoleEndpoints(ctx)
+	endpoints, err := i.accessService.GetRoleEndpoints(ctx, req.GetEndpoint())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%s", err.Error())
 	}
@@ -76,7 +137,63 @@ func (i *Implementation) GetRoleEndpoints(
 		endpointPermissions = append(endpointPermissions, converter.ToEndpointPermissionsService(ep))
 	}
 
+	// The permissions matching the endpoint are listed from the one applied to it.
+	var matched string
+	if req.GetEndpoint() != "" && len(endpoints) > 0 {
+		matched = endpoints[0].Endpoint
+	}
+
 	return &accessv1.GetRoleEndpointsResponse{
 		EndpointPermissions: endpointPermissions,
+		Matched:             matched,
 	}, nil
 }
+
+// AddDenyRule adds a rule denying access to an endpoint.
+func (i *Implementation) AddDenyRule(ctx context.Context, req *accessv1.AddDenyRuleRequest) (*empty.Empty, error) {
+	err := i.accessService.AddDenyRule(ctx, converter.ToDenyRuleFromAPI(req))
+	if err != nil {
+		switch {
+		case errors.Is(err, access.ErrDenyRuleAlreadyExists):
+			return nil, status.Errorf(codes.AlreadyExists, "%s", err.Error())
+		case errors.Is(err, access.ErrUnknownRole) || errors.Is(err, access.ErrInvalidEndpointPattern):
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+
+		return nil, status.Errorf(codes.Internal, "%s", err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// DeleteDenyRule deletes an existing deny rule.
+func (i *Implementation) DeleteDenyRule(
+	ctx context.Context,
+	req *accessv1.DeleteDenyRuleRequest,
+) (*empty.Empty, error) {
+	err := i.accessService.DeleteDenyRule(ctx, req.GetName())
+	if err != nil {
+		if errors.Is(err, access.ErrDenyRuleNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%s", err.Error())
+		}
+
+		return nil, status.Errorf(codes.Internal, "%s", err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// GetDenyRules retrieves the list of deny rules.
+func (i *Implementation) GetDenyRules(ctx context.Context, _ *empty.Empty) (*accessv1.GetDenyRulesResponse, error) {
+	rules, err := i.accessService.GetDenyRules(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s", err.Error())
+	}
+
+	denyRules := make([]*accessv1.DenyRule, 0, len(rules))
+	for _, rule := range rules {
+		denyRules = append(denyRules, converter.ToDenyRuleAPI(rule))
+	}
+
+	return &accessv1.GetDenyRulesResponse{DenyRules: denyRules}, nil
+}
@@ -0,0 +1,87 @@
+package access
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/8thgencore/microservice-auth/internal/converter"
+	"github.com/8thgencore/microservice-auth/internal/service/access"
+	"github.com/8thgencore/microservice-auth/internal/service/relation"
+	accessv1 "github.com/8thgencore/microservice-auth/pkg/pb/access/v1"
+)
+
+// WriteTuples writes relation tuples.
+func (i *Implementation) WriteTuples(
+	ctx context.Context,
+	req *accessv1.WriteTuplesRequest,
+) (*accessv1.WriteTuplesResponse, error) {
+	token, err := i.relationService.WriteTuples(ctx, converter.ToRelationTuplesFromAPI(req.GetTuples()))
+	if err != nil {
+		return nil, relationStatus(err)
+	}
+
+	return &accessv1.WriteTuplesResponse{ConsistencyToken: token}, nil
+}
+
+// DeleteTuples deletes relation tuples.
+func (i *Implementation) DeleteTuples(
+	ctx context.Context,
+	req *accessv1.DeleteTuplesRequest,
+) (*accessv1.DeleteTuplesResponse, error) {
+	token, err := i.relationService.DeleteTuples(ctx, converter.ToRelationTuplesFromAPI(req.GetTuples()))
+	if err != nil {
+		return nil, relationStatus(err)
+	}
+
+	return &accessv1.DeleteTuplesResponse{ConsistencyToken: token}, nil
+}
+
+// CheckRelation checks whether a subject has a relation to an object.
+func (i *Implementation) CheckRelation(
+	ctx context.Context,
+	req *accessv1.CheckRelationRequest,
+) (*accessv1.CheckRelationResponse, error) {
+	allowed, token, err := i.relationService.CheckRelation(ctx, converter.ToRelationTupleFromAPI(req.GetTuple()),
+		req.GetConsistencyToken())
+	if err != nil {
+		return nil, relationStatus(err)
+	}
+
+	return &accessv1.CheckRelationResponse{Allowed: allowed, ConsistencyToken: token}, nil
+}
+
+// ListObjects lists the objects of a namespace a subject has a relation to.
+func (i *Implementation) ListObjects(
+	ctx context.Context,
+	req *accessv1.ListObjectsRequest,
+) (*accessv1.ListObjectsResponse, error) {
+	page, err := i.relationService.ListObjects(ctx, converter.ToRelationObjectsParamsFromAPI(req))
+	if err != nil {
+		return nil, relationStatus(err)
+	}
+
+	return &accessv1.ListObjectsResponse{
+		Objects:          converter.ToRelationObjectsAPI(req.GetNamespace(), page.IDs),
+		ConsistencyToken: page.ConsistencyToken,
+		NextPageToken:    page.NextPageToken,
+	}, nil
+}
+
+// relationStatus converts an error of the relation service to a status.
+func relationStatus(err error) error {
+	switch {
+	case errors.Is(err, access.ErrAccessDenied) || errors.Is(err, access.ErrEndpointNotFound) ||
+		errors.Is(err, access.ErrInvalidAccessToken):
+		return status.Errorf(codes.PermissionDenied, "%s", err.Error())
+	case errors.Is(err, relation.ErrUnknownNamespace) || errors.Is(err, relation.ErrUnknownRelation) ||
+		errors.Is(err, relation.ErrInvalidConsistencyToken) || errors.Is(err, relation.ErrInvalidPageToken):
+		return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	case errors.Is(err, relation.ErrDepthExceeded):
+		return status.Errorf(codes.ResourceExhausted, "%s", err.Error())
+	}
+
+	return status.Errorf(codes.Internal, "%s", err.Error())
+}
@@ -8,12 +8,14 @@ import (
 // Implementation structure describes API layer.
 type Implementation struct {
 	desc.UnimplementedAccessV1Server
-	accessService service.AccessService
+	accessService   service.AccessService
+	relationService service.RelationService
 }
 
 // NewImplementation creates new object of API layer.
-func NewImplementation(accessService service.AccessService) *Implementation {
+func NewImplementation(accessService service.AccessService, relationService service.RelationService) *Implementation {
 	return &Implementation{
-		accessService: accessService,
+		accessService:   accessService,
+		relationService: relationService,
 	}
 }
@@ -10,11 +10,15 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	accessAPI "github.com/8thgencore/microservice-auth/internal/delivery/access"
+	"github.com/8thgencore/microservice-auth/internal/model"
 	"github.com/8thgencore/microservice-auth/internal/service"
+	accessService "github.com/8thgencore/microservice-auth/internal/service/access"
 	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
 	accessv1 "github.com/8thgencore/microservice-auth/pkg/pb/access/v1"
+	userv1 "github.com/8thgencore/microservice-auth/pkg/pb/user/v1"
 )
 
 func TestCheck(t *testing.T) {
@@ -31,7 +35,9 @@ func TestCheck(t *testing.T) {
 		ctx = context.Background()
 		mc  = minimock.NewController(t)
 
-		endpoint = "/chat_v1.ChatV1/Create"
+		endpoint   = "/chat_v1.ChatV1/Create"
+		permission = "chat.moderate"
+		attributes = map[string]string{"owner_id": "owner-id"}
 
 		serviceErr = errors.New("service error")
 
@@ -59,7 +65,7 @@ func TestCheck(t *testing.T) {
 			err:  nil,
 			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
 				mock := serviceMocks.NewAccessServiceMock(mc)
-				mock.CheckMock.Expect(minimock.AnyContext, endpoint).Return(nil)
+				mock.CheckMock.Expect(minimock.AnyContext, endpoint, nil).Return(nil)
 				return mock
 			},
 		},
@@ -73,10 +79,133 @@ func TestCheck(t *testing.T) {
 			err:  status.Errorf(codes.PermissionDenied, "%s", serviceErr.Error()),
 			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
 				mock := serviceMocks.NewAccessServiceMock(mc)
-				mock.CheckMock.Expect(minimock.AnyContext, endpoint).Return(serviceErr)
+				mock.CheckMock.Expect(minimock.AnyContext, endpoint, nil).Return(serviceErr)
 				return mock
 			},
 		},
+		{
+			name: "attributes success case",
+			args: args{
+				ctx: ctx,
+				req: &accessv1.CheckRequest{Endpoint: endpoint, Attributes: attributes},
+			},
+			want: res,
+			err:  nil,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.CheckMock.Expect(minimock.AnyContext, endpoint, attributes).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "deny rule error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  status.Error(codes.PermissionDenied, `access denied by deny rule "incident"`),
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.CheckMock.Expect(minimock.AnyContext, endpoint, nil).Return(&accessService.DenyError{Rule: "incident"})
+				return mock
+			},
+		},
+		{
+			name: "permission success case",
+			args: args{
+				ctx: ctx,
+				req: &accessv1.CheckRequest{Permission: permission},
+			},
+			want: res,
+			err:  nil,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.CheckPermissionMock.Expect(minimock.AnyContext, permission).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "access token principal success case",
+			args: args{
+				ctx: ctx,
+				req: &accessv1.CheckRequest{
+					Endpoint:  endpoint,
+					Principal: &accessv1.CheckRequest_AccessToken{AccessToken: "access_token"},
+				},
+			},
+			want: res,
+			err:  nil,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.CheckAsMock.Expect(minimock.AnyContext, &model.AccessPrincipal{AccessToken: "access_token"},
+					endpoint, nil).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "subject principal permission success case",
+			args: args{
+				ctx: ctx,
+				req: &accessv1.CheckRequest{
+					Permission: permission,
+					Principal: &accessv1.CheckRequest_Subject{Subject: &accessv1.CheckSubject{
+						UserId: "user-id",
+						Roles:  []string{"USER"},
+					}},
+				},
+			},
+			want: res,
+			err:  nil,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.CheckPermissionAsMock.Expect(minimock.AnyContext,
+					&model.AccessPrincipal{UserID: "user-id", Roles: []string{"USER"}}, permission).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "principal service error case",
+			args: args{
+				ctx: ctx,
+				req: &accessv1.CheckRequest{
+					Endpoint:  endpoint,
+					Principal: &accessv1.CheckRequest_AccessToken{AccessToken: "access_token"},
+				},
+			},
+			want: nil,
+			err:  status.Errorf(codes.PermissionDenied, "%s", accessService.ErrServiceCallerRequired.Error()),
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.CheckAsMock.Expect(minimock.AnyContext, &model.AccessPrincipal{AccessToken: "access_token"},
+					endpoint, nil).Return(accessService.ErrServiceCallerRequired)
+				return mock
+			},
+		},
+		{
+			name: "endpoint and permission error case",
+			args: args{
+				ctx: ctx,
+				req: &accessv1.CheckRequest{Endpoint: endpoint, Permission: permission},
+			},
+			want: nil,
+			err:  status.Error(codes.InvalidArgument, "exactly one of endpoint and permission must be set"),
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				return serviceMocks.NewAccessServiceMock(mc)
+			},
+		},
+		{
+			name: "neither endpoint nor permission error case",
+			args: args{
+				ctx: ctx,
+				req: &accessv1.CheckRequest{},
+			},
+			want: nil,
+			err:  status.Error(codes.InvalidArgument, "exactly one of endpoint and permission must be set"),
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				return serviceMocks.NewAccessServiceMock(mc)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -84,7 +213,7 @@ func TestCheck(t *testing.T) {
 			t.Parallel()
 
 			accessServiceMock := tt.accessServiceMock(mc)
-			api := accessAPI.NewImplementation(accessServiceMock)
+			api := accessAPI.NewImplementation(accessServiceMock, nil)
 
 			res, err := api.Check(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
@@ -92,3 +221,354 @@ func TestCheck(t *testing.T) {
 		})
 	}
 }
+
+func TestGetRoleEndpoints(t *testing.T) {
+	t.Parallel()
+
+	type accessServiceMockFunc func(mc *minimock.Controller) service.AccessService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		endpoint  = "/chat_v1.ChatV1/GetMessages"
+		condition = `ip.inCIDR("10.0.0.0/8")`
+
+		endpointPermissions = []*model.EndpointPermissions{
+			{Endpoint: "/chat_v1.ChatV1/Get*", Roles: []string{"ADMIN"}, Condition: condition},
+			{Endpoint: "/chat_v1.ChatV1/*", Roles: []string{"USER"}},
+		}
+
+		policies = []*accessv1.EndpointPermissions{
+			{
+				Endpoint:     "/chat_v1.ChatV1/Get*",
+				AllowedRoles: []userv1.Role{userv1.Role_ADMIN},
+				Roles:        []string{"ADMIN"},
+				Condition:    condition,
+			},
+			{
+				Endpoint:     "/chat_v1.ChatV1/*",
+				AllowedRoles: []userv1.Role{userv1.Role_USER},
+				Roles:        []string{"USER"},
+			},
+		}
+	)
+
+	tests := []struct {
+		name              string
+		req               *accessv1.GetRoleEndpointsRequest
+		want              *accessv1.GetRoleEndpointsResponse
+		err               error
+		accessServiceMock accessServiceMockFunc
+	}{
+		{
+			name: "success case",
+			req:  &accessv1.GetRoleEndpointsRequest{},
+			want: &accessv1.GetRoleEndpointsResponse{EndpointPermissions: policies},
+			err:  nil,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.GetRoleEndpointsMock.Expect(minimock.AnyContext, "").Return(endpointPermissions, nil)
+				return mock
+			},
+		},
+		{
+			name: "matching endpoint success case",
+			req:  &accessv1.GetRoleEndpointsRequest{Endpoint: endpoint},
+			want: &accessv1.GetRoleEndpointsResponse{EndpointPermissions: policies, Matched: "/chat_v1.ChatV1/Get*"},
+			err:  nil,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.GetRoleEndpointsMock.Expect(minimock.AnyContext, endpoint).Return(endpointPermissions, nil)
+				return mock
+			},
+		},
+		{
+			name: "no matching endpoint success case",
+			req:  &accessv1.GetRoleEndpointsRequest{Endpoint: endpoint},
+			want: &accessv1.GetRoleEndpointsResponse{},
+			err:  nil,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.GetRoleEndpointsMock.Expect(minimock.AnyContext, endpoint).Return(nil, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := accessAPI.NewImplementation(tt.accessServiceMock(mc), nil)
+
+			res, err := api.GetRoleEndpoints(ctx, tt.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestUpdateRoleEndpoint(t *testing.T) {
+	t.Parallel()
+
+	type accessServiceMockFunc func(mc *minimock.Controller) service.AccessService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		endpoint  = "/chat_v1.ChatV1/Delete"
+		roles     = []string{"ADMIN"}
+		condition = `attributes.owner_id == subject`
+	)
+
+	tests := []struct {
+		name              string
+		req               *accessv1.UpdateRoleEndpointRequest
+		err               error
+		accessServiceMock accessServiceMockFunc
+	}{
+		{
+			name: "keep condition success case",
+			req:  &accessv1.UpdateRoleEndpointRequest{Endpoint: endpoint, Roles: roles},
+			err:  nil,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.UpdateRoleEndpointMock.Expect(minimock.AnyContext, endpoint, roles, nil).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "replace condition success case",
+			req: &accessv1.UpdateRoleEndpointRequest{
+				Endpoint:  endpoint,
+				Roles:     roles,
+				Condition: wrapperspb.String(condition),
+			},
+			err: nil,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.UpdateRoleEndpointMock.Expect(minimock.AnyContext, endpoint, roles, &condition).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "invalid condition error case",
+			req: &accessv1.UpdateRoleEndpointRequest{
+				Endpoint:  endpoint,
+				Roles:     roles,
+				Condition: wrapperspb.String("subject +"),
+			},
+			err: status.Error(codes.InvalidArgument, accessService.ErrInvalidCondition.Error()),
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.UpdateRoleEndpointMock.Return(accessService.ErrInvalidCondition)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := accessAPI.NewImplementation(tt.accessServiceMock(mc), nil)
+
+			_, err := api.UpdateRoleEndpoint(ctx, tt.req)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestAddDenyRule(t *testing.T) {
+	t.Parallel()
+
+	type accessServiceMockFunc func(mc *minimock.Controller) service.AccessService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		req = &accessv1.AddDenyRuleRequest{
+			Name:        "incident",
+			Endpoint:    "/chat_v1.ChatV1/*",
+			ExceptRoles: []string{"ADMIN"},
+			Reason:      "spam",
+		}
+
+		rule = &model.DenyRule{
+			Name:        "incident",
+			Endpoint:    "/chat_v1.ChatV1/*",
+			ExceptRoles: []string{"ADMIN"},
+			Reason:      "spam",
+		}
+	)
+
+	tests := []struct {
+		name              string
+		err               error
+		accessServiceMock accessServiceMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.AddDenyRuleMock.Expect(minimock.AnyContext, rule).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "deny rule exists error case",
+			err:  status.Error(codes.AlreadyExists, accessService.ErrDenyRuleAlreadyExists.Error()),
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.AddDenyRuleMock.Expect(minimock.AnyContext, rule).Return(accessService.ErrDenyRuleAlreadyExists)
+				return mock
+			},
+		},
+		{
+			name: "unknown role error case",
+			err:  status.Error(codes.InvalidArgument, accessService.ErrUnknownRole.Error()),
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.AddDenyRuleMock.Expect(minimock.AnyContext, rule).Return(accessService.ErrUnknownRole)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := accessAPI.NewImplementation(tt.accessServiceMock(mc), nil)
+
+			_, err := api.AddDenyRule(ctx, req)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestDeleteDenyRule(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		name = "incident"
+	)
+
+	mock := serviceMocks.NewAccessServiceMock(mc)
+	mock.DeleteDenyRuleMock.Expect(minimock.AnyContext, name).Return(accessService.ErrDenyRuleNotFound)
+	api := accessAPI.NewImplementation(mock, nil)
+
+	_, err := api.DeleteDenyRule(ctx, &accessv1.DeleteDenyRuleRequest{Name: name})
+	require.Equal(t, status.Error(codes.NotFound, accessService.ErrDenyRuleNotFound.Error()), err)
+}
+
+func TestBatchCheck(t *testing.T) {
+	t.Parallel()
+
+	type accessServiceMockFunc func(mc *minimock.Controller) service.AccessService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID   = "7d8a3c6e-8a4b-4d4b-9f0a-3b1d2c4e5f60"
+		endpoint = "/chat_v1.ChatV1/Delete"
+
+		checks = []*model.AccessCheck{
+			{Endpoint: endpoint, Attributes: map[string]string{"owner_id": userID}},
+		}
+
+		decisions = []*model.AccessDecision{{
+			Endpoint:     endpoint,
+			Reason:       model.AccessReasonConditionFailed,
+			Policy:       endpoint,
+			AllowedRoles: []string{"ADMIN"},
+			Condition:    "attributes.owner_id == subject",
+			Roles:        []string{"ADMIN", "USER"},
+		}}
+	)
+
+	tests := []struct {
+		name              string
+		explain           bool
+		want              *accessv1.BatchCheckResponse
+		err               error
+		accessServiceMock accessServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: &accessv1.BatchCheckResponse{
+				Decisions: []*accessv1.AccessDecision{{Endpoint: endpoint}},
+			},
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.BatchCheckMock.Expect(minimock.AnyContext, userID, checks).Return(decisions, nil)
+				return mock
+			},
+		},
+		{
+			name:    "explain case",
+			explain: true,
+			want: &accessv1.BatchCheckResponse{
+				Decisions: []*accessv1.AccessDecision{{
+					Endpoint: endpoint,
+					Explanation: &accessv1.AccessExplanation{
+						Reason:       accessv1.AccessDecisionReason_CONDITION_FAILED,
+						Policy:       endpoint,
+						AllowedRoles: []string{"ADMIN"},
+						Condition:    "attributes.owner_id == subject",
+						Roles:        []string{"ADMIN", "USER"},
+					},
+				}},
+			},
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.BatchCheckMock.Expect(minimock.AnyContext, userID, checks).Return(decisions, nil)
+				return mock
+			},
+		},
+		{
+			name: "user not found error case",
+			err:  status.Error(codes.NotFound, accessService.ErrUserNotFound.Error()),
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.BatchCheckMock.Expect(minimock.AnyContext, userID, checks).Return(nil, accessService.ErrUserNotFound)
+				return mock
+			},
+		},
+		{
+			name: "access denied error case",
+			err:  status.Error(codes.PermissionDenied, accessService.ErrAccessDenied.Error()),
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.BatchCheckMock.Expect(minimock.AnyContext, userID, checks).Return(nil, accessService.ErrAccessDenied)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := accessAPI.NewImplementation(tt.accessServiceMock(mc), nil)
+
+			res, err := api.BatchCheck(ctx, &accessv1.BatchCheckRequest{
+				Checks: []*accessv1.AccessCheck{
+					{Endpoint: endpoint, Attributes: map[string]string{"owner_id": userID}},
+				},
+				Explain: tt.explain,
+				UserId:  userID,
+			})
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	accessAPI "github.com/8thgencore/microservice-auth/internal/delivery/access"
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	accessService "github.com/8thgencore/microservice-auth/internal/service/access"
+	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
+	relationService "github.com/8thgencore/microservice-auth/internal/service/relation"
+	accessv1 "github.com/8thgencore/microservice-auth/pkg/pb/access/v1"
+)
+
+func TestCheckRelation(t *testing.T) {
+	t.Parallel()
+
+	type relationServiceMockFunc func(mc *minimock.Controller) service.RelationService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		req = &accessv1.CheckRelationRequest{
+			Tuple: &accessv1.RelationTuple{
+				Object:   "chat:42",
+				Relation: "member",
+				Subject:  "group:eng#member",
+			},
+			ConsistencyToken: "token",
+		}
+
+		tuple = &model.RelationTuple{
+			Object:   model.RelationObject{Namespace: "chat", ID: "42"},
+			Relation: "member",
+			Subject:  model.RelationSubject{Namespace: "group", ID: "eng", Relation: "member"},
+		}
+	)
+
+	tests := []struct {
+		name                string
+		want                *accessv1.CheckRelationResponse
+		err                 error
+		relationServiceMock relationServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: &accessv1.CheckRelationResponse{Allowed: true, ConsistencyToken: "next-token"},
+			relationServiceMock: func(mc *minimock.Controller) service.RelationService {
+				mock := serviceMocks.NewRelationServiceMock(mc)
+				mock.CheckRelationMock.Expect(minimock.AnyContext, tuple, "token").Return(true, "next-token", nil)
+				return mock
+			},
+		},
+		{
+			name: "access denied error case",
+			err:  status.Error(codes.PermissionDenied, accessService.ErrAccessDenied.Error()),
+			relationServiceMock: func(mc *minimock.Controller) service.RelationService {
+				mock := serviceMocks.NewRelationServiceMock(mc)
+				mock.CheckRelationMock.Expect(minimock.AnyContext, tuple, "token").
+					Return(false, "", accessService.ErrAccessDenied)
+				return mock
+			},
+		},
+		{
+			name: "invalid consistency token error case",
+			err:  status.Error(codes.InvalidArgument, relationService.ErrInvalidConsistencyToken.Error()),
+			relationServiceMock: func(mc *minimock.Controller) service.RelationService {
+				mock := serviceMocks.NewRelationServiceMock(mc)
+				mock.CheckRelationMock.Expect(minimock.AnyContext, tuple, "token").
+					Return(false, "", relationService.ErrInvalidConsistencyToken)
+				return mock
+			},
+		},
+		{
+			name: "depth exceeded error case",
+			err:  status.Error(codes.ResourceExhausted, relationService.ErrDepthExceeded.Error()),
+			relationServiceMock: func(mc *minimock.Controller) service.RelationService {
+				mock := serviceMocks.NewRelationServiceMock(mc)
+				mock.CheckRelationMock.Expect(minimock.AnyContext, tuple, "token").
+					Return(false, "", relationService.ErrDepthExceeded)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := accessAPI.NewImplementation(nil, tt.relationServiceMock(mc))
+
+			res, err := api.CheckRelation(ctx, req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestWriteTuples(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		tuples = []*model.RelationTuple{{
+			Object:   model.RelationObject{Namespace: "chat", ID: "42"},
+			Relation: "owner",
+			Subject:  model.RelationSubject{Namespace: "user", ID: "7"},
+		}}
+	)
+
+	mock := serviceMocks.NewRelationServiceMock(mc)
+	mock.WriteTuplesMock.Expect(minimock.AnyContext, tuples).Return("token", nil)
+	api := accessAPI.NewImplementation(nil, mock)
+
+	res, err := api.WriteTuples(ctx, &accessv1.WriteTuplesRequest{
+		Tuples: []*accessv1.RelationTuple{{Object: "chat:42", Relation: "owner", Subject: "user:7"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "token", res.GetConsistencyToken())
+}
+
+func TestListObjects(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		subject = model.RelationSubject{Namespace: "user", ID: "7"}
+	)
+
+	mock := serviceMocks.NewRelationServiceMock(mc)
+	mock.ListObjectsMock.Expect(minimock.AnyContext, &model.RelationObjectsParams{
+		Namespace: "chat",
+		Relation:  "member",
+		Subject:   subject,
+		PageSize:  2,
+		PageToken: "page",
+	}).Return(&model.RelationObjectsPage{
+		IDs:              []string{"1", "42"},
+		NextPageToken:    "next",
+		ConsistencyToken: "token",
+	}, nil)
+	api := accessAPI.NewImplementation(nil, mock)
+
+	res, err := api.ListObjects(ctx, &accessv1.ListObjectsRequest{
+		Namespace: "chat",
+		Relation:  "member",
+		Subject:   "user:7",
+		PageSize:  2,
+		PageToken: "page",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"chat:1", "chat:42"}, res.GetObjects())
+	require.Equal(t, "token", res.GetConsistencyToken())
+	require.Equal(t, "next", res.GetNextPageToken())
+}
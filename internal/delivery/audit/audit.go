@@ -0,0 +1,43 @@
+package audit
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/8thgencore/microservice-auth/internal/converter"
+	"github.com/8thgencore/microservice-auth/internal/service/audit"
+	desc "github.com/8thgencore/microservice-auth/pkg/pb/audit/v1"
+)
+
+// ListAuditEvents returns a page of audit events matching a filter.
+func (impl *Implementation) ListAuditEvents(
+	ctx context.Context,
+	req *desc.ListAuditEventsRequest,
+) (*desc.ListAuditEventsResponse, error) {
+	page, err := impl.auditService.ListEvents(ctx, converter.ToAuditListParamsFromAPI(req))
+	if err != nil {
+		if errors.Is(err, audit.ErrInvalidPageToken) || errors.Is(err, audit.ErrInvalidTimeRange) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return converter.ToListAuditEventsResponseFromService(page), nil
+}
+
+// VerifyAuditChain walks the hash chain of the audit log and reports its first broken link.
+func (impl *Implementation) VerifyAuditChain(
+	ctx context.Context,
+	_ *desc.VerifyAuditChainRequest,
+) (*desc.VerifyAuditChainResponse, error) {
+	verification, err := impl.auditService.VerifyChain(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return converter.ToVerifyAuditChainResponseFromService(verification), nil
+}
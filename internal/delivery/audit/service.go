@@ -0,0 +1,19 @@
+package audit
+
+import (
+	"github.com/8thgencore/microservice-auth/internal/service"
+	desc "github.com/8thgencore/microservice-auth/pkg/pb/audit/v1"
+)
+
+// Implementation structure describes API layer.
+type Implementation struct {
+	desc.UnimplementedAuditV1Server
+	auditService service.AuditService
+}
+
+// NewImplementation creates new object of API layer.
+func NewImplementation(auditService service.AuditService) *Implementation {
+	return &Implementation{
+		auditService: auditService,
+	}
+}
@@ -0,0 +1,245 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	auditAPI "github.com/8thgencore/microservice-auth/internal/delivery/audit"
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	auditService "github.com/8thgencore/microservice-auth/internal/service/audit"
+	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
+	auditv1 "github.com/8thgencore/microservice-auth/pkg/pb/audit/v1"
+)
+
+func TestListAuditEvents(t *testing.T) {
+	t.Parallel()
+
+	type auditServiceMockFunc func(mc *minimock.Controller) service.AuditService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		eventID  = "0196a1b2-0000-7000-8000-000000000001"
+		actorID  = "0196a1b2-0000-7000-8000-000000000002"
+		targetID = "0196a1b2-0000-7000-8000-000000000003"
+
+		pageToken     = "page_token"
+		nextPageToken = "next_page_token"
+		occurredAt    = time.Date(2025, 5, 4, 12, 0, 0, 0, time.UTC)
+		from          = occurredAt.Add(-time.Hour)
+
+		req = &auditv1.ListAuditEventsRequest{
+			PageSize:   10,
+			PageToken:  pageToken,
+			ActorId:    actorID,
+			TargetType: string(model.AuditTargetUser),
+			TargetId:   targetID,
+			Action:     string(model.AuditActionUserUpdated),
+			From:       timestamppb.New(from),
+		}
+
+		params = &model.AuditListParams{
+			Filter: model.AuditFilter{
+				ActorID: actorID,
+				Target:  model.AuditTarget{Type: model.AuditTargetUser, ID: targetID},
+				Action:  model.AuditActionUserUpdated,
+				From:    &from,
+			},
+			PageSize:  10,
+			PageToken: pageToken,
+		}
+
+		page = &model.AuditPage{
+			Events: []*model.AuditEvent{{
+				ID:         eventID,
+				OccurredAt: occurredAt,
+				ActorID:    actorID,
+				Action:     model.AuditActionUserUpdated,
+				Target:     model.AuditTarget{Type: model.AuditTargetUser, ID: targetID},
+				Changes: map[string]model.AuditChange{
+					"name":     {Old: "old name", New: "new name"},
+					"password": {},
+				},
+				Metadata:  map[string]string{"session_id": "session"},
+				IPAddress: "127.0.0.1",
+				UserAgent: "test",
+				TraceID:   "trace",
+			}},
+			NextPageToken: nextPageToken,
+		}
+
+		res = &auditv1.ListAuditEventsResponse{
+			Events: []*auditv1.AuditEvent{{
+				Id:         eventID,
+				OccurredAt: timestamppb.New(occurredAt),
+				ActorId:    actorID,
+				Action:     string(model.AuditActionUserUpdated),
+				TargetType: string(model.AuditTargetUser),
+				TargetId:   targetID,
+				Changes: map[string]*auditv1.FieldChange{
+					"name": {
+						Old: structpb.NewStringValue("old name"),
+						New: structpb.NewStringValue("new name"),
+					},
+					"password": {},
+				},
+				Metadata:  map[string]string{"session_id": "session"},
+				IpAddress: "127.0.0.1",
+				UserAgent: "test",
+				TraceId:   "trace",
+			}},
+			NextPageToken: nextPageToken,
+		}
+	)
+
+	tests := []struct {
+		name             string
+		want             *auditv1.ListAuditEventsResponse
+		err              error
+		auditServiceMock auditServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: res,
+			err:  nil,
+			auditServiceMock: func(mc *minimock.Controller) service.AuditService {
+				mock := serviceMocks.NewAuditServiceMock(mc)
+				mock.ListEventsMock.Expect(ctx, params).Return(page, nil)
+				return mock
+			},
+		},
+		{
+			name: "invalid page token case",
+			want: nil,
+			err:  status.Error(codes.InvalidArgument, auditService.ErrInvalidPageToken.Error()),
+			auditServiceMock: func(mc *minimock.Controller) service.AuditService {
+				mock := serviceMocks.NewAuditServiceMock(mc)
+				mock.ListEventsMock.Expect(ctx, params).Return(nil, auditService.ErrInvalidPageToken)
+				return mock
+			},
+		},
+		{
+			name: "invalid time range case",
+			want: nil,
+			err:  status.Error(codes.InvalidArgument, auditService.ErrInvalidTimeRange.Error()),
+			auditServiceMock: func(mc *minimock.Controller) service.AuditService {
+				mock := serviceMocks.NewAuditServiceMock(mc)
+				mock.ListEventsMock.Expect(ctx, params).Return(nil, auditService.ErrInvalidTimeRange)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			want: nil,
+			err:  status.Error(codes.Internal, "service error"),
+			auditServiceMock: func(mc *minimock.Controller) service.AuditService {
+				mock := serviceMocks.NewAuditServiceMock(mc)
+				mock.ListEventsMock.Expect(ctx, params).Return(nil, errors.New("service error"))
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := auditAPI.NewImplementation(tt.auditServiceMock(mc))
+
+			res, err := api.ListAuditEvents(ctx, req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestVerifyAuditChain(t *testing.T) {
+	t.Parallel()
+
+	type auditServiceMockFunc func(mc *minimock.Controller) service.AuditService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		eventID  = "0196a1b2-0000-7000-8000-000000000001"
+		headHash = "head_hash"
+
+		req = &auditv1.VerifyAuditChainRequest{}
+
+		verification = &model.AuditChainVerification{
+			Checked:   12,
+			Unchained: 2,
+			HeadSeq:   12,
+			HeadHash:  headHash,
+			Break: &model.AuditChainBreak{
+				Seq:     13,
+				EventID: eventID,
+				Reason:  model.AuditChainBreakHashMismatch,
+			},
+		}
+
+		res = &auditv1.VerifyAuditChainResponse{
+			Checked:   12,
+			Unchained: 2,
+			HeadSeq:   12,
+			HeadHash:  headHash,
+			Break: &auditv1.AuditChainBreak{
+				Seq:     13,
+				EventId: eventID,
+				Reason:  auditv1.AuditChainBreakReason_HASH_MISMATCH,
+			},
+		}
+	)
+
+	tests := []struct {
+		name             string
+		want             *auditv1.VerifyAuditChainResponse
+		err              error
+		auditServiceMock auditServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: res,
+			err:  nil,
+			auditServiceMock: func(mc *minimock.Controller) service.AuditService {
+				mock := serviceMocks.NewAuditServiceMock(mc)
+				mock.VerifyChainMock.Expect(ctx).Return(verification, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			want: nil,
+			err:  status.Error(codes.Internal, auditService.ErrAuditVerify.Error()),
+			auditServiceMock: func(mc *minimock.Controller) service.AuditService {
+				mock := serviceMocks.NewAuditServiceMock(mc)
+				mock.VerifyChainMock.Expect(ctx).Return(nil, auditService.ErrAuditVerify)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := auditAPI.NewImplementation(tt.auditServiceMock(mc))
+
+			res, err := api.VerifyAuditChain(ctx, req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
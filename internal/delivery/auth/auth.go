@@ -2,24 +2,47 @@ package auth
 
 import (
 	"context"
+	"errors"
 
 	"github.com/8thgencore/microservice-auth/internal/converter"
+	"github.com/8thgencore/microservice-auth/internal/delivery/user"
+	"github.com/8thgencore/microservice-auth/internal/model"
+	authService "github.com/8thgencore/microservice-auth/internal/service/auth"
 	authv1 "github.com/8thgencore/microservice-auth/pkg/pb/auth/v1"
+	"github.com/8thgencore/microservice-auth/pkg/utils"
 	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
-// Login user and return refresh token.
+// Login user and return refresh token, or the MFA challenge token if a second factor is required.
 func (i *Implementation) Login(ctx context.Context, req *authv1.LoginRequest) (*authv1.LoginResponse, error) {
-	tokenPair, err := i.authService.Login(ctx, converter.ToUserLoginFromAPI(req.GetCreds()))
+	result, err := i.authService.Login(ctx, converter.ToUserLoginFromAPI(req.GetCreds()), clientInfo(ctx))
 	if err != nil {
+		if errors.Is(err, authService.ErrEmailNotVerified) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if accountDisabled(err) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		var retryErr *authService.RetryError
+		if errors.As(err, &retryErr) {
+			return nil, retryStatus(retryErr)
+		}
+
 		return nil, status.Errorf(codes.Unauthenticated, "%s", err.Error())
 	}
 
+	if result.MfaToken != "" {
+		return &authv1.LoginResponse{MfaToken: result.MfaToken}, nil
+	}
+
 	return &authv1.LoginResponse{
-		AccessToken:  tokenPair.AccessToken,
-		RefreshToken: tokenPair.RefreshToken,
+		AccessToken:  result.Tokens.AccessToken,
+		RefreshToken: result.Tokens.RefreshToken,
 	}, nil
 }
 
@@ -30,10 +53,14 @@ func (i *Implementation) RefreshTokens(
 ) (*authv1.RefreshTokensResponse, error) {
 	accessToken, err := i.authService.GetAccessToken(ctx, req.GetRefreshToken())
 	if err != nil {
+		if accountDisabled(err) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+
 		return nil, status.Errorf(codes.Unauthenticated, "%s", err.Error())
 	}
 
-	refreshToken, err := i.authService.GetRefreshToken(ctx, req.GetRefreshToken())
+	refreshToken, err := i.authService.GetRefreshToken(ctx, req.GetRefreshToken(), clientInfo(ctx))
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "%s", err.Error())
 	}
@@ -56,3 +83,73 @@ func (i *Implementation) Logout(
 
 	return &empty.Empty{}, nil
 }
+
+// LogoutAll signs the current user out everywhere.
+func (i *Implementation) LogoutAll(ctx context.Context, _ *empty.Empty) (*empty.Empty, error) {
+	userID, ok := ctx.Value(user.UserIDKey).(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if err := i.authService.LogoutAll(ctx, userID); err != nil {
+		return nil, logoutAllError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// ForceLogout signs a user out everywhere.
+func (i *Implementation) ForceLogout(ctx context.Context, req *authv1.ForceLogoutRequest) (*empty.Empty, error) {
+	if err := i.authService.LogoutAll(ctx, req.GetUserId()); err != nil {
+		return nil, logoutAllError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// UnlockUser lifts the lockout of a user.
+func (i *Implementation) UnlockUser(ctx context.Context, req *authv1.UnlockUserRequest) (*empty.Empty, error) {
+	if err := i.authService.UnlockUser(ctx, req.GetUserId()); err != nil {
+		if errors.Is(err, authService.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// accountDisabled reports whether the error refuses a user that is suspended or deleted.
+func accountDisabled(err error) bool {
+	return errors.Is(err, authService.ErrUserSuspended) || errors.Is(err, authService.ErrUserDeleted)
+}
+
+// retryStatus maps a refused login to a gRPC status that tells when to retry.
+func retryStatus(err *authService.RetryError) error {
+	st := status.New(codes.ResourceExhausted, err.Error())
+
+	withDetails, detailsErr := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(err.RetryAfter)})
+	if detailsErr != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
+
+// logoutAllError maps an error of signing a user out everywhere to a gRPC status.
+func logoutAllError(err error) error {
+	if errors.Is(err, authService.ErrUserNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+// clientInfo returns the client the request is made from.
+func clientInfo(ctx context.Context) *model.ClientInfo {
+	return &model.ClientInfo{
+		IPAddress: utils.ExtractClientIP(ctx),
+		UserAgent: utils.ExtractUserAgent(ctx),
+	}
+}
@@ -0,0 +1,6 @@
+package auth
+
+type contextKey string
+
+// SessionIDKey is the key for the session ID of the access token in context.
+const SessionIDKey contextKey = "session_id"
@@ -0,0 +1,116 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/8thgencore/microservice-auth/internal/delivery/user"
+	authService "github.com/8thgencore/microservice-auth/internal/service/auth"
+	authv1 "github.com/8thgencore/microservice-auth/pkg/pb/auth/v1"
+)
+
+// VerifyMfa completes a login with a second factor.
+func (i *Implementation) VerifyMfa(
+	ctx context.Context,
+	req *authv1.VerifyMfaRequest,
+) (*authv1.VerifyMfaResponse, error) {
+	tokenPair, err := i.authService.VerifyMfa(ctx, req.GetMfaToken(), req.GetCode(), clientInfo(ctx))
+	if err != nil {
+		if errors.Is(err, authService.ErrMfaFailed) {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if accountDisabled(err) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		var retryErr *authService.RetryError
+		if errors.As(err, &retryErr) {
+			return nil, retryStatus(retryErr)
+		}
+
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return &authv1.VerifyMfaResponse{
+		AccessToken:  tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
+	}, nil
+}
+
+// BeginTotpEnrollment generates a TOTP secret for the current user.
+func (i *Implementation) BeginTotpEnrollment(
+	ctx context.Context,
+	_ *empty.Empty,
+) (*authv1.BeginTotpEnrollmentResponse, error) {
+	userID, ok := ctx.Value(user.UserIDKey).(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	enrollment, err := i.authService.BeginTotpEnrollment(ctx, userID)
+	if err != nil {
+		return nil, mfaError(err)
+	}
+
+	return &authv1.BeginTotpEnrollmentResponse{
+		Secret:     enrollment.Secret,
+		OtpauthUri: enrollment.URI,
+	}, nil
+}
+
+// ConfirmTotpEnrollment enables TOTP for the current user.
+func (i *Implementation) ConfirmTotpEnrollment(
+	ctx context.Context,
+	req *authv1.ConfirmTotpEnrollmentRequest,
+) (*authv1.ConfirmTotpEnrollmentResponse, error) {
+	userID, ok := ctx.Value(user.UserIDKey).(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	recoveryCodes, err := i.authService.ConfirmTotpEnrollment(ctx, userID, req.GetCode())
+	if err != nil {
+		return nil, mfaError(err)
+	}
+
+	return &authv1.ConfirmTotpEnrollmentResponse{
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// DisableTotp disables TOTP for the current user.
+func (i *Implementation) DisableTotp(ctx context.Context, req *authv1.DisableTotpRequest) (*empty.Empty, error) {
+	userID, ok := ctx.Value(user.UserIDKey).(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if err := i.authService.DisableTotp(ctx, userID, req.GetCode()); err != nil {
+		return nil, mfaError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// mfaError maps an error of managing the second factors to a gRPC status.
+func mfaError(err error) error {
+	var retryErr *authService.RetryError
+	if errors.As(err, &retryErr) {
+		return retryStatus(retryErr)
+	}
+
+	switch {
+	case errors.Is(err, authService.ErrUserNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, authService.ErrInvalidMfaCode):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, authService.ErrMfaNotEnrolled), errors.Is(err, authService.ErrMfaAlreadyEnabled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/8thgencore/microservice-auth/internal/delivery/user"
+	authService "github.com/8thgencore/microservice-auth/internal/service/auth"
+	authv1 "github.com/8thgencore/microservice-auth/pkg/pb/auth/v1"
+)
+
+// BeginPasskeyRegistration starts the registration of a passkey for the current user.
+func (i *Implementation) BeginPasskeyRegistration(
+	ctx context.Context,
+	_ *empty.Empty,
+) (*authv1.BeginPasskeyRegistrationResponse, error) {
+	userID, ok := ctx.Value(user.UserIDKey).(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	challenge, err := i.authService.BeginPasskeyRegistration(ctx, userID)
+	if err != nil {
+		return nil, passkeyError(err)
+	}
+
+	return &authv1.BeginPasskeyRegistrationResponse{
+		CeremonyId: challenge.CeremonyID,
+		Options:    string(challenge.Options),
+	}, nil
+}
+
+// FinishPasskeyRegistration stores the passkey of the current user.
+func (i *Implementation) FinishPasskeyRegistration(
+	ctx context.Context,
+	req *authv1.FinishPasskeyRegistrationRequest,
+) (*empty.Empty, error) {
+	userID, ok := ctx.Value(user.UserIDKey).(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	err := i.authService.FinishPasskeyRegistration(ctx, userID, req.GetCeremonyId(), []byte(req.GetCredential()))
+	if err != nil {
+		return nil, passkeyError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// BeginPasskeyLogin starts a passwordless login.
+func (i *Implementation) BeginPasskeyLogin(
+	ctx context.Context,
+	_ *empty.Empty,
+) (*authv1.BeginPasskeyLoginResponse, error) {
+	challenge, err := i.authService.BeginPasskeyLogin(ctx)
+	if err != nil {
+		return nil, passkeyError(err)
+	}
+
+	return &authv1.BeginPasskeyLoginResponse{
+		CeremonyId: challenge.CeremonyID,
+		Options:    string(challenge.Options),
+	}, nil
+}
+
+// FinishPasskeyLogin logs the user in with a passkey.
+func (i *Implementation) FinishPasskeyLogin(
+	ctx context.Context,
+	req *authv1.FinishPasskeyLoginRequest,
+) (*authv1.FinishPasskeyLoginResponse, error) {
+	tokenPair, err := i.authService.FinishPasskeyLogin(
+		ctx,
+		req.GetCeremonyId(),
+		[]byte(req.GetCredential()),
+		clientInfo(ctx),
+	)
+	if err != nil {
+		if errors.Is(err, authService.ErrPasskeyFailed) {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if errors.Is(err, authService.ErrEmailNotVerified) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if accountDisabled(err) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return &authv1.FinishPasskeyLoginResponse{
+		AccessToken:  tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
+	}, nil
+}
+
+// passkeyError maps an error of registering a passkey to a gRPC status.
+func passkeyError(err error) error {
+	switch {
+	case errors.Is(err, authService.ErrUserNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, authService.ErrInvalidPasskey), errors.Is(err, authService.ErrInvalidPasskeyCeremony):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
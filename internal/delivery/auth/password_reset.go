@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authService "github.com/8thgencore/microservice-auth/internal/service/auth"
+	authv1 "github.com/8thgencore/microservice-auth/pkg/pb/auth/v1"
+)
+
+// RequestPasswordReset sends a password reset link to the email.
+func (i *Implementation) RequestPasswordReset(
+	ctx context.Context,
+	req *authv1.RequestPasswordResetRequest,
+) (*empty.Empty, error) {
+	if err := i.authService.RequestPasswordReset(ctx, req.GetEmail()); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// ResetPassword sets a new password with a reset token.
+func (i *Implementation) ResetPassword(ctx context.Context, req *authv1.ResetPasswordRequest) (*empty.Empty, error) {
+	if err := i.authService.ResetPassword(ctx, req.GetToken(), req.GetNewPassword()); err != nil {
+		if errors.Is(err, authService.ErrInvalidResetToken) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,116 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/8thgencore/microservice-auth/internal/converter"
+	"github.com/8thgencore/microservice-auth/internal/delivery/user"
+	authService "github.com/8thgencore/microservice-auth/internal/service/auth"
+	authv1 "github.com/8thgencore/microservice-auth/pkg/pb/auth/v1"
+)
+
+// ListMySessions returns the active sessions of the current user.
+func (i *Implementation) ListMySessions(ctx context.Context, _ *empty.Empty) (*authv1.ListSessionsResponse, error) {
+	userID, ok := ctx.Value(user.UserIDKey).(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	sessions, err := i.authService.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	sessionID, _ := ctx.Value(SessionIDKey).(string)
+
+	return &authv1.ListSessionsResponse{
+		Sessions: converter.ToSessionsFromService(sessions, sessionID),
+	}, nil
+}
+
+// RevokeSession revokes a session of the current user.
+func (i *Implementation) RevokeSession(ctx context.Context, req *authv1.RevokeSessionRequest) (*empty.Empty, error) {
+	userID, ok := ctx.Value(user.UserIDKey).(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if err := i.authService.RevokeSession(ctx, userID, req.GetSessionId()); err != nil {
+		return nil, sessionError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// RevokeAllOtherSessions revokes every session of the current user except the current one.
+func (i *Implementation) RevokeAllOtherSessions(ctx context.Context, _ *empty.Empty) (*empty.Empty, error) {
+	userID, ok := ctx.Value(user.UserIDKey).(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	// Access tokens issued before sessions were introduced do not identify their session.
+	sessionID, _ := ctx.Value(SessionIDKey).(string)
+	if sessionID == "" {
+		return nil, status.Error(codes.FailedPrecondition, "current session is unknown, refresh the tokens first")
+	}
+
+	if err := i.authService.RevokeAllSessions(ctx, userID, sessionID); err != nil {
+		return nil, sessionError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// ListUserSessions returns the active sessions of a user.
+func (i *Implementation) ListUserSessions(
+	ctx context.Context,
+	req *authv1.ListUserSessionsRequest,
+) (*authv1.ListSessionsResponse, error) {
+	sessions, err := i.authService.ListSessions(ctx, req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &authv1.ListSessionsResponse{
+		Sessions: converter.ToSessionsFromService(sessions, ""),
+	}, nil
+}
+
+// RevokeUserSession revokes a session of a user.
+func (i *Implementation) RevokeUserSession(
+	ctx context.Context,
+	req *authv1.RevokeUserSessionRequest,
+) (*empty.Empty, error) {
+	if err := i.authService.RevokeSession(ctx, req.GetUserId(), req.GetSessionId()); err != nil {
+		return nil, sessionError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// RevokeAllUserSessions revokes every session of a user.
+func (i *Implementation) RevokeAllUserSessions(
+	ctx context.Context,
+	req *authv1.RevokeAllUserSessionsRequest,
+) (*empty.Empty, error) {
+	if err := i.authService.RevokeAllSessions(ctx, req.GetUserId(), ""); err != nil {
+		return nil, sessionError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// sessionError maps session service errors to gRPC status errors.
+func sessionError(err error) error {
+	if errors.Is(err, authService.ErrSessionNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
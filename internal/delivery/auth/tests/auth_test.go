@@ -6,15 +6,20 @@ import (
 	"testing"
 
 	"github.com/gojuno/minimock/v3"
+	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	authAPI "github.com/8thgencore/microservice-auth/internal/delivery/auth"
+	"github.com/8thgencore/microservice-auth/internal/delivery/user"
 	"github.com/8thgencore/microservice-auth/internal/model"
 	"github.com/8thgencore/microservice-auth/internal/service"
+	authService "github.com/8thgencore/microservice-auth/internal/service/auth"
 	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
 	auth_v1 "github.com/8thgencore/microservice-auth/pkg/pb/auth/v1"
+	"github.com/8thgencore/microservice-auth/pkg/utils"
 )
 
 var (
@@ -22,6 +27,7 @@ var (
 	password     = "password"
 	refreshToken = "refresh_token"
 	accessToken  = "access_token"
+	mfaToken     = "mfa_token"
 )
 
 func TestLogin(t *testing.T) {
@@ -35,8 +41,10 @@ func TestLogin(t *testing.T) {
 	}
 
 	var (
-		ctx = context.Background()
-		mc  = minimock.NewController(t)
+		ctx = utils.WithClientIP(metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			"grpcgateway-user-agent", "test-agent",
+		)), "10.0.0.1")
+		mc = minimock.NewController(t)
 
 		serviceErr = errors.New("service error")
 
@@ -52,6 +60,11 @@ func TestLogin(t *testing.T) {
 			Password: password,
 		}
 
+		client = &model.ClientInfo{
+			IPAddress: "10.0.0.1",
+			UserAgent: "test-agent",
+		}
+
 		res = &auth_v1.LoginResponse{
 			RefreshToken: refreshToken,
 			AccessToken:  accessToken,
@@ -75,12 +88,42 @@ func TestLogin(t *testing.T) {
 			err:  nil,
 			authServiceMock: func(mc *minimock.Controller) service.AuthService {
 				mock := serviceMocks.NewAuthServiceMock(mc)
-				mock.LoginMock.Expect(minimock.AnyContext, creds).Return(
-					&model.TokenPair{AccessToken: accessToken, RefreshToken: refreshToken}, nil,
+				mock.LoginMock.Expect(minimock.AnyContext, creds, client).Return(
+					&model.LoginResult{Tokens: &model.TokenPair{AccessToken: accessToken, RefreshToken: refreshToken}}, nil,
+				)
+				return mock
+			},
+		},
+		{
+			name: "mfa required case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: &auth_v1.LoginResponse{MfaToken: mfaToken},
+			err:  nil,
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.LoginMock.Expect(minimock.AnyContext, creds, client).Return(
+					&model.LoginResult{MfaToken: mfaToken}, nil,
 				)
 				return mock
 			},
 		},
+		{
+			name: "email not verified case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  status.Error(codes.FailedPrecondition, authService.ErrEmailNotVerified.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.LoginMock.Expect(minimock.AnyContext, creds, client).Return(nil, authService.ErrEmailNotVerified)
+				return mock
+			},
+		},
 		{
 			name: "service error case",
 			args: args{
@@ -91,7 +134,7 @@ func TestLogin(t *testing.T) {
 			err:  status.Errorf(codes.Unauthenticated, "%s", serviceErr.Error()),
 			authServiceMock: func(mc *minimock.Controller) service.AuthService {
 				mock := serviceMocks.NewAuthServiceMock(mc)
-				mock.LoginMock.Expect(minimock.AnyContext, creds).Return(&model.TokenPair{}, serviceErr)
+				mock.LoginMock.Expect(minimock.AnyContext, creds, client).Return(nil, serviceErr)
 				return mock
 			},
 		},
@@ -160,7 +203,7 @@ func TestRefreshTokens(t *testing.T) {
 				mock := serviceMocks.NewAuthServiceMock(mc)
 				mock.GetAccessTokenMock.Expect(minimock.AnyContext, oldRefreshToken).
 					Return(accessToken, nil)
-				mock.GetRefreshTokenMock.Expect(minimock.AnyContext, oldRefreshToken).
+				mock.GetRefreshTokenMock.Expect(minimock.AnyContext, oldRefreshToken, &model.ClientInfo{}).
 					Return(refreshToken, nil)
 
 				return mock
@@ -181,6 +224,21 @@ func TestRefreshTokens(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "suspended user case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  status.Error(codes.PermissionDenied, authService.ErrUserSuspended.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.GetAccessTokenMock.Expect(minimock.AnyContext, oldRefreshToken).
+					Return("", authService.ErrUserSuspended)
+				return mock
+			},
+		},
 		{
 			name: "refresh token error case",
 			args: args{
@@ -193,7 +251,7 @@ func TestRefreshTokens(t *testing.T) {
 				mock := serviceMocks.NewAuthServiceMock(mc)
 				mock.GetAccessTokenMock.Expect(minimock.AnyContext, oldRefreshToken).
 					Return(accessToken, nil)
-				mock.GetRefreshTokenMock.Expect(minimock.AnyContext, oldRefreshToken).
+				mock.GetRefreshTokenMock.Expect(minimock.AnyContext, oldRefreshToken, &model.ClientInfo{}).
 					Return("", serviceErr)
 
 				return mock
@@ -214,3 +272,129 @@ func TestRefreshTokens(t *testing.T) {
 		})
 	}
 }
+
+func TestLogoutAll(t *testing.T) {
+	t.Parallel()
+
+	type authServiceMockFunc func(mc *minimock.Controller) service.AuthService
+
+	var (
+		ctx = context.WithValue(context.Background(), user.UserIDKey, userID)
+		mc  = minimock.NewController(t)
+	)
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		want            *empty.Empty
+		err             error
+		authServiceMock authServiceMockFunc
+	}{
+		{
+			name: "success case",
+			ctx:  ctx,
+			want: &empty.Empty{},
+			err:  nil,
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.LogoutAllMock.Expect(minimock.AnyContext, userID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "unauthenticated case",
+			ctx:  context.Background(),
+			want: nil,
+			err:  status.Error(codes.Unauthenticated, "user not authenticated"),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				return serviceMocks.NewAuthServiceMock(mc)
+			},
+		},
+		{
+			name: "service error case",
+			ctx:  ctx,
+			want: nil,
+			err:  status.Error(codes.Internal, authService.ErrLogoutFailed.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.LogoutAllMock.Expect(minimock.AnyContext, userID).Return(authService.ErrLogoutFailed)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := authAPI.NewImplementation(tt.authServiceMock(mc))
+
+			res, err := api.LogoutAll(tt.ctx, &empty.Empty{})
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestForceLogout(t *testing.T) {
+	t.Parallel()
+
+	type authServiceMockFunc func(mc *minimock.Controller) service.AuthService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		req = &auth_v1.ForceLogoutRequest{UserId: userID}
+	)
+
+	tests := []struct {
+		name            string
+		want            *empty.Empty
+		err             error
+		authServiceMock authServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: &empty.Empty{},
+			err:  nil,
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.LogoutAllMock.Expect(ctx, userID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "user not found case",
+			want: nil,
+			err:  status.Error(codes.NotFound, authService.ErrUserNotFound.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.LogoutAllMock.Expect(ctx, userID).Return(authService.ErrUserNotFound)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			want: nil,
+			err:  status.Error(codes.Internal, authService.ErrLogoutFailed.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.LogoutAllMock.Expect(ctx, userID).Return(authService.ErrLogoutFailed)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := authAPI.NewImplementation(tt.authServiceMock(mc))
+
+			res, err := api.ForceLogout(ctx, req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
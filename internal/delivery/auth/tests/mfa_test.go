@@ -0,0 +1,296 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	authAPI "github.com/8thgencore/microservice-auth/internal/delivery/auth"
+	"github.com/8thgencore/microservice-auth/internal/delivery/user"
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	authService "github.com/8thgencore/microservice-auth/internal/service/auth"
+	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
+	auth_v1 "github.com/8thgencore/microservice-auth/pkg/pb/auth/v1"
+	"github.com/8thgencore/microservice-auth/pkg/utils"
+)
+
+var totpCode = "123456"
+
+func TestVerifyMfa(t *testing.T) {
+	t.Parallel()
+
+	type authServiceMockFunc func(mc *minimock.Controller) service.AuthService
+
+	var (
+		ctx = utils.WithClientIP(metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			"user-agent", "test-agent",
+		)), "10.0.0.1")
+		mc = minimock.NewController(t)
+
+		req = &auth_v1.VerifyMfaRequest{
+			MfaToken: mfaToken,
+			Code:     totpCode,
+		}
+
+		client = &model.ClientInfo{
+			IPAddress: "10.0.0.1",
+			UserAgent: "test-agent",
+		}
+	)
+
+	tests := []struct {
+		name            string
+		want            *auth_v1.VerifyMfaResponse
+		err             error
+		authServiceMock authServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: &auth_v1.VerifyMfaResponse{
+				AccessToken:  accessToken,
+				RefreshToken: refreshToken,
+			},
+			err: nil,
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.VerifyMfaMock.Expect(minimock.AnyContext, mfaToken, totpCode, client).Return(
+					&model.TokenPair{AccessToken: accessToken, RefreshToken: refreshToken}, nil,
+				)
+				return mock
+			},
+		},
+		{
+			name: "invalid code case",
+			want: nil,
+			err:  status.Error(codes.Unauthenticated, authService.ErrInvalidMfaCode.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.VerifyMfaMock.Expect(minimock.AnyContext, mfaToken, totpCode, client).
+					Return(nil, authService.ErrInvalidMfaCode)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			want: nil,
+			err:  status.Error(codes.Internal, authService.ErrMfaFailed.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.VerifyMfaMock.Expect(minimock.AnyContext, mfaToken, totpCode, client).
+					Return(nil, authService.ErrMfaFailed)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := authAPI.NewImplementation(tt.authServiceMock(mc))
+
+			res, err := api.VerifyMfa(ctx, req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestBeginTotpEnrollment(t *testing.T) {
+	t.Parallel()
+
+	type authServiceMockFunc func(mc *minimock.Controller) service.AuthService
+
+	var (
+		ctx = context.WithValue(context.Background(), user.UserIDKey, userID)
+		mc  = minimock.NewController(t)
+
+		enrollment = &model.TotpEnrollment{
+			Secret: "JBSWY3DPEHPK3PXP",
+			URI:    "otpauth://totp/auth:username?issuer=auth&secret=JBSWY3DPEHPK3PXP",
+		}
+	)
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		want            *auth_v1.BeginTotpEnrollmentResponse
+		err             error
+		authServiceMock authServiceMockFunc
+	}{
+		{
+			name: "success case",
+			ctx:  ctx,
+			want: &auth_v1.BeginTotpEnrollmentResponse{
+				Secret:     enrollment.Secret,
+				OtpauthUri: enrollment.URI,
+			},
+			err: nil,
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.BeginTotpEnrollmentMock.Expect(minimock.AnyContext, userID).Return(enrollment, nil)
+				return mock
+			},
+		},
+		{
+			name: "unauthenticated case",
+			ctx:  context.Background(),
+			want: nil,
+			err:  status.Error(codes.Unauthenticated, "user not authenticated"),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				return serviceMocks.NewAuthServiceMock(mc)
+			},
+		},
+		{
+			name: "already enabled case",
+			ctx:  ctx,
+			want: nil,
+			err:  status.Error(codes.FailedPrecondition, authService.ErrMfaAlreadyEnabled.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.BeginTotpEnrollmentMock.Expect(minimock.AnyContext, userID).
+					Return(nil, authService.ErrMfaAlreadyEnabled)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := authAPI.NewImplementation(tt.authServiceMock(mc))
+
+			res, err := api.BeginTotpEnrollment(tt.ctx, &empty.Empty{})
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestConfirmTotpEnrollment(t *testing.T) {
+	t.Parallel()
+
+	type authServiceMockFunc func(mc *minimock.Controller) service.AuthService
+
+	var (
+		ctx = context.WithValue(context.Background(), user.UserIDKey, userID)
+		mc  = minimock.NewController(t)
+
+		req = &auth_v1.ConfirmTotpEnrollmentRequest{Code: totpCode}
+
+		recoveryCodes = []string{"AAAA-BBBB-CCCC-DDDD", "EEEE-FFFF-GGGG-HHHH"}
+	)
+
+	tests := []struct {
+		name            string
+		want            *auth_v1.ConfirmTotpEnrollmentResponse
+		err             error
+		authServiceMock authServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: &auth_v1.ConfirmTotpEnrollmentResponse{RecoveryCodes: recoveryCodes},
+			err:  nil,
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.ConfirmTotpEnrollmentMock.Expect(minimock.AnyContext, userID, totpCode).Return(recoveryCodes, nil)
+				return mock
+			},
+		},
+		{
+			name: "invalid code case",
+			want: nil,
+			err:  status.Error(codes.InvalidArgument, authService.ErrInvalidMfaCode.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.ConfirmTotpEnrollmentMock.Expect(minimock.AnyContext, userID, totpCode).
+					Return(nil, authService.ErrInvalidMfaCode)
+				return mock
+			},
+		},
+		{
+			name: "not enrolled case",
+			want: nil,
+			err:  status.Error(codes.FailedPrecondition, authService.ErrMfaNotEnrolled.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.ConfirmTotpEnrollmentMock.Expect(minimock.AnyContext, userID, totpCode).
+					Return(nil, authService.ErrMfaNotEnrolled)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := authAPI.NewImplementation(tt.authServiceMock(mc))
+
+			res, err := api.ConfirmTotpEnrollment(ctx, req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestDisableTotp(t *testing.T) {
+	t.Parallel()
+
+	type authServiceMockFunc func(mc *minimock.Controller) service.AuthService
+
+	var (
+		ctx = context.WithValue(context.Background(), user.UserIDKey, userID)
+		mc  = minimock.NewController(t)
+
+		req = &auth_v1.DisableTotpRequest{Code: totpCode}
+	)
+
+	tests := []struct {
+		name            string
+		want            *empty.Empty
+		err             error
+		authServiceMock authServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: &empty.Empty{},
+			err:  nil,
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.DisableTotpMock.Expect(minimock.AnyContext, userID, totpCode).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			want: nil,
+			err:  status.Error(codes.Internal, authService.ErrMfaFailed.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.DisableTotpMock.Expect(minimock.AnyContext, userID, totpCode).Return(authService.ErrMfaFailed)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := authAPI.NewImplementation(tt.authServiceMock(mc))
+
+			res, err := api.DisableTotp(ctx, req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
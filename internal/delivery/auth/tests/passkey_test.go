@@ -0,0 +1,242 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authAPI "github.com/8thgencore/microservice-auth/internal/delivery/auth"
+	"github.com/8thgencore/microservice-auth/internal/delivery/user"
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	authService "github.com/8thgencore/microservice-auth/internal/service/auth"
+	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
+	auth_v1 "github.com/8thgencore/microservice-auth/pkg/pb/auth/v1"
+)
+
+var (
+	ceremonyID        = "ceremony_uuid"
+	passkeyOptions    = `{"publicKey":{"challenge":"Y2hhbGxlbmdl"}}`
+	passkeyCredential = `{"id":"Y3JlZGVudGlhbA","type":"public-key"}`
+)
+
+func TestBeginPasskeyRegistration(t *testing.T) {
+	t.Parallel()
+
+	type authServiceMockFunc func(mc *minimock.Controller) service.AuthService
+
+	var (
+		ctx = context.WithValue(context.Background(), user.UserIDKey, userID)
+		mc  = minimock.NewController(t)
+	)
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		want            *auth_v1.BeginPasskeyRegistrationResponse
+		err             error
+		authServiceMock authServiceMockFunc
+	}{
+		{
+			name: "success case",
+			ctx:  ctx,
+			want: &auth_v1.BeginPasskeyRegistrationResponse{
+				CeremonyId: ceremonyID,
+				Options:    passkeyOptions,
+			},
+			err: nil,
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.BeginPasskeyRegistrationMock.Expect(minimock.AnyContext, userID).Return(
+					&model.PasskeyChallenge{CeremonyID: ceremonyID, Options: []byte(passkeyOptions)}, nil,
+				)
+				return mock
+			},
+		},
+		{
+			name: "unauthenticated case",
+			ctx:  context.Background(),
+			want: nil,
+			err:  status.Error(codes.Unauthenticated, "user not authenticated"),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				return serviceMocks.NewAuthServiceMock(mc)
+			},
+		},
+		{
+			name: "user not found case",
+			ctx:  ctx,
+			want: nil,
+			err:  status.Error(codes.NotFound, authService.ErrUserNotFound.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.BeginPasskeyRegistrationMock.Expect(minimock.AnyContext, userID).
+					Return(nil, authService.ErrUserNotFound)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := authAPI.NewImplementation(tt.authServiceMock(mc))
+
+			res, err := api.BeginPasskeyRegistration(tt.ctx, &empty.Empty{})
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestFinishPasskeyRegistration(t *testing.T) {
+	t.Parallel()
+
+	type authServiceMockFunc func(mc *minimock.Controller) service.AuthService
+
+	var (
+		ctx = context.WithValue(context.Background(), user.UserIDKey, userID)
+		mc  = minimock.NewController(t)
+
+		req = &auth_v1.FinishPasskeyRegistrationRequest{
+			CeremonyId: ceremonyID,
+			Credential: passkeyCredential,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		want            *empty.Empty
+		err             error
+		authServiceMock authServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: &empty.Empty{},
+			err:  nil,
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.FinishPasskeyRegistrationMock.
+					Expect(minimock.AnyContext, userID, ceremonyID, []byte(passkeyCredential)).
+					Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "invalid passkey case",
+			want: nil,
+			err:  status.Error(codes.InvalidArgument, authService.ErrInvalidPasskey.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.FinishPasskeyRegistrationMock.
+					Expect(minimock.AnyContext, userID, ceremonyID, []byte(passkeyCredential)).
+					Return(authService.ErrInvalidPasskey)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			want: nil,
+			err:  status.Error(codes.Internal, authService.ErrPasskeyFailed.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.FinishPasskeyRegistrationMock.
+					Expect(minimock.AnyContext, userID, ceremonyID, []byte(passkeyCredential)).
+					Return(authService.ErrPasskeyFailed)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := authAPI.NewImplementation(tt.authServiceMock(mc))
+
+			res, err := api.FinishPasskeyRegistration(ctx, req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestFinishPasskeyLogin(t *testing.T) {
+	t.Parallel()
+
+	type authServiceMockFunc func(mc *minimock.Controller) service.AuthService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		req = &auth_v1.FinishPasskeyLoginRequest{
+			CeremonyId: ceremonyID,
+			Credential: passkeyCredential,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		want            *auth_v1.FinishPasskeyLoginResponse
+		err             error
+		authServiceMock authServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: &auth_v1.FinishPasskeyLoginResponse{
+				AccessToken:  accessToken,
+				RefreshToken: refreshToken,
+			},
+			err: nil,
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.FinishPasskeyLoginMock.
+					Expect(minimock.AnyContext, ceremonyID, []byte(passkeyCredential), &model.ClientInfo{}).
+					Return(&model.TokenPair{AccessToken: accessToken, RefreshToken: refreshToken}, nil)
+				return mock
+			},
+		},
+		{
+			name: "invalid passkey case",
+			want: nil,
+			err:  status.Error(codes.Unauthenticated, authService.ErrInvalidPasskey.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.FinishPasskeyLoginMock.
+					Expect(minimock.AnyContext, ceremonyID, []byte(passkeyCredential), &model.ClientInfo{}).
+					Return(nil, authService.ErrInvalidPasskey)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			want: nil,
+			err:  status.Error(codes.Internal, authService.ErrPasskeyFailed.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.FinishPasskeyLoginMock.
+					Expect(minimock.AnyContext, ceremonyID, []byte(passkeyCredential), &model.ClientInfo{}).
+					Return(nil, authService.ErrPasskeyFailed)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := authAPI.NewImplementation(tt.authServiceMock(mc))
+
+			res, err := api.FinishPasskeyLogin(ctx, req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
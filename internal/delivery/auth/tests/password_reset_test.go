@@ -0,0 +1,142 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authAPI "github.com/8thgencore/microservice-auth/internal/delivery/auth"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	authService "github.com/8thgencore/microservice-auth/internal/service/auth"
+	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
+	auth_v1 "github.com/8thgencore/microservice-auth/pkg/pb/auth/v1"
+)
+
+var (
+	email      = "user@example.com"
+	resetToken = "reset_token"
+)
+
+func TestRequestPasswordReset(t *testing.T) {
+	t.Parallel()
+
+	type authServiceMockFunc func(mc *minimock.Controller) service.AuthService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		req = &auth_v1.RequestPasswordResetRequest{Email: email}
+	)
+
+	tests := []struct {
+		name            string
+		want            *empty.Empty
+		err             error
+		authServiceMock authServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: &empty.Empty{},
+			err:  nil,
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.RequestPasswordResetMock.Expect(ctx, email).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			want: nil,
+			err:  status.Error(codes.Internal, authService.ErrPasswordResetFailed.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.RequestPasswordResetMock.Expect(ctx, email).Return(authService.ErrPasswordResetFailed)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := authAPI.NewImplementation(tt.authServiceMock(mc))
+
+			res, err := api.RequestPasswordReset(ctx, req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestResetPassword(t *testing.T) {
+	t.Parallel()
+
+	type authServiceMockFunc func(mc *minimock.Controller) service.AuthService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		req = &auth_v1.ResetPasswordRequest{
+			Token:       resetToken,
+			NewPassword: password,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		want            *empty.Empty
+		err             error
+		authServiceMock authServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: &empty.Empty{},
+			err:  nil,
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.ResetPasswordMock.Expect(ctx, resetToken, password).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "invalid token case",
+			want: nil,
+			err:  status.Error(codes.InvalidArgument, authService.ErrInvalidResetToken.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.ResetPasswordMock.Expect(ctx, resetToken, password).Return(authService.ErrInvalidResetToken)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			want: nil,
+			err:  status.Error(codes.Internal, authService.ErrPasswordResetFailed.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.ResetPasswordMock.Expect(ctx, resetToken, password).Return(authService.ErrPasswordResetFailed)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := authAPI.NewImplementation(tt.authServiceMock(mc))
+
+			res, err := api.ResetPassword(ctx, req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
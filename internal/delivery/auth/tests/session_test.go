@@ -0,0 +1,225 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authAPI "github.com/8thgencore/microservice-auth/internal/delivery/auth"
+	"github.com/8thgencore/microservice-auth/internal/delivery/user"
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	authService "github.com/8thgencore/microservice-auth/internal/service/auth"
+	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
+	auth_v1 "github.com/8thgencore/microservice-auth/pkg/pb/auth/v1"
+)
+
+var (
+	userID           = "user_uuid"
+	sessionID        = "session_uuid"
+	otherSessionID   = "other_session_uuid"
+	sessionCreatedAt = time.Now()
+)
+
+func TestListMySessions(t *testing.T) {
+	t.Parallel()
+
+	type authServiceMockFunc func(mc *minimock.Controller) service.AuthService
+
+	var (
+		ctx = context.WithValue(
+			context.WithValue(context.Background(), user.UserIDKey, userID),
+			authAPI.SessionIDKey, sessionID,
+		)
+		mc = minimock.NewController(t)
+
+		serviceErr = errors.New("service error")
+
+		sessions = []*model.TokenFamily{
+			{ID: sessionID, UserID: userID, IPAddress: "10.0.0.1", UserAgent: "test-agent", CreatedAt: sessionCreatedAt},
+			{ID: otherSessionID, UserID: userID, CreatedAt: sessionCreatedAt},
+		}
+
+		res = &auth_v1.ListSessionsResponse{
+			Sessions: []*auth_v1.Session{
+				{
+					Id:        sessionID,
+					IpAddress: "10.0.0.1",
+					UserAgent: "test-agent",
+					CreatedAt: timestamppb.New(sessionCreatedAt),
+					Current:   true,
+				},
+				{
+					Id:        otherSessionID,
+					CreatedAt: timestamppb.New(sessionCreatedAt),
+				},
+			},
+		}
+	)
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		want            *auth_v1.ListSessionsResponse
+		err             error
+		authServiceMock authServiceMockFunc
+	}{
+		{
+			name: "success case",
+			ctx:  ctx,
+			want: res,
+			err:  nil,
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.ListSessionsMock.Expect(minimock.AnyContext, userID).Return(sessions, nil)
+				return mock
+			},
+		},
+		{
+			name: "unauthenticated case",
+			ctx:  context.Background(),
+			want: nil,
+			err:  status.Error(codes.Unauthenticated, "user not authenticated"),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				return serviceMocks.NewAuthServiceMock(mc)
+			},
+		},
+		{
+			name: "service error case",
+			ctx:  ctx,
+			want: nil,
+			err:  status.Error(codes.Internal, serviceErr.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.ListSessionsMock.Expect(minimock.AnyContext, userID).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := authAPI.NewImplementation(tt.authServiceMock(mc))
+
+			res, err := api.ListMySessions(tt.ctx, &empty.Empty{})
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestRevokeSession(t *testing.T) {
+	t.Parallel()
+
+	type authServiceMockFunc func(mc *minimock.Controller) service.AuthService
+
+	var (
+		ctx = context.WithValue(context.Background(), user.UserIDKey, userID)
+		mc  = minimock.NewController(t)
+
+		req = &auth_v1.RevokeSessionRequest{SessionId: otherSessionID}
+	)
+
+	tests := []struct {
+		name            string
+		want            *empty.Empty
+		err             error
+		authServiceMock authServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: &empty.Empty{},
+			err:  nil,
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.RevokeSessionMock.Expect(minimock.AnyContext, userID, otherSessionID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "session not found case",
+			want: nil,
+			err:  status.Error(codes.NotFound, authService.ErrSessionNotFound.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.RevokeSessionMock.Expect(minimock.AnyContext, userID, otherSessionID).
+					Return(authService.ErrSessionNotFound)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := authAPI.NewImplementation(tt.authServiceMock(mc))
+
+			res, err := api.RevokeSession(ctx, req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestRevokeAllOtherSessions(t *testing.T) {
+	t.Parallel()
+
+	type authServiceMockFunc func(mc *minimock.Controller) service.AuthService
+
+	var (
+		ctx = context.WithValue(context.Background(), user.UserIDKey, userID)
+		mc  = minimock.NewController(t)
+	)
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		want            *empty.Empty
+		err             error
+		authServiceMock authServiceMockFunc
+	}{
+		{
+			name: "success case",
+			ctx:  context.WithValue(ctx, authAPI.SessionIDKey, sessionID),
+			want: &empty.Empty{},
+			err:  nil,
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.RevokeAllSessionsMock.Expect(minimock.AnyContext, userID, sessionID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "unknown session case",
+			ctx:  ctx,
+			want: nil,
+			err:  status.Error(codes.FailedPrecondition, "current session is unknown, refresh the tokens first"),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				return serviceMocks.NewAuthServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := authAPI.NewImplementation(tt.authServiceMock(mc))
+
+			res, err := api.RevokeAllOtherSessions(tt.ctx, &empty.Empty{})
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authAPI "github.com/8thgencore/microservice-auth/internal/delivery/auth"
+	"github.com/8thgencore/microservice-auth/internal/delivery/user"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	authService "github.com/8thgencore/microservice-auth/internal/service/auth"
+	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
+	auth_v1 "github.com/8thgencore/microservice-auth/pkg/pb/auth/v1"
+)
+
+func TestLoginThrottled(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		req = &auth_v1.LoginRequest{
+			Creds: &auth_v1.Creds{
+				Username: username,
+				Password: password,
+			},
+		}
+	)
+
+	authServiceMock := serviceMocks.NewAuthServiceMock(mc)
+	authServiceMock.LoginMock.Return(nil, &authService.RetryError{
+		Err:        authService.ErrAccountLocked,
+		RetryAfter: 90 * time.Second,
+	})
+
+	api := authAPI.NewImplementation(authServiceMock)
+
+	res, err := api.Login(ctx, req)
+	require.Nil(t, res)
+
+	st := status.Convert(err)
+	require.Equal(t, codes.ResourceExhausted, st.Code())
+	require.Equal(t, authService.ErrAccountLocked.Error(), st.Message())
+	require.Len(t, st.Details(), 1)
+
+	info, ok := st.Details()[0].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	require.Equal(t, 90*time.Second, info.GetRetryDelay().AsDuration())
+}
+
+func TestVerifyMfaThrottled(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		req = &auth_v1.VerifyMfaRequest{MfaToken: "mfa_token", Code: "123456"}
+	)
+
+	authServiceMock := serviceMocks.NewAuthServiceMock(mc)
+	authServiceMock.VerifyMfaMock.Return(nil, &authService.RetryError{
+		Err:        authService.ErrMfaLocked,
+		RetryAfter: 15 * time.Minute,
+	})
+
+	api := authAPI.NewImplementation(authServiceMock)
+
+	res, err := api.VerifyMfa(ctx, req)
+	require.Nil(t, res)
+
+	st := status.Convert(err)
+	require.Equal(t, codes.ResourceExhausted, st.Code())
+	require.Equal(t, authService.ErrMfaLocked.Error(), st.Message())
+	require.Len(t, st.Details(), 1)
+}
+
+func TestDisableTotpThrottled(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.WithValue(context.Background(), user.UserIDKey, userID)
+		mc  = minimock.NewController(t)
+
+		req = &auth_v1.DisableTotpRequest{Code: "123456"}
+	)
+
+	authServiceMock := serviceMocks.NewAuthServiceMock(mc)
+	authServiceMock.DisableTotpMock.Expect(minimock.AnyContext, userID, req.GetCode()).Return(&authService.RetryError{
+		Err:        authService.ErrMfaLocked,
+		RetryAfter: 15 * time.Minute,
+	})
+
+	api := authAPI.NewImplementation(authServiceMock)
+
+	res, err := api.DisableTotp(ctx, req)
+	require.Nil(t, res)
+
+	st := status.Convert(err)
+	require.Equal(t, codes.ResourceExhausted, st.Code())
+	require.Equal(t, authService.ErrMfaLocked.Error(), st.Message())
+	require.Len(t, st.Details(), 1)
+}
+
+func TestUnlockUser(t *testing.T) {
+	t.Parallel()
+
+	type authServiceMockFunc func(mc *minimock.Controller) service.AuthService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		req = &auth_v1.UnlockUserRequest{UserId: userID}
+	)
+
+	tests := []struct {
+		name            string
+		want            *empty.Empty
+		err             error
+		authServiceMock authServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: &empty.Empty{},
+			err:  nil,
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.UnlockUserMock.Expect(ctx, userID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "user not found case",
+			want: nil,
+			err:  status.Error(codes.NotFound, authService.ErrUserNotFound.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.UnlockUserMock.Expect(ctx, userID).Return(authService.ErrUserNotFound)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			want: nil,
+			err:  status.Error(codes.Internal, authService.ErrUnlockFailed.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.UnlockUserMock.Expect(ctx, userID).Return(authService.ErrUnlockFailed)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := authAPI.NewImplementation(tt.authServiceMock(mc))
+
+			res, err := api.UnlockUser(ctx, req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package extauthz
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// anyMethod is the method of the routes matching every HTTP method.
+const anyMethod = "*"
+
+// parameterName is the pattern of the names of path parameters, which are the names of the attributes
+// passed to the condition of the policy of the endpoint.
+var parameterName = regexp.MustCompile(`^[A-Za-z0-9_]{1,64}$`)
+
+// routesFile is the JSON document mapping HTTP requests to endpoints, e.g.:
+//
+//	{"routes": [
+//	  {"method": "GET", "path": "/v1/chats/{chat_id}", "endpoint": "/chat_v1.ChatV1/Get"}
+//	]}
+type routesFile struct {
+	Routes []Route `json:"routes"`
+}
+
+// Route maps the HTTP requests with the method and the path to an endpoint. A segment of the path
+// written as {name} matches any segment and passes it as the name attribute, the method * matches
+// every method.
+type Route struct {
+	Method   string `json:"method"`
+	Path     string `json:"path"`
+	Endpoint string `json:"endpoint"`
+}
+
+type route struct {
+	method   string
+	segments []string
+	endpoint string
+}
+
+// Routes maps HTTP requests to endpoints, the first matching route applies.
+type Routes struct {
+	routes []route
+}
+
+// LoadRoutes reads the routes file, an empty path gives no routes.
+func LoadRoutes(path string) (*Routes, error) {
+	if path == "" {
+		return NewRoutes(nil)
+	}
+
+	data, err := os.ReadFile(path) // #nosec G304 -- path comes from service configuration
+	if err != nil {
+		return nil, fmt.Errorf("could not read ext_authz routes: %w", err)
+	}
+
+	var file routesFile
+	if err = json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("could not parse ext_authz routes: %w", err)
+	}
+
+	return NewRoutes(file.Routes)
+}
+
+// NewRoutes creates the routes, in the order they apply.
+func NewRoutes(routes []Route) (*Routes, error) {
+	r := &Routes{routes: make([]route, 0, len(routes))}
+
+	for _, rt := range routes {
+		if rt.Method == "" {
+			return nil, fmt.Errorf("route %q: method is required", rt.Path)
+		}
+		if !strings.HasPrefix(rt.Path, "/") {
+			return nil, fmt.Errorf("route %q: path must start with '/'", rt.Path)
+		}
+		if !strings.HasPrefix(rt.Endpoint, "/") {
+			return nil, fmt.Errorf("route %q: endpoint must be a full method", rt.Path)
+		}
+
+		segments := strings.Split(rt.Path, "/")[1:]
+		for _, segment := range segments {
+			if name, ok := parameter(segment); ok && !parameterName.MatchString(name) {
+				return nil, fmt.Errorf("route %q: invalid path parameter %q", rt.Path, name)
+			}
+		}
+
+		r.routes = append(r.routes, route{
+			method:   strings.ToUpper(rt.Method),
+			segments: segments,
+			endpoint: rt.Endpoint,
+		})
+	}
+
+	return r, nil
+}
+
+// Match returns the endpoint of the first route matching the HTTP request and the path parameters
+// as attributes. The query of the path is ignored.
+func (r *Routes) Match(method, path string) (string, map[string]string, bool) {
+	path, _, _ = strings.Cut(path, "?")
+	if !strings.HasPrefix(path, "/") {
+		return "", nil, false
+	}
+	segments := strings.Split(path, "/")[1:]
+
+	for _, rt := range r.routes {
+		if rt.method != anyMethod && rt.method != strings.ToUpper(method) {
+			continue
+		}
+		if attributes, ok := rt.match(segments); ok {
+			return rt.endpoint, attributes, true
+		}
+	}
+
+	return "", nil, false
+}
+
+// match returns the path parameters when the segments of a path match the route.
+func (rt route) match(segments []string) (map[string]string, bool) {
+	if len(segments) != len(rt.segments) {
+		return nil, false
+	}
+
+	var attributes map[string]string
+	for i, segment := range rt.segments {
+		name, ok := parameter(segment)
+		switch {
+		case ok && segments[i] != "":
+			if attributes == nil {
+				attributes = make(map[string]string)
+			}
+			attributes[name] = segments[i]
+		case ok || segment != segments[i]:
+			return nil, false
+		}
+	}
+
+	return attributes, true
+}
+
+// parameter returns the name of the path parameter when the segment is one.
+func parameter(segment string) (string, bool) {
+	name, ok := strings.CutPrefix(segment, "{")
+	if !ok {
+		return "", false
+	}
+
+	return strings.CutSuffix(name, "}")
+}
@@ -0,0 +1,152 @@
+package extauthz
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	"github.com/8thgencore/microservice-auth/internal/service/access"
+	"github.com/8thgencore/microservice-auth/internal/tokens"
+	"github.com/8thgencore/microservice-auth/pkg/utils"
+)
+
+const (
+	// serviceName is the name of the trusted service the access of the users is checked for.
+	serviceName = "envoy"
+
+	authPrefix = "Bearer "
+	grpcPrefix = "application/grpc"
+)
+
+// Headers added to the requests Envoy lets through to the upstream
+const (
+	UserIDHeader   = "x-user-id"
+	UserNameHeader = "x-user-name"
+	UserRoleHeader = "x-user-role"
+)
+
+// Server implements the Envoy external authorization API.
+type Server struct {
+	authv3.UnimplementedAuthorizationServer
+	accessService   service.AccessService
+	tokenOperations tokens.TokenOperations
+	routes          *Routes
+}
+
+// NewServer creates new external authorization server.
+func NewServer(accessService service.AccessService, tokenOperations tokens.TokenOperations, routes *Routes) *Server {
+	return &Server{
+		accessService:   accessService,
+		tokenOperations: tokenOperations,
+		routes:          routes,
+	}
+}
+
+// Check authorizes a request received by Envoy. The bearer token of the request must be valid and its user
+// must be allowed by the policy of the endpoint of the request: the method of a gRPC request or the endpoint
+// routed to for an HTTP request. Allowed requests reach the upstream with the headers of the user.
+func (s *Server) Check(ctx context.Context, req *authv3.CheckRequest) (*authv3.CheckResponse, error) {
+	httpReq := req.GetAttributes().GetRequest().GetHttp()
+	headers := httpReq.GetHeaders()
+
+	token, ok := strings.CutPrefix(headers["authorization"], authPrefix)
+	if !ok || token == "" {
+		return denied(codes.Unauthenticated, "authorization header is not provided"), nil
+	}
+
+	claims, err := s.tokenOperations.VerifyAccessToken(token)
+	if err != nil {
+		return denied(codes.Unauthenticated, access.ErrInvalidAccessToken.Error()), nil
+	}
+
+	endpoint, attributes, ok := s.endpoint(httpReq)
+	if !ok {
+		return denied(codes.PermissionDenied, "no endpoint matches the request"), nil
+	}
+
+	principal := &model.AccessPrincipal{AccessToken: token}
+	err = s.accessService.CheckAs(requestContext(ctx, req), principal, endpoint, attributes)
+	if err != nil {
+		if errors.Is(err, access.ErrInvalidAccessToken) || errors.Is(err, access.ErrAccessTokenExpired) {
+			return denied(codes.Unauthenticated, err.Error()), nil
+		}
+
+		return denied(codes.PermissionDenied, err.Error()), nil
+	}
+
+	return allowed(claims), nil
+}
+
+// requestContext returns the context the request is checked in. The conditions of the policies see
+// the client of the request and its headers, not Envoy and the metadata of its call.
+func requestContext(ctx context.Context, req *authv3.CheckRequest) context.Context {
+	md := metadata.MD{}
+	for name, value := range req.GetAttributes().GetRequest().GetHttp().GetHeaders() {
+		md.Set(name, value)
+	}
+	ctx = metadata.NewIncomingContext(tokens.WithService(ctx, serviceName), md)
+
+	return utils.WithClientIP(ctx, req.GetAttributes().GetSource().GetAddress().GetSocketAddress().GetAddress())
+}
+
+// endpoint returns the endpoint of the request and the attributes passed to the condition of its policy.
+func (s *Server) endpoint(httpReq *authv3.AttributeContext_HttpRequest) (string, map[string]string, bool) {
+	if strings.HasPrefix(httpReq.GetHeaders()["content-type"], grpcPrefix) {
+		path, _, _ := strings.Cut(httpReq.GetPath(), "?")
+
+		return path, nil, true
+	}
+
+	return s.routes.Match(httpReq.GetMethod(), httpReq.GetPath())
+}
+
+// allowed returns the response letting the request through with the headers of the user,
+// replacing the headers of the same name sent by the client.
+func allowed(claims *model.UserClaims) *authv3.CheckResponse {
+	return &authv3.CheckResponse{
+		Status: &rpcstatus.Status{Code: int32(codes.OK)},
+		HttpResponse: &authv3.CheckResponse_OkResponse{
+			OkResponse: &authv3.OkHttpResponse{
+				Headers: []*corev3.HeaderValueOption{
+					header(UserIDHeader, claims.Subject),
+					header(UserNameHeader, claims.Username),
+					header(UserRoleHeader, strings.Join(claims.Roles, ",")),
+				},
+			},
+		},
+	}
+}
+
+// denied returns the response rejecting the request, with 401 for unauthenticated requests and 403 otherwise.
+func denied(code codes.Code, message string) *authv3.CheckResponse {
+	httpCode := typev3.StatusCode_Forbidden
+	if code == codes.Unauthenticated {
+		httpCode = typev3.StatusCode_Unauthorized
+	}
+
+	return &authv3.CheckResponse{
+		Status: &rpcstatus.Status{Code: int32(code), Message: message},
+		HttpResponse: &authv3.CheckResponse_DeniedResponse{
+			DeniedResponse: &authv3.DeniedHttpResponse{
+				Status: &typev3.HttpStatus{Code: httpCode},
+				Body:   message,
+			},
+		},
+	}
+}
+
+func header(key, value string) *corev3.HeaderValueOption {
+	return &corev3.HeaderValueOption{
+		Header:       &corev3.HeaderValue{Key: key, Value: value},
+		AppendAction: corev3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+	}
+}
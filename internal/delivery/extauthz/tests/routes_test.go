@@ -0,0 +1,150 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/8thgencore/microservice-auth/internal/delivery/extauthz"
+)
+
+func TestRoutesMatch(t *testing.T) {
+	t.Parallel()
+
+	routes, err := extauthz.NewRoutes([]extauthz.Route{
+		{Method: "POST", Path: "/v1/chats", Endpoint: "/chat_v1.ChatV1/Create"},
+		{Method: "get", Path: "/v1/chats/mine", Endpoint: "/chat_v1.ChatV1/ListMine"},
+		{Method: "GET", Path: "/v1/chats/{chat_id}", Endpoint: "/chat_v1.ChatV1/Get"},
+		{Method: "*", Path: "/v1/chats/{chat_id}/messages/{message_id}", Endpoint: "/chat_v1.ChatV1/Message"},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		endpoint   string
+		attributes map[string]string
+		ok         bool
+	}{
+		{
+			name:     "literal path case",
+			method:   "POST",
+			path:     "/v1/chats",
+			endpoint: "/chat_v1.ChatV1/Create",
+			ok:       true,
+		},
+		{
+			name:     "first route applies case",
+			method:   "GET",
+			path:     "/v1/chats/mine",
+			endpoint: "/chat_v1.ChatV1/ListMine",
+			ok:       true,
+		},
+		{
+			name:       "path parameter case",
+			method:     "get",
+			path:       "/v1/chats/42?fields=name",
+			endpoint:   "/chat_v1.ChatV1/Get",
+			attributes: map[string]string{"chat_id": "42"},
+			ok:         true,
+		},
+		{
+			name:       "any method case",
+			method:     "DELETE",
+			path:       "/v1/chats/42/messages/7",
+			endpoint:   "/chat_v1.ChatV1/Message",
+			attributes: map[string]string{"chat_id": "42", "message_id": "7"},
+			ok:         true,
+		},
+		{
+			name:   "other method case",
+			method: "DELETE",
+			path:   "/v1/chats/42",
+		},
+		{
+			name:   "empty parameter case",
+			method: "GET",
+			path:   "/v1/chats/",
+		},
+		{
+			name:   "longer path case",
+			method: "GET",
+			path:   "/v1/chats/42/members",
+		},
+		{
+			name:   "relative path case",
+			method: "POST",
+			path:   "v1/chats",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			endpoint, attributes, ok := routes.Match(tt.method, tt.path)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.endpoint, endpoint)
+			require.Equal(t, tt.attributes, attributes)
+		})
+	}
+}
+
+func TestNewRoutesInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		route extauthz.Route
+	}{
+		{
+			name:  "missing method case",
+			route: extauthz.Route{Path: "/v1/chats", Endpoint: "/chat_v1.ChatV1/Create"},
+		},
+		{
+			name:  "relative path case",
+			route: extauthz.Route{Method: "GET", Path: "v1/chats", Endpoint: "/chat_v1.ChatV1/List"},
+		},
+		{
+			name:  "missing endpoint case",
+			route: extauthz.Route{Method: "GET", Path: "/v1/chats"},
+		},
+		{
+			name:  "invalid parameter case",
+			route: extauthz.Route{Method: "GET", Path: "/v1/chats/{chat-id}", Endpoint: "/chat_v1.ChatV1/Get"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := extauthz.NewRoutes([]extauthz.Route{tt.route})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestLoadRoutes(t *testing.T) {
+	t.Parallel()
+
+	routes, err := extauthz.LoadRoutes("")
+	require.NoError(t, err)
+	_, _, ok := routes.Match("GET", "/v1/chats")
+	require.False(t, ok)
+
+	routes, err = extauthz.LoadRoutes(filepath.Join("..", "..", "..", "..", "ext-authz-routes.example.json"))
+	require.NoError(t, err)
+	endpoint, attributes, ok := routes.Match("POST", "/v1/chats/42/messages")
+	require.True(t, ok)
+	require.Equal(t, "/chat_v1.ChatV1/SendMessage", endpoint)
+	require.Equal(t, map[string]string{"chat_id": "42"}, attributes)
+
+	path := filepath.Join(t.TempDir(), "routes.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"routes": [`), 0o600))
+	_, err = extauthz.LoadRoutes(path)
+	require.Error(t, err)
+}
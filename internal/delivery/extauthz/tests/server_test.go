@@ -0,0 +1,254 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/8thgencore/microservice-auth/internal/delivery/extauthz"
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	accessService "github.com/8thgencore/microservice-auth/internal/service/access"
+	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
+	"github.com/8thgencore/microservice-auth/internal/tokens"
+	tokenMocks "github.com/8thgencore/microservice-auth/internal/tokens/mocks"
+	"github.com/8thgencore/microservice-auth/pkg/utils"
+)
+
+// newEnvoyClient serves the server over an in-memory connection and returns a client calling it as Envoy does.
+func newEnvoyClient(t *testing.T, server *extauthz.Server) authv3.AuthorizationClient {
+	lis := bufconn.Listen(1 << 20)
+
+	srv := grpc.NewServer()
+	authv3.RegisterAuthorizationServer(srv, server)
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///ext-authz",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return authv3.NewAuthorizationClient(conn)
+}
+
+// clientAddress is the address of the client of the requests received by Envoy.
+const clientAddress = "203.0.113.9"
+
+// envoyRequest returns the check request Envoy sends for an HTTP request of the client.
+func envoyRequest(method, path string, headers map[string]string) *authv3.CheckRequest {
+	return &authv3.CheckRequest{
+		Attributes: &authv3.AttributeContext{
+			Source: &authv3.AttributeContext_Peer{
+				Address: &corev3.Address{
+					Address: &corev3.Address_SocketAddress{
+						SocketAddress: &corev3.SocketAddress{
+							Address:       clientAddress,
+							PortSpecifier: &corev3.SocketAddress_PortValue{PortValue: 40000},
+						},
+					},
+				},
+			},
+			Request: &authv3.AttributeContext_Request{
+				Http: &authv3.AttributeContext_HttpRequest{
+					Method:  method,
+					Path:    path,
+					Headers: headers,
+				},
+			},
+		},
+	}
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	type accessServiceMockFunc func(mc *minimock.Controller) service.AccessService
+	type tokenOperationsMockFunc func(mc *minimock.Controller) tokens.TokenOperations
+
+	var (
+		ctx = context.Background()
+
+		token     = "access_token"
+		principal = &model.AccessPrincipal{AccessToken: token}
+		claims    = &model.UserClaims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: "user-id"},
+			Username:         "username",
+			Roles:            model.ClaimRoles{"USER", "SUPPORT"},
+		}
+
+		bearer      = map[string]string{"authorization": "Bearer " + token}
+		grpcHeaders = map[string]string{
+			"authorization": "Bearer " + token,
+			"content-type":  "application/grpc+proto",
+		}
+
+		userHeaders = map[string]string{
+			extauthz.UserIDHeader:   "user-id",
+			extauthz.UserNameHeader: "username",
+			extauthz.UserRoleHeader: "USER,SUPPORT",
+		}
+
+		verifiedMock = func(mc *minimock.Controller) tokens.TokenOperations {
+			mock := tokenMocks.NewTokenOperationsMock(mc)
+			mock.VerifyAccessTokenMock.Expect(token).Return(claims, nil)
+			return mock
+		}
+
+		// checkAsMock expects the access of the principal to the endpoint to be checked for Envoy.
+		// The conditions see the address and the headers of the client, not those of Envoy.
+		checkAsMock = func(endpoint string, attributes map[string]string, err error) accessServiceMockFunc {
+			return func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.CheckAsMock.Set(func(
+					ctx context.Context,
+					p *model.AccessPrincipal,
+					e string,
+					a map[string]string,
+				) error {
+					require.Equal(mc, "envoy", tokens.Service(ctx))
+					require.Equal(mc, clientAddress, utils.ExtractClientIP(ctx))
+					md, _ := metadata.FromIncomingContext(ctx)
+					require.Equal(mc, []string{"Bearer " + token}, md.Get("authorization"))
+					require.Equal(mc, principal, p)
+					require.Equal(mc, endpoint, e)
+					require.Equal(mc, attributes, a)
+					return err
+				})
+				return mock
+			}
+		}
+	)
+
+	routes, err := extauthz.NewRoutes([]extauthz.Route{
+		{Method: "GET", Path: "/v1/chats/{chat_id}", Endpoint: "/chat_v1.ChatV1/Get"},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name                string
+		req                 *authv3.CheckRequest
+		code                codes.Code
+		httpStatus          typev3.StatusCode
+		headers             map[string]string
+		accessServiceMock   accessServiceMockFunc
+		tokenOperationsMock tokenOperationsMockFunc
+	}{
+		{
+			name:                "http route allowed case",
+			req:                 envoyRequest("GET", "/v1/chats/42?fields=name", bearer),
+			code:                codes.OK,
+			headers:             userHeaders,
+			accessServiceMock:   checkAsMock("/chat_v1.ChatV1/Get", map[string]string{"chat_id": "42"}, nil),
+			tokenOperationsMock: verifiedMock,
+		},
+		{
+			name:                "grpc method allowed case",
+			req:                 envoyRequest("POST", "/chat_v1.ChatV1/SendMessage", grpcHeaders),
+			code:                codes.OK,
+			headers:             userHeaders,
+			accessServiceMock:   checkAsMock("/chat_v1.ChatV1/SendMessage", nil, nil),
+			tokenOperationsMock: verifiedMock,
+		},
+		{
+			name:       "access denied case",
+			req:        envoyRequest("GET", "/v1/chats/42", bearer),
+			code:       codes.PermissionDenied,
+			httpStatus: typev3.StatusCode_Forbidden,
+			accessServiceMock: checkAsMock("/chat_v1.ChatV1/Get", map[string]string{"chat_id": "42"},
+				accessService.ErrAccessDenied),
+			tokenOperationsMock: verifiedMock,
+		},
+		{
+			name:       "access token expired case",
+			req:        envoyRequest("POST", "/chat_v1.ChatV1/SendMessage", grpcHeaders),
+			code:       codes.Unauthenticated,
+			httpStatus: typev3.StatusCode_Unauthorized,
+			accessServiceMock: checkAsMock("/chat_v1.ChatV1/SendMessage", nil,
+				accessService.ErrAccessTokenExpired),
+			tokenOperationsMock: verifiedMock,
+		},
+		{
+			name:       "no route case",
+			req:        envoyRequest("DELETE", "/v1/chats/42", bearer),
+			code:       codes.PermissionDenied,
+			httpStatus: typev3.StatusCode_Forbidden,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				return serviceMocks.NewAccessServiceMock(mc)
+			},
+			tokenOperationsMock: verifiedMock,
+		},
+		{
+			name:       "invalid access token case",
+			req:        envoyRequest("GET", "/v1/chats/42", bearer),
+			code:       codes.Unauthenticated,
+			httpStatus: typev3.StatusCode_Unauthorized,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				return serviceMocks.NewAccessServiceMock(mc)
+			},
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyAccessTokenMock.Expect(token).Return(nil, errors.New("invalid token"))
+				return mock
+			},
+		},
+		{
+			name:       "missing authorization header case",
+			req:        envoyRequest("GET", "/v1/chats/42", map[string]string{"authorization": token}),
+			code:       codes.Unauthenticated,
+			httpStatus: typev3.StatusCode_Unauthorized,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				return serviceMocks.NewAccessServiceMock(mc)
+			},
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				return tokenMocks.NewTokenOperationsMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			server := extauthz.NewServer(tt.accessServiceMock(mc), tt.tokenOperationsMock(mc), routes)
+			client := newEnvoyClient(t, server)
+
+			res, err := client.Check(ctx, tt.req)
+			require.NoError(t, err)
+			require.Equal(t, int32(tt.code), res.GetStatus().GetCode())
+
+			if tt.code != codes.OK {
+				require.Equal(t, tt.httpStatus, res.GetDeniedResponse().GetStatus().GetCode())
+				require.Nil(t, res.GetOkResponse())
+				return
+			}
+
+			headers := make(map[string]string)
+			for _, option := range res.GetOkResponse().GetHeaders() {
+				headers[option.GetHeader().GetKey()] = option.GetHeader().GetValue()
+			}
+			require.Equal(t, tt.headers, headers)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package jwks
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/8thgencore/microservice-auth/internal/tokens"
+)
+
+// Path is the well-known location of the JSON Web Key Set.
+const Path = "/.well-known/jwks.json"
+
+// Handler serves the public token verification keys as a JSON Web Key Set.
+type Handler struct {
+	tokenOperations tokens.TokenOperations
+}
+
+// NewHandler creates new JWKS handler.
+func NewHandler(tokenOperations tokens.TokenOperations) *Handler {
+	return &Handler{
+		tokenOperations: tokenOperations,
+	}
+}
+
+// ServeHTTP writes the key set. It matches runtime.HandlerFunc so it can be mounted on the gateway mux.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	body, err := json.Marshal(h.tokenOperations.JWKS())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/jwk-set+json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	_, _ = w.Write(body)
+}
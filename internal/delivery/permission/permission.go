@@ -0,0 +1,92 @@
+package permission
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/8thgencore/microservice-auth/internal/converter"
+	"github.com/8thgencore/microservice-auth/internal/service/permission"
+	desc "github.com/8thgencore/microservice-auth/pkg/pb/permission/v1"
+)
+
+// CreatePermission creates a new permission.
+func (impl *Implementation) CreatePermission(
+	ctx context.Context,
+	req *desc.CreatePermissionRequest,
+) (*empty.Empty, error) {
+	if err := impl.permissionService.Create(ctx, converter.ToPermissionCreateFromAPI(req)); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// GetPermission returns a permission by name.
+func (impl *Implementation) GetPermission(
+	ctx context.Context,
+	req *desc.GetPermissionRequest,
+) (*desc.GetPermissionResponse, error) {
+	p, err := impl.permissionService.Get(ctx, req.GetName())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &desc.GetPermissionResponse{
+		Permission: converter.ToPermissionFromService(p),
+	}, nil
+}
+
+// ListPermissions returns all permissions.
+func (impl *Implementation) ListPermissions(
+	ctx context.Context,
+	_ *empty.Empty,
+) (*desc.ListPermissionsResponse, error) {
+	permissions, err := impl.permissionService.List(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return converter.ToListPermissionsResponseFromService(permissions), nil
+}
+
+// UpdatePermission updates the description, the endpoints or the roles of a permission.
+func (impl *Implementation) UpdatePermission(
+	ctx context.Context,
+	req *desc.UpdatePermissionRequest,
+) (*empty.Empty, error) {
+	if err := impl.permissionService.Update(ctx, converter.ToPermissionUpdateFromAPI(req)); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// DeletePermission deletes a permission.
+func (impl *Implementation) DeletePermission(
+	ctx context.Context,
+	req *desc.DeletePermissionRequest,
+) (*empty.Empty, error) {
+	if err := impl.permissionService.Delete(ctx, req.GetName()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// toStatusError converts an error of the permission service to a gRPC status error.
+func toStatusError(err error) error {
+	switch {
+	case errors.Is(err, permission.ErrPermissionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, permission.ErrPermissionExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, permission.ErrUnknownRole):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,19 @@
+package permission
+
+import (
+	"github.com/8thgencore/microservice-auth/internal/service"
+	desc "github.com/8thgencore/microservice-auth/pkg/pb/permission/v1"
+)
+
+// Implementation structure describes API layer.
+type Implementation struct {
+	desc.UnimplementedPermissionV1Server
+	permissionService service.PermissionService
+}
+
+// NewImplementation creates new object of API layer.
+func NewImplementation(permissionService service.PermissionService) *Implementation {
+	return &Implementation{
+		permissionService: permissionService,
+	}
+}
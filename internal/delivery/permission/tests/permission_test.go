@@ -0,0 +1,286 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	permissionAPI "github.com/8thgencore/microservice-auth/internal/delivery/permission"
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
+	permissionService "github.com/8thgencore/microservice-auth/internal/service/permission"
+	permissionv1 "github.com/8thgencore/microservice-auth/pkg/pb/permission/v1"
+	userv1 "github.com/8thgencore/microservice-auth/pkg/pb/user/v1"
+)
+
+type permissionServiceMockFunc func(mc *minimock.Controller) service.PermissionService
+
+var (
+	name        = "chat.moderate"
+	description = "Moderate chats"
+	endpoints   = []string{"/chat_v1.ChatV1/Delete"}
+	roles       = []string{"ADMIN"}
+	createdAt   = time.Date(2025, 5, 25, 12, 0, 0, 0, time.UTC)
+)
+
+func TestCreatePermission(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		req = &permissionv1.CreatePermissionRequest{
+			Name:        name,
+			Description: description,
+			Endpoints:   endpoints,
+			Roles:       roles,
+		}
+
+		permission = &model.Permission{
+			Name:        name,
+			Description: description,
+			Endpoints:   endpoints,
+			Roles:       roles,
+		}
+	)
+
+	tests := []struct {
+		name                  string
+		err                   error
+		permissionServiceMock permissionServiceMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			permissionServiceMock: func(mc *minimock.Controller) service.PermissionService {
+				mock := serviceMocks.NewPermissionServiceMock(mc)
+				mock.CreateMock.Expect(ctx, permission).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "permission exists error case",
+			err:  status.Error(codes.AlreadyExists, permissionService.ErrPermissionExists.Error()),
+			permissionServiceMock: func(mc *minimock.Controller) service.PermissionService {
+				mock := serviceMocks.NewPermissionServiceMock(mc)
+				mock.CreateMock.Expect(ctx, permission).Return(permissionService.ErrPermissionExists)
+				return mock
+			},
+		},
+		{
+			name: "unknown role error case",
+			err:  status.Error(codes.InvalidArgument, permissionService.ErrUnknownRole.Error()),
+			permissionServiceMock: func(mc *minimock.Controller) service.PermissionService {
+				mock := serviceMocks.NewPermissionServiceMock(mc)
+				mock.CreateMock.Expect(ctx, permission).Return(permissionService.ErrUnknownRole)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := permissionAPI.NewImplementation(tt.permissionServiceMock(mc))
+
+			_, err := api.CreatePermission(ctx, req)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestGetPermission(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		permission = &model.Permission{
+			Name:        name,
+			Description: description,
+			Endpoints:   endpoints,
+			Roles:       roles,
+			CreatedAt:   createdAt,
+			UpdatedAt:   sql.NullTime{},
+		}
+
+		res = &permissionv1.GetPermissionResponse{
+			Permission: &permissionv1.Permission{
+				Name:        name,
+				Description: description,
+				Endpoints:   endpoints,
+				Roles:       roles,
+				CreatedAt:   timestamppb.New(createdAt),
+			},
+		}
+	)
+
+	tests := []struct {
+		name                  string
+		want                  *permissionv1.GetPermissionResponse
+		err                   error
+		permissionServiceMock permissionServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: res,
+			err:  nil,
+			permissionServiceMock: func(mc *minimock.Controller) service.PermissionService {
+				mock := serviceMocks.NewPermissionServiceMock(mc)
+				mock.GetMock.Expect(ctx, name).Return(permission, nil)
+				return mock
+			},
+		},
+		{
+			name: "permission not found error case",
+			want: nil,
+			err:  status.Error(codes.NotFound, permissionService.ErrPermissionNotFound.Error()),
+			permissionServiceMock: func(mc *minimock.Controller) service.PermissionService {
+				mock := serviceMocks.NewPermissionServiceMock(mc)
+				mock.GetMock.Expect(ctx, name).Return(nil, permissionService.ErrPermissionNotFound)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := permissionAPI.NewImplementation(tt.permissionServiceMock(mc))
+
+			res, err := api.GetPermission(ctx, &permissionv1.GetPermissionRequest{Name: name})
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestUpdatePermission(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		req = &permissionv1.UpdatePermissionRequest{
+			Name:        name,
+			Description: wrapperspb.String(description),
+			Roles:       &userv1.RoleNames{Names: roles},
+		}
+	)
+
+	tests := []struct {
+		name                  string
+		err                   error
+		permissionServiceMock permissionServiceMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			permissionServiceMock: func(mc *minimock.Controller) service.PermissionService {
+				mock := serviceMocks.NewPermissionServiceMock(mc)
+				mock.UpdateMock.Set(func(_ context.Context, got *model.PermissionUpdate) error {
+					require.Equal(mc, name, got.Name)
+					require.Equal(mc, &description, got.Description)
+					require.Nil(mc, got.Endpoints)
+					require.Equal(mc, &roles, got.Roles)
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "permission not found error case",
+			err:  status.Error(codes.NotFound, permissionService.ErrPermissionNotFound.Error()),
+			permissionServiceMock: func(mc *minimock.Controller) service.PermissionService {
+				mock := serviceMocks.NewPermissionServiceMock(mc)
+				mock.UpdateMock.Return(permissionService.ErrPermissionNotFound)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			err:  status.Error(codes.Internal, permissionService.ErrPermissionUpdate.Error()),
+			permissionServiceMock: func(mc *minimock.Controller) service.PermissionService {
+				mock := serviceMocks.NewPermissionServiceMock(mc)
+				mock.UpdateMock.Return(permissionService.ErrPermissionUpdate)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := permissionAPI.NewImplementation(tt.permissionServiceMock(mc))
+
+			_, err := api.UpdatePermission(ctx, req)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestDeletePermission(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+	)
+
+	tests := []struct {
+		name                  string
+		want                  *empty.Empty
+		err                   error
+		permissionServiceMock permissionServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: &empty.Empty{},
+			err:  nil,
+			permissionServiceMock: func(mc *minimock.Controller) service.PermissionService {
+				mock := serviceMocks.NewPermissionServiceMock(mc)
+				mock.DeleteMock.Expect(ctx, name).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			want: nil,
+			err:  status.Error(codes.Internal, errors.New("some error").Error()),
+			permissionServiceMock: func(mc *minimock.Controller) service.PermissionService {
+				mock := serviceMocks.NewPermissionServiceMock(mc)
+				mock.DeleteMock.Expect(ctx, name).Return(errors.New("some error"))
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := permissionAPI.NewImplementation(tt.permissionServiceMock(mc))
+
+			res, err := api.DeletePermission(ctx, &permissionv1.DeletePermissionRequest{Name: name})
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package role
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/8thgencore/microservice-auth/internal/converter"
+	"github.com/8thgencore/microservice-auth/internal/service/role"
+	desc "github.com/8thgencore/microservice-auth/pkg/pb/role/v1"
+)
+
+// CreateRole creates a new role.
+func (impl *Implementation) CreateRole(ctx context.Context, req *desc.CreateRoleRequest) (*empty.Empty, error) {
+	if err := impl.roleService.Create(ctx, converter.ToRoleCreateFromAPI(req)); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// GetRole returns a role by name.
+func (impl *Implementation) GetRole(ctx context.Context, req *desc.GetRoleRequest) (*desc.GetRoleResponse, error) {
+	r, err := impl.roleService.Get(ctx, req.GetName())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &desc.GetRoleResponse{
+		Role: converter.ToRoleFromService(r),
+	}, nil
+}
+
+// ListRoles returns all roles.
+func (impl *Implementation) ListRoles(ctx context.Context, _ *empty.Empty) (*desc.ListRolesResponse, error) {
+	roles, err := impl.roleService.List(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return converter.ToListRolesResponseFromService(roles), nil
+}
+
+// UpdateRole updates the description or the parents of a role.
+func (impl *Implementation) UpdateRole(ctx context.Context, req *desc.UpdateRoleRequest) (*empty.Empty, error) {
+	if err := impl.roleService.Update(ctx, converter.ToRoleUpdateFromAPI(req)); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// DeleteRole deletes a role.
+func (impl *Implementation) DeleteRole(ctx context.Context, req *desc.DeleteRoleRequest) (*empty.Empty, error) {
+	if err := impl.roleService.Delete(ctx, req.GetName()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// toStatusError converts an error of the role service to a gRPC status error.
+func toStatusError(err error) error {
+	switch {
+	case errors.Is(err, role.ErrRoleNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, role.ErrRoleExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, role.ErrParentRoleNotFound), errors.Is(err, role.ErrRoleCycle):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, role.ErrRoleInUse), errors.Is(err, role.ErrBuiltinRole):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
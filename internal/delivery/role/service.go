@@ -0,0 +1,19 @@
+package role
+
+import (
+	"github.com/8thgencore/microservice-auth/internal/service"
+	desc "github.com/8thgencore/microservice-auth/pkg/pb/role/v1"
+)
+
+// Implementation structure describes API layer.
+type Implementation struct {
+	desc.UnimplementedRoleV1Server
+	roleService service.RoleService
+}
+
+// NewImplementation creates new object of API layer.
+func NewImplementation(roleService service.RoleService) *Implementation {
+	return &Implementation{
+		roleService: roleService,
+	}
+}
@@ -0,0 +1,296 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	roleAPI "github.com/8thgencore/microservice-auth/internal/delivery/role"
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
+	roleService "github.com/8thgencore/microservice-auth/internal/service/role"
+	rolev1 "github.com/8thgencore/microservice-auth/pkg/pb/role/v1"
+	userv1 "github.com/8thgencore/microservice-auth/pkg/pb/user/v1"
+)
+
+type roleServiceMockFunc func(mc *minimock.Controller) service.RoleService
+
+var (
+	name        = "SUPPORT"
+	description = "Support staff"
+	parents     = []string{"ADMIN"}
+	createdAt   = time.Date(2025, 5, 18, 12, 0, 0, 0, time.UTC)
+)
+
+func TestCreateRole(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		req = &rolev1.CreateRoleRequest{
+			Name:        name,
+			Description: description,
+			Parents:     parents,
+		}
+
+		role = &model.Role{
+			Name:        name,
+			Description: description,
+			Parents:     parents,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		err             error
+		roleServiceMock roleServiceMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			roleServiceMock: func(mc *minimock.Controller) service.RoleService {
+				mock := serviceMocks.NewRoleServiceMock(mc)
+				mock.CreateMock.Expect(ctx, role).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "role exists error case",
+			err:  status.Error(codes.AlreadyExists, roleService.ErrRoleExists.Error()),
+			roleServiceMock: func(mc *minimock.Controller) service.RoleService {
+				mock := serviceMocks.NewRoleServiceMock(mc)
+				mock.CreateMock.Expect(ctx, role).Return(roleService.ErrRoleExists)
+				return mock
+			},
+		},
+		{
+			name: "parent not found error case",
+			err:  status.Error(codes.InvalidArgument, roleService.ErrParentRoleNotFound.Error()),
+			roleServiceMock: func(mc *minimock.Controller) service.RoleService {
+				mock := serviceMocks.NewRoleServiceMock(mc)
+				mock.CreateMock.Expect(ctx, role).Return(roleService.ErrParentRoleNotFound)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := roleAPI.NewImplementation(tt.roleServiceMock(mc))
+
+			_, err := api.CreateRole(ctx, req)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestGetRole(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		role = &model.Role{
+			Name:        name,
+			Description: description,
+			Parents:     parents,
+			CreatedAt:   createdAt,
+			UpdatedAt:   sql.NullTime{},
+		}
+
+		res = &rolev1.GetRoleResponse{
+			Role: &rolev1.Role{
+				Name:        name,
+				Description: description,
+				Parents:     parents,
+				CreatedAt:   timestamppb.New(createdAt),
+			},
+		}
+	)
+
+	tests := []struct {
+		name            string
+		want            *rolev1.GetRoleResponse
+		err             error
+		roleServiceMock roleServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: res,
+			err:  nil,
+			roleServiceMock: func(mc *minimock.Controller) service.RoleService {
+				mock := serviceMocks.NewRoleServiceMock(mc)
+				mock.GetMock.Expect(ctx, name).Return(role, nil)
+				return mock
+			},
+		},
+		{
+			name: "role not found error case",
+			want: nil,
+			err:  status.Error(codes.NotFound, roleService.ErrRoleNotFound.Error()),
+			roleServiceMock: func(mc *minimock.Controller) service.RoleService {
+				mock := serviceMocks.NewRoleServiceMock(mc)
+				mock.GetMock.Expect(ctx, name).Return(nil, roleService.ErrRoleNotFound)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := roleAPI.NewImplementation(tt.roleServiceMock(mc))
+
+			res, err := api.GetRole(ctx, &rolev1.GetRoleRequest{Name: name})
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestUpdateRole(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		req = &rolev1.UpdateRoleRequest{
+			Name:        name,
+			Description: wrapperspb.String(description),
+			Parents:     &userv1.RoleNames{},
+		}
+
+		update = &model.RoleUpdate{
+			Name:        name,
+			Description: &description,
+			Parents:     new([]string),
+		}
+	)
+
+	tests := []struct {
+		name            string
+		err             error
+		roleServiceMock roleServiceMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			roleServiceMock: func(mc *minimock.Controller) service.RoleService {
+				mock := serviceMocks.NewRoleServiceMock(mc)
+				mock.UpdateMock.Set(func(_ context.Context, got *model.RoleUpdate) error {
+					require.Equal(mc, update.Name, got.Name)
+					require.Equal(mc, update.Description, got.Description)
+					require.Empty(mc, *got.Parents)
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "cycle error case",
+			err:  status.Error(codes.InvalidArgument, roleService.ErrRoleCycle.Error()),
+			roleServiceMock: func(mc *minimock.Controller) service.RoleService {
+				mock := serviceMocks.NewRoleServiceMock(mc)
+				mock.UpdateMock.Return(roleService.ErrRoleCycle)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			err:  status.Error(codes.Internal, roleService.ErrRoleUpdate.Error()),
+			roleServiceMock: func(mc *minimock.Controller) service.RoleService {
+				mock := serviceMocks.NewRoleServiceMock(mc)
+				mock.UpdateMock.Return(roleService.ErrRoleUpdate)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := roleAPI.NewImplementation(tt.roleServiceMock(mc))
+
+			_, err := api.UpdateRole(ctx, req)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestDeleteRole(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+	)
+
+	tests := []struct {
+		name            string
+		want            *empty.Empty
+		err             error
+		roleServiceMock roleServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: &empty.Empty{},
+			err:  nil,
+			roleServiceMock: func(mc *minimock.Controller) service.RoleService {
+				mock := serviceMocks.NewRoleServiceMock(mc)
+				mock.DeleteMock.Expect(ctx, name).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "role in use error case",
+			want: nil,
+			err:  status.Error(codes.FailedPrecondition, roleService.ErrRoleInUse.Error()),
+			roleServiceMock: func(mc *minimock.Controller) service.RoleService {
+				mock := serviceMocks.NewRoleServiceMock(mc)
+				mock.DeleteMock.Expect(ctx, name).Return(roleService.ErrRoleInUse)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			want: nil,
+			err:  status.Error(codes.Internal, errors.New("some error").Error()),
+			roleServiceMock: func(mc *minimock.Controller) service.RoleService {
+				mock := serviceMocks.NewRoleServiceMock(mc)
+				mock.DeleteMock.Expect(ctx, name).Return(errors.New("some error"))
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := roleAPI.NewImplementation(tt.roleServiceMock(mc))
+
+			res, err := api.DeleteRole(ctx, &rolev1.DeleteRoleRequest{Name: name})
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
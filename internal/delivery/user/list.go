@@ -0,0 +1,30 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/8thgencore/microservice-auth/internal/converter"
+	"github.com/8thgencore/microservice-auth/internal/service/user"
+	userv1 "github.com/8thgencore/microservice-auth/pkg/pb/user/v1"
+)
+
+// ListUsers returns a page of users matching a filter.
+func (impl *Implementation) ListUsers(
+	ctx context.Context,
+	req *userv1.ListUsersRequest,
+) (*userv1.ListUsersResponse, error) {
+	page, err := impl.userService.ListUsers(ctx, converter.ToUserListParamsFromAPI(req))
+	if err != nil {
+		if errors.Is(err, user.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return converter.ToListUsersResponseFromService(page), nil
+}
@@ -0,0 +1,59 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/8thgencore/microservice-auth/internal/service/user"
+	userv1 "github.com/8thgencore/microservice-auth/pkg/pb/user/v1"
+)
+
+// SuspendUser prevents a user from signing in until a time or until they are restored.
+func (impl *Implementation) SuspendUser(ctx context.Context, req *userv1.SuspendUserRequest) (*empty.Empty, error) {
+	var until *time.Time
+	if req.GetUntil() != nil {
+		t := req.GetUntil().AsTime()
+		until = &t
+	}
+
+	if err := impl.userService.Suspend(ctx, req.GetUserId(), until); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// RestoreUser makes a suspended, deactivated or deleted user active again.
+func (impl *Implementation) RestoreUser(ctx context.Context, req *userv1.RestoreUserRequest) (*empty.Empty, error) {
+	if err := impl.userService.Restore(ctx, req.GetUserId()); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// PurgeUser permanently deletes a deleted or deactivated user.
+func (impl *Implementation) PurgeUser(ctx context.Context, req *userv1.PurgeUserRequest) (*empty.Empty, error) {
+	if err := impl.userService.Purge(ctx, req.GetUserId()); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// statusError maps an error of changing the status of a user to a gRPC status.
+func statusError(err error) error {
+	switch {
+	case errors.Is(err, user.ErrUserNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, user.ErrUserDeleted), errors.Is(err, user.ErrUserNotDeleted):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	userAPI "github.com/8thgencore/microservice-auth/internal/delivery/user"
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
+	userService "github.com/8thgencore/microservice-auth/internal/service/user"
+	userv1 "github.com/8thgencore/microservice-auth/pkg/pb/user/v1"
+)
+
+func TestListUsers(t *testing.T) {
+	t.Parallel()
+
+	type userServiceMockFunc func(mc *minimock.Controller) service.UserService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		pageToken     = "page_token"
+		nextPageToken = "next_page_token"
+		emailVerified = true
+		createdAfter  = createdAt.AsTime()
+
+		req = &userv1.ListUsersRequest{
+			PageSize:      10,
+			PageToken:     pageToken,
+			Role:          role,
+			NamePrefix:    "na",
+			CreatedAfter:  createdAt,
+			EmailVerified: wrapperspb.Bool(true),
+			OrderBy:       userv1.UserOrderBy_USER_ORDER_BY_NAME,
+			Descending:    true,
+		}
+
+		params = &model.UserListParams{
+			Filter: model.UserFilter{
+				Role:          roleName,
+				NamePrefix:    "na",
+				CreatedAfter:  &createdAfter,
+				EmailVerified: &emailVerified,
+			},
+			OrderBy:    model.UserOrderByName,
+			Descending: true,
+			PageSize:   10,
+			PageToken:  pageToken,
+		}
+
+		page = &model.UserPage{
+			Users: []*model.User{{
+				ID:            id,
+				Name:          name,
+				Email:         email,
+				EmailVerified: true,
+				Roles:         []string{roleName},
+				CreatedAt:     createdAt.AsTime(),
+				UpdatedAt:     sql.NullTime{Time: updatedAt.AsTime(), Valid: true},
+			}},
+			NextPageToken: nextPageToken,
+			TotalSize:     11,
+		}
+
+		res = &userv1.ListUsersResponse{
+			Users: []*userv1.User{{
+				Id:            id,
+				Name:          name,
+				Email:         email,
+				EmailVerified: true,
+				Role:          role,
+				Roles:         []string{roleName},
+				Created:       createdAt,
+				Updated:       updatedAt,
+			}},
+			NextPageToken: nextPageToken,
+			TotalSize:     11,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		want            *userv1.ListUsersResponse
+		err             error
+		userServiceMock userServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: res,
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.ListUsersMock.Expect(ctx, params).Return(page, nil)
+				return mock
+			},
+		},
+		{
+			name: "invalid page token case",
+			want: nil,
+			err:  status.Error(codes.InvalidArgument, userService.ErrInvalidPageToken.Error()),
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.ListUsersMock.Expect(ctx, params).Return(nil, userService.ErrInvalidPageToken)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			want: nil,
+			err:  status.Error(codes.Internal, "service error"),
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.ListUsersMock.Expect(ctx, params).Return(nil, errors.New("service error"))
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := userAPI.NewImplementation(tt.userServiceMock(mc))
+
+			res, err := api.ListUsers(ctx, req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	userAPI "github.com/8thgencore/microservice-auth/internal/delivery/user"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
+	userService "github.com/8thgencore/microservice-auth/internal/service/user"
+	userv1 "github.com/8thgencore/microservice-auth/pkg/pb/user/v1"
+)
+
+func TestSuspendUser(t *testing.T) {
+	t.Parallel()
+
+	type userServiceMockFunc func(mc *minimock.Controller) service.UserService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		until = time.Now().Add(time.Hour).UTC()
+	)
+
+	tests := []struct {
+		name            string
+		req             *userv1.SuspendUserRequest
+		want            *empty.Empty
+		err             error
+		userServiceMock userServiceMockFunc
+	}{
+		{
+			name: "success case",
+			req:  &userv1.SuspendUserRequest{UserId: id, Until: timestamppb.New(until)},
+			want: &empty.Empty{},
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.SuspendMock.Expect(ctx, id, &until).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "without end case",
+			req:  &userv1.SuspendUserRequest{UserId: id},
+			want: &empty.Empty{},
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.SuspendMock.Expect(ctx, id, nil).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "deleted user case",
+			req:  &userv1.SuspendUserRequest{UserId: id},
+			want: nil,
+			err:  status.Error(codes.FailedPrecondition, userService.ErrUserDeleted.Error()),
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.SuspendMock.Expect(ctx, id, nil).Return(userService.ErrUserDeleted)
+				return mock
+			},
+		},
+		{
+			name: "user not found case",
+			req:  &userv1.SuspendUserRequest{UserId: id},
+			want: nil,
+			err:  status.Error(codes.NotFound, userService.ErrUserNotFound.Error()),
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.SuspendMock.Expect(ctx, id, nil).Return(userService.ErrUserNotFound)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := userAPI.NewImplementation(tt.userServiceMock(mc))
+
+			res, err := api.SuspendUser(ctx, tt.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestRestoreUser(t *testing.T) {
+	t.Parallel()
+
+	type userServiceMockFunc func(mc *minimock.Controller) service.UserService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		req = &userv1.RestoreUserRequest{UserId: id}
+	)
+
+	tests := []struct {
+		name            string
+		want            *empty.Empty
+		err             error
+		userServiceMock userServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: &empty.Empty{},
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.RestoreMock.Expect(ctx, id).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			want: nil,
+			err:  status.Error(codes.Internal, "service error"),
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.RestoreMock.Expect(ctx, id).Return(errors.New("service error"))
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := userAPI.NewImplementation(tt.userServiceMock(mc))
+
+			res, err := api.RestoreUser(ctx, req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestPurgeUser(t *testing.T) {
+	t.Parallel()
+
+	type userServiceMockFunc func(mc *minimock.Controller) service.UserService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		req = &userv1.PurgeUserRequest{UserId: id}
+	)
+
+	tests := []struct {
+		name            string
+		want            *empty.Empty
+		err             error
+		userServiceMock userServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: &empty.Empty{},
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.PurgeMock.Expect(ctx, id).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "user not deleted case",
+			want: nil,
+			err:  status.Error(codes.FailedPrecondition, userService.ErrUserNotDeleted.Error()),
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.PurgeMock.Expect(ctx, id).Return(userService.ErrUserNotDeleted)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := userAPI.NewImplementation(tt.userServiceMock(mc))
+
+			res, err := api.PurgeUser(ctx, req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
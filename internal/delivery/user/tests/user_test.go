@@ -10,6 +10,7 @@ import (
 	"github.com/8thgencore/microservice-auth/internal/model"
 	"github.com/8thgencore/microservice-auth/internal/service"
 	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
+	userService "github.com/8thgencore/microservice-auth/internal/service/user"
 	userv1 "github.com/8thgencore/microservice-auth/pkg/pb/user/v1"
 	"github.com/gojuno/minimock/v3"
 	"github.com/golang/protobuf/ptypes/empty"
@@ -63,7 +64,7 @@ func TestCreate(t *testing.T) {
 			Email:           email,
 			Password:        password,
 			PasswordConfirm: passwordConfirm,
-			Role:            roleName,
+			Roles:           []string{roleName},
 		}
 
 		res = &userv1.CreateResponse{
@@ -92,6 +93,20 @@ func TestCreate(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "unknown role error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  status.Error(codes.InvalidArgument, userService.ErrUnknownRole.Error()),
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.CreateMock.Expect(minimock.AnyContext, userCreate).Return("", userService.ErrUnknownRole)
+				return mock
+			},
+		},
 		{
 			name: "service error case",
 			args: args{
@@ -146,7 +161,7 @@ func TestGet(t *testing.T) {
 			ID:        id,
 			Name:      name,
 			Email:     email,
-			Role:      roleName,
+			Roles:     []string{roleName},
 			CreatedAt: createdAt.AsTime(),
 			UpdatedAt: sql.NullTime{
 				Time:  updatedAt.AsTime(),
@@ -160,6 +175,7 @@ func TestGet(t *testing.T) {
 				Name:    name,
 				Email:   email,
 				Role:    role,
+				Roles:   []string{roleName},
 				Created: createdAt,
 				Updated: updatedAt,
 			},
@@ -231,11 +247,10 @@ func TestUpdate(t *testing.T) {
 		ctx = context.Background()
 		mc  = minimock.NewController(t)
 
-		id       = "uuid"
-		name     = "name"
-		email    = "email"
-		role     = userv1.Role_USER
-		roleName = "USER"
+		id    = "uuid"
+		name  = "name"
+		email = "email"
+		roles = []string{"USER", "SUPPORT"}
 
 		serviceErr = errors.New("service error")
 
@@ -244,7 +259,7 @@ func TestUpdate(t *testing.T) {
 				Id:    id,
 				Name:  wrapperspb.String(name),
 				Email: wrapperspb.String(email),
-				Role:  role,
+				Roles: &userv1.RoleNames{Names: roles},
 			},
 		}
 
@@ -252,7 +267,7 @@ func TestUpdate(t *testing.T) {
 			ID:    id,
 			Name:  &name,
 			Email: &email,
-			Role:  &roleName,
+			Roles: &roles,
 		}
 
 		res = &empty.Empty{}
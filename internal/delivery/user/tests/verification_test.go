@@ -0,0 +1,146 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userAPI "github.com/8thgencore/microservice-auth/internal/delivery/user"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
+	userService "github.com/8thgencore/microservice-auth/internal/service/user"
+	userv1 "github.com/8thgencore/microservice-auth/pkg/pb/user/v1"
+)
+
+var verificationToken = "verification_token"
+
+func TestSendVerificationEmail(t *testing.T) {
+	t.Parallel()
+
+	type userServiceMockFunc func(mc *minimock.Controller) service.UserService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		req = &userv1.SendVerificationEmailRequest{Email: email}
+	)
+
+	tests := []struct {
+		name            string
+		want            *empty.Empty
+		err             error
+		userServiceMock userServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: &empty.Empty{},
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.SendVerificationEmailMock.Expect(ctx, email).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			want: nil,
+			err:  status.Error(codes.Internal, userService.ErrEmailVerificationFailed.Error()),
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.SendVerificationEmailMock.Expect(ctx, email).Return(userService.ErrEmailVerificationFailed)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := userAPI.NewImplementation(tt.userServiceMock(mc))
+
+			res, err := api.SendVerificationEmail(ctx, req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestVerifyEmail(t *testing.T) {
+	t.Parallel()
+
+	type userServiceMockFunc func(mc *minimock.Controller) service.UserService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		req = &userv1.VerifyEmailRequest{Token: verificationToken}
+	)
+
+	tests := []struct {
+		name            string
+		want            *empty.Empty
+		err             error
+		userServiceMock userServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: &empty.Empty{},
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.VerifyEmailMock.Expect(ctx, verificationToken).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "invalid token case",
+			want: nil,
+			err:  status.Error(codes.InvalidArgument, userService.ErrInvalidVerificationToken.Error()),
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.VerifyEmailMock.Expect(ctx, verificationToken).Return(userService.ErrInvalidVerificationToken)
+				return mock
+			},
+		},
+		{
+			name: "email taken case",
+			want: nil,
+			err:  status.Error(codes.AlreadyExists, userService.ErrUserEmailExists.Error()),
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.VerifyEmailMock.Expect(ctx, verificationToken).Return(userService.ErrUserEmailExists)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			want: nil,
+			err:  status.Error(codes.Internal, userService.ErrEmailVerificationFailed.Error()),
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.VerifyEmailMock.Expect(ctx, verificationToken).Return(userService.ErrEmailVerificationFailed)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := userAPI.NewImplementation(tt.userServiceMock(mc))
+
+			res, err := api.VerifyEmail(ctx, req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -22,6 +22,10 @@ func (impl *Implementation) Create(ctx context.Context, req *userv1.CreateReques
 
 	id, err := impl.userService.Create(ctx, converter.ToUserCreateFromAPI(req.GetUser()))
 	if err != nil {
+		if errors.Is(err, user.ErrUnknownRole) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -54,6 +58,10 @@ func (impl *Implementation) Update(ctx context.Context, req *userv1.UpdateReques
 
 	err := impl.userService.Update(ctx, converter.ToUserUpdateFromAPI(req.GetUser()))
 	if err != nil {
+		if errors.Is(err, user.ErrUnknownRole) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -142,7 +150,7 @@ func (impl *Implementation) DeleteMe(ctx context.Context, _ *empty.Empty) (*empt
 		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
 	}
 
-	err := impl.userService.Delete(ctx, userID)
+	err := impl.userService.Deactivate(ctx, userID)
 	if err != nil {
 		switch {
 		case errors.Is(err, user.ErrUserNotFound):
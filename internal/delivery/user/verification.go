@@ -0,0 +1,42 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/8thgencore/microservice-auth/internal/service/user"
+	userv1 "github.com/8thgencore/microservice-auth/pkg/pb/user/v1"
+)
+
+// SendVerificationEmail sends a new verification link to an email that is not verified yet.
+func (impl *Implementation) SendVerificationEmail(
+	ctx context.Context,
+	req *userv1.SendVerificationEmailRequest,
+) (*empty.Empty, error) {
+	if err := impl.userService.SendVerificationEmail(ctx, req.GetEmail()); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// VerifyEmail confirms an email with the token from a verification link.
+func (impl *Implementation) VerifyEmail(ctx context.Context, req *userv1.VerifyEmailRequest) (*empty.Empty, error) {
+	err := impl.userService.VerifyEmail(ctx, req.GetToken())
+	if err != nil {
+		switch {
+		case errors.Is(err, user.ErrInvalidVerificationToken):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, user.ErrUserEmailExists):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &empty.Empty{}, nil
+}
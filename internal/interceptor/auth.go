@@ -2,11 +2,15 @@ package interceptor
 
 import (
 	"context"
+	"slices"
 
+	"github.com/8thgencore/microservice-auth/internal/audit"
+	"github.com/8thgencore/microservice-auth/internal/delivery/auth"
 	"github.com/8thgencore/microservice-auth/internal/delivery/user"
+	"github.com/8thgencore/microservice-auth/internal/model"
 	"github.com/8thgencore/microservice-auth/internal/repository"
+	"github.com/8thgencore/microservice-auth/internal/service"
 	"github.com/8thgencore/microservice-auth/internal/tokens"
-	userv1 "github.com/8thgencore/microservice-auth/pkg/pb/user/v1"
 	"github.com/8thgencore/microservice-auth/pkg/utils"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -17,25 +21,65 @@ import (
 type Auth struct {
 	TokenOperations tokens.TokenOperations
 	TokenRepository repository.TokenRepository
+	UserRepository  repository.UserRepository
+	RoleService     service.RoleService
+	// ServiceKeys authenticate the trusted services calling the service endpoints.
+	ServiceKeys *tokens.ServiceKeys
 }
 
 // Map of endpoints that do not require authorization
 var publicEndpoints = map[string]struct{}{
-	"/auth_v1.AuthV1/Login":         {},
-	"/auth_v1.AuthV1/RefreshTokens": {},
-	"/auth_v1.AuthV1/Logout":        {},
+	"/auth_v1.AuthV1/Login":                 {},
+	"/auth_v1.AuthV1/RefreshTokens":         {},
+	"/auth_v1.AuthV1/Logout":                {},
+	"/auth_v1.AuthV1/VerifyMfa":             {},
+	"/auth_v1.AuthV1/BeginPasskeyLogin":     {},
+	"/auth_v1.AuthV1/FinishPasskeyLogin":    {},
+	"/auth_v1.AuthV1/RequestPasswordReset":  {},
+	"/auth_v1.AuthV1/ResetPassword":         {},
+	"/user_v1.UserV1/SendVerificationEmail": {},
+	"/user_v1.UserV1/VerifyEmail":           {},
+}
+
+// Map of endpoints that trusted services may call with a service key instead of an access token
+var serviceEndpoints = map[string]struct{}{
+	"/access_v1.AccessV1/Check": {},
 }
 
 // Map of endpoints that are only accessible by admins
 var adminEndpoints = map[string]struct{}{
-	"/user_v1.UserV1/Create":                 {},
-	"/user_v1.UserV1/Get":                    {},
-	"/user_v1.UserV1/Update":                 {},
-	"/user_v1.UserV1/Delete":                 {},
-	"/access_v1.AccessV1/AddRoleEndpoint":    {},
-	"/access_v1.AccessV1/UpdateRoleEndpoint": {},
-	"/access_v1.AccessV1/DeleteRoleEndpoint": {},
-	"/access_v1.AccessV1/GetRoleEndpoints":   {},
+	"/user_v1.UserV1/Create":                       {},
+	"/user_v1.UserV1/Get":                          {},
+	"/user_v1.UserV1/Update":                       {},
+	"/user_v1.UserV1/Delete":                       {},
+	"/access_v1.AccessV1/AddRoleEndpoint":          {},
+	"/access_v1.AccessV1/UpdateRoleEndpoint":       {},
+	"/access_v1.AccessV1/DeleteRoleEndpoint":       {},
+	"/access_v1.AccessV1/GetRoleEndpoints":         {},
+	"/access_v1.AccessV1/AddDenyRule":              {},
+	"/access_v1.AccessV1/DeleteDenyRule":           {},
+	"/access_v1.AccessV1/GetDenyRules":             {},
+	"/auth_v1.AuthV1/ListUserSessions":             {},
+	"/auth_v1.AuthV1/RevokeUserSession":            {},
+	"/auth_v1.AuthV1/RevokeAllUserSessions":        {},
+	"/auth_v1.AuthV1/ForceLogout":                  {},
+	"/auth_v1.AuthV1/UnlockUser":                   {},
+	"/user_v1.UserV1/ListUsers":                    {},
+	"/user_v1.UserV1/SuspendUser":                  {},
+	"/user_v1.UserV1/RestoreUser":                  {},
+	"/user_v1.UserV1/PurgeUser":                    {},
+	"/audit_v1.AuditV1/ListAuditEvents":            {},
+	"/audit_v1.AuditV1/VerifyAuditChain":           {},
+	"/role_v1.RoleV1/CreateRole":                   {},
+	"/role_v1.RoleV1/GetRole":                      {},
+	"/role_v1.RoleV1/ListRoles":                    {},
+	"/role_v1.RoleV1/UpdateRole":                   {},
+	"/role_v1.RoleV1/DeleteRole":                   {},
+	"/permission_v1.PermissionV1/CreatePermission": {},
+	"/permission_v1.PermissionV1/GetPermission":    {},
+	"/permission_v1.PermissionV1/ListPermissions":  {},
+	"/permission_v1.PermissionV1/UpdatePermission": {},
+	"/permission_v1.PermissionV1/DeletePermission": {},
 }
 
 // AuthInterceptor is used for authorization.
@@ -50,6 +94,18 @@ func (c *Auth) AuthInterceptor(
 		return handler(ctx, req)
 	}
 
+	// Trusted services authenticate with their key, the endpoint then checks what they may do
+	if _, exists := serviceEndpoints[info.FullMethod]; exists {
+		if key, err := utils.ExtractServiceKey(ctx); err == nil {
+			name, ok := c.ServiceKeys.Authenticate(key)
+			if !ok {
+				return nil, status.Errorf(codes.Unauthenticated, "invalid service key")
+			}
+
+			return handler(tokens.WithService(ctx, name), req)
+		}
+	}
+
 	// Extract token from context
 	token, err := utils.ExtractToken(ctx)
 	if err != nil {
@@ -62,7 +118,7 @@ func (c *Auth) AuthInterceptor(
 		return nil, status.Errorf(codes.Unauthenticated, "failed to verify token: %v", err)
 	}
 
-	version, err := c.TokenRepository.GetTokenVersion(ctx, claims.Subject)
+	version, err := tokens.CurrentVersion(ctx, c.TokenRepository, c.UserRepository, claims.Subject)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -72,13 +128,16 @@ func (c *Auth) AuthInterceptor(
 
 	// Checking whether the current method is in the list of admin endpoints
 	if _, exists := adminEndpoints[info.FullMethod]; exists {
-		if claims.Role != userv1.Role_name[int32(userv1.Role_ADMIN)] {
+		// Admins are the callers with the admin role or a role inheriting it
+		if !slices.Contains(c.RoleService.EffectiveRoles(claims.Roles), string(model.UserRoleAdmin)) {
 			return nil, status.Errorf(codes.PermissionDenied, "access denied: insufficient permissions")
 		}
 	}
 
-	// Create a new context with the user ID
+	// Create a new context with the user ID and the session ID, the user is the actor of audited changes
 	ctxWithUserID := context.WithValue(ctx, user.UserIDKey, claims.Subject)
+	ctxWithUserID = context.WithValue(ctxWithUserID, auth.SessionIDKey, claims.SessionID)
+	ctxWithUserID = audit.WithActor(ctxWithUserID, claims.Subject)
 
 	// Pass the updated context to the handler
 	return handler(ctxWithUserID, req)
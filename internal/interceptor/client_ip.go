@@ -0,0 +1,22 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/8thgencore/microservice-auth/pkg/utils"
+)
+
+// ClientIPInterceptorFactory resolves the client IP address of requests once for the throttles, rate limits,
+// audit events and conditions. The x-forwarded-for header is only trusted when set by one of the proxies.
+func ClientIPInterceptorFactory(proxies utils.TrustedProxies) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		return handler(utils.WithClientIP(ctx, proxies.ClientIP(ctx)), req)
+	}
+}
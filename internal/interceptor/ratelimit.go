@@ -0,0 +1,85 @@
+package interceptor
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/8thgencore/microservice-auth/internal/delivery/user"
+	"github.com/8thgencore/microservice-auth/internal/metrics"
+	"github.com/8thgencore/microservice-auth/internal/ratelimit"
+	"github.com/8thgencore/microservice-auth/pkg/utils"
+	"github.com/8thgencore/microservice-common/pkg/logger/sl"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// RateLimit is a struct that limits the calls to the gRPC methods.
+type RateLimit struct {
+	Limiter ratelimit.Limiter
+	Rules   []*ratelimit.Rule
+	Logger  *slog.Logger
+}
+
+// RateLimitInterceptor refuses calls that exceed a rule matching their method.
+// It must run after the auth interceptor, which puts the user ID into the context.
+func (r *RateLimit) RateLimitInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	for _, rule := range r.Rules {
+		if !rule.Matches(info.FullMethod) {
+			continue
+		}
+
+		subject, ok := rateLimitSubject(ctx, rule.Scope, info.FullMethod)
+		if !ok {
+			continue
+		}
+
+		allowed, retryAfter, err := r.Limiter.Allow(ctx, rule, subject)
+		if err != nil {
+			// An unavailable Redis must not take the service down with it.
+			r.Logger.Error("failed to check rate limit", slog.String("rule", rule.ID), sl.Err(err))
+			continue
+		}
+		if !allowed {
+			metrics.IncRateLimitedCounter(info.FullMethod, rule.Scope)
+			return nil, rateLimitStatus(retryAfter)
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+// rateLimitSubject returns whose calls are counted in the scope.
+// Calls without a user, like the public endpoints, are not limited per user.
+func rateLimitSubject(ctx context.Context, scope, method string) (string, bool) {
+	switch scope {
+	case ratelimit.ScopeUser:
+		userID, ok := ctx.Value(user.UserIDKey).(string)
+		return userID, ok && userID != ""
+	case ratelimit.ScopeIP:
+		ip := utils.ExtractClientIP(ctx)
+		return ip, ip != ""
+	default:
+		return method, true
+	}
+}
+
+// rateLimitStatus returns the status of a refused call that tells when to retry.
+func rateLimitStatus(retryAfter time.Duration) error {
+	st := status.New(codes.ResourceExhausted, "rate limit exceeded")
+
+	withDetails, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+	if err != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
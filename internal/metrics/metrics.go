@@ -18,6 +18,7 @@ type Metrics struct {
 	requestCounter        prometheus.Counter
 	responseCounter       *prometheus.CounterVec
 	histogramResponseTime *prometheus.HistogramVec
+	rateLimitedCounter    *prometheus.CounterVec
 }
 
 var metrics *Metrics
@@ -52,6 +53,15 @@ func Init(_ context.Context) error {
 			},
 			[]string{"status"},
 		),
+		rateLimitedCounter: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      appName + "_rate_limited_total",
+				Help:      "Number of requests refused by a rate limit",
+			},
+			[]string{"method", "scope"},
+		),
 	}
 
 	return nil
@@ -71,3 +81,12 @@ func IncResponseCounter(status string, method string) {
 func HistogramResponseTimeObserve(status string, time float64) {
 	metrics.histogramResponseTime.WithLabelValues(status).Observe(time)
 }
+
+// IncRateLimitedCounter increases number of requests refused by a rate limit of the scope.
+// Metrics are only collected in production, elsewhere it does nothing.
+func IncRateLimitedCounter(method string, scope string) {
+	if metrics == nil {
+		return
+	}
+	metrics.rateLimitedCounter.WithLabelValues(method, scope).Inc()
+}
@@ -1,7 +1,82 @@
 package model
 
+import "time"
+
 // EndpointPermissions type is the structure for endpoint permissions by roles.
 type EndpointPermissions struct {
 	Endpoint string
 	Roles    []string
+	// Condition is a CEL expression which must evaluate to true for access to be granted, empty for none.
+	Condition string
+}
+
+// DenyRule type is the structure for a rule denying access to an endpoint, which overrides the policies
+// allowing it. A rule without roles and users denies everyone but the callers with an excepted role.
+type DenyRule struct {
+	Name string
+	// Endpoint is the endpoint or the pattern of endpoints the rule denies access to.
+	Endpoint string
+	// Roles are the denied roles, the roles inheriting them are denied too.
+	Roles []string
+	// UserIDs are the IDs of the denied users.
+	UserIDs []string
+	// ExceptRoles are the roles never denied by the rule, the roles inheriting them are not denied either.
+	ExceptRoles []string
+	Reason      string
+	CreatedAt   time.Time
+}
+
+// AccessCheck type is the structure for a check of access to an endpoint.
+type AccessCheck struct {
+	Endpoint string
+	// Attributes are passed to the condition of the policy of the endpoint.
+	Attributes map[string]string
+}
+
+// AccessPrincipal type is the structure for the user whose access a trusted service checks, identified by
+// either an access token or a user ID and roles.
+type AccessPrincipal struct {
+	AccessToken string
+	UserID      string
+	Roles       []string
+}
+
+// AccessDecisionReason type is the type for why access to an endpoint was granted or denied.
+type AccessDecisionReason string
+
+// AccessDecisionReason constants
+const (
+	// AccessReasonRoleAllowed means that a role of the user is allowed by the policy of the endpoint.
+	AccessReasonRoleAllowed AccessDecisionReason = "ROLE_ALLOWED"
+	// AccessReasonPermissionGranted means that the user holds a permission granting access to the endpoint.
+	AccessReasonPermissionGranted AccessDecisionReason = "PERMISSION_GRANTED"
+	// AccessReasonDenyRule means that a deny rule denies the user access to the endpoint.
+	AccessReasonDenyRule AccessDecisionReason = "DENY_RULE"
+	// AccessReasonNoPolicy means that neither a policy nor a permission gives access to the endpoint.
+	AccessReasonNoPolicy AccessDecisionReason = "NO_POLICY"
+	// AccessReasonNotAllowed means that no role or permission of the user gives access to the endpoint.
+	AccessReasonNotAllowed AccessDecisionReason = "NOT_ALLOWED"
+	// AccessReasonConditionFailed means that the condition of the policy of the endpoint does not hold.
+	AccessReasonConditionFailed AccessDecisionReason = "CONDITION_FAILED"
+	// AccessReasonUserInactive means that the user is suspended, deactivated or deleted.
+	AccessReasonUserInactive AccessDecisionReason = "USER_INACTIVE"
+)
+
+// AccessDecision type is the structure for the decision on access to an endpoint and how it was made.
+type AccessDecision struct {
+	Endpoint string
+	Allowed  bool
+	Reason   AccessDecisionReason
+	// Policy is the endpoint or the pattern of the policy applied to the endpoint, empty when none matches it.
+	Policy string
+	// AllowedRoles are the roles allowed by the policy.
+	AllowedRoles []string
+	// Condition is the condition of the policy, empty for none.
+	Condition string
+	// Permissions are the permissions granting access to the endpoint.
+	Permissions []string
+	// Roles are the roles of the user and the roles they inherit.
+	Roles []string
+	// DenyRule is the name of the deny rule denying access, empty when none does.
+	DenyRule string
 }
@@ -0,0 +1,165 @@
+package model
+
+import "time"
+
+// AuditAction type is the type for the kind of an audited action.
+type AuditAction string
+
+// AuditAction constants
+const (
+	AuditActionUserCreated           AuditAction = "user.created"
+	AuditActionUserRead              AuditAction = "user.read"
+	AuditActionUserUpdated           AuditAction = "user.updated"
+	AuditActionUserDeleted           AuditAction = "user.deleted"
+	AuditActionUserDeactivated       AuditAction = "user.deactivated"
+	AuditActionUserSuspended         AuditAction = "user.suspended"
+	AuditActionUserRestored          AuditAction = "user.restored"
+	AuditActionUserPurged            AuditAction = "user.purged"
+	AuditActionPasswordChanged       AuditAction = "user.password_changed"
+	AuditActionEmailVerified         AuditAction = "user.email_verified"
+	AuditActionLogin                 AuditAction = "auth.login"
+	AuditActionLoginFailed           AuditAction = "auth.login_failed"
+	AuditActionLoginUnlocked         AuditAction = "auth.login_unlocked"
+	AuditActionLogoutAll             AuditAction = "auth.logout_all"
+	AuditActionRefreshTokenReused    AuditAction = "auth.refresh_token_reused"
+	AuditActionPasswordReset         AuditAction = "auth.password_reset"
+	AuditActionMfaEnabled            AuditAction = "auth.mfa_enabled"
+	AuditActionMfaDisabled           AuditAction = "auth.mfa_disabled"
+	AuditActionPasskeyRegistered     AuditAction = "auth.passkey_registered"
+	AuditActionEndpointPolicyAdded   AuditAction = "access.policy_added"
+	AuditActionEndpointPolicyUpdated AuditAction = "access.policy_updated"
+	AuditActionEndpointPolicyDeleted AuditAction = "access.policy_deleted"
+	AuditActionDenyRuleAdded         AuditAction = "access.deny_rule_added"
+	AuditActionDenyRuleDeleted       AuditAction = "access.deny_rule_deleted"
+	AuditActionRoleCreated           AuditAction = "role.created"
+	AuditActionRoleUpdated           AuditAction = "role.updated"
+	AuditActionRoleDeleted           AuditAction = "role.deleted"
+	AuditActionPermissionCreated     AuditAction = "permission.created"
+	AuditActionPermissionUpdated     AuditAction = "permission.updated"
+	AuditActionPermissionDeleted     AuditAction = "permission.deleted"
+	// AuditActionLegacy marks the free-text entries of the former transaction log.
+	AuditActionLegacy AuditAction = "legacy"
+)
+
+// AuditTargetType type is the type for the kind of object an audited action is performed on.
+type AuditTargetType string
+
+// AuditTargetType constants
+const (
+	AuditTargetUser       AuditTargetType = "user"
+	AuditTargetEndpoint   AuditTargetType = "endpoint"
+	AuditTargetRole       AuditTargetType = "role"
+	AuditTargetPermission AuditTargetType = "permission"
+	AuditTargetDenyRule   AuditTargetType = "deny_rule"
+)
+
+// AuditTarget is the object an audited action is performed on.
+type AuditTarget struct {
+	Type AuditTargetType
+	ID   string
+}
+
+// AuditChange is the change of a field. Secrets are recorded as changed without their values.
+type AuditChange struct {
+	Old any `json:"old,omitempty"`
+	New any `json:"new,omitempty"`
+}
+
+// AuditEvent type is the main structure for audit log record.
+type AuditEvent struct {
+	ID         string
+	OccurredAt time.Time
+	// ActorID is the ID of the user who performed the action, empty for the system and anonymous callers.
+	ActorID string
+	Action  AuditAction
+	Target  AuditTarget
+	// Changes are the changed fields of the target by name.
+	Changes   map[string]AuditChange
+	Metadata  map[string]string
+	IPAddress string
+	UserAgent string
+	TraceID   string
+	// Seq is the position of the event in the hash chain of the audit log, 0 until the event is sealed.
+	Seq int64
+	// PrevHash is the hash of the previous event, empty for the first chained event.
+	PrevHash string
+	// Hash is the hash of the content of the event and PrevHash, empty for the events
+	// recorded before the audit log was chained.
+	Hash string
+}
+
+// AuditFilter restricts the listed audit events. Empty fields do not restrict.
+type AuditFilter struct {
+	ActorID string
+	// Target restricts the events to a kind of object, or to a single object when its ID is set.
+	Target AuditTarget
+	Action AuditAction
+	From   *time.Time
+	To     *time.Time
+}
+
+// AuditListParams represents a request for a page of audit events.
+type AuditListParams struct {
+	Filter   AuditFilter
+	PageSize int
+	// PageToken continues a previous listing with the same filter.
+	PageToken string
+}
+
+// AuditCursor is the position of the last listed event: older events come next.
+type AuditCursor struct {
+	OccurredAt time.Time
+	ID         string
+}
+
+// AuditListQuery represents a query of audit events for the repository.
+type AuditListQuery struct {
+	Filter AuditFilter
+	Before *AuditCursor
+	Limit  int
+}
+
+// AuditPage is a page of listed audit events, newest first.
+type AuditPage struct {
+	Events []*AuditEvent
+	// NextPageToken continues the listing, it is empty on the last page.
+	NextPageToken string
+}
+
+// AuditChainBreakReason type is the type for the kind of a broken link of the audit hash chain.
+type AuditChainBreakReason string
+
+// AuditChainBreakReason constants
+const (
+	// AuditChainBreakSequenceGap is found where events are removed.
+	AuditChainBreakSequenceGap AuditChainBreakReason = "SEQUENCE_GAP"
+	// AuditChainBreakPrevHashMismatch is found where events are removed, inserted or reordered.
+	AuditChainBreakPrevHashMismatch AuditChainBreakReason = "PREV_HASH_MISMATCH"
+	// AuditChainBreakHashMismatch is found where the content of an event is altered.
+	AuditChainBreakHashMismatch AuditChainBreakReason = "HASH_MISMATCH"
+	// AuditChainBreakUnchained is found where an event without a hash is not a legacy event: it follows chained
+	// events or it has the hash of a previous event.
+	AuditChainBreakUnchained AuditChainBreakReason = "UNCHAINED"
+)
+
+// AuditChainBreak is the first broken link of the audit hash chain.
+type AuditChainBreak struct {
+	Seq     int64
+	EventID string
+	Reason  AuditChainBreakReason
+}
+
+// AuditChainVerification is the result of walking the audit hash chain.
+type AuditChainVerification struct {
+	// Verified is false when a link of the chain is broken.
+	Verified bool
+	// Checked is the number of events walked up to the head or the broken link.
+	Checked int64
+	// Unchained is the number of events recorded before the audit log was chained.
+	Unchained int64
+	// HeadSeq and HeadHash are the last verified link, to be compared with a copy kept
+	// outside the database, since a chain can be rebuilt by anyone able to rewrite it.
+	HeadSeq  int64
+	HeadHash string
+	Break    *AuditChainBreak
+}
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"database/sql"
+	"time"
+)
+
 // UserCreds type is the structure for user sign in.
 type UserCreds struct {
 	Username string
@@ -8,11 +13,14 @@ type UserCreds struct {
 
 // AuthInfo type is the structure for user authentication data from storage.
 type AuthInfo struct {
-	ID       string
-	Username string
-	Password string
-	Role     string
-	Version  int
+	ID             string
+	Username       string
+	Password       string
+	Roles          []string
+	Version        int
+	EmailVerified  bool
+	Status         UserStatus
+	SuspendedUntil sql.NullTime
 }
 
 // TokenPair type is the structure for storing access and refresh tokens.
@@ -20,3 +28,16 @@ type TokenPair struct {
 	AccessToken  string
 	RefreshToken string
 }
+
+// LoginResult is the result of a login: either a token pair, or the MFA challenge token
+// when the user has to present a second factor.
+type LoginResult struct {
+	Tokens   *TokenPair
+	MfaToken string
+}
+
+// LoginFailures is the count of the failed logins of a username or a client IP.
+type LoginFailures struct {
+	Count  int
+	LastAt time.Time
+}
@@ -1,16 +1,55 @@
 package model
 
-import jwt "github.com/golang-jwt/jwt/v5"
+import (
+	"encoding/json"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+)
 
 // UserClaims is custom wrapper for jwt claims.
 type UserClaims struct {
 	jwt.RegisteredClaims
 	Username string `json:"username"`
-	Role     string `json:"role"`
-	Version  int    `json:"ver"`
+	// Roles are the roles held by the user, the roles they inherit are resolved when access is checked.
+	Roles ClaimRoles `json:"role"`
+	// Permissions are the permissions held through the roles when the token was issued, if embedded.
+	// They let other services check permissions offline, the access service resolves them on every check.
+	Permissions []string `json:"permissions,omitempty"`
+	Version     int      `json:"ver"`
+	// SessionID is the token family of the refresh token the access token was issued with.
+	SessionID string `json:"sid,omitempty"`
+}
+
+// ClaimRoles are the roles of the "role" claim. Tokens issued while users held a single role
+// carry it as a string, which is read as a list of one role.
+type ClaimRoles []string
+
+// UnmarshalJSON reads a list of roles or a single role.
+func (r *ClaimRoles) UnmarshalJSON(data []byte) error {
+	var roles []string
+	if err := json.Unmarshal(data, &roles); err == nil {
+		*r = roles
+
+		return nil
+	}
+
+	var role string
+	if err := json.Unmarshal(data, &role); err != nil {
+		return err
+	}
+	*r = ClaimRoles{role}
+
+	return nil
 }
 
 // RefreshClaims - a data structure containing the minimum data for the refresh token.
+// The token ID is carried in the "jti" claim and the family it was rotated within in "fid".
 type RefreshClaims struct {
 	jwt.RegisteredClaims
+	FamilyID string `json:"fid,omitempty"`
+}
+
+// MfaClaims are the claims of the challenge token handed out by a login that awaits the second factor.
+type MfaClaims struct {
+	jwt.RegisteredClaims
 }
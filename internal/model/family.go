@@ -0,0 +1,26 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// TokenFamily type is the chain of refresh tokens issued from a single login, i.e. a user session.
+// Only the refresh token with the current token ID may be rotated.
+type TokenFamily struct {
+	ID              string
+	UserID          string
+	CurrentTokenID  string
+	IPAddress       string
+	UserAgent       string
+	LastRefreshedAt sql.NullTime
+	RevokedAt       sql.NullTime
+	CreatedAt       time.Time
+	UpdatedAt       sql.NullTime
+}
+
+// ClientInfo type is the structure for the client a session is used from.
+type ClientInfo struct {
+	IPAddress string
+	UserAgent string
+}
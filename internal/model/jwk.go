@@ -0,0 +1,21 @@
+package model
+
+// JWK type is the JSON Web Key representation of a public token verification key (RFC 7517).
+type JWK struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use,omitempty"`
+	Algorithm string `json:"alg,omitempty"`
+	KeyID     string `json:"kid,omitempty"`
+	// RSA public key parameters.
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// EC and OKP public key parameters.
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+	Y     string `json:"y,omitempty"`
+}
+
+// JWKSet type is the set of public keys published by the service.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
@@ -1,7 +0,0 @@
-package model
-
-// Log type is the main structure for transaction log record.
-type Log struct {
-	ID   string
-	Text string
-}
@@ -0,0 +1,23 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Totp is the TOTP second factor of a user. It only takes effect once confirmed.
+type Totp struct {
+	UserID      string
+	Secret      string
+	ConfirmedAt sql.NullTime
+	// LastUsedStep is the time step of the last accepted code, a code is never accepted twice.
+	LastUsedStep int64
+	CreatedAt    time.Time
+	UpdatedAt    sql.NullTime
+}
+
+// TotpEnrollment is the secret of a TOTP enrollment to be added to an authenticator app.
+type TotpEnrollment struct {
+	Secret string
+	URI    string
+}
@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// Notification is a message waiting in the outbox to be sent.
+type Notification struct {
+	ID        string
+	Recipient string
+	Subject   string
+	Body      string
+	Attempts  int
+	CreatedAt time.Time
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Passkey is a WebAuthn credential a user can log in with instead of a password.
+type Passkey struct {
+	ID              []byte
+	UserID          string
+	PublicKey       []byte
+	AttestationType string
+	Transports      []string
+	AAGUID          []byte
+	// SignCount is the signature counter of the authenticator, a cloned authenticator falls behind it.
+	SignCount      uint32
+	BackupEligible bool
+	BackupState    bool
+	CreatedAt      time.Time
+	LastUsedAt     sql.NullTime
+}
+
+// PasskeyCeremony is the state of a passkey registration or login kept between its two steps.
+type PasskeyCeremony struct {
+	// UserID is the user registering a passkey, it is empty for a login.
+	UserID string `json:"user_id,omitempty"`
+	// Session is the WebAuthn session data the response of the authenticator is verified against.
+	Session []byte `json:"session"`
+}
+
+// PasskeyChallenge is the first step of a passkey ceremony to be passed to the authenticator.
+type PasskeyChallenge struct {
+	// CeremonyID identifies the ceremony in its second step.
+	CeremonyID string
+	// Options are the JSON encoded options of navigator.credentials.create or navigator.credentials.get.
+	Options []byte
+}
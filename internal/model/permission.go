@@ -0,0 +1,25 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Permission type is the main structure for permission. A permission such as "users:read" is granted
+// to roles and grants access to the endpoints it is mapped to.
+type Permission struct {
+	Name        string
+	Description string
+	Endpoints   []string
+	Roles       []string
+	CreatedAt   time.Time
+	UpdatedAt   sql.NullTime
+}
+
+// PermissionUpdate represents the data for updating a permission.
+type PermissionUpdate struct {
+	Name        string
+	Description *string   // Optional field
+	Endpoints   *[]string // Optional field
+	Roles       *[]string // Optional field
+}
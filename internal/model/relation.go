@@ -0,0 +1,46 @@
+package model
+
+// RelationTuple type is the structure for a relation of a subject to an object, such as
+// chat:42#member@user:7, or chat:42#member@group:eng#member for the members of a group.
+type RelationTuple struct {
+	Object   RelationObject
+	Relation string
+	Subject  RelationSubject
+}
+
+// RelationObject type is the structure for an object of a namespace, such as chat:42.
+type RelationObject struct {
+	Namespace string
+	ID        string
+}
+
+// RelationSubject type is the structure for the subject of a relation tuple: an object such as user:7,
+// or the userset of the subjects having a relation to an object such as group:eng#member.
+type RelationSubject struct {
+	Namespace string
+	ID        string
+	// Relation is the relation of the userset, empty for an object.
+	Relation string
+}
+
+// RelationObjectsParams represents a request for a page of the objects of a namespace a subject has
+// a relation to.
+type RelationObjectsParams struct {
+	Namespace string
+	Relation  string
+	Subject   RelationSubject
+	// ConsistencyToken makes the listing see at least the writes up to it.
+	ConsistencyToken string
+	PageSize         int
+	// PageToken continues a previous listing of the same objects.
+	PageToken string
+}
+
+// RelationObjectsPage is a page of the IDs of listed objects, ordered.
+type RelationObjectsPage struct {
+	IDs []string
+	// NextPageToken continues the listing, it is empty on the last page.
+	NextPageToken string
+	// ConsistencyToken is the token of the tuples the listing saw.
+	ConsistencyToken string
+}
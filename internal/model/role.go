@@ -0,0 +1,22 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Role type is the main structure for role. A role inherits everything allowed to its parents.
+type Role struct {
+	Name        string
+	Description string
+	Parents     []string
+	CreatedAt   time.Time
+	UpdatedAt   sql.NullTime
+}
+
+// RoleUpdate represents the data for updating a role.
+type RoleUpdate struct {
+	Name        string
+	Description *string   // Optional field
+	Parents     *[]string // Optional field
+}
@@ -5,7 +5,7 @@ import (
 	"time"
 )
 
-// UserRole type is the type for user role.
+// UserRole type is the type for the names of the built-in roles.
 type UserRole string
 
 // UserRole constants
@@ -14,33 +14,143 @@ const (
 	UserRoleAdmin UserRole = "ADMIN"
 )
 
+// UserStatus type is the type for the status of a user account.
+type UserStatus string
+
+// UserStatus constants
+const (
+	UserStatusActive UserStatus = "ACTIVE"
+	// UserStatusSuspended is set by an admin, until a time or until the user is restored.
+	UserStatusSuspended UserStatus = "SUSPENDED"
+	// UserStatusDeactivated is set when users delete their own account.
+	UserStatusDeactivated UserStatus = "DEACTIVATED"
+	// UserStatusDeleted is set when an admin deletes a user.
+	UserStatusDeleted UserStatus = "DELETED"
+)
+
+// IsActive reports whether an account with the status may be used, suspendedUntil is the end of a suspension.
+// A suspension past its end no longer applies.
+func (s UserStatus) IsActive(suspendedUntil sql.NullTime) bool {
+	switch s {
+	case UserStatusSuspended:
+		return suspendedUntil.Valid && time.Now().After(suspendedUntil.Time)
+	case UserStatusDeactivated, UserStatusDeleted:
+		return false
+	default:
+		return true
+	}
+}
+
 // User type is the main structure for user.
 type User struct {
-	ID        string
-	Name      string
-	Email     string
-	Password  string
-	Role      string
-	Version   int
+	ID            string
+	Name          string
+	Email         string
+	EmailVerified bool
+	// PendingEmail is the new email of the user until it is verified.
+	PendingEmail sql.NullString
+	Password     string
+	Roles        []string
+	// Permissions are the permissions held through the roles, resolved when an access token is issued.
+	Permissions []string
+	Version     int
+	Status      UserStatus
+	// SuspendedUntil is the end of a suspension, a suspension without an end lasts until the user is restored.
+	SuspendedUntil sql.NullTime
+	// DeletedAt is when the user was deleted or deactivated, the user is purged after the retention period.
+	DeletedAt sql.NullTime
 	CreatedAt time.Time
 	UpdatedAt sql.NullTime
 }
 
+// IsActive reports whether the user may sign in and access endpoints.
+func (u *User) IsActive() bool {
+	return u.Status.IsActive(u.SuspendedUntil)
+}
+
+// UserStatusChange represents a change of the status of a user.
+type UserStatusChange struct {
+	Status         UserStatus
+	SuspendedUntil sql.NullTime
+	DeletedAt      sql.NullTime
+}
+
 // UserCreate type is the structure for creating user.
 type UserCreate struct {
 	ID              string
 	Name            string
 	Email           string
+	EmailVerified   bool
 	Password        string
 	PasswordConfirm string
-	Role            string
+	Roles           []string
 }
 
 // UserUpdate represents the data for updating a user
 type UserUpdate struct {
-	ID      string
-	Name    *string // Optional field
-	Email   *string // Optional field
-	Role    *string // Optional field
-	Version *int32  // Optional field
+	ID           string
+	Name         *string   // Optional field
+	Email        *string   // Optional field
+	PendingEmail *string   // Optional field
+	Roles        *[]string // Optional field
+	Version      *int32    // Optional field
+}
+
+// UserOrderBy is the field users are listed by.
+type UserOrderBy string
+
+// UserOrderBy constants
+const (
+	// UserOrderByID lists users by ID, which is the order they were created in for UUIDv7 IDs.
+	UserOrderByID        UserOrderBy = "id"
+	UserOrderByName      UserOrderBy = "name"
+	UserOrderByEmail     UserOrderBy = "email"
+	UserOrderByCreatedAt UserOrderBy = "created_at"
+)
+
+// UserFilter restricts the listed users. Empty fields do not restrict.
+type UserFilter struct {
+	// Role restricts the users to the holders of the role, not counting the roles inheriting it.
+	Role          string
+	NamePrefix    string
+	EmailPrefix   string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	EmailVerified *bool
+	Status        UserStatus
+}
+
+// UserListParams represents a request for a page of users.
+type UserListParams struct {
+	Filter     UserFilter
+	OrderBy    UserOrderBy
+	Descending bool
+	PageSize   int
+	// PageToken continues a previous listing with the same filter and order.
+	PageToken string
+}
+
+// UserCursor is the position of the last listed user: users after it by the order come next.
+type UserCursor struct {
+	// Value is the value of the ordering field of the user, ID-ordered listings only use the ID.
+	Value string
+	ID    string
+}
+
+// UserListQuery represents a query of users for the repository.
+type UserListQuery struct {
+	Filter     UserFilter
+	OrderBy    UserOrderBy
+	Descending bool
+	After      *UserCursor
+	Limit      int
+}
+
+// UserPage is a page of listed users.
+type UserPage struct {
+	Users []*User
+	// NextPageToken continues the listing, it is empty on the last page.
+	NextPageToken string
+	// TotalSize is an estimate of the number of users matching the filter.
+	TotalSize int64
 }
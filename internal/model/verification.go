@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// EmailVerification is a pending verification of an email of a user.
+type EmailVerification struct {
+	UserID    string
+	Email     string
+	TokenHash string
+	ExpiresAt time.Time
+}
@@ -0,0 +1,4 @@
+package notifier
+
+//go:generate sh -c "rm -rf mocks && mkdir -p mocks"
+//go:generate ./../../bin/minimock -g -i Sender -o ./mocks/ -s "_minimock.go"
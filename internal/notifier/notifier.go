@@ -0,0 +1,39 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/8thgencore/microservice-auth/internal/config"
+)
+
+// Senders that can be chosen in the configuration.
+const (
+	SMTP = "smtp"
+	File = "file"
+)
+
+// Message is an outbound message to a user.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Sender is the interface for delivering messages to users.
+type Sender interface {
+	// Send delivers the message. A failed delivery may be retried with the same message.
+	Send(ctx context.Context, msg *Message) error
+}
+
+// NewSender creates the sender chosen in the configuration.
+func NewSender(cfg *config.NotifierConfig) (Sender, error) {
+	switch cfg.Sender {
+	case SMTP:
+		return NewSMTPSender(&cfg.SMTP), nil
+	case File:
+		return NewFileSender(cfg.FilePath), nil
+	default:
+		return nil, fmt.Errorf("unknown notification sender %q", cfg.Sender)
+	}
+}
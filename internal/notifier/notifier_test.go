@@ -0,0 +1,84 @@
+package notifier
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/8thgencore/microservice-auth/internal/config"
+)
+
+var resetData = PasswordResetData{
+	Name:             "username",
+	Link:             "http://localhost/reset-password?token=reset_token",
+	ExpiresInMinutes: 30,
+}
+
+func TestTemplatesRender(t *testing.T) {
+	t.Parallel()
+
+	templates, err := NewTemplates("en")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		locale  string
+		subject string
+	}{
+		{name: "default locale", locale: "", subject: "Reset your password"},
+		{name: "exact locale", locale: "ru", subject: "Сброс пароля"},
+		{name: "regional locale", locale: "ru-RU", subject: "Сброс пароля"},
+		{name: "unknown locale", locale: "de", subject: "Reset your password"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			subject, body, err := templates.Render(PasswordResetTemplate, tt.locale, resetData)
+			require.NoError(t, err)
+			require.Equal(t, tt.subject, subject)
+			require.Contains(t, body, resetData.Name)
+			require.Contains(t, body, resetData.Link)
+		})
+	}
+
+	t.Run("unknown template", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := templates.Render("unknown", "en", resetData)
+		require.ErrorIs(t, err, ErrUnknownTemplate)
+	})
+}
+
+func TestNewSender(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewSender(&config.NotifierConfig{Sender: "pigeon"})
+	require.Error(t, err)
+
+	sender, err := NewSender(&config.NotifierConfig{Sender: SMTP})
+	require.NoError(t, err)
+	require.IsType(t, &smtpSender{}, sender)
+}
+
+func TestFileSender(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "mail.log")
+	sender, err := NewSender(&config.NotifierConfig{Sender: File, FilePath: path})
+	require.NoError(t, err)
+
+	for _, subject := range []string{"first", "second"} {
+		err = sender.Send(context.Background(), &Message{To: "user@example.com", Subject: subject, Body: "body"})
+		require.NoError(t, err)
+	}
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "To: user@example.com\nSubject: first\n\nbody\n")
+	require.Contains(t, string(data), "Subject: second")
+}
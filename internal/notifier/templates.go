@@ -0,0 +1,112 @@
+package notifier
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+	"text/template"
+)
+
+// Templates of the messages.
+const (
+	PasswordResetTemplate     = "password_reset"
+	EmailVerificationTemplate = "email_verification"
+)
+
+// PasswordResetData is the data of the password reset message.
+type PasswordResetData struct {
+	Name             string
+	Link             string
+	ExpiresInMinutes int
+}
+
+// EmailVerificationData is the data of the email verification message.
+type EmailVerificationData struct {
+	Name           string
+	Link           string
+	ExpiresInHours int
+}
+
+// ErrUnknownTemplate is returned when there is no template with the name.
+var ErrUnknownTemplate = errors.New("unknown notification template")
+
+//go:embed templates
+var templateFS embed.FS
+
+// Templates renders messages from the templates in templates/<locale>/<name>.tmpl.
+// A template defines a "subject" and a "body".
+type Templates struct {
+	defaultLocale string
+	templates     map[string]*template.Template
+}
+
+// NewTemplates parses the embedded templates.
+func NewTemplates(defaultLocale string) (*Templates, error) {
+	t := &Templates{
+		defaultLocale: defaultLocale,
+		templates:     make(map[string]*template.Template),
+	}
+
+	files, err := fs.Glob(templateFS, "templates/*/*.tmpl")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		tmpl, err := template.ParseFS(templateFS, file)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range []string{"subject", "body"} {
+			if tmpl.Lookup(name) == nil {
+				return nil, fmt.Errorf("template %s does not define %q", file, name)
+			}
+		}
+
+		locale := path.Base(path.Dir(file))
+		t.templates[templateKey(strings.TrimSuffix(path.Base(file), ".tmpl"), locale)] = tmpl
+	}
+
+	return t, nil
+}
+
+// Render returns the subject and the body of a message in the locale. It falls back to the
+// language of the locale and then to the default locale, so "pt-BR" may be rendered as "pt".
+func (t *Templates) Render(name, locale string, data any) (string, string, error) {
+	tmpl := t.lookup(name, locale)
+	if tmpl == nil {
+		return "", "", ErrUnknownTemplate
+	}
+
+	var subject, body bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return "", "", err
+	}
+	if err := tmpl.ExecuteTemplate(&body, "body", data); err != nil {
+		return "", "", err
+	}
+
+	return strings.TrimSpace(subject.String()), strings.TrimSpace(body.String()), nil
+}
+
+func (t *Templates) lookup(name, locale string) *template.Template {
+	locale = strings.ReplaceAll(locale, "_", "-")
+	language, _, _ := strings.Cut(locale, "-")
+
+	for _, l := range []string{locale, language, t.defaultLocale} {
+		if tmpl, ok := t.templates[templateKey(name, l)]; ok {
+			return tmpl
+		}
+	}
+
+	return nil
+}
+
+func templateKey(name, locale string) string {
+	return strings.ToLower(locale) + "/" + name
+}
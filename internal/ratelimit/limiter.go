@@ -0,0 +1,102 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/8thgencore/microservice-auth/internal/cache"
+)
+
+const keyPrefix = "rate_limit:"
+
+// tokenBucketScript is the generic cell rate algorithm: the key stores the theoretical arrival time (TAT),
+// the time the bucket is full again. A call adds the emission interval ARGV[2] to it and is allowed unless
+// that puts the TAT more than the period ARGV[3] ahead of the time ARGV[1]. Times are in milliseconds,
+// which Lua numbers hold exactly. Returns whether the call is allowed and, when refused, the milliseconds
+// until the next one can be.
+var tokenBucketScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local interval = tonumber(ARGV[2])
+local period = tonumber(ARGV[3])
+local tat = tonumber(redis.call('GET', KEYS[1]) or now)
+if tat < now then
+	tat = now
+end
+local new_tat = tat + interval
+local allow_at = new_tat - period
+if now < allow_at then
+	return {0, allow_at - now}
+end
+redis.call('SET', KEYS[1], new_tat, 'PX', new_tat - now)
+return {1, 0}
+`)
+
+// slidingWindowScript keeps a log of the calls of the last period ARGV[2] in a sorted set scored by the time
+// ARGV[1]. The calls that left the window are removed and the call ARGV[4] is logged if fewer than ARGV[3]
+// remain. Times are in milliseconds. Returns whether the call is allowed and, when refused, the milliseconds
+// until enough logged calls leave the window.
+var slidingWindowScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local period = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - period)
+local count = redis.call('ZCARD', KEYS[1])
+if count < limit then
+	redis.call('ZADD', KEYS[1], now, ARGV[4])
+	redis.call('PEXPIRE', KEYS[1], period)
+	return {1, 0}
+end
+local freed = redis.call('ZRANGE', KEYS[1], count - limit, count - limit, 'WITHSCORES')
+return {0, tonumber(freed[2]) + period - now}
+`)
+
+// Limiter decides whether a call within a rule may go ahead.
+type Limiter interface {
+	// Allow counts a call under the key of the subject and reports whether it is within the rule.
+	// A refused call is not counted; retryAfter tells when the next call can be allowed.
+	Allow(ctx context.Context, rule *Rule, subject string) (allowed bool, retryAfter time.Duration, err error)
+}
+
+type limiter struct {
+	redisClient cache.Client
+	now         func() time.Time
+}
+
+// NewLimiter creates a Limiter that keeps its counters in Redis, so they are shared by every replica.
+// Each call is decided by a single script, which Redis runs atomically: concurrent calls never exceed a limit.
+func NewLimiter(redisClient cache.Client) Limiter {
+	return &limiter{
+		redisClient: redisClient,
+		now:         time.Now,
+	}
+}
+
+// Allow counts a call with the algorithm of the rule.
+func (l *limiter) Allow(ctx context.Context, rule *Rule, subject string) (bool, time.Duration, error) {
+	key := keyPrefix + rule.ID + ":" + subject
+	now := l.now()
+
+	var (
+		res []int64
+		err error
+	)
+	switch rule.Algorithm {
+	case SlidingWindow:
+		member := fmt.Sprintf("%020d:%s", now.UnixNano(), uuid.NewString())
+		res, err = slidingWindowScript.Run(ctx, l.redisClient, []string{key},
+			now.UnixMilli(), rule.Period.Milliseconds(), rule.Limit, member).Int64Slice()
+	default:
+		interval := rule.Period / time.Duration(rule.Limit)
+		res, err = tokenBucketScript.Run(ctx, l.redisClient, []string{key},
+			now.UnixMilli(), interval.Milliseconds(), rule.Period.Milliseconds()).Int64Slice()
+	}
+	if err != nil {
+		return false, 0, err
+	}
+
+	return res[0] == 1, time.Duration(res[1]) * time.Millisecond, nil
+}
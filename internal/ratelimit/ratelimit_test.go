@@ -0,0 +1,232 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+
+	"github.com/8thgencore/microservice-auth/internal/cache"
+)
+
+// newRedis returns a client of an in-memory Redis server, which runs the scripts of the limiter.
+func newRedis(t *testing.T) cache.Client {
+	client := cache.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()}, slog.New(slog.DiscardHandler))
+	t.Cleanup(func() { _ = client.Close() })
+
+	return client
+}
+
+// clock is a time that only moves when told to.
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newTestLimiter(redisClient cache.Client, clk *clock) *limiter {
+	return &limiter{redisClient: redisClient, now: clk.Now}
+}
+
+func TestParseRule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		spec string
+		want *Rule
+		err  bool
+	}{
+		{
+			name: "token bucket by default case",
+			spec: "* user 100/1s",
+			want: &Rule{
+				ID:        "* user 100/1s",
+				Method:    AnyMethod,
+				Scope:     ScopeUser,
+				Limit:     100,
+				Period:    time.Second,
+				Algorithm: TokenBucket,
+			},
+		},
+		{
+			name: "sliding window case",
+			spec: " /auth_v1.AuthV1/Login  ip 10/1m sliding_window",
+			want: &Rule{
+				ID:        "/auth_v1.AuthV1/Login ip 10/1m sliding_window",
+				Method:    "/auth_v1.AuthV1/Login",
+				Scope:     ScopeIP,
+				Limit:     10,
+				Period:    time.Minute,
+				Algorithm: SlidingWindow,
+			},
+		},
+		{name: "missing limit case", spec: "* user", err: true},
+		{name: "unknown scope case", spec: "* tenant 10/1s", err: true},
+		{name: "unknown algorithm case", spec: "* ip 10/1s leaky_bucket", err: true},
+		{name: "invalid limit case", spec: "* ip 0/1s", err: true},
+		{name: "invalid period case", spec: "* ip 10/soon", err: true},
+		{name: "limit too high case", spec: "* ip 2000/1s", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule, err := ParseRule(tt.spec)
+			if tt.err {
+				require.True(t, errors.Is(err, ErrInvalidRule))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, rule)
+		})
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx  = context.Background()
+		clk  = &clock{now: time.Unix(1000, 0)}
+		l    = newTestLimiter(newRedis(t), clk)
+		rule = &Rule{ID: "bucket", Limit: 3, Period: 3 * time.Second, Algorithm: TokenBucket}
+	)
+
+	// A full bucket allows a burst of the limit.
+	for range 3 {
+		allowed, _, err := l.Allow(ctx, rule, "subject")
+		require.NoError(t, err)
+		require.True(t, allowed)
+	}
+
+	clk.Advance(200 * time.Millisecond)
+	allowed, retryAfter, err := l.Allow(ctx, rule, "subject")
+	require.NoError(t, err)
+	require.False(t, allowed)
+	require.Equal(t, 800*time.Millisecond, retryAfter)
+
+	// Other subjects have their own bucket.
+	allowed, _, err = l.Allow(ctx, rule, "other")
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	// A token is added every second.
+	clk.Advance(800 * time.Millisecond)
+	allowed, _, err = l.Allow(ctx, rule, "subject")
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	allowed, _, err = l.Allow(ctx, rule, "subject")
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	// The bucket does not fill past the limit.
+	clk.Advance(time.Hour)
+	for range 3 {
+		allowed, _, err = l.Allow(ctx, rule, "subject")
+		require.NoError(t, err)
+		require.True(t, allowed)
+	}
+	allowed, _, err = l.Allow(ctx, rule, "subject")
+	require.NoError(t, err)
+	require.False(t, allowed)
+}
+
+func TestSlidingWindow(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx  = context.Background()
+		clk  = &clock{now: time.Unix(1000, 0)}
+		l    = newTestLimiter(newRedis(t), clk)
+		rule = &Rule{ID: "window", Limit: 2, Period: 10 * time.Second, Algorithm: SlidingWindow}
+	)
+
+	allowed, _, err := l.Allow(ctx, rule, "subject")
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	clk.Advance(time.Second)
+	allowed, _, err = l.Allow(ctx, rule, "subject")
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	clk.Advance(time.Second)
+	allowed, retryAfter, err := l.Allow(ctx, rule, "subject")
+	require.NoError(t, err)
+	require.False(t, allowed)
+	require.Equal(t, 8*time.Second, retryAfter)
+
+	// Refused calls are not counted, the first call leaves the window after the period.
+	clk.Advance(8 * time.Second)
+	allowed, _, err = l.Allow(ctx, rule, "subject")
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	allowed, retryAfter, err = l.Allow(ctx, rule, "subject")
+	require.NoError(t, err)
+	require.False(t, allowed)
+	require.Equal(t, time.Second, retryAfter)
+}
+
+// TestReplicas checks that concurrent calls through several limiters sharing Redis never exceed a limit.
+func TestReplicas(t *testing.T) {
+	t.Parallel()
+
+	for _, algorithm := range []string{TokenBucket, SlidingWindow} {
+		t.Run(algorithm, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				ctx      = context.Background()
+				clk      = &clock{now: time.Unix(1000, 0)}
+				client   = newRedis(t)
+				replicas = []*limiter{newTestLimiter(client, clk), newTestLimiter(client, clk)}
+				rule     = &Rule{ID: algorithm, Limit: 10, Period: time.Minute, Algorithm: algorithm}
+
+				wg      sync.WaitGroup
+				mu      sync.Mutex
+				allowed int
+			)
+
+			for i := range 50 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+
+					ok, _, err := replicas[i%len(replicas)].Allow(ctx, rule, "subject")
+					require.NoError(t, err)
+
+					if ok {
+						mu.Lock()
+						allowed++
+						mu.Unlock()
+					}
+				}()
+			}
+			wg.Wait()
+
+			require.LessOrEqual(t, allowed, rule.Limit)
+			require.Positive(t, allowed)
+		})
+	}
+}
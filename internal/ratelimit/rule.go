@@ -0,0 +1,118 @@
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Algorithms
+const (
+	// TokenBucket allows bursts of up to the limit and refills evenly over the period.
+	TokenBucket = "token_bucket"
+	// SlidingWindow allows up to the limit in any span of the period.
+	SlidingWindow = "sliding_window"
+)
+
+// Scopes
+const (
+	// ScopeMethod shares the limit among all callers of a method.
+	ScopeMethod = "method"
+	// ScopeUser gives every authenticated user their own limit.
+	ScopeUser = "user"
+	// ScopeIP gives every client IP its own limit.
+	ScopeIP = "ip"
+)
+
+// AnyMethod matches every method.
+const AnyMethod = "*"
+
+// ErrInvalidRule is returned when a rule can not be parsed.
+var ErrInvalidRule = errors.New("invalid rate limit rule")
+
+// Rule is a limit of the calls of a method in a scope.
+type Rule struct {
+	// ID tells the counters of the rule apart from the counters of other rules.
+	ID        string
+	Method    string
+	Scope     string
+	Limit     int
+	Period    time.Duration
+	Algorithm string
+}
+
+// Matches reports whether the rule applies to the method.
+func (r *Rule) Matches(method string) bool {
+	return r.Method == AnyMethod || r.Method == method
+}
+
+// ParseRules parses the rules of the configuration in order.
+func ParseRules(specs []string) ([]*Rule, error) {
+	rules := make([]*Rule, 0, len(specs))
+	for _, spec := range specs {
+		if strings.TrimSpace(spec) == "" {
+			continue
+		}
+
+		rule, err := ParseRule(spec)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// ParseRule parses a rule written as "<method> <scope> <limit>/<period> [<algorithm>]",
+// for example "/auth_v1.AuthV1/Login ip 10/1m sliding_window" or "* user 100/1s".
+// The algorithm defaults to the token bucket.
+func ParseRule(spec string) (*Rule, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != 3 && len(fields) != 4 {
+		return nil, fmt.Errorf("%w %q: expected method, scope, limit and optional algorithm", ErrInvalidRule, spec)
+	}
+
+	rule := &Rule{
+		ID:        strings.Join(fields, " "),
+		Method:    fields[0],
+		Scope:     fields[1],
+		Algorithm: TokenBucket,
+	}
+	if len(fields) == 4 {
+		rule.Algorithm = fields[3]
+	}
+
+	switch rule.Scope {
+	case ScopeMethod, ScopeUser, ScopeIP:
+	default:
+		return nil, fmt.Errorf("%w %q: unknown scope %q", ErrInvalidRule, spec, rule.Scope)
+	}
+
+	switch rule.Algorithm {
+	case TokenBucket, SlidingWindow:
+	default:
+		return nil, fmt.Errorf("%w %q: unknown algorithm %q", ErrInvalidRule, spec, rule.Algorithm)
+	}
+
+	limit, period, ok := strings.Cut(fields[2], "/")
+	if !ok {
+		return nil, fmt.Errorf("%w %q: expected limit as <count>/<period>", ErrInvalidRule, spec)
+	}
+
+	var err error
+	if rule.Limit, err = strconv.Atoi(limit); err != nil || rule.Limit <= 0 {
+		return nil, fmt.Errorf("%w %q: limit must be a positive number", ErrInvalidRule, spec)
+	}
+	if rule.Period, err = time.ParseDuration(period); err != nil || rule.Period <= 0 {
+		return nil, fmt.Errorf("%w %q: period must be a positive duration", ErrInvalidRule, spec)
+	}
+	// The limiter counts in milliseconds.
+	if rule.Period/time.Duration(rule.Limit) < time.Millisecond {
+		return nil, fmt.Errorf("%w %q: limit is too high for the period", ErrInvalidRule, spec)
+	}
+
+	return rule, nil
+}
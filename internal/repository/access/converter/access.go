@@ -10,8 +10,27 @@ func ToEndpointPermissionsFromRepo(endpointPermissions []*dao.EndpointPermission
 	var res []*model.EndpointPermissions
 	for _, e := range endpointPermissions {
 		res = append(res, &model.EndpointPermissions{
-			Endpoint: e.Endpoint,
-			Roles:    e.Roles,
+			Endpoint:  e.Endpoint,
+			Roles:     e.Roles,
+			Condition: e.Condition,
+		})
+	}
+
+	return res
+}
+
+// ToDenyRulesFromRepo converts repository layer model to structure of service layer.
+func ToDenyRulesFromRepo(denyRules []*dao.DenyRule) []*model.DenyRule {
+	var res []*model.DenyRule
+	for _, r := range denyRules {
+		res = append(res, &model.DenyRule{
+			Name:        r.Name,
+			Endpoint:    r.Endpoint,
+			Roles:       r.Roles,
+			UserIDs:     r.UserIDs,
+			ExceptRoles: r.ExceptRoles,
+			Reason:      r.Reason,
+			CreatedAt:   r.CreatedAt,
 		})
 	}
 
@@ -1,7 +1,21 @@
 package dao
 
+import "time"
+
 // EndpointPermissions type is the structure for endpoint permissions by roles.
 type EndpointPermissions struct {
-	Endpoint string   `db:"endpoint"`
-	Roles    []string `db:"allowed_roles"`
+	Endpoint  string   `db:"endpoint"`
+	Roles     []string `db:"allowed_roles"`
+	Condition string   `db:"condition"`
+}
+
+// DenyRule type is the structure for a rule denying access to an endpoint.
+type DenyRule struct {
+	Name        string    `db:"name"`
+	Endpoint    string    `db:"endpoint"`
+	Roles       []string  `db:"roles"`
+	UserIDs     []string  `db:"user_ids"`
+	ExceptRoles []string  `db:"except_roles"`
+	Reason      string    `db:"reason"`
+	CreatedAt   time.Time `db:"created_at"`
 }
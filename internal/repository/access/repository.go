@@ -21,6 +21,16 @@ const (
 
 	endpointColumn     = "endpoint"
 	allowedRolesColumn = "allowed_roles"
+	conditionColumn    = "condition"
+
+	denyRulesTableName = "deny_rules"
+
+	nameColumn        = "name"
+	rolesColumn       = "roles"
+	userIDsColumn     = "user_ids"
+	exceptRolesColumn = "except_roles"
+	reasonColumn      = "reason"
+	createdAtColumn   = "created_at"
 )
 
 type repo struct {
@@ -33,7 +43,7 @@ func NewRepository(db db.Client) repository.AccessRepository {
 }
 
 func (r *repo) GetRoleEndpoints(ctx context.Context) ([]*model.EndpointPermissions, error) {
-	builderSelect := sq.Select(endpointColumn, allowedRolesColumn).
+	builderSelect := sq.Select(endpointColumn, allowedRolesColumn, conditionColumn).
 		From(tableName).
 		PlaceholderFormat(sq.Dollar)
 
@@ -56,10 +66,10 @@ func (r *repo) GetRoleEndpoints(ctx context.Context) ([]*model.EndpointPermissio
 	return converter.ToEndpointPermissionsFromRepo(endpointPermissions), nil
 }
 
-func (r *repo) AddRoleEndpoint(ctx context.Context, endpoint string, allowedRoles []string) error {
+func (r *repo) AddRoleEndpoint(ctx context.Context, endpoint string, allowedRoles []string, condition string) error {
 	builderInsert := sq.Insert(tableName).
-		Columns(endpointColumn, allowedRolesColumn).
-		Values(endpoint, allowedRoles).
+		Columns(endpointColumn, allowedRolesColumn, conditionColumn).
+		Values(endpoint, allowedRoles, condition).
 		PlaceholderFormat(sq.Dollar)
 
 	query, args, err := builderInsert.ToSql()
@@ -83,11 +93,19 @@ func (r *repo) AddRoleEndpoint(ctx context.Context, endpoint string, allowedRole
 	return err
 }
 
-func (r *repo) UpdateRoleEndpoint(ctx context.Context, endpoint string, allowedRoles []string) error {
+func (r *repo) UpdateRoleEndpoint(
+	ctx context.Context,
+	endpoint string,
+	allowedRoles []string,
+	condition *string,
+) error {
 	builderUpdate := sq.Update(tableName).
 		Set(allowedRolesColumn, allowedRoles).
 		Where(sq.Eq{endpointColumn: endpoint}).
 		PlaceholderFormat(sq.Dollar)
+	if condition != nil {
+		builderUpdate = builderUpdate.Set(conditionColumn, *condition)
+	}
 
 	query, args, err := builderUpdate.ToSql()
 	if err != nil {
@@ -123,3 +141,92 @@ func (r *repo) DeleteRoleEndpoint(ctx context.Context, endpoint string) error {
 
 	return err
 }
+
+func (r *repo) GetDenyRules(ctx context.Context) ([]*model.DenyRule, error) {
+	builderSelect := sq.Select(nameColumn, endpointColumn, rolesColumn, userIDsColumn, exceptRolesColumn,
+		reasonColumn, createdAtColumn).
+		From(denyRulesTableName).
+		OrderBy(nameColumn).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "access_repository.GetDenyRules",
+		QueryRaw: query,
+	}
+
+	var denyRules []*dao.DenyRule
+	err = r.db.DB().ScanAllContext(ctx, &denyRules, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToDenyRulesFromRepo(denyRules), nil
+}
+
+func (r *repo) AddDenyRule(ctx context.Context, rule *model.DenyRule) error {
+	builderInsert := sq.Insert(denyRulesTableName).
+		Columns(nameColumn, endpointColumn, rolesColumn, userIDsColumn, exceptRolesColumn, reasonColumn).
+		Values(rule.Name, rule.Endpoint, nonNil(rule.Roles), nonNil(rule.UserIDs), nonNil(rule.ExceptRoles),
+			rule.Reason).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "access_repository.AddDenyRule",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return accessService.ErrDenyRuleAlreadyExists
+		}
+	}
+
+	return err
+}
+
+func (r *repo) DeleteDenyRule(ctx context.Context, name string) error {
+	builderDelete := sq.Delete(denyRulesTableName).
+		Where(sq.Eq{nameColumn: name}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "access_repository.DeleteDenyRule",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return accessService.ErrDenyRuleNotFound
+	}
+
+	return nil
+}
+
+// nonNil returns an empty list for a nil one, so that a not null array column is set.
+func nonNil(names []string) []string {
+	if names == nil {
+		return []string{}
+	}
+
+	return names
+}
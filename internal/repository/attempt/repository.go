@@ -0,0 +1,125 @@
+package attempt
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/8thgencore/microservice-auth/internal/cache"
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+)
+
+const keyPrefix = "login_failures:"
+
+// addScript counts a failure at ARGV[1] and returns the failures including it.
+var addScript = redis.NewScript(`
+local count = redis.call('HINCRBY', KEYS[1], 'count', 1)
+redis.call('HSET', KEYS[1], 'last_at', ARGV[1])
+redis.call('PEXPIRE', KEYS[1], ARGV[2])
+return count
+`)
+
+// reserveScript counts an attempt at ARGV[1] and returns the count and the time of the last failure before it.
+var reserveScript = redis.NewScript(`
+local count = redis.call('HINCRBY', KEYS[1], 'count', 1)
+local last_at = redis.call('HGET', KEYS[1], 'last_at') or ''
+redis.call('HSET', KEYS[1], 'last_at', ARGV[1])
+redis.call('PEXPIRE', KEYS[1], ARGV[2])
+return {count - 1, last_at}
+`)
+
+// releaseScript takes back the attempt counted at ARGV[1]. The time of the last failure goes back to ARGV[2]
+// unless a later attempt has been counted since.
+var releaseScript = redis.NewScript(`
+local count = redis.call('HINCRBY', KEYS[1], 'count', -1)
+if count <= 0 then
+	return redis.call('DEL', KEYS[1])
+end
+if redis.call('HGET', KEYS[1], 'last_at') == ARGV[1] then
+	if ARGV[2] == '' then
+		redis.call('HDEL', KEYS[1], 'last_at')
+	else
+		redis.call('HSET', KEYS[1], 'last_at', ARGV[2])
+	end
+end
+return count
+`)
+
+type repo struct {
+	redisClient cache.Client
+	failureTTL  time.Duration
+}
+
+// NewRepository creates a new instance of LoginAttemptRepository.
+// Failures are forgotten failureTTL after the last one.
+func NewRepository(redisClient cache.Client, failureTTL time.Duration) repository.LoginAttemptRepository {
+	return &repo{
+		redisClient: redisClient,
+		failureTTL:  failureTTL,
+	}
+}
+
+// AddFailure counts a failure for the key and returns the failures including it.
+func (r *repo) AddFailure(ctx context.Context, key string) (*model.LoginFailures, error) {
+	now := time.Now()
+
+	count, err := addScript.Run(
+		ctx, r.redisClient, []string{keyPrefix + key}, now.UnixNano(), r.failureTTL.Milliseconds(),
+	).Int()
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.LoginFailures{Count: count, LastAt: now}, nil
+}
+
+// Reserve counts an attempt for the key at the time, before its outcome is known, and returns the failures
+// counted before it. Concurrent attempts are counted one after the other, so each sees the ones before it.
+func (r *repo) Reserve(ctx context.Context, key string, at time.Time) (*model.LoginFailures, error) {
+	res, err := reserveScript.Run(
+		ctx, r.redisClient, []string{keyPrefix + key}, at.UnixNano(), r.failureTTL.Milliseconds(),
+	).Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	count, _ := res[0].(int64)
+	lastAt, _ := res[1].(string)
+
+	return parseFailures(count, lastAt)
+}
+
+// Release takes back the attempt reserved at the time once it turned out not to be a failure.
+// before are the failures Reserve returned for it.
+func (r *repo) Release(ctx context.Context, key string, at time.Time, before *model.LoginFailures) error {
+	lastAt := ""
+	if !before.LastAt.IsZero() {
+		lastAt = strconv.FormatInt(before.LastAt.UnixNano(), 10)
+	}
+
+	return releaseScript.Run(ctx, r.redisClient, []string{keyPrefix + key}, at.UnixNano(), lastAt).Err()
+}
+
+// Reset forgets the failures counted for the key.
+func (r *repo) Reset(ctx context.Context, key string) error {
+	return r.redisClient.Del(ctx, keyPrefix+key)
+}
+
+// parseFailures returns the failures of the count with the last one at the time stored in nanoseconds.
+func parseFailures(count int64, lastAt string) (*model.LoginFailures, error) {
+	failures := &model.LoginFailures{Count: int(count)}
+	if lastAt == "" {
+		return failures, nil
+	}
+
+	nanos, err := strconv.ParseInt(lastAt, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	failures.LastAt = time.Unix(0, nanos)
+
+	return failures, nil
+}
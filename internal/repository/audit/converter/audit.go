@@ -0,0 +1,98 @@
+package converter
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository/audit/dao"
+)
+
+// ToAuditEventFromRepo converts repository layer model to structure of service layer.
+func ToAuditEventFromRepo(event *dao.AuditEvent) (*model.AuditEvent, error) {
+	var changes map[string]model.AuditChange
+	if err := json.Unmarshal(event.Changes, &changes); err != nil {
+		return nil, err
+	}
+
+	var metadata map[string]string
+	if err := json.Unmarshal(event.Metadata, &metadata); err != nil {
+		return nil, err
+	}
+
+	return &model.AuditEvent{
+		ID:         event.ID,
+		OccurredAt: event.OccurredAt,
+		ActorID:    event.ActorID.String,
+		Action:     model.AuditAction(event.Action),
+		Target: model.AuditTarget{
+			Type: model.AuditTargetType(event.TargetType),
+			ID:   event.TargetID,
+		},
+		Changes:   changes,
+		Metadata:  metadata,
+		IPAddress: event.IPAddress.String,
+		UserAgent: event.UserAgent.String,
+		TraceID:   event.TraceID.String,
+		Seq:       event.Seq.Int64,
+		PrevHash:  event.PrevHash,
+		Hash:      event.Hash,
+	}, nil
+}
+
+// ToAuditEventsFromRepo converts repository layer models to structures of service layer.
+func ToAuditEventsFromRepo(events []*dao.AuditEvent) ([]*model.AuditEvent, error) {
+	res := make([]*model.AuditEvent, 0, len(events))
+	for _, event := range events {
+		converted, err := ToAuditEventFromRepo(event)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, converted)
+	}
+
+	return res, nil
+}
+
+// ToRepoFromAuditEvent converts service layer model to structure of repository layer.
+func ToRepoFromAuditEvent(event *model.AuditEvent) (*dao.AuditEvent, error) {
+	changes, err := json.Marshal(nonNil(event.Changes))
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := json.Marshal(nonNil(event.Metadata))
+	if err != nil {
+		return nil, err
+	}
+
+	return &dao.AuditEvent{
+		ID:         event.ID,
+		OccurredAt: event.OccurredAt,
+		ActorID:    nullString(event.ActorID),
+		Action:     string(event.Action),
+		TargetType: string(event.Target.Type),
+		TargetID:   event.Target.ID,
+		Changes:    changes,
+		Metadata:   metadata,
+		IPAddress:  nullString(event.IPAddress),
+		UserAgent:  nullString(event.UserAgent),
+		TraceID:    nullString(event.TraceID),
+		Seq:        sql.NullInt64{Int64: event.Seq, Valid: event.Seq != 0},
+		PrevHash:   event.PrevHash,
+		Hash:       event.Hash,
+	}, nil
+}
+
+// nonNil returns an empty map for nil, so that it is stored as an empty JSON object.
+func nonNil[V any](m map[string]V) map[string]V {
+	if m == nil {
+		return map[string]V{}
+	}
+
+	return m
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
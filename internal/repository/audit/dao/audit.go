@@ -0,0 +1,30 @@
+package dao
+
+import (
+	"database/sql"
+	"time"
+)
+
+// AuditEvent type is the structure for audit log record from storage.
+type AuditEvent struct {
+	ID         string         `db:"id"`
+	OccurredAt time.Time      `db:"occurred_at"`
+	ActorID    sql.NullString `db:"actor_id"`
+	Action     string         `db:"action"`
+	TargetType string         `db:"target_type"`
+	TargetID   string         `db:"target_id"`
+	Changes    []byte         `db:"changes"`
+	Metadata   []byte         `db:"metadata"`
+	IPAddress  sql.NullString `db:"ip_address"`
+	UserAgent  sql.NullString `db:"user_agent"`
+	TraceID    sql.NullString `db:"trace_id"`
+	Seq        sql.NullInt64  `db:"seq"`
+	PrevHash   string         `db:"prev_hash"`
+	Hash       string         `db:"hash"`
+}
+
+// ChainHead is the last event of the audit hash chain.
+type ChainHead struct {
+	Seq  int64  `db:"seq"`
+	Hash string `db:"hash"`
+}
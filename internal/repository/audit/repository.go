@@ -0,0 +1,326 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/8thgencore/microservice-auth/internal/audit"
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	"github.com/8thgencore/microservice-auth/internal/repository/audit/converter"
+	"github.com/8thgencore/microservice-auth/internal/repository/audit/dao"
+	"github.com/8thgencore/microservice-common/pkg/db"
+	"github.com/8thgencore/microservice-common/pkg/db/transaction"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	tableName = "audit_events"
+
+	idColumn         = "id"
+	occurredAtColumn = "occurred_at"
+	actorIDColumn    = "actor_id"
+	actionColumn     = "action"
+	targetTypeColumn = "target_type"
+	targetIDColumn   = "target_id"
+	changesColumn    = "changes"
+	metadataColumn   = "metadata"
+	ipAddressColumn  = "ip_address"
+	userAgentColumn  = "user_agent"
+	traceIDColumn    = "trace_id"
+	seqColumn        = "seq"
+	prevHashColumn   = "prev_hash"
+	hashColumn       = "hash"
+
+	// chainLockKey is the key of the advisory lock serialising the seals of the hash chain.
+	chainLockKey = 0x61756469745f6c67
+)
+
+var eventColumns = []string{
+	idColumn,
+	occurredAtColumn,
+	actorIDColumn,
+	actionColumn,
+	targetTypeColumn,
+	targetIDColumn,
+	changesColumn,
+	metadataColumn,
+	ipAddressColumn,
+	userAgentColumn,
+	traceIDColumn,
+	seqColumn,
+	prevHashColumn,
+	hashColumn,
+}
+
+type repo struct {
+	db        db.Client
+	txManager db.TxManager
+}
+
+// NewRepository creates new object of repository layer.
+func NewRepository(db db.Client) repository.AuditRepository {
+	return &repo{
+		db:        db,
+		txManager: transaction.NewTransactionManager(db.DB()),
+	}
+}
+
+// Record stores an audit event, within the transaction of the caller if there is one.
+// The event is linked to the hash chain afterwards by Seal, so that recording takes no lock.
+func (r *repo) Record(ctx context.Context, event *model.AuditEvent) error {
+	// The time is set here, at the precision of the database, as it is part of the hash.
+	event.OccurredAt = time.Now().UTC().Truncate(time.Microsecond)
+	event.Seq, event.PrevHash, event.Hash = 0, "", ""
+
+	return r.insert(ctx, event)
+}
+
+// Seal links up to limit events not linked yet to the hash chain, in the order they were recorded, and returns
+// how many it linked. Seals are serialised by a lock held until the end of the transaction, so that every event
+// is linked to the last sealed one.
+func (r *repo) Seal(ctx context.Context, limit int) (int, error) {
+	var sealed int
+	err := r.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		q := db.Query{
+			Name:     "audit_repository.LockChain",
+			QueryRaw: "SELECT pg_advisory_xact_lock($1)",
+		}
+		if _, err := r.db.DB().ExecContext(ctx, q, chainLockKey); err != nil {
+			return err
+		}
+
+		head, err := r.chainHead(ctx)
+		if err != nil {
+			return err
+		}
+
+		events, err := r.unsealed(ctx, limit)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			event.Seq = head.Seq + 1
+			event.PrevHash = head.Hash
+			if event.Hash, err = audit.Hash(event); err != nil {
+				return err
+			}
+
+			if err = r.link(ctx, event); err != nil {
+				return err
+			}
+			head = &dao.ChainHead{Seq: event.Seq, Hash: event.Hash}
+		}
+		sealed = len(events)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return sealed, nil
+}
+
+// unsealed returns up to limit events not linked to the hash chain, in the order they were recorded.
+func (r *repo) unsealed(ctx context.Context, limit int) ([]*model.AuditEvent, error) {
+	query, args, err := sq.Select(eventColumns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{seqColumn: nil}).
+		OrderBy(occurredAtColumn, idColumn).
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "audit_repository.Unsealed",
+		QueryRaw: query,
+	}
+
+	var events []*dao.AuditEvent
+	if err = r.db.DB().ScanAllContext(ctx, &events, q, args...); err != nil {
+		return nil, err
+	}
+
+	return converter.ToAuditEventsFromRepo(events)
+}
+
+// link stores the position and the hashes of the event in the hash chain.
+func (r *repo) link(ctx context.Context, event *model.AuditEvent) error {
+	query, args, err := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(seqColumn, event.Seq).
+		Set(prevHashColumn, event.PrevHash).
+		Set(hashColumn, event.Hash).
+		Where(sq.Eq{idColumn: event.ID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "audit_repository.Link",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+// chainHead returns the last sealed event of the chain, zero when none is.
+func (r *repo) chainHead(ctx context.Context) (*dao.ChainHead, error) {
+	query, args, err := sq.Select(seqColumn, hashColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.NotEq{seqColumn: nil}).
+		OrderBy(seqColumn + " DESC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "audit_repository.ChainHead",
+		QueryRaw: query,
+	}
+
+	var head dao.ChainHead
+	if err = r.db.DB().ScanOneContext(ctx, &head, q, args...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &dao.ChainHead{}, nil
+		}
+
+		return nil, err
+	}
+
+	return &head, nil
+}
+
+func (r *repo) insert(ctx context.Context, event *model.AuditEvent) error {
+	record, err := converter.ToRepoFromAuditEvent(event)
+	if err != nil {
+		return err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(eventColumns...).
+		Values(
+			record.ID,
+			record.OccurredAt,
+			record.ActorID,
+			record.Action,
+			record.TargetType,
+			record.TargetID,
+			record.Changes,
+			record.Metadata,
+			record.IPAddress,
+			record.UserAgent,
+			record.TraceID,
+			record.Seq,
+			record.PrevHash,
+			record.Hash,
+		)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "audit_repository.Record",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+// List returns the events matching the query, newest first.
+func (r *repo) List(ctx context.Context, query *model.AuditListQuery) ([]*model.AuditEvent, error) {
+	builderSelect := sq.Select(eventColumns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar)
+
+	filter := &query.Filter
+	if filter.ActorID != "" {
+		builderSelect = builderSelect.Where(sq.Eq{actorIDColumn: filter.ActorID})
+	}
+	if filter.Target.Type != "" {
+		builderSelect = builderSelect.Where(sq.Eq{targetTypeColumn: filter.Target.Type})
+	}
+	if filter.Target.ID != "" {
+		builderSelect = builderSelect.Where(sq.Eq{targetIDColumn: filter.Target.ID})
+	}
+	if filter.Action != "" {
+		builderSelect = builderSelect.Where(sq.Eq{actionColumn: filter.Action})
+	}
+	if filter.From != nil {
+		builderSelect = builderSelect.Where(sq.GtOrEq{occurredAtColumn: *filter.From})
+	}
+	if filter.To != nil {
+		builderSelect = builderSelect.Where(sq.Lt{occurredAtColumn: *filter.To})
+	}
+
+	if query.Before != nil {
+		builderSelect = builderSelect.Where(
+			sq.Expr("("+occurredAtColumn+", "+idColumn+") < (?, ?)", query.Before.OccurredAt, query.Before.ID),
+		)
+	}
+
+	builderSelect = builderSelect.
+		OrderBy(occurredAtColumn+" DESC", idColumn+" DESC").
+		Limit(uint64(query.Limit))
+
+	queryRaw, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "audit_repository.List",
+		QueryRaw: queryRaw,
+	}
+
+	var events []*dao.AuditEvent
+	err = r.db.DB().ScanAllContext(ctx, &events, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToAuditEventsFromRepo(events)
+}
+
+// ListChain returns up to limit events of the hash chain following the sequence number, in chain order.
+func (r *repo) ListChain(ctx context.Context, afterSeq int64, limit int) ([]*model.AuditEvent, error) {
+	query, args, err := sq.Select(eventColumns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Gt{seqColumn: afterSeq}).
+		OrderBy(seqColumn).
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "audit_repository.ListChain",
+		QueryRaw: query,
+	}
+
+	var events []*dao.AuditEvent
+	err = r.db.DB().ScanAllContext(ctx, &events, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToAuditEventsFromRepo(events)
+}
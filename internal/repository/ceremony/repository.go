@@ -0,0 +1,73 @@
+package ceremony
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/8thgencore/microservice-common/pkg/cache"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	authService "github.com/8thgencore/microservice-auth/internal/service/auth"
+)
+
+const keyPrefix = "passkey_ceremony:"
+
+type repo struct {
+	redisClient cache.Client
+	ceremonyTTL time.Duration
+}
+
+// NewRepository creates a new instance of PasskeyCeremonyRepository.
+func NewRepository(redisClient cache.Client, ceremonyTTL time.Duration) repository.PasskeyCeremonyRepository {
+	return &repo{
+		redisClient: redisClient,
+		ceremonyTTL: ceremonyTTL,
+	}
+}
+
+// Save stores the state of a ceremony in Redis with a TTL (time-to-live).
+// The state is kept as the single member of a set, so that removing it claims the ceremony atomically.
+func (r *repo) Save(ctx context.Context, id string, ceremony *model.PasskeyCeremony) error {
+	data, err := json.Marshal(ceremony)
+	if err != nil {
+		return err
+	}
+
+	key := keyPrefix + id
+	if _, err = r.redisClient.SAdd(ctx, key, string(data)); err != nil {
+		return err
+	}
+
+	return r.redisClient.Expire(ctx, key, r.ceremonyTTL)
+}
+
+// Take retrieves the state of a ceremony and deletes it.
+// Of concurrent calls for the same ceremony only one succeeds.
+func (r *repo) Take(ctx context.Context, id string) (*model.PasskeyCeremony, error) {
+	key := keyPrefix + id
+
+	members, err := r.redisClient.SMembers(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(members) != 1 {
+		return nil, authService.ErrInvalidPasskeyCeremony
+	}
+
+	removed, err := r.redisClient.SRem(ctx, key, members[0])
+	if err != nil {
+		return nil, err
+	}
+	if removed != 1 {
+		return nil, authService.ErrInvalidPasskeyCeremony
+	}
+
+	var ceremony model.PasskeyCeremony
+	if err = json.Unmarshal([]byte(members[0]), &ceremony); err != nil {
+		return nil, err
+	}
+
+	return &ceremony, nil
+}
@@ -0,0 +1,31 @@
+package converter
+
+import (
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository/family/dao"
+)
+
+// ToTokenFamilyFromRepo converts repository layer model to structure of service layer.
+func ToTokenFamilyFromRepo(family *dao.TokenFamily) *model.TokenFamily {
+	return &model.TokenFamily{
+		ID:              family.ID,
+		UserID:          family.UserID,
+		CurrentTokenID:  family.CurrentTokenID,
+		IPAddress:       family.IPAddress,
+		UserAgent:       family.UserAgent,
+		LastRefreshedAt: family.LastRefreshedAt,
+		RevokedAt:       family.RevokedAt,
+		CreatedAt:       family.CreatedAt,
+		UpdatedAt:       family.UpdatedAt,
+	}
+}
+
+// ToTokenFamiliesFromRepo converts repository layer models to structures of service layer.
+func ToTokenFamiliesFromRepo(families []*dao.TokenFamily) []*model.TokenFamily {
+	res := make([]*model.TokenFamily, 0, len(families))
+	for _, family := range families {
+		res = append(res, ToTokenFamilyFromRepo(family))
+	}
+
+	return res
+}
@@ -0,0 +1,19 @@
+package dao
+
+import (
+	"database/sql"
+	"time"
+)
+
+// TokenFamily type is the structure for refresh token family from storage.
+type TokenFamily struct {
+	ID              string       `db:"id"`
+	UserID          string       `db:"user_id"`
+	CurrentTokenID  string       `db:"current_token_id"`
+	IPAddress       string       `db:"ip_address"`
+	UserAgent       string       `db:"user_agent"`
+	LastRefreshedAt sql.NullTime `db:"last_refreshed_at"`
+	RevokedAt       sql.NullTime `db:"revoked_at"`
+	CreatedAt       time.Time    `db:"created_at"`
+	UpdatedAt       sql.NullTime `db:"updated_at"`
+}
@@ -0,0 +1,247 @@
+package family
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/8thgencore/microservice-common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	"github.com/8thgencore/microservice-auth/internal/repository/family/converter"
+	"github.com/8thgencore/microservice-auth/internal/repository/family/dao"
+	authService "github.com/8thgencore/microservice-auth/internal/service/auth"
+)
+
+const (
+	tableName = "refresh_token_families"
+
+	idColumn              = "id"
+	userIDColumn          = "user_id"
+	currentTokenIDColumn  = "current_token_id"
+	ipAddressColumn       = "ip_address"
+	userAgentColumn       = "user_agent"
+	lastRefreshedAtColumn = "last_refreshed_at"
+	revokedAtColumn       = "revoked_at"
+	createdAtColumn       = "created_at"
+	updatedAtColumn       = "updated_at"
+)
+
+var columns = []string{
+	idColumn,
+	userIDColumn,
+	currentTokenIDColumn,
+	ipAddressColumn,
+	userAgentColumn,
+	lastRefreshedAtColumn,
+	revokedAtColumn,
+	createdAtColumn,
+	updatedAtColumn,
+}
+
+type repo struct {
+	db              db.Client
+	refreshTokenTTL time.Duration
+}
+
+// NewRepository creates new object of repository layer.
+// A family is active until it is revoked or no token was rotated within the refresh token TTL.
+func NewRepository(db db.Client, refreshTokenTTL time.Duration) repository.TokenFamilyRepository {
+	return &repo{
+		db:              db,
+		refreshTokenTTL: refreshTokenTTL,
+	}
+}
+
+// Create creates a new refresh token family.
+func (r *repo) Create(ctx context.Context, family *model.TokenFamily) error {
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(idColumn, userIDColumn, currentTokenIDColumn, ipAddressColumn, userAgentColumn).
+		Values(family.ID, family.UserID, family.CurrentTokenID, family.IPAddress, family.UserAgent)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "family_repository.Create",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Get retrieves a refresh token family by its ID.
+func (r *repo) Get(ctx context.Context, id string) (*model.TokenFamily, error) {
+	builderSelect := sq.Select(columns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: id}).
+		Limit(1)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "family_repository.Get",
+		QueryRaw: query,
+	}
+
+	var family dao.TokenFamily
+	err = r.db.DB().ScanOneContext(ctx, &family, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, authService.ErrTokenFamilyNotFound
+		}
+
+		return nil, err
+	}
+
+	return converter.ToTokenFamilyFromRepo(&family), nil
+}
+
+// ListActive retrieves the active families of a user, most recently created first.
+func (r *repo) ListActive(ctx context.Context, userID string) ([]*model.TokenFamily, error) {
+	builderSelect := sq.Select(columns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{
+			userIDColumn:    userID,
+			revokedAtColumn: nil,
+		}).
+		Where(sq.Expr(
+			"COALESCE("+lastRefreshedAtColumn+", "+createdAtColumn+") > NOW() - make_interval(secs => ?)",
+			r.refreshTokenTTL.Seconds(),
+		)).
+		OrderBy(createdAtColumn + " DESC")
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "family_repository.ListActive",
+		QueryRaw: query,
+	}
+
+	var families []*dao.TokenFamily
+	err = r.db.DB().ScanAllContext(ctx, &families, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToTokenFamiliesFromRepo(families), nil
+}
+
+// Rotate replaces the current token ID of an active family if it still equals currentTokenID
+// and records the client the token was refreshed from.
+// It reports false when the family was revoked or the token was already rotated.
+func (r *repo) Rotate(
+	ctx context.Context,
+	id, currentTokenID, nextTokenID string,
+	client *model.ClientInfo,
+) (bool, error) {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(currentTokenIDColumn, nextTokenID).
+		Set(ipAddressColumn, client.IPAddress).
+		Set(userAgentColumn, client.UserAgent).
+		Set(lastRefreshedAtColumn, sq.Expr("NOW()")).
+		Set(updatedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{
+			idColumn:             id,
+			currentTokenIDColumn: currentTokenID,
+			revokedAtColumn:      nil,
+		})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "family_repository.Rotate",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// Revoke revokes a refresh token family so none of its tokens can be used again.
+func (r *repo) Revoke(ctx context.Context, id string) error {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(revokedAtColumn, sq.Expr("NOW()")).
+		Set(updatedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{
+			idColumn:        id,
+			revokedAtColumn: nil,
+		})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "family_repository.Revoke",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RevokeAll revokes every family of a user except the one with exceptID, if given.
+func (r *repo) RevokeAll(ctx context.Context, userID string, exceptID string) error {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(revokedAtColumn, sq.Expr("NOW()")).
+		Set(updatedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{
+			userIDColumn:    userID,
+			revokedAtColumn: nil,
+		})
+	if exceptID != "" {
+		builderUpdate = builderUpdate.Where(sq.NotEq{idColumn: exceptID})
+	}
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "family_repository.RevokeAll",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -4,5 +4,17 @@ package repository
 //go:generate ./../../bin/minimock -g -i UserRepository -o ./mocks/ -s "_minimock.go"
 //go:generate ./../../bin/minimock -g -i KeyRepository -o ./mocks/ -s "_minimock.go"
 //go:generate ./../../bin/minimock -g -i AccessRepository -o ./mocks/ -s "_minimock.go"
-//go:generate ./../../bin/minimock -g -i LogRepository -o ./mocks/ -s "_minimock.go"
+//go:generate ./../../bin/minimock -g -i RelationRepository -o ./mocks/ -s "_minimock.go"
+//go:generate ./../../bin/minimock -g -i RoleRepository -o ./mocks/ -s "_minimock.go"
+//go:generate ./../../bin/minimock -g -i PermissionRepository -o ./mocks/ -s "_minimock.go"
+//go:generate ./../../bin/minimock -g -i AuditRepository -o ./mocks/ -s "_minimock.go"
 //go:generate ./../../bin/minimock -g -i TokenRepository -o ./mocks/ -s "_minimock.go"
+//go:generate ./../../bin/minimock -g -i PolicyVersionRepository -o ./mocks/ -s "_minimock.go"
+//go:generate ./../../bin/minimock -g -i TokenFamilyRepository -o ./mocks/ -s "_minimock.go"
+//go:generate ./../../bin/minimock -g -i MfaRepository -o ./mocks/ -s "_minimock.go"
+//go:generate ./../../bin/minimock -g -i PasskeyRepository -o ./mocks/ -s "_minimock.go"
+//go:generate ./../../bin/minimock -g -i PasskeyCeremonyRepository -o ./mocks/ -s "_minimock.go"
+//go:generate ./../../bin/minimock -g -i PasswordResetRepository -o ./mocks/ -s "_minimock.go"
+//go:generate ./../../bin/minimock -g -i LoginAttemptRepository -o ./mocks/ -s "_minimock.go"
+//go:generate ./../../bin/minimock -g -i NotificationRepository -o ./mocks/ -s "_minimock.go"
+//go:generate ./../../bin/minimock -g -i EmailVerificationRepository -o ./mocks/ -s "_minimock.go"
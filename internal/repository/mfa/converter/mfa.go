@@ -0,0 +1,18 @@
+package converter
+
+import (
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository/mfa/dao"
+)
+
+// ToTotpFromRepo converts repository layer model to structure of service layer.
+func ToTotpFromRepo(totp *dao.Totp) *model.Totp {
+	return &model.Totp{
+		UserID:       totp.UserID,
+		Secret:       totp.Secret,
+		ConfirmedAt:  totp.ConfirmedAt,
+		LastUsedStep: totp.LastUsedStep,
+		CreatedAt:    totp.CreatedAt,
+		UpdatedAt:    totp.UpdatedAt,
+	}
+}
@@ -0,0 +1,16 @@
+package dao
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Totp type is the structure for TOTP second factor from storage.
+type Totp struct {
+	UserID       string       `db:"user_id"`
+	Secret       string       `db:"secret"`
+	ConfirmedAt  sql.NullTime `db:"confirmed_at"`
+	LastUsedStep int64        `db:"last_used_step"`
+	CreatedAt    time.Time    `db:"created_at"`
+	UpdatedAt    sql.NullTime `db:"updated_at"`
+}
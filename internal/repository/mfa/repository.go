@@ -0,0 +1,308 @@
+package mfa
+
+import (
+	"context"
+	"errors"
+
+	"github.com/8thgencore/microservice-common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	"github.com/8thgencore/microservice-auth/internal/repository/mfa/converter"
+	"github.com/8thgencore/microservice-auth/internal/repository/mfa/dao"
+	authService "github.com/8thgencore/microservice-auth/internal/service/auth"
+)
+
+const (
+	totpTableName          = "user_totp"
+	recoveryCodesTableName = "user_recovery_codes"
+
+	userIDColumn       = "user_id"
+	secretColumn       = "secret"
+	confirmedAtColumn  = "confirmed_at"
+	lastUsedStepColumn = "last_used_step"
+	codeHashColumn     = "code_hash"
+	usedAtColumn       = "used_at"
+	createdAtColumn    = "created_at"
+	updatedAtColumn    = "updated_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new object of repository layer.
+func NewRepository(db db.Client) repository.MfaRepository {
+	return &repo{db: db}
+}
+
+// SaveTotp stores the secret of a pending TOTP enrollment, replacing a previous pending one.
+// A confirmed TOTP is left untouched.
+func (r *repo) SaveTotp(ctx context.Context, userID, secret string) error {
+	builderInsert := sq.Insert(totpTableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(userIDColumn, secretColumn).
+		Values(userID, secret).
+		Suffix("ON CONFLICT (" + userIDColumn + ") DO UPDATE SET " +
+			secretColumn + " = EXCLUDED." + secretColumn + ", " +
+			lastUsedStepColumn + " = 0, " +
+			updatedAtColumn + " = NOW() " +
+			"WHERE " + totpTableName + "." + confirmedAtColumn + " IS NULL")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "mfa_repository.SaveTotp",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetTotp retrieves the TOTP second factor of a user.
+func (r *repo) GetTotp(ctx context.Context, userID string) (*model.Totp, error) {
+	builderSelect := sq.Select(
+		userIDColumn,
+		secretColumn,
+		confirmedAtColumn,
+		lastUsedStepColumn,
+		createdAtColumn,
+		updatedAtColumn,
+	).
+		From(totpTableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{userIDColumn: userID}).
+		Limit(1)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "mfa_repository.GetTotp",
+		QueryRaw: query,
+	}
+
+	var totp dao.Totp
+	err = r.db.DB().ScanOneContext(ctx, &totp, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, authService.ErrMfaNotEnrolled
+		}
+
+		return nil, err
+	}
+
+	return converter.ToTotpFromRepo(&totp), nil
+}
+
+// ConfirmTotp turns a pending TOTP enrollment on.
+func (r *repo) ConfirmTotp(ctx context.Context, userID string) error {
+	builderUpdate := sq.Update(totpTableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(confirmedAtColumn, sq.Expr("NOW()")).
+		Set(updatedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{
+			userIDColumn:      userID,
+			confirmedAtColumn: nil,
+		})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "mfa_repository.ConfirmTotp",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return authService.ErrMfaNotEnrolled
+	}
+
+	return nil
+}
+
+// UseTotpStep records the time step of an accepted code.
+// It reports false when a code of the same or a later time step was already accepted.
+func (r *repo) UseTotpStep(ctx context.Context, userID string, step int64) (bool, error) {
+	builderUpdate := sq.Update(totpTableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(lastUsedStepColumn, step).
+		Set(updatedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{userIDColumn: userID}).
+		Where(sq.Lt{lastUsedStepColumn: step})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "mfa_repository.UseTotpStep",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// DeleteTotp removes the TOTP second factor of a user.
+func (r *repo) DeleteTotp(ctx context.Context, userID string) error {
+	builderDelete := sq.Delete(totpTableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{userIDColumn: userID})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "mfa_repository.DeleteTotp",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReplaceRecoveryCodes replaces the recovery codes of a user with the given code hashes.
+func (r *repo) ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error {
+	if err := r.DeleteRecoveryCodes(ctx, userID); err != nil {
+		return err
+	}
+
+	builderInsert := sq.Insert(recoveryCodesTableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(userIDColumn, codeHashColumn)
+	for _, codeHash := range codeHashes {
+		builderInsert = builderInsert.Values(userID, codeHash)
+	}
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "mfa_repository.ReplaceRecoveryCodes",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// HasRecoveryCode reports whether the user has an unused recovery code with the hash, without using it.
+func (r *repo) HasRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error) {
+	builderSelect := sq.Select("1").
+		From(recoveryCodesTableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{
+			userIDColumn:   userID,
+			codeHashColumn: codeHash,
+			usedAtColumn:   nil,
+		}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")")
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "mfa_repository.HasRecoveryCode",
+		QueryRaw: query,
+	}
+
+	var exists bool
+	if err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// UseRecoveryCode marks an unused recovery code of a user as used.
+// It reports false when there is no such unused code.
+func (r *repo) UseRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error) {
+	builderUpdate := sq.Update(recoveryCodesTableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(usedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{
+			userIDColumn:   userID,
+			codeHashColumn: codeHash,
+			usedAtColumn:   nil,
+		})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "mfa_repository.UseRecoveryCode",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// DeleteRecoveryCodes removes every recovery code of a user.
+func (r *repo) DeleteRecoveryCodes(ctx context.Context, userID string) error {
+	builderDelete := sq.Delete(recoveryCodesTableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{userIDColumn: userID})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "mfa_repository.DeleteRecoveryCodes",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
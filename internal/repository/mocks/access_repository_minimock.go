@@ -17,13 +17,27 @@ type AccessRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcAddRoleEndpoint          func(ctx context.Context, endpoint string, allowedRoles []string) (err error)
+	funcAddDenyRule          func(ctx context.Context, rule *model.DenyRule) (err error)
+	funcAddDenyRuleOrigin    string
+	inspectFuncAddDenyRule   func(ctx context.Context, rule *model.DenyRule)
+	afterAddDenyRuleCounter  uint64
+	beforeAddDenyRuleCounter uint64
+	AddDenyRuleMock          mAccessRepositoryMockAddDenyRule
+
+	funcAddRoleEndpoint          func(ctx context.Context, endpoint string, allowedRoles []string, condition string) (err error)
 	funcAddRoleEndpointOrigin    string
-	inspectFuncAddRoleEndpoint   func(ctx context.Context, endpoint string, allowedRoles []string)
+	inspectFuncAddRoleEndpoint   func(ctx context.Context, endpoint string, allowedRoles []string, condition string)
 	afterAddRoleEndpointCounter  uint64
 	beforeAddRoleEndpointCounter uint64
 	AddRoleEndpointMock          mAccessRepositoryMockAddRoleEndpoint
 
+	funcDeleteDenyRule          func(ctx context.Context, name string) (err error)
+	funcDeleteDenyRuleOrigin    string
+	inspectFuncDeleteDenyRule   func(ctx context.Context, name string)
+	afterDeleteDenyRuleCounter  uint64
+	beforeDeleteDenyRuleCounter uint64
+	DeleteDenyRuleMock          mAccessRepositoryMockDeleteDenyRule
+
 	funcDeleteRoleEndpoint          func(ctx context.Context, endpoint string) (err error)
 	funcDeleteRoleEndpointOrigin    string
 	inspectFuncDeleteRoleEndpoint   func(ctx context.Context, endpoint string)
@@ -31,6 +45,13 @@ type AccessRepositoryMock struct {
 	beforeDeleteRoleEndpointCounter uint64
 	DeleteRoleEndpointMock          mAccessRepositoryMockDeleteRoleEndpoint
 
+	funcGetDenyRules          func(ctx context.Context) (dpa1 []*model.DenyRule, err error)
+	funcGetDenyRulesOrigin    string
+	inspectFuncGetDenyRules   func(ctx context.Context)
+	afterGetDenyRulesCounter  uint64
+	beforeGetDenyRulesCounter uint64
+	GetDenyRulesMock          mAccessRepositoryMockGetDenyRules
+
 	funcGetRoleEndpoints          func(ctx context.Context) (epa1 []*model.EndpointPermissions, err error)
 	funcGetRoleEndpointsOrigin    string
 	inspectFuncGetRoleEndpoints   func(ctx context.Context)
@@ -38,9 +59,9 @@ type AccessRepositoryMock struct {
 	beforeGetRoleEndpointsCounter uint64
 	GetRoleEndpointsMock          mAccessRepositoryMockGetRoleEndpoints
 
-	funcUpdateRoleEndpoint          func(ctx context.Context, endpoint string, allowedRoles []string) (err error)
+	funcUpdateRoleEndpoint          func(ctx context.Context, endpoint string, allowedRoles []string, condition *string) (err error)
 	funcUpdateRoleEndpointOrigin    string
-	inspectFuncUpdateRoleEndpoint   func(ctx context.Context, endpoint string, allowedRoles []string)
+	inspectFuncUpdateRoleEndpoint   func(ctx context.Context, endpoint string, allowedRoles []string, condition *string)
 	afterUpdateRoleEndpointCounter  uint64
 	beforeUpdateRoleEndpointCounter uint64
 	UpdateRoleEndpointMock          mAccessRepositoryMockUpdateRoleEndpoint
@@ -54,12 +75,21 @@ func NewAccessRepositoryMock(t minimock.Tester) *AccessRepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AddDenyRuleMock = mAccessRepositoryMockAddDenyRule{mock: m}
+	m.AddDenyRuleMock.callArgs = []*AccessRepositoryMockAddDenyRuleParams{}
+
 	m.AddRoleEndpointMock = mAccessRepositoryMockAddRoleEndpoint{mock: m}
 	m.AddRoleEndpointMock.callArgs = []*AccessRepositoryMockAddRoleEndpointParams{}
 
+	m.DeleteDenyRuleMock = mAccessRepositoryMockDeleteDenyRule{mock: m}
+	m.DeleteDenyRuleMock.callArgs = []*AccessRepositoryMockDeleteDenyRuleParams{}
+
 	m.DeleteRoleEndpointMock = mAccessRepositoryMockDeleteRoleEndpoint{mock: m}
 	m.DeleteRoleEndpointMock.callArgs = []*AccessRepositoryMockDeleteRoleEndpointParams{}
 
+	m.GetDenyRulesMock = mAccessRepositoryMockGetDenyRules{mock: m}
+	m.GetDenyRulesMock.callArgs = []*AccessRepositoryMockGetDenyRulesParams{}
+
 	m.GetRoleEndpointsMock = mAccessRepositoryMockGetRoleEndpoints{mock: m}
 	m.GetRoleEndpointsMock.callArgs = []*AccessRepositoryMockGetRoleEndpointsParams{}
 
@@ -71,6 +101,348 @@ func NewAccessRepositoryMock(t minimock.Tester) *AccessRepositoryMock {
 	return m
 }
 
+type mAccessRepositoryMockAddDenyRule struct {
+	optional           bool
+	mock               *AccessRepositoryMock
+	defaultExpectation *AccessRepositoryMockAddDenyRuleExpectation
+	expectations       []*AccessRepositoryMockAddDenyRuleExpectation
+
+	callArgs []*AccessRepositoryMockAddDenyRuleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessRepositoryMockAddDenyRuleExpectation specifies expectation struct of the AccessRepository.AddDenyRule
+type AccessRepositoryMockAddDenyRuleExpectation struct {
+	mock               *AccessRepositoryMock
+	params             *AccessRepositoryMockAddDenyRuleParams
+	paramPtrs          *AccessRepositoryMockAddDenyRuleParamPtrs
+	expectationOrigins AccessRepositoryMockAddDenyRuleExpectationOrigins
+	results            *AccessRepositoryMockAddDenyRuleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessRepositoryMockAddDenyRuleParams contains parameters of the AccessRepository.AddDenyRule
+type AccessRepositoryMockAddDenyRuleParams struct {
+	ctx  context.Context
+	rule *model.DenyRule
+}
+
+// AccessRepositoryMockAddDenyRuleParamPtrs contains pointers to parameters of the AccessRepository.AddDenyRule
+type AccessRepositoryMockAddDenyRuleParamPtrs struct {
+	ctx  *context.Context
+	rule **model.DenyRule
+}
+
+// AccessRepositoryMockAddDenyRuleResults contains results of the AccessRepository.AddDenyRule
+type AccessRepositoryMockAddDenyRuleResults struct {
+	err error
+}
+
+// AccessRepositoryMockAddDenyRuleOrigins contains origins of expectations of the AccessRepository.AddDenyRule
+type AccessRepositoryMockAddDenyRuleExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originRule string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddDenyRule *mAccessRepositoryMockAddDenyRule) Optional() *mAccessRepositoryMockAddDenyRule {
+	mmAddDenyRule.optional = true
+	return mmAddDenyRule
+}
+
+// Expect sets up expected params for AccessRepository.AddDenyRule
+func (mmAddDenyRule *mAccessRepositoryMockAddDenyRule) Expect(ctx context.Context, rule *model.DenyRule) *mAccessRepositoryMockAddDenyRule {
+	if mmAddDenyRule.mock.funcAddDenyRule != nil {
+		mmAddDenyRule.mock.t.Fatalf("AccessRepositoryMock.AddDenyRule mock is already set by Set")
+	}
+
+	if mmAddDenyRule.defaultExpectation == nil {
+		mmAddDenyRule.defaultExpectation = &AccessRepositoryMockAddDenyRuleExpectation{}
+	}
+
+	if mmAddDenyRule.defaultExpectation.paramPtrs != nil {
+		mmAddDenyRule.mock.t.Fatalf("AccessRepositoryMock.AddDenyRule mock is already set by ExpectParams functions")
+	}
+
+	mmAddDenyRule.defaultExpectation.params = &AccessRepositoryMockAddDenyRuleParams{ctx, rule}
+	mmAddDenyRule.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddDenyRule.expectations {
+		if minimock.Equal(e.params, mmAddDenyRule.defaultExpectation.params) {
+			mmAddDenyRule.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddDenyRule.defaultExpectation.params)
+		}
+	}
+
+	return mmAddDenyRule
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessRepository.AddDenyRule
+func (mmAddDenyRule *mAccessRepositoryMockAddDenyRule) ExpectCtxParam1(ctx context.Context) *mAccessRepositoryMockAddDenyRule {
+	if mmAddDenyRule.mock.funcAddDenyRule != nil {
+		mmAddDenyRule.mock.t.Fatalf("AccessRepositoryMock.AddDenyRule mock is already set by Set")
+	}
+
+	if mmAddDenyRule.defaultExpectation == nil {
+		mmAddDenyRule.defaultExpectation = &AccessRepositoryMockAddDenyRuleExpectation{}
+	}
+
+	if mmAddDenyRule.defaultExpectation.params != nil {
+		mmAddDenyRule.mock.t.Fatalf("AccessRepositoryMock.AddDenyRule mock is already set by Expect")
+	}
+
+	if mmAddDenyRule.defaultExpectation.paramPtrs == nil {
+		mmAddDenyRule.defaultExpectation.paramPtrs = &AccessRepositoryMockAddDenyRuleParamPtrs{}
+	}
+	mmAddDenyRule.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddDenyRule.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddDenyRule
+}
+
+// ExpectRuleParam2 sets up expected param rule for AccessRepository.AddDenyRule
+func (mmAddDenyRule *mAccessRepositoryMockAddDenyRule) ExpectRuleParam2(rule *model.DenyRule) *mAccessRepositoryMockAddDenyRule {
+	if mmAddDenyRule.mock.funcAddDenyRule != nil {
+		mmAddDenyRule.mock.t.Fatalf("AccessRepositoryMock.AddDenyRule mock is already set by Set")
+	}
+
+	if mmAddDenyRule.defaultExpectation == nil {
+		mmAddDenyRule.defaultExpectation = &AccessRepositoryMockAddDenyRuleExpectation{}
+	}
+
+	if mmAddDenyRule.defaultExpectation.params != nil {
+		mmAddDenyRule.mock.t.Fatalf("AccessRepositoryMock.AddDenyRule mock is already set by Expect")
+	}
+
+	if mmAddDenyRule.defaultExpectation.paramPtrs == nil {
+		mmAddDenyRule.defaultExpectation.paramPtrs = &AccessRepositoryMockAddDenyRuleParamPtrs{}
+	}
+	mmAddDenyRule.defaultExpectation.paramPtrs.rule = &rule
+	mmAddDenyRule.defaultExpectation.expectationOrigins.originRule = minimock.CallerInfo(1)
+
+	return mmAddDenyRule
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessRepository.AddDenyRule
+func (mmAddDenyRule *mAccessRepositoryMockAddDenyRule) Inspect(f func(ctx context.Context, rule *model.DenyRule)) *mAccessRepositoryMockAddDenyRule {
+	if mmAddDenyRule.mock.inspectFuncAddDenyRule != nil {
+		mmAddDenyRule.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.AddDenyRule")
+	}
+
+	mmAddDenyRule.mock.inspectFuncAddDenyRule = f
+
+	return mmAddDenyRule
+}
+
+// Return sets up results that will be returned by AccessRepository.AddDenyRule
+func (mmAddDenyRule *mAccessRepositoryMockAddDenyRule) Return(err error) *AccessRepositoryMock {
+	if mmAddDenyRule.mock.funcAddDenyRule != nil {
+		mmAddDenyRule.mock.t.Fatalf("AccessRepositoryMock.AddDenyRule mock is already set by Set")
+	}
+
+	if mmAddDenyRule.defaultExpectation == nil {
+		mmAddDenyRule.defaultExpectation = &AccessRepositoryMockAddDenyRuleExpectation{mock: mmAddDenyRule.mock}
+	}
+	mmAddDenyRule.defaultExpectation.results = &AccessRepositoryMockAddDenyRuleResults{err}
+	mmAddDenyRule.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddDenyRule.mock
+}
+
+// Set uses given function f to mock the AccessRepository.AddDenyRule method
+func (mmAddDenyRule *mAccessRepositoryMockAddDenyRule) Set(f func(ctx context.Context, rule *model.DenyRule) (err error)) *AccessRepositoryMock {
+	if mmAddDenyRule.defaultExpectation != nil {
+		mmAddDenyRule.mock.t.Fatalf("Default expectation is already set for the AccessRepository.AddDenyRule method")
+	}
+
+	if len(mmAddDenyRule.expectations) > 0 {
+		mmAddDenyRule.mock.t.Fatalf("Some expectations are already set for the AccessRepository.AddDenyRule method")
+	}
+
+	mmAddDenyRule.mock.funcAddDenyRule = f
+	mmAddDenyRule.mock.funcAddDenyRuleOrigin = minimock.CallerInfo(1)
+	return mmAddDenyRule.mock
+}
+
+// When sets expectation for the AccessRepository.AddDenyRule which will trigger the result defined by the following
+// Then helper
+func (mmAddDenyRule *mAccessRepositoryMockAddDenyRule) When(ctx context.Context, rule *model.DenyRule) *AccessRepositoryMockAddDenyRuleExpectation {
+	if mmAddDenyRule.mock.funcAddDenyRule != nil {
+		mmAddDenyRule.mock.t.Fatalf("AccessRepositoryMock.AddDenyRule mock is already set by Set")
+	}
+
+	expectation := &AccessRepositoryMockAddDenyRuleExpectation{
+		mock:               mmAddDenyRule.mock,
+		params:             &AccessRepositoryMockAddDenyRuleParams{ctx, rule},
+		expectationOrigins: AccessRepositoryMockAddDenyRuleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddDenyRule.expectations = append(mmAddDenyRule.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessRepository.AddDenyRule return parameters for the expectation previously defined by the When method
+func (e *AccessRepositoryMockAddDenyRuleExpectation) Then(err error) *AccessRepositoryMock {
+	e.results = &AccessRepositoryMockAddDenyRuleResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessRepository.AddDenyRule should be invoked
+func (mmAddDenyRule *mAccessRepositoryMockAddDenyRule) Times(n uint64) *mAccessRepositoryMockAddDenyRule {
+	if n == 0 {
+		mmAddDenyRule.mock.t.Fatalf("Times of AccessRepositoryMock.AddDenyRule mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddDenyRule.expectedInvocations, n)
+	mmAddDenyRule.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddDenyRule
+}
+
+func (mmAddDenyRule *mAccessRepositoryMockAddDenyRule) invocationsDone() bool {
+	if len(mmAddDenyRule.expectations) == 0 && mmAddDenyRule.defaultExpectation == nil && mmAddDenyRule.mock.funcAddDenyRule == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddDenyRule.mock.afterAddDenyRuleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddDenyRule.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddDenyRule implements mm_repository.AccessRepository
+func (mmAddDenyRule *AccessRepositoryMock) AddDenyRule(ctx context.Context, rule *model.DenyRule) (err error) {
+	mm_atomic.AddUint64(&mmAddDenyRule.beforeAddDenyRuleCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddDenyRule.afterAddDenyRuleCounter, 1)
+
+	mmAddDenyRule.t.Helper()
+
+	if mmAddDenyRule.inspectFuncAddDenyRule != nil {
+		mmAddDenyRule.inspectFuncAddDenyRule(ctx, rule)
+	}
+
+	mm_params := AccessRepositoryMockAddDenyRuleParams{ctx, rule}
+
+	// Record call args
+	mmAddDenyRule.AddDenyRuleMock.mutex.Lock()
+	mmAddDenyRule.AddDenyRuleMock.callArgs = append(mmAddDenyRule.AddDenyRuleMock.callArgs, &mm_params)
+	mmAddDenyRule.AddDenyRuleMock.mutex.Unlock()
+
+	for _, e := range mmAddDenyRule.AddDenyRuleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddDenyRule.AddDenyRuleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddDenyRule.AddDenyRuleMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddDenyRule.AddDenyRuleMock.defaultExpectation.params
+		mm_want_ptrs := mmAddDenyRule.AddDenyRuleMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessRepositoryMockAddDenyRuleParams{ctx, rule}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddDenyRule.t.Errorf("AccessRepositoryMock.AddDenyRule got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddDenyRule.AddDenyRuleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.rule != nil && !minimock.Equal(*mm_want_ptrs.rule, mm_got.rule) {
+				mmAddDenyRule.t.Errorf("AccessRepositoryMock.AddDenyRule got unexpected parameter rule, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddDenyRule.AddDenyRuleMock.defaultExpectation.expectationOrigins.originRule, *mm_want_ptrs.rule, mm_got.rule, minimock.Diff(*mm_want_ptrs.rule, mm_got.rule))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddDenyRule.t.Errorf("AccessRepositoryMock.AddDenyRule got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddDenyRule.AddDenyRuleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddDenyRule.AddDenyRuleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddDenyRule.t.Fatal("No results are set for the AccessRepositoryMock.AddDenyRule")
+		}
+		return (*mm_results).err
+	}
+	if mmAddDenyRule.funcAddDenyRule != nil {
+		return mmAddDenyRule.funcAddDenyRule(ctx, rule)
+	}
+	mmAddDenyRule.t.Fatalf("Unexpected call to AccessRepositoryMock.AddDenyRule. %v %v", ctx, rule)
+	return
+}
+
+// AddDenyRuleAfterCounter returns a count of finished AccessRepositoryMock.AddDenyRule invocations
+func (mmAddDenyRule *AccessRepositoryMock) AddDenyRuleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddDenyRule.afterAddDenyRuleCounter)
+}
+
+// AddDenyRuleBeforeCounter returns a count of AccessRepositoryMock.AddDenyRule invocations
+func (mmAddDenyRule *AccessRepositoryMock) AddDenyRuleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddDenyRule.beforeAddDenyRuleCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessRepositoryMock.AddDenyRule.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddDenyRule *mAccessRepositoryMockAddDenyRule) Calls() []*AccessRepositoryMockAddDenyRuleParams {
+	mmAddDenyRule.mutex.RLock()
+
+	argCopy := make([]*AccessRepositoryMockAddDenyRuleParams, len(mmAddDenyRule.callArgs))
+	copy(argCopy, mmAddDenyRule.callArgs)
+
+	mmAddDenyRule.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddDenyRuleDone returns true if the count of the AddDenyRule invocations corresponds
+// the number of defined expectations
+func (m *AccessRepositoryMock) MinimockAddDenyRuleDone() bool {
+	if m.AddDenyRuleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddDenyRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddDenyRuleMock.invocationsDone()
+}
+
+// MinimockAddDenyRuleInspect logs each unmet expectation
+func (m *AccessRepositoryMock) MinimockAddDenyRuleInspect() {
+	for _, e := range m.AddDenyRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessRepositoryMock.AddDenyRule at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddDenyRuleCounter := mm_atomic.LoadUint64(&m.afterAddDenyRuleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddDenyRuleMock.defaultExpectation != nil && afterAddDenyRuleCounter < 1 {
+		if m.AddDenyRuleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessRepositoryMock.AddDenyRule at\n%s", m.AddDenyRuleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessRepositoryMock.AddDenyRule at\n%s with params: %#v", m.AddDenyRuleMock.defaultExpectation.expectationOrigins.origin, *m.AddDenyRuleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddDenyRule != nil && afterAddDenyRuleCounter < 1 {
+		m.t.Errorf("Expected call to AccessRepositoryMock.AddDenyRule at\n%s", m.funcAddDenyRuleOrigin)
+	}
+
+	if !m.AddDenyRuleMock.invocationsDone() && afterAddDenyRuleCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessRepositoryMock.AddDenyRule at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddDenyRuleMock.expectedInvocations), m.AddDenyRuleMock.expectedInvocationsOrigin, afterAddDenyRuleCounter)
+	}
+}
+
 type mAccessRepositoryMockAddRoleEndpoint struct {
 	optional           bool
 	mock               *AccessRepositoryMock
@@ -100,6 +472,7 @@ type AccessRepositoryMockAddRoleEndpointParams struct {
 	ctx          context.Context
 	endpoint     string
 	allowedRoles []string
+	condition    string
 }
 
 // AccessRepositoryMockAddRoleEndpointParamPtrs contains pointers to parameters of the AccessRepository.AddRoleEndpoint
@@ -107,6 +480,7 @@ type AccessRepositoryMockAddRoleEndpointParamPtrs struct {
 	ctx          *context.Context
 	endpoint     *string
 	allowedRoles *[]string
+	condition    *string
 }
 
 // AccessRepositoryMockAddRoleEndpointResults contains results of the AccessRepository.AddRoleEndpoint
@@ -120,6 +494,7 @@ type AccessRepositoryMockAddRoleEndpointExpectationOrigins struct {
 	originCtx          string
 	originEndpoint     string
 	originAllowedRoles string
+	originCondition    string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -133,7 +508,7 @@ func (mmAddRoleEndpoint *mAccessRepositoryMockAddRoleEndpoint) Optional() *mAcce
 }
 
 // Expect sets up expected params for AccessRepository.AddRoleEndpoint
-func (mmAddRoleEndpoint *mAccessRepositoryMockAddRoleEndpoint) Expect(ctx context.Context, endpoint string, allowedRoles []string) *mAccessRepositoryMockAddRoleEndpoint {
+func (mmAddRoleEndpoint *mAccessRepositoryMockAddRoleEndpoint) Expect(ctx context.Context, endpoint string, allowedRoles []string, condition string) *mAccessRepositoryMockAddRoleEndpoint {
 	if mmAddRoleEndpoint.mock.funcAddRoleEndpoint != nil {
 		mmAddRoleEndpoint.mock.t.Fatalf("AccessRepositoryMock.AddRoleEndpoint mock is already set by Set")
 	}
@@ -146,7 +521,7 @@ func (mmAddRoleEndpoint *mAccessRepositoryMockAddRoleEndpoint) Expect(ctx contex
 		mmAddRoleEndpoint.mock.t.Fatalf("AccessRepositoryMock.AddRoleEndpoint mock is already set by ExpectParams functions")
 	}
 
-	mmAddRoleEndpoint.defaultExpectation.params = &AccessRepositoryMockAddRoleEndpointParams{ctx, endpoint, allowedRoles}
+	mmAddRoleEndpoint.defaultExpectation.params = &AccessRepositoryMockAddRoleEndpointParams{ctx, endpoint, allowedRoles, condition}
 	mmAddRoleEndpoint.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmAddRoleEndpoint.expectations {
 		if minimock.Equal(e.params, mmAddRoleEndpoint.defaultExpectation.params) {
@@ -226,8 +601,31 @@ func (mmAddRoleEndpoint *mAccessRepositoryMockAddRoleEndpoint) ExpectAllowedRole
 	return mmAddRoleEndpoint
 }
 
+// ExpectConditionParam4 sets up expected param condition for AccessRepository.AddRoleEndpoint
+func (mmAddRoleEndpoint *mAccessRepositoryMockAddRoleEndpoint) ExpectConditionParam4(condition string) *mAccessRepositoryMockAddRoleEndpoint {
+	if mmAddRoleEndpoint.mock.funcAddRoleEndpoint != nil {
+		mmAddRoleEndpoint.mock.t.Fatalf("AccessRepositoryMock.AddRoleEndpoint mock is already set by Set")
+	}
+
+	if mmAddRoleEndpoint.defaultExpectation == nil {
+		mmAddRoleEndpoint.defaultExpectation = &AccessRepositoryMockAddRoleEndpointExpectation{}
+	}
+
+	if mmAddRoleEndpoint.defaultExpectation.params != nil {
+		mmAddRoleEndpoint.mock.t.Fatalf("AccessRepositoryMock.AddRoleEndpoint mock is already set by Expect")
+	}
+
+	if mmAddRoleEndpoint.defaultExpectation.paramPtrs == nil {
+		mmAddRoleEndpoint.defaultExpectation.paramPtrs = &AccessRepositoryMockAddRoleEndpointParamPtrs{}
+	}
+	mmAddRoleEndpoint.defaultExpectation.paramPtrs.condition = &condition
+	mmAddRoleEndpoint.defaultExpectation.expectationOrigins.originCondition = minimock.CallerInfo(1)
+
+	return mmAddRoleEndpoint
+}
+
 // Inspect accepts an inspector function that has same arguments as the AccessRepository.AddRoleEndpoint
-func (mmAddRoleEndpoint *mAccessRepositoryMockAddRoleEndpoint) Inspect(f func(ctx context.Context, endpoint string, allowedRoles []string)) *mAccessRepositoryMockAddRoleEndpoint {
+func (mmAddRoleEndpoint *mAccessRepositoryMockAddRoleEndpoint) Inspect(f func(ctx context.Context, endpoint string, allowedRoles []string, condition string)) *mAccessRepositoryMockAddRoleEndpoint {
 	if mmAddRoleEndpoint.mock.inspectFuncAddRoleEndpoint != nil {
 		mmAddRoleEndpoint.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.AddRoleEndpoint")
 	}
@@ -252,7 +650,7 @@ func (mmAddRoleEndpoint *mAccessRepositoryMockAddRoleEndpoint) Return(err error)
 }
 
 // Set uses given function f to mock the AccessRepository.AddRoleEndpoint method
-func (mmAddRoleEndpoint *mAccessRepositoryMockAddRoleEndpoint) Set(f func(ctx context.Context, endpoint string, allowedRoles []string) (err error)) *AccessRepositoryMock {
+func (mmAddRoleEndpoint *mAccessRepositoryMockAddRoleEndpoint) Set(f func(ctx context.Context, endpoint string, allowedRoles []string, condition string) (err error)) *AccessRepositoryMock {
 	if mmAddRoleEndpoint.defaultExpectation != nil {
 		mmAddRoleEndpoint.mock.t.Fatalf("Default expectation is already set for the AccessRepository.AddRoleEndpoint method")
 	}
@@ -268,179 +666,526 @@ func (mmAddRoleEndpoint *mAccessRepositoryMockAddRoleEndpoint) Set(f func(ctx co
 
 // When sets expectation for the AccessRepository.AddRoleEndpoint which will trigger the result defined by the following
 // Then helper
-func (mmAddRoleEndpoint *mAccessRepositoryMockAddRoleEndpoint) When(ctx context.Context, endpoint string, allowedRoles []string) *AccessRepositoryMockAddRoleEndpointExpectation {
+func (mmAddRoleEndpoint *mAccessRepositoryMockAddRoleEndpoint) When(ctx context.Context, endpoint string, allowedRoles []string, condition string) *AccessRepositoryMockAddRoleEndpointExpectation {
 	if mmAddRoleEndpoint.mock.funcAddRoleEndpoint != nil {
 		mmAddRoleEndpoint.mock.t.Fatalf("AccessRepositoryMock.AddRoleEndpoint mock is already set by Set")
 	}
 
 	expectation := &AccessRepositoryMockAddRoleEndpointExpectation{
 		mock:               mmAddRoleEndpoint.mock,
-		params:             &AccessRepositoryMockAddRoleEndpointParams{ctx, endpoint, allowedRoles},
+		params:             &AccessRepositoryMockAddRoleEndpointParams{ctx, endpoint, allowedRoles, condition},
 		expectationOrigins: AccessRepositoryMockAddRoleEndpointExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmAddRoleEndpoint.expectations = append(mmAddRoleEndpoint.expectations, expectation)
+	mmAddRoleEndpoint.expectations = append(mmAddRoleEndpoint.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessRepository.AddRoleEndpoint return parameters for the expectation previously defined by the When method
+func (e *AccessRepositoryMockAddRoleEndpointExpectation) Then(err error) *AccessRepositoryMock {
+	e.results = &AccessRepositoryMockAddRoleEndpointResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessRepository.AddRoleEndpoint should be invoked
+func (mmAddRoleEndpoint *mAccessRepositoryMockAddRoleEndpoint) Times(n uint64) *mAccessRepositoryMockAddRoleEndpoint {
+	if n == 0 {
+		mmAddRoleEndpoint.mock.t.Fatalf("Times of AccessRepositoryMock.AddRoleEndpoint mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddRoleEndpoint.expectedInvocations, n)
+	mmAddRoleEndpoint.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddRoleEndpoint
+}
+
+func (mmAddRoleEndpoint *mAccessRepositoryMockAddRoleEndpoint) invocationsDone() bool {
+	if len(mmAddRoleEndpoint.expectations) == 0 && mmAddRoleEndpoint.defaultExpectation == nil && mmAddRoleEndpoint.mock.funcAddRoleEndpoint == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddRoleEndpoint.mock.afterAddRoleEndpointCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddRoleEndpoint.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddRoleEndpoint implements mm_repository.AccessRepository
+func (mmAddRoleEndpoint *AccessRepositoryMock) AddRoleEndpoint(ctx context.Context, endpoint string, allowedRoles []string, condition string) (err error) {
+	mm_atomic.AddUint64(&mmAddRoleEndpoint.beforeAddRoleEndpointCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddRoleEndpoint.afterAddRoleEndpointCounter, 1)
+
+	mmAddRoleEndpoint.t.Helper()
+
+	if mmAddRoleEndpoint.inspectFuncAddRoleEndpoint != nil {
+		mmAddRoleEndpoint.inspectFuncAddRoleEndpoint(ctx, endpoint, allowedRoles, condition)
+	}
+
+	mm_params := AccessRepositoryMockAddRoleEndpointParams{ctx, endpoint, allowedRoles, condition}
+
+	// Record call args
+	mmAddRoleEndpoint.AddRoleEndpointMock.mutex.Lock()
+	mmAddRoleEndpoint.AddRoleEndpointMock.callArgs = append(mmAddRoleEndpoint.AddRoleEndpointMock.callArgs, &mm_params)
+	mmAddRoleEndpoint.AddRoleEndpointMock.mutex.Unlock()
+
+	for _, e := range mmAddRoleEndpoint.AddRoleEndpointMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation.params
+		mm_want_ptrs := mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessRepositoryMockAddRoleEndpointParams{ctx, endpoint, allowedRoles, condition}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddRoleEndpoint.t.Errorf("AccessRepositoryMock.AddRoleEndpoint got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.endpoint != nil && !minimock.Equal(*mm_want_ptrs.endpoint, mm_got.endpoint) {
+				mmAddRoleEndpoint.t.Errorf("AccessRepositoryMock.AddRoleEndpoint got unexpected parameter endpoint, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation.expectationOrigins.originEndpoint, *mm_want_ptrs.endpoint, mm_got.endpoint, minimock.Diff(*mm_want_ptrs.endpoint, mm_got.endpoint))
+			}
+
+			if mm_want_ptrs.allowedRoles != nil && !minimock.Equal(*mm_want_ptrs.allowedRoles, mm_got.allowedRoles) {
+				mmAddRoleEndpoint.t.Errorf("AccessRepositoryMock.AddRoleEndpoint got unexpected parameter allowedRoles, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation.expectationOrigins.originAllowedRoles, *mm_want_ptrs.allowedRoles, mm_got.allowedRoles, minimock.Diff(*mm_want_ptrs.allowedRoles, mm_got.allowedRoles))
+			}
+
+			if mm_want_ptrs.condition != nil && !minimock.Equal(*mm_want_ptrs.condition, mm_got.condition) {
+				mmAddRoleEndpoint.t.Errorf("AccessRepositoryMock.AddRoleEndpoint got unexpected parameter condition, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation.expectationOrigins.originCondition, *mm_want_ptrs.condition, mm_got.condition, minimock.Diff(*mm_want_ptrs.condition, mm_got.condition))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddRoleEndpoint.t.Errorf("AccessRepositoryMock.AddRoleEndpoint got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddRoleEndpoint.t.Fatal("No results are set for the AccessRepositoryMock.AddRoleEndpoint")
+		}
+		return (*mm_results).err
+	}
+	if mmAddRoleEndpoint.funcAddRoleEndpoint != nil {
+		return mmAddRoleEndpoint.funcAddRoleEndpoint(ctx, endpoint, allowedRoles, condition)
+	}
+	mmAddRoleEndpoint.t.Fatalf("Unexpected call to AccessRepositoryMock.AddRoleEndpoint. %v %v %v %v", ctx, endpoint, allowedRoles, condition)
+	return
+}
+
+// AddRoleEndpointAfterCounter returns a count of finished AccessRepositoryMock.AddRoleEndpoint invocations
+func (mmAddRoleEndpoint *AccessRepositoryMock) AddRoleEndpointAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddRoleEndpoint.afterAddRoleEndpointCounter)
+}
+
+// AddRoleEndpointBeforeCounter returns a count of AccessRepositoryMock.AddRoleEndpoint invocations
+func (mmAddRoleEndpoint *AccessRepositoryMock) AddRoleEndpointBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddRoleEndpoint.beforeAddRoleEndpointCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessRepositoryMock.AddRoleEndpoint.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddRoleEndpoint *mAccessRepositoryMockAddRoleEndpoint) Calls() []*AccessRepositoryMockAddRoleEndpointParams {
+	mmAddRoleEndpoint.mutex.RLock()
+
+	argCopy := make([]*AccessRepositoryMockAddRoleEndpointParams, len(mmAddRoleEndpoint.callArgs))
+	copy(argCopy, mmAddRoleEndpoint.callArgs)
+
+	mmAddRoleEndpoint.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddRoleEndpointDone returns true if the count of the AddRoleEndpoint invocations corresponds
+// the number of defined expectations
+func (m *AccessRepositoryMock) MinimockAddRoleEndpointDone() bool {
+	if m.AddRoleEndpointMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddRoleEndpointMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddRoleEndpointMock.invocationsDone()
+}
+
+// MinimockAddRoleEndpointInspect logs each unmet expectation
+func (m *AccessRepositoryMock) MinimockAddRoleEndpointInspect() {
+	for _, e := range m.AddRoleEndpointMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessRepositoryMock.AddRoleEndpoint at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddRoleEndpointCounter := mm_atomic.LoadUint64(&m.afterAddRoleEndpointCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddRoleEndpointMock.defaultExpectation != nil && afterAddRoleEndpointCounter < 1 {
+		if m.AddRoleEndpointMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessRepositoryMock.AddRoleEndpoint at\n%s", m.AddRoleEndpointMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessRepositoryMock.AddRoleEndpoint at\n%s with params: %#v", m.AddRoleEndpointMock.defaultExpectation.expectationOrigins.origin, *m.AddRoleEndpointMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddRoleEndpoint != nil && afterAddRoleEndpointCounter < 1 {
+		m.t.Errorf("Expected call to AccessRepositoryMock.AddRoleEndpoint at\n%s", m.funcAddRoleEndpointOrigin)
+	}
+
+	if !m.AddRoleEndpointMock.invocationsDone() && afterAddRoleEndpointCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessRepositoryMock.AddRoleEndpoint at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddRoleEndpointMock.expectedInvocations), m.AddRoleEndpointMock.expectedInvocationsOrigin, afterAddRoleEndpointCounter)
+	}
+}
+
+type mAccessRepositoryMockDeleteDenyRule struct {
+	optional           bool
+	mock               *AccessRepositoryMock
+	defaultExpectation *AccessRepositoryMockDeleteDenyRuleExpectation
+	expectations       []*AccessRepositoryMockDeleteDenyRuleExpectation
+
+	callArgs []*AccessRepositoryMockDeleteDenyRuleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessRepositoryMockDeleteDenyRuleExpectation specifies expectation struct of the AccessRepository.DeleteDenyRule
+type AccessRepositoryMockDeleteDenyRuleExpectation struct {
+	mock               *AccessRepositoryMock
+	params             *AccessRepositoryMockDeleteDenyRuleParams
+	paramPtrs          *AccessRepositoryMockDeleteDenyRuleParamPtrs
+	expectationOrigins AccessRepositoryMockDeleteDenyRuleExpectationOrigins
+	results            *AccessRepositoryMockDeleteDenyRuleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessRepositoryMockDeleteDenyRuleParams contains parameters of the AccessRepository.DeleteDenyRule
+type AccessRepositoryMockDeleteDenyRuleParams struct {
+	ctx  context.Context
+	name string
+}
+
+// AccessRepositoryMockDeleteDenyRuleParamPtrs contains pointers to parameters of the AccessRepository.DeleteDenyRule
+type AccessRepositoryMockDeleteDenyRuleParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// AccessRepositoryMockDeleteDenyRuleResults contains results of the AccessRepository.DeleteDenyRule
+type AccessRepositoryMockDeleteDenyRuleResults struct {
+	err error
+}
+
+// AccessRepositoryMockDeleteDenyRuleOrigins contains origins of expectations of the AccessRepository.DeleteDenyRule
+type AccessRepositoryMockDeleteDenyRuleExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteDenyRule *mAccessRepositoryMockDeleteDenyRule) Optional() *mAccessRepositoryMockDeleteDenyRule {
+	mmDeleteDenyRule.optional = true
+	return mmDeleteDenyRule
+}
+
+// Expect sets up expected params for AccessRepository.DeleteDenyRule
+func (mmDeleteDenyRule *mAccessRepositoryMockDeleteDenyRule) Expect(ctx context.Context, name string) *mAccessRepositoryMockDeleteDenyRule {
+	if mmDeleteDenyRule.mock.funcDeleteDenyRule != nil {
+		mmDeleteDenyRule.mock.t.Fatalf("AccessRepositoryMock.DeleteDenyRule mock is already set by Set")
+	}
+
+	if mmDeleteDenyRule.defaultExpectation == nil {
+		mmDeleteDenyRule.defaultExpectation = &AccessRepositoryMockDeleteDenyRuleExpectation{}
+	}
+
+	if mmDeleteDenyRule.defaultExpectation.paramPtrs != nil {
+		mmDeleteDenyRule.mock.t.Fatalf("AccessRepositoryMock.DeleteDenyRule mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteDenyRule.defaultExpectation.params = &AccessRepositoryMockDeleteDenyRuleParams{ctx, name}
+	mmDeleteDenyRule.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteDenyRule.expectations {
+		if minimock.Equal(e.params, mmDeleteDenyRule.defaultExpectation.params) {
+			mmDeleteDenyRule.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteDenyRule.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteDenyRule
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessRepository.DeleteDenyRule
+func (mmDeleteDenyRule *mAccessRepositoryMockDeleteDenyRule) ExpectCtxParam1(ctx context.Context) *mAccessRepositoryMockDeleteDenyRule {
+	if mmDeleteDenyRule.mock.funcDeleteDenyRule != nil {
+		mmDeleteDenyRule.mock.t.Fatalf("AccessRepositoryMock.DeleteDenyRule mock is already set by Set")
+	}
+
+	if mmDeleteDenyRule.defaultExpectation == nil {
+		mmDeleteDenyRule.defaultExpectation = &AccessRepositoryMockDeleteDenyRuleExpectation{}
+	}
+
+	if mmDeleteDenyRule.defaultExpectation.params != nil {
+		mmDeleteDenyRule.mock.t.Fatalf("AccessRepositoryMock.DeleteDenyRule mock is already set by Expect")
+	}
+
+	if mmDeleteDenyRule.defaultExpectation.paramPtrs == nil {
+		mmDeleteDenyRule.defaultExpectation.paramPtrs = &AccessRepositoryMockDeleteDenyRuleParamPtrs{}
+	}
+	mmDeleteDenyRule.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteDenyRule.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteDenyRule
+}
+
+// ExpectNameParam2 sets up expected param name for AccessRepository.DeleteDenyRule
+func (mmDeleteDenyRule *mAccessRepositoryMockDeleteDenyRule) ExpectNameParam2(name string) *mAccessRepositoryMockDeleteDenyRule {
+	if mmDeleteDenyRule.mock.funcDeleteDenyRule != nil {
+		mmDeleteDenyRule.mock.t.Fatalf("AccessRepositoryMock.DeleteDenyRule mock is already set by Set")
+	}
+
+	if mmDeleteDenyRule.defaultExpectation == nil {
+		mmDeleteDenyRule.defaultExpectation = &AccessRepositoryMockDeleteDenyRuleExpectation{}
+	}
+
+	if mmDeleteDenyRule.defaultExpectation.params != nil {
+		mmDeleteDenyRule.mock.t.Fatalf("AccessRepositoryMock.DeleteDenyRule mock is already set by Expect")
+	}
+
+	if mmDeleteDenyRule.defaultExpectation.paramPtrs == nil {
+		mmDeleteDenyRule.defaultExpectation.paramPtrs = &AccessRepositoryMockDeleteDenyRuleParamPtrs{}
+	}
+	mmDeleteDenyRule.defaultExpectation.paramPtrs.name = &name
+	mmDeleteDenyRule.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmDeleteDenyRule
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessRepository.DeleteDenyRule
+func (mmDeleteDenyRule *mAccessRepositoryMockDeleteDenyRule) Inspect(f func(ctx context.Context, name string)) *mAccessRepositoryMockDeleteDenyRule {
+	if mmDeleteDenyRule.mock.inspectFuncDeleteDenyRule != nil {
+		mmDeleteDenyRule.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.DeleteDenyRule")
+	}
+
+	mmDeleteDenyRule.mock.inspectFuncDeleteDenyRule = f
+
+	return mmDeleteDenyRule
+}
+
+// Return sets up results that will be returned by AccessRepository.DeleteDenyRule
+func (mmDeleteDenyRule *mAccessRepositoryMockDeleteDenyRule) Return(err error) *AccessRepositoryMock {
+	if mmDeleteDenyRule.mock.funcDeleteDenyRule != nil {
+		mmDeleteDenyRule.mock.t.Fatalf("AccessRepositoryMock.DeleteDenyRule mock is already set by Set")
+	}
+
+	if mmDeleteDenyRule.defaultExpectation == nil {
+		mmDeleteDenyRule.defaultExpectation = &AccessRepositoryMockDeleteDenyRuleExpectation{mock: mmDeleteDenyRule.mock}
+	}
+	mmDeleteDenyRule.defaultExpectation.results = &AccessRepositoryMockDeleteDenyRuleResults{err}
+	mmDeleteDenyRule.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteDenyRule.mock
+}
+
+// Set uses given function f to mock the AccessRepository.DeleteDenyRule method
+func (mmDeleteDenyRule *mAccessRepositoryMockDeleteDenyRule) Set(f func(ctx context.Context, name string) (err error)) *AccessRepositoryMock {
+	if mmDeleteDenyRule.defaultExpectation != nil {
+		mmDeleteDenyRule.mock.t.Fatalf("Default expectation is already set for the AccessRepository.DeleteDenyRule method")
+	}
+
+	if len(mmDeleteDenyRule.expectations) > 0 {
+		mmDeleteDenyRule.mock.t.Fatalf("Some expectations are already set for the AccessRepository.DeleteDenyRule method")
+	}
+
+	mmDeleteDenyRule.mock.funcDeleteDenyRule = f
+	mmDeleteDenyRule.mock.funcDeleteDenyRuleOrigin = minimock.CallerInfo(1)
+	return mmDeleteDenyRule.mock
+}
+
+// When sets expectation for the AccessRepository.DeleteDenyRule which will trigger the result defined by the following
+// Then helper
+func (mmDeleteDenyRule *mAccessRepositoryMockDeleteDenyRule) When(ctx context.Context, name string) *AccessRepositoryMockDeleteDenyRuleExpectation {
+	if mmDeleteDenyRule.mock.funcDeleteDenyRule != nil {
+		mmDeleteDenyRule.mock.t.Fatalf("AccessRepositoryMock.DeleteDenyRule mock is already set by Set")
+	}
+
+	expectation := &AccessRepositoryMockDeleteDenyRuleExpectation{
+		mock:               mmDeleteDenyRule.mock,
+		params:             &AccessRepositoryMockDeleteDenyRuleParams{ctx, name},
+		expectationOrigins: AccessRepositoryMockDeleteDenyRuleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteDenyRule.expectations = append(mmDeleteDenyRule.expectations, expectation)
 	return expectation
 }
 
-// Then sets up AccessRepository.AddRoleEndpoint return parameters for the expectation previously defined by the When method
-func (e *AccessRepositoryMockAddRoleEndpointExpectation) Then(err error) *AccessRepositoryMock {
-	e.results = &AccessRepositoryMockAddRoleEndpointResults{err}
+// Then sets up AccessRepository.DeleteDenyRule return parameters for the expectation previously defined by the When method
+func (e *AccessRepositoryMockDeleteDenyRuleExpectation) Then(err error) *AccessRepositoryMock {
+	e.results = &AccessRepositoryMockDeleteDenyRuleResults{err}
 	return e.mock
 }
 
-// Times sets number of times AccessRepository.AddRoleEndpoint should be invoked
-func (mmAddRoleEndpoint *mAccessRepositoryMockAddRoleEndpoint) Times(n uint64) *mAccessRepositoryMockAddRoleEndpoint {
+// Times sets number of times AccessRepository.DeleteDenyRule should be invoked
+func (mmDeleteDenyRule *mAccessRepositoryMockDeleteDenyRule) Times(n uint64) *mAccessRepositoryMockDeleteDenyRule {
 	if n == 0 {
-		mmAddRoleEndpoint.mock.t.Fatalf("Times of AccessRepositoryMock.AddRoleEndpoint mock can not be zero")
+		mmDeleteDenyRule.mock.t.Fatalf("Times of AccessRepositoryMock.DeleteDenyRule mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmAddRoleEndpoint.expectedInvocations, n)
-	mmAddRoleEndpoint.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmAddRoleEndpoint
+	mm_atomic.StoreUint64(&mmDeleteDenyRule.expectedInvocations, n)
+	mmDeleteDenyRule.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteDenyRule
 }
 
-func (mmAddRoleEndpoint *mAccessRepositoryMockAddRoleEndpoint) invocationsDone() bool {
-	if len(mmAddRoleEndpoint.expectations) == 0 && mmAddRoleEndpoint.defaultExpectation == nil && mmAddRoleEndpoint.mock.funcAddRoleEndpoint == nil {
+func (mmDeleteDenyRule *mAccessRepositoryMockDeleteDenyRule) invocationsDone() bool {
+	if len(mmDeleteDenyRule.expectations) == 0 && mmDeleteDenyRule.defaultExpectation == nil && mmDeleteDenyRule.mock.funcDeleteDenyRule == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmAddRoleEndpoint.mock.afterAddRoleEndpointCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmAddRoleEndpoint.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteDenyRule.mock.afterDeleteDenyRuleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteDenyRule.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// AddRoleEndpoint implements mm_repository.AccessRepository
-func (mmAddRoleEndpoint *AccessRepositoryMock) AddRoleEndpoint(ctx context.Context, endpoint string, allowedRoles []string) (err error) {
-	mm_atomic.AddUint64(&mmAddRoleEndpoint.beforeAddRoleEndpointCounter, 1)
-	defer mm_atomic.AddUint64(&mmAddRoleEndpoint.afterAddRoleEndpointCounter, 1)
+// DeleteDenyRule implements mm_repository.AccessRepository
+func (mmDeleteDenyRule *AccessRepositoryMock) DeleteDenyRule(ctx context.Context, name string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteDenyRule.beforeDeleteDenyRuleCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteDenyRule.afterDeleteDenyRuleCounter, 1)
 
-	mmAddRoleEndpoint.t.Helper()
+	mmDeleteDenyRule.t.Helper()
 
-	if mmAddRoleEndpoint.inspectFuncAddRoleEndpoint != nil {
-		mmAddRoleEndpoint.inspectFuncAddRoleEndpoint(ctx, endpoint, allowedRoles)
+	if mmDeleteDenyRule.inspectFuncDeleteDenyRule != nil {
+		mmDeleteDenyRule.inspectFuncDeleteDenyRule(ctx, name)
 	}
 
-	mm_params := AccessRepositoryMockAddRoleEndpointParams{ctx, endpoint, allowedRoles}
+	mm_params := AccessRepositoryMockDeleteDenyRuleParams{ctx, name}
 
 	// Record call args
-	mmAddRoleEndpoint.AddRoleEndpointMock.mutex.Lock()
-	mmAddRoleEndpoint.AddRoleEndpointMock.callArgs = append(mmAddRoleEndpoint.AddRoleEndpointMock.callArgs, &mm_params)
-	mmAddRoleEndpoint.AddRoleEndpointMock.mutex.Unlock()
+	mmDeleteDenyRule.DeleteDenyRuleMock.mutex.Lock()
+	mmDeleteDenyRule.DeleteDenyRuleMock.callArgs = append(mmDeleteDenyRule.DeleteDenyRuleMock.callArgs, &mm_params)
+	mmDeleteDenyRule.DeleteDenyRuleMock.mutex.Unlock()
 
-	for _, e := range mmAddRoleEndpoint.AddRoleEndpointMock.expectations {
+	for _, e := range mmDeleteDenyRule.DeleteDenyRuleMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation.Counter, 1)
-		mm_want := mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation.params
-		mm_want_ptrs := mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation.paramPtrs
+	if mmDeleteDenyRule.DeleteDenyRuleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteDenyRule.DeleteDenyRuleMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteDenyRule.DeleteDenyRuleMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteDenyRule.DeleteDenyRuleMock.defaultExpectation.paramPtrs
 
-		mm_got := AccessRepositoryMockAddRoleEndpointParams{ctx, endpoint, allowedRoles}
+		mm_got := AccessRepositoryMockDeleteDenyRuleParams{ctx, name}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmAddRoleEndpoint.t.Errorf("AccessRepositoryMock.AddRoleEndpoint got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.endpoint != nil && !minimock.Equal(*mm_want_ptrs.endpoint, mm_got.endpoint) {
-				mmAddRoleEndpoint.t.Errorf("AccessRepositoryMock.AddRoleEndpoint got unexpected parameter endpoint, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation.expectationOrigins.originEndpoint, *mm_want_ptrs.endpoint, mm_got.endpoint, minimock.Diff(*mm_want_ptrs.endpoint, mm_got.endpoint))
+				mmDeleteDenyRule.t.Errorf("AccessRepositoryMock.DeleteDenyRule got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteDenyRule.DeleteDenyRuleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.allowedRoles != nil && !minimock.Equal(*mm_want_ptrs.allowedRoles, mm_got.allowedRoles) {
-				mmAddRoleEndpoint.t.Errorf("AccessRepositoryMock.AddRoleEndpoint got unexpected parameter allowedRoles, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation.expectationOrigins.originAllowedRoles, *mm_want_ptrs.allowedRoles, mm_got.allowedRoles, minimock.Diff(*mm_want_ptrs.allowedRoles, mm_got.allowedRoles))
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmDeleteDenyRule.t.Errorf("AccessRepositoryMock.DeleteDenyRule got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteDenyRule.DeleteDenyRuleMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmAddRoleEndpoint.t.Errorf("AccessRepositoryMock.AddRoleEndpoint got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmDeleteDenyRule.t.Errorf("AccessRepositoryMock.DeleteDenyRule got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteDenyRule.DeleteDenyRuleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation.results
+		mm_results := mmDeleteDenyRule.DeleteDenyRuleMock.defaultExpectation.results
 		if mm_results == nil {
-			mmAddRoleEndpoint.t.Fatal("No results are set for the AccessRepositoryMock.AddRoleEndpoint")
+			mmDeleteDenyRule.t.Fatal("No results are set for the AccessRepositoryMock.DeleteDenyRule")
 		}
 		return (*mm_results).err
 	}
-	if mmAddRoleEndpoint.funcAddRoleEndpoint != nil {
-		return mmAddRoleEndpoint.funcAddRoleEndpoint(ctx, endpoint, allowedRoles)
+	if mmDeleteDenyRule.funcDeleteDenyRule != nil {
+		return mmDeleteDenyRule.funcDeleteDenyRule(ctx, name)
 	}
-	mmAddRoleEndpoint.t.Fatalf("Unexpected call to AccessRepositoryMock.AddRoleEndpoint. %v %v %v", ctx, endpoint, allowedRoles)
+	mmDeleteDenyRule.t.Fatalf("Unexpected call to AccessRepositoryMock.DeleteDenyRule. %v %v", ctx, name)
 	return
 }
 
-// AddRoleEndpointAfterCounter returns a count of finished AccessRepositoryMock.AddRoleEndpoint invocations
-func (mmAddRoleEndpoint *AccessRepositoryMock) AddRoleEndpointAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmAddRoleEndpoint.afterAddRoleEndpointCounter)
+// DeleteDenyRuleAfterCounter returns a count of finished AccessRepositoryMock.DeleteDenyRule invocations
+func (mmDeleteDenyRule *AccessRepositoryMock) DeleteDenyRuleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteDenyRule.afterDeleteDenyRuleCounter)
 }
 
-// AddRoleEndpointBeforeCounter returns a count of AccessRepositoryMock.AddRoleEndpoint invocations
-func (mmAddRoleEndpoint *AccessRepositoryMock) AddRoleEndpointBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmAddRoleEndpoint.beforeAddRoleEndpointCounter)
+// DeleteDenyRuleBeforeCounter returns a count of AccessRepositoryMock.DeleteDenyRule invocations
+func (mmDeleteDenyRule *AccessRepositoryMock) DeleteDenyRuleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteDenyRule.beforeDeleteDenyRuleCounter)
 }
 
-// Calls returns a list of arguments used in each call to AccessRepositoryMock.AddRoleEndpoint.
+// Calls returns a list of arguments used in each call to AccessRepositoryMock.DeleteDenyRule.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmAddRoleEndpoint *mAccessRepositoryMockAddRoleEndpoint) Calls() []*AccessRepositoryMockAddRoleEndpointParams {
-	mmAddRoleEndpoint.mutex.RLock()
+func (mmDeleteDenyRule *mAccessRepositoryMockDeleteDenyRule) Calls() []*AccessRepositoryMockDeleteDenyRuleParams {
+	mmDeleteDenyRule.mutex.RLock()
 
-	argCopy := make([]*AccessRepositoryMockAddRoleEndpointParams, len(mmAddRoleEndpoint.callArgs))
-	copy(argCopy, mmAddRoleEndpoint.callArgs)
+	argCopy := make([]*AccessRepositoryMockDeleteDenyRuleParams, len(mmDeleteDenyRule.callArgs))
+	copy(argCopy, mmDeleteDenyRule.callArgs)
 
-	mmAddRoleEndpoint.mutex.RUnlock()
+	mmDeleteDenyRule.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockAddRoleEndpointDone returns true if the count of the AddRoleEndpoint invocations corresponds
+// MinimockDeleteDenyRuleDone returns true if the count of the DeleteDenyRule invocations corresponds
 // the number of defined expectations
-func (m *AccessRepositoryMock) MinimockAddRoleEndpointDone() bool {
-	if m.AddRoleEndpointMock.optional {
+func (m *AccessRepositoryMock) MinimockDeleteDenyRuleDone() bool {
+	if m.DeleteDenyRuleMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.AddRoleEndpointMock.expectations {
+	for _, e := range m.DeleteDenyRuleMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.AddRoleEndpointMock.invocationsDone()
+	return m.DeleteDenyRuleMock.invocationsDone()
 }
 
-// MinimockAddRoleEndpointInspect logs each unmet expectation
-func (m *AccessRepositoryMock) MinimockAddRoleEndpointInspect() {
-	for _, e := range m.AddRoleEndpointMock.expectations {
+// MinimockDeleteDenyRuleInspect logs each unmet expectation
+func (m *AccessRepositoryMock) MinimockDeleteDenyRuleInspect() {
+	for _, e := range m.DeleteDenyRuleMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AccessRepositoryMock.AddRoleEndpoint at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to AccessRepositoryMock.DeleteDenyRule at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterAddRoleEndpointCounter := mm_atomic.LoadUint64(&m.afterAddRoleEndpointCounter)
+	afterDeleteDenyRuleCounter := mm_atomic.LoadUint64(&m.afterDeleteDenyRuleCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.AddRoleEndpointMock.defaultExpectation != nil && afterAddRoleEndpointCounter < 1 {
-		if m.AddRoleEndpointMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to AccessRepositoryMock.AddRoleEndpoint at\n%s", m.AddRoleEndpointMock.defaultExpectation.returnOrigin)
+	if m.DeleteDenyRuleMock.defaultExpectation != nil && afterDeleteDenyRuleCounter < 1 {
+		if m.DeleteDenyRuleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessRepositoryMock.DeleteDenyRule at\n%s", m.DeleteDenyRuleMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to AccessRepositoryMock.AddRoleEndpoint at\n%s with params: %#v", m.AddRoleEndpointMock.defaultExpectation.expectationOrigins.origin, *m.AddRoleEndpointMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AccessRepositoryMock.DeleteDenyRule at\n%s with params: %#v", m.DeleteDenyRuleMock.defaultExpectation.expectationOrigins.origin, *m.DeleteDenyRuleMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcAddRoleEndpoint != nil && afterAddRoleEndpointCounter < 1 {
-		m.t.Errorf("Expected call to AccessRepositoryMock.AddRoleEndpoint at\n%s", m.funcAddRoleEndpointOrigin)
+	if m.funcDeleteDenyRule != nil && afterDeleteDenyRuleCounter < 1 {
+		m.t.Errorf("Expected call to AccessRepositoryMock.DeleteDenyRule at\n%s", m.funcDeleteDenyRuleOrigin)
 	}
 
-	if !m.AddRoleEndpointMock.invocationsDone() && afterAddRoleEndpointCounter > 0 {
-		m.t.Errorf("Expected %d calls to AccessRepositoryMock.AddRoleEndpoint at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.AddRoleEndpointMock.expectedInvocations), m.AddRoleEndpointMock.expectedInvocationsOrigin, afterAddRoleEndpointCounter)
+	if !m.DeleteDenyRuleMock.invocationsDone() && afterDeleteDenyRuleCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessRepositoryMock.DeleteDenyRule at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteDenyRuleMock.expectedInvocations), m.DeleteDenyRuleMock.expectedInvocationsOrigin, afterDeleteDenyRuleCounter)
 	}
 }
 
@@ -786,6 +1531,318 @@ func (m *AccessRepositoryMock) MinimockDeleteRoleEndpointInspect() {
 	}
 }
 
+type mAccessRepositoryMockGetDenyRules struct {
+	optional           bool
+	mock               *AccessRepositoryMock
+	defaultExpectation *AccessRepositoryMockGetDenyRulesExpectation
+	expectations       []*AccessRepositoryMockGetDenyRulesExpectation
+
+	callArgs []*AccessRepositoryMockGetDenyRulesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessRepositoryMockGetDenyRulesExpectation specifies expectation struct of the AccessRepository.GetDenyRules
+type AccessRepositoryMockGetDenyRulesExpectation struct {
+	mock               *AccessRepositoryMock
+	params             *AccessRepositoryMockGetDenyRulesParams
+	paramPtrs          *AccessRepositoryMockGetDenyRulesParamPtrs
+	expectationOrigins AccessRepositoryMockGetDenyRulesExpectationOrigins
+	results            *AccessRepositoryMockGetDenyRulesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessRepositoryMockGetDenyRulesParams contains parameters of the AccessRepository.GetDenyRules
+type AccessRepositoryMockGetDenyRulesParams struct {
+	ctx context.Context
+}
+
+// AccessRepositoryMockGetDenyRulesParamPtrs contains pointers to parameters of the AccessRepository.GetDenyRules
+type AccessRepositoryMockGetDenyRulesParamPtrs struct {
+	ctx *context.Context
+}
+
+// AccessRepositoryMockGetDenyRulesResults contains results of the AccessRepository.GetDenyRules
+type AccessRepositoryMockGetDenyRulesResults struct {
+	dpa1 []*model.DenyRule
+	err  error
+}
+
+// AccessRepositoryMockGetDenyRulesOrigins contains origins of expectations of the AccessRepository.GetDenyRules
+type AccessRepositoryMockGetDenyRulesExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetDenyRules *mAccessRepositoryMockGetDenyRules) Optional() *mAccessRepositoryMockGetDenyRules {
+	mmGetDenyRules.optional = true
+	return mmGetDenyRules
+}
+
+// Expect sets up expected params for AccessRepository.GetDenyRules
+func (mmGetDenyRules *mAccessRepositoryMockGetDenyRules) Expect(ctx context.Context) *mAccessRepositoryMockGetDenyRules {
+	if mmGetDenyRules.mock.funcGetDenyRules != nil {
+		mmGetDenyRules.mock.t.Fatalf("AccessRepositoryMock.GetDenyRules mock is already set by Set")
+	}
+
+	if mmGetDenyRules.defaultExpectation == nil {
+		mmGetDenyRules.defaultExpectation = &AccessRepositoryMockGetDenyRulesExpectation{}
+	}
+
+	if mmGetDenyRules.defaultExpectation.paramPtrs != nil {
+		mmGetDenyRules.mock.t.Fatalf("AccessRepositoryMock.GetDenyRules mock is already set by ExpectParams functions")
+	}
+
+	mmGetDenyRules.defaultExpectation.params = &AccessRepositoryMockGetDenyRulesParams{ctx}
+	mmGetDenyRules.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetDenyRules.expectations {
+		if minimock.Equal(e.params, mmGetDenyRules.defaultExpectation.params) {
+			mmGetDenyRules.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetDenyRules.defaultExpectation.params)
+		}
+	}
+
+	return mmGetDenyRules
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessRepository.GetDenyRules
+func (mmGetDenyRules *mAccessRepositoryMockGetDenyRules) ExpectCtxParam1(ctx context.Context) *mAccessRepositoryMockGetDenyRules {
+	if mmGetDenyRules.mock.funcGetDenyRules != nil {
+		mmGetDenyRules.mock.t.Fatalf("AccessRepositoryMock.GetDenyRules mock is already set by Set")
+	}
+
+	if mmGetDenyRules.defaultExpectation == nil {
+		mmGetDenyRules.defaultExpectation = &AccessRepositoryMockGetDenyRulesExpectation{}
+	}
+
+	if mmGetDenyRules.defaultExpectation.params != nil {
+		mmGetDenyRules.mock.t.Fatalf("AccessRepositoryMock.GetDenyRules mock is already set by Expect")
+	}
+
+	if mmGetDenyRules.defaultExpectation.paramPtrs == nil {
+		mmGetDenyRules.defaultExpectation.paramPtrs = &AccessRepositoryMockGetDenyRulesParamPtrs{}
+	}
+	mmGetDenyRules.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetDenyRules.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetDenyRules
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessRepository.GetDenyRules
+func (mmGetDenyRules *mAccessRepositoryMockGetDenyRules) Inspect(f func(ctx context.Context)) *mAccessRepositoryMockGetDenyRules {
+	if mmGetDenyRules.mock.inspectFuncGetDenyRules != nil {
+		mmGetDenyRules.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.GetDenyRules")
+	}
+
+	mmGetDenyRules.mock.inspectFuncGetDenyRules = f
+
+	return mmGetDenyRules
+}
+
+// Return sets up results that will be returned by AccessRepository.GetDenyRules
+func (mmGetDenyRules *mAccessRepositoryMockGetDenyRules) Return(dpa1 []*model.DenyRule, err error) *AccessRepositoryMock {
+	if mmGetDenyRules.mock.funcGetDenyRules != nil {
+		mmGetDenyRules.mock.t.Fatalf("AccessRepositoryMock.GetDenyRules mock is already set by Set")
+	}
+
+	if mmGetDenyRules.defaultExpectation == nil {
+		mmGetDenyRules.defaultExpectation = &AccessRepositoryMockGetDenyRulesExpectation{mock: mmGetDenyRules.mock}
+	}
+	mmGetDenyRules.defaultExpectation.results = &AccessRepositoryMockGetDenyRulesResults{dpa1, err}
+	mmGetDenyRules.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetDenyRules.mock
+}
+
+// Set uses given function f to mock the AccessRepository.GetDenyRules method
+func (mmGetDenyRules *mAccessRepositoryMockGetDenyRules) Set(f func(ctx context.Context) (dpa1 []*model.DenyRule, err error)) *AccessRepositoryMock {
+	if mmGetDenyRules.defaultExpectation != nil {
+		mmGetDenyRules.mock.t.Fatalf("Default expectation is already set for the AccessRepository.GetDenyRules method")
+	}
+
+	if len(mmGetDenyRules.expectations) > 0 {
+		mmGetDenyRules.mock.t.Fatalf("Some expectations are already set for the AccessRepository.GetDenyRules method")
+	}
+
+	mmGetDenyRules.mock.funcGetDenyRules = f
+	mmGetDenyRules.mock.funcGetDenyRulesOrigin = minimock.CallerInfo(1)
+	return mmGetDenyRules.mock
+}
+
+// When sets expectation for the AccessRepository.GetDenyRules which will trigger the result defined by the following
+// Then helper
+func (mmGetDenyRules *mAccessRepositoryMockGetDenyRules) When(ctx context.Context) *AccessRepositoryMockGetDenyRulesExpectation {
+	if mmGetDenyRules.mock.funcGetDenyRules != nil {
+		mmGetDenyRules.mock.t.Fatalf("AccessRepositoryMock.GetDenyRules mock is already set by Set")
+	}
+
+	expectation := &AccessRepositoryMockGetDenyRulesExpectation{
+		mock:               mmGetDenyRules.mock,
+		params:             &AccessRepositoryMockGetDenyRulesParams{ctx},
+		expectationOrigins: AccessRepositoryMockGetDenyRulesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetDenyRules.expectations = append(mmGetDenyRules.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessRepository.GetDenyRules return parameters for the expectation previously defined by the When method
+func (e *AccessRepositoryMockGetDenyRulesExpectation) Then(dpa1 []*model.DenyRule, err error) *AccessRepositoryMock {
+	e.results = &AccessRepositoryMockGetDenyRulesResults{dpa1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessRepository.GetDenyRules should be invoked
+func (mmGetDenyRules *mAccessRepositoryMockGetDenyRules) Times(n uint64) *mAccessRepositoryMockGetDenyRules {
+	if n == 0 {
+		mmGetDenyRules.mock.t.Fatalf("Times of AccessRepositoryMock.GetDenyRules mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetDenyRules.expectedInvocations, n)
+	mmGetDenyRules.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetDenyRules
+}
+
+func (mmGetDenyRules *mAccessRepositoryMockGetDenyRules) invocationsDone() bool {
+	if len(mmGetDenyRules.expectations) == 0 && mmGetDenyRules.defaultExpectation == nil && mmGetDenyRules.mock.funcGetDenyRules == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetDenyRules.mock.afterGetDenyRulesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetDenyRules.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetDenyRules implements mm_repository.AccessRepository
+func (mmGetDenyRules *AccessRepositoryMock) GetDenyRules(ctx context.Context) (dpa1 []*model.DenyRule, err error) {
+	mm_atomic.AddUint64(&mmGetDenyRules.beforeGetDenyRulesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetDenyRules.afterGetDenyRulesCounter, 1)
+
+	mmGetDenyRules.t.Helper()
+
+	if mmGetDenyRules.inspectFuncGetDenyRules != nil {
+		mmGetDenyRules.inspectFuncGetDenyRules(ctx)
+	}
+
+	mm_params := AccessRepositoryMockGetDenyRulesParams{ctx}
+
+	// Record call args
+	mmGetDenyRules.GetDenyRulesMock.mutex.Lock()
+	mmGetDenyRules.GetDenyRulesMock.callArgs = append(mmGetDenyRules.GetDenyRulesMock.callArgs, &mm_params)
+	mmGetDenyRules.GetDenyRulesMock.mutex.Unlock()
+
+	for _, e := range mmGetDenyRules.GetDenyRulesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.dpa1, e.results.err
+		}
+	}
+
+	if mmGetDenyRules.GetDenyRulesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetDenyRules.GetDenyRulesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetDenyRules.GetDenyRulesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetDenyRules.GetDenyRulesMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessRepositoryMockGetDenyRulesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetDenyRules.t.Errorf("AccessRepositoryMock.GetDenyRules got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDenyRules.GetDenyRulesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetDenyRules.t.Errorf("AccessRepositoryMock.GetDenyRules got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetDenyRules.GetDenyRulesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetDenyRules.GetDenyRulesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetDenyRules.t.Fatal("No results are set for the AccessRepositoryMock.GetDenyRules")
+		}
+		return (*mm_results).dpa1, (*mm_results).err
+	}
+	if mmGetDenyRules.funcGetDenyRules != nil {
+		return mmGetDenyRules.funcGetDenyRules(ctx)
+	}
+	mmGetDenyRules.t.Fatalf("Unexpected call to AccessRepositoryMock.GetDenyRules. %v", ctx)
+	return
+}
+
+// GetDenyRulesAfterCounter returns a count of finished AccessRepositoryMock.GetDenyRules invocations
+func (mmGetDenyRules *AccessRepositoryMock) GetDenyRulesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDenyRules.afterGetDenyRulesCounter)
+}
+
+// GetDenyRulesBeforeCounter returns a count of AccessRepositoryMock.GetDenyRules invocations
+func (mmGetDenyRules *AccessRepositoryMock) GetDenyRulesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDenyRules.beforeGetDenyRulesCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessRepositoryMock.GetDenyRules.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetDenyRules *mAccessRepositoryMockGetDenyRules) Calls() []*AccessRepositoryMockGetDenyRulesParams {
+	mmGetDenyRules.mutex.RLock()
+
+	argCopy := make([]*AccessRepositoryMockGetDenyRulesParams, len(mmGetDenyRules.callArgs))
+	copy(argCopy, mmGetDenyRules.callArgs)
+
+	mmGetDenyRules.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDenyRulesDone returns true if the count of the GetDenyRules invocations corresponds
+// the number of defined expectations
+func (m *AccessRepositoryMock) MinimockGetDenyRulesDone() bool {
+	if m.GetDenyRulesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetDenyRulesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetDenyRulesMock.invocationsDone()
+}
+
+// MinimockGetDenyRulesInspect logs each unmet expectation
+func (m *AccessRepositoryMock) MinimockGetDenyRulesInspect() {
+	for _, e := range m.GetDenyRulesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetDenyRules at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetDenyRulesCounter := mm_atomic.LoadUint64(&m.afterGetDenyRulesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetDenyRulesMock.defaultExpectation != nil && afterGetDenyRulesCounter < 1 {
+		if m.GetDenyRulesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetDenyRules at\n%s", m.GetDenyRulesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetDenyRules at\n%s with params: %#v", m.GetDenyRulesMock.defaultExpectation.expectationOrigins.origin, *m.GetDenyRulesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetDenyRules != nil && afterGetDenyRulesCounter < 1 {
+		m.t.Errorf("Expected call to AccessRepositoryMock.GetDenyRules at\n%s", m.funcGetDenyRulesOrigin)
+	}
+
+	if !m.GetDenyRulesMock.invocationsDone() && afterGetDenyRulesCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessRepositoryMock.GetDenyRules at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetDenyRulesMock.expectedInvocations), m.GetDenyRulesMock.expectedInvocationsOrigin, afterGetDenyRulesCounter)
+	}
+}
+
 type mAccessRepositoryMockGetRoleEndpoints struct {
 	optional           bool
 	mock               *AccessRepositoryMock
@@ -1127,6 +2184,7 @@ type AccessRepositoryMockUpdateRoleEndpointParams struct {
 	ctx          context.Context
 	endpoint     string
 	allowedRoles []string
+	condition    *string
 }
 
 // AccessRepositoryMockUpdateRoleEndpointParamPtrs contains pointers to parameters of the AccessRepository.UpdateRoleEndpoint
@@ -1134,6 +2192,7 @@ type AccessRepositoryMockUpdateRoleEndpointParamPtrs struct {
 	ctx          *context.Context
 	endpoint     *string
 	allowedRoles *[]string
+	condition    **string
 }
 
 // AccessRepositoryMockUpdateRoleEndpointResults contains results of the AccessRepository.UpdateRoleEndpoint
@@ -1147,6 +2206,7 @@ type AccessRepositoryMockUpdateRoleEndpointExpectationOrigins struct {
 	originCtx          string
 	originEndpoint     string
 	originAllowedRoles string
+	originCondition    string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1160,7 +2220,7 @@ func (mmUpdateRoleEndpoint *mAccessRepositoryMockUpdateRoleEndpoint) Optional()
 }
 
 // Expect sets up expected params for AccessRepository.UpdateRoleEndpoint
-func (mmUpdateRoleEndpoint *mAccessRepositoryMockUpdateRoleEndpoint) Expect(ctx context.Context, endpoint string, allowedRoles []string) *mAccessRepositoryMockUpdateRoleEndpoint {
+func (mmUpdateRoleEndpoint *mAccessRepositoryMockUpdateRoleEndpoint) Expect(ctx context.Context, endpoint string, allowedRoles []string, condition *string) *mAccessRepositoryMockUpdateRoleEndpoint {
 	if mmUpdateRoleEndpoint.mock.funcUpdateRoleEndpoint != nil {
 		mmUpdateRoleEndpoint.mock.t.Fatalf("AccessRepositoryMock.UpdateRoleEndpoint mock is already set by Set")
 	}
@@ -1173,7 +2233,7 @@ func (mmUpdateRoleEndpoint *mAccessRepositoryMockUpdateRoleEndpoint) Expect(ctx
 		mmUpdateRoleEndpoint.mock.t.Fatalf("AccessRepositoryMock.UpdateRoleEndpoint mock is already set by ExpectParams functions")
 	}
 
-	mmUpdateRoleEndpoint.defaultExpectation.params = &AccessRepositoryMockUpdateRoleEndpointParams{ctx, endpoint, allowedRoles}
+	mmUpdateRoleEndpoint.defaultExpectation.params = &AccessRepositoryMockUpdateRoleEndpointParams{ctx, endpoint, allowedRoles, condition}
 	mmUpdateRoleEndpoint.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmUpdateRoleEndpoint.expectations {
 		if minimock.Equal(e.params, mmUpdateRoleEndpoint.defaultExpectation.params) {
@@ -1253,8 +2313,31 @@ func (mmUpdateRoleEndpoint *mAccessRepositoryMockUpdateRoleEndpoint) ExpectAllow
 	return mmUpdateRoleEndpoint
 }
 
+// ExpectConditionParam4 sets up expected param condition for AccessRepository.UpdateRoleEndpoint
+func (mmUpdateRoleEndpoint *mAccessRepositoryMockUpdateRoleEndpoint) ExpectConditionParam4(condition *string) *mAccessRepositoryMockUpdateRoleEndpoint {
+	if mmUpdateRoleEndpoint.mock.funcUpdateRoleEndpoint != nil {
+		mmUpdateRoleEndpoint.mock.t.Fatalf("AccessRepositoryMock.UpdateRoleEndpoint mock is already set by Set")
+	}
+
+	if mmUpdateRoleEndpoint.defaultExpectation == nil {
+		mmUpdateRoleEndpoint.defaultExpectation = &AccessRepositoryMockUpdateRoleEndpointExpectation{}
+	}
+
+	if mmUpdateRoleEndpoint.defaultExpectation.params != nil {
+		mmUpdateRoleEndpoint.mock.t.Fatalf("AccessRepositoryMock.UpdateRoleEndpoint mock is already set by Expect")
+	}
+
+	if mmUpdateRoleEndpoint.defaultExpectation.paramPtrs == nil {
+		mmUpdateRoleEndpoint.defaultExpectation.paramPtrs = &AccessRepositoryMockUpdateRoleEndpointParamPtrs{}
+	}
+	mmUpdateRoleEndpoint.defaultExpectation.paramPtrs.condition = &condition
+	mmUpdateRoleEndpoint.defaultExpectation.expectationOrigins.originCondition = minimock.CallerInfo(1)
+
+	return mmUpdateRoleEndpoint
+}
+
 // Inspect accepts an inspector function that has same arguments as the AccessRepository.UpdateRoleEndpoint
-func (mmUpdateRoleEndpoint *mAccessRepositoryMockUpdateRoleEndpoint) Inspect(f func(ctx context.Context, endpoint string, allowedRoles []string)) *mAccessRepositoryMockUpdateRoleEndpoint {
+func (mmUpdateRoleEndpoint *mAccessRepositoryMockUpdateRoleEndpoint) Inspect(f func(ctx context.Context, endpoint string, allowedRoles []string, condition *string)) *mAccessRepositoryMockUpdateRoleEndpoint {
 	if mmUpdateRoleEndpoint.mock.inspectFuncUpdateRoleEndpoint != nil {
 		mmUpdateRoleEndpoint.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.UpdateRoleEndpoint")
 	}
@@ -1279,7 +2362,7 @@ func (mmUpdateRoleEndpoint *mAccessRepositoryMockUpdateRoleEndpoint) Return(err
 }
 
 // Set uses given function f to mock the AccessRepository.UpdateRoleEndpoint method
-func (mmUpdateRoleEndpoint *mAccessRepositoryMockUpdateRoleEndpoint) Set(f func(ctx context.Context, endpoint string, allowedRoles []string) (err error)) *AccessRepositoryMock {
+func (mmUpdateRoleEndpoint *mAccessRepositoryMockUpdateRoleEndpoint) Set(f func(ctx context.Context, endpoint string, allowedRoles []string, condition *string) (err error)) *AccessRepositoryMock {
 	if mmUpdateRoleEndpoint.defaultExpectation != nil {
 		mmUpdateRoleEndpoint.mock.t.Fatalf("Default expectation is already set for the AccessRepository.UpdateRoleEndpoint method")
 	}
@@ -1295,14 +2378,14 @@ func (mmUpdateRoleEndpoint *mAccessRepositoryMockUpdateRoleEndpoint) Set(f func(
 
 // When sets expectation for the AccessRepository.UpdateRoleEndpoint which will trigger the result defined by the following
 // Then helper
-func (mmUpdateRoleEndpoint *mAccessRepositoryMockUpdateRoleEndpoint) When(ctx context.Context, endpoint string, allowedRoles []string) *AccessRepositoryMockUpdateRoleEndpointExpectation {
+func (mmUpdateRoleEndpoint *mAccessRepositoryMockUpdateRoleEndpoint) When(ctx context.Context, endpoint string, allowedRoles []string, condition *string) *AccessRepositoryMockUpdateRoleEndpointExpectation {
 	if mmUpdateRoleEndpoint.mock.funcUpdateRoleEndpoint != nil {
 		mmUpdateRoleEndpoint.mock.t.Fatalf("AccessRepositoryMock.UpdateRoleEndpoint mock is already set by Set")
 	}
 
 	expectation := &AccessRepositoryMockUpdateRoleEndpointExpectation{
 		mock:               mmUpdateRoleEndpoint.mock,
-		params:             &AccessRepositoryMockUpdateRoleEndpointParams{ctx, endpoint, allowedRoles},
+		params:             &AccessRepositoryMockUpdateRoleEndpointParams{ctx, endpoint, allowedRoles, condition},
 		expectationOrigins: AccessRepositoryMockUpdateRoleEndpointExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmUpdateRoleEndpoint.expectations = append(mmUpdateRoleEndpoint.expectations, expectation)
@@ -1337,17 +2420,17 @@ func (mmUpdateRoleEndpoint *mAccessRepositoryMockUpdateRoleEndpoint) invocations
 }
 
 // UpdateRoleEndpoint implements mm_repository.AccessRepository
-func (mmUpdateRoleEndpoint *AccessRepositoryMock) UpdateRoleEndpoint(ctx context.Context, endpoint string, allowedRoles []string) (err error) {
+func (mmUpdateRoleEndpoint *AccessRepositoryMock) UpdateRoleEndpoint(ctx context.Context, endpoint string, allowedRoles []string, condition *string) (err error) {
 	mm_atomic.AddUint64(&mmUpdateRoleEndpoint.beforeUpdateRoleEndpointCounter, 1)
 	defer mm_atomic.AddUint64(&mmUpdateRoleEndpoint.afterUpdateRoleEndpointCounter, 1)
 
 	mmUpdateRoleEndpoint.t.Helper()
 
 	if mmUpdateRoleEndpoint.inspectFuncUpdateRoleEndpoint != nil {
-		mmUpdateRoleEndpoint.inspectFuncUpdateRoleEndpoint(ctx, endpoint, allowedRoles)
+		mmUpdateRoleEndpoint.inspectFuncUpdateRoleEndpoint(ctx, endpoint, allowedRoles, condition)
 	}
 
-	mm_params := AccessRepositoryMockUpdateRoleEndpointParams{ctx, endpoint, allowedRoles}
+	mm_params := AccessRepositoryMockUpdateRoleEndpointParams{ctx, endpoint, allowedRoles, condition}
 
 	// Record call args
 	mmUpdateRoleEndpoint.UpdateRoleEndpointMock.mutex.Lock()
@@ -1366,7 +2449,7 @@ func (mmUpdateRoleEndpoint *AccessRepositoryMock) UpdateRoleEndpoint(ctx context
 		mm_want := mmUpdateRoleEndpoint.UpdateRoleEndpointMock.defaultExpectation.params
 		mm_want_ptrs := mmUpdateRoleEndpoint.UpdateRoleEndpointMock.defaultExpectation.paramPtrs
 
-		mm_got := AccessRepositoryMockUpdateRoleEndpointParams{ctx, endpoint, allowedRoles}
+		mm_got := AccessRepositoryMockUpdateRoleEndpointParams{ctx, endpoint, allowedRoles, condition}
 
 		if mm_want_ptrs != nil {
 
@@ -1385,6 +2468,11 @@ func (mmUpdateRoleEndpoint *AccessRepositoryMock) UpdateRoleEndpoint(ctx context
 					mmUpdateRoleEndpoint.UpdateRoleEndpointMock.defaultExpectation.expectationOrigins.originAllowedRoles, *mm_want_ptrs.allowedRoles, mm_got.allowedRoles, minimock.Diff(*mm_want_ptrs.allowedRoles, mm_got.allowedRoles))
 			}
 
+			if mm_want_ptrs.condition != nil && !minimock.Equal(*mm_want_ptrs.condition, mm_got.condition) {
+				mmUpdateRoleEndpoint.t.Errorf("AccessRepositoryMock.UpdateRoleEndpoint got unexpected parameter condition, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateRoleEndpoint.UpdateRoleEndpointMock.defaultExpectation.expectationOrigins.originCondition, *mm_want_ptrs.condition, mm_got.condition, minimock.Diff(*mm_want_ptrs.condition, mm_got.condition))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmUpdateRoleEndpoint.t.Errorf("AccessRepositoryMock.UpdateRoleEndpoint got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmUpdateRoleEndpoint.UpdateRoleEndpointMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -1397,9 +2485,9 @@ func (mmUpdateRoleEndpoint *AccessRepositoryMock) UpdateRoleEndpoint(ctx context
 		return (*mm_results).err
 	}
 	if mmUpdateRoleEndpoint.funcUpdateRoleEndpoint != nil {
-		return mmUpdateRoleEndpoint.funcUpdateRoleEndpoint(ctx, endpoint, allowedRoles)
+		return mmUpdateRoleEndpoint.funcUpdateRoleEndpoint(ctx, endpoint, allowedRoles, condition)
 	}
-	mmUpdateRoleEndpoint.t.Fatalf("Unexpected call to AccessRepositoryMock.UpdateRoleEndpoint. %v %v %v", ctx, endpoint, allowedRoles)
+	mmUpdateRoleEndpoint.t.Fatalf("Unexpected call to AccessRepositoryMock.UpdateRoleEndpoint. %v %v %v %v", ctx, endpoint, allowedRoles, condition)
 	return
 }
 
@@ -1475,10 +2563,16 @@ func (m *AccessRepositoryMock) MinimockUpdateRoleEndpointInspect() {
 func (m *AccessRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAddDenyRuleInspect()
+
 			m.MinimockAddRoleEndpointInspect()
 
+			m.MinimockDeleteDenyRuleInspect()
+
 			m.MinimockDeleteRoleEndpointInspect()
 
+			m.MinimockGetDenyRulesInspect()
+
 			m.MinimockGetRoleEndpointsInspect()
 
 			m.MinimockUpdateRoleEndpointInspect()
@@ -1505,8 +2599,11 @@ func (m *AccessRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *AccessRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAddDenyRuleDone() &&
 		m.MinimockAddRoleEndpointDone() &&
+		m.MinimockDeleteDenyRuleDone() &&
 		m.MinimockDeleteRoleEndpointDone() &&
+		m.MinimockGetDenyRulesDone() &&
 		m.MinimockGetRoleEndpointsDone() &&
 		m.MinimockUpdateRoleEndpointDone()
 }
@@ -0,0 +1,1514 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// AuditRepositoryMock implements mm_repository.AuditRepository
+type AuditRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcList          func(ctx context.Context, query *model.AuditListQuery) (apa1 []*model.AuditEvent, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context, query *model.AuditListQuery)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mAuditRepositoryMockList
+
+	funcListChain          func(ctx context.Context, afterSeq int64, limit int) (apa1 []*model.AuditEvent, err error)
+	funcListChainOrigin    string
+	inspectFuncListChain   func(ctx context.Context, afterSeq int64, limit int)
+	afterListChainCounter  uint64
+	beforeListChainCounter uint64
+	ListChainMock          mAuditRepositoryMockListChain
+
+	funcRecord          func(ctx context.Context, event *model.AuditEvent) (err error)
+	funcRecordOrigin    string
+	inspectFuncRecord   func(ctx context.Context, event *model.AuditEvent)
+	afterRecordCounter  uint64
+	beforeRecordCounter uint64
+	RecordMock          mAuditRepositoryMockRecord
+
+	funcSeal          func(ctx context.Context, limit int) (i1 int, err error)
+	funcSealOrigin    string
+	inspectFuncSeal   func(ctx context.Context, limit int)
+	afterSealCounter  uint64
+	beforeSealCounter uint64
+	SealMock          mAuditRepositoryMockSeal
+}
+
+// NewAuditRepositoryMock returns a mock for mm_repository.AuditRepository
+func NewAuditRepositoryMock(t minimock.Tester) *AuditRepositoryMock {
+	m := &AuditRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ListMock = mAuditRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*AuditRepositoryMockListParams{}
+
+	m.ListChainMock = mAuditRepositoryMockListChain{mock: m}
+	m.ListChainMock.callArgs = []*AuditRepositoryMockListChainParams{}
+
+	m.RecordMock = mAuditRepositoryMockRecord{mock: m}
+	m.RecordMock.callArgs = []*AuditRepositoryMockRecordParams{}
+
+	m.SealMock = mAuditRepositoryMockSeal{mock: m}
+	m.SealMock.callArgs = []*AuditRepositoryMockSealParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuditRepositoryMockList struct {
+	optional           bool
+	mock               *AuditRepositoryMock
+	defaultExpectation *AuditRepositoryMockListExpectation
+	expectations       []*AuditRepositoryMockListExpectation
+
+	callArgs []*AuditRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditRepositoryMockListExpectation specifies expectation struct of the AuditRepository.List
+type AuditRepositoryMockListExpectation struct {
+	mock               *AuditRepositoryMock
+	params             *AuditRepositoryMockListParams
+	paramPtrs          *AuditRepositoryMockListParamPtrs
+	expectationOrigins AuditRepositoryMockListExpectationOrigins
+	results            *AuditRepositoryMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditRepositoryMockListParams contains parameters of the AuditRepository.List
+type AuditRepositoryMockListParams struct {
+	ctx   context.Context
+	query *model.AuditListQuery
+}
+
+// AuditRepositoryMockListParamPtrs contains pointers to parameters of the AuditRepository.List
+type AuditRepositoryMockListParamPtrs struct {
+	ctx   *context.Context
+	query **model.AuditListQuery
+}
+
+// AuditRepositoryMockListResults contains results of the AuditRepository.List
+type AuditRepositoryMockListResults struct {
+	apa1 []*model.AuditEvent
+	err  error
+}
+
+// AuditRepositoryMockListOrigins contains origins of expectations of the AuditRepository.List
+type AuditRepositoryMockListExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originQuery string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mAuditRepositoryMockList) Optional() *mAuditRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for AuditRepository.List
+func (mmList *mAuditRepositoryMockList) Expect(ctx context.Context, query *model.AuditListQuery) *mAuditRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &AuditRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("AuditRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &AuditRepositoryMockListParams{ctx, query}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditRepository.List
+func (mmList *mAuditRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mAuditRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &AuditRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("AuditRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &AuditRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// ExpectQueryParam2 sets up expected param query for AuditRepository.List
+func (mmList *mAuditRepositoryMockList) ExpectQueryParam2(query *model.AuditListQuery) *mAuditRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &AuditRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("AuditRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &AuditRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.query = &query
+	mmList.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditRepository.List
+func (mmList *mAuditRepositoryMockList) Inspect(f func(ctx context.Context, query *model.AuditListQuery)) *mAuditRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for AuditRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by AuditRepository.List
+func (mmList *mAuditRepositoryMockList) Return(apa1 []*model.AuditEvent, err error) *AuditRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &AuditRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &AuditRepositoryMockListResults{apa1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the AuditRepository.List method
+func (mmList *mAuditRepositoryMockList) Set(f func(ctx context.Context, query *model.AuditListQuery) (apa1 []*model.AuditEvent, err error)) *AuditRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the AuditRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the AuditRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the AuditRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mAuditRepositoryMockList) When(ctx context.Context, query *model.AuditListQuery) *AuditRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &AuditRepositoryMockListExpectation{
+		mock:               mmList.mock,
+		params:             &AuditRepositoryMockListParams{ctx, query},
+		expectationOrigins: AuditRepositoryMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditRepository.List return parameters for the expectation previously defined by the When method
+func (e *AuditRepositoryMockListExpectation) Then(apa1 []*model.AuditEvent, err error) *AuditRepositoryMock {
+	e.results = &AuditRepositoryMockListResults{apa1, err}
+	return e.mock
+}
+
+// Times sets number of times AuditRepository.List should be invoked
+func (mmList *mAuditRepositoryMockList) Times(n uint64) *mAuditRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of AuditRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mAuditRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_repository.AuditRepository
+func (mmList *AuditRepositoryMock) List(ctx context.Context, query *model.AuditListQuery) (apa1 []*model.AuditEvent, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, query)
+	}
+
+	mm_params := AuditRepositoryMockListParams{ctx, query}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.apa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditRepositoryMockListParams{ctx, query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("AuditRepositoryMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmList.t.Errorf("AuditRepositoryMock.List got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("AuditRepositoryMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the AuditRepositoryMock.List")
+		}
+		return (*mm_results).apa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, query)
+	}
+	mmList.t.Fatalf("Unexpected call to AuditRepositoryMock.List. %v %v", ctx, query)
+	return
+}
+
+// ListAfterCounter returns a count of finished AuditRepositoryMock.List invocations
+func (mmList *AuditRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of AuditRepositoryMock.List invocations
+func (mmList *AuditRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mAuditRepositoryMockList) Calls() []*AuditRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*AuditRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *AuditRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *AuditRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditRepositoryMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditRepositoryMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditRepositoryMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to AuditRepositoryMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditRepositoryMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+type mAuditRepositoryMockListChain struct {
+	optional           bool
+	mock               *AuditRepositoryMock
+	defaultExpectation *AuditRepositoryMockListChainExpectation
+	expectations       []*AuditRepositoryMockListChainExpectation
+
+	callArgs []*AuditRepositoryMockListChainParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditRepositoryMockListChainExpectation specifies expectation struct of the AuditRepository.ListChain
+type AuditRepositoryMockListChainExpectation struct {
+	mock               *AuditRepositoryMock
+	params             *AuditRepositoryMockListChainParams
+	paramPtrs          *AuditRepositoryMockListChainParamPtrs
+	expectationOrigins AuditRepositoryMockListChainExpectationOrigins
+	results            *AuditRepositoryMockListChainResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditRepositoryMockListChainParams contains parameters of the AuditRepository.ListChain
+type AuditRepositoryMockListChainParams struct {
+	ctx      context.Context
+	afterSeq int64
+	limit    int
+}
+
+// AuditRepositoryMockListChainParamPtrs contains pointers to parameters of the AuditRepository.ListChain
+type AuditRepositoryMockListChainParamPtrs struct {
+	ctx      *context.Context
+	afterSeq *int64
+	limit    *int
+}
+
+// AuditRepositoryMockListChainResults contains results of the AuditRepository.ListChain
+type AuditRepositoryMockListChainResults struct {
+	apa1 []*model.AuditEvent
+	err  error
+}
+
+// AuditRepositoryMockListChainOrigins contains origins of expectations of the AuditRepository.ListChain
+type AuditRepositoryMockListChainExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originAfterSeq string
+	originLimit    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListChain *mAuditRepositoryMockListChain) Optional() *mAuditRepositoryMockListChain {
+	mmListChain.optional = true
+	return mmListChain
+}
+
+// Expect sets up expected params for AuditRepository.ListChain
+func (mmListChain *mAuditRepositoryMockListChain) Expect(ctx context.Context, afterSeq int64, limit int) *mAuditRepositoryMockListChain {
+	if mmListChain.mock.funcListChain != nil {
+		mmListChain.mock.t.Fatalf("AuditRepositoryMock.ListChain mock is already set by Set")
+	}
+
+	if mmListChain.defaultExpectation == nil {
+		mmListChain.defaultExpectation = &AuditRepositoryMockListChainExpectation{}
+	}
+
+	if mmListChain.defaultExpectation.paramPtrs != nil {
+		mmListChain.mock.t.Fatalf("AuditRepositoryMock.ListChain mock is already set by ExpectParams functions")
+	}
+
+	mmListChain.defaultExpectation.params = &AuditRepositoryMockListChainParams{ctx, afterSeq, limit}
+	mmListChain.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListChain.expectations {
+		if minimock.Equal(e.params, mmListChain.defaultExpectation.params) {
+			mmListChain.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListChain.defaultExpectation.params)
+		}
+	}
+
+	return mmListChain
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditRepository.ListChain
+func (mmListChain *mAuditRepositoryMockListChain) ExpectCtxParam1(ctx context.Context) *mAuditRepositoryMockListChain {
+	if mmListChain.mock.funcListChain != nil {
+		mmListChain.mock.t.Fatalf("AuditRepositoryMock.ListChain mock is already set by Set")
+	}
+
+	if mmListChain.defaultExpectation == nil {
+		mmListChain.defaultExpectation = &AuditRepositoryMockListChainExpectation{}
+	}
+
+	if mmListChain.defaultExpectation.params != nil {
+		mmListChain.mock.t.Fatalf("AuditRepositoryMock.ListChain mock is already set by Expect")
+	}
+
+	if mmListChain.defaultExpectation.paramPtrs == nil {
+		mmListChain.defaultExpectation.paramPtrs = &AuditRepositoryMockListChainParamPtrs{}
+	}
+	mmListChain.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListChain.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListChain
+}
+
+// ExpectAfterSeqParam2 sets up expected param afterSeq for AuditRepository.ListChain
+func (mmListChain *mAuditRepositoryMockListChain) ExpectAfterSeqParam2(afterSeq int64) *mAuditRepositoryMockListChain {
+	if mmListChain.mock.funcListChain != nil {
+		mmListChain.mock.t.Fatalf("AuditRepositoryMock.ListChain mock is already set by Set")
+	}
+
+	if mmListChain.defaultExpectation == nil {
+		mmListChain.defaultExpectation = &AuditRepositoryMockListChainExpectation{}
+	}
+
+	if mmListChain.defaultExpectation.params != nil {
+		mmListChain.mock.t.Fatalf("AuditRepositoryMock.ListChain mock is already set by Expect")
+	}
+
+	if mmListChain.defaultExpectation.paramPtrs == nil {
+		mmListChain.defaultExpectation.paramPtrs = &AuditRepositoryMockListChainParamPtrs{}
+	}
+	mmListChain.defaultExpectation.paramPtrs.afterSeq = &afterSeq
+	mmListChain.defaultExpectation.expectationOrigins.originAfterSeq = minimock.CallerInfo(1)
+
+	return mmListChain
+}
+
+// ExpectLimitParam3 sets up expected param limit for AuditRepository.ListChain
+func (mmListChain *mAuditRepositoryMockListChain) ExpectLimitParam3(limit int) *mAuditRepositoryMockListChain {
+	if mmListChain.mock.funcListChain != nil {
+		mmListChain.mock.t.Fatalf("AuditRepositoryMock.ListChain mock is already set by Set")
+	}
+
+	if mmListChain.defaultExpectation == nil {
+		mmListChain.defaultExpectation = &AuditRepositoryMockListChainExpectation{}
+	}
+
+	if mmListChain.defaultExpectation.params != nil {
+		mmListChain.mock.t.Fatalf("AuditRepositoryMock.ListChain mock is already set by Expect")
+	}
+
+	if mmListChain.defaultExpectation.paramPtrs == nil {
+		mmListChain.defaultExpectation.paramPtrs = &AuditRepositoryMockListChainParamPtrs{}
+	}
+	mmListChain.defaultExpectation.paramPtrs.limit = &limit
+	mmListChain.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListChain
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditRepository.ListChain
+func (mmListChain *mAuditRepositoryMockListChain) Inspect(f func(ctx context.Context, afterSeq int64, limit int)) *mAuditRepositoryMockListChain {
+	if mmListChain.mock.inspectFuncListChain != nil {
+		mmListChain.mock.t.Fatalf("Inspect function is already set for AuditRepositoryMock.ListChain")
+	}
+
+	mmListChain.mock.inspectFuncListChain = f
+
+	return mmListChain
+}
+
+// Return sets up results that will be returned by AuditRepository.ListChain
+func (mmListChain *mAuditRepositoryMockListChain) Return(apa1 []*model.AuditEvent, err error) *AuditRepositoryMock {
+	if mmListChain.mock.funcListChain != nil {
+		mmListChain.mock.t.Fatalf("AuditRepositoryMock.ListChain mock is already set by Set")
+	}
+
+	if mmListChain.defaultExpectation == nil {
+		mmListChain.defaultExpectation = &AuditRepositoryMockListChainExpectation{mock: mmListChain.mock}
+	}
+	mmListChain.defaultExpectation.results = &AuditRepositoryMockListChainResults{apa1, err}
+	mmListChain.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListChain.mock
+}
+
+// Set uses given function f to mock the AuditRepository.ListChain method
+func (mmListChain *mAuditRepositoryMockListChain) Set(f func(ctx context.Context, afterSeq int64, limit int) (apa1 []*model.AuditEvent, err error)) *AuditRepositoryMock {
+	if mmListChain.defaultExpectation != nil {
+		mmListChain.mock.t.Fatalf("Default expectation is already set for the AuditRepository.ListChain method")
+	}
+
+	if len(mmListChain.expectations) > 0 {
+		mmListChain.mock.t.Fatalf("Some expectations are already set for the AuditRepository.ListChain method")
+	}
+
+	mmListChain.mock.funcListChain = f
+	mmListChain.mock.funcListChainOrigin = minimock.CallerInfo(1)
+	return mmListChain.mock
+}
+
+// When sets expectation for the AuditRepository.ListChain which will trigger the result defined by the following
+// Then helper
+func (mmListChain *mAuditRepositoryMockListChain) When(ctx context.Context, afterSeq int64, limit int) *AuditRepositoryMockListChainExpectation {
+	if mmListChain.mock.funcListChain != nil {
+		mmListChain.mock.t.Fatalf("AuditRepositoryMock.ListChain mock is already set by Set")
+	}
+
+	expectation := &AuditRepositoryMockListChainExpectation{
+		mock:               mmListChain.mock,
+		params:             &AuditRepositoryMockListChainParams{ctx, afterSeq, limit},
+		expectationOrigins: AuditRepositoryMockListChainExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListChain.expectations = append(mmListChain.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditRepository.ListChain return parameters for the expectation previously defined by the When method
+func (e *AuditRepositoryMockListChainExpectation) Then(apa1 []*model.AuditEvent, err error) *AuditRepositoryMock {
+	e.results = &AuditRepositoryMockListChainResults{apa1, err}
+	return e.mock
+}
+
+// Times sets number of times AuditRepository.ListChain should be invoked
+func (mmListChain *mAuditRepositoryMockListChain) Times(n uint64) *mAuditRepositoryMockListChain {
+	if n == 0 {
+		mmListChain.mock.t.Fatalf("Times of AuditRepositoryMock.ListChain mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListChain.expectedInvocations, n)
+	mmListChain.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListChain
+}
+
+func (mmListChain *mAuditRepositoryMockListChain) invocationsDone() bool {
+	if len(mmListChain.expectations) == 0 && mmListChain.defaultExpectation == nil && mmListChain.mock.funcListChain == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListChain.mock.afterListChainCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListChain.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListChain implements mm_repository.AuditRepository
+func (mmListChain *AuditRepositoryMock) ListChain(ctx context.Context, afterSeq int64, limit int) (apa1 []*model.AuditEvent, err error) {
+	mm_atomic.AddUint64(&mmListChain.beforeListChainCounter, 1)
+	defer mm_atomic.AddUint64(&mmListChain.afterListChainCounter, 1)
+
+	mmListChain.t.Helper()
+
+	if mmListChain.inspectFuncListChain != nil {
+		mmListChain.inspectFuncListChain(ctx, afterSeq, limit)
+	}
+
+	mm_params := AuditRepositoryMockListChainParams{ctx, afterSeq, limit}
+
+	// Record call args
+	mmListChain.ListChainMock.mutex.Lock()
+	mmListChain.ListChainMock.callArgs = append(mmListChain.ListChainMock.callArgs, &mm_params)
+	mmListChain.ListChainMock.mutex.Unlock()
+
+	for _, e := range mmListChain.ListChainMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.apa1, e.results.err
+		}
+	}
+
+	if mmListChain.ListChainMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListChain.ListChainMock.defaultExpectation.Counter, 1)
+		mm_want := mmListChain.ListChainMock.defaultExpectation.params
+		mm_want_ptrs := mmListChain.ListChainMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditRepositoryMockListChainParams{ctx, afterSeq, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListChain.t.Errorf("AuditRepositoryMock.ListChain got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListChain.ListChainMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.afterSeq != nil && !minimock.Equal(*mm_want_ptrs.afterSeq, mm_got.afterSeq) {
+				mmListChain.t.Errorf("AuditRepositoryMock.ListChain got unexpected parameter afterSeq, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListChain.ListChainMock.defaultExpectation.expectationOrigins.originAfterSeq, *mm_want_ptrs.afterSeq, mm_got.afterSeq, minimock.Diff(*mm_want_ptrs.afterSeq, mm_got.afterSeq))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListChain.t.Errorf("AuditRepositoryMock.ListChain got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListChain.ListChainMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListChain.t.Errorf("AuditRepositoryMock.ListChain got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListChain.ListChainMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListChain.ListChainMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListChain.t.Fatal("No results are set for the AuditRepositoryMock.ListChain")
+		}
+		return (*mm_results).apa1, (*mm_results).err
+	}
+	if mmListChain.funcListChain != nil {
+		return mmListChain.funcListChain(ctx, afterSeq, limit)
+	}
+	mmListChain.t.Fatalf("Unexpected call to AuditRepositoryMock.ListChain. %v %v %v", ctx, afterSeq, limit)
+	return
+}
+
+// ListChainAfterCounter returns a count of finished AuditRepositoryMock.ListChain invocations
+func (mmListChain *AuditRepositoryMock) ListChainAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListChain.afterListChainCounter)
+}
+
+// ListChainBeforeCounter returns a count of AuditRepositoryMock.ListChain invocations
+func (mmListChain *AuditRepositoryMock) ListChainBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListChain.beforeListChainCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditRepositoryMock.ListChain.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListChain *mAuditRepositoryMockListChain) Calls() []*AuditRepositoryMockListChainParams {
+	mmListChain.mutex.RLock()
+
+	argCopy := make([]*AuditRepositoryMockListChainParams, len(mmListChain.callArgs))
+	copy(argCopy, mmListChain.callArgs)
+
+	mmListChain.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListChainDone returns true if the count of the ListChain invocations corresponds
+// the number of defined expectations
+func (m *AuditRepositoryMock) MinimockListChainDone() bool {
+	if m.ListChainMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListChainMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListChainMock.invocationsDone()
+}
+
+// MinimockListChainInspect logs each unmet expectation
+func (m *AuditRepositoryMock) MinimockListChainInspect() {
+	for _, e := range m.ListChainMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditRepositoryMock.ListChain at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListChainCounter := mm_atomic.LoadUint64(&m.afterListChainCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListChainMock.defaultExpectation != nil && afterListChainCounter < 1 {
+		if m.ListChainMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditRepositoryMock.ListChain at\n%s", m.ListChainMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditRepositoryMock.ListChain at\n%s with params: %#v", m.ListChainMock.defaultExpectation.expectationOrigins.origin, *m.ListChainMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListChain != nil && afterListChainCounter < 1 {
+		m.t.Errorf("Expected call to AuditRepositoryMock.ListChain at\n%s", m.funcListChainOrigin)
+	}
+
+	if !m.ListChainMock.invocationsDone() && afterListChainCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditRepositoryMock.ListChain at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListChainMock.expectedInvocations), m.ListChainMock.expectedInvocationsOrigin, afterListChainCounter)
+	}
+}
+
+type mAuditRepositoryMockRecord struct {
+	optional           bool
+	mock               *AuditRepositoryMock
+	defaultExpectation *AuditRepositoryMockRecordExpectation
+	expectations       []*AuditRepositoryMockRecordExpectation
+
+	callArgs []*AuditRepositoryMockRecordParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditRepositoryMockRecordExpectation specifies expectation struct of the AuditRepository.Record
+type AuditRepositoryMockRecordExpectation struct {
+	mock               *AuditRepositoryMock
+	params             *AuditRepositoryMockRecordParams
+	paramPtrs          *AuditRepositoryMockRecordParamPtrs
+	expectationOrigins AuditRepositoryMockRecordExpectationOrigins
+	results            *AuditRepositoryMockRecordResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditRepositoryMockRecordParams contains parameters of the AuditRepository.Record
+type AuditRepositoryMockRecordParams struct {
+	ctx   context.Context
+	event *model.AuditEvent
+}
+
+// AuditRepositoryMockRecordParamPtrs contains pointers to parameters of the AuditRepository.Record
+type AuditRepositoryMockRecordParamPtrs struct {
+	ctx   *context.Context
+	event **model.AuditEvent
+}
+
+// AuditRepositoryMockRecordResults contains results of the AuditRepository.Record
+type AuditRepositoryMockRecordResults struct {
+	err error
+}
+
+// AuditRepositoryMockRecordOrigins contains origins of expectations of the AuditRepository.Record
+type AuditRepositoryMockRecordExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEvent string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRecord *mAuditRepositoryMockRecord) Optional() *mAuditRepositoryMockRecord {
+	mmRecord.optional = true
+	return mmRecord
+}
+
+// Expect sets up expected params for AuditRepository.Record
+func (mmRecord *mAuditRepositoryMockRecord) Expect(ctx context.Context, event *model.AuditEvent) *mAuditRepositoryMockRecord {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("AuditRepositoryMock.Record mock is already set by Set")
+	}
+
+	if mmRecord.defaultExpectation == nil {
+		mmRecord.defaultExpectation = &AuditRepositoryMockRecordExpectation{}
+	}
+
+	if mmRecord.defaultExpectation.paramPtrs != nil {
+		mmRecord.mock.t.Fatalf("AuditRepositoryMock.Record mock is already set by ExpectParams functions")
+	}
+
+	mmRecord.defaultExpectation.params = &AuditRepositoryMockRecordParams{ctx, event}
+	mmRecord.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRecord.expectations {
+		if minimock.Equal(e.params, mmRecord.defaultExpectation.params) {
+			mmRecord.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecord.defaultExpectation.params)
+		}
+	}
+
+	return mmRecord
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditRepository.Record
+func (mmRecord *mAuditRepositoryMockRecord) ExpectCtxParam1(ctx context.Context) *mAuditRepositoryMockRecord {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("AuditRepositoryMock.Record mock is already set by Set")
+	}
+
+	if mmRecord.defaultExpectation == nil {
+		mmRecord.defaultExpectation = &AuditRepositoryMockRecordExpectation{}
+	}
+
+	if mmRecord.defaultExpectation.params != nil {
+		mmRecord.mock.t.Fatalf("AuditRepositoryMock.Record mock is already set by Expect")
+	}
+
+	if mmRecord.defaultExpectation.paramPtrs == nil {
+		mmRecord.defaultExpectation.paramPtrs = &AuditRepositoryMockRecordParamPtrs{}
+	}
+	mmRecord.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRecord.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRecord
+}
+
+// ExpectEventParam2 sets up expected param event for AuditRepository.Record
+func (mmRecord *mAuditRepositoryMockRecord) ExpectEventParam2(event *model.AuditEvent) *mAuditRepositoryMockRecord {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("AuditRepositoryMock.Record mock is already set by Set")
+	}
+
+	if mmRecord.defaultExpectation == nil {
+		mmRecord.defaultExpectation = &AuditRepositoryMockRecordExpectation{}
+	}
+
+	if mmRecord.defaultExpectation.params != nil {
+		mmRecord.mock.t.Fatalf("AuditRepositoryMock.Record mock is already set by Expect")
+	}
+
+	if mmRecord.defaultExpectation.paramPtrs == nil {
+		mmRecord.defaultExpectation.paramPtrs = &AuditRepositoryMockRecordParamPtrs{}
+	}
+	mmRecord.defaultExpectation.paramPtrs.event = &event
+	mmRecord.defaultExpectation.expectationOrigins.originEvent = minimock.CallerInfo(1)
+
+	return mmRecord
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditRepository.Record
+func (mmRecord *mAuditRepositoryMockRecord) Inspect(f func(ctx context.Context, event *model.AuditEvent)) *mAuditRepositoryMockRecord {
+	if mmRecord.mock.inspectFuncRecord != nil {
+		mmRecord.mock.t.Fatalf("Inspect function is already set for AuditRepositoryMock.Record")
+	}
+
+	mmRecord.mock.inspectFuncRecord = f
+
+	return mmRecord
+}
+
+// Return sets up results that will be returned by AuditRepository.Record
+func (mmRecord *mAuditRepositoryMockRecord) Return(err error) *AuditRepositoryMock {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("AuditRepositoryMock.Record mock is already set by Set")
+	}
+
+	if mmRecord.defaultExpectation == nil {
+		mmRecord.defaultExpectation = &AuditRepositoryMockRecordExpectation{mock: mmRecord.mock}
+	}
+	mmRecord.defaultExpectation.results = &AuditRepositoryMockRecordResults{err}
+	mmRecord.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRecord.mock
+}
+
+// Set uses given function f to mock the AuditRepository.Record method
+func (mmRecord *mAuditRepositoryMockRecord) Set(f func(ctx context.Context, event *model.AuditEvent) (err error)) *AuditRepositoryMock {
+	if mmRecord.defaultExpectation != nil {
+		mmRecord.mock.t.Fatalf("Default expectation is already set for the AuditRepository.Record method")
+	}
+
+	if len(mmRecord.expectations) > 0 {
+		mmRecord.mock.t.Fatalf("Some expectations are already set for the AuditRepository.Record method")
+	}
+
+	mmRecord.mock.funcRecord = f
+	mmRecord.mock.funcRecordOrigin = minimock.CallerInfo(1)
+	return mmRecord.mock
+}
+
+// When sets expectation for the AuditRepository.Record which will trigger the result defined by the following
+// Then helper
+func (mmRecord *mAuditRepositoryMockRecord) When(ctx context.Context, event *model.AuditEvent) *AuditRepositoryMockRecordExpectation {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("AuditRepositoryMock.Record mock is already set by Set")
+	}
+
+	expectation := &AuditRepositoryMockRecordExpectation{
+		mock:               mmRecord.mock,
+		params:             &AuditRepositoryMockRecordParams{ctx, event},
+		expectationOrigins: AuditRepositoryMockRecordExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRecord.expectations = append(mmRecord.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditRepository.Record return parameters for the expectation previously defined by the When method
+func (e *AuditRepositoryMockRecordExpectation) Then(err error) *AuditRepositoryMock {
+	e.results = &AuditRepositoryMockRecordResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuditRepository.Record should be invoked
+func (mmRecord *mAuditRepositoryMockRecord) Times(n uint64) *mAuditRepositoryMockRecord {
+	if n == 0 {
+		mmRecord.mock.t.Fatalf("Times of AuditRepositoryMock.Record mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRecord.expectedInvocations, n)
+	mmRecord.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRecord
+}
+
+func (mmRecord *mAuditRepositoryMockRecord) invocationsDone() bool {
+	if len(mmRecord.expectations) == 0 && mmRecord.defaultExpectation == nil && mmRecord.mock.funcRecord == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRecord.mock.afterRecordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRecord.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Record implements mm_repository.AuditRepository
+func (mmRecord *AuditRepositoryMock) Record(ctx context.Context, event *model.AuditEvent) (err error) {
+	mm_atomic.AddUint64(&mmRecord.beforeRecordCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecord.afterRecordCounter, 1)
+
+	mmRecord.t.Helper()
+
+	if mmRecord.inspectFuncRecord != nil {
+		mmRecord.inspectFuncRecord(ctx, event)
+	}
+
+	mm_params := AuditRepositoryMockRecordParams{ctx, event}
+
+	// Record call args
+	mmRecord.RecordMock.mutex.Lock()
+	mmRecord.RecordMock.callArgs = append(mmRecord.RecordMock.callArgs, &mm_params)
+	mmRecord.RecordMock.mutex.Unlock()
+
+	for _, e := range mmRecord.RecordMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRecord.RecordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecord.RecordMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecord.RecordMock.defaultExpectation.params
+		mm_want_ptrs := mmRecord.RecordMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditRepositoryMockRecordParams{ctx, event}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRecord.t.Errorf("AuditRepositoryMock.Record got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecord.RecordMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmRecord.t.Errorf("AuditRepositoryMock.Record got unexpected parameter event, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecord.RecordMock.defaultExpectation.expectationOrigins.originEvent, *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecord.t.Errorf("AuditRepositoryMock.Record got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRecord.RecordMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRecord.RecordMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRecord.t.Fatal("No results are set for the AuditRepositoryMock.Record")
+		}
+		return (*mm_results).err
+	}
+	if mmRecord.funcRecord != nil {
+		return mmRecord.funcRecord(ctx, event)
+	}
+	mmRecord.t.Fatalf("Unexpected call to AuditRepositoryMock.Record. %v %v", ctx, event)
+	return
+}
+
+// RecordAfterCounter returns a count of finished AuditRepositoryMock.Record invocations
+func (mmRecord *AuditRepositoryMock) RecordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecord.afterRecordCounter)
+}
+
+// RecordBeforeCounter returns a count of AuditRepositoryMock.Record invocations
+func (mmRecord *AuditRepositoryMock) RecordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecord.beforeRecordCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditRepositoryMock.Record.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecord *mAuditRepositoryMockRecord) Calls() []*AuditRepositoryMockRecordParams {
+	mmRecord.mutex.RLock()
+
+	argCopy := make([]*AuditRepositoryMockRecordParams, len(mmRecord.callArgs))
+	copy(argCopy, mmRecord.callArgs)
+
+	mmRecord.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecordDone returns true if the count of the Record invocations corresponds
+// the number of defined expectations
+func (m *AuditRepositoryMock) MinimockRecordDone() bool {
+	if m.RecordMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RecordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RecordMock.invocationsDone()
+}
+
+// MinimockRecordInspect logs each unmet expectation
+func (m *AuditRepositoryMock) MinimockRecordInspect() {
+	for _, e := range m.RecordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditRepositoryMock.Record at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRecordCounter := mm_atomic.LoadUint64(&m.afterRecordCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecordMock.defaultExpectation != nil && afterRecordCounter < 1 {
+		if m.RecordMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditRepositoryMock.Record at\n%s", m.RecordMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditRepositoryMock.Record at\n%s with params: %#v", m.RecordMock.defaultExpectation.expectationOrigins.origin, *m.RecordMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecord != nil && afterRecordCounter < 1 {
+		m.t.Errorf("Expected call to AuditRepositoryMock.Record at\n%s", m.funcRecordOrigin)
+	}
+
+	if !m.RecordMock.invocationsDone() && afterRecordCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditRepositoryMock.Record at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RecordMock.expectedInvocations), m.RecordMock.expectedInvocationsOrigin, afterRecordCounter)
+	}
+}
+
+type mAuditRepositoryMockSeal struct {
+	optional           bool
+	mock               *AuditRepositoryMock
+	defaultExpectation *AuditRepositoryMockSealExpectation
+	expectations       []*AuditRepositoryMockSealExpectation
+
+	callArgs []*AuditRepositoryMockSealParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditRepositoryMockSealExpectation specifies expectation struct of the AuditRepository.Seal
+type AuditRepositoryMockSealExpectation struct {
+	mock               *AuditRepositoryMock
+	params             *AuditRepositoryMockSealParams
+	paramPtrs          *AuditRepositoryMockSealParamPtrs
+	expectationOrigins AuditRepositoryMockSealExpectationOrigins
+	results            *AuditRepositoryMockSealResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditRepositoryMockSealParams contains parameters of the AuditRepository.Seal
+type AuditRepositoryMockSealParams struct {
+	ctx   context.Context
+	limit int
+}
+
+// AuditRepositoryMockSealParamPtrs contains pointers to parameters of the AuditRepository.Seal
+type AuditRepositoryMockSealParamPtrs struct {
+	ctx   *context.Context
+	limit *int
+}
+
+// AuditRepositoryMockSealResults contains results of the AuditRepository.Seal
+type AuditRepositoryMockSealResults struct {
+	i1  int
+	err error
+}
+
+// AuditRepositoryMockSealOrigins contains origins of expectations of the AuditRepository.Seal
+type AuditRepositoryMockSealExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSeal *mAuditRepositoryMockSeal) Optional() *mAuditRepositoryMockSeal {
+	mmSeal.optional = true
+	return mmSeal
+}
+
+// Expect sets up expected params for AuditRepository.Seal
+func (mmSeal *mAuditRepositoryMockSeal) Expect(ctx context.Context, limit int) *mAuditRepositoryMockSeal {
+	if mmSeal.mock.funcSeal != nil {
+		mmSeal.mock.t.Fatalf("AuditRepositoryMock.Seal mock is already set by Set")
+	}
+
+	if mmSeal.defaultExpectation == nil {
+		mmSeal.defaultExpectation = &AuditRepositoryMockSealExpectation{}
+	}
+
+	if mmSeal.defaultExpectation.paramPtrs != nil {
+		mmSeal.mock.t.Fatalf("AuditRepositoryMock.Seal mock is already set by ExpectParams functions")
+	}
+
+	mmSeal.defaultExpectation.params = &AuditRepositoryMockSealParams{ctx, limit}
+	mmSeal.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSeal.expectations {
+		if minimock.Equal(e.params, mmSeal.defaultExpectation.params) {
+			mmSeal.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSeal.defaultExpectation.params)
+		}
+	}
+
+	return mmSeal
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditRepository.Seal
+func (mmSeal *mAuditRepositoryMockSeal) ExpectCtxParam1(ctx context.Context) *mAuditRepositoryMockSeal {
+	if mmSeal.mock.funcSeal != nil {
+		mmSeal.mock.t.Fatalf("AuditRepositoryMock.Seal mock is already set by Set")
+	}
+
+	if mmSeal.defaultExpectation == nil {
+		mmSeal.defaultExpectation = &AuditRepositoryMockSealExpectation{}
+	}
+
+	if mmSeal.defaultExpectation.params != nil {
+		mmSeal.mock.t.Fatalf("AuditRepositoryMock.Seal mock is already set by Expect")
+	}
+
+	if mmSeal.defaultExpectation.paramPtrs == nil {
+		mmSeal.defaultExpectation.paramPtrs = &AuditRepositoryMockSealParamPtrs{}
+	}
+	mmSeal.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSeal.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSeal
+}
+
+// ExpectLimitParam2 sets up expected param limit for AuditRepository.Seal
+func (mmSeal *mAuditRepositoryMockSeal) ExpectLimitParam2(limit int) *mAuditRepositoryMockSeal {
+	if mmSeal.mock.funcSeal != nil {
+		mmSeal.mock.t.Fatalf("AuditRepositoryMock.Seal mock is already set by Set")
+	}
+
+	if mmSeal.defaultExpectation == nil {
+		mmSeal.defaultExpectation = &AuditRepositoryMockSealExpectation{}
+	}
+
+	if mmSeal.defaultExpectation.params != nil {
+		mmSeal.mock.t.Fatalf("AuditRepositoryMock.Seal mock is already set by Expect")
+	}
+
+	if mmSeal.defaultExpectation.paramPtrs == nil {
+		mmSeal.defaultExpectation.paramPtrs = &AuditRepositoryMockSealParamPtrs{}
+	}
+	mmSeal.defaultExpectation.paramPtrs.limit = &limit
+	mmSeal.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmSeal
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditRepository.Seal
+func (mmSeal *mAuditRepositoryMockSeal) Inspect(f func(ctx context.Context, limit int)) *mAuditRepositoryMockSeal {
+	if mmSeal.mock.inspectFuncSeal != nil {
+		mmSeal.mock.t.Fatalf("Inspect function is already set for AuditRepositoryMock.Seal")
+	}
+
+	mmSeal.mock.inspectFuncSeal = f
+
+	return mmSeal
+}
+
+// Return sets up results that will be returned by AuditRepository.Seal
+func (mmSeal *mAuditRepositoryMockSeal) Return(i1 int, err error) *AuditRepositoryMock {
+	if mmSeal.mock.funcSeal != nil {
+		mmSeal.mock.t.Fatalf("AuditRepositoryMock.Seal mock is already set by Set")
+	}
+
+	if mmSeal.defaultExpectation == nil {
+		mmSeal.defaultExpectation = &AuditRepositoryMockSealExpectation{mock: mmSeal.mock}
+	}
+	mmSeal.defaultExpectation.results = &AuditRepositoryMockSealResults{i1, err}
+	mmSeal.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSeal.mock
+}
+
+// Set uses given function f to mock the AuditRepository.Seal method
+func (mmSeal *mAuditRepositoryMockSeal) Set(f func(ctx context.Context, limit int) (i1 int, err error)) *AuditRepositoryMock {
+	if mmSeal.defaultExpectation != nil {
+		mmSeal.mock.t.Fatalf("Default expectation is already set for the AuditRepository.Seal method")
+	}
+
+	if len(mmSeal.expectations) > 0 {
+		mmSeal.mock.t.Fatalf("Some expectations are already set for the AuditRepository.Seal method")
+	}
+
+	mmSeal.mock.funcSeal = f
+	mmSeal.mock.funcSealOrigin = minimock.CallerInfo(1)
+	return mmSeal.mock
+}
+
+// When sets expectation for the AuditRepository.Seal which will trigger the result defined by the following
+// Then helper
+func (mmSeal *mAuditRepositoryMockSeal) When(ctx context.Context, limit int) *AuditRepositoryMockSealExpectation {
+	if mmSeal.mock.funcSeal != nil {
+		mmSeal.mock.t.Fatalf("AuditRepositoryMock.Seal mock is already set by Set")
+	}
+
+	expectation := &AuditRepositoryMockSealExpectation{
+		mock:               mmSeal.mock,
+		params:             &AuditRepositoryMockSealParams{ctx, limit},
+		expectationOrigins: AuditRepositoryMockSealExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSeal.expectations = append(mmSeal.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditRepository.Seal return parameters for the expectation previously defined by the When method
+func (e *AuditRepositoryMockSealExpectation) Then(i1 int, err error) *AuditRepositoryMock {
+	e.results = &AuditRepositoryMockSealResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times AuditRepository.Seal should be invoked
+func (mmSeal *mAuditRepositoryMockSeal) Times(n uint64) *mAuditRepositoryMockSeal {
+	if n == 0 {
+		mmSeal.mock.t.Fatalf("Times of AuditRepositoryMock.Seal mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSeal.expectedInvocations, n)
+	mmSeal.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSeal
+}
+
+func (mmSeal *mAuditRepositoryMockSeal) invocationsDone() bool {
+	if len(mmSeal.expectations) == 0 && mmSeal.defaultExpectation == nil && mmSeal.mock.funcSeal == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSeal.mock.afterSealCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSeal.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Seal implements mm_repository.AuditRepository
+func (mmSeal *AuditRepositoryMock) Seal(ctx context.Context, limit int) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmSeal.beforeSealCounter, 1)
+	defer mm_atomic.AddUint64(&mmSeal.afterSealCounter, 1)
+
+	mmSeal.t.Helper()
+
+	if mmSeal.inspectFuncSeal != nil {
+		mmSeal.inspectFuncSeal(ctx, limit)
+	}
+
+	mm_params := AuditRepositoryMockSealParams{ctx, limit}
+
+	// Record call args
+	mmSeal.SealMock.mutex.Lock()
+	mmSeal.SealMock.callArgs = append(mmSeal.SealMock.callArgs, &mm_params)
+	mmSeal.SealMock.mutex.Unlock()
+
+	for _, e := range mmSeal.SealMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmSeal.SealMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSeal.SealMock.defaultExpectation.Counter, 1)
+		mm_want := mmSeal.SealMock.defaultExpectation.params
+		mm_want_ptrs := mmSeal.SealMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditRepositoryMockSealParams{ctx, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSeal.t.Errorf("AuditRepositoryMock.Seal got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSeal.SealMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmSeal.t.Errorf("AuditRepositoryMock.Seal got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSeal.SealMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSeal.t.Errorf("AuditRepositoryMock.Seal got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSeal.SealMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSeal.SealMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSeal.t.Fatal("No results are set for the AuditRepositoryMock.Seal")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmSeal.funcSeal != nil {
+		return mmSeal.funcSeal(ctx, limit)
+	}
+	mmSeal.t.Fatalf("Unexpected call to AuditRepositoryMock.Seal. %v %v", ctx, limit)
+	return
+}
+
+// SealAfterCounter returns a count of finished AuditRepositoryMock.Seal invocations
+func (mmSeal *AuditRepositoryMock) SealAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSeal.afterSealCounter)
+}
+
+// SealBeforeCounter returns a count of AuditRepositoryMock.Seal invocations
+func (mmSeal *AuditRepositoryMock) SealBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSeal.beforeSealCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditRepositoryMock.Seal.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSeal *mAuditRepositoryMockSeal) Calls() []*AuditRepositoryMockSealParams {
+	mmSeal.mutex.RLock()
+
+	argCopy := make([]*AuditRepositoryMockSealParams, len(mmSeal.callArgs))
+	copy(argCopy, mmSeal.callArgs)
+
+	mmSeal.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSealDone returns true if the count of the Seal invocations corresponds
+// the number of defined expectations
+func (m *AuditRepositoryMock) MinimockSealDone() bool {
+	if m.SealMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SealMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SealMock.invocationsDone()
+}
+
+// MinimockSealInspect logs each unmet expectation
+func (m *AuditRepositoryMock) MinimockSealInspect() {
+	for _, e := range m.SealMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditRepositoryMock.Seal at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSealCounter := mm_atomic.LoadUint64(&m.afterSealCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SealMock.defaultExpectation != nil && afterSealCounter < 1 {
+		if m.SealMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditRepositoryMock.Seal at\n%s", m.SealMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditRepositoryMock.Seal at\n%s with params: %#v", m.SealMock.defaultExpectation.expectationOrigins.origin, *m.SealMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSeal != nil && afterSealCounter < 1 {
+		m.t.Errorf("Expected call to AuditRepositoryMock.Seal at\n%s", m.funcSealOrigin)
+	}
+
+	if !m.SealMock.invocationsDone() && afterSealCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditRepositoryMock.Seal at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SealMock.expectedInvocations), m.SealMock.expectedInvocationsOrigin, afterSealCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuditRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockListInspect()
+
+			m.MinimockListChainInspect()
+
+			m.MinimockRecordInspect()
+
+			m.MinimockSealInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuditRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuditRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockListDone() &&
+		m.MinimockListChainDone() &&
+		m.MinimockRecordDone() &&
+		m.MinimockSealDone()
+}
@@ -0,0 +1,771 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// EmailVerificationRepositoryMock implements mm_repository.EmailVerificationRepository
+type EmailVerificationRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, verification *model.EmailVerification) (err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, verification *model.EmailVerification)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mEmailVerificationRepositoryMockCreate
+
+	funcUse          func(ctx context.Context, tokenHash string) (ep1 *model.EmailVerification, err error)
+	funcUseOrigin    string
+	inspectFuncUse   func(ctx context.Context, tokenHash string)
+	afterUseCounter  uint64
+	beforeUseCounter uint64
+	UseMock          mEmailVerificationRepositoryMockUse
+}
+
+// NewEmailVerificationRepositoryMock returns a mock for mm_repository.EmailVerificationRepository
+func NewEmailVerificationRepositoryMock(t minimock.Tester) *EmailVerificationRepositoryMock {
+	m := &EmailVerificationRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mEmailVerificationRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*EmailVerificationRepositoryMockCreateParams{}
+
+	m.UseMock = mEmailVerificationRepositoryMockUse{mock: m}
+	m.UseMock.callArgs = []*EmailVerificationRepositoryMockUseParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mEmailVerificationRepositoryMockCreate struct {
+	optional           bool
+	mock               *EmailVerificationRepositoryMock
+	defaultExpectation *EmailVerificationRepositoryMockCreateExpectation
+	expectations       []*EmailVerificationRepositoryMockCreateExpectation
+
+	callArgs []*EmailVerificationRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EmailVerificationRepositoryMockCreateExpectation specifies expectation struct of the EmailVerificationRepository.Create
+type EmailVerificationRepositoryMockCreateExpectation struct {
+	mock               *EmailVerificationRepositoryMock
+	params             *EmailVerificationRepositoryMockCreateParams
+	paramPtrs          *EmailVerificationRepositoryMockCreateParamPtrs
+	expectationOrigins EmailVerificationRepositoryMockCreateExpectationOrigins
+	results            *EmailVerificationRepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EmailVerificationRepositoryMockCreateParams contains parameters of the EmailVerificationRepository.Create
+type EmailVerificationRepositoryMockCreateParams struct {
+	ctx          context.Context
+	verification *model.EmailVerification
+}
+
+// EmailVerificationRepositoryMockCreateParamPtrs contains pointers to parameters of the EmailVerificationRepository.Create
+type EmailVerificationRepositoryMockCreateParamPtrs struct {
+	ctx          *context.Context
+	verification **model.EmailVerification
+}
+
+// EmailVerificationRepositoryMockCreateResults contains results of the EmailVerificationRepository.Create
+type EmailVerificationRepositoryMockCreateResults struct {
+	err error
+}
+
+// EmailVerificationRepositoryMockCreateOrigins contains origins of expectations of the EmailVerificationRepository.Create
+type EmailVerificationRepositoryMockCreateExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originVerification string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mEmailVerificationRepositoryMockCreate) Optional() *mEmailVerificationRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for EmailVerificationRepository.Create
+func (mmCreate *mEmailVerificationRepositoryMockCreate) Expect(ctx context.Context, verification *model.EmailVerification) *mEmailVerificationRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EmailVerificationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &EmailVerificationRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("EmailVerificationRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &EmailVerificationRepositoryMockCreateParams{ctx, verification}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EmailVerificationRepository.Create
+func (mmCreate *mEmailVerificationRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mEmailVerificationRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EmailVerificationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &EmailVerificationRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("EmailVerificationRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &EmailVerificationRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectVerificationParam2 sets up expected param verification for EmailVerificationRepository.Create
+func (mmCreate *mEmailVerificationRepositoryMockCreate) ExpectVerificationParam2(verification *model.EmailVerification) *mEmailVerificationRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EmailVerificationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &EmailVerificationRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("EmailVerificationRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &EmailVerificationRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.verification = &verification
+	mmCreate.defaultExpectation.expectationOrigins.originVerification = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the EmailVerificationRepository.Create
+func (mmCreate *mEmailVerificationRepositoryMockCreate) Inspect(f func(ctx context.Context, verification *model.EmailVerification)) *mEmailVerificationRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for EmailVerificationRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by EmailVerificationRepository.Create
+func (mmCreate *mEmailVerificationRepositoryMockCreate) Return(err error) *EmailVerificationRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EmailVerificationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &EmailVerificationRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &EmailVerificationRepositoryMockCreateResults{err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the EmailVerificationRepository.Create method
+func (mmCreate *mEmailVerificationRepositoryMockCreate) Set(f func(ctx context.Context, verification *model.EmailVerification) (err error)) *EmailVerificationRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the EmailVerificationRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the EmailVerificationRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the EmailVerificationRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mEmailVerificationRepositoryMockCreate) When(ctx context.Context, verification *model.EmailVerification) *EmailVerificationRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EmailVerificationRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &EmailVerificationRepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &EmailVerificationRepositoryMockCreateParams{ctx, verification},
+		expectationOrigins: EmailVerificationRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EmailVerificationRepository.Create return parameters for the expectation previously defined by the When method
+func (e *EmailVerificationRepositoryMockCreateExpectation) Then(err error) *EmailVerificationRepositoryMock {
+	e.results = &EmailVerificationRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times EmailVerificationRepository.Create should be invoked
+func (mmCreate *mEmailVerificationRepositoryMockCreate) Times(n uint64) *mEmailVerificationRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of EmailVerificationRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mEmailVerificationRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_repository.EmailVerificationRepository
+func (mmCreate *EmailVerificationRepositoryMock) Create(ctx context.Context, verification *model.EmailVerification) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, verification)
+	}
+
+	mm_params := EmailVerificationRepositoryMockCreateParams{ctx, verification}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := EmailVerificationRepositoryMockCreateParams{ctx, verification}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("EmailVerificationRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.verification != nil && !minimock.Equal(*mm_want_ptrs.verification, mm_got.verification) {
+				mmCreate.t.Errorf("EmailVerificationRepositoryMock.Create got unexpected parameter verification, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originVerification, *mm_want_ptrs.verification, mm_got.verification, minimock.Diff(*mm_want_ptrs.verification, mm_got.verification))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("EmailVerificationRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the EmailVerificationRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, verification)
+	}
+	mmCreate.t.Fatalf("Unexpected call to EmailVerificationRepositoryMock.Create. %v %v", ctx, verification)
+	return
+}
+
+// CreateAfterCounter returns a count of finished EmailVerificationRepositoryMock.Create invocations
+func (mmCreate *EmailVerificationRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of EmailVerificationRepositoryMock.Create invocations
+func (mmCreate *EmailVerificationRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to EmailVerificationRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mEmailVerificationRepositoryMockCreate) Calls() []*EmailVerificationRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*EmailVerificationRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *EmailVerificationRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *EmailVerificationRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EmailVerificationRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EmailVerificationRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EmailVerificationRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to EmailVerificationRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to EmailVerificationRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mEmailVerificationRepositoryMockUse struct {
+	optional           bool
+	mock               *EmailVerificationRepositoryMock
+	defaultExpectation *EmailVerificationRepositoryMockUseExpectation
+	expectations       []*EmailVerificationRepositoryMockUseExpectation
+
+	callArgs []*EmailVerificationRepositoryMockUseParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EmailVerificationRepositoryMockUseExpectation specifies expectation struct of the EmailVerificationRepository.Use
+type EmailVerificationRepositoryMockUseExpectation struct {
+	mock               *EmailVerificationRepositoryMock
+	params             *EmailVerificationRepositoryMockUseParams
+	paramPtrs          *EmailVerificationRepositoryMockUseParamPtrs
+	expectationOrigins EmailVerificationRepositoryMockUseExpectationOrigins
+	results            *EmailVerificationRepositoryMockUseResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EmailVerificationRepositoryMockUseParams contains parameters of the EmailVerificationRepository.Use
+type EmailVerificationRepositoryMockUseParams struct {
+	ctx       context.Context
+	tokenHash string
+}
+
+// EmailVerificationRepositoryMockUseParamPtrs contains pointers to parameters of the EmailVerificationRepository.Use
+type EmailVerificationRepositoryMockUseParamPtrs struct {
+	ctx       *context.Context
+	tokenHash *string
+}
+
+// EmailVerificationRepositoryMockUseResults contains results of the EmailVerificationRepository.Use
+type EmailVerificationRepositoryMockUseResults struct {
+	ep1 *model.EmailVerification
+	err error
+}
+
+// EmailVerificationRepositoryMockUseOrigins contains origins of expectations of the EmailVerificationRepository.Use
+type EmailVerificationRepositoryMockUseExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originTokenHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUse *mEmailVerificationRepositoryMockUse) Optional() *mEmailVerificationRepositoryMockUse {
+	mmUse.optional = true
+	return mmUse
+}
+
+// Expect sets up expected params for EmailVerificationRepository.Use
+func (mmUse *mEmailVerificationRepositoryMockUse) Expect(ctx context.Context, tokenHash string) *mEmailVerificationRepositoryMockUse {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("EmailVerificationRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &EmailVerificationRepositoryMockUseExpectation{}
+	}
+
+	if mmUse.defaultExpectation.paramPtrs != nil {
+		mmUse.mock.t.Fatalf("EmailVerificationRepositoryMock.Use mock is already set by ExpectParams functions")
+	}
+
+	mmUse.defaultExpectation.params = &EmailVerificationRepositoryMockUseParams{ctx, tokenHash}
+	mmUse.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUse.expectations {
+		if minimock.Equal(e.params, mmUse.defaultExpectation.params) {
+			mmUse.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUse.defaultExpectation.params)
+		}
+	}
+
+	return mmUse
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EmailVerificationRepository.Use
+func (mmUse *mEmailVerificationRepositoryMockUse) ExpectCtxParam1(ctx context.Context) *mEmailVerificationRepositoryMockUse {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("EmailVerificationRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &EmailVerificationRepositoryMockUseExpectation{}
+	}
+
+	if mmUse.defaultExpectation.params != nil {
+		mmUse.mock.t.Fatalf("EmailVerificationRepositoryMock.Use mock is already set by Expect")
+	}
+
+	if mmUse.defaultExpectation.paramPtrs == nil {
+		mmUse.defaultExpectation.paramPtrs = &EmailVerificationRepositoryMockUseParamPtrs{}
+	}
+	mmUse.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUse.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUse
+}
+
+// ExpectTokenHashParam2 sets up expected param tokenHash for EmailVerificationRepository.Use
+func (mmUse *mEmailVerificationRepositoryMockUse) ExpectTokenHashParam2(tokenHash string) *mEmailVerificationRepositoryMockUse {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("EmailVerificationRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &EmailVerificationRepositoryMockUseExpectation{}
+	}
+
+	if mmUse.defaultExpectation.params != nil {
+		mmUse.mock.t.Fatalf("EmailVerificationRepositoryMock.Use mock is already set by Expect")
+	}
+
+	if mmUse.defaultExpectation.paramPtrs == nil {
+		mmUse.defaultExpectation.paramPtrs = &EmailVerificationRepositoryMockUseParamPtrs{}
+	}
+	mmUse.defaultExpectation.paramPtrs.tokenHash = &tokenHash
+	mmUse.defaultExpectation.expectationOrigins.originTokenHash = minimock.CallerInfo(1)
+
+	return mmUse
+}
+
+// Inspect accepts an inspector function that has same arguments as the EmailVerificationRepository.Use
+func (mmUse *mEmailVerificationRepositoryMockUse) Inspect(f func(ctx context.Context, tokenHash string)) *mEmailVerificationRepositoryMockUse {
+	if mmUse.mock.inspectFuncUse != nil {
+		mmUse.mock.t.Fatalf("Inspect function is already set for EmailVerificationRepositoryMock.Use")
+	}
+
+	mmUse.mock.inspectFuncUse = f
+
+	return mmUse
+}
+
+// Return sets up results that will be returned by EmailVerificationRepository.Use
+func (mmUse *mEmailVerificationRepositoryMockUse) Return(ep1 *model.EmailVerification, err error) *EmailVerificationRepositoryMock {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("EmailVerificationRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &EmailVerificationRepositoryMockUseExpectation{mock: mmUse.mock}
+	}
+	mmUse.defaultExpectation.results = &EmailVerificationRepositoryMockUseResults{ep1, err}
+	mmUse.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUse.mock
+}
+
+// Set uses given function f to mock the EmailVerificationRepository.Use method
+func (mmUse *mEmailVerificationRepositoryMockUse) Set(f func(ctx context.Context, tokenHash string) (ep1 *model.EmailVerification, err error)) *EmailVerificationRepositoryMock {
+	if mmUse.defaultExpectation != nil {
+		mmUse.mock.t.Fatalf("Default expectation is already set for the EmailVerificationRepository.Use method")
+	}
+
+	if len(mmUse.expectations) > 0 {
+		mmUse.mock.t.Fatalf("Some expectations are already set for the EmailVerificationRepository.Use method")
+	}
+
+	mmUse.mock.funcUse = f
+	mmUse.mock.funcUseOrigin = minimock.CallerInfo(1)
+	return mmUse.mock
+}
+
+// When sets expectation for the EmailVerificationRepository.Use which will trigger the result defined by the following
+// Then helper
+func (mmUse *mEmailVerificationRepositoryMockUse) When(ctx context.Context, tokenHash string) *EmailVerificationRepositoryMockUseExpectation {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("EmailVerificationRepositoryMock.Use mock is already set by Set")
+	}
+
+	expectation := &EmailVerificationRepositoryMockUseExpectation{
+		mock:               mmUse.mock,
+		params:             &EmailVerificationRepositoryMockUseParams{ctx, tokenHash},
+		expectationOrigins: EmailVerificationRepositoryMockUseExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUse.expectations = append(mmUse.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EmailVerificationRepository.Use return parameters for the expectation previously defined by the When method
+func (e *EmailVerificationRepositoryMockUseExpectation) Then(ep1 *model.EmailVerification, err error) *EmailVerificationRepositoryMock {
+	e.results = &EmailVerificationRepositoryMockUseResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times EmailVerificationRepository.Use should be invoked
+func (mmUse *mEmailVerificationRepositoryMockUse) Times(n uint64) *mEmailVerificationRepositoryMockUse {
+	if n == 0 {
+		mmUse.mock.t.Fatalf("Times of EmailVerificationRepositoryMock.Use mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUse.expectedInvocations, n)
+	mmUse.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUse
+}
+
+func (mmUse *mEmailVerificationRepositoryMockUse) invocationsDone() bool {
+	if len(mmUse.expectations) == 0 && mmUse.defaultExpectation == nil && mmUse.mock.funcUse == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUse.mock.afterUseCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUse.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Use implements mm_repository.EmailVerificationRepository
+func (mmUse *EmailVerificationRepositoryMock) Use(ctx context.Context, tokenHash string) (ep1 *model.EmailVerification, err error) {
+	mm_atomic.AddUint64(&mmUse.beforeUseCounter, 1)
+	defer mm_atomic.AddUint64(&mmUse.afterUseCounter, 1)
+
+	mmUse.t.Helper()
+
+	if mmUse.inspectFuncUse != nil {
+		mmUse.inspectFuncUse(ctx, tokenHash)
+	}
+
+	mm_params := EmailVerificationRepositoryMockUseParams{ctx, tokenHash}
+
+	// Record call args
+	mmUse.UseMock.mutex.Lock()
+	mmUse.UseMock.callArgs = append(mmUse.UseMock.callArgs, &mm_params)
+	mmUse.UseMock.mutex.Unlock()
+
+	for _, e := range mmUse.UseMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmUse.UseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUse.UseMock.defaultExpectation.Counter, 1)
+		mm_want := mmUse.UseMock.defaultExpectation.params
+		mm_want_ptrs := mmUse.UseMock.defaultExpectation.paramPtrs
+
+		mm_got := EmailVerificationRepositoryMockUseParams{ctx, tokenHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUse.t.Errorf("EmailVerificationRepositoryMock.Use got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUse.UseMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tokenHash != nil && !minimock.Equal(*mm_want_ptrs.tokenHash, mm_got.tokenHash) {
+				mmUse.t.Errorf("EmailVerificationRepositoryMock.Use got unexpected parameter tokenHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUse.UseMock.defaultExpectation.expectationOrigins.originTokenHash, *mm_want_ptrs.tokenHash, mm_got.tokenHash, minimock.Diff(*mm_want_ptrs.tokenHash, mm_got.tokenHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUse.t.Errorf("EmailVerificationRepositoryMock.Use got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUse.UseMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUse.UseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUse.t.Fatal("No results are set for the EmailVerificationRepositoryMock.Use")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmUse.funcUse != nil {
+		return mmUse.funcUse(ctx, tokenHash)
+	}
+	mmUse.t.Fatalf("Unexpected call to EmailVerificationRepositoryMock.Use. %v %v", ctx, tokenHash)
+	return
+}
+
+// UseAfterCounter returns a count of finished EmailVerificationRepositoryMock.Use invocations
+func (mmUse *EmailVerificationRepositoryMock) UseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUse.afterUseCounter)
+}
+
+// UseBeforeCounter returns a count of EmailVerificationRepositoryMock.Use invocations
+func (mmUse *EmailVerificationRepositoryMock) UseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUse.beforeUseCounter)
+}
+
+// Calls returns a list of arguments used in each call to EmailVerificationRepositoryMock.Use.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUse *mEmailVerificationRepositoryMockUse) Calls() []*EmailVerificationRepositoryMockUseParams {
+	mmUse.mutex.RLock()
+
+	argCopy := make([]*EmailVerificationRepositoryMockUseParams, len(mmUse.callArgs))
+	copy(argCopy, mmUse.callArgs)
+
+	mmUse.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUseDone returns true if the count of the Use invocations corresponds
+// the number of defined expectations
+func (m *EmailVerificationRepositoryMock) MinimockUseDone() bool {
+	if m.UseMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UseMock.invocationsDone()
+}
+
+// MinimockUseInspect logs each unmet expectation
+func (m *EmailVerificationRepositoryMock) MinimockUseInspect() {
+	for _, e := range m.UseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EmailVerificationRepositoryMock.Use at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUseCounter := mm_atomic.LoadUint64(&m.afterUseCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UseMock.defaultExpectation != nil && afterUseCounter < 1 {
+		if m.UseMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EmailVerificationRepositoryMock.Use at\n%s", m.UseMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EmailVerificationRepositoryMock.Use at\n%s with params: %#v", m.UseMock.defaultExpectation.expectationOrigins.origin, *m.UseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUse != nil && afterUseCounter < 1 {
+		m.t.Errorf("Expected call to EmailVerificationRepositoryMock.Use at\n%s", m.funcUseOrigin)
+	}
+
+	if !m.UseMock.invocationsDone() && afterUseCounter > 0 {
+		m.t.Errorf("Expected %d calls to EmailVerificationRepositoryMock.Use at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UseMock.expectedInvocations), m.UseMock.expectedInvocationsOrigin, afterUseCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *EmailVerificationRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockUseInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *EmailVerificationRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *EmailVerificationRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockUseDone()
+}
@@ -0,0 +1,1576 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// LoginAttemptRepositoryMock implements mm_repository.LoginAttemptRepository
+type LoginAttemptRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddFailure          func(ctx context.Context, key string) (lp1 *model.LoginFailures, err error)
+	funcAddFailureOrigin    string
+	inspectFuncAddFailure   func(ctx context.Context, key string)
+	afterAddFailureCounter  uint64
+	beforeAddFailureCounter uint64
+	AddFailureMock          mLoginAttemptRepositoryMockAddFailure
+
+	funcRelease          func(ctx context.Context, key string, at time.Time, before *model.LoginFailures) (err error)
+	funcReleaseOrigin    string
+	inspectFuncRelease   func(ctx context.Context, key string, at time.Time, before *model.LoginFailures)
+	afterReleaseCounter  uint64
+	beforeReleaseCounter uint64
+	ReleaseMock          mLoginAttemptRepositoryMockRelease
+
+	funcReserve          func(ctx context.Context, key string, at time.Time) (lp1 *model.LoginFailures, err error)
+	funcReserveOrigin    string
+	inspectFuncReserve   func(ctx context.Context, key string, at time.Time)
+	afterReserveCounter  uint64
+	beforeReserveCounter uint64
+	ReserveMock          mLoginAttemptRepositoryMockReserve
+
+	funcReset          func(ctx context.Context, key string) (err error)
+	funcResetOrigin    string
+	inspectFuncReset   func(ctx context.Context, key string)
+	afterResetCounter  uint64
+	beforeResetCounter uint64
+	ResetMock          mLoginAttemptRepositoryMockReset
+}
+
+// NewLoginAttemptRepositoryMock returns a mock for mm_repository.LoginAttemptRepository
+func NewLoginAttemptRepositoryMock(t minimock.Tester) *LoginAttemptRepositoryMock {
+	m := &LoginAttemptRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddFailureMock = mLoginAttemptRepositoryMockAddFailure{mock: m}
+	m.AddFailureMock.callArgs = []*LoginAttemptRepositoryMockAddFailureParams{}
+
+	m.ReleaseMock = mLoginAttemptRepositoryMockRelease{mock: m}
+	m.ReleaseMock.callArgs = []*LoginAttemptRepositoryMockReleaseParams{}
+
+	m.ReserveMock = mLoginAttemptRepositoryMockReserve{mock: m}
+	m.ReserveMock.callArgs = []*LoginAttemptRepositoryMockReserveParams{}
+
+	m.ResetMock = mLoginAttemptRepositoryMockReset{mock: m}
+	m.ResetMock.callArgs = []*LoginAttemptRepositoryMockResetParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mLoginAttemptRepositoryMockAddFailure struct {
+	optional           bool
+	mock               *LoginAttemptRepositoryMock
+	defaultExpectation *LoginAttemptRepositoryMockAddFailureExpectation
+	expectations       []*LoginAttemptRepositoryMockAddFailureExpectation
+
+	callArgs []*LoginAttemptRepositoryMockAddFailureParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LoginAttemptRepositoryMockAddFailureExpectation specifies expectation struct of the LoginAttemptRepository.AddFailure
+type LoginAttemptRepositoryMockAddFailureExpectation struct {
+	mock               *LoginAttemptRepositoryMock
+	params             *LoginAttemptRepositoryMockAddFailureParams
+	paramPtrs          *LoginAttemptRepositoryMockAddFailureParamPtrs
+	expectationOrigins LoginAttemptRepositoryMockAddFailureExpectationOrigins
+	results            *LoginAttemptRepositoryMockAddFailureResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LoginAttemptRepositoryMockAddFailureParams contains parameters of the LoginAttemptRepository.AddFailure
+type LoginAttemptRepositoryMockAddFailureParams struct {
+	ctx context.Context
+	key string
+}
+
+// LoginAttemptRepositoryMockAddFailureParamPtrs contains pointers to parameters of the LoginAttemptRepository.AddFailure
+type LoginAttemptRepositoryMockAddFailureParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// LoginAttemptRepositoryMockAddFailureResults contains results of the LoginAttemptRepository.AddFailure
+type LoginAttemptRepositoryMockAddFailureResults struct {
+	lp1 *model.LoginFailures
+	err error
+}
+
+// LoginAttemptRepositoryMockAddFailureOrigins contains origins of expectations of the LoginAttemptRepository.AddFailure
+type LoginAttemptRepositoryMockAddFailureExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddFailure *mLoginAttemptRepositoryMockAddFailure) Optional() *mLoginAttemptRepositoryMockAddFailure {
+	mmAddFailure.optional = true
+	return mmAddFailure
+}
+
+// Expect sets up expected params for LoginAttemptRepository.AddFailure
+func (mmAddFailure *mLoginAttemptRepositoryMockAddFailure) Expect(ctx context.Context, key string) *mLoginAttemptRepositoryMockAddFailure {
+	if mmAddFailure.mock.funcAddFailure != nil {
+		mmAddFailure.mock.t.Fatalf("LoginAttemptRepositoryMock.AddFailure mock is already set by Set")
+	}
+
+	if mmAddFailure.defaultExpectation == nil {
+		mmAddFailure.defaultExpectation = &LoginAttemptRepositoryMockAddFailureExpectation{}
+	}
+
+	if mmAddFailure.defaultExpectation.paramPtrs != nil {
+		mmAddFailure.mock.t.Fatalf("LoginAttemptRepositoryMock.AddFailure mock is already set by ExpectParams functions")
+	}
+
+	mmAddFailure.defaultExpectation.params = &LoginAttemptRepositoryMockAddFailureParams{ctx, key}
+	mmAddFailure.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddFailure.expectations {
+		if minimock.Equal(e.params, mmAddFailure.defaultExpectation.params) {
+			mmAddFailure.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddFailure.defaultExpectation.params)
+		}
+	}
+
+	return mmAddFailure
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LoginAttemptRepository.AddFailure
+func (mmAddFailure *mLoginAttemptRepositoryMockAddFailure) ExpectCtxParam1(ctx context.Context) *mLoginAttemptRepositoryMockAddFailure {
+	if mmAddFailure.mock.funcAddFailure != nil {
+		mmAddFailure.mock.t.Fatalf("LoginAttemptRepositoryMock.AddFailure mock is already set by Set")
+	}
+
+	if mmAddFailure.defaultExpectation == nil {
+		mmAddFailure.defaultExpectation = &LoginAttemptRepositoryMockAddFailureExpectation{}
+	}
+
+	if mmAddFailure.defaultExpectation.params != nil {
+		mmAddFailure.mock.t.Fatalf("LoginAttemptRepositoryMock.AddFailure mock is already set by Expect")
+	}
+
+	if mmAddFailure.defaultExpectation.paramPtrs == nil {
+		mmAddFailure.defaultExpectation.paramPtrs = &LoginAttemptRepositoryMockAddFailureParamPtrs{}
+	}
+	mmAddFailure.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddFailure.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddFailure
+}
+
+// ExpectKeyParam2 sets up expected param key for LoginAttemptRepository.AddFailure
+func (mmAddFailure *mLoginAttemptRepositoryMockAddFailure) ExpectKeyParam2(key string) *mLoginAttemptRepositoryMockAddFailure {
+	if mmAddFailure.mock.funcAddFailure != nil {
+		mmAddFailure.mock.t.Fatalf("LoginAttemptRepositoryMock.AddFailure mock is already set by Set")
+	}
+
+	if mmAddFailure.defaultExpectation == nil {
+		mmAddFailure.defaultExpectation = &LoginAttemptRepositoryMockAddFailureExpectation{}
+	}
+
+	if mmAddFailure.defaultExpectation.params != nil {
+		mmAddFailure.mock.t.Fatalf("LoginAttemptRepositoryMock.AddFailure mock is already set by Expect")
+	}
+
+	if mmAddFailure.defaultExpectation.paramPtrs == nil {
+		mmAddFailure.defaultExpectation.paramPtrs = &LoginAttemptRepositoryMockAddFailureParamPtrs{}
+	}
+	mmAddFailure.defaultExpectation.paramPtrs.key = &key
+	mmAddFailure.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmAddFailure
+}
+
+// Inspect accepts an inspector function that has same arguments as the LoginAttemptRepository.AddFailure
+func (mmAddFailure *mLoginAttemptRepositoryMockAddFailure) Inspect(f func(ctx context.Context, key string)) *mLoginAttemptRepositoryMockAddFailure {
+	if mmAddFailure.mock.inspectFuncAddFailure != nil {
+		mmAddFailure.mock.t.Fatalf("Inspect function is already set for LoginAttemptRepositoryMock.AddFailure")
+	}
+
+	mmAddFailure.mock.inspectFuncAddFailure = f
+
+	return mmAddFailure
+}
+
+// Return sets up results that will be returned by LoginAttemptRepository.AddFailure
+func (mmAddFailure *mLoginAttemptRepositoryMockAddFailure) Return(lp1 *model.LoginFailures, err error) *LoginAttemptRepositoryMock {
+	if mmAddFailure.mock.funcAddFailure != nil {
+		mmAddFailure.mock.t.Fatalf("LoginAttemptRepositoryMock.AddFailure mock is already set by Set")
+	}
+
+	if mmAddFailure.defaultExpectation == nil {
+		mmAddFailure.defaultExpectation = &LoginAttemptRepositoryMockAddFailureExpectation{mock: mmAddFailure.mock}
+	}
+	mmAddFailure.defaultExpectation.results = &LoginAttemptRepositoryMockAddFailureResults{lp1, err}
+	mmAddFailure.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddFailure.mock
+}
+
+// Set uses given function f to mock the LoginAttemptRepository.AddFailure method
+func (mmAddFailure *mLoginAttemptRepositoryMockAddFailure) Set(f func(ctx context.Context, key string) (lp1 *model.LoginFailures, err error)) *LoginAttemptRepositoryMock {
+	if mmAddFailure.defaultExpectation != nil {
+		mmAddFailure.mock.t.Fatalf("Default expectation is already set for the LoginAttemptRepository.AddFailure method")
+	}
+
+	if len(mmAddFailure.expectations) > 0 {
+		mmAddFailure.mock.t.Fatalf("Some expectations are already set for the LoginAttemptRepository.AddFailure method")
+	}
+
+	mmAddFailure.mock.funcAddFailure = f
+	mmAddFailure.mock.funcAddFailureOrigin = minimock.CallerInfo(1)
+	return mmAddFailure.mock
+}
+
+// When sets expectation for the LoginAttemptRepository.AddFailure which will trigger the result defined by the following
+// Then helper
+func (mmAddFailure *mLoginAttemptRepositoryMockAddFailure) When(ctx context.Context, key string) *LoginAttemptRepositoryMockAddFailureExpectation {
+	if mmAddFailure.mock.funcAddFailure != nil {
+		mmAddFailure.mock.t.Fatalf("LoginAttemptRepositoryMock.AddFailure mock is already set by Set")
+	}
+
+	expectation := &LoginAttemptRepositoryMockAddFailureExpectation{
+		mock:               mmAddFailure.mock,
+		params:             &LoginAttemptRepositoryMockAddFailureParams{ctx, key},
+		expectationOrigins: LoginAttemptRepositoryMockAddFailureExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddFailure.expectations = append(mmAddFailure.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LoginAttemptRepository.AddFailure return parameters for the expectation previously defined by the When method
+func (e *LoginAttemptRepositoryMockAddFailureExpectation) Then(lp1 *model.LoginFailures, err error) *LoginAttemptRepositoryMock {
+	e.results = &LoginAttemptRepositoryMockAddFailureResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times LoginAttemptRepository.AddFailure should be invoked
+func (mmAddFailure *mLoginAttemptRepositoryMockAddFailure) Times(n uint64) *mLoginAttemptRepositoryMockAddFailure {
+	if n == 0 {
+		mmAddFailure.mock.t.Fatalf("Times of LoginAttemptRepositoryMock.AddFailure mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddFailure.expectedInvocations, n)
+	mmAddFailure.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddFailure
+}
+
+func (mmAddFailure *mLoginAttemptRepositoryMockAddFailure) invocationsDone() bool {
+	if len(mmAddFailure.expectations) == 0 && mmAddFailure.defaultExpectation == nil && mmAddFailure.mock.funcAddFailure == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddFailure.mock.afterAddFailureCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddFailure.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddFailure implements mm_repository.LoginAttemptRepository
+func (mmAddFailure *LoginAttemptRepositoryMock) AddFailure(ctx context.Context, key string) (lp1 *model.LoginFailures, err error) {
+	mm_atomic.AddUint64(&mmAddFailure.beforeAddFailureCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddFailure.afterAddFailureCounter, 1)
+
+	mmAddFailure.t.Helper()
+
+	if mmAddFailure.inspectFuncAddFailure != nil {
+		mmAddFailure.inspectFuncAddFailure(ctx, key)
+	}
+
+	mm_params := LoginAttemptRepositoryMockAddFailureParams{ctx, key}
+
+	// Record call args
+	mmAddFailure.AddFailureMock.mutex.Lock()
+	mmAddFailure.AddFailureMock.callArgs = append(mmAddFailure.AddFailureMock.callArgs, &mm_params)
+	mmAddFailure.AddFailureMock.mutex.Unlock()
+
+	for _, e := range mmAddFailure.AddFailureMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmAddFailure.AddFailureMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddFailure.AddFailureMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddFailure.AddFailureMock.defaultExpectation.params
+		mm_want_ptrs := mmAddFailure.AddFailureMock.defaultExpectation.paramPtrs
+
+		mm_got := LoginAttemptRepositoryMockAddFailureParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddFailure.t.Errorf("LoginAttemptRepositoryMock.AddFailure got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddFailure.AddFailureMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmAddFailure.t.Errorf("LoginAttemptRepositoryMock.AddFailure got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddFailure.AddFailureMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddFailure.t.Errorf("LoginAttemptRepositoryMock.AddFailure got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddFailure.AddFailureMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddFailure.AddFailureMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddFailure.t.Fatal("No results are set for the LoginAttemptRepositoryMock.AddFailure")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmAddFailure.funcAddFailure != nil {
+		return mmAddFailure.funcAddFailure(ctx, key)
+	}
+	mmAddFailure.t.Fatalf("Unexpected call to LoginAttemptRepositoryMock.AddFailure. %v %v", ctx, key)
+	return
+}
+
+// AddFailureAfterCounter returns a count of finished LoginAttemptRepositoryMock.AddFailure invocations
+func (mmAddFailure *LoginAttemptRepositoryMock) AddFailureAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddFailure.afterAddFailureCounter)
+}
+
+// AddFailureBeforeCounter returns a count of LoginAttemptRepositoryMock.AddFailure invocations
+func (mmAddFailure *LoginAttemptRepositoryMock) AddFailureBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddFailure.beforeAddFailureCounter)
+}
+
+// Calls returns a list of arguments used in each call to LoginAttemptRepositoryMock.AddFailure.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddFailure *mLoginAttemptRepositoryMockAddFailure) Calls() []*LoginAttemptRepositoryMockAddFailureParams {
+	mmAddFailure.mutex.RLock()
+
+	argCopy := make([]*LoginAttemptRepositoryMockAddFailureParams, len(mmAddFailure.callArgs))
+	copy(argCopy, mmAddFailure.callArgs)
+
+	mmAddFailure.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddFailureDone returns true if the count of the AddFailure invocations corresponds
+// the number of defined expectations
+func (m *LoginAttemptRepositoryMock) MinimockAddFailureDone() bool {
+	if m.AddFailureMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddFailureMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddFailureMock.invocationsDone()
+}
+
+// MinimockAddFailureInspect logs each unmet expectation
+func (m *LoginAttemptRepositoryMock) MinimockAddFailureInspect() {
+	for _, e := range m.AddFailureMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LoginAttemptRepositoryMock.AddFailure at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddFailureCounter := mm_atomic.LoadUint64(&m.afterAddFailureCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddFailureMock.defaultExpectation != nil && afterAddFailureCounter < 1 {
+		if m.AddFailureMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LoginAttemptRepositoryMock.AddFailure at\n%s", m.AddFailureMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LoginAttemptRepositoryMock.AddFailure at\n%s with params: %#v", m.AddFailureMock.defaultExpectation.expectationOrigins.origin, *m.AddFailureMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddFailure != nil && afterAddFailureCounter < 1 {
+		m.t.Errorf("Expected call to LoginAttemptRepositoryMock.AddFailure at\n%s", m.funcAddFailureOrigin)
+	}
+
+	if !m.AddFailureMock.invocationsDone() && afterAddFailureCounter > 0 {
+		m.t.Errorf("Expected %d calls to LoginAttemptRepositoryMock.AddFailure at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddFailureMock.expectedInvocations), m.AddFailureMock.expectedInvocationsOrigin, afterAddFailureCounter)
+	}
+}
+
+type mLoginAttemptRepositoryMockRelease struct {
+	optional           bool
+	mock               *LoginAttemptRepositoryMock
+	defaultExpectation *LoginAttemptRepositoryMockReleaseExpectation
+	expectations       []*LoginAttemptRepositoryMockReleaseExpectation
+
+	callArgs []*LoginAttemptRepositoryMockReleaseParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LoginAttemptRepositoryMockReleaseExpectation specifies expectation struct of the LoginAttemptRepository.Release
+type LoginAttemptRepositoryMockReleaseExpectation struct {
+	mock               *LoginAttemptRepositoryMock
+	params             *LoginAttemptRepositoryMockReleaseParams
+	paramPtrs          *LoginAttemptRepositoryMockReleaseParamPtrs
+	expectationOrigins LoginAttemptRepositoryMockReleaseExpectationOrigins
+	results            *LoginAttemptRepositoryMockReleaseResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LoginAttemptRepositoryMockReleaseParams contains parameters of the LoginAttemptRepository.Release
+type LoginAttemptRepositoryMockReleaseParams struct {
+	ctx    context.Context
+	key    string
+	at     time.Time
+	before *model.LoginFailures
+}
+
+// LoginAttemptRepositoryMockReleaseParamPtrs contains pointers to parameters of the LoginAttemptRepository.Release
+type LoginAttemptRepositoryMockReleaseParamPtrs struct {
+	ctx    *context.Context
+	key    *string
+	at     *time.Time
+	before **model.LoginFailures
+}
+
+// LoginAttemptRepositoryMockReleaseResults contains results of the LoginAttemptRepository.Release
+type LoginAttemptRepositoryMockReleaseResults struct {
+	err error
+}
+
+// LoginAttemptRepositoryMockReleaseOrigins contains origins of expectations of the LoginAttemptRepository.Release
+type LoginAttemptRepositoryMockReleaseExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originKey    string
+	originAt     string
+	originBefore string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRelease *mLoginAttemptRepositoryMockRelease) Optional() *mLoginAttemptRepositoryMockRelease {
+	mmRelease.optional = true
+	return mmRelease
+}
+
+// Expect sets up expected params for LoginAttemptRepository.Release
+func (mmRelease *mLoginAttemptRepositoryMockRelease) Expect(ctx context.Context, key string, at time.Time, before *model.LoginFailures) *mLoginAttemptRepositoryMockRelease {
+	if mmRelease.mock.funcRelease != nil {
+		mmRelease.mock.t.Fatalf("LoginAttemptRepositoryMock.Release mock is already set by Set")
+	}
+
+	if mmRelease.defaultExpectation == nil {
+		mmRelease.defaultExpectation = &LoginAttemptRepositoryMockReleaseExpectation{}
+	}
+
+	if mmRelease.defaultExpectation.paramPtrs != nil {
+		mmRelease.mock.t.Fatalf("LoginAttemptRepositoryMock.Release mock is already set by ExpectParams functions")
+	}
+
+	mmRelease.defaultExpectation.params = &LoginAttemptRepositoryMockReleaseParams{ctx, key, at, before}
+	mmRelease.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRelease.expectations {
+		if minimock.Equal(e.params, mmRelease.defaultExpectation.params) {
+			mmRelease.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRelease.defaultExpectation.params)
+		}
+	}
+
+	return mmRelease
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LoginAttemptRepository.Release
+func (mmRelease *mLoginAttemptRepositoryMockRelease) ExpectCtxParam1(ctx context.Context) *mLoginAttemptRepositoryMockRelease {
+	if mmRelease.mock.funcRelease != nil {
+		mmRelease.mock.t.Fatalf("LoginAttemptRepositoryMock.Release mock is already set by Set")
+	}
+
+	if mmRelease.defaultExpectation == nil {
+		mmRelease.defaultExpectation = &LoginAttemptRepositoryMockReleaseExpectation{}
+	}
+
+	if mmRelease.defaultExpectation.params != nil {
+		mmRelease.mock.t.Fatalf("LoginAttemptRepositoryMock.Release mock is already set by Expect")
+	}
+
+	if mmRelease.defaultExpectation.paramPtrs == nil {
+		mmRelease.defaultExpectation.paramPtrs = &LoginAttemptRepositoryMockReleaseParamPtrs{}
+	}
+	mmRelease.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRelease.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRelease
+}
+
+// ExpectKeyParam2 sets up expected param key for LoginAttemptRepository.Release
+func (mmRelease *mLoginAttemptRepositoryMockRelease) ExpectKeyParam2(key string) *mLoginAttemptRepositoryMockRelease {
+	if mmRelease.mock.funcRelease != nil {
+		mmRelease.mock.t.Fatalf("LoginAttemptRepositoryMock.Release mock is already set by Set")
+	}
+
+	if mmRelease.defaultExpectation == nil {
+		mmRelease.defaultExpectation = &LoginAttemptRepositoryMockReleaseExpectation{}
+	}
+
+	if mmRelease.defaultExpectation.params != nil {
+		mmRelease.mock.t.Fatalf("LoginAttemptRepositoryMock.Release mock is already set by Expect")
+	}
+
+	if mmRelease.defaultExpectation.paramPtrs == nil {
+		mmRelease.defaultExpectation.paramPtrs = &LoginAttemptRepositoryMockReleaseParamPtrs{}
+	}
+	mmRelease.defaultExpectation.paramPtrs.key = &key
+	mmRelease.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmRelease
+}
+
+// ExpectAtParam3 sets up expected param at for LoginAttemptRepository.Release
+func (mmRelease *mLoginAttemptRepositoryMockRelease) ExpectAtParam3(at time.Time) *mLoginAttemptRepositoryMockRelease {
+	if mmRelease.mock.funcRelease != nil {
+		mmRelease.mock.t.Fatalf("LoginAttemptRepositoryMock.Release mock is already set by Set")
+	}
+
+	if mmRelease.defaultExpectation == nil {
+		mmRelease.defaultExpectation = &LoginAttemptRepositoryMockReleaseExpectation{}
+	}
+
+	if mmRelease.defaultExpectation.params != nil {
+		mmRelease.mock.t.Fatalf("LoginAttemptRepositoryMock.Release mock is already set by Expect")
+	}
+
+	if mmRelease.defaultExpectation.paramPtrs == nil {
+		mmRelease.defaultExpectation.paramPtrs = &LoginAttemptRepositoryMockReleaseParamPtrs{}
+	}
+	mmRelease.defaultExpectation.paramPtrs.at = &at
+	mmRelease.defaultExpectation.expectationOrigins.originAt = minimock.CallerInfo(1)
+
+	return mmRelease
+}
+
+// ExpectBeforeParam4 sets up expected param before for LoginAttemptRepository.Release
+func (mmRelease *mLoginAttemptRepositoryMockRelease) ExpectBeforeParam4(before *model.LoginFailures) *mLoginAttemptRepositoryMockRelease {
+	if mmRelease.mock.funcRelease != nil {
+		mmRelease.mock.t.Fatalf("LoginAttemptRepositoryMock.Release mock is already set by Set")
+	}
+
+	if mmRelease.defaultExpectation == nil {
+		mmRelease.defaultExpectation = &LoginAttemptRepositoryMockReleaseExpectation{}
+	}
+
+	if mmRelease.defaultExpectation.params != nil {
+		mmRelease.mock.t.Fatalf("LoginAttemptRepositoryMock.Release mock is already set by Expect")
+	}
+
+	if mmRelease.defaultExpectation.paramPtrs == nil {
+		mmRelease.defaultExpectation.paramPtrs = &LoginAttemptRepositoryMockReleaseParamPtrs{}
+	}
+	mmRelease.defaultExpectation.paramPtrs.before = &before
+	mmRelease.defaultExpectation.expectationOrigins.originBefore = minimock.CallerInfo(1)
+
+	return mmRelease
+}
+
+// Inspect accepts an inspector function that has same arguments as the LoginAttemptRepository.Release
+func (mmRelease *mLoginAttemptRepositoryMockRelease) Inspect(f func(ctx context.Context, key string, at time.Time, before *model.LoginFailures)) *mLoginAttemptRepositoryMockRelease {
+	if mmRelease.mock.inspectFuncRelease != nil {
+		mmRelease.mock.t.Fatalf("Inspect function is already set for LoginAttemptRepositoryMock.Release")
+	}
+
+	mmRelease.mock.inspectFuncRelease = f
+
+	return mmRelease
+}
+
+// Return sets up results that will be returned by LoginAttemptRepository.Release
+func (mmRelease *mLoginAttemptRepositoryMockRelease) Return(err error) *LoginAttemptRepositoryMock {
+	if mmRelease.mock.funcRelease != nil {
+		mmRelease.mock.t.Fatalf("LoginAttemptRepositoryMock.Release mock is already set by Set")
+	}
+
+	if mmRelease.defaultExpectation == nil {
+		mmRelease.defaultExpectation = &LoginAttemptRepositoryMockReleaseExpectation{mock: mmRelease.mock}
+	}
+	mmRelease.defaultExpectation.results = &LoginAttemptRepositoryMockReleaseResults{err}
+	mmRelease.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRelease.mock
+}
+
+// Set uses given function f to mock the LoginAttemptRepository.Release method
+func (mmRelease *mLoginAttemptRepositoryMockRelease) Set(f func(ctx context.Context, key string, at time.Time, before *model.LoginFailures) (err error)) *LoginAttemptRepositoryMock {
+	if mmRelease.defaultExpectation != nil {
+		mmRelease.mock.t.Fatalf("Default expectation is already set for the LoginAttemptRepository.Release method")
+	}
+
+	if len(mmRelease.expectations) > 0 {
+		mmRelease.mock.t.Fatalf("Some expectations are already set for the LoginAttemptRepository.Release method")
+	}
+
+	mmRelease.mock.funcRelease = f
+	mmRelease.mock.funcReleaseOrigin = minimock.CallerInfo(1)
+	return mmRelease.mock
+}
+
+// When sets expectation for the LoginAttemptRepository.Release which will trigger the result defined by the following
+// Then helper
+func (mmRelease *mLoginAttemptRepositoryMockRelease) When(ctx context.Context, key string, at time.Time, before *model.LoginFailures) *LoginAttemptRepositoryMockReleaseExpectation {
+	if mmRelease.mock.funcRelease != nil {
+		mmRelease.mock.t.Fatalf("LoginAttemptRepositoryMock.Release mock is already set by Set")
+	}
+
+	expectation := &LoginAttemptRepositoryMockReleaseExpectation{
+		mock:               mmRelease.mock,
+		params:             &LoginAttemptRepositoryMockReleaseParams{ctx, key, at, before},
+		expectationOrigins: LoginAttemptRepositoryMockReleaseExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRelease.expectations = append(mmRelease.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LoginAttemptRepository.Release return parameters for the expectation previously defined by the When method
+func (e *LoginAttemptRepositoryMockReleaseExpectation) Then(err error) *LoginAttemptRepositoryMock {
+	e.results = &LoginAttemptRepositoryMockReleaseResults{err}
+	return e.mock
+}
+
+// Times sets number of times LoginAttemptRepository.Release should be invoked
+func (mmRelease *mLoginAttemptRepositoryMockRelease) Times(n uint64) *mLoginAttemptRepositoryMockRelease {
+	if n == 0 {
+		mmRelease.mock.t.Fatalf("Times of LoginAttemptRepositoryMock.Release mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRelease.expectedInvocations, n)
+	mmRelease.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRelease
+}
+
+func (mmRelease *mLoginAttemptRepositoryMockRelease) invocationsDone() bool {
+	if len(mmRelease.expectations) == 0 && mmRelease.defaultExpectation == nil && mmRelease.mock.funcRelease == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRelease.mock.afterReleaseCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRelease.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Release implements mm_repository.LoginAttemptRepository
+func (mmRelease *LoginAttemptRepositoryMock) Release(ctx context.Context, key string, at time.Time, before *model.LoginFailures) (err error) {
+	mm_atomic.AddUint64(&mmRelease.beforeReleaseCounter, 1)
+	defer mm_atomic.AddUint64(&mmRelease.afterReleaseCounter, 1)
+
+	mmRelease.t.Helper()
+
+	if mmRelease.inspectFuncRelease != nil {
+		mmRelease.inspectFuncRelease(ctx, key, at, before)
+	}
+
+	mm_params := LoginAttemptRepositoryMockReleaseParams{ctx, key, at, before}
+
+	// Record call args
+	mmRelease.ReleaseMock.mutex.Lock()
+	mmRelease.ReleaseMock.callArgs = append(mmRelease.ReleaseMock.callArgs, &mm_params)
+	mmRelease.ReleaseMock.mutex.Unlock()
+
+	for _, e := range mmRelease.ReleaseMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRelease.ReleaseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRelease.ReleaseMock.defaultExpectation.Counter, 1)
+		mm_want := mmRelease.ReleaseMock.defaultExpectation.params
+		mm_want_ptrs := mmRelease.ReleaseMock.defaultExpectation.paramPtrs
+
+		mm_got := LoginAttemptRepositoryMockReleaseParams{ctx, key, at, before}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRelease.t.Errorf("LoginAttemptRepositoryMock.Release got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRelease.ReleaseMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmRelease.t.Errorf("LoginAttemptRepositoryMock.Release got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRelease.ReleaseMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.at != nil && !minimock.Equal(*mm_want_ptrs.at, mm_got.at) {
+				mmRelease.t.Errorf("LoginAttemptRepositoryMock.Release got unexpected parameter at, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRelease.ReleaseMock.defaultExpectation.expectationOrigins.originAt, *mm_want_ptrs.at, mm_got.at, minimock.Diff(*mm_want_ptrs.at, mm_got.at))
+			}
+
+			if mm_want_ptrs.before != nil && !minimock.Equal(*mm_want_ptrs.before, mm_got.before) {
+				mmRelease.t.Errorf("LoginAttemptRepositoryMock.Release got unexpected parameter before, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRelease.ReleaseMock.defaultExpectation.expectationOrigins.originBefore, *mm_want_ptrs.before, mm_got.before, minimock.Diff(*mm_want_ptrs.before, mm_got.before))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRelease.t.Errorf("LoginAttemptRepositoryMock.Release got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRelease.ReleaseMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRelease.ReleaseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRelease.t.Fatal("No results are set for the LoginAttemptRepositoryMock.Release")
+		}
+		return (*mm_results).err
+	}
+	if mmRelease.funcRelease != nil {
+		return mmRelease.funcRelease(ctx, key, at, before)
+	}
+	mmRelease.t.Fatalf("Unexpected call to LoginAttemptRepositoryMock.Release. %v %v %v %v", ctx, key, at, before)
+	return
+}
+
+// ReleaseAfterCounter returns a count of finished LoginAttemptRepositoryMock.Release invocations
+func (mmRelease *LoginAttemptRepositoryMock) ReleaseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRelease.afterReleaseCounter)
+}
+
+// ReleaseBeforeCounter returns a count of LoginAttemptRepositoryMock.Release invocations
+func (mmRelease *LoginAttemptRepositoryMock) ReleaseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRelease.beforeReleaseCounter)
+}
+
+// Calls returns a list of arguments used in each call to LoginAttemptRepositoryMock.Release.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRelease *mLoginAttemptRepositoryMockRelease) Calls() []*LoginAttemptRepositoryMockReleaseParams {
+	mmRelease.mutex.RLock()
+
+	argCopy := make([]*LoginAttemptRepositoryMockReleaseParams, len(mmRelease.callArgs))
+	copy(argCopy, mmRelease.callArgs)
+
+	mmRelease.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReleaseDone returns true if the count of the Release invocations corresponds
+// the number of defined expectations
+func (m *LoginAttemptRepositoryMock) MinimockReleaseDone() bool {
+	if m.ReleaseMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReleaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReleaseMock.invocationsDone()
+}
+
+// MinimockReleaseInspect logs each unmet expectation
+func (m *LoginAttemptRepositoryMock) MinimockReleaseInspect() {
+	for _, e := range m.ReleaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LoginAttemptRepositoryMock.Release at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReleaseCounter := mm_atomic.LoadUint64(&m.afterReleaseCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReleaseMock.defaultExpectation != nil && afterReleaseCounter < 1 {
+		if m.ReleaseMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LoginAttemptRepositoryMock.Release at\n%s", m.ReleaseMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LoginAttemptRepositoryMock.Release at\n%s with params: %#v", m.ReleaseMock.defaultExpectation.expectationOrigins.origin, *m.ReleaseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRelease != nil && afterReleaseCounter < 1 {
+		m.t.Errorf("Expected call to LoginAttemptRepositoryMock.Release at\n%s", m.funcReleaseOrigin)
+	}
+
+	if !m.ReleaseMock.invocationsDone() && afterReleaseCounter > 0 {
+		m.t.Errorf("Expected %d calls to LoginAttemptRepositoryMock.Release at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReleaseMock.expectedInvocations), m.ReleaseMock.expectedInvocationsOrigin, afterReleaseCounter)
+	}
+}
+
+type mLoginAttemptRepositoryMockReserve struct {
+	optional           bool
+	mock               *LoginAttemptRepositoryMock
+	defaultExpectation *LoginAttemptRepositoryMockReserveExpectation
+	expectations       []*LoginAttemptRepositoryMockReserveExpectation
+
+	callArgs []*LoginAttemptRepositoryMockReserveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LoginAttemptRepositoryMockReserveExpectation specifies expectation struct of the LoginAttemptRepository.Reserve
+type LoginAttemptRepositoryMockReserveExpectation struct {
+	mock               *LoginAttemptRepositoryMock
+	params             *LoginAttemptRepositoryMockReserveParams
+	paramPtrs          *LoginAttemptRepositoryMockReserveParamPtrs
+	expectationOrigins LoginAttemptRepositoryMockReserveExpectationOrigins
+	results            *LoginAttemptRepositoryMockReserveResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LoginAttemptRepositoryMockReserveParams contains parameters of the LoginAttemptRepository.Reserve
+type LoginAttemptRepositoryMockReserveParams struct {
+	ctx context.Context
+	key string
+	at  time.Time
+}
+
+// LoginAttemptRepositoryMockReserveParamPtrs contains pointers to parameters of the LoginAttemptRepository.Reserve
+type LoginAttemptRepositoryMockReserveParamPtrs struct {
+	ctx *context.Context
+	key *string
+	at  *time.Time
+}
+
+// LoginAttemptRepositoryMockReserveResults contains results of the LoginAttemptRepository.Reserve
+type LoginAttemptRepositoryMockReserveResults struct {
+	lp1 *model.LoginFailures
+	err error
+}
+
+// LoginAttemptRepositoryMockReserveOrigins contains origins of expectations of the LoginAttemptRepository.Reserve
+type LoginAttemptRepositoryMockReserveExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+	originAt  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReserve *mLoginAttemptRepositoryMockReserve) Optional() *mLoginAttemptRepositoryMockReserve {
+	mmReserve.optional = true
+	return mmReserve
+}
+
+// Expect sets up expected params for LoginAttemptRepository.Reserve
+func (mmReserve *mLoginAttemptRepositoryMockReserve) Expect(ctx context.Context, key string, at time.Time) *mLoginAttemptRepositoryMockReserve {
+	if mmReserve.mock.funcReserve != nil {
+		mmReserve.mock.t.Fatalf("LoginAttemptRepositoryMock.Reserve mock is already set by Set")
+	}
+
+	if mmReserve.defaultExpectation == nil {
+		mmReserve.defaultExpectation = &LoginAttemptRepositoryMockReserveExpectation{}
+	}
+
+	if mmReserve.defaultExpectation.paramPtrs != nil {
+		mmReserve.mock.t.Fatalf("LoginAttemptRepositoryMock.Reserve mock is already set by ExpectParams functions")
+	}
+
+	mmReserve.defaultExpectation.params = &LoginAttemptRepositoryMockReserveParams{ctx, key, at}
+	mmReserve.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReserve.expectations {
+		if minimock.Equal(e.params, mmReserve.defaultExpectation.params) {
+			mmReserve.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReserve.defaultExpectation.params)
+		}
+	}
+
+	return mmReserve
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LoginAttemptRepository.Reserve
+func (mmReserve *mLoginAttemptRepositoryMockReserve) ExpectCtxParam1(ctx context.Context) *mLoginAttemptRepositoryMockReserve {
+	if mmReserve.mock.funcReserve != nil {
+		mmReserve.mock.t.Fatalf("LoginAttemptRepositoryMock.Reserve mock is already set by Set")
+	}
+
+	if mmReserve.defaultExpectation == nil {
+		mmReserve.defaultExpectation = &LoginAttemptRepositoryMockReserveExpectation{}
+	}
+
+	if mmReserve.defaultExpectation.params != nil {
+		mmReserve.mock.t.Fatalf("LoginAttemptRepositoryMock.Reserve mock is already set by Expect")
+	}
+
+	if mmReserve.defaultExpectation.paramPtrs == nil {
+		mmReserve.defaultExpectation.paramPtrs = &LoginAttemptRepositoryMockReserveParamPtrs{}
+	}
+	mmReserve.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReserve.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReserve
+}
+
+// ExpectKeyParam2 sets up expected param key for LoginAttemptRepository.Reserve
+func (mmReserve *mLoginAttemptRepositoryMockReserve) ExpectKeyParam2(key string) *mLoginAttemptRepositoryMockReserve {
+	if mmReserve.mock.funcReserve != nil {
+		mmReserve.mock.t.Fatalf("LoginAttemptRepositoryMock.Reserve mock is already set by Set")
+	}
+
+	if mmReserve.defaultExpectation == nil {
+		mmReserve.defaultExpectation = &LoginAttemptRepositoryMockReserveExpectation{}
+	}
+
+	if mmReserve.defaultExpectation.params != nil {
+		mmReserve.mock.t.Fatalf("LoginAttemptRepositoryMock.Reserve mock is already set by Expect")
+	}
+
+	if mmReserve.defaultExpectation.paramPtrs == nil {
+		mmReserve.defaultExpectation.paramPtrs = &LoginAttemptRepositoryMockReserveParamPtrs{}
+	}
+	mmReserve.defaultExpectation.paramPtrs.key = &key
+	mmReserve.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmReserve
+}
+
+// ExpectAtParam3 sets up expected param at for LoginAttemptRepository.Reserve
+func (mmReserve *mLoginAttemptRepositoryMockReserve) ExpectAtParam3(at time.Time) *mLoginAttemptRepositoryMockReserve {
+	if mmReserve.mock.funcReserve != nil {
+		mmReserve.mock.t.Fatalf("LoginAttemptRepositoryMock.Reserve mock is already set by Set")
+	}
+
+	if mmReserve.defaultExpectation == nil {
+		mmReserve.defaultExpectation = &LoginAttemptRepositoryMockReserveExpectation{}
+	}
+
+	if mmReserve.defaultExpectation.params != nil {
+		mmReserve.mock.t.Fatalf("LoginAttemptRepositoryMock.Reserve mock is already set by Expect")
+	}
+
+	if mmReserve.defaultExpectation.paramPtrs == nil {
+		mmReserve.defaultExpectation.paramPtrs = &LoginAttemptRepositoryMockReserveParamPtrs{}
+	}
+	mmReserve.defaultExpectation.paramPtrs.at = &at
+	mmReserve.defaultExpectation.expectationOrigins.originAt = minimock.CallerInfo(1)
+
+	return mmReserve
+}
+
+// Inspect accepts an inspector function that has same arguments as the LoginAttemptRepository.Reserve
+func (mmReserve *mLoginAttemptRepositoryMockReserve) Inspect(f func(ctx context.Context, key string, at time.Time)) *mLoginAttemptRepositoryMockReserve {
+	if mmReserve.mock.inspectFuncReserve != nil {
+		mmReserve.mock.t.Fatalf("Inspect function is already set for LoginAttemptRepositoryMock.Reserve")
+	}
+
+	mmReserve.mock.inspectFuncReserve = f
+
+	return mmReserve
+}
+
+// Return sets up results that will be returned by LoginAttemptRepository.Reserve
+func (mmReserve *mLoginAttemptRepositoryMockReserve) Return(lp1 *model.LoginFailures, err error) *LoginAttemptRepositoryMock {
+	if mmReserve.mock.funcReserve != nil {
+		mmReserve.mock.t.Fatalf("LoginAttemptRepositoryMock.Reserve mock is already set by Set")
+	}
+
+	if mmReserve.defaultExpectation == nil {
+		mmReserve.defaultExpectation = &LoginAttemptRepositoryMockReserveExpectation{mock: mmReserve.mock}
+	}
+	mmReserve.defaultExpectation.results = &LoginAttemptRepositoryMockReserveResults{lp1, err}
+	mmReserve.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReserve.mock
+}
+
+// Set uses given function f to mock the LoginAttemptRepository.Reserve method
+func (mmReserve *mLoginAttemptRepositoryMockReserve) Set(f func(ctx context.Context, key string, at time.Time) (lp1 *model.LoginFailures, err error)) *LoginAttemptRepositoryMock {
+	if mmReserve.defaultExpectation != nil {
+		mmReserve.mock.t.Fatalf("Default expectation is already set for the LoginAttemptRepository.Reserve method")
+	}
+
+	if len(mmReserve.expectations) > 0 {
+		mmReserve.mock.t.Fatalf("Some expectations are already set for the LoginAttemptRepository.Reserve method")
+	}
+
+	mmReserve.mock.funcReserve = f
+	mmReserve.mock.funcReserveOrigin = minimock.CallerInfo(1)
+	return mmReserve.mock
+}
+
+// When sets expectation for the LoginAttemptRepository.Reserve which will trigger the result defined by the following
+// Then helper
+func (mmReserve *mLoginAttemptRepositoryMockReserve) When(ctx context.Context, key string, at time.Time) *LoginAttemptRepositoryMockReserveExpectation {
+	if mmReserve.mock.funcReserve != nil {
+		mmReserve.mock.t.Fatalf("LoginAttemptRepositoryMock.Reserve mock is already set by Set")
+	}
+
+	expectation := &LoginAttemptRepositoryMockReserveExpectation{
+		mock:               mmReserve.mock,
+		params:             &LoginAttemptRepositoryMockReserveParams{ctx, key, at},
+		expectationOrigins: LoginAttemptRepositoryMockReserveExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReserve.expectations = append(mmReserve.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LoginAttemptRepository.Reserve return parameters for the expectation previously defined by the When method
+func (e *LoginAttemptRepositoryMockReserveExpectation) Then(lp1 *model.LoginFailures, err error) *LoginAttemptRepositoryMock {
+	e.results = &LoginAttemptRepositoryMockReserveResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times LoginAttemptRepository.Reserve should be invoked
+func (mmReserve *mLoginAttemptRepositoryMockReserve) Times(n uint64) *mLoginAttemptRepositoryMockReserve {
+	if n == 0 {
+		mmReserve.mock.t.Fatalf("Times of LoginAttemptRepositoryMock.Reserve mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReserve.expectedInvocations, n)
+	mmReserve.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReserve
+}
+
+func (mmReserve *mLoginAttemptRepositoryMockReserve) invocationsDone() bool {
+	if len(mmReserve.expectations) == 0 && mmReserve.defaultExpectation == nil && mmReserve.mock.funcReserve == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReserve.mock.afterReserveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReserve.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Reserve implements mm_repository.LoginAttemptRepository
+func (mmReserve *LoginAttemptRepositoryMock) Reserve(ctx context.Context, key string, at time.Time) (lp1 *model.LoginFailures, err error) {
+	mm_atomic.AddUint64(&mmReserve.beforeReserveCounter, 1)
+	defer mm_atomic.AddUint64(&mmReserve.afterReserveCounter, 1)
+
+	mmReserve.t.Helper()
+
+	if mmReserve.inspectFuncReserve != nil {
+		mmReserve.inspectFuncReserve(ctx, key, at)
+	}
+
+	mm_params := LoginAttemptRepositoryMockReserveParams{ctx, key, at}
+
+	// Record call args
+	mmReserve.ReserveMock.mutex.Lock()
+	mmReserve.ReserveMock.callArgs = append(mmReserve.ReserveMock.callArgs, &mm_params)
+	mmReserve.ReserveMock.mutex.Unlock()
+
+	for _, e := range mmReserve.ReserveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmReserve.ReserveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReserve.ReserveMock.defaultExpectation.Counter, 1)
+		mm_want := mmReserve.ReserveMock.defaultExpectation.params
+		mm_want_ptrs := mmReserve.ReserveMock.defaultExpectation.paramPtrs
+
+		mm_got := LoginAttemptRepositoryMockReserveParams{ctx, key, at}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReserve.t.Errorf("LoginAttemptRepositoryMock.Reserve got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserve.ReserveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmReserve.t.Errorf("LoginAttemptRepositoryMock.Reserve got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserve.ReserveMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.at != nil && !minimock.Equal(*mm_want_ptrs.at, mm_got.at) {
+				mmReserve.t.Errorf("LoginAttemptRepositoryMock.Reserve got unexpected parameter at, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserve.ReserveMock.defaultExpectation.expectationOrigins.originAt, *mm_want_ptrs.at, mm_got.at, minimock.Diff(*mm_want_ptrs.at, mm_got.at))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReserve.t.Errorf("LoginAttemptRepositoryMock.Reserve got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReserve.ReserveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReserve.ReserveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReserve.t.Fatal("No results are set for the LoginAttemptRepositoryMock.Reserve")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmReserve.funcReserve != nil {
+		return mmReserve.funcReserve(ctx, key, at)
+	}
+	mmReserve.t.Fatalf("Unexpected call to LoginAttemptRepositoryMock.Reserve. %v %v %v", ctx, key, at)
+	return
+}
+
+// ReserveAfterCounter returns a count of finished LoginAttemptRepositoryMock.Reserve invocations
+func (mmReserve *LoginAttemptRepositoryMock) ReserveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReserve.afterReserveCounter)
+}
+
+// ReserveBeforeCounter returns a count of LoginAttemptRepositoryMock.Reserve invocations
+func (mmReserve *LoginAttemptRepositoryMock) ReserveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReserve.beforeReserveCounter)
+}
+
+// Calls returns a list of arguments used in each call to LoginAttemptRepositoryMock.Reserve.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReserve *mLoginAttemptRepositoryMockReserve) Calls() []*LoginAttemptRepositoryMockReserveParams {
+	mmReserve.mutex.RLock()
+
+	argCopy := make([]*LoginAttemptRepositoryMockReserveParams, len(mmReserve.callArgs))
+	copy(argCopy, mmReserve.callArgs)
+
+	mmReserve.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReserveDone returns true if the count of the Reserve invocations corresponds
+// the number of defined expectations
+func (m *LoginAttemptRepositoryMock) MinimockReserveDone() bool {
+	if m.ReserveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReserveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReserveMock.invocationsDone()
+}
+
+// MinimockReserveInspect logs each unmet expectation
+func (m *LoginAttemptRepositoryMock) MinimockReserveInspect() {
+	for _, e := range m.ReserveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LoginAttemptRepositoryMock.Reserve at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReserveCounter := mm_atomic.LoadUint64(&m.afterReserveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReserveMock.defaultExpectation != nil && afterReserveCounter < 1 {
+		if m.ReserveMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LoginAttemptRepositoryMock.Reserve at\n%s", m.ReserveMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LoginAttemptRepositoryMock.Reserve at\n%s with params: %#v", m.ReserveMock.defaultExpectation.expectationOrigins.origin, *m.ReserveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReserve != nil && afterReserveCounter < 1 {
+		m.t.Errorf("Expected call to LoginAttemptRepositoryMock.Reserve at\n%s", m.funcReserveOrigin)
+	}
+
+	if !m.ReserveMock.invocationsDone() && afterReserveCounter > 0 {
+		m.t.Errorf("Expected %d calls to LoginAttemptRepositoryMock.Reserve at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReserveMock.expectedInvocations), m.ReserveMock.expectedInvocationsOrigin, afterReserveCounter)
+	}
+}
+
+type mLoginAttemptRepositoryMockReset struct {
+	optional           bool
+	mock               *LoginAttemptRepositoryMock
+	defaultExpectation *LoginAttemptRepositoryMockResetExpectation
+	expectations       []*LoginAttemptRepositoryMockResetExpectation
+
+	callArgs []*LoginAttemptRepositoryMockResetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LoginAttemptRepositoryMockResetExpectation specifies expectation struct of the LoginAttemptRepository.Reset
+type LoginAttemptRepositoryMockResetExpectation struct {
+	mock               *LoginAttemptRepositoryMock
+	params             *LoginAttemptRepositoryMockResetParams
+	paramPtrs          *LoginAttemptRepositoryMockResetParamPtrs
+	expectationOrigins LoginAttemptRepositoryMockResetExpectationOrigins
+	results            *LoginAttemptRepositoryMockResetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LoginAttemptRepositoryMockResetParams contains parameters of the LoginAttemptRepository.Reset
+type LoginAttemptRepositoryMockResetParams struct {
+	ctx context.Context
+	key string
+}
+
+// LoginAttemptRepositoryMockResetParamPtrs contains pointers to parameters of the LoginAttemptRepository.Reset
+type LoginAttemptRepositoryMockResetParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// LoginAttemptRepositoryMockResetResults contains results of the LoginAttemptRepository.Reset
+type LoginAttemptRepositoryMockResetResults struct {
+	err error
+}
+
+// LoginAttemptRepositoryMockResetOrigins contains origins of expectations of the LoginAttemptRepository.Reset
+type LoginAttemptRepositoryMockResetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReset *mLoginAttemptRepositoryMockReset) Optional() *mLoginAttemptRepositoryMockReset {
+	mmReset.optional = true
+	return mmReset
+}
+
+// Expect sets up expected params for LoginAttemptRepository.Reset
+func (mmReset *mLoginAttemptRepositoryMockReset) Expect(ctx context.Context, key string) *mLoginAttemptRepositoryMockReset {
+	if mmReset.mock.funcReset != nil {
+		mmReset.mock.t.Fatalf("LoginAttemptRepositoryMock.Reset mock is already set by Set")
+	}
+
+	if mmReset.defaultExpectation == nil {
+		mmReset.defaultExpectation = &LoginAttemptRepositoryMockResetExpectation{}
+	}
+
+	if mmReset.defaultExpectation.paramPtrs != nil {
+		mmReset.mock.t.Fatalf("LoginAttemptRepositoryMock.Reset mock is already set by ExpectParams functions")
+	}
+
+	mmReset.defaultExpectation.params = &LoginAttemptRepositoryMockResetParams{ctx, key}
+	mmReset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReset.expectations {
+		if minimock.Equal(e.params, mmReset.defaultExpectation.params) {
+			mmReset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReset.defaultExpectation.params)
+		}
+	}
+
+	return mmReset
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LoginAttemptRepository.Reset
+func (mmReset *mLoginAttemptRepositoryMockReset) ExpectCtxParam1(ctx context.Context) *mLoginAttemptRepositoryMockReset {
+	if mmReset.mock.funcReset != nil {
+		mmReset.mock.t.Fatalf("LoginAttemptRepositoryMock.Reset mock is already set by Set")
+	}
+
+	if mmReset.defaultExpectation == nil {
+		mmReset.defaultExpectation = &LoginAttemptRepositoryMockResetExpectation{}
+	}
+
+	if mmReset.defaultExpectation.params != nil {
+		mmReset.mock.t.Fatalf("LoginAttemptRepositoryMock.Reset mock is already set by Expect")
+	}
+
+	if mmReset.defaultExpectation.paramPtrs == nil {
+		mmReset.defaultExpectation.paramPtrs = &LoginAttemptRepositoryMockResetParamPtrs{}
+	}
+	mmReset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReset
+}
+
+// ExpectKeyParam2 sets up expected param key for LoginAttemptRepository.Reset
+func (mmReset *mLoginAttemptRepositoryMockReset) ExpectKeyParam2(key string) *mLoginAttemptRepositoryMockReset {
+	if mmReset.mock.funcReset != nil {
+		mmReset.mock.t.Fatalf("LoginAttemptRepositoryMock.Reset mock is already set by Set")
+	}
+
+	if mmReset.defaultExpectation == nil {
+		mmReset.defaultExpectation = &LoginAttemptRepositoryMockResetExpectation{}
+	}
+
+	if mmReset.defaultExpectation.params != nil {
+		mmReset.mock.t.Fatalf("LoginAttemptRepositoryMock.Reset mock is already set by Expect")
+	}
+
+	if mmReset.defaultExpectation.paramPtrs == nil {
+		mmReset.defaultExpectation.paramPtrs = &LoginAttemptRepositoryMockResetParamPtrs{}
+	}
+	mmReset.defaultExpectation.paramPtrs.key = &key
+	mmReset.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmReset
+}
+
+// Inspect accepts an inspector function that has same arguments as the LoginAttemptRepository.Reset
+func (mmReset *mLoginAttemptRepositoryMockReset) Inspect(f func(ctx context.Context, key string)) *mLoginAttemptRepositoryMockReset {
+	if mmReset.mock.inspectFuncReset != nil {
+		mmReset.mock.t.Fatalf("Inspect function is already set for LoginAttemptRepositoryMock.Reset")
+	}
+
+	mmReset.mock.inspectFuncReset = f
+
+	return mmReset
+}
+
+// Return sets up results that will be returned by LoginAttemptRepository.Reset
+func (mmReset *mLoginAttemptRepositoryMockReset) Return(err error) *LoginAttemptRepositoryMock {
+	if mmReset.mock.funcReset != nil {
+		mmReset.mock.t.Fatalf("LoginAttemptRepositoryMock.Reset mock is already set by Set")
+	}
+
+	if mmReset.defaultExpectation == nil {
+		mmReset.defaultExpectation = &LoginAttemptRepositoryMockResetExpectation{mock: mmReset.mock}
+	}
+	mmReset.defaultExpectation.results = &LoginAttemptRepositoryMockResetResults{err}
+	mmReset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReset.mock
+}
+
+// Set uses given function f to mock the LoginAttemptRepository.Reset method
+func (mmReset *mLoginAttemptRepositoryMockReset) Set(f func(ctx context.Context, key string) (err error)) *LoginAttemptRepositoryMock {
+	if mmReset.defaultExpectation != nil {
+		mmReset.mock.t.Fatalf("Default expectation is already set for the LoginAttemptRepository.Reset method")
+	}
+
+	if len(mmReset.expectations) > 0 {
+		mmReset.mock.t.Fatalf("Some expectations are already set for the LoginAttemptRepository.Reset method")
+	}
+
+	mmReset.mock.funcReset = f
+	mmReset.mock.funcResetOrigin = minimock.CallerInfo(1)
+	return mmReset.mock
+}
+
+// When sets expectation for the LoginAttemptRepository.Reset which will trigger the result defined by the following
+// Then helper
+func (mmReset *mLoginAttemptRepositoryMockReset) When(ctx context.Context, key string) *LoginAttemptRepositoryMockResetExpectation {
+	if mmReset.mock.funcReset != nil {
+		mmReset.mock.t.Fatalf("LoginAttemptRepositoryMock.Reset mock is already set by Set")
+	}
+
+	expectation := &LoginAttemptRepositoryMockResetExpectation{
+		mock:               mmReset.mock,
+		params:             &LoginAttemptRepositoryMockResetParams{ctx, key},
+		expectationOrigins: LoginAttemptRepositoryMockResetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReset.expectations = append(mmReset.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LoginAttemptRepository.Reset return parameters for the expectation previously defined by the When method
+func (e *LoginAttemptRepositoryMockResetExpectation) Then(err error) *LoginAttemptRepositoryMock {
+	e.results = &LoginAttemptRepositoryMockResetResults{err}
+	return e.mock
+}
+
+// Times sets number of times LoginAttemptRepository.Reset should be invoked
+func (mmReset *mLoginAttemptRepositoryMockReset) Times(n uint64) *mLoginAttemptRepositoryMockReset {
+	if n == 0 {
+		mmReset.mock.t.Fatalf("Times of LoginAttemptRepositoryMock.Reset mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReset.expectedInvocations, n)
+	mmReset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReset
+}
+
+func (mmReset *mLoginAttemptRepositoryMockReset) invocationsDone() bool {
+	if len(mmReset.expectations) == 0 && mmReset.defaultExpectation == nil && mmReset.mock.funcReset == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReset.mock.afterResetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReset.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Reset implements mm_repository.LoginAttemptRepository
+func (mmReset *LoginAttemptRepositoryMock) Reset(ctx context.Context, key string) (err error) {
+	mm_atomic.AddUint64(&mmReset.beforeResetCounter, 1)
+	defer mm_atomic.AddUint64(&mmReset.afterResetCounter, 1)
+
+	mmReset.t.Helper()
+
+	if mmReset.inspectFuncReset != nil {
+		mmReset.inspectFuncReset(ctx, key)
+	}
+
+	mm_params := LoginAttemptRepositoryMockResetParams{ctx, key}
+
+	// Record call args
+	mmReset.ResetMock.mutex.Lock()
+	mmReset.ResetMock.callArgs = append(mmReset.ResetMock.callArgs, &mm_params)
+	mmReset.ResetMock.mutex.Unlock()
+
+	for _, e := range mmReset.ResetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReset.ResetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReset.ResetMock.defaultExpectation.Counter, 1)
+		mm_want := mmReset.ResetMock.defaultExpectation.params
+		mm_want_ptrs := mmReset.ResetMock.defaultExpectation.paramPtrs
+
+		mm_got := LoginAttemptRepositoryMockResetParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReset.t.Errorf("LoginAttemptRepositoryMock.Reset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReset.ResetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmReset.t.Errorf("LoginAttemptRepositoryMock.Reset got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReset.ResetMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReset.t.Errorf("LoginAttemptRepositoryMock.Reset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReset.ResetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReset.ResetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReset.t.Fatal("No results are set for the LoginAttemptRepositoryMock.Reset")
+		}
+		return (*mm_results).err
+	}
+	if mmReset.funcReset != nil {
+		return mmReset.funcReset(ctx, key)
+	}
+	mmReset.t.Fatalf("Unexpected call to LoginAttemptRepositoryMock.Reset. %v %v", ctx, key)
+	return
+}
+
+// ResetAfterCounter returns a count of finished LoginAttemptRepositoryMock.Reset invocations
+func (mmReset *LoginAttemptRepositoryMock) ResetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReset.afterResetCounter)
+}
+
+// ResetBeforeCounter returns a count of LoginAttemptRepositoryMock.Reset invocations
+func (mmReset *LoginAttemptRepositoryMock) ResetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReset.beforeResetCounter)
+}
+
+// Calls returns a list of arguments used in each call to LoginAttemptRepositoryMock.Reset.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReset *mLoginAttemptRepositoryMockReset) Calls() []*LoginAttemptRepositoryMockResetParams {
+	mmReset.mutex.RLock()
+
+	argCopy := make([]*LoginAttemptRepositoryMockResetParams, len(mmReset.callArgs))
+	copy(argCopy, mmReset.callArgs)
+
+	mmReset.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockResetDone returns true if the count of the Reset invocations corresponds
+// the number of defined expectations
+func (m *LoginAttemptRepositoryMock) MinimockResetDone() bool {
+	if m.ResetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ResetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ResetMock.invocationsDone()
+}
+
+// MinimockResetInspect logs each unmet expectation
+func (m *LoginAttemptRepositoryMock) MinimockResetInspect() {
+	for _, e := range m.ResetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LoginAttemptRepositoryMock.Reset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterResetCounter := mm_atomic.LoadUint64(&m.afterResetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResetMock.defaultExpectation != nil && afterResetCounter < 1 {
+		if m.ResetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LoginAttemptRepositoryMock.Reset at\n%s", m.ResetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LoginAttemptRepositoryMock.Reset at\n%s with params: %#v", m.ResetMock.defaultExpectation.expectationOrigins.origin, *m.ResetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReset != nil && afterResetCounter < 1 {
+		m.t.Errorf("Expected call to LoginAttemptRepositoryMock.Reset at\n%s", m.funcResetOrigin)
+	}
+
+	if !m.ResetMock.invocationsDone() && afterResetCounter > 0 {
+		m.t.Errorf("Expected %d calls to LoginAttemptRepositoryMock.Reset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ResetMock.expectedInvocations), m.ResetMock.expectedInvocationsOrigin, afterResetCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *LoginAttemptRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddFailureInspect()
+
+			m.MinimockReleaseInspect()
+
+			m.MinimockReserveInspect()
+
+			m.MinimockResetInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *LoginAttemptRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *LoginAttemptRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddFailureDone() &&
+		m.MinimockReleaseDone() &&
+		m.MinimockReserveDone() &&
+		m.MinimockResetDone()
+}
@@ -0,0 +1,3414 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// MfaRepositoryMock implements mm_repository.MfaRepository
+type MfaRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcConfirmTotp          func(ctx context.Context, userID string) (err error)
+	funcConfirmTotpOrigin    string
+	inspectFuncConfirmTotp   func(ctx context.Context, userID string)
+	afterConfirmTotpCounter  uint64
+	beforeConfirmTotpCounter uint64
+	ConfirmTotpMock          mMfaRepositoryMockConfirmTotp
+
+	funcDeleteRecoveryCodes          func(ctx context.Context, userID string) (err error)
+	funcDeleteRecoveryCodesOrigin    string
+	inspectFuncDeleteRecoveryCodes   func(ctx context.Context, userID string)
+	afterDeleteRecoveryCodesCounter  uint64
+	beforeDeleteRecoveryCodesCounter uint64
+	DeleteRecoveryCodesMock          mMfaRepositoryMockDeleteRecoveryCodes
+
+	funcDeleteTotp          func(ctx context.Context, userID string) (err error)
+	funcDeleteTotpOrigin    string
+	inspectFuncDeleteTotp   func(ctx context.Context, userID string)
+	afterDeleteTotpCounter  uint64
+	beforeDeleteTotpCounter uint64
+	DeleteTotpMock          mMfaRepositoryMockDeleteTotp
+
+	funcGetTotp          func(ctx context.Context, userID string) (tp1 *model.Totp, err error)
+	funcGetTotpOrigin    string
+	inspectFuncGetTotp   func(ctx context.Context, userID string)
+	afterGetTotpCounter  uint64
+	beforeGetTotpCounter uint64
+	GetTotpMock          mMfaRepositoryMockGetTotp
+
+	funcHasRecoveryCode          func(ctx context.Context, userID string, codeHash string) (b1 bool, err error)
+	funcHasRecoveryCodeOrigin    string
+	inspectFuncHasRecoveryCode   func(ctx context.Context, userID string, codeHash string)
+	afterHasRecoveryCodeCounter  uint64
+	beforeHasRecoveryCodeCounter uint64
+	HasRecoveryCodeMock          mMfaRepositoryMockHasRecoveryCode
+
+	funcReplaceRecoveryCodes          func(ctx context.Context, userID string, codeHashes []string) (err error)
+	funcReplaceRecoveryCodesOrigin    string
+	inspectFuncReplaceRecoveryCodes   func(ctx context.Context, userID string, codeHashes []string)
+	afterReplaceRecoveryCodesCounter  uint64
+	beforeReplaceRecoveryCodesCounter uint64
+	ReplaceRecoveryCodesMock          mMfaRepositoryMockReplaceRecoveryCodes
+
+	funcSaveTotp          func(ctx context.Context, userID string, secret string) (err error)
+	funcSaveTotpOrigin    string
+	inspectFuncSaveTotp   func(ctx context.Context, userID string, secret string)
+	afterSaveTotpCounter  uint64
+	beforeSaveTotpCounter uint64
+	SaveTotpMock          mMfaRepositoryMockSaveTotp
+
+	funcUseRecoveryCode          func(ctx context.Context, userID string, codeHash string) (b1 bool, err error)
+	funcUseRecoveryCodeOrigin    string
+	inspectFuncUseRecoveryCode   func(ctx context.Context, userID string, codeHash string)
+	afterUseRecoveryCodeCounter  uint64
+	beforeUseRecoveryCodeCounter uint64
+	UseRecoveryCodeMock          mMfaRepositoryMockUseRecoveryCode
+
+	funcUseTotpStep          func(ctx context.Context, userID string, step int64) (b1 bool, err error)
+	funcUseTotpStepOrigin    string
+	inspectFuncUseTotpStep   func(ctx context.Context, userID string, step int64)
+	afterUseTotpStepCounter  uint64
+	beforeUseTotpStepCounter uint64
+	UseTotpStepMock          mMfaRepositoryMockUseTotpStep
+}
+
+// NewMfaRepositoryMock returns a mock for mm_repository.MfaRepository
+func NewMfaRepositoryMock(t minimock.Tester) *MfaRepositoryMock {
+	m := &MfaRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ConfirmTotpMock = mMfaRepositoryMockConfirmTotp{mock: m}
+	m.ConfirmTotpMock.callArgs = []*MfaRepositoryMockConfirmTotpParams{}
+
+	m.DeleteRecoveryCodesMock = mMfaRepositoryMockDeleteRecoveryCodes{mock: m}
+	m.DeleteRecoveryCodesMock.callArgs = []*MfaRepositoryMockDeleteRecoveryCodesParams{}
+
+	m.DeleteTotpMock = mMfaRepositoryMockDeleteTotp{mock: m}
+	m.DeleteTotpMock.callArgs = []*MfaRepositoryMockDeleteTotpParams{}
+
+	m.GetTotpMock = mMfaRepositoryMockGetTotp{mock: m}
+	m.GetTotpMock.callArgs = []*MfaRepositoryMockGetTotpParams{}
+
+	m.HasRecoveryCodeMock = mMfaRepositoryMockHasRecoveryCode{mock: m}
+	m.HasRecoveryCodeMock.callArgs = []*MfaRepositoryMockHasRecoveryCodeParams{}
+
+	m.ReplaceRecoveryCodesMock = mMfaRepositoryMockReplaceRecoveryCodes{mock: m}
+	m.ReplaceRecoveryCodesMock.callArgs = []*MfaRepositoryMockReplaceRecoveryCodesParams{}
+
+	m.SaveTotpMock = mMfaRepositoryMockSaveTotp{mock: m}
+	m.SaveTotpMock.callArgs = []*MfaRepositoryMockSaveTotpParams{}
+
+	m.UseRecoveryCodeMock = mMfaRepositoryMockUseRecoveryCode{mock: m}
+	m.UseRecoveryCodeMock.callArgs = []*MfaRepositoryMockUseRecoveryCodeParams{}
+
+	m.UseTotpStepMock = mMfaRepositoryMockUseTotpStep{mock: m}
+	m.UseTotpStepMock.callArgs = []*MfaRepositoryMockUseTotpStepParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mMfaRepositoryMockConfirmTotp struct {
+	optional           bool
+	mock               *MfaRepositoryMock
+	defaultExpectation *MfaRepositoryMockConfirmTotpExpectation
+	expectations       []*MfaRepositoryMockConfirmTotpExpectation
+
+	callArgs []*MfaRepositoryMockConfirmTotpParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MfaRepositoryMockConfirmTotpExpectation specifies expectation struct of the MfaRepository.ConfirmTotp
+type MfaRepositoryMockConfirmTotpExpectation struct {
+	mock               *MfaRepositoryMock
+	params             *MfaRepositoryMockConfirmTotpParams
+	paramPtrs          *MfaRepositoryMockConfirmTotpParamPtrs
+	expectationOrigins MfaRepositoryMockConfirmTotpExpectationOrigins
+	results            *MfaRepositoryMockConfirmTotpResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MfaRepositoryMockConfirmTotpParams contains parameters of the MfaRepository.ConfirmTotp
+type MfaRepositoryMockConfirmTotpParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// MfaRepositoryMockConfirmTotpParamPtrs contains pointers to parameters of the MfaRepository.ConfirmTotp
+type MfaRepositoryMockConfirmTotpParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// MfaRepositoryMockConfirmTotpResults contains results of the MfaRepository.ConfirmTotp
+type MfaRepositoryMockConfirmTotpResults struct {
+	err error
+}
+
+// MfaRepositoryMockConfirmTotpOrigins contains origins of expectations of the MfaRepository.ConfirmTotp
+type MfaRepositoryMockConfirmTotpExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmConfirmTotp *mMfaRepositoryMockConfirmTotp) Optional() *mMfaRepositoryMockConfirmTotp {
+	mmConfirmTotp.optional = true
+	return mmConfirmTotp
+}
+
+// Expect sets up expected params for MfaRepository.ConfirmTotp
+func (mmConfirmTotp *mMfaRepositoryMockConfirmTotp) Expect(ctx context.Context, userID string) *mMfaRepositoryMockConfirmTotp {
+	if mmConfirmTotp.mock.funcConfirmTotp != nil {
+		mmConfirmTotp.mock.t.Fatalf("MfaRepositoryMock.ConfirmTotp mock is already set by Set")
+	}
+
+	if mmConfirmTotp.defaultExpectation == nil {
+		mmConfirmTotp.defaultExpectation = &MfaRepositoryMockConfirmTotpExpectation{}
+	}
+
+	if mmConfirmTotp.defaultExpectation.paramPtrs != nil {
+		mmConfirmTotp.mock.t.Fatalf("MfaRepositoryMock.ConfirmTotp mock is already set by ExpectParams functions")
+	}
+
+	mmConfirmTotp.defaultExpectation.params = &MfaRepositoryMockConfirmTotpParams{ctx, userID}
+	mmConfirmTotp.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmConfirmTotp.expectations {
+		if minimock.Equal(e.params, mmConfirmTotp.defaultExpectation.params) {
+			mmConfirmTotp.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConfirmTotp.defaultExpectation.params)
+		}
+	}
+
+	return mmConfirmTotp
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MfaRepository.ConfirmTotp
+func (mmConfirmTotp *mMfaRepositoryMockConfirmTotp) ExpectCtxParam1(ctx context.Context) *mMfaRepositoryMockConfirmTotp {
+	if mmConfirmTotp.mock.funcConfirmTotp != nil {
+		mmConfirmTotp.mock.t.Fatalf("MfaRepositoryMock.ConfirmTotp mock is already set by Set")
+	}
+
+	if mmConfirmTotp.defaultExpectation == nil {
+		mmConfirmTotp.defaultExpectation = &MfaRepositoryMockConfirmTotpExpectation{}
+	}
+
+	if mmConfirmTotp.defaultExpectation.params != nil {
+		mmConfirmTotp.mock.t.Fatalf("MfaRepositoryMock.ConfirmTotp mock is already set by Expect")
+	}
+
+	if mmConfirmTotp.defaultExpectation.paramPtrs == nil {
+		mmConfirmTotp.defaultExpectation.paramPtrs = &MfaRepositoryMockConfirmTotpParamPtrs{}
+	}
+	mmConfirmTotp.defaultExpectation.paramPtrs.ctx = &ctx
+	mmConfirmTotp.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmConfirmTotp
+}
+
+// ExpectUserIDParam2 sets up expected param userID for MfaRepository.ConfirmTotp
+func (mmConfirmTotp *mMfaRepositoryMockConfirmTotp) ExpectUserIDParam2(userID string) *mMfaRepositoryMockConfirmTotp {
+	if mmConfirmTotp.mock.funcConfirmTotp != nil {
+		mmConfirmTotp.mock.t.Fatalf("MfaRepositoryMock.ConfirmTotp mock is already set by Set")
+	}
+
+	if mmConfirmTotp.defaultExpectation == nil {
+		mmConfirmTotp.defaultExpectation = &MfaRepositoryMockConfirmTotpExpectation{}
+	}
+
+	if mmConfirmTotp.defaultExpectation.params != nil {
+		mmConfirmTotp.mock.t.Fatalf("MfaRepositoryMock.ConfirmTotp mock is already set by Expect")
+	}
+
+	if mmConfirmTotp.defaultExpectation.paramPtrs == nil {
+		mmConfirmTotp.defaultExpectation.paramPtrs = &MfaRepositoryMockConfirmTotpParamPtrs{}
+	}
+	mmConfirmTotp.defaultExpectation.paramPtrs.userID = &userID
+	mmConfirmTotp.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmConfirmTotp
+}
+
+// Inspect accepts an inspector function that has same arguments as the MfaRepository.ConfirmTotp
+func (mmConfirmTotp *mMfaRepositoryMockConfirmTotp) Inspect(f func(ctx context.Context, userID string)) *mMfaRepositoryMockConfirmTotp {
+	if mmConfirmTotp.mock.inspectFuncConfirmTotp != nil {
+		mmConfirmTotp.mock.t.Fatalf("Inspect function is already set for MfaRepositoryMock.ConfirmTotp")
+	}
+
+	mmConfirmTotp.mock.inspectFuncConfirmTotp = f
+
+	return mmConfirmTotp
+}
+
+// Return sets up results that will be returned by MfaRepository.ConfirmTotp
+func (mmConfirmTotp *mMfaRepositoryMockConfirmTotp) Return(err error) *MfaRepositoryMock {
+	if mmConfirmTotp.mock.funcConfirmTotp != nil {
+		mmConfirmTotp.mock.t.Fatalf("MfaRepositoryMock.ConfirmTotp mock is already set by Set")
+	}
+
+	if mmConfirmTotp.defaultExpectation == nil {
+		mmConfirmTotp.defaultExpectation = &MfaRepositoryMockConfirmTotpExpectation{mock: mmConfirmTotp.mock}
+	}
+	mmConfirmTotp.defaultExpectation.results = &MfaRepositoryMockConfirmTotpResults{err}
+	mmConfirmTotp.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmConfirmTotp.mock
+}
+
+// Set uses given function f to mock the MfaRepository.ConfirmTotp method
+func (mmConfirmTotp *mMfaRepositoryMockConfirmTotp) Set(f func(ctx context.Context, userID string) (err error)) *MfaRepositoryMock {
+	if mmConfirmTotp.defaultExpectation != nil {
+		mmConfirmTotp.mock.t.Fatalf("Default expectation is already set for the MfaRepository.ConfirmTotp method")
+	}
+
+	if len(mmConfirmTotp.expectations) > 0 {
+		mmConfirmTotp.mock.t.Fatalf("Some expectations are already set for the MfaRepository.ConfirmTotp method")
+	}
+
+	mmConfirmTotp.mock.funcConfirmTotp = f
+	mmConfirmTotp.mock.funcConfirmTotpOrigin = minimock.CallerInfo(1)
+	return mmConfirmTotp.mock
+}
+
+// When sets expectation for the MfaRepository.ConfirmTotp which will trigger the result defined by the following
+// Then helper
+func (mmConfirmTotp *mMfaRepositoryMockConfirmTotp) When(ctx context.Context, userID string) *MfaRepositoryMockConfirmTotpExpectation {
+	if mmConfirmTotp.mock.funcConfirmTotp != nil {
+		mmConfirmTotp.mock.t.Fatalf("MfaRepositoryMock.ConfirmTotp mock is already set by Set")
+	}
+
+	expectation := &MfaRepositoryMockConfirmTotpExpectation{
+		mock:               mmConfirmTotp.mock,
+		params:             &MfaRepositoryMockConfirmTotpParams{ctx, userID},
+		expectationOrigins: MfaRepositoryMockConfirmTotpExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmConfirmTotp.expectations = append(mmConfirmTotp.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MfaRepository.ConfirmTotp return parameters for the expectation previously defined by the When method
+func (e *MfaRepositoryMockConfirmTotpExpectation) Then(err error) *MfaRepositoryMock {
+	e.results = &MfaRepositoryMockConfirmTotpResults{err}
+	return e.mock
+}
+
+// Times sets number of times MfaRepository.ConfirmTotp should be invoked
+func (mmConfirmTotp *mMfaRepositoryMockConfirmTotp) Times(n uint64) *mMfaRepositoryMockConfirmTotp {
+	if n == 0 {
+		mmConfirmTotp.mock.t.Fatalf("Times of MfaRepositoryMock.ConfirmTotp mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmConfirmTotp.expectedInvocations, n)
+	mmConfirmTotp.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmConfirmTotp
+}
+
+func (mmConfirmTotp *mMfaRepositoryMockConfirmTotp) invocationsDone() bool {
+	if len(mmConfirmTotp.expectations) == 0 && mmConfirmTotp.defaultExpectation == nil && mmConfirmTotp.mock.funcConfirmTotp == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmConfirmTotp.mock.afterConfirmTotpCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmConfirmTotp.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ConfirmTotp implements mm_repository.MfaRepository
+func (mmConfirmTotp *MfaRepositoryMock) ConfirmTotp(ctx context.Context, userID string) (err error) {
+	mm_atomic.AddUint64(&mmConfirmTotp.beforeConfirmTotpCounter, 1)
+	defer mm_atomic.AddUint64(&mmConfirmTotp.afterConfirmTotpCounter, 1)
+
+	mmConfirmTotp.t.Helper()
+
+	if mmConfirmTotp.inspectFuncConfirmTotp != nil {
+		mmConfirmTotp.inspectFuncConfirmTotp(ctx, userID)
+	}
+
+	mm_params := MfaRepositoryMockConfirmTotpParams{ctx, userID}
+
+	// Record call args
+	mmConfirmTotp.ConfirmTotpMock.mutex.Lock()
+	mmConfirmTotp.ConfirmTotpMock.callArgs = append(mmConfirmTotp.ConfirmTotpMock.callArgs, &mm_params)
+	mmConfirmTotp.ConfirmTotpMock.mutex.Unlock()
+
+	for _, e := range mmConfirmTotp.ConfirmTotpMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmConfirmTotp.ConfirmTotpMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConfirmTotp.ConfirmTotpMock.defaultExpectation.Counter, 1)
+		mm_want := mmConfirmTotp.ConfirmTotpMock.defaultExpectation.params
+		mm_want_ptrs := mmConfirmTotp.ConfirmTotpMock.defaultExpectation.paramPtrs
+
+		mm_got := MfaRepositoryMockConfirmTotpParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmConfirmTotp.t.Errorf("MfaRepositoryMock.ConfirmTotp got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConfirmTotp.ConfirmTotpMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmConfirmTotp.t.Errorf("MfaRepositoryMock.ConfirmTotp got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConfirmTotp.ConfirmTotpMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConfirmTotp.t.Errorf("MfaRepositoryMock.ConfirmTotp got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmConfirmTotp.ConfirmTotpMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConfirmTotp.ConfirmTotpMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConfirmTotp.t.Fatal("No results are set for the MfaRepositoryMock.ConfirmTotp")
+		}
+		return (*mm_results).err
+	}
+	if mmConfirmTotp.funcConfirmTotp != nil {
+		return mmConfirmTotp.funcConfirmTotp(ctx, userID)
+	}
+	mmConfirmTotp.t.Fatalf("Unexpected call to MfaRepositoryMock.ConfirmTotp. %v %v", ctx, userID)
+	return
+}
+
+// ConfirmTotpAfterCounter returns a count of finished MfaRepositoryMock.ConfirmTotp invocations
+func (mmConfirmTotp *MfaRepositoryMock) ConfirmTotpAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConfirmTotp.afterConfirmTotpCounter)
+}
+
+// ConfirmTotpBeforeCounter returns a count of MfaRepositoryMock.ConfirmTotp invocations
+func (mmConfirmTotp *MfaRepositoryMock) ConfirmTotpBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConfirmTotp.beforeConfirmTotpCounter)
+}
+
+// Calls returns a list of arguments used in each call to MfaRepositoryMock.ConfirmTotp.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConfirmTotp *mMfaRepositoryMockConfirmTotp) Calls() []*MfaRepositoryMockConfirmTotpParams {
+	mmConfirmTotp.mutex.RLock()
+
+	argCopy := make([]*MfaRepositoryMockConfirmTotpParams, len(mmConfirmTotp.callArgs))
+	copy(argCopy, mmConfirmTotp.callArgs)
+
+	mmConfirmTotp.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConfirmTotpDone returns true if the count of the ConfirmTotp invocations corresponds
+// the number of defined expectations
+func (m *MfaRepositoryMock) MinimockConfirmTotpDone() bool {
+	if m.ConfirmTotpMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ConfirmTotpMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ConfirmTotpMock.invocationsDone()
+}
+
+// MinimockConfirmTotpInspect logs each unmet expectation
+func (m *MfaRepositoryMock) MinimockConfirmTotpInspect() {
+	for _, e := range m.ConfirmTotpMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MfaRepositoryMock.ConfirmTotp at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterConfirmTotpCounter := mm_atomic.LoadUint64(&m.afterConfirmTotpCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConfirmTotpMock.defaultExpectation != nil && afterConfirmTotpCounter < 1 {
+		if m.ConfirmTotpMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MfaRepositoryMock.ConfirmTotp at\n%s", m.ConfirmTotpMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MfaRepositoryMock.ConfirmTotp at\n%s with params: %#v", m.ConfirmTotpMock.defaultExpectation.expectationOrigins.origin, *m.ConfirmTotpMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConfirmTotp != nil && afterConfirmTotpCounter < 1 {
+		m.t.Errorf("Expected call to MfaRepositoryMock.ConfirmTotp at\n%s", m.funcConfirmTotpOrigin)
+	}
+
+	if !m.ConfirmTotpMock.invocationsDone() && afterConfirmTotpCounter > 0 {
+		m.t.Errorf("Expected %d calls to MfaRepositoryMock.ConfirmTotp at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ConfirmTotpMock.expectedInvocations), m.ConfirmTotpMock.expectedInvocationsOrigin, afterConfirmTotpCounter)
+	}
+}
+
+type mMfaRepositoryMockDeleteRecoveryCodes struct {
+	optional           bool
+	mock               *MfaRepositoryMock
+	defaultExpectation *MfaRepositoryMockDeleteRecoveryCodesExpectation
+	expectations       []*MfaRepositoryMockDeleteRecoveryCodesExpectation
+
+	callArgs []*MfaRepositoryMockDeleteRecoveryCodesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MfaRepositoryMockDeleteRecoveryCodesExpectation specifies expectation struct of the MfaRepository.DeleteRecoveryCodes
+type MfaRepositoryMockDeleteRecoveryCodesExpectation struct {
+	mock               *MfaRepositoryMock
+	params             *MfaRepositoryMockDeleteRecoveryCodesParams
+	paramPtrs          *MfaRepositoryMockDeleteRecoveryCodesParamPtrs
+	expectationOrigins MfaRepositoryMockDeleteRecoveryCodesExpectationOrigins
+	results            *MfaRepositoryMockDeleteRecoveryCodesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MfaRepositoryMockDeleteRecoveryCodesParams contains parameters of the MfaRepository.DeleteRecoveryCodes
+type MfaRepositoryMockDeleteRecoveryCodesParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// MfaRepositoryMockDeleteRecoveryCodesParamPtrs contains pointers to parameters of the MfaRepository.DeleteRecoveryCodes
+type MfaRepositoryMockDeleteRecoveryCodesParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// MfaRepositoryMockDeleteRecoveryCodesResults contains results of the MfaRepository.DeleteRecoveryCodes
+type MfaRepositoryMockDeleteRecoveryCodesResults struct {
+	err error
+}
+
+// MfaRepositoryMockDeleteRecoveryCodesOrigins contains origins of expectations of the MfaRepository.DeleteRecoveryCodes
+type MfaRepositoryMockDeleteRecoveryCodesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteRecoveryCodes *mMfaRepositoryMockDeleteRecoveryCodes) Optional() *mMfaRepositoryMockDeleteRecoveryCodes {
+	mmDeleteRecoveryCodes.optional = true
+	return mmDeleteRecoveryCodes
+}
+
+// Expect sets up expected params for MfaRepository.DeleteRecoveryCodes
+func (mmDeleteRecoveryCodes *mMfaRepositoryMockDeleteRecoveryCodes) Expect(ctx context.Context, userID string) *mMfaRepositoryMockDeleteRecoveryCodes {
+	if mmDeleteRecoveryCodes.mock.funcDeleteRecoveryCodes != nil {
+		mmDeleteRecoveryCodes.mock.t.Fatalf("MfaRepositoryMock.DeleteRecoveryCodes mock is already set by Set")
+	}
+
+	if mmDeleteRecoveryCodes.defaultExpectation == nil {
+		mmDeleteRecoveryCodes.defaultExpectation = &MfaRepositoryMockDeleteRecoveryCodesExpectation{}
+	}
+
+	if mmDeleteRecoveryCodes.defaultExpectation.paramPtrs != nil {
+		mmDeleteRecoveryCodes.mock.t.Fatalf("MfaRepositoryMock.DeleteRecoveryCodes mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteRecoveryCodes.defaultExpectation.params = &MfaRepositoryMockDeleteRecoveryCodesParams{ctx, userID}
+	mmDeleteRecoveryCodes.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteRecoveryCodes.expectations {
+		if minimock.Equal(e.params, mmDeleteRecoveryCodes.defaultExpectation.params) {
+			mmDeleteRecoveryCodes.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteRecoveryCodes.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteRecoveryCodes
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MfaRepository.DeleteRecoveryCodes
+func (mmDeleteRecoveryCodes *mMfaRepositoryMockDeleteRecoveryCodes) ExpectCtxParam1(ctx context.Context) *mMfaRepositoryMockDeleteRecoveryCodes {
+	if mmDeleteRecoveryCodes.mock.funcDeleteRecoveryCodes != nil {
+		mmDeleteRecoveryCodes.mock.t.Fatalf("MfaRepositoryMock.DeleteRecoveryCodes mock is already set by Set")
+	}
+
+	if mmDeleteRecoveryCodes.defaultExpectation == nil {
+		mmDeleteRecoveryCodes.defaultExpectation = &MfaRepositoryMockDeleteRecoveryCodesExpectation{}
+	}
+
+	if mmDeleteRecoveryCodes.defaultExpectation.params != nil {
+		mmDeleteRecoveryCodes.mock.t.Fatalf("MfaRepositoryMock.DeleteRecoveryCodes mock is already set by Expect")
+	}
+
+	if mmDeleteRecoveryCodes.defaultExpectation.paramPtrs == nil {
+		mmDeleteRecoveryCodes.defaultExpectation.paramPtrs = &MfaRepositoryMockDeleteRecoveryCodesParamPtrs{}
+	}
+	mmDeleteRecoveryCodes.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteRecoveryCodes.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteRecoveryCodes
+}
+
+// ExpectUserIDParam2 sets up expected param userID for MfaRepository.DeleteRecoveryCodes
+func (mmDeleteRecoveryCodes *mMfaRepositoryMockDeleteRecoveryCodes) ExpectUserIDParam2(userID string) *mMfaRepositoryMockDeleteRecoveryCodes {
+	if mmDeleteRecoveryCodes.mock.funcDeleteRecoveryCodes != nil {
+		mmDeleteRecoveryCodes.mock.t.Fatalf("MfaRepositoryMock.DeleteRecoveryCodes mock is already set by Set")
+	}
+
+	if mmDeleteRecoveryCodes.defaultExpectation == nil {
+		mmDeleteRecoveryCodes.defaultExpectation = &MfaRepositoryMockDeleteRecoveryCodesExpectation{}
+	}
+
+	if mmDeleteRecoveryCodes.defaultExpectation.params != nil {
+		mmDeleteRecoveryCodes.mock.t.Fatalf("MfaRepositoryMock.DeleteRecoveryCodes mock is already set by Expect")
+	}
+
+	if mmDeleteRecoveryCodes.defaultExpectation.paramPtrs == nil {
+		mmDeleteRecoveryCodes.defaultExpectation.paramPtrs = &MfaRepositoryMockDeleteRecoveryCodesParamPtrs{}
+	}
+	mmDeleteRecoveryCodes.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteRecoveryCodes.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteRecoveryCodes
+}
+
+// Inspect accepts an inspector function that has same arguments as the MfaRepository.DeleteRecoveryCodes
+func (mmDeleteRecoveryCodes *mMfaRepositoryMockDeleteRecoveryCodes) Inspect(f func(ctx context.Context, userID string)) *mMfaRepositoryMockDeleteRecoveryCodes {
+	if mmDeleteRecoveryCodes.mock.inspectFuncDeleteRecoveryCodes != nil {
+		mmDeleteRecoveryCodes.mock.t.Fatalf("Inspect function is already set for MfaRepositoryMock.DeleteRecoveryCodes")
+	}
+
+	mmDeleteRecoveryCodes.mock.inspectFuncDeleteRecoveryCodes = f
+
+	return mmDeleteRecoveryCodes
+}
+
+// Return sets up results that will be returned by MfaRepository.DeleteRecoveryCodes
+func (mmDeleteRecoveryCodes *mMfaRepositoryMockDeleteRecoveryCodes) Return(err error) *MfaRepositoryMock {
+	if mmDeleteRecoveryCodes.mock.funcDeleteRecoveryCodes != nil {
+		mmDeleteRecoveryCodes.mock.t.Fatalf("MfaRepositoryMock.DeleteRecoveryCodes mock is already set by Set")
+	}
+
+	if mmDeleteRecoveryCodes.defaultExpectation == nil {
+		mmDeleteRecoveryCodes.defaultExpectation = &MfaRepositoryMockDeleteRecoveryCodesExpectation{mock: mmDeleteRecoveryCodes.mock}
+	}
+	mmDeleteRecoveryCodes.defaultExpectation.results = &MfaRepositoryMockDeleteRecoveryCodesResults{err}
+	mmDeleteRecoveryCodes.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteRecoveryCodes.mock
+}
+
+// Set uses given function f to mock the MfaRepository.DeleteRecoveryCodes method
+func (mmDeleteRecoveryCodes *mMfaRepositoryMockDeleteRecoveryCodes) Set(f func(ctx context.Context, userID string) (err error)) *MfaRepositoryMock {
+	if mmDeleteRecoveryCodes.defaultExpectation != nil {
+		mmDeleteRecoveryCodes.mock.t.Fatalf("Default expectation is already set for the MfaRepository.DeleteRecoveryCodes method")
+	}
+
+	if len(mmDeleteRecoveryCodes.expectations) > 0 {
+		mmDeleteRecoveryCodes.mock.t.Fatalf("Some expectations are already set for the MfaRepository.DeleteRecoveryCodes method")
+	}
+
+	mmDeleteRecoveryCodes.mock.funcDeleteRecoveryCodes = f
+	mmDeleteRecoveryCodes.mock.funcDeleteRecoveryCodesOrigin = minimock.CallerInfo(1)
+	return mmDeleteRecoveryCodes.mock
+}
+
+// When sets expectation for the MfaRepository.DeleteRecoveryCodes which will trigger the result defined by the following
+// Then helper
+func (mmDeleteRecoveryCodes *mMfaRepositoryMockDeleteRecoveryCodes) When(ctx context.Context, userID string) *MfaRepositoryMockDeleteRecoveryCodesExpectation {
+	if mmDeleteRecoveryCodes.mock.funcDeleteRecoveryCodes != nil {
+		mmDeleteRecoveryCodes.mock.t.Fatalf("MfaRepositoryMock.DeleteRecoveryCodes mock is already set by Set")
+	}
+
+	expectation := &MfaRepositoryMockDeleteRecoveryCodesExpectation{
+		mock:               mmDeleteRecoveryCodes.mock,
+		params:             &MfaRepositoryMockDeleteRecoveryCodesParams{ctx, userID},
+		expectationOrigins: MfaRepositoryMockDeleteRecoveryCodesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteRecoveryCodes.expectations = append(mmDeleteRecoveryCodes.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MfaRepository.DeleteRecoveryCodes return parameters for the expectation previously defined by the When method
+func (e *MfaRepositoryMockDeleteRecoveryCodesExpectation) Then(err error) *MfaRepositoryMock {
+	e.results = &MfaRepositoryMockDeleteRecoveryCodesResults{err}
+	return e.mock
+}
+
+// Times sets number of times MfaRepository.DeleteRecoveryCodes should be invoked
+func (mmDeleteRecoveryCodes *mMfaRepositoryMockDeleteRecoveryCodes) Times(n uint64) *mMfaRepositoryMockDeleteRecoveryCodes {
+	if n == 0 {
+		mmDeleteRecoveryCodes.mock.t.Fatalf("Times of MfaRepositoryMock.DeleteRecoveryCodes mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteRecoveryCodes.expectedInvocations, n)
+	mmDeleteRecoveryCodes.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteRecoveryCodes
+}
+
+func (mmDeleteRecoveryCodes *mMfaRepositoryMockDeleteRecoveryCodes) invocationsDone() bool {
+	if len(mmDeleteRecoveryCodes.expectations) == 0 && mmDeleteRecoveryCodes.defaultExpectation == nil && mmDeleteRecoveryCodes.mock.funcDeleteRecoveryCodes == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteRecoveryCodes.mock.afterDeleteRecoveryCodesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteRecoveryCodes.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteRecoveryCodes implements mm_repository.MfaRepository
+func (mmDeleteRecoveryCodes *MfaRepositoryMock) DeleteRecoveryCodes(ctx context.Context, userID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteRecoveryCodes.beforeDeleteRecoveryCodesCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteRecoveryCodes.afterDeleteRecoveryCodesCounter, 1)
+
+	mmDeleteRecoveryCodes.t.Helper()
+
+	if mmDeleteRecoveryCodes.inspectFuncDeleteRecoveryCodes != nil {
+		mmDeleteRecoveryCodes.inspectFuncDeleteRecoveryCodes(ctx, userID)
+	}
+
+	mm_params := MfaRepositoryMockDeleteRecoveryCodesParams{ctx, userID}
+
+	// Record call args
+	mmDeleteRecoveryCodes.DeleteRecoveryCodesMock.mutex.Lock()
+	mmDeleteRecoveryCodes.DeleteRecoveryCodesMock.callArgs = append(mmDeleteRecoveryCodes.DeleteRecoveryCodesMock.callArgs, &mm_params)
+	mmDeleteRecoveryCodes.DeleteRecoveryCodesMock.mutex.Unlock()
+
+	for _, e := range mmDeleteRecoveryCodes.DeleteRecoveryCodesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteRecoveryCodes.DeleteRecoveryCodesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteRecoveryCodes.DeleteRecoveryCodesMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteRecoveryCodes.DeleteRecoveryCodesMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteRecoveryCodes.DeleteRecoveryCodesMock.defaultExpectation.paramPtrs
+
+		mm_got := MfaRepositoryMockDeleteRecoveryCodesParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteRecoveryCodes.t.Errorf("MfaRepositoryMock.DeleteRecoveryCodes got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRecoveryCodes.DeleteRecoveryCodesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteRecoveryCodes.t.Errorf("MfaRepositoryMock.DeleteRecoveryCodes got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRecoveryCodes.DeleteRecoveryCodesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteRecoveryCodes.t.Errorf("MfaRepositoryMock.DeleteRecoveryCodes got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteRecoveryCodes.DeleteRecoveryCodesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteRecoveryCodes.DeleteRecoveryCodesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteRecoveryCodes.t.Fatal("No results are set for the MfaRepositoryMock.DeleteRecoveryCodes")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteRecoveryCodes.funcDeleteRecoveryCodes != nil {
+		return mmDeleteRecoveryCodes.funcDeleteRecoveryCodes(ctx, userID)
+	}
+	mmDeleteRecoveryCodes.t.Fatalf("Unexpected call to MfaRepositoryMock.DeleteRecoveryCodes. %v %v", ctx, userID)
+	return
+}
+
+// DeleteRecoveryCodesAfterCounter returns a count of finished MfaRepositoryMock.DeleteRecoveryCodes invocations
+func (mmDeleteRecoveryCodes *MfaRepositoryMock) DeleteRecoveryCodesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRecoveryCodes.afterDeleteRecoveryCodesCounter)
+}
+
+// DeleteRecoveryCodesBeforeCounter returns a count of MfaRepositoryMock.DeleteRecoveryCodes invocations
+func (mmDeleteRecoveryCodes *MfaRepositoryMock) DeleteRecoveryCodesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRecoveryCodes.beforeDeleteRecoveryCodesCounter)
+}
+
+// Calls returns a list of arguments used in each call to MfaRepositoryMock.DeleteRecoveryCodes.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteRecoveryCodes *mMfaRepositoryMockDeleteRecoveryCodes) Calls() []*MfaRepositoryMockDeleteRecoveryCodesParams {
+	mmDeleteRecoveryCodes.mutex.RLock()
+
+	argCopy := make([]*MfaRepositoryMockDeleteRecoveryCodesParams, len(mmDeleteRecoveryCodes.callArgs))
+	copy(argCopy, mmDeleteRecoveryCodes.callArgs)
+
+	mmDeleteRecoveryCodes.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteRecoveryCodesDone returns true if the count of the DeleteRecoveryCodes invocations corresponds
+// the number of defined expectations
+func (m *MfaRepositoryMock) MinimockDeleteRecoveryCodesDone() bool {
+	if m.DeleteRecoveryCodesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteRecoveryCodesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteRecoveryCodesMock.invocationsDone()
+}
+
+// MinimockDeleteRecoveryCodesInspect logs each unmet expectation
+func (m *MfaRepositoryMock) MinimockDeleteRecoveryCodesInspect() {
+	for _, e := range m.DeleteRecoveryCodesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MfaRepositoryMock.DeleteRecoveryCodes at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteRecoveryCodesCounter := mm_atomic.LoadUint64(&m.afterDeleteRecoveryCodesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteRecoveryCodesMock.defaultExpectation != nil && afterDeleteRecoveryCodesCounter < 1 {
+		if m.DeleteRecoveryCodesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MfaRepositoryMock.DeleteRecoveryCodes at\n%s", m.DeleteRecoveryCodesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MfaRepositoryMock.DeleteRecoveryCodes at\n%s with params: %#v", m.DeleteRecoveryCodesMock.defaultExpectation.expectationOrigins.origin, *m.DeleteRecoveryCodesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteRecoveryCodes != nil && afterDeleteRecoveryCodesCounter < 1 {
+		m.t.Errorf("Expected call to MfaRepositoryMock.DeleteRecoveryCodes at\n%s", m.funcDeleteRecoveryCodesOrigin)
+	}
+
+	if !m.DeleteRecoveryCodesMock.invocationsDone() && afterDeleteRecoveryCodesCounter > 0 {
+		m.t.Errorf("Expected %d calls to MfaRepositoryMock.DeleteRecoveryCodes at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteRecoveryCodesMock.expectedInvocations), m.DeleteRecoveryCodesMock.expectedInvocationsOrigin, afterDeleteRecoveryCodesCounter)
+	}
+}
+
+type mMfaRepositoryMockDeleteTotp struct {
+	optional           bool
+	mock               *MfaRepositoryMock
+	defaultExpectation *MfaRepositoryMockDeleteTotpExpectation
+	expectations       []*MfaRepositoryMockDeleteTotpExpectation
+
+	callArgs []*MfaRepositoryMockDeleteTotpParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MfaRepositoryMockDeleteTotpExpectation specifies expectation struct of the MfaRepository.DeleteTotp
+type MfaRepositoryMockDeleteTotpExpectation struct {
+	mock               *MfaRepositoryMock
+	params             *MfaRepositoryMockDeleteTotpParams
+	paramPtrs          *MfaRepositoryMockDeleteTotpParamPtrs
+	expectationOrigins MfaRepositoryMockDeleteTotpExpectationOrigins
+	results            *MfaRepositoryMockDeleteTotpResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MfaRepositoryMockDeleteTotpParams contains parameters of the MfaRepository.DeleteTotp
+type MfaRepositoryMockDeleteTotpParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// MfaRepositoryMockDeleteTotpParamPtrs contains pointers to parameters of the MfaRepository.DeleteTotp
+type MfaRepositoryMockDeleteTotpParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// MfaRepositoryMockDeleteTotpResults contains results of the MfaRepository.DeleteTotp
+type MfaRepositoryMockDeleteTotpResults struct {
+	err error
+}
+
+// MfaRepositoryMockDeleteTotpOrigins contains origins of expectations of the MfaRepository.DeleteTotp
+type MfaRepositoryMockDeleteTotpExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteTotp *mMfaRepositoryMockDeleteTotp) Optional() *mMfaRepositoryMockDeleteTotp {
+	mmDeleteTotp.optional = true
+	return mmDeleteTotp
+}
+
+// Expect sets up expected params for MfaRepository.DeleteTotp
+func (mmDeleteTotp *mMfaRepositoryMockDeleteTotp) Expect(ctx context.Context, userID string) *mMfaRepositoryMockDeleteTotp {
+	if mmDeleteTotp.mock.funcDeleteTotp != nil {
+		mmDeleteTotp.mock.t.Fatalf("MfaRepositoryMock.DeleteTotp mock is already set by Set")
+	}
+
+	if mmDeleteTotp.defaultExpectation == nil {
+		mmDeleteTotp.defaultExpectation = &MfaRepositoryMockDeleteTotpExpectation{}
+	}
+
+	if mmDeleteTotp.defaultExpectation.paramPtrs != nil {
+		mmDeleteTotp.mock.t.Fatalf("MfaRepositoryMock.DeleteTotp mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteTotp.defaultExpectation.params = &MfaRepositoryMockDeleteTotpParams{ctx, userID}
+	mmDeleteTotp.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteTotp.expectations {
+		if minimock.Equal(e.params, mmDeleteTotp.defaultExpectation.params) {
+			mmDeleteTotp.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteTotp.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteTotp
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MfaRepository.DeleteTotp
+func (mmDeleteTotp *mMfaRepositoryMockDeleteTotp) ExpectCtxParam1(ctx context.Context) *mMfaRepositoryMockDeleteTotp {
+	if mmDeleteTotp.mock.funcDeleteTotp != nil {
+		mmDeleteTotp.mock.t.Fatalf("MfaRepositoryMock.DeleteTotp mock is already set by Set")
+	}
+
+	if mmDeleteTotp.defaultExpectation == nil {
+		mmDeleteTotp.defaultExpectation = &MfaRepositoryMockDeleteTotpExpectation{}
+	}
+
+	if mmDeleteTotp.defaultExpectation.params != nil {
+		mmDeleteTotp.mock.t.Fatalf("MfaRepositoryMock.DeleteTotp mock is already set by Expect")
+	}
+
+	if mmDeleteTotp.defaultExpectation.paramPtrs == nil {
+		mmDeleteTotp.defaultExpectation.paramPtrs = &MfaRepositoryMockDeleteTotpParamPtrs{}
+	}
+	mmDeleteTotp.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteTotp.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteTotp
+}
+
+// ExpectUserIDParam2 sets up expected param userID for MfaRepository.DeleteTotp
+func (mmDeleteTotp *mMfaRepositoryMockDeleteTotp) ExpectUserIDParam2(userID string) *mMfaRepositoryMockDeleteTotp {
+	if mmDeleteTotp.mock.funcDeleteTotp != nil {
+		mmDeleteTotp.mock.t.Fatalf("MfaRepositoryMock.DeleteTotp mock is already set by Set")
+	}
+
+	if mmDeleteTotp.defaultExpectation == nil {
+		mmDeleteTotp.defaultExpectation = &MfaRepositoryMockDeleteTotpExpectation{}
+	}
+
+	if mmDeleteTotp.defaultExpectation.params != nil {
+		mmDeleteTotp.mock.t.Fatalf("MfaRepositoryMock.DeleteTotp mock is already set by Expect")
+	}
+
+	if mmDeleteTotp.defaultExpectation.paramPtrs == nil {
+		mmDeleteTotp.defaultExpectation.paramPtrs = &MfaRepositoryMockDeleteTotpParamPtrs{}
+	}
+	mmDeleteTotp.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteTotp.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteTotp
+}
+
+// Inspect accepts an inspector function that has same arguments as the MfaRepository.DeleteTotp
+func (mmDeleteTotp *mMfaRepositoryMockDeleteTotp) Inspect(f func(ctx context.Context, userID string)) *mMfaRepositoryMockDeleteTotp {
+	if mmDeleteTotp.mock.inspectFuncDeleteTotp != nil {
+		mmDeleteTotp.mock.t.Fatalf("Inspect function is already set for MfaRepositoryMock.DeleteTotp")
+	}
+
+	mmDeleteTotp.mock.inspectFuncDeleteTotp = f
+
+	return mmDeleteTotp
+}
+
+// Return sets up results that will be returned by MfaRepository.DeleteTotp
+func (mmDeleteTotp *mMfaRepositoryMockDeleteTotp) Return(err error) *MfaRepositoryMock {
+	if mmDeleteTotp.mock.funcDeleteTotp != nil {
+		mmDeleteTotp.mock.t.Fatalf("MfaRepositoryMock.DeleteTotp mock is already set by Set")
+	}
+
+	if mmDeleteTotp.defaultExpectation == nil {
+		mmDeleteTotp.defaultExpectation = &MfaRepositoryMockDeleteTotpExpectation{mock: mmDeleteTotp.mock}
+	}
+	mmDeleteTotp.defaultExpectation.results = &MfaRepositoryMockDeleteTotpResults{err}
+	mmDeleteTotp.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteTotp.mock
+}
+
+// Set uses given function f to mock the MfaRepository.DeleteTotp method
+func (mmDeleteTotp *mMfaRepositoryMockDeleteTotp) Set(f func(ctx context.Context, userID string) (err error)) *MfaRepositoryMock {
+	if mmDeleteTotp.defaultExpectation != nil {
+		mmDeleteTotp.mock.t.Fatalf("Default expectation is already set for the MfaRepository.DeleteTotp method")
+	}
+
+	if len(mmDeleteTotp.expectations) > 0 {
+		mmDeleteTotp.mock.t.Fatalf("Some expectations are already set for the MfaRepository.DeleteTotp method")
+	}
+
+	mmDeleteTotp.mock.funcDeleteTotp = f
+	mmDeleteTotp.mock.funcDeleteTotpOrigin = minimock.CallerInfo(1)
+	return mmDeleteTotp.mock
+}
+
+// When sets expectation for the MfaRepository.DeleteTotp which will trigger the result defined by the following
+// Then helper
+func (mmDeleteTotp *mMfaRepositoryMockDeleteTotp) When(ctx context.Context, userID string) *MfaRepositoryMockDeleteTotpExpectation {
+	if mmDeleteTotp.mock.funcDeleteTotp != nil {
+		mmDeleteTotp.mock.t.Fatalf("MfaRepositoryMock.DeleteTotp mock is already set by Set")
+	}
+
+	expectation := &MfaRepositoryMockDeleteTotpExpectation{
+		mock:               mmDeleteTotp.mock,
+		params:             &MfaRepositoryMockDeleteTotpParams{ctx, userID},
+		expectationOrigins: MfaRepositoryMockDeleteTotpExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteTotp.expectations = append(mmDeleteTotp.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MfaRepository.DeleteTotp return parameters for the expectation previously defined by the When method
+func (e *MfaRepositoryMockDeleteTotpExpectation) Then(err error) *MfaRepositoryMock {
+	e.results = &MfaRepositoryMockDeleteTotpResults{err}
+	return e.mock
+}
+
+// Times sets number of times MfaRepository.DeleteTotp should be invoked
+func (mmDeleteTotp *mMfaRepositoryMockDeleteTotp) Times(n uint64) *mMfaRepositoryMockDeleteTotp {
+	if n == 0 {
+		mmDeleteTotp.mock.t.Fatalf("Times of MfaRepositoryMock.DeleteTotp mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteTotp.expectedInvocations, n)
+	mmDeleteTotp.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteTotp
+}
+
+func (mmDeleteTotp *mMfaRepositoryMockDeleteTotp) invocationsDone() bool {
+	if len(mmDeleteTotp.expectations) == 0 && mmDeleteTotp.defaultExpectation == nil && mmDeleteTotp.mock.funcDeleteTotp == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteTotp.mock.afterDeleteTotpCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteTotp.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteTotp implements mm_repository.MfaRepository
+func (mmDeleteTotp *MfaRepositoryMock) DeleteTotp(ctx context.Context, userID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteTotp.beforeDeleteTotpCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteTotp.afterDeleteTotpCounter, 1)
+
+	mmDeleteTotp.t.Helper()
+
+	if mmDeleteTotp.inspectFuncDeleteTotp != nil {
+		mmDeleteTotp.inspectFuncDeleteTotp(ctx, userID)
+	}
+
+	mm_params := MfaRepositoryMockDeleteTotpParams{ctx, userID}
+
+	// Record call args
+	mmDeleteTotp.DeleteTotpMock.mutex.Lock()
+	mmDeleteTotp.DeleteTotpMock.callArgs = append(mmDeleteTotp.DeleteTotpMock.callArgs, &mm_params)
+	mmDeleteTotp.DeleteTotpMock.mutex.Unlock()
+
+	for _, e := range mmDeleteTotp.DeleteTotpMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteTotp.DeleteTotpMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteTotp.DeleteTotpMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteTotp.DeleteTotpMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteTotp.DeleteTotpMock.defaultExpectation.paramPtrs
+
+		mm_got := MfaRepositoryMockDeleteTotpParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteTotp.t.Errorf("MfaRepositoryMock.DeleteTotp got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTotp.DeleteTotpMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteTotp.t.Errorf("MfaRepositoryMock.DeleteTotp got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTotp.DeleteTotpMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteTotp.t.Errorf("MfaRepositoryMock.DeleteTotp got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteTotp.DeleteTotpMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteTotp.DeleteTotpMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteTotp.t.Fatal("No results are set for the MfaRepositoryMock.DeleteTotp")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteTotp.funcDeleteTotp != nil {
+		return mmDeleteTotp.funcDeleteTotp(ctx, userID)
+	}
+	mmDeleteTotp.t.Fatalf("Unexpected call to MfaRepositoryMock.DeleteTotp. %v %v", ctx, userID)
+	return
+}
+
+// DeleteTotpAfterCounter returns a count of finished MfaRepositoryMock.DeleteTotp invocations
+func (mmDeleteTotp *MfaRepositoryMock) DeleteTotpAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTotp.afterDeleteTotpCounter)
+}
+
+// DeleteTotpBeforeCounter returns a count of MfaRepositoryMock.DeleteTotp invocations
+func (mmDeleteTotp *MfaRepositoryMock) DeleteTotpBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTotp.beforeDeleteTotpCounter)
+}
+
+// Calls returns a list of arguments used in each call to MfaRepositoryMock.DeleteTotp.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteTotp *mMfaRepositoryMockDeleteTotp) Calls() []*MfaRepositoryMockDeleteTotpParams {
+	mmDeleteTotp.mutex.RLock()
+
+	argCopy := make([]*MfaRepositoryMockDeleteTotpParams, len(mmDeleteTotp.callArgs))
+	copy(argCopy, mmDeleteTotp.callArgs)
+
+	mmDeleteTotp.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteTotpDone returns true if the count of the DeleteTotp invocations corresponds
+// the number of defined expectations
+func (m *MfaRepositoryMock) MinimockDeleteTotpDone() bool {
+	if m.DeleteTotpMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteTotpMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteTotpMock.invocationsDone()
+}
+
+// MinimockDeleteTotpInspect logs each unmet expectation
+func (m *MfaRepositoryMock) MinimockDeleteTotpInspect() {
+	for _, e := range m.DeleteTotpMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MfaRepositoryMock.DeleteTotp at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteTotpCounter := mm_atomic.LoadUint64(&m.afterDeleteTotpCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteTotpMock.defaultExpectation != nil && afterDeleteTotpCounter < 1 {
+		if m.DeleteTotpMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MfaRepositoryMock.DeleteTotp at\n%s", m.DeleteTotpMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MfaRepositoryMock.DeleteTotp at\n%s with params: %#v", m.DeleteTotpMock.defaultExpectation.expectationOrigins.origin, *m.DeleteTotpMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteTotp != nil && afterDeleteTotpCounter < 1 {
+		m.t.Errorf("Expected call to MfaRepositoryMock.DeleteTotp at\n%s", m.funcDeleteTotpOrigin)
+	}
+
+	if !m.DeleteTotpMock.invocationsDone() && afterDeleteTotpCounter > 0 {
+		m.t.Errorf("Expected %d calls to MfaRepositoryMock.DeleteTotp at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteTotpMock.expectedInvocations), m.DeleteTotpMock.expectedInvocationsOrigin, afterDeleteTotpCounter)
+	}
+}
+
+type mMfaRepositoryMockGetTotp struct {
+	optional           bool
+	mock               *MfaRepositoryMock
+	defaultExpectation *MfaRepositoryMockGetTotpExpectation
+	expectations       []*MfaRepositoryMockGetTotpExpectation
+
+	callArgs []*MfaRepositoryMockGetTotpParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MfaRepositoryMockGetTotpExpectation specifies expectation struct of the MfaRepository.GetTotp
+type MfaRepositoryMockGetTotpExpectation struct {
+	mock               *MfaRepositoryMock
+	params             *MfaRepositoryMockGetTotpParams
+	paramPtrs          *MfaRepositoryMockGetTotpParamPtrs
+	expectationOrigins MfaRepositoryMockGetTotpExpectationOrigins
+	results            *MfaRepositoryMockGetTotpResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MfaRepositoryMockGetTotpParams contains parameters of the MfaRepository.GetTotp
+type MfaRepositoryMockGetTotpParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// MfaRepositoryMockGetTotpParamPtrs contains pointers to parameters of the MfaRepository.GetTotp
+type MfaRepositoryMockGetTotpParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// MfaRepositoryMockGetTotpResults contains results of the MfaRepository.GetTotp
+type MfaRepositoryMockGetTotpResults struct {
+	tp1 *model.Totp
+	err error
+}
+
+// MfaRepositoryMockGetTotpOrigins contains origins of expectations of the MfaRepository.GetTotp
+type MfaRepositoryMockGetTotpExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetTotp *mMfaRepositoryMockGetTotp) Optional() *mMfaRepositoryMockGetTotp {
+	mmGetTotp.optional = true
+	return mmGetTotp
+}
+
+// Expect sets up expected params for MfaRepository.GetTotp
+func (mmGetTotp *mMfaRepositoryMockGetTotp) Expect(ctx context.Context, userID string) *mMfaRepositoryMockGetTotp {
+	if mmGetTotp.mock.funcGetTotp != nil {
+		mmGetTotp.mock.t.Fatalf("MfaRepositoryMock.GetTotp mock is already set by Set")
+	}
+
+	if mmGetTotp.defaultExpectation == nil {
+		mmGetTotp.defaultExpectation = &MfaRepositoryMockGetTotpExpectation{}
+	}
+
+	if mmGetTotp.defaultExpectation.paramPtrs != nil {
+		mmGetTotp.mock.t.Fatalf("MfaRepositoryMock.GetTotp mock is already set by ExpectParams functions")
+	}
+
+	mmGetTotp.defaultExpectation.params = &MfaRepositoryMockGetTotpParams{ctx, userID}
+	mmGetTotp.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetTotp.expectations {
+		if minimock.Equal(e.params, mmGetTotp.defaultExpectation.params) {
+			mmGetTotp.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetTotp.defaultExpectation.params)
+		}
+	}
+
+	return mmGetTotp
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MfaRepository.GetTotp
+func (mmGetTotp *mMfaRepositoryMockGetTotp) ExpectCtxParam1(ctx context.Context) *mMfaRepositoryMockGetTotp {
+	if mmGetTotp.mock.funcGetTotp != nil {
+		mmGetTotp.mock.t.Fatalf("MfaRepositoryMock.GetTotp mock is already set by Set")
+	}
+
+	if mmGetTotp.defaultExpectation == nil {
+		mmGetTotp.defaultExpectation = &MfaRepositoryMockGetTotpExpectation{}
+	}
+
+	if mmGetTotp.defaultExpectation.params != nil {
+		mmGetTotp.mock.t.Fatalf("MfaRepositoryMock.GetTotp mock is already set by Expect")
+	}
+
+	if mmGetTotp.defaultExpectation.paramPtrs == nil {
+		mmGetTotp.defaultExpectation.paramPtrs = &MfaRepositoryMockGetTotpParamPtrs{}
+	}
+	mmGetTotp.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetTotp.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetTotp
+}
+
+// ExpectUserIDParam2 sets up expected param userID for MfaRepository.GetTotp
+func (mmGetTotp *mMfaRepositoryMockGetTotp) ExpectUserIDParam2(userID string) *mMfaRepositoryMockGetTotp {
+	if mmGetTotp.mock.funcGetTotp != nil {
+		mmGetTotp.mock.t.Fatalf("MfaRepositoryMock.GetTotp mock is already set by Set")
+	}
+
+	if mmGetTotp.defaultExpectation == nil {
+		mmGetTotp.defaultExpectation = &MfaRepositoryMockGetTotpExpectation{}
+	}
+
+	if mmGetTotp.defaultExpectation.params != nil {
+		mmGetTotp.mock.t.Fatalf("MfaRepositoryMock.GetTotp mock is already set by Expect")
+	}
+
+	if mmGetTotp.defaultExpectation.paramPtrs == nil {
+		mmGetTotp.defaultExpectation.paramPtrs = &MfaRepositoryMockGetTotpParamPtrs{}
+	}
+	mmGetTotp.defaultExpectation.paramPtrs.userID = &userID
+	mmGetTotp.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetTotp
+}
+
+// Inspect accepts an inspector function that has same arguments as the MfaRepository.GetTotp
+func (mmGetTotp *mMfaRepositoryMockGetTotp) Inspect(f func(ctx context.Context, userID string)) *mMfaRepositoryMockGetTotp {
+	if mmGetTotp.mock.inspectFuncGetTotp != nil {
+		mmGetTotp.mock.t.Fatalf("Inspect function is already set for MfaRepositoryMock.GetTotp")
+	}
+
+	mmGetTotp.mock.inspectFuncGetTotp = f
+
+	return mmGetTotp
+}
+
+// Return sets up results that will be returned by MfaRepository.GetTotp
+func (mmGetTotp *mMfaRepositoryMockGetTotp) Return(tp1 *model.Totp, err error) *MfaRepositoryMock {
+	if mmGetTotp.mock.funcGetTotp != nil {
+		mmGetTotp.mock.t.Fatalf("MfaRepositoryMock.GetTotp mock is already set by Set")
+	}
+
+	if mmGetTotp.defaultExpectation == nil {
+		mmGetTotp.defaultExpectation = &MfaRepositoryMockGetTotpExpectation{mock: mmGetTotp.mock}
+	}
+	mmGetTotp.defaultExpectation.results = &MfaRepositoryMockGetTotpResults{tp1, err}
+	mmGetTotp.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetTotp.mock
+}
+
+// Set uses given function f to mock the MfaRepository.GetTotp method
+func (mmGetTotp *mMfaRepositoryMockGetTotp) Set(f func(ctx context.Context, userID string) (tp1 *model.Totp, err error)) *MfaRepositoryMock {
+	if mmGetTotp.defaultExpectation != nil {
+		mmGetTotp.mock.t.Fatalf("Default expectation is already set for the MfaRepository.GetTotp method")
+	}
+
+	if len(mmGetTotp.expectations) > 0 {
+		mmGetTotp.mock.t.Fatalf("Some expectations are already set for the MfaRepository.GetTotp method")
+	}
+
+	mmGetTotp.mock.funcGetTotp = f
+	mmGetTotp.mock.funcGetTotpOrigin = minimock.CallerInfo(1)
+	return mmGetTotp.mock
+}
+
+// When sets expectation for the MfaRepository.GetTotp which will trigger the result defined by the following
+// Then helper
+func (mmGetTotp *mMfaRepositoryMockGetTotp) When(ctx context.Context, userID string) *MfaRepositoryMockGetTotpExpectation {
+	if mmGetTotp.mock.funcGetTotp != nil {
+		mmGetTotp.mock.t.Fatalf("MfaRepositoryMock.GetTotp mock is already set by Set")
+	}
+
+	expectation := &MfaRepositoryMockGetTotpExpectation{
+		mock:               mmGetTotp.mock,
+		params:             &MfaRepositoryMockGetTotpParams{ctx, userID},
+		expectationOrigins: MfaRepositoryMockGetTotpExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetTotp.expectations = append(mmGetTotp.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MfaRepository.GetTotp return parameters for the expectation previously defined by the When method
+func (e *MfaRepositoryMockGetTotpExpectation) Then(tp1 *model.Totp, err error) *MfaRepositoryMock {
+	e.results = &MfaRepositoryMockGetTotpResults{tp1, err}
+	return e.mock
+}
+
+// Times sets number of times MfaRepository.GetTotp should be invoked
+func (mmGetTotp *mMfaRepositoryMockGetTotp) Times(n uint64) *mMfaRepositoryMockGetTotp {
+	if n == 0 {
+		mmGetTotp.mock.t.Fatalf("Times of MfaRepositoryMock.GetTotp mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetTotp.expectedInvocations, n)
+	mmGetTotp.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetTotp
+}
+
+func (mmGetTotp *mMfaRepositoryMockGetTotp) invocationsDone() bool {
+	if len(mmGetTotp.expectations) == 0 && mmGetTotp.defaultExpectation == nil && mmGetTotp.mock.funcGetTotp == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetTotp.mock.afterGetTotpCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetTotp.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetTotp implements mm_repository.MfaRepository
+func (mmGetTotp *MfaRepositoryMock) GetTotp(ctx context.Context, userID string) (tp1 *model.Totp, err error) {
+	mm_atomic.AddUint64(&mmGetTotp.beforeGetTotpCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetTotp.afterGetTotpCounter, 1)
+
+	mmGetTotp.t.Helper()
+
+	if mmGetTotp.inspectFuncGetTotp != nil {
+		mmGetTotp.inspectFuncGetTotp(ctx, userID)
+	}
+
+	mm_params := MfaRepositoryMockGetTotpParams{ctx, userID}
+
+	// Record call args
+	mmGetTotp.GetTotpMock.mutex.Lock()
+	mmGetTotp.GetTotpMock.callArgs = append(mmGetTotp.GetTotpMock.callArgs, &mm_params)
+	mmGetTotp.GetTotpMock.mutex.Unlock()
+
+	for _, e := range mmGetTotp.GetTotpMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tp1, e.results.err
+		}
+	}
+
+	if mmGetTotp.GetTotpMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetTotp.GetTotpMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetTotp.GetTotpMock.defaultExpectation.params
+		mm_want_ptrs := mmGetTotp.GetTotpMock.defaultExpectation.paramPtrs
+
+		mm_got := MfaRepositoryMockGetTotpParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetTotp.t.Errorf("MfaRepositoryMock.GetTotp got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTotp.GetTotpMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetTotp.t.Errorf("MfaRepositoryMock.GetTotp got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTotp.GetTotpMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetTotp.t.Errorf("MfaRepositoryMock.GetTotp got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetTotp.GetTotpMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetTotp.GetTotpMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetTotp.t.Fatal("No results are set for the MfaRepositoryMock.GetTotp")
+		}
+		return (*mm_results).tp1, (*mm_results).err
+	}
+	if mmGetTotp.funcGetTotp != nil {
+		return mmGetTotp.funcGetTotp(ctx, userID)
+	}
+	mmGetTotp.t.Fatalf("Unexpected call to MfaRepositoryMock.GetTotp. %v %v", ctx, userID)
+	return
+}
+
+// GetTotpAfterCounter returns a count of finished MfaRepositoryMock.GetTotp invocations
+func (mmGetTotp *MfaRepositoryMock) GetTotpAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTotp.afterGetTotpCounter)
+}
+
+// GetTotpBeforeCounter returns a count of MfaRepositoryMock.GetTotp invocations
+func (mmGetTotp *MfaRepositoryMock) GetTotpBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTotp.beforeGetTotpCounter)
+}
+
+// Calls returns a list of arguments used in each call to MfaRepositoryMock.GetTotp.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetTotp *mMfaRepositoryMockGetTotp) Calls() []*MfaRepositoryMockGetTotpParams {
+	mmGetTotp.mutex.RLock()
+
+	argCopy := make([]*MfaRepositoryMockGetTotpParams, len(mmGetTotp.callArgs))
+	copy(argCopy, mmGetTotp.callArgs)
+
+	mmGetTotp.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetTotpDone returns true if the count of the GetTotp invocations corresponds
+// the number of defined expectations
+func (m *MfaRepositoryMock) MinimockGetTotpDone() bool {
+	if m.GetTotpMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetTotpMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetTotpMock.invocationsDone()
+}
+
+// MinimockGetTotpInspect logs each unmet expectation
+func (m *MfaRepositoryMock) MinimockGetTotpInspect() {
+	for _, e := range m.GetTotpMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MfaRepositoryMock.GetTotp at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetTotpCounter := mm_atomic.LoadUint64(&m.afterGetTotpCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetTotpMock.defaultExpectation != nil && afterGetTotpCounter < 1 {
+		if m.GetTotpMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MfaRepositoryMock.GetTotp at\n%s", m.GetTotpMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MfaRepositoryMock.GetTotp at\n%s with params: %#v", m.GetTotpMock.defaultExpectation.expectationOrigins.origin, *m.GetTotpMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetTotp != nil && afterGetTotpCounter < 1 {
+		m.t.Errorf("Expected call to MfaRepositoryMock.GetTotp at\n%s", m.funcGetTotpOrigin)
+	}
+
+	if !m.GetTotpMock.invocationsDone() && afterGetTotpCounter > 0 {
+		m.t.Errorf("Expected %d calls to MfaRepositoryMock.GetTotp at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetTotpMock.expectedInvocations), m.GetTotpMock.expectedInvocationsOrigin, afterGetTotpCounter)
+	}
+}
+
+type mMfaRepositoryMockHasRecoveryCode struct {
+	optional           bool
+	mock               *MfaRepositoryMock
+	defaultExpectation *MfaRepositoryMockHasRecoveryCodeExpectation
+	expectations       []*MfaRepositoryMockHasRecoveryCodeExpectation
+
+	callArgs []*MfaRepositoryMockHasRecoveryCodeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MfaRepositoryMockHasRecoveryCodeExpectation specifies expectation struct of the MfaRepository.HasRecoveryCode
+type MfaRepositoryMockHasRecoveryCodeExpectation struct {
+	mock               *MfaRepositoryMock
+	params             *MfaRepositoryMockHasRecoveryCodeParams
+	paramPtrs          *MfaRepositoryMockHasRecoveryCodeParamPtrs
+	expectationOrigins MfaRepositoryMockHasRecoveryCodeExpectationOrigins
+	results            *MfaRepositoryMockHasRecoveryCodeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MfaRepositoryMockHasRecoveryCodeParams contains parameters of the MfaRepository.HasRecoveryCode
+type MfaRepositoryMockHasRecoveryCodeParams struct {
+	ctx      context.Context
+	userID   string
+	codeHash string
+}
+
+// MfaRepositoryMockHasRecoveryCodeParamPtrs contains pointers to parameters of the MfaRepository.HasRecoveryCode
+type MfaRepositoryMockHasRecoveryCodeParamPtrs struct {
+	ctx      *context.Context
+	userID   *string
+	codeHash *string
+}
+
+// MfaRepositoryMockHasRecoveryCodeResults contains results of the MfaRepository.HasRecoveryCode
+type MfaRepositoryMockHasRecoveryCodeResults struct {
+	b1  bool
+	err error
+}
+
+// MfaRepositoryMockHasRecoveryCodeOrigins contains origins of expectations of the MfaRepository.HasRecoveryCode
+type MfaRepositoryMockHasRecoveryCodeExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originCodeHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmHasRecoveryCode *mMfaRepositoryMockHasRecoveryCode) Optional() *mMfaRepositoryMockHasRecoveryCode {
+	mmHasRecoveryCode.optional = true
+	return mmHasRecoveryCode
+}
+
+// Expect sets up expected params for MfaRepository.HasRecoveryCode
+func (mmHasRecoveryCode *mMfaRepositoryMockHasRecoveryCode) Expect(ctx context.Context, userID string, codeHash string) *mMfaRepositoryMockHasRecoveryCode {
+	if mmHasRecoveryCode.mock.funcHasRecoveryCode != nil {
+		mmHasRecoveryCode.mock.t.Fatalf("MfaRepositoryMock.HasRecoveryCode mock is already set by Set")
+	}
+
+	if mmHasRecoveryCode.defaultExpectation == nil {
+		mmHasRecoveryCode.defaultExpectation = &MfaRepositoryMockHasRecoveryCodeExpectation{}
+	}
+
+	if mmHasRecoveryCode.defaultExpectation.paramPtrs != nil {
+		mmHasRecoveryCode.mock.t.Fatalf("MfaRepositoryMock.HasRecoveryCode mock is already set by ExpectParams functions")
+	}
+
+	mmHasRecoveryCode.defaultExpectation.params = &MfaRepositoryMockHasRecoveryCodeParams{ctx, userID, codeHash}
+	mmHasRecoveryCode.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmHasRecoveryCode.expectations {
+		if minimock.Equal(e.params, mmHasRecoveryCode.defaultExpectation.params) {
+			mmHasRecoveryCode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHasRecoveryCode.defaultExpectation.params)
+		}
+	}
+
+	return mmHasRecoveryCode
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MfaRepository.HasRecoveryCode
+func (mmHasRecoveryCode *mMfaRepositoryMockHasRecoveryCode) ExpectCtxParam1(ctx context.Context) *mMfaRepositoryMockHasRecoveryCode {
+	if mmHasRecoveryCode.mock.funcHasRecoveryCode != nil {
+		mmHasRecoveryCode.mock.t.Fatalf("MfaRepositoryMock.HasRecoveryCode mock is already set by Set")
+	}
+
+	if mmHasRecoveryCode.defaultExpectation == nil {
+		mmHasRecoveryCode.defaultExpectation = &MfaRepositoryMockHasRecoveryCodeExpectation{}
+	}
+
+	if mmHasRecoveryCode.defaultExpectation.params != nil {
+		mmHasRecoveryCode.mock.t.Fatalf("MfaRepositoryMock.HasRecoveryCode mock is already set by Expect")
+	}
+
+	if mmHasRecoveryCode.defaultExpectation.paramPtrs == nil {
+		mmHasRecoveryCode.defaultExpectation.paramPtrs = &MfaRepositoryMockHasRecoveryCodeParamPtrs{}
+	}
+	mmHasRecoveryCode.defaultExpectation.paramPtrs.ctx = &ctx
+	mmHasRecoveryCode.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmHasRecoveryCode
+}
+
+// ExpectUserIDParam2 sets up expected param userID for MfaRepository.HasRecoveryCode
+func (mmHasRecoveryCode *mMfaRepositoryMockHasRecoveryCode) ExpectUserIDParam2(userID string) *mMfaRepositoryMockHasRecoveryCode {
+	if mmHasRecoveryCode.mock.funcHasRecoveryCode != nil {
+		mmHasRecoveryCode.mock.t.Fatalf("MfaRepositoryMock.HasRecoveryCode mock is already set by Set")
+	}
+
+	if mmHasRecoveryCode.defaultExpectation == nil {
+		mmHasRecoveryCode.defaultExpectation = &MfaRepositoryMockHasRecoveryCodeExpectation{}
+	}
+
+	if mmHasRecoveryCode.defaultExpectation.params != nil {
+		mmHasRecoveryCode.mock.t.Fatalf("MfaRepositoryMock.HasRecoveryCode mock is already set by Expect")
+	}
+
+	if mmHasRecoveryCode.defaultExpectation.paramPtrs == nil {
+		mmHasRecoveryCode.defaultExpectation.paramPtrs = &MfaRepositoryMockHasRecoveryCodeParamPtrs{}
+	}
+	mmHasRecoveryCode.defaultExpectation.paramPtrs.userID = &userID
+	mmHasRecoveryCode.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmHasRecoveryCode
+}
+
+// ExpectCodeHashParam3 sets up expected param codeHash for MfaRepository.HasRecoveryCode
+func (mmHasRecoveryCode *mMfaRepositoryMockHasRecoveryCode) ExpectCodeHashParam3(codeHash string) *mMfaRepositoryMockHasRecoveryCode {
+	if mmHasRecoveryCode.mock.funcHasRecoveryCode != nil {
+		mmHasRecoveryCode.mock.t.Fatalf("MfaRepositoryMock.HasRecoveryCode mock is already set by Set")
+	}
+
+	if mmHasRecoveryCode.defaultExpectation == nil {
+		mmHasRecoveryCode.defaultExpectation = &MfaRepositoryMockHasRecoveryCodeExpectation{}
+	}
+
+	if mmHasRecoveryCode.defaultExpectation.params != nil {
+		mmHasRecoveryCode.mock.t.Fatalf("MfaRepositoryMock.HasRecoveryCode mock is already set by Expect")
+	}
+
+	if mmHasRecoveryCode.defaultExpectation.paramPtrs == nil {
+		mmHasRecoveryCode.defaultExpectation.paramPtrs = &MfaRepositoryMockHasRecoveryCodeParamPtrs{}
+	}
+	mmHasRecoveryCode.defaultExpectation.paramPtrs.codeHash = &codeHash
+	mmHasRecoveryCode.defaultExpectation.expectationOrigins.originCodeHash = minimock.CallerInfo(1)
+
+	return mmHasRecoveryCode
+}
+
+// Inspect accepts an inspector function that has same arguments as the MfaRepository.HasRecoveryCode
+func (mmHasRecoveryCode *mMfaRepositoryMockHasRecoveryCode) Inspect(f func(ctx context.Context, userID string, codeHash string)) *mMfaRepositoryMockHasRecoveryCode {
+	if mmHasRecoveryCode.mock.inspectFuncHasRecoveryCode != nil {
+		mmHasRecoveryCode.mock.t.Fatalf("Inspect function is already set for MfaRepositoryMock.HasRecoveryCode")
+	}
+
+	mmHasRecoveryCode.mock.inspectFuncHasRecoveryCode = f
+
+	return mmHasRecoveryCode
+}
+
+// Return sets up results that will be returned by MfaRepository.HasRecoveryCode
+func (mmHasRecoveryCode *mMfaRepositoryMockHasRecoveryCode) Return(b1 bool, err error) *MfaRepositoryMock {
+	if mmHasRecoveryCode.mock.funcHasRecoveryCode != nil {
+		mmHasRecoveryCode.mock.t.Fatalf("MfaRepositoryMock.HasRecoveryCode mock is already set by Set")
+	}
+
+	if mmHasRecoveryCode.defaultExpectation == nil {
+		mmHasRecoveryCode.defaultExpectation = &MfaRepositoryMockHasRecoveryCodeExpectation{mock: mmHasRecoveryCode.mock}
+	}
+	mmHasRecoveryCode.defaultExpectation.results = &MfaRepositoryMockHasRecoveryCodeResults{b1, err}
+	mmHasRecoveryCode.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmHasRecoveryCode.mock
+}
+
+// Set uses given function f to mock the MfaRepository.HasRecoveryCode method
+func (mmHasRecoveryCode *mMfaRepositoryMockHasRecoveryCode) Set(f func(ctx context.Context, userID string, codeHash string) (b1 bool, err error)) *MfaRepositoryMock {
+	if mmHasRecoveryCode.defaultExpectation != nil {
+		mmHasRecoveryCode.mock.t.Fatalf("Default expectation is already set for the MfaRepository.HasRecoveryCode method")
+	}
+
+	if len(mmHasRecoveryCode.expectations) > 0 {
+		mmHasRecoveryCode.mock.t.Fatalf("Some expectations are already set for the MfaRepository.HasRecoveryCode method")
+	}
+
+	mmHasRecoveryCode.mock.funcHasRecoveryCode = f
+	mmHasRecoveryCode.mock.funcHasRecoveryCodeOrigin = minimock.CallerInfo(1)
+	return mmHasRecoveryCode.mock
+}
+
+// When sets expectation for the MfaRepository.HasRecoveryCode which will trigger the result defined by the following
+// Then helper
+func (mmHasRecoveryCode *mMfaRepositoryMockHasRecoveryCode) When(ctx context.Context, userID string, codeHash string) *MfaRepositoryMockHasRecoveryCodeExpectation {
+	if mmHasRecoveryCode.mock.funcHasRecoveryCode != nil {
+		mmHasRecoveryCode.mock.t.Fatalf("MfaRepositoryMock.HasRecoveryCode mock is already set by Set")
+	}
+
+	expectation := &MfaRepositoryMockHasRecoveryCodeExpectation{
+		mock:               mmHasRecoveryCode.mock,
+		params:             &MfaRepositoryMockHasRecoveryCodeParams{ctx, userID, codeHash},
+		expectationOrigins: MfaRepositoryMockHasRecoveryCodeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmHasRecoveryCode.expectations = append(mmHasRecoveryCode.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MfaRepository.HasRecoveryCode return parameters for the expectation previously defined by the When method
+func (e *MfaRepositoryMockHasRecoveryCodeExpectation) Then(b1 bool, err error) *MfaRepositoryMock {
+	e.results = &MfaRepositoryMockHasRecoveryCodeResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times MfaRepository.HasRecoveryCode should be invoked
+func (mmHasRecoveryCode *mMfaRepositoryMockHasRecoveryCode) Times(n uint64) *mMfaRepositoryMockHasRecoveryCode {
+	if n == 0 {
+		mmHasRecoveryCode.mock.t.Fatalf("Times of MfaRepositoryMock.HasRecoveryCode mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmHasRecoveryCode.expectedInvocations, n)
+	mmHasRecoveryCode.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmHasRecoveryCode
+}
+
+func (mmHasRecoveryCode *mMfaRepositoryMockHasRecoveryCode) invocationsDone() bool {
+	if len(mmHasRecoveryCode.expectations) == 0 && mmHasRecoveryCode.defaultExpectation == nil && mmHasRecoveryCode.mock.funcHasRecoveryCode == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmHasRecoveryCode.mock.afterHasRecoveryCodeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmHasRecoveryCode.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// HasRecoveryCode implements mm_repository.MfaRepository
+func (mmHasRecoveryCode *MfaRepositoryMock) HasRecoveryCode(ctx context.Context, userID string, codeHash string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmHasRecoveryCode.beforeHasRecoveryCodeCounter, 1)
+	defer mm_atomic.AddUint64(&mmHasRecoveryCode.afterHasRecoveryCodeCounter, 1)
+
+	mmHasRecoveryCode.t.Helper()
+
+	if mmHasRecoveryCode.inspectFuncHasRecoveryCode != nil {
+		mmHasRecoveryCode.inspectFuncHasRecoveryCode(ctx, userID, codeHash)
+	}
+
+	mm_params := MfaRepositoryMockHasRecoveryCodeParams{ctx, userID, codeHash}
+
+	// Record call args
+	mmHasRecoveryCode.HasRecoveryCodeMock.mutex.Lock()
+	mmHasRecoveryCode.HasRecoveryCodeMock.callArgs = append(mmHasRecoveryCode.HasRecoveryCodeMock.callArgs, &mm_params)
+	mmHasRecoveryCode.HasRecoveryCodeMock.mutex.Unlock()
+
+	for _, e := range mmHasRecoveryCode.HasRecoveryCodeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmHasRecoveryCode.HasRecoveryCodeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHasRecoveryCode.HasRecoveryCodeMock.defaultExpectation.Counter, 1)
+		mm_want := mmHasRecoveryCode.HasRecoveryCodeMock.defaultExpectation.params
+		mm_want_ptrs := mmHasRecoveryCode.HasRecoveryCodeMock.defaultExpectation.paramPtrs
+
+		mm_got := MfaRepositoryMockHasRecoveryCodeParams{ctx, userID, codeHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmHasRecoveryCode.t.Errorf("MfaRepositoryMock.HasRecoveryCode got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHasRecoveryCode.HasRecoveryCodeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmHasRecoveryCode.t.Errorf("MfaRepositoryMock.HasRecoveryCode got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHasRecoveryCode.HasRecoveryCodeMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.codeHash != nil && !minimock.Equal(*mm_want_ptrs.codeHash, mm_got.codeHash) {
+				mmHasRecoveryCode.t.Errorf("MfaRepositoryMock.HasRecoveryCode got unexpected parameter codeHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHasRecoveryCode.HasRecoveryCodeMock.defaultExpectation.expectationOrigins.originCodeHash, *mm_want_ptrs.codeHash, mm_got.codeHash, minimock.Diff(*mm_want_ptrs.codeHash, mm_got.codeHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHasRecoveryCode.t.Errorf("MfaRepositoryMock.HasRecoveryCode got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmHasRecoveryCode.HasRecoveryCodeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmHasRecoveryCode.HasRecoveryCodeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmHasRecoveryCode.t.Fatal("No results are set for the MfaRepositoryMock.HasRecoveryCode")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmHasRecoveryCode.funcHasRecoveryCode != nil {
+		return mmHasRecoveryCode.funcHasRecoveryCode(ctx, userID, codeHash)
+	}
+	mmHasRecoveryCode.t.Fatalf("Unexpected call to MfaRepositoryMock.HasRecoveryCode. %v %v %v", ctx, userID, codeHash)
+	return
+}
+
+// HasRecoveryCodeAfterCounter returns a count of finished MfaRepositoryMock.HasRecoveryCode invocations
+func (mmHasRecoveryCode *MfaRepositoryMock) HasRecoveryCodeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHasRecoveryCode.afterHasRecoveryCodeCounter)
+}
+
+// HasRecoveryCodeBeforeCounter returns a count of MfaRepositoryMock.HasRecoveryCode invocations
+func (mmHasRecoveryCode *MfaRepositoryMock) HasRecoveryCodeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHasRecoveryCode.beforeHasRecoveryCodeCounter)
+}
+
+// Calls returns a list of arguments used in each call to MfaRepositoryMock.HasRecoveryCode.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmHasRecoveryCode *mMfaRepositoryMockHasRecoveryCode) Calls() []*MfaRepositoryMockHasRecoveryCodeParams {
+	mmHasRecoveryCode.mutex.RLock()
+
+	argCopy := make([]*MfaRepositoryMockHasRecoveryCodeParams, len(mmHasRecoveryCode.callArgs))
+	copy(argCopy, mmHasRecoveryCode.callArgs)
+
+	mmHasRecoveryCode.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockHasRecoveryCodeDone returns true if the count of the HasRecoveryCode invocations corresponds
+// the number of defined expectations
+func (m *MfaRepositoryMock) MinimockHasRecoveryCodeDone() bool {
+	if m.HasRecoveryCodeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.HasRecoveryCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.HasRecoveryCodeMock.invocationsDone()
+}
+
+// MinimockHasRecoveryCodeInspect logs each unmet expectation
+func (m *MfaRepositoryMock) MinimockHasRecoveryCodeInspect() {
+	for _, e := range m.HasRecoveryCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MfaRepositoryMock.HasRecoveryCode at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterHasRecoveryCodeCounter := mm_atomic.LoadUint64(&m.afterHasRecoveryCodeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HasRecoveryCodeMock.defaultExpectation != nil && afterHasRecoveryCodeCounter < 1 {
+		if m.HasRecoveryCodeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MfaRepositoryMock.HasRecoveryCode at\n%s", m.HasRecoveryCodeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MfaRepositoryMock.HasRecoveryCode at\n%s with params: %#v", m.HasRecoveryCodeMock.defaultExpectation.expectationOrigins.origin, *m.HasRecoveryCodeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHasRecoveryCode != nil && afterHasRecoveryCodeCounter < 1 {
+		m.t.Errorf("Expected call to MfaRepositoryMock.HasRecoveryCode at\n%s", m.funcHasRecoveryCodeOrigin)
+	}
+
+	if !m.HasRecoveryCodeMock.invocationsDone() && afterHasRecoveryCodeCounter > 0 {
+		m.t.Errorf("Expected %d calls to MfaRepositoryMock.HasRecoveryCode at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.HasRecoveryCodeMock.expectedInvocations), m.HasRecoveryCodeMock.expectedInvocationsOrigin, afterHasRecoveryCodeCounter)
+	}
+}
+
+type mMfaRepositoryMockReplaceRecoveryCodes struct {
+	optional           bool
+	mock               *MfaRepositoryMock
+	defaultExpectation *MfaRepositoryMockReplaceRecoveryCodesExpectation
+	expectations       []*MfaRepositoryMockReplaceRecoveryCodesExpectation
+
+	callArgs []*MfaRepositoryMockReplaceRecoveryCodesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MfaRepositoryMockReplaceRecoveryCodesExpectation specifies expectation struct of the MfaRepository.ReplaceRecoveryCodes
+type MfaRepositoryMockReplaceRecoveryCodesExpectation struct {
+	mock               *MfaRepositoryMock
+	params             *MfaRepositoryMockReplaceRecoveryCodesParams
+	paramPtrs          *MfaRepositoryMockReplaceRecoveryCodesParamPtrs
+	expectationOrigins MfaRepositoryMockReplaceRecoveryCodesExpectationOrigins
+	results            *MfaRepositoryMockReplaceRecoveryCodesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MfaRepositoryMockReplaceRecoveryCodesParams contains parameters of the MfaRepository.ReplaceRecoveryCodes
+type MfaRepositoryMockReplaceRecoveryCodesParams struct {
+	ctx        context.Context
+	userID     string
+	codeHashes []string
+}
+
+// MfaRepositoryMockReplaceRecoveryCodesParamPtrs contains pointers to parameters of the MfaRepository.ReplaceRecoveryCodes
+type MfaRepositoryMockReplaceRecoveryCodesParamPtrs struct {
+	ctx        *context.Context
+	userID     *string
+	codeHashes *[]string
+}
+
+// MfaRepositoryMockReplaceRecoveryCodesResults contains results of the MfaRepository.ReplaceRecoveryCodes
+type MfaRepositoryMockReplaceRecoveryCodesResults struct {
+	err error
+}
+
+// MfaRepositoryMockReplaceRecoveryCodesOrigins contains origins of expectations of the MfaRepository.ReplaceRecoveryCodes
+type MfaRepositoryMockReplaceRecoveryCodesExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUserID     string
+	originCodeHashes string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReplaceRecoveryCodes *mMfaRepositoryMockReplaceRecoveryCodes) Optional() *mMfaRepositoryMockReplaceRecoveryCodes {
+	mmReplaceRecoveryCodes.optional = true
+	return mmReplaceRecoveryCodes
+}
+
+// Expect sets up expected params for MfaRepository.ReplaceRecoveryCodes
+func (mmReplaceRecoveryCodes *mMfaRepositoryMockReplaceRecoveryCodes) Expect(ctx context.Context, userID string, codeHashes []string) *mMfaRepositoryMockReplaceRecoveryCodes {
+	if mmReplaceRecoveryCodes.mock.funcReplaceRecoveryCodes != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("MfaRepositoryMock.ReplaceRecoveryCodes mock is already set by Set")
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation == nil {
+		mmReplaceRecoveryCodes.defaultExpectation = &MfaRepositoryMockReplaceRecoveryCodesExpectation{}
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation.paramPtrs != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("MfaRepositoryMock.ReplaceRecoveryCodes mock is already set by ExpectParams functions")
+	}
+
+	mmReplaceRecoveryCodes.defaultExpectation.params = &MfaRepositoryMockReplaceRecoveryCodesParams{ctx, userID, codeHashes}
+	mmReplaceRecoveryCodes.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReplaceRecoveryCodes.expectations {
+		if minimock.Equal(e.params, mmReplaceRecoveryCodes.defaultExpectation.params) {
+			mmReplaceRecoveryCodes.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReplaceRecoveryCodes.defaultExpectation.params)
+		}
+	}
+
+	return mmReplaceRecoveryCodes
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MfaRepository.ReplaceRecoveryCodes
+func (mmReplaceRecoveryCodes *mMfaRepositoryMockReplaceRecoveryCodes) ExpectCtxParam1(ctx context.Context) *mMfaRepositoryMockReplaceRecoveryCodes {
+	if mmReplaceRecoveryCodes.mock.funcReplaceRecoveryCodes != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("MfaRepositoryMock.ReplaceRecoveryCodes mock is already set by Set")
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation == nil {
+		mmReplaceRecoveryCodes.defaultExpectation = &MfaRepositoryMockReplaceRecoveryCodesExpectation{}
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation.params != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("MfaRepositoryMock.ReplaceRecoveryCodes mock is already set by Expect")
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation.paramPtrs == nil {
+		mmReplaceRecoveryCodes.defaultExpectation.paramPtrs = &MfaRepositoryMockReplaceRecoveryCodesParamPtrs{}
+	}
+	mmReplaceRecoveryCodes.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReplaceRecoveryCodes.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReplaceRecoveryCodes
+}
+
+// ExpectUserIDParam2 sets up expected param userID for MfaRepository.ReplaceRecoveryCodes
+func (mmReplaceRecoveryCodes *mMfaRepositoryMockReplaceRecoveryCodes) ExpectUserIDParam2(userID string) *mMfaRepositoryMockReplaceRecoveryCodes {
+	if mmReplaceRecoveryCodes.mock.funcReplaceRecoveryCodes != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("MfaRepositoryMock.ReplaceRecoveryCodes mock is already set by Set")
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation == nil {
+		mmReplaceRecoveryCodes.defaultExpectation = &MfaRepositoryMockReplaceRecoveryCodesExpectation{}
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation.params != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("MfaRepositoryMock.ReplaceRecoveryCodes mock is already set by Expect")
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation.paramPtrs == nil {
+		mmReplaceRecoveryCodes.defaultExpectation.paramPtrs = &MfaRepositoryMockReplaceRecoveryCodesParamPtrs{}
+	}
+	mmReplaceRecoveryCodes.defaultExpectation.paramPtrs.userID = &userID
+	mmReplaceRecoveryCodes.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmReplaceRecoveryCodes
+}
+
+// ExpectCodeHashesParam3 sets up expected param codeHashes for MfaRepository.ReplaceRecoveryCodes
+func (mmReplaceRecoveryCodes *mMfaRepositoryMockReplaceRecoveryCodes) ExpectCodeHashesParam3(codeHashes []string) *mMfaRepositoryMockReplaceRecoveryCodes {
+	if mmReplaceRecoveryCodes.mock.funcReplaceRecoveryCodes != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("MfaRepositoryMock.ReplaceRecoveryCodes mock is already set by Set")
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation == nil {
+		mmReplaceRecoveryCodes.defaultExpectation = &MfaRepositoryMockReplaceRecoveryCodesExpectation{}
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation.params != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("MfaRepositoryMock.ReplaceRecoveryCodes mock is already set by Expect")
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation.paramPtrs == nil {
+		mmReplaceRecoveryCodes.defaultExpectation.paramPtrs = &MfaRepositoryMockReplaceRecoveryCodesParamPtrs{}
+	}
+	mmReplaceRecoveryCodes.defaultExpectation.paramPtrs.codeHashes = &codeHashes
+	mmReplaceRecoveryCodes.defaultExpectation.expectationOrigins.originCodeHashes = minimock.CallerInfo(1)
+
+	return mmReplaceRecoveryCodes
+}
+
+// Inspect accepts an inspector function that has same arguments as the MfaRepository.ReplaceRecoveryCodes
+func (mmReplaceRecoveryCodes *mMfaRepositoryMockReplaceRecoveryCodes) Inspect(f func(ctx context.Context, userID string, codeHashes []string)) *mMfaRepositoryMockReplaceRecoveryCodes {
+	if mmReplaceRecoveryCodes.mock.inspectFuncReplaceRecoveryCodes != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("Inspect function is already set for MfaRepositoryMock.ReplaceRecoveryCodes")
+	}
+
+	mmReplaceRecoveryCodes.mock.inspectFuncReplaceRecoveryCodes = f
+
+	return mmReplaceRecoveryCodes
+}
+
+// Return sets up results that will be returned by MfaRepository.ReplaceRecoveryCodes
+func (mmReplaceRecoveryCodes *mMfaRepositoryMockReplaceRecoveryCodes) Return(err error) *MfaRepositoryMock {
+	if mmReplaceRecoveryCodes.mock.funcReplaceRecoveryCodes != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("MfaRepositoryMock.ReplaceRecoveryCodes mock is already set by Set")
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation == nil {
+		mmReplaceRecoveryCodes.defaultExpectation = &MfaRepositoryMockReplaceRecoveryCodesExpectation{mock: mmReplaceRecoveryCodes.mock}
+	}
+	mmReplaceRecoveryCodes.defaultExpectation.results = &MfaRepositoryMockReplaceRecoveryCodesResults{err}
+	mmReplaceRecoveryCodes.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReplaceRecoveryCodes.mock
+}
+
+// Set uses given function f to mock the MfaRepository.ReplaceRecoveryCodes method
+func (mmReplaceRecoveryCodes *mMfaRepositoryMockReplaceRecoveryCodes) Set(f func(ctx context.Context, userID string, codeHashes []string) (err error)) *MfaRepositoryMock {
+	if mmReplaceRecoveryCodes.defaultExpectation != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("Default expectation is already set for the MfaRepository.ReplaceRecoveryCodes method")
+	}
+
+	if len(mmReplaceRecoveryCodes.expectations) > 0 {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("Some expectations are already set for the MfaRepository.ReplaceRecoveryCodes method")
+	}
+
+	mmReplaceRecoveryCodes.mock.funcReplaceRecoveryCodes = f
+	mmReplaceRecoveryCodes.mock.funcReplaceRecoveryCodesOrigin = minimock.CallerInfo(1)
+	return mmReplaceRecoveryCodes.mock
+}
+
+// When sets expectation for the MfaRepository.ReplaceRecoveryCodes which will trigger the result defined by the following
+// Then helper
+func (mmReplaceRecoveryCodes *mMfaRepositoryMockReplaceRecoveryCodes) When(ctx context.Context, userID string, codeHashes []string) *MfaRepositoryMockReplaceRecoveryCodesExpectation {
+	if mmReplaceRecoveryCodes.mock.funcReplaceRecoveryCodes != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("MfaRepositoryMock.ReplaceRecoveryCodes mock is already set by Set")
+	}
+
+	expectation := &MfaRepositoryMockReplaceRecoveryCodesExpectation{
+		mock:               mmReplaceRecoveryCodes.mock,
+		params:             &MfaRepositoryMockReplaceRecoveryCodesParams{ctx, userID, codeHashes},
+		expectationOrigins: MfaRepositoryMockReplaceRecoveryCodesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReplaceRecoveryCodes.expectations = append(mmReplaceRecoveryCodes.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MfaRepository.ReplaceRecoveryCodes return parameters for the expectation previously defined by the When method
+func (e *MfaRepositoryMockReplaceRecoveryCodesExpectation) Then(err error) *MfaRepositoryMock {
+	e.results = &MfaRepositoryMockReplaceRecoveryCodesResults{err}
+	return e.mock
+}
+
+// Times sets number of times MfaRepository.ReplaceRecoveryCodes should be invoked
+func (mmReplaceRecoveryCodes *mMfaRepositoryMockReplaceRecoveryCodes) Times(n uint64) *mMfaRepositoryMockReplaceRecoveryCodes {
+	if n == 0 {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("Times of MfaRepositoryMock.ReplaceRecoveryCodes mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReplaceRecoveryCodes.expectedInvocations, n)
+	mmReplaceRecoveryCodes.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReplaceRecoveryCodes
+}
+
+func (mmReplaceRecoveryCodes *mMfaRepositoryMockReplaceRecoveryCodes) invocationsDone() bool {
+	if len(mmReplaceRecoveryCodes.expectations) == 0 && mmReplaceRecoveryCodes.defaultExpectation == nil && mmReplaceRecoveryCodes.mock.funcReplaceRecoveryCodes == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReplaceRecoveryCodes.mock.afterReplaceRecoveryCodesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReplaceRecoveryCodes.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReplaceRecoveryCodes implements mm_repository.MfaRepository
+func (mmReplaceRecoveryCodes *MfaRepositoryMock) ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) (err error) {
+	mm_atomic.AddUint64(&mmReplaceRecoveryCodes.beforeReplaceRecoveryCodesCounter, 1)
+	defer mm_atomic.AddUint64(&mmReplaceRecoveryCodes.afterReplaceRecoveryCodesCounter, 1)
+
+	mmReplaceRecoveryCodes.t.Helper()
+
+	if mmReplaceRecoveryCodes.inspectFuncReplaceRecoveryCodes != nil {
+		mmReplaceRecoveryCodes.inspectFuncReplaceRecoveryCodes(ctx, userID, codeHashes)
+	}
+
+	mm_params := MfaRepositoryMockReplaceRecoveryCodesParams{ctx, userID, codeHashes}
+
+	// Record call args
+	mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.mutex.Lock()
+	mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.callArgs = append(mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.callArgs, &mm_params)
+	mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.mutex.Unlock()
+
+	for _, e := range mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.defaultExpectation.Counter, 1)
+		mm_want := mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.defaultExpectation.params
+		mm_want_ptrs := mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.defaultExpectation.paramPtrs
+
+		mm_got := MfaRepositoryMockReplaceRecoveryCodesParams{ctx, userID, codeHashes}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReplaceRecoveryCodes.t.Errorf("MfaRepositoryMock.ReplaceRecoveryCodes got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmReplaceRecoveryCodes.t.Errorf("MfaRepositoryMock.ReplaceRecoveryCodes got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.codeHashes != nil && !minimock.Equal(*mm_want_ptrs.codeHashes, mm_got.codeHashes) {
+				mmReplaceRecoveryCodes.t.Errorf("MfaRepositoryMock.ReplaceRecoveryCodes got unexpected parameter codeHashes, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.defaultExpectation.expectationOrigins.originCodeHashes, *mm_want_ptrs.codeHashes, mm_got.codeHashes, minimock.Diff(*mm_want_ptrs.codeHashes, mm_got.codeHashes))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReplaceRecoveryCodes.t.Errorf("MfaRepositoryMock.ReplaceRecoveryCodes got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReplaceRecoveryCodes.t.Fatal("No results are set for the MfaRepositoryMock.ReplaceRecoveryCodes")
+		}
+		return (*mm_results).err
+	}
+	if mmReplaceRecoveryCodes.funcReplaceRecoveryCodes != nil {
+		return mmReplaceRecoveryCodes.funcReplaceRecoveryCodes(ctx, userID, codeHashes)
+	}
+	mmReplaceRecoveryCodes.t.Fatalf("Unexpected call to MfaRepositoryMock.ReplaceRecoveryCodes. %v %v %v", ctx, userID, codeHashes)
+	return
+}
+
+// ReplaceRecoveryCodesAfterCounter returns a count of finished MfaRepositoryMock.ReplaceRecoveryCodes invocations
+func (mmReplaceRecoveryCodes *MfaRepositoryMock) ReplaceRecoveryCodesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReplaceRecoveryCodes.afterReplaceRecoveryCodesCounter)
+}
+
+// ReplaceRecoveryCodesBeforeCounter returns a count of MfaRepositoryMock.ReplaceRecoveryCodes invocations
+func (mmReplaceRecoveryCodes *MfaRepositoryMock) ReplaceRecoveryCodesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReplaceRecoveryCodes.beforeReplaceRecoveryCodesCounter)
+}
+
+// Calls returns a list of arguments used in each call to MfaRepositoryMock.ReplaceRecoveryCodes.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReplaceRecoveryCodes *mMfaRepositoryMockReplaceRecoveryCodes) Calls() []*MfaRepositoryMockReplaceRecoveryCodesParams {
+	mmReplaceRecoveryCodes.mutex.RLock()
+
+	argCopy := make([]*MfaRepositoryMockReplaceRecoveryCodesParams, len(mmReplaceRecoveryCodes.callArgs))
+	copy(argCopy, mmReplaceRecoveryCodes.callArgs)
+
+	mmReplaceRecoveryCodes.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReplaceRecoveryCodesDone returns true if the count of the ReplaceRecoveryCodes invocations corresponds
+// the number of defined expectations
+func (m *MfaRepositoryMock) MinimockReplaceRecoveryCodesDone() bool {
+	if m.ReplaceRecoveryCodesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReplaceRecoveryCodesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReplaceRecoveryCodesMock.invocationsDone()
+}
+
+// MinimockReplaceRecoveryCodesInspect logs each unmet expectation
+func (m *MfaRepositoryMock) MinimockReplaceRecoveryCodesInspect() {
+	for _, e := range m.ReplaceRecoveryCodesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MfaRepositoryMock.ReplaceRecoveryCodes at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReplaceRecoveryCodesCounter := mm_atomic.LoadUint64(&m.afterReplaceRecoveryCodesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReplaceRecoveryCodesMock.defaultExpectation != nil && afterReplaceRecoveryCodesCounter < 1 {
+		if m.ReplaceRecoveryCodesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MfaRepositoryMock.ReplaceRecoveryCodes at\n%s", m.ReplaceRecoveryCodesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MfaRepositoryMock.ReplaceRecoveryCodes at\n%s with params: %#v", m.ReplaceRecoveryCodesMock.defaultExpectation.expectationOrigins.origin, *m.ReplaceRecoveryCodesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReplaceRecoveryCodes != nil && afterReplaceRecoveryCodesCounter < 1 {
+		m.t.Errorf("Expected call to MfaRepositoryMock.ReplaceRecoveryCodes at\n%s", m.funcReplaceRecoveryCodesOrigin)
+	}
+
+	if !m.ReplaceRecoveryCodesMock.invocationsDone() && afterReplaceRecoveryCodesCounter > 0 {
+		m.t.Errorf("Expected %d calls to MfaRepositoryMock.ReplaceRecoveryCodes at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReplaceRecoveryCodesMock.expectedInvocations), m.ReplaceRecoveryCodesMock.expectedInvocationsOrigin, afterReplaceRecoveryCodesCounter)
+	}
+}
+
+type mMfaRepositoryMockSaveTotp struct {
+	optional           bool
+	mock               *MfaRepositoryMock
+	defaultExpectation *MfaRepositoryMockSaveTotpExpectation
+	expectations       []*MfaRepositoryMockSaveTotpExpectation
+
+	callArgs []*MfaRepositoryMockSaveTotpParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MfaRepositoryMockSaveTotpExpectation specifies expectation struct of the MfaRepository.SaveTotp
+type MfaRepositoryMockSaveTotpExpectation struct {
+	mock               *MfaRepositoryMock
+	params             *MfaRepositoryMockSaveTotpParams
+	paramPtrs          *MfaRepositoryMockSaveTotpParamPtrs
+	expectationOrigins MfaRepositoryMockSaveTotpExpectationOrigins
+	results            *MfaRepositoryMockSaveTotpResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MfaRepositoryMockSaveTotpParams contains parameters of the MfaRepository.SaveTotp
+type MfaRepositoryMockSaveTotpParams struct {
+	ctx    context.Context
+	userID string
+	secret string
+}
+
+// MfaRepositoryMockSaveTotpParamPtrs contains pointers to parameters of the MfaRepository.SaveTotp
+type MfaRepositoryMockSaveTotpParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+	secret *string
+}
+
+// MfaRepositoryMockSaveTotpResults contains results of the MfaRepository.SaveTotp
+type MfaRepositoryMockSaveTotpResults struct {
+	err error
+}
+
+// MfaRepositoryMockSaveTotpOrigins contains origins of expectations of the MfaRepository.SaveTotp
+type MfaRepositoryMockSaveTotpExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originSecret string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveTotp *mMfaRepositoryMockSaveTotp) Optional() *mMfaRepositoryMockSaveTotp {
+	mmSaveTotp.optional = true
+	return mmSaveTotp
+}
+
+// Expect sets up expected params for MfaRepository.SaveTotp
+func (mmSaveTotp *mMfaRepositoryMockSaveTotp) Expect(ctx context.Context, userID string, secret string) *mMfaRepositoryMockSaveTotp {
+	if mmSaveTotp.mock.funcSaveTotp != nil {
+		mmSaveTotp.mock.t.Fatalf("MfaRepositoryMock.SaveTotp mock is already set by Set")
+	}
+
+	if mmSaveTotp.defaultExpectation == nil {
+		mmSaveTotp.defaultExpectation = &MfaRepositoryMockSaveTotpExpectation{}
+	}
+
+	if mmSaveTotp.defaultExpectation.paramPtrs != nil {
+		mmSaveTotp.mock.t.Fatalf("MfaRepositoryMock.SaveTotp mock is already set by ExpectParams functions")
+	}
+
+	mmSaveTotp.defaultExpectation.params = &MfaRepositoryMockSaveTotpParams{ctx, userID, secret}
+	mmSaveTotp.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveTotp.expectations {
+		if minimock.Equal(e.params, mmSaveTotp.defaultExpectation.params) {
+			mmSaveTotp.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveTotp.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveTotp
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MfaRepository.SaveTotp
+func (mmSaveTotp *mMfaRepositoryMockSaveTotp) ExpectCtxParam1(ctx context.Context) *mMfaRepositoryMockSaveTotp {
+	if mmSaveTotp.mock.funcSaveTotp != nil {
+		mmSaveTotp.mock.t.Fatalf("MfaRepositoryMock.SaveTotp mock is already set by Set")
+	}
+
+	if mmSaveTotp.defaultExpectation == nil {
+		mmSaveTotp.defaultExpectation = &MfaRepositoryMockSaveTotpExpectation{}
+	}
+
+	if mmSaveTotp.defaultExpectation.params != nil {
+		mmSaveTotp.mock.t.Fatalf("MfaRepositoryMock.SaveTotp mock is already set by Expect")
+	}
+
+	if mmSaveTotp.defaultExpectation.paramPtrs == nil {
+		mmSaveTotp.defaultExpectation.paramPtrs = &MfaRepositoryMockSaveTotpParamPtrs{}
+	}
+	mmSaveTotp.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveTotp.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveTotp
+}
+
+// ExpectUserIDParam2 sets up expected param userID for MfaRepository.SaveTotp
+func (mmSaveTotp *mMfaRepositoryMockSaveTotp) ExpectUserIDParam2(userID string) *mMfaRepositoryMockSaveTotp {
+	if mmSaveTotp.mock.funcSaveTotp != nil {
+		mmSaveTotp.mock.t.Fatalf("MfaRepositoryMock.SaveTotp mock is already set by Set")
+	}
+
+	if mmSaveTotp.defaultExpectation == nil {
+		mmSaveTotp.defaultExpectation = &MfaRepositoryMockSaveTotpExpectation{}
+	}
+
+	if mmSaveTotp.defaultExpectation.params != nil {
+		mmSaveTotp.mock.t.Fatalf("MfaRepositoryMock.SaveTotp mock is already set by Expect")
+	}
+
+	if mmSaveTotp.defaultExpectation.paramPtrs == nil {
+		mmSaveTotp.defaultExpectation.paramPtrs = &MfaRepositoryMockSaveTotpParamPtrs{}
+	}
+	mmSaveTotp.defaultExpectation.paramPtrs.userID = &userID
+	mmSaveTotp.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmSaveTotp
+}
+
+// ExpectSecretParam3 sets up expected param secret for MfaRepository.SaveTotp
+func (mmSaveTotp *mMfaRepositoryMockSaveTotp) ExpectSecretParam3(secret string) *mMfaRepositoryMockSaveTotp {
+	if mmSaveTotp.mock.funcSaveTotp != nil {
+		mmSaveTotp.mock.t.Fatalf("MfaRepositoryMock.SaveTotp mock is already set by Set")
+	}
+
+	if mmSaveTotp.defaultExpectation == nil {
+		mmSaveTotp.defaultExpectation = &MfaRepositoryMockSaveTotpExpectation{}
+	}
+
+	if mmSaveTotp.defaultExpectation.params != nil {
+		mmSaveTotp.mock.t.Fatalf("MfaRepositoryMock.SaveTotp mock is already set by Expect")
+	}
+
+	if mmSaveTotp.defaultExpectation.paramPtrs == nil {
+		mmSaveTotp.defaultExpectation.paramPtrs = &MfaRepositoryMockSaveTotpParamPtrs{}
+	}
+	mmSaveTotp.defaultExpectation.paramPtrs.secret = &secret
+	mmSaveTotp.defaultExpectation.expectationOrigins.originSecret = minimock.CallerInfo(1)
+
+	return mmSaveTotp
+}
+
+// Inspect accepts an inspector function that has same arguments as the MfaRepository.SaveTotp
+func (mmSaveTotp *mMfaRepositoryMockSaveTotp) Inspect(f func(ctx context.Context, userID string, secret string)) *mMfaRepositoryMockSaveTotp {
+	if mmSaveTotp.mock.inspectFuncSaveTotp != nil {
+		mmSaveTotp.mock.t.Fatalf("Inspect function is already set for MfaRepositoryMock.SaveTotp")
+	}
+
+	mmSaveTotp.mock.inspectFuncSaveTotp = f
+
+	return mmSaveTotp
+}
+
+// Return sets up results that will be returned by MfaRepository.SaveTotp
+func (mmSaveTotp *mMfaRepositoryMockSaveTotp) Return(err error) *MfaRepositoryMock {
+	if mmSaveTotp.mock.funcSaveTotp != nil {
+		mmSaveTotp.mock.t.Fatalf("MfaRepositoryMock.SaveTotp mock is already set by Set")
+	}
+
+	if mmSaveTotp.defaultExpectation == nil {
+		mmSaveTotp.defaultExpectation = &MfaRepositoryMockSaveTotpExpectation{mock: mmSaveTotp.mock}
+	}
+	mmSaveTotp.defaultExpectation.results = &MfaRepositoryMockSaveTotpResults{err}
+	mmSaveTotp.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveTotp.mock
+}
+
+// Set uses given function f to mock the MfaRepository.SaveTotp method
+func (mmSaveTotp *mMfaRepositoryMockSaveTotp) Set(f func(ctx context.Context, userID string, secret string) (err error)) *MfaRepositoryMock {
+	if mmSaveTotp.defaultExpectation != nil {
+		mmSaveTotp.mock.t.Fatalf("Default expectation is already set for the MfaRepository.SaveTotp method")
+	}
+
+	if len(mmSaveTotp.expectations) > 0 {
+		mmSaveTotp.mock.t.Fatalf("Some expectations are already set for the MfaRepository.SaveTotp method")
+	}
+
+	mmSaveTotp.mock.funcSaveTotp = f
+	mmSaveTotp.mock.funcSaveTotpOrigin = minimock.CallerInfo(1)
+	return mmSaveTotp.mock
+}
+
+// When sets expectation for the MfaRepository.SaveTotp which will trigger the result defined by the following
+// Then helper
+func (mmSaveTotp *mMfaRepositoryMockSaveTotp) When(ctx context.Context, userID string, secret string) *MfaRepositoryMockSaveTotpExpectation {
+	if mmSaveTotp.mock.funcSaveTotp != nil {
+		mmSaveTotp.mock.t.Fatalf("MfaRepositoryMock.SaveTotp mock is already set by Set")
+	}
+
+	expectation := &MfaRepositoryMockSaveTotpExpectation{
+		mock:               mmSaveTotp.mock,
+		params:             &MfaRepositoryMockSaveTotpParams{ctx, userID, secret},
+		expectationOrigins: MfaRepositoryMockSaveTotpExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveTotp.expectations = append(mmSaveTotp.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MfaRepository.SaveTotp return parameters for the expectation previously defined by the When method
+func (e *MfaRepositoryMockSaveTotpExpectation) Then(err error) *MfaRepositoryMock {
+	e.results = &MfaRepositoryMockSaveTotpResults{err}
+	return e.mock
+}
+
+// Times sets number of times MfaRepository.SaveTotp should be invoked
+func (mmSaveTotp *mMfaRepositoryMockSaveTotp) Times(n uint64) *mMfaRepositoryMockSaveTotp {
+	if n == 0 {
+		mmSaveTotp.mock.t.Fatalf("Times of MfaRepositoryMock.SaveTotp mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveTotp.expectedInvocations, n)
+	mmSaveTotp.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveTotp
+}
+
+func (mmSaveTotp *mMfaRepositoryMockSaveTotp) invocationsDone() bool {
+	if len(mmSaveTotp.expectations) == 0 && mmSaveTotp.defaultExpectation == nil && mmSaveTotp.mock.funcSaveTotp == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveTotp.mock.afterSaveTotpCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveTotp.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveTotp implements mm_repository.MfaRepository
+func (mmSaveTotp *MfaRepositoryMock) SaveTotp(ctx context.Context, userID string, secret string) (err error) {
+	mm_atomic.AddUint64(&mmSaveTotp.beforeSaveTotpCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveTotp.afterSaveTotpCounter, 1)
+
+	mmSaveTotp.t.Helper()
+
+	if mmSaveTotp.inspectFuncSaveTotp != nil {
+		mmSaveTotp.inspectFuncSaveTotp(ctx, userID, secret)
+	}
+
+	mm_params := MfaRepositoryMockSaveTotpParams{ctx, userID, secret}
+
+	// Record call args
+	mmSaveTotp.SaveTotpMock.mutex.Lock()
+	mmSaveTotp.SaveTotpMock.callArgs = append(mmSaveTotp.SaveTotpMock.callArgs, &mm_params)
+	mmSaveTotp.SaveTotpMock.mutex.Unlock()
+
+	for _, e := range mmSaveTotp.SaveTotpMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveTotp.SaveTotpMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveTotp.SaveTotpMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveTotp.SaveTotpMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveTotp.SaveTotpMock.defaultExpectation.paramPtrs
+
+		mm_got := MfaRepositoryMockSaveTotpParams{ctx, userID, secret}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveTotp.t.Errorf("MfaRepositoryMock.SaveTotp got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveTotp.SaveTotpMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSaveTotp.t.Errorf("MfaRepositoryMock.SaveTotp got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveTotp.SaveTotpMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.secret != nil && !minimock.Equal(*mm_want_ptrs.secret, mm_got.secret) {
+				mmSaveTotp.t.Errorf("MfaRepositoryMock.SaveTotp got unexpected parameter secret, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveTotp.SaveTotpMock.defaultExpectation.expectationOrigins.originSecret, *mm_want_ptrs.secret, mm_got.secret, minimock.Diff(*mm_want_ptrs.secret, mm_got.secret))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveTotp.t.Errorf("MfaRepositoryMock.SaveTotp got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveTotp.SaveTotpMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveTotp.SaveTotpMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveTotp.t.Fatal("No results are set for the MfaRepositoryMock.SaveTotp")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveTotp.funcSaveTotp != nil {
+		return mmSaveTotp.funcSaveTotp(ctx, userID, secret)
+	}
+	mmSaveTotp.t.Fatalf("Unexpected call to MfaRepositoryMock.SaveTotp. %v %v %v", ctx, userID, secret)
+	return
+}
+
+// SaveTotpAfterCounter returns a count of finished MfaRepositoryMock.SaveTotp invocations
+func (mmSaveTotp *MfaRepositoryMock) SaveTotpAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveTotp.afterSaveTotpCounter)
+}
+
+// SaveTotpBeforeCounter returns a count of MfaRepositoryMock.SaveTotp invocations
+func (mmSaveTotp *MfaRepositoryMock) SaveTotpBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveTotp.beforeSaveTotpCounter)
+}
+
+// Calls returns a list of arguments used in each call to MfaRepositoryMock.SaveTotp.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveTotp *mMfaRepositoryMockSaveTotp) Calls() []*MfaRepositoryMockSaveTotpParams {
+	mmSaveTotp.mutex.RLock()
+
+	argCopy := make([]*MfaRepositoryMockSaveTotpParams, len(mmSaveTotp.callArgs))
+	copy(argCopy, mmSaveTotp.callArgs)
+
+	mmSaveTotp.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveTotpDone returns true if the count of the SaveTotp invocations corresponds
+// the number of defined expectations
+func (m *MfaRepositoryMock) MinimockSaveTotpDone() bool {
+	if m.SaveTotpMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveTotpMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveTotpMock.invocationsDone()
+}
+
+// MinimockSaveTotpInspect logs each unmet expectation
+func (m *MfaRepositoryMock) MinimockSaveTotpInspect() {
+	for _, e := range m.SaveTotpMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MfaRepositoryMock.SaveTotp at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveTotpCounter := mm_atomic.LoadUint64(&m.afterSaveTotpCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveTotpMock.defaultExpectation != nil && afterSaveTotpCounter < 1 {
+		if m.SaveTotpMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MfaRepositoryMock.SaveTotp at\n%s", m.SaveTotpMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MfaRepositoryMock.SaveTotp at\n%s with params: %#v", m.SaveTotpMock.defaultExpectation.expectationOrigins.origin, *m.SaveTotpMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveTotp != nil && afterSaveTotpCounter < 1 {
+		m.t.Errorf("Expected call to MfaRepositoryMock.SaveTotp at\n%s", m.funcSaveTotpOrigin)
+	}
+
+	if !m.SaveTotpMock.invocationsDone() && afterSaveTotpCounter > 0 {
+		m.t.Errorf("Expected %d calls to MfaRepositoryMock.SaveTotp at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveTotpMock.expectedInvocations), m.SaveTotpMock.expectedInvocationsOrigin, afterSaveTotpCounter)
+	}
+}
+
+type mMfaRepositoryMockUseRecoveryCode struct {
+	optional           bool
+	mock               *MfaRepositoryMock
+	defaultExpectation *MfaRepositoryMockUseRecoveryCodeExpectation
+	expectations       []*MfaRepositoryMockUseRecoveryCodeExpectation
+
+	callArgs []*MfaRepositoryMockUseRecoveryCodeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MfaRepositoryMockUseRecoveryCodeExpectation specifies expectation struct of the MfaRepository.UseRecoveryCode
+type MfaRepositoryMockUseRecoveryCodeExpectation struct {
+	mock               *MfaRepositoryMock
+	params             *MfaRepositoryMockUseRecoveryCodeParams
+	paramPtrs          *MfaRepositoryMockUseRecoveryCodeParamPtrs
+	expectationOrigins MfaRepositoryMockUseRecoveryCodeExpectationOrigins
+	results            *MfaRepositoryMockUseRecoveryCodeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MfaRepositoryMockUseRecoveryCodeParams contains parameters of the MfaRepository.UseRecoveryCode
+type MfaRepositoryMockUseRecoveryCodeParams struct {
+	ctx      context.Context
+	userID   string
+	codeHash string
+}
+
+// MfaRepositoryMockUseRecoveryCodeParamPtrs contains pointers to parameters of the MfaRepository.UseRecoveryCode
+type MfaRepositoryMockUseRecoveryCodeParamPtrs struct {
+	ctx      *context.Context
+	userID   *string
+	codeHash *string
+}
+
+// MfaRepositoryMockUseRecoveryCodeResults contains results of the MfaRepository.UseRecoveryCode
+type MfaRepositoryMockUseRecoveryCodeResults struct {
+	b1  bool
+	err error
+}
+
+// MfaRepositoryMockUseRecoveryCodeOrigins contains origins of expectations of the MfaRepository.UseRecoveryCode
+type MfaRepositoryMockUseRecoveryCodeExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originCodeHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUseRecoveryCode *mMfaRepositoryMockUseRecoveryCode) Optional() *mMfaRepositoryMockUseRecoveryCode {
+	mmUseRecoveryCode.optional = true
+	return mmUseRecoveryCode
+}
+
+// Expect sets up expected params for MfaRepository.UseRecoveryCode
+func (mmUseRecoveryCode *mMfaRepositoryMockUseRecoveryCode) Expect(ctx context.Context, userID string, codeHash string) *mMfaRepositoryMockUseRecoveryCode {
+	if mmUseRecoveryCode.mock.funcUseRecoveryCode != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("MfaRepositoryMock.UseRecoveryCode mock is already set by Set")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation == nil {
+		mmUseRecoveryCode.defaultExpectation = &MfaRepositoryMockUseRecoveryCodeExpectation{}
+	}
+
+	if mmUseRecoveryCode.defaultExpectation.paramPtrs != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("MfaRepositoryMock.UseRecoveryCode mock is already set by ExpectParams functions")
+	}
+
+	mmUseRecoveryCode.defaultExpectation.params = &MfaRepositoryMockUseRecoveryCodeParams{ctx, userID, codeHash}
+	mmUseRecoveryCode.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUseRecoveryCode.expectations {
+		if minimock.Equal(e.params, mmUseRecoveryCode.defaultExpectation.params) {
+			mmUseRecoveryCode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUseRecoveryCode.defaultExpectation.params)
+		}
+	}
+
+	return mmUseRecoveryCode
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MfaRepository.UseRecoveryCode
+func (mmUseRecoveryCode *mMfaRepositoryMockUseRecoveryCode) ExpectCtxParam1(ctx context.Context) *mMfaRepositoryMockUseRecoveryCode {
+	if mmUseRecoveryCode.mock.funcUseRecoveryCode != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("MfaRepositoryMock.UseRecoveryCode mock is already set by Set")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation == nil {
+		mmUseRecoveryCode.defaultExpectation = &MfaRepositoryMockUseRecoveryCodeExpectation{}
+	}
+
+	if mmUseRecoveryCode.defaultExpectation.params != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("MfaRepositoryMock.UseRecoveryCode mock is already set by Expect")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation.paramPtrs == nil {
+		mmUseRecoveryCode.defaultExpectation.paramPtrs = &MfaRepositoryMockUseRecoveryCodeParamPtrs{}
+	}
+	mmUseRecoveryCode.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUseRecoveryCode.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUseRecoveryCode
+}
+
+// ExpectUserIDParam2 sets up expected param userID for MfaRepository.UseRecoveryCode
+func (mmUseRecoveryCode *mMfaRepositoryMockUseRecoveryCode) ExpectUserIDParam2(userID string) *mMfaRepositoryMockUseRecoveryCode {
+	if mmUseRecoveryCode.mock.funcUseRecoveryCode != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("MfaRepositoryMock.UseRecoveryCode mock is already set by Set")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation == nil {
+		mmUseRecoveryCode.defaultExpectation = &MfaRepositoryMockUseRecoveryCodeExpectation{}
+	}
+
+	if mmUseRecoveryCode.defaultExpectation.params != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("MfaRepositoryMock.UseRecoveryCode mock is already set by Expect")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation.paramPtrs == nil {
+		mmUseRecoveryCode.defaultExpectation.paramPtrs = &MfaRepositoryMockUseRecoveryCodeParamPtrs{}
+	}
+	mmUseRecoveryCode.defaultExpectation.paramPtrs.userID = &userID
+	mmUseRecoveryCode.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUseRecoveryCode
+}
+
+// ExpectCodeHashParam3 sets up expected param codeHash for MfaRepository.UseRecoveryCode
+func (mmUseRecoveryCode *mMfaRepositoryMockUseRecoveryCode) ExpectCodeHashParam3(codeHash string) *mMfaRepositoryMockUseRecoveryCode {
+	if mmUseRecoveryCode.mock.funcUseRecoveryCode != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("MfaRepositoryMock.UseRecoveryCode mock is already set by Set")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation == nil {
+		mmUseRecoveryCode.defaultExpectation = &MfaRepositoryMockUseRecoveryCodeExpectation{}
+	}
+
+	if mmUseRecoveryCode.defaultExpectation.params != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("MfaRepositoryMock.UseRecoveryCode mock is already set by Expect")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation.paramPtrs == nil {
+		mmUseRecoveryCode.defaultExpectation.paramPtrs = &MfaRepositoryMockUseRecoveryCodeParamPtrs{}
+	}
+	mmUseRecoveryCode.defaultExpectation.paramPtrs.codeHash = &codeHash
+	mmUseRecoveryCode.defaultExpectation.expectationOrigins.originCodeHash = minimock.CallerInfo(1)
+
+	return mmUseRecoveryCode
+}
+
+// Inspect accepts an inspector function that has same arguments as the MfaRepository.UseRecoveryCode
+func (mmUseRecoveryCode *mMfaRepositoryMockUseRecoveryCode) Inspect(f func(ctx context.Context, userID string, codeHash string)) *mMfaRepositoryMockUseRecoveryCode {
+	if mmUseRecoveryCode.mock.inspectFuncUseRecoveryCode != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("Inspect function is already set for MfaRepositoryMock.UseRecoveryCode")
+	}
+
+	mmUseRecoveryCode.mock.inspectFuncUseRecoveryCode = f
+
+	return mmUseRecoveryCode
+}
+
+// Return sets up results that will be returned by MfaRepository.UseRecoveryCode
+func (mmUseRecoveryCode *mMfaRepositoryMockUseRecoveryCode) Return(b1 bool, err error) *MfaRepositoryMock {
+	if mmUseRecoveryCode.mock.funcUseRecoveryCode != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("MfaRepositoryMock.UseRecoveryCode mock is already set by Set")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation == nil {
+		mmUseRecoveryCode.defaultExpectation = &MfaRepositoryMockUseRecoveryCodeExpectation{mock: mmUseRecoveryCode.mock}
+	}
+	mmUseRecoveryCode.defaultExpectation.results = &MfaRepositoryMockUseRecoveryCodeResults{b1, err}
+	mmUseRecoveryCode.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUseRecoveryCode.mock
+}
+
+// Set uses given function f to mock the MfaRepository.UseRecoveryCode method
+func (mmUseRecoveryCode *mMfaRepositoryMockUseRecoveryCode) Set(f func(ctx context.Context, userID string, codeHash string) (b1 bool, err error)) *MfaRepositoryMock {
+	if mmUseRecoveryCode.defaultExpectation != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("Default expectation is already set for the MfaRepository.UseRecoveryCode method")
+	}
+
+	if len(mmUseRecoveryCode.expectations) > 0 {
+		mmUseRecoveryCode.mock.t.Fatalf("Some expectations are already set for the MfaRepository.UseRecoveryCode method")
+	}
+
+	mmUseRecoveryCode.mock.funcUseRecoveryCode = f
+	mmUseRecoveryCode.mock.funcUseRecoveryCodeOrigin = minimock.CallerInfo(1)
+	return mmUseRecoveryCode.mock
+}
+
+// When sets expectation for the MfaRepository.UseRecoveryCode which will trigger the result defined by the following
+// Then helper
+func (mmUseRecoveryCode *mMfaRepositoryMockUseRecoveryCode) When(ctx context.Context, userID string, codeHash string) *MfaRepositoryMockUseRecoveryCodeExpectation {
+	if mmUseRecoveryCode.mock.funcUseRecoveryCode != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("MfaRepositoryMock.UseRecoveryCode mock is already set by Set")
+	}
+
+	expectation := &MfaRepositoryMockUseRecoveryCodeExpectation{
+		mock:               mmUseRecoveryCode.mock,
+		params:             &MfaRepositoryMockUseRecoveryCodeParams{ctx, userID, codeHash},
+		expectationOrigins: MfaRepositoryMockUseRecoveryCodeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUseRecoveryCode.expectations = append(mmUseRecoveryCode.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MfaRepository.UseRecoveryCode return parameters for the expectation previously defined by the When method
+func (e *MfaRepositoryMockUseRecoveryCodeExpectation) Then(b1 bool, err error) *MfaRepositoryMock {
+	e.results = &MfaRepositoryMockUseRecoveryCodeResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times MfaRepository.UseRecoveryCode should be invoked
+func (mmUseRecoveryCode *mMfaRepositoryMockUseRecoveryCode) Times(n uint64) *mMfaRepositoryMockUseRecoveryCode {
+	if n == 0 {
+		mmUseRecoveryCode.mock.t.Fatalf("Times of MfaRepositoryMock.UseRecoveryCode mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUseRecoveryCode.expectedInvocations, n)
+	mmUseRecoveryCode.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUseRecoveryCode
+}
+
+func (mmUseRecoveryCode *mMfaRepositoryMockUseRecoveryCode) invocationsDone() bool {
+	if len(mmUseRecoveryCode.expectations) == 0 && mmUseRecoveryCode.defaultExpectation == nil && mmUseRecoveryCode.mock.funcUseRecoveryCode == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUseRecoveryCode.mock.afterUseRecoveryCodeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUseRecoveryCode.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UseRecoveryCode implements mm_repository.MfaRepository
+func (mmUseRecoveryCode *MfaRepositoryMock) UseRecoveryCode(ctx context.Context, userID string, codeHash string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmUseRecoveryCode.beforeUseRecoveryCodeCounter, 1)
+	defer mm_atomic.AddUint64(&mmUseRecoveryCode.afterUseRecoveryCodeCounter, 1)
+
+	mmUseRecoveryCode.t.Helper()
+
+	if mmUseRecoveryCode.inspectFuncUseRecoveryCode != nil {
+		mmUseRecoveryCode.inspectFuncUseRecoveryCode(ctx, userID, codeHash)
+	}
+
+	mm_params := MfaRepositoryMockUseRecoveryCodeParams{ctx, userID, codeHash}
+
+	// Record call args
+	mmUseRecoveryCode.UseRecoveryCodeMock.mutex.Lock()
+	mmUseRecoveryCode.UseRecoveryCodeMock.callArgs = append(mmUseRecoveryCode.UseRecoveryCodeMock.callArgs, &mm_params)
+	mmUseRecoveryCode.UseRecoveryCodeMock.mutex.Unlock()
+
+	for _, e := range mmUseRecoveryCode.UseRecoveryCodeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.Counter, 1)
+		mm_want := mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.params
+		mm_want_ptrs := mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.paramPtrs
+
+		mm_got := MfaRepositoryMockUseRecoveryCodeParams{ctx, userID, codeHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUseRecoveryCode.t.Errorf("MfaRepositoryMock.UseRecoveryCode got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUseRecoveryCode.t.Errorf("MfaRepositoryMock.UseRecoveryCode got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.codeHash != nil && !minimock.Equal(*mm_want_ptrs.codeHash, mm_got.codeHash) {
+				mmUseRecoveryCode.t.Errorf("MfaRepositoryMock.UseRecoveryCode got unexpected parameter codeHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.expectationOrigins.originCodeHash, *mm_want_ptrs.codeHash, mm_got.codeHash, minimock.Diff(*mm_want_ptrs.codeHash, mm_got.codeHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUseRecoveryCode.t.Errorf("MfaRepositoryMock.UseRecoveryCode got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUseRecoveryCode.t.Fatal("No results are set for the MfaRepositoryMock.UseRecoveryCode")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmUseRecoveryCode.funcUseRecoveryCode != nil {
+		return mmUseRecoveryCode.funcUseRecoveryCode(ctx, userID, codeHash)
+	}
+	mmUseRecoveryCode.t.Fatalf("Unexpected call to MfaRepositoryMock.UseRecoveryCode. %v %v %v", ctx, userID, codeHash)
+	return
+}
+
+// UseRecoveryCodeAfterCounter returns a count of finished MfaRepositoryMock.UseRecoveryCode invocations
+func (mmUseRecoveryCode *MfaRepositoryMock) UseRecoveryCodeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUseRecoveryCode.afterUseRecoveryCodeCounter)
+}
+
+// UseRecoveryCodeBeforeCounter returns a count of MfaRepositoryMock.UseRecoveryCode invocations
+func (mmUseRecoveryCode *MfaRepositoryMock) UseRecoveryCodeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUseRecoveryCode.beforeUseRecoveryCodeCounter)
+}
+
+// Calls returns a list of arguments used in each call to MfaRepositoryMock.UseRecoveryCode.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUseRecoveryCode *mMfaRepositoryMockUseRecoveryCode) Calls() []*MfaRepositoryMockUseRecoveryCodeParams {
+	mmUseRecoveryCode.mutex.RLock()
+
+	argCopy := make([]*MfaRepositoryMockUseRecoveryCodeParams, len(mmUseRecoveryCode.callArgs))
+	copy(argCopy, mmUseRecoveryCode.callArgs)
+
+	mmUseRecoveryCode.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUseRecoveryCodeDone returns true if the count of the UseRecoveryCode invocations corresponds
+// the number of defined expectations
+func (m *MfaRepositoryMock) MinimockUseRecoveryCodeDone() bool {
+	if m.UseRecoveryCodeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UseRecoveryCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UseRecoveryCodeMock.invocationsDone()
+}
+
+// MinimockUseRecoveryCodeInspect logs each unmet expectation
+func (m *MfaRepositoryMock) MinimockUseRecoveryCodeInspect() {
+	for _, e := range m.UseRecoveryCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MfaRepositoryMock.UseRecoveryCode at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUseRecoveryCodeCounter := mm_atomic.LoadUint64(&m.afterUseRecoveryCodeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UseRecoveryCodeMock.defaultExpectation != nil && afterUseRecoveryCodeCounter < 1 {
+		if m.UseRecoveryCodeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MfaRepositoryMock.UseRecoveryCode at\n%s", m.UseRecoveryCodeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MfaRepositoryMock.UseRecoveryCode at\n%s with params: %#v", m.UseRecoveryCodeMock.defaultExpectation.expectationOrigins.origin, *m.UseRecoveryCodeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUseRecoveryCode != nil && afterUseRecoveryCodeCounter < 1 {
+		m.t.Errorf("Expected call to MfaRepositoryMock.UseRecoveryCode at\n%s", m.funcUseRecoveryCodeOrigin)
+	}
+
+	if !m.UseRecoveryCodeMock.invocationsDone() && afterUseRecoveryCodeCounter > 0 {
+		m.t.Errorf("Expected %d calls to MfaRepositoryMock.UseRecoveryCode at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UseRecoveryCodeMock.expectedInvocations), m.UseRecoveryCodeMock.expectedInvocationsOrigin, afterUseRecoveryCodeCounter)
+	}
+}
+
+type mMfaRepositoryMockUseTotpStep struct {
+	optional           bool
+	mock               *MfaRepositoryMock
+	defaultExpectation *MfaRepositoryMockUseTotpStepExpectation
+	expectations       []*MfaRepositoryMockUseTotpStepExpectation
+
+	callArgs []*MfaRepositoryMockUseTotpStepParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MfaRepositoryMockUseTotpStepExpectation specifies expectation struct of the MfaRepository.UseTotpStep
+type MfaRepositoryMockUseTotpStepExpectation struct {
+	mock               *MfaRepositoryMock
+	params             *MfaRepositoryMockUseTotpStepParams
+	paramPtrs          *MfaRepositoryMockUseTotpStepParamPtrs
+	expectationOrigins MfaRepositoryMockUseTotpStepExpectationOrigins
+	results            *MfaRepositoryMockUseTotpStepResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MfaRepositoryMockUseTotpStepParams contains parameters of the MfaRepository.UseTotpStep
+type MfaRepositoryMockUseTotpStepParams struct {
+	ctx    context.Context
+	userID string
+	step   int64
+}
+
+// MfaRepositoryMockUseTotpStepParamPtrs contains pointers to parameters of the MfaRepository.UseTotpStep
+type MfaRepositoryMockUseTotpStepParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+	step   *int64
+}
+
+// MfaRepositoryMockUseTotpStepResults contains results of the MfaRepository.UseTotpStep
+type MfaRepositoryMockUseTotpStepResults struct {
+	b1  bool
+	err error
+}
+
+// MfaRepositoryMockUseTotpStepOrigins contains origins of expectations of the MfaRepository.UseTotpStep
+type MfaRepositoryMockUseTotpStepExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originStep   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUseTotpStep *mMfaRepositoryMockUseTotpStep) Optional() *mMfaRepositoryMockUseTotpStep {
+	mmUseTotpStep.optional = true
+	return mmUseTotpStep
+}
+
+// Expect sets up expected params for MfaRepository.UseTotpStep
+func (mmUseTotpStep *mMfaRepositoryMockUseTotpStep) Expect(ctx context.Context, userID string, step int64) *mMfaRepositoryMockUseTotpStep {
+	if mmUseTotpStep.mock.funcUseTotpStep != nil {
+		mmUseTotpStep.mock.t.Fatalf("MfaRepositoryMock.UseTotpStep mock is already set by Set")
+	}
+
+	if mmUseTotpStep.defaultExpectation == nil {
+		mmUseTotpStep.defaultExpectation = &MfaRepositoryMockUseTotpStepExpectation{}
+	}
+
+	if mmUseTotpStep.defaultExpectation.paramPtrs != nil {
+		mmUseTotpStep.mock.t.Fatalf("MfaRepositoryMock.UseTotpStep mock is already set by ExpectParams functions")
+	}
+
+	mmUseTotpStep.defaultExpectation.params = &MfaRepositoryMockUseTotpStepParams{ctx, userID, step}
+	mmUseTotpStep.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUseTotpStep.expectations {
+		if minimock.Equal(e.params, mmUseTotpStep.defaultExpectation.params) {
+			mmUseTotpStep.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUseTotpStep.defaultExpectation.params)
+		}
+	}
+
+	return mmUseTotpStep
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MfaRepository.UseTotpStep
+func (mmUseTotpStep *mMfaRepositoryMockUseTotpStep) ExpectCtxParam1(ctx context.Context) *mMfaRepositoryMockUseTotpStep {
+	if mmUseTotpStep.mock.funcUseTotpStep != nil {
+		mmUseTotpStep.mock.t.Fatalf("MfaRepositoryMock.UseTotpStep mock is already set by Set")
+	}
+
+	if mmUseTotpStep.defaultExpectation == nil {
+		mmUseTotpStep.defaultExpectation = &MfaRepositoryMockUseTotpStepExpectation{}
+	}
+
+	if mmUseTotpStep.defaultExpectation.params != nil {
+		mmUseTotpStep.mock.t.Fatalf("MfaRepositoryMock.UseTotpStep mock is already set by Expect")
+	}
+
+	if mmUseTotpStep.defaultExpectation.paramPtrs == nil {
+		mmUseTotpStep.defaultExpectation.paramPtrs = &MfaRepositoryMockUseTotpStepParamPtrs{}
+	}
+	mmUseTotpStep.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUseTotpStep.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUseTotpStep
+}
+
+// ExpectUserIDParam2 sets up expected param userID for MfaRepository.UseTotpStep
+func (mmUseTotpStep *mMfaRepositoryMockUseTotpStep) ExpectUserIDParam2(userID string) *mMfaRepositoryMockUseTotpStep {
+	if mmUseTotpStep.mock.funcUseTotpStep != nil {
+		mmUseTotpStep.mock.t.Fatalf("MfaRepositoryMock.UseTotpStep mock is already set by Set")
+	}
+
+	if mmUseTotpStep.defaultExpectation == nil {
+		mmUseTotpStep.defaultExpectation = &MfaRepositoryMockUseTotpStepExpectation{}
+	}
+
+	if mmUseTotpStep.defaultExpectation.params != nil {
+		mmUseTotpStep.mock.t.Fatalf("MfaRepositoryMock.UseTotpStep mock is already set by Expect")
+	}
+
+	if mmUseTotpStep.defaultExpectation.paramPtrs == nil {
+		mmUseTotpStep.defaultExpectation.paramPtrs = &MfaRepositoryMockUseTotpStepParamPtrs{}
+	}
+	mmUseTotpStep.defaultExpectation.paramPtrs.userID = &userID
+	mmUseTotpStep.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUseTotpStep
+}
+
+// ExpectStepParam3 sets up expected param step for MfaRepository.UseTotpStep
+func (mmUseTotpStep *mMfaRepositoryMockUseTotpStep) ExpectStepParam3(step int64) *mMfaRepositoryMockUseTotpStep {
+	if mmUseTotpStep.mock.funcUseTotpStep != nil {
+		mmUseTotpStep.mock.t.Fatalf("MfaRepositoryMock.UseTotpStep mock is already set by Set")
+	}
+
+	if mmUseTotpStep.defaultExpectation == nil {
+		mmUseTotpStep.defaultExpectation = &MfaRepositoryMockUseTotpStepExpectation{}
+	}
+
+	if mmUseTotpStep.defaultExpectation.params != nil {
+		mmUseTotpStep.mock.t.Fatalf("MfaRepositoryMock.UseTotpStep mock is already set by Expect")
+	}
+
+	if mmUseTotpStep.defaultExpectation.paramPtrs == nil {
+		mmUseTotpStep.defaultExpectation.paramPtrs = &MfaRepositoryMockUseTotpStepParamPtrs{}
+	}
+	mmUseTotpStep.defaultExpectation.paramPtrs.step = &step
+	mmUseTotpStep.defaultExpectation.expectationOrigins.originStep = minimock.CallerInfo(1)
+
+	return mmUseTotpStep
+}
+
+// Inspect accepts an inspector function that has same arguments as the MfaRepository.UseTotpStep
+func (mmUseTotpStep *mMfaRepositoryMockUseTotpStep) Inspect(f func(ctx context.Context, userID string, step int64)) *mMfaRepositoryMockUseTotpStep {
+	if mmUseTotpStep.mock.inspectFuncUseTotpStep != nil {
+		mmUseTotpStep.mock.t.Fatalf("Inspect function is already set for MfaRepositoryMock.UseTotpStep")
+	}
+
+	mmUseTotpStep.mock.inspectFuncUseTotpStep = f
+
+	return mmUseTotpStep
+}
+
+// Return sets up results that will be returned by MfaRepository.UseTotpStep
+func (mmUseTotpStep *mMfaRepositoryMockUseTotpStep) Return(b1 bool, err error) *MfaRepositoryMock {
+	if mmUseTotpStep.mock.funcUseTotpStep != nil {
+		mmUseTotpStep.mock.t.Fatalf("MfaRepositoryMock.UseTotpStep mock is already set by Set")
+	}
+
+	if mmUseTotpStep.defaultExpectation == nil {
+		mmUseTotpStep.defaultExpectation = &MfaRepositoryMockUseTotpStepExpectation{mock: mmUseTotpStep.mock}
+	}
+	mmUseTotpStep.defaultExpectation.results = &MfaRepositoryMockUseTotpStepResults{b1, err}
+	mmUseTotpStep.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUseTotpStep.mock
+}
+
+// Set uses given function f to mock the MfaRepository.UseTotpStep method
+func (mmUseTotpStep *mMfaRepositoryMockUseTotpStep) Set(f func(ctx context.Context, userID string, step int64) (b1 bool, err error)) *MfaRepositoryMock {
+	if mmUseTotpStep.defaultExpectation != nil {
+		mmUseTotpStep.mock.t.Fatalf("Default expectation is already set for the MfaRepository.UseTotpStep method")
+	}
+
+	if len(mmUseTotpStep.expectations) > 0 {
+		mmUseTotpStep.mock.t.Fatalf("Some expectations are already set for the MfaRepository.UseTotpStep method")
+	}
+
+	mmUseTotpStep.mock.funcUseTotpStep = f
+	mmUseTotpStep.mock.funcUseTotpStepOrigin = minimock.CallerInfo(1)
+	return mmUseTotpStep.mock
+}
+
+// When sets expectation for the MfaRepository.UseTotpStep which will trigger the result defined by the following
+// Then helper
+func (mmUseTotpStep *mMfaRepositoryMockUseTotpStep) When(ctx context.Context, userID string, step int64) *MfaRepositoryMockUseTotpStepExpectation {
+	if mmUseTotpStep.mock.funcUseTotpStep != nil {
+		mmUseTotpStep.mock.t.Fatalf("MfaRepositoryMock.UseTotpStep mock is already set by Set")
+	}
+
+	expectation := &MfaRepositoryMockUseTotpStepExpectation{
+		mock:               mmUseTotpStep.mock,
+		params:             &MfaRepositoryMockUseTotpStepParams{ctx, userID, step},
+		expectationOrigins: MfaRepositoryMockUseTotpStepExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUseTotpStep.expectations = append(mmUseTotpStep.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MfaRepository.UseTotpStep return parameters for the expectation previously defined by the When method
+func (e *MfaRepositoryMockUseTotpStepExpectation) Then(b1 bool, err error) *MfaRepositoryMock {
+	e.results = &MfaRepositoryMockUseTotpStepResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times MfaRepository.UseTotpStep should be invoked
+func (mmUseTotpStep *mMfaRepositoryMockUseTotpStep) Times(n uint64) *mMfaRepositoryMockUseTotpStep {
+	if n == 0 {
+		mmUseTotpStep.mock.t.Fatalf("Times of MfaRepositoryMock.UseTotpStep mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUseTotpStep.expectedInvocations, n)
+	mmUseTotpStep.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUseTotpStep
+}
+
+func (mmUseTotpStep *mMfaRepositoryMockUseTotpStep) invocationsDone() bool {
+	if len(mmUseTotpStep.expectations) == 0 && mmUseTotpStep.defaultExpectation == nil && mmUseTotpStep.mock.funcUseTotpStep == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUseTotpStep.mock.afterUseTotpStepCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUseTotpStep.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UseTotpStep implements mm_repository.MfaRepository
+func (mmUseTotpStep *MfaRepositoryMock) UseTotpStep(ctx context.Context, userID string, step int64) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmUseTotpStep.beforeUseTotpStepCounter, 1)
+	defer mm_atomic.AddUint64(&mmUseTotpStep.afterUseTotpStepCounter, 1)
+
+	mmUseTotpStep.t.Helper()
+
+	if mmUseTotpStep.inspectFuncUseTotpStep != nil {
+		mmUseTotpStep.inspectFuncUseTotpStep(ctx, userID, step)
+	}
+
+	mm_params := MfaRepositoryMockUseTotpStepParams{ctx, userID, step}
+
+	// Record call args
+	mmUseTotpStep.UseTotpStepMock.mutex.Lock()
+	mmUseTotpStep.UseTotpStepMock.callArgs = append(mmUseTotpStep.UseTotpStepMock.callArgs, &mm_params)
+	mmUseTotpStep.UseTotpStepMock.mutex.Unlock()
+
+	for _, e := range mmUseTotpStep.UseTotpStepMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmUseTotpStep.UseTotpStepMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUseTotpStep.UseTotpStepMock.defaultExpectation.Counter, 1)
+		mm_want := mmUseTotpStep.UseTotpStepMock.defaultExpectation.params
+		mm_want_ptrs := mmUseTotpStep.UseTotpStepMock.defaultExpectation.paramPtrs
+
+		mm_got := MfaRepositoryMockUseTotpStepParams{ctx, userID, step}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUseTotpStep.t.Errorf("MfaRepositoryMock.UseTotpStep got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUseTotpStep.UseTotpStepMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUseTotpStep.t.Errorf("MfaRepositoryMock.UseTotpStep got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUseTotpStep.UseTotpStepMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.step != nil && !minimock.Equal(*mm_want_ptrs.step, mm_got.step) {
+				mmUseTotpStep.t.Errorf("MfaRepositoryMock.UseTotpStep got unexpected parameter step, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUseTotpStep.UseTotpStepMock.defaultExpectation.expectationOrigins.originStep, *mm_want_ptrs.step, mm_got.step, minimock.Diff(*mm_want_ptrs.step, mm_got.step))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUseTotpStep.t.Errorf("MfaRepositoryMock.UseTotpStep got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUseTotpStep.UseTotpStepMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUseTotpStep.UseTotpStepMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUseTotpStep.t.Fatal("No results are set for the MfaRepositoryMock.UseTotpStep")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmUseTotpStep.funcUseTotpStep != nil {
+		return mmUseTotpStep.funcUseTotpStep(ctx, userID, step)
+	}
+	mmUseTotpStep.t.Fatalf("Unexpected call to MfaRepositoryMock.UseTotpStep. %v %v %v", ctx, userID, step)
+	return
+}
+
+// UseTotpStepAfterCounter returns a count of finished MfaRepositoryMock.UseTotpStep invocations
+func (mmUseTotpStep *MfaRepositoryMock) UseTotpStepAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUseTotpStep.afterUseTotpStepCounter)
+}
+
+// UseTotpStepBeforeCounter returns a count of MfaRepositoryMock.UseTotpStep invocations
+func (mmUseTotpStep *MfaRepositoryMock) UseTotpStepBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUseTotpStep.beforeUseTotpStepCounter)
+}
+
+// Calls returns a list of arguments used in each call to MfaRepositoryMock.UseTotpStep.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUseTotpStep *mMfaRepositoryMockUseTotpStep) Calls() []*MfaRepositoryMockUseTotpStepParams {
+	mmUseTotpStep.mutex.RLock()
+
+	argCopy := make([]*MfaRepositoryMockUseTotpStepParams, len(mmUseTotpStep.callArgs))
+	copy(argCopy, mmUseTotpStep.callArgs)
+
+	mmUseTotpStep.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUseTotpStepDone returns true if the count of the UseTotpStep invocations corresponds
+// the number of defined expectations
+func (m *MfaRepositoryMock) MinimockUseTotpStepDone() bool {
+	if m.UseTotpStepMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UseTotpStepMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UseTotpStepMock.invocationsDone()
+}
+
+// MinimockUseTotpStepInspect logs each unmet expectation
+func (m *MfaRepositoryMock) MinimockUseTotpStepInspect() {
+	for _, e := range m.UseTotpStepMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MfaRepositoryMock.UseTotpStep at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUseTotpStepCounter := mm_atomic.LoadUint64(&m.afterUseTotpStepCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UseTotpStepMock.defaultExpectation != nil && afterUseTotpStepCounter < 1 {
+		if m.UseTotpStepMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MfaRepositoryMock.UseTotpStep at\n%s", m.UseTotpStepMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MfaRepositoryMock.UseTotpStep at\n%s with params: %#v", m.UseTotpStepMock.defaultExpectation.expectationOrigins.origin, *m.UseTotpStepMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUseTotpStep != nil && afterUseTotpStepCounter < 1 {
+		m.t.Errorf("Expected call to MfaRepositoryMock.UseTotpStep at\n%s", m.funcUseTotpStepOrigin)
+	}
+
+	if !m.UseTotpStepMock.invocationsDone() && afterUseTotpStepCounter > 0 {
+		m.t.Errorf("Expected %d calls to MfaRepositoryMock.UseTotpStep at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UseTotpStepMock.expectedInvocations), m.UseTotpStepMock.expectedInvocationsOrigin, afterUseTotpStepCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *MfaRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockConfirmTotpInspect()
+
+			m.MinimockDeleteRecoveryCodesInspect()
+
+			m.MinimockDeleteTotpInspect()
+
+			m.MinimockGetTotpInspect()
+
+			m.MinimockHasRecoveryCodeInspect()
+
+			m.MinimockReplaceRecoveryCodesInspect()
+
+			m.MinimockSaveTotpInspect()
+
+			m.MinimockUseRecoveryCodeInspect()
+
+			m.MinimockUseTotpStepInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *MfaRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *MfaRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockConfirmTotpDone() &&
+		m.MinimockDeleteRecoveryCodesDone() &&
+		m.MinimockDeleteTotpDone() &&
+		m.MinimockGetTotpDone() &&
+		m.MinimockHasRecoveryCodeDone() &&
+		m.MinimockReplaceRecoveryCodesDone() &&
+		m.MinimockSaveTotpDone() &&
+		m.MinimockUseRecoveryCodeDone() &&
+		m.MinimockUseTotpStepDone()
+}
@@ -0,0 +1,1606 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// NotificationRepositoryMock implements mm_repository.NotificationRepository
+type NotificationRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcClaimDue          func(ctx context.Context, limit int, maxAttempts int, lease time.Duration) (npa1 []*model.Notification, err error)
+	funcClaimDueOrigin    string
+	inspectFuncClaimDue   func(ctx context.Context, limit int, maxAttempts int, lease time.Duration)
+	afterClaimDueCounter  uint64
+	beforeClaimDueCounter uint64
+	ClaimDueMock          mNotificationRepositoryMockClaimDue
+
+	funcCreate          func(ctx context.Context, notification *model.Notification) (err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, notification *model.Notification)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mNotificationRepositoryMockCreate
+
+	funcMarkFailed          func(ctx context.Context, id string, retryIn time.Duration, lastError string) (err error)
+	funcMarkFailedOrigin    string
+	inspectFuncMarkFailed   func(ctx context.Context, id string, retryIn time.Duration, lastError string)
+	afterMarkFailedCounter  uint64
+	beforeMarkFailedCounter uint64
+	MarkFailedMock          mNotificationRepositoryMockMarkFailed
+
+	funcMarkSent          func(ctx context.Context, id string) (err error)
+	funcMarkSentOrigin    string
+	inspectFuncMarkSent   func(ctx context.Context, id string)
+	afterMarkSentCounter  uint64
+	beforeMarkSentCounter uint64
+	MarkSentMock          mNotificationRepositoryMockMarkSent
+}
+
+// NewNotificationRepositoryMock returns a mock for mm_repository.NotificationRepository
+func NewNotificationRepositoryMock(t minimock.Tester) *NotificationRepositoryMock {
+	m := &NotificationRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ClaimDueMock = mNotificationRepositoryMockClaimDue{mock: m}
+	m.ClaimDueMock.callArgs = []*NotificationRepositoryMockClaimDueParams{}
+
+	m.CreateMock = mNotificationRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*NotificationRepositoryMockCreateParams{}
+
+	m.MarkFailedMock = mNotificationRepositoryMockMarkFailed{mock: m}
+	m.MarkFailedMock.callArgs = []*NotificationRepositoryMockMarkFailedParams{}
+
+	m.MarkSentMock = mNotificationRepositoryMockMarkSent{mock: m}
+	m.MarkSentMock.callArgs = []*NotificationRepositoryMockMarkSentParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mNotificationRepositoryMockClaimDue struct {
+	optional           bool
+	mock               *NotificationRepositoryMock
+	defaultExpectation *NotificationRepositoryMockClaimDueExpectation
+	expectations       []*NotificationRepositoryMockClaimDueExpectation
+
+	callArgs []*NotificationRepositoryMockClaimDueParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// NotificationRepositoryMockClaimDueExpectation specifies expectation struct of the NotificationRepository.ClaimDue
+type NotificationRepositoryMockClaimDueExpectation struct {
+	mock               *NotificationRepositoryMock
+	params             *NotificationRepositoryMockClaimDueParams
+	paramPtrs          *NotificationRepositoryMockClaimDueParamPtrs
+	expectationOrigins NotificationRepositoryMockClaimDueExpectationOrigins
+	results            *NotificationRepositoryMockClaimDueResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// NotificationRepositoryMockClaimDueParams contains parameters of the NotificationRepository.ClaimDue
+type NotificationRepositoryMockClaimDueParams struct {
+	ctx         context.Context
+	limit       int
+	maxAttempts int
+	lease       time.Duration
+}
+
+// NotificationRepositoryMockClaimDueParamPtrs contains pointers to parameters of the NotificationRepository.ClaimDue
+type NotificationRepositoryMockClaimDueParamPtrs struct {
+	ctx         *context.Context
+	limit       *int
+	maxAttempts *int
+	lease       *time.Duration
+}
+
+// NotificationRepositoryMockClaimDueResults contains results of the NotificationRepository.ClaimDue
+type NotificationRepositoryMockClaimDueResults struct {
+	npa1 []*model.Notification
+	err  error
+}
+
+// NotificationRepositoryMockClaimDueOrigins contains origins of expectations of the NotificationRepository.ClaimDue
+type NotificationRepositoryMockClaimDueExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originLimit       string
+	originMaxAttempts string
+	originLease       string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClaimDue *mNotificationRepositoryMockClaimDue) Optional() *mNotificationRepositoryMockClaimDue {
+	mmClaimDue.optional = true
+	return mmClaimDue
+}
+
+// Expect sets up expected params for NotificationRepository.ClaimDue
+func (mmClaimDue *mNotificationRepositoryMockClaimDue) Expect(ctx context.Context, limit int, maxAttempts int, lease time.Duration) *mNotificationRepositoryMockClaimDue {
+	if mmClaimDue.mock.funcClaimDue != nil {
+		mmClaimDue.mock.t.Fatalf("NotificationRepositoryMock.ClaimDue mock is already set by Set")
+	}
+
+	if mmClaimDue.defaultExpectation == nil {
+		mmClaimDue.defaultExpectation = &NotificationRepositoryMockClaimDueExpectation{}
+	}
+
+	if mmClaimDue.defaultExpectation.paramPtrs != nil {
+		mmClaimDue.mock.t.Fatalf("NotificationRepositoryMock.ClaimDue mock is already set by ExpectParams functions")
+	}
+
+	mmClaimDue.defaultExpectation.params = &NotificationRepositoryMockClaimDueParams{ctx, limit, maxAttempts, lease}
+	mmClaimDue.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmClaimDue.expectations {
+		if minimock.Equal(e.params, mmClaimDue.defaultExpectation.params) {
+			mmClaimDue.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClaimDue.defaultExpectation.params)
+		}
+	}
+
+	return mmClaimDue
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationRepository.ClaimDue
+func (mmClaimDue *mNotificationRepositoryMockClaimDue) ExpectCtxParam1(ctx context.Context) *mNotificationRepositoryMockClaimDue {
+	if mmClaimDue.mock.funcClaimDue != nil {
+		mmClaimDue.mock.t.Fatalf("NotificationRepositoryMock.ClaimDue mock is already set by Set")
+	}
+
+	if mmClaimDue.defaultExpectation == nil {
+		mmClaimDue.defaultExpectation = &NotificationRepositoryMockClaimDueExpectation{}
+	}
+
+	if mmClaimDue.defaultExpectation.params != nil {
+		mmClaimDue.mock.t.Fatalf("NotificationRepositoryMock.ClaimDue mock is already set by Expect")
+	}
+
+	if mmClaimDue.defaultExpectation.paramPtrs == nil {
+		mmClaimDue.defaultExpectation.paramPtrs = &NotificationRepositoryMockClaimDueParamPtrs{}
+	}
+	mmClaimDue.defaultExpectation.paramPtrs.ctx = &ctx
+	mmClaimDue.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmClaimDue
+}
+
+// ExpectLimitParam2 sets up expected param limit for NotificationRepository.ClaimDue
+func (mmClaimDue *mNotificationRepositoryMockClaimDue) ExpectLimitParam2(limit int) *mNotificationRepositoryMockClaimDue {
+	if mmClaimDue.mock.funcClaimDue != nil {
+		mmClaimDue.mock.t.Fatalf("NotificationRepositoryMock.ClaimDue mock is already set by Set")
+	}
+
+	if mmClaimDue.defaultExpectation == nil {
+		mmClaimDue.defaultExpectation = &NotificationRepositoryMockClaimDueExpectation{}
+	}
+
+	if mmClaimDue.defaultExpectation.params != nil {
+		mmClaimDue.mock.t.Fatalf("NotificationRepositoryMock.ClaimDue mock is already set by Expect")
+	}
+
+	if mmClaimDue.defaultExpectation.paramPtrs == nil {
+		mmClaimDue.defaultExpectation.paramPtrs = &NotificationRepositoryMockClaimDueParamPtrs{}
+	}
+	mmClaimDue.defaultExpectation.paramPtrs.limit = &limit
+	mmClaimDue.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmClaimDue
+}
+
+// ExpectMaxAttemptsParam3 sets up expected param maxAttempts for NotificationRepository.ClaimDue
+func (mmClaimDue *mNotificationRepositoryMockClaimDue) ExpectMaxAttemptsParam3(maxAttempts int) *mNotificationRepositoryMockClaimDue {
+	if mmClaimDue.mock.funcClaimDue != nil {
+		mmClaimDue.mock.t.Fatalf("NotificationRepositoryMock.ClaimDue mock is already set by Set")
+	}
+
+	if mmClaimDue.defaultExpectation == nil {
+		mmClaimDue.defaultExpectation = &NotificationRepositoryMockClaimDueExpectation{}
+	}
+
+	if mmClaimDue.defaultExpectation.params != nil {
+		mmClaimDue.mock.t.Fatalf("NotificationRepositoryMock.ClaimDue mock is already set by Expect")
+	}
+
+	if mmClaimDue.defaultExpectation.paramPtrs == nil {
+		mmClaimDue.defaultExpectation.paramPtrs = &NotificationRepositoryMockClaimDueParamPtrs{}
+	}
+	mmClaimDue.defaultExpectation.paramPtrs.maxAttempts = &maxAttempts
+	mmClaimDue.defaultExpectation.expectationOrigins.originMaxAttempts = minimock.CallerInfo(1)
+
+	return mmClaimDue
+}
+
+// ExpectLeaseParam4 sets up expected param lease for NotificationRepository.ClaimDue
+func (mmClaimDue *mNotificationRepositoryMockClaimDue) ExpectLeaseParam4(lease time.Duration) *mNotificationRepositoryMockClaimDue {
+	if mmClaimDue.mock.funcClaimDue != nil {
+		mmClaimDue.mock.t.Fatalf("NotificationRepositoryMock.ClaimDue mock is already set by Set")
+	}
+
+	if mmClaimDue.defaultExpectation == nil {
+		mmClaimDue.defaultExpectation = &NotificationRepositoryMockClaimDueExpectation{}
+	}
+
+	if mmClaimDue.defaultExpectation.params != nil {
+		mmClaimDue.mock.t.Fatalf("NotificationRepositoryMock.ClaimDue mock is already set by Expect")
+	}
+
+	if mmClaimDue.defaultExpectation.paramPtrs == nil {
+		mmClaimDue.defaultExpectation.paramPtrs = &NotificationRepositoryMockClaimDueParamPtrs{}
+	}
+	mmClaimDue.defaultExpectation.paramPtrs.lease = &lease
+	mmClaimDue.defaultExpectation.expectationOrigins.originLease = minimock.CallerInfo(1)
+
+	return mmClaimDue
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationRepository.ClaimDue
+func (mmClaimDue *mNotificationRepositoryMockClaimDue) Inspect(f func(ctx context.Context, limit int, maxAttempts int, lease time.Duration)) *mNotificationRepositoryMockClaimDue {
+	if mmClaimDue.mock.inspectFuncClaimDue != nil {
+		mmClaimDue.mock.t.Fatalf("Inspect function is already set for NotificationRepositoryMock.ClaimDue")
+	}
+
+	mmClaimDue.mock.inspectFuncClaimDue = f
+
+	return mmClaimDue
+}
+
+// Return sets up results that will be returned by NotificationRepository.ClaimDue
+func (mmClaimDue *mNotificationRepositoryMockClaimDue) Return(npa1 []*model.Notification, err error) *NotificationRepositoryMock {
+	if mmClaimDue.mock.funcClaimDue != nil {
+		mmClaimDue.mock.t.Fatalf("NotificationRepositoryMock.ClaimDue mock is already set by Set")
+	}
+
+	if mmClaimDue.defaultExpectation == nil {
+		mmClaimDue.defaultExpectation = &NotificationRepositoryMockClaimDueExpectation{mock: mmClaimDue.mock}
+	}
+	mmClaimDue.defaultExpectation.results = &NotificationRepositoryMockClaimDueResults{npa1, err}
+	mmClaimDue.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmClaimDue.mock
+}
+
+// Set uses given function f to mock the NotificationRepository.ClaimDue method
+func (mmClaimDue *mNotificationRepositoryMockClaimDue) Set(f func(ctx context.Context, limit int, maxAttempts int, lease time.Duration) (npa1 []*model.Notification, err error)) *NotificationRepositoryMock {
+	if mmClaimDue.defaultExpectation != nil {
+		mmClaimDue.mock.t.Fatalf("Default expectation is already set for the NotificationRepository.ClaimDue method")
+	}
+
+	if len(mmClaimDue.expectations) > 0 {
+		mmClaimDue.mock.t.Fatalf("Some expectations are already set for the NotificationRepository.ClaimDue method")
+	}
+
+	mmClaimDue.mock.funcClaimDue = f
+	mmClaimDue.mock.funcClaimDueOrigin = minimock.CallerInfo(1)
+	return mmClaimDue.mock
+}
+
+// When sets expectation for the NotificationRepository.ClaimDue which will trigger the result defined by the following
+// Then helper
+func (mmClaimDue *mNotificationRepositoryMockClaimDue) When(ctx context.Context, limit int, maxAttempts int, lease time.Duration) *NotificationRepositoryMockClaimDueExpectation {
+	if mmClaimDue.mock.funcClaimDue != nil {
+		mmClaimDue.mock.t.Fatalf("NotificationRepositoryMock.ClaimDue mock is already set by Set")
+	}
+
+	expectation := &NotificationRepositoryMockClaimDueExpectation{
+		mock:               mmClaimDue.mock,
+		params:             &NotificationRepositoryMockClaimDueParams{ctx, limit, maxAttempts, lease},
+		expectationOrigins: NotificationRepositoryMockClaimDueExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmClaimDue.expectations = append(mmClaimDue.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationRepository.ClaimDue return parameters for the expectation previously defined by the When method
+func (e *NotificationRepositoryMockClaimDueExpectation) Then(npa1 []*model.Notification, err error) *NotificationRepositoryMock {
+	e.results = &NotificationRepositoryMockClaimDueResults{npa1, err}
+	return e.mock
+}
+
+// Times sets number of times NotificationRepository.ClaimDue should be invoked
+func (mmClaimDue *mNotificationRepositoryMockClaimDue) Times(n uint64) *mNotificationRepositoryMockClaimDue {
+	if n == 0 {
+		mmClaimDue.mock.t.Fatalf("Times of NotificationRepositoryMock.ClaimDue mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClaimDue.expectedInvocations, n)
+	mmClaimDue.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmClaimDue
+}
+
+func (mmClaimDue *mNotificationRepositoryMockClaimDue) invocationsDone() bool {
+	if len(mmClaimDue.expectations) == 0 && mmClaimDue.defaultExpectation == nil && mmClaimDue.mock.funcClaimDue == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClaimDue.mock.afterClaimDueCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClaimDue.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ClaimDue implements mm_repository.NotificationRepository
+func (mmClaimDue *NotificationRepositoryMock) ClaimDue(ctx context.Context, limit int, maxAttempts int, lease time.Duration) (npa1 []*model.Notification, err error) {
+	mm_atomic.AddUint64(&mmClaimDue.beforeClaimDueCounter, 1)
+	defer mm_atomic.AddUint64(&mmClaimDue.afterClaimDueCounter, 1)
+
+	mmClaimDue.t.Helper()
+
+	if mmClaimDue.inspectFuncClaimDue != nil {
+		mmClaimDue.inspectFuncClaimDue(ctx, limit, maxAttempts, lease)
+	}
+
+	mm_params := NotificationRepositoryMockClaimDueParams{ctx, limit, maxAttempts, lease}
+
+	// Record call args
+	mmClaimDue.ClaimDueMock.mutex.Lock()
+	mmClaimDue.ClaimDueMock.callArgs = append(mmClaimDue.ClaimDueMock.callArgs, &mm_params)
+	mmClaimDue.ClaimDueMock.mutex.Unlock()
+
+	for _, e := range mmClaimDue.ClaimDueMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.npa1, e.results.err
+		}
+	}
+
+	if mmClaimDue.ClaimDueMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClaimDue.ClaimDueMock.defaultExpectation.Counter, 1)
+		mm_want := mmClaimDue.ClaimDueMock.defaultExpectation.params
+		mm_want_ptrs := mmClaimDue.ClaimDueMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationRepositoryMockClaimDueParams{ctx, limit, maxAttempts, lease}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClaimDue.t.Errorf("NotificationRepositoryMock.ClaimDue got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimDue.ClaimDueMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmClaimDue.t.Errorf("NotificationRepositoryMock.ClaimDue got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimDue.ClaimDueMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+			if mm_want_ptrs.maxAttempts != nil && !minimock.Equal(*mm_want_ptrs.maxAttempts, mm_got.maxAttempts) {
+				mmClaimDue.t.Errorf("NotificationRepositoryMock.ClaimDue got unexpected parameter maxAttempts, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimDue.ClaimDueMock.defaultExpectation.expectationOrigins.originMaxAttempts, *mm_want_ptrs.maxAttempts, mm_got.maxAttempts, minimock.Diff(*mm_want_ptrs.maxAttempts, mm_got.maxAttempts))
+			}
+
+			if mm_want_ptrs.lease != nil && !minimock.Equal(*mm_want_ptrs.lease, mm_got.lease) {
+				mmClaimDue.t.Errorf("NotificationRepositoryMock.ClaimDue got unexpected parameter lease, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimDue.ClaimDueMock.defaultExpectation.expectationOrigins.originLease, *mm_want_ptrs.lease, mm_got.lease, minimock.Diff(*mm_want_ptrs.lease, mm_got.lease))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClaimDue.t.Errorf("NotificationRepositoryMock.ClaimDue got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmClaimDue.ClaimDueMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClaimDue.ClaimDueMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClaimDue.t.Fatal("No results are set for the NotificationRepositoryMock.ClaimDue")
+		}
+		return (*mm_results).npa1, (*mm_results).err
+	}
+	if mmClaimDue.funcClaimDue != nil {
+		return mmClaimDue.funcClaimDue(ctx, limit, maxAttempts, lease)
+	}
+	mmClaimDue.t.Fatalf("Unexpected call to NotificationRepositoryMock.ClaimDue. %v %v %v %v", ctx, limit, maxAttempts, lease)
+	return
+}
+
+// ClaimDueAfterCounter returns a count of finished NotificationRepositoryMock.ClaimDue invocations
+func (mmClaimDue *NotificationRepositoryMock) ClaimDueAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimDue.afterClaimDueCounter)
+}
+
+// ClaimDueBeforeCounter returns a count of NotificationRepositoryMock.ClaimDue invocations
+func (mmClaimDue *NotificationRepositoryMock) ClaimDueBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimDue.beforeClaimDueCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationRepositoryMock.ClaimDue.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClaimDue *mNotificationRepositoryMockClaimDue) Calls() []*NotificationRepositoryMockClaimDueParams {
+	mmClaimDue.mutex.RLock()
+
+	argCopy := make([]*NotificationRepositoryMockClaimDueParams, len(mmClaimDue.callArgs))
+	copy(argCopy, mmClaimDue.callArgs)
+
+	mmClaimDue.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClaimDueDone returns true if the count of the ClaimDue invocations corresponds
+// the number of defined expectations
+func (m *NotificationRepositoryMock) MinimockClaimDueDone() bool {
+	if m.ClaimDueMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClaimDueMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClaimDueMock.invocationsDone()
+}
+
+// MinimockClaimDueInspect logs each unmet expectation
+func (m *NotificationRepositoryMock) MinimockClaimDueInspect() {
+	for _, e := range m.ClaimDueMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.ClaimDue at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterClaimDueCounter := mm_atomic.LoadUint64(&m.afterClaimDueCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClaimDueMock.defaultExpectation != nil && afterClaimDueCounter < 1 {
+		if m.ClaimDueMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.ClaimDue at\n%s", m.ClaimDueMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.ClaimDue at\n%s with params: %#v", m.ClaimDueMock.defaultExpectation.expectationOrigins.origin, *m.ClaimDueMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClaimDue != nil && afterClaimDueCounter < 1 {
+		m.t.Errorf("Expected call to NotificationRepositoryMock.ClaimDue at\n%s", m.funcClaimDueOrigin)
+	}
+
+	if !m.ClaimDueMock.invocationsDone() && afterClaimDueCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationRepositoryMock.ClaimDue at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ClaimDueMock.expectedInvocations), m.ClaimDueMock.expectedInvocationsOrigin, afterClaimDueCounter)
+	}
+}
+
+type mNotificationRepositoryMockCreate struct {
+	optional           bool
+	mock               *NotificationRepositoryMock
+	defaultExpectation *NotificationRepositoryMockCreateExpectation
+	expectations       []*NotificationRepositoryMockCreateExpectation
+
+	callArgs []*NotificationRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// NotificationRepositoryMockCreateExpectation specifies expectation struct of the NotificationRepository.Create
+type NotificationRepositoryMockCreateExpectation struct {
+	mock               *NotificationRepositoryMock
+	params             *NotificationRepositoryMockCreateParams
+	paramPtrs          *NotificationRepositoryMockCreateParamPtrs
+	expectationOrigins NotificationRepositoryMockCreateExpectationOrigins
+	results            *NotificationRepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// NotificationRepositoryMockCreateParams contains parameters of the NotificationRepository.Create
+type NotificationRepositoryMockCreateParams struct {
+	ctx          context.Context
+	notification *model.Notification
+}
+
+// NotificationRepositoryMockCreateParamPtrs contains pointers to parameters of the NotificationRepository.Create
+type NotificationRepositoryMockCreateParamPtrs struct {
+	ctx          *context.Context
+	notification **model.Notification
+}
+
+// NotificationRepositoryMockCreateResults contains results of the NotificationRepository.Create
+type NotificationRepositoryMockCreateResults struct {
+	err error
+}
+
+// NotificationRepositoryMockCreateOrigins contains origins of expectations of the NotificationRepository.Create
+type NotificationRepositoryMockCreateExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originNotification string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mNotificationRepositoryMockCreate) Optional() *mNotificationRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for NotificationRepository.Create
+func (mmCreate *mNotificationRepositoryMockCreate) Expect(ctx context.Context, notification *model.Notification) *mNotificationRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("NotificationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &NotificationRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("NotificationRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &NotificationRepositoryMockCreateParams{ctx, notification}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationRepository.Create
+func (mmCreate *mNotificationRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mNotificationRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("NotificationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &NotificationRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("NotificationRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &NotificationRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectNotificationParam2 sets up expected param notification for NotificationRepository.Create
+func (mmCreate *mNotificationRepositoryMockCreate) ExpectNotificationParam2(notification *model.Notification) *mNotificationRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("NotificationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &NotificationRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("NotificationRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &NotificationRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.notification = &notification
+	mmCreate.defaultExpectation.expectationOrigins.originNotification = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationRepository.Create
+func (mmCreate *mNotificationRepositoryMockCreate) Inspect(f func(ctx context.Context, notification *model.Notification)) *mNotificationRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for NotificationRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by NotificationRepository.Create
+func (mmCreate *mNotificationRepositoryMockCreate) Return(err error) *NotificationRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("NotificationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &NotificationRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &NotificationRepositoryMockCreateResults{err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the NotificationRepository.Create method
+func (mmCreate *mNotificationRepositoryMockCreate) Set(f func(ctx context.Context, notification *model.Notification) (err error)) *NotificationRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the NotificationRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the NotificationRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the NotificationRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mNotificationRepositoryMockCreate) When(ctx context.Context, notification *model.Notification) *NotificationRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("NotificationRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &NotificationRepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &NotificationRepositoryMockCreateParams{ctx, notification},
+		expectationOrigins: NotificationRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationRepository.Create return parameters for the expectation previously defined by the When method
+func (e *NotificationRepositoryMockCreateExpectation) Then(err error) *NotificationRepositoryMock {
+	e.results = &NotificationRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times NotificationRepository.Create should be invoked
+func (mmCreate *mNotificationRepositoryMockCreate) Times(n uint64) *mNotificationRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of NotificationRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mNotificationRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_repository.NotificationRepository
+func (mmCreate *NotificationRepositoryMock) Create(ctx context.Context, notification *model.Notification) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, notification)
+	}
+
+	mm_params := NotificationRepositoryMockCreateParams{ctx, notification}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationRepositoryMockCreateParams{ctx, notification}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("NotificationRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.notification != nil && !minimock.Equal(*mm_want_ptrs.notification, mm_got.notification) {
+				mmCreate.t.Errorf("NotificationRepositoryMock.Create got unexpected parameter notification, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originNotification, *mm_want_ptrs.notification, mm_got.notification, minimock.Diff(*mm_want_ptrs.notification, mm_got.notification))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("NotificationRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the NotificationRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, notification)
+	}
+	mmCreate.t.Fatalf("Unexpected call to NotificationRepositoryMock.Create. %v %v", ctx, notification)
+	return
+}
+
+// CreateAfterCounter returns a count of finished NotificationRepositoryMock.Create invocations
+func (mmCreate *NotificationRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of NotificationRepositoryMock.Create invocations
+func (mmCreate *NotificationRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mNotificationRepositoryMockCreate) Calls() []*NotificationRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*NotificationRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *NotificationRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *NotificationRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to NotificationRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mNotificationRepositoryMockMarkFailed struct {
+	optional           bool
+	mock               *NotificationRepositoryMock
+	defaultExpectation *NotificationRepositoryMockMarkFailedExpectation
+	expectations       []*NotificationRepositoryMockMarkFailedExpectation
+
+	callArgs []*NotificationRepositoryMockMarkFailedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// NotificationRepositoryMockMarkFailedExpectation specifies expectation struct of the NotificationRepository.MarkFailed
+type NotificationRepositoryMockMarkFailedExpectation struct {
+	mock               *NotificationRepositoryMock
+	params             *NotificationRepositoryMockMarkFailedParams
+	paramPtrs          *NotificationRepositoryMockMarkFailedParamPtrs
+	expectationOrigins NotificationRepositoryMockMarkFailedExpectationOrigins
+	results            *NotificationRepositoryMockMarkFailedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// NotificationRepositoryMockMarkFailedParams contains parameters of the NotificationRepository.MarkFailed
+type NotificationRepositoryMockMarkFailedParams struct {
+	ctx       context.Context
+	id        string
+	retryIn   time.Duration
+	lastError string
+}
+
+// NotificationRepositoryMockMarkFailedParamPtrs contains pointers to parameters of the NotificationRepository.MarkFailed
+type NotificationRepositoryMockMarkFailedParamPtrs struct {
+	ctx       *context.Context
+	id        *string
+	retryIn   *time.Duration
+	lastError *string
+}
+
+// NotificationRepositoryMockMarkFailedResults contains results of the NotificationRepository.MarkFailed
+type NotificationRepositoryMockMarkFailedResults struct {
+	err error
+}
+
+// NotificationRepositoryMockMarkFailedOrigins contains origins of expectations of the NotificationRepository.MarkFailed
+type NotificationRepositoryMockMarkFailedExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originId        string
+	originRetryIn   string
+	originLastError string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkFailed *mNotificationRepositoryMockMarkFailed) Optional() *mNotificationRepositoryMockMarkFailed {
+	mmMarkFailed.optional = true
+	return mmMarkFailed
+}
+
+// Expect sets up expected params for NotificationRepository.MarkFailed
+func (mmMarkFailed *mNotificationRepositoryMockMarkFailed) Expect(ctx context.Context, id string, retryIn time.Duration, lastError string) *mNotificationRepositoryMockMarkFailed {
+	if mmMarkFailed.mock.funcMarkFailed != nil {
+		mmMarkFailed.mock.t.Fatalf("NotificationRepositoryMock.MarkFailed mock is already set by Set")
+	}
+
+	if mmMarkFailed.defaultExpectation == nil {
+		mmMarkFailed.defaultExpectation = &NotificationRepositoryMockMarkFailedExpectation{}
+	}
+
+	if mmMarkFailed.defaultExpectation.paramPtrs != nil {
+		mmMarkFailed.mock.t.Fatalf("NotificationRepositoryMock.MarkFailed mock is already set by ExpectParams functions")
+	}
+
+	mmMarkFailed.defaultExpectation.params = &NotificationRepositoryMockMarkFailedParams{ctx, id, retryIn, lastError}
+	mmMarkFailed.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkFailed.expectations {
+		if minimock.Equal(e.params, mmMarkFailed.defaultExpectation.params) {
+			mmMarkFailed.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkFailed.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkFailed
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationRepository.MarkFailed
+func (mmMarkFailed *mNotificationRepositoryMockMarkFailed) ExpectCtxParam1(ctx context.Context) *mNotificationRepositoryMockMarkFailed {
+	if mmMarkFailed.mock.funcMarkFailed != nil {
+		mmMarkFailed.mock.t.Fatalf("NotificationRepositoryMock.MarkFailed mock is already set by Set")
+	}
+
+	if mmMarkFailed.defaultExpectation == nil {
+		mmMarkFailed.defaultExpectation = &NotificationRepositoryMockMarkFailedExpectation{}
+	}
+
+	if mmMarkFailed.defaultExpectation.params != nil {
+		mmMarkFailed.mock.t.Fatalf("NotificationRepositoryMock.MarkFailed mock is already set by Expect")
+	}
+
+	if mmMarkFailed.defaultExpectation.paramPtrs == nil {
+		mmMarkFailed.defaultExpectation.paramPtrs = &NotificationRepositoryMockMarkFailedParamPtrs{}
+	}
+	mmMarkFailed.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkFailed.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkFailed
+}
+
+// ExpectIdParam2 sets up expected param id for NotificationRepository.MarkFailed
+func (mmMarkFailed *mNotificationRepositoryMockMarkFailed) ExpectIdParam2(id string) *mNotificationRepositoryMockMarkFailed {
+	if mmMarkFailed.mock.funcMarkFailed != nil {
+		mmMarkFailed.mock.t.Fatalf("NotificationRepositoryMock.MarkFailed mock is already set by Set")
+	}
+
+	if mmMarkFailed.defaultExpectation == nil {
+		mmMarkFailed.defaultExpectation = &NotificationRepositoryMockMarkFailedExpectation{}
+	}
+
+	if mmMarkFailed.defaultExpectation.params != nil {
+		mmMarkFailed.mock.t.Fatalf("NotificationRepositoryMock.MarkFailed mock is already set by Expect")
+	}
+
+	if mmMarkFailed.defaultExpectation.paramPtrs == nil {
+		mmMarkFailed.defaultExpectation.paramPtrs = &NotificationRepositoryMockMarkFailedParamPtrs{}
+	}
+	mmMarkFailed.defaultExpectation.paramPtrs.id = &id
+	mmMarkFailed.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmMarkFailed
+}
+
+// ExpectRetryInParam3 sets up expected param retryIn for NotificationRepository.MarkFailed
+func (mmMarkFailed *mNotificationRepositoryMockMarkFailed) ExpectRetryInParam3(retryIn time.Duration) *mNotificationRepositoryMockMarkFailed {
+	if mmMarkFailed.mock.funcMarkFailed != nil {
+		mmMarkFailed.mock.t.Fatalf("NotificationRepositoryMock.MarkFailed mock is already set by Set")
+	}
+
+	if mmMarkFailed.defaultExpectation == nil {
+		mmMarkFailed.defaultExpectation = &NotificationRepositoryMockMarkFailedExpectation{}
+	}
+
+	if mmMarkFailed.defaultExpectation.params != nil {
+		mmMarkFailed.mock.t.Fatalf("NotificationRepositoryMock.MarkFailed mock is already set by Expect")
+	}
+
+	if mmMarkFailed.defaultExpectation.paramPtrs == nil {
+		mmMarkFailed.defaultExpectation.paramPtrs = &NotificationRepositoryMockMarkFailedParamPtrs{}
+	}
+	mmMarkFailed.defaultExpectation.paramPtrs.retryIn = &retryIn
+	mmMarkFailed.defaultExpectation.expectationOrigins.originRetryIn = minimock.CallerInfo(1)
+
+	return mmMarkFailed
+}
+
+// ExpectLastErrorParam4 sets up expected param lastError for NotificationRepository.MarkFailed
+func (mmMarkFailed *mNotificationRepositoryMockMarkFailed) ExpectLastErrorParam4(lastError string) *mNotificationRepositoryMockMarkFailed {
+	if mmMarkFailed.mock.funcMarkFailed != nil {
+		mmMarkFailed.mock.t.Fatalf("NotificationRepositoryMock.MarkFailed mock is already set by Set")
+	}
+
+	if mmMarkFailed.defaultExpectation == nil {
+		mmMarkFailed.defaultExpectation = &NotificationRepositoryMockMarkFailedExpectation{}
+	}
+
+	if mmMarkFailed.defaultExpectation.params != nil {
+		mmMarkFailed.mock.t.Fatalf("NotificationRepositoryMock.MarkFailed mock is already set by Expect")
+	}
+
+	if mmMarkFailed.defaultExpectation.paramPtrs == nil {
+		mmMarkFailed.defaultExpectation.paramPtrs = &NotificationRepositoryMockMarkFailedParamPtrs{}
+	}
+	mmMarkFailed.defaultExpectation.paramPtrs.lastError = &lastError
+	mmMarkFailed.defaultExpectation.expectationOrigins.originLastError = minimock.CallerInfo(1)
+
+	return mmMarkFailed
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationRepository.MarkFailed
+func (mmMarkFailed *mNotificationRepositoryMockMarkFailed) Inspect(f func(ctx context.Context, id string, retryIn time.Duration, lastError string)) *mNotificationRepositoryMockMarkFailed {
+	if mmMarkFailed.mock.inspectFuncMarkFailed != nil {
+		mmMarkFailed.mock.t.Fatalf("Inspect function is already set for NotificationRepositoryMock.MarkFailed")
+	}
+
+	mmMarkFailed.mock.inspectFuncMarkFailed = f
+
+	return mmMarkFailed
+}
+
+// Return sets up results that will be returned by NotificationRepository.MarkFailed
+func (mmMarkFailed *mNotificationRepositoryMockMarkFailed) Return(err error) *NotificationRepositoryMock {
+	if mmMarkFailed.mock.funcMarkFailed != nil {
+		mmMarkFailed.mock.t.Fatalf("NotificationRepositoryMock.MarkFailed mock is already set by Set")
+	}
+
+	if mmMarkFailed.defaultExpectation == nil {
+		mmMarkFailed.defaultExpectation = &NotificationRepositoryMockMarkFailedExpectation{mock: mmMarkFailed.mock}
+	}
+	mmMarkFailed.defaultExpectation.results = &NotificationRepositoryMockMarkFailedResults{err}
+	mmMarkFailed.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkFailed.mock
+}
+
+// Set uses given function f to mock the NotificationRepository.MarkFailed method
+func (mmMarkFailed *mNotificationRepositoryMockMarkFailed) Set(f func(ctx context.Context, id string, retryIn time.Duration, lastError string) (err error)) *NotificationRepositoryMock {
+	if mmMarkFailed.defaultExpectation != nil {
+		mmMarkFailed.mock.t.Fatalf("Default expectation is already set for the NotificationRepository.MarkFailed method")
+	}
+
+	if len(mmMarkFailed.expectations) > 0 {
+		mmMarkFailed.mock.t.Fatalf("Some expectations are already set for the NotificationRepository.MarkFailed method")
+	}
+
+	mmMarkFailed.mock.funcMarkFailed = f
+	mmMarkFailed.mock.funcMarkFailedOrigin = minimock.CallerInfo(1)
+	return mmMarkFailed.mock
+}
+
+// When sets expectation for the NotificationRepository.MarkFailed which will trigger the result defined by the following
+// Then helper
+func (mmMarkFailed *mNotificationRepositoryMockMarkFailed) When(ctx context.Context, id string, retryIn time.Duration, lastError string) *NotificationRepositoryMockMarkFailedExpectation {
+	if mmMarkFailed.mock.funcMarkFailed != nil {
+		mmMarkFailed.mock.t.Fatalf("NotificationRepositoryMock.MarkFailed mock is already set by Set")
+	}
+
+	expectation := &NotificationRepositoryMockMarkFailedExpectation{
+		mock:               mmMarkFailed.mock,
+		params:             &NotificationRepositoryMockMarkFailedParams{ctx, id, retryIn, lastError},
+		expectationOrigins: NotificationRepositoryMockMarkFailedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkFailed.expectations = append(mmMarkFailed.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationRepository.MarkFailed return parameters for the expectation previously defined by the When method
+func (e *NotificationRepositoryMockMarkFailedExpectation) Then(err error) *NotificationRepositoryMock {
+	e.results = &NotificationRepositoryMockMarkFailedResults{err}
+	return e.mock
+}
+
+// Times sets number of times NotificationRepository.MarkFailed should be invoked
+func (mmMarkFailed *mNotificationRepositoryMockMarkFailed) Times(n uint64) *mNotificationRepositoryMockMarkFailed {
+	if n == 0 {
+		mmMarkFailed.mock.t.Fatalf("Times of NotificationRepositoryMock.MarkFailed mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkFailed.expectedInvocations, n)
+	mmMarkFailed.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkFailed
+}
+
+func (mmMarkFailed *mNotificationRepositoryMockMarkFailed) invocationsDone() bool {
+	if len(mmMarkFailed.expectations) == 0 && mmMarkFailed.defaultExpectation == nil && mmMarkFailed.mock.funcMarkFailed == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkFailed.mock.afterMarkFailedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkFailed.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkFailed implements mm_repository.NotificationRepository
+func (mmMarkFailed *NotificationRepositoryMock) MarkFailed(ctx context.Context, id string, retryIn time.Duration, lastError string) (err error) {
+	mm_atomic.AddUint64(&mmMarkFailed.beforeMarkFailedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkFailed.afterMarkFailedCounter, 1)
+
+	mmMarkFailed.t.Helper()
+
+	if mmMarkFailed.inspectFuncMarkFailed != nil {
+		mmMarkFailed.inspectFuncMarkFailed(ctx, id, retryIn, lastError)
+	}
+
+	mm_params := NotificationRepositoryMockMarkFailedParams{ctx, id, retryIn, lastError}
+
+	// Record call args
+	mmMarkFailed.MarkFailedMock.mutex.Lock()
+	mmMarkFailed.MarkFailedMock.callArgs = append(mmMarkFailed.MarkFailedMock.callArgs, &mm_params)
+	mmMarkFailed.MarkFailedMock.mutex.Unlock()
+
+	for _, e := range mmMarkFailed.MarkFailedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkFailed.MarkFailedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkFailed.MarkFailedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkFailed.MarkFailedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkFailed.MarkFailedMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationRepositoryMockMarkFailedParams{ctx, id, retryIn, lastError}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkFailed.t.Errorf("NotificationRepositoryMock.MarkFailed got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkFailed.MarkFailedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmMarkFailed.t.Errorf("NotificationRepositoryMock.MarkFailed got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkFailed.MarkFailedMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.retryIn != nil && !minimock.Equal(*mm_want_ptrs.retryIn, mm_got.retryIn) {
+				mmMarkFailed.t.Errorf("NotificationRepositoryMock.MarkFailed got unexpected parameter retryIn, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkFailed.MarkFailedMock.defaultExpectation.expectationOrigins.originRetryIn, *mm_want_ptrs.retryIn, mm_got.retryIn, minimock.Diff(*mm_want_ptrs.retryIn, mm_got.retryIn))
+			}
+
+			if mm_want_ptrs.lastError != nil && !minimock.Equal(*mm_want_ptrs.lastError, mm_got.lastError) {
+				mmMarkFailed.t.Errorf("NotificationRepositoryMock.MarkFailed got unexpected parameter lastError, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkFailed.MarkFailedMock.defaultExpectation.expectationOrigins.originLastError, *mm_want_ptrs.lastError, mm_got.lastError, minimock.Diff(*mm_want_ptrs.lastError, mm_got.lastError))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkFailed.t.Errorf("NotificationRepositoryMock.MarkFailed got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkFailed.MarkFailedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkFailed.MarkFailedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkFailed.t.Fatal("No results are set for the NotificationRepositoryMock.MarkFailed")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkFailed.funcMarkFailed != nil {
+		return mmMarkFailed.funcMarkFailed(ctx, id, retryIn, lastError)
+	}
+	mmMarkFailed.t.Fatalf("Unexpected call to NotificationRepositoryMock.MarkFailed. %v %v %v %v", ctx, id, retryIn, lastError)
+	return
+}
+
+// MarkFailedAfterCounter returns a count of finished NotificationRepositoryMock.MarkFailed invocations
+func (mmMarkFailed *NotificationRepositoryMock) MarkFailedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkFailed.afterMarkFailedCounter)
+}
+
+// MarkFailedBeforeCounter returns a count of NotificationRepositoryMock.MarkFailed invocations
+func (mmMarkFailed *NotificationRepositoryMock) MarkFailedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkFailed.beforeMarkFailedCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationRepositoryMock.MarkFailed.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkFailed *mNotificationRepositoryMockMarkFailed) Calls() []*NotificationRepositoryMockMarkFailedParams {
+	mmMarkFailed.mutex.RLock()
+
+	argCopy := make([]*NotificationRepositoryMockMarkFailedParams, len(mmMarkFailed.callArgs))
+	copy(argCopy, mmMarkFailed.callArgs)
+
+	mmMarkFailed.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkFailedDone returns true if the count of the MarkFailed invocations corresponds
+// the number of defined expectations
+func (m *NotificationRepositoryMock) MinimockMarkFailedDone() bool {
+	if m.MarkFailedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkFailedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkFailedMock.invocationsDone()
+}
+
+// MinimockMarkFailedInspect logs each unmet expectation
+func (m *NotificationRepositoryMock) MinimockMarkFailedInspect() {
+	for _, e := range m.MarkFailedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.MarkFailed at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkFailedCounter := mm_atomic.LoadUint64(&m.afterMarkFailedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkFailedMock.defaultExpectation != nil && afterMarkFailedCounter < 1 {
+		if m.MarkFailedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.MarkFailed at\n%s", m.MarkFailedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.MarkFailed at\n%s with params: %#v", m.MarkFailedMock.defaultExpectation.expectationOrigins.origin, *m.MarkFailedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkFailed != nil && afterMarkFailedCounter < 1 {
+		m.t.Errorf("Expected call to NotificationRepositoryMock.MarkFailed at\n%s", m.funcMarkFailedOrigin)
+	}
+
+	if !m.MarkFailedMock.invocationsDone() && afterMarkFailedCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationRepositoryMock.MarkFailed at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkFailedMock.expectedInvocations), m.MarkFailedMock.expectedInvocationsOrigin, afterMarkFailedCounter)
+	}
+}
+
+type mNotificationRepositoryMockMarkSent struct {
+	optional           bool
+	mock               *NotificationRepositoryMock
+	defaultExpectation *NotificationRepositoryMockMarkSentExpectation
+	expectations       []*NotificationRepositoryMockMarkSentExpectation
+
+	callArgs []*NotificationRepositoryMockMarkSentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// NotificationRepositoryMockMarkSentExpectation specifies expectation struct of the NotificationRepository.MarkSent
+type NotificationRepositoryMockMarkSentExpectation struct {
+	mock               *NotificationRepositoryMock
+	params             *NotificationRepositoryMockMarkSentParams
+	paramPtrs          *NotificationRepositoryMockMarkSentParamPtrs
+	expectationOrigins NotificationRepositoryMockMarkSentExpectationOrigins
+	results            *NotificationRepositoryMockMarkSentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// NotificationRepositoryMockMarkSentParams contains parameters of the NotificationRepository.MarkSent
+type NotificationRepositoryMockMarkSentParams struct {
+	ctx context.Context
+	id  string
+}
+
+// NotificationRepositoryMockMarkSentParamPtrs contains pointers to parameters of the NotificationRepository.MarkSent
+type NotificationRepositoryMockMarkSentParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// NotificationRepositoryMockMarkSentResults contains results of the NotificationRepository.MarkSent
+type NotificationRepositoryMockMarkSentResults struct {
+	err error
+}
+
+// NotificationRepositoryMockMarkSentOrigins contains origins of expectations of the NotificationRepository.MarkSent
+type NotificationRepositoryMockMarkSentExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkSent *mNotificationRepositoryMockMarkSent) Optional() *mNotificationRepositoryMockMarkSent {
+	mmMarkSent.optional = true
+	return mmMarkSent
+}
+
+// Expect sets up expected params for NotificationRepository.MarkSent
+func (mmMarkSent *mNotificationRepositoryMockMarkSent) Expect(ctx context.Context, id string) *mNotificationRepositoryMockMarkSent {
+	if mmMarkSent.mock.funcMarkSent != nil {
+		mmMarkSent.mock.t.Fatalf("NotificationRepositoryMock.MarkSent mock is already set by Set")
+	}
+
+	if mmMarkSent.defaultExpectation == nil {
+		mmMarkSent.defaultExpectation = &NotificationRepositoryMockMarkSentExpectation{}
+	}
+
+	if mmMarkSent.defaultExpectation.paramPtrs != nil {
+		mmMarkSent.mock.t.Fatalf("NotificationRepositoryMock.MarkSent mock is already set by ExpectParams functions")
+	}
+
+	mmMarkSent.defaultExpectation.params = &NotificationRepositoryMockMarkSentParams{ctx, id}
+	mmMarkSent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkSent.expectations {
+		if minimock.Equal(e.params, mmMarkSent.defaultExpectation.params) {
+			mmMarkSent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkSent.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkSent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationRepository.MarkSent
+func (mmMarkSent *mNotificationRepositoryMockMarkSent) ExpectCtxParam1(ctx context.Context) *mNotificationRepositoryMockMarkSent {
+	if mmMarkSent.mock.funcMarkSent != nil {
+		mmMarkSent.mock.t.Fatalf("NotificationRepositoryMock.MarkSent mock is already set by Set")
+	}
+
+	if mmMarkSent.defaultExpectation == nil {
+		mmMarkSent.defaultExpectation = &NotificationRepositoryMockMarkSentExpectation{}
+	}
+
+	if mmMarkSent.defaultExpectation.params != nil {
+		mmMarkSent.mock.t.Fatalf("NotificationRepositoryMock.MarkSent mock is already set by Expect")
+	}
+
+	if mmMarkSent.defaultExpectation.paramPtrs == nil {
+		mmMarkSent.defaultExpectation.paramPtrs = &NotificationRepositoryMockMarkSentParamPtrs{}
+	}
+	mmMarkSent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkSent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkSent
+}
+
+// ExpectIdParam2 sets up expected param id for NotificationRepository.MarkSent
+func (mmMarkSent *mNotificationRepositoryMockMarkSent) ExpectIdParam2(id string) *mNotificationRepositoryMockMarkSent {
+	if mmMarkSent.mock.funcMarkSent != nil {
+		mmMarkSent.mock.t.Fatalf("NotificationRepositoryMock.MarkSent mock is already set by Set")
+	}
+
+	if mmMarkSent.defaultExpectation == nil {
+		mmMarkSent.defaultExpectation = &NotificationRepositoryMockMarkSentExpectation{}
+	}
+
+	if mmMarkSent.defaultExpectation.params != nil {
+		mmMarkSent.mock.t.Fatalf("NotificationRepositoryMock.MarkSent mock is already set by Expect")
+	}
+
+	if mmMarkSent.defaultExpectation.paramPtrs == nil {
+		mmMarkSent.defaultExpectation.paramPtrs = &NotificationRepositoryMockMarkSentParamPtrs{}
+	}
+	mmMarkSent.defaultExpectation.paramPtrs.id = &id
+	mmMarkSent.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmMarkSent
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationRepository.MarkSent
+func (mmMarkSent *mNotificationRepositoryMockMarkSent) Inspect(f func(ctx context.Context, id string)) *mNotificationRepositoryMockMarkSent {
+	if mmMarkSent.mock.inspectFuncMarkSent != nil {
+		mmMarkSent.mock.t.Fatalf("Inspect function is already set for NotificationRepositoryMock.MarkSent")
+	}
+
+	mmMarkSent.mock.inspectFuncMarkSent = f
+
+	return mmMarkSent
+}
+
+// Return sets up results that will be returned by NotificationRepository.MarkSent
+func (mmMarkSent *mNotificationRepositoryMockMarkSent) Return(err error) *NotificationRepositoryMock {
+	if mmMarkSent.mock.funcMarkSent != nil {
+		mmMarkSent.mock.t.Fatalf("NotificationRepositoryMock.MarkSent mock is already set by Set")
+	}
+
+	if mmMarkSent.defaultExpectation == nil {
+		mmMarkSent.defaultExpectation = &NotificationRepositoryMockMarkSentExpectation{mock: mmMarkSent.mock}
+	}
+	mmMarkSent.defaultExpectation.results = &NotificationRepositoryMockMarkSentResults{err}
+	mmMarkSent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkSent.mock
+}
+
+// Set uses given function f to mock the NotificationRepository.MarkSent method
+func (mmMarkSent *mNotificationRepositoryMockMarkSent) Set(f func(ctx context.Context, id string) (err error)) *NotificationRepositoryMock {
+	if mmMarkSent.defaultExpectation != nil {
+		mmMarkSent.mock.t.Fatalf("Default expectation is already set for the NotificationRepository.MarkSent method")
+	}
+
+	if len(mmMarkSent.expectations) > 0 {
+		mmMarkSent.mock.t.Fatalf("Some expectations are already set for the NotificationRepository.MarkSent method")
+	}
+
+	mmMarkSent.mock.funcMarkSent = f
+	mmMarkSent.mock.funcMarkSentOrigin = minimock.CallerInfo(1)
+	return mmMarkSent.mock
+}
+
+// When sets expectation for the NotificationRepository.MarkSent which will trigger the result defined by the following
+// Then helper
+func (mmMarkSent *mNotificationRepositoryMockMarkSent) When(ctx context.Context, id string) *NotificationRepositoryMockMarkSentExpectation {
+	if mmMarkSent.mock.funcMarkSent != nil {
+		mmMarkSent.mock.t.Fatalf("NotificationRepositoryMock.MarkSent mock is already set by Set")
+	}
+
+	expectation := &NotificationRepositoryMockMarkSentExpectation{
+		mock:               mmMarkSent.mock,
+		params:             &NotificationRepositoryMockMarkSentParams{ctx, id},
+		expectationOrigins: NotificationRepositoryMockMarkSentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkSent.expectations = append(mmMarkSent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationRepository.MarkSent return parameters for the expectation previously defined by the When method
+func (e *NotificationRepositoryMockMarkSentExpectation) Then(err error) *NotificationRepositoryMock {
+	e.results = &NotificationRepositoryMockMarkSentResults{err}
+	return e.mock
+}
+
+// Times sets number of times NotificationRepository.MarkSent should be invoked
+func (mmMarkSent *mNotificationRepositoryMockMarkSent) Times(n uint64) *mNotificationRepositoryMockMarkSent {
+	if n == 0 {
+		mmMarkSent.mock.t.Fatalf("Times of NotificationRepositoryMock.MarkSent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkSent.expectedInvocations, n)
+	mmMarkSent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkSent
+}
+
+func (mmMarkSent *mNotificationRepositoryMockMarkSent) invocationsDone() bool {
+	if len(mmMarkSent.expectations) == 0 && mmMarkSent.defaultExpectation == nil && mmMarkSent.mock.funcMarkSent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkSent.mock.afterMarkSentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkSent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkSent implements mm_repository.NotificationRepository
+func (mmMarkSent *NotificationRepositoryMock) MarkSent(ctx context.Context, id string) (err error) {
+	mm_atomic.AddUint64(&mmMarkSent.beforeMarkSentCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkSent.afterMarkSentCounter, 1)
+
+	mmMarkSent.t.Helper()
+
+	if mmMarkSent.inspectFuncMarkSent != nil {
+		mmMarkSent.inspectFuncMarkSent(ctx, id)
+	}
+
+	mm_params := NotificationRepositoryMockMarkSentParams{ctx, id}
+
+	// Record call args
+	mmMarkSent.MarkSentMock.mutex.Lock()
+	mmMarkSent.MarkSentMock.callArgs = append(mmMarkSent.MarkSentMock.callArgs, &mm_params)
+	mmMarkSent.MarkSentMock.mutex.Unlock()
+
+	for _, e := range mmMarkSent.MarkSentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkSent.MarkSentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkSent.MarkSentMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkSent.MarkSentMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkSent.MarkSentMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationRepositoryMockMarkSentParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkSent.t.Errorf("NotificationRepositoryMock.MarkSent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkSent.MarkSentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmMarkSent.t.Errorf("NotificationRepositoryMock.MarkSent got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkSent.MarkSentMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkSent.t.Errorf("NotificationRepositoryMock.MarkSent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkSent.MarkSentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkSent.MarkSentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkSent.t.Fatal("No results are set for the NotificationRepositoryMock.MarkSent")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkSent.funcMarkSent != nil {
+		return mmMarkSent.funcMarkSent(ctx, id)
+	}
+	mmMarkSent.t.Fatalf("Unexpected call to NotificationRepositoryMock.MarkSent. %v %v", ctx, id)
+	return
+}
+
+// MarkSentAfterCounter returns a count of finished NotificationRepositoryMock.MarkSent invocations
+func (mmMarkSent *NotificationRepositoryMock) MarkSentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkSent.afterMarkSentCounter)
+}
+
+// MarkSentBeforeCounter returns a count of NotificationRepositoryMock.MarkSent invocations
+func (mmMarkSent *NotificationRepositoryMock) MarkSentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkSent.beforeMarkSentCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationRepositoryMock.MarkSent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkSent *mNotificationRepositoryMockMarkSent) Calls() []*NotificationRepositoryMockMarkSentParams {
+	mmMarkSent.mutex.RLock()
+
+	argCopy := make([]*NotificationRepositoryMockMarkSentParams, len(mmMarkSent.callArgs))
+	copy(argCopy, mmMarkSent.callArgs)
+
+	mmMarkSent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkSentDone returns true if the count of the MarkSent invocations corresponds
+// the number of defined expectations
+func (m *NotificationRepositoryMock) MinimockMarkSentDone() bool {
+	if m.MarkSentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkSentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkSentMock.invocationsDone()
+}
+
+// MinimockMarkSentInspect logs each unmet expectation
+func (m *NotificationRepositoryMock) MinimockMarkSentInspect() {
+	for _, e := range m.MarkSentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.MarkSent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkSentCounter := mm_atomic.LoadUint64(&m.afterMarkSentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkSentMock.defaultExpectation != nil && afterMarkSentCounter < 1 {
+		if m.MarkSentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.MarkSent at\n%s", m.MarkSentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.MarkSent at\n%s with params: %#v", m.MarkSentMock.defaultExpectation.expectationOrigins.origin, *m.MarkSentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkSent != nil && afterMarkSentCounter < 1 {
+		m.t.Errorf("Expected call to NotificationRepositoryMock.MarkSent at\n%s", m.funcMarkSentOrigin)
+	}
+
+	if !m.MarkSentMock.invocationsDone() && afterMarkSentCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationRepositoryMock.MarkSent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkSentMock.expectedInvocations), m.MarkSentMock.expectedInvocationsOrigin, afterMarkSentCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *NotificationRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockClaimDueInspect()
+
+			m.MinimockCreateInspect()
+
+			m.MinimockMarkFailedInspect()
+
+			m.MinimockMarkSentInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *NotificationRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *NotificationRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockClaimDueDone() &&
+		m.MinimockCreateDone() &&
+		m.MinimockMarkFailedDone() &&
+		m.MinimockMarkSentDone()
+}
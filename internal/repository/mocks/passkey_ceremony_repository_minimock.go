@@ -0,0 +1,802 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// PasskeyCeremonyRepositoryMock implements mm_repository.PasskeyCeremonyRepository
+type PasskeyCeremonyRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcSave          func(ctx context.Context, id string, ceremony *model.PasskeyCeremony) (err error)
+	funcSaveOrigin    string
+	inspectFuncSave   func(ctx context.Context, id string, ceremony *model.PasskeyCeremony)
+	afterSaveCounter  uint64
+	beforeSaveCounter uint64
+	SaveMock          mPasskeyCeremonyRepositoryMockSave
+
+	funcTake          func(ctx context.Context, id string) (pp1 *model.PasskeyCeremony, err error)
+	funcTakeOrigin    string
+	inspectFuncTake   func(ctx context.Context, id string)
+	afterTakeCounter  uint64
+	beforeTakeCounter uint64
+	TakeMock          mPasskeyCeremonyRepositoryMockTake
+}
+
+// NewPasskeyCeremonyRepositoryMock returns a mock for mm_repository.PasskeyCeremonyRepository
+func NewPasskeyCeremonyRepositoryMock(t minimock.Tester) *PasskeyCeremonyRepositoryMock {
+	m := &PasskeyCeremonyRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.SaveMock = mPasskeyCeremonyRepositoryMockSave{mock: m}
+	m.SaveMock.callArgs = []*PasskeyCeremonyRepositoryMockSaveParams{}
+
+	m.TakeMock = mPasskeyCeremonyRepositoryMockTake{mock: m}
+	m.TakeMock.callArgs = []*PasskeyCeremonyRepositoryMockTakeParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPasskeyCeremonyRepositoryMockSave struct {
+	optional           bool
+	mock               *PasskeyCeremonyRepositoryMock
+	defaultExpectation *PasskeyCeremonyRepositoryMockSaveExpectation
+	expectations       []*PasskeyCeremonyRepositoryMockSaveExpectation
+
+	callArgs []*PasskeyCeremonyRepositoryMockSaveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PasskeyCeremonyRepositoryMockSaveExpectation specifies expectation struct of the PasskeyCeremonyRepository.Save
+type PasskeyCeremonyRepositoryMockSaveExpectation struct {
+	mock               *PasskeyCeremonyRepositoryMock
+	params             *PasskeyCeremonyRepositoryMockSaveParams
+	paramPtrs          *PasskeyCeremonyRepositoryMockSaveParamPtrs
+	expectationOrigins PasskeyCeremonyRepositoryMockSaveExpectationOrigins
+	results            *PasskeyCeremonyRepositoryMockSaveResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PasskeyCeremonyRepositoryMockSaveParams contains parameters of the PasskeyCeremonyRepository.Save
+type PasskeyCeremonyRepositoryMockSaveParams struct {
+	ctx      context.Context
+	id       string
+	ceremony *model.PasskeyCeremony
+}
+
+// PasskeyCeremonyRepositoryMockSaveParamPtrs contains pointers to parameters of the PasskeyCeremonyRepository.Save
+type PasskeyCeremonyRepositoryMockSaveParamPtrs struct {
+	ctx      *context.Context
+	id       *string
+	ceremony **model.PasskeyCeremony
+}
+
+// PasskeyCeremonyRepositoryMockSaveResults contains results of the PasskeyCeremonyRepository.Save
+type PasskeyCeremonyRepositoryMockSaveResults struct {
+	err error
+}
+
+// PasskeyCeremonyRepositoryMockSaveOrigins contains origins of expectations of the PasskeyCeremonyRepository.Save
+type PasskeyCeremonyRepositoryMockSaveExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originId       string
+	originCeremony string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSave *mPasskeyCeremonyRepositoryMockSave) Optional() *mPasskeyCeremonyRepositoryMockSave {
+	mmSave.optional = true
+	return mmSave
+}
+
+// Expect sets up expected params for PasskeyCeremonyRepository.Save
+func (mmSave *mPasskeyCeremonyRepositoryMockSave) Expect(ctx context.Context, id string, ceremony *model.PasskeyCeremony) *mPasskeyCeremonyRepositoryMockSave {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("PasskeyCeremonyRepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &PasskeyCeremonyRepositoryMockSaveExpectation{}
+	}
+
+	if mmSave.defaultExpectation.paramPtrs != nil {
+		mmSave.mock.t.Fatalf("PasskeyCeremonyRepositoryMock.Save mock is already set by ExpectParams functions")
+	}
+
+	mmSave.defaultExpectation.params = &PasskeyCeremonyRepositoryMockSaveParams{ctx, id, ceremony}
+	mmSave.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSave.expectations {
+		if minimock.Equal(e.params, mmSave.defaultExpectation.params) {
+			mmSave.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSave.defaultExpectation.params)
+		}
+	}
+
+	return mmSave
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PasskeyCeremonyRepository.Save
+func (mmSave *mPasskeyCeremonyRepositoryMockSave) ExpectCtxParam1(ctx context.Context) *mPasskeyCeremonyRepositoryMockSave {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("PasskeyCeremonyRepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &PasskeyCeremonyRepositoryMockSaveExpectation{}
+	}
+
+	if mmSave.defaultExpectation.params != nil {
+		mmSave.mock.t.Fatalf("PasskeyCeremonyRepositoryMock.Save mock is already set by Expect")
+	}
+
+	if mmSave.defaultExpectation.paramPtrs == nil {
+		mmSave.defaultExpectation.paramPtrs = &PasskeyCeremonyRepositoryMockSaveParamPtrs{}
+	}
+	mmSave.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSave.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSave
+}
+
+// ExpectIdParam2 sets up expected param id for PasskeyCeremonyRepository.Save
+func (mmSave *mPasskeyCeremonyRepositoryMockSave) ExpectIdParam2(id string) *mPasskeyCeremonyRepositoryMockSave {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("PasskeyCeremonyRepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &PasskeyCeremonyRepositoryMockSaveExpectation{}
+	}
+
+	if mmSave.defaultExpectation.params != nil {
+		mmSave.mock.t.Fatalf("PasskeyCeremonyRepositoryMock.Save mock is already set by Expect")
+	}
+
+	if mmSave.defaultExpectation.paramPtrs == nil {
+		mmSave.defaultExpectation.paramPtrs = &PasskeyCeremonyRepositoryMockSaveParamPtrs{}
+	}
+	mmSave.defaultExpectation.paramPtrs.id = &id
+	mmSave.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmSave
+}
+
+// ExpectCeremonyParam3 sets up expected param ceremony for PasskeyCeremonyRepository.Save
+func (mmSave *mPasskeyCeremonyRepositoryMockSave) ExpectCeremonyParam3(ceremony *model.PasskeyCeremony) *mPasskeyCeremonyRepositoryMockSave {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("PasskeyCeremonyRepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &PasskeyCeremonyRepositoryMockSaveExpectation{}
+	}
+
+	if mmSave.defaultExpectation.params != nil {
+		mmSave.mock.t.Fatalf("PasskeyCeremonyRepositoryMock.Save mock is already set by Expect")
+	}
+
+	if mmSave.defaultExpectation.paramPtrs == nil {
+		mmSave.defaultExpectation.paramPtrs = &PasskeyCeremonyRepositoryMockSaveParamPtrs{}
+	}
+	mmSave.defaultExpectation.paramPtrs.ceremony = &ceremony
+	mmSave.defaultExpectation.expectationOrigins.originCeremony = minimock.CallerInfo(1)
+
+	return mmSave
+}
+
+// Inspect accepts an inspector function that has same arguments as the PasskeyCeremonyRepository.Save
+func (mmSave *mPasskeyCeremonyRepositoryMockSave) Inspect(f func(ctx context.Context, id string, ceremony *model.PasskeyCeremony)) *mPasskeyCeremonyRepositoryMockSave {
+	if mmSave.mock.inspectFuncSave != nil {
+		mmSave.mock.t.Fatalf("Inspect function is already set for PasskeyCeremonyRepositoryMock.Save")
+	}
+
+	mmSave.mock.inspectFuncSave = f
+
+	return mmSave
+}
+
+// Return sets up results that will be returned by PasskeyCeremonyRepository.Save
+func (mmSave *mPasskeyCeremonyRepositoryMockSave) Return(err error) *PasskeyCeremonyRepositoryMock {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("PasskeyCeremonyRepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &PasskeyCeremonyRepositoryMockSaveExpectation{mock: mmSave.mock}
+	}
+	mmSave.defaultExpectation.results = &PasskeyCeremonyRepositoryMockSaveResults{err}
+	mmSave.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSave.mock
+}
+
+// Set uses given function f to mock the PasskeyCeremonyRepository.Save method
+func (mmSave *mPasskeyCeremonyRepositoryMockSave) Set(f func(ctx context.Context, id string, ceremony *model.PasskeyCeremony) (err error)) *PasskeyCeremonyRepositoryMock {
+	if mmSave.defaultExpectation != nil {
+		mmSave.mock.t.Fatalf("Default expectation is already set for the PasskeyCeremonyRepository.Save method")
+	}
+
+	if len(mmSave.expectations) > 0 {
+		mmSave.mock.t.Fatalf("Some expectations are already set for the PasskeyCeremonyRepository.Save method")
+	}
+
+	mmSave.mock.funcSave = f
+	mmSave.mock.funcSaveOrigin = minimock.CallerInfo(1)
+	return mmSave.mock
+}
+
+// When sets expectation for the PasskeyCeremonyRepository.Save which will trigger the result defined by the following
+// Then helper
+func (mmSave *mPasskeyCeremonyRepositoryMockSave) When(ctx context.Context, id string, ceremony *model.PasskeyCeremony) *PasskeyCeremonyRepositoryMockSaveExpectation {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("PasskeyCeremonyRepositoryMock.Save mock is already set by Set")
+	}
+
+	expectation := &PasskeyCeremonyRepositoryMockSaveExpectation{
+		mock:               mmSave.mock,
+		params:             &PasskeyCeremonyRepositoryMockSaveParams{ctx, id, ceremony},
+		expectationOrigins: PasskeyCeremonyRepositoryMockSaveExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSave.expectations = append(mmSave.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PasskeyCeremonyRepository.Save return parameters for the expectation previously defined by the When method
+func (e *PasskeyCeremonyRepositoryMockSaveExpectation) Then(err error) *PasskeyCeremonyRepositoryMock {
+	e.results = &PasskeyCeremonyRepositoryMockSaveResults{err}
+	return e.mock
+}
+
+// Times sets number of times PasskeyCeremonyRepository.Save should be invoked
+func (mmSave *mPasskeyCeremonyRepositoryMockSave) Times(n uint64) *mPasskeyCeremonyRepositoryMockSave {
+	if n == 0 {
+		mmSave.mock.t.Fatalf("Times of PasskeyCeremonyRepositoryMock.Save mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSave.expectedInvocations, n)
+	mmSave.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSave
+}
+
+func (mmSave *mPasskeyCeremonyRepositoryMockSave) invocationsDone() bool {
+	if len(mmSave.expectations) == 0 && mmSave.defaultExpectation == nil && mmSave.mock.funcSave == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSave.mock.afterSaveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSave.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Save implements mm_repository.PasskeyCeremonyRepository
+func (mmSave *PasskeyCeremonyRepositoryMock) Save(ctx context.Context, id string, ceremony *model.PasskeyCeremony) (err error) {
+	mm_atomic.AddUint64(&mmSave.beforeSaveCounter, 1)
+	defer mm_atomic.AddUint64(&mmSave.afterSaveCounter, 1)
+
+	mmSave.t.Helper()
+
+	if mmSave.inspectFuncSave != nil {
+		mmSave.inspectFuncSave(ctx, id, ceremony)
+	}
+
+	mm_params := PasskeyCeremonyRepositoryMockSaveParams{ctx, id, ceremony}
+
+	// Record call args
+	mmSave.SaveMock.mutex.Lock()
+	mmSave.SaveMock.callArgs = append(mmSave.SaveMock.callArgs, &mm_params)
+	mmSave.SaveMock.mutex.Unlock()
+
+	for _, e := range mmSave.SaveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSave.SaveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSave.SaveMock.defaultExpectation.Counter, 1)
+		mm_want := mmSave.SaveMock.defaultExpectation.params
+		mm_want_ptrs := mmSave.SaveMock.defaultExpectation.paramPtrs
+
+		mm_got := PasskeyCeremonyRepositoryMockSaveParams{ctx, id, ceremony}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSave.t.Errorf("PasskeyCeremonyRepositoryMock.Save got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSave.SaveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmSave.t.Errorf("PasskeyCeremonyRepositoryMock.Save got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSave.SaveMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.ceremony != nil && !minimock.Equal(*mm_want_ptrs.ceremony, mm_got.ceremony) {
+				mmSave.t.Errorf("PasskeyCeremonyRepositoryMock.Save got unexpected parameter ceremony, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSave.SaveMock.defaultExpectation.expectationOrigins.originCeremony, *mm_want_ptrs.ceremony, mm_got.ceremony, minimock.Diff(*mm_want_ptrs.ceremony, mm_got.ceremony))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSave.t.Errorf("PasskeyCeremonyRepositoryMock.Save got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSave.SaveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSave.SaveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSave.t.Fatal("No results are set for the PasskeyCeremonyRepositoryMock.Save")
+		}
+		return (*mm_results).err
+	}
+	if mmSave.funcSave != nil {
+		return mmSave.funcSave(ctx, id, ceremony)
+	}
+	mmSave.t.Fatalf("Unexpected call to PasskeyCeremonyRepositoryMock.Save. %v %v %v", ctx, id, ceremony)
+	return
+}
+
+// SaveAfterCounter returns a count of finished PasskeyCeremonyRepositoryMock.Save invocations
+func (mmSave *PasskeyCeremonyRepositoryMock) SaveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSave.afterSaveCounter)
+}
+
+// SaveBeforeCounter returns a count of PasskeyCeremonyRepositoryMock.Save invocations
+func (mmSave *PasskeyCeremonyRepositoryMock) SaveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSave.beforeSaveCounter)
+}
+
+// Calls returns a list of arguments used in each call to PasskeyCeremonyRepositoryMock.Save.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSave *mPasskeyCeremonyRepositoryMockSave) Calls() []*PasskeyCeremonyRepositoryMockSaveParams {
+	mmSave.mutex.RLock()
+
+	argCopy := make([]*PasskeyCeremonyRepositoryMockSaveParams, len(mmSave.callArgs))
+	copy(argCopy, mmSave.callArgs)
+
+	mmSave.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveDone returns true if the count of the Save invocations corresponds
+// the number of defined expectations
+func (m *PasskeyCeremonyRepositoryMock) MinimockSaveDone() bool {
+	if m.SaveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveMock.invocationsDone()
+}
+
+// MinimockSaveInspect logs each unmet expectation
+func (m *PasskeyCeremonyRepositoryMock) MinimockSaveInspect() {
+	for _, e := range m.SaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PasskeyCeremonyRepositoryMock.Save at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveCounter := mm_atomic.LoadUint64(&m.afterSaveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveMock.defaultExpectation != nil && afterSaveCounter < 1 {
+		if m.SaveMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PasskeyCeremonyRepositoryMock.Save at\n%s", m.SaveMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PasskeyCeremonyRepositoryMock.Save at\n%s with params: %#v", m.SaveMock.defaultExpectation.expectationOrigins.origin, *m.SaveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSave != nil && afterSaveCounter < 1 {
+		m.t.Errorf("Expected call to PasskeyCeremonyRepositoryMock.Save at\n%s", m.funcSaveOrigin)
+	}
+
+	if !m.SaveMock.invocationsDone() && afterSaveCounter > 0 {
+		m.t.Errorf("Expected %d calls to PasskeyCeremonyRepositoryMock.Save at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveMock.expectedInvocations), m.SaveMock.expectedInvocationsOrigin, afterSaveCounter)
+	}
+}
+
+type mPasskeyCeremonyRepositoryMockTake struct {
+	optional           bool
+	mock               *PasskeyCeremonyRepositoryMock
+	defaultExpectation *PasskeyCeremonyRepositoryMockTakeExpectation
+	expectations       []*PasskeyCeremonyRepositoryMockTakeExpectation
+
+	callArgs []*PasskeyCeremonyRepositoryMockTakeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PasskeyCeremonyRepositoryMockTakeExpectation specifies expectation struct of the PasskeyCeremonyRepository.Take
+type PasskeyCeremonyRepositoryMockTakeExpectation struct {
+	mock               *PasskeyCeremonyRepositoryMock
+	params             *PasskeyCeremonyRepositoryMockTakeParams
+	paramPtrs          *PasskeyCeremonyRepositoryMockTakeParamPtrs
+	expectationOrigins PasskeyCeremonyRepositoryMockTakeExpectationOrigins
+	results            *PasskeyCeremonyRepositoryMockTakeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PasskeyCeremonyRepositoryMockTakeParams contains parameters of the PasskeyCeremonyRepository.Take
+type PasskeyCeremonyRepositoryMockTakeParams struct {
+	ctx context.Context
+	id  string
+}
+
+// PasskeyCeremonyRepositoryMockTakeParamPtrs contains pointers to parameters of the PasskeyCeremonyRepository.Take
+type PasskeyCeremonyRepositoryMockTakeParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// PasskeyCeremonyRepositoryMockTakeResults contains results of the PasskeyCeremonyRepository.Take
+type PasskeyCeremonyRepositoryMockTakeResults struct {
+	pp1 *model.PasskeyCeremony
+	err error
+}
+
+// PasskeyCeremonyRepositoryMockTakeOrigins contains origins of expectations of the PasskeyCeremonyRepository.Take
+type PasskeyCeremonyRepositoryMockTakeExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTake *mPasskeyCeremonyRepositoryMockTake) Optional() *mPasskeyCeremonyRepositoryMockTake {
+	mmTake.optional = true
+	return mmTake
+}
+
+// Expect sets up expected params for PasskeyCeremonyRepository.Take
+func (mmTake *mPasskeyCeremonyRepositoryMockTake) Expect(ctx context.Context, id string) *mPasskeyCeremonyRepositoryMockTake {
+	if mmTake.mock.funcTake != nil {
+		mmTake.mock.t.Fatalf("PasskeyCeremonyRepositoryMock.Take mock is already set by Set")
+	}
+
+	if mmTake.defaultExpectation == nil {
+		mmTake.defaultExpectation = &PasskeyCeremonyRepositoryMockTakeExpectation{}
+	}
+
+	if mmTake.defaultExpectation.paramPtrs != nil {
+		mmTake.mock.t.Fatalf("PasskeyCeremonyRepositoryMock.Take mock is already set by ExpectParams functions")
+	}
+
+	mmTake.defaultExpectation.params = &PasskeyCeremonyRepositoryMockTakeParams{ctx, id}
+	mmTake.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmTake.expectations {
+		if minimock.Equal(e.params, mmTake.defaultExpectation.params) {
+			mmTake.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTake.defaultExpectation.params)
+		}
+	}
+
+	return mmTake
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PasskeyCeremonyRepository.Take
+func (mmTake *mPasskeyCeremonyRepositoryMockTake) ExpectCtxParam1(ctx context.Context) *mPasskeyCeremonyRepositoryMockTake {
+	if mmTake.mock.funcTake != nil {
+		mmTake.mock.t.Fatalf("PasskeyCeremonyRepositoryMock.Take mock is already set by Set")
+	}
+
+	if mmTake.defaultExpectation == nil {
+		mmTake.defaultExpectation = &PasskeyCeremonyRepositoryMockTakeExpectation{}
+	}
+
+	if mmTake.defaultExpectation.params != nil {
+		mmTake.mock.t.Fatalf("PasskeyCeremonyRepositoryMock.Take mock is already set by Expect")
+	}
+
+	if mmTake.defaultExpectation.paramPtrs == nil {
+		mmTake.defaultExpectation.paramPtrs = &PasskeyCeremonyRepositoryMockTakeParamPtrs{}
+	}
+	mmTake.defaultExpectation.paramPtrs.ctx = &ctx
+	mmTake.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmTake
+}
+
+// ExpectIdParam2 sets up expected param id for PasskeyCeremonyRepository.Take
+func (mmTake *mPasskeyCeremonyRepositoryMockTake) ExpectIdParam2(id string) *mPasskeyCeremonyRepositoryMockTake {
+	if mmTake.mock.funcTake != nil {
+		mmTake.mock.t.Fatalf("PasskeyCeremonyRepositoryMock.Take mock is already set by Set")
+	}
+
+	if mmTake.defaultExpectation == nil {
+		mmTake.defaultExpectation = &PasskeyCeremonyRepositoryMockTakeExpectation{}
+	}
+
+	if mmTake.defaultExpectation.params != nil {
+		mmTake.mock.t.Fatalf("PasskeyCeremonyRepositoryMock.Take mock is already set by Expect")
+	}
+
+	if mmTake.defaultExpectation.paramPtrs == nil {
+		mmTake.defaultExpectation.paramPtrs = &PasskeyCeremonyRepositoryMockTakeParamPtrs{}
+	}
+	mmTake.defaultExpectation.paramPtrs.id = &id
+	mmTake.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmTake
+}
+
+// Inspect accepts an inspector function that has same arguments as the PasskeyCeremonyRepository.Take
+func (mmTake *mPasskeyCeremonyRepositoryMockTake) Inspect(f func(ctx context.Context, id string)) *mPasskeyCeremonyRepositoryMockTake {
+	if mmTake.mock.inspectFuncTake != nil {
+		mmTake.mock.t.Fatalf("Inspect function is already set for PasskeyCeremonyRepositoryMock.Take")
+	}
+
+	mmTake.mock.inspectFuncTake = f
+
+	return mmTake
+}
+
+// Return sets up results that will be returned by PasskeyCeremonyRepository.Take
+func (mmTake *mPasskeyCeremonyRepositoryMockTake) Return(pp1 *model.PasskeyCeremony, err error) *PasskeyCeremonyRepositoryMock {
+	if mmTake.mock.funcTake != nil {
+		mmTake.mock.t.Fatalf("PasskeyCeremonyRepositoryMock.Take mock is already set by Set")
+	}
+
+	if mmTake.defaultExpectation == nil {
+		mmTake.defaultExpectation = &PasskeyCeremonyRepositoryMockTakeExpectation{mock: mmTake.mock}
+	}
+	mmTake.defaultExpectation.results = &PasskeyCeremonyRepositoryMockTakeResults{pp1, err}
+	mmTake.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmTake.mock
+}
+
+// Set uses given function f to mock the PasskeyCeremonyRepository.Take method
+func (mmTake *mPasskeyCeremonyRepositoryMockTake) Set(f func(ctx context.Context, id string) (pp1 *model.PasskeyCeremony, err error)) *PasskeyCeremonyRepositoryMock {
+	if mmTake.defaultExpectation != nil {
+		mmTake.mock.t.Fatalf("Default expectation is already set for the PasskeyCeremonyRepository.Take method")
+	}
+
+	if len(mmTake.expectations) > 0 {
+		mmTake.mock.t.Fatalf("Some expectations are already set for the PasskeyCeremonyRepository.Take method")
+	}
+
+	mmTake.mock.funcTake = f
+	mmTake.mock.funcTakeOrigin = minimock.CallerInfo(1)
+	return mmTake.mock
+}
+
+// When sets expectation for the PasskeyCeremonyRepository.Take which will trigger the result defined by the following
+// Then helper
+func (mmTake *mPasskeyCeremonyRepositoryMockTake) When(ctx context.Context, id string) *PasskeyCeremonyRepositoryMockTakeExpectation {
+	if mmTake.mock.funcTake != nil {
+		mmTake.mock.t.Fatalf("PasskeyCeremonyRepositoryMock.Take mock is already set by Set")
+	}
+
+	expectation := &PasskeyCeremonyRepositoryMockTakeExpectation{
+		mock:               mmTake.mock,
+		params:             &PasskeyCeremonyRepositoryMockTakeParams{ctx, id},
+		expectationOrigins: PasskeyCeremonyRepositoryMockTakeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmTake.expectations = append(mmTake.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PasskeyCeremonyRepository.Take return parameters for the expectation previously defined by the When method
+func (e *PasskeyCeremonyRepositoryMockTakeExpectation) Then(pp1 *model.PasskeyCeremony, err error) *PasskeyCeremonyRepositoryMock {
+	e.results = &PasskeyCeremonyRepositoryMockTakeResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times PasskeyCeremonyRepository.Take should be invoked
+func (mmTake *mPasskeyCeremonyRepositoryMockTake) Times(n uint64) *mPasskeyCeremonyRepositoryMockTake {
+	if n == 0 {
+		mmTake.mock.t.Fatalf("Times of PasskeyCeremonyRepositoryMock.Take mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTake.expectedInvocations, n)
+	mmTake.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmTake
+}
+
+func (mmTake *mPasskeyCeremonyRepositoryMockTake) invocationsDone() bool {
+	if len(mmTake.expectations) == 0 && mmTake.defaultExpectation == nil && mmTake.mock.funcTake == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTake.mock.afterTakeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTake.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Take implements mm_repository.PasskeyCeremonyRepository
+func (mmTake *PasskeyCeremonyRepositoryMock) Take(ctx context.Context, id string) (pp1 *model.PasskeyCeremony, err error) {
+	mm_atomic.AddUint64(&mmTake.beforeTakeCounter, 1)
+	defer mm_atomic.AddUint64(&mmTake.afterTakeCounter, 1)
+
+	mmTake.t.Helper()
+
+	if mmTake.inspectFuncTake != nil {
+		mmTake.inspectFuncTake(ctx, id)
+	}
+
+	mm_params := PasskeyCeremonyRepositoryMockTakeParams{ctx, id}
+
+	// Record call args
+	mmTake.TakeMock.mutex.Lock()
+	mmTake.TakeMock.callArgs = append(mmTake.TakeMock.callArgs, &mm_params)
+	mmTake.TakeMock.mutex.Unlock()
+
+	for _, e := range mmTake.TakeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmTake.TakeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTake.TakeMock.defaultExpectation.Counter, 1)
+		mm_want := mmTake.TakeMock.defaultExpectation.params
+		mm_want_ptrs := mmTake.TakeMock.defaultExpectation.paramPtrs
+
+		mm_got := PasskeyCeremonyRepositoryMockTakeParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmTake.t.Errorf("PasskeyCeremonyRepositoryMock.Take got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTake.TakeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmTake.t.Errorf("PasskeyCeremonyRepositoryMock.Take got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTake.TakeMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTake.t.Errorf("PasskeyCeremonyRepositoryMock.Take got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmTake.TakeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTake.TakeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTake.t.Fatal("No results are set for the PasskeyCeremonyRepositoryMock.Take")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmTake.funcTake != nil {
+		return mmTake.funcTake(ctx, id)
+	}
+	mmTake.t.Fatalf("Unexpected call to PasskeyCeremonyRepositoryMock.Take. %v %v", ctx, id)
+	return
+}
+
+// TakeAfterCounter returns a count of finished PasskeyCeremonyRepositoryMock.Take invocations
+func (mmTake *PasskeyCeremonyRepositoryMock) TakeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTake.afterTakeCounter)
+}
+
+// TakeBeforeCounter returns a count of PasskeyCeremonyRepositoryMock.Take invocations
+func (mmTake *PasskeyCeremonyRepositoryMock) TakeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTake.beforeTakeCounter)
+}
+
+// Calls returns a list of arguments used in each call to PasskeyCeremonyRepositoryMock.Take.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTake *mPasskeyCeremonyRepositoryMockTake) Calls() []*PasskeyCeremonyRepositoryMockTakeParams {
+	mmTake.mutex.RLock()
+
+	argCopy := make([]*PasskeyCeremonyRepositoryMockTakeParams, len(mmTake.callArgs))
+	copy(argCopy, mmTake.callArgs)
+
+	mmTake.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTakeDone returns true if the count of the Take invocations corresponds
+// the number of defined expectations
+func (m *PasskeyCeremonyRepositoryMock) MinimockTakeDone() bool {
+	if m.TakeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.TakeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.TakeMock.invocationsDone()
+}
+
+// MinimockTakeInspect logs each unmet expectation
+func (m *PasskeyCeremonyRepositoryMock) MinimockTakeInspect() {
+	for _, e := range m.TakeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PasskeyCeremonyRepositoryMock.Take at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterTakeCounter := mm_atomic.LoadUint64(&m.afterTakeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TakeMock.defaultExpectation != nil && afterTakeCounter < 1 {
+		if m.TakeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PasskeyCeremonyRepositoryMock.Take at\n%s", m.TakeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PasskeyCeremonyRepositoryMock.Take at\n%s with params: %#v", m.TakeMock.defaultExpectation.expectationOrigins.origin, *m.TakeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTake != nil && afterTakeCounter < 1 {
+		m.t.Errorf("Expected call to PasskeyCeremonyRepositoryMock.Take at\n%s", m.funcTakeOrigin)
+	}
+
+	if !m.TakeMock.invocationsDone() && afterTakeCounter > 0 {
+		m.t.Errorf("Expected %d calls to PasskeyCeremonyRepositoryMock.Take at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.TakeMock.expectedInvocations), m.TakeMock.expectedInvocationsOrigin, afterTakeCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PasskeyCeremonyRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockSaveInspect()
+
+			m.MinimockTakeInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PasskeyCeremonyRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PasskeyCeremonyRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockSaveDone() &&
+		m.MinimockTakeDone()
+}
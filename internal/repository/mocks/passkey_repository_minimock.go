@@ -0,0 +1,1189 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// PasskeyRepositoryMock implements mm_repository.PasskeyRepository
+type PasskeyRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, passkey *model.Passkey) (err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, passkey *model.Passkey)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mPasskeyRepositoryMockCreate
+
+	funcListByUser          func(ctx context.Context, userID string) (ppa1 []*model.Passkey, err error)
+	funcListByUserOrigin    string
+	inspectFuncListByUser   func(ctx context.Context, userID string)
+	afterListByUserCounter  uint64
+	beforeListByUserCounter uint64
+	ListByUserMock          mPasskeyRepositoryMockListByUser
+
+	funcUse          func(ctx context.Context, id []byte, signCount uint32, backupState bool) (b1 bool, err error)
+	funcUseOrigin    string
+	inspectFuncUse   func(ctx context.Context, id []byte, signCount uint32, backupState bool)
+	afterUseCounter  uint64
+	beforeUseCounter uint64
+	UseMock          mPasskeyRepositoryMockUse
+}
+
+// NewPasskeyRepositoryMock returns a mock for mm_repository.PasskeyRepository
+func NewPasskeyRepositoryMock(t minimock.Tester) *PasskeyRepositoryMock {
+	m := &PasskeyRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mPasskeyRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*PasskeyRepositoryMockCreateParams{}
+
+	m.ListByUserMock = mPasskeyRepositoryMockListByUser{mock: m}
+	m.ListByUserMock.callArgs = []*PasskeyRepositoryMockListByUserParams{}
+
+	m.UseMock = mPasskeyRepositoryMockUse{mock: m}
+	m.UseMock.callArgs = []*PasskeyRepositoryMockUseParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPasskeyRepositoryMockCreate struct {
+	optional           bool
+	mock               *PasskeyRepositoryMock
+	defaultExpectation *PasskeyRepositoryMockCreateExpectation
+	expectations       []*PasskeyRepositoryMockCreateExpectation
+
+	callArgs []*PasskeyRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PasskeyRepositoryMockCreateExpectation specifies expectation struct of the PasskeyRepository.Create
+type PasskeyRepositoryMockCreateExpectation struct {
+	mock               *PasskeyRepositoryMock
+	params             *PasskeyRepositoryMockCreateParams
+	paramPtrs          *PasskeyRepositoryMockCreateParamPtrs
+	expectationOrigins PasskeyRepositoryMockCreateExpectationOrigins
+	results            *PasskeyRepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PasskeyRepositoryMockCreateParams contains parameters of the PasskeyRepository.Create
+type PasskeyRepositoryMockCreateParams struct {
+	ctx     context.Context
+	passkey *model.Passkey
+}
+
+// PasskeyRepositoryMockCreateParamPtrs contains pointers to parameters of the PasskeyRepository.Create
+type PasskeyRepositoryMockCreateParamPtrs struct {
+	ctx     *context.Context
+	passkey **model.Passkey
+}
+
+// PasskeyRepositoryMockCreateResults contains results of the PasskeyRepository.Create
+type PasskeyRepositoryMockCreateResults struct {
+	err error
+}
+
+// PasskeyRepositoryMockCreateOrigins contains origins of expectations of the PasskeyRepository.Create
+type PasskeyRepositoryMockCreateExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originPasskey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mPasskeyRepositoryMockCreate) Optional() *mPasskeyRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for PasskeyRepository.Create
+func (mmCreate *mPasskeyRepositoryMockCreate) Expect(ctx context.Context, passkey *model.Passkey) *mPasskeyRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PasskeyRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &PasskeyRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("PasskeyRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &PasskeyRepositoryMockCreateParams{ctx, passkey}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PasskeyRepository.Create
+func (mmCreate *mPasskeyRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mPasskeyRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PasskeyRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &PasskeyRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("PasskeyRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &PasskeyRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectPasskeyParam2 sets up expected param passkey for PasskeyRepository.Create
+func (mmCreate *mPasskeyRepositoryMockCreate) ExpectPasskeyParam2(passkey *model.Passkey) *mPasskeyRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PasskeyRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &PasskeyRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("PasskeyRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &PasskeyRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.passkey = &passkey
+	mmCreate.defaultExpectation.expectationOrigins.originPasskey = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the PasskeyRepository.Create
+func (mmCreate *mPasskeyRepositoryMockCreate) Inspect(f func(ctx context.Context, passkey *model.Passkey)) *mPasskeyRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for PasskeyRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by PasskeyRepository.Create
+func (mmCreate *mPasskeyRepositoryMockCreate) Return(err error) *PasskeyRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PasskeyRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &PasskeyRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &PasskeyRepositoryMockCreateResults{err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the PasskeyRepository.Create method
+func (mmCreate *mPasskeyRepositoryMockCreate) Set(f func(ctx context.Context, passkey *model.Passkey) (err error)) *PasskeyRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the PasskeyRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the PasskeyRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the PasskeyRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mPasskeyRepositoryMockCreate) When(ctx context.Context, passkey *model.Passkey) *PasskeyRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PasskeyRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &PasskeyRepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &PasskeyRepositoryMockCreateParams{ctx, passkey},
+		expectationOrigins: PasskeyRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PasskeyRepository.Create return parameters for the expectation previously defined by the When method
+func (e *PasskeyRepositoryMockCreateExpectation) Then(err error) *PasskeyRepositoryMock {
+	e.results = &PasskeyRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times PasskeyRepository.Create should be invoked
+func (mmCreate *mPasskeyRepositoryMockCreate) Times(n uint64) *mPasskeyRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of PasskeyRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mPasskeyRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_repository.PasskeyRepository
+func (mmCreate *PasskeyRepositoryMock) Create(ctx context.Context, passkey *model.Passkey) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, passkey)
+	}
+
+	mm_params := PasskeyRepositoryMockCreateParams{ctx, passkey}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := PasskeyRepositoryMockCreateParams{ctx, passkey}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("PasskeyRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.passkey != nil && !minimock.Equal(*mm_want_ptrs.passkey, mm_got.passkey) {
+				mmCreate.t.Errorf("PasskeyRepositoryMock.Create got unexpected parameter passkey, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originPasskey, *mm_want_ptrs.passkey, mm_got.passkey, minimock.Diff(*mm_want_ptrs.passkey, mm_got.passkey))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("PasskeyRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the PasskeyRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, passkey)
+	}
+	mmCreate.t.Fatalf("Unexpected call to PasskeyRepositoryMock.Create. %v %v", ctx, passkey)
+	return
+}
+
+// CreateAfterCounter returns a count of finished PasskeyRepositoryMock.Create invocations
+func (mmCreate *PasskeyRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of PasskeyRepositoryMock.Create invocations
+func (mmCreate *PasskeyRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to PasskeyRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mPasskeyRepositoryMockCreate) Calls() []*PasskeyRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*PasskeyRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *PasskeyRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *PasskeyRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PasskeyRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PasskeyRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PasskeyRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to PasskeyRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to PasskeyRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mPasskeyRepositoryMockListByUser struct {
+	optional           bool
+	mock               *PasskeyRepositoryMock
+	defaultExpectation *PasskeyRepositoryMockListByUserExpectation
+	expectations       []*PasskeyRepositoryMockListByUserExpectation
+
+	callArgs []*PasskeyRepositoryMockListByUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PasskeyRepositoryMockListByUserExpectation specifies expectation struct of the PasskeyRepository.ListByUser
+type PasskeyRepositoryMockListByUserExpectation struct {
+	mock               *PasskeyRepositoryMock
+	params             *PasskeyRepositoryMockListByUserParams
+	paramPtrs          *PasskeyRepositoryMockListByUserParamPtrs
+	expectationOrigins PasskeyRepositoryMockListByUserExpectationOrigins
+	results            *PasskeyRepositoryMockListByUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PasskeyRepositoryMockListByUserParams contains parameters of the PasskeyRepository.ListByUser
+type PasskeyRepositoryMockListByUserParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// PasskeyRepositoryMockListByUserParamPtrs contains pointers to parameters of the PasskeyRepository.ListByUser
+type PasskeyRepositoryMockListByUserParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// PasskeyRepositoryMockListByUserResults contains results of the PasskeyRepository.ListByUser
+type PasskeyRepositoryMockListByUserResults struct {
+	ppa1 []*model.Passkey
+	err  error
+}
+
+// PasskeyRepositoryMockListByUserOrigins contains origins of expectations of the PasskeyRepository.ListByUser
+type PasskeyRepositoryMockListByUserExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListByUser *mPasskeyRepositoryMockListByUser) Optional() *mPasskeyRepositoryMockListByUser {
+	mmListByUser.optional = true
+	return mmListByUser
+}
+
+// Expect sets up expected params for PasskeyRepository.ListByUser
+func (mmListByUser *mPasskeyRepositoryMockListByUser) Expect(ctx context.Context, userID string) *mPasskeyRepositoryMockListByUser {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("PasskeyRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &PasskeyRepositoryMockListByUserExpectation{}
+	}
+
+	if mmListByUser.defaultExpectation.paramPtrs != nil {
+		mmListByUser.mock.t.Fatalf("PasskeyRepositoryMock.ListByUser mock is already set by ExpectParams functions")
+	}
+
+	mmListByUser.defaultExpectation.params = &PasskeyRepositoryMockListByUserParams{ctx, userID}
+	mmListByUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListByUser.expectations {
+		if minimock.Equal(e.params, mmListByUser.defaultExpectation.params) {
+			mmListByUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListByUser.defaultExpectation.params)
+		}
+	}
+
+	return mmListByUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PasskeyRepository.ListByUser
+func (mmListByUser *mPasskeyRepositoryMockListByUser) ExpectCtxParam1(ctx context.Context) *mPasskeyRepositoryMockListByUser {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("PasskeyRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &PasskeyRepositoryMockListByUserExpectation{}
+	}
+
+	if mmListByUser.defaultExpectation.params != nil {
+		mmListByUser.mock.t.Fatalf("PasskeyRepositoryMock.ListByUser mock is already set by Expect")
+	}
+
+	if mmListByUser.defaultExpectation.paramPtrs == nil {
+		mmListByUser.defaultExpectation.paramPtrs = &PasskeyRepositoryMockListByUserParamPtrs{}
+	}
+	mmListByUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListByUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListByUser
+}
+
+// ExpectUserIDParam2 sets up expected param userID for PasskeyRepository.ListByUser
+func (mmListByUser *mPasskeyRepositoryMockListByUser) ExpectUserIDParam2(userID string) *mPasskeyRepositoryMockListByUser {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("PasskeyRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &PasskeyRepositoryMockListByUserExpectation{}
+	}
+
+	if mmListByUser.defaultExpectation.params != nil {
+		mmListByUser.mock.t.Fatalf("PasskeyRepositoryMock.ListByUser mock is already set by Expect")
+	}
+
+	if mmListByUser.defaultExpectation.paramPtrs == nil {
+		mmListByUser.defaultExpectation.paramPtrs = &PasskeyRepositoryMockListByUserParamPtrs{}
+	}
+	mmListByUser.defaultExpectation.paramPtrs.userID = &userID
+	mmListByUser.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListByUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the PasskeyRepository.ListByUser
+func (mmListByUser *mPasskeyRepositoryMockListByUser) Inspect(f func(ctx context.Context, userID string)) *mPasskeyRepositoryMockListByUser {
+	if mmListByUser.mock.inspectFuncListByUser != nil {
+		mmListByUser.mock.t.Fatalf("Inspect function is already set for PasskeyRepositoryMock.ListByUser")
+	}
+
+	mmListByUser.mock.inspectFuncListByUser = f
+
+	return mmListByUser
+}
+
+// Return sets up results that will be returned by PasskeyRepository.ListByUser
+func (mmListByUser *mPasskeyRepositoryMockListByUser) Return(ppa1 []*model.Passkey, err error) *PasskeyRepositoryMock {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("PasskeyRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &PasskeyRepositoryMockListByUserExpectation{mock: mmListByUser.mock}
+	}
+	mmListByUser.defaultExpectation.results = &PasskeyRepositoryMockListByUserResults{ppa1, err}
+	mmListByUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListByUser.mock
+}
+
+// Set uses given function f to mock the PasskeyRepository.ListByUser method
+func (mmListByUser *mPasskeyRepositoryMockListByUser) Set(f func(ctx context.Context, userID string) (ppa1 []*model.Passkey, err error)) *PasskeyRepositoryMock {
+	if mmListByUser.defaultExpectation != nil {
+		mmListByUser.mock.t.Fatalf("Default expectation is already set for the PasskeyRepository.ListByUser method")
+	}
+
+	if len(mmListByUser.expectations) > 0 {
+		mmListByUser.mock.t.Fatalf("Some expectations are already set for the PasskeyRepository.ListByUser method")
+	}
+
+	mmListByUser.mock.funcListByUser = f
+	mmListByUser.mock.funcListByUserOrigin = minimock.CallerInfo(1)
+	return mmListByUser.mock
+}
+
+// When sets expectation for the PasskeyRepository.ListByUser which will trigger the result defined by the following
+// Then helper
+func (mmListByUser *mPasskeyRepositoryMockListByUser) When(ctx context.Context, userID string) *PasskeyRepositoryMockListByUserExpectation {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("PasskeyRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	expectation := &PasskeyRepositoryMockListByUserExpectation{
+		mock:               mmListByUser.mock,
+		params:             &PasskeyRepositoryMockListByUserParams{ctx, userID},
+		expectationOrigins: PasskeyRepositoryMockListByUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListByUser.expectations = append(mmListByUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PasskeyRepository.ListByUser return parameters for the expectation previously defined by the When method
+func (e *PasskeyRepositoryMockListByUserExpectation) Then(ppa1 []*model.Passkey, err error) *PasskeyRepositoryMock {
+	e.results = &PasskeyRepositoryMockListByUserResults{ppa1, err}
+	return e.mock
+}
+
+// Times sets number of times PasskeyRepository.ListByUser should be invoked
+func (mmListByUser *mPasskeyRepositoryMockListByUser) Times(n uint64) *mPasskeyRepositoryMockListByUser {
+	if n == 0 {
+		mmListByUser.mock.t.Fatalf("Times of PasskeyRepositoryMock.ListByUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListByUser.expectedInvocations, n)
+	mmListByUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListByUser
+}
+
+func (mmListByUser *mPasskeyRepositoryMockListByUser) invocationsDone() bool {
+	if len(mmListByUser.expectations) == 0 && mmListByUser.defaultExpectation == nil && mmListByUser.mock.funcListByUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListByUser.mock.afterListByUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListByUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListByUser implements mm_repository.PasskeyRepository
+func (mmListByUser *PasskeyRepositoryMock) ListByUser(ctx context.Context, userID string) (ppa1 []*model.Passkey, err error) {
+	mm_atomic.AddUint64(&mmListByUser.beforeListByUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmListByUser.afterListByUserCounter, 1)
+
+	mmListByUser.t.Helper()
+
+	if mmListByUser.inspectFuncListByUser != nil {
+		mmListByUser.inspectFuncListByUser(ctx, userID)
+	}
+
+	mm_params := PasskeyRepositoryMockListByUserParams{ctx, userID}
+
+	// Record call args
+	mmListByUser.ListByUserMock.mutex.Lock()
+	mmListByUser.ListByUserMock.callArgs = append(mmListByUser.ListByUserMock.callArgs, &mm_params)
+	mmListByUser.ListByUserMock.mutex.Unlock()
+
+	for _, e := range mmListByUser.ListByUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.err
+		}
+	}
+
+	if mmListByUser.ListByUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListByUser.ListByUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmListByUser.ListByUserMock.defaultExpectation.params
+		mm_want_ptrs := mmListByUser.ListByUserMock.defaultExpectation.paramPtrs
+
+		mm_got := PasskeyRepositoryMockListByUserParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListByUser.t.Errorf("PasskeyRepositoryMock.ListByUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListByUser.ListByUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListByUser.t.Errorf("PasskeyRepositoryMock.ListByUser got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListByUser.ListByUserMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListByUser.t.Errorf("PasskeyRepositoryMock.ListByUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListByUser.ListByUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListByUser.ListByUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListByUser.t.Fatal("No results are set for the PasskeyRepositoryMock.ListByUser")
+		}
+		return (*mm_results).ppa1, (*mm_results).err
+	}
+	if mmListByUser.funcListByUser != nil {
+		return mmListByUser.funcListByUser(ctx, userID)
+	}
+	mmListByUser.t.Fatalf("Unexpected call to PasskeyRepositoryMock.ListByUser. %v %v", ctx, userID)
+	return
+}
+
+// ListByUserAfterCounter returns a count of finished PasskeyRepositoryMock.ListByUser invocations
+func (mmListByUser *PasskeyRepositoryMock) ListByUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListByUser.afterListByUserCounter)
+}
+
+// ListByUserBeforeCounter returns a count of PasskeyRepositoryMock.ListByUser invocations
+func (mmListByUser *PasskeyRepositoryMock) ListByUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListByUser.beforeListByUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to PasskeyRepositoryMock.ListByUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListByUser *mPasskeyRepositoryMockListByUser) Calls() []*PasskeyRepositoryMockListByUserParams {
+	mmListByUser.mutex.RLock()
+
+	argCopy := make([]*PasskeyRepositoryMockListByUserParams, len(mmListByUser.callArgs))
+	copy(argCopy, mmListByUser.callArgs)
+
+	mmListByUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListByUserDone returns true if the count of the ListByUser invocations corresponds
+// the number of defined expectations
+func (m *PasskeyRepositoryMock) MinimockListByUserDone() bool {
+	if m.ListByUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListByUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListByUserMock.invocationsDone()
+}
+
+// MinimockListByUserInspect logs each unmet expectation
+func (m *PasskeyRepositoryMock) MinimockListByUserInspect() {
+	for _, e := range m.ListByUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PasskeyRepositoryMock.ListByUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListByUserCounter := mm_atomic.LoadUint64(&m.afterListByUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListByUserMock.defaultExpectation != nil && afterListByUserCounter < 1 {
+		if m.ListByUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PasskeyRepositoryMock.ListByUser at\n%s", m.ListByUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PasskeyRepositoryMock.ListByUser at\n%s with params: %#v", m.ListByUserMock.defaultExpectation.expectationOrigins.origin, *m.ListByUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListByUser != nil && afterListByUserCounter < 1 {
+		m.t.Errorf("Expected call to PasskeyRepositoryMock.ListByUser at\n%s", m.funcListByUserOrigin)
+	}
+
+	if !m.ListByUserMock.invocationsDone() && afterListByUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to PasskeyRepositoryMock.ListByUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListByUserMock.expectedInvocations), m.ListByUserMock.expectedInvocationsOrigin, afterListByUserCounter)
+	}
+}
+
+type mPasskeyRepositoryMockUse struct {
+	optional           bool
+	mock               *PasskeyRepositoryMock
+	defaultExpectation *PasskeyRepositoryMockUseExpectation
+	expectations       []*PasskeyRepositoryMockUseExpectation
+
+	callArgs []*PasskeyRepositoryMockUseParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PasskeyRepositoryMockUseExpectation specifies expectation struct of the PasskeyRepository.Use
+type PasskeyRepositoryMockUseExpectation struct {
+	mock               *PasskeyRepositoryMock
+	params             *PasskeyRepositoryMockUseParams
+	paramPtrs          *PasskeyRepositoryMockUseParamPtrs
+	expectationOrigins PasskeyRepositoryMockUseExpectationOrigins
+	results            *PasskeyRepositoryMockUseResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PasskeyRepositoryMockUseParams contains parameters of the PasskeyRepository.Use
+type PasskeyRepositoryMockUseParams struct {
+	ctx         context.Context
+	id          []byte
+	signCount   uint32
+	backupState bool
+}
+
+// PasskeyRepositoryMockUseParamPtrs contains pointers to parameters of the PasskeyRepository.Use
+type PasskeyRepositoryMockUseParamPtrs struct {
+	ctx         *context.Context
+	id          *[]byte
+	signCount   *uint32
+	backupState *bool
+}
+
+// PasskeyRepositoryMockUseResults contains results of the PasskeyRepository.Use
+type PasskeyRepositoryMockUseResults struct {
+	b1  bool
+	err error
+}
+
+// PasskeyRepositoryMockUseOrigins contains origins of expectations of the PasskeyRepository.Use
+type PasskeyRepositoryMockUseExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originId          string
+	originSignCount   string
+	originBackupState string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUse *mPasskeyRepositoryMockUse) Optional() *mPasskeyRepositoryMockUse {
+	mmUse.optional = true
+	return mmUse
+}
+
+// Expect sets up expected params for PasskeyRepository.Use
+func (mmUse *mPasskeyRepositoryMockUse) Expect(ctx context.Context, id []byte, signCount uint32, backupState bool) *mPasskeyRepositoryMockUse {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("PasskeyRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &PasskeyRepositoryMockUseExpectation{}
+	}
+
+	if mmUse.defaultExpectation.paramPtrs != nil {
+		mmUse.mock.t.Fatalf("PasskeyRepositoryMock.Use mock is already set by ExpectParams functions")
+	}
+
+	mmUse.defaultExpectation.params = &PasskeyRepositoryMockUseParams{ctx, id, signCount, backupState}
+	mmUse.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUse.expectations {
+		if minimock.Equal(e.params, mmUse.defaultExpectation.params) {
+			mmUse.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUse.defaultExpectation.params)
+		}
+	}
+
+	return mmUse
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PasskeyRepository.Use
+func (mmUse *mPasskeyRepositoryMockUse) ExpectCtxParam1(ctx context.Context) *mPasskeyRepositoryMockUse {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("PasskeyRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &PasskeyRepositoryMockUseExpectation{}
+	}
+
+	if mmUse.defaultExpectation.params != nil {
+		mmUse.mock.t.Fatalf("PasskeyRepositoryMock.Use mock is already set by Expect")
+	}
+
+	if mmUse.defaultExpectation.paramPtrs == nil {
+		mmUse.defaultExpectation.paramPtrs = &PasskeyRepositoryMockUseParamPtrs{}
+	}
+	mmUse.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUse.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUse
+}
+
+// ExpectIdParam2 sets up expected param id for PasskeyRepository.Use
+func (mmUse *mPasskeyRepositoryMockUse) ExpectIdParam2(id []byte) *mPasskeyRepositoryMockUse {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("PasskeyRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &PasskeyRepositoryMockUseExpectation{}
+	}
+
+	if mmUse.defaultExpectation.params != nil {
+		mmUse.mock.t.Fatalf("PasskeyRepositoryMock.Use mock is already set by Expect")
+	}
+
+	if mmUse.defaultExpectation.paramPtrs == nil {
+		mmUse.defaultExpectation.paramPtrs = &PasskeyRepositoryMockUseParamPtrs{}
+	}
+	mmUse.defaultExpectation.paramPtrs.id = &id
+	mmUse.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmUse
+}
+
+// ExpectSignCountParam3 sets up expected param signCount for PasskeyRepository.Use
+func (mmUse *mPasskeyRepositoryMockUse) ExpectSignCountParam3(signCount uint32) *mPasskeyRepositoryMockUse {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("PasskeyRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &PasskeyRepositoryMockUseExpectation{}
+	}
+
+	if mmUse.defaultExpectation.params != nil {
+		mmUse.mock.t.Fatalf("PasskeyRepositoryMock.Use mock is already set by Expect")
+	}
+
+	if mmUse.defaultExpectation.paramPtrs == nil {
+		mmUse.defaultExpectation.paramPtrs = &PasskeyRepositoryMockUseParamPtrs{}
+	}
+	mmUse.defaultExpectation.paramPtrs.signCount = &signCount
+	mmUse.defaultExpectation.expectationOrigins.originSignCount = minimock.CallerInfo(1)
+
+	return mmUse
+}
+
+// ExpectBackupStateParam4 sets up expected param backupState for PasskeyRepository.Use
+func (mmUse *mPasskeyRepositoryMockUse) ExpectBackupStateParam4(backupState bool) *mPasskeyRepositoryMockUse {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("PasskeyRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &PasskeyRepositoryMockUseExpectation{}
+	}
+
+	if mmUse.defaultExpectation.params != nil {
+		mmUse.mock.t.Fatalf("PasskeyRepositoryMock.Use mock is already set by Expect")
+	}
+
+	if mmUse.defaultExpectation.paramPtrs == nil {
+		mmUse.defaultExpectation.paramPtrs = &PasskeyRepositoryMockUseParamPtrs{}
+	}
+	mmUse.defaultExpectation.paramPtrs.backupState = &backupState
+	mmUse.defaultExpectation.expectationOrigins.originBackupState = minimock.CallerInfo(1)
+
+	return mmUse
+}
+
+// Inspect accepts an inspector function that has same arguments as the PasskeyRepository.Use
+func (mmUse *mPasskeyRepositoryMockUse) Inspect(f func(ctx context.Context, id []byte, signCount uint32, backupState bool)) *mPasskeyRepositoryMockUse {
+	if mmUse.mock.inspectFuncUse != nil {
+		mmUse.mock.t.Fatalf("Inspect function is already set for PasskeyRepositoryMock.Use")
+	}
+
+	mmUse.mock.inspectFuncUse = f
+
+	return mmUse
+}
+
+// Return sets up results that will be returned by PasskeyRepository.Use
+func (mmUse *mPasskeyRepositoryMockUse) Return(b1 bool, err error) *PasskeyRepositoryMock {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("PasskeyRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &PasskeyRepositoryMockUseExpectation{mock: mmUse.mock}
+	}
+	mmUse.defaultExpectation.results = &PasskeyRepositoryMockUseResults{b1, err}
+	mmUse.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUse.mock
+}
+
+// Set uses given function f to mock the PasskeyRepository.Use method
+func (mmUse *mPasskeyRepositoryMockUse) Set(f func(ctx context.Context, id []byte, signCount uint32, backupState bool) (b1 bool, err error)) *PasskeyRepositoryMock {
+	if mmUse.defaultExpectation != nil {
+		mmUse.mock.t.Fatalf("Default expectation is already set for the PasskeyRepository.Use method")
+	}
+
+	if len(mmUse.expectations) > 0 {
+		mmUse.mock.t.Fatalf("Some expectations are already set for the PasskeyRepository.Use method")
+	}
+
+	mmUse.mock.funcUse = f
+	mmUse.mock.funcUseOrigin = minimock.CallerInfo(1)
+	return mmUse.mock
+}
+
+// When sets expectation for the PasskeyRepository.Use which will trigger the result defined by the following
+// Then helper
+func (mmUse *mPasskeyRepositoryMockUse) When(ctx context.Context, id []byte, signCount uint32, backupState bool) *PasskeyRepositoryMockUseExpectation {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("PasskeyRepositoryMock.Use mock is already set by Set")
+	}
+
+	expectation := &PasskeyRepositoryMockUseExpectation{
+		mock:               mmUse.mock,
+		params:             &PasskeyRepositoryMockUseParams{ctx, id, signCount, backupState},
+		expectationOrigins: PasskeyRepositoryMockUseExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUse.expectations = append(mmUse.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PasskeyRepository.Use return parameters for the expectation previously defined by the When method
+func (e *PasskeyRepositoryMockUseExpectation) Then(b1 bool, err error) *PasskeyRepositoryMock {
+	e.results = &PasskeyRepositoryMockUseResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times PasskeyRepository.Use should be invoked
+func (mmUse *mPasskeyRepositoryMockUse) Times(n uint64) *mPasskeyRepositoryMockUse {
+	if n == 0 {
+		mmUse.mock.t.Fatalf("Times of PasskeyRepositoryMock.Use mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUse.expectedInvocations, n)
+	mmUse.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUse
+}
+
+func (mmUse *mPasskeyRepositoryMockUse) invocationsDone() bool {
+	if len(mmUse.expectations) == 0 && mmUse.defaultExpectation == nil && mmUse.mock.funcUse == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUse.mock.afterUseCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUse.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Use implements mm_repository.PasskeyRepository
+func (mmUse *PasskeyRepositoryMock) Use(ctx context.Context, id []byte, signCount uint32, backupState bool) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmUse.beforeUseCounter, 1)
+	defer mm_atomic.AddUint64(&mmUse.afterUseCounter, 1)
+
+	mmUse.t.Helper()
+
+	if mmUse.inspectFuncUse != nil {
+		mmUse.inspectFuncUse(ctx, id, signCount, backupState)
+	}
+
+	mm_params := PasskeyRepositoryMockUseParams{ctx, id, signCount, backupState}
+
+	// Record call args
+	mmUse.UseMock.mutex.Lock()
+	mmUse.UseMock.callArgs = append(mmUse.UseMock.callArgs, &mm_params)
+	mmUse.UseMock.mutex.Unlock()
+
+	for _, e := range mmUse.UseMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmUse.UseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUse.UseMock.defaultExpectation.Counter, 1)
+		mm_want := mmUse.UseMock.defaultExpectation.params
+		mm_want_ptrs := mmUse.UseMock.defaultExpectation.paramPtrs
+
+		mm_got := PasskeyRepositoryMockUseParams{ctx, id, signCount, backupState}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUse.t.Errorf("PasskeyRepositoryMock.Use got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUse.UseMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUse.t.Errorf("PasskeyRepositoryMock.Use got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUse.UseMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.signCount != nil && !minimock.Equal(*mm_want_ptrs.signCount, mm_got.signCount) {
+				mmUse.t.Errorf("PasskeyRepositoryMock.Use got unexpected parameter signCount, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUse.UseMock.defaultExpectation.expectationOrigins.originSignCount, *mm_want_ptrs.signCount, mm_got.signCount, minimock.Diff(*mm_want_ptrs.signCount, mm_got.signCount))
+			}
+
+			if mm_want_ptrs.backupState != nil && !minimock.Equal(*mm_want_ptrs.backupState, mm_got.backupState) {
+				mmUse.t.Errorf("PasskeyRepositoryMock.Use got unexpected parameter backupState, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUse.UseMock.defaultExpectation.expectationOrigins.originBackupState, *mm_want_ptrs.backupState, mm_got.backupState, minimock.Diff(*mm_want_ptrs.backupState, mm_got.backupState))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUse.t.Errorf("PasskeyRepositoryMock.Use got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUse.UseMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUse.UseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUse.t.Fatal("No results are set for the PasskeyRepositoryMock.Use")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmUse.funcUse != nil {
+		return mmUse.funcUse(ctx, id, signCount, backupState)
+	}
+	mmUse.t.Fatalf("Unexpected call to PasskeyRepositoryMock.Use. %v %v %v %v", ctx, id, signCount, backupState)
+	return
+}
+
+// UseAfterCounter returns a count of finished PasskeyRepositoryMock.Use invocations
+func (mmUse *PasskeyRepositoryMock) UseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUse.afterUseCounter)
+}
+
+// UseBeforeCounter returns a count of PasskeyRepositoryMock.Use invocations
+func (mmUse *PasskeyRepositoryMock) UseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUse.beforeUseCounter)
+}
+
+// Calls returns a list of arguments used in each call to PasskeyRepositoryMock.Use.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUse *mPasskeyRepositoryMockUse) Calls() []*PasskeyRepositoryMockUseParams {
+	mmUse.mutex.RLock()
+
+	argCopy := make([]*PasskeyRepositoryMockUseParams, len(mmUse.callArgs))
+	copy(argCopy, mmUse.callArgs)
+
+	mmUse.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUseDone returns true if the count of the Use invocations corresponds
+// the number of defined expectations
+func (m *PasskeyRepositoryMock) MinimockUseDone() bool {
+	if m.UseMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UseMock.invocationsDone()
+}
+
+// MinimockUseInspect logs each unmet expectation
+func (m *PasskeyRepositoryMock) MinimockUseInspect() {
+	for _, e := range m.UseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PasskeyRepositoryMock.Use at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUseCounter := mm_atomic.LoadUint64(&m.afterUseCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UseMock.defaultExpectation != nil && afterUseCounter < 1 {
+		if m.UseMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PasskeyRepositoryMock.Use at\n%s", m.UseMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PasskeyRepositoryMock.Use at\n%s with params: %#v", m.UseMock.defaultExpectation.expectationOrigins.origin, *m.UseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUse != nil && afterUseCounter < 1 {
+		m.t.Errorf("Expected call to PasskeyRepositoryMock.Use at\n%s", m.funcUseOrigin)
+	}
+
+	if !m.UseMock.invocationsDone() && afterUseCounter > 0 {
+		m.t.Errorf("Expected %d calls to PasskeyRepositoryMock.Use at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UseMock.expectedInvocations), m.UseMock.expectedInvocationsOrigin, afterUseCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PasskeyRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockListByUserInspect()
+
+			m.MinimockUseInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PasskeyRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PasskeyRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockListByUserDone() &&
+		m.MinimockUseDone()
+}
@@ -0,0 +1,1188 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// PasswordResetRepositoryMock implements mm_repository.PasswordResetRepository
+type PasswordResetRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, userID string, tokenHash string, expiresAt time.Time) (err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, userID string, tokenHash string, expiresAt time.Time)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mPasswordResetRepositoryMockCreate
+
+	funcDeleteByUser          func(ctx context.Context, userID string) (err error)
+	funcDeleteByUserOrigin    string
+	inspectFuncDeleteByUser   func(ctx context.Context, userID string)
+	afterDeleteByUserCounter  uint64
+	beforeDeleteByUserCounter uint64
+	DeleteByUserMock          mPasswordResetRepositoryMockDeleteByUser
+
+	funcUse          func(ctx context.Context, tokenHash string) (s1 string, err error)
+	funcUseOrigin    string
+	inspectFuncUse   func(ctx context.Context, tokenHash string)
+	afterUseCounter  uint64
+	beforeUseCounter uint64
+	UseMock          mPasswordResetRepositoryMockUse
+}
+
+// NewPasswordResetRepositoryMock returns a mock for mm_repository.PasswordResetRepository
+func NewPasswordResetRepositoryMock(t minimock.Tester) *PasswordResetRepositoryMock {
+	m := &PasswordResetRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mPasswordResetRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*PasswordResetRepositoryMockCreateParams{}
+
+	m.DeleteByUserMock = mPasswordResetRepositoryMockDeleteByUser{mock: m}
+	m.DeleteByUserMock.callArgs = []*PasswordResetRepositoryMockDeleteByUserParams{}
+
+	m.UseMock = mPasswordResetRepositoryMockUse{mock: m}
+	m.UseMock.callArgs = []*PasswordResetRepositoryMockUseParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPasswordResetRepositoryMockCreate struct {
+	optional           bool
+	mock               *PasswordResetRepositoryMock
+	defaultExpectation *PasswordResetRepositoryMockCreateExpectation
+	expectations       []*PasswordResetRepositoryMockCreateExpectation
+
+	callArgs []*PasswordResetRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PasswordResetRepositoryMockCreateExpectation specifies expectation struct of the PasswordResetRepository.Create
+type PasswordResetRepositoryMockCreateExpectation struct {
+	mock               *PasswordResetRepositoryMock
+	params             *PasswordResetRepositoryMockCreateParams
+	paramPtrs          *PasswordResetRepositoryMockCreateParamPtrs
+	expectationOrigins PasswordResetRepositoryMockCreateExpectationOrigins
+	results            *PasswordResetRepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PasswordResetRepositoryMockCreateParams contains parameters of the PasswordResetRepository.Create
+type PasswordResetRepositoryMockCreateParams struct {
+	ctx       context.Context
+	userID    string
+	tokenHash string
+	expiresAt time.Time
+}
+
+// PasswordResetRepositoryMockCreateParamPtrs contains pointers to parameters of the PasswordResetRepository.Create
+type PasswordResetRepositoryMockCreateParamPtrs struct {
+	ctx       *context.Context
+	userID    *string
+	tokenHash *string
+	expiresAt *time.Time
+}
+
+// PasswordResetRepositoryMockCreateResults contains results of the PasswordResetRepository.Create
+type PasswordResetRepositoryMockCreateResults struct {
+	err error
+}
+
+// PasswordResetRepositoryMockCreateOrigins contains origins of expectations of the PasswordResetRepository.Create
+type PasswordResetRepositoryMockCreateExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUserID    string
+	originTokenHash string
+	originExpiresAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mPasswordResetRepositoryMockCreate) Optional() *mPasswordResetRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for PasswordResetRepository.Create
+func (mmCreate *mPasswordResetRepositoryMockCreate) Expect(ctx context.Context, userID string, tokenHash string, expiresAt time.Time) *mPasswordResetRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PasswordResetRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &PasswordResetRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("PasswordResetRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &PasswordResetRepositoryMockCreateParams{ctx, userID, tokenHash, expiresAt}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PasswordResetRepository.Create
+func (mmCreate *mPasswordResetRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mPasswordResetRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PasswordResetRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &PasswordResetRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("PasswordResetRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &PasswordResetRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectUserIDParam2 sets up expected param userID for PasswordResetRepository.Create
+func (mmCreate *mPasswordResetRepositoryMockCreate) ExpectUserIDParam2(userID string) *mPasswordResetRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PasswordResetRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &PasswordResetRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("PasswordResetRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &PasswordResetRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.userID = &userID
+	mmCreate.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectTokenHashParam3 sets up expected param tokenHash for PasswordResetRepository.Create
+func (mmCreate *mPasswordResetRepositoryMockCreate) ExpectTokenHashParam3(tokenHash string) *mPasswordResetRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PasswordResetRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &PasswordResetRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("PasswordResetRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &PasswordResetRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.tokenHash = &tokenHash
+	mmCreate.defaultExpectation.expectationOrigins.originTokenHash = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectExpiresAtParam4 sets up expected param expiresAt for PasswordResetRepository.Create
+func (mmCreate *mPasswordResetRepositoryMockCreate) ExpectExpiresAtParam4(expiresAt time.Time) *mPasswordResetRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PasswordResetRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &PasswordResetRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("PasswordResetRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &PasswordResetRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.expiresAt = &expiresAt
+	mmCreate.defaultExpectation.expectationOrigins.originExpiresAt = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the PasswordResetRepository.Create
+func (mmCreate *mPasswordResetRepositoryMockCreate) Inspect(f func(ctx context.Context, userID string, tokenHash string, expiresAt time.Time)) *mPasswordResetRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for PasswordResetRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by PasswordResetRepository.Create
+func (mmCreate *mPasswordResetRepositoryMockCreate) Return(err error) *PasswordResetRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PasswordResetRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &PasswordResetRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &PasswordResetRepositoryMockCreateResults{err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the PasswordResetRepository.Create method
+func (mmCreate *mPasswordResetRepositoryMockCreate) Set(f func(ctx context.Context, userID string, tokenHash string, expiresAt time.Time) (err error)) *PasswordResetRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the PasswordResetRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the PasswordResetRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the PasswordResetRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mPasswordResetRepositoryMockCreate) When(ctx context.Context, userID string, tokenHash string, expiresAt time.Time) *PasswordResetRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PasswordResetRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &PasswordResetRepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &PasswordResetRepositoryMockCreateParams{ctx, userID, tokenHash, expiresAt},
+		expectationOrigins: PasswordResetRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PasswordResetRepository.Create return parameters for the expectation previously defined by the When method
+func (e *PasswordResetRepositoryMockCreateExpectation) Then(err error) *PasswordResetRepositoryMock {
+	e.results = &PasswordResetRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times PasswordResetRepository.Create should be invoked
+func (mmCreate *mPasswordResetRepositoryMockCreate) Times(n uint64) *mPasswordResetRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of PasswordResetRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mPasswordResetRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_repository.PasswordResetRepository
+func (mmCreate *PasswordResetRepositoryMock) Create(ctx context.Context, userID string, tokenHash string, expiresAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, userID, tokenHash, expiresAt)
+	}
+
+	mm_params := PasswordResetRepositoryMockCreateParams{ctx, userID, tokenHash, expiresAt}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := PasswordResetRepositoryMockCreateParams{ctx, userID, tokenHash, expiresAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("PasswordResetRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmCreate.t.Errorf("PasswordResetRepositoryMock.Create got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.tokenHash != nil && !minimock.Equal(*mm_want_ptrs.tokenHash, mm_got.tokenHash) {
+				mmCreate.t.Errorf("PasswordResetRepositoryMock.Create got unexpected parameter tokenHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originTokenHash, *mm_want_ptrs.tokenHash, mm_got.tokenHash, minimock.Diff(*mm_want_ptrs.tokenHash, mm_got.tokenHash))
+			}
+
+			if mm_want_ptrs.expiresAt != nil && !minimock.Equal(*mm_want_ptrs.expiresAt, mm_got.expiresAt) {
+				mmCreate.t.Errorf("PasswordResetRepositoryMock.Create got unexpected parameter expiresAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originExpiresAt, *mm_want_ptrs.expiresAt, mm_got.expiresAt, minimock.Diff(*mm_want_ptrs.expiresAt, mm_got.expiresAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("PasswordResetRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the PasswordResetRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, userID, tokenHash, expiresAt)
+	}
+	mmCreate.t.Fatalf("Unexpected call to PasswordResetRepositoryMock.Create. %v %v %v %v", ctx, userID, tokenHash, expiresAt)
+	return
+}
+
+// CreateAfterCounter returns a count of finished PasswordResetRepositoryMock.Create invocations
+func (mmCreate *PasswordResetRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of PasswordResetRepositoryMock.Create invocations
+func (mmCreate *PasswordResetRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to PasswordResetRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mPasswordResetRepositoryMockCreate) Calls() []*PasswordResetRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*PasswordResetRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *PasswordResetRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *PasswordResetRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PasswordResetRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PasswordResetRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PasswordResetRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to PasswordResetRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to PasswordResetRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mPasswordResetRepositoryMockDeleteByUser struct {
+	optional           bool
+	mock               *PasswordResetRepositoryMock
+	defaultExpectation *PasswordResetRepositoryMockDeleteByUserExpectation
+	expectations       []*PasswordResetRepositoryMockDeleteByUserExpectation
+
+	callArgs []*PasswordResetRepositoryMockDeleteByUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PasswordResetRepositoryMockDeleteByUserExpectation specifies expectation struct of the PasswordResetRepository.DeleteByUser
+type PasswordResetRepositoryMockDeleteByUserExpectation struct {
+	mock               *PasswordResetRepositoryMock
+	params             *PasswordResetRepositoryMockDeleteByUserParams
+	paramPtrs          *PasswordResetRepositoryMockDeleteByUserParamPtrs
+	expectationOrigins PasswordResetRepositoryMockDeleteByUserExpectationOrigins
+	results            *PasswordResetRepositoryMockDeleteByUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PasswordResetRepositoryMockDeleteByUserParams contains parameters of the PasswordResetRepository.DeleteByUser
+type PasswordResetRepositoryMockDeleteByUserParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// PasswordResetRepositoryMockDeleteByUserParamPtrs contains pointers to parameters of the PasswordResetRepository.DeleteByUser
+type PasswordResetRepositoryMockDeleteByUserParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// PasswordResetRepositoryMockDeleteByUserResults contains results of the PasswordResetRepository.DeleteByUser
+type PasswordResetRepositoryMockDeleteByUserResults struct {
+	err error
+}
+
+// PasswordResetRepositoryMockDeleteByUserOrigins contains origins of expectations of the PasswordResetRepository.DeleteByUser
+type PasswordResetRepositoryMockDeleteByUserExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteByUser *mPasswordResetRepositoryMockDeleteByUser) Optional() *mPasswordResetRepositoryMockDeleteByUser {
+	mmDeleteByUser.optional = true
+	return mmDeleteByUser
+}
+
+// Expect sets up expected params for PasswordResetRepository.DeleteByUser
+func (mmDeleteByUser *mPasswordResetRepositoryMockDeleteByUser) Expect(ctx context.Context, userID string) *mPasswordResetRepositoryMockDeleteByUser {
+	if mmDeleteByUser.mock.funcDeleteByUser != nil {
+		mmDeleteByUser.mock.t.Fatalf("PasswordResetRepositoryMock.DeleteByUser mock is already set by Set")
+	}
+
+	if mmDeleteByUser.defaultExpectation == nil {
+		mmDeleteByUser.defaultExpectation = &PasswordResetRepositoryMockDeleteByUserExpectation{}
+	}
+
+	if mmDeleteByUser.defaultExpectation.paramPtrs != nil {
+		mmDeleteByUser.mock.t.Fatalf("PasswordResetRepositoryMock.DeleteByUser mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteByUser.defaultExpectation.params = &PasswordResetRepositoryMockDeleteByUserParams{ctx, userID}
+	mmDeleteByUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteByUser.expectations {
+		if minimock.Equal(e.params, mmDeleteByUser.defaultExpectation.params) {
+			mmDeleteByUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteByUser.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteByUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PasswordResetRepository.DeleteByUser
+func (mmDeleteByUser *mPasswordResetRepositoryMockDeleteByUser) ExpectCtxParam1(ctx context.Context) *mPasswordResetRepositoryMockDeleteByUser {
+	if mmDeleteByUser.mock.funcDeleteByUser != nil {
+		mmDeleteByUser.mock.t.Fatalf("PasswordResetRepositoryMock.DeleteByUser mock is already set by Set")
+	}
+
+	if mmDeleteByUser.defaultExpectation == nil {
+		mmDeleteByUser.defaultExpectation = &PasswordResetRepositoryMockDeleteByUserExpectation{}
+	}
+
+	if mmDeleteByUser.defaultExpectation.params != nil {
+		mmDeleteByUser.mock.t.Fatalf("PasswordResetRepositoryMock.DeleteByUser mock is already set by Expect")
+	}
+
+	if mmDeleteByUser.defaultExpectation.paramPtrs == nil {
+		mmDeleteByUser.defaultExpectation.paramPtrs = &PasswordResetRepositoryMockDeleteByUserParamPtrs{}
+	}
+	mmDeleteByUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteByUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteByUser
+}
+
+// ExpectUserIDParam2 sets up expected param userID for PasswordResetRepository.DeleteByUser
+func (mmDeleteByUser *mPasswordResetRepositoryMockDeleteByUser) ExpectUserIDParam2(userID string) *mPasswordResetRepositoryMockDeleteByUser {
+	if mmDeleteByUser.mock.funcDeleteByUser != nil {
+		mmDeleteByUser.mock.t.Fatalf("PasswordResetRepositoryMock.DeleteByUser mock is already set by Set")
+	}
+
+	if mmDeleteByUser.defaultExpectation == nil {
+		mmDeleteByUser.defaultExpectation = &PasswordResetRepositoryMockDeleteByUserExpectation{}
+	}
+
+	if mmDeleteByUser.defaultExpectation.params != nil {
+		mmDeleteByUser.mock.t.Fatalf("PasswordResetRepositoryMock.DeleteByUser mock is already set by Expect")
+	}
+
+	if mmDeleteByUser.defaultExpectation.paramPtrs == nil {
+		mmDeleteByUser.defaultExpectation.paramPtrs = &PasswordResetRepositoryMockDeleteByUserParamPtrs{}
+	}
+	mmDeleteByUser.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteByUser.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteByUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the PasswordResetRepository.DeleteByUser
+func (mmDeleteByUser *mPasswordResetRepositoryMockDeleteByUser) Inspect(f func(ctx context.Context, userID string)) *mPasswordResetRepositoryMockDeleteByUser {
+	if mmDeleteByUser.mock.inspectFuncDeleteByUser != nil {
+		mmDeleteByUser.mock.t.Fatalf("Inspect function is already set for PasswordResetRepositoryMock.DeleteByUser")
+	}
+
+	mmDeleteByUser.mock.inspectFuncDeleteByUser = f
+
+	return mmDeleteByUser
+}
+
+// Return sets up results that will be returned by PasswordResetRepository.DeleteByUser
+func (mmDeleteByUser *mPasswordResetRepositoryMockDeleteByUser) Return(err error) *PasswordResetRepositoryMock {
+	if mmDeleteByUser.mock.funcDeleteByUser != nil {
+		mmDeleteByUser.mock.t.Fatalf("PasswordResetRepositoryMock.DeleteByUser mock is already set by Set")
+	}
+
+	if mmDeleteByUser.defaultExpectation == nil {
+		mmDeleteByUser.defaultExpectation = &PasswordResetRepositoryMockDeleteByUserExpectation{mock: mmDeleteByUser.mock}
+	}
+	mmDeleteByUser.defaultExpectation.results = &PasswordResetRepositoryMockDeleteByUserResults{err}
+	mmDeleteByUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteByUser.mock
+}
+
+// Set uses given function f to mock the PasswordResetRepository.DeleteByUser method
+func (mmDeleteByUser *mPasswordResetRepositoryMockDeleteByUser) Set(f func(ctx context.Context, userID string) (err error)) *PasswordResetRepositoryMock {
+	if mmDeleteByUser.defaultExpectation != nil {
+		mmDeleteByUser.mock.t.Fatalf("Default expectation is already set for the PasswordResetRepository.DeleteByUser method")
+	}
+
+	if len(mmDeleteByUser.expectations) > 0 {
+		mmDeleteByUser.mock.t.Fatalf("Some expectations are already set for the PasswordResetRepository.DeleteByUser method")
+	}
+
+	mmDeleteByUser.mock.funcDeleteByUser = f
+	mmDeleteByUser.mock.funcDeleteByUserOrigin = minimock.CallerInfo(1)
+	return mmDeleteByUser.mock
+}
+
+// When sets expectation for the PasswordResetRepository.DeleteByUser which will trigger the result defined by the following
+// Then helper
+func (mmDeleteByUser *mPasswordResetRepositoryMockDeleteByUser) When(ctx context.Context, userID string) *PasswordResetRepositoryMockDeleteByUserExpectation {
+	if mmDeleteByUser.mock.funcDeleteByUser != nil {
+		mmDeleteByUser.mock.t.Fatalf("PasswordResetRepositoryMock.DeleteByUser mock is already set by Set")
+	}
+
+	expectation := &PasswordResetRepositoryMockDeleteByUserExpectation{
+		mock:               mmDeleteByUser.mock,
+		params:             &PasswordResetRepositoryMockDeleteByUserParams{ctx, userID},
+		expectationOrigins: PasswordResetRepositoryMockDeleteByUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteByUser.expectations = append(mmDeleteByUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PasswordResetRepository.DeleteByUser return parameters for the expectation previously defined by the When method
+func (e *PasswordResetRepositoryMockDeleteByUserExpectation) Then(err error) *PasswordResetRepositoryMock {
+	e.results = &PasswordResetRepositoryMockDeleteByUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times PasswordResetRepository.DeleteByUser should be invoked
+func (mmDeleteByUser *mPasswordResetRepositoryMockDeleteByUser) Times(n uint64) *mPasswordResetRepositoryMockDeleteByUser {
+	if n == 0 {
+		mmDeleteByUser.mock.t.Fatalf("Times of PasswordResetRepositoryMock.DeleteByUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteByUser.expectedInvocations, n)
+	mmDeleteByUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteByUser
+}
+
+func (mmDeleteByUser *mPasswordResetRepositoryMockDeleteByUser) invocationsDone() bool {
+	if len(mmDeleteByUser.expectations) == 0 && mmDeleteByUser.defaultExpectation == nil && mmDeleteByUser.mock.funcDeleteByUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteByUser.mock.afterDeleteByUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteByUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteByUser implements mm_repository.PasswordResetRepository
+func (mmDeleteByUser *PasswordResetRepositoryMock) DeleteByUser(ctx context.Context, userID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteByUser.beforeDeleteByUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteByUser.afterDeleteByUserCounter, 1)
+
+	mmDeleteByUser.t.Helper()
+
+	if mmDeleteByUser.inspectFuncDeleteByUser != nil {
+		mmDeleteByUser.inspectFuncDeleteByUser(ctx, userID)
+	}
+
+	mm_params := PasswordResetRepositoryMockDeleteByUserParams{ctx, userID}
+
+	// Record call args
+	mmDeleteByUser.DeleteByUserMock.mutex.Lock()
+	mmDeleteByUser.DeleteByUserMock.callArgs = append(mmDeleteByUser.DeleteByUserMock.callArgs, &mm_params)
+	mmDeleteByUser.DeleteByUserMock.mutex.Unlock()
+
+	for _, e := range mmDeleteByUser.DeleteByUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteByUser.DeleteByUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteByUser.DeleteByUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteByUser.DeleteByUserMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteByUser.DeleteByUserMock.defaultExpectation.paramPtrs
+
+		mm_got := PasswordResetRepositoryMockDeleteByUserParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteByUser.t.Errorf("PasswordResetRepositoryMock.DeleteByUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteByUser.DeleteByUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteByUser.t.Errorf("PasswordResetRepositoryMock.DeleteByUser got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteByUser.DeleteByUserMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteByUser.t.Errorf("PasswordResetRepositoryMock.DeleteByUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteByUser.DeleteByUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteByUser.DeleteByUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteByUser.t.Fatal("No results are set for the PasswordResetRepositoryMock.DeleteByUser")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteByUser.funcDeleteByUser != nil {
+		return mmDeleteByUser.funcDeleteByUser(ctx, userID)
+	}
+	mmDeleteByUser.t.Fatalf("Unexpected call to PasswordResetRepositoryMock.DeleteByUser. %v %v", ctx, userID)
+	return
+}
+
+// DeleteByUserAfterCounter returns a count of finished PasswordResetRepositoryMock.DeleteByUser invocations
+func (mmDeleteByUser *PasswordResetRepositoryMock) DeleteByUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteByUser.afterDeleteByUserCounter)
+}
+
+// DeleteByUserBeforeCounter returns a count of PasswordResetRepositoryMock.DeleteByUser invocations
+func (mmDeleteByUser *PasswordResetRepositoryMock) DeleteByUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteByUser.beforeDeleteByUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to PasswordResetRepositoryMock.DeleteByUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteByUser *mPasswordResetRepositoryMockDeleteByUser) Calls() []*PasswordResetRepositoryMockDeleteByUserParams {
+	mmDeleteByUser.mutex.RLock()
+
+	argCopy := make([]*PasswordResetRepositoryMockDeleteByUserParams, len(mmDeleteByUser.callArgs))
+	copy(argCopy, mmDeleteByUser.callArgs)
+
+	mmDeleteByUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteByUserDone returns true if the count of the DeleteByUser invocations corresponds
+// the number of defined expectations
+func (m *PasswordResetRepositoryMock) MinimockDeleteByUserDone() bool {
+	if m.DeleteByUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteByUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteByUserMock.invocationsDone()
+}
+
+// MinimockDeleteByUserInspect logs each unmet expectation
+func (m *PasswordResetRepositoryMock) MinimockDeleteByUserInspect() {
+	for _, e := range m.DeleteByUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PasswordResetRepositoryMock.DeleteByUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteByUserCounter := mm_atomic.LoadUint64(&m.afterDeleteByUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteByUserMock.defaultExpectation != nil && afterDeleteByUserCounter < 1 {
+		if m.DeleteByUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PasswordResetRepositoryMock.DeleteByUser at\n%s", m.DeleteByUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PasswordResetRepositoryMock.DeleteByUser at\n%s with params: %#v", m.DeleteByUserMock.defaultExpectation.expectationOrigins.origin, *m.DeleteByUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteByUser != nil && afterDeleteByUserCounter < 1 {
+		m.t.Errorf("Expected call to PasswordResetRepositoryMock.DeleteByUser at\n%s", m.funcDeleteByUserOrigin)
+	}
+
+	if !m.DeleteByUserMock.invocationsDone() && afterDeleteByUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to PasswordResetRepositoryMock.DeleteByUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteByUserMock.expectedInvocations), m.DeleteByUserMock.expectedInvocationsOrigin, afterDeleteByUserCounter)
+	}
+}
+
+type mPasswordResetRepositoryMockUse struct {
+	optional           bool
+	mock               *PasswordResetRepositoryMock
+	defaultExpectation *PasswordResetRepositoryMockUseExpectation
+	expectations       []*PasswordResetRepositoryMockUseExpectation
+
+	callArgs []*PasswordResetRepositoryMockUseParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PasswordResetRepositoryMockUseExpectation specifies expectation struct of the PasswordResetRepository.Use
+type PasswordResetRepositoryMockUseExpectation struct {
+	mock               *PasswordResetRepositoryMock
+	params             *PasswordResetRepositoryMockUseParams
+	paramPtrs          *PasswordResetRepositoryMockUseParamPtrs
+	expectationOrigins PasswordResetRepositoryMockUseExpectationOrigins
+	results            *PasswordResetRepositoryMockUseResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PasswordResetRepositoryMockUseParams contains parameters of the PasswordResetRepository.Use
+type PasswordResetRepositoryMockUseParams struct {
+	ctx       context.Context
+	tokenHash string
+}
+
+// PasswordResetRepositoryMockUseParamPtrs contains pointers to parameters of the PasswordResetRepository.Use
+type PasswordResetRepositoryMockUseParamPtrs struct {
+	ctx       *context.Context
+	tokenHash *string
+}
+
+// PasswordResetRepositoryMockUseResults contains results of the PasswordResetRepository.Use
+type PasswordResetRepositoryMockUseResults struct {
+	s1  string
+	err error
+}
+
+// PasswordResetRepositoryMockUseOrigins contains origins of expectations of the PasswordResetRepository.Use
+type PasswordResetRepositoryMockUseExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originTokenHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUse *mPasswordResetRepositoryMockUse) Optional() *mPasswordResetRepositoryMockUse {
+	mmUse.optional = true
+	return mmUse
+}
+
+// Expect sets up expected params for PasswordResetRepository.Use
+func (mmUse *mPasswordResetRepositoryMockUse) Expect(ctx context.Context, tokenHash string) *mPasswordResetRepositoryMockUse {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("PasswordResetRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &PasswordResetRepositoryMockUseExpectation{}
+	}
+
+	if mmUse.defaultExpectation.paramPtrs != nil {
+		mmUse.mock.t.Fatalf("PasswordResetRepositoryMock.Use mock is already set by ExpectParams functions")
+	}
+
+	mmUse.defaultExpectation.params = &PasswordResetRepositoryMockUseParams{ctx, tokenHash}
+	mmUse.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUse.expectations {
+		if minimock.Equal(e.params, mmUse.defaultExpectation.params) {
+			mmUse.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUse.defaultExpectation.params)
+		}
+	}
+
+	return mmUse
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PasswordResetRepository.Use
+func (mmUse *mPasswordResetRepositoryMockUse) ExpectCtxParam1(ctx context.Context) *mPasswordResetRepositoryMockUse {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("PasswordResetRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &PasswordResetRepositoryMockUseExpectation{}
+	}
+
+	if mmUse.defaultExpectation.params != nil {
+		mmUse.mock.t.Fatalf("PasswordResetRepositoryMock.Use mock is already set by Expect")
+	}
+
+	if mmUse.defaultExpectation.paramPtrs == nil {
+		mmUse.defaultExpectation.paramPtrs = &PasswordResetRepositoryMockUseParamPtrs{}
+	}
+	mmUse.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUse.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUse
+}
+
+// ExpectTokenHashParam2 sets up expected param tokenHash for PasswordResetRepository.Use
+func (mmUse *mPasswordResetRepositoryMockUse) ExpectTokenHashParam2(tokenHash string) *mPasswordResetRepositoryMockUse {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("PasswordResetRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &PasswordResetRepositoryMockUseExpectation{}
+	}
+
+	if mmUse.defaultExpectation.params != nil {
+		mmUse.mock.t.Fatalf("PasswordResetRepositoryMock.Use mock is already set by Expect")
+	}
+
+	if mmUse.defaultExpectation.paramPtrs == nil {
+		mmUse.defaultExpectation.paramPtrs = &PasswordResetRepositoryMockUseParamPtrs{}
+	}
+	mmUse.defaultExpectation.paramPtrs.tokenHash = &tokenHash
+	mmUse.defaultExpectation.expectationOrigins.originTokenHash = minimock.CallerInfo(1)
+
+	return mmUse
+}
+
+// Inspect accepts an inspector function that has same arguments as the PasswordResetRepository.Use
+func (mmUse *mPasswordResetRepositoryMockUse) Inspect(f func(ctx context.Context, tokenHash string)) *mPasswordResetRepositoryMockUse {
+	if mmUse.mock.inspectFuncUse != nil {
+		mmUse.mock.t.Fatalf("Inspect function is already set for PasswordResetRepositoryMock.Use")
+	}
+
+	mmUse.mock.inspectFuncUse = f
+
+	return mmUse
+}
+
+// Return sets up results that will be returned by PasswordResetRepository.Use
+func (mmUse *mPasswordResetRepositoryMockUse) Return(s1 string, err error) *PasswordResetRepositoryMock {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("PasswordResetRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &PasswordResetRepositoryMockUseExpectation{mock: mmUse.mock}
+	}
+	mmUse.defaultExpectation.results = &PasswordResetRepositoryMockUseResults{s1, err}
+	mmUse.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUse.mock
+}
+
+// Set uses given function f to mock the PasswordResetRepository.Use method
+func (mmUse *mPasswordResetRepositoryMockUse) Set(f func(ctx context.Context, tokenHash string) (s1 string, err error)) *PasswordResetRepositoryMock {
+	if mmUse.defaultExpectation != nil {
+		mmUse.mock.t.Fatalf("Default expectation is already set for the PasswordResetRepository.Use method")
+	}
+
+	if len(mmUse.expectations) > 0 {
+		mmUse.mock.t.Fatalf("Some expectations are already set for the PasswordResetRepository.Use method")
+	}
+
+	mmUse.mock.funcUse = f
+	mmUse.mock.funcUseOrigin = minimock.CallerInfo(1)
+	return mmUse.mock
+}
+
+// When sets expectation for the PasswordResetRepository.Use which will trigger the result defined by the following
+// Then helper
+func (mmUse *mPasswordResetRepositoryMockUse) When(ctx context.Context, tokenHash string) *PasswordResetRepositoryMockUseExpectation {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("PasswordResetRepositoryMock.Use mock is already set by Set")
+	}
+
+	expectation := &PasswordResetRepositoryMockUseExpectation{
+		mock:               mmUse.mock,
+		params:             &PasswordResetRepositoryMockUseParams{ctx, tokenHash},
+		expectationOrigins: PasswordResetRepositoryMockUseExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUse.expectations = append(mmUse.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PasswordResetRepository.Use return parameters for the expectation previously defined by the When method
+func (e *PasswordResetRepositoryMockUseExpectation) Then(s1 string, err error) *PasswordResetRepositoryMock {
+	e.results = &PasswordResetRepositoryMockUseResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times PasswordResetRepository.Use should be invoked
+func (mmUse *mPasswordResetRepositoryMockUse) Times(n uint64) *mPasswordResetRepositoryMockUse {
+	if n == 0 {
+		mmUse.mock.t.Fatalf("Times of PasswordResetRepositoryMock.Use mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUse.expectedInvocations, n)
+	mmUse.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUse
+}
+
+func (mmUse *mPasswordResetRepositoryMockUse) invocationsDone() bool {
+	if len(mmUse.expectations) == 0 && mmUse.defaultExpectation == nil && mmUse.mock.funcUse == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUse.mock.afterUseCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUse.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Use implements mm_repository.PasswordResetRepository
+func (mmUse *PasswordResetRepositoryMock) Use(ctx context.Context, tokenHash string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmUse.beforeUseCounter, 1)
+	defer mm_atomic.AddUint64(&mmUse.afterUseCounter, 1)
+
+	mmUse.t.Helper()
+
+	if mmUse.inspectFuncUse != nil {
+		mmUse.inspectFuncUse(ctx, tokenHash)
+	}
+
+	mm_params := PasswordResetRepositoryMockUseParams{ctx, tokenHash}
+
+	// Record call args
+	mmUse.UseMock.mutex.Lock()
+	mmUse.UseMock.callArgs = append(mmUse.UseMock.callArgs, &mm_params)
+	mmUse.UseMock.mutex.Unlock()
+
+	for _, e := range mmUse.UseMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmUse.UseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUse.UseMock.defaultExpectation.Counter, 1)
+		mm_want := mmUse.UseMock.defaultExpectation.params
+		mm_want_ptrs := mmUse.UseMock.defaultExpectation.paramPtrs
+
+		mm_got := PasswordResetRepositoryMockUseParams{ctx, tokenHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUse.t.Errorf("PasswordResetRepositoryMock.Use got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUse.UseMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tokenHash != nil && !minimock.Equal(*mm_want_ptrs.tokenHash, mm_got.tokenHash) {
+				mmUse.t.Errorf("PasswordResetRepositoryMock.Use got unexpected parameter tokenHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUse.UseMock.defaultExpectation.expectationOrigins.originTokenHash, *mm_want_ptrs.tokenHash, mm_got.tokenHash, minimock.Diff(*mm_want_ptrs.tokenHash, mm_got.tokenHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUse.t.Errorf("PasswordResetRepositoryMock.Use got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUse.UseMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUse.UseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUse.t.Fatal("No results are set for the PasswordResetRepositoryMock.Use")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmUse.funcUse != nil {
+		return mmUse.funcUse(ctx, tokenHash)
+	}
+	mmUse.t.Fatalf("Unexpected call to PasswordResetRepositoryMock.Use. %v %v", ctx, tokenHash)
+	return
+}
+
+// UseAfterCounter returns a count of finished PasswordResetRepositoryMock.Use invocations
+func (mmUse *PasswordResetRepositoryMock) UseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUse.afterUseCounter)
+}
+
+// UseBeforeCounter returns a count of PasswordResetRepositoryMock.Use invocations
+func (mmUse *PasswordResetRepositoryMock) UseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUse.beforeUseCounter)
+}
+
+// Calls returns a list of arguments used in each call to PasswordResetRepositoryMock.Use.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUse *mPasswordResetRepositoryMockUse) Calls() []*PasswordResetRepositoryMockUseParams {
+	mmUse.mutex.RLock()
+
+	argCopy := make([]*PasswordResetRepositoryMockUseParams, len(mmUse.callArgs))
+	copy(argCopy, mmUse.callArgs)
+
+	mmUse.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUseDone returns true if the count of the Use invocations corresponds
+// the number of defined expectations
+func (m *PasswordResetRepositoryMock) MinimockUseDone() bool {
+	if m.UseMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UseMock.invocationsDone()
+}
+
+// MinimockUseInspect logs each unmet expectation
+func (m *PasswordResetRepositoryMock) MinimockUseInspect() {
+	for _, e := range m.UseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PasswordResetRepositoryMock.Use at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUseCounter := mm_atomic.LoadUint64(&m.afterUseCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UseMock.defaultExpectation != nil && afterUseCounter < 1 {
+		if m.UseMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PasswordResetRepositoryMock.Use at\n%s", m.UseMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PasswordResetRepositoryMock.Use at\n%s with params: %#v", m.UseMock.defaultExpectation.expectationOrigins.origin, *m.UseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUse != nil && afterUseCounter < 1 {
+		m.t.Errorf("Expected call to PasswordResetRepositoryMock.Use at\n%s", m.funcUseOrigin)
+	}
+
+	if !m.UseMock.invocationsDone() && afterUseCounter > 0 {
+		m.t.Errorf("Expected %d calls to PasswordResetRepositoryMock.Use at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UseMock.expectedInvocations), m.UseMock.expectedInvocationsOrigin, afterUseCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PasswordResetRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockDeleteByUserInspect()
+
+			m.MinimockUseInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PasswordResetRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PasswordResetRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockDeleteByUserDone() &&
+		m.MinimockUseDone()
+}
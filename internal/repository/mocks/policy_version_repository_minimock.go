@@ -0,0 +1,1419 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// PolicyVersionRepositoryMock implements mm_repository.PolicyVersionRepository
+type PolicyVersionRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddDenyRulesVersion          func(ctx context.Context, version string) (b1 bool, err error)
+	funcAddDenyRulesVersionOrigin    string
+	inspectFuncAddDenyRulesVersion   func(ctx context.Context, version string)
+	afterAddDenyRulesVersionCounter  uint64
+	beforeAddDenyRulesVersionCounter uint64
+	AddDenyRulesVersionMock          mPolicyVersionRepositoryMockAddDenyRulesVersion
+
+	funcDeleteDenyRulesVersion          func(ctx context.Context) (err error)
+	funcDeleteDenyRulesVersionOrigin    string
+	inspectFuncDeleteDenyRulesVersion   func(ctx context.Context)
+	afterDeleteDenyRulesVersionCounter  uint64
+	beforeDeleteDenyRulesVersionCounter uint64
+	DeleteDenyRulesVersionMock          mPolicyVersionRepositoryMockDeleteDenyRulesVersion
+
+	funcGetDenyRulesVersion          func(ctx context.Context) (s1 string, err error)
+	funcGetDenyRulesVersionOrigin    string
+	inspectFuncGetDenyRulesVersion   func(ctx context.Context)
+	afterGetDenyRulesVersionCounter  uint64
+	beforeGetDenyRulesVersionCounter uint64
+	GetDenyRulesVersionMock          mPolicyVersionRepositoryMockGetDenyRulesVersion
+
+	funcSetDenyRulesVersion          func(ctx context.Context, version string) (err error)
+	funcSetDenyRulesVersionOrigin    string
+	inspectFuncSetDenyRulesVersion   func(ctx context.Context, version string)
+	afterSetDenyRulesVersionCounter  uint64
+	beforeSetDenyRulesVersionCounter uint64
+	SetDenyRulesVersionMock          mPolicyVersionRepositoryMockSetDenyRulesVersion
+}
+
+// NewPolicyVersionRepositoryMock returns a mock for mm_repository.PolicyVersionRepository
+func NewPolicyVersionRepositoryMock(t minimock.Tester) *PolicyVersionRepositoryMock {
+	m := &PolicyVersionRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddDenyRulesVersionMock = mPolicyVersionRepositoryMockAddDenyRulesVersion{mock: m}
+	m.AddDenyRulesVersionMock.callArgs = []*PolicyVersionRepositoryMockAddDenyRulesVersionParams{}
+
+	m.DeleteDenyRulesVersionMock = mPolicyVersionRepositoryMockDeleteDenyRulesVersion{mock: m}
+	m.DeleteDenyRulesVersionMock.callArgs = []*PolicyVersionRepositoryMockDeleteDenyRulesVersionParams{}
+
+	m.GetDenyRulesVersionMock = mPolicyVersionRepositoryMockGetDenyRulesVersion{mock: m}
+	m.GetDenyRulesVersionMock.callArgs = []*PolicyVersionRepositoryMockGetDenyRulesVersionParams{}
+
+	m.SetDenyRulesVersionMock = mPolicyVersionRepositoryMockSetDenyRulesVersion{mock: m}
+	m.SetDenyRulesVersionMock.callArgs = []*PolicyVersionRepositoryMockSetDenyRulesVersionParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPolicyVersionRepositoryMockAddDenyRulesVersion struct {
+	optional           bool
+	mock               *PolicyVersionRepositoryMock
+	defaultExpectation *PolicyVersionRepositoryMockAddDenyRulesVersionExpectation
+	expectations       []*PolicyVersionRepositoryMockAddDenyRulesVersionExpectation
+
+	callArgs []*PolicyVersionRepositoryMockAddDenyRulesVersionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyVersionRepositoryMockAddDenyRulesVersionExpectation specifies expectation struct of the PolicyVersionRepository.AddDenyRulesVersion
+type PolicyVersionRepositoryMockAddDenyRulesVersionExpectation struct {
+	mock               *PolicyVersionRepositoryMock
+	params             *PolicyVersionRepositoryMockAddDenyRulesVersionParams
+	paramPtrs          *PolicyVersionRepositoryMockAddDenyRulesVersionParamPtrs
+	expectationOrigins PolicyVersionRepositoryMockAddDenyRulesVersionExpectationOrigins
+	results            *PolicyVersionRepositoryMockAddDenyRulesVersionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyVersionRepositoryMockAddDenyRulesVersionParams contains parameters of the PolicyVersionRepository.AddDenyRulesVersion
+type PolicyVersionRepositoryMockAddDenyRulesVersionParams struct {
+	ctx     context.Context
+	version string
+}
+
+// PolicyVersionRepositoryMockAddDenyRulesVersionParamPtrs contains pointers to parameters of the PolicyVersionRepository.AddDenyRulesVersion
+type PolicyVersionRepositoryMockAddDenyRulesVersionParamPtrs struct {
+	ctx     *context.Context
+	version *string
+}
+
+// PolicyVersionRepositoryMockAddDenyRulesVersionResults contains results of the PolicyVersionRepository.AddDenyRulesVersion
+type PolicyVersionRepositoryMockAddDenyRulesVersionResults struct {
+	b1  bool
+	err error
+}
+
+// PolicyVersionRepositoryMockAddDenyRulesVersionOrigins contains origins of expectations of the PolicyVersionRepository.AddDenyRulesVersion
+type PolicyVersionRepositoryMockAddDenyRulesVersionExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originVersion string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddDenyRulesVersion *mPolicyVersionRepositoryMockAddDenyRulesVersion) Optional() *mPolicyVersionRepositoryMockAddDenyRulesVersion {
+	mmAddDenyRulesVersion.optional = true
+	return mmAddDenyRulesVersion
+}
+
+// Expect sets up expected params for PolicyVersionRepository.AddDenyRulesVersion
+func (mmAddDenyRulesVersion *mPolicyVersionRepositoryMockAddDenyRulesVersion) Expect(ctx context.Context, version string) *mPolicyVersionRepositoryMockAddDenyRulesVersion {
+	if mmAddDenyRulesVersion.mock.funcAddDenyRulesVersion != nil {
+		mmAddDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.AddDenyRulesVersion mock is already set by Set")
+	}
+
+	if mmAddDenyRulesVersion.defaultExpectation == nil {
+		mmAddDenyRulesVersion.defaultExpectation = &PolicyVersionRepositoryMockAddDenyRulesVersionExpectation{}
+	}
+
+	if mmAddDenyRulesVersion.defaultExpectation.paramPtrs != nil {
+		mmAddDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.AddDenyRulesVersion mock is already set by ExpectParams functions")
+	}
+
+	mmAddDenyRulesVersion.defaultExpectation.params = &PolicyVersionRepositoryMockAddDenyRulesVersionParams{ctx, version}
+	mmAddDenyRulesVersion.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddDenyRulesVersion.expectations {
+		if minimock.Equal(e.params, mmAddDenyRulesVersion.defaultExpectation.params) {
+			mmAddDenyRulesVersion.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddDenyRulesVersion.defaultExpectation.params)
+		}
+	}
+
+	return mmAddDenyRulesVersion
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyVersionRepository.AddDenyRulesVersion
+func (mmAddDenyRulesVersion *mPolicyVersionRepositoryMockAddDenyRulesVersion) ExpectCtxParam1(ctx context.Context) *mPolicyVersionRepositoryMockAddDenyRulesVersion {
+	if mmAddDenyRulesVersion.mock.funcAddDenyRulesVersion != nil {
+		mmAddDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.AddDenyRulesVersion mock is already set by Set")
+	}
+
+	if mmAddDenyRulesVersion.defaultExpectation == nil {
+		mmAddDenyRulesVersion.defaultExpectation = &PolicyVersionRepositoryMockAddDenyRulesVersionExpectation{}
+	}
+
+	if mmAddDenyRulesVersion.defaultExpectation.params != nil {
+		mmAddDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.AddDenyRulesVersion mock is already set by Expect")
+	}
+
+	if mmAddDenyRulesVersion.defaultExpectation.paramPtrs == nil {
+		mmAddDenyRulesVersion.defaultExpectation.paramPtrs = &PolicyVersionRepositoryMockAddDenyRulesVersionParamPtrs{}
+	}
+	mmAddDenyRulesVersion.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddDenyRulesVersion.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddDenyRulesVersion
+}
+
+// ExpectVersionParam2 sets up expected param version for PolicyVersionRepository.AddDenyRulesVersion
+func (mmAddDenyRulesVersion *mPolicyVersionRepositoryMockAddDenyRulesVersion) ExpectVersionParam2(version string) *mPolicyVersionRepositoryMockAddDenyRulesVersion {
+	if mmAddDenyRulesVersion.mock.funcAddDenyRulesVersion != nil {
+		mmAddDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.AddDenyRulesVersion mock is already set by Set")
+	}
+
+	if mmAddDenyRulesVersion.defaultExpectation == nil {
+		mmAddDenyRulesVersion.defaultExpectation = &PolicyVersionRepositoryMockAddDenyRulesVersionExpectation{}
+	}
+
+	if mmAddDenyRulesVersion.defaultExpectation.params != nil {
+		mmAddDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.AddDenyRulesVersion mock is already set by Expect")
+	}
+
+	if mmAddDenyRulesVersion.defaultExpectation.paramPtrs == nil {
+		mmAddDenyRulesVersion.defaultExpectation.paramPtrs = &PolicyVersionRepositoryMockAddDenyRulesVersionParamPtrs{}
+	}
+	mmAddDenyRulesVersion.defaultExpectation.paramPtrs.version = &version
+	mmAddDenyRulesVersion.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
+
+	return mmAddDenyRulesVersion
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyVersionRepository.AddDenyRulesVersion
+func (mmAddDenyRulesVersion *mPolicyVersionRepositoryMockAddDenyRulesVersion) Inspect(f func(ctx context.Context, version string)) *mPolicyVersionRepositoryMockAddDenyRulesVersion {
+	if mmAddDenyRulesVersion.mock.inspectFuncAddDenyRulesVersion != nil {
+		mmAddDenyRulesVersion.mock.t.Fatalf("Inspect function is already set for PolicyVersionRepositoryMock.AddDenyRulesVersion")
+	}
+
+	mmAddDenyRulesVersion.mock.inspectFuncAddDenyRulesVersion = f
+
+	return mmAddDenyRulesVersion
+}
+
+// Return sets up results that will be returned by PolicyVersionRepository.AddDenyRulesVersion
+func (mmAddDenyRulesVersion *mPolicyVersionRepositoryMockAddDenyRulesVersion) Return(b1 bool, err error) *PolicyVersionRepositoryMock {
+	if mmAddDenyRulesVersion.mock.funcAddDenyRulesVersion != nil {
+		mmAddDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.AddDenyRulesVersion mock is already set by Set")
+	}
+
+	if mmAddDenyRulesVersion.defaultExpectation == nil {
+		mmAddDenyRulesVersion.defaultExpectation = &PolicyVersionRepositoryMockAddDenyRulesVersionExpectation{mock: mmAddDenyRulesVersion.mock}
+	}
+	mmAddDenyRulesVersion.defaultExpectation.results = &PolicyVersionRepositoryMockAddDenyRulesVersionResults{b1, err}
+	mmAddDenyRulesVersion.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddDenyRulesVersion.mock
+}
+
+// Set uses given function f to mock the PolicyVersionRepository.AddDenyRulesVersion method
+func (mmAddDenyRulesVersion *mPolicyVersionRepositoryMockAddDenyRulesVersion) Set(f func(ctx context.Context, version string) (b1 bool, err error)) *PolicyVersionRepositoryMock {
+	if mmAddDenyRulesVersion.defaultExpectation != nil {
+		mmAddDenyRulesVersion.mock.t.Fatalf("Default expectation is already set for the PolicyVersionRepository.AddDenyRulesVersion method")
+	}
+
+	if len(mmAddDenyRulesVersion.expectations) > 0 {
+		mmAddDenyRulesVersion.mock.t.Fatalf("Some expectations are already set for the PolicyVersionRepository.AddDenyRulesVersion method")
+	}
+
+	mmAddDenyRulesVersion.mock.funcAddDenyRulesVersion = f
+	mmAddDenyRulesVersion.mock.funcAddDenyRulesVersionOrigin = minimock.CallerInfo(1)
+	return mmAddDenyRulesVersion.mock
+}
+
+// When sets expectation for the PolicyVersionRepository.AddDenyRulesVersion which will trigger the result defined by the following
+// Then helper
+func (mmAddDenyRulesVersion *mPolicyVersionRepositoryMockAddDenyRulesVersion) When(ctx context.Context, version string) *PolicyVersionRepositoryMockAddDenyRulesVersionExpectation {
+	if mmAddDenyRulesVersion.mock.funcAddDenyRulesVersion != nil {
+		mmAddDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.AddDenyRulesVersion mock is already set by Set")
+	}
+
+	expectation := &PolicyVersionRepositoryMockAddDenyRulesVersionExpectation{
+		mock:               mmAddDenyRulesVersion.mock,
+		params:             &PolicyVersionRepositoryMockAddDenyRulesVersionParams{ctx, version},
+		expectationOrigins: PolicyVersionRepositoryMockAddDenyRulesVersionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddDenyRulesVersion.expectations = append(mmAddDenyRulesVersion.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyVersionRepository.AddDenyRulesVersion return parameters for the expectation previously defined by the When method
+func (e *PolicyVersionRepositoryMockAddDenyRulesVersionExpectation) Then(b1 bool, err error) *PolicyVersionRepositoryMock {
+	e.results = &PolicyVersionRepositoryMockAddDenyRulesVersionResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times PolicyVersionRepository.AddDenyRulesVersion should be invoked
+func (mmAddDenyRulesVersion *mPolicyVersionRepositoryMockAddDenyRulesVersion) Times(n uint64) *mPolicyVersionRepositoryMockAddDenyRulesVersion {
+	if n == 0 {
+		mmAddDenyRulesVersion.mock.t.Fatalf("Times of PolicyVersionRepositoryMock.AddDenyRulesVersion mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddDenyRulesVersion.expectedInvocations, n)
+	mmAddDenyRulesVersion.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddDenyRulesVersion
+}
+
+func (mmAddDenyRulesVersion *mPolicyVersionRepositoryMockAddDenyRulesVersion) invocationsDone() bool {
+	if len(mmAddDenyRulesVersion.expectations) == 0 && mmAddDenyRulesVersion.defaultExpectation == nil && mmAddDenyRulesVersion.mock.funcAddDenyRulesVersion == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddDenyRulesVersion.mock.afterAddDenyRulesVersionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddDenyRulesVersion.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddDenyRulesVersion implements mm_repository.PolicyVersionRepository
+func (mmAddDenyRulesVersion *PolicyVersionRepositoryMock) AddDenyRulesVersion(ctx context.Context, version string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmAddDenyRulesVersion.beforeAddDenyRulesVersionCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddDenyRulesVersion.afterAddDenyRulesVersionCounter, 1)
+
+	mmAddDenyRulesVersion.t.Helper()
+
+	if mmAddDenyRulesVersion.inspectFuncAddDenyRulesVersion != nil {
+		mmAddDenyRulesVersion.inspectFuncAddDenyRulesVersion(ctx, version)
+	}
+
+	mm_params := PolicyVersionRepositoryMockAddDenyRulesVersionParams{ctx, version}
+
+	// Record call args
+	mmAddDenyRulesVersion.AddDenyRulesVersionMock.mutex.Lock()
+	mmAddDenyRulesVersion.AddDenyRulesVersionMock.callArgs = append(mmAddDenyRulesVersion.AddDenyRulesVersionMock.callArgs, &mm_params)
+	mmAddDenyRulesVersion.AddDenyRulesVersionMock.mutex.Unlock()
+
+	for _, e := range mmAddDenyRulesVersion.AddDenyRulesVersionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmAddDenyRulesVersion.AddDenyRulesVersionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddDenyRulesVersion.AddDenyRulesVersionMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddDenyRulesVersion.AddDenyRulesVersionMock.defaultExpectation.params
+		mm_want_ptrs := mmAddDenyRulesVersion.AddDenyRulesVersionMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyVersionRepositoryMockAddDenyRulesVersionParams{ctx, version}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddDenyRulesVersion.t.Errorf("PolicyVersionRepositoryMock.AddDenyRulesVersion got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddDenyRulesVersion.AddDenyRulesVersionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmAddDenyRulesVersion.t.Errorf("PolicyVersionRepositoryMock.AddDenyRulesVersion got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddDenyRulesVersion.AddDenyRulesVersionMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddDenyRulesVersion.t.Errorf("PolicyVersionRepositoryMock.AddDenyRulesVersion got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddDenyRulesVersion.AddDenyRulesVersionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddDenyRulesVersion.AddDenyRulesVersionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddDenyRulesVersion.t.Fatal("No results are set for the PolicyVersionRepositoryMock.AddDenyRulesVersion")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmAddDenyRulesVersion.funcAddDenyRulesVersion != nil {
+		return mmAddDenyRulesVersion.funcAddDenyRulesVersion(ctx, version)
+	}
+	mmAddDenyRulesVersion.t.Fatalf("Unexpected call to PolicyVersionRepositoryMock.AddDenyRulesVersion. %v %v", ctx, version)
+	return
+}
+
+// AddDenyRulesVersionAfterCounter returns a count of finished PolicyVersionRepositoryMock.AddDenyRulesVersion invocations
+func (mmAddDenyRulesVersion *PolicyVersionRepositoryMock) AddDenyRulesVersionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddDenyRulesVersion.afterAddDenyRulesVersionCounter)
+}
+
+// AddDenyRulesVersionBeforeCounter returns a count of PolicyVersionRepositoryMock.AddDenyRulesVersion invocations
+func (mmAddDenyRulesVersion *PolicyVersionRepositoryMock) AddDenyRulesVersionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddDenyRulesVersion.beforeAddDenyRulesVersionCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyVersionRepositoryMock.AddDenyRulesVersion.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddDenyRulesVersion *mPolicyVersionRepositoryMockAddDenyRulesVersion) Calls() []*PolicyVersionRepositoryMockAddDenyRulesVersionParams {
+	mmAddDenyRulesVersion.mutex.RLock()
+
+	argCopy := make([]*PolicyVersionRepositoryMockAddDenyRulesVersionParams, len(mmAddDenyRulesVersion.callArgs))
+	copy(argCopy, mmAddDenyRulesVersion.callArgs)
+
+	mmAddDenyRulesVersion.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddDenyRulesVersionDone returns true if the count of the AddDenyRulesVersion invocations corresponds
+// the number of defined expectations
+func (m *PolicyVersionRepositoryMock) MinimockAddDenyRulesVersionDone() bool {
+	if m.AddDenyRulesVersionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddDenyRulesVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddDenyRulesVersionMock.invocationsDone()
+}
+
+// MinimockAddDenyRulesVersionInspect logs each unmet expectation
+func (m *PolicyVersionRepositoryMock) MinimockAddDenyRulesVersionInspect() {
+	for _, e := range m.AddDenyRulesVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyVersionRepositoryMock.AddDenyRulesVersion at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddDenyRulesVersionCounter := mm_atomic.LoadUint64(&m.afterAddDenyRulesVersionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddDenyRulesVersionMock.defaultExpectation != nil && afterAddDenyRulesVersionCounter < 1 {
+		if m.AddDenyRulesVersionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyVersionRepositoryMock.AddDenyRulesVersion at\n%s", m.AddDenyRulesVersionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyVersionRepositoryMock.AddDenyRulesVersion at\n%s with params: %#v", m.AddDenyRulesVersionMock.defaultExpectation.expectationOrigins.origin, *m.AddDenyRulesVersionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddDenyRulesVersion != nil && afterAddDenyRulesVersionCounter < 1 {
+		m.t.Errorf("Expected call to PolicyVersionRepositoryMock.AddDenyRulesVersion at\n%s", m.funcAddDenyRulesVersionOrigin)
+	}
+
+	if !m.AddDenyRulesVersionMock.invocationsDone() && afterAddDenyRulesVersionCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyVersionRepositoryMock.AddDenyRulesVersion at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddDenyRulesVersionMock.expectedInvocations), m.AddDenyRulesVersionMock.expectedInvocationsOrigin, afterAddDenyRulesVersionCounter)
+	}
+}
+
+type mPolicyVersionRepositoryMockDeleteDenyRulesVersion struct {
+	optional           bool
+	mock               *PolicyVersionRepositoryMock
+	defaultExpectation *PolicyVersionRepositoryMockDeleteDenyRulesVersionExpectation
+	expectations       []*PolicyVersionRepositoryMockDeleteDenyRulesVersionExpectation
+
+	callArgs []*PolicyVersionRepositoryMockDeleteDenyRulesVersionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyVersionRepositoryMockDeleteDenyRulesVersionExpectation specifies expectation struct of the PolicyVersionRepository.DeleteDenyRulesVersion
+type PolicyVersionRepositoryMockDeleteDenyRulesVersionExpectation struct {
+	mock               *PolicyVersionRepositoryMock
+	params             *PolicyVersionRepositoryMockDeleteDenyRulesVersionParams
+	paramPtrs          *PolicyVersionRepositoryMockDeleteDenyRulesVersionParamPtrs
+	expectationOrigins PolicyVersionRepositoryMockDeleteDenyRulesVersionExpectationOrigins
+	results            *PolicyVersionRepositoryMockDeleteDenyRulesVersionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyVersionRepositoryMockDeleteDenyRulesVersionParams contains parameters of the PolicyVersionRepository.DeleteDenyRulesVersion
+type PolicyVersionRepositoryMockDeleteDenyRulesVersionParams struct {
+	ctx context.Context
+}
+
+// PolicyVersionRepositoryMockDeleteDenyRulesVersionParamPtrs contains pointers to parameters of the PolicyVersionRepository.DeleteDenyRulesVersion
+type PolicyVersionRepositoryMockDeleteDenyRulesVersionParamPtrs struct {
+	ctx *context.Context
+}
+
+// PolicyVersionRepositoryMockDeleteDenyRulesVersionResults contains results of the PolicyVersionRepository.DeleteDenyRulesVersion
+type PolicyVersionRepositoryMockDeleteDenyRulesVersionResults struct {
+	err error
+}
+
+// PolicyVersionRepositoryMockDeleteDenyRulesVersionOrigins contains origins of expectations of the PolicyVersionRepository.DeleteDenyRulesVersion
+type PolicyVersionRepositoryMockDeleteDenyRulesVersionExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteDenyRulesVersion *mPolicyVersionRepositoryMockDeleteDenyRulesVersion) Optional() *mPolicyVersionRepositoryMockDeleteDenyRulesVersion {
+	mmDeleteDenyRulesVersion.optional = true
+	return mmDeleteDenyRulesVersion
+}
+
+// Expect sets up expected params for PolicyVersionRepository.DeleteDenyRulesVersion
+func (mmDeleteDenyRulesVersion *mPolicyVersionRepositoryMockDeleteDenyRulesVersion) Expect(ctx context.Context) *mPolicyVersionRepositoryMockDeleteDenyRulesVersion {
+	if mmDeleteDenyRulesVersion.mock.funcDeleteDenyRulesVersion != nil {
+		mmDeleteDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.DeleteDenyRulesVersion mock is already set by Set")
+	}
+
+	if mmDeleteDenyRulesVersion.defaultExpectation == nil {
+		mmDeleteDenyRulesVersion.defaultExpectation = &PolicyVersionRepositoryMockDeleteDenyRulesVersionExpectation{}
+	}
+
+	if mmDeleteDenyRulesVersion.defaultExpectation.paramPtrs != nil {
+		mmDeleteDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.DeleteDenyRulesVersion mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteDenyRulesVersion.defaultExpectation.params = &PolicyVersionRepositoryMockDeleteDenyRulesVersionParams{ctx}
+	mmDeleteDenyRulesVersion.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteDenyRulesVersion.expectations {
+		if minimock.Equal(e.params, mmDeleteDenyRulesVersion.defaultExpectation.params) {
+			mmDeleteDenyRulesVersion.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteDenyRulesVersion.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteDenyRulesVersion
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyVersionRepository.DeleteDenyRulesVersion
+func (mmDeleteDenyRulesVersion *mPolicyVersionRepositoryMockDeleteDenyRulesVersion) ExpectCtxParam1(ctx context.Context) *mPolicyVersionRepositoryMockDeleteDenyRulesVersion {
+	if mmDeleteDenyRulesVersion.mock.funcDeleteDenyRulesVersion != nil {
+		mmDeleteDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.DeleteDenyRulesVersion mock is already set by Set")
+	}
+
+	if mmDeleteDenyRulesVersion.defaultExpectation == nil {
+		mmDeleteDenyRulesVersion.defaultExpectation = &PolicyVersionRepositoryMockDeleteDenyRulesVersionExpectation{}
+	}
+
+	if mmDeleteDenyRulesVersion.defaultExpectation.params != nil {
+		mmDeleteDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.DeleteDenyRulesVersion mock is already set by Expect")
+	}
+
+	if mmDeleteDenyRulesVersion.defaultExpectation.paramPtrs == nil {
+		mmDeleteDenyRulesVersion.defaultExpectation.paramPtrs = &PolicyVersionRepositoryMockDeleteDenyRulesVersionParamPtrs{}
+	}
+	mmDeleteDenyRulesVersion.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteDenyRulesVersion.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteDenyRulesVersion
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyVersionRepository.DeleteDenyRulesVersion
+func (mmDeleteDenyRulesVersion *mPolicyVersionRepositoryMockDeleteDenyRulesVersion) Inspect(f func(ctx context.Context)) *mPolicyVersionRepositoryMockDeleteDenyRulesVersion {
+	if mmDeleteDenyRulesVersion.mock.inspectFuncDeleteDenyRulesVersion != nil {
+		mmDeleteDenyRulesVersion.mock.t.Fatalf("Inspect function is already set for PolicyVersionRepositoryMock.DeleteDenyRulesVersion")
+	}
+
+	mmDeleteDenyRulesVersion.mock.inspectFuncDeleteDenyRulesVersion = f
+
+	return mmDeleteDenyRulesVersion
+}
+
+// Return sets up results that will be returned by PolicyVersionRepository.DeleteDenyRulesVersion
+func (mmDeleteDenyRulesVersion *mPolicyVersionRepositoryMockDeleteDenyRulesVersion) Return(err error) *PolicyVersionRepositoryMock {
+	if mmDeleteDenyRulesVersion.mock.funcDeleteDenyRulesVersion != nil {
+		mmDeleteDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.DeleteDenyRulesVersion mock is already set by Set")
+	}
+
+	if mmDeleteDenyRulesVersion.defaultExpectation == nil {
+		mmDeleteDenyRulesVersion.defaultExpectation = &PolicyVersionRepositoryMockDeleteDenyRulesVersionExpectation{mock: mmDeleteDenyRulesVersion.mock}
+	}
+	mmDeleteDenyRulesVersion.defaultExpectation.results = &PolicyVersionRepositoryMockDeleteDenyRulesVersionResults{err}
+	mmDeleteDenyRulesVersion.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteDenyRulesVersion.mock
+}
+
+// Set uses given function f to mock the PolicyVersionRepository.DeleteDenyRulesVersion method
+func (mmDeleteDenyRulesVersion *mPolicyVersionRepositoryMockDeleteDenyRulesVersion) Set(f func(ctx context.Context) (err error)) *PolicyVersionRepositoryMock {
+	if mmDeleteDenyRulesVersion.defaultExpectation != nil {
+		mmDeleteDenyRulesVersion.mock.t.Fatalf("Default expectation is already set for the PolicyVersionRepository.DeleteDenyRulesVersion method")
+	}
+
+	if len(mmDeleteDenyRulesVersion.expectations) > 0 {
+		mmDeleteDenyRulesVersion.mock.t.Fatalf("Some expectations are already set for the PolicyVersionRepository.DeleteDenyRulesVersion method")
+	}
+
+	mmDeleteDenyRulesVersion.mock.funcDeleteDenyRulesVersion = f
+	mmDeleteDenyRulesVersion.mock.funcDeleteDenyRulesVersionOrigin = minimock.CallerInfo(1)
+	return mmDeleteDenyRulesVersion.mock
+}
+
+// When sets expectation for the PolicyVersionRepository.DeleteDenyRulesVersion which will trigger the result defined by the following
+// Then helper
+func (mmDeleteDenyRulesVersion *mPolicyVersionRepositoryMockDeleteDenyRulesVersion) When(ctx context.Context) *PolicyVersionRepositoryMockDeleteDenyRulesVersionExpectation {
+	if mmDeleteDenyRulesVersion.mock.funcDeleteDenyRulesVersion != nil {
+		mmDeleteDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.DeleteDenyRulesVersion mock is already set by Set")
+	}
+
+	expectation := &PolicyVersionRepositoryMockDeleteDenyRulesVersionExpectation{
+		mock:               mmDeleteDenyRulesVersion.mock,
+		params:             &PolicyVersionRepositoryMockDeleteDenyRulesVersionParams{ctx},
+		expectationOrigins: PolicyVersionRepositoryMockDeleteDenyRulesVersionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteDenyRulesVersion.expectations = append(mmDeleteDenyRulesVersion.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyVersionRepository.DeleteDenyRulesVersion return parameters for the expectation previously defined by the When method
+func (e *PolicyVersionRepositoryMockDeleteDenyRulesVersionExpectation) Then(err error) *PolicyVersionRepositoryMock {
+	e.results = &PolicyVersionRepositoryMockDeleteDenyRulesVersionResults{err}
+	return e.mock
+}
+
+// Times sets number of times PolicyVersionRepository.DeleteDenyRulesVersion should be invoked
+func (mmDeleteDenyRulesVersion *mPolicyVersionRepositoryMockDeleteDenyRulesVersion) Times(n uint64) *mPolicyVersionRepositoryMockDeleteDenyRulesVersion {
+	if n == 0 {
+		mmDeleteDenyRulesVersion.mock.t.Fatalf("Times of PolicyVersionRepositoryMock.DeleteDenyRulesVersion mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteDenyRulesVersion.expectedInvocations, n)
+	mmDeleteDenyRulesVersion.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteDenyRulesVersion
+}
+
+func (mmDeleteDenyRulesVersion *mPolicyVersionRepositoryMockDeleteDenyRulesVersion) invocationsDone() bool {
+	if len(mmDeleteDenyRulesVersion.expectations) == 0 && mmDeleteDenyRulesVersion.defaultExpectation == nil && mmDeleteDenyRulesVersion.mock.funcDeleteDenyRulesVersion == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteDenyRulesVersion.mock.afterDeleteDenyRulesVersionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteDenyRulesVersion.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteDenyRulesVersion implements mm_repository.PolicyVersionRepository
+func (mmDeleteDenyRulesVersion *PolicyVersionRepositoryMock) DeleteDenyRulesVersion(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmDeleteDenyRulesVersion.beforeDeleteDenyRulesVersionCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteDenyRulesVersion.afterDeleteDenyRulesVersionCounter, 1)
+
+	mmDeleteDenyRulesVersion.t.Helper()
+
+	if mmDeleteDenyRulesVersion.inspectFuncDeleteDenyRulesVersion != nil {
+		mmDeleteDenyRulesVersion.inspectFuncDeleteDenyRulesVersion(ctx)
+	}
+
+	mm_params := PolicyVersionRepositoryMockDeleteDenyRulesVersionParams{ctx}
+
+	// Record call args
+	mmDeleteDenyRulesVersion.DeleteDenyRulesVersionMock.mutex.Lock()
+	mmDeleteDenyRulesVersion.DeleteDenyRulesVersionMock.callArgs = append(mmDeleteDenyRulesVersion.DeleteDenyRulesVersionMock.callArgs, &mm_params)
+	mmDeleteDenyRulesVersion.DeleteDenyRulesVersionMock.mutex.Unlock()
+
+	for _, e := range mmDeleteDenyRulesVersion.DeleteDenyRulesVersionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteDenyRulesVersion.DeleteDenyRulesVersionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteDenyRulesVersion.DeleteDenyRulesVersionMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteDenyRulesVersion.DeleteDenyRulesVersionMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteDenyRulesVersion.DeleteDenyRulesVersionMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyVersionRepositoryMockDeleteDenyRulesVersionParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteDenyRulesVersion.t.Errorf("PolicyVersionRepositoryMock.DeleteDenyRulesVersion got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteDenyRulesVersion.DeleteDenyRulesVersionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteDenyRulesVersion.t.Errorf("PolicyVersionRepositoryMock.DeleteDenyRulesVersion got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteDenyRulesVersion.DeleteDenyRulesVersionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteDenyRulesVersion.DeleteDenyRulesVersionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteDenyRulesVersion.t.Fatal("No results are set for the PolicyVersionRepositoryMock.DeleteDenyRulesVersion")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteDenyRulesVersion.funcDeleteDenyRulesVersion != nil {
+		return mmDeleteDenyRulesVersion.funcDeleteDenyRulesVersion(ctx)
+	}
+	mmDeleteDenyRulesVersion.t.Fatalf("Unexpected call to PolicyVersionRepositoryMock.DeleteDenyRulesVersion. %v", ctx)
+	return
+}
+
+// DeleteDenyRulesVersionAfterCounter returns a count of finished PolicyVersionRepositoryMock.DeleteDenyRulesVersion invocations
+func (mmDeleteDenyRulesVersion *PolicyVersionRepositoryMock) DeleteDenyRulesVersionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteDenyRulesVersion.afterDeleteDenyRulesVersionCounter)
+}
+
+// DeleteDenyRulesVersionBeforeCounter returns a count of PolicyVersionRepositoryMock.DeleteDenyRulesVersion invocations
+func (mmDeleteDenyRulesVersion *PolicyVersionRepositoryMock) DeleteDenyRulesVersionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteDenyRulesVersion.beforeDeleteDenyRulesVersionCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyVersionRepositoryMock.DeleteDenyRulesVersion.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteDenyRulesVersion *mPolicyVersionRepositoryMockDeleteDenyRulesVersion) Calls() []*PolicyVersionRepositoryMockDeleteDenyRulesVersionParams {
+	mmDeleteDenyRulesVersion.mutex.RLock()
+
+	argCopy := make([]*PolicyVersionRepositoryMockDeleteDenyRulesVersionParams, len(mmDeleteDenyRulesVersion.callArgs))
+	copy(argCopy, mmDeleteDenyRulesVersion.callArgs)
+
+	mmDeleteDenyRulesVersion.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDenyRulesVersionDone returns true if the count of the DeleteDenyRulesVersion invocations corresponds
+// the number of defined expectations
+func (m *PolicyVersionRepositoryMock) MinimockDeleteDenyRulesVersionDone() bool {
+	if m.DeleteDenyRulesVersionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteDenyRulesVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteDenyRulesVersionMock.invocationsDone()
+}
+
+// MinimockDeleteDenyRulesVersionInspect logs each unmet expectation
+func (m *PolicyVersionRepositoryMock) MinimockDeleteDenyRulesVersionInspect() {
+	for _, e := range m.DeleteDenyRulesVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyVersionRepositoryMock.DeleteDenyRulesVersion at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteDenyRulesVersionCounter := mm_atomic.LoadUint64(&m.afterDeleteDenyRulesVersionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteDenyRulesVersionMock.defaultExpectation != nil && afterDeleteDenyRulesVersionCounter < 1 {
+		if m.DeleteDenyRulesVersionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyVersionRepositoryMock.DeleteDenyRulesVersion at\n%s", m.DeleteDenyRulesVersionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyVersionRepositoryMock.DeleteDenyRulesVersion at\n%s with params: %#v", m.DeleteDenyRulesVersionMock.defaultExpectation.expectationOrigins.origin, *m.DeleteDenyRulesVersionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteDenyRulesVersion != nil && afterDeleteDenyRulesVersionCounter < 1 {
+		m.t.Errorf("Expected call to PolicyVersionRepositoryMock.DeleteDenyRulesVersion at\n%s", m.funcDeleteDenyRulesVersionOrigin)
+	}
+
+	if !m.DeleteDenyRulesVersionMock.invocationsDone() && afterDeleteDenyRulesVersionCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyVersionRepositoryMock.DeleteDenyRulesVersion at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteDenyRulesVersionMock.expectedInvocations), m.DeleteDenyRulesVersionMock.expectedInvocationsOrigin, afterDeleteDenyRulesVersionCounter)
+	}
+}
+
+type mPolicyVersionRepositoryMockGetDenyRulesVersion struct {
+	optional           bool
+	mock               *PolicyVersionRepositoryMock
+	defaultExpectation *PolicyVersionRepositoryMockGetDenyRulesVersionExpectation
+	expectations       []*PolicyVersionRepositoryMockGetDenyRulesVersionExpectation
+
+	callArgs []*PolicyVersionRepositoryMockGetDenyRulesVersionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyVersionRepositoryMockGetDenyRulesVersionExpectation specifies expectation struct of the PolicyVersionRepository.GetDenyRulesVersion
+type PolicyVersionRepositoryMockGetDenyRulesVersionExpectation struct {
+	mock               *PolicyVersionRepositoryMock
+	params             *PolicyVersionRepositoryMockGetDenyRulesVersionParams
+	paramPtrs          *PolicyVersionRepositoryMockGetDenyRulesVersionParamPtrs
+	expectationOrigins PolicyVersionRepositoryMockGetDenyRulesVersionExpectationOrigins
+	results            *PolicyVersionRepositoryMockGetDenyRulesVersionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyVersionRepositoryMockGetDenyRulesVersionParams contains parameters of the PolicyVersionRepository.GetDenyRulesVersion
+type PolicyVersionRepositoryMockGetDenyRulesVersionParams struct {
+	ctx context.Context
+}
+
+// PolicyVersionRepositoryMockGetDenyRulesVersionParamPtrs contains pointers to parameters of the PolicyVersionRepository.GetDenyRulesVersion
+type PolicyVersionRepositoryMockGetDenyRulesVersionParamPtrs struct {
+	ctx *context.Context
+}
+
+// PolicyVersionRepositoryMockGetDenyRulesVersionResults contains results of the PolicyVersionRepository.GetDenyRulesVersion
+type PolicyVersionRepositoryMockGetDenyRulesVersionResults struct {
+	s1  string
+	err error
+}
+
+// PolicyVersionRepositoryMockGetDenyRulesVersionOrigins contains origins of expectations of the PolicyVersionRepository.GetDenyRulesVersion
+type PolicyVersionRepositoryMockGetDenyRulesVersionExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetDenyRulesVersion *mPolicyVersionRepositoryMockGetDenyRulesVersion) Optional() *mPolicyVersionRepositoryMockGetDenyRulesVersion {
+	mmGetDenyRulesVersion.optional = true
+	return mmGetDenyRulesVersion
+}
+
+// Expect sets up expected params for PolicyVersionRepository.GetDenyRulesVersion
+func (mmGetDenyRulesVersion *mPolicyVersionRepositoryMockGetDenyRulesVersion) Expect(ctx context.Context) *mPolicyVersionRepositoryMockGetDenyRulesVersion {
+	if mmGetDenyRulesVersion.mock.funcGetDenyRulesVersion != nil {
+		mmGetDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.GetDenyRulesVersion mock is already set by Set")
+	}
+
+	if mmGetDenyRulesVersion.defaultExpectation == nil {
+		mmGetDenyRulesVersion.defaultExpectation = &PolicyVersionRepositoryMockGetDenyRulesVersionExpectation{}
+	}
+
+	if mmGetDenyRulesVersion.defaultExpectation.paramPtrs != nil {
+		mmGetDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.GetDenyRulesVersion mock is already set by ExpectParams functions")
+	}
+
+	mmGetDenyRulesVersion.defaultExpectation.params = &PolicyVersionRepositoryMockGetDenyRulesVersionParams{ctx}
+	mmGetDenyRulesVersion.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetDenyRulesVersion.expectations {
+		if minimock.Equal(e.params, mmGetDenyRulesVersion.defaultExpectation.params) {
+			mmGetDenyRulesVersion.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetDenyRulesVersion.defaultExpectation.params)
+		}
+	}
+
+	return mmGetDenyRulesVersion
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyVersionRepository.GetDenyRulesVersion
+func (mmGetDenyRulesVersion *mPolicyVersionRepositoryMockGetDenyRulesVersion) ExpectCtxParam1(ctx context.Context) *mPolicyVersionRepositoryMockGetDenyRulesVersion {
+	if mmGetDenyRulesVersion.mock.funcGetDenyRulesVersion != nil {
+		mmGetDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.GetDenyRulesVersion mock is already set by Set")
+	}
+
+	if mmGetDenyRulesVersion.defaultExpectation == nil {
+		mmGetDenyRulesVersion.defaultExpectation = &PolicyVersionRepositoryMockGetDenyRulesVersionExpectation{}
+	}
+
+	if mmGetDenyRulesVersion.defaultExpectation.params != nil {
+		mmGetDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.GetDenyRulesVersion mock is already set by Expect")
+	}
+
+	if mmGetDenyRulesVersion.defaultExpectation.paramPtrs == nil {
+		mmGetDenyRulesVersion.defaultExpectation.paramPtrs = &PolicyVersionRepositoryMockGetDenyRulesVersionParamPtrs{}
+	}
+	mmGetDenyRulesVersion.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetDenyRulesVersion.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetDenyRulesVersion
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyVersionRepository.GetDenyRulesVersion
+func (mmGetDenyRulesVersion *mPolicyVersionRepositoryMockGetDenyRulesVersion) Inspect(f func(ctx context.Context)) *mPolicyVersionRepositoryMockGetDenyRulesVersion {
+	if mmGetDenyRulesVersion.mock.inspectFuncGetDenyRulesVersion != nil {
+		mmGetDenyRulesVersion.mock.t.Fatalf("Inspect function is already set for PolicyVersionRepositoryMock.GetDenyRulesVersion")
+	}
+
+	mmGetDenyRulesVersion.mock.inspectFuncGetDenyRulesVersion = f
+
+	return mmGetDenyRulesVersion
+}
+
+// Return sets up results that will be returned by PolicyVersionRepository.GetDenyRulesVersion
+func (mmGetDenyRulesVersion *mPolicyVersionRepositoryMockGetDenyRulesVersion) Return(s1 string, err error) *PolicyVersionRepositoryMock {
+	if mmGetDenyRulesVersion.mock.funcGetDenyRulesVersion != nil {
+		mmGetDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.GetDenyRulesVersion mock is already set by Set")
+	}
+
+	if mmGetDenyRulesVersion.defaultExpectation == nil {
+		mmGetDenyRulesVersion.defaultExpectation = &PolicyVersionRepositoryMockGetDenyRulesVersionExpectation{mock: mmGetDenyRulesVersion.mock}
+	}
+	mmGetDenyRulesVersion.defaultExpectation.results = &PolicyVersionRepositoryMockGetDenyRulesVersionResults{s1, err}
+	mmGetDenyRulesVersion.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetDenyRulesVersion.mock
+}
+
+// Set uses given function f to mock the PolicyVersionRepository.GetDenyRulesVersion method
+func (mmGetDenyRulesVersion *mPolicyVersionRepositoryMockGetDenyRulesVersion) Set(f func(ctx context.Context) (s1 string, err error)) *PolicyVersionRepositoryMock {
+	if mmGetDenyRulesVersion.defaultExpectation != nil {
+		mmGetDenyRulesVersion.mock.t.Fatalf("Default expectation is already set for the PolicyVersionRepository.GetDenyRulesVersion method")
+	}
+
+	if len(mmGetDenyRulesVersion.expectations) > 0 {
+		mmGetDenyRulesVersion.mock.t.Fatalf("Some expectations are already set for the PolicyVersionRepository.GetDenyRulesVersion method")
+	}
+
+	mmGetDenyRulesVersion.mock.funcGetDenyRulesVersion = f
+	mmGetDenyRulesVersion.mock.funcGetDenyRulesVersionOrigin = minimock.CallerInfo(1)
+	return mmGetDenyRulesVersion.mock
+}
+
+// When sets expectation for the PolicyVersionRepository.GetDenyRulesVersion which will trigger the result defined by the following
+// Then helper
+func (mmGetDenyRulesVersion *mPolicyVersionRepositoryMockGetDenyRulesVersion) When(ctx context.Context) *PolicyVersionRepositoryMockGetDenyRulesVersionExpectation {
+	if mmGetDenyRulesVersion.mock.funcGetDenyRulesVersion != nil {
+		mmGetDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.GetDenyRulesVersion mock is already set by Set")
+	}
+
+	expectation := &PolicyVersionRepositoryMockGetDenyRulesVersionExpectation{
+		mock:               mmGetDenyRulesVersion.mock,
+		params:             &PolicyVersionRepositoryMockGetDenyRulesVersionParams{ctx},
+		expectationOrigins: PolicyVersionRepositoryMockGetDenyRulesVersionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetDenyRulesVersion.expectations = append(mmGetDenyRulesVersion.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyVersionRepository.GetDenyRulesVersion return parameters for the expectation previously defined by the When method
+func (e *PolicyVersionRepositoryMockGetDenyRulesVersionExpectation) Then(s1 string, err error) *PolicyVersionRepositoryMock {
+	e.results = &PolicyVersionRepositoryMockGetDenyRulesVersionResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times PolicyVersionRepository.GetDenyRulesVersion should be invoked
+func (mmGetDenyRulesVersion *mPolicyVersionRepositoryMockGetDenyRulesVersion) Times(n uint64) *mPolicyVersionRepositoryMockGetDenyRulesVersion {
+	if n == 0 {
+		mmGetDenyRulesVersion.mock.t.Fatalf("Times of PolicyVersionRepositoryMock.GetDenyRulesVersion mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetDenyRulesVersion.expectedInvocations, n)
+	mmGetDenyRulesVersion.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetDenyRulesVersion
+}
+
+func (mmGetDenyRulesVersion *mPolicyVersionRepositoryMockGetDenyRulesVersion) invocationsDone() bool {
+	if len(mmGetDenyRulesVersion.expectations) == 0 && mmGetDenyRulesVersion.defaultExpectation == nil && mmGetDenyRulesVersion.mock.funcGetDenyRulesVersion == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetDenyRulesVersion.mock.afterGetDenyRulesVersionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetDenyRulesVersion.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetDenyRulesVersion implements mm_repository.PolicyVersionRepository
+func (mmGetDenyRulesVersion *PolicyVersionRepositoryMock) GetDenyRulesVersion(ctx context.Context) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmGetDenyRulesVersion.beforeGetDenyRulesVersionCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetDenyRulesVersion.afterGetDenyRulesVersionCounter, 1)
+
+	mmGetDenyRulesVersion.t.Helper()
+
+	if mmGetDenyRulesVersion.inspectFuncGetDenyRulesVersion != nil {
+		mmGetDenyRulesVersion.inspectFuncGetDenyRulesVersion(ctx)
+	}
+
+	mm_params := PolicyVersionRepositoryMockGetDenyRulesVersionParams{ctx}
+
+	// Record call args
+	mmGetDenyRulesVersion.GetDenyRulesVersionMock.mutex.Lock()
+	mmGetDenyRulesVersion.GetDenyRulesVersionMock.callArgs = append(mmGetDenyRulesVersion.GetDenyRulesVersionMock.callArgs, &mm_params)
+	mmGetDenyRulesVersion.GetDenyRulesVersionMock.mutex.Unlock()
+
+	for _, e := range mmGetDenyRulesVersion.GetDenyRulesVersionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetDenyRulesVersion.GetDenyRulesVersionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetDenyRulesVersion.GetDenyRulesVersionMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetDenyRulesVersion.GetDenyRulesVersionMock.defaultExpectation.params
+		mm_want_ptrs := mmGetDenyRulesVersion.GetDenyRulesVersionMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyVersionRepositoryMockGetDenyRulesVersionParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetDenyRulesVersion.t.Errorf("PolicyVersionRepositoryMock.GetDenyRulesVersion got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDenyRulesVersion.GetDenyRulesVersionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetDenyRulesVersion.t.Errorf("PolicyVersionRepositoryMock.GetDenyRulesVersion got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetDenyRulesVersion.GetDenyRulesVersionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetDenyRulesVersion.GetDenyRulesVersionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetDenyRulesVersion.t.Fatal("No results are set for the PolicyVersionRepositoryMock.GetDenyRulesVersion")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetDenyRulesVersion.funcGetDenyRulesVersion != nil {
+		return mmGetDenyRulesVersion.funcGetDenyRulesVersion(ctx)
+	}
+	mmGetDenyRulesVersion.t.Fatalf("Unexpected call to PolicyVersionRepositoryMock.GetDenyRulesVersion. %v", ctx)
+	return
+}
+
+// GetDenyRulesVersionAfterCounter returns a count of finished PolicyVersionRepositoryMock.GetDenyRulesVersion invocations
+func (mmGetDenyRulesVersion *PolicyVersionRepositoryMock) GetDenyRulesVersionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDenyRulesVersion.afterGetDenyRulesVersionCounter)
+}
+
+// GetDenyRulesVersionBeforeCounter returns a count of PolicyVersionRepositoryMock.GetDenyRulesVersion invocations
+func (mmGetDenyRulesVersion *PolicyVersionRepositoryMock) GetDenyRulesVersionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDenyRulesVersion.beforeGetDenyRulesVersionCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyVersionRepositoryMock.GetDenyRulesVersion.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetDenyRulesVersion *mPolicyVersionRepositoryMockGetDenyRulesVersion) Calls() []*PolicyVersionRepositoryMockGetDenyRulesVersionParams {
+	mmGetDenyRulesVersion.mutex.RLock()
+
+	argCopy := make([]*PolicyVersionRepositoryMockGetDenyRulesVersionParams, len(mmGetDenyRulesVersion.callArgs))
+	copy(argCopy, mmGetDenyRulesVersion.callArgs)
+
+	mmGetDenyRulesVersion.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDenyRulesVersionDone returns true if the count of the GetDenyRulesVersion invocations corresponds
+// the number of defined expectations
+func (m *PolicyVersionRepositoryMock) MinimockGetDenyRulesVersionDone() bool {
+	if m.GetDenyRulesVersionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetDenyRulesVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetDenyRulesVersionMock.invocationsDone()
+}
+
+// MinimockGetDenyRulesVersionInspect logs each unmet expectation
+func (m *PolicyVersionRepositoryMock) MinimockGetDenyRulesVersionInspect() {
+	for _, e := range m.GetDenyRulesVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyVersionRepositoryMock.GetDenyRulesVersion at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetDenyRulesVersionCounter := mm_atomic.LoadUint64(&m.afterGetDenyRulesVersionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetDenyRulesVersionMock.defaultExpectation != nil && afterGetDenyRulesVersionCounter < 1 {
+		if m.GetDenyRulesVersionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyVersionRepositoryMock.GetDenyRulesVersion at\n%s", m.GetDenyRulesVersionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyVersionRepositoryMock.GetDenyRulesVersion at\n%s with params: %#v", m.GetDenyRulesVersionMock.defaultExpectation.expectationOrigins.origin, *m.GetDenyRulesVersionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetDenyRulesVersion != nil && afterGetDenyRulesVersionCounter < 1 {
+		m.t.Errorf("Expected call to PolicyVersionRepositoryMock.GetDenyRulesVersion at\n%s", m.funcGetDenyRulesVersionOrigin)
+	}
+
+	if !m.GetDenyRulesVersionMock.invocationsDone() && afterGetDenyRulesVersionCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyVersionRepositoryMock.GetDenyRulesVersion at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetDenyRulesVersionMock.expectedInvocations), m.GetDenyRulesVersionMock.expectedInvocationsOrigin, afterGetDenyRulesVersionCounter)
+	}
+}
+
+type mPolicyVersionRepositoryMockSetDenyRulesVersion struct {
+	optional           bool
+	mock               *PolicyVersionRepositoryMock
+	defaultExpectation *PolicyVersionRepositoryMockSetDenyRulesVersionExpectation
+	expectations       []*PolicyVersionRepositoryMockSetDenyRulesVersionExpectation
+
+	callArgs []*PolicyVersionRepositoryMockSetDenyRulesVersionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PolicyVersionRepositoryMockSetDenyRulesVersionExpectation specifies expectation struct of the PolicyVersionRepository.SetDenyRulesVersion
+type PolicyVersionRepositoryMockSetDenyRulesVersionExpectation struct {
+	mock               *PolicyVersionRepositoryMock
+	params             *PolicyVersionRepositoryMockSetDenyRulesVersionParams
+	paramPtrs          *PolicyVersionRepositoryMockSetDenyRulesVersionParamPtrs
+	expectationOrigins PolicyVersionRepositoryMockSetDenyRulesVersionExpectationOrigins
+	results            *PolicyVersionRepositoryMockSetDenyRulesVersionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PolicyVersionRepositoryMockSetDenyRulesVersionParams contains parameters of the PolicyVersionRepository.SetDenyRulesVersion
+type PolicyVersionRepositoryMockSetDenyRulesVersionParams struct {
+	ctx     context.Context
+	version string
+}
+
+// PolicyVersionRepositoryMockSetDenyRulesVersionParamPtrs contains pointers to parameters of the PolicyVersionRepository.SetDenyRulesVersion
+type PolicyVersionRepositoryMockSetDenyRulesVersionParamPtrs struct {
+	ctx     *context.Context
+	version *string
+}
+
+// PolicyVersionRepositoryMockSetDenyRulesVersionResults contains results of the PolicyVersionRepository.SetDenyRulesVersion
+type PolicyVersionRepositoryMockSetDenyRulesVersionResults struct {
+	err error
+}
+
+// PolicyVersionRepositoryMockSetDenyRulesVersionOrigins contains origins of expectations of the PolicyVersionRepository.SetDenyRulesVersion
+type PolicyVersionRepositoryMockSetDenyRulesVersionExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originVersion string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetDenyRulesVersion *mPolicyVersionRepositoryMockSetDenyRulesVersion) Optional() *mPolicyVersionRepositoryMockSetDenyRulesVersion {
+	mmSetDenyRulesVersion.optional = true
+	return mmSetDenyRulesVersion
+}
+
+// Expect sets up expected params for PolicyVersionRepository.SetDenyRulesVersion
+func (mmSetDenyRulesVersion *mPolicyVersionRepositoryMockSetDenyRulesVersion) Expect(ctx context.Context, version string) *mPolicyVersionRepositoryMockSetDenyRulesVersion {
+	if mmSetDenyRulesVersion.mock.funcSetDenyRulesVersion != nil {
+		mmSetDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.SetDenyRulesVersion mock is already set by Set")
+	}
+
+	if mmSetDenyRulesVersion.defaultExpectation == nil {
+		mmSetDenyRulesVersion.defaultExpectation = &PolicyVersionRepositoryMockSetDenyRulesVersionExpectation{}
+	}
+
+	if mmSetDenyRulesVersion.defaultExpectation.paramPtrs != nil {
+		mmSetDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.SetDenyRulesVersion mock is already set by ExpectParams functions")
+	}
+
+	mmSetDenyRulesVersion.defaultExpectation.params = &PolicyVersionRepositoryMockSetDenyRulesVersionParams{ctx, version}
+	mmSetDenyRulesVersion.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetDenyRulesVersion.expectations {
+		if minimock.Equal(e.params, mmSetDenyRulesVersion.defaultExpectation.params) {
+			mmSetDenyRulesVersion.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetDenyRulesVersion.defaultExpectation.params)
+		}
+	}
+
+	return mmSetDenyRulesVersion
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PolicyVersionRepository.SetDenyRulesVersion
+func (mmSetDenyRulesVersion *mPolicyVersionRepositoryMockSetDenyRulesVersion) ExpectCtxParam1(ctx context.Context) *mPolicyVersionRepositoryMockSetDenyRulesVersion {
+	if mmSetDenyRulesVersion.mock.funcSetDenyRulesVersion != nil {
+		mmSetDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.SetDenyRulesVersion mock is already set by Set")
+	}
+
+	if mmSetDenyRulesVersion.defaultExpectation == nil {
+		mmSetDenyRulesVersion.defaultExpectation = &PolicyVersionRepositoryMockSetDenyRulesVersionExpectation{}
+	}
+
+	if mmSetDenyRulesVersion.defaultExpectation.params != nil {
+		mmSetDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.SetDenyRulesVersion mock is already set by Expect")
+	}
+
+	if mmSetDenyRulesVersion.defaultExpectation.paramPtrs == nil {
+		mmSetDenyRulesVersion.defaultExpectation.paramPtrs = &PolicyVersionRepositoryMockSetDenyRulesVersionParamPtrs{}
+	}
+	mmSetDenyRulesVersion.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetDenyRulesVersion.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetDenyRulesVersion
+}
+
+// ExpectVersionParam2 sets up expected param version for PolicyVersionRepository.SetDenyRulesVersion
+func (mmSetDenyRulesVersion *mPolicyVersionRepositoryMockSetDenyRulesVersion) ExpectVersionParam2(version string) *mPolicyVersionRepositoryMockSetDenyRulesVersion {
+	if mmSetDenyRulesVersion.mock.funcSetDenyRulesVersion != nil {
+		mmSetDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.SetDenyRulesVersion mock is already set by Set")
+	}
+
+	if mmSetDenyRulesVersion.defaultExpectation == nil {
+		mmSetDenyRulesVersion.defaultExpectation = &PolicyVersionRepositoryMockSetDenyRulesVersionExpectation{}
+	}
+
+	if mmSetDenyRulesVersion.defaultExpectation.params != nil {
+		mmSetDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.SetDenyRulesVersion mock is already set by Expect")
+	}
+
+	if mmSetDenyRulesVersion.defaultExpectation.paramPtrs == nil {
+		mmSetDenyRulesVersion.defaultExpectation.paramPtrs = &PolicyVersionRepositoryMockSetDenyRulesVersionParamPtrs{}
+	}
+	mmSetDenyRulesVersion.defaultExpectation.paramPtrs.version = &version
+	mmSetDenyRulesVersion.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
+
+	return mmSetDenyRulesVersion
+}
+
+// Inspect accepts an inspector function that has same arguments as the PolicyVersionRepository.SetDenyRulesVersion
+func (mmSetDenyRulesVersion *mPolicyVersionRepositoryMockSetDenyRulesVersion) Inspect(f func(ctx context.Context, version string)) *mPolicyVersionRepositoryMockSetDenyRulesVersion {
+	if mmSetDenyRulesVersion.mock.inspectFuncSetDenyRulesVersion != nil {
+		mmSetDenyRulesVersion.mock.t.Fatalf("Inspect function is already set for PolicyVersionRepositoryMock.SetDenyRulesVersion")
+	}
+
+	mmSetDenyRulesVersion.mock.inspectFuncSetDenyRulesVersion = f
+
+	return mmSetDenyRulesVersion
+}
+
+// Return sets up results that will be returned by PolicyVersionRepository.SetDenyRulesVersion
+func (mmSetDenyRulesVersion *mPolicyVersionRepositoryMockSetDenyRulesVersion) Return(err error) *PolicyVersionRepositoryMock {
+	if mmSetDenyRulesVersion.mock.funcSetDenyRulesVersion != nil {
+		mmSetDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.SetDenyRulesVersion mock is already set by Set")
+	}
+
+	if mmSetDenyRulesVersion.defaultExpectation == nil {
+		mmSetDenyRulesVersion.defaultExpectation = &PolicyVersionRepositoryMockSetDenyRulesVersionExpectation{mock: mmSetDenyRulesVersion.mock}
+	}
+	mmSetDenyRulesVersion.defaultExpectation.results = &PolicyVersionRepositoryMockSetDenyRulesVersionResults{err}
+	mmSetDenyRulesVersion.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetDenyRulesVersion.mock
+}
+
+// Set uses given function f to mock the PolicyVersionRepository.SetDenyRulesVersion method
+func (mmSetDenyRulesVersion *mPolicyVersionRepositoryMockSetDenyRulesVersion) Set(f func(ctx context.Context, version string) (err error)) *PolicyVersionRepositoryMock {
+	if mmSetDenyRulesVersion.defaultExpectation != nil {
+		mmSetDenyRulesVersion.mock.t.Fatalf("Default expectation is already set for the PolicyVersionRepository.SetDenyRulesVersion method")
+	}
+
+	if len(mmSetDenyRulesVersion.expectations) > 0 {
+		mmSetDenyRulesVersion.mock.t.Fatalf("Some expectations are already set for the PolicyVersionRepository.SetDenyRulesVersion method")
+	}
+
+	mmSetDenyRulesVersion.mock.funcSetDenyRulesVersion = f
+	mmSetDenyRulesVersion.mock.funcSetDenyRulesVersionOrigin = minimock.CallerInfo(1)
+	return mmSetDenyRulesVersion.mock
+}
+
+// When sets expectation for the PolicyVersionRepository.SetDenyRulesVersion which will trigger the result defined by the following
+// Then helper
+func (mmSetDenyRulesVersion *mPolicyVersionRepositoryMockSetDenyRulesVersion) When(ctx context.Context, version string) *PolicyVersionRepositoryMockSetDenyRulesVersionExpectation {
+	if mmSetDenyRulesVersion.mock.funcSetDenyRulesVersion != nil {
+		mmSetDenyRulesVersion.mock.t.Fatalf("PolicyVersionRepositoryMock.SetDenyRulesVersion mock is already set by Set")
+	}
+
+	expectation := &PolicyVersionRepositoryMockSetDenyRulesVersionExpectation{
+		mock:               mmSetDenyRulesVersion.mock,
+		params:             &PolicyVersionRepositoryMockSetDenyRulesVersionParams{ctx, version},
+		expectationOrigins: PolicyVersionRepositoryMockSetDenyRulesVersionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetDenyRulesVersion.expectations = append(mmSetDenyRulesVersion.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PolicyVersionRepository.SetDenyRulesVersion return parameters for the expectation previously defined by the When method
+func (e *PolicyVersionRepositoryMockSetDenyRulesVersionExpectation) Then(err error) *PolicyVersionRepositoryMock {
+	e.results = &PolicyVersionRepositoryMockSetDenyRulesVersionResults{err}
+	return e.mock
+}
+
+// Times sets number of times PolicyVersionRepository.SetDenyRulesVersion should be invoked
+func (mmSetDenyRulesVersion *mPolicyVersionRepositoryMockSetDenyRulesVersion) Times(n uint64) *mPolicyVersionRepositoryMockSetDenyRulesVersion {
+	if n == 0 {
+		mmSetDenyRulesVersion.mock.t.Fatalf("Times of PolicyVersionRepositoryMock.SetDenyRulesVersion mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetDenyRulesVersion.expectedInvocations, n)
+	mmSetDenyRulesVersion.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetDenyRulesVersion
+}
+
+func (mmSetDenyRulesVersion *mPolicyVersionRepositoryMockSetDenyRulesVersion) invocationsDone() bool {
+	if len(mmSetDenyRulesVersion.expectations) == 0 && mmSetDenyRulesVersion.defaultExpectation == nil && mmSetDenyRulesVersion.mock.funcSetDenyRulesVersion == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetDenyRulesVersion.mock.afterSetDenyRulesVersionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetDenyRulesVersion.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetDenyRulesVersion implements mm_repository.PolicyVersionRepository
+func (mmSetDenyRulesVersion *PolicyVersionRepositoryMock) SetDenyRulesVersion(ctx context.Context, version string) (err error) {
+	mm_atomic.AddUint64(&mmSetDenyRulesVersion.beforeSetDenyRulesVersionCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetDenyRulesVersion.afterSetDenyRulesVersionCounter, 1)
+
+	mmSetDenyRulesVersion.t.Helper()
+
+	if mmSetDenyRulesVersion.inspectFuncSetDenyRulesVersion != nil {
+		mmSetDenyRulesVersion.inspectFuncSetDenyRulesVersion(ctx, version)
+	}
+
+	mm_params := PolicyVersionRepositoryMockSetDenyRulesVersionParams{ctx, version}
+
+	// Record call args
+	mmSetDenyRulesVersion.SetDenyRulesVersionMock.mutex.Lock()
+	mmSetDenyRulesVersion.SetDenyRulesVersionMock.callArgs = append(mmSetDenyRulesVersion.SetDenyRulesVersionMock.callArgs, &mm_params)
+	mmSetDenyRulesVersion.SetDenyRulesVersionMock.mutex.Unlock()
+
+	for _, e := range mmSetDenyRulesVersion.SetDenyRulesVersionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetDenyRulesVersion.SetDenyRulesVersionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetDenyRulesVersion.SetDenyRulesVersionMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetDenyRulesVersion.SetDenyRulesVersionMock.defaultExpectation.params
+		mm_want_ptrs := mmSetDenyRulesVersion.SetDenyRulesVersionMock.defaultExpectation.paramPtrs
+
+		mm_got := PolicyVersionRepositoryMockSetDenyRulesVersionParams{ctx, version}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetDenyRulesVersion.t.Errorf("PolicyVersionRepositoryMock.SetDenyRulesVersion got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetDenyRulesVersion.SetDenyRulesVersionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmSetDenyRulesVersion.t.Errorf("PolicyVersionRepositoryMock.SetDenyRulesVersion got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetDenyRulesVersion.SetDenyRulesVersionMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetDenyRulesVersion.t.Errorf("PolicyVersionRepositoryMock.SetDenyRulesVersion got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetDenyRulesVersion.SetDenyRulesVersionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetDenyRulesVersion.SetDenyRulesVersionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetDenyRulesVersion.t.Fatal("No results are set for the PolicyVersionRepositoryMock.SetDenyRulesVersion")
+		}
+		return (*mm_results).err
+	}
+	if mmSetDenyRulesVersion.funcSetDenyRulesVersion != nil {
+		return mmSetDenyRulesVersion.funcSetDenyRulesVersion(ctx, version)
+	}
+	mmSetDenyRulesVersion.t.Fatalf("Unexpected call to PolicyVersionRepositoryMock.SetDenyRulesVersion. %v %v", ctx, version)
+	return
+}
+
+// SetDenyRulesVersionAfterCounter returns a count of finished PolicyVersionRepositoryMock.SetDenyRulesVersion invocations
+func (mmSetDenyRulesVersion *PolicyVersionRepositoryMock) SetDenyRulesVersionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetDenyRulesVersion.afterSetDenyRulesVersionCounter)
+}
+
+// SetDenyRulesVersionBeforeCounter returns a count of PolicyVersionRepositoryMock.SetDenyRulesVersion invocations
+func (mmSetDenyRulesVersion *PolicyVersionRepositoryMock) SetDenyRulesVersionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetDenyRulesVersion.beforeSetDenyRulesVersionCounter)
+}
+
+// Calls returns a list of arguments used in each call to PolicyVersionRepositoryMock.SetDenyRulesVersion.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetDenyRulesVersion *mPolicyVersionRepositoryMockSetDenyRulesVersion) Calls() []*PolicyVersionRepositoryMockSetDenyRulesVersionParams {
+	mmSetDenyRulesVersion.mutex.RLock()
+
+	argCopy := make([]*PolicyVersionRepositoryMockSetDenyRulesVersionParams, len(mmSetDenyRulesVersion.callArgs))
+	copy(argCopy, mmSetDenyRulesVersion.callArgs)
+
+	mmSetDenyRulesVersion.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetDenyRulesVersionDone returns true if the count of the SetDenyRulesVersion invocations corresponds
+// the number of defined expectations
+func (m *PolicyVersionRepositoryMock) MinimockSetDenyRulesVersionDone() bool {
+	if m.SetDenyRulesVersionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetDenyRulesVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetDenyRulesVersionMock.invocationsDone()
+}
+
+// MinimockSetDenyRulesVersionInspect logs each unmet expectation
+func (m *PolicyVersionRepositoryMock) MinimockSetDenyRulesVersionInspect() {
+	for _, e := range m.SetDenyRulesVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PolicyVersionRepositoryMock.SetDenyRulesVersion at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetDenyRulesVersionCounter := mm_atomic.LoadUint64(&m.afterSetDenyRulesVersionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetDenyRulesVersionMock.defaultExpectation != nil && afterSetDenyRulesVersionCounter < 1 {
+		if m.SetDenyRulesVersionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PolicyVersionRepositoryMock.SetDenyRulesVersion at\n%s", m.SetDenyRulesVersionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PolicyVersionRepositoryMock.SetDenyRulesVersion at\n%s with params: %#v", m.SetDenyRulesVersionMock.defaultExpectation.expectationOrigins.origin, *m.SetDenyRulesVersionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetDenyRulesVersion != nil && afterSetDenyRulesVersionCounter < 1 {
+		m.t.Errorf("Expected call to PolicyVersionRepositoryMock.SetDenyRulesVersion at\n%s", m.funcSetDenyRulesVersionOrigin)
+	}
+
+	if !m.SetDenyRulesVersionMock.invocationsDone() && afterSetDenyRulesVersionCounter > 0 {
+		m.t.Errorf("Expected %d calls to PolicyVersionRepositoryMock.SetDenyRulesVersion at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetDenyRulesVersionMock.expectedInvocations), m.SetDenyRulesVersionMock.expectedInvocationsOrigin, afterSetDenyRulesVersionCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PolicyVersionRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddDenyRulesVersionInspect()
+
+			m.MinimockDeleteDenyRulesVersionInspect()
+
+			m.MinimockGetDenyRulesVersionInspect()
+
+			m.MinimockSetDenyRulesVersionInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PolicyVersionRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PolicyVersionRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddDenyRulesVersionDone() &&
+		m.MinimockDeleteDenyRulesVersionDone() &&
+		m.MinimockGetDenyRulesVersionDone() &&
+		m.MinimockSetDenyRulesVersionDone()
+}
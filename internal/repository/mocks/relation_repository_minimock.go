@@ -0,0 +1,2194 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RelationRepositoryMock implements mm_repository.RelationRepository
+type RelationRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDeleteTuples          func(ctx context.Context, tuples []*model.RelationTuple) (err error)
+	funcDeleteTuplesOrigin    string
+	inspectFuncDeleteTuples   func(ctx context.Context, tuples []*model.RelationTuple)
+	afterDeleteTuplesCounter  uint64
+	beforeDeleteTuplesCounter uint64
+	DeleteTuplesMock          mRelationRepositoryMockDeleteTuples
+
+	funcNextRevision          func(ctx context.Context) (i1 int64, err error)
+	funcNextRevisionOrigin    string
+	inspectFuncNextRevision   func(ctx context.Context)
+	afterNextRevisionCounter  uint64
+	beforeNextRevisionCounter uint64
+	NextRevisionMock          mRelationRepositoryMockNextRevision
+
+	funcReadSubjectTuples          func(ctx context.Context, subject model.RelationObject) (rpa1 []*model.RelationTuple, err error)
+	funcReadSubjectTuplesOrigin    string
+	inspectFuncReadSubjectTuples   func(ctx context.Context, subject model.RelationObject)
+	afterReadSubjectTuplesCounter  uint64
+	beforeReadSubjectTuplesCounter uint64
+	ReadSubjectTuplesMock          mRelationRepositoryMockReadSubjectTuples
+
+	funcReadTuples          func(ctx context.Context, object model.RelationObject, relation string) (rpa1 []*model.RelationTuple, err error)
+	funcReadTuplesOrigin    string
+	inspectFuncReadTuples   func(ctx context.Context, object model.RelationObject, relation string)
+	afterReadTuplesCounter  uint64
+	beforeReadTuplesCounter uint64
+	ReadTuplesMock          mRelationRepositoryMockReadTuples
+
+	funcRevision          func(ctx context.Context) (i1 int64, err error)
+	funcRevisionOrigin    string
+	inspectFuncRevision   func(ctx context.Context)
+	afterRevisionCounter  uint64
+	beforeRevisionCounter uint64
+	RevisionMock          mRelationRepositoryMockRevision
+
+	funcWriteTuples          func(ctx context.Context, tuples []*model.RelationTuple, revision int64) (err error)
+	funcWriteTuplesOrigin    string
+	inspectFuncWriteTuples   func(ctx context.Context, tuples []*model.RelationTuple, revision int64)
+	afterWriteTuplesCounter  uint64
+	beforeWriteTuplesCounter uint64
+	WriteTuplesMock          mRelationRepositoryMockWriteTuples
+}
+
+// NewRelationRepositoryMock returns a mock for mm_repository.RelationRepository
+func NewRelationRepositoryMock(t minimock.Tester) *RelationRepositoryMock {
+	m := &RelationRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteTuplesMock = mRelationRepositoryMockDeleteTuples{mock: m}
+	m.DeleteTuplesMock.callArgs = []*RelationRepositoryMockDeleteTuplesParams{}
+
+	m.NextRevisionMock = mRelationRepositoryMockNextRevision{mock: m}
+	m.NextRevisionMock.callArgs = []*RelationRepositoryMockNextRevisionParams{}
+
+	m.ReadSubjectTuplesMock = mRelationRepositoryMockReadSubjectTuples{mock: m}
+	m.ReadSubjectTuplesMock.callArgs = []*RelationRepositoryMockReadSubjectTuplesParams{}
+
+	m.ReadTuplesMock = mRelationRepositoryMockReadTuples{mock: m}
+	m.ReadTuplesMock.callArgs = []*RelationRepositoryMockReadTuplesParams{}
+
+	m.RevisionMock = mRelationRepositoryMockRevision{mock: m}
+	m.RevisionMock.callArgs = []*RelationRepositoryMockRevisionParams{}
+
+	m.WriteTuplesMock = mRelationRepositoryMockWriteTuples{mock: m}
+	m.WriteTuplesMock.callArgs = []*RelationRepositoryMockWriteTuplesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRelationRepositoryMockDeleteTuples struct {
+	optional           bool
+	mock               *RelationRepositoryMock
+	defaultExpectation *RelationRepositoryMockDeleteTuplesExpectation
+	expectations       []*RelationRepositoryMockDeleteTuplesExpectation
+
+	callArgs []*RelationRepositoryMockDeleteTuplesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationRepositoryMockDeleteTuplesExpectation specifies expectation struct of the RelationRepository.DeleteTuples
+type RelationRepositoryMockDeleteTuplesExpectation struct {
+	mock               *RelationRepositoryMock
+	params             *RelationRepositoryMockDeleteTuplesParams
+	paramPtrs          *RelationRepositoryMockDeleteTuplesParamPtrs
+	expectationOrigins RelationRepositoryMockDeleteTuplesExpectationOrigins
+	results            *RelationRepositoryMockDeleteTuplesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationRepositoryMockDeleteTuplesParams contains parameters of the RelationRepository.DeleteTuples
+type RelationRepositoryMockDeleteTuplesParams struct {
+	ctx    context.Context
+	tuples []*model.RelationTuple
+}
+
+// RelationRepositoryMockDeleteTuplesParamPtrs contains pointers to parameters of the RelationRepository.DeleteTuples
+type RelationRepositoryMockDeleteTuplesParamPtrs struct {
+	ctx    *context.Context
+	tuples *[]*model.RelationTuple
+}
+
+// RelationRepositoryMockDeleteTuplesResults contains results of the RelationRepository.DeleteTuples
+type RelationRepositoryMockDeleteTuplesResults struct {
+	err error
+}
+
+// RelationRepositoryMockDeleteTuplesOrigins contains origins of expectations of the RelationRepository.DeleteTuples
+type RelationRepositoryMockDeleteTuplesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originTuples string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteTuples *mRelationRepositoryMockDeleteTuples) Optional() *mRelationRepositoryMockDeleteTuples {
+	mmDeleteTuples.optional = true
+	return mmDeleteTuples
+}
+
+// Expect sets up expected params for RelationRepository.DeleteTuples
+func (mmDeleteTuples *mRelationRepositoryMockDeleteTuples) Expect(ctx context.Context, tuples []*model.RelationTuple) *mRelationRepositoryMockDeleteTuples {
+	if mmDeleteTuples.mock.funcDeleteTuples != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationRepositoryMock.DeleteTuples mock is already set by Set")
+	}
+
+	if mmDeleteTuples.defaultExpectation == nil {
+		mmDeleteTuples.defaultExpectation = &RelationRepositoryMockDeleteTuplesExpectation{}
+	}
+
+	if mmDeleteTuples.defaultExpectation.paramPtrs != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationRepositoryMock.DeleteTuples mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteTuples.defaultExpectation.params = &RelationRepositoryMockDeleteTuplesParams{ctx, tuples}
+	mmDeleteTuples.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteTuples.expectations {
+		if minimock.Equal(e.params, mmDeleteTuples.defaultExpectation.params) {
+			mmDeleteTuples.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteTuples.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteTuples
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationRepository.DeleteTuples
+func (mmDeleteTuples *mRelationRepositoryMockDeleteTuples) ExpectCtxParam1(ctx context.Context) *mRelationRepositoryMockDeleteTuples {
+	if mmDeleteTuples.mock.funcDeleteTuples != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationRepositoryMock.DeleteTuples mock is already set by Set")
+	}
+
+	if mmDeleteTuples.defaultExpectation == nil {
+		mmDeleteTuples.defaultExpectation = &RelationRepositoryMockDeleteTuplesExpectation{}
+	}
+
+	if mmDeleteTuples.defaultExpectation.params != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationRepositoryMock.DeleteTuples mock is already set by Expect")
+	}
+
+	if mmDeleteTuples.defaultExpectation.paramPtrs == nil {
+		mmDeleteTuples.defaultExpectation.paramPtrs = &RelationRepositoryMockDeleteTuplesParamPtrs{}
+	}
+	mmDeleteTuples.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteTuples.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteTuples
+}
+
+// ExpectTuplesParam2 sets up expected param tuples for RelationRepository.DeleteTuples
+func (mmDeleteTuples *mRelationRepositoryMockDeleteTuples) ExpectTuplesParam2(tuples []*model.RelationTuple) *mRelationRepositoryMockDeleteTuples {
+	if mmDeleteTuples.mock.funcDeleteTuples != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationRepositoryMock.DeleteTuples mock is already set by Set")
+	}
+
+	if mmDeleteTuples.defaultExpectation == nil {
+		mmDeleteTuples.defaultExpectation = &RelationRepositoryMockDeleteTuplesExpectation{}
+	}
+
+	if mmDeleteTuples.defaultExpectation.params != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationRepositoryMock.DeleteTuples mock is already set by Expect")
+	}
+
+	if mmDeleteTuples.defaultExpectation.paramPtrs == nil {
+		mmDeleteTuples.defaultExpectation.paramPtrs = &RelationRepositoryMockDeleteTuplesParamPtrs{}
+	}
+	mmDeleteTuples.defaultExpectation.paramPtrs.tuples = &tuples
+	mmDeleteTuples.defaultExpectation.expectationOrigins.originTuples = minimock.CallerInfo(1)
+
+	return mmDeleteTuples
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationRepository.DeleteTuples
+func (mmDeleteTuples *mRelationRepositoryMockDeleteTuples) Inspect(f func(ctx context.Context, tuples []*model.RelationTuple)) *mRelationRepositoryMockDeleteTuples {
+	if mmDeleteTuples.mock.inspectFuncDeleteTuples != nil {
+		mmDeleteTuples.mock.t.Fatalf("Inspect function is already set for RelationRepositoryMock.DeleteTuples")
+	}
+
+	mmDeleteTuples.mock.inspectFuncDeleteTuples = f
+
+	return mmDeleteTuples
+}
+
+// Return sets up results that will be returned by RelationRepository.DeleteTuples
+func (mmDeleteTuples *mRelationRepositoryMockDeleteTuples) Return(err error) *RelationRepositoryMock {
+	if mmDeleteTuples.mock.funcDeleteTuples != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationRepositoryMock.DeleteTuples mock is already set by Set")
+	}
+
+	if mmDeleteTuples.defaultExpectation == nil {
+		mmDeleteTuples.defaultExpectation = &RelationRepositoryMockDeleteTuplesExpectation{mock: mmDeleteTuples.mock}
+	}
+	mmDeleteTuples.defaultExpectation.results = &RelationRepositoryMockDeleteTuplesResults{err}
+	mmDeleteTuples.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteTuples.mock
+}
+
+// Set uses given function f to mock the RelationRepository.DeleteTuples method
+func (mmDeleteTuples *mRelationRepositoryMockDeleteTuples) Set(f func(ctx context.Context, tuples []*model.RelationTuple) (err error)) *RelationRepositoryMock {
+	if mmDeleteTuples.defaultExpectation != nil {
+		mmDeleteTuples.mock.t.Fatalf("Default expectation is already set for the RelationRepository.DeleteTuples method")
+	}
+
+	if len(mmDeleteTuples.expectations) > 0 {
+		mmDeleteTuples.mock.t.Fatalf("Some expectations are already set for the RelationRepository.DeleteTuples method")
+	}
+
+	mmDeleteTuples.mock.funcDeleteTuples = f
+	mmDeleteTuples.mock.funcDeleteTuplesOrigin = minimock.CallerInfo(1)
+	return mmDeleteTuples.mock
+}
+
+// When sets expectation for the RelationRepository.DeleteTuples which will trigger the result defined by the following
+// Then helper
+func (mmDeleteTuples *mRelationRepositoryMockDeleteTuples) When(ctx context.Context, tuples []*model.RelationTuple) *RelationRepositoryMockDeleteTuplesExpectation {
+	if mmDeleteTuples.mock.funcDeleteTuples != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationRepositoryMock.DeleteTuples mock is already set by Set")
+	}
+
+	expectation := &RelationRepositoryMockDeleteTuplesExpectation{
+		mock:               mmDeleteTuples.mock,
+		params:             &RelationRepositoryMockDeleteTuplesParams{ctx, tuples},
+		expectationOrigins: RelationRepositoryMockDeleteTuplesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteTuples.expectations = append(mmDeleteTuples.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationRepository.DeleteTuples return parameters for the expectation previously defined by the When method
+func (e *RelationRepositoryMockDeleteTuplesExpectation) Then(err error) *RelationRepositoryMock {
+	e.results = &RelationRepositoryMockDeleteTuplesResults{err}
+	return e.mock
+}
+
+// Times sets number of times RelationRepository.DeleteTuples should be invoked
+func (mmDeleteTuples *mRelationRepositoryMockDeleteTuples) Times(n uint64) *mRelationRepositoryMockDeleteTuples {
+	if n == 0 {
+		mmDeleteTuples.mock.t.Fatalf("Times of RelationRepositoryMock.DeleteTuples mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteTuples.expectedInvocations, n)
+	mmDeleteTuples.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteTuples
+}
+
+func (mmDeleteTuples *mRelationRepositoryMockDeleteTuples) invocationsDone() bool {
+	if len(mmDeleteTuples.expectations) == 0 && mmDeleteTuples.defaultExpectation == nil && mmDeleteTuples.mock.funcDeleteTuples == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteTuples.mock.afterDeleteTuplesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteTuples.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteTuples implements mm_repository.RelationRepository
+func (mmDeleteTuples *RelationRepositoryMock) DeleteTuples(ctx context.Context, tuples []*model.RelationTuple) (err error) {
+	mm_atomic.AddUint64(&mmDeleteTuples.beforeDeleteTuplesCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteTuples.afterDeleteTuplesCounter, 1)
+
+	mmDeleteTuples.t.Helper()
+
+	if mmDeleteTuples.inspectFuncDeleteTuples != nil {
+		mmDeleteTuples.inspectFuncDeleteTuples(ctx, tuples)
+	}
+
+	mm_params := RelationRepositoryMockDeleteTuplesParams{ctx, tuples}
+
+	// Record call args
+	mmDeleteTuples.DeleteTuplesMock.mutex.Lock()
+	mmDeleteTuples.DeleteTuplesMock.callArgs = append(mmDeleteTuples.DeleteTuplesMock.callArgs, &mm_params)
+	mmDeleteTuples.DeleteTuplesMock.mutex.Unlock()
+
+	for _, e := range mmDeleteTuples.DeleteTuplesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteTuples.DeleteTuplesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteTuples.DeleteTuplesMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteTuples.DeleteTuplesMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteTuples.DeleteTuplesMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationRepositoryMockDeleteTuplesParams{ctx, tuples}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteTuples.t.Errorf("RelationRepositoryMock.DeleteTuples got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTuples.DeleteTuplesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tuples != nil && !minimock.Equal(*mm_want_ptrs.tuples, mm_got.tuples) {
+				mmDeleteTuples.t.Errorf("RelationRepositoryMock.DeleteTuples got unexpected parameter tuples, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTuples.DeleteTuplesMock.defaultExpectation.expectationOrigins.originTuples, *mm_want_ptrs.tuples, mm_got.tuples, minimock.Diff(*mm_want_ptrs.tuples, mm_got.tuples))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteTuples.t.Errorf("RelationRepositoryMock.DeleteTuples got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteTuples.DeleteTuplesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteTuples.DeleteTuplesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteTuples.t.Fatal("No results are set for the RelationRepositoryMock.DeleteTuples")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteTuples.funcDeleteTuples != nil {
+		return mmDeleteTuples.funcDeleteTuples(ctx, tuples)
+	}
+	mmDeleteTuples.t.Fatalf("Unexpected call to RelationRepositoryMock.DeleteTuples. %v %v", ctx, tuples)
+	return
+}
+
+// DeleteTuplesAfterCounter returns a count of finished RelationRepositoryMock.DeleteTuples invocations
+func (mmDeleteTuples *RelationRepositoryMock) DeleteTuplesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTuples.afterDeleteTuplesCounter)
+}
+
+// DeleteTuplesBeforeCounter returns a count of RelationRepositoryMock.DeleteTuples invocations
+func (mmDeleteTuples *RelationRepositoryMock) DeleteTuplesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTuples.beforeDeleteTuplesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationRepositoryMock.DeleteTuples.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteTuples *mRelationRepositoryMockDeleteTuples) Calls() []*RelationRepositoryMockDeleteTuplesParams {
+	mmDeleteTuples.mutex.RLock()
+
+	argCopy := make([]*RelationRepositoryMockDeleteTuplesParams, len(mmDeleteTuples.callArgs))
+	copy(argCopy, mmDeleteTuples.callArgs)
+
+	mmDeleteTuples.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteTuplesDone returns true if the count of the DeleteTuples invocations corresponds
+// the number of defined expectations
+func (m *RelationRepositoryMock) MinimockDeleteTuplesDone() bool {
+	if m.DeleteTuplesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteTuplesMock.invocationsDone()
+}
+
+// MinimockDeleteTuplesInspect logs each unmet expectation
+func (m *RelationRepositoryMock) MinimockDeleteTuplesInspect() {
+	for _, e := range m.DeleteTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationRepositoryMock.DeleteTuples at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteTuplesCounter := mm_atomic.LoadUint64(&m.afterDeleteTuplesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteTuplesMock.defaultExpectation != nil && afterDeleteTuplesCounter < 1 {
+		if m.DeleteTuplesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationRepositoryMock.DeleteTuples at\n%s", m.DeleteTuplesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationRepositoryMock.DeleteTuples at\n%s with params: %#v", m.DeleteTuplesMock.defaultExpectation.expectationOrigins.origin, *m.DeleteTuplesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteTuples != nil && afterDeleteTuplesCounter < 1 {
+		m.t.Errorf("Expected call to RelationRepositoryMock.DeleteTuples at\n%s", m.funcDeleteTuplesOrigin)
+	}
+
+	if !m.DeleteTuplesMock.invocationsDone() && afterDeleteTuplesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationRepositoryMock.DeleteTuples at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteTuplesMock.expectedInvocations), m.DeleteTuplesMock.expectedInvocationsOrigin, afterDeleteTuplesCounter)
+	}
+}
+
+type mRelationRepositoryMockNextRevision struct {
+	optional           bool
+	mock               *RelationRepositoryMock
+	defaultExpectation *RelationRepositoryMockNextRevisionExpectation
+	expectations       []*RelationRepositoryMockNextRevisionExpectation
+
+	callArgs []*RelationRepositoryMockNextRevisionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationRepositoryMockNextRevisionExpectation specifies expectation struct of the RelationRepository.NextRevision
+type RelationRepositoryMockNextRevisionExpectation struct {
+	mock               *RelationRepositoryMock
+	params             *RelationRepositoryMockNextRevisionParams
+	paramPtrs          *RelationRepositoryMockNextRevisionParamPtrs
+	expectationOrigins RelationRepositoryMockNextRevisionExpectationOrigins
+	results            *RelationRepositoryMockNextRevisionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationRepositoryMockNextRevisionParams contains parameters of the RelationRepository.NextRevision
+type RelationRepositoryMockNextRevisionParams struct {
+	ctx context.Context
+}
+
+// RelationRepositoryMockNextRevisionParamPtrs contains pointers to parameters of the RelationRepository.NextRevision
+type RelationRepositoryMockNextRevisionParamPtrs struct {
+	ctx *context.Context
+}
+
+// RelationRepositoryMockNextRevisionResults contains results of the RelationRepository.NextRevision
+type RelationRepositoryMockNextRevisionResults struct {
+	i1  int64
+	err error
+}
+
+// RelationRepositoryMockNextRevisionOrigins contains origins of expectations of the RelationRepository.NextRevision
+type RelationRepositoryMockNextRevisionExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmNextRevision *mRelationRepositoryMockNextRevision) Optional() *mRelationRepositoryMockNextRevision {
+	mmNextRevision.optional = true
+	return mmNextRevision
+}
+
+// Expect sets up expected params for RelationRepository.NextRevision
+func (mmNextRevision *mRelationRepositoryMockNextRevision) Expect(ctx context.Context) *mRelationRepositoryMockNextRevision {
+	if mmNextRevision.mock.funcNextRevision != nil {
+		mmNextRevision.mock.t.Fatalf("RelationRepositoryMock.NextRevision mock is already set by Set")
+	}
+
+	if mmNextRevision.defaultExpectation == nil {
+		mmNextRevision.defaultExpectation = &RelationRepositoryMockNextRevisionExpectation{}
+	}
+
+	if mmNextRevision.defaultExpectation.paramPtrs != nil {
+		mmNextRevision.mock.t.Fatalf("RelationRepositoryMock.NextRevision mock is already set by ExpectParams functions")
+	}
+
+	mmNextRevision.defaultExpectation.params = &RelationRepositoryMockNextRevisionParams{ctx}
+	mmNextRevision.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmNextRevision.expectations {
+		if minimock.Equal(e.params, mmNextRevision.defaultExpectation.params) {
+			mmNextRevision.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmNextRevision.defaultExpectation.params)
+		}
+	}
+
+	return mmNextRevision
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationRepository.NextRevision
+func (mmNextRevision *mRelationRepositoryMockNextRevision) ExpectCtxParam1(ctx context.Context) *mRelationRepositoryMockNextRevision {
+	if mmNextRevision.mock.funcNextRevision != nil {
+		mmNextRevision.mock.t.Fatalf("RelationRepositoryMock.NextRevision mock is already set by Set")
+	}
+
+	if mmNextRevision.defaultExpectation == nil {
+		mmNextRevision.defaultExpectation = &RelationRepositoryMockNextRevisionExpectation{}
+	}
+
+	if mmNextRevision.defaultExpectation.params != nil {
+		mmNextRevision.mock.t.Fatalf("RelationRepositoryMock.NextRevision mock is already set by Expect")
+	}
+
+	if mmNextRevision.defaultExpectation.paramPtrs == nil {
+		mmNextRevision.defaultExpectation.paramPtrs = &RelationRepositoryMockNextRevisionParamPtrs{}
+	}
+	mmNextRevision.defaultExpectation.paramPtrs.ctx = &ctx
+	mmNextRevision.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmNextRevision
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationRepository.NextRevision
+func (mmNextRevision *mRelationRepositoryMockNextRevision) Inspect(f func(ctx context.Context)) *mRelationRepositoryMockNextRevision {
+	if mmNextRevision.mock.inspectFuncNextRevision != nil {
+		mmNextRevision.mock.t.Fatalf("Inspect function is already set for RelationRepositoryMock.NextRevision")
+	}
+
+	mmNextRevision.mock.inspectFuncNextRevision = f
+
+	return mmNextRevision
+}
+
+// Return sets up results that will be returned by RelationRepository.NextRevision
+func (mmNextRevision *mRelationRepositoryMockNextRevision) Return(i1 int64, err error) *RelationRepositoryMock {
+	if mmNextRevision.mock.funcNextRevision != nil {
+		mmNextRevision.mock.t.Fatalf("RelationRepositoryMock.NextRevision mock is already set by Set")
+	}
+
+	if mmNextRevision.defaultExpectation == nil {
+		mmNextRevision.defaultExpectation = &RelationRepositoryMockNextRevisionExpectation{mock: mmNextRevision.mock}
+	}
+	mmNextRevision.defaultExpectation.results = &RelationRepositoryMockNextRevisionResults{i1, err}
+	mmNextRevision.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmNextRevision.mock
+}
+
+// Set uses given function f to mock the RelationRepository.NextRevision method
+func (mmNextRevision *mRelationRepositoryMockNextRevision) Set(f func(ctx context.Context) (i1 int64, err error)) *RelationRepositoryMock {
+	if mmNextRevision.defaultExpectation != nil {
+		mmNextRevision.mock.t.Fatalf("Default expectation is already set for the RelationRepository.NextRevision method")
+	}
+
+	if len(mmNextRevision.expectations) > 0 {
+		mmNextRevision.mock.t.Fatalf("Some expectations are already set for the RelationRepository.NextRevision method")
+	}
+
+	mmNextRevision.mock.funcNextRevision = f
+	mmNextRevision.mock.funcNextRevisionOrigin = minimock.CallerInfo(1)
+	return mmNextRevision.mock
+}
+
+// When sets expectation for the RelationRepository.NextRevision which will trigger the result defined by the following
+// Then helper
+func (mmNextRevision *mRelationRepositoryMockNextRevision) When(ctx context.Context) *RelationRepositoryMockNextRevisionExpectation {
+	if mmNextRevision.mock.funcNextRevision != nil {
+		mmNextRevision.mock.t.Fatalf("RelationRepositoryMock.NextRevision mock is already set by Set")
+	}
+
+	expectation := &RelationRepositoryMockNextRevisionExpectation{
+		mock:               mmNextRevision.mock,
+		params:             &RelationRepositoryMockNextRevisionParams{ctx},
+		expectationOrigins: RelationRepositoryMockNextRevisionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmNextRevision.expectations = append(mmNextRevision.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationRepository.NextRevision return parameters for the expectation previously defined by the When method
+func (e *RelationRepositoryMockNextRevisionExpectation) Then(i1 int64, err error) *RelationRepositoryMock {
+	e.results = &RelationRepositoryMockNextRevisionResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times RelationRepository.NextRevision should be invoked
+func (mmNextRevision *mRelationRepositoryMockNextRevision) Times(n uint64) *mRelationRepositoryMockNextRevision {
+	if n == 0 {
+		mmNextRevision.mock.t.Fatalf("Times of RelationRepositoryMock.NextRevision mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmNextRevision.expectedInvocations, n)
+	mmNextRevision.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmNextRevision
+}
+
+func (mmNextRevision *mRelationRepositoryMockNextRevision) invocationsDone() bool {
+	if len(mmNextRevision.expectations) == 0 && mmNextRevision.defaultExpectation == nil && mmNextRevision.mock.funcNextRevision == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmNextRevision.mock.afterNextRevisionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmNextRevision.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// NextRevision implements mm_repository.RelationRepository
+func (mmNextRevision *RelationRepositoryMock) NextRevision(ctx context.Context) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmNextRevision.beforeNextRevisionCounter, 1)
+	defer mm_atomic.AddUint64(&mmNextRevision.afterNextRevisionCounter, 1)
+
+	mmNextRevision.t.Helper()
+
+	if mmNextRevision.inspectFuncNextRevision != nil {
+		mmNextRevision.inspectFuncNextRevision(ctx)
+	}
+
+	mm_params := RelationRepositoryMockNextRevisionParams{ctx}
+
+	// Record call args
+	mmNextRevision.NextRevisionMock.mutex.Lock()
+	mmNextRevision.NextRevisionMock.callArgs = append(mmNextRevision.NextRevisionMock.callArgs, &mm_params)
+	mmNextRevision.NextRevisionMock.mutex.Unlock()
+
+	for _, e := range mmNextRevision.NextRevisionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmNextRevision.NextRevisionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmNextRevision.NextRevisionMock.defaultExpectation.Counter, 1)
+		mm_want := mmNextRevision.NextRevisionMock.defaultExpectation.params
+		mm_want_ptrs := mmNextRevision.NextRevisionMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationRepositoryMockNextRevisionParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmNextRevision.t.Errorf("RelationRepositoryMock.NextRevision got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmNextRevision.NextRevisionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmNextRevision.t.Errorf("RelationRepositoryMock.NextRevision got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmNextRevision.NextRevisionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmNextRevision.NextRevisionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmNextRevision.t.Fatal("No results are set for the RelationRepositoryMock.NextRevision")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmNextRevision.funcNextRevision != nil {
+		return mmNextRevision.funcNextRevision(ctx)
+	}
+	mmNextRevision.t.Fatalf("Unexpected call to RelationRepositoryMock.NextRevision. %v", ctx)
+	return
+}
+
+// NextRevisionAfterCounter returns a count of finished RelationRepositoryMock.NextRevision invocations
+func (mmNextRevision *RelationRepositoryMock) NextRevisionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmNextRevision.afterNextRevisionCounter)
+}
+
+// NextRevisionBeforeCounter returns a count of RelationRepositoryMock.NextRevision invocations
+func (mmNextRevision *RelationRepositoryMock) NextRevisionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmNextRevision.beforeNextRevisionCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationRepositoryMock.NextRevision.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmNextRevision *mRelationRepositoryMockNextRevision) Calls() []*RelationRepositoryMockNextRevisionParams {
+	mmNextRevision.mutex.RLock()
+
+	argCopy := make([]*RelationRepositoryMockNextRevisionParams, len(mmNextRevision.callArgs))
+	copy(argCopy, mmNextRevision.callArgs)
+
+	mmNextRevision.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockNextRevisionDone returns true if the count of the NextRevision invocations corresponds
+// the number of defined expectations
+func (m *RelationRepositoryMock) MinimockNextRevisionDone() bool {
+	if m.NextRevisionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.NextRevisionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.NextRevisionMock.invocationsDone()
+}
+
+// MinimockNextRevisionInspect logs each unmet expectation
+func (m *RelationRepositoryMock) MinimockNextRevisionInspect() {
+	for _, e := range m.NextRevisionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationRepositoryMock.NextRevision at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterNextRevisionCounter := mm_atomic.LoadUint64(&m.afterNextRevisionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.NextRevisionMock.defaultExpectation != nil && afterNextRevisionCounter < 1 {
+		if m.NextRevisionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationRepositoryMock.NextRevision at\n%s", m.NextRevisionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationRepositoryMock.NextRevision at\n%s with params: %#v", m.NextRevisionMock.defaultExpectation.expectationOrigins.origin, *m.NextRevisionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcNextRevision != nil && afterNextRevisionCounter < 1 {
+		m.t.Errorf("Expected call to RelationRepositoryMock.NextRevision at\n%s", m.funcNextRevisionOrigin)
+	}
+
+	if !m.NextRevisionMock.invocationsDone() && afterNextRevisionCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationRepositoryMock.NextRevision at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.NextRevisionMock.expectedInvocations), m.NextRevisionMock.expectedInvocationsOrigin, afterNextRevisionCounter)
+	}
+}
+
+type mRelationRepositoryMockReadSubjectTuples struct {
+	optional           bool
+	mock               *RelationRepositoryMock
+	defaultExpectation *RelationRepositoryMockReadSubjectTuplesExpectation
+	expectations       []*RelationRepositoryMockReadSubjectTuplesExpectation
+
+	callArgs []*RelationRepositoryMockReadSubjectTuplesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationRepositoryMockReadSubjectTuplesExpectation specifies expectation struct of the RelationRepository.ReadSubjectTuples
+type RelationRepositoryMockReadSubjectTuplesExpectation struct {
+	mock               *RelationRepositoryMock
+	params             *RelationRepositoryMockReadSubjectTuplesParams
+	paramPtrs          *RelationRepositoryMockReadSubjectTuplesParamPtrs
+	expectationOrigins RelationRepositoryMockReadSubjectTuplesExpectationOrigins
+	results            *RelationRepositoryMockReadSubjectTuplesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationRepositoryMockReadSubjectTuplesParams contains parameters of the RelationRepository.ReadSubjectTuples
+type RelationRepositoryMockReadSubjectTuplesParams struct {
+	ctx     context.Context
+	subject model.RelationObject
+}
+
+// RelationRepositoryMockReadSubjectTuplesParamPtrs contains pointers to parameters of the RelationRepository.ReadSubjectTuples
+type RelationRepositoryMockReadSubjectTuplesParamPtrs struct {
+	ctx     *context.Context
+	subject *model.RelationObject
+}
+
+// RelationRepositoryMockReadSubjectTuplesResults contains results of the RelationRepository.ReadSubjectTuples
+type RelationRepositoryMockReadSubjectTuplesResults struct {
+	rpa1 []*model.RelationTuple
+	err  error
+}
+
+// RelationRepositoryMockReadSubjectTuplesOrigins contains origins of expectations of the RelationRepository.ReadSubjectTuples
+type RelationRepositoryMockReadSubjectTuplesExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originSubject string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReadSubjectTuples *mRelationRepositoryMockReadSubjectTuples) Optional() *mRelationRepositoryMockReadSubjectTuples {
+	mmReadSubjectTuples.optional = true
+	return mmReadSubjectTuples
+}
+
+// Expect sets up expected params for RelationRepository.ReadSubjectTuples
+func (mmReadSubjectTuples *mRelationRepositoryMockReadSubjectTuples) Expect(ctx context.Context, subject model.RelationObject) *mRelationRepositoryMockReadSubjectTuples {
+	if mmReadSubjectTuples.mock.funcReadSubjectTuples != nil {
+		mmReadSubjectTuples.mock.t.Fatalf("RelationRepositoryMock.ReadSubjectTuples mock is already set by Set")
+	}
+
+	if mmReadSubjectTuples.defaultExpectation == nil {
+		mmReadSubjectTuples.defaultExpectation = &RelationRepositoryMockReadSubjectTuplesExpectation{}
+	}
+
+	if mmReadSubjectTuples.defaultExpectation.paramPtrs != nil {
+		mmReadSubjectTuples.mock.t.Fatalf("RelationRepositoryMock.ReadSubjectTuples mock is already set by ExpectParams functions")
+	}
+
+	mmReadSubjectTuples.defaultExpectation.params = &RelationRepositoryMockReadSubjectTuplesParams{ctx, subject}
+	mmReadSubjectTuples.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReadSubjectTuples.expectations {
+		if minimock.Equal(e.params, mmReadSubjectTuples.defaultExpectation.params) {
+			mmReadSubjectTuples.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReadSubjectTuples.defaultExpectation.params)
+		}
+	}
+
+	return mmReadSubjectTuples
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationRepository.ReadSubjectTuples
+func (mmReadSubjectTuples *mRelationRepositoryMockReadSubjectTuples) ExpectCtxParam1(ctx context.Context) *mRelationRepositoryMockReadSubjectTuples {
+	if mmReadSubjectTuples.mock.funcReadSubjectTuples != nil {
+		mmReadSubjectTuples.mock.t.Fatalf("RelationRepositoryMock.ReadSubjectTuples mock is already set by Set")
+	}
+
+	if mmReadSubjectTuples.defaultExpectation == nil {
+		mmReadSubjectTuples.defaultExpectation = &RelationRepositoryMockReadSubjectTuplesExpectation{}
+	}
+
+	if mmReadSubjectTuples.defaultExpectation.params != nil {
+		mmReadSubjectTuples.mock.t.Fatalf("RelationRepositoryMock.ReadSubjectTuples mock is already set by Expect")
+	}
+
+	if mmReadSubjectTuples.defaultExpectation.paramPtrs == nil {
+		mmReadSubjectTuples.defaultExpectation.paramPtrs = &RelationRepositoryMockReadSubjectTuplesParamPtrs{}
+	}
+	mmReadSubjectTuples.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReadSubjectTuples.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReadSubjectTuples
+}
+
+// ExpectSubjectParam2 sets up expected param subject for RelationRepository.ReadSubjectTuples
+func (mmReadSubjectTuples *mRelationRepositoryMockReadSubjectTuples) ExpectSubjectParam2(subject model.RelationObject) *mRelationRepositoryMockReadSubjectTuples {
+	if mmReadSubjectTuples.mock.funcReadSubjectTuples != nil {
+		mmReadSubjectTuples.mock.t.Fatalf("RelationRepositoryMock.ReadSubjectTuples mock is already set by Set")
+	}
+
+	if mmReadSubjectTuples.defaultExpectation == nil {
+		mmReadSubjectTuples.defaultExpectation = &RelationRepositoryMockReadSubjectTuplesExpectation{}
+	}
+
+	if mmReadSubjectTuples.defaultExpectation.params != nil {
+		mmReadSubjectTuples.mock.t.Fatalf("RelationRepositoryMock.ReadSubjectTuples mock is already set by Expect")
+	}
+
+	if mmReadSubjectTuples.defaultExpectation.paramPtrs == nil {
+		mmReadSubjectTuples.defaultExpectation.paramPtrs = &RelationRepositoryMockReadSubjectTuplesParamPtrs{}
+	}
+	mmReadSubjectTuples.defaultExpectation.paramPtrs.subject = &subject
+	mmReadSubjectTuples.defaultExpectation.expectationOrigins.originSubject = minimock.CallerInfo(1)
+
+	return mmReadSubjectTuples
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationRepository.ReadSubjectTuples
+func (mmReadSubjectTuples *mRelationRepositoryMockReadSubjectTuples) Inspect(f func(ctx context.Context, subject model.RelationObject)) *mRelationRepositoryMockReadSubjectTuples {
+	if mmReadSubjectTuples.mock.inspectFuncReadSubjectTuples != nil {
+		mmReadSubjectTuples.mock.t.Fatalf("Inspect function is already set for RelationRepositoryMock.ReadSubjectTuples")
+	}
+
+	mmReadSubjectTuples.mock.inspectFuncReadSubjectTuples = f
+
+	return mmReadSubjectTuples
+}
+
+// Return sets up results that will be returned by RelationRepository.ReadSubjectTuples
+func (mmReadSubjectTuples *mRelationRepositoryMockReadSubjectTuples) Return(rpa1 []*model.RelationTuple, err error) *RelationRepositoryMock {
+	if mmReadSubjectTuples.mock.funcReadSubjectTuples != nil {
+		mmReadSubjectTuples.mock.t.Fatalf("RelationRepositoryMock.ReadSubjectTuples mock is already set by Set")
+	}
+
+	if mmReadSubjectTuples.defaultExpectation == nil {
+		mmReadSubjectTuples.defaultExpectation = &RelationRepositoryMockReadSubjectTuplesExpectation{mock: mmReadSubjectTuples.mock}
+	}
+	mmReadSubjectTuples.defaultExpectation.results = &RelationRepositoryMockReadSubjectTuplesResults{rpa1, err}
+	mmReadSubjectTuples.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReadSubjectTuples.mock
+}
+
+// Set uses given function f to mock the RelationRepository.ReadSubjectTuples method
+func (mmReadSubjectTuples *mRelationRepositoryMockReadSubjectTuples) Set(f func(ctx context.Context, subject model.RelationObject) (rpa1 []*model.RelationTuple, err error)) *RelationRepositoryMock {
+	if mmReadSubjectTuples.defaultExpectation != nil {
+		mmReadSubjectTuples.mock.t.Fatalf("Default expectation is already set for the RelationRepository.ReadSubjectTuples method")
+	}
+
+	if len(mmReadSubjectTuples.expectations) > 0 {
+		mmReadSubjectTuples.mock.t.Fatalf("Some expectations are already set for the RelationRepository.ReadSubjectTuples method")
+	}
+
+	mmReadSubjectTuples.mock.funcReadSubjectTuples = f
+	mmReadSubjectTuples.mock.funcReadSubjectTuplesOrigin = minimock.CallerInfo(1)
+	return mmReadSubjectTuples.mock
+}
+
+// When sets expectation for the RelationRepository.ReadSubjectTuples which will trigger the result defined by the following
+// Then helper
+func (mmReadSubjectTuples *mRelationRepositoryMockReadSubjectTuples) When(ctx context.Context, subject model.RelationObject) *RelationRepositoryMockReadSubjectTuplesExpectation {
+	if mmReadSubjectTuples.mock.funcReadSubjectTuples != nil {
+		mmReadSubjectTuples.mock.t.Fatalf("RelationRepositoryMock.ReadSubjectTuples mock is already set by Set")
+	}
+
+	expectation := &RelationRepositoryMockReadSubjectTuplesExpectation{
+		mock:               mmReadSubjectTuples.mock,
+		params:             &RelationRepositoryMockReadSubjectTuplesParams{ctx, subject},
+		expectationOrigins: RelationRepositoryMockReadSubjectTuplesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReadSubjectTuples.expectations = append(mmReadSubjectTuples.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationRepository.ReadSubjectTuples return parameters for the expectation previously defined by the When method
+func (e *RelationRepositoryMockReadSubjectTuplesExpectation) Then(rpa1 []*model.RelationTuple, err error) *RelationRepositoryMock {
+	e.results = &RelationRepositoryMockReadSubjectTuplesResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times RelationRepository.ReadSubjectTuples should be invoked
+func (mmReadSubjectTuples *mRelationRepositoryMockReadSubjectTuples) Times(n uint64) *mRelationRepositoryMockReadSubjectTuples {
+	if n == 0 {
+		mmReadSubjectTuples.mock.t.Fatalf("Times of RelationRepositoryMock.ReadSubjectTuples mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReadSubjectTuples.expectedInvocations, n)
+	mmReadSubjectTuples.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReadSubjectTuples
+}
+
+func (mmReadSubjectTuples *mRelationRepositoryMockReadSubjectTuples) invocationsDone() bool {
+	if len(mmReadSubjectTuples.expectations) == 0 && mmReadSubjectTuples.defaultExpectation == nil && mmReadSubjectTuples.mock.funcReadSubjectTuples == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReadSubjectTuples.mock.afterReadSubjectTuplesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReadSubjectTuples.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReadSubjectTuples implements mm_repository.RelationRepository
+func (mmReadSubjectTuples *RelationRepositoryMock) ReadSubjectTuples(ctx context.Context, subject model.RelationObject) (rpa1 []*model.RelationTuple, err error) {
+	mm_atomic.AddUint64(&mmReadSubjectTuples.beforeReadSubjectTuplesCounter, 1)
+	defer mm_atomic.AddUint64(&mmReadSubjectTuples.afterReadSubjectTuplesCounter, 1)
+
+	mmReadSubjectTuples.t.Helper()
+
+	if mmReadSubjectTuples.inspectFuncReadSubjectTuples != nil {
+		mmReadSubjectTuples.inspectFuncReadSubjectTuples(ctx, subject)
+	}
+
+	mm_params := RelationRepositoryMockReadSubjectTuplesParams{ctx, subject}
+
+	// Record call args
+	mmReadSubjectTuples.ReadSubjectTuplesMock.mutex.Lock()
+	mmReadSubjectTuples.ReadSubjectTuplesMock.callArgs = append(mmReadSubjectTuples.ReadSubjectTuplesMock.callArgs, &mm_params)
+	mmReadSubjectTuples.ReadSubjectTuplesMock.mutex.Unlock()
+
+	for _, e := range mmReadSubjectTuples.ReadSubjectTuplesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmReadSubjectTuples.ReadSubjectTuplesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReadSubjectTuples.ReadSubjectTuplesMock.defaultExpectation.Counter, 1)
+		mm_want := mmReadSubjectTuples.ReadSubjectTuplesMock.defaultExpectation.params
+		mm_want_ptrs := mmReadSubjectTuples.ReadSubjectTuplesMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationRepositoryMockReadSubjectTuplesParams{ctx, subject}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReadSubjectTuples.t.Errorf("RelationRepositoryMock.ReadSubjectTuples got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReadSubjectTuples.ReadSubjectTuplesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.subject != nil && !minimock.Equal(*mm_want_ptrs.subject, mm_got.subject) {
+				mmReadSubjectTuples.t.Errorf("RelationRepositoryMock.ReadSubjectTuples got unexpected parameter subject, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReadSubjectTuples.ReadSubjectTuplesMock.defaultExpectation.expectationOrigins.originSubject, *mm_want_ptrs.subject, mm_got.subject, minimock.Diff(*mm_want_ptrs.subject, mm_got.subject))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReadSubjectTuples.t.Errorf("RelationRepositoryMock.ReadSubjectTuples got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReadSubjectTuples.ReadSubjectTuplesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReadSubjectTuples.ReadSubjectTuplesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReadSubjectTuples.t.Fatal("No results are set for the RelationRepositoryMock.ReadSubjectTuples")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmReadSubjectTuples.funcReadSubjectTuples != nil {
+		return mmReadSubjectTuples.funcReadSubjectTuples(ctx, subject)
+	}
+	mmReadSubjectTuples.t.Fatalf("Unexpected call to RelationRepositoryMock.ReadSubjectTuples. %v %v", ctx, subject)
+	return
+}
+
+// ReadSubjectTuplesAfterCounter returns a count of finished RelationRepositoryMock.ReadSubjectTuples invocations
+func (mmReadSubjectTuples *RelationRepositoryMock) ReadSubjectTuplesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReadSubjectTuples.afterReadSubjectTuplesCounter)
+}
+
+// ReadSubjectTuplesBeforeCounter returns a count of RelationRepositoryMock.ReadSubjectTuples invocations
+func (mmReadSubjectTuples *RelationRepositoryMock) ReadSubjectTuplesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReadSubjectTuples.beforeReadSubjectTuplesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationRepositoryMock.ReadSubjectTuples.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReadSubjectTuples *mRelationRepositoryMockReadSubjectTuples) Calls() []*RelationRepositoryMockReadSubjectTuplesParams {
+	mmReadSubjectTuples.mutex.RLock()
+
+	argCopy := make([]*RelationRepositoryMockReadSubjectTuplesParams, len(mmReadSubjectTuples.callArgs))
+	copy(argCopy, mmReadSubjectTuples.callArgs)
+
+	mmReadSubjectTuples.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReadSubjectTuplesDone returns true if the count of the ReadSubjectTuples invocations corresponds
+// the number of defined expectations
+func (m *RelationRepositoryMock) MinimockReadSubjectTuplesDone() bool {
+	if m.ReadSubjectTuplesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReadSubjectTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReadSubjectTuplesMock.invocationsDone()
+}
+
+// MinimockReadSubjectTuplesInspect logs each unmet expectation
+func (m *RelationRepositoryMock) MinimockReadSubjectTuplesInspect() {
+	for _, e := range m.ReadSubjectTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationRepositoryMock.ReadSubjectTuples at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReadSubjectTuplesCounter := mm_atomic.LoadUint64(&m.afterReadSubjectTuplesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReadSubjectTuplesMock.defaultExpectation != nil && afterReadSubjectTuplesCounter < 1 {
+		if m.ReadSubjectTuplesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationRepositoryMock.ReadSubjectTuples at\n%s", m.ReadSubjectTuplesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationRepositoryMock.ReadSubjectTuples at\n%s with params: %#v", m.ReadSubjectTuplesMock.defaultExpectation.expectationOrigins.origin, *m.ReadSubjectTuplesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReadSubjectTuples != nil && afterReadSubjectTuplesCounter < 1 {
+		m.t.Errorf("Expected call to RelationRepositoryMock.ReadSubjectTuples at\n%s", m.funcReadSubjectTuplesOrigin)
+	}
+
+	if !m.ReadSubjectTuplesMock.invocationsDone() && afterReadSubjectTuplesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationRepositoryMock.ReadSubjectTuples at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReadSubjectTuplesMock.expectedInvocations), m.ReadSubjectTuplesMock.expectedInvocationsOrigin, afterReadSubjectTuplesCounter)
+	}
+}
+
+type mRelationRepositoryMockReadTuples struct {
+	optional           bool
+	mock               *RelationRepositoryMock
+	defaultExpectation *RelationRepositoryMockReadTuplesExpectation
+	expectations       []*RelationRepositoryMockReadTuplesExpectation
+
+	callArgs []*RelationRepositoryMockReadTuplesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationRepositoryMockReadTuplesExpectation specifies expectation struct of the RelationRepository.ReadTuples
+type RelationRepositoryMockReadTuplesExpectation struct {
+	mock               *RelationRepositoryMock
+	params             *RelationRepositoryMockReadTuplesParams
+	paramPtrs          *RelationRepositoryMockReadTuplesParamPtrs
+	expectationOrigins RelationRepositoryMockReadTuplesExpectationOrigins
+	results            *RelationRepositoryMockReadTuplesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationRepositoryMockReadTuplesParams contains parameters of the RelationRepository.ReadTuples
+type RelationRepositoryMockReadTuplesParams struct {
+	ctx      context.Context
+	object   model.RelationObject
+	relation string
+}
+
+// RelationRepositoryMockReadTuplesParamPtrs contains pointers to parameters of the RelationRepository.ReadTuples
+type RelationRepositoryMockReadTuplesParamPtrs struct {
+	ctx      *context.Context
+	object   *model.RelationObject
+	relation *string
+}
+
+// RelationRepositoryMockReadTuplesResults contains results of the RelationRepository.ReadTuples
+type RelationRepositoryMockReadTuplesResults struct {
+	rpa1 []*model.RelationTuple
+	err  error
+}
+
+// RelationRepositoryMockReadTuplesOrigins contains origins of expectations of the RelationRepository.ReadTuples
+type RelationRepositoryMockReadTuplesExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originObject   string
+	originRelation string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReadTuples *mRelationRepositoryMockReadTuples) Optional() *mRelationRepositoryMockReadTuples {
+	mmReadTuples.optional = true
+	return mmReadTuples
+}
+
+// Expect sets up expected params for RelationRepository.ReadTuples
+func (mmReadTuples *mRelationRepositoryMockReadTuples) Expect(ctx context.Context, object model.RelationObject, relation string) *mRelationRepositoryMockReadTuples {
+	if mmReadTuples.mock.funcReadTuples != nil {
+		mmReadTuples.mock.t.Fatalf("RelationRepositoryMock.ReadTuples mock is already set by Set")
+	}
+
+	if mmReadTuples.defaultExpectation == nil {
+		mmReadTuples.defaultExpectation = &RelationRepositoryMockReadTuplesExpectation{}
+	}
+
+	if mmReadTuples.defaultExpectation.paramPtrs != nil {
+		mmReadTuples.mock.t.Fatalf("RelationRepositoryMock.ReadTuples mock is already set by ExpectParams functions")
+	}
+
+	mmReadTuples.defaultExpectation.params = &RelationRepositoryMockReadTuplesParams{ctx, object, relation}
+	mmReadTuples.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReadTuples.expectations {
+		if minimock.Equal(e.params, mmReadTuples.defaultExpectation.params) {
+			mmReadTuples.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReadTuples.defaultExpectation.params)
+		}
+	}
+
+	return mmReadTuples
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationRepository.ReadTuples
+func (mmReadTuples *mRelationRepositoryMockReadTuples) ExpectCtxParam1(ctx context.Context) *mRelationRepositoryMockReadTuples {
+	if mmReadTuples.mock.funcReadTuples != nil {
+		mmReadTuples.mock.t.Fatalf("RelationRepositoryMock.ReadTuples mock is already set by Set")
+	}
+
+	if mmReadTuples.defaultExpectation == nil {
+		mmReadTuples.defaultExpectation = &RelationRepositoryMockReadTuplesExpectation{}
+	}
+
+	if mmReadTuples.defaultExpectation.params != nil {
+		mmReadTuples.mock.t.Fatalf("RelationRepositoryMock.ReadTuples mock is already set by Expect")
+	}
+
+	if mmReadTuples.defaultExpectation.paramPtrs == nil {
+		mmReadTuples.defaultExpectation.paramPtrs = &RelationRepositoryMockReadTuplesParamPtrs{}
+	}
+	mmReadTuples.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReadTuples.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReadTuples
+}
+
+// ExpectObjectParam2 sets up expected param object for RelationRepository.ReadTuples
+func (mmReadTuples *mRelationRepositoryMockReadTuples) ExpectObjectParam2(object model.RelationObject) *mRelationRepositoryMockReadTuples {
+	if mmReadTuples.mock.funcReadTuples != nil {
+		mmReadTuples.mock.t.Fatalf("RelationRepositoryMock.ReadTuples mock is already set by Set")
+	}
+
+	if mmReadTuples.defaultExpectation == nil {
+		mmReadTuples.defaultExpectation = &RelationRepositoryMockReadTuplesExpectation{}
+	}
+
+	if mmReadTuples.defaultExpectation.params != nil {
+		mmReadTuples.mock.t.Fatalf("RelationRepositoryMock.ReadTuples mock is already set by Expect")
+	}
+
+	if mmReadTuples.defaultExpectation.paramPtrs == nil {
+		mmReadTuples.defaultExpectation.paramPtrs = &RelationRepositoryMockReadTuplesParamPtrs{}
+	}
+	mmReadTuples.defaultExpectation.paramPtrs.object = &object
+	mmReadTuples.defaultExpectation.expectationOrigins.originObject = minimock.CallerInfo(1)
+
+	return mmReadTuples
+}
+
+// ExpectRelationParam3 sets up expected param relation for RelationRepository.ReadTuples
+func (mmReadTuples *mRelationRepositoryMockReadTuples) ExpectRelationParam3(relation string) *mRelationRepositoryMockReadTuples {
+	if mmReadTuples.mock.funcReadTuples != nil {
+		mmReadTuples.mock.t.Fatalf("RelationRepositoryMock.ReadTuples mock is already set by Set")
+	}
+
+	if mmReadTuples.defaultExpectation == nil {
+		mmReadTuples.defaultExpectation = &RelationRepositoryMockReadTuplesExpectation{}
+	}
+
+	if mmReadTuples.defaultExpectation.params != nil {
+		mmReadTuples.mock.t.Fatalf("RelationRepositoryMock.ReadTuples mock is already set by Expect")
+	}
+
+	if mmReadTuples.defaultExpectation.paramPtrs == nil {
+		mmReadTuples.defaultExpectation.paramPtrs = &RelationRepositoryMockReadTuplesParamPtrs{}
+	}
+	mmReadTuples.defaultExpectation.paramPtrs.relation = &relation
+	mmReadTuples.defaultExpectation.expectationOrigins.originRelation = minimock.CallerInfo(1)
+
+	return mmReadTuples
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationRepository.ReadTuples
+func (mmReadTuples *mRelationRepositoryMockReadTuples) Inspect(f func(ctx context.Context, object model.RelationObject, relation string)) *mRelationRepositoryMockReadTuples {
+	if mmReadTuples.mock.inspectFuncReadTuples != nil {
+		mmReadTuples.mock.t.Fatalf("Inspect function is already set for RelationRepositoryMock.ReadTuples")
+	}
+
+	mmReadTuples.mock.inspectFuncReadTuples = f
+
+	return mmReadTuples
+}
+
+// Return sets up results that will be returned by RelationRepository.ReadTuples
+func (mmReadTuples *mRelationRepositoryMockReadTuples) Return(rpa1 []*model.RelationTuple, err error) *RelationRepositoryMock {
+	if mmReadTuples.mock.funcReadTuples != nil {
+		mmReadTuples.mock.t.Fatalf("RelationRepositoryMock.ReadTuples mock is already set by Set")
+	}
+
+	if mmReadTuples.defaultExpectation == nil {
+		mmReadTuples.defaultExpectation = &RelationRepositoryMockReadTuplesExpectation{mock: mmReadTuples.mock}
+	}
+	mmReadTuples.defaultExpectation.results = &RelationRepositoryMockReadTuplesResults{rpa1, err}
+	mmReadTuples.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReadTuples.mock
+}
+
+// Set uses given function f to mock the RelationRepository.ReadTuples method
+func (mmReadTuples *mRelationRepositoryMockReadTuples) Set(f func(ctx context.Context, object model.RelationObject, relation string) (rpa1 []*model.RelationTuple, err error)) *RelationRepositoryMock {
+	if mmReadTuples.defaultExpectation != nil {
+		mmReadTuples.mock.t.Fatalf("Default expectation is already set for the RelationRepository.ReadTuples method")
+	}
+
+	if len(mmReadTuples.expectations) > 0 {
+		mmReadTuples.mock.t.Fatalf("Some expectations are already set for the RelationRepository.ReadTuples method")
+	}
+
+	mmReadTuples.mock.funcReadTuples = f
+	mmReadTuples.mock.funcReadTuplesOrigin = minimock.CallerInfo(1)
+	return mmReadTuples.mock
+}
+
+// When sets expectation for the RelationRepository.ReadTuples which will trigger the result defined by the following
+// Then helper
+func (mmReadTuples *mRelationRepositoryMockReadTuples) When(ctx context.Context, object model.RelationObject, relation string) *RelationRepositoryMockReadTuplesExpectation {
+	if mmReadTuples.mock.funcReadTuples != nil {
+		mmReadTuples.mock.t.Fatalf("RelationRepositoryMock.ReadTuples mock is already set by Set")
+	}
+
+	expectation := &RelationRepositoryMockReadTuplesExpectation{
+		mock:               mmReadTuples.mock,
+		params:             &RelationRepositoryMockReadTuplesParams{ctx, object, relation},
+		expectationOrigins: RelationRepositoryMockReadTuplesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReadTuples.expectations = append(mmReadTuples.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationRepository.ReadTuples return parameters for the expectation previously defined by the When method
+func (e *RelationRepositoryMockReadTuplesExpectation) Then(rpa1 []*model.RelationTuple, err error) *RelationRepositoryMock {
+	e.results = &RelationRepositoryMockReadTuplesResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times RelationRepository.ReadTuples should be invoked
+func (mmReadTuples *mRelationRepositoryMockReadTuples) Times(n uint64) *mRelationRepositoryMockReadTuples {
+	if n == 0 {
+		mmReadTuples.mock.t.Fatalf("Times of RelationRepositoryMock.ReadTuples mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReadTuples.expectedInvocations, n)
+	mmReadTuples.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReadTuples
+}
+
+func (mmReadTuples *mRelationRepositoryMockReadTuples) invocationsDone() bool {
+	if len(mmReadTuples.expectations) == 0 && mmReadTuples.defaultExpectation == nil && mmReadTuples.mock.funcReadTuples == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReadTuples.mock.afterReadTuplesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReadTuples.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReadTuples implements mm_repository.RelationRepository
+func (mmReadTuples *RelationRepositoryMock) ReadTuples(ctx context.Context, object model.RelationObject, relation string) (rpa1 []*model.RelationTuple, err error) {
+	mm_atomic.AddUint64(&mmReadTuples.beforeReadTuplesCounter, 1)
+	defer mm_atomic.AddUint64(&mmReadTuples.afterReadTuplesCounter, 1)
+
+	mmReadTuples.t.Helper()
+
+	if mmReadTuples.inspectFuncReadTuples != nil {
+		mmReadTuples.inspectFuncReadTuples(ctx, object, relation)
+	}
+
+	mm_params := RelationRepositoryMockReadTuplesParams{ctx, object, relation}
+
+	// Record call args
+	mmReadTuples.ReadTuplesMock.mutex.Lock()
+	mmReadTuples.ReadTuplesMock.callArgs = append(mmReadTuples.ReadTuplesMock.callArgs, &mm_params)
+	mmReadTuples.ReadTuplesMock.mutex.Unlock()
+
+	for _, e := range mmReadTuples.ReadTuplesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmReadTuples.ReadTuplesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReadTuples.ReadTuplesMock.defaultExpectation.Counter, 1)
+		mm_want := mmReadTuples.ReadTuplesMock.defaultExpectation.params
+		mm_want_ptrs := mmReadTuples.ReadTuplesMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationRepositoryMockReadTuplesParams{ctx, object, relation}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReadTuples.t.Errorf("RelationRepositoryMock.ReadTuples got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReadTuples.ReadTuplesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.object != nil && !minimock.Equal(*mm_want_ptrs.object, mm_got.object) {
+				mmReadTuples.t.Errorf("RelationRepositoryMock.ReadTuples got unexpected parameter object, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReadTuples.ReadTuplesMock.defaultExpectation.expectationOrigins.originObject, *mm_want_ptrs.object, mm_got.object, minimock.Diff(*mm_want_ptrs.object, mm_got.object))
+			}
+
+			if mm_want_ptrs.relation != nil && !minimock.Equal(*mm_want_ptrs.relation, mm_got.relation) {
+				mmReadTuples.t.Errorf("RelationRepositoryMock.ReadTuples got unexpected parameter relation, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReadTuples.ReadTuplesMock.defaultExpectation.expectationOrigins.originRelation, *mm_want_ptrs.relation, mm_got.relation, minimock.Diff(*mm_want_ptrs.relation, mm_got.relation))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReadTuples.t.Errorf("RelationRepositoryMock.ReadTuples got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReadTuples.ReadTuplesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReadTuples.ReadTuplesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReadTuples.t.Fatal("No results are set for the RelationRepositoryMock.ReadTuples")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmReadTuples.funcReadTuples != nil {
+		return mmReadTuples.funcReadTuples(ctx, object, relation)
+	}
+	mmReadTuples.t.Fatalf("Unexpected call to RelationRepositoryMock.ReadTuples. %v %v %v", ctx, object, relation)
+	return
+}
+
+// ReadTuplesAfterCounter returns a count of finished RelationRepositoryMock.ReadTuples invocations
+func (mmReadTuples *RelationRepositoryMock) ReadTuplesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReadTuples.afterReadTuplesCounter)
+}
+
+// ReadTuplesBeforeCounter returns a count of RelationRepositoryMock.ReadTuples invocations
+func (mmReadTuples *RelationRepositoryMock) ReadTuplesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReadTuples.beforeReadTuplesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationRepositoryMock.ReadTuples.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReadTuples *mRelationRepositoryMockReadTuples) Calls() []*RelationRepositoryMockReadTuplesParams {
+	mmReadTuples.mutex.RLock()
+
+	argCopy := make([]*RelationRepositoryMockReadTuplesParams, len(mmReadTuples.callArgs))
+	copy(argCopy, mmReadTuples.callArgs)
+
+	mmReadTuples.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReadTuplesDone returns true if the count of the ReadTuples invocations corresponds
+// the number of defined expectations
+func (m *RelationRepositoryMock) MinimockReadTuplesDone() bool {
+	if m.ReadTuplesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReadTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReadTuplesMock.invocationsDone()
+}
+
+// MinimockReadTuplesInspect logs each unmet expectation
+func (m *RelationRepositoryMock) MinimockReadTuplesInspect() {
+	for _, e := range m.ReadTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationRepositoryMock.ReadTuples at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReadTuplesCounter := mm_atomic.LoadUint64(&m.afterReadTuplesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReadTuplesMock.defaultExpectation != nil && afterReadTuplesCounter < 1 {
+		if m.ReadTuplesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationRepositoryMock.ReadTuples at\n%s", m.ReadTuplesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationRepositoryMock.ReadTuples at\n%s with params: %#v", m.ReadTuplesMock.defaultExpectation.expectationOrigins.origin, *m.ReadTuplesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReadTuples != nil && afterReadTuplesCounter < 1 {
+		m.t.Errorf("Expected call to RelationRepositoryMock.ReadTuples at\n%s", m.funcReadTuplesOrigin)
+	}
+
+	if !m.ReadTuplesMock.invocationsDone() && afterReadTuplesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationRepositoryMock.ReadTuples at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReadTuplesMock.expectedInvocations), m.ReadTuplesMock.expectedInvocationsOrigin, afterReadTuplesCounter)
+	}
+}
+
+type mRelationRepositoryMockRevision struct {
+	optional           bool
+	mock               *RelationRepositoryMock
+	defaultExpectation *RelationRepositoryMockRevisionExpectation
+	expectations       []*RelationRepositoryMockRevisionExpectation
+
+	callArgs []*RelationRepositoryMockRevisionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationRepositoryMockRevisionExpectation specifies expectation struct of the RelationRepository.Revision
+type RelationRepositoryMockRevisionExpectation struct {
+	mock               *RelationRepositoryMock
+	params             *RelationRepositoryMockRevisionParams
+	paramPtrs          *RelationRepositoryMockRevisionParamPtrs
+	expectationOrigins RelationRepositoryMockRevisionExpectationOrigins
+	results            *RelationRepositoryMockRevisionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationRepositoryMockRevisionParams contains parameters of the RelationRepository.Revision
+type RelationRepositoryMockRevisionParams struct {
+	ctx context.Context
+}
+
+// RelationRepositoryMockRevisionParamPtrs contains pointers to parameters of the RelationRepository.Revision
+type RelationRepositoryMockRevisionParamPtrs struct {
+	ctx *context.Context
+}
+
+// RelationRepositoryMockRevisionResults contains results of the RelationRepository.Revision
+type RelationRepositoryMockRevisionResults struct {
+	i1  int64
+	err error
+}
+
+// RelationRepositoryMockRevisionOrigins contains origins of expectations of the RelationRepository.Revision
+type RelationRepositoryMockRevisionExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevision *mRelationRepositoryMockRevision) Optional() *mRelationRepositoryMockRevision {
+	mmRevision.optional = true
+	return mmRevision
+}
+
+// Expect sets up expected params for RelationRepository.Revision
+func (mmRevision *mRelationRepositoryMockRevision) Expect(ctx context.Context) *mRelationRepositoryMockRevision {
+	if mmRevision.mock.funcRevision != nil {
+		mmRevision.mock.t.Fatalf("RelationRepositoryMock.Revision mock is already set by Set")
+	}
+
+	if mmRevision.defaultExpectation == nil {
+		mmRevision.defaultExpectation = &RelationRepositoryMockRevisionExpectation{}
+	}
+
+	if mmRevision.defaultExpectation.paramPtrs != nil {
+		mmRevision.mock.t.Fatalf("RelationRepositoryMock.Revision mock is already set by ExpectParams functions")
+	}
+
+	mmRevision.defaultExpectation.params = &RelationRepositoryMockRevisionParams{ctx}
+	mmRevision.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevision.expectations {
+		if minimock.Equal(e.params, mmRevision.defaultExpectation.params) {
+			mmRevision.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevision.defaultExpectation.params)
+		}
+	}
+
+	return mmRevision
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationRepository.Revision
+func (mmRevision *mRelationRepositoryMockRevision) ExpectCtxParam1(ctx context.Context) *mRelationRepositoryMockRevision {
+	if mmRevision.mock.funcRevision != nil {
+		mmRevision.mock.t.Fatalf("RelationRepositoryMock.Revision mock is already set by Set")
+	}
+
+	if mmRevision.defaultExpectation == nil {
+		mmRevision.defaultExpectation = &RelationRepositoryMockRevisionExpectation{}
+	}
+
+	if mmRevision.defaultExpectation.params != nil {
+		mmRevision.mock.t.Fatalf("RelationRepositoryMock.Revision mock is already set by Expect")
+	}
+
+	if mmRevision.defaultExpectation.paramPtrs == nil {
+		mmRevision.defaultExpectation.paramPtrs = &RelationRepositoryMockRevisionParamPtrs{}
+	}
+	mmRevision.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevision.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevision
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationRepository.Revision
+func (mmRevision *mRelationRepositoryMockRevision) Inspect(f func(ctx context.Context)) *mRelationRepositoryMockRevision {
+	if mmRevision.mock.inspectFuncRevision != nil {
+		mmRevision.mock.t.Fatalf("Inspect function is already set for RelationRepositoryMock.Revision")
+	}
+
+	mmRevision.mock.inspectFuncRevision = f
+
+	return mmRevision
+}
+
+// Return sets up results that will be returned by RelationRepository.Revision
+func (mmRevision *mRelationRepositoryMockRevision) Return(i1 int64, err error) *RelationRepositoryMock {
+	if mmRevision.mock.funcRevision != nil {
+		mmRevision.mock.t.Fatalf("RelationRepositoryMock.Revision mock is already set by Set")
+	}
+
+	if mmRevision.defaultExpectation == nil {
+		mmRevision.defaultExpectation = &RelationRepositoryMockRevisionExpectation{mock: mmRevision.mock}
+	}
+	mmRevision.defaultExpectation.results = &RelationRepositoryMockRevisionResults{i1, err}
+	mmRevision.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevision.mock
+}
+
+// Set uses given function f to mock the RelationRepository.Revision method
+func (mmRevision *mRelationRepositoryMockRevision) Set(f func(ctx context.Context) (i1 int64, err error)) *RelationRepositoryMock {
+	if mmRevision.defaultExpectation != nil {
+		mmRevision.mock.t.Fatalf("Default expectation is already set for the RelationRepository.Revision method")
+	}
+
+	if len(mmRevision.expectations) > 0 {
+		mmRevision.mock.t.Fatalf("Some expectations are already set for the RelationRepository.Revision method")
+	}
+
+	mmRevision.mock.funcRevision = f
+	mmRevision.mock.funcRevisionOrigin = minimock.CallerInfo(1)
+	return mmRevision.mock
+}
+
+// When sets expectation for the RelationRepository.Revision which will trigger the result defined by the following
+// Then helper
+func (mmRevision *mRelationRepositoryMockRevision) When(ctx context.Context) *RelationRepositoryMockRevisionExpectation {
+	if mmRevision.mock.funcRevision != nil {
+		mmRevision.mock.t.Fatalf("RelationRepositoryMock.Revision mock is already set by Set")
+	}
+
+	expectation := &RelationRepositoryMockRevisionExpectation{
+		mock:               mmRevision.mock,
+		params:             &RelationRepositoryMockRevisionParams{ctx},
+		expectationOrigins: RelationRepositoryMockRevisionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevision.expectations = append(mmRevision.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationRepository.Revision return parameters for the expectation previously defined by the When method
+func (e *RelationRepositoryMockRevisionExpectation) Then(i1 int64, err error) *RelationRepositoryMock {
+	e.results = &RelationRepositoryMockRevisionResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times RelationRepository.Revision should be invoked
+func (mmRevision *mRelationRepositoryMockRevision) Times(n uint64) *mRelationRepositoryMockRevision {
+	if n == 0 {
+		mmRevision.mock.t.Fatalf("Times of RelationRepositoryMock.Revision mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevision.expectedInvocations, n)
+	mmRevision.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevision
+}
+
+func (mmRevision *mRelationRepositoryMockRevision) invocationsDone() bool {
+	if len(mmRevision.expectations) == 0 && mmRevision.defaultExpectation == nil && mmRevision.mock.funcRevision == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevision.mock.afterRevisionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevision.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Revision implements mm_repository.RelationRepository
+func (mmRevision *RelationRepositoryMock) Revision(ctx context.Context) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmRevision.beforeRevisionCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevision.afterRevisionCounter, 1)
+
+	mmRevision.t.Helper()
+
+	if mmRevision.inspectFuncRevision != nil {
+		mmRevision.inspectFuncRevision(ctx)
+	}
+
+	mm_params := RelationRepositoryMockRevisionParams{ctx}
+
+	// Record call args
+	mmRevision.RevisionMock.mutex.Lock()
+	mmRevision.RevisionMock.callArgs = append(mmRevision.RevisionMock.callArgs, &mm_params)
+	mmRevision.RevisionMock.mutex.Unlock()
+
+	for _, e := range mmRevision.RevisionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmRevision.RevisionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevision.RevisionMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevision.RevisionMock.defaultExpectation.params
+		mm_want_ptrs := mmRevision.RevisionMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationRepositoryMockRevisionParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevision.t.Errorf("RelationRepositoryMock.Revision got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevision.RevisionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevision.t.Errorf("RelationRepositoryMock.Revision got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevision.RevisionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevision.RevisionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevision.t.Fatal("No results are set for the RelationRepositoryMock.Revision")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmRevision.funcRevision != nil {
+		return mmRevision.funcRevision(ctx)
+	}
+	mmRevision.t.Fatalf("Unexpected call to RelationRepositoryMock.Revision. %v", ctx)
+	return
+}
+
+// RevisionAfterCounter returns a count of finished RelationRepositoryMock.Revision invocations
+func (mmRevision *RelationRepositoryMock) RevisionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevision.afterRevisionCounter)
+}
+
+// RevisionBeforeCounter returns a count of RelationRepositoryMock.Revision invocations
+func (mmRevision *RelationRepositoryMock) RevisionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevision.beforeRevisionCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationRepositoryMock.Revision.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevision *mRelationRepositoryMockRevision) Calls() []*RelationRepositoryMockRevisionParams {
+	mmRevision.mutex.RLock()
+
+	argCopy := make([]*RelationRepositoryMockRevisionParams, len(mmRevision.callArgs))
+	copy(argCopy, mmRevision.callArgs)
+
+	mmRevision.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevisionDone returns true if the count of the Revision invocations corresponds
+// the number of defined expectations
+func (m *RelationRepositoryMock) MinimockRevisionDone() bool {
+	if m.RevisionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevisionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevisionMock.invocationsDone()
+}
+
+// MinimockRevisionInspect logs each unmet expectation
+func (m *RelationRepositoryMock) MinimockRevisionInspect() {
+	for _, e := range m.RevisionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationRepositoryMock.Revision at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevisionCounter := mm_atomic.LoadUint64(&m.afterRevisionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevisionMock.defaultExpectation != nil && afterRevisionCounter < 1 {
+		if m.RevisionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationRepositoryMock.Revision at\n%s", m.RevisionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationRepositoryMock.Revision at\n%s with params: %#v", m.RevisionMock.defaultExpectation.expectationOrigins.origin, *m.RevisionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevision != nil && afterRevisionCounter < 1 {
+		m.t.Errorf("Expected call to RelationRepositoryMock.Revision at\n%s", m.funcRevisionOrigin)
+	}
+
+	if !m.RevisionMock.invocationsDone() && afterRevisionCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationRepositoryMock.Revision at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevisionMock.expectedInvocations), m.RevisionMock.expectedInvocationsOrigin, afterRevisionCounter)
+	}
+}
+
+type mRelationRepositoryMockWriteTuples struct {
+	optional           bool
+	mock               *RelationRepositoryMock
+	defaultExpectation *RelationRepositoryMockWriteTuplesExpectation
+	expectations       []*RelationRepositoryMockWriteTuplesExpectation
+
+	callArgs []*RelationRepositoryMockWriteTuplesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationRepositoryMockWriteTuplesExpectation specifies expectation struct of the RelationRepository.WriteTuples
+type RelationRepositoryMockWriteTuplesExpectation struct {
+	mock               *RelationRepositoryMock
+	params             *RelationRepositoryMockWriteTuplesParams
+	paramPtrs          *RelationRepositoryMockWriteTuplesParamPtrs
+	expectationOrigins RelationRepositoryMockWriteTuplesExpectationOrigins
+	results            *RelationRepositoryMockWriteTuplesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationRepositoryMockWriteTuplesParams contains parameters of the RelationRepository.WriteTuples
+type RelationRepositoryMockWriteTuplesParams struct {
+	ctx      context.Context
+	tuples   []*model.RelationTuple
+	revision int64
+}
+
+// RelationRepositoryMockWriteTuplesParamPtrs contains pointers to parameters of the RelationRepository.WriteTuples
+type RelationRepositoryMockWriteTuplesParamPtrs struct {
+	ctx      *context.Context
+	tuples   *[]*model.RelationTuple
+	revision *int64
+}
+
+// RelationRepositoryMockWriteTuplesResults contains results of the RelationRepository.WriteTuples
+type RelationRepositoryMockWriteTuplesResults struct {
+	err error
+}
+
+// RelationRepositoryMockWriteTuplesOrigins contains origins of expectations of the RelationRepository.WriteTuples
+type RelationRepositoryMockWriteTuplesExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originTuples   string
+	originRevision string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmWriteTuples *mRelationRepositoryMockWriteTuples) Optional() *mRelationRepositoryMockWriteTuples {
+	mmWriteTuples.optional = true
+	return mmWriteTuples
+}
+
+// Expect sets up expected params for RelationRepository.WriteTuples
+func (mmWriteTuples *mRelationRepositoryMockWriteTuples) Expect(ctx context.Context, tuples []*model.RelationTuple, revision int64) *mRelationRepositoryMockWriteTuples {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationRepositoryMock.WriteTuples mock is already set by Set")
+	}
+
+	if mmWriteTuples.defaultExpectation == nil {
+		mmWriteTuples.defaultExpectation = &RelationRepositoryMockWriteTuplesExpectation{}
+	}
+
+	if mmWriteTuples.defaultExpectation.paramPtrs != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationRepositoryMock.WriteTuples mock is already set by ExpectParams functions")
+	}
+
+	mmWriteTuples.defaultExpectation.params = &RelationRepositoryMockWriteTuplesParams{ctx, tuples, revision}
+	mmWriteTuples.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmWriteTuples.expectations {
+		if minimock.Equal(e.params, mmWriteTuples.defaultExpectation.params) {
+			mmWriteTuples.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWriteTuples.defaultExpectation.params)
+		}
+	}
+
+	return mmWriteTuples
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationRepository.WriteTuples
+func (mmWriteTuples *mRelationRepositoryMockWriteTuples) ExpectCtxParam1(ctx context.Context) *mRelationRepositoryMockWriteTuples {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationRepositoryMock.WriteTuples mock is already set by Set")
+	}
+
+	if mmWriteTuples.defaultExpectation == nil {
+		mmWriteTuples.defaultExpectation = &RelationRepositoryMockWriteTuplesExpectation{}
+	}
+
+	if mmWriteTuples.defaultExpectation.params != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationRepositoryMock.WriteTuples mock is already set by Expect")
+	}
+
+	if mmWriteTuples.defaultExpectation.paramPtrs == nil {
+		mmWriteTuples.defaultExpectation.paramPtrs = &RelationRepositoryMockWriteTuplesParamPtrs{}
+	}
+	mmWriteTuples.defaultExpectation.paramPtrs.ctx = &ctx
+	mmWriteTuples.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmWriteTuples
+}
+
+// ExpectTuplesParam2 sets up expected param tuples for RelationRepository.WriteTuples
+func (mmWriteTuples *mRelationRepositoryMockWriteTuples) ExpectTuplesParam2(tuples []*model.RelationTuple) *mRelationRepositoryMockWriteTuples {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationRepositoryMock.WriteTuples mock is already set by Set")
+	}
+
+	if mmWriteTuples.defaultExpectation == nil {
+		mmWriteTuples.defaultExpectation = &RelationRepositoryMockWriteTuplesExpectation{}
+	}
+
+	if mmWriteTuples.defaultExpectation.params != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationRepositoryMock.WriteTuples mock is already set by Expect")
+	}
+
+	if mmWriteTuples.defaultExpectation.paramPtrs == nil {
+		mmWriteTuples.defaultExpectation.paramPtrs = &RelationRepositoryMockWriteTuplesParamPtrs{}
+	}
+	mmWriteTuples.defaultExpectation.paramPtrs.tuples = &tuples
+	mmWriteTuples.defaultExpectation.expectationOrigins.originTuples = minimock.CallerInfo(1)
+
+	return mmWriteTuples
+}
+
+// ExpectRevisionParam3 sets up expected param revision for RelationRepository.WriteTuples
+func (mmWriteTuples *mRelationRepositoryMockWriteTuples) ExpectRevisionParam3(revision int64) *mRelationRepositoryMockWriteTuples {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationRepositoryMock.WriteTuples mock is already set by Set")
+	}
+
+	if mmWriteTuples.defaultExpectation == nil {
+		mmWriteTuples.defaultExpectation = &RelationRepositoryMockWriteTuplesExpectation{}
+	}
+
+	if mmWriteTuples.defaultExpectation.params != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationRepositoryMock.WriteTuples mock is already set by Expect")
+	}
+
+	if mmWriteTuples.defaultExpectation.paramPtrs == nil {
+		mmWriteTuples.defaultExpectation.paramPtrs = &RelationRepositoryMockWriteTuplesParamPtrs{}
+	}
+	mmWriteTuples.defaultExpectation.paramPtrs.revision = &revision
+	mmWriteTuples.defaultExpectation.expectationOrigins.originRevision = minimock.CallerInfo(1)
+
+	return mmWriteTuples
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationRepository.WriteTuples
+func (mmWriteTuples *mRelationRepositoryMockWriteTuples) Inspect(f func(ctx context.Context, tuples []*model.RelationTuple, revision int64)) *mRelationRepositoryMockWriteTuples {
+	if mmWriteTuples.mock.inspectFuncWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("Inspect function is already set for RelationRepositoryMock.WriteTuples")
+	}
+
+	mmWriteTuples.mock.inspectFuncWriteTuples = f
+
+	return mmWriteTuples
+}
+
+// Return sets up results that will be returned by RelationRepository.WriteTuples
+func (mmWriteTuples *mRelationRepositoryMockWriteTuples) Return(err error) *RelationRepositoryMock {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationRepositoryMock.WriteTuples mock is already set by Set")
+	}
+
+	if mmWriteTuples.defaultExpectation == nil {
+		mmWriteTuples.defaultExpectation = &RelationRepositoryMockWriteTuplesExpectation{mock: mmWriteTuples.mock}
+	}
+	mmWriteTuples.defaultExpectation.results = &RelationRepositoryMockWriteTuplesResults{err}
+	mmWriteTuples.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmWriteTuples.mock
+}
+
+// Set uses given function f to mock the RelationRepository.WriteTuples method
+func (mmWriteTuples *mRelationRepositoryMockWriteTuples) Set(f func(ctx context.Context, tuples []*model.RelationTuple, revision int64) (err error)) *RelationRepositoryMock {
+	if mmWriteTuples.defaultExpectation != nil {
+		mmWriteTuples.mock.t.Fatalf("Default expectation is already set for the RelationRepository.WriteTuples method")
+	}
+
+	if len(mmWriteTuples.expectations) > 0 {
+		mmWriteTuples.mock.t.Fatalf("Some expectations are already set for the RelationRepository.WriteTuples method")
+	}
+
+	mmWriteTuples.mock.funcWriteTuples = f
+	mmWriteTuples.mock.funcWriteTuplesOrigin = minimock.CallerInfo(1)
+	return mmWriteTuples.mock
+}
+
+// When sets expectation for the RelationRepository.WriteTuples which will trigger the result defined by the following
+// Then helper
+func (mmWriteTuples *mRelationRepositoryMockWriteTuples) When(ctx context.Context, tuples []*model.RelationTuple, revision int64) *RelationRepositoryMockWriteTuplesExpectation {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationRepositoryMock.WriteTuples mock is already set by Set")
+	}
+
+	expectation := &RelationRepositoryMockWriteTuplesExpectation{
+		mock:               mmWriteTuples.mock,
+		params:             &RelationRepositoryMockWriteTuplesParams{ctx, tuples, revision},
+		expectationOrigins: RelationRepositoryMockWriteTuplesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmWriteTuples.expectations = append(mmWriteTuples.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationRepository.WriteTuples return parameters for the expectation previously defined by the When method
+func (e *RelationRepositoryMockWriteTuplesExpectation) Then(err error) *RelationRepositoryMock {
+	e.results = &RelationRepositoryMockWriteTuplesResults{err}
+	return e.mock
+}
+
+// Times sets number of times RelationRepository.WriteTuples should be invoked
+func (mmWriteTuples *mRelationRepositoryMockWriteTuples) Times(n uint64) *mRelationRepositoryMockWriteTuples {
+	if n == 0 {
+		mmWriteTuples.mock.t.Fatalf("Times of RelationRepositoryMock.WriteTuples mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmWriteTuples.expectedInvocations, n)
+	mmWriteTuples.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmWriteTuples
+}
+
+func (mmWriteTuples *mRelationRepositoryMockWriteTuples) invocationsDone() bool {
+	if len(mmWriteTuples.expectations) == 0 && mmWriteTuples.defaultExpectation == nil && mmWriteTuples.mock.funcWriteTuples == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmWriteTuples.mock.afterWriteTuplesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWriteTuples.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// WriteTuples implements mm_repository.RelationRepository
+func (mmWriteTuples *RelationRepositoryMock) WriteTuples(ctx context.Context, tuples []*model.RelationTuple, revision int64) (err error) {
+	mm_atomic.AddUint64(&mmWriteTuples.beforeWriteTuplesCounter, 1)
+	defer mm_atomic.AddUint64(&mmWriteTuples.afterWriteTuplesCounter, 1)
+
+	mmWriteTuples.t.Helper()
+
+	if mmWriteTuples.inspectFuncWriteTuples != nil {
+		mmWriteTuples.inspectFuncWriteTuples(ctx, tuples, revision)
+	}
+
+	mm_params := RelationRepositoryMockWriteTuplesParams{ctx, tuples, revision}
+
+	// Record call args
+	mmWriteTuples.WriteTuplesMock.mutex.Lock()
+	mmWriteTuples.WriteTuplesMock.callArgs = append(mmWriteTuples.WriteTuplesMock.callArgs, &mm_params)
+	mmWriteTuples.WriteTuplesMock.mutex.Unlock()
+
+	for _, e := range mmWriteTuples.WriteTuplesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmWriteTuples.WriteTuplesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWriteTuples.WriteTuplesMock.defaultExpectation.Counter, 1)
+		mm_want := mmWriteTuples.WriteTuplesMock.defaultExpectation.params
+		mm_want_ptrs := mmWriteTuples.WriteTuplesMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationRepositoryMockWriteTuplesParams{ctx, tuples, revision}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmWriteTuples.t.Errorf("RelationRepositoryMock.WriteTuples got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWriteTuples.WriteTuplesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tuples != nil && !minimock.Equal(*mm_want_ptrs.tuples, mm_got.tuples) {
+				mmWriteTuples.t.Errorf("RelationRepositoryMock.WriteTuples got unexpected parameter tuples, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWriteTuples.WriteTuplesMock.defaultExpectation.expectationOrigins.originTuples, *mm_want_ptrs.tuples, mm_got.tuples, minimock.Diff(*mm_want_ptrs.tuples, mm_got.tuples))
+			}
+
+			if mm_want_ptrs.revision != nil && !minimock.Equal(*mm_want_ptrs.revision, mm_got.revision) {
+				mmWriteTuples.t.Errorf("RelationRepositoryMock.WriteTuples got unexpected parameter revision, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWriteTuples.WriteTuplesMock.defaultExpectation.expectationOrigins.originRevision, *mm_want_ptrs.revision, mm_got.revision, minimock.Diff(*mm_want_ptrs.revision, mm_got.revision))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmWriteTuples.t.Errorf("RelationRepositoryMock.WriteTuples got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmWriteTuples.WriteTuplesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmWriteTuples.WriteTuplesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmWriteTuples.t.Fatal("No results are set for the RelationRepositoryMock.WriteTuples")
+		}
+		return (*mm_results).err
+	}
+	if mmWriteTuples.funcWriteTuples != nil {
+		return mmWriteTuples.funcWriteTuples(ctx, tuples, revision)
+	}
+	mmWriteTuples.t.Fatalf("Unexpected call to RelationRepositoryMock.WriteTuples. %v %v %v", ctx, tuples, revision)
+	return
+}
+
+// WriteTuplesAfterCounter returns a count of finished RelationRepositoryMock.WriteTuples invocations
+func (mmWriteTuples *RelationRepositoryMock) WriteTuplesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWriteTuples.afterWriteTuplesCounter)
+}
+
+// WriteTuplesBeforeCounter returns a count of RelationRepositoryMock.WriteTuples invocations
+func (mmWriteTuples *RelationRepositoryMock) WriteTuplesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWriteTuples.beforeWriteTuplesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationRepositoryMock.WriteTuples.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmWriteTuples *mRelationRepositoryMockWriteTuples) Calls() []*RelationRepositoryMockWriteTuplesParams {
+	mmWriteTuples.mutex.RLock()
+
+	argCopy := make([]*RelationRepositoryMockWriteTuplesParams, len(mmWriteTuples.callArgs))
+	copy(argCopy, mmWriteTuples.callArgs)
+
+	mmWriteTuples.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockWriteTuplesDone returns true if the count of the WriteTuples invocations corresponds
+// the number of defined expectations
+func (m *RelationRepositoryMock) MinimockWriteTuplesDone() bool {
+	if m.WriteTuplesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.WriteTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.WriteTuplesMock.invocationsDone()
+}
+
+// MinimockWriteTuplesInspect logs each unmet expectation
+func (m *RelationRepositoryMock) MinimockWriteTuplesInspect() {
+	for _, e := range m.WriteTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationRepositoryMock.WriteTuples at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterWriteTuplesCounter := mm_atomic.LoadUint64(&m.afterWriteTuplesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.WriteTuplesMock.defaultExpectation != nil && afterWriteTuplesCounter < 1 {
+		if m.WriteTuplesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationRepositoryMock.WriteTuples at\n%s", m.WriteTuplesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationRepositoryMock.WriteTuples at\n%s with params: %#v", m.WriteTuplesMock.defaultExpectation.expectationOrigins.origin, *m.WriteTuplesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcWriteTuples != nil && afterWriteTuplesCounter < 1 {
+		m.t.Errorf("Expected call to RelationRepositoryMock.WriteTuples at\n%s", m.funcWriteTuplesOrigin)
+	}
+
+	if !m.WriteTuplesMock.invocationsDone() && afterWriteTuplesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationRepositoryMock.WriteTuples at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.WriteTuplesMock.expectedInvocations), m.WriteTuplesMock.expectedInvocationsOrigin, afterWriteTuplesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RelationRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDeleteTuplesInspect()
+
+			m.MinimockNextRevisionInspect()
+
+			m.MinimockReadSubjectTuplesInspect()
+
+			m.MinimockReadTuplesInspect()
+
+			m.MinimockRevisionInspect()
+
+			m.MinimockWriteTuplesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RelationRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RelationRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeleteTuplesDone() &&
+		m.MinimockNextRevisionDone() &&
+		m.MinimockReadSubjectTuplesDone() &&
+		m.MinimockReadTuplesDone() &&
+		m.MinimockRevisionDone() &&
+		m.MinimockWriteTuplesDone()
+}
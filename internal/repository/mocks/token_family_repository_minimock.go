@@ -0,0 +1,2317 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// TokenFamilyRepositoryMock implements mm_repository.TokenFamilyRepository
+type TokenFamilyRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, family *model.TokenFamily) (err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, family *model.TokenFamily)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mTokenFamilyRepositoryMockCreate
+
+	funcGet          func(ctx context.Context, id string) (tp1 *model.TokenFamily, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, id string)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mTokenFamilyRepositoryMockGet
+
+	funcListActive          func(ctx context.Context, userID string) (tpa1 []*model.TokenFamily, err error)
+	funcListActiveOrigin    string
+	inspectFuncListActive   func(ctx context.Context, userID string)
+	afterListActiveCounter  uint64
+	beforeListActiveCounter uint64
+	ListActiveMock          mTokenFamilyRepositoryMockListActive
+
+	funcRevoke          func(ctx context.Context, id string) (err error)
+	funcRevokeOrigin    string
+	inspectFuncRevoke   func(ctx context.Context, id string)
+	afterRevokeCounter  uint64
+	beforeRevokeCounter uint64
+	RevokeMock          mTokenFamilyRepositoryMockRevoke
+
+	funcRevokeAll          func(ctx context.Context, userID string, exceptID string) (err error)
+	funcRevokeAllOrigin    string
+	inspectFuncRevokeAll   func(ctx context.Context, userID string, exceptID string)
+	afterRevokeAllCounter  uint64
+	beforeRevokeAllCounter uint64
+	RevokeAllMock          mTokenFamilyRepositoryMockRevokeAll
+
+	funcRotate          func(ctx context.Context, id string, currentTokenID string, nextTokenID string, client *model.ClientInfo) (b1 bool, err error)
+	funcRotateOrigin    string
+	inspectFuncRotate   func(ctx context.Context, id string, currentTokenID string, nextTokenID string, client *model.ClientInfo)
+	afterRotateCounter  uint64
+	beforeRotateCounter uint64
+	RotateMock          mTokenFamilyRepositoryMockRotate
+}
+
+// NewTokenFamilyRepositoryMock returns a mock for mm_repository.TokenFamilyRepository
+func NewTokenFamilyRepositoryMock(t minimock.Tester) *TokenFamilyRepositoryMock {
+	m := &TokenFamilyRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mTokenFamilyRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*TokenFamilyRepositoryMockCreateParams{}
+
+	m.GetMock = mTokenFamilyRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*TokenFamilyRepositoryMockGetParams{}
+
+	m.ListActiveMock = mTokenFamilyRepositoryMockListActive{mock: m}
+	m.ListActiveMock.callArgs = []*TokenFamilyRepositoryMockListActiveParams{}
+
+	m.RevokeMock = mTokenFamilyRepositoryMockRevoke{mock: m}
+	m.RevokeMock.callArgs = []*TokenFamilyRepositoryMockRevokeParams{}
+
+	m.RevokeAllMock = mTokenFamilyRepositoryMockRevokeAll{mock: m}
+	m.RevokeAllMock.callArgs = []*TokenFamilyRepositoryMockRevokeAllParams{}
+
+	m.RotateMock = mTokenFamilyRepositoryMockRotate{mock: m}
+	m.RotateMock.callArgs = []*TokenFamilyRepositoryMockRotateParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mTokenFamilyRepositoryMockCreate struct {
+	optional           bool
+	mock               *TokenFamilyRepositoryMock
+	defaultExpectation *TokenFamilyRepositoryMockCreateExpectation
+	expectations       []*TokenFamilyRepositoryMockCreateExpectation
+
+	callArgs []*TokenFamilyRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TokenFamilyRepositoryMockCreateExpectation specifies expectation struct of the TokenFamilyRepository.Create
+type TokenFamilyRepositoryMockCreateExpectation struct {
+	mock               *TokenFamilyRepositoryMock
+	params             *TokenFamilyRepositoryMockCreateParams
+	paramPtrs          *TokenFamilyRepositoryMockCreateParamPtrs
+	expectationOrigins TokenFamilyRepositoryMockCreateExpectationOrigins
+	results            *TokenFamilyRepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TokenFamilyRepositoryMockCreateParams contains parameters of the TokenFamilyRepository.Create
+type TokenFamilyRepositoryMockCreateParams struct {
+	ctx    context.Context
+	family *model.TokenFamily
+}
+
+// TokenFamilyRepositoryMockCreateParamPtrs contains pointers to parameters of the TokenFamilyRepository.Create
+type TokenFamilyRepositoryMockCreateParamPtrs struct {
+	ctx    *context.Context
+	family **model.TokenFamily
+}
+
+// TokenFamilyRepositoryMockCreateResults contains results of the TokenFamilyRepository.Create
+type TokenFamilyRepositoryMockCreateResults struct {
+	err error
+}
+
+// TokenFamilyRepositoryMockCreateOrigins contains origins of expectations of the TokenFamilyRepository.Create
+type TokenFamilyRepositoryMockCreateExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFamily string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mTokenFamilyRepositoryMockCreate) Optional() *mTokenFamilyRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for TokenFamilyRepository.Create
+func (mmCreate *mTokenFamilyRepositoryMockCreate) Expect(ctx context.Context, family *model.TokenFamily) *mTokenFamilyRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("TokenFamilyRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &TokenFamilyRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("TokenFamilyRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &TokenFamilyRepositoryMockCreateParams{ctx, family}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TokenFamilyRepository.Create
+func (mmCreate *mTokenFamilyRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mTokenFamilyRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("TokenFamilyRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &TokenFamilyRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("TokenFamilyRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &TokenFamilyRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectFamilyParam2 sets up expected param family for TokenFamilyRepository.Create
+func (mmCreate *mTokenFamilyRepositoryMockCreate) ExpectFamilyParam2(family *model.TokenFamily) *mTokenFamilyRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("TokenFamilyRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &TokenFamilyRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("TokenFamilyRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &TokenFamilyRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.family = &family
+	mmCreate.defaultExpectation.expectationOrigins.originFamily = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenFamilyRepository.Create
+func (mmCreate *mTokenFamilyRepositoryMockCreate) Inspect(f func(ctx context.Context, family *model.TokenFamily)) *mTokenFamilyRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for TokenFamilyRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by TokenFamilyRepository.Create
+func (mmCreate *mTokenFamilyRepositoryMockCreate) Return(err error) *TokenFamilyRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("TokenFamilyRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &TokenFamilyRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &TokenFamilyRepositoryMockCreateResults{err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the TokenFamilyRepository.Create method
+func (mmCreate *mTokenFamilyRepositoryMockCreate) Set(f func(ctx context.Context, family *model.TokenFamily) (err error)) *TokenFamilyRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the TokenFamilyRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the TokenFamilyRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the TokenFamilyRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mTokenFamilyRepositoryMockCreate) When(ctx context.Context, family *model.TokenFamily) *TokenFamilyRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("TokenFamilyRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &TokenFamilyRepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &TokenFamilyRepositoryMockCreateParams{ctx, family},
+		expectationOrigins: TokenFamilyRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TokenFamilyRepository.Create return parameters for the expectation previously defined by the When method
+func (e *TokenFamilyRepositoryMockCreateExpectation) Then(err error) *TokenFamilyRepositoryMock {
+	e.results = &TokenFamilyRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times TokenFamilyRepository.Create should be invoked
+func (mmCreate *mTokenFamilyRepositoryMockCreate) Times(n uint64) *mTokenFamilyRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of TokenFamilyRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mTokenFamilyRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_repository.TokenFamilyRepository
+func (mmCreate *TokenFamilyRepositoryMock) Create(ctx context.Context, family *model.TokenFamily) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, family)
+	}
+
+	mm_params := TokenFamilyRepositoryMockCreateParams{ctx, family}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := TokenFamilyRepositoryMockCreateParams{ctx, family}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("TokenFamilyRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.family != nil && !minimock.Equal(*mm_want_ptrs.family, mm_got.family) {
+				mmCreate.t.Errorf("TokenFamilyRepositoryMock.Create got unexpected parameter family, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originFamily, *mm_want_ptrs.family, mm_got.family, minimock.Diff(*mm_want_ptrs.family, mm_got.family))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("TokenFamilyRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the TokenFamilyRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, family)
+	}
+	mmCreate.t.Fatalf("Unexpected call to TokenFamilyRepositoryMock.Create. %v %v", ctx, family)
+	return
+}
+
+// CreateAfterCounter returns a count of finished TokenFamilyRepositoryMock.Create invocations
+func (mmCreate *TokenFamilyRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of TokenFamilyRepositoryMock.Create invocations
+func (mmCreate *TokenFamilyRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to TokenFamilyRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mTokenFamilyRepositoryMockCreate) Calls() []*TokenFamilyRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*TokenFamilyRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *TokenFamilyRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *TokenFamilyRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TokenFamilyRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TokenFamilyRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TokenFamilyRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to TokenFamilyRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenFamilyRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mTokenFamilyRepositoryMockGet struct {
+	optional           bool
+	mock               *TokenFamilyRepositoryMock
+	defaultExpectation *TokenFamilyRepositoryMockGetExpectation
+	expectations       []*TokenFamilyRepositoryMockGetExpectation
+
+	callArgs []*TokenFamilyRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TokenFamilyRepositoryMockGetExpectation specifies expectation struct of the TokenFamilyRepository.Get
+type TokenFamilyRepositoryMockGetExpectation struct {
+	mock               *TokenFamilyRepositoryMock
+	params             *TokenFamilyRepositoryMockGetParams
+	paramPtrs          *TokenFamilyRepositoryMockGetParamPtrs
+	expectationOrigins TokenFamilyRepositoryMockGetExpectationOrigins
+	results            *TokenFamilyRepositoryMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TokenFamilyRepositoryMockGetParams contains parameters of the TokenFamilyRepository.Get
+type TokenFamilyRepositoryMockGetParams struct {
+	ctx context.Context
+	id  string
+}
+
+// TokenFamilyRepositoryMockGetParamPtrs contains pointers to parameters of the TokenFamilyRepository.Get
+type TokenFamilyRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// TokenFamilyRepositoryMockGetResults contains results of the TokenFamilyRepository.Get
+type TokenFamilyRepositoryMockGetResults struct {
+	tp1 *model.TokenFamily
+	err error
+}
+
+// TokenFamilyRepositoryMockGetOrigins contains origins of expectations of the TokenFamilyRepository.Get
+type TokenFamilyRepositoryMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mTokenFamilyRepositoryMockGet) Optional() *mTokenFamilyRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for TokenFamilyRepository.Get
+func (mmGet *mTokenFamilyRepositoryMockGet) Expect(ctx context.Context, id string) *mTokenFamilyRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("TokenFamilyRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &TokenFamilyRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("TokenFamilyRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &TokenFamilyRepositoryMockGetParams{ctx, id}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TokenFamilyRepository.Get
+func (mmGet *mTokenFamilyRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mTokenFamilyRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("TokenFamilyRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &TokenFamilyRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("TokenFamilyRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &TokenFamilyRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for TokenFamilyRepository.Get
+func (mmGet *mTokenFamilyRepositoryMockGet) ExpectIdParam2(id string) *mTokenFamilyRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("TokenFamilyRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &TokenFamilyRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("TokenFamilyRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &TokenFamilyRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+	mmGet.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenFamilyRepository.Get
+func (mmGet *mTokenFamilyRepositoryMockGet) Inspect(f func(ctx context.Context, id string)) *mTokenFamilyRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for TokenFamilyRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by TokenFamilyRepository.Get
+func (mmGet *mTokenFamilyRepositoryMockGet) Return(tp1 *model.TokenFamily, err error) *TokenFamilyRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("TokenFamilyRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &TokenFamilyRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &TokenFamilyRepositoryMockGetResults{tp1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the TokenFamilyRepository.Get method
+func (mmGet *mTokenFamilyRepositoryMockGet) Set(f func(ctx context.Context, id string) (tp1 *model.TokenFamily, err error)) *TokenFamilyRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the TokenFamilyRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the TokenFamilyRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the TokenFamilyRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mTokenFamilyRepositoryMockGet) When(ctx context.Context, id string) *TokenFamilyRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("TokenFamilyRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &TokenFamilyRepositoryMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &TokenFamilyRepositoryMockGetParams{ctx, id},
+		expectationOrigins: TokenFamilyRepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TokenFamilyRepository.Get return parameters for the expectation previously defined by the When method
+func (e *TokenFamilyRepositoryMockGetExpectation) Then(tp1 *model.TokenFamily, err error) *TokenFamilyRepositoryMock {
+	e.results = &TokenFamilyRepositoryMockGetResults{tp1, err}
+	return e.mock
+}
+
+// Times sets number of times TokenFamilyRepository.Get should be invoked
+func (mmGet *mTokenFamilyRepositoryMockGet) Times(n uint64) *mTokenFamilyRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of TokenFamilyRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mTokenFamilyRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_repository.TokenFamilyRepository
+func (mmGet *TokenFamilyRepositoryMock) Get(ctx context.Context, id string) (tp1 *model.TokenFamily, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := TokenFamilyRepositoryMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := TokenFamilyRepositoryMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("TokenFamilyRepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("TokenFamilyRepositoryMock.Get got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("TokenFamilyRepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the TokenFamilyRepositoryMock.Get")
+		}
+		return (*mm_results).tp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to TokenFamilyRepositoryMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished TokenFamilyRepositoryMock.Get invocations
+func (mmGet *TokenFamilyRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of TokenFamilyRepositoryMock.Get invocations
+func (mmGet *TokenFamilyRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to TokenFamilyRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mTokenFamilyRepositoryMockGet) Calls() []*TokenFamilyRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*TokenFamilyRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *TokenFamilyRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *TokenFamilyRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TokenFamilyRepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TokenFamilyRepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TokenFamilyRepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to TokenFamilyRepositoryMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenFamilyRepositoryMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mTokenFamilyRepositoryMockListActive struct {
+	optional           bool
+	mock               *TokenFamilyRepositoryMock
+	defaultExpectation *TokenFamilyRepositoryMockListActiveExpectation
+	expectations       []*TokenFamilyRepositoryMockListActiveExpectation
+
+	callArgs []*TokenFamilyRepositoryMockListActiveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TokenFamilyRepositoryMockListActiveExpectation specifies expectation struct of the TokenFamilyRepository.ListActive
+type TokenFamilyRepositoryMockListActiveExpectation struct {
+	mock               *TokenFamilyRepositoryMock
+	params             *TokenFamilyRepositoryMockListActiveParams
+	paramPtrs          *TokenFamilyRepositoryMockListActiveParamPtrs
+	expectationOrigins TokenFamilyRepositoryMockListActiveExpectationOrigins
+	results            *TokenFamilyRepositoryMockListActiveResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TokenFamilyRepositoryMockListActiveParams contains parameters of the TokenFamilyRepository.ListActive
+type TokenFamilyRepositoryMockListActiveParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// TokenFamilyRepositoryMockListActiveParamPtrs contains pointers to parameters of the TokenFamilyRepository.ListActive
+type TokenFamilyRepositoryMockListActiveParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// TokenFamilyRepositoryMockListActiveResults contains results of the TokenFamilyRepository.ListActive
+type TokenFamilyRepositoryMockListActiveResults struct {
+	tpa1 []*model.TokenFamily
+	err  error
+}
+
+// TokenFamilyRepositoryMockListActiveOrigins contains origins of expectations of the TokenFamilyRepository.ListActive
+type TokenFamilyRepositoryMockListActiveExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListActive *mTokenFamilyRepositoryMockListActive) Optional() *mTokenFamilyRepositoryMockListActive {
+	mmListActive.optional = true
+	return mmListActive
+}
+
+// Expect sets up expected params for TokenFamilyRepository.ListActive
+func (mmListActive *mTokenFamilyRepositoryMockListActive) Expect(ctx context.Context, userID string) *mTokenFamilyRepositoryMockListActive {
+	if mmListActive.mock.funcListActive != nil {
+		mmListActive.mock.t.Fatalf("TokenFamilyRepositoryMock.ListActive mock is already set by Set")
+	}
+
+	if mmListActive.defaultExpectation == nil {
+		mmListActive.defaultExpectation = &TokenFamilyRepositoryMockListActiveExpectation{}
+	}
+
+	if mmListActive.defaultExpectation.paramPtrs != nil {
+		mmListActive.mock.t.Fatalf("TokenFamilyRepositoryMock.ListActive mock is already set by ExpectParams functions")
+	}
+
+	mmListActive.defaultExpectation.params = &TokenFamilyRepositoryMockListActiveParams{ctx, userID}
+	mmListActive.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListActive.expectations {
+		if minimock.Equal(e.params, mmListActive.defaultExpectation.params) {
+			mmListActive.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListActive.defaultExpectation.params)
+		}
+	}
+
+	return mmListActive
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TokenFamilyRepository.ListActive
+func (mmListActive *mTokenFamilyRepositoryMockListActive) ExpectCtxParam1(ctx context.Context) *mTokenFamilyRepositoryMockListActive {
+	if mmListActive.mock.funcListActive != nil {
+		mmListActive.mock.t.Fatalf("TokenFamilyRepositoryMock.ListActive mock is already set by Set")
+	}
+
+	if mmListActive.defaultExpectation == nil {
+		mmListActive.defaultExpectation = &TokenFamilyRepositoryMockListActiveExpectation{}
+	}
+
+	if mmListActive.defaultExpectation.params != nil {
+		mmListActive.mock.t.Fatalf("TokenFamilyRepositoryMock.ListActive mock is already set by Expect")
+	}
+
+	if mmListActive.defaultExpectation.paramPtrs == nil {
+		mmListActive.defaultExpectation.paramPtrs = &TokenFamilyRepositoryMockListActiveParamPtrs{}
+	}
+	mmListActive.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListActive.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListActive
+}
+
+// ExpectUserIDParam2 sets up expected param userID for TokenFamilyRepository.ListActive
+func (mmListActive *mTokenFamilyRepositoryMockListActive) ExpectUserIDParam2(userID string) *mTokenFamilyRepositoryMockListActive {
+	if mmListActive.mock.funcListActive != nil {
+		mmListActive.mock.t.Fatalf("TokenFamilyRepositoryMock.ListActive mock is already set by Set")
+	}
+
+	if mmListActive.defaultExpectation == nil {
+		mmListActive.defaultExpectation = &TokenFamilyRepositoryMockListActiveExpectation{}
+	}
+
+	if mmListActive.defaultExpectation.params != nil {
+		mmListActive.mock.t.Fatalf("TokenFamilyRepositoryMock.ListActive mock is already set by Expect")
+	}
+
+	if mmListActive.defaultExpectation.paramPtrs == nil {
+		mmListActive.defaultExpectation.paramPtrs = &TokenFamilyRepositoryMockListActiveParamPtrs{}
+	}
+	mmListActive.defaultExpectation.paramPtrs.userID = &userID
+	mmListActive.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListActive
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenFamilyRepository.ListActive
+func (mmListActive *mTokenFamilyRepositoryMockListActive) Inspect(f func(ctx context.Context, userID string)) *mTokenFamilyRepositoryMockListActive {
+	if mmListActive.mock.inspectFuncListActive != nil {
+		mmListActive.mock.t.Fatalf("Inspect function is already set for TokenFamilyRepositoryMock.ListActive")
+	}
+
+	mmListActive.mock.inspectFuncListActive = f
+
+	return mmListActive
+}
+
+// Return sets up results that will be returned by TokenFamilyRepository.ListActive
+func (mmListActive *mTokenFamilyRepositoryMockListActive) Return(tpa1 []*model.TokenFamily, err error) *TokenFamilyRepositoryMock {
+	if mmListActive.mock.funcListActive != nil {
+		mmListActive.mock.t.Fatalf("TokenFamilyRepositoryMock.ListActive mock is already set by Set")
+	}
+
+	if mmListActive.defaultExpectation == nil {
+		mmListActive.defaultExpectation = &TokenFamilyRepositoryMockListActiveExpectation{mock: mmListActive.mock}
+	}
+	mmListActive.defaultExpectation.results = &TokenFamilyRepositoryMockListActiveResults{tpa1, err}
+	mmListActive.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListActive.mock
+}
+
+// Set uses given function f to mock the TokenFamilyRepository.ListActive method
+func (mmListActive *mTokenFamilyRepositoryMockListActive) Set(f func(ctx context.Context, userID string) (tpa1 []*model.TokenFamily, err error)) *TokenFamilyRepositoryMock {
+	if mmListActive.defaultExpectation != nil {
+		mmListActive.mock.t.Fatalf("Default expectation is already set for the TokenFamilyRepository.ListActive method")
+	}
+
+	if len(mmListActive.expectations) > 0 {
+		mmListActive.mock.t.Fatalf("Some expectations are already set for the TokenFamilyRepository.ListActive method")
+	}
+
+	mmListActive.mock.funcListActive = f
+	mmListActive.mock.funcListActiveOrigin = minimock.CallerInfo(1)
+	return mmListActive.mock
+}
+
+// When sets expectation for the TokenFamilyRepository.ListActive which will trigger the result defined by the following
+// Then helper
+func (mmListActive *mTokenFamilyRepositoryMockListActive) When(ctx context.Context, userID string) *TokenFamilyRepositoryMockListActiveExpectation {
+	if mmListActive.mock.funcListActive != nil {
+		mmListActive.mock.t.Fatalf("TokenFamilyRepositoryMock.ListActive mock is already set by Set")
+	}
+
+	expectation := &TokenFamilyRepositoryMockListActiveExpectation{
+		mock:               mmListActive.mock,
+		params:             &TokenFamilyRepositoryMockListActiveParams{ctx, userID},
+		expectationOrigins: TokenFamilyRepositoryMockListActiveExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListActive.expectations = append(mmListActive.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TokenFamilyRepository.ListActive return parameters for the expectation previously defined by the When method
+func (e *TokenFamilyRepositoryMockListActiveExpectation) Then(tpa1 []*model.TokenFamily, err error) *TokenFamilyRepositoryMock {
+	e.results = &TokenFamilyRepositoryMockListActiveResults{tpa1, err}
+	return e.mock
+}
+
+// Times sets number of times TokenFamilyRepository.ListActive should be invoked
+func (mmListActive *mTokenFamilyRepositoryMockListActive) Times(n uint64) *mTokenFamilyRepositoryMockListActive {
+	if n == 0 {
+		mmListActive.mock.t.Fatalf("Times of TokenFamilyRepositoryMock.ListActive mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListActive.expectedInvocations, n)
+	mmListActive.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListActive
+}
+
+func (mmListActive *mTokenFamilyRepositoryMockListActive) invocationsDone() bool {
+	if len(mmListActive.expectations) == 0 && mmListActive.defaultExpectation == nil && mmListActive.mock.funcListActive == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListActive.mock.afterListActiveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListActive.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListActive implements mm_repository.TokenFamilyRepository
+func (mmListActive *TokenFamilyRepositoryMock) ListActive(ctx context.Context, userID string) (tpa1 []*model.TokenFamily, err error) {
+	mm_atomic.AddUint64(&mmListActive.beforeListActiveCounter, 1)
+	defer mm_atomic.AddUint64(&mmListActive.afterListActiveCounter, 1)
+
+	mmListActive.t.Helper()
+
+	if mmListActive.inspectFuncListActive != nil {
+		mmListActive.inspectFuncListActive(ctx, userID)
+	}
+
+	mm_params := TokenFamilyRepositoryMockListActiveParams{ctx, userID}
+
+	// Record call args
+	mmListActive.ListActiveMock.mutex.Lock()
+	mmListActive.ListActiveMock.callArgs = append(mmListActive.ListActiveMock.callArgs, &mm_params)
+	mmListActive.ListActiveMock.mutex.Unlock()
+
+	for _, e := range mmListActive.ListActiveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tpa1, e.results.err
+		}
+	}
+
+	if mmListActive.ListActiveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListActive.ListActiveMock.defaultExpectation.Counter, 1)
+		mm_want := mmListActive.ListActiveMock.defaultExpectation.params
+		mm_want_ptrs := mmListActive.ListActiveMock.defaultExpectation.paramPtrs
+
+		mm_got := TokenFamilyRepositoryMockListActiveParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListActive.t.Errorf("TokenFamilyRepositoryMock.ListActive got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListActive.ListActiveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListActive.t.Errorf("TokenFamilyRepositoryMock.ListActive got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListActive.ListActiveMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListActive.t.Errorf("TokenFamilyRepositoryMock.ListActive got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListActive.ListActiveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListActive.ListActiveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListActive.t.Fatal("No results are set for the TokenFamilyRepositoryMock.ListActive")
+		}
+		return (*mm_results).tpa1, (*mm_results).err
+	}
+	if mmListActive.funcListActive != nil {
+		return mmListActive.funcListActive(ctx, userID)
+	}
+	mmListActive.t.Fatalf("Unexpected call to TokenFamilyRepositoryMock.ListActive. %v %v", ctx, userID)
+	return
+}
+
+// ListActiveAfterCounter returns a count of finished TokenFamilyRepositoryMock.ListActive invocations
+func (mmListActive *TokenFamilyRepositoryMock) ListActiveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListActive.afterListActiveCounter)
+}
+
+// ListActiveBeforeCounter returns a count of TokenFamilyRepositoryMock.ListActive invocations
+func (mmListActive *TokenFamilyRepositoryMock) ListActiveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListActive.beforeListActiveCounter)
+}
+
+// Calls returns a list of arguments used in each call to TokenFamilyRepositoryMock.ListActive.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListActive *mTokenFamilyRepositoryMockListActive) Calls() []*TokenFamilyRepositoryMockListActiveParams {
+	mmListActive.mutex.RLock()
+
+	argCopy := make([]*TokenFamilyRepositoryMockListActiveParams, len(mmListActive.callArgs))
+	copy(argCopy, mmListActive.callArgs)
+
+	mmListActive.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListActiveDone returns true if the count of the ListActive invocations corresponds
+// the number of defined expectations
+func (m *TokenFamilyRepositoryMock) MinimockListActiveDone() bool {
+	if m.ListActiveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListActiveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListActiveMock.invocationsDone()
+}
+
+// MinimockListActiveInspect logs each unmet expectation
+func (m *TokenFamilyRepositoryMock) MinimockListActiveInspect() {
+	for _, e := range m.ListActiveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TokenFamilyRepositoryMock.ListActive at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListActiveCounter := mm_atomic.LoadUint64(&m.afterListActiveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListActiveMock.defaultExpectation != nil && afterListActiveCounter < 1 {
+		if m.ListActiveMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TokenFamilyRepositoryMock.ListActive at\n%s", m.ListActiveMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TokenFamilyRepositoryMock.ListActive at\n%s with params: %#v", m.ListActiveMock.defaultExpectation.expectationOrigins.origin, *m.ListActiveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListActive != nil && afterListActiveCounter < 1 {
+		m.t.Errorf("Expected call to TokenFamilyRepositoryMock.ListActive at\n%s", m.funcListActiveOrigin)
+	}
+
+	if !m.ListActiveMock.invocationsDone() && afterListActiveCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenFamilyRepositoryMock.ListActive at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListActiveMock.expectedInvocations), m.ListActiveMock.expectedInvocationsOrigin, afterListActiveCounter)
+	}
+}
+
+type mTokenFamilyRepositoryMockRevoke struct {
+	optional           bool
+	mock               *TokenFamilyRepositoryMock
+	defaultExpectation *TokenFamilyRepositoryMockRevokeExpectation
+	expectations       []*TokenFamilyRepositoryMockRevokeExpectation
+
+	callArgs []*TokenFamilyRepositoryMockRevokeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TokenFamilyRepositoryMockRevokeExpectation specifies expectation struct of the TokenFamilyRepository.Revoke
+type TokenFamilyRepositoryMockRevokeExpectation struct {
+	mock               *TokenFamilyRepositoryMock
+	params             *TokenFamilyRepositoryMockRevokeParams
+	paramPtrs          *TokenFamilyRepositoryMockRevokeParamPtrs
+	expectationOrigins TokenFamilyRepositoryMockRevokeExpectationOrigins
+	results            *TokenFamilyRepositoryMockRevokeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TokenFamilyRepositoryMockRevokeParams contains parameters of the TokenFamilyRepository.Revoke
+type TokenFamilyRepositoryMockRevokeParams struct {
+	ctx context.Context
+	id  string
+}
+
+// TokenFamilyRepositoryMockRevokeParamPtrs contains pointers to parameters of the TokenFamilyRepository.Revoke
+type TokenFamilyRepositoryMockRevokeParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// TokenFamilyRepositoryMockRevokeResults contains results of the TokenFamilyRepository.Revoke
+type TokenFamilyRepositoryMockRevokeResults struct {
+	err error
+}
+
+// TokenFamilyRepositoryMockRevokeOrigins contains origins of expectations of the TokenFamilyRepository.Revoke
+type TokenFamilyRepositoryMockRevokeExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevoke *mTokenFamilyRepositoryMockRevoke) Optional() *mTokenFamilyRepositoryMockRevoke {
+	mmRevoke.optional = true
+	return mmRevoke
+}
+
+// Expect sets up expected params for TokenFamilyRepository.Revoke
+func (mmRevoke *mTokenFamilyRepositoryMockRevoke) Expect(ctx context.Context, id string) *mTokenFamilyRepositoryMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("TokenFamilyRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &TokenFamilyRepositoryMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs != nil {
+		mmRevoke.mock.t.Fatalf("TokenFamilyRepositoryMock.Revoke mock is already set by ExpectParams functions")
+	}
+
+	mmRevoke.defaultExpectation.params = &TokenFamilyRepositoryMockRevokeParams{ctx, id}
+	mmRevoke.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevoke.expectations {
+		if minimock.Equal(e.params, mmRevoke.defaultExpectation.params) {
+			mmRevoke.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevoke.defaultExpectation.params)
+		}
+	}
+
+	return mmRevoke
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TokenFamilyRepository.Revoke
+func (mmRevoke *mTokenFamilyRepositoryMockRevoke) ExpectCtxParam1(ctx context.Context) *mTokenFamilyRepositoryMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("TokenFamilyRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &TokenFamilyRepositoryMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.params != nil {
+		mmRevoke.mock.t.Fatalf("TokenFamilyRepositoryMock.Revoke mock is already set by Expect")
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs == nil {
+		mmRevoke.defaultExpectation.paramPtrs = &TokenFamilyRepositoryMockRevokeParamPtrs{}
+	}
+	mmRevoke.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevoke.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevoke
+}
+
+// ExpectIdParam2 sets up expected param id for TokenFamilyRepository.Revoke
+func (mmRevoke *mTokenFamilyRepositoryMockRevoke) ExpectIdParam2(id string) *mTokenFamilyRepositoryMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("TokenFamilyRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &TokenFamilyRepositoryMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.params != nil {
+		mmRevoke.mock.t.Fatalf("TokenFamilyRepositoryMock.Revoke mock is already set by Expect")
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs == nil {
+		mmRevoke.defaultExpectation.paramPtrs = &TokenFamilyRepositoryMockRevokeParamPtrs{}
+	}
+	mmRevoke.defaultExpectation.paramPtrs.id = &id
+	mmRevoke.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmRevoke
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenFamilyRepository.Revoke
+func (mmRevoke *mTokenFamilyRepositoryMockRevoke) Inspect(f func(ctx context.Context, id string)) *mTokenFamilyRepositoryMockRevoke {
+	if mmRevoke.mock.inspectFuncRevoke != nil {
+		mmRevoke.mock.t.Fatalf("Inspect function is already set for TokenFamilyRepositoryMock.Revoke")
+	}
+
+	mmRevoke.mock.inspectFuncRevoke = f
+
+	return mmRevoke
+}
+
+// Return sets up results that will be returned by TokenFamilyRepository.Revoke
+func (mmRevoke *mTokenFamilyRepositoryMockRevoke) Return(err error) *TokenFamilyRepositoryMock {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("TokenFamilyRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &TokenFamilyRepositoryMockRevokeExpectation{mock: mmRevoke.mock}
+	}
+	mmRevoke.defaultExpectation.results = &TokenFamilyRepositoryMockRevokeResults{err}
+	mmRevoke.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevoke.mock
+}
+
+// Set uses given function f to mock the TokenFamilyRepository.Revoke method
+func (mmRevoke *mTokenFamilyRepositoryMockRevoke) Set(f func(ctx context.Context, id string) (err error)) *TokenFamilyRepositoryMock {
+	if mmRevoke.defaultExpectation != nil {
+		mmRevoke.mock.t.Fatalf("Default expectation is already set for the TokenFamilyRepository.Revoke method")
+	}
+
+	if len(mmRevoke.expectations) > 0 {
+		mmRevoke.mock.t.Fatalf("Some expectations are already set for the TokenFamilyRepository.Revoke method")
+	}
+
+	mmRevoke.mock.funcRevoke = f
+	mmRevoke.mock.funcRevokeOrigin = minimock.CallerInfo(1)
+	return mmRevoke.mock
+}
+
+// When sets expectation for the TokenFamilyRepository.Revoke which will trigger the result defined by the following
+// Then helper
+func (mmRevoke *mTokenFamilyRepositoryMockRevoke) When(ctx context.Context, id string) *TokenFamilyRepositoryMockRevokeExpectation {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("TokenFamilyRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	expectation := &TokenFamilyRepositoryMockRevokeExpectation{
+		mock:               mmRevoke.mock,
+		params:             &TokenFamilyRepositoryMockRevokeParams{ctx, id},
+		expectationOrigins: TokenFamilyRepositoryMockRevokeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevoke.expectations = append(mmRevoke.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TokenFamilyRepository.Revoke return parameters for the expectation previously defined by the When method
+func (e *TokenFamilyRepositoryMockRevokeExpectation) Then(err error) *TokenFamilyRepositoryMock {
+	e.results = &TokenFamilyRepositoryMockRevokeResults{err}
+	return e.mock
+}
+
+// Times sets number of times TokenFamilyRepository.Revoke should be invoked
+func (mmRevoke *mTokenFamilyRepositoryMockRevoke) Times(n uint64) *mTokenFamilyRepositoryMockRevoke {
+	if n == 0 {
+		mmRevoke.mock.t.Fatalf("Times of TokenFamilyRepositoryMock.Revoke mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevoke.expectedInvocations, n)
+	mmRevoke.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevoke
+}
+
+func (mmRevoke *mTokenFamilyRepositoryMockRevoke) invocationsDone() bool {
+	if len(mmRevoke.expectations) == 0 && mmRevoke.defaultExpectation == nil && mmRevoke.mock.funcRevoke == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevoke.mock.afterRevokeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevoke.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Revoke implements mm_repository.TokenFamilyRepository
+func (mmRevoke *TokenFamilyRepositoryMock) Revoke(ctx context.Context, id string) (err error) {
+	mm_atomic.AddUint64(&mmRevoke.beforeRevokeCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevoke.afterRevokeCounter, 1)
+
+	mmRevoke.t.Helper()
+
+	if mmRevoke.inspectFuncRevoke != nil {
+		mmRevoke.inspectFuncRevoke(ctx, id)
+	}
+
+	mm_params := TokenFamilyRepositoryMockRevokeParams{ctx, id}
+
+	// Record call args
+	mmRevoke.RevokeMock.mutex.Lock()
+	mmRevoke.RevokeMock.callArgs = append(mmRevoke.RevokeMock.callArgs, &mm_params)
+	mmRevoke.RevokeMock.mutex.Unlock()
+
+	for _, e := range mmRevoke.RevokeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevoke.RevokeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevoke.RevokeMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevoke.RevokeMock.defaultExpectation.params
+		mm_want_ptrs := mmRevoke.RevokeMock.defaultExpectation.paramPtrs
+
+		mm_got := TokenFamilyRepositoryMockRevokeParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevoke.t.Errorf("TokenFamilyRepositoryMock.Revoke got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevoke.RevokeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRevoke.t.Errorf("TokenFamilyRepositoryMock.Revoke got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevoke.RevokeMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevoke.t.Errorf("TokenFamilyRepositoryMock.Revoke got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevoke.RevokeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevoke.RevokeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevoke.t.Fatal("No results are set for the TokenFamilyRepositoryMock.Revoke")
+		}
+		return (*mm_results).err
+	}
+	if mmRevoke.funcRevoke != nil {
+		return mmRevoke.funcRevoke(ctx, id)
+	}
+	mmRevoke.t.Fatalf("Unexpected call to TokenFamilyRepositoryMock.Revoke. %v %v", ctx, id)
+	return
+}
+
+// RevokeAfterCounter returns a count of finished TokenFamilyRepositoryMock.Revoke invocations
+func (mmRevoke *TokenFamilyRepositoryMock) RevokeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevoke.afterRevokeCounter)
+}
+
+// RevokeBeforeCounter returns a count of TokenFamilyRepositoryMock.Revoke invocations
+func (mmRevoke *TokenFamilyRepositoryMock) RevokeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevoke.beforeRevokeCounter)
+}
+
+// Calls returns a list of arguments used in each call to TokenFamilyRepositoryMock.Revoke.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevoke *mTokenFamilyRepositoryMockRevoke) Calls() []*TokenFamilyRepositoryMockRevokeParams {
+	mmRevoke.mutex.RLock()
+
+	argCopy := make([]*TokenFamilyRepositoryMockRevokeParams, len(mmRevoke.callArgs))
+	copy(argCopy, mmRevoke.callArgs)
+
+	mmRevoke.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeDone returns true if the count of the Revoke invocations corresponds
+// the number of defined expectations
+func (m *TokenFamilyRepositoryMock) MinimockRevokeDone() bool {
+	if m.RevokeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeMock.invocationsDone()
+}
+
+// MinimockRevokeInspect logs each unmet expectation
+func (m *TokenFamilyRepositoryMock) MinimockRevokeInspect() {
+	for _, e := range m.RevokeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TokenFamilyRepositoryMock.Revoke at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevokeCounter := mm_atomic.LoadUint64(&m.afterRevokeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeMock.defaultExpectation != nil && afterRevokeCounter < 1 {
+		if m.RevokeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TokenFamilyRepositoryMock.Revoke at\n%s", m.RevokeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TokenFamilyRepositoryMock.Revoke at\n%s with params: %#v", m.RevokeMock.defaultExpectation.expectationOrigins.origin, *m.RevokeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevoke != nil && afterRevokeCounter < 1 {
+		m.t.Errorf("Expected call to TokenFamilyRepositoryMock.Revoke at\n%s", m.funcRevokeOrigin)
+	}
+
+	if !m.RevokeMock.invocationsDone() && afterRevokeCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenFamilyRepositoryMock.Revoke at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeMock.expectedInvocations), m.RevokeMock.expectedInvocationsOrigin, afterRevokeCounter)
+	}
+}
+
+type mTokenFamilyRepositoryMockRevokeAll struct {
+	optional           bool
+	mock               *TokenFamilyRepositoryMock
+	defaultExpectation *TokenFamilyRepositoryMockRevokeAllExpectation
+	expectations       []*TokenFamilyRepositoryMockRevokeAllExpectation
+
+	callArgs []*TokenFamilyRepositoryMockRevokeAllParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TokenFamilyRepositoryMockRevokeAllExpectation specifies expectation struct of the TokenFamilyRepository.RevokeAll
+type TokenFamilyRepositoryMockRevokeAllExpectation struct {
+	mock               *TokenFamilyRepositoryMock
+	params             *TokenFamilyRepositoryMockRevokeAllParams
+	paramPtrs          *TokenFamilyRepositoryMockRevokeAllParamPtrs
+	expectationOrigins TokenFamilyRepositoryMockRevokeAllExpectationOrigins
+	results            *TokenFamilyRepositoryMockRevokeAllResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TokenFamilyRepositoryMockRevokeAllParams contains parameters of the TokenFamilyRepository.RevokeAll
+type TokenFamilyRepositoryMockRevokeAllParams struct {
+	ctx      context.Context
+	userID   string
+	exceptID string
+}
+
+// TokenFamilyRepositoryMockRevokeAllParamPtrs contains pointers to parameters of the TokenFamilyRepository.RevokeAll
+type TokenFamilyRepositoryMockRevokeAllParamPtrs struct {
+	ctx      *context.Context
+	userID   *string
+	exceptID *string
+}
+
+// TokenFamilyRepositoryMockRevokeAllResults contains results of the TokenFamilyRepository.RevokeAll
+type TokenFamilyRepositoryMockRevokeAllResults struct {
+	err error
+}
+
+// TokenFamilyRepositoryMockRevokeAllOrigins contains origins of expectations of the TokenFamilyRepository.RevokeAll
+type TokenFamilyRepositoryMockRevokeAllExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originExceptID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeAll *mTokenFamilyRepositoryMockRevokeAll) Optional() *mTokenFamilyRepositoryMockRevokeAll {
+	mmRevokeAll.optional = true
+	return mmRevokeAll
+}
+
+// Expect sets up expected params for TokenFamilyRepository.RevokeAll
+func (mmRevokeAll *mTokenFamilyRepositoryMockRevokeAll) Expect(ctx context.Context, userID string, exceptID string) *mTokenFamilyRepositoryMockRevokeAll {
+	if mmRevokeAll.mock.funcRevokeAll != nil {
+		mmRevokeAll.mock.t.Fatalf("TokenFamilyRepositoryMock.RevokeAll mock is already set by Set")
+	}
+
+	if mmRevokeAll.defaultExpectation == nil {
+		mmRevokeAll.defaultExpectation = &TokenFamilyRepositoryMockRevokeAllExpectation{}
+	}
+
+	if mmRevokeAll.defaultExpectation.paramPtrs != nil {
+		mmRevokeAll.mock.t.Fatalf("TokenFamilyRepositoryMock.RevokeAll mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeAll.defaultExpectation.params = &TokenFamilyRepositoryMockRevokeAllParams{ctx, userID, exceptID}
+	mmRevokeAll.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevokeAll.expectations {
+		if minimock.Equal(e.params, mmRevokeAll.defaultExpectation.params) {
+			mmRevokeAll.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeAll.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeAll
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TokenFamilyRepository.RevokeAll
+func (mmRevokeAll *mTokenFamilyRepositoryMockRevokeAll) ExpectCtxParam1(ctx context.Context) *mTokenFamilyRepositoryMockRevokeAll {
+	if mmRevokeAll.mock.funcRevokeAll != nil {
+		mmRevokeAll.mock.t.Fatalf("TokenFamilyRepositoryMock.RevokeAll mock is already set by Set")
+	}
+
+	if mmRevokeAll.defaultExpectation == nil {
+		mmRevokeAll.defaultExpectation = &TokenFamilyRepositoryMockRevokeAllExpectation{}
+	}
+
+	if mmRevokeAll.defaultExpectation.params != nil {
+		mmRevokeAll.mock.t.Fatalf("TokenFamilyRepositoryMock.RevokeAll mock is already set by Expect")
+	}
+
+	if mmRevokeAll.defaultExpectation.paramPtrs == nil {
+		mmRevokeAll.defaultExpectation.paramPtrs = &TokenFamilyRepositoryMockRevokeAllParamPtrs{}
+	}
+	mmRevokeAll.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevokeAll.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevokeAll
+}
+
+// ExpectUserIDParam2 sets up expected param userID for TokenFamilyRepository.RevokeAll
+func (mmRevokeAll *mTokenFamilyRepositoryMockRevokeAll) ExpectUserIDParam2(userID string) *mTokenFamilyRepositoryMockRevokeAll {
+	if mmRevokeAll.mock.funcRevokeAll != nil {
+		mmRevokeAll.mock.t.Fatalf("TokenFamilyRepositoryMock.RevokeAll mock is already set by Set")
+	}
+
+	if mmRevokeAll.defaultExpectation == nil {
+		mmRevokeAll.defaultExpectation = &TokenFamilyRepositoryMockRevokeAllExpectation{}
+	}
+
+	if mmRevokeAll.defaultExpectation.params != nil {
+		mmRevokeAll.mock.t.Fatalf("TokenFamilyRepositoryMock.RevokeAll mock is already set by Expect")
+	}
+
+	if mmRevokeAll.defaultExpectation.paramPtrs == nil {
+		mmRevokeAll.defaultExpectation.paramPtrs = &TokenFamilyRepositoryMockRevokeAllParamPtrs{}
+	}
+	mmRevokeAll.defaultExpectation.paramPtrs.userID = &userID
+	mmRevokeAll.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRevokeAll
+}
+
+// ExpectExceptIDParam3 sets up expected param exceptID for TokenFamilyRepository.RevokeAll
+func (mmRevokeAll *mTokenFamilyRepositoryMockRevokeAll) ExpectExceptIDParam3(exceptID string) *mTokenFamilyRepositoryMockRevokeAll {
+	if mmRevokeAll.mock.funcRevokeAll != nil {
+		mmRevokeAll.mock.t.Fatalf("TokenFamilyRepositoryMock.RevokeAll mock is already set by Set")
+	}
+
+	if mmRevokeAll.defaultExpectation == nil {
+		mmRevokeAll.defaultExpectation = &TokenFamilyRepositoryMockRevokeAllExpectation{}
+	}
+
+	if mmRevokeAll.defaultExpectation.params != nil {
+		mmRevokeAll.mock.t.Fatalf("TokenFamilyRepositoryMock.RevokeAll mock is already set by Expect")
+	}
+
+	if mmRevokeAll.defaultExpectation.paramPtrs == nil {
+		mmRevokeAll.defaultExpectation.paramPtrs = &TokenFamilyRepositoryMockRevokeAllParamPtrs{}
+	}
+	mmRevokeAll.defaultExpectation.paramPtrs.exceptID = &exceptID
+	mmRevokeAll.defaultExpectation.expectationOrigins.originExceptID = minimock.CallerInfo(1)
+
+	return mmRevokeAll
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenFamilyRepository.RevokeAll
+func (mmRevokeAll *mTokenFamilyRepositoryMockRevokeAll) Inspect(f func(ctx context.Context, userID string, exceptID string)) *mTokenFamilyRepositoryMockRevokeAll {
+	if mmRevokeAll.mock.inspectFuncRevokeAll != nil {
+		mmRevokeAll.mock.t.Fatalf("Inspect function is already set for TokenFamilyRepositoryMock.RevokeAll")
+	}
+
+	mmRevokeAll.mock.inspectFuncRevokeAll = f
+
+	return mmRevokeAll
+}
+
+// Return sets up results that will be returned by TokenFamilyRepository.RevokeAll
+func (mmRevokeAll *mTokenFamilyRepositoryMockRevokeAll) Return(err error) *TokenFamilyRepositoryMock {
+	if mmRevokeAll.mock.funcRevokeAll != nil {
+		mmRevokeAll.mock.t.Fatalf("TokenFamilyRepositoryMock.RevokeAll mock is already set by Set")
+	}
+
+	if mmRevokeAll.defaultExpectation == nil {
+		mmRevokeAll.defaultExpectation = &TokenFamilyRepositoryMockRevokeAllExpectation{mock: mmRevokeAll.mock}
+	}
+	mmRevokeAll.defaultExpectation.results = &TokenFamilyRepositoryMockRevokeAllResults{err}
+	mmRevokeAll.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevokeAll.mock
+}
+
+// Set uses given function f to mock the TokenFamilyRepository.RevokeAll method
+func (mmRevokeAll *mTokenFamilyRepositoryMockRevokeAll) Set(f func(ctx context.Context, userID string, exceptID string) (err error)) *TokenFamilyRepositoryMock {
+	if mmRevokeAll.defaultExpectation != nil {
+		mmRevokeAll.mock.t.Fatalf("Default expectation is already set for the TokenFamilyRepository.RevokeAll method")
+	}
+
+	if len(mmRevokeAll.expectations) > 0 {
+		mmRevokeAll.mock.t.Fatalf("Some expectations are already set for the TokenFamilyRepository.RevokeAll method")
+	}
+
+	mmRevokeAll.mock.funcRevokeAll = f
+	mmRevokeAll.mock.funcRevokeAllOrigin = minimock.CallerInfo(1)
+	return mmRevokeAll.mock
+}
+
+// When sets expectation for the TokenFamilyRepository.RevokeAll which will trigger the result defined by the following
+// Then helper
+func (mmRevokeAll *mTokenFamilyRepositoryMockRevokeAll) When(ctx context.Context, userID string, exceptID string) *TokenFamilyRepositoryMockRevokeAllExpectation {
+	if mmRevokeAll.mock.funcRevokeAll != nil {
+		mmRevokeAll.mock.t.Fatalf("TokenFamilyRepositoryMock.RevokeAll mock is already set by Set")
+	}
+
+	expectation := &TokenFamilyRepositoryMockRevokeAllExpectation{
+		mock:               mmRevokeAll.mock,
+		params:             &TokenFamilyRepositoryMockRevokeAllParams{ctx, userID, exceptID},
+		expectationOrigins: TokenFamilyRepositoryMockRevokeAllExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevokeAll.expectations = append(mmRevokeAll.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TokenFamilyRepository.RevokeAll return parameters for the expectation previously defined by the When method
+func (e *TokenFamilyRepositoryMockRevokeAllExpectation) Then(err error) *TokenFamilyRepositoryMock {
+	e.results = &TokenFamilyRepositoryMockRevokeAllResults{err}
+	return e.mock
+}
+
+// Times sets number of times TokenFamilyRepository.RevokeAll should be invoked
+func (mmRevokeAll *mTokenFamilyRepositoryMockRevokeAll) Times(n uint64) *mTokenFamilyRepositoryMockRevokeAll {
+	if n == 0 {
+		mmRevokeAll.mock.t.Fatalf("Times of TokenFamilyRepositoryMock.RevokeAll mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeAll.expectedInvocations, n)
+	mmRevokeAll.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevokeAll
+}
+
+func (mmRevokeAll *mTokenFamilyRepositoryMockRevokeAll) invocationsDone() bool {
+	if len(mmRevokeAll.expectations) == 0 && mmRevokeAll.defaultExpectation == nil && mmRevokeAll.mock.funcRevokeAll == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeAll.mock.afterRevokeAllCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeAll.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeAll implements mm_repository.TokenFamilyRepository
+func (mmRevokeAll *TokenFamilyRepositoryMock) RevokeAll(ctx context.Context, userID string, exceptID string) (err error) {
+	mm_atomic.AddUint64(&mmRevokeAll.beforeRevokeAllCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeAll.afterRevokeAllCounter, 1)
+
+	mmRevokeAll.t.Helper()
+
+	if mmRevokeAll.inspectFuncRevokeAll != nil {
+		mmRevokeAll.inspectFuncRevokeAll(ctx, userID, exceptID)
+	}
+
+	mm_params := TokenFamilyRepositoryMockRevokeAllParams{ctx, userID, exceptID}
+
+	// Record call args
+	mmRevokeAll.RevokeAllMock.mutex.Lock()
+	mmRevokeAll.RevokeAllMock.callArgs = append(mmRevokeAll.RevokeAllMock.callArgs, &mm_params)
+	mmRevokeAll.RevokeAllMock.mutex.Unlock()
+
+	for _, e := range mmRevokeAll.RevokeAllMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeAll.RevokeAllMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeAll.RevokeAllMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeAll.RevokeAllMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeAll.RevokeAllMock.defaultExpectation.paramPtrs
+
+		mm_got := TokenFamilyRepositoryMockRevokeAllParams{ctx, userID, exceptID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeAll.t.Errorf("TokenFamilyRepositoryMock.RevokeAll got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeAll.RevokeAllMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRevokeAll.t.Errorf("TokenFamilyRepositoryMock.RevokeAll got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeAll.RevokeAllMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.exceptID != nil && !minimock.Equal(*mm_want_ptrs.exceptID, mm_got.exceptID) {
+				mmRevokeAll.t.Errorf("TokenFamilyRepositoryMock.RevokeAll got unexpected parameter exceptID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeAll.RevokeAllMock.defaultExpectation.expectationOrigins.originExceptID, *mm_want_ptrs.exceptID, mm_got.exceptID, minimock.Diff(*mm_want_ptrs.exceptID, mm_got.exceptID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeAll.t.Errorf("TokenFamilyRepositoryMock.RevokeAll got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevokeAll.RevokeAllMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeAll.RevokeAllMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeAll.t.Fatal("No results are set for the TokenFamilyRepositoryMock.RevokeAll")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeAll.funcRevokeAll != nil {
+		return mmRevokeAll.funcRevokeAll(ctx, userID, exceptID)
+	}
+	mmRevokeAll.t.Fatalf("Unexpected call to TokenFamilyRepositoryMock.RevokeAll. %v %v %v", ctx, userID, exceptID)
+	return
+}
+
+// RevokeAllAfterCounter returns a count of finished TokenFamilyRepositoryMock.RevokeAll invocations
+func (mmRevokeAll *TokenFamilyRepositoryMock) RevokeAllAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeAll.afterRevokeAllCounter)
+}
+
+// RevokeAllBeforeCounter returns a count of TokenFamilyRepositoryMock.RevokeAll invocations
+func (mmRevokeAll *TokenFamilyRepositoryMock) RevokeAllBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeAll.beforeRevokeAllCounter)
+}
+
+// Calls returns a list of arguments used in each call to TokenFamilyRepositoryMock.RevokeAll.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeAll *mTokenFamilyRepositoryMockRevokeAll) Calls() []*TokenFamilyRepositoryMockRevokeAllParams {
+	mmRevokeAll.mutex.RLock()
+
+	argCopy := make([]*TokenFamilyRepositoryMockRevokeAllParams, len(mmRevokeAll.callArgs))
+	copy(argCopy, mmRevokeAll.callArgs)
+
+	mmRevokeAll.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeAllDone returns true if the count of the RevokeAll invocations corresponds
+// the number of defined expectations
+func (m *TokenFamilyRepositoryMock) MinimockRevokeAllDone() bool {
+	if m.RevokeAllMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeAllMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeAllMock.invocationsDone()
+}
+
+// MinimockRevokeAllInspect logs each unmet expectation
+func (m *TokenFamilyRepositoryMock) MinimockRevokeAllInspect() {
+	for _, e := range m.RevokeAllMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TokenFamilyRepositoryMock.RevokeAll at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevokeAllCounter := mm_atomic.LoadUint64(&m.afterRevokeAllCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeAllMock.defaultExpectation != nil && afterRevokeAllCounter < 1 {
+		if m.RevokeAllMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TokenFamilyRepositoryMock.RevokeAll at\n%s", m.RevokeAllMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TokenFamilyRepositoryMock.RevokeAll at\n%s with params: %#v", m.RevokeAllMock.defaultExpectation.expectationOrigins.origin, *m.RevokeAllMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeAll != nil && afterRevokeAllCounter < 1 {
+		m.t.Errorf("Expected call to TokenFamilyRepositoryMock.RevokeAll at\n%s", m.funcRevokeAllOrigin)
+	}
+
+	if !m.RevokeAllMock.invocationsDone() && afterRevokeAllCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenFamilyRepositoryMock.RevokeAll at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeAllMock.expectedInvocations), m.RevokeAllMock.expectedInvocationsOrigin, afterRevokeAllCounter)
+	}
+}
+
+type mTokenFamilyRepositoryMockRotate struct {
+	optional           bool
+	mock               *TokenFamilyRepositoryMock
+	defaultExpectation *TokenFamilyRepositoryMockRotateExpectation
+	expectations       []*TokenFamilyRepositoryMockRotateExpectation
+
+	callArgs []*TokenFamilyRepositoryMockRotateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TokenFamilyRepositoryMockRotateExpectation specifies expectation struct of the TokenFamilyRepository.Rotate
+type TokenFamilyRepositoryMockRotateExpectation struct {
+	mock               *TokenFamilyRepositoryMock
+	params             *TokenFamilyRepositoryMockRotateParams
+	paramPtrs          *TokenFamilyRepositoryMockRotateParamPtrs
+	expectationOrigins TokenFamilyRepositoryMockRotateExpectationOrigins
+	results            *TokenFamilyRepositoryMockRotateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TokenFamilyRepositoryMockRotateParams contains parameters of the TokenFamilyRepository.Rotate
+type TokenFamilyRepositoryMockRotateParams struct {
+	ctx            context.Context
+	id             string
+	currentTokenID string
+	nextTokenID    string
+	client         *model.ClientInfo
+}
+
+// TokenFamilyRepositoryMockRotateParamPtrs contains pointers to parameters of the TokenFamilyRepository.Rotate
+type TokenFamilyRepositoryMockRotateParamPtrs struct {
+	ctx            *context.Context
+	id             *string
+	currentTokenID *string
+	nextTokenID    *string
+	client         **model.ClientInfo
+}
+
+// TokenFamilyRepositoryMockRotateResults contains results of the TokenFamilyRepository.Rotate
+type TokenFamilyRepositoryMockRotateResults struct {
+	b1  bool
+	err error
+}
+
+// TokenFamilyRepositoryMockRotateOrigins contains origins of expectations of the TokenFamilyRepository.Rotate
+type TokenFamilyRepositoryMockRotateExpectationOrigins struct {
+	origin               string
+	originCtx            string
+	originId             string
+	originCurrentTokenID string
+	originNextTokenID    string
+	originClient         string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRotate *mTokenFamilyRepositoryMockRotate) Optional() *mTokenFamilyRepositoryMockRotate {
+	mmRotate.optional = true
+	return mmRotate
+}
+
+// Expect sets up expected params for TokenFamilyRepository.Rotate
+func (mmRotate *mTokenFamilyRepositoryMockRotate) Expect(ctx context.Context, id string, currentTokenID string, nextTokenID string, client *model.ClientInfo) *mTokenFamilyRepositoryMockRotate {
+	if mmRotate.mock.funcRotate != nil {
+		mmRotate.mock.t.Fatalf("TokenFamilyRepositoryMock.Rotate mock is already set by Set")
+	}
+
+	if mmRotate.defaultExpectation == nil {
+		mmRotate.defaultExpectation = &TokenFamilyRepositoryMockRotateExpectation{}
+	}
+
+	if mmRotate.defaultExpectation.paramPtrs != nil {
+		mmRotate.mock.t.Fatalf("TokenFamilyRepositoryMock.Rotate mock is already set by ExpectParams functions")
+	}
+
+	mmRotate.defaultExpectation.params = &TokenFamilyRepositoryMockRotateParams{ctx, id, currentTokenID, nextTokenID, client}
+	mmRotate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRotate.expectations {
+		if minimock.Equal(e.params, mmRotate.defaultExpectation.params) {
+			mmRotate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRotate.defaultExpectation.params)
+		}
+	}
+
+	return mmRotate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TokenFamilyRepository.Rotate
+func (mmRotate *mTokenFamilyRepositoryMockRotate) ExpectCtxParam1(ctx context.Context) *mTokenFamilyRepositoryMockRotate {
+	if mmRotate.mock.funcRotate != nil {
+		mmRotate.mock.t.Fatalf("TokenFamilyRepositoryMock.Rotate mock is already set by Set")
+	}
+
+	if mmRotate.defaultExpectation == nil {
+		mmRotate.defaultExpectation = &TokenFamilyRepositoryMockRotateExpectation{}
+	}
+
+	if mmRotate.defaultExpectation.params != nil {
+		mmRotate.mock.t.Fatalf("TokenFamilyRepositoryMock.Rotate mock is already set by Expect")
+	}
+
+	if mmRotate.defaultExpectation.paramPtrs == nil {
+		mmRotate.defaultExpectation.paramPtrs = &TokenFamilyRepositoryMockRotateParamPtrs{}
+	}
+	mmRotate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRotate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRotate
+}
+
+// ExpectIdParam2 sets up expected param id for TokenFamilyRepository.Rotate
+func (mmRotate *mTokenFamilyRepositoryMockRotate) ExpectIdParam2(id string) *mTokenFamilyRepositoryMockRotate {
+	if mmRotate.mock.funcRotate != nil {
+		mmRotate.mock.t.Fatalf("TokenFamilyRepositoryMock.Rotate mock is already set by Set")
+	}
+
+	if mmRotate.defaultExpectation == nil {
+		mmRotate.defaultExpectation = &TokenFamilyRepositoryMockRotateExpectation{}
+	}
+
+	if mmRotate.defaultExpectation.params != nil {
+		mmRotate.mock.t.Fatalf("TokenFamilyRepositoryMock.Rotate mock is already set by Expect")
+	}
+
+	if mmRotate.defaultExpectation.paramPtrs == nil {
+		mmRotate.defaultExpectation.paramPtrs = &TokenFamilyRepositoryMockRotateParamPtrs{}
+	}
+	mmRotate.defaultExpectation.paramPtrs.id = &id
+	mmRotate.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmRotate
+}
+
+// ExpectCurrentTokenIDParam3 sets up expected param currentTokenID for TokenFamilyRepository.Rotate
+func (mmRotate *mTokenFamilyRepositoryMockRotate) ExpectCurrentTokenIDParam3(currentTokenID string) *mTokenFamilyRepositoryMockRotate {
+	if mmRotate.mock.funcRotate != nil {
+		mmRotate.mock.t.Fatalf("TokenFamilyRepositoryMock.Rotate mock is already set by Set")
+	}
+
+	if mmRotate.defaultExpectation == nil {
+		mmRotate.defaultExpectation = &TokenFamilyRepositoryMockRotateExpectation{}
+	}
+
+	if mmRotate.defaultExpectation.params != nil {
+		mmRotate.mock.t.Fatalf("TokenFamilyRepositoryMock.Rotate mock is already set by Expect")
+	}
+
+	if mmRotate.defaultExpectation.paramPtrs == nil {
+		mmRotate.defaultExpectation.paramPtrs = &TokenFamilyRepositoryMockRotateParamPtrs{}
+	}
+	mmRotate.defaultExpectation.paramPtrs.currentTokenID = &currentTokenID
+	mmRotate.defaultExpectation.expectationOrigins.originCurrentTokenID = minimock.CallerInfo(1)
+
+	return mmRotate
+}
+
+// ExpectNextTokenIDParam4 sets up expected param nextTokenID for TokenFamilyRepository.Rotate
+func (mmRotate *mTokenFamilyRepositoryMockRotate) ExpectNextTokenIDParam4(nextTokenID string) *mTokenFamilyRepositoryMockRotate {
+	if mmRotate.mock.funcRotate != nil {
+		mmRotate.mock.t.Fatalf("TokenFamilyRepositoryMock.Rotate mock is already set by Set")
+	}
+
+	if mmRotate.defaultExpectation == nil {
+		mmRotate.defaultExpectation = &TokenFamilyRepositoryMockRotateExpectation{}
+	}
+
+	if mmRotate.defaultExpectation.params != nil {
+		mmRotate.mock.t.Fatalf("TokenFamilyRepositoryMock.Rotate mock is already set by Expect")
+	}
+
+	if mmRotate.defaultExpectation.paramPtrs == nil {
+		mmRotate.defaultExpectation.paramPtrs = &TokenFamilyRepositoryMockRotateParamPtrs{}
+	}
+	mmRotate.defaultExpectation.paramPtrs.nextTokenID = &nextTokenID
+	mmRotate.defaultExpectation.expectationOrigins.originNextTokenID = minimock.CallerInfo(1)
+
+	return mmRotate
+}
+
+// ExpectClientParam5 sets up expected param client for TokenFamilyRepository.Rotate
+func (mmRotate *mTokenFamilyRepositoryMockRotate) ExpectClientParam5(client *model.ClientInfo) *mTokenFamilyRepositoryMockRotate {
+	if mmRotate.mock.funcRotate != nil {
+		mmRotate.mock.t.Fatalf("TokenFamilyRepositoryMock.Rotate mock is already set by Set")
+	}
+
+	if mmRotate.defaultExpectation == nil {
+		mmRotate.defaultExpectation = &TokenFamilyRepositoryMockRotateExpectation{}
+	}
+
+	if mmRotate.defaultExpectation.params != nil {
+		mmRotate.mock.t.Fatalf("TokenFamilyRepositoryMock.Rotate mock is already set by Expect")
+	}
+
+	if mmRotate.defaultExpectation.paramPtrs == nil {
+		mmRotate.defaultExpectation.paramPtrs = &TokenFamilyRepositoryMockRotateParamPtrs{}
+	}
+	mmRotate.defaultExpectation.paramPtrs.client = &client
+	mmRotate.defaultExpectation.expectationOrigins.originClient = minimock.CallerInfo(1)
+
+	return mmRotate
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenFamilyRepository.Rotate
+func (mmRotate *mTokenFamilyRepositoryMockRotate) Inspect(f func(ctx context.Context, id string, currentTokenID string, nextTokenID string, client *model.ClientInfo)) *mTokenFamilyRepositoryMockRotate {
+	if mmRotate.mock.inspectFuncRotate != nil {
+		mmRotate.mock.t.Fatalf("Inspect function is already set for TokenFamilyRepositoryMock.Rotate")
+	}
+
+	mmRotate.mock.inspectFuncRotate = f
+
+	return mmRotate
+}
+
+// Return sets up results that will be returned by TokenFamilyRepository.Rotate
+func (mmRotate *mTokenFamilyRepositoryMockRotate) Return(b1 bool, err error) *TokenFamilyRepositoryMock {
+	if mmRotate.mock.funcRotate != nil {
+		mmRotate.mock.t.Fatalf("TokenFamilyRepositoryMock.Rotate mock is already set by Set")
+	}
+
+	if mmRotate.defaultExpectation == nil {
+		mmRotate.defaultExpectation = &TokenFamilyRepositoryMockRotateExpectation{mock: mmRotate.mock}
+	}
+	mmRotate.defaultExpectation.results = &TokenFamilyRepositoryMockRotateResults{b1, err}
+	mmRotate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRotate.mock
+}
+
+// Set uses given function f to mock the TokenFamilyRepository.Rotate method
+func (mmRotate *mTokenFamilyRepositoryMockRotate) Set(f func(ctx context.Context, id string, currentTokenID string, nextTokenID string, client *model.ClientInfo) (b1 bool, err error)) *TokenFamilyRepositoryMock {
+	if mmRotate.defaultExpectation != nil {
+		mmRotate.mock.t.Fatalf("Default expectation is already set for the TokenFamilyRepository.Rotate method")
+	}
+
+	if len(mmRotate.expectations) > 0 {
+		mmRotate.mock.t.Fatalf("Some expectations are already set for the TokenFamilyRepository.Rotate method")
+	}
+
+	mmRotate.mock.funcRotate = f
+	mmRotate.mock.funcRotateOrigin = minimock.CallerInfo(1)
+	return mmRotate.mock
+}
+
+// When sets expectation for the TokenFamilyRepository.Rotate which will trigger the result defined by the following
+// Then helper
+func (mmRotate *mTokenFamilyRepositoryMockRotate) When(ctx context.Context, id string, currentTokenID string, nextTokenID string, client *model.ClientInfo) *TokenFamilyRepositoryMockRotateExpectation {
+	if mmRotate.mock.funcRotate != nil {
+		mmRotate.mock.t.Fatalf("TokenFamilyRepositoryMock.Rotate mock is already set by Set")
+	}
+
+	expectation := &TokenFamilyRepositoryMockRotateExpectation{
+		mock:               mmRotate.mock,
+		params:             &TokenFamilyRepositoryMockRotateParams{ctx, id, currentTokenID, nextTokenID, client},
+		expectationOrigins: TokenFamilyRepositoryMockRotateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRotate.expectations = append(mmRotate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TokenFamilyRepository.Rotate return parameters for the expectation previously defined by the When method
+func (e *TokenFamilyRepositoryMockRotateExpectation) Then(b1 bool, err error) *TokenFamilyRepositoryMock {
+	e.results = &TokenFamilyRepositoryMockRotateResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times TokenFamilyRepository.Rotate should be invoked
+func (mmRotate *mTokenFamilyRepositoryMockRotate) Times(n uint64) *mTokenFamilyRepositoryMockRotate {
+	if n == 0 {
+		mmRotate.mock.t.Fatalf("Times of TokenFamilyRepositoryMock.Rotate mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRotate.expectedInvocations, n)
+	mmRotate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRotate
+}
+
+func (mmRotate *mTokenFamilyRepositoryMockRotate) invocationsDone() bool {
+	if len(mmRotate.expectations) == 0 && mmRotate.defaultExpectation == nil && mmRotate.mock.funcRotate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRotate.mock.afterRotateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRotate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Rotate implements mm_repository.TokenFamilyRepository
+func (mmRotate *TokenFamilyRepositoryMock) Rotate(ctx context.Context, id string, currentTokenID string, nextTokenID string, client *model.ClientInfo) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmRotate.beforeRotateCounter, 1)
+	defer mm_atomic.AddUint64(&mmRotate.afterRotateCounter, 1)
+
+	mmRotate.t.Helper()
+
+	if mmRotate.inspectFuncRotate != nil {
+		mmRotate.inspectFuncRotate(ctx, id, currentTokenID, nextTokenID, client)
+	}
+
+	mm_params := TokenFamilyRepositoryMockRotateParams{ctx, id, currentTokenID, nextTokenID, client}
+
+	// Record call args
+	mmRotate.RotateMock.mutex.Lock()
+	mmRotate.RotateMock.callArgs = append(mmRotate.RotateMock.callArgs, &mm_params)
+	mmRotate.RotateMock.mutex.Unlock()
+
+	for _, e := range mmRotate.RotateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmRotate.RotateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRotate.RotateMock.defaultExpectation.Counter, 1)
+		mm_want := mmRotate.RotateMock.defaultExpectation.params
+		mm_want_ptrs := mmRotate.RotateMock.defaultExpectation.paramPtrs
+
+		mm_got := TokenFamilyRepositoryMockRotateParams{ctx, id, currentTokenID, nextTokenID, client}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRotate.t.Errorf("TokenFamilyRepositoryMock.Rotate got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRotate.RotateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRotate.t.Errorf("TokenFamilyRepositoryMock.Rotate got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRotate.RotateMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.currentTokenID != nil && !minimock.Equal(*mm_want_ptrs.currentTokenID, mm_got.currentTokenID) {
+				mmRotate.t.Errorf("TokenFamilyRepositoryMock.Rotate got unexpected parameter currentTokenID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRotate.RotateMock.defaultExpectation.expectationOrigins.originCurrentTokenID, *mm_want_ptrs.currentTokenID, mm_got.currentTokenID, minimock.Diff(*mm_want_ptrs.currentTokenID, mm_got.currentTokenID))
+			}
+
+			if mm_want_ptrs.nextTokenID != nil && !minimock.Equal(*mm_want_ptrs.nextTokenID, mm_got.nextTokenID) {
+				mmRotate.t.Errorf("TokenFamilyRepositoryMock.Rotate got unexpected parameter nextTokenID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRotate.RotateMock.defaultExpectation.expectationOrigins.originNextTokenID, *mm_want_ptrs.nextTokenID, mm_got.nextTokenID, minimock.Diff(*mm_want_ptrs.nextTokenID, mm_got.nextTokenID))
+			}
+
+			if mm_want_ptrs.client != nil && !minimock.Equal(*mm_want_ptrs.client, mm_got.client) {
+				mmRotate.t.Errorf("TokenFamilyRepositoryMock.Rotate got unexpected parameter client, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRotate.RotateMock.defaultExpectation.expectationOrigins.originClient, *mm_want_ptrs.client, mm_got.client, minimock.Diff(*mm_want_ptrs.client, mm_got.client))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRotate.t.Errorf("TokenFamilyRepositoryMock.Rotate got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRotate.RotateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRotate.RotateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRotate.t.Fatal("No results are set for the TokenFamilyRepositoryMock.Rotate")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmRotate.funcRotate != nil {
+		return mmRotate.funcRotate(ctx, id, currentTokenID, nextTokenID, client)
+	}
+	mmRotate.t.Fatalf("Unexpected call to TokenFamilyRepositoryMock.Rotate. %v %v %v %v %v", ctx, id, currentTokenID, nextTokenID, client)
+	return
+}
+
+// RotateAfterCounter returns a count of finished TokenFamilyRepositoryMock.Rotate invocations
+func (mmRotate *TokenFamilyRepositoryMock) RotateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRotate.afterRotateCounter)
+}
+
+// RotateBeforeCounter returns a count of TokenFamilyRepositoryMock.Rotate invocations
+func (mmRotate *TokenFamilyRepositoryMock) RotateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRotate.beforeRotateCounter)
+}
+
+// Calls returns a list of arguments used in each call to TokenFamilyRepositoryMock.Rotate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRotate *mTokenFamilyRepositoryMockRotate) Calls() []*TokenFamilyRepositoryMockRotateParams {
+	mmRotate.mutex.RLock()
+
+	argCopy := make([]*TokenFamilyRepositoryMockRotateParams, len(mmRotate.callArgs))
+	copy(argCopy, mmRotate.callArgs)
+
+	mmRotate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRotateDone returns true if the count of the Rotate invocations corresponds
+// the number of defined expectations
+func (m *TokenFamilyRepositoryMock) MinimockRotateDone() bool {
+	if m.RotateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RotateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RotateMock.invocationsDone()
+}
+
+// MinimockRotateInspect logs each unmet expectation
+func (m *TokenFamilyRepositoryMock) MinimockRotateInspect() {
+	for _, e := range m.RotateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TokenFamilyRepositoryMock.Rotate at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRotateCounter := mm_atomic.LoadUint64(&m.afterRotateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RotateMock.defaultExpectation != nil && afterRotateCounter < 1 {
+		if m.RotateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TokenFamilyRepositoryMock.Rotate at\n%s", m.RotateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TokenFamilyRepositoryMock.Rotate at\n%s with params: %#v", m.RotateMock.defaultExpectation.expectationOrigins.origin, *m.RotateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRotate != nil && afterRotateCounter < 1 {
+		m.t.Errorf("Expected call to TokenFamilyRepositoryMock.Rotate at\n%s", m.funcRotateOrigin)
+	}
+
+	if !m.RotateMock.invocationsDone() && afterRotateCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenFamilyRepositoryMock.Rotate at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RotateMock.expectedInvocations), m.RotateMock.expectedInvocationsOrigin, afterRotateCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *TokenFamilyRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockListActiveInspect()
+
+			m.MinimockRevokeInspect()
+
+			m.MinimockRevokeAllInspect()
+
+			m.MinimockRotateInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *TokenFamilyRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *TokenFamilyRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockListActiveDone() &&
+		m.MinimockRevokeDone() &&
+		m.MinimockRevokeAllDone() &&
+		m.MinimockRotateDone()
+}
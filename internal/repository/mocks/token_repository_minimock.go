@@ -23,6 +23,13 @@ type TokenRepositoryMock struct {
 	beforeAddRevokedTokenCounter uint64
 	AddRevokedTokenMock          mTokenRepositoryMockAddRevokedToken
 
+	funcDeleteTokenVersion          func(ctx context.Context, userID string) (err error)
+	funcDeleteTokenVersionOrigin    string
+	inspectFuncDeleteTokenVersion   func(ctx context.Context, userID string)
+	afterDeleteTokenVersionCounter  uint64
+	beforeDeleteTokenVersionCounter uint64
+	DeleteTokenVersionMock          mTokenRepositoryMockDeleteTokenVersion
+
 	funcGetTokenVersion          func(ctx context.Context, userID string) (i1 int, err error)
 	funcGetTokenVersionOrigin    string
 	inspectFuncGetTokenVersion   func(ctx context.Context, userID string)
@@ -37,6 +44,13 @@ type TokenRepositoryMock struct {
 	beforeIsTokenRevokedCounter uint64
 	IsTokenRevokedMock          mTokenRepositoryMockIsTokenRevoked
 
+	funcRevokeToken          func(ctx context.Context, token string) (b1 bool, err error)
+	funcRevokeTokenOrigin    string
+	inspectFuncRevokeToken   func(ctx context.Context, token string)
+	afterRevokeTokenCounter  uint64
+	beforeRevokeTokenCounter uint64
+	RevokeTokenMock          mTokenRepositoryMockRevokeToken
+
 	funcSetTokenVersion          func(ctx context.Context, userID string, version int) (err error)
 	funcSetTokenVersionOrigin    string
 	inspectFuncSetTokenVersion   func(ctx context.Context, userID string, version int)
@@ -56,12 +70,18 @@ func NewTokenRepositoryMock(t minimock.Tester) *TokenRepositoryMock {
 	m.AddRevokedTokenMock = mTokenRepositoryMockAddRevokedToken{mock: m}
 	m.AddRevokedTokenMock.callArgs = []*TokenRepositoryMockAddRevokedTokenParams{}
 
+	m.DeleteTokenVersionMock = mTokenRepositoryMockDeleteTokenVersion{mock: m}
+	m.DeleteTokenVersionMock.callArgs = []*TokenRepositoryMockDeleteTokenVersionParams{}
+
 	m.GetTokenVersionMock = mTokenRepositoryMockGetTokenVersion{mock: m}
 	m.GetTokenVersionMock.callArgs = []*TokenRepositoryMockGetTokenVersionParams{}
 
 	m.IsTokenRevokedMock = mTokenRepositoryMockIsTokenRevoked{mock: m}
 	m.IsTokenRevokedMock.callArgs = []*TokenRepositoryMockIsTokenRevokedParams{}
 
+	m.RevokeTokenMock = mTokenRepositoryMockRevokeToken{mock: m}
+	m.RevokeTokenMock.callArgs = []*TokenRepositoryMockRevokeTokenParams{}
+
 	m.SetTokenVersionMock = mTokenRepositoryMockSetTokenVersion{mock: m}
 	m.SetTokenVersionMock.callArgs = []*TokenRepositoryMockSetTokenVersionParams{}
 
@@ -412,6 +432,348 @@ func (m *TokenRepositoryMock) MinimockAddRevokedTokenInspect() {
 	}
 }
 
+type mTokenRepositoryMockDeleteTokenVersion struct {
+	optional           bool
+	mock               *TokenRepositoryMock
+	defaultExpectation *TokenRepositoryMockDeleteTokenVersionExpectation
+	expectations       []*TokenRepositoryMockDeleteTokenVersionExpectation
+
+	callArgs []*TokenRepositoryMockDeleteTokenVersionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TokenRepositoryMockDeleteTokenVersionExpectation specifies expectation struct of the TokenRepository.DeleteTokenVersion
+type TokenRepositoryMockDeleteTokenVersionExpectation struct {
+	mock               *TokenRepositoryMock
+	params             *TokenRepositoryMockDeleteTokenVersionParams
+	paramPtrs          *TokenRepositoryMockDeleteTokenVersionParamPtrs
+	expectationOrigins TokenRepositoryMockDeleteTokenVersionExpectationOrigins
+	results            *TokenRepositoryMockDeleteTokenVersionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TokenRepositoryMockDeleteTokenVersionParams contains parameters of the TokenRepository.DeleteTokenVersion
+type TokenRepositoryMockDeleteTokenVersionParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// TokenRepositoryMockDeleteTokenVersionParamPtrs contains pointers to parameters of the TokenRepository.DeleteTokenVersion
+type TokenRepositoryMockDeleteTokenVersionParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// TokenRepositoryMockDeleteTokenVersionResults contains results of the TokenRepository.DeleteTokenVersion
+type TokenRepositoryMockDeleteTokenVersionResults struct {
+	err error
+}
+
+// TokenRepositoryMockDeleteTokenVersionOrigins contains origins of expectations of the TokenRepository.DeleteTokenVersion
+type TokenRepositoryMockDeleteTokenVersionExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteTokenVersion *mTokenRepositoryMockDeleteTokenVersion) Optional() *mTokenRepositoryMockDeleteTokenVersion {
+	mmDeleteTokenVersion.optional = true
+	return mmDeleteTokenVersion
+}
+
+// Expect sets up expected params for TokenRepository.DeleteTokenVersion
+func (mmDeleteTokenVersion *mTokenRepositoryMockDeleteTokenVersion) Expect(ctx context.Context, userID string) *mTokenRepositoryMockDeleteTokenVersion {
+	if mmDeleteTokenVersion.mock.funcDeleteTokenVersion != nil {
+		mmDeleteTokenVersion.mock.t.Fatalf("TokenRepositoryMock.DeleteTokenVersion mock is already set by Set")
+	}
+
+	if mmDeleteTokenVersion.defaultExpectation == nil {
+		mmDeleteTokenVersion.defaultExpectation = &TokenRepositoryMockDeleteTokenVersionExpectation{}
+	}
+
+	if mmDeleteTokenVersion.defaultExpectation.paramPtrs != nil {
+		mmDeleteTokenVersion.mock.t.Fatalf("TokenRepositoryMock.DeleteTokenVersion mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteTokenVersion.defaultExpectation.params = &TokenRepositoryMockDeleteTokenVersionParams{ctx, userID}
+	mmDeleteTokenVersion.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteTokenVersion.expectations {
+		if minimock.Equal(e.params, mmDeleteTokenVersion.defaultExpectation.params) {
+			mmDeleteTokenVersion.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteTokenVersion.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteTokenVersion
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TokenRepository.DeleteTokenVersion
+func (mmDeleteTokenVersion *mTokenRepositoryMockDeleteTokenVersion) ExpectCtxParam1(ctx context.Context) *mTokenRepositoryMockDeleteTokenVersion {
+	if mmDeleteTokenVersion.mock.funcDeleteTokenVersion != nil {
+		mmDeleteTokenVersion.mock.t.Fatalf("TokenRepositoryMock.DeleteTokenVersion mock is already set by Set")
+	}
+
+	if mmDeleteTokenVersion.defaultExpectation == nil {
+		mmDeleteTokenVersion.defaultExpectation = &TokenRepositoryMockDeleteTokenVersionExpectation{}
+	}
+
+	if mmDeleteTokenVersion.defaultExpectation.params != nil {
+		mmDeleteTokenVersion.mock.t.Fatalf("TokenRepositoryMock.DeleteTokenVersion mock is already set by Expect")
+	}
+
+	if mmDeleteTokenVersion.defaultExpectation.paramPtrs == nil {
+		mmDeleteTokenVersion.defaultExpectation.paramPtrs = &TokenRepositoryMockDeleteTokenVersionParamPtrs{}
+	}
+	mmDeleteTokenVersion.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteTokenVersion.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteTokenVersion
+}
+
+// ExpectUserIDParam2 sets up expected param userID for TokenRepository.DeleteTokenVersion
+func (mmDeleteTokenVersion *mTokenRepositoryMockDeleteTokenVersion) ExpectUserIDParam2(userID string) *mTokenRepositoryMockDeleteTokenVersion {
+	if mmDeleteTokenVersion.mock.funcDeleteTokenVersion != nil {
+		mmDeleteTokenVersion.mock.t.Fatalf("TokenRepositoryMock.DeleteTokenVersion mock is already set by Set")
+	}
+
+	if mmDeleteTokenVersion.defaultExpectation == nil {
+		mmDeleteTokenVersion.defaultExpectation = &TokenRepositoryMockDeleteTokenVersionExpectation{}
+	}
+
+	if mmDeleteTokenVersion.defaultExpectation.params != nil {
+		mmDeleteTokenVersion.mock.t.Fatalf("TokenRepositoryMock.DeleteTokenVersion mock is already set by Expect")
+	}
+
+	if mmDeleteTokenVersion.defaultExpectation.paramPtrs == nil {
+		mmDeleteTokenVersion.defaultExpectation.paramPtrs = &TokenRepositoryMockDeleteTokenVersionParamPtrs{}
+	}
+	mmDeleteTokenVersion.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteTokenVersion.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteTokenVersion
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenRepository.DeleteTokenVersion
+func (mmDeleteTokenVersion *mTokenRepositoryMockDeleteTokenVersion) Inspect(f func(ctx context.Context, userID string)) *mTokenRepositoryMockDeleteTokenVersion {
+	if mmDeleteTokenVersion.mock.inspectFuncDeleteTokenVersion != nil {
+		mmDeleteTokenVersion.mock.t.Fatalf("Inspect function is already set for TokenRepositoryMock.DeleteTokenVersion")
+	}
+
+	mmDeleteTokenVersion.mock.inspectFuncDeleteTokenVersion = f
+
+	return mmDeleteTokenVersion
+}
+
+// Return sets up results that will be returned by TokenRepository.DeleteTokenVersion
+func (mmDeleteTokenVersion *mTokenRepositoryMockDeleteTokenVersion) Return(err error) *TokenRepositoryMock {
+	if mmDeleteTokenVersion.mock.funcDeleteTokenVersion != nil {
+		mmDeleteTokenVersion.mock.t.Fatalf("TokenRepositoryMock.DeleteTokenVersion mock is already set by Set")
+	}
+
+	if mmDeleteTokenVersion.defaultExpectation == nil {
+		mmDeleteTokenVersion.defaultExpectation = &TokenRepositoryMockDeleteTokenVersionExpectation{mock: mmDeleteTokenVersion.mock}
+	}
+	mmDeleteTokenVersion.defaultExpectation.results = &TokenRepositoryMockDeleteTokenVersionResults{err}
+	mmDeleteTokenVersion.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteTokenVersion.mock
+}
+
+// Set uses given function f to mock the TokenRepository.DeleteTokenVersion method
+func (mmDeleteTokenVersion *mTokenRepositoryMockDeleteTokenVersion) Set(f func(ctx context.Context, userID string) (err error)) *TokenRepositoryMock {
+	if mmDeleteTokenVersion.defaultExpectation != nil {
+		mmDeleteTokenVersion.mock.t.Fatalf("Default expectation is already set for the TokenRepository.DeleteTokenVersion method")
+	}
+
+	if len(mmDeleteTokenVersion.expectations) > 0 {
+		mmDeleteTokenVersion.mock.t.Fatalf("Some expectations are already set for the TokenRepository.DeleteTokenVersion method")
+	}
+
+	mmDeleteTokenVersion.mock.funcDeleteTokenVersion = f
+	mmDeleteTokenVersion.mock.funcDeleteTokenVersionOrigin = minimock.CallerInfo(1)
+	return mmDeleteTokenVersion.mock
+}
+
+// When sets expectation for the TokenRepository.DeleteTokenVersion which will trigger the result defined by the following
+// Then helper
+func (mmDeleteTokenVersion *mTokenRepositoryMockDeleteTokenVersion) When(ctx context.Context, userID string) *TokenRepositoryMockDeleteTokenVersionExpectation {
+	if mmDeleteTokenVersion.mock.funcDeleteTokenVersion != nil {
+		mmDeleteTokenVersion.mock.t.Fatalf("TokenRepositoryMock.DeleteTokenVersion mock is already set by Set")
+	}
+
+	expectation := &TokenRepositoryMockDeleteTokenVersionExpectation{
+		mock:               mmDeleteTokenVersion.mock,
+		params:             &TokenRepositoryMockDeleteTokenVersionParams{ctx, userID},
+		expectationOrigins: TokenRepositoryMockDeleteTokenVersionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteTokenVersion.expectations = append(mmDeleteTokenVersion.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TokenRepository.DeleteTokenVersion return parameters for the expectation previously defined by the When method
+func (e *TokenRepositoryMockDeleteTokenVersionExpectation) Then(err error) *TokenRepositoryMock {
+	e.results = &TokenRepositoryMockDeleteTokenVersionResults{err}
+	return e.mock
+}
+
+// Times sets number of times TokenRepository.DeleteTokenVersion should be invoked
+func (mmDeleteTokenVersion *mTokenRepositoryMockDeleteTokenVersion) Times(n uint64) *mTokenRepositoryMockDeleteTokenVersion {
+	if n == 0 {
+		mmDeleteTokenVersion.mock.t.Fatalf("Times of TokenRepositoryMock.DeleteTokenVersion mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteTokenVersion.expectedInvocations, n)
+	mmDeleteTokenVersion.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteTokenVersion
+}
+
+func (mmDeleteTokenVersion *mTokenRepositoryMockDeleteTokenVersion) invocationsDone() bool {
+	if len(mmDeleteTokenVersion.expectations) == 0 && mmDeleteTokenVersion.defaultExpectation == nil && mmDeleteTokenVersion.mock.funcDeleteTokenVersion == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteTokenVersion.mock.afterDeleteTokenVersionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteTokenVersion.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteTokenVersion implements mm_repository.TokenRepository
+func (mmDeleteTokenVersion *TokenRepositoryMock) DeleteTokenVersion(ctx context.Context, userID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteTokenVersion.beforeDeleteTokenVersionCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteTokenVersion.afterDeleteTokenVersionCounter, 1)
+
+	mmDeleteTokenVersion.t.Helper()
+
+	if mmDeleteTokenVersion.inspectFuncDeleteTokenVersion != nil {
+		mmDeleteTokenVersion.inspectFuncDeleteTokenVersion(ctx, userID)
+	}
+
+	mm_params := TokenRepositoryMockDeleteTokenVersionParams{ctx, userID}
+
+	// Record call args
+	mmDeleteTokenVersion.DeleteTokenVersionMock.mutex.Lock()
+	mmDeleteTokenVersion.DeleteTokenVersionMock.callArgs = append(mmDeleteTokenVersion.DeleteTokenVersionMock.callArgs, &mm_params)
+	mmDeleteTokenVersion.DeleteTokenVersionMock.mutex.Unlock()
+
+	for _, e := range mmDeleteTokenVersion.DeleteTokenVersionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteTokenVersion.DeleteTokenVersionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteTokenVersion.DeleteTokenVersionMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteTokenVersion.DeleteTokenVersionMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteTokenVersion.DeleteTokenVersionMock.defaultExpectation.paramPtrs
+
+		mm_got := TokenRepositoryMockDeleteTokenVersionParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteTokenVersion.t.Errorf("TokenRepositoryMock.DeleteTokenVersion got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTokenVersion.DeleteTokenVersionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteTokenVersion.t.Errorf("TokenRepositoryMock.DeleteTokenVersion got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTokenVersion.DeleteTokenVersionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteTokenVersion.t.Errorf("TokenRepositoryMock.DeleteTokenVersion got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteTokenVersion.DeleteTokenVersionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteTokenVersion.DeleteTokenVersionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteTokenVersion.t.Fatal("No results are set for the TokenRepositoryMock.DeleteTokenVersion")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteTokenVersion.funcDeleteTokenVersion != nil {
+		return mmDeleteTokenVersion.funcDeleteTokenVersion(ctx, userID)
+	}
+	mmDeleteTokenVersion.t.Fatalf("Unexpected call to TokenRepositoryMock.DeleteTokenVersion. %v %v", ctx, userID)
+	return
+}
+
+// DeleteTokenVersionAfterCounter returns a count of finished TokenRepositoryMock.DeleteTokenVersion invocations
+func (mmDeleteTokenVersion *TokenRepositoryMock) DeleteTokenVersionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTokenVersion.afterDeleteTokenVersionCounter)
+}
+
+// DeleteTokenVersionBeforeCounter returns a count of TokenRepositoryMock.DeleteTokenVersion invocations
+func (mmDeleteTokenVersion *TokenRepositoryMock) DeleteTokenVersionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTokenVersion.beforeDeleteTokenVersionCounter)
+}
+
+// Calls returns a list of arguments used in each call to TokenRepositoryMock.DeleteTokenVersion.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteTokenVersion *mTokenRepositoryMockDeleteTokenVersion) Calls() []*TokenRepositoryMockDeleteTokenVersionParams {
+	mmDeleteTokenVersion.mutex.RLock()
+
+	argCopy := make([]*TokenRepositoryMockDeleteTokenVersionParams, len(mmDeleteTokenVersion.callArgs))
+	copy(argCopy, mmDeleteTokenVersion.callArgs)
+
+	mmDeleteTokenVersion.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteTokenVersionDone returns true if the count of the DeleteTokenVersion invocations corresponds
+// the number of defined expectations
+func (m *TokenRepositoryMock) MinimockDeleteTokenVersionDone() bool {
+	if m.DeleteTokenVersionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteTokenVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteTokenVersionMock.invocationsDone()
+}
+
+// MinimockDeleteTokenVersionInspect logs each unmet expectation
+func (m *TokenRepositoryMock) MinimockDeleteTokenVersionInspect() {
+	for _, e := range m.DeleteTokenVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TokenRepositoryMock.DeleteTokenVersion at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteTokenVersionCounter := mm_atomic.LoadUint64(&m.afterDeleteTokenVersionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteTokenVersionMock.defaultExpectation != nil && afterDeleteTokenVersionCounter < 1 {
+		if m.DeleteTokenVersionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TokenRepositoryMock.DeleteTokenVersion at\n%s", m.DeleteTokenVersionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TokenRepositoryMock.DeleteTokenVersion at\n%s with params: %#v", m.DeleteTokenVersionMock.defaultExpectation.expectationOrigins.origin, *m.DeleteTokenVersionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteTokenVersion != nil && afterDeleteTokenVersionCounter < 1 {
+		m.t.Errorf("Expected call to TokenRepositoryMock.DeleteTokenVersion at\n%s", m.funcDeleteTokenVersionOrigin)
+	}
+
+	if !m.DeleteTokenVersionMock.invocationsDone() && afterDeleteTokenVersionCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenRepositoryMock.DeleteTokenVersion at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteTokenVersionMock.expectedInvocations), m.DeleteTokenVersionMock.expectedInvocationsOrigin, afterDeleteTokenVersionCounter)
+	}
+}
+
 type mTokenRepositoryMockGetTokenVersion struct {
 	optional           bool
 	mock               *TokenRepositoryMock
@@ -1098,6 +1460,349 @@ func (m *TokenRepositoryMock) MinimockIsTokenRevokedInspect() {
 	}
 }
 
+type mTokenRepositoryMockRevokeToken struct {
+	optional           bool
+	mock               *TokenRepositoryMock
+	defaultExpectation *TokenRepositoryMockRevokeTokenExpectation
+	expectations       []*TokenRepositoryMockRevokeTokenExpectation
+
+	callArgs []*TokenRepositoryMockRevokeTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TokenRepositoryMockRevokeTokenExpectation specifies expectation struct of the TokenRepository.RevokeToken
+type TokenRepositoryMockRevokeTokenExpectation struct {
+	mock               *TokenRepositoryMock
+	params             *TokenRepositoryMockRevokeTokenParams
+	paramPtrs          *TokenRepositoryMockRevokeTokenParamPtrs
+	expectationOrigins TokenRepositoryMockRevokeTokenExpectationOrigins
+	results            *TokenRepositoryMockRevokeTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TokenRepositoryMockRevokeTokenParams contains parameters of the TokenRepository.RevokeToken
+type TokenRepositoryMockRevokeTokenParams struct {
+	ctx   context.Context
+	token string
+}
+
+// TokenRepositoryMockRevokeTokenParamPtrs contains pointers to parameters of the TokenRepository.RevokeToken
+type TokenRepositoryMockRevokeTokenParamPtrs struct {
+	ctx   *context.Context
+	token *string
+}
+
+// TokenRepositoryMockRevokeTokenResults contains results of the TokenRepository.RevokeToken
+type TokenRepositoryMockRevokeTokenResults struct {
+	b1  bool
+	err error
+}
+
+// TokenRepositoryMockRevokeTokenOrigins contains origins of expectations of the TokenRepository.RevokeToken
+type TokenRepositoryMockRevokeTokenExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeToken *mTokenRepositoryMockRevokeToken) Optional() *mTokenRepositoryMockRevokeToken {
+	mmRevokeToken.optional = true
+	return mmRevokeToken
+}
+
+// Expect sets up expected params for TokenRepository.RevokeToken
+func (mmRevokeToken *mTokenRepositoryMockRevokeToken) Expect(ctx context.Context, token string) *mTokenRepositoryMockRevokeToken {
+	if mmRevokeToken.mock.funcRevokeToken != nil {
+		mmRevokeToken.mock.t.Fatalf("TokenRepositoryMock.RevokeToken mock is already set by Set")
+	}
+
+	if mmRevokeToken.defaultExpectation == nil {
+		mmRevokeToken.defaultExpectation = &TokenRepositoryMockRevokeTokenExpectation{}
+	}
+
+	if mmRevokeToken.defaultExpectation.paramPtrs != nil {
+		mmRevokeToken.mock.t.Fatalf("TokenRepositoryMock.RevokeToken mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeToken.defaultExpectation.params = &TokenRepositoryMockRevokeTokenParams{ctx, token}
+	mmRevokeToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevokeToken.expectations {
+		if minimock.Equal(e.params, mmRevokeToken.defaultExpectation.params) {
+			mmRevokeToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeToken.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TokenRepository.RevokeToken
+func (mmRevokeToken *mTokenRepositoryMockRevokeToken) ExpectCtxParam1(ctx context.Context) *mTokenRepositoryMockRevokeToken {
+	if mmRevokeToken.mock.funcRevokeToken != nil {
+		mmRevokeToken.mock.t.Fatalf("TokenRepositoryMock.RevokeToken mock is already set by Set")
+	}
+
+	if mmRevokeToken.defaultExpectation == nil {
+		mmRevokeToken.defaultExpectation = &TokenRepositoryMockRevokeTokenExpectation{}
+	}
+
+	if mmRevokeToken.defaultExpectation.params != nil {
+		mmRevokeToken.mock.t.Fatalf("TokenRepositoryMock.RevokeToken mock is already set by Expect")
+	}
+
+	if mmRevokeToken.defaultExpectation.paramPtrs == nil {
+		mmRevokeToken.defaultExpectation.paramPtrs = &TokenRepositoryMockRevokeTokenParamPtrs{}
+	}
+	mmRevokeToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevokeToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevokeToken
+}
+
+// ExpectTokenParam2 sets up expected param token for TokenRepository.RevokeToken
+func (mmRevokeToken *mTokenRepositoryMockRevokeToken) ExpectTokenParam2(token string) *mTokenRepositoryMockRevokeToken {
+	if mmRevokeToken.mock.funcRevokeToken != nil {
+		mmRevokeToken.mock.t.Fatalf("TokenRepositoryMock.RevokeToken mock is already set by Set")
+	}
+
+	if mmRevokeToken.defaultExpectation == nil {
+		mmRevokeToken.defaultExpectation = &TokenRepositoryMockRevokeTokenExpectation{}
+	}
+
+	if mmRevokeToken.defaultExpectation.params != nil {
+		mmRevokeToken.mock.t.Fatalf("TokenRepositoryMock.RevokeToken mock is already set by Expect")
+	}
+
+	if mmRevokeToken.defaultExpectation.paramPtrs == nil {
+		mmRevokeToken.defaultExpectation.paramPtrs = &TokenRepositoryMockRevokeTokenParamPtrs{}
+	}
+	mmRevokeToken.defaultExpectation.paramPtrs.token = &token
+	mmRevokeToken.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmRevokeToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenRepository.RevokeToken
+func (mmRevokeToken *mTokenRepositoryMockRevokeToken) Inspect(f func(ctx context.Context, token string)) *mTokenRepositoryMockRevokeToken {
+	if mmRevokeToken.mock.inspectFuncRevokeToken != nil {
+		mmRevokeToken.mock.t.Fatalf("Inspect function is already set for TokenRepositoryMock.RevokeToken")
+	}
+
+	mmRevokeToken.mock.inspectFuncRevokeToken = f
+
+	return mmRevokeToken
+}
+
+// Return sets up results that will be returned by TokenRepository.RevokeToken
+func (mmRevokeToken *mTokenRepositoryMockRevokeToken) Return(b1 bool, err error) *TokenRepositoryMock {
+	if mmRevokeToken.mock.funcRevokeToken != nil {
+		mmRevokeToken.mock.t.Fatalf("TokenRepositoryMock.RevokeToken mock is already set by Set")
+	}
+
+	if mmRevokeToken.defaultExpectation == nil {
+		mmRevokeToken.defaultExpectation = &TokenRepositoryMockRevokeTokenExpectation{mock: mmRevokeToken.mock}
+	}
+	mmRevokeToken.defaultExpectation.results = &TokenRepositoryMockRevokeTokenResults{b1, err}
+	mmRevokeToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevokeToken.mock
+}
+
+// Set uses given function f to mock the TokenRepository.RevokeToken method
+func (mmRevokeToken *mTokenRepositoryMockRevokeToken) Set(f func(ctx context.Context, token string) (b1 bool, err error)) *TokenRepositoryMock {
+	if mmRevokeToken.defaultExpectation != nil {
+		mmRevokeToken.mock.t.Fatalf("Default expectation is already set for the TokenRepository.RevokeToken method")
+	}
+
+	if len(mmRevokeToken.expectations) > 0 {
+		mmRevokeToken.mock.t.Fatalf("Some expectations are already set for the TokenRepository.RevokeToken method")
+	}
+
+	mmRevokeToken.mock.funcRevokeToken = f
+	mmRevokeToken.mock.funcRevokeTokenOrigin = minimock.CallerInfo(1)
+	return mmRevokeToken.mock
+}
+
+// When sets expectation for the TokenRepository.RevokeToken which will trigger the result defined by the following
+// Then helper
+func (mmRevokeToken *mTokenRepositoryMockRevokeToken) When(ctx context.Context, token string) *TokenRepositoryMockRevokeTokenExpectation {
+	if mmRevokeToken.mock.funcRevokeToken != nil {
+		mmRevokeToken.mock.t.Fatalf("TokenRepositoryMock.RevokeToken mock is already set by Set")
+	}
+
+	expectation := &TokenRepositoryMockRevokeTokenExpectation{
+		mock:               mmRevokeToken.mock,
+		params:             &TokenRepositoryMockRevokeTokenParams{ctx, token},
+		expectationOrigins: TokenRepositoryMockRevokeTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevokeToken.expectations = append(mmRevokeToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TokenRepository.RevokeToken return parameters for the expectation previously defined by the When method
+func (e *TokenRepositoryMockRevokeTokenExpectation) Then(b1 bool, err error) *TokenRepositoryMock {
+	e.results = &TokenRepositoryMockRevokeTokenResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times TokenRepository.RevokeToken should be invoked
+func (mmRevokeToken *mTokenRepositoryMockRevokeToken) Times(n uint64) *mTokenRepositoryMockRevokeToken {
+	if n == 0 {
+		mmRevokeToken.mock.t.Fatalf("Times of TokenRepositoryMock.RevokeToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeToken.expectedInvocations, n)
+	mmRevokeToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevokeToken
+}
+
+func (mmRevokeToken *mTokenRepositoryMockRevokeToken) invocationsDone() bool {
+	if len(mmRevokeToken.expectations) == 0 && mmRevokeToken.defaultExpectation == nil && mmRevokeToken.mock.funcRevokeToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeToken.mock.afterRevokeTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeToken implements mm_repository.TokenRepository
+func (mmRevokeToken *TokenRepositoryMock) RevokeToken(ctx context.Context, token string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmRevokeToken.beforeRevokeTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeToken.afterRevokeTokenCounter, 1)
+
+	mmRevokeToken.t.Helper()
+
+	if mmRevokeToken.inspectFuncRevokeToken != nil {
+		mmRevokeToken.inspectFuncRevokeToken(ctx, token)
+	}
+
+	mm_params := TokenRepositoryMockRevokeTokenParams{ctx, token}
+
+	// Record call args
+	mmRevokeToken.RevokeTokenMock.mutex.Lock()
+	mmRevokeToken.RevokeTokenMock.callArgs = append(mmRevokeToken.RevokeTokenMock.callArgs, &mm_params)
+	mmRevokeToken.RevokeTokenMock.mutex.Unlock()
+
+	for _, e := range mmRevokeToken.RevokeTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmRevokeToken.RevokeTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeToken.RevokeTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeToken.RevokeTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeToken.RevokeTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := TokenRepositoryMockRevokeTokenParams{ctx, token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeToken.t.Errorf("TokenRepositoryMock.RevokeToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeToken.RevokeTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmRevokeToken.t.Errorf("TokenRepositoryMock.RevokeToken got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeToken.RevokeTokenMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeToken.t.Errorf("TokenRepositoryMock.RevokeToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevokeToken.RevokeTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeToken.RevokeTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeToken.t.Fatal("No results are set for the TokenRepositoryMock.RevokeToken")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmRevokeToken.funcRevokeToken != nil {
+		return mmRevokeToken.funcRevokeToken(ctx, token)
+	}
+	mmRevokeToken.t.Fatalf("Unexpected call to TokenRepositoryMock.RevokeToken. %v %v", ctx, token)
+	return
+}
+
+// RevokeTokenAfterCounter returns a count of finished TokenRepositoryMock.RevokeToken invocations
+func (mmRevokeToken *TokenRepositoryMock) RevokeTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeToken.afterRevokeTokenCounter)
+}
+
+// RevokeTokenBeforeCounter returns a count of TokenRepositoryMock.RevokeToken invocations
+func (mmRevokeToken *TokenRepositoryMock) RevokeTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeToken.beforeRevokeTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to TokenRepositoryMock.RevokeToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeToken *mTokenRepositoryMockRevokeToken) Calls() []*TokenRepositoryMockRevokeTokenParams {
+	mmRevokeToken.mutex.RLock()
+
+	argCopy := make([]*TokenRepositoryMockRevokeTokenParams, len(mmRevokeToken.callArgs))
+	copy(argCopy, mmRevokeToken.callArgs)
+
+	mmRevokeToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeTokenDone returns true if the count of the RevokeToken invocations corresponds
+// the number of defined expectations
+func (m *TokenRepositoryMock) MinimockRevokeTokenDone() bool {
+	if m.RevokeTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeTokenMock.invocationsDone()
+}
+
+// MinimockRevokeTokenInspect logs each unmet expectation
+func (m *TokenRepositoryMock) MinimockRevokeTokenInspect() {
+	for _, e := range m.RevokeTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TokenRepositoryMock.RevokeToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevokeTokenCounter := mm_atomic.LoadUint64(&m.afterRevokeTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeTokenMock.defaultExpectation != nil && afterRevokeTokenCounter < 1 {
+		if m.RevokeTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TokenRepositoryMock.RevokeToken at\n%s", m.RevokeTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TokenRepositoryMock.RevokeToken at\n%s with params: %#v", m.RevokeTokenMock.defaultExpectation.expectationOrigins.origin, *m.RevokeTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeToken != nil && afterRevokeTokenCounter < 1 {
+		m.t.Errorf("Expected call to TokenRepositoryMock.RevokeToken at\n%s", m.funcRevokeTokenOrigin)
+	}
+
+	if !m.RevokeTokenMock.invocationsDone() && afterRevokeTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenRepositoryMock.RevokeToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeTokenMock.expectedInvocations), m.RevokeTokenMock.expectedInvocationsOrigin, afterRevokeTokenCounter)
+	}
+}
+
 type mTokenRepositoryMockSetTokenVersion struct {
 	optional           bool
 	mock               *TokenRepositoryMock
@@ -1477,10 +2182,14 @@ func (m *TokenRepositoryMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockAddRevokedTokenInspect()
 
+			m.MinimockDeleteTokenVersionInspect()
+
 			m.MinimockGetTokenVersionInspect()
 
 			m.MinimockIsTokenRevokedInspect()
 
+			m.MinimockRevokeTokenInspect()
+
 			m.MinimockSetTokenVersionInspect()
 		}
 	})
@@ -1506,7 +2215,9 @@ func (m *TokenRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockAddRevokedTokenDone() &&
+		m.MinimockDeleteTokenVersionDone() &&
 		m.MinimockGetTokenVersionDone() &&
 		m.MinimockIsTokenRevokedDone() &&
+		m.MinimockRevokeTokenDone() &&
 		m.MinimockSetTokenVersionDone()
 }
@@ -6,6 +6,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/8thgencore/microservice-auth/internal/model"
@@ -17,6 +18,20 @@ type UserRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcConfirmEmail          func(ctx context.Context, userID string, email string) (err error)
+	funcConfirmEmailOrigin    string
+	inspectFuncConfirmEmail   func(ctx context.Context, userID string, email string)
+	afterConfirmEmailCounter  uint64
+	beforeConfirmEmailCounter uint64
+	ConfirmEmailMock          mUserRepositoryMockConfirmEmail
+
+	funcCount          func(ctx context.Context, filter *model.UserFilter) (i1 int64, err error)
+	funcCountOrigin    string
+	inspectFuncCount   func(ctx context.Context, filter *model.UserFilter)
+	afterCountCounter  uint64
+	beforeCountCounter uint64
+	CountMock          mUserRepositoryMockCount
+
 	funcCreate          func(ctx context.Context, user *model.UserCreate) (s1 string, err error)
 	funcCreateOrigin    string
 	inspectFuncCreate   func(ctx context.Context, user *model.UserCreate)
@@ -31,6 +46,13 @@ type UserRepositoryMock struct {
 	beforeDeleteCounter uint64
 	DeleteMock          mUserRepositoryMockDelete
 
+	funcFindByEmail          func(ctx context.Context, email string) (up1 *model.User, err error)
+	funcFindByEmailOrigin    string
+	inspectFuncFindByEmail   func(ctx context.Context, email string)
+	afterFindByEmailCounter  uint64
+	beforeFindByEmailCounter uint64
+	FindByEmailMock          mUserRepositoryMockFindByEmail
+
 	funcFindByName          func(ctx context.Context, name string) (up1 *model.User, err error)
 	funcFindByNameOrigin    string
 	inspectFuncFindByName   func(ctx context.Context, name string)
@@ -52,6 +74,41 @@ type UserRepositoryMock struct {
 	beforeGetAuthInfoCounter uint64
 	GetAuthInfoMock          mUserRepositoryMockGetAuthInfo
 
+	funcGetVersion          func(ctx context.Context, id string) (i1 int, err error)
+	funcGetVersionOrigin    string
+	inspectFuncGetVersion   func(ctx context.Context, id string)
+	afterGetVersionCounter  uint64
+	beforeGetVersionCounter uint64
+	GetVersionMock          mUserRepositoryMockGetVersion
+
+	funcIncrementVersion          func(ctx context.Context, id string) (i1 int, err error)
+	funcIncrementVersionOrigin    string
+	inspectFuncIncrementVersion   func(ctx context.Context, id string)
+	afterIncrementVersionCounter  uint64
+	beforeIncrementVersionCounter uint64
+	IncrementVersionMock          mUserRepositoryMockIncrementVersion
+
+	funcList          func(ctx context.Context, query *model.UserListQuery) (upa1 []*model.User, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context, query *model.UserListQuery)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mUserRepositoryMockList
+
+	funcPurgeDeleted          func(ctx context.Context, deletedBefore time.Time, limit int) (sa1 []string, err error)
+	funcPurgeDeletedOrigin    string
+	inspectFuncPurgeDeleted   func(ctx context.Context, deletedBefore time.Time, limit int)
+	afterPurgeDeletedCounter  uint64
+	beforePurgeDeletedCounter uint64
+	PurgeDeletedMock          mUserRepositoryMockPurgeDeleted
+
+	funcSetStatus          func(ctx context.Context, id string, change *model.UserStatusChange) (i1 int, err error)
+	funcSetStatusOrigin    string
+	inspectFuncSetStatus   func(ctx context.Context, id string, change *model.UserStatusChange)
+	afterSetStatusCounter  uint64
+	beforeSetStatusCounter uint64
+	SetStatusMock          mUserRepositoryMockSetStatus
+
 	funcUpdate          func(ctx context.Context, user *model.UserUpdate) (err error)
 	funcUpdateOrigin    string
 	inspectFuncUpdate   func(ctx context.Context, user *model.UserUpdate)
@@ -75,12 +132,21 @@ func NewUserRepositoryMock(t minimock.Tester) *UserRepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.ConfirmEmailMock = mUserRepositoryMockConfirmEmail{mock: m}
+	m.ConfirmEmailMock.callArgs = []*UserRepositoryMockConfirmEmailParams{}
+
+	m.CountMock = mUserRepositoryMockCount{mock: m}
+	m.CountMock.callArgs = []*UserRepositoryMockCountParams{}
+
 	m.CreateMock = mUserRepositoryMockCreate{mock: m}
 	m.CreateMock.callArgs = []*UserRepositoryMockCreateParams{}
 
 	m.DeleteMock = mUserRepositoryMockDelete{mock: m}
 	m.DeleteMock.callArgs = []*UserRepositoryMockDeleteParams{}
 
+	m.FindByEmailMock = mUserRepositoryMockFindByEmail{mock: m}
+	m.FindByEmailMock.callArgs = []*UserRepositoryMockFindByEmailParams{}
+
 	m.FindByNameMock = mUserRepositoryMockFindByName{mock: m}
 	m.FindByNameMock.callArgs = []*UserRepositoryMockFindByNameParams{}
 
@@ -90,6 +156,21 @@ func NewUserRepositoryMock(t minimock.Tester) *UserRepositoryMock {
 	m.GetAuthInfoMock = mUserRepositoryMockGetAuthInfo{mock: m}
 	m.GetAuthInfoMock.callArgs = []*UserRepositoryMockGetAuthInfoParams{}
 
+	m.GetVersionMock = mUserRepositoryMockGetVersion{mock: m}
+	m.GetVersionMock.callArgs = []*UserRepositoryMockGetVersionParams{}
+
+	m.IncrementVersionMock = mUserRepositoryMockIncrementVersion{mock: m}
+	m.IncrementVersionMock.callArgs = []*UserRepositoryMockIncrementVersionParams{}
+
+	m.ListMock = mUserRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*UserRepositoryMockListParams{}
+
+	m.PurgeDeletedMock = mUserRepositoryMockPurgeDeleted{mock: m}
+	m.PurgeDeletedMock.callArgs = []*UserRepositoryMockPurgeDeletedParams{}
+
+	m.SetStatusMock = mUserRepositoryMockSetStatus{mock: m}
+	m.SetStatusMock.callArgs = []*UserRepositoryMockSetStatusParams{}
+
 	m.UpdateMock = mUserRepositoryMockUpdate{mock: m}
 	m.UpdateMock.callArgs = []*UserRepositoryMockUpdateParams{}
 
@@ -101,53 +182,55 @@ func NewUserRepositoryMock(t minimock.Tester) *UserRepositoryMock {
 	return m
 }
 
-type mUserRepositoryMockCreate struct {
+type mUserRepositoryMockConfirmEmail struct {
 	optional           bool
 	mock               *UserRepositoryMock
-	defaultExpectation *UserRepositoryMockCreateExpectation
-	expectations       []*UserRepositoryMockCreateExpectation
+	defaultExpectation *UserRepositoryMockConfirmEmailExpectation
+	expectations       []*UserRepositoryMockConfirmEmailExpectation
 
-	callArgs []*UserRepositoryMockCreateParams
+	callArgs []*UserRepositoryMockConfirmEmailParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserRepositoryMockCreateExpectation specifies expectation struct of the UserRepository.Create
-type UserRepositoryMockCreateExpectation struct {
+// UserRepositoryMockConfirmEmailExpectation specifies expectation struct of the UserRepository.ConfirmEmail
+type UserRepositoryMockConfirmEmailExpectation struct {
 	mock               *UserRepositoryMock
-	params             *UserRepositoryMockCreateParams
-	paramPtrs          *UserRepositoryMockCreateParamPtrs
-	expectationOrigins UserRepositoryMockCreateExpectationOrigins
-	results            *UserRepositoryMockCreateResults
+	params             *UserRepositoryMockConfirmEmailParams
+	paramPtrs          *UserRepositoryMockConfirmEmailParamPtrs
+	expectationOrigins UserRepositoryMockConfirmEmailExpectationOrigins
+	results            *UserRepositoryMockConfirmEmailResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserRepositoryMockCreateParams contains parameters of the UserRepository.Create
-type UserRepositoryMockCreateParams struct {
-	ctx  context.Context
-	user *model.UserCreate
+// UserRepositoryMockConfirmEmailParams contains parameters of the UserRepository.ConfirmEmail
+type UserRepositoryMockConfirmEmailParams struct {
+	ctx    context.Context
+	userID string
+	email  string
 }
 
-// UserRepositoryMockCreateParamPtrs contains pointers to parameters of the UserRepository.Create
-type UserRepositoryMockCreateParamPtrs struct {
-	ctx  *context.Context
-	user **model.UserCreate
+// UserRepositoryMockConfirmEmailParamPtrs contains pointers to parameters of the UserRepository.ConfirmEmail
+type UserRepositoryMockConfirmEmailParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+	email  *string
 }
 
-// UserRepositoryMockCreateResults contains results of the UserRepository.Create
-type UserRepositoryMockCreateResults struct {
-	s1  string
+// UserRepositoryMockConfirmEmailResults contains results of the UserRepository.ConfirmEmail
+type UserRepositoryMockConfirmEmailResults struct {
 	err error
 }
 
-// UserRepositoryMockCreateOrigins contains origins of expectations of the UserRepository.Create
-type UserRepositoryMockCreateExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originUser string
+// UserRepositoryMockConfirmEmailOrigins contains origins of expectations of the UserRepository.ConfirmEmail
+type UserRepositoryMockConfirmEmailExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originEmail  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -155,341 +238,370 @@ type UserRepositoryMockCreateExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreate *mUserRepositoryMockCreate) Optional() *mUserRepositoryMockCreate {
-	mmCreate.optional = true
-	return mmCreate
+func (mmConfirmEmail *mUserRepositoryMockConfirmEmail) Optional() *mUserRepositoryMockConfirmEmail {
+	mmConfirmEmail.optional = true
+	return mmConfirmEmail
 }
 
-// Expect sets up expected params for UserRepository.Create
-func (mmCreate *mUserRepositoryMockCreate) Expect(ctx context.Context, user *model.UserCreate) *mUserRepositoryMockCreate {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("UserRepositoryMock.Create mock is already set by Set")
+// Expect sets up expected params for UserRepository.ConfirmEmail
+func (mmConfirmEmail *mUserRepositoryMockConfirmEmail) Expect(ctx context.Context, userID string, email string) *mUserRepositoryMockConfirmEmail {
+	if mmConfirmEmail.mock.funcConfirmEmail != nil {
+		mmConfirmEmail.mock.t.Fatalf("UserRepositoryMock.ConfirmEmail mock is already set by Set")
 	}
 
-	if mmCreate.defaultExpectation == nil {
-		mmCreate.defaultExpectation = &UserRepositoryMockCreateExpectation{}
+	if mmConfirmEmail.defaultExpectation == nil {
+		mmConfirmEmail.defaultExpectation = &UserRepositoryMockConfirmEmailExpectation{}
 	}
 
-	if mmCreate.defaultExpectation.paramPtrs != nil {
-		mmCreate.mock.t.Fatalf("UserRepositoryMock.Create mock is already set by ExpectParams functions")
+	if mmConfirmEmail.defaultExpectation.paramPtrs != nil {
+		mmConfirmEmail.mock.t.Fatalf("UserRepositoryMock.ConfirmEmail mock is already set by ExpectParams functions")
 	}
 
-	mmCreate.defaultExpectation.params = &UserRepositoryMockCreateParams{ctx, user}
-	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreate.expectations {
-		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
-			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+	mmConfirmEmail.defaultExpectation.params = &UserRepositoryMockConfirmEmailParams{ctx, userID, email}
+	mmConfirmEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmConfirmEmail.expectations {
+		if minimock.Equal(e.params, mmConfirmEmail.defaultExpectation.params) {
+			mmConfirmEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConfirmEmail.defaultExpectation.params)
 		}
 	}
 
-	return mmCreate
+	return mmConfirmEmail
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserRepository.Create
-func (mmCreate *mUserRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockCreate {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("UserRepositoryMock.Create mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.ConfirmEmail
+func (mmConfirmEmail *mUserRepositoryMockConfirmEmail) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockConfirmEmail {
+	if mmConfirmEmail.mock.funcConfirmEmail != nil {
+		mmConfirmEmail.mock.t.Fatalf("UserRepositoryMock.ConfirmEmail mock is already set by Set")
 	}
 
-	if mmCreate.defaultExpectation == nil {
-		mmCreate.defaultExpectation = &UserRepositoryMockCreateExpectation{}
+	if mmConfirmEmail.defaultExpectation == nil {
+		mmConfirmEmail.defaultExpectation = &UserRepositoryMockConfirmEmailExpectation{}
 	}
 
-	if mmCreate.defaultExpectation.params != nil {
-		mmCreate.mock.t.Fatalf("UserRepositoryMock.Create mock is already set by Expect")
+	if mmConfirmEmail.defaultExpectation.params != nil {
+		mmConfirmEmail.mock.t.Fatalf("UserRepositoryMock.ConfirmEmail mock is already set by Expect")
 	}
 
-	if mmCreate.defaultExpectation.paramPtrs == nil {
-		mmCreate.defaultExpectation.paramPtrs = &UserRepositoryMockCreateParamPtrs{}
+	if mmConfirmEmail.defaultExpectation.paramPtrs == nil {
+		mmConfirmEmail.defaultExpectation.paramPtrs = &UserRepositoryMockConfirmEmailParamPtrs{}
 	}
-	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmConfirmEmail.defaultExpectation.paramPtrs.ctx = &ctx
+	mmConfirmEmail.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreate
+	return mmConfirmEmail
 }
 
-// ExpectUserParam2 sets up expected param user for UserRepository.Create
-func (mmCreate *mUserRepositoryMockCreate) ExpectUserParam2(user *model.UserCreate) *mUserRepositoryMockCreate {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("UserRepositoryMock.Create mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for UserRepository.ConfirmEmail
+func (mmConfirmEmail *mUserRepositoryMockConfirmEmail) ExpectUserIDParam2(userID string) *mUserRepositoryMockConfirmEmail {
+	if mmConfirmEmail.mock.funcConfirmEmail != nil {
+		mmConfirmEmail.mock.t.Fatalf("UserRepositoryMock.ConfirmEmail mock is already set by Set")
 	}
 
-	if mmCreate.defaultExpectation == nil {
-		mmCreate.defaultExpectation = &UserRepositoryMockCreateExpectation{}
+	if mmConfirmEmail.defaultExpectation == nil {
+		mmConfirmEmail.defaultExpectation = &UserRepositoryMockConfirmEmailExpectation{}
 	}
 
-	if mmCreate.defaultExpectation.params != nil {
-		mmCreate.mock.t.Fatalf("UserRepositoryMock.Create mock is already set by Expect")
+	if mmConfirmEmail.defaultExpectation.params != nil {
+		mmConfirmEmail.mock.t.Fatalf("UserRepositoryMock.ConfirmEmail mock is already set by Expect")
 	}
 
-	if mmCreate.defaultExpectation.paramPtrs == nil {
-		mmCreate.defaultExpectation.paramPtrs = &UserRepositoryMockCreateParamPtrs{}
+	if mmConfirmEmail.defaultExpectation.paramPtrs == nil {
+		mmConfirmEmail.defaultExpectation.paramPtrs = &UserRepositoryMockConfirmEmailParamPtrs{}
 	}
-	mmCreate.defaultExpectation.paramPtrs.user = &user
-	mmCreate.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+	mmConfirmEmail.defaultExpectation.paramPtrs.userID = &userID
+	mmConfirmEmail.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmCreate
+	return mmConfirmEmail
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserRepository.Create
-func (mmCreate *mUserRepositoryMockCreate) Inspect(f func(ctx context.Context, user *model.UserCreate)) *mUserRepositoryMockCreate {
-	if mmCreate.mock.inspectFuncCreate != nil {
-		mmCreate.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.Create")
+// ExpectEmailParam3 sets up expected param email for UserRepository.ConfirmEmail
+func (mmConfirmEmail *mUserRepositoryMockConfirmEmail) ExpectEmailParam3(email string) *mUserRepositoryMockConfirmEmail {
+	if mmConfirmEmail.mock.funcConfirmEmail != nil {
+		mmConfirmEmail.mock.t.Fatalf("UserRepositoryMock.ConfirmEmail mock is already set by Set")
 	}
 
-	mmCreate.mock.inspectFuncCreate = f
+	if mmConfirmEmail.defaultExpectation == nil {
+		mmConfirmEmail.defaultExpectation = &UserRepositoryMockConfirmEmailExpectation{}
+	}
 
-	return mmCreate
+	if mmConfirmEmail.defaultExpectation.params != nil {
+		mmConfirmEmail.mock.t.Fatalf("UserRepositoryMock.ConfirmEmail mock is already set by Expect")
+	}
+
+	if mmConfirmEmail.defaultExpectation.paramPtrs == nil {
+		mmConfirmEmail.defaultExpectation.paramPtrs = &UserRepositoryMockConfirmEmailParamPtrs{}
+	}
+	mmConfirmEmail.defaultExpectation.paramPtrs.email = &email
+	mmConfirmEmail.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmConfirmEmail
 }
 
-// Return sets up results that will be returned by UserRepository.Create
-func (mmCreate *mUserRepositoryMockCreate) Return(s1 string, err error) *UserRepositoryMock {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("UserRepositoryMock.Create mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the UserRepository.ConfirmEmail
+func (mmConfirmEmail *mUserRepositoryMockConfirmEmail) Inspect(f func(ctx context.Context, userID string, email string)) *mUserRepositoryMockConfirmEmail {
+	if mmConfirmEmail.mock.inspectFuncConfirmEmail != nil {
+		mmConfirmEmail.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.ConfirmEmail")
 	}
 
-	if mmCreate.defaultExpectation == nil {
-		mmCreate.defaultExpectation = &UserRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	mmConfirmEmail.mock.inspectFuncConfirmEmail = f
+
+	return mmConfirmEmail
+}
+
+// Return sets up results that will be returned by UserRepository.ConfirmEmail
+func (mmConfirmEmail *mUserRepositoryMockConfirmEmail) Return(err error) *UserRepositoryMock {
+	if mmConfirmEmail.mock.funcConfirmEmail != nil {
+		mmConfirmEmail.mock.t.Fatalf("UserRepositoryMock.ConfirmEmail mock is already set by Set")
 	}
-	mmCreate.defaultExpectation.results = &UserRepositoryMockCreateResults{s1, err}
-	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreate.mock
+
+	if mmConfirmEmail.defaultExpectation == nil {
+		mmConfirmEmail.defaultExpectation = &UserRepositoryMockConfirmEmailExpectation{mock: mmConfirmEmail.mock}
+	}
+	mmConfirmEmail.defaultExpectation.results = &UserRepositoryMockConfirmEmailResults{err}
+	mmConfirmEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmConfirmEmail.mock
 }
 
-// Set uses given function f to mock the UserRepository.Create method
-func (mmCreate *mUserRepositoryMockCreate) Set(f func(ctx context.Context, user *model.UserCreate) (s1 string, err error)) *UserRepositoryMock {
-	if mmCreate.defaultExpectation != nil {
-		mmCreate.mock.t.Fatalf("Default expectation is already set for the UserRepository.Create method")
+// Set uses given function f to mock the UserRepository.ConfirmEmail method
+func (mmConfirmEmail *mUserRepositoryMockConfirmEmail) Set(f func(ctx context.Context, userID string, email string) (err error)) *UserRepositoryMock {
+	if mmConfirmEmail.defaultExpectation != nil {
+		mmConfirmEmail.mock.t.Fatalf("Default expectation is already set for the UserRepository.ConfirmEmail method")
 	}
 
-	if len(mmCreate.expectations) > 0 {
-		mmCreate.mock.t.Fatalf("Some expectations are already set for the UserRepository.Create method")
+	if len(mmConfirmEmail.expectations) > 0 {
+		mmConfirmEmail.mock.t.Fatalf("Some expectations are already set for the UserRepository.ConfirmEmail method")
 	}
 
-	mmCreate.mock.funcCreate = f
-	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
-	return mmCreate.mock
+	mmConfirmEmail.mock.funcConfirmEmail = f
+	mmConfirmEmail.mock.funcConfirmEmailOrigin = minimock.CallerInfo(1)
+	return mmConfirmEmail.mock
 }
 
-// When sets expectation for the UserRepository.Create which will trigger the result defined by the following
+// When sets expectation for the UserRepository.ConfirmEmail which will trigger the result defined by the following
 // Then helper
-func (mmCreate *mUserRepositoryMockCreate) When(ctx context.Context, user *model.UserCreate) *UserRepositoryMockCreateExpectation {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("UserRepositoryMock.Create mock is already set by Set")
+func (mmConfirmEmail *mUserRepositoryMockConfirmEmail) When(ctx context.Context, userID string, email string) *UserRepositoryMockConfirmEmailExpectation {
+	if mmConfirmEmail.mock.funcConfirmEmail != nil {
+		mmConfirmEmail.mock.t.Fatalf("UserRepositoryMock.ConfirmEmail mock is already set by Set")
 	}
 
-	expectation := &UserRepositoryMockCreateExpectation{
-		mock:               mmCreate.mock,
-		params:             &UserRepositoryMockCreateParams{ctx, user},
-		expectationOrigins: UserRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserRepositoryMockConfirmEmailExpectation{
+		mock:               mmConfirmEmail.mock,
+		params:             &UserRepositoryMockConfirmEmailParams{ctx, userID, email},
+		expectationOrigins: UserRepositoryMockConfirmEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	mmConfirmEmail.expectations = append(mmConfirmEmail.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserRepository.Create return parameters for the expectation previously defined by the When method
-func (e *UserRepositoryMockCreateExpectation) Then(s1 string, err error) *UserRepositoryMock {
-	e.results = &UserRepositoryMockCreateResults{s1, err}
+// Then sets up UserRepository.ConfirmEmail return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockConfirmEmailExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockConfirmEmailResults{err}
 	return e.mock
 }
 
-// Times sets number of times UserRepository.Create should be invoked
-func (mmCreate *mUserRepositoryMockCreate) Times(n uint64) *mUserRepositoryMockCreate {
+// Times sets number of times UserRepository.ConfirmEmail should be invoked
+func (mmConfirmEmail *mUserRepositoryMockConfirmEmail) Times(n uint64) *mUserRepositoryMockConfirmEmail {
 	if n == 0 {
-		mmCreate.mock.t.Fatalf("Times of UserRepositoryMock.Create mock can not be zero")
+		mmConfirmEmail.mock.t.Fatalf("Times of UserRepositoryMock.ConfirmEmail mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
-	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreate
+	mm_atomic.StoreUint64(&mmConfirmEmail.expectedInvocations, n)
+	mmConfirmEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmConfirmEmail
 }
 
-func (mmCreate *mUserRepositoryMockCreate) invocationsDone() bool {
-	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+func (mmConfirmEmail *mUserRepositoryMockConfirmEmail) invocationsDone() bool {
+	if len(mmConfirmEmail.expectations) == 0 && mmConfirmEmail.defaultExpectation == nil && mmConfirmEmail.mock.funcConfirmEmail == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmConfirmEmail.mock.afterConfirmEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmConfirmEmail.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Create implements mm_repository.UserRepository
-func (mmCreate *UserRepositoryMock) Create(ctx context.Context, user *model.UserCreate) (s1 string, err error) {
-	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+// ConfirmEmail implements mm_repository.UserRepository
+func (mmConfirmEmail *UserRepositoryMock) ConfirmEmail(ctx context.Context, userID string, email string) (err error) {
+	mm_atomic.AddUint64(&mmConfirmEmail.beforeConfirmEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmConfirmEmail.afterConfirmEmailCounter, 1)
 
-	mmCreate.t.Helper()
+	mmConfirmEmail.t.Helper()
 
-	if mmCreate.inspectFuncCreate != nil {
-		mmCreate.inspectFuncCreate(ctx, user)
+	if mmConfirmEmail.inspectFuncConfirmEmail != nil {
+		mmConfirmEmail.inspectFuncConfirmEmail(ctx, userID, email)
 	}
 
-	mm_params := UserRepositoryMockCreateParams{ctx, user}
+	mm_params := UserRepositoryMockConfirmEmailParams{ctx, userID, email}
 
 	// Record call args
-	mmCreate.CreateMock.mutex.Lock()
-	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
-	mmCreate.CreateMock.mutex.Unlock()
+	mmConfirmEmail.ConfirmEmailMock.mutex.Lock()
+	mmConfirmEmail.ConfirmEmailMock.callArgs = append(mmConfirmEmail.ConfirmEmailMock.callArgs, &mm_params)
+	mmConfirmEmail.ConfirmEmailMock.mutex.Unlock()
 
-	for _, e := range mmCreate.CreateMock.expectations {
+	for _, e := range mmConfirmEmail.ConfirmEmailMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.s1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmCreate.CreateMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreate.CreateMock.defaultExpectation.params
-		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+	if mmConfirmEmail.ConfirmEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConfirmEmail.ConfirmEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmConfirmEmail.ConfirmEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmConfirmEmail.ConfirmEmailMock.defaultExpectation.paramPtrs
 
-		mm_got := UserRepositoryMockCreateParams{ctx, user}
+		mm_got := UserRepositoryMockConfirmEmailParams{ctx, userID, email}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreate.t.Errorf("UserRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmConfirmEmail.t.Errorf("UserRepositoryMock.ConfirmEmail got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConfirmEmail.ConfirmEmailMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
-				mmCreate.t.Errorf("UserRepositoryMock.Create got unexpected parameter user, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originUser, *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmConfirmEmail.t.Errorf("UserRepositoryMock.ConfirmEmail got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConfirmEmail.ConfirmEmailMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmConfirmEmail.t.Errorf("UserRepositoryMock.ConfirmEmail got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConfirmEmail.ConfirmEmailMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreate.t.Errorf("UserRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmConfirmEmail.t.Errorf("UserRepositoryMock.ConfirmEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmConfirmEmail.ConfirmEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		mm_results := mmConfirmEmail.ConfirmEmailMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreate.t.Fatal("No results are set for the UserRepositoryMock.Create")
+			mmConfirmEmail.t.Fatal("No results are set for the UserRepositoryMock.ConfirmEmail")
 		}
-		return (*mm_results).s1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmCreate.funcCreate != nil {
-		return mmCreate.funcCreate(ctx, user)
+	if mmConfirmEmail.funcConfirmEmail != nil {
+		return mmConfirmEmail.funcConfirmEmail(ctx, userID, email)
 	}
-	mmCreate.t.Fatalf("Unexpected call to UserRepositoryMock.Create. %v %v", ctx, user)
+	mmConfirmEmail.t.Fatalf("Unexpected call to UserRepositoryMock.ConfirmEmail. %v %v %v", ctx, userID, email)
 	return
 }
 
-// CreateAfterCounter returns a count of finished UserRepositoryMock.Create invocations
-func (mmCreate *UserRepositoryMock) CreateAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+// ConfirmEmailAfterCounter returns a count of finished UserRepositoryMock.ConfirmEmail invocations
+func (mmConfirmEmail *UserRepositoryMock) ConfirmEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConfirmEmail.afterConfirmEmailCounter)
 }
 
-// CreateBeforeCounter returns a count of UserRepositoryMock.Create invocations
-func (mmCreate *UserRepositoryMock) CreateBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+// ConfirmEmailBeforeCounter returns a count of UserRepositoryMock.ConfirmEmail invocations
+func (mmConfirmEmail *UserRepositoryMock) ConfirmEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConfirmEmail.beforeConfirmEmailCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserRepositoryMock.Create.
+// Calls returns a list of arguments used in each call to UserRepositoryMock.ConfirmEmail.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreate *mUserRepositoryMockCreate) Calls() []*UserRepositoryMockCreateParams {
-	mmCreate.mutex.RLock()
+func (mmConfirmEmail *mUserRepositoryMockConfirmEmail) Calls() []*UserRepositoryMockConfirmEmailParams {
+	mmConfirmEmail.mutex.RLock()
 
-	argCopy := make([]*UserRepositoryMockCreateParams, len(mmCreate.callArgs))
-	copy(argCopy, mmCreate.callArgs)
+	argCopy := make([]*UserRepositoryMockConfirmEmailParams, len(mmConfirmEmail.callArgs))
+	copy(argCopy, mmConfirmEmail.callArgs)
 
-	mmCreate.mutex.RUnlock()
+	mmConfirmEmail.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// MinimockConfirmEmailDone returns true if the count of the ConfirmEmail invocations corresponds
 // the number of defined expectations
-func (m *UserRepositoryMock) MinimockCreateDone() bool {
-	if m.CreateMock.optional {
+func (m *UserRepositoryMock) MinimockConfirmEmailDone() bool {
+	if m.ConfirmEmailMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateMock.expectations {
+	for _, e := range m.ConfirmEmailMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateMock.invocationsDone()
+	return m.ConfirmEmailMock.invocationsDone()
 }
 
-// MinimockCreateInspect logs each unmet expectation
-func (m *UserRepositoryMock) MinimockCreateInspect() {
-	for _, e := range m.CreateMock.expectations {
+// MinimockConfirmEmailInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockConfirmEmailInspect() {
+	for _, e := range m.ConfirmEmailMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.ConfirmEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	afterConfirmEmailCounter := mm_atomic.LoadUint64(&m.afterConfirmEmailCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
-		if m.CreateMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+	if m.ConfirmEmailMock.defaultExpectation != nil && afterConfirmEmailCounter < 1 {
+		if m.ConfirmEmailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.ConfirmEmail at\n%s", m.ConfirmEmailMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.ConfirmEmail at\n%s with params: %#v", m.ConfirmEmailMock.defaultExpectation.expectationOrigins.origin, *m.ConfirmEmailMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreate != nil && afterCreateCounter < 1 {
-		m.t.Errorf("Expected call to UserRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	if m.funcConfirmEmail != nil && afterConfirmEmailCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.ConfirmEmail at\n%s", m.funcConfirmEmailOrigin)
 	}
 
-	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserRepositoryMock.Create at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	if !m.ConfirmEmailMock.invocationsDone() && afterConfirmEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.ConfirmEmail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ConfirmEmailMock.expectedInvocations), m.ConfirmEmailMock.expectedInvocationsOrigin, afterConfirmEmailCounter)
 	}
 }
 
-type mUserRepositoryMockDelete struct {
+type mUserRepositoryMockCount struct {
 	optional           bool
 	mock               *UserRepositoryMock
-	defaultExpectation *UserRepositoryMockDeleteExpectation
-	expectations       []*UserRepositoryMockDeleteExpectation
+	defaultExpectation *UserRepositoryMockCountExpectation
+	expectations       []*UserRepositoryMockCountExpectation
 
-	callArgs []*UserRepositoryMockDeleteParams
+	callArgs []*UserRepositoryMockCountParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserRepositoryMockDeleteExpectation specifies expectation struct of the UserRepository.Delete
-type UserRepositoryMockDeleteExpectation struct {
+// UserRepositoryMockCountExpectation specifies expectation struct of the UserRepository.Count
+type UserRepositoryMockCountExpectation struct {
 	mock               *UserRepositoryMock
-	params             *UserRepositoryMockDeleteParams
-	paramPtrs          *UserRepositoryMockDeleteParamPtrs
-	expectationOrigins UserRepositoryMockDeleteExpectationOrigins
-	results            *UserRepositoryMockDeleteResults
+	params             *UserRepositoryMockCountParams
+	paramPtrs          *UserRepositoryMockCountParamPtrs
+	expectationOrigins UserRepositoryMockCountExpectationOrigins
+	results            *UserRepositoryMockCountResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserRepositoryMockDeleteParams contains parameters of the UserRepository.Delete
-type UserRepositoryMockDeleteParams struct {
-	ctx context.Context
-	id  string
+// UserRepositoryMockCountParams contains parameters of the UserRepository.Count
+type UserRepositoryMockCountParams struct {
+	ctx    context.Context
+	filter *model.UserFilter
 }
 
-// UserRepositoryMockDeleteParamPtrs contains pointers to parameters of the UserRepository.Delete
-type UserRepositoryMockDeleteParamPtrs struct {
-	ctx *context.Context
-	id  *string
+// UserRepositoryMockCountParamPtrs contains pointers to parameters of the UserRepository.Count
+type UserRepositoryMockCountParamPtrs struct {
+	ctx    *context.Context
+	filter **model.UserFilter
 }
 
-// UserRepositoryMockDeleteResults contains results of the UserRepository.Delete
-type UserRepositoryMockDeleteResults struct {
+// UserRepositoryMockCountResults contains results of the UserRepository.Count
+type UserRepositoryMockCountResults struct {
+	i1  int64
 	err error
 }
 
-// UserRepositoryMockDeleteOrigins contains origins of expectations of the UserRepository.Delete
-type UserRepositoryMockDeleteExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originId  string
+// UserRepositoryMockCountOrigins contains origins of expectations of the UserRepository.Count
+type UserRepositoryMockCountExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -497,342 +609,342 @@ type UserRepositoryMockDeleteExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDelete *mUserRepositoryMockDelete) Optional() *mUserRepositoryMockDelete {
-	mmDelete.optional = true
-	return mmDelete
+func (mmCount *mUserRepositoryMockCount) Optional() *mUserRepositoryMockCount {
+	mmCount.optional = true
+	return mmCount
 }
 
-// Expect sets up expected params for UserRepository.Delete
-func (mmDelete *mUserRepositoryMockDelete) Expect(ctx context.Context, id string) *mUserRepositoryMockDelete {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("UserRepositoryMock.Delete mock is already set by Set")
+// Expect sets up expected params for UserRepository.Count
+func (mmCount *mUserRepositoryMockCount) Expect(ctx context.Context, filter *model.UserFilter) *mUserRepositoryMockCount {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &UserRepositoryMockDeleteExpectation{}
+	if mmCount.defaultExpectation == nil {
+		mmCount.defaultExpectation = &UserRepositoryMockCountExpectation{}
 	}
 
-	if mmDelete.defaultExpectation.paramPtrs != nil {
-		mmDelete.mock.t.Fatalf("UserRepositoryMock.Delete mock is already set by ExpectParams functions")
+	if mmCount.defaultExpectation.paramPtrs != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by ExpectParams functions")
 	}
 
-	mmDelete.defaultExpectation.params = &UserRepositoryMockDeleteParams{ctx, id}
-	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDelete.expectations {
-		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
-			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+	mmCount.defaultExpectation.params = &UserRepositoryMockCountParams{ctx, filter}
+	mmCount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCount.expectations {
+		if minimock.Equal(e.params, mmCount.defaultExpectation.params) {
+			mmCount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCount.defaultExpectation.params)
 		}
 	}
 
-	return mmDelete
+	return mmCount
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserRepository.Delete
-func (mmDelete *mUserRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockDelete {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("UserRepositoryMock.Delete mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.Count
+func (mmCount *mUserRepositoryMockCount) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockCount {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &UserRepositoryMockDeleteExpectation{}
+	if mmCount.defaultExpectation == nil {
+		mmCount.defaultExpectation = &UserRepositoryMockCountExpectation{}
 	}
 
-	if mmDelete.defaultExpectation.params != nil {
-		mmDelete.mock.t.Fatalf("UserRepositoryMock.Delete mock is already set by Expect")
+	if mmCount.defaultExpectation.params != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by Expect")
 	}
 
-	if mmDelete.defaultExpectation.paramPtrs == nil {
-		mmDelete.defaultExpectation.paramPtrs = &UserRepositoryMockDeleteParamPtrs{}
+	if mmCount.defaultExpectation.paramPtrs == nil {
+		mmCount.defaultExpectation.paramPtrs = &UserRepositoryMockCountParamPtrs{}
 	}
-	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmCount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDelete
+	return mmCount
 }
 
-// ExpectIdParam2 sets up expected param id for UserRepository.Delete
-func (mmDelete *mUserRepositoryMockDelete) ExpectIdParam2(id string) *mUserRepositoryMockDelete {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("UserRepositoryMock.Delete mock is already set by Set")
+// ExpectFilterParam2 sets up expected param filter for UserRepository.Count
+func (mmCount *mUserRepositoryMockCount) ExpectFilterParam2(filter *model.UserFilter) *mUserRepositoryMockCount {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &UserRepositoryMockDeleteExpectation{}
+	if mmCount.defaultExpectation == nil {
+		mmCount.defaultExpectation = &UserRepositoryMockCountExpectation{}
 	}
 
-	if mmDelete.defaultExpectation.params != nil {
-		mmDelete.mock.t.Fatalf("UserRepositoryMock.Delete mock is already set by Expect")
+	if mmCount.defaultExpectation.params != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by Expect")
 	}
 
-	if mmDelete.defaultExpectation.paramPtrs == nil {
-		mmDelete.defaultExpectation.paramPtrs = &UserRepositoryMockDeleteParamPtrs{}
+	if mmCount.defaultExpectation.paramPtrs == nil {
+		mmCount.defaultExpectation.paramPtrs = &UserRepositoryMockCountParamPtrs{}
 	}
-	mmDelete.defaultExpectation.paramPtrs.id = &id
-	mmDelete.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmCount.defaultExpectation.paramPtrs.filter = &filter
+	mmCount.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
 
-	return mmDelete
+	return mmCount
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserRepository.Delete
-func (mmDelete *mUserRepositoryMockDelete) Inspect(f func(ctx context.Context, id string)) *mUserRepositoryMockDelete {
-	if mmDelete.mock.inspectFuncDelete != nil {
-		mmDelete.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.Delete")
+// Inspect accepts an inspector function that has same arguments as the UserRepository.Count
+func (mmCount *mUserRepositoryMockCount) Inspect(f func(ctx context.Context, filter *model.UserFilter)) *mUserRepositoryMockCount {
+	if mmCount.mock.inspectFuncCount != nil {
+		mmCount.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.Count")
 	}
 
-	mmDelete.mock.inspectFuncDelete = f
+	mmCount.mock.inspectFuncCount = f
 
-	return mmDelete
+	return mmCount
 }
 
-// Return sets up results that will be returned by UserRepository.Delete
-func (mmDelete *mUserRepositoryMockDelete) Return(err error) *UserRepositoryMock {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("UserRepositoryMock.Delete mock is already set by Set")
+// Return sets up results that will be returned by UserRepository.Count
+func (mmCount *mUserRepositoryMockCount) Return(i1 int64, err error) *UserRepositoryMock {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &UserRepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	if mmCount.defaultExpectation == nil {
+		mmCount.defaultExpectation = &UserRepositoryMockCountExpectation{mock: mmCount.mock}
 	}
-	mmDelete.defaultExpectation.results = &UserRepositoryMockDeleteResults{err}
-	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDelete.mock
+	mmCount.defaultExpectation.results = &UserRepositoryMockCountResults{i1, err}
+	mmCount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCount.mock
 }
 
-// Set uses given function f to mock the UserRepository.Delete method
-func (mmDelete *mUserRepositoryMockDelete) Set(f func(ctx context.Context, id string) (err error)) *UserRepositoryMock {
-	if mmDelete.defaultExpectation != nil {
-		mmDelete.mock.t.Fatalf("Default expectation is already set for the UserRepository.Delete method")
+// Set uses given function f to mock the UserRepository.Count method
+func (mmCount *mUserRepositoryMockCount) Set(f func(ctx context.Context, filter *model.UserFilter) (i1 int64, err error)) *UserRepositoryMock {
+	if mmCount.defaultExpectation != nil {
+		mmCount.mock.t.Fatalf("Default expectation is already set for the UserRepository.Count method")
 	}
 
-	if len(mmDelete.expectations) > 0 {
-		mmDelete.mock.t.Fatalf("Some expectations are already set for the UserRepository.Delete method")
+	if len(mmCount.expectations) > 0 {
+		mmCount.mock.t.Fatalf("Some expectations are already set for the UserRepository.Count method")
 	}
 
-	mmDelete.mock.funcDelete = f
-	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
-	return mmDelete.mock
+	mmCount.mock.funcCount = f
+	mmCount.mock.funcCountOrigin = minimock.CallerInfo(1)
+	return mmCount.mock
 }
 
-// When sets expectation for the UserRepository.Delete which will trigger the result defined by the following
+// When sets expectation for the UserRepository.Count which will trigger the result defined by the following
 // Then helper
-func (mmDelete *mUserRepositoryMockDelete) When(ctx context.Context, id string) *UserRepositoryMockDeleteExpectation {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("UserRepositoryMock.Delete mock is already set by Set")
+func (mmCount *mUserRepositoryMockCount) When(ctx context.Context, filter *model.UserFilter) *UserRepositoryMockCountExpectation {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by Set")
 	}
 
-	expectation := &UserRepositoryMockDeleteExpectation{
-		mock:               mmDelete.mock,
-		params:             &UserRepositoryMockDeleteParams{ctx, id},
-		expectationOrigins: UserRepositoryMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserRepositoryMockCountExpectation{
+		mock:               mmCount.mock,
+		params:             &UserRepositoryMockCountParams{ctx, filter},
+		expectationOrigins: UserRepositoryMockCountExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	mmCount.expectations = append(mmCount.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserRepository.Delete return parameters for the expectation previously defined by the When method
-func (e *UserRepositoryMockDeleteExpectation) Then(err error) *UserRepositoryMock {
-	e.results = &UserRepositoryMockDeleteResults{err}
+// Then sets up UserRepository.Count return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockCountExpectation) Then(i1 int64, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockCountResults{i1, err}
 	return e.mock
 }
 
-// Times sets number of times UserRepository.Delete should be invoked
-func (mmDelete *mUserRepositoryMockDelete) Times(n uint64) *mUserRepositoryMockDelete {
+// Times sets number of times UserRepository.Count should be invoked
+func (mmCount *mUserRepositoryMockCount) Times(n uint64) *mUserRepositoryMockCount {
 	if n == 0 {
-		mmDelete.mock.t.Fatalf("Times of UserRepositoryMock.Delete mock can not be zero")
+		mmCount.mock.t.Fatalf("Times of UserRepositoryMock.Count mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
-	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDelete
+	mm_atomic.StoreUint64(&mmCount.expectedInvocations, n)
+	mmCount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCount
 }
 
-func (mmDelete *mUserRepositoryMockDelete) invocationsDone() bool {
-	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+func (mmCount *mUserRepositoryMockCount) invocationsDone() bool {
+	if len(mmCount.expectations) == 0 && mmCount.defaultExpectation == nil && mmCount.mock.funcCount == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmCount.mock.afterCountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCount.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Delete implements mm_repository.UserRepository
-func (mmDelete *UserRepositoryMock) Delete(ctx context.Context, id string) (err error) {
-	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
-	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+// Count implements mm_repository.UserRepository
+func (mmCount *UserRepositoryMock) Count(ctx context.Context, filter *model.UserFilter) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCount.beforeCountCounter, 1)
+	defer mm_atomic.AddUint64(&mmCount.afterCountCounter, 1)
 
-	mmDelete.t.Helper()
+	mmCount.t.Helper()
 
-	if mmDelete.inspectFuncDelete != nil {
-		mmDelete.inspectFuncDelete(ctx, id)
+	if mmCount.inspectFuncCount != nil {
+		mmCount.inspectFuncCount(ctx, filter)
 	}
 
-	mm_params := UserRepositoryMockDeleteParams{ctx, id}
+	mm_params := UserRepositoryMockCountParams{ctx, filter}
 
 	// Record call args
-	mmDelete.DeleteMock.mutex.Lock()
-	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
-	mmDelete.DeleteMock.mutex.Unlock()
+	mmCount.CountMock.mutex.Lock()
+	mmCount.CountMock.callArgs = append(mmCount.CountMock.callArgs, &mm_params)
+	mmCount.CountMock.mutex.Unlock()
 
-	for _, e := range mmDelete.DeleteMock.expectations {
+	for _, e := range mmCount.CountMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.i1, e.results.err
 		}
 	}
 
-	if mmDelete.DeleteMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
-		mm_want := mmDelete.DeleteMock.defaultExpectation.params
-		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+	if mmCount.CountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCount.CountMock.defaultExpectation.Counter, 1)
+		mm_want := mmCount.CountMock.defaultExpectation.params
+		mm_want_ptrs := mmCount.CountMock.defaultExpectation.paramPtrs
 
-		mm_got := UserRepositoryMockDeleteParams{ctx, id}
+		mm_got := UserRepositoryMockCountParams{ctx, filter}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDelete.t.Errorf("UserRepositoryMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmCount.t.Errorf("UserRepositoryMock.Count got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCount.CountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmDelete.t.Errorf("UserRepositoryMock.Delete got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmCount.t.Errorf("UserRepositoryMock.Count got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCount.CountMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDelete.t.Errorf("UserRepositoryMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmCount.t.Errorf("UserRepositoryMock.Count got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCount.CountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		mm_results := mmCount.CountMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDelete.t.Fatal("No results are set for the UserRepositoryMock.Delete")
+			mmCount.t.Fatal("No results are set for the UserRepositoryMock.Count")
 		}
-		return (*mm_results).err
+		return (*mm_results).i1, (*mm_results).err
 	}
-	if mmDelete.funcDelete != nil {
-		return mmDelete.funcDelete(ctx, id)
+	if mmCount.funcCount != nil {
+		return mmCount.funcCount(ctx, filter)
 	}
-	mmDelete.t.Fatalf("Unexpected call to UserRepositoryMock.Delete. %v %v", ctx, id)
+	mmCount.t.Fatalf("Unexpected call to UserRepositoryMock.Count. %v %v", ctx, filter)
 	return
 }
 
-// DeleteAfterCounter returns a count of finished UserRepositoryMock.Delete invocations
-func (mmDelete *UserRepositoryMock) DeleteAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+// CountAfterCounter returns a count of finished UserRepositoryMock.Count invocations
+func (mmCount *UserRepositoryMock) CountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCount.afterCountCounter)
 }
 
-// DeleteBeforeCounter returns a count of UserRepositoryMock.Delete invocations
-func (mmDelete *UserRepositoryMock) DeleteBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+// CountBeforeCounter returns a count of UserRepositoryMock.Count invocations
+func (mmCount *UserRepositoryMock) CountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCount.beforeCountCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserRepositoryMock.Delete.
+// Calls returns a list of arguments used in each call to UserRepositoryMock.Count.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDelete *mUserRepositoryMockDelete) Calls() []*UserRepositoryMockDeleteParams {
-	mmDelete.mutex.RLock()
+func (mmCount *mUserRepositoryMockCount) Calls() []*UserRepositoryMockCountParams {
+	mmCount.mutex.RLock()
 
-	argCopy := make([]*UserRepositoryMockDeleteParams, len(mmDelete.callArgs))
-	copy(argCopy, mmDelete.callArgs)
+	argCopy := make([]*UserRepositoryMockCountParams, len(mmCount.callArgs))
+	copy(argCopy, mmCount.callArgs)
 
-	mmDelete.mutex.RUnlock()
+	mmCount.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// MinimockCountDone returns true if the count of the Count invocations corresponds
 // the number of defined expectations
-func (m *UserRepositoryMock) MinimockDeleteDone() bool {
-	if m.DeleteMock.optional {
+func (m *UserRepositoryMock) MinimockCountDone() bool {
+	if m.CountMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteMock.expectations {
+	for _, e := range m.CountMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteMock.invocationsDone()
+	return m.CountMock.invocationsDone()
 }
 
-// MinimockDeleteInspect logs each unmet expectation
-func (m *UserRepositoryMock) MinimockDeleteInspect() {
-	for _, e := range m.DeleteMock.expectations {
+// MinimockCountInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockCountInspect() {
+	for _, e := range m.CountMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserRepositoryMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.Count at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	afterCountCounter := mm_atomic.LoadUint64(&m.afterCountCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
-		if m.DeleteMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserRepositoryMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+	if m.CountMock.defaultExpectation != nil && afterCountCounter < 1 {
+		if m.CountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.Count at\n%s", m.CountMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserRepositoryMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.Count at\n%s with params: %#v", m.CountMock.defaultExpectation.expectationOrigins.origin, *m.CountMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDelete != nil && afterDeleteCounter < 1 {
-		m.t.Errorf("Expected call to UserRepositoryMock.Delete at\n%s", m.funcDeleteOrigin)
+	if m.funcCount != nil && afterCountCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.Count at\n%s", m.funcCountOrigin)
 	}
 
-	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserRepositoryMock.Delete at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	if !m.CountMock.invocationsDone() && afterCountCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.Count at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountMock.expectedInvocations), m.CountMock.expectedInvocationsOrigin, afterCountCounter)
 	}
 }
 
-type mUserRepositoryMockFindByName struct {
+type mUserRepositoryMockCreate struct {
 	optional           bool
 	mock               *UserRepositoryMock
-	defaultExpectation *UserRepositoryMockFindByNameExpectation
-	expectations       []*UserRepositoryMockFindByNameExpectation
+	defaultExpectation *UserRepositoryMockCreateExpectation
+	expectations       []*UserRepositoryMockCreateExpectation
 
-	callArgs []*UserRepositoryMockFindByNameParams
+	callArgs []*UserRepositoryMockCreateParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserRepositoryMockFindByNameExpectation specifies expectation struct of the UserRepository.FindByName
-type UserRepositoryMockFindByNameExpectation struct {
+// UserRepositoryMockCreateExpectation specifies expectation struct of the UserRepository.Create
+type UserRepositoryMockCreateExpectation struct {
 	mock               *UserRepositoryMock
-	params             *UserRepositoryMockFindByNameParams
-	paramPtrs          *UserRepositoryMockFindByNameParamPtrs
-	expectationOrigins UserRepositoryMockFindByNameExpectationOrigins
-	results            *UserRepositoryMockFindByNameResults
+	params             *UserRepositoryMockCreateParams
+	paramPtrs          *UserRepositoryMockCreateParamPtrs
+	expectationOrigins UserRepositoryMockCreateExpectationOrigins
+	results            *UserRepositoryMockCreateResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserRepositoryMockFindByNameParams contains parameters of the UserRepository.FindByName
-type UserRepositoryMockFindByNameParams struct {
+// UserRepositoryMockCreateParams contains parameters of the UserRepository.Create
+type UserRepositoryMockCreateParams struct {
 	ctx  context.Context
-	name string
+	user *model.UserCreate
 }
 
-// UserRepositoryMockFindByNameParamPtrs contains pointers to parameters of the UserRepository.FindByName
-type UserRepositoryMockFindByNameParamPtrs struct {
+// UserRepositoryMockCreateParamPtrs contains pointers to parameters of the UserRepository.Create
+type UserRepositoryMockCreateParamPtrs struct {
 	ctx  *context.Context
-	name *string
+	user **model.UserCreate
 }
 
-// UserRepositoryMockFindByNameResults contains results of the UserRepository.FindByName
-type UserRepositoryMockFindByNameResults struct {
-	up1 *model.User
+// UserRepositoryMockCreateResults contains results of the UserRepository.Create
+type UserRepositoryMockCreateResults struct {
+	s1  string
 	err error
 }
 
-// UserRepositoryMockFindByNameOrigins contains origins of expectations of the UserRepository.FindByName
-type UserRepositoryMockFindByNameExpectationOrigins struct {
+// UserRepositoryMockCreateOrigins contains origins of expectations of the UserRepository.Create
+type UserRepositoryMockCreateExpectationOrigins struct {
 	origin     string
 	originCtx  string
-	originName string
+	originUser string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -840,342 +952,3088 @@ type UserRepositoryMockFindByNameExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmFindByName *mUserRepositoryMockFindByName) Optional() *mUserRepositoryMockFindByName {
-	mmFindByName.optional = true
-	return mmFindByName
+func (mmCreate *mUserRepositoryMockCreate) Optional() *mUserRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
 }
 
-// Expect sets up expected params for UserRepository.FindByName
-func (mmFindByName *mUserRepositoryMockFindByName) Expect(ctx context.Context, name string) *mUserRepositoryMockFindByName {
-	if mmFindByName.mock.funcFindByName != nil {
-		mmFindByName.mock.t.Fatalf("UserRepositoryMock.FindByName mock is already set by Set")
+// Expect sets up expected params for UserRepository.Create
+func (mmCreate *mUserRepositoryMockCreate) Expect(ctx context.Context, user *model.UserCreate) *mUserRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("UserRepositoryMock.Create mock is already set by Set")
 	}
 
-	if mmFindByName.defaultExpectation == nil {
-		mmFindByName.defaultExpectation = &UserRepositoryMockFindByNameExpectation{}
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &UserRepositoryMockCreateExpectation{}
 	}
 
-	if mmFindByName.defaultExpectation.paramPtrs != nil {
-		mmFindByName.mock.t.Fatalf("UserRepositoryMock.FindByName mock is already set by ExpectParams functions")
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("UserRepositoryMock.Create mock is already set by ExpectParams functions")
 	}
 
-	mmFindByName.defaultExpectation.params = &UserRepositoryMockFindByNameParams{ctx, name}
-	mmFindByName.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmFindByName.expectations {
-		if minimock.Equal(e.params, mmFindByName.defaultExpectation.params) {
-			mmFindByName.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindByName.defaultExpectation.params)
+	mmCreate.defaultExpectation.params = &UserRepositoryMockCreateParams{ctx, user}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
 		}
 	}
 
-	return mmFindByName
+	return mmCreate
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserRepository.FindByName
-func (mmFindByName *mUserRepositoryMockFindByName) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockFindByName {
-	if mmFindByName.mock.funcFindByName != nil {
-		mmFindByName.mock.t.Fatalf("UserRepositoryMock.FindByName mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.Create
+func (mmCreate *mUserRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("UserRepositoryMock.Create mock is already set by Set")
 	}
 
-	if mmFindByName.defaultExpectation == nil {
-		mmFindByName.defaultExpectation = &UserRepositoryMockFindByNameExpectation{}
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &UserRepositoryMockCreateExpectation{}
 	}
 
-	if mmFindByName.defaultExpectation.params != nil {
-		mmFindByName.mock.t.Fatalf("UserRepositoryMock.FindByName mock is already set by Expect")
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("UserRepositoryMock.Create mock is already set by Expect")
 	}
 
-	if mmFindByName.defaultExpectation.paramPtrs == nil {
-		mmFindByName.defaultExpectation.paramPtrs = &UserRepositoryMockFindByNameParamPtrs{}
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &UserRepositoryMockCreateParamPtrs{}
 	}
-	mmFindByName.defaultExpectation.paramPtrs.ctx = &ctx
-	mmFindByName.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectUserParam2 sets up expected param user for UserRepository.Create
+func (mmCreate *mUserRepositoryMockCreate) ExpectUserParam2(user *model.UserCreate) *mUserRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("UserRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &UserRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("UserRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &UserRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.user = &user
+	mmCreate.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.Create
+func (mmCreate *mUserRepositoryMockCreate) Inspect(f func(ctx context.Context, user *model.UserCreate)) *mUserRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by UserRepository.Create
+func (mmCreate *mUserRepositoryMockCreate) Return(s1 string, err error) *UserRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("UserRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &UserRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &UserRepositoryMockCreateResults{s1, err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the UserRepository.Create method
+func (mmCreate *mUserRepositoryMockCreate) Set(f func(ctx context.Context, user *model.UserCreate) (s1 string, err error)) *UserRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the UserRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the UserRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the UserRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mUserRepositoryMockCreate) When(ctx context.Context, user *model.UserCreate) *UserRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("UserRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &UserRepositoryMockCreateParams{ctx, user},
+		expectationOrigins: UserRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.Create return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockCreateExpectation) Then(s1 string, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockCreateResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.Create should be invoked
+func (mmCreate *mUserRepositoryMockCreate) Times(n uint64) *mUserRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of UserRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mUserRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_repository.UserRepository
+func (mmCreate *UserRepositoryMock) Create(ctx context.Context, user *model.UserCreate) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, user)
+	}
+
+	mm_params := UserRepositoryMockCreateParams{ctx, user}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockCreateParams{ctx, user}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("UserRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
+				mmCreate.t.Errorf("UserRepositoryMock.Create got unexpected parameter user, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originUser, *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("UserRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the UserRepositoryMock.Create")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, user)
+	}
+	mmCreate.t.Fatalf("Unexpected call to UserRepositoryMock.Create. %v %v", ctx, user)
+	return
+}
+
+// CreateAfterCounter returns a count of finished UserRepositoryMock.Create invocations
+func (mmCreate *UserRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of UserRepositoryMock.Create invocations
+func (mmCreate *UserRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mUserRepositoryMockCreate) Calls() []*UserRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mUserRepositoryMockDelete struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockDeleteExpectation
+	expectations       []*UserRepositoryMockDeleteExpectation
+
+	callArgs []*UserRepositoryMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockDeleteExpectation specifies expectation struct of the UserRepository.Delete
+type UserRepositoryMockDeleteExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockDeleteParams
+	paramPtrs          *UserRepositoryMockDeleteParamPtrs
+	expectationOrigins UserRepositoryMockDeleteExpectationOrigins
+	results            *UserRepositoryMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockDeleteParams contains parameters of the UserRepository.Delete
+type UserRepositoryMockDeleteParams struct {
+	ctx context.Context
+	id  string
+}
+
+// UserRepositoryMockDeleteParamPtrs contains pointers to parameters of the UserRepository.Delete
+type UserRepositoryMockDeleteParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// UserRepositoryMockDeleteResults contains results of the UserRepository.Delete
+type UserRepositoryMockDeleteResults struct {
+	err error
+}
+
+// UserRepositoryMockDeleteOrigins contains origins of expectations of the UserRepository.Delete
+type UserRepositoryMockDeleteExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mUserRepositoryMockDelete) Optional() *mUserRepositoryMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for UserRepository.Delete
+func (mmDelete *mUserRepositoryMockDelete) Expect(ctx context.Context, id string) *mUserRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("UserRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &UserRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("UserRepositoryMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &UserRepositoryMockDeleteParams{ctx, id}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.Delete
+func (mmDelete *mUserRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("UserRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &UserRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("UserRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &UserRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.Delete
+func (mmDelete *mUserRepositoryMockDelete) ExpectIdParam2(id string) *mUserRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("UserRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &UserRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("UserRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &UserRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.id = &id
+	mmDelete.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.Delete
+func (mmDelete *mUserRepositoryMockDelete) Inspect(f func(ctx context.Context, id string)) *mUserRepositoryMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by UserRepository.Delete
+func (mmDelete *mUserRepositoryMockDelete) Return(err error) *UserRepositoryMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("UserRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &UserRepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &UserRepositoryMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the UserRepository.Delete method
+func (mmDelete *mUserRepositoryMockDelete) Set(f func(ctx context.Context, id string) (err error)) *UserRepositoryMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the UserRepository.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the UserRepository.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the UserRepository.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mUserRepositoryMockDelete) When(ctx context.Context, id string) *UserRepositoryMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("UserRepositoryMock.Delete mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &UserRepositoryMockDeleteParams{ctx, id},
+		expectationOrigins: UserRepositoryMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.Delete return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockDeleteExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.Delete should be invoked
+func (mmDelete *mUserRepositoryMockDelete) Times(n uint64) *mUserRepositoryMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of UserRepositoryMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mUserRepositoryMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_repository.UserRepository
+func (mmDelete *UserRepositoryMock) Delete(ctx context.Context, id string) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, id)
+	}
+
+	mm_params := UserRepositoryMockDeleteParams{ctx, id}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockDeleteParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("UserRepositoryMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDelete.t.Errorf("UserRepositoryMock.Delete got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("UserRepositoryMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the UserRepositoryMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, id)
+	}
+	mmDelete.t.Fatalf("Unexpected call to UserRepositoryMock.Delete. %v %v", ctx, id)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished UserRepositoryMock.Delete invocations
+func (mmDelete *UserRepositoryMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of UserRepositoryMock.Delete invocations
+func (mmDelete *UserRepositoryMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mUserRepositoryMockDelete) Calls() []*UserRepositoryMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mUserRepositoryMockFindByEmail struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockFindByEmailExpectation
+	expectations       []*UserRepositoryMockFindByEmailExpectation
+
+	callArgs []*UserRepositoryMockFindByEmailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockFindByEmailExpectation specifies expectation struct of the UserRepository.FindByEmail
+type UserRepositoryMockFindByEmailExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockFindByEmailParams
+	paramPtrs          *UserRepositoryMockFindByEmailParamPtrs
+	expectationOrigins UserRepositoryMockFindByEmailExpectationOrigins
+	results            *UserRepositoryMockFindByEmailResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockFindByEmailParams contains parameters of the UserRepository.FindByEmail
+type UserRepositoryMockFindByEmailParams struct {
+	ctx   context.Context
+	email string
+}
+
+// UserRepositoryMockFindByEmailParamPtrs contains pointers to parameters of the UserRepository.FindByEmail
+type UserRepositoryMockFindByEmailParamPtrs struct {
+	ctx   *context.Context
+	email *string
+}
+
+// UserRepositoryMockFindByEmailResults contains results of the UserRepository.FindByEmail
+type UserRepositoryMockFindByEmailResults struct {
+	up1 *model.User
+	err error
+}
+
+// UserRepositoryMockFindByEmailOrigins contains origins of expectations of the UserRepository.FindByEmail
+type UserRepositoryMockFindByEmailExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEmail string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindByEmail *mUserRepositoryMockFindByEmail) Optional() *mUserRepositoryMockFindByEmail {
+	mmFindByEmail.optional = true
+	return mmFindByEmail
+}
+
+// Expect sets up expected params for UserRepository.FindByEmail
+func (mmFindByEmail *mUserRepositoryMockFindByEmail) Expect(ctx context.Context, email string) *mUserRepositoryMockFindByEmail {
+	if mmFindByEmail.mock.funcFindByEmail != nil {
+		mmFindByEmail.mock.t.Fatalf("UserRepositoryMock.FindByEmail mock is already set by Set")
+	}
+
+	if mmFindByEmail.defaultExpectation == nil {
+		mmFindByEmail.defaultExpectation = &UserRepositoryMockFindByEmailExpectation{}
+	}
+
+	if mmFindByEmail.defaultExpectation.paramPtrs != nil {
+		mmFindByEmail.mock.t.Fatalf("UserRepositoryMock.FindByEmail mock is already set by ExpectParams functions")
+	}
+
+	mmFindByEmail.defaultExpectation.params = &UserRepositoryMockFindByEmailParams{ctx, email}
+	mmFindByEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindByEmail.expectations {
+		if minimock.Equal(e.params, mmFindByEmail.defaultExpectation.params) {
+			mmFindByEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindByEmail.defaultExpectation.params)
+		}
+	}
+
+	return mmFindByEmail
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.FindByEmail
+func (mmFindByEmail *mUserRepositoryMockFindByEmail) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockFindByEmail {
+	if mmFindByEmail.mock.funcFindByEmail != nil {
+		mmFindByEmail.mock.t.Fatalf("UserRepositoryMock.FindByEmail mock is already set by Set")
+	}
+
+	if mmFindByEmail.defaultExpectation == nil {
+		mmFindByEmail.defaultExpectation = &UserRepositoryMockFindByEmailExpectation{}
+	}
+
+	if mmFindByEmail.defaultExpectation.params != nil {
+		mmFindByEmail.mock.t.Fatalf("UserRepositoryMock.FindByEmail mock is already set by Expect")
+	}
+
+	if mmFindByEmail.defaultExpectation.paramPtrs == nil {
+		mmFindByEmail.defaultExpectation.paramPtrs = &UserRepositoryMockFindByEmailParamPtrs{}
+	}
+	mmFindByEmail.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindByEmail.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindByEmail
+}
+
+// ExpectEmailParam2 sets up expected param email for UserRepository.FindByEmail
+func (mmFindByEmail *mUserRepositoryMockFindByEmail) ExpectEmailParam2(email string) *mUserRepositoryMockFindByEmail {
+	if mmFindByEmail.mock.funcFindByEmail != nil {
+		mmFindByEmail.mock.t.Fatalf("UserRepositoryMock.FindByEmail mock is already set by Set")
+	}
+
+	if mmFindByEmail.defaultExpectation == nil {
+		mmFindByEmail.defaultExpectation = &UserRepositoryMockFindByEmailExpectation{}
+	}
+
+	if mmFindByEmail.defaultExpectation.params != nil {
+		mmFindByEmail.mock.t.Fatalf("UserRepositoryMock.FindByEmail mock is already set by Expect")
+	}
+
+	if mmFindByEmail.defaultExpectation.paramPtrs == nil {
+		mmFindByEmail.defaultExpectation.paramPtrs = &UserRepositoryMockFindByEmailParamPtrs{}
+	}
+	mmFindByEmail.defaultExpectation.paramPtrs.email = &email
+	mmFindByEmail.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmFindByEmail
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.FindByEmail
+func (mmFindByEmail *mUserRepositoryMockFindByEmail) Inspect(f func(ctx context.Context, email string)) *mUserRepositoryMockFindByEmail {
+	if mmFindByEmail.mock.inspectFuncFindByEmail != nil {
+		mmFindByEmail.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.FindByEmail")
+	}
+
+	mmFindByEmail.mock.inspectFuncFindByEmail = f
+
+	return mmFindByEmail
+}
+
+// Return sets up results that will be returned by UserRepository.FindByEmail
+func (mmFindByEmail *mUserRepositoryMockFindByEmail) Return(up1 *model.User, err error) *UserRepositoryMock {
+	if mmFindByEmail.mock.funcFindByEmail != nil {
+		mmFindByEmail.mock.t.Fatalf("UserRepositoryMock.FindByEmail mock is already set by Set")
+	}
+
+	if mmFindByEmail.defaultExpectation == nil {
+		mmFindByEmail.defaultExpectation = &UserRepositoryMockFindByEmailExpectation{mock: mmFindByEmail.mock}
+	}
+	mmFindByEmail.defaultExpectation.results = &UserRepositoryMockFindByEmailResults{up1, err}
+	mmFindByEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindByEmail.mock
+}
+
+// Set uses given function f to mock the UserRepository.FindByEmail method
+func (mmFindByEmail *mUserRepositoryMockFindByEmail) Set(f func(ctx context.Context, email string) (up1 *model.User, err error)) *UserRepositoryMock {
+	if mmFindByEmail.defaultExpectation != nil {
+		mmFindByEmail.mock.t.Fatalf("Default expectation is already set for the UserRepository.FindByEmail method")
+	}
+
+	if len(mmFindByEmail.expectations) > 0 {
+		mmFindByEmail.mock.t.Fatalf("Some expectations are already set for the UserRepository.FindByEmail method")
+	}
+
+	mmFindByEmail.mock.funcFindByEmail = f
+	mmFindByEmail.mock.funcFindByEmailOrigin = minimock.CallerInfo(1)
+	return mmFindByEmail.mock
+}
+
+// When sets expectation for the UserRepository.FindByEmail which will trigger the result defined by the following
+// Then helper
+func (mmFindByEmail *mUserRepositoryMockFindByEmail) When(ctx context.Context, email string) *UserRepositoryMockFindByEmailExpectation {
+	if mmFindByEmail.mock.funcFindByEmail != nil {
+		mmFindByEmail.mock.t.Fatalf("UserRepositoryMock.FindByEmail mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockFindByEmailExpectation{
+		mock:               mmFindByEmail.mock,
+		params:             &UserRepositoryMockFindByEmailParams{ctx, email},
+		expectationOrigins: UserRepositoryMockFindByEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindByEmail.expectations = append(mmFindByEmail.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.FindByEmail return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockFindByEmailExpectation) Then(up1 *model.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockFindByEmailResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.FindByEmail should be invoked
+func (mmFindByEmail *mUserRepositoryMockFindByEmail) Times(n uint64) *mUserRepositoryMockFindByEmail {
+	if n == 0 {
+		mmFindByEmail.mock.t.Fatalf("Times of UserRepositoryMock.FindByEmail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindByEmail.expectedInvocations, n)
+	mmFindByEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindByEmail
+}
+
+func (mmFindByEmail *mUserRepositoryMockFindByEmail) invocationsDone() bool {
+	if len(mmFindByEmail.expectations) == 0 && mmFindByEmail.defaultExpectation == nil && mmFindByEmail.mock.funcFindByEmail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindByEmail.mock.afterFindByEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindByEmail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindByEmail implements mm_repository.UserRepository
+func (mmFindByEmail *UserRepositoryMock) FindByEmail(ctx context.Context, email string) (up1 *model.User, err error) {
+	mm_atomic.AddUint64(&mmFindByEmail.beforeFindByEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindByEmail.afterFindByEmailCounter, 1)
+
+	mmFindByEmail.t.Helper()
+
+	if mmFindByEmail.inspectFuncFindByEmail != nil {
+		mmFindByEmail.inspectFuncFindByEmail(ctx, email)
+	}
+
+	mm_params := UserRepositoryMockFindByEmailParams{ctx, email}
+
+	// Record call args
+	mmFindByEmail.FindByEmailMock.mutex.Lock()
+	mmFindByEmail.FindByEmailMock.callArgs = append(mmFindByEmail.FindByEmailMock.callArgs, &mm_params)
+	mmFindByEmail.FindByEmailMock.mutex.Unlock()
+
+	for _, e := range mmFindByEmail.FindByEmailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmFindByEmail.FindByEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindByEmail.FindByEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindByEmail.FindByEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmFindByEmail.FindByEmailMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockFindByEmailParams{ctx, email}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindByEmail.t.Errorf("UserRepositoryMock.FindByEmail got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindByEmail.FindByEmailMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmFindByEmail.t.Errorf("UserRepositoryMock.FindByEmail got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindByEmail.FindByEmailMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindByEmail.t.Errorf("UserRepositoryMock.FindByEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindByEmail.FindByEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindByEmail.FindByEmailMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindByEmail.t.Fatal("No results are set for the UserRepositoryMock.FindByEmail")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmFindByEmail.funcFindByEmail != nil {
+		return mmFindByEmail.funcFindByEmail(ctx, email)
+	}
+	mmFindByEmail.t.Fatalf("Unexpected call to UserRepositoryMock.FindByEmail. %v %v", ctx, email)
+	return
+}
+
+// FindByEmailAfterCounter returns a count of finished UserRepositoryMock.FindByEmail invocations
+func (mmFindByEmail *UserRepositoryMock) FindByEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindByEmail.afterFindByEmailCounter)
+}
+
+// FindByEmailBeforeCounter returns a count of UserRepositoryMock.FindByEmail invocations
+func (mmFindByEmail *UserRepositoryMock) FindByEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindByEmail.beforeFindByEmailCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.FindByEmail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindByEmail *mUserRepositoryMockFindByEmail) Calls() []*UserRepositoryMockFindByEmailParams {
+	mmFindByEmail.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockFindByEmailParams, len(mmFindByEmail.callArgs))
+	copy(argCopy, mmFindByEmail.callArgs)
+
+	mmFindByEmail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindByEmailDone returns true if the count of the FindByEmail invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockFindByEmailDone() bool {
+	if m.FindByEmailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindByEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindByEmailMock.invocationsDone()
+}
+
+// MinimockFindByEmailInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockFindByEmailInspect() {
+	for _, e := range m.FindByEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.FindByEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindByEmailCounter := mm_atomic.LoadUint64(&m.afterFindByEmailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindByEmailMock.defaultExpectation != nil && afterFindByEmailCounter < 1 {
+		if m.FindByEmailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.FindByEmail at\n%s", m.FindByEmailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.FindByEmail at\n%s with params: %#v", m.FindByEmailMock.defaultExpectation.expectationOrigins.origin, *m.FindByEmailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindByEmail != nil && afterFindByEmailCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.FindByEmail at\n%s", m.funcFindByEmailOrigin)
+	}
+
+	if !m.FindByEmailMock.invocationsDone() && afterFindByEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.FindByEmail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindByEmailMock.expectedInvocations), m.FindByEmailMock.expectedInvocationsOrigin, afterFindByEmailCounter)
+	}
+}
+
+type mUserRepositoryMockFindByName struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockFindByNameExpectation
+	expectations       []*UserRepositoryMockFindByNameExpectation
+
+	callArgs []*UserRepositoryMockFindByNameParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockFindByNameExpectation specifies expectation struct of the UserRepository.FindByName
+type UserRepositoryMockFindByNameExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockFindByNameParams
+	paramPtrs          *UserRepositoryMockFindByNameParamPtrs
+	expectationOrigins UserRepositoryMockFindByNameExpectationOrigins
+	results            *UserRepositoryMockFindByNameResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockFindByNameParams contains parameters of the UserRepository.FindByName
+type UserRepositoryMockFindByNameParams struct {
+	ctx  context.Context
+	name string
+}
+
+// UserRepositoryMockFindByNameParamPtrs contains pointers to parameters of the UserRepository.FindByName
+type UserRepositoryMockFindByNameParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// UserRepositoryMockFindByNameResults contains results of the UserRepository.FindByName
+type UserRepositoryMockFindByNameResults struct {
+	up1 *model.User
+	err error
+}
+
+// UserRepositoryMockFindByNameOrigins contains origins of expectations of the UserRepository.FindByName
+type UserRepositoryMockFindByNameExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindByName *mUserRepositoryMockFindByName) Optional() *mUserRepositoryMockFindByName {
+	mmFindByName.optional = true
+	return mmFindByName
+}
+
+// Expect sets up expected params for UserRepository.FindByName
+func (mmFindByName *mUserRepositoryMockFindByName) Expect(ctx context.Context, name string) *mUserRepositoryMockFindByName {
+	if mmFindByName.mock.funcFindByName != nil {
+		mmFindByName.mock.t.Fatalf("UserRepositoryMock.FindByName mock is already set by Set")
+	}
+
+	if mmFindByName.defaultExpectation == nil {
+		mmFindByName.defaultExpectation = &UserRepositoryMockFindByNameExpectation{}
+	}
+
+	if mmFindByName.defaultExpectation.paramPtrs != nil {
+		mmFindByName.mock.t.Fatalf("UserRepositoryMock.FindByName mock is already set by ExpectParams functions")
+	}
+
+	mmFindByName.defaultExpectation.params = &UserRepositoryMockFindByNameParams{ctx, name}
+	mmFindByName.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindByName.expectations {
+		if minimock.Equal(e.params, mmFindByName.defaultExpectation.params) {
+			mmFindByName.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindByName.defaultExpectation.params)
+		}
+	}
+
+	return mmFindByName
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.FindByName
+func (mmFindByName *mUserRepositoryMockFindByName) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockFindByName {
+	if mmFindByName.mock.funcFindByName != nil {
+		mmFindByName.mock.t.Fatalf("UserRepositoryMock.FindByName mock is already set by Set")
+	}
+
+	if mmFindByName.defaultExpectation == nil {
+		mmFindByName.defaultExpectation = &UserRepositoryMockFindByNameExpectation{}
+	}
+
+	if mmFindByName.defaultExpectation.params != nil {
+		mmFindByName.mock.t.Fatalf("UserRepositoryMock.FindByName mock is already set by Expect")
+	}
+
+	if mmFindByName.defaultExpectation.paramPtrs == nil {
+		mmFindByName.defaultExpectation.paramPtrs = &UserRepositoryMockFindByNameParamPtrs{}
+	}
+	mmFindByName.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindByName.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindByName
+}
+
+// ExpectNameParam2 sets up expected param name for UserRepository.FindByName
+func (mmFindByName *mUserRepositoryMockFindByName) ExpectNameParam2(name string) *mUserRepositoryMockFindByName {
+	if mmFindByName.mock.funcFindByName != nil {
+		mmFindByName.mock.t.Fatalf("UserRepositoryMock.FindByName mock is already set by Set")
+	}
+
+	if mmFindByName.defaultExpectation == nil {
+		mmFindByName.defaultExpectation = &UserRepositoryMockFindByNameExpectation{}
+	}
+
+	if mmFindByName.defaultExpectation.params != nil {
+		mmFindByName.mock.t.Fatalf("UserRepositoryMock.FindByName mock is already set by Expect")
+	}
+
+	if mmFindByName.defaultExpectation.paramPtrs == nil {
+		mmFindByName.defaultExpectation.paramPtrs = &UserRepositoryMockFindByNameParamPtrs{}
+	}
+	mmFindByName.defaultExpectation.paramPtrs.name = &name
+	mmFindByName.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmFindByName
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.FindByName
+func (mmFindByName *mUserRepositoryMockFindByName) Inspect(f func(ctx context.Context, name string)) *mUserRepositoryMockFindByName {
+	if mmFindByName.mock.inspectFuncFindByName != nil {
+		mmFindByName.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.FindByName")
+	}
+
+	mmFindByName.mock.inspectFuncFindByName = f
+
+	return mmFindByName
+}
+
+// Return sets up results that will be returned by UserRepository.FindByName
+func (mmFindByName *mUserRepositoryMockFindByName) Return(up1 *model.User, err error) *UserRepositoryMock {
+	if mmFindByName.mock.funcFindByName != nil {
+		mmFindByName.mock.t.Fatalf("UserRepositoryMock.FindByName mock is already set by Set")
+	}
+
+	if mmFindByName.defaultExpectation == nil {
+		mmFindByName.defaultExpectation = &UserRepositoryMockFindByNameExpectation{mock: mmFindByName.mock}
+	}
+	mmFindByName.defaultExpectation.results = &UserRepositoryMockFindByNameResults{up1, err}
+	mmFindByName.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindByName.mock
+}
+
+// Set uses given function f to mock the UserRepository.FindByName method
+func (mmFindByName *mUserRepositoryMockFindByName) Set(f func(ctx context.Context, name string) (up1 *model.User, err error)) *UserRepositoryMock {
+	if mmFindByName.defaultExpectation != nil {
+		mmFindByName.mock.t.Fatalf("Default expectation is already set for the UserRepository.FindByName method")
+	}
+
+	if len(mmFindByName.expectations) > 0 {
+		mmFindByName.mock.t.Fatalf("Some expectations are already set for the UserRepository.FindByName method")
+	}
+
+	mmFindByName.mock.funcFindByName = f
+	mmFindByName.mock.funcFindByNameOrigin = minimock.CallerInfo(1)
+	return mmFindByName.mock
+}
+
+// When sets expectation for the UserRepository.FindByName which will trigger the result defined by the following
+// Then helper
+func (mmFindByName *mUserRepositoryMockFindByName) When(ctx context.Context, name string) *UserRepositoryMockFindByNameExpectation {
+	if mmFindByName.mock.funcFindByName != nil {
+		mmFindByName.mock.t.Fatalf("UserRepositoryMock.FindByName mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockFindByNameExpectation{
+		mock:               mmFindByName.mock,
+		params:             &UserRepositoryMockFindByNameParams{ctx, name},
+		expectationOrigins: UserRepositoryMockFindByNameExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindByName.expectations = append(mmFindByName.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.FindByName return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockFindByNameExpectation) Then(up1 *model.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockFindByNameResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.FindByName should be invoked
+func (mmFindByName *mUserRepositoryMockFindByName) Times(n uint64) *mUserRepositoryMockFindByName {
+	if n == 0 {
+		mmFindByName.mock.t.Fatalf("Times of UserRepositoryMock.FindByName mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindByName.expectedInvocations, n)
+	mmFindByName.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindByName
+}
+
+func (mmFindByName *mUserRepositoryMockFindByName) invocationsDone() bool {
+	if len(mmFindByName.expectations) == 0 && mmFindByName.defaultExpectation == nil && mmFindByName.mock.funcFindByName == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindByName.mock.afterFindByNameCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindByName.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindByName implements mm_repository.UserRepository
+func (mmFindByName *UserRepositoryMock) FindByName(ctx context.Context, name string) (up1 *model.User, err error) {
+	mm_atomic.AddUint64(&mmFindByName.beforeFindByNameCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindByName.afterFindByNameCounter, 1)
+
+	mmFindByName.t.Helper()
+
+	if mmFindByName.inspectFuncFindByName != nil {
+		mmFindByName.inspectFuncFindByName(ctx, name)
+	}
+
+	mm_params := UserRepositoryMockFindByNameParams{ctx, name}
+
+	// Record call args
+	mmFindByName.FindByNameMock.mutex.Lock()
+	mmFindByName.FindByNameMock.callArgs = append(mmFindByName.FindByNameMock.callArgs, &mm_params)
+	mmFindByName.FindByNameMock.mutex.Unlock()
+
+	for _, e := range mmFindByName.FindByNameMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmFindByName.FindByNameMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindByName.FindByNameMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindByName.FindByNameMock.defaultExpectation.params
+		mm_want_ptrs := mmFindByName.FindByNameMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockFindByNameParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindByName.t.Errorf("UserRepositoryMock.FindByName got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindByName.FindByNameMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmFindByName.t.Errorf("UserRepositoryMock.FindByName got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindByName.FindByNameMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindByName.t.Errorf("UserRepositoryMock.FindByName got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindByName.FindByNameMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindByName.FindByNameMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindByName.t.Fatal("No results are set for the UserRepositoryMock.FindByName")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmFindByName.funcFindByName != nil {
+		return mmFindByName.funcFindByName(ctx, name)
+	}
+	mmFindByName.t.Fatalf("Unexpected call to UserRepositoryMock.FindByName. %v %v", ctx, name)
+	return
+}
+
+// FindByNameAfterCounter returns a count of finished UserRepositoryMock.FindByName invocations
+func (mmFindByName *UserRepositoryMock) FindByNameAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindByName.afterFindByNameCounter)
+}
+
+// FindByNameBeforeCounter returns a count of UserRepositoryMock.FindByName invocations
+func (mmFindByName *UserRepositoryMock) FindByNameBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindByName.beforeFindByNameCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.FindByName.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindByName *mUserRepositoryMockFindByName) Calls() []*UserRepositoryMockFindByNameParams {
+	mmFindByName.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockFindByNameParams, len(mmFindByName.callArgs))
+	copy(argCopy, mmFindByName.callArgs)
+
+	mmFindByName.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindByNameDone returns true if the count of the FindByName invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockFindByNameDone() bool {
+	if m.FindByNameMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindByNameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindByNameMock.invocationsDone()
+}
+
+// MinimockFindByNameInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockFindByNameInspect() {
+	for _, e := range m.FindByNameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.FindByName at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindByNameCounter := mm_atomic.LoadUint64(&m.afterFindByNameCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindByNameMock.defaultExpectation != nil && afterFindByNameCounter < 1 {
+		if m.FindByNameMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.FindByName at\n%s", m.FindByNameMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.FindByName at\n%s with params: %#v", m.FindByNameMock.defaultExpectation.expectationOrigins.origin, *m.FindByNameMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindByName != nil && afterFindByNameCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.FindByName at\n%s", m.funcFindByNameOrigin)
+	}
+
+	if !m.FindByNameMock.invocationsDone() && afterFindByNameCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.FindByName at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindByNameMock.expectedInvocations), m.FindByNameMock.expectedInvocationsOrigin, afterFindByNameCounter)
+	}
+}
+
+type mUserRepositoryMockGet struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockGetExpectation
+	expectations       []*UserRepositoryMockGetExpectation
+
+	callArgs []*UserRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockGetExpectation specifies expectation struct of the UserRepository.Get
+type UserRepositoryMockGetExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockGetParams
+	paramPtrs          *UserRepositoryMockGetParamPtrs
+	expectationOrigins UserRepositoryMockGetExpectationOrigins
+	results            *UserRepositoryMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockGetParams contains parameters of the UserRepository.Get
+type UserRepositoryMockGetParams struct {
+	ctx context.Context
+	id  string
+}
+
+// UserRepositoryMockGetParamPtrs contains pointers to parameters of the UserRepository.Get
+type UserRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// UserRepositoryMockGetResults contains results of the UserRepository.Get
+type UserRepositoryMockGetResults struct {
+	up1 *model.User
+	err error
+}
+
+// UserRepositoryMockGetOrigins contains origins of expectations of the UserRepository.Get
+type UserRepositoryMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mUserRepositoryMockGet) Optional() *mUserRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for UserRepository.Get
+func (mmGet *mUserRepositoryMockGet) Expect(ctx context.Context, id string) *mUserRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &UserRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &UserRepositoryMockGetParams{ctx, id}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.Get
+func (mmGet *mUserRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &UserRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &UserRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.Get
+func (mmGet *mUserRepositoryMockGet) ExpectIdParam2(id string) *mUserRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &UserRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &UserRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+	mmGet.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.Get
+func (mmGet *mUserRepositoryMockGet) Inspect(f func(ctx context.Context, id string)) *mUserRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by UserRepository.Get
+func (mmGet *mUserRepositoryMockGet) Return(up1 *model.User, err error) *UserRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &UserRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &UserRepositoryMockGetResults{up1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the UserRepository.Get method
+func (mmGet *mUserRepositoryMockGet) Set(f func(ctx context.Context, id string) (up1 *model.User, err error)) *UserRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the UserRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the UserRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the UserRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mUserRepositoryMockGet) When(ctx context.Context, id string) *UserRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &UserRepositoryMockGetParams{ctx, id},
+		expectationOrigins: UserRepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.Get return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockGetExpectation) Then(up1 *model.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockGetResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.Get should be invoked
+func (mmGet *mUserRepositoryMockGet) Times(n uint64) *mUserRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of UserRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mUserRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_repository.UserRepository
+func (mmGet *UserRepositoryMock) Get(ctx context.Context, id string) (up1 *model.User, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := UserRepositoryMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("UserRepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("UserRepositoryMock.Get got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("UserRepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the UserRepositoryMock.Get")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to UserRepositoryMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished UserRepositoryMock.Get invocations
+func (mmGet *UserRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of UserRepositoryMock.Get invocations
+func (mmGet *UserRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mUserRepositoryMockGet) Calls() []*UserRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mUserRepositoryMockGetAuthInfo struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockGetAuthInfoExpectation
+	expectations       []*UserRepositoryMockGetAuthInfoExpectation
+
+	callArgs []*UserRepositoryMockGetAuthInfoParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockGetAuthInfoExpectation specifies expectation struct of the UserRepository.GetAuthInfo
+type UserRepositoryMockGetAuthInfoExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockGetAuthInfoParams
+	paramPtrs          *UserRepositoryMockGetAuthInfoParamPtrs
+	expectationOrigins UserRepositoryMockGetAuthInfoExpectationOrigins
+	results            *UserRepositoryMockGetAuthInfoResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockGetAuthInfoParams contains parameters of the UserRepository.GetAuthInfo
+type UserRepositoryMockGetAuthInfoParams struct {
+	ctx      context.Context
+	username string
+}
+
+// UserRepositoryMockGetAuthInfoParamPtrs contains pointers to parameters of the UserRepository.GetAuthInfo
+type UserRepositoryMockGetAuthInfoParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// UserRepositoryMockGetAuthInfoResults contains results of the UserRepository.GetAuthInfo
+type UserRepositoryMockGetAuthInfoResults struct {
+	ap1 *model.AuthInfo
+	err error
+}
+
+// UserRepositoryMockGetAuthInfoOrigins contains origins of expectations of the UserRepository.GetAuthInfo
+type UserRepositoryMockGetAuthInfoExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAuthInfo *mUserRepositoryMockGetAuthInfo) Optional() *mUserRepositoryMockGetAuthInfo {
+	mmGetAuthInfo.optional = true
+	return mmGetAuthInfo
+}
+
+// Expect sets up expected params for UserRepository.GetAuthInfo
+func (mmGetAuthInfo *mUserRepositoryMockGetAuthInfo) Expect(ctx context.Context, username string) *mUserRepositoryMockGetAuthInfo {
+	if mmGetAuthInfo.mock.funcGetAuthInfo != nil {
+		mmGetAuthInfo.mock.t.Fatalf("UserRepositoryMock.GetAuthInfo mock is already set by Set")
+	}
+
+	if mmGetAuthInfo.defaultExpectation == nil {
+		mmGetAuthInfo.defaultExpectation = &UserRepositoryMockGetAuthInfoExpectation{}
+	}
+
+	if mmGetAuthInfo.defaultExpectation.paramPtrs != nil {
+		mmGetAuthInfo.mock.t.Fatalf("UserRepositoryMock.GetAuthInfo mock is already set by ExpectParams functions")
+	}
+
+	mmGetAuthInfo.defaultExpectation.params = &UserRepositoryMockGetAuthInfoParams{ctx, username}
+	mmGetAuthInfo.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAuthInfo.expectations {
+		if minimock.Equal(e.params, mmGetAuthInfo.defaultExpectation.params) {
+			mmGetAuthInfo.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAuthInfo.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAuthInfo
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetAuthInfo
+func (mmGetAuthInfo *mUserRepositoryMockGetAuthInfo) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetAuthInfo {
+	if mmGetAuthInfo.mock.funcGetAuthInfo != nil {
+		mmGetAuthInfo.mock.t.Fatalf("UserRepositoryMock.GetAuthInfo mock is already set by Set")
+	}
+
+	if mmGetAuthInfo.defaultExpectation == nil {
+		mmGetAuthInfo.defaultExpectation = &UserRepositoryMockGetAuthInfoExpectation{}
+	}
+
+	if mmGetAuthInfo.defaultExpectation.params != nil {
+		mmGetAuthInfo.mock.t.Fatalf("UserRepositoryMock.GetAuthInfo mock is already set by Expect")
+	}
+
+	if mmGetAuthInfo.defaultExpectation.paramPtrs == nil {
+		mmGetAuthInfo.defaultExpectation.paramPtrs = &UserRepositoryMockGetAuthInfoParamPtrs{}
+	}
+	mmGetAuthInfo.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAuthInfo.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAuthInfo
+}
+
+// ExpectUsernameParam2 sets up expected param username for UserRepository.GetAuthInfo
+func (mmGetAuthInfo *mUserRepositoryMockGetAuthInfo) ExpectUsernameParam2(username string) *mUserRepositoryMockGetAuthInfo {
+	if mmGetAuthInfo.mock.funcGetAuthInfo != nil {
+		mmGetAuthInfo.mock.t.Fatalf("UserRepositoryMock.GetAuthInfo mock is already set by Set")
+	}
+
+	if mmGetAuthInfo.defaultExpectation == nil {
+		mmGetAuthInfo.defaultExpectation = &UserRepositoryMockGetAuthInfoExpectation{}
+	}
+
+	if mmGetAuthInfo.defaultExpectation.params != nil {
+		mmGetAuthInfo.mock.t.Fatalf("UserRepositoryMock.GetAuthInfo mock is already set by Expect")
+	}
+
+	if mmGetAuthInfo.defaultExpectation.paramPtrs == nil {
+		mmGetAuthInfo.defaultExpectation.paramPtrs = &UserRepositoryMockGetAuthInfoParamPtrs{}
+	}
+	mmGetAuthInfo.defaultExpectation.paramPtrs.username = &username
+	mmGetAuthInfo.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetAuthInfo
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.GetAuthInfo
+func (mmGetAuthInfo *mUserRepositoryMockGetAuthInfo) Inspect(f func(ctx context.Context, username string)) *mUserRepositoryMockGetAuthInfo {
+	if mmGetAuthInfo.mock.inspectFuncGetAuthInfo != nil {
+		mmGetAuthInfo.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetAuthInfo")
+	}
+
+	mmGetAuthInfo.mock.inspectFuncGetAuthInfo = f
+
+	return mmGetAuthInfo
+}
+
+// Return sets up results that will be returned by UserRepository.GetAuthInfo
+func (mmGetAuthInfo *mUserRepositoryMockGetAuthInfo) Return(ap1 *model.AuthInfo, err error) *UserRepositoryMock {
+	if mmGetAuthInfo.mock.funcGetAuthInfo != nil {
+		mmGetAuthInfo.mock.t.Fatalf("UserRepositoryMock.GetAuthInfo mock is already set by Set")
+	}
+
+	if mmGetAuthInfo.defaultExpectation == nil {
+		mmGetAuthInfo.defaultExpectation = &UserRepositoryMockGetAuthInfoExpectation{mock: mmGetAuthInfo.mock}
+	}
+	mmGetAuthInfo.defaultExpectation.results = &UserRepositoryMockGetAuthInfoResults{ap1, err}
+	mmGetAuthInfo.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAuthInfo.mock
+}
+
+// Set uses given function f to mock the UserRepository.GetAuthInfo method
+func (mmGetAuthInfo *mUserRepositoryMockGetAuthInfo) Set(f func(ctx context.Context, username string) (ap1 *model.AuthInfo, err error)) *UserRepositoryMock {
+	if mmGetAuthInfo.defaultExpectation != nil {
+		mmGetAuthInfo.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetAuthInfo method")
+	}
+
+	if len(mmGetAuthInfo.expectations) > 0 {
+		mmGetAuthInfo.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetAuthInfo method")
+	}
+
+	mmGetAuthInfo.mock.funcGetAuthInfo = f
+	mmGetAuthInfo.mock.funcGetAuthInfoOrigin = minimock.CallerInfo(1)
+	return mmGetAuthInfo.mock
+}
+
+// When sets expectation for the UserRepository.GetAuthInfo which will trigger the result defined by the following
+// Then helper
+func (mmGetAuthInfo *mUserRepositoryMockGetAuthInfo) When(ctx context.Context, username string) *UserRepositoryMockGetAuthInfoExpectation {
+	if mmGetAuthInfo.mock.funcGetAuthInfo != nil {
+		mmGetAuthInfo.mock.t.Fatalf("UserRepositoryMock.GetAuthInfo mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockGetAuthInfoExpectation{
+		mock:               mmGetAuthInfo.mock,
+		params:             &UserRepositoryMockGetAuthInfoParams{ctx, username},
+		expectationOrigins: UserRepositoryMockGetAuthInfoExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAuthInfo.expectations = append(mmGetAuthInfo.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.GetAuthInfo return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockGetAuthInfoExpectation) Then(ap1 *model.AuthInfo, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockGetAuthInfoResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.GetAuthInfo should be invoked
+func (mmGetAuthInfo *mUserRepositoryMockGetAuthInfo) Times(n uint64) *mUserRepositoryMockGetAuthInfo {
+	if n == 0 {
+		mmGetAuthInfo.mock.t.Fatalf("Times of UserRepositoryMock.GetAuthInfo mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAuthInfo.expectedInvocations, n)
+	mmGetAuthInfo.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAuthInfo
+}
+
+func (mmGetAuthInfo *mUserRepositoryMockGetAuthInfo) invocationsDone() bool {
+	if len(mmGetAuthInfo.expectations) == 0 && mmGetAuthInfo.defaultExpectation == nil && mmGetAuthInfo.mock.funcGetAuthInfo == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAuthInfo.mock.afterGetAuthInfoCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAuthInfo.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAuthInfo implements mm_repository.UserRepository
+func (mmGetAuthInfo *UserRepositoryMock) GetAuthInfo(ctx context.Context, username string) (ap1 *model.AuthInfo, err error) {
+	mm_atomic.AddUint64(&mmGetAuthInfo.beforeGetAuthInfoCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAuthInfo.afterGetAuthInfoCounter, 1)
+
+	mmGetAuthInfo.t.Helper()
+
+	if mmGetAuthInfo.inspectFuncGetAuthInfo != nil {
+		mmGetAuthInfo.inspectFuncGetAuthInfo(ctx, username)
+	}
+
+	mm_params := UserRepositoryMockGetAuthInfoParams{ctx, username}
+
+	// Record call args
+	mmGetAuthInfo.GetAuthInfoMock.mutex.Lock()
+	mmGetAuthInfo.GetAuthInfoMock.callArgs = append(mmGetAuthInfo.GetAuthInfoMock.callArgs, &mm_params)
+	mmGetAuthInfo.GetAuthInfoMock.mutex.Unlock()
+
+	for _, e := range mmGetAuthInfo.GetAuthInfoMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmGetAuthInfo.GetAuthInfoMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAuthInfo.GetAuthInfoMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAuthInfo.GetAuthInfoMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAuthInfo.GetAuthInfoMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockGetAuthInfoParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAuthInfo.t.Errorf("UserRepositoryMock.GetAuthInfo got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAuthInfo.GetAuthInfoMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetAuthInfo.t.Errorf("UserRepositoryMock.GetAuthInfo got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAuthInfo.GetAuthInfoMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAuthInfo.t.Errorf("UserRepositoryMock.GetAuthInfo got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAuthInfo.GetAuthInfoMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAuthInfo.GetAuthInfoMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAuthInfo.t.Fatal("No results are set for the UserRepositoryMock.GetAuthInfo")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmGetAuthInfo.funcGetAuthInfo != nil {
+		return mmGetAuthInfo.funcGetAuthInfo(ctx, username)
+	}
+	mmGetAuthInfo.t.Fatalf("Unexpected call to UserRepositoryMock.GetAuthInfo. %v %v", ctx, username)
+	return
+}
+
+// GetAuthInfoAfterCounter returns a count of finished UserRepositoryMock.GetAuthInfo invocations
+func (mmGetAuthInfo *UserRepositoryMock) GetAuthInfoAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAuthInfo.afterGetAuthInfoCounter)
+}
+
+// GetAuthInfoBeforeCounter returns a count of UserRepositoryMock.GetAuthInfo invocations
+func (mmGetAuthInfo *UserRepositoryMock) GetAuthInfoBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAuthInfo.beforeGetAuthInfoCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.GetAuthInfo.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAuthInfo *mUserRepositoryMockGetAuthInfo) Calls() []*UserRepositoryMockGetAuthInfoParams {
+	mmGetAuthInfo.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockGetAuthInfoParams, len(mmGetAuthInfo.callArgs))
+	copy(argCopy, mmGetAuthInfo.callArgs)
+
+	mmGetAuthInfo.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAuthInfoDone returns true if the count of the GetAuthInfo invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockGetAuthInfoDone() bool {
+	if m.GetAuthInfoMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAuthInfoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAuthInfoMock.invocationsDone()
+}
+
+// MinimockGetAuthInfoInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockGetAuthInfoInspect() {
+	for _, e := range m.GetAuthInfoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetAuthInfo at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAuthInfoCounter := mm_atomic.LoadUint64(&m.afterGetAuthInfoCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAuthInfoMock.defaultExpectation != nil && afterGetAuthInfoCounter < 1 {
+		if m.GetAuthInfoMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetAuthInfo at\n%s", m.GetAuthInfoMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetAuthInfo at\n%s with params: %#v", m.GetAuthInfoMock.defaultExpectation.expectationOrigins.origin, *m.GetAuthInfoMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAuthInfo != nil && afterGetAuthInfoCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.GetAuthInfo at\n%s", m.funcGetAuthInfoOrigin)
+	}
+
+	if !m.GetAuthInfoMock.invocationsDone() && afterGetAuthInfoCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.GetAuthInfo at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAuthInfoMock.expectedInvocations), m.GetAuthInfoMock.expectedInvocationsOrigin, afterGetAuthInfoCounter)
+	}
+}
+
+type mUserRepositoryMockGetVersion struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockGetVersionExpectation
+	expectations       []*UserRepositoryMockGetVersionExpectation
+
+	callArgs []*UserRepositoryMockGetVersionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockGetVersionExpectation specifies expectation struct of the UserRepository.GetVersion
+type UserRepositoryMockGetVersionExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockGetVersionParams
+	paramPtrs          *UserRepositoryMockGetVersionParamPtrs
+	expectationOrigins UserRepositoryMockGetVersionExpectationOrigins
+	results            *UserRepositoryMockGetVersionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockGetVersionParams contains parameters of the UserRepository.GetVersion
+type UserRepositoryMockGetVersionParams struct {
+	ctx context.Context
+	id  string
+}
+
+// UserRepositoryMockGetVersionParamPtrs contains pointers to parameters of the UserRepository.GetVersion
+type UserRepositoryMockGetVersionParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// UserRepositoryMockGetVersionResults contains results of the UserRepository.GetVersion
+type UserRepositoryMockGetVersionResults struct {
+	i1  int
+	err error
+}
+
+// UserRepositoryMockGetVersionOrigins contains origins of expectations of the UserRepository.GetVersion
+type UserRepositoryMockGetVersionExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetVersion *mUserRepositoryMockGetVersion) Optional() *mUserRepositoryMockGetVersion {
+	mmGetVersion.optional = true
+	return mmGetVersion
+}
+
+// Expect sets up expected params for UserRepository.GetVersion
+func (mmGetVersion *mUserRepositoryMockGetVersion) Expect(ctx context.Context, id string) *mUserRepositoryMockGetVersion {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("UserRepositoryMock.GetVersion mock is already set by Set")
+	}
+
+	if mmGetVersion.defaultExpectation == nil {
+		mmGetVersion.defaultExpectation = &UserRepositoryMockGetVersionExpectation{}
+	}
+
+	if mmGetVersion.defaultExpectation.paramPtrs != nil {
+		mmGetVersion.mock.t.Fatalf("UserRepositoryMock.GetVersion mock is already set by ExpectParams functions")
+	}
+
+	mmGetVersion.defaultExpectation.params = &UserRepositoryMockGetVersionParams{ctx, id}
+	mmGetVersion.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetVersion.expectations {
+		if minimock.Equal(e.params, mmGetVersion.defaultExpectation.params) {
+			mmGetVersion.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetVersion.defaultExpectation.params)
+		}
+	}
+
+	return mmGetVersion
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetVersion
+func (mmGetVersion *mUserRepositoryMockGetVersion) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetVersion {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("UserRepositoryMock.GetVersion mock is already set by Set")
+	}
+
+	if mmGetVersion.defaultExpectation == nil {
+		mmGetVersion.defaultExpectation = &UserRepositoryMockGetVersionExpectation{}
+	}
+
+	if mmGetVersion.defaultExpectation.params != nil {
+		mmGetVersion.mock.t.Fatalf("UserRepositoryMock.GetVersion mock is already set by Expect")
+	}
+
+	if mmGetVersion.defaultExpectation.paramPtrs == nil {
+		mmGetVersion.defaultExpectation.paramPtrs = &UserRepositoryMockGetVersionParamPtrs{}
+	}
+	mmGetVersion.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetVersion.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetVersion
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.GetVersion
+func (mmGetVersion *mUserRepositoryMockGetVersion) ExpectIdParam2(id string) *mUserRepositoryMockGetVersion {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("UserRepositoryMock.GetVersion mock is already set by Set")
+	}
+
+	if mmGetVersion.defaultExpectation == nil {
+		mmGetVersion.defaultExpectation = &UserRepositoryMockGetVersionExpectation{}
+	}
+
+	if mmGetVersion.defaultExpectation.params != nil {
+		mmGetVersion.mock.t.Fatalf("UserRepositoryMock.GetVersion mock is already set by Expect")
+	}
+
+	if mmGetVersion.defaultExpectation.paramPtrs == nil {
+		mmGetVersion.defaultExpectation.paramPtrs = &UserRepositoryMockGetVersionParamPtrs{}
+	}
+	mmGetVersion.defaultExpectation.paramPtrs.id = &id
+	mmGetVersion.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetVersion
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.GetVersion
+func (mmGetVersion *mUserRepositoryMockGetVersion) Inspect(f func(ctx context.Context, id string)) *mUserRepositoryMockGetVersion {
+	if mmGetVersion.mock.inspectFuncGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetVersion")
+	}
+
+	mmGetVersion.mock.inspectFuncGetVersion = f
+
+	return mmGetVersion
+}
+
+// Return sets up results that will be returned by UserRepository.GetVersion
+func (mmGetVersion *mUserRepositoryMockGetVersion) Return(i1 int, err error) *UserRepositoryMock {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("UserRepositoryMock.GetVersion mock is already set by Set")
+	}
+
+	if mmGetVersion.defaultExpectation == nil {
+		mmGetVersion.defaultExpectation = &UserRepositoryMockGetVersionExpectation{mock: mmGetVersion.mock}
+	}
+	mmGetVersion.defaultExpectation.results = &UserRepositoryMockGetVersionResults{i1, err}
+	mmGetVersion.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetVersion.mock
+}
+
+// Set uses given function f to mock the UserRepository.GetVersion method
+func (mmGetVersion *mUserRepositoryMockGetVersion) Set(f func(ctx context.Context, id string) (i1 int, err error)) *UserRepositoryMock {
+	if mmGetVersion.defaultExpectation != nil {
+		mmGetVersion.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetVersion method")
+	}
+
+	if len(mmGetVersion.expectations) > 0 {
+		mmGetVersion.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetVersion method")
+	}
+
+	mmGetVersion.mock.funcGetVersion = f
+	mmGetVersion.mock.funcGetVersionOrigin = minimock.CallerInfo(1)
+	return mmGetVersion.mock
+}
+
+// When sets expectation for the UserRepository.GetVersion which will trigger the result defined by the following
+// Then helper
+func (mmGetVersion *mUserRepositoryMockGetVersion) When(ctx context.Context, id string) *UserRepositoryMockGetVersionExpectation {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("UserRepositoryMock.GetVersion mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockGetVersionExpectation{
+		mock:               mmGetVersion.mock,
+		params:             &UserRepositoryMockGetVersionParams{ctx, id},
+		expectationOrigins: UserRepositoryMockGetVersionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetVersion.expectations = append(mmGetVersion.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.GetVersion return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockGetVersionExpectation) Then(i1 int, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockGetVersionResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.GetVersion should be invoked
+func (mmGetVersion *mUserRepositoryMockGetVersion) Times(n uint64) *mUserRepositoryMockGetVersion {
+	if n == 0 {
+		mmGetVersion.mock.t.Fatalf("Times of UserRepositoryMock.GetVersion mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetVersion.expectedInvocations, n)
+	mmGetVersion.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetVersion
+}
+
+func (mmGetVersion *mUserRepositoryMockGetVersion) invocationsDone() bool {
+	if len(mmGetVersion.expectations) == 0 && mmGetVersion.defaultExpectation == nil && mmGetVersion.mock.funcGetVersion == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetVersion.mock.afterGetVersionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetVersion.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetVersion implements mm_repository.UserRepository
+func (mmGetVersion *UserRepositoryMock) GetVersion(ctx context.Context, id string) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmGetVersion.beforeGetVersionCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetVersion.afterGetVersionCounter, 1)
+
+	mmGetVersion.t.Helper()
+
+	if mmGetVersion.inspectFuncGetVersion != nil {
+		mmGetVersion.inspectFuncGetVersion(ctx, id)
+	}
+
+	mm_params := UserRepositoryMockGetVersionParams{ctx, id}
+
+	// Record call args
+	mmGetVersion.GetVersionMock.mutex.Lock()
+	mmGetVersion.GetVersionMock.callArgs = append(mmGetVersion.GetVersionMock.callArgs, &mm_params)
+	mmGetVersion.GetVersionMock.mutex.Unlock()
+
+	for _, e := range mmGetVersion.GetVersionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmGetVersion.GetVersionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetVersion.GetVersionMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetVersion.GetVersionMock.defaultExpectation.params
+		mm_want_ptrs := mmGetVersion.GetVersionMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockGetVersionParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetVersion.t.Errorf("UserRepositoryMock.GetVersion got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetVersion.GetVersionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetVersion.t.Errorf("UserRepositoryMock.GetVersion got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetVersion.GetVersionMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetVersion.t.Errorf("UserRepositoryMock.GetVersion got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetVersion.GetVersionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetVersion.GetVersionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetVersion.t.Fatal("No results are set for the UserRepositoryMock.GetVersion")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmGetVersion.funcGetVersion != nil {
+		return mmGetVersion.funcGetVersion(ctx, id)
+	}
+	mmGetVersion.t.Fatalf("Unexpected call to UserRepositoryMock.GetVersion. %v %v", ctx, id)
+	return
+}
+
+// GetVersionAfterCounter returns a count of finished UserRepositoryMock.GetVersion invocations
+func (mmGetVersion *UserRepositoryMock) GetVersionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetVersion.afterGetVersionCounter)
+}
+
+// GetVersionBeforeCounter returns a count of UserRepositoryMock.GetVersion invocations
+func (mmGetVersion *UserRepositoryMock) GetVersionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetVersion.beforeGetVersionCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.GetVersion.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetVersion *mUserRepositoryMockGetVersion) Calls() []*UserRepositoryMockGetVersionParams {
+	mmGetVersion.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockGetVersionParams, len(mmGetVersion.callArgs))
+	copy(argCopy, mmGetVersion.callArgs)
+
+	mmGetVersion.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetVersionDone returns true if the count of the GetVersion invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockGetVersionDone() bool {
+	if m.GetVersionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetVersionMock.invocationsDone()
+}
+
+// MinimockGetVersionInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockGetVersionInspect() {
+	for _, e := range m.GetVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetVersion at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetVersionCounter := mm_atomic.LoadUint64(&m.afterGetVersionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetVersionMock.defaultExpectation != nil && afterGetVersionCounter < 1 {
+		if m.GetVersionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetVersion at\n%s", m.GetVersionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetVersion at\n%s with params: %#v", m.GetVersionMock.defaultExpectation.expectationOrigins.origin, *m.GetVersionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetVersion != nil && afterGetVersionCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.GetVersion at\n%s", m.funcGetVersionOrigin)
+	}
+
+	if !m.GetVersionMock.invocationsDone() && afterGetVersionCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.GetVersion at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetVersionMock.expectedInvocations), m.GetVersionMock.expectedInvocationsOrigin, afterGetVersionCounter)
+	}
+}
+
+type mUserRepositoryMockIncrementVersion struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockIncrementVersionExpectation
+	expectations       []*UserRepositoryMockIncrementVersionExpectation
+
+	callArgs []*UserRepositoryMockIncrementVersionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockIncrementVersionExpectation specifies expectation struct of the UserRepository.IncrementVersion
+type UserRepositoryMockIncrementVersionExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockIncrementVersionParams
+	paramPtrs          *UserRepositoryMockIncrementVersionParamPtrs
+	expectationOrigins UserRepositoryMockIncrementVersionExpectationOrigins
+	results            *UserRepositoryMockIncrementVersionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockIncrementVersionParams contains parameters of the UserRepository.IncrementVersion
+type UserRepositoryMockIncrementVersionParams struct {
+	ctx context.Context
+	id  string
+}
+
+// UserRepositoryMockIncrementVersionParamPtrs contains pointers to parameters of the UserRepository.IncrementVersion
+type UserRepositoryMockIncrementVersionParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// UserRepositoryMockIncrementVersionResults contains results of the UserRepository.IncrementVersion
+type UserRepositoryMockIncrementVersionResults struct {
+	i1  int
+	err error
+}
+
+// UserRepositoryMockIncrementVersionOrigins contains origins of expectations of the UserRepository.IncrementVersion
+type UserRepositoryMockIncrementVersionExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIncrementVersion *mUserRepositoryMockIncrementVersion) Optional() *mUserRepositoryMockIncrementVersion {
+	mmIncrementVersion.optional = true
+	return mmIncrementVersion
+}
+
+// Expect sets up expected params for UserRepository.IncrementVersion
+func (mmIncrementVersion *mUserRepositoryMockIncrementVersion) Expect(ctx context.Context, id string) *mUserRepositoryMockIncrementVersion {
+	if mmIncrementVersion.mock.funcIncrementVersion != nil {
+		mmIncrementVersion.mock.t.Fatalf("UserRepositoryMock.IncrementVersion mock is already set by Set")
+	}
+
+	if mmIncrementVersion.defaultExpectation == nil {
+		mmIncrementVersion.defaultExpectation = &UserRepositoryMockIncrementVersionExpectation{}
+	}
+
+	if mmIncrementVersion.defaultExpectation.paramPtrs != nil {
+		mmIncrementVersion.mock.t.Fatalf("UserRepositoryMock.IncrementVersion mock is already set by ExpectParams functions")
+	}
+
+	mmIncrementVersion.defaultExpectation.params = &UserRepositoryMockIncrementVersionParams{ctx, id}
+	mmIncrementVersion.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIncrementVersion.expectations {
+		if minimock.Equal(e.params, mmIncrementVersion.defaultExpectation.params) {
+			mmIncrementVersion.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIncrementVersion.defaultExpectation.params)
+		}
+	}
+
+	return mmIncrementVersion
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.IncrementVersion
+func (mmIncrementVersion *mUserRepositoryMockIncrementVersion) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockIncrementVersion {
+	if mmIncrementVersion.mock.funcIncrementVersion != nil {
+		mmIncrementVersion.mock.t.Fatalf("UserRepositoryMock.IncrementVersion mock is already set by Set")
+	}
+
+	if mmIncrementVersion.defaultExpectation == nil {
+		mmIncrementVersion.defaultExpectation = &UserRepositoryMockIncrementVersionExpectation{}
+	}
+
+	if mmIncrementVersion.defaultExpectation.params != nil {
+		mmIncrementVersion.mock.t.Fatalf("UserRepositoryMock.IncrementVersion mock is already set by Expect")
+	}
+
+	if mmIncrementVersion.defaultExpectation.paramPtrs == nil {
+		mmIncrementVersion.defaultExpectation.paramPtrs = &UserRepositoryMockIncrementVersionParamPtrs{}
+	}
+	mmIncrementVersion.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIncrementVersion.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmIncrementVersion
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.IncrementVersion
+func (mmIncrementVersion *mUserRepositoryMockIncrementVersion) ExpectIdParam2(id string) *mUserRepositoryMockIncrementVersion {
+	if mmIncrementVersion.mock.funcIncrementVersion != nil {
+		mmIncrementVersion.mock.t.Fatalf("UserRepositoryMock.IncrementVersion mock is already set by Set")
+	}
+
+	if mmIncrementVersion.defaultExpectation == nil {
+		mmIncrementVersion.defaultExpectation = &UserRepositoryMockIncrementVersionExpectation{}
+	}
+
+	if mmIncrementVersion.defaultExpectation.params != nil {
+		mmIncrementVersion.mock.t.Fatalf("UserRepositoryMock.IncrementVersion mock is already set by Expect")
+	}
+
+	if mmIncrementVersion.defaultExpectation.paramPtrs == nil {
+		mmIncrementVersion.defaultExpectation.paramPtrs = &UserRepositoryMockIncrementVersionParamPtrs{}
+	}
+	mmIncrementVersion.defaultExpectation.paramPtrs.id = &id
+	mmIncrementVersion.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmIncrementVersion
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.IncrementVersion
+func (mmIncrementVersion *mUserRepositoryMockIncrementVersion) Inspect(f func(ctx context.Context, id string)) *mUserRepositoryMockIncrementVersion {
+	if mmIncrementVersion.mock.inspectFuncIncrementVersion != nil {
+		mmIncrementVersion.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.IncrementVersion")
+	}
+
+	mmIncrementVersion.mock.inspectFuncIncrementVersion = f
+
+	return mmIncrementVersion
+}
+
+// Return sets up results that will be returned by UserRepository.IncrementVersion
+func (mmIncrementVersion *mUserRepositoryMockIncrementVersion) Return(i1 int, err error) *UserRepositoryMock {
+	if mmIncrementVersion.mock.funcIncrementVersion != nil {
+		mmIncrementVersion.mock.t.Fatalf("UserRepositoryMock.IncrementVersion mock is already set by Set")
+	}
+
+	if mmIncrementVersion.defaultExpectation == nil {
+		mmIncrementVersion.defaultExpectation = &UserRepositoryMockIncrementVersionExpectation{mock: mmIncrementVersion.mock}
+	}
+	mmIncrementVersion.defaultExpectation.results = &UserRepositoryMockIncrementVersionResults{i1, err}
+	mmIncrementVersion.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIncrementVersion.mock
+}
+
+// Set uses given function f to mock the UserRepository.IncrementVersion method
+func (mmIncrementVersion *mUserRepositoryMockIncrementVersion) Set(f func(ctx context.Context, id string) (i1 int, err error)) *UserRepositoryMock {
+	if mmIncrementVersion.defaultExpectation != nil {
+		mmIncrementVersion.mock.t.Fatalf("Default expectation is already set for the UserRepository.IncrementVersion method")
+	}
+
+	if len(mmIncrementVersion.expectations) > 0 {
+		mmIncrementVersion.mock.t.Fatalf("Some expectations are already set for the UserRepository.IncrementVersion method")
+	}
+
+	mmIncrementVersion.mock.funcIncrementVersion = f
+	mmIncrementVersion.mock.funcIncrementVersionOrigin = minimock.CallerInfo(1)
+	return mmIncrementVersion.mock
+}
+
+// When sets expectation for the UserRepository.IncrementVersion which will trigger the result defined by the following
+// Then helper
+func (mmIncrementVersion *mUserRepositoryMockIncrementVersion) When(ctx context.Context, id string) *UserRepositoryMockIncrementVersionExpectation {
+	if mmIncrementVersion.mock.funcIncrementVersion != nil {
+		mmIncrementVersion.mock.t.Fatalf("UserRepositoryMock.IncrementVersion mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockIncrementVersionExpectation{
+		mock:               mmIncrementVersion.mock,
+		params:             &UserRepositoryMockIncrementVersionParams{ctx, id},
+		expectationOrigins: UserRepositoryMockIncrementVersionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmIncrementVersion.expectations = append(mmIncrementVersion.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.IncrementVersion return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockIncrementVersionExpectation) Then(i1 int, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockIncrementVersionResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.IncrementVersion should be invoked
+func (mmIncrementVersion *mUserRepositoryMockIncrementVersion) Times(n uint64) *mUserRepositoryMockIncrementVersion {
+	if n == 0 {
+		mmIncrementVersion.mock.t.Fatalf("Times of UserRepositoryMock.IncrementVersion mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmIncrementVersion.expectedInvocations, n)
+	mmIncrementVersion.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIncrementVersion
+}
+
+func (mmIncrementVersion *mUserRepositoryMockIncrementVersion) invocationsDone() bool {
+	if len(mmIncrementVersion.expectations) == 0 && mmIncrementVersion.defaultExpectation == nil && mmIncrementVersion.mock.funcIncrementVersion == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmIncrementVersion.mock.afterIncrementVersionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIncrementVersion.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// IncrementVersion implements mm_repository.UserRepository
+func (mmIncrementVersion *UserRepositoryMock) IncrementVersion(ctx context.Context, id string) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmIncrementVersion.beforeIncrementVersionCounter, 1)
+	defer mm_atomic.AddUint64(&mmIncrementVersion.afterIncrementVersionCounter, 1)
+
+	mmIncrementVersion.t.Helper()
+
+	if mmIncrementVersion.inspectFuncIncrementVersion != nil {
+		mmIncrementVersion.inspectFuncIncrementVersion(ctx, id)
+	}
+
+	mm_params := UserRepositoryMockIncrementVersionParams{ctx, id}
+
+	// Record call args
+	mmIncrementVersion.IncrementVersionMock.mutex.Lock()
+	mmIncrementVersion.IncrementVersionMock.callArgs = append(mmIncrementVersion.IncrementVersionMock.callArgs, &mm_params)
+	mmIncrementVersion.IncrementVersionMock.mutex.Unlock()
+
+	for _, e := range mmIncrementVersion.IncrementVersionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmIncrementVersion.IncrementVersionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIncrementVersion.IncrementVersionMock.defaultExpectation.Counter, 1)
+		mm_want := mmIncrementVersion.IncrementVersionMock.defaultExpectation.params
+		mm_want_ptrs := mmIncrementVersion.IncrementVersionMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockIncrementVersionParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIncrementVersion.t.Errorf("UserRepositoryMock.IncrementVersion got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIncrementVersion.IncrementVersionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmIncrementVersion.t.Errorf("UserRepositoryMock.IncrementVersion got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIncrementVersion.IncrementVersionMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIncrementVersion.t.Errorf("UserRepositoryMock.IncrementVersion got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIncrementVersion.IncrementVersionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIncrementVersion.IncrementVersionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIncrementVersion.t.Fatal("No results are set for the UserRepositoryMock.IncrementVersion")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmIncrementVersion.funcIncrementVersion != nil {
+		return mmIncrementVersion.funcIncrementVersion(ctx, id)
+	}
+	mmIncrementVersion.t.Fatalf("Unexpected call to UserRepositoryMock.IncrementVersion. %v %v", ctx, id)
+	return
+}
+
+// IncrementVersionAfterCounter returns a count of finished UserRepositoryMock.IncrementVersion invocations
+func (mmIncrementVersion *UserRepositoryMock) IncrementVersionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIncrementVersion.afterIncrementVersionCounter)
+}
+
+// IncrementVersionBeforeCounter returns a count of UserRepositoryMock.IncrementVersion invocations
+func (mmIncrementVersion *UserRepositoryMock) IncrementVersionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIncrementVersion.beforeIncrementVersionCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.IncrementVersion.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIncrementVersion *mUserRepositoryMockIncrementVersion) Calls() []*UserRepositoryMockIncrementVersionParams {
+	mmIncrementVersion.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockIncrementVersionParams, len(mmIncrementVersion.callArgs))
+	copy(argCopy, mmIncrementVersion.callArgs)
+
+	mmIncrementVersion.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIncrementVersionDone returns true if the count of the IncrementVersion invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockIncrementVersionDone() bool {
+	if m.IncrementVersionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.IncrementVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.IncrementVersionMock.invocationsDone()
+}
+
+// MinimockIncrementVersionInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockIncrementVersionInspect() {
+	for _, e := range m.IncrementVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.IncrementVersion at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterIncrementVersionCounter := mm_atomic.LoadUint64(&m.afterIncrementVersionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IncrementVersionMock.defaultExpectation != nil && afterIncrementVersionCounter < 1 {
+		if m.IncrementVersionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.IncrementVersion at\n%s", m.IncrementVersionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.IncrementVersion at\n%s with params: %#v", m.IncrementVersionMock.defaultExpectation.expectationOrigins.origin, *m.IncrementVersionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIncrementVersion != nil && afterIncrementVersionCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.IncrementVersion at\n%s", m.funcIncrementVersionOrigin)
+	}
+
+	if !m.IncrementVersionMock.invocationsDone() && afterIncrementVersionCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.IncrementVersion at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IncrementVersionMock.expectedInvocations), m.IncrementVersionMock.expectedInvocationsOrigin, afterIncrementVersionCounter)
+	}
+}
+
+type mUserRepositoryMockList struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockListExpectation
+	expectations       []*UserRepositoryMockListExpectation
+
+	callArgs []*UserRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockListExpectation specifies expectation struct of the UserRepository.List
+type UserRepositoryMockListExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockListParams
+	paramPtrs          *UserRepositoryMockListParamPtrs
+	expectationOrigins UserRepositoryMockListExpectationOrigins
+	results            *UserRepositoryMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockListParams contains parameters of the UserRepository.List
+type UserRepositoryMockListParams struct {
+	ctx   context.Context
+	query *model.UserListQuery
+}
+
+// UserRepositoryMockListParamPtrs contains pointers to parameters of the UserRepository.List
+type UserRepositoryMockListParamPtrs struct {
+	ctx   *context.Context
+	query **model.UserListQuery
+}
+
+// UserRepositoryMockListResults contains results of the UserRepository.List
+type UserRepositoryMockListResults struct {
+	upa1 []*model.User
+	err  error
+}
+
+// UserRepositoryMockListOrigins contains origins of expectations of the UserRepository.List
+type UserRepositoryMockListExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originQuery string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mUserRepositoryMockList) Optional() *mUserRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for UserRepository.List
+func (mmList *mUserRepositoryMockList) Expect(ctx context.Context, query *model.UserListQuery) *mUserRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("UserRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &UserRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("UserRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &UserRepositoryMockListParams{ctx, query}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.List
+func (mmList *mUserRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("UserRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &UserRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("UserRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &UserRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmFindByName
+	return mmList
 }
 
-// ExpectNameParam2 sets up expected param name for UserRepository.FindByName
-func (mmFindByName *mUserRepositoryMockFindByName) ExpectNameParam2(name string) *mUserRepositoryMockFindByName {
-	if mmFindByName.mock.funcFindByName != nil {
-		mmFindByName.mock.t.Fatalf("UserRepositoryMock.FindByName mock is already set by Set")
+// ExpectQueryParam2 sets up expected param query for UserRepository.List
+func (mmList *mUserRepositoryMockList) ExpectQueryParam2(query *model.UserListQuery) *mUserRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("UserRepositoryMock.List mock is already set by Set")
 	}
 
-	if mmFindByName.defaultExpectation == nil {
-		mmFindByName.defaultExpectation = &UserRepositoryMockFindByNameExpectation{}
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &UserRepositoryMockListExpectation{}
 	}
 
-	if mmFindByName.defaultExpectation.params != nil {
-		mmFindByName.mock.t.Fatalf("UserRepositoryMock.FindByName mock is already set by Expect")
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("UserRepositoryMock.List mock is already set by Expect")
 	}
 
-	if mmFindByName.defaultExpectation.paramPtrs == nil {
-		mmFindByName.defaultExpectation.paramPtrs = &UserRepositoryMockFindByNameParamPtrs{}
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &UserRepositoryMockListParamPtrs{}
 	}
-	mmFindByName.defaultExpectation.paramPtrs.name = &name
-	mmFindByName.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+	mmList.defaultExpectation.paramPtrs.query = &query
+	mmList.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
 
-	return mmFindByName
+	return mmList
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserRepository.FindByName
-func (mmFindByName *mUserRepositoryMockFindByName) Inspect(f func(ctx context.Context, name string)) *mUserRepositoryMockFindByName {
-	if mmFindByName.mock.inspectFuncFindByName != nil {
-		mmFindByName.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.FindByName")
+// Inspect accepts an inspector function that has same arguments as the UserRepository.List
+func (mmList *mUserRepositoryMockList) Inspect(f func(ctx context.Context, query *model.UserListQuery)) *mUserRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.List")
 	}
 
-	mmFindByName.mock.inspectFuncFindByName = f
+	mmList.mock.inspectFuncList = f
 
-	return mmFindByName
+	return mmList
 }
 
-// Return sets up results that will be returned by UserRepository.FindByName
-func (mmFindByName *mUserRepositoryMockFindByName) Return(up1 *model.User, err error) *UserRepositoryMock {
-	if mmFindByName.mock.funcFindByName != nil {
-		mmFindByName.mock.t.Fatalf("UserRepositoryMock.FindByName mock is already set by Set")
+// Return sets up results that will be returned by UserRepository.List
+func (mmList *mUserRepositoryMockList) Return(upa1 []*model.User, err error) *UserRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("UserRepositoryMock.List mock is already set by Set")
 	}
 
-	if mmFindByName.defaultExpectation == nil {
-		mmFindByName.defaultExpectation = &UserRepositoryMockFindByNameExpectation{mock: mmFindByName.mock}
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &UserRepositoryMockListExpectation{mock: mmList.mock}
 	}
-	mmFindByName.defaultExpectation.results = &UserRepositoryMockFindByNameResults{up1, err}
-	mmFindByName.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmFindByName.mock
+	mmList.defaultExpectation.results = &UserRepositoryMockListResults{upa1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
 }
 
-// Set uses given function f to mock the UserRepository.FindByName method
-func (mmFindByName *mUserRepositoryMockFindByName) Set(f func(ctx context.Context, name string) (up1 *model.User, err error)) *UserRepositoryMock {
-	if mmFindByName.defaultExpectation != nil {
-		mmFindByName.mock.t.Fatalf("Default expectation is already set for the UserRepository.FindByName method")
+// Set uses given function f to mock the UserRepository.List method
+func (mmList *mUserRepositoryMockList) Set(f func(ctx context.Context, query *model.UserListQuery) (upa1 []*model.User, err error)) *UserRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the UserRepository.List method")
 	}
 
-	if len(mmFindByName.expectations) > 0 {
-		mmFindByName.mock.t.Fatalf("Some expectations are already set for the UserRepository.FindByName method")
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the UserRepository.List method")
 	}
 
-	mmFindByName.mock.funcFindByName = f
-	mmFindByName.mock.funcFindByNameOrigin = minimock.CallerInfo(1)
-	return mmFindByName.mock
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
 }
 
-// When sets expectation for the UserRepository.FindByName which will trigger the result defined by the following
+// When sets expectation for the UserRepository.List which will trigger the result defined by the following
 // Then helper
-func (mmFindByName *mUserRepositoryMockFindByName) When(ctx context.Context, name string) *UserRepositoryMockFindByNameExpectation {
-	if mmFindByName.mock.funcFindByName != nil {
-		mmFindByName.mock.t.Fatalf("UserRepositoryMock.FindByName mock is already set by Set")
+func (mmList *mUserRepositoryMockList) When(ctx context.Context, query *model.UserListQuery) *UserRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("UserRepositoryMock.List mock is already set by Set")
 	}
 
-	expectation := &UserRepositoryMockFindByNameExpectation{
-		mock:               mmFindByName.mock,
-		params:             &UserRepositoryMockFindByNameParams{ctx, name},
-		expectationOrigins: UserRepositoryMockFindByNameExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserRepositoryMockListExpectation{
+		mock:               mmList.mock,
+		params:             &UserRepositoryMockListParams{ctx, query},
+		expectationOrigins: UserRepositoryMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmFindByName.expectations = append(mmFindByName.expectations, expectation)
+	mmList.expectations = append(mmList.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserRepository.FindByName return parameters for the expectation previously defined by the When method
-func (e *UserRepositoryMockFindByNameExpectation) Then(up1 *model.User, err error) *UserRepositoryMock {
-	e.results = &UserRepositoryMockFindByNameResults{up1, err}
+// Then sets up UserRepository.List return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockListExpectation) Then(upa1 []*model.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockListResults{upa1, err}
 	return e.mock
 }
 
-// Times sets number of times UserRepository.FindByName should be invoked
-func (mmFindByName *mUserRepositoryMockFindByName) Times(n uint64) *mUserRepositoryMockFindByName {
+// Times sets number of times UserRepository.List should be invoked
+func (mmList *mUserRepositoryMockList) Times(n uint64) *mUserRepositoryMockList {
 	if n == 0 {
-		mmFindByName.mock.t.Fatalf("Times of UserRepositoryMock.FindByName mock can not be zero")
+		mmList.mock.t.Fatalf("Times of UserRepositoryMock.List mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmFindByName.expectedInvocations, n)
-	mmFindByName.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmFindByName
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
 }
 
-func (mmFindByName *mUserRepositoryMockFindByName) invocationsDone() bool {
-	if len(mmFindByName.expectations) == 0 && mmFindByName.defaultExpectation == nil && mmFindByName.mock.funcFindByName == nil {
+func (mmList *mUserRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmFindByName.mock.afterFindByNameCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmFindByName.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// FindByName implements mm_repository.UserRepository
-func (mmFindByName *UserRepositoryMock) FindByName(ctx context.Context, name string) (up1 *model.User, err error) {
-	mm_atomic.AddUint64(&mmFindByName.beforeFindByNameCounter, 1)
-	defer mm_atomic.AddUint64(&mmFindByName.afterFindByNameCounter, 1)
+// List implements mm_repository.UserRepository
+func (mmList *UserRepositoryMock) List(ctx context.Context, query *model.UserListQuery) (upa1 []*model.User, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
 
-	mmFindByName.t.Helper()
+	mmList.t.Helper()
 
-	if mmFindByName.inspectFuncFindByName != nil {
-		mmFindByName.inspectFuncFindByName(ctx, name)
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, query)
 	}
 
-	mm_params := UserRepositoryMockFindByNameParams{ctx, name}
+	mm_params := UserRepositoryMockListParams{ctx, query}
 
 	// Record call args
-	mmFindByName.FindByNameMock.mutex.Lock()
-	mmFindByName.FindByNameMock.callArgs = append(mmFindByName.FindByNameMock.callArgs, &mm_params)
-	mmFindByName.FindByNameMock.mutex.Unlock()
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
 
-	for _, e := range mmFindByName.FindByNameMock.expectations {
+	for _, e := range mmList.ListMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.up1, e.results.err
+			return e.results.upa1, e.results.err
 		}
 	}
 
-	if mmFindByName.FindByNameMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmFindByName.FindByNameMock.defaultExpectation.Counter, 1)
-		mm_want := mmFindByName.FindByNameMock.defaultExpectation.params
-		mm_want_ptrs := mmFindByName.FindByNameMock.defaultExpectation.paramPtrs
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
 
-		mm_got := UserRepositoryMockFindByNameParams{ctx, name}
+		mm_got := UserRepositoryMockListParams{ctx, query}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmFindByName.t.Errorf("UserRepositoryMock.FindByName got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmFindByName.FindByNameMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmList.t.Errorf("UserRepositoryMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
-				mmFindByName.t.Errorf("UserRepositoryMock.FindByName got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmFindByName.FindByNameMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmList.t.Errorf("UserRepositoryMock.List got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmFindByName.t.Errorf("UserRepositoryMock.FindByName got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmFindByName.FindByNameMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmList.t.Errorf("UserRepositoryMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmFindByName.FindByNameMock.defaultExpectation.results
+		mm_results := mmList.ListMock.defaultExpectation.results
 		if mm_results == nil {
-			mmFindByName.t.Fatal("No results are set for the UserRepositoryMock.FindByName")
+			mmList.t.Fatal("No results are set for the UserRepositoryMock.List")
 		}
-		return (*mm_results).up1, (*mm_results).err
+		return (*mm_results).upa1, (*mm_results).err
 	}
-	if mmFindByName.funcFindByName != nil {
-		return mmFindByName.funcFindByName(ctx, name)
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, query)
 	}
-	mmFindByName.t.Fatalf("Unexpected call to UserRepositoryMock.FindByName. %v %v", ctx, name)
+	mmList.t.Fatalf("Unexpected call to UserRepositoryMock.List. %v %v", ctx, query)
 	return
 }
 
-// FindByNameAfterCounter returns a count of finished UserRepositoryMock.FindByName invocations
-func (mmFindByName *UserRepositoryMock) FindByNameAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmFindByName.afterFindByNameCounter)
+// ListAfterCounter returns a count of finished UserRepositoryMock.List invocations
+func (mmList *UserRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
 }
 
-// FindByNameBeforeCounter returns a count of UserRepositoryMock.FindByName invocations
-func (mmFindByName *UserRepositoryMock) FindByNameBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmFindByName.beforeFindByNameCounter)
+// ListBeforeCounter returns a count of UserRepositoryMock.List invocations
+func (mmList *UserRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserRepositoryMock.FindByName.
+// Calls returns a list of arguments used in each call to UserRepositoryMock.List.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmFindByName *mUserRepositoryMockFindByName) Calls() []*UserRepositoryMockFindByNameParams {
-	mmFindByName.mutex.RLock()
+func (mmList *mUserRepositoryMockList) Calls() []*UserRepositoryMockListParams {
+	mmList.mutex.RLock()
 
-	argCopy := make([]*UserRepositoryMockFindByNameParams, len(mmFindByName.callArgs))
-	copy(argCopy, mmFindByName.callArgs)
+	argCopy := make([]*UserRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
 
-	mmFindByName.mutex.RUnlock()
+	mmList.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockFindByNameDone returns true if the count of the FindByName invocations corresponds
+// MinimockListDone returns true if the count of the List invocations corresponds
 // the number of defined expectations
-func (m *UserRepositoryMock) MinimockFindByNameDone() bool {
-	if m.FindByNameMock.optional {
+func (m *UserRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.FindByNameMock.expectations {
+	for _, e := range m.ListMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.FindByNameMock.invocationsDone()
+	return m.ListMock.invocationsDone()
 }
 
-// MinimockFindByNameInspect logs each unmet expectation
-func (m *UserRepositoryMock) MinimockFindByNameInspect() {
-	for _, e := range m.FindByNameMock.expectations {
+// MinimockListInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserRepositoryMock.FindByName at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterFindByNameCounter := mm_atomic.LoadUint64(&m.afterFindByNameCounter)
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.FindByNameMock.defaultExpectation != nil && afterFindByNameCounter < 1 {
-		if m.FindByNameMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserRepositoryMock.FindByName at\n%s", m.FindByNameMock.defaultExpectation.returnOrigin)
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserRepositoryMock.FindByName at\n%s with params: %#v", m.FindByNameMock.defaultExpectation.expectationOrigins.origin, *m.FindByNameMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcFindByName != nil && afterFindByNameCounter < 1 {
-		m.t.Errorf("Expected call to UserRepositoryMock.FindByName at\n%s", m.funcFindByNameOrigin)
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.List at\n%s", m.funcListOrigin)
 	}
 
-	if !m.FindByNameMock.invocationsDone() && afterFindByNameCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserRepositoryMock.FindByName at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.FindByNameMock.expectedInvocations), m.FindByNameMock.expectedInvocationsOrigin, afterFindByNameCounter)
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
 	}
 }
 
-type mUserRepositoryMockGet struct {
+type mUserRepositoryMockPurgeDeleted struct {
 	optional           bool
 	mock               *UserRepositoryMock
-	defaultExpectation *UserRepositoryMockGetExpectation
-	expectations       []*UserRepositoryMockGetExpectation
+	defaultExpectation *UserRepositoryMockPurgeDeletedExpectation
+	expectations       []*UserRepositoryMockPurgeDeletedExpectation
 
-	callArgs []*UserRepositoryMockGetParams
+	callArgs []*UserRepositoryMockPurgeDeletedParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserRepositoryMockGetExpectation specifies expectation struct of the UserRepository.Get
-type UserRepositoryMockGetExpectation struct {
+// UserRepositoryMockPurgeDeletedExpectation specifies expectation struct of the UserRepository.PurgeDeleted
+type UserRepositoryMockPurgeDeletedExpectation struct {
 	mock               *UserRepositoryMock
-	params             *UserRepositoryMockGetParams
-	paramPtrs          *UserRepositoryMockGetParamPtrs
-	expectationOrigins UserRepositoryMockGetExpectationOrigins
-	results            *UserRepositoryMockGetResults
+	params             *UserRepositoryMockPurgeDeletedParams
+	paramPtrs          *UserRepositoryMockPurgeDeletedParamPtrs
+	expectationOrigins UserRepositoryMockPurgeDeletedExpectationOrigins
+	results            *UserRepositoryMockPurgeDeletedResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserRepositoryMockGetParams contains parameters of the UserRepository.Get
-type UserRepositoryMockGetParams struct {
-	ctx context.Context
-	id  string
+// UserRepositoryMockPurgeDeletedParams contains parameters of the UserRepository.PurgeDeleted
+type UserRepositoryMockPurgeDeletedParams struct {
+	ctx           context.Context
+	deletedBefore time.Time
+	limit         int
 }
 
-// UserRepositoryMockGetParamPtrs contains pointers to parameters of the UserRepository.Get
-type UserRepositoryMockGetParamPtrs struct {
-	ctx *context.Context
-	id  *string
+// UserRepositoryMockPurgeDeletedParamPtrs contains pointers to parameters of the UserRepository.PurgeDeleted
+type UserRepositoryMockPurgeDeletedParamPtrs struct {
+	ctx           *context.Context
+	deletedBefore *time.Time
+	limit         *int
 }
 
-// UserRepositoryMockGetResults contains results of the UserRepository.Get
-type UserRepositoryMockGetResults struct {
-	up1 *model.User
+// UserRepositoryMockPurgeDeletedResults contains results of the UserRepository.PurgeDeleted
+type UserRepositoryMockPurgeDeletedResults struct {
+	sa1 []string
 	err error
 }
 
-// UserRepositoryMockGetOrigins contains origins of expectations of the UserRepository.Get
-type UserRepositoryMockGetExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originId  string
+// UserRepositoryMockPurgeDeletedOrigins contains origins of expectations of the UserRepository.PurgeDeleted
+type UserRepositoryMockPurgeDeletedExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originDeletedBefore string
+	originLimit         string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1183,342 +4041,373 @@ type UserRepositoryMockGetExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGet *mUserRepositoryMockGet) Optional() *mUserRepositoryMockGet {
-	mmGet.optional = true
-	return mmGet
+func (mmPurgeDeleted *mUserRepositoryMockPurgeDeleted) Optional() *mUserRepositoryMockPurgeDeleted {
+	mmPurgeDeleted.optional = true
+	return mmPurgeDeleted
 }
 
-// Expect sets up expected params for UserRepository.Get
-func (mmGet *mUserRepositoryMockGet) Expect(ctx context.Context, id string) *mUserRepositoryMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Set")
+// Expect sets up expected params for UserRepository.PurgeDeleted
+func (mmPurgeDeleted *mUserRepositoryMockPurgeDeleted) Expect(ctx context.Context, deletedBefore time.Time, limit int) *mUserRepositoryMockPurgeDeleted {
+	if mmPurgeDeleted.mock.funcPurgeDeleted != nil {
+		mmPurgeDeleted.mock.t.Fatalf("UserRepositoryMock.PurgeDeleted mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &UserRepositoryMockGetExpectation{}
+	if mmPurgeDeleted.defaultExpectation == nil {
+		mmPurgeDeleted.defaultExpectation = &UserRepositoryMockPurgeDeletedExpectation{}
 	}
 
-	if mmGet.defaultExpectation.paramPtrs != nil {
-		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by ExpectParams functions")
+	if mmPurgeDeleted.defaultExpectation.paramPtrs != nil {
+		mmPurgeDeleted.mock.t.Fatalf("UserRepositoryMock.PurgeDeleted mock is already set by ExpectParams functions")
 	}
 
-	mmGet.defaultExpectation.params = &UserRepositoryMockGetParams{ctx, id}
-	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGet.expectations {
-		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
-			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+	mmPurgeDeleted.defaultExpectation.params = &UserRepositoryMockPurgeDeletedParams{ctx, deletedBefore, limit}
+	mmPurgeDeleted.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeDeleted.expectations {
+		if minimock.Equal(e.params, mmPurgeDeleted.defaultExpectation.params) {
+			mmPurgeDeleted.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeDeleted.defaultExpectation.params)
 		}
 	}
 
-	return mmGet
+	return mmPurgeDeleted
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserRepository.Get
-func (mmGet *mUserRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.PurgeDeleted
+func (mmPurgeDeleted *mUserRepositoryMockPurgeDeleted) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockPurgeDeleted {
+	if mmPurgeDeleted.mock.funcPurgeDeleted != nil {
+		mmPurgeDeleted.mock.t.Fatalf("UserRepositoryMock.PurgeDeleted mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &UserRepositoryMockGetExpectation{}
+	if mmPurgeDeleted.defaultExpectation == nil {
+		mmPurgeDeleted.defaultExpectation = &UserRepositoryMockPurgeDeletedExpectation{}
 	}
 
-	if mmGet.defaultExpectation.params != nil {
-		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Expect")
+	if mmPurgeDeleted.defaultExpectation.params != nil {
+		mmPurgeDeleted.mock.t.Fatalf("UserRepositoryMock.PurgeDeleted mock is already set by Expect")
 	}
 
-	if mmGet.defaultExpectation.paramPtrs == nil {
-		mmGet.defaultExpectation.paramPtrs = &UserRepositoryMockGetParamPtrs{}
+	if mmPurgeDeleted.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeleted.defaultExpectation.paramPtrs = &UserRepositoryMockPurgeDeletedParamPtrs{}
 	}
-	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmPurgeDeleted.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeDeleted.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGet
+	return mmPurgeDeleted
 }
 
-// ExpectIdParam2 sets up expected param id for UserRepository.Get
-func (mmGet *mUserRepositoryMockGet) ExpectIdParam2(id string) *mUserRepositoryMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Set")
+// ExpectDeletedBeforeParam2 sets up expected param deletedBefore for UserRepository.PurgeDeleted
+func (mmPurgeDeleted *mUserRepositoryMockPurgeDeleted) ExpectDeletedBeforeParam2(deletedBefore time.Time) *mUserRepositoryMockPurgeDeleted {
+	if mmPurgeDeleted.mock.funcPurgeDeleted != nil {
+		mmPurgeDeleted.mock.t.Fatalf("UserRepositoryMock.PurgeDeleted mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &UserRepositoryMockGetExpectation{}
+	if mmPurgeDeleted.defaultExpectation == nil {
+		mmPurgeDeleted.defaultExpectation = &UserRepositoryMockPurgeDeletedExpectation{}
 	}
 
-	if mmGet.defaultExpectation.params != nil {
-		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Expect")
+	if mmPurgeDeleted.defaultExpectation.params != nil {
+		mmPurgeDeleted.mock.t.Fatalf("UserRepositoryMock.PurgeDeleted mock is already set by Expect")
 	}
 
-	if mmGet.defaultExpectation.paramPtrs == nil {
-		mmGet.defaultExpectation.paramPtrs = &UserRepositoryMockGetParamPtrs{}
+	if mmPurgeDeleted.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeleted.defaultExpectation.paramPtrs = &UserRepositoryMockPurgeDeletedParamPtrs{}
 	}
-	mmGet.defaultExpectation.paramPtrs.id = &id
-	mmGet.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmPurgeDeleted.defaultExpectation.paramPtrs.deletedBefore = &deletedBefore
+	mmPurgeDeleted.defaultExpectation.expectationOrigins.originDeletedBefore = minimock.CallerInfo(1)
 
-	return mmGet
+	return mmPurgeDeleted
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserRepository.Get
-func (mmGet *mUserRepositoryMockGet) Inspect(f func(ctx context.Context, id string)) *mUserRepositoryMockGet {
-	if mmGet.mock.inspectFuncGet != nil {
-		mmGet.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.Get")
+// ExpectLimitParam3 sets up expected param limit for UserRepository.PurgeDeleted
+func (mmPurgeDeleted *mUserRepositoryMockPurgeDeleted) ExpectLimitParam3(limit int) *mUserRepositoryMockPurgeDeleted {
+	if mmPurgeDeleted.mock.funcPurgeDeleted != nil {
+		mmPurgeDeleted.mock.t.Fatalf("UserRepositoryMock.PurgeDeleted mock is already set by Set")
 	}
 
-	mmGet.mock.inspectFuncGet = f
+	if mmPurgeDeleted.defaultExpectation == nil {
+		mmPurgeDeleted.defaultExpectation = &UserRepositoryMockPurgeDeletedExpectation{}
+	}
 
-	return mmGet
+	if mmPurgeDeleted.defaultExpectation.params != nil {
+		mmPurgeDeleted.mock.t.Fatalf("UserRepositoryMock.PurgeDeleted mock is already set by Expect")
+	}
+
+	if mmPurgeDeleted.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeleted.defaultExpectation.paramPtrs = &UserRepositoryMockPurgeDeletedParamPtrs{}
+	}
+	mmPurgeDeleted.defaultExpectation.paramPtrs.limit = &limit
+	mmPurgeDeleted.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmPurgeDeleted
 }
 
-// Return sets up results that will be returned by UserRepository.Get
-func (mmGet *mUserRepositoryMockGet) Return(up1 *model.User, err error) *UserRepositoryMock {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the UserRepository.PurgeDeleted
+func (mmPurgeDeleted *mUserRepositoryMockPurgeDeleted) Inspect(f func(ctx context.Context, deletedBefore time.Time, limit int)) *mUserRepositoryMockPurgeDeleted {
+	if mmPurgeDeleted.mock.inspectFuncPurgeDeleted != nil {
+		mmPurgeDeleted.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.PurgeDeleted")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &UserRepositoryMockGetExpectation{mock: mmGet.mock}
+	mmPurgeDeleted.mock.inspectFuncPurgeDeleted = f
+
+	return mmPurgeDeleted
+}
+
+// Return sets up results that will be returned by UserRepository.PurgeDeleted
+func (mmPurgeDeleted *mUserRepositoryMockPurgeDeleted) Return(sa1 []string, err error) *UserRepositoryMock {
+	if mmPurgeDeleted.mock.funcPurgeDeleted != nil {
+		mmPurgeDeleted.mock.t.Fatalf("UserRepositoryMock.PurgeDeleted mock is already set by Set")
 	}
-	mmGet.defaultExpectation.results = &UserRepositoryMockGetResults{up1, err}
-	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGet.mock
+
+	if mmPurgeDeleted.defaultExpectation == nil {
+		mmPurgeDeleted.defaultExpectation = &UserRepositoryMockPurgeDeletedExpectation{mock: mmPurgeDeleted.mock}
+	}
+	mmPurgeDeleted.defaultExpectation.results = &UserRepositoryMockPurgeDeletedResults{sa1, err}
+	mmPurgeDeleted.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeleted.mock
 }
 
-// Set uses given function f to mock the UserRepository.Get method
-func (mmGet *mUserRepositoryMockGet) Set(f func(ctx context.Context, id string) (up1 *model.User, err error)) *UserRepositoryMock {
-	if mmGet.defaultExpectation != nil {
-		mmGet.mock.t.Fatalf("Default expectation is already set for the UserRepository.Get method")
+// Set uses given function f to mock the UserRepository.PurgeDeleted method
+func (mmPurgeDeleted *mUserRepositoryMockPurgeDeleted) Set(f func(ctx context.Context, deletedBefore time.Time, limit int) (sa1 []string, err error)) *UserRepositoryMock {
+	if mmPurgeDeleted.defaultExpectation != nil {
+		mmPurgeDeleted.mock.t.Fatalf("Default expectation is already set for the UserRepository.PurgeDeleted method")
 	}
 
-	if len(mmGet.expectations) > 0 {
-		mmGet.mock.t.Fatalf("Some expectations are already set for the UserRepository.Get method")
+	if len(mmPurgeDeleted.expectations) > 0 {
+		mmPurgeDeleted.mock.t.Fatalf("Some expectations are already set for the UserRepository.PurgeDeleted method")
 	}
 
-	mmGet.mock.funcGet = f
-	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
-	return mmGet.mock
+	mmPurgeDeleted.mock.funcPurgeDeleted = f
+	mmPurgeDeleted.mock.funcPurgeDeletedOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeleted.mock
 }
 
-// When sets expectation for the UserRepository.Get which will trigger the result defined by the following
+// When sets expectation for the UserRepository.PurgeDeleted which will trigger the result defined by the following
 // Then helper
-func (mmGet *mUserRepositoryMockGet) When(ctx context.Context, id string) *UserRepositoryMockGetExpectation {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Set")
+func (mmPurgeDeleted *mUserRepositoryMockPurgeDeleted) When(ctx context.Context, deletedBefore time.Time, limit int) *UserRepositoryMockPurgeDeletedExpectation {
+	if mmPurgeDeleted.mock.funcPurgeDeleted != nil {
+		mmPurgeDeleted.mock.t.Fatalf("UserRepositoryMock.PurgeDeleted mock is already set by Set")
 	}
 
-	expectation := &UserRepositoryMockGetExpectation{
-		mock:               mmGet.mock,
-		params:             &UserRepositoryMockGetParams{ctx, id},
-		expectationOrigins: UserRepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserRepositoryMockPurgeDeletedExpectation{
+		mock:               mmPurgeDeleted.mock,
+		params:             &UserRepositoryMockPurgeDeletedParams{ctx, deletedBefore, limit},
+		expectationOrigins: UserRepositoryMockPurgeDeletedExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGet.expectations = append(mmGet.expectations, expectation)
+	mmPurgeDeleted.expectations = append(mmPurgeDeleted.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserRepository.Get return parameters for the expectation previously defined by the When method
-func (e *UserRepositoryMockGetExpectation) Then(up1 *model.User, err error) *UserRepositoryMock {
-	e.results = &UserRepositoryMockGetResults{up1, err}
+// Then sets up UserRepository.PurgeDeleted return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockPurgeDeletedExpectation) Then(sa1 []string, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockPurgeDeletedResults{sa1, err}
 	return e.mock
 }
 
-// Times sets number of times UserRepository.Get should be invoked
-func (mmGet *mUserRepositoryMockGet) Times(n uint64) *mUserRepositoryMockGet {
+// Times sets number of times UserRepository.PurgeDeleted should be invoked
+func (mmPurgeDeleted *mUserRepositoryMockPurgeDeleted) Times(n uint64) *mUserRepositoryMockPurgeDeleted {
 	if n == 0 {
-		mmGet.mock.t.Fatalf("Times of UserRepositoryMock.Get mock can not be zero")
+		mmPurgeDeleted.mock.t.Fatalf("Times of UserRepositoryMock.PurgeDeleted mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
-	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGet
+	mm_atomic.StoreUint64(&mmPurgeDeleted.expectedInvocations, n)
+	mmPurgeDeleted.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeleted
 }
 
-func (mmGet *mUserRepositoryMockGet) invocationsDone() bool {
-	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+func (mmPurgeDeleted *mUserRepositoryMockPurgeDeleted) invocationsDone() bool {
+	if len(mmPurgeDeleted.expectations) == 0 && mmPurgeDeleted.defaultExpectation == nil && mmPurgeDeleted.mock.funcPurgeDeleted == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeDeleted.mock.afterPurgeDeletedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeDeleted.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Get implements mm_repository.UserRepository
-func (mmGet *UserRepositoryMock) Get(ctx context.Context, id string) (up1 *model.User, err error) {
-	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
-	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+// PurgeDeleted implements mm_repository.UserRepository
+func (mmPurgeDeleted *UserRepositoryMock) PurgeDeleted(ctx context.Context, deletedBefore time.Time, limit int) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmPurgeDeleted.beforePurgeDeletedCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeDeleted.afterPurgeDeletedCounter, 1)
 
-	mmGet.t.Helper()
-
-	if mmGet.inspectFuncGet != nil {
-		mmGet.inspectFuncGet(ctx, id)
+	mmPurgeDeleted.t.Helper()
+
+	if mmPurgeDeleted.inspectFuncPurgeDeleted != nil {
+		mmPurgeDeleted.inspectFuncPurgeDeleted(ctx, deletedBefore, limit)
 	}
 
-	mm_params := UserRepositoryMockGetParams{ctx, id}
+	mm_params := UserRepositoryMockPurgeDeletedParams{ctx, deletedBefore, limit}
 
 	// Record call args
-	mmGet.GetMock.mutex.Lock()
-	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
-	mmGet.GetMock.mutex.Unlock()
+	mmPurgeDeleted.PurgeDeletedMock.mutex.Lock()
+	mmPurgeDeleted.PurgeDeletedMock.callArgs = append(mmPurgeDeleted.PurgeDeletedMock.callArgs, &mm_params)
+	mmPurgeDeleted.PurgeDeletedMock.mutex.Unlock()
 
-	for _, e := range mmGet.GetMock.expectations {
+	for _, e := range mmPurgeDeleted.PurgeDeletedMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.up1, e.results.err
+			return e.results.sa1, e.results.err
 		}
 	}
 
-	if mmGet.GetMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
-		mm_want := mmGet.GetMock.defaultExpectation.params
-		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+	if mmPurgeDeleted.PurgeDeletedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeDeleted.PurgeDeletedMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeDeleted.PurgeDeletedMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeDeleted.PurgeDeletedMock.defaultExpectation.paramPtrs
 
-		mm_got := UserRepositoryMockGetParams{ctx, id}
+		mm_got := UserRepositoryMockPurgeDeletedParams{ctx, deletedBefore, limit}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGet.t.Errorf("UserRepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmPurgeDeleted.t.Errorf("UserRepositoryMock.PurgeDeleted got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeleted.PurgeDeletedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmGet.t.Errorf("UserRepositoryMock.Get got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGet.GetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.deletedBefore != nil && !minimock.Equal(*mm_want_ptrs.deletedBefore, mm_got.deletedBefore) {
+				mmPurgeDeleted.t.Errorf("UserRepositoryMock.PurgeDeleted got unexpected parameter deletedBefore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeleted.PurgeDeletedMock.defaultExpectation.expectationOrigins.originDeletedBefore, *mm_want_ptrs.deletedBefore, mm_got.deletedBefore, minimock.Diff(*mm_want_ptrs.deletedBefore, mm_got.deletedBefore))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmPurgeDeleted.t.Errorf("UserRepositoryMock.PurgeDeleted got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeleted.PurgeDeletedMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGet.t.Errorf("UserRepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmPurgeDeleted.t.Errorf("UserRepositoryMock.PurgeDeleted got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeDeleted.PurgeDeletedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGet.GetMock.defaultExpectation.results
+		mm_results := mmPurgeDeleted.PurgeDeletedMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGet.t.Fatal("No results are set for the UserRepositoryMock.Get")
+			mmPurgeDeleted.t.Fatal("No results are set for the UserRepositoryMock.PurgeDeleted")
 		}
-		return (*mm_results).up1, (*mm_results).err
+		return (*mm_results).sa1, (*mm_results).err
 	}
-	if mmGet.funcGet != nil {
-		return mmGet.funcGet(ctx, id)
+	if mmPurgeDeleted.funcPurgeDeleted != nil {
+		return mmPurgeDeleted.funcPurgeDeleted(ctx, deletedBefore, limit)
 	}
-	mmGet.t.Fatalf("Unexpected call to UserRepositoryMock.Get. %v %v", ctx, id)
+	mmPurgeDeleted.t.Fatalf("Unexpected call to UserRepositoryMock.PurgeDeleted. %v %v %v", ctx, deletedBefore, limit)
 	return
 }
 
-// GetAfterCounter returns a count of finished UserRepositoryMock.Get invocations
-func (mmGet *UserRepositoryMock) GetAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+// PurgeDeletedAfterCounter returns a count of finished UserRepositoryMock.PurgeDeleted invocations
+func (mmPurgeDeleted *UserRepositoryMock) PurgeDeletedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeleted.afterPurgeDeletedCounter)
 }
 
-// GetBeforeCounter returns a count of UserRepositoryMock.Get invocations
-func (mmGet *UserRepositoryMock) GetBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+// PurgeDeletedBeforeCounter returns a count of UserRepositoryMock.PurgeDeleted invocations
+func (mmPurgeDeleted *UserRepositoryMock) PurgeDeletedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeleted.beforePurgeDeletedCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserRepositoryMock.Get.
+// Calls returns a list of arguments used in each call to UserRepositoryMock.PurgeDeleted.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGet *mUserRepositoryMockGet) Calls() []*UserRepositoryMockGetParams {
-	mmGet.mutex.RLock()
+func (mmPurgeDeleted *mUserRepositoryMockPurgeDeleted) Calls() []*UserRepositoryMockPurgeDeletedParams {
+	mmPurgeDeleted.mutex.RLock()
 
-	argCopy := make([]*UserRepositoryMockGetParams, len(mmGet.callArgs))
-	copy(argCopy, mmGet.callArgs)
+	argCopy := make([]*UserRepositoryMockPurgeDeletedParams, len(mmPurgeDeleted.callArgs))
+	copy(argCopy, mmPurgeDeleted.callArgs)
 
-	mmGet.mutex.RUnlock()
+	mmPurgeDeleted.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetDone returns true if the count of the Get invocations corresponds
+// MinimockPurgeDeletedDone returns true if the count of the PurgeDeleted invocations corresponds
 // the number of defined expectations
-func (m *UserRepositoryMock) MinimockGetDone() bool {
-	if m.GetMock.optional {
+func (m *UserRepositoryMock) MinimockPurgeDeletedDone() bool {
+	if m.PurgeDeletedMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetMock.expectations {
+	for _, e := range m.PurgeDeletedMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetMock.invocationsDone()
+	return m.PurgeDeletedMock.invocationsDone()
 }
 
-// MinimockGetInspect logs each unmet expectation
-func (m *UserRepositoryMock) MinimockGetInspect() {
-	for _, e := range m.GetMock.expectations {
+// MinimockPurgeDeletedInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockPurgeDeletedInspect() {
+	for _, e := range m.PurgeDeletedMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserRepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.PurgeDeleted at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	afterPurgeDeletedCounter := mm_atomic.LoadUint64(&m.afterPurgeDeletedCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
-		if m.GetMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserRepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+	if m.PurgeDeletedMock.defaultExpectation != nil && afterPurgeDeletedCounter < 1 {
+		if m.PurgeDeletedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.PurgeDeleted at\n%s", m.PurgeDeletedMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserRepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.PurgeDeleted at\n%s with params: %#v", m.PurgeDeletedMock.defaultExpectation.expectationOrigins.origin, *m.PurgeDeletedMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGet != nil && afterGetCounter < 1 {
-		m.t.Errorf("Expected call to UserRepositoryMock.Get at\n%s", m.funcGetOrigin)
+	if m.funcPurgeDeleted != nil && afterPurgeDeletedCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.PurgeDeleted at\n%s", m.funcPurgeDeletedOrigin)
 	}
 
-	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserRepositoryMock.Get at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	if !m.PurgeDeletedMock.invocationsDone() && afterPurgeDeletedCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.PurgeDeleted at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeDeletedMock.expectedInvocations), m.PurgeDeletedMock.expectedInvocationsOrigin, afterPurgeDeletedCounter)
 	}
 }
 
-type mUserRepositoryMockGetAuthInfo struct {
+type mUserRepositoryMockSetStatus struct {
 	optional           bool
 	mock               *UserRepositoryMock
-	defaultExpectation *UserRepositoryMockGetAuthInfoExpectation
-	expectations       []*UserRepositoryMockGetAuthInfoExpectation
+	defaultExpectation *UserRepositoryMockSetStatusExpectation
+	expectations       []*UserRepositoryMockSetStatusExpectation
 
-	callArgs []*UserRepositoryMockGetAuthInfoParams
+	callArgs []*UserRepositoryMockSetStatusParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserRepositoryMockGetAuthInfoExpectation specifies expectation struct of the UserRepository.GetAuthInfo
-type UserRepositoryMockGetAuthInfoExpectation struct {
+// UserRepositoryMockSetStatusExpectation specifies expectation struct of the UserRepository.SetStatus
+type UserRepositoryMockSetStatusExpectation struct {
 	mock               *UserRepositoryMock
-	params             *UserRepositoryMockGetAuthInfoParams
-	paramPtrs          *UserRepositoryMockGetAuthInfoParamPtrs
-	expectationOrigins UserRepositoryMockGetAuthInfoExpectationOrigins
-	results            *UserRepositoryMockGetAuthInfoResults
+	params             *UserRepositoryMockSetStatusParams
+	paramPtrs          *UserRepositoryMockSetStatusParamPtrs
+	expectationOrigins UserRepositoryMockSetStatusExpectationOrigins
+	results            *UserRepositoryMockSetStatusResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserRepositoryMockGetAuthInfoParams contains parameters of the UserRepository.GetAuthInfo
-type UserRepositoryMockGetAuthInfoParams struct {
-	ctx      context.Context
-	username string
+// UserRepositoryMockSetStatusParams contains parameters of the UserRepository.SetStatus
+type UserRepositoryMockSetStatusParams struct {
+	ctx    context.Context
+	id     string
+	change *model.UserStatusChange
 }
 
-// UserRepositoryMockGetAuthInfoParamPtrs contains pointers to parameters of the UserRepository.GetAuthInfo
-type UserRepositoryMockGetAuthInfoParamPtrs struct {
-	ctx      *context.Context
-	username *string
+// UserRepositoryMockSetStatusParamPtrs contains pointers to parameters of the UserRepository.SetStatus
+type UserRepositoryMockSetStatusParamPtrs struct {
+	ctx    *context.Context
+	id     *string
+	change **model.UserStatusChange
 }
 
-// UserRepositoryMockGetAuthInfoResults contains results of the UserRepository.GetAuthInfo
-type UserRepositoryMockGetAuthInfoResults struct {
-	ap1 *model.AuthInfo
+// UserRepositoryMockSetStatusResults contains results of the UserRepository.SetStatus
+type UserRepositoryMockSetStatusResults struct {
+	i1  int
 	err error
 }
 
-// UserRepositoryMockGetAuthInfoOrigins contains origins of expectations of the UserRepository.GetAuthInfo
-type UserRepositoryMockGetAuthInfoExpectationOrigins struct {
-	origin         string
-	originCtx      string
-	originUsername string
+// UserRepositoryMockSetStatusOrigins contains origins of expectations of the UserRepository.SetStatus
+type UserRepositoryMockSetStatusExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originId     string
+	originChange string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1526,292 +4415,320 @@ type UserRepositoryMockGetAuthInfoExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetAuthInfo *mUserRepositoryMockGetAuthInfo) Optional() *mUserRepositoryMockGetAuthInfo {
-	mmGetAuthInfo.optional = true
-	return mmGetAuthInfo
+func (mmSetStatus *mUserRepositoryMockSetStatus) Optional() *mUserRepositoryMockSetStatus {
+	mmSetStatus.optional = true
+	return mmSetStatus
 }
 
-// Expect sets up expected params for UserRepository.GetAuthInfo
-func (mmGetAuthInfo *mUserRepositoryMockGetAuthInfo) Expect(ctx context.Context, username string) *mUserRepositoryMockGetAuthInfo {
-	if mmGetAuthInfo.mock.funcGetAuthInfo != nil {
-		mmGetAuthInfo.mock.t.Fatalf("UserRepositoryMock.GetAuthInfo mock is already set by Set")
+// Expect sets up expected params for UserRepository.SetStatus
+func (mmSetStatus *mUserRepositoryMockSetStatus) Expect(ctx context.Context, id string, change *model.UserStatusChange) *mUserRepositoryMockSetStatus {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("UserRepositoryMock.SetStatus mock is already set by Set")
 	}
 
-	if mmGetAuthInfo.defaultExpectation == nil {
-		mmGetAuthInfo.defaultExpectation = &UserRepositoryMockGetAuthInfoExpectation{}
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &UserRepositoryMockSetStatusExpectation{}
 	}
 
-	if mmGetAuthInfo.defaultExpectation.paramPtrs != nil {
-		mmGetAuthInfo.mock.t.Fatalf("UserRepositoryMock.GetAuthInfo mock is already set by ExpectParams functions")
+	if mmSetStatus.defaultExpectation.paramPtrs != nil {
+		mmSetStatus.mock.t.Fatalf("UserRepositoryMock.SetStatus mock is already set by ExpectParams functions")
 	}
 
-	mmGetAuthInfo.defaultExpectation.params = &UserRepositoryMockGetAuthInfoParams{ctx, username}
-	mmGetAuthInfo.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetAuthInfo.expectations {
-		if minimock.Equal(e.params, mmGetAuthInfo.defaultExpectation.params) {
-			mmGetAuthInfo.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAuthInfo.defaultExpectation.params)
+	mmSetStatus.defaultExpectation.params = &UserRepositoryMockSetStatusParams{ctx, id, change}
+	mmSetStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetStatus.expectations {
+		if minimock.Equal(e.params, mmSetStatus.defaultExpectation.params) {
+			mmSetStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetStatus.defaultExpectation.params)
 		}
 	}
 
-	return mmGetAuthInfo
+	return mmSetStatus
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetAuthInfo
-func (mmGetAuthInfo *mUserRepositoryMockGetAuthInfo) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetAuthInfo {
-	if mmGetAuthInfo.mock.funcGetAuthInfo != nil {
-		mmGetAuthInfo.mock.t.Fatalf("UserRepositoryMock.GetAuthInfo mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.SetStatus
+func (mmSetStatus *mUserRepositoryMockSetStatus) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockSetStatus {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("UserRepositoryMock.SetStatus mock is already set by Set")
 	}
 
-	if mmGetAuthInfo.defaultExpectation == nil {
-		mmGetAuthInfo.defaultExpectation = &UserRepositoryMockGetAuthInfoExpectation{}
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &UserRepositoryMockSetStatusExpectation{}
 	}
 
-	if mmGetAuthInfo.defaultExpectation.params != nil {
-		mmGetAuthInfo.mock.t.Fatalf("UserRepositoryMock.GetAuthInfo mock is already set by Expect")
+	if mmSetStatus.defaultExpectation.params != nil {
+		mmSetStatus.mock.t.Fatalf("UserRepositoryMock.SetStatus mock is already set by Expect")
 	}
 
-	if mmGetAuthInfo.defaultExpectation.paramPtrs == nil {
-		mmGetAuthInfo.defaultExpectation.paramPtrs = &UserRepositoryMockGetAuthInfoParamPtrs{}
+	if mmSetStatus.defaultExpectation.paramPtrs == nil {
+		mmSetStatus.defaultExpectation.paramPtrs = &UserRepositoryMockSetStatusParamPtrs{}
 	}
-	mmGetAuthInfo.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetAuthInfo.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmSetStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetAuthInfo
+	return mmSetStatus
 }
 
-// ExpectUsernameParam2 sets up expected param username for UserRepository.GetAuthInfo
-func (mmGetAuthInfo *mUserRepositoryMockGetAuthInfo) ExpectUsernameParam2(username string) *mUserRepositoryMockGetAuthInfo {
-	if mmGetAuthInfo.mock.funcGetAuthInfo != nil {
-		mmGetAuthInfo.mock.t.Fatalf("UserRepositoryMock.GetAuthInfo mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for UserRepository.SetStatus
+func (mmSetStatus *mUserRepositoryMockSetStatus) ExpectIdParam2(id string) *mUserRepositoryMockSetStatus {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("UserRepositoryMock.SetStatus mock is already set by Set")
 	}
 
-	if mmGetAuthInfo.defaultExpectation == nil {
-		mmGetAuthInfo.defaultExpectation = &UserRepositoryMockGetAuthInfoExpectation{}
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &UserRepositoryMockSetStatusExpectation{}
 	}
 
-	if mmGetAuthInfo.defaultExpectation.params != nil {
-		mmGetAuthInfo.mock.t.Fatalf("UserRepositoryMock.GetAuthInfo mock is already set by Expect")
+	if mmSetStatus.defaultExpectation.params != nil {
+		mmSetStatus.mock.t.Fatalf("UserRepositoryMock.SetStatus mock is already set by Expect")
 	}
 
-	if mmGetAuthInfo.defaultExpectation.paramPtrs == nil {
-		mmGetAuthInfo.defaultExpectation.paramPtrs = &UserRepositoryMockGetAuthInfoParamPtrs{}
+	if mmSetStatus.defaultExpectation.paramPtrs == nil {
+		mmSetStatus.defaultExpectation.paramPtrs = &UserRepositoryMockSetStatusParamPtrs{}
 	}
-	mmGetAuthInfo.defaultExpectation.paramPtrs.username = &username
-	mmGetAuthInfo.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+	mmSetStatus.defaultExpectation.paramPtrs.id = &id
+	mmSetStatus.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmGetAuthInfo
+	return mmSetStatus
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserRepository.GetAuthInfo
-func (mmGetAuthInfo *mUserRepositoryMockGetAuthInfo) Inspect(f func(ctx context.Context, username string)) *mUserRepositoryMockGetAuthInfo {
-	if mmGetAuthInfo.mock.inspectFuncGetAuthInfo != nil {
-		mmGetAuthInfo.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetAuthInfo")
+// ExpectChangeParam3 sets up expected param change for UserRepository.SetStatus
+func (mmSetStatus *mUserRepositoryMockSetStatus) ExpectChangeParam3(change *model.UserStatusChange) *mUserRepositoryMockSetStatus {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("UserRepositoryMock.SetStatus mock is already set by Set")
 	}
 
-	mmGetAuthInfo.mock.inspectFuncGetAuthInfo = f
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &UserRepositoryMockSetStatusExpectation{}
+	}
 
-	return mmGetAuthInfo
+	if mmSetStatus.defaultExpectation.params != nil {
+		mmSetStatus.mock.t.Fatalf("UserRepositoryMock.SetStatus mock is already set by Expect")
+	}
+
+	if mmSetStatus.defaultExpectation.paramPtrs == nil {
+		mmSetStatus.defaultExpectation.paramPtrs = &UserRepositoryMockSetStatusParamPtrs{}
+	}
+	mmSetStatus.defaultExpectation.paramPtrs.change = &change
+	mmSetStatus.defaultExpectation.expectationOrigins.originChange = minimock.CallerInfo(1)
+
+	return mmSetStatus
 }
 
-// Return sets up results that will be returned by UserRepository.GetAuthInfo
-func (mmGetAuthInfo *mUserRepositoryMockGetAuthInfo) Return(ap1 *model.AuthInfo, err error) *UserRepositoryMock {
-	if mmGetAuthInfo.mock.funcGetAuthInfo != nil {
-		mmGetAuthInfo.mock.t.Fatalf("UserRepositoryMock.GetAuthInfo mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the UserRepository.SetStatus
+func (mmSetStatus *mUserRepositoryMockSetStatus) Inspect(f func(ctx context.Context, id string, change *model.UserStatusChange)) *mUserRepositoryMockSetStatus {
+	if mmSetStatus.mock.inspectFuncSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.SetStatus")
 	}
 
-	if mmGetAuthInfo.defaultExpectation == nil {
-		mmGetAuthInfo.defaultExpectation = &UserRepositoryMockGetAuthInfoExpectation{mock: mmGetAuthInfo.mock}
+	mmSetStatus.mock.inspectFuncSetStatus = f
+
+	return mmSetStatus
+}
+
+// Return sets up results that will be returned by UserRepository.SetStatus
+func (mmSetStatus *mUserRepositoryMockSetStatus) Return(i1 int, err error) *UserRepositoryMock {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("UserRepositoryMock.SetStatus mock is already set by Set")
 	}
-	mmGetAuthInfo.defaultExpectation.results = &UserRepositoryMockGetAuthInfoResults{ap1, err}
-	mmGetAuthInfo.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetAuthInfo.mock
+
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &UserRepositoryMockSetStatusExpectation{mock: mmSetStatus.mock}
+	}
+	mmSetStatus.defaultExpectation.results = &UserRepositoryMockSetStatusResults{i1, err}
+	mmSetStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetStatus.mock
 }
 
-// Set uses given function f to mock the UserRepository.GetAuthInfo method
-func (mmGetAuthInfo *mUserRepositoryMockGetAuthInfo) Set(f func(ctx context.Context, username string) (ap1 *model.AuthInfo, err error)) *UserRepositoryMock {
-	if mmGetAuthInfo.defaultExpectation != nil {
-		mmGetAuthInfo.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetAuthInfo method")
+// Set uses given function f to mock the UserRepository.SetStatus method
+func (mmSetStatus *mUserRepositoryMockSetStatus) Set(f func(ctx context.Context, id string, change *model.UserStatusChange) (i1 int, err error)) *UserRepositoryMock {
+	if mmSetStatus.defaultExpectation != nil {
+		mmSetStatus.mock.t.Fatalf("Default expectation is already set for the UserRepository.SetStatus method")
 	}
 
-	if len(mmGetAuthInfo.expectations) > 0 {
-		mmGetAuthInfo.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetAuthInfo method")
+	if len(mmSetStatus.expectations) > 0 {
+		mmSetStatus.mock.t.Fatalf("Some expectations are already set for the UserRepository.SetStatus method")
 	}
 
-	mmGetAuthInfo.mock.funcGetAuthInfo = f
-	mmGetAuthInfo.mock.funcGetAuthInfoOrigin = minimock.CallerInfo(1)
-	return mmGetAuthInfo.mock
+	mmSetStatus.mock.funcSetStatus = f
+	mmSetStatus.mock.funcSetStatusOrigin = minimock.CallerInfo(1)
+	return mmSetStatus.mock
 }
 
-// When sets expectation for the UserRepository.GetAuthInfo which will trigger the result defined by the following
+// When sets expectation for the UserRepository.SetStatus which will trigger the result defined by the following
 // Then helper
-func (mmGetAuthInfo *mUserRepositoryMockGetAuthInfo) When(ctx context.Context, username string) *UserRepositoryMockGetAuthInfoExpectation {
-	if mmGetAuthInfo.mock.funcGetAuthInfo != nil {
-		mmGetAuthInfo.mock.t.Fatalf("UserRepositoryMock.GetAuthInfo mock is already set by Set")
+func (mmSetStatus *mUserRepositoryMockSetStatus) When(ctx context.Context, id string, change *model.UserStatusChange) *UserRepositoryMockSetStatusExpectation {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("UserRepositoryMock.SetStatus mock is already set by Set")
 	}
 
-	expectation := &UserRepositoryMockGetAuthInfoExpectation{
-		mock:               mmGetAuthInfo.mock,
-		params:             &UserRepositoryMockGetAuthInfoParams{ctx, username},
-		expectationOrigins: UserRepositoryMockGetAuthInfoExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserRepositoryMockSetStatusExpectation{
+		mock:               mmSetStatus.mock,
+		params:             &UserRepositoryMockSetStatusParams{ctx, id, change},
+		expectationOrigins: UserRepositoryMockSetStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetAuthInfo.expectations = append(mmGetAuthInfo.expectations, expectation)
+	mmSetStatus.expectations = append(mmSetStatus.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserRepository.GetAuthInfo return parameters for the expectation previously defined by the When method
-func (e *UserRepositoryMockGetAuthInfoExpectation) Then(ap1 *model.AuthInfo, err error) *UserRepositoryMock {
-	e.results = &UserRepositoryMockGetAuthInfoResults{ap1, err}
+// Then sets up UserRepository.SetStatus return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockSetStatusExpectation) Then(i1 int, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockSetStatusResults{i1, err}
 	return e.mock
 }
 
-// Times sets number of times UserRepository.GetAuthInfo should be invoked
-func (mmGetAuthInfo *mUserRepositoryMockGetAuthInfo) Times(n uint64) *mUserRepositoryMockGetAuthInfo {
+// Times sets number of times UserRepository.SetStatus should be invoked
+func (mmSetStatus *mUserRepositoryMockSetStatus) Times(n uint64) *mUserRepositoryMockSetStatus {
 	if n == 0 {
-		mmGetAuthInfo.mock.t.Fatalf("Times of UserRepositoryMock.GetAuthInfo mock can not be zero")
+		mmSetStatus.mock.t.Fatalf("Times of UserRepositoryMock.SetStatus mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetAuthInfo.expectedInvocations, n)
-	mmGetAuthInfo.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetAuthInfo
+	mm_atomic.StoreUint64(&mmSetStatus.expectedInvocations, n)
+	mmSetStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetStatus
 }
 
-func (mmGetAuthInfo *mUserRepositoryMockGetAuthInfo) invocationsDone() bool {
-	if len(mmGetAuthInfo.expectations) == 0 && mmGetAuthInfo.defaultExpectation == nil && mmGetAuthInfo.mock.funcGetAuthInfo == nil {
+func (mmSetStatus *mUserRepositoryMockSetStatus) invocationsDone() bool {
+	if len(mmSetStatus.expectations) == 0 && mmSetStatus.defaultExpectation == nil && mmSetStatus.mock.funcSetStatus == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetAuthInfo.mock.afterGetAuthInfoCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetAuthInfo.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSetStatus.mock.afterSetStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetStatus.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetAuthInfo implements mm_repository.UserRepository
-func (mmGetAuthInfo *UserRepositoryMock) GetAuthInfo(ctx context.Context, username string) (ap1 *model.AuthInfo, err error) {
-	mm_atomic.AddUint64(&mmGetAuthInfo.beforeGetAuthInfoCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetAuthInfo.afterGetAuthInfoCounter, 1)
+// SetStatus implements mm_repository.UserRepository
+func (mmSetStatus *UserRepositoryMock) SetStatus(ctx context.Context, id string, change *model.UserStatusChange) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmSetStatus.beforeSetStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetStatus.afterSetStatusCounter, 1)
 
-	mmGetAuthInfo.t.Helper()
+	mmSetStatus.t.Helper()
 
-	if mmGetAuthInfo.inspectFuncGetAuthInfo != nil {
-		mmGetAuthInfo.inspectFuncGetAuthInfo(ctx, username)
+	if mmSetStatus.inspectFuncSetStatus != nil {
+		mmSetStatus.inspectFuncSetStatus(ctx, id, change)
 	}
 
-	mm_params := UserRepositoryMockGetAuthInfoParams{ctx, username}
+	mm_params := UserRepositoryMockSetStatusParams{ctx, id, change}
 
 	// Record call args
-	mmGetAuthInfo.GetAuthInfoMock.mutex.Lock()
-	mmGetAuthInfo.GetAuthInfoMock.callArgs = append(mmGetAuthInfo.GetAuthInfoMock.callArgs, &mm_params)
-	mmGetAuthInfo.GetAuthInfoMock.mutex.Unlock()
+	mmSetStatus.SetStatusMock.mutex.Lock()
+	mmSetStatus.SetStatusMock.callArgs = append(mmSetStatus.SetStatusMock.callArgs, &mm_params)
+	mmSetStatus.SetStatusMock.mutex.Unlock()
 
-	for _, e := range mmGetAuthInfo.GetAuthInfoMock.expectations {
+	for _, e := range mmSetStatus.SetStatusMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ap1, e.results.err
+			return e.results.i1, e.results.err
 		}
 	}
 
-	if mmGetAuthInfo.GetAuthInfoMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetAuthInfo.GetAuthInfoMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetAuthInfo.GetAuthInfoMock.defaultExpectation.params
-		mm_want_ptrs := mmGetAuthInfo.GetAuthInfoMock.defaultExpectation.paramPtrs
+	if mmSetStatus.SetStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetStatus.SetStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetStatus.SetStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmSetStatus.SetStatusMock.defaultExpectation.paramPtrs
 
-		mm_got := UserRepositoryMockGetAuthInfoParams{ctx, username}
+		mm_got := UserRepositoryMockSetStatusParams{ctx, id, change}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetAuthInfo.t.Errorf("UserRepositoryMock.GetAuthInfo got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetAuthInfo.GetAuthInfoMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSetStatus.t.Errorf("UserRepositoryMock.SetStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetStatus.SetStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
-				mmGetAuthInfo.t.Errorf("UserRepositoryMock.GetAuthInfo got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetAuthInfo.GetAuthInfoMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmSetStatus.t.Errorf("UserRepositoryMock.SetStatus got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetStatus.SetStatusMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.change != nil && !minimock.Equal(*mm_want_ptrs.change, mm_got.change) {
+				mmSetStatus.t.Errorf("UserRepositoryMock.SetStatus got unexpected parameter change, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetStatus.SetStatusMock.defaultExpectation.expectationOrigins.originChange, *mm_want_ptrs.change, mm_got.change, minimock.Diff(*mm_want_ptrs.change, mm_got.change))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetAuthInfo.t.Errorf("UserRepositoryMock.GetAuthInfo got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetAuthInfo.GetAuthInfoMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSetStatus.t.Errorf("UserRepositoryMock.SetStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetStatus.SetStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetAuthInfo.GetAuthInfoMock.defaultExpectation.results
+		mm_results := mmSetStatus.SetStatusMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetAuthInfo.t.Fatal("No results are set for the UserRepositoryMock.GetAuthInfo")
+			mmSetStatus.t.Fatal("No results are set for the UserRepositoryMock.SetStatus")
 		}
-		return (*mm_results).ap1, (*mm_results).err
+		return (*mm_results).i1, (*mm_results).err
 	}
-	if mmGetAuthInfo.funcGetAuthInfo != nil {
-		return mmGetAuthInfo.funcGetAuthInfo(ctx, username)
+	if mmSetStatus.funcSetStatus != nil {
+		return mmSetStatus.funcSetStatus(ctx, id, change)
 	}
-	mmGetAuthInfo.t.Fatalf("Unexpected call to UserRepositoryMock.GetAuthInfo. %v %v", ctx, username)
+	mmSetStatus.t.Fatalf("Unexpected call to UserRepositoryMock.SetStatus. %v %v %v", ctx, id, change)
 	return
 }
 
-// GetAuthInfoAfterCounter returns a count of finished UserRepositoryMock.GetAuthInfo invocations
-func (mmGetAuthInfo *UserRepositoryMock) GetAuthInfoAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetAuthInfo.afterGetAuthInfoCounter)
+// SetStatusAfterCounter returns a count of finished UserRepositoryMock.SetStatus invocations
+func (mmSetStatus *UserRepositoryMock) SetStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetStatus.afterSetStatusCounter)
 }
 
-// GetAuthInfoBeforeCounter returns a count of UserRepositoryMock.GetAuthInfo invocations
-func (mmGetAuthInfo *UserRepositoryMock) GetAuthInfoBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetAuthInfo.beforeGetAuthInfoCounter)
+// SetStatusBeforeCounter returns a count of UserRepositoryMock.SetStatus invocations
+func (mmSetStatus *UserRepositoryMock) SetStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetStatus.beforeSetStatusCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserRepositoryMock.GetAuthInfo.
+// Calls returns a list of arguments used in each call to UserRepositoryMock.SetStatus.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetAuthInfo *mUserRepositoryMockGetAuthInfo) Calls() []*UserRepositoryMockGetAuthInfoParams {
-	mmGetAuthInfo.mutex.RLock()
+func (mmSetStatus *mUserRepositoryMockSetStatus) Calls() []*UserRepositoryMockSetStatusParams {
+	mmSetStatus.mutex.RLock()
 
-	argCopy := make([]*UserRepositoryMockGetAuthInfoParams, len(mmGetAuthInfo.callArgs))
-	copy(argCopy, mmGetAuthInfo.callArgs)
+	argCopy := make([]*UserRepositoryMockSetStatusParams, len(mmSetStatus.callArgs))
+	copy(argCopy, mmSetStatus.callArgs)
 
-	mmGetAuthInfo.mutex.RUnlock()
+	mmSetStatus.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetAuthInfoDone returns true if the count of the GetAuthInfo invocations corresponds
+// MinimockSetStatusDone returns true if the count of the SetStatus invocations corresponds
 // the number of defined expectations
-func (m *UserRepositoryMock) MinimockGetAuthInfoDone() bool {
-	if m.GetAuthInfoMock.optional {
+func (m *UserRepositoryMock) MinimockSetStatusDone() bool {
+	if m.SetStatusMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetAuthInfoMock.expectations {
+	for _, e := range m.SetStatusMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetAuthInfoMock.invocationsDone()
+	return m.SetStatusMock.invocationsDone()
 }
 
-// MinimockGetAuthInfoInspect logs each unmet expectation
-func (m *UserRepositoryMock) MinimockGetAuthInfoInspect() {
-	for _, e := range m.GetAuthInfoMock.expectations {
+// MinimockSetStatusInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockSetStatusInspect() {
+	for _, e := range m.SetStatusMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserRepositoryMock.GetAuthInfo at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.SetStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetAuthInfoCounter := mm_atomic.LoadUint64(&m.afterGetAuthInfoCounter)
+	afterSetStatusCounter := mm_atomic.LoadUint64(&m.afterSetStatusCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetAuthInfoMock.defaultExpectation != nil && afterGetAuthInfoCounter < 1 {
-		if m.GetAuthInfoMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserRepositoryMock.GetAuthInfo at\n%s", m.GetAuthInfoMock.defaultExpectation.returnOrigin)
+	if m.SetStatusMock.defaultExpectation != nil && afterSetStatusCounter < 1 {
+		if m.SetStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.SetStatus at\n%s", m.SetStatusMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserRepositoryMock.GetAuthInfo at\n%s with params: %#v", m.GetAuthInfoMock.defaultExpectation.expectationOrigins.origin, *m.GetAuthInfoMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.SetStatus at\n%s with params: %#v", m.SetStatusMock.defaultExpectation.expectationOrigins.origin, *m.SetStatusMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetAuthInfo != nil && afterGetAuthInfoCounter < 1 {
-		m.t.Errorf("Expected call to UserRepositoryMock.GetAuthInfo at\n%s", m.funcGetAuthInfoOrigin)
+	if m.funcSetStatus != nil && afterSetStatusCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.SetStatus at\n%s", m.funcSetStatusOrigin)
 	}
 
-	if !m.GetAuthInfoMock.invocationsDone() && afterGetAuthInfoCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserRepositoryMock.GetAuthInfo at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetAuthInfoMock.expectedInvocations), m.GetAuthInfoMock.expectedInvocationsOrigin, afterGetAuthInfoCounter)
+	if !m.SetStatusMock.invocationsDone() && afterSetStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.SetStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetStatusMock.expectedInvocations), m.SetStatusMock.expectedInvocationsOrigin, afterSetStatusCounter)
 	}
 }
 
@@ -2534,16 +5451,32 @@ func (m *UserRepositoryMock) MinimockUpdatePasswordInspect() {
 func (m *UserRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockConfirmEmailInspect()
+
+			m.MinimockCountInspect()
+
 			m.MinimockCreateInspect()
 
 			m.MinimockDeleteInspect()
 
+			m.MinimockFindByEmailInspect()
+
 			m.MinimockFindByNameInspect()
 
 			m.MinimockGetInspect()
 
 			m.MinimockGetAuthInfoInspect()
 
+			m.MinimockGetVersionInspect()
+
+			m.MinimockIncrementVersionInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockPurgeDeletedInspect()
+
+			m.MinimockSetStatusInspect()
+
 			m.MinimockUpdateInspect()
 
 			m.MinimockUpdatePasswordInspect()
@@ -2570,11 +5503,19 @@ func (m *UserRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *UserRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockConfirmEmailDone() &&
+		m.MinimockCountDone() &&
 		m.MinimockCreateDone() &&
 		m.MinimockDeleteDone() &&
+		m.MinimockFindByEmailDone() &&
 		m.MinimockFindByNameDone() &&
 		m.MinimockGetDone() &&
 		m.MinimockGetAuthInfoDone() &&
+		m.MinimockGetVersionDone() &&
+		m.MinimockIncrementVersionDone() &&
+		m.MinimockListDone() &&
+		m.MinimockPurgeDeletedDone() &&
+		m.MinimockSetStatusDone() &&
 		m.MinimockUpdateDone() &&
 		m.MinimockUpdatePasswordDone()
 }
@@ -0,0 +1,28 @@
+package converter
+
+import (
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository/notification/dao"
+)
+
+// ToNotificationFromRepo converts repository layer model to structure of service layer.
+func ToNotificationFromRepo(notification *dao.Notification) *model.Notification {
+	return &model.Notification{
+		ID:        notification.ID,
+		Recipient: notification.Recipient,
+		Subject:   notification.Subject,
+		Body:      notification.Body,
+		Attempts:  notification.Attempts,
+		CreatedAt: notification.CreatedAt,
+	}
+}
+
+// ToNotificationsFromRepo converts repository layer models to structures of service layer.
+func ToNotificationsFromRepo(notifications []*dao.Notification) []*model.Notification {
+	res := make([]*model.Notification, 0, len(notifications))
+	for _, notification := range notifications {
+		res = append(res, ToNotificationFromRepo(notification))
+	}
+
+	return res
+}
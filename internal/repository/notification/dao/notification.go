@@ -0,0 +1,13 @@
+package dao
+
+import "time"
+
+// Notification type is the structure for outbox message from storage.
+type Notification struct {
+	ID        string    `db:"id"`
+	Recipient string    `db:"recipient"`
+	Subject   string    `db:"subject"`
+	Body      string    `db:"body"`
+	Attempts  int       `db:"attempts"`
+	CreatedAt time.Time `db:"created_at"`
+}
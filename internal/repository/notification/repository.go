@@ -0,0 +1,160 @@
+package notification
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/8thgencore/microservice-common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	"github.com/8thgencore/microservice-auth/internal/repository/notification/converter"
+	"github.com/8thgencore/microservice-auth/internal/repository/notification/dao"
+)
+
+const (
+	tableName = "notification_outbox"
+
+	idColumn            = "id"
+	recipientColumn     = "recipient"
+	subjectColumn       = "subject"
+	bodyColumn          = "body"
+	attemptsColumn      = "attempts"
+	nextAttemptAtColumn = "next_attempt_at"
+	lastErrorColumn     = "last_error"
+	sentAtColumn        = "sent_at"
+	createdAtColumn     = "created_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new object of repository layer.
+func NewRepository(db db.Client) repository.NotificationRepository {
+	return &repo{db: db}
+}
+
+// Create puts a message into the outbox.
+func (r *repo) Create(ctx context.Context, notification *model.Notification) error {
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(recipientColumn, subjectColumn, bodyColumn).
+		Values(notification.Recipient, notification.Subject, notification.Body)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "notification_repository.Create",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ClaimDue takes up to limit unsent messages that are due and postpones them by lease.
+// Rows locked by a concurrent claim are skipped, so every message is claimed by one dispatcher.
+func (r *repo) ClaimDue(
+	ctx context.Context,
+	limit, maxAttempts int,
+	lease time.Duration,
+) ([]*model.Notification, error) {
+	builderDue := sq.Select(idColumn).
+		From(tableName).
+		Where(sq.Eq{sentAtColumn: nil}).
+		Where(sq.Lt{attemptsColumn: maxAttempts}).
+		Where(sq.Expr(nextAttemptAtColumn + " <= NOW()")).
+		OrderBy(nextAttemptAtColumn).
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(attemptsColumn, sq.Expr(attemptsColumn+" + 1")).
+		Set(nextAttemptAtColumn, sq.Expr("NOW() + make_interval(secs => ?)", lease.Seconds())).
+		Where(sq.Expr(idColumn+" IN (?)", builderDue)).
+		Suffix("RETURNING " + strings.Join([]string{
+			idColumn, recipientColumn, subjectColumn, bodyColumn, attemptsColumn, createdAtColumn,
+		}, ", "))
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "notification_repository.ClaimDue",
+		QueryRaw: query,
+	}
+
+	var notifications []*dao.Notification
+	err = r.db.DB().ScanAllContext(ctx, &notifications, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToNotificationsFromRepo(notifications), nil
+}
+
+// MarkSent records that a message was sent. The body is dropped as it may carry secrets like reset links.
+func (r *repo) MarkSent(ctx context.Context, id string) error {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(sentAtColumn, sq.Expr("NOW()")).
+		Set(bodyColumn, "").
+		Set(lastErrorColumn, nil).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "notification_repository.MarkSent",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarkFailed records a failed attempt and postpones the next one by retryIn.
+func (r *repo) MarkFailed(ctx context.Context, id string, retryIn time.Duration, lastError string) error {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(nextAttemptAtColumn, sq.Expr("NOW() + make_interval(secs => ?)", retryIn.Seconds())).
+		Set(lastErrorColumn, lastError).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "notification_repository.MarkFailed",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package converter
+
+import (
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository/passkey/dao"
+)
+
+// ToPasskeyFromRepo converts repository layer model to structure of service layer.
+func ToPasskeyFromRepo(passkey *dao.Passkey) *model.Passkey {
+	return &model.Passkey{
+		ID:              passkey.ID,
+		UserID:          passkey.UserID,
+		PublicKey:       passkey.PublicKey,
+		AttestationType: passkey.AttestationType,
+		Transports:      passkey.Transports,
+		AAGUID:          passkey.AAGUID,
+		SignCount:       uint32(passkey.SignCount),
+		BackupEligible:  passkey.BackupEligible,
+		BackupState:     passkey.BackupState,
+		CreatedAt:       passkey.CreatedAt,
+		LastUsedAt:      passkey.LastUsedAt,
+	}
+}
+
+// ToPasskeysFromRepo converts repository layer models to structures of service layer.
+func ToPasskeysFromRepo(passkeys []*dao.Passkey) []*model.Passkey {
+	res := make([]*model.Passkey, 0, len(passkeys))
+	for _, passkey := range passkeys {
+		res = append(res, ToPasskeyFromRepo(passkey))
+	}
+
+	return res
+}
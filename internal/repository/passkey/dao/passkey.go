@@ -0,0 +1,21 @@
+package dao
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Passkey type is the structure for WebAuthn credential from storage.
+type Passkey struct {
+	ID              []byte       `db:"id"`
+	UserID          string       `db:"user_id"`
+	PublicKey       []byte       `db:"public_key"`
+	AttestationType string       `db:"attestation_type"`
+	Transports      []string     `db:"transports"`
+	AAGUID          []byte       `db:"aaguid"`
+	SignCount       int64        `db:"sign_count"`
+	BackupEligible  bool         `db:"backup_eligible"`
+	BackupState     bool         `db:"backup_state"`
+	CreatedAt       time.Time    `db:"created_at"`
+	LastUsedAt      sql.NullTime `db:"last_used_at"`
+}
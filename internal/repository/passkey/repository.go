@@ -0,0 +1,161 @@
+package passkey
+
+import (
+	"context"
+
+	"github.com/8thgencore/microservice-common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	"github.com/8thgencore/microservice-auth/internal/repository/passkey/converter"
+	"github.com/8thgencore/microservice-auth/internal/repository/passkey/dao"
+)
+
+const (
+	tableName = "passkeys"
+
+	idColumn              = "id"
+	userIDColumn          = "user_id"
+	publicKeyColumn       = "public_key"
+	attestationTypeColumn = "attestation_type"
+	transportsColumn      = "transports"
+	aaguidColumn          = "aaguid"
+	signCountColumn       = "sign_count"
+	backupEligibleColumn  = "backup_eligible"
+	backupStateColumn     = "backup_state"
+	createdAtColumn       = "created_at"
+	lastUsedAtColumn      = "last_used_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new object of repository layer.
+func NewRepository(db db.Client) repository.PasskeyRepository {
+	return &repo{db: db}
+}
+
+// Create stores a newly registered passkey.
+func (r *repo) Create(ctx context.Context, passkey *model.Passkey) error {
+	transports := passkey.Transports
+	if transports == nil {
+		transports = []string{}
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(
+			idColumn,
+			userIDColumn,
+			publicKeyColumn,
+			attestationTypeColumn,
+			transportsColumn,
+			aaguidColumn,
+			signCountColumn,
+			backupEligibleColumn,
+			backupStateColumn,
+		).
+		Values(
+			passkey.ID,
+			passkey.UserID,
+			passkey.PublicKey,
+			passkey.AttestationType,
+			transports,
+			passkey.AAGUID,
+			int64(passkey.SignCount),
+			passkey.BackupEligible,
+			passkey.BackupState,
+		)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "passkey_repository.Create",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListByUser retrieves every passkey of a user, the oldest first.
+func (r *repo) ListByUser(ctx context.Context, userID string) ([]*model.Passkey, error) {
+	builderSelect := sq.Select(
+		idColumn,
+		userIDColumn,
+		publicKeyColumn,
+		attestationTypeColumn,
+		transportsColumn,
+		aaguidColumn,
+		signCountColumn,
+		backupEligibleColumn,
+		backupStateColumn,
+		createdAtColumn,
+		lastUsedAtColumn,
+	).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{userIDColumn: userID}).
+		OrderBy(createdAtColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "passkey_repository.ListByUser",
+		QueryRaw: query,
+	}
+
+	var passkeys []*dao.Passkey
+	err = r.db.DB().ScanAllContext(ctx, &passkeys, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToPasskeysFromRepo(passkeys), nil
+}
+
+// Use records a login with the passkey and its new signature counter.
+// Authenticators that do not keep a counter always report zero. Otherwise it reports false
+// when the counter does not move forward, as a concurrent login with the same counter did.
+func (r *repo) Use(ctx context.Context, id []byte, signCount uint32, backupState bool) (bool, error) {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(signCountColumn, int64(signCount)).
+		Set(backupStateColumn, backupState).
+		Set(lastUsedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{idColumn: id})
+	if signCount == 0 {
+		builderUpdate = builderUpdate.Where(sq.Eq{signCountColumn: 0})
+	} else {
+		builderUpdate = builderUpdate.Where(sq.Lt{signCountColumn: int64(signCount)})
+	}
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "passkey_repository.Use",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
@@ -0,0 +1,28 @@
+package converter
+
+import (
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository/permission/dao"
+)
+
+// ToPermissionFromRepo converts repository layer model to structure of service layer.
+func ToPermissionFromRepo(permission *dao.Permission) *model.Permission {
+	return &model.Permission{
+		Name:        permission.Name,
+		Description: permission.Description,
+		Endpoints:   permission.Endpoints,
+		Roles:       permission.Roles,
+		CreatedAt:   permission.CreatedAt,
+		UpdatedAt:   permission.UpdatedAt,
+	}
+}
+
+// ToPermissionsFromRepo converts repository layer models to structures of service layer.
+func ToPermissionsFromRepo(permissions []*dao.Permission) []*model.Permission {
+	res := make([]*model.Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		res = append(res, ToPermissionFromRepo(permission))
+	}
+
+	return res
+}
@@ -0,0 +1,16 @@
+package dao
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Permission type is the structure for permission from storage.
+type Permission struct {
+	Name        string       `db:"name"`
+	Description string       `db:"description"`
+	Endpoints   []string     `db:"endpoints"`
+	Roles       []string     `db:"roles"`
+	CreatedAt   time.Time    `db:"created_at"`
+	UpdatedAt   sql.NullTime `db:"updated_at"`
+}
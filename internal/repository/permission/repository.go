@@ -0,0 +1,310 @@
+package permission
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	"github.com/8thgencore/microservice-auth/internal/repository/permission/converter"
+	"github.com/8thgencore/microservice-auth/internal/repository/permission/dao"
+	permissionService "github.com/8thgencore/microservice-auth/internal/service/permission"
+	"github.com/8thgencore/microservice-common/pkg/db"
+)
+
+const (
+	tableName          = "permissions"
+	endpointsTableName = "permission_endpoints"
+	rolesTableName     = "role_permissions"
+
+	nameColumn        = "name"
+	descriptionColumn = "description"
+	createdAtColumn   = "created_at"
+	updatedAtColumn   = "updated_at"
+
+	permissionColumn = "permission"
+	endpointColumn   = "endpoint"
+	roleColumn       = "role"
+
+	// endpointsColumn selects the endpoints of a permission as an array.
+	endpointsColumn = "ARRAY(SELECT " + endpointColumn + " FROM " + endpointsTableName +
+		" WHERE " + endpointsTableName + "." + permissionColumn + " = " + tableName + "." + nameColumn +
+		" ORDER BY " + endpointColumn + ") AS endpoints"
+
+	// rolesColumn selects the roles granted a permission as an array.
+	rolesColumn = "ARRAY(SELECT " + roleColumn + " FROM " + rolesTableName +
+		" WHERE " + rolesTableName + "." + permissionColumn + " = " + tableName + "." + nameColumn +
+		" ORDER BY " + roleColumn + ") AS roles"
+
+	permissionsPkey = "permissions_pkey"
+	rolesRoleFkey   = "role_permissions_role_fkey"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new object of repository layer.
+func NewRepository(db db.Client) repository.PermissionRepository {
+	return &repo{db: db}
+}
+
+// Create creates a new permission with its endpoints and roles.
+func (r *repo) Create(ctx context.Context, permission *model.Permission) error {
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(nameColumn, descriptionColumn).
+		Values(permission.Name, permission.Description)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "permission_repository.Create",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation &&
+			pgErr.ConstraintName == permissionsPkey {
+			return permissionService.ErrPermissionExists
+		}
+
+		return err
+	}
+
+	if err = r.insertEndpoints(ctx, permission.Name, permission.Endpoints); err != nil {
+		return err
+	}
+
+	return r.insertRoles(ctx, permission.Name, permission.Roles)
+}
+
+// Get retrieves a permission by its name.
+func (r *repo) Get(ctx context.Context, name string) (*model.Permission, error) {
+	builderSelect := sq.Select(nameColumn, descriptionColumn, endpointsColumn, rolesColumn,
+		createdAtColumn, updatedAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{nameColumn: name}).
+		Limit(1)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "permission_repository.Get",
+		QueryRaw: query,
+	}
+
+	var permission dao.Permission
+	err = r.db.DB().ScanOneContext(ctx, &permission, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, permissionService.ErrPermissionNotFound
+		}
+
+		return nil, err
+	}
+
+	return converter.ToPermissionFromRepo(&permission), nil
+}
+
+// List retrieves every permission, ordered by name.
+func (r *repo) List(ctx context.Context) ([]*model.Permission, error) {
+	builderSelect := sq.Select(nameColumn, descriptionColumn, endpointsColumn, rolesColumn,
+		createdAtColumn, updatedAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		OrderBy(nameColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "permission_repository.List",
+		QueryRaw: query,
+	}
+
+	var permissions []*dao.Permission
+	err = r.db.DB().ScanAllContext(ctx, &permissions, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToPermissionsFromRepo(permissions), nil
+}
+
+// Update updates the description of a permission and replaces its endpoints and roles.
+func (r *repo) Update(ctx context.Context, update *model.PermissionUpdate) error {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(updatedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{nameColumn: update.Name})
+
+	if update.Description != nil {
+		builderUpdate = builderUpdate.Set(descriptionColumn, *update.Description)
+	}
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "permission_repository.Update",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return permissionService.ErrPermissionNotFound
+	}
+
+	if update.Endpoints != nil {
+		if err = r.deleteMappings(ctx, endpointsTableName, update.Name); err != nil {
+			return err
+		}
+		if err = r.insertEndpoints(ctx, update.Name, *update.Endpoints); err != nil {
+			return err
+		}
+	}
+
+	if update.Roles != nil {
+		if err = r.deleteMappings(ctx, rolesTableName, update.Name); err != nil {
+			return err
+		}
+		if err = r.insertRoles(ctx, update.Name, *update.Roles); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Delete deletes a permission. The roles granted it and the endpoints mapped to it lose it.
+func (r *repo) Delete(ctx context.Context, name string) error {
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{nameColumn: name})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "permission_repository.Delete",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return permissionService.ErrPermissionNotFound
+	}
+
+	return nil
+}
+
+func (r *repo) insertEndpoints(ctx context.Context, name string, endpoints []string) error {
+	if len(endpoints) == 0 {
+		return nil
+	}
+
+	builderInsert := sq.Insert(endpointsTableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(permissionColumn, endpointColumn)
+	for _, endpoint := range endpoints {
+		builderInsert = builderInsert.Values(name, endpoint)
+	}
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "permission_repository.InsertEndpoints",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
+
+func (r *repo) insertRoles(ctx context.Context, name string, roles []string) error {
+	if len(roles) == 0 {
+		return nil
+	}
+
+	builderInsert := sq.Insert(rolesTableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(roleColumn, permissionColumn)
+	for _, role := range roles {
+		builderInsert = builderInsert.Values(role, name)
+	}
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "permission_repository.InsertRoles",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation &&
+			pgErr.ConstraintName == rolesRoleFkey {
+			return permissionService.ErrUnknownRole
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// deleteMappings deletes the endpoints or the roles of a permission.
+func (r *repo) deleteMappings(ctx context.Context, table, name string) error {
+	builderDelete := sq.Delete(table).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{permissionColumn: name})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "permission_repository.DeleteMappings",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
@@ -0,0 +1,62 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/8thgencore/microservice-auth/internal/cache"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+)
+
+const denyRulesVersionKey = "policy_version:deny_rules"
+
+type repo struct {
+	redisClient cache.Client
+}
+
+// NewRepository creates a new instance of PolicyVersionRepository.
+func NewRepository(redisClient cache.Client) repository.PolicyVersionRepository {
+	return &repo{redisClient: redisClient}
+}
+
+// GetDenyRulesVersion gets the version of the deny rules from the cache.
+func (r *repo) GetDenyRulesVersion(ctx context.Context) (string, error) {
+	version, err := r.redisClient.Get(ctx, denyRulesVersionKey)
+	if err != nil {
+		if errors.Is(err, cache.ErrKeyNotFound) {
+			return "", nil
+		}
+		return "", fmt.Errorf("could not get deny rules version: %w", err)
+	}
+
+	return version, nil
+}
+
+// AddDenyRulesVersion caches the version of the deny rules unless one is cached.
+func (r *repo) AddDenyRulesVersion(ctx context.Context, version string) (bool, error) {
+	added, err := r.redisClient.SetNX(ctx, denyRulesVersionKey, version, 0)
+	if err != nil {
+		return false, fmt.Errorf("could not add deny rules version: %w", err)
+	}
+
+	return added, nil
+}
+
+// SetDenyRulesVersion replaces the version of the deny rules.
+func (r *repo) SetDenyRulesVersion(ctx context.Context, version string) error {
+	if err := r.redisClient.Set(ctx, denyRulesVersionKey, version); err != nil {
+		return fmt.Errorf("could not set deny rules version: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteDenyRulesVersion removes the version of the deny rules from the cache.
+func (r *repo) DeleteDenyRulesVersion(ctx context.Context) error {
+	if err := r.redisClient.Del(ctx, denyRulesVersionKey); err != nil {
+		return fmt.Errorf("could not delete deny rules version: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package converter
+
+import (
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository/relation/dao"
+)
+
+// ToRelationTuplesFromRepo converts repository layer model to structure of service layer.
+func ToRelationTuplesFromRepo(tuples []*dao.RelationTuple) []*model.RelationTuple {
+	var res []*model.RelationTuple
+	for _, t := range tuples {
+		res = append(res, &model.RelationTuple{
+			Object:   model.RelationObject{Namespace: t.ObjectNamespace, ID: t.ObjectID},
+			Relation: t.Relation,
+			Subject: model.RelationSubject{
+				Namespace: t.SubjectNamespace,
+				ID:        t.SubjectID,
+				Relation:  t.SubjectRelation,
+			},
+		})
+	}
+
+	return res
+}
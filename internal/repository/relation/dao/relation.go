@@ -0,0 +1,11 @@
+package dao
+
+// RelationTuple type is the structure for a relation of a subject to an object.
+type RelationTuple struct {
+	ObjectNamespace  string `db:"object_namespace"`
+	ObjectID         string `db:"object_id"`
+	Relation         string `db:"relation"`
+	SubjectNamespace string `db:"subject_namespace"`
+	SubjectID        string `db:"subject_id"`
+	SubjectRelation  string `db:"subject_relation"`
+}
@@ -0,0 +1,174 @@
+package relation
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	"github.com/8thgencore/microservice-auth/internal/repository/relation/converter"
+	"github.com/8thgencore/microservice-auth/internal/repository/relation/dao"
+	"github.com/8thgencore/microservice-common/pkg/db"
+)
+
+const (
+	tableName = "relation_tuples"
+
+	objectNamespaceColumn  = "object_namespace"
+	objectIDColumn         = "object_id"
+	relationColumn         = "relation"
+	subjectNamespaceColumn = "subject_namespace"
+	subjectIDColumn        = "subject_id"
+	subjectRelationColumn  = "subject_relation"
+	revisionColumn         = "revision"
+
+	revisionSequence = "relation_tuples_revision_seq"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new object of repository layer.
+func NewRepository(db db.Client) repository.RelationRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) NextRevision(ctx context.Context) (int64, error) {
+	q := db.Query{
+		Name:     "relation_repository.NextRevision",
+		QueryRaw: "SELECT nextval('" + revisionSequence + "')",
+	}
+
+	var revision int64
+	err := r.db.DB().QueryRowContext(ctx, q).Scan(&revision)
+
+	return revision, err
+}
+
+func (r *repo) Revision(ctx context.Context) (int64, error) {
+	q := db.Query{
+		Name:     "relation_repository.Revision",
+		QueryRaw: "SELECT CASE WHEN is_called THEN last_value ELSE 0 END FROM " + revisionSequence,
+	}
+
+	var revision int64
+	err := r.db.DB().QueryRowContext(ctx, q).Scan(&revision)
+
+	return revision, err
+}
+
+func (r *repo) WriteTuples(ctx context.Context, tuples []*model.RelationTuple, revision int64) error {
+	builderInsert := sq.Insert(tableName).
+		Columns(objectNamespaceColumn, objectIDColumn, relationColumn, subjectNamespaceColumn, subjectIDColumn,
+			subjectRelationColumn, revisionColumn).
+		Suffix("ON CONFLICT DO NOTHING").
+		PlaceholderFormat(sq.Dollar)
+	for _, t := range tuples {
+		builderInsert = builderInsert.Values(t.Object.Namespace, t.Object.ID, t.Relation, t.Subject.Namespace,
+			t.Subject.ID, t.Subject.Relation, revision)
+	}
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "relation_repository.WriteTuples",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
+
+func (r *repo) DeleteTuples(ctx context.Context, tuples []*model.RelationTuple) error {
+	matches := make(sq.Or, 0, len(tuples))
+	for _, t := range tuples {
+		matches = append(matches, sq.Eq{
+			objectNamespaceColumn:  t.Object.Namespace,
+			objectIDColumn:         t.Object.ID,
+			relationColumn:         t.Relation,
+			subjectNamespaceColumn: t.Subject.Namespace,
+			subjectIDColumn:        t.Subject.ID,
+			subjectRelationColumn:  t.Subject.Relation,
+		})
+	}
+
+	builderDelete := sq.Delete(tableName).
+		Where(matches).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "relation_repository.DeleteTuples",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
+
+func (r *repo) ReadTuples(
+	ctx context.Context,
+	object model.RelationObject,
+	relation string,
+) ([]*model.RelationTuple, error) {
+	builderSelect := sq.Select(objectNamespaceColumn, objectIDColumn, relationColumn, subjectNamespaceColumn,
+		subjectIDColumn, subjectRelationColumn).
+		From(tableName).
+		Where(sq.Eq{objectNamespaceColumn: object.Namespace, objectIDColumn: object.ID, relationColumn: relation}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "relation_repository.ReadTuples",
+		QueryRaw: query,
+	}
+
+	var tuples []*dao.RelationTuple
+	err = r.db.DB().ScanAllContext(ctx, &tuples, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToRelationTuplesFromRepo(tuples), nil
+}
+
+func (r *repo) ReadSubjectTuples(ctx context.Context, subject model.RelationObject) ([]*model.RelationTuple, error) {
+	builderSelect := sq.Select(objectNamespaceColumn, objectIDColumn, relationColumn, subjectNamespaceColumn,
+		subjectIDColumn, subjectRelationColumn).
+		From(tableName).
+		Where(sq.Eq{subjectNamespaceColumn: subject.Namespace, subjectIDColumn: subject.ID}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "relation_repository.ReadSubjectTuples",
+		QueryRaw: query,
+	}
+
+	var tuples []*dao.RelationTuple
+	err = r.db.DB().ScanAllContext(ctx, &tuples, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToRelationTuplesFromRepo(tuples), nil
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/8thgencore/microservice-auth/internal/model"
 )
@@ -11,33 +12,222 @@ type UserRepository interface {
 	Create(ctx context.Context, user *model.UserCreate) (string, error)
 	Get(ctx context.Context, id string) (*model.User, error)
 	Update(ctx context.Context, user *model.UserUpdate) error
+	// Delete permanently deletes the user.
 	Delete(ctx context.Context, id string) error
+	// SetStatus changes the status of the user and bumps the token version, returning the new version.
+	SetStatus(ctx context.Context, id string, change *model.UserStatusChange) (int, error)
+	// PurgeDeleted permanently deletes up to limit users deleted or deactivated before the time.
+	// It returns the IDs of the purged users.
+	PurgeDeleted(ctx context.Context, deletedBefore time.Time, limit int) ([]string, error)
 	GetAuthInfo(ctx context.Context, username string) (*model.AuthInfo, error)
 	FindByName(ctx context.Context, name string) (*model.User, error)
+	// FindByEmail returns the user with the email, or nil if there is none.
+	FindByEmail(ctx context.Context, email string) (*model.User, error)
 	UpdatePassword(ctx context.Context, userID string, hashedPassword string) error
+	// GetVersion returns the durable token version of the user.
+	GetVersion(ctx context.Context, id string) (int, error)
+	// IncrementVersion bumps the token version of the user, invalidating every token issued before.
+	IncrementVersion(ctx context.Context, id string) (int, error)
+	// ConfirmEmail marks the email of the user as verified, making a pending email the email of the user.
+	ConfirmEmail(ctx context.Context, userID, email string) error
+	// List returns the users matching the query in its order.
+	List(ctx context.Context, query *model.UserListQuery) ([]*model.User, error)
+	// Count returns an estimate of the number of users matching the filter.
+	Count(ctx context.Context, filter *model.UserFilter) (int64, error)
 }
 
 // AccessRepository is the interface for access policies repository communication.
 type AccessRepository interface {
 	GetRoleEndpoints(ctx context.Context) ([]*model.EndpointPermissions, error)
-	AddRoleEndpoint(ctx context.Context, endpoint string, allowedRoles []string) error
-	UpdateRoleEndpoint(ctx context.Context, endpoint string, allowedRoles []string) error
+	AddRoleEndpoint(ctx context.Context, endpoint string, allowedRoles []string, condition string) error
+	// UpdateRoleEndpoint replaces the roles of the endpoint, and its condition when it is not nil.
+	UpdateRoleEndpoint(ctx context.Context, endpoint string, allowedRoles []string, condition *string) error
 	DeleteRoleEndpoint(ctx context.Context, endpoint string) error
+	// GetDenyRules returns every deny rule, ordered by name.
+	GetDenyRules(ctx context.Context) ([]*model.DenyRule, error)
+	AddDenyRule(ctx context.Context, rule *model.DenyRule) error
+	DeleteDenyRule(ctx context.Context, name string) error
 }
 
-// LogRepository is the interface for transaction log repository communication.
-type LogRepository interface {
-	Log(ctx context.Context, log *model.Log) error
+// RelationRepository is the interface for relation tuples repository communication.
+type RelationRepository interface {
+	// NextRevision takes the revision of a write or a delete of tuples.
+	NextRevision(ctx context.Context) (int64, error)
+	// Revision returns the last revision taken, 0 when no tuples were ever written.
+	Revision(ctx context.Context) (int64, error)
+	// WriteTuples stores the tuples at the revision, the tuples already stored are left untouched.
+	WriteTuples(ctx context.Context, tuples []*model.RelationTuple, revision int64) error
+	DeleteTuples(ctx context.Context, tuples []*model.RelationTuple) error
+	// ReadTuples returns the tuples of the relation of the object.
+	ReadTuples(ctx context.Context, object model.RelationObject, relation string) ([]*model.RelationTuple, error)
+	// ReadSubjectTuples returns the tuples of the object as a subject, itself or as any of its usersets.
+	ReadSubjectTuples(ctx context.Context, subject model.RelationObject) ([]*model.RelationTuple, error)
+}
+
+// RoleRepository is the interface for roles repository communication.
+type RoleRepository interface {
+	Create(ctx context.Context, role *model.Role) error
+	Get(ctx context.Context, name string) (*model.Role, error)
+	// List returns every role with its parents, ordered by name.
+	List(ctx context.Context) ([]*model.Role, error)
+	Update(ctx context.Context, update *model.RoleUpdate) error
+	// Delete deletes the role, taking it from the users holding it and the roles inheriting it.
+	Delete(ctx context.Context, name string) error
+}
+
+// PermissionRepository is the interface for permissions repository communication.
+type PermissionRepository interface {
+	Create(ctx context.Context, permission *model.Permission) error
+	Get(ctx context.Context, name string) (*model.Permission, error)
+	// List returns every permission with its endpoints and roles, ordered by name.
+	List(ctx context.Context) ([]*model.Permission, error)
+	Update(ctx context.Context, update *model.PermissionUpdate) error
+	Delete(ctx context.Context, name string) error
+}
+
+// AuditRepository is the interface for audit log repository communication.
+type AuditRepository interface {
+	// Record stores an audit event, setting its time. It is linked to the hash chain by Seal.
+	Record(ctx context.Context, event *model.AuditEvent) error
+	// Seal links up to limit recorded events to the hash chain, setting their sequence numbers and hashes,
+	// and returns how many it linked.
+	Seal(ctx context.Context, limit int) (int, error)
+	// List returns the events matching the query, newest first.
+	List(ctx context.Context, query *model.AuditListQuery) ([]*model.AuditEvent, error)
+	// ListChain returns up to limit events following the sequence number, in chain order.
+	ListChain(ctx context.Context, afterSeq int64, limit int) ([]*model.AuditEvent, error)
+}
+
+// TokenFamilyRepository is the interface for refresh token family repository communication.
+type TokenFamilyRepository interface {
+	// Create creates a new refresh token family.
+	Create(ctx context.Context, family *model.TokenFamily) error
+	// Get retrieves a refresh token family by its ID.
+	Get(ctx context.Context, id string) (*model.TokenFamily, error)
+	// ListActive retrieves the active families (sessions) of a user.
+	ListActive(ctx context.Context, userID string) ([]*model.TokenFamily, error)
+	// Rotate atomically replaces the current token ID of an active family.
+	// It returns false if currentTokenID is no longer current or the family is revoked.
+	Rotate(ctx context.Context, id, currentTokenID, nextTokenID string, client *model.ClientInfo) (bool, error)
+	// Revoke revokes every token of the family.
+	Revoke(ctx context.Context, id string) error
+	// RevokeAll revokes every family of a user except the one with exceptID, if given.
+	RevokeAll(ctx context.Context, userID string, exceptID string) error
+}
+
+// MfaRepository is the interface for multi-factor authentication repository communication.
+type MfaRepository interface {
+	// SaveTotp stores the secret of a pending TOTP enrollment. A confirmed TOTP is left untouched.
+	SaveTotp(ctx context.Context, userID, secret string) error
+	// GetTotp retrieves the TOTP second factor of a user.
+	GetTotp(ctx context.Context, userID string) (*model.Totp, error)
+	// ConfirmTotp turns a pending TOTP enrollment on.
+	ConfirmTotp(ctx context.Context, userID string) error
+	// UseTotpStep atomically records the time step of an accepted code.
+	// It returns false if a code of the same or a later time step was already accepted.
+	UseTotpStep(ctx context.Context, userID string, step int64) (bool, error)
+	// DeleteTotp removes the TOTP second factor of a user.
+	DeleteTotp(ctx context.Context, userID string) error
+	// ReplaceRecoveryCodes replaces the recovery codes of a user with the given code hashes.
+	ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error
+	// HasRecoveryCode reports whether the user has an unused recovery code with the hash, without using it.
+	HasRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error)
+	// UseRecoveryCode atomically marks an unused recovery code as used.
+	// It returns false if there is no such unused code.
+	UseRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error)
+	// DeleteRecoveryCodes removes every recovery code of a user.
+	DeleteRecoveryCodes(ctx context.Context, userID string) error
+}
+
+// PasskeyRepository is the interface for passkey repository communication.
+type PasskeyRepository interface {
+	// Create stores a newly registered passkey.
+	Create(ctx context.Context, passkey *model.Passkey) error
+	// ListByUser retrieves every passkey of a user.
+	ListByUser(ctx context.Context, userID string) ([]*model.Passkey, error)
+	// Use atomically records a login with the passkey and its new signature counter.
+	// It returns false if the counter does not move forward for an authenticator that keeps one.
+	Use(ctx context.Context, id []byte, signCount uint32, backupState bool) (bool, error)
+}
+
+// PasskeyCeremonyRepository is the interface for pending passkey ceremonies repository communication.
+type PasskeyCeremonyRepository interface {
+	// Save stores the state of a ceremony until it expires.
+	Save(ctx context.Context, id string, ceremony *model.PasskeyCeremony) error
+	// Take retrieves and removes the state of a ceremony, so it can only be finished once.
+	Take(ctx context.Context, id string) (*model.PasskeyCeremony, error)
+}
+
+// LoginAttemptRepository is the interface for failed logins repository communication.
+// The key is either a username or a client IP with a prefix telling them apart.
+type LoginAttemptRepository interface {
+	// AddFailure counts a failure for the key and returns the failures including it.
+	AddFailure(ctx context.Context, key string) (*model.LoginFailures, error)
+	// Reserve atomically counts an attempt for the key at the time, before its outcome is known,
+	// and returns the failures counted before it.
+	Reserve(ctx context.Context, key string, at time.Time) (*model.LoginFailures, error)
+	// Release takes back an attempt reserved at the time that turned out not to be a failure.
+	Release(ctx context.Context, key string, at time.Time, before *model.LoginFailures) error
+	// Reset forgets the failures counted for the key.
+	Reset(ctx context.Context, key string) error
+}
+
+// PasswordResetRepository is the interface for password reset tokens repository communication.
+type PasswordResetRepository interface {
+	// Create stores the hash of a new reset token of a user.
+	Create(ctx context.Context, userID, tokenHash string, expiresAt time.Time) error
+	// Use atomically marks an unused and unexpired reset token as used and returns its user ID.
+	Use(ctx context.Context, tokenHash string) (string, error)
+	// DeleteByUser removes every reset token of a user.
+	DeleteByUser(ctx context.Context, userID string) error
 }
 
 // TokenRepository is the interface for revoked token repository communication.
 type TokenRepository interface {
 	// AddRevokedToken adds the revoked token to the cache.
 	AddRevokedToken(ctx context.Context, refreshToken string) error
+	// RevokeToken atomically revokes a token unless it already is revoked and reports whether it revoked it.
+	RevokeToken(ctx context.Context, token string) (bool, error)
 	// IsTokenRevoked checks if the refresh token is revoked.
 	IsTokenRevoked(ctx context.Context, refreshToken string) (bool, error)
-	// SetTokenVersion sets the token version.
+	// SetTokenVersion caches the token version.
 	SetTokenVersion(ctx context.Context, userID string, version int) error
-	// GetTokenVersion gets the current token version from the cache.
+	// DeleteTokenVersion removes the token version from the cache, the next read falls back to the database.
+	DeleteTokenVersion(ctx context.Context, userID string) error
+	// GetTokenVersion gets the token version from the cache. It returns 0 on a cache miss.
 	GetTokenVersion(ctx context.Context, userID string) (int, error)
 }
+
+// PolicyVersionRepository is the interface for the versions of the access policy shared by the replicas.
+// A replica reads its cached deny rules again once their version changed.
+type PolicyVersionRepository interface {
+	// GetDenyRulesVersion returns the version of the deny rules. It returns an empty version on a cache miss.
+	GetDenyRulesVersion(ctx context.Context) (string, error)
+	// AddDenyRulesVersion caches the version of the deny rules unless one is cached and reports whether it did.
+	AddDenyRulesVersion(ctx context.Context, version string) (bool, error)
+	// SetDenyRulesVersion replaces the version of the deny rules.
+	SetDenyRulesVersion(ctx context.Context, version string) error
+	// DeleteDenyRulesVersion removes the version of the deny rules from the cache.
+	DeleteDenyRulesVersion(ctx context.Context) error
+}
+
+// NotificationRepository is the interface for the outbox of notifications.
+type NotificationRepository interface {
+	// Create puts a message into the outbox. Within a transaction it only becomes visible on commit.
+	Create(ctx context.Context, notification *model.Notification) error
+	// ClaimDue takes up to limit unsent messages that are due and have attempts left.
+	// The messages are postponed by lease, so that other dispatchers skip them meanwhile.
+	ClaimDue(ctx context.Context, limit, maxAttempts int, lease time.Duration) ([]*model.Notification, error)
+	// MarkSent records that a message was sent.
+	MarkSent(ctx context.Context, id string) error
+	// MarkFailed records a failed attempt and postpones the next one by retryIn.
+	MarkFailed(ctx context.Context, id string, retryIn time.Duration, lastError string) error
+}
+
+// EmailVerificationRepository is the interface for email verification tokens repository communication.
+type EmailVerificationRepository interface {
+	// Create stores the hash of a new verification token of an email of a user.
+	Create(ctx context.Context, verification *model.EmailVerification) error
+	// Use atomically marks an unused and unexpired verification token as used and returns what it verifies.
+	Use(ctx context.Context, tokenHash string) (*model.EmailVerification, error)
+}
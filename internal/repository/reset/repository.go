@@ -0,0 +1,117 @@
+package reset
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/8thgencore/microservice-common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	authService "github.com/8thgencore/microservice-auth/internal/service/auth"
+)
+
+const (
+	tableName = "password_reset_tokens"
+
+	userIDColumn    = "user_id"
+	tokenHashColumn = "token_hash"
+	expiresAtColumn = "expires_at"
+	usedAtColumn    = "used_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new object of repository layer.
+func NewRepository(db db.Client) repository.PasswordResetRepository {
+	return &repo{db: db}
+}
+
+// Create stores the hash of a new reset token of a user.
+func (r *repo) Create(ctx context.Context, userID, tokenHash string, expiresAt time.Time) error {
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(userIDColumn, tokenHashColumn, expiresAtColumn).
+		Values(userID, tokenHash, expiresAt)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "reset_repository.Create",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Use marks an unused and unexpired reset token as used and returns its user ID.
+// Of concurrent calls with the same token only one succeeds.
+func (r *repo) Use(ctx context.Context, tokenHash string) (string, error) {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(usedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{
+			tokenHashColumn: tokenHash,
+			usedAtColumn:    nil,
+		}).
+		Where(sq.Expr(expiresAtColumn + " > NOW()")).
+		Suffix("RETURNING " + userIDColumn)
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	q := db.Query{
+		Name:     "reset_repository.Use",
+		QueryRaw: query,
+	}
+
+	var userID string
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", authService.ErrInvalidResetToken
+		}
+
+		return "", err
+	}
+
+	return userID, nil
+}
+
+// DeleteByUser removes every reset token of a user.
+func (r *repo) DeleteByUser(ctx context.Context, userID string) error {
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{userIDColumn: userID})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "reset_repository.DeleteByUser",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
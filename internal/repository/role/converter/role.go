@@ -0,0 +1,27 @@
+package converter
+
+import (
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository/role/dao"
+)
+
+// ToRoleFromRepo converts repository layer model to structure of service layer.
+func ToRoleFromRepo(role *dao.Role) *model.Role {
+	return &model.Role{
+		Name:        role.Name,
+		Description: role.Description,
+		Parents:     role.Parents,
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+}
+
+// ToRolesFromRepo converts repository layer models to structures of service layer.
+func ToRolesFromRepo(roles []*dao.Role) []*model.Role {
+	res := make([]*model.Role, 0, len(roles))
+	for _, role := range roles {
+		res = append(res, ToRoleFromRepo(role))
+	}
+
+	return res
+}
@@ -0,0 +1,15 @@
+package dao
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Role type is the structure for role from storage.
+type Role struct {
+	Name        string       `db:"name"`
+	Description string       `db:"description"`
+	Parents     []string     `db:"parents"`
+	CreatedAt   time.Time    `db:"created_at"`
+	UpdatedAt   sql.NullTime `db:"updated_at"`
+}
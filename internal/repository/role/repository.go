@@ -0,0 +1,258 @@
+package role
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	"github.com/8thgencore/microservice-auth/internal/repository/role/converter"
+	"github.com/8thgencore/microservice-auth/internal/repository/role/dao"
+	roleService "github.com/8thgencore/microservice-auth/internal/service/role"
+	"github.com/8thgencore/microservice-common/pkg/db"
+)
+
+const (
+	tableName        = "roles"
+	parentsTableName = "role_parents"
+
+	nameColumn        = "name"
+	descriptionColumn = "description"
+	createdAtColumn   = "created_at"
+	updatedAtColumn   = "updated_at"
+
+	roleColumn   = "role"
+	parentColumn = "parent"
+
+	// parentsColumn selects the parents of a role as an array.
+	parentsColumn = "ARRAY(SELECT " + parentColumn + " FROM " + parentsTableName +
+		" WHERE " + parentsTableName + "." + roleColumn + " = " + tableName + "." + nameColumn +
+		" ORDER BY " + parentColumn + ") AS parents"
+
+	rolesPkey         = "roles_pkey"
+	parentsParentFkey = "role_parents_parent_fkey"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new object of repository layer.
+func NewRepository(db db.Client) repository.RoleRepository {
+	return &repo{db: db}
+}
+
+// Create creates a new role with its parents.
+func (r *repo) Create(ctx context.Context, role *model.Role) error {
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(nameColumn, descriptionColumn).
+		Values(role.Name, role.Description)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.Create",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation && pgErr.ConstraintName == rolesPkey {
+			return roleService.ErrRoleExists
+		}
+
+		return err
+	}
+
+	return r.insertParents(ctx, role.Name, role.Parents)
+}
+
+// Get retrieves a role by its name.
+func (r *repo) Get(ctx context.Context, name string) (*model.Role, error) {
+	builderSelect := sq.Select(nameColumn, descriptionColumn, parentsColumn, createdAtColumn, updatedAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{nameColumn: name}).
+		Limit(1)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.Get",
+		QueryRaw: query,
+	}
+
+	var role dao.Role
+	err = r.db.DB().ScanOneContext(ctx, &role, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, roleService.ErrRoleNotFound
+		}
+
+		return nil, err
+	}
+
+	return converter.ToRoleFromRepo(&role), nil
+}
+
+// List retrieves every role, ordered by name.
+func (r *repo) List(ctx context.Context) ([]*model.Role, error) {
+	builderSelect := sq.Select(nameColumn, descriptionColumn, parentsColumn, createdAtColumn, updatedAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		OrderBy(nameColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.List",
+		QueryRaw: query,
+	}
+
+	var roles []*dao.Role
+	err = r.db.DB().ScanAllContext(ctx, &roles, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToRolesFromRepo(roles), nil
+}
+
+// Update updates the description of a role and replaces its parents.
+func (r *repo) Update(ctx context.Context, update *model.RoleUpdate) error {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(updatedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{nameColumn: update.Name})
+
+	if update.Description != nil {
+		builderUpdate = builderUpdate.Set(descriptionColumn, *update.Description)
+	}
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.Update",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return roleService.ErrRoleNotFound
+	}
+
+	if update.Parents == nil {
+		return nil
+	}
+
+	if err = r.deleteParents(ctx, update.Name); err != nil {
+		return err
+	}
+
+	return r.insertParents(ctx, update.Name, *update.Parents)
+}
+
+// Delete deletes a role. The users holding it and the roles inheriting it lose it.
+func (r *repo) Delete(ctx context.Context, name string) error {
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{nameColumn: name})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.Delete",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return roleService.ErrRoleNotFound
+	}
+
+	return nil
+}
+
+func (r *repo) insertParents(ctx context.Context, name string, parents []string) error {
+	if len(parents) == 0 {
+		return nil
+	}
+
+	builderInsert := sq.Insert(parentsTableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(roleColumn, parentColumn)
+	for _, parent := range parents {
+		builderInsert = builderInsert.Values(name, parent)
+	}
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.InsertParents",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation &&
+			pgErr.ConstraintName == parentsParentFkey {
+			return roleService.ErrParentRoleNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *repo) deleteParents(ctx context.Context, name string) error {
+	builderDelete := sq.Delete(parentsTableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{roleColumn: name})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.DeleteParents",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
@@ -2,13 +2,13 @@ package token
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
+	"github.com/8thgencore/microservice-auth/internal/cache"
 	"github.com/8thgencore/microservice-auth/internal/repository"
-	"github.com/8thgencore/microservice-common/pkg/cache"
 )
 
 type repo struct {
@@ -32,21 +32,21 @@ func NewRepository(
 
 // AddRevokedToken adds a revoked refresh token to Redis with a TTL (time-to-live).
 func (r *repo) AddRevokedToken(ctx context.Context, refreshToken string) error {
-	if err := r.redisClient.SetEx(ctx, refreshToken, true, r.refreshTokenTTL); err != nil {
-		return err
-	}
+	return r.redisClient.SetEx(ctx, refreshToken, true, r.refreshTokenTTL)
+}
 
-	return nil
+// RevokeToken adds the token to the revoked tokens unless it already is one.
+// The check and the revocation are a single SETNX, so of concurrent calls exactly one revokes the token.
+func (r *repo) RevokeToken(ctx context.Context, token string) (bool, error) {
+	return r.redisClient.SetNX(ctx, token, true, r.refreshTokenTTL)
 }
 
 // IsTokenRevoked checks if a refresh token is in the list of revoked tokens.
 func (r *repo) IsTokenRevoked(ctx context.Context, refreshToken string) (bool, error) {
-	_, err := r.redisClient.Get(ctx, refreshToken)
-	if err != nil {
-		if string(err.Error()) == "key not found" {
+	if _, err := r.redisClient.Get(ctx, refreshToken); err != nil {
+		if errors.Is(err, cache.ErrKeyNotFound) {
 			return false, nil
 		}
-
 		return false, err
 	}
 
@@ -63,12 +63,22 @@ func (r *repo) SetTokenVersion(ctx context.Context, userID string, version int)
 	return nil
 }
 
+// DeleteTokenVersion removes the token version from the cache.
+func (r *repo) DeleteTokenVersion(ctx context.Context, userID string) error {
+	key := "token_version:" + userID
+	if err := r.redisClient.Del(ctx, key); err != nil {
+		return fmt.Errorf("could not delete user version: %w", err)
+	}
+
+	return nil
+}
+
 // GetTokenVersion gets the current token version from the cache.
 func (r *repo) GetTokenVersion(ctx context.Context, userID string) (int, error) {
 	key := "token_version:" + userID
 	rawVersion, err := r.redisClient.Get(ctx, key)
 	if err != nil {
-		if strings.Contains(err.Error(), "key not found") {
+		if errors.Is(err, cache.ErrKeyNotFound) {
 			return 0, nil
 		}
 		return 0, fmt.Errorf("could not get user version: %w", err)
@@ -10,25 +10,33 @@ import (
 // ToUserFromRepo converts repository layer model to structure of service layer.
 func ToUserFromRepo(user *dao.User) *model.User {
 	return &model.User{
-		ID:        user.ID,
-		Name:      user.Name,
-		Email:     user.Email,
-		Password:  user.Password,
-		Role:      user.Role,
-		Version:   user.Version,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+		ID:             user.ID,
+		Name:           user.Name,
+		Email:          user.Email,
+		EmailVerified:  user.EmailVerified,
+		PendingEmail:   user.PendingEmail,
+		Password:       user.Password,
+		Roles:          user.Roles,
+		Version:        user.Version,
+		Status:         model.UserStatus(user.Status),
+		SuspendedUntil: user.SuspendedUntil,
+		DeletedAt:      user.DeletedAt,
+		CreatedAt:      user.CreatedAt,
+		UpdatedAt:      user.UpdatedAt,
 	}
 }
 
 // ToAuthInfoFromRepo converts repository layer model to structure of service layer.
 func ToAuthInfoFromRepo(authInfo *dao.AuthInfo) *model.AuthInfo {
 	return &model.AuthInfo{
-		ID:       authInfo.ID,
-		Username: authInfo.Username,
-		Role:     authInfo.Role,
-		Version:  authInfo.Version,
-		Password: authInfo.Password,
+		ID:             authInfo.ID,
+		Username:       authInfo.Username,
+		Roles:          authInfo.Roles,
+		Version:        authInfo.Version,
+		Password:       authInfo.Password,
+		EmailVerified:  authInfo.EmailVerified,
+		Status:         model.UserStatus(authInfo.Status),
+		SuspendedUntil: authInfo.SuspendedUntil,
 	}
 }
 
@@ -44,8 +52,8 @@ func ToUserUpdateDAO(user *model.UserUpdate) *dao.UserUpdate {
 	if user.Email != nil {
 		update.Email = sql.NullString{String: *user.Email, Valid: true}
 	}
-	if user.Role != nil {
-		update.Role = sql.NullString{String: *user.Role, Valid: true}
+	if user.PendingEmail != nil {
+		update.PendingEmail = sql.NullString{String: *user.PendingEmail, Valid: true}
 	}
 	if user.Version != nil {
 		update.Version = sql.NullInt32{Int32: *user.Version, Valid: true}
@@ -53,3 +61,13 @@ func ToUserUpdateDAO(user *model.UserUpdate) *dao.UserUpdate {
 
 	return update
 }
+
+// ToUsersFromRepo converts a list of repository layer models to structures of service layer.
+func ToUsersFromRepo(users []*dao.User) []*model.User {
+	result := make([]*model.User, 0, len(users))
+	for _, user := range users {
+		result = append(result, ToUserFromRepo(user))
+	}
+
+	return result
+}
@@ -7,30 +7,38 @@ import (
 
 // User type is the main structure for user.
 type User struct {
-	ID        string       `db:"id"`
-	Name      string       `db:"name"`
-	Email     string       `db:"email"`
-	Password  string       `db:"password"`
-	Role      string       `db:"role"`
-	Version   int          `db:"version"`
-	CreatedAt time.Time    `db:"created_at"`
-	UpdatedAt sql.NullTime `db:"updated_at"`
+	ID             string         `db:"id"`
+	Name           string         `db:"name"`
+	Email          string         `db:"email"`
+	EmailVerified  bool           `db:"email_verified"`
+	PendingEmail   sql.NullString `db:"pending_email"`
+	Password       string         `db:"password"`
+	Roles          []string       `db:"roles"`
+	Version        int            `db:"version"`
+	Status         string         `db:"status"`
+	SuspendedUntil sql.NullTime   `db:"suspended_until"`
+	DeletedAt      sql.NullTime   `db:"deleted_at"`
+	CreatedAt      time.Time      `db:"created_at"`
+	UpdatedAt      sql.NullTime   `db:"updated_at"`
 }
 
 // AuthInfo type is the structure for user authentication data from storage.
 type AuthInfo struct {
-	ID       string `db:"id"`
-	Username string `db:"name"`
-	Password string `db:"password"`
-	Role     string `db:"role"`
-	Version  int    `db:"version"`
+	ID             string       `db:"id"`
+	Username       string       `db:"name"`
+	Password       string       `db:"password"`
+	Roles          []string     `db:"roles"`
+	Version        int          `db:"version"`
+	EmailVerified  bool         `db:"email_verified"`
+	Status         string       `db:"status"`
+	SuspendedUntil sql.NullTime `db:"suspended_until"`
 }
 
 // UserUpdate type is the structure for user update data from storage.
 type UserUpdate struct {
-	ID      string
-	Name    sql.NullString
-	Email   sql.NullString
-	Role    sql.NullString
-	Version sql.NullInt32
+	ID           string
+	Name         sql.NullString
+	Email        sql.NullString
+	PendingEmail sql.NullString
+	Version      sql.NullInt32
 }
@@ -3,6 +3,8 @@ package user
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/8thgencore/microservice-auth/internal/model"
 	"github.com/8thgencore/microservice-auth/internal/repository"
@@ -20,17 +22,31 @@ import (
 const (
 	tableName = "users"
 
-	idColumn        = "id"
-	nameColumn      = "name"
-	passwordColumn  = "password"
-	emailColumn     = "email"
-	roleColumn      = "role"
-	versionColumn   = "version"
-	createdAtColumn = "created_at"
-	updatedAtColumn = "updated_at"
-
-	userNameKey  = "users_name_key"
-	userEmailKey = "users_email_key"
+	idColumn            = "id"
+	nameColumn          = "name"
+	passwordColumn      = "password"
+	emailColumn         = "email"
+	emailVerifiedColumn = "email_verified"
+	pendingEmailColumn  = "pending_email"
+	versionColumn       = "version"
+	statusColumn        = "status"
+	suspendedColumn     = "suspended_until"
+	deletedAtColumn     = "deleted_at"
+	createdAtColumn     = "created_at"
+	updatedAtColumn     = "updated_at"
+
+	userRolesTableName = "user_roles"
+	userIDColumn       = "user_id"
+	roleColumn         = "role"
+
+	// rolesColumn selects the roles held by a user as an array.
+	rolesColumn = "ARRAY(SELECT " + roleColumn + " FROM " + userRolesTableName +
+		" WHERE " + userRolesTableName + "." + userIDColumn + " = " + tableName + "." + idColumn +
+		" ORDER BY " + roleColumn + ") AS roles"
+
+	userNameKey      = "users_name_key"
+	userEmailKey     = "users_email_key"
+	userRolesRoleKey = "user_roles_role_fkey"
 )
 
 type repo struct {
@@ -46,8 +62,8 @@ func NewRepository(db db.Client) repository.UserRepository {
 func (r *repo) Create(ctx context.Context, user *model.UserCreate) (string, error) {
 	builderInsert := sq.Insert(tableName).
 		PlaceholderFormat(sq.Dollar).
-		Columns(idColumn, nameColumn, roleColumn, emailColumn, passwordColumn).
-		Values(user.ID, user.Name, user.Role, user.Email, user.Password).
+		Columns(idColumn, nameColumn, emailColumn, emailVerifiedColumn, passwordColumn).
+		Values(user.ID, user.Name, user.Email, user.EmailVerified, user.Password).
 		Suffix("RETURNING " + idColumn)
 
 	query, args, err := builderInsert.ToSql()
@@ -76,6 +92,10 @@ func (r *repo) Create(ctx context.Context, user *model.UserCreate) (string, erro
 		return "", err
 	}
 
+	if err = r.insertRoles(ctx, id, user.Roles); err != nil {
+		return "", err
+	}
+
 	return id, nil
 }
 
@@ -85,9 +105,14 @@ func (r *repo) Get(ctx context.Context, id string) (*model.User, error) {
 		idColumn,
 		nameColumn,
 		emailColumn,
+		emailVerifiedColumn,
+		pendingEmailColumn,
 		passwordColumn,
-		roleColumn,
+		rolesColumn,
 		versionColumn,
+		statusColumn,
+		suspendedColumn,
+		deletedAtColumn,
 		createdAtColumn,
 		updatedAtColumn,
 	).
@@ -134,8 +159,8 @@ func (r *repo) Update(ctx context.Context, user *model.UserUpdate) error {
 	if userDAO.Email.Valid {
 		builderUpdate = builderUpdate.Set(emailColumn, userDAO.Email.String)
 	}
-	if userDAO.Role.Valid {
-		builderUpdate = builderUpdate.Set(roleColumn, userDAO.Role.String)
+	if userDAO.PendingEmail.Valid {
+		builderUpdate = builderUpdate.Set(pendingEmailColumn, userDAO.PendingEmail.String)
 	}
 	if userDAO.Version.Valid {
 		builderUpdate = builderUpdate.Set(versionColumn, userDAO.Version.Int32)
@@ -166,10 +191,76 @@ func (r *repo) Update(ctx context.Context, user *model.UserUpdate) error {
 		return err
 	}
 
+	if user.Roles == nil {
+		return nil
+	}
+
+	if err = r.deleteRoles(ctx, user.ID); err != nil {
+		return err
+	}
+
+	return r.insertRoles(ctx, user.ID, *user.Roles)
+}
+
+// insertRoles grants the roles to a user.
+func (r *repo) insertRoles(ctx context.Context, userID string, roles []string) error {
+	if len(roles) == 0 {
+		return nil
+	}
+
+	builderInsert := sq.Insert(userRolesTableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(userIDColumn, roleColumn)
+	for _, role := range roles {
+		builderInsert = builderInsert.Values(userID, role)
+	}
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "user_repository.InsertRoles",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation &&
+			pgErr.ConstraintName == userRolesRoleKey {
+			return userService.ErrUnknownRole
+		}
+
+		return err
+	}
+
 	return nil
 }
 
-// Delete deletes a user by their ID.
+// deleteRoles takes every role from a user.
+func (r *repo) deleteRoles(ctx context.Context, userID string) error {
+	builderDelete := sq.Delete(userRolesTableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{userIDColumn: userID})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "user_repository.DeleteRoles",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
+
+// Delete permanently deletes a user by their ID together with their credentials and sessions.
 func (r *repo) Delete(ctx context.Context, id string) error {
 	builderDelete := sq.Delete(tableName).
 		PlaceholderFormat(sq.Dollar).
@@ -193,9 +284,152 @@ func (r *repo) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// SetStatus changes the status of a user and bumps the token version, so that tokens issued
+// before the change are rejected. It returns the new token version.
+func (r *repo) SetStatus(ctx context.Context, id string, change *model.UserStatusChange) (int, error) {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(statusColumn, change.Status).
+		Set(suspendedColumn, change.SuspendedUntil).
+		Set(deletedAtColumn, change.DeletedAt).
+		Set(versionColumn, sq.Expr(versionColumn+" + 1")).
+		Set(updatedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{idColumn: id}).
+		Suffix("RETURNING " + versionColumn)
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "user_repository.SetStatus",
+		QueryRaw: query,
+	}
+
+	var version int
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, userService.ErrUserNotFound
+		}
+
+		return 0, err
+	}
+
+	return version, nil
+}
+
+// PurgeDeleted permanently deletes up to limit users that were deleted or deactivated before the time
+// and returns their IDs.
+func (r *repo) PurgeDeleted(ctx context.Context, deletedBefore time.Time, limit int) ([]string, error) {
+	builderSelect := sq.Select(idColumn).
+		From(tableName).
+		Where(sq.Eq{statusColumn: []model.UserStatus{model.UserStatusDeleted, model.UserStatusDeactivated}}).
+		Where(sq.Lt{deletedAtColumn: deletedBefore}).
+		OrderBy(deletedAtColumn).
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(builderSelect.Prefix(idColumn + " IN (").Suffix(")")).
+		Suffix("RETURNING " + idColumn)
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "user_repository.PurgeDeleted",
+		QueryRaw: query,
+	}
+
+	var ids []string
+	err = r.db.DB().ScanAllContext(ctx, &ids, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// GetVersion retrieves the token version of a user.
+func (r *repo) GetVersion(ctx context.Context, id string) (int, error) {
+	builderSelect := sq.Select(versionColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: id}).
+		Limit(1)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "user_repository.GetVersion",
+		QueryRaw: query,
+	}
+
+	var version int
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, userService.ErrUserNotFound
+		}
+
+		return 0, err
+	}
+
+	return version, nil
+}
+
+// IncrementVersion atomically increments the token version of a user and returns the new version.
+func (r *repo) IncrementVersion(ctx context.Context, id string) (int, error) {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(versionColumn, sq.Expr(versionColumn+" + 1")).
+		Set(updatedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{idColumn: id}).
+		Suffix("RETURNING " + versionColumn)
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "user_repository.IncrementVersion",
+		QueryRaw: query,
+	}
+
+	var version int
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, userService.ErrUserNotFound
+		}
+
+		return 0, err
+	}
+
+	return version, nil
+}
+
 // GetAuthInfo retrieves authentication information for a user by their username.
 func (r *repo) GetAuthInfo(ctx context.Context, username string) (*model.AuthInfo, error) {
-	builderSelect := sq.Select(idColumn, nameColumn, roleColumn, passwordColumn, versionColumn).
+	builderSelect := sq.Select(
+		idColumn,
+		nameColumn,
+		rolesColumn,
+		passwordColumn,
+		versionColumn,
+		emailVerifiedColumn,
+		statusColumn,
+		suspendedColumn,
+	).
 		From(tableName).
 		PlaceholderFormat(sq.Dollar).
 		Where(sq.Eq{nameColumn: username}).
@@ -230,8 +464,13 @@ func (r *repo) FindByName(ctx context.Context, name string) (*model.User, error)
 		idColumn,
 		nameColumn,
 		emailColumn,
-		roleColumn,
+		emailVerifiedColumn,
+		pendingEmailColumn,
+		rolesColumn,
 		versionColumn,
+		statusColumn,
+		suspendedColumn,
+		deletedAtColumn,
 		createdAtColumn,
 		updatedAtColumn,
 	).
@@ -262,6 +501,49 @@ func (r *repo) FindByName(ctx context.Context, name string) (*model.User, error)
 	return converter.ToUserFromRepo(&user), nil
 }
 
+// FindByEmail returns user with specified email
+func (r *repo) FindByEmail(ctx context.Context, email string) (*model.User, error) {
+	builderSelect := sq.Select(
+		idColumn,
+		nameColumn,
+		emailColumn,
+		emailVerifiedColumn,
+		pendingEmailColumn,
+		rolesColumn,
+		versionColumn,
+		statusColumn,
+		suspendedColumn,
+		deletedAtColumn,
+		createdAtColumn,
+		updatedAtColumn,
+	).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{emailColumn: email}).
+		Limit(1)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "user_repository.FindByEmail",
+		QueryRaw: query,
+	}
+
+	var user dao.User
+	err = r.db.DB().ScanOneContext(ctx, &user, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return converter.ToUserFromRepo(&user), nil
+}
+
 // UpdatePassword updates the user's password
 func (r *repo) UpdatePassword(ctx context.Context, userID string, hashedPassword string) error {
 	builderUpdate := sq.Update(tableName).
@@ -287,3 +569,233 @@ func (r *repo) UpdatePassword(ctx context.Context, userID string, hashedPassword
 
 	return nil
 }
+
+// ConfirmEmail marks the email of a user as verified. A pending email becomes the email of the user.
+func (r *repo) ConfirmEmail(ctx context.Context, userID, email string) error {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(emailColumn, email).
+		Set(emailVerifiedColumn, true).
+		Set(pendingEmailColumn, sq.Expr(
+			"CASE WHEN "+pendingEmailColumn+" = ? THEN NULL ELSE "+pendingEmailColumn+" END", email,
+		)).
+		Set(updatedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{idColumn: userID}).
+		Where(sq.Or{
+			sq.Eq{emailColumn: email},
+			sq.Eq{pendingEmailColumn: email},
+		})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "user_repository.ConfirmEmail",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation && pgErr.ConstraintName == userEmailKey {
+			return userService.ErrUserEmailExists
+		}
+
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return userService.ErrInvalidVerificationToken
+	}
+
+	return nil
+}
+
+// List retrieves a page of users by keyset pagination: the users after the cursor in the order of
+// the query. Ties of the ordering field are broken by ID, so every user has a unique position.
+func (r *repo) List(ctx context.Context, query *model.UserListQuery) ([]*model.User, error) {
+	orderColumn := orderByColumns[query.OrderBy]
+	if orderColumn == "" {
+		orderColumn = idColumn
+	}
+	direction := "ASC"
+	if query.Descending {
+		direction = "DESC"
+	}
+
+	builderSelect := filterUsers(sq.Select(
+		idColumn,
+		nameColumn,
+		emailColumn,
+		emailVerifiedColumn,
+		pendingEmailColumn,
+		rolesColumn,
+		versionColumn,
+		statusColumn,
+		suspendedColumn,
+		deletedAtColumn,
+		createdAtColumn,
+		updatedAtColumn,
+	).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar), &query.Filter)
+
+	if query.After != nil {
+		after, err := afterCursor(orderColumn, query.After, query.Descending)
+		if err != nil {
+			return nil, err
+		}
+		builderSelect = builderSelect.Where(after)
+	}
+
+	if orderColumn != idColumn {
+		builderSelect = builderSelect.OrderBy(orderColumn + " " + direction)
+	}
+	builderSelect = builderSelect.OrderBy(idColumn + " " + direction).Limit(uint64(query.Limit))
+
+	queryRaw, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "user_repository.List",
+		QueryRaw: queryRaw,
+	}
+
+	var users []*dao.User
+	err = r.db.DB().ScanAllContext(ctx, &users, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToUsersFromRepo(users), nil
+}
+
+// Count estimates the number of users matching the filter. Without a filter the estimate of the
+// planner is used, as counting every user of a large table is slow; filtered users are counted.
+func (r *repo) Count(ctx context.Context, filter *model.UserFilter) (int64, error) {
+	if *filter == (model.UserFilter{}) {
+		estimate, err := r.estimateCount(ctx)
+		if err != nil || estimate >= 0 {
+			return estimate, err
+		}
+	}
+
+	builderSelect := filterUsers(sq.Select("COUNT(*)").
+		From(tableName).
+		PlaceholderFormat(sq.Dollar), filter)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "user_repository.Count",
+		QueryRaw: query,
+	}
+
+	var count int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// estimateCount returns the number of users estimated by the planner, or -1 while the table
+// has not been analyzed yet.
+func (r *repo) estimateCount(ctx context.Context) (int64, error) {
+	builderSelect := sq.Select("reltuples::bigint").
+		From("pg_class").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Expr("oid = ?::regclass", tableName))
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "user_repository.EstimateCount",
+		QueryRaw: query,
+	}
+
+	var estimate int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&estimate)
+	if err != nil {
+		return 0, err
+	}
+
+	return estimate, nil
+}
+
+// orderByColumns maps the orders of a listing to their columns.
+var orderByColumns = map[model.UserOrderBy]string{
+	model.UserOrderByID:        idColumn,
+	model.UserOrderByName:      nameColumn,
+	model.UserOrderByEmail:     emailColumn,
+	model.UserOrderByCreatedAt: createdAtColumn,
+}
+
+// filterUsers restricts the selected users to the ones matching the filter.
+func filterUsers(builder sq.SelectBuilder, filter *model.UserFilter) sq.SelectBuilder {
+	if filter.Role != "" {
+		builder = builder.Where(sq.Expr(
+			"EXISTS (SELECT 1 FROM "+userRolesTableName+" WHERE "+userRolesTableName+"."+userIDColumn+
+				" = "+tableName+"."+idColumn+" AND "+userRolesTableName+"."+roleColumn+" = ?)",
+			filter.Role,
+		))
+	}
+	if filter.NamePrefix != "" {
+		builder = builder.Where(sq.Expr("LOWER("+nameColumn+") LIKE ?", likePrefix(filter.NamePrefix)))
+	}
+	if filter.EmailPrefix != "" {
+		builder = builder.Where(sq.Expr("LOWER("+emailColumn+") LIKE ?", likePrefix(filter.EmailPrefix)))
+	}
+	if filter.CreatedAfter != nil {
+		builder = builder.Where(sq.GtOrEq{createdAtColumn: *filter.CreatedAfter})
+	}
+	if filter.CreatedBefore != nil {
+		builder = builder.Where(sq.Lt{createdAtColumn: *filter.CreatedBefore})
+	}
+	if filter.EmailVerified != nil {
+		builder = builder.Where(sq.Eq{emailVerifiedColumn: *filter.EmailVerified})
+	}
+	if filter.Status != "" {
+		builder = builder.Where(sq.Eq{statusColumn: filter.Status})
+	}
+
+	return builder
+}
+
+// likePrefix returns a LIKE pattern matching the lower-cased values that start with the prefix in any case.
+func likePrefix(prefix string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(strings.ToLower(prefix)) + "%"
+}
+
+// afterCursor returns the condition for the users after the cursor in the order by the column.
+func afterCursor(orderColumn string, cursor *model.UserCursor, descending bool) (sq.Sqlizer, error) {
+	op := ">"
+	if descending {
+		op = "<"
+	}
+
+	if orderColumn == idColumn {
+		return sq.Expr(idColumn+" "+op+" ?", cursor.ID), nil
+	}
+
+	var value any = cursor.Value
+	if orderColumn == createdAtColumn {
+		createdAt, err := time.Parse(time.RFC3339Nano, cursor.Value)
+		if err != nil {
+			return nil, err
+		}
+		value = createdAt
+	}
+
+	return sq.Expr("("+orderColumn+", "+idColumn+") "+op+" (?, ?)", value, cursor.ID), nil
+}
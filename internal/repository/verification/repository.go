@@ -0,0 +1,94 @@
+package verification
+
+import (
+	"context"
+	"errors"
+
+	"github.com/8thgencore/microservice-common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	userService "github.com/8thgencore/microservice-auth/internal/service/user"
+)
+
+const (
+	tableName = "email_verification_tokens"
+
+	userIDColumn    = "user_id"
+	emailColumn     = "email"
+	tokenHashColumn = "token_hash"
+	expiresAtColumn = "expires_at"
+	usedAtColumn    = "used_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new object of repository layer.
+func NewRepository(db db.Client) repository.EmailVerificationRepository {
+	return &repo{db: db}
+}
+
+// Create stores the hash of a new verification token of an email of a user.
+func (r *repo) Create(ctx context.Context, verification *model.EmailVerification) error {
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(userIDColumn, emailColumn, tokenHashColumn, expiresAtColumn).
+		Values(verification.UserID, verification.Email, verification.TokenHash, verification.ExpiresAt)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "verification_repository.Create",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Use marks an unused and unexpired verification token as used and returns what it verifies.
+// Of concurrent calls with the same token only one succeeds.
+func (r *repo) Use(ctx context.Context, tokenHash string) (*model.EmailVerification, error) {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(usedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{
+			tokenHashColumn: tokenHash,
+			usedAtColumn:    nil,
+		}).
+		Where(sq.Expr(expiresAtColumn + " > NOW()")).
+		Suffix("RETURNING " + userIDColumn + ", " + emailColumn)
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "verification_repository.Use",
+		QueryRaw: query,
+	}
+
+	verification := &model.EmailVerification{TokenHash: tokenHash}
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&verification.UserID, &verification.Email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, userService.ErrInvalidVerificationToken
+		}
+
+		return nil, err
+	}
+
+	return verification, nil
+}
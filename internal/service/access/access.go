@@ -5,6 +5,7 @@ import (
 	"errors"
 	"slices"
 
+	"github.com/8thgencore/microservice-auth/internal/audit"
 	"github.com/8thgencore/microservice-auth/internal/model"
 	"github.com/8thgencore/microservice-auth/pkg/utils"
 )
@@ -41,37 +42,80 @@ var (
 	ErrFailedToDeleteEndpoint = errors.New("failed to delete endpoint")
 	// ErrFailedToUpdateEndpoint occurs when there is a problem updating an existing endpoint.
 	ErrFailedToUpdateEndpoint = errors.New("failed to update endpoint")
+	// ErrNoRoles occurs when an endpoint is given no roles allowed to access it.
+	ErrNoRoles = errors.New("at least one role is required")
+	// ErrUnknownRole occurs when an endpoint is given a role that is not defined.
+	ErrUnknownRole = errors.New("unknown role")
+	// ErrInvalidEndpointPattern occurs when an endpoint is neither a full method nor a pattern of full methods.
+	ErrInvalidEndpointPattern = errors.New("invalid endpoint pattern")
+	// ErrInvalidCondition occurs when the condition of an endpoint does not compile or does not evaluate to a bool.
+	ErrInvalidCondition = errors.New("invalid condition")
 )
 
-func (s *accessService) Check(ctx context.Context, endpoint string) error {
-	token, err := utils.ExtractToken(ctx)
+func (s *accessService) Check(ctx context.Context, endpoint string, attributes map[string]string) error {
+	_, err := s.authorize(ctx, endpoint, attributes)
+
+	return err
+}
+
+// CheckPermission checks that the caller holds the permission through one of their roles.
+func (s *accessService) CheckPermission(ctx context.Context, permission string) error {
+	claims, err := s.verifyCaller(ctx)
 	if err != nil {
 		return err
 	}
 
-	claims, err := s.tokenOperations.VerifyAccessToken(token)
+	return s.holdsPermission(claims, permission)
+}
+
+// holdsPermission checks that the user with the claims holds the permission through one of their roles.
+func (s *accessService) holdsPermission(claims *model.UserClaims, permission string) error {
+	if !slices.Contains(s.permissionService.RolePermissions(claims.Roles), permission) {
+		return ErrAccessDenied
+	}
+
+	return nil
+}
+
+// authorize checks that the caller may access the endpoint and returns the claims of their access token.
+// The caller may access it if no deny rule denies them access, if one of their roles is allowed to or they hold
+// a permission granting access to it, and if the condition of the policy of the endpoint holds.
+func (s *accessService) authorize(
+	ctx context.Context,
+	endpoint string,
+	attributes map[string]string,
+) (*model.UserClaims, error) {
+	claims, err := s.verifyCaller(ctx)
 	if err != nil {
-		return ErrInvalidAccessToken
+		return nil, err
 	}
 
-	s.rolesMutex.RLock()
-	roles, ok := s.accessibleRoles[endpoint]
-	s.rolesMutex.RUnlock()
+	if err = decisionError(s.decide(ctx, claims, endpoint, attributes)); err != nil {
+		return nil, err
+	}
 
-	if !ok {
-		return ErrEndpointNotFound
+	return claims, nil
+}
+
+// verifyCaller returns the claims of the access token of the caller.
+func (s *accessService) verifyCaller(ctx context.Context) (*model.UserClaims, error) {
+	token, err := utils.ExtractToken(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	if !slices.Contains(roles, claims.Role) {
-		return ErrAccessDenied
+	claims, err := s.tokenOperations.VerifyAccessToken(token)
+	if err != nil {
+		return nil, ErrInvalidAccessToken
 	}
 
-	return nil
+	return claims, nil
 }
 
 // GetRoleEndpoints retrieves the list of resources after verifying access permissions.
-func (s *accessService) GetRoleEndpoints(ctx context.Context) ([]*model.EndpointPermissions, error) {
-	err := s.Check(ctx, getRoleEndpointsEndpoint)
+// When the endpoint is set, only the resources matching it are listed, the one applied to it first.
+func (s *accessService) GetRoleEndpoints(ctx context.Context, endpoint string) ([]*model.EndpointPermissions, error) {
+	err := s.Check(ctx, getRoleEndpointsEndpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -81,17 +125,45 @@ func (s *accessService) GetRoleEndpoints(ctx context.Context) ([]*model.Endpoint
 		return nil, ErrFailedToGetEndpoint
 	}
 
+	if endpoint != "" {
+		resources = slices.DeleteFunc(resources, func(resource *model.EndpointPermissions) bool {
+			return !matches(resource.Endpoint, endpoint)
+		})
+		slices.SortFunc(resources, func(a, b *model.EndpointPermissions) int {
+			return comparePatterns(a.Endpoint, b.Endpoint)
+		})
+	}
+
 	return resources, nil
 }
 
 // AddRoleEndpoint adds a new resource after verifying access permissions.
-func (s *accessService) AddRoleEndpoint(ctx context.Context, endpoint string, roles []string) error {
-	err := s.Check(ctx, addRoleEndpointEndpoint)
+func (s *accessService) AddRoleEndpoint(ctx context.Context, endpoint string, roles []string, condition string) error {
+	claims, err := s.authorize(ctx, addRoleEndpointEndpoint, nil)
 	if err != nil {
 		return err
 	}
 
-	err = s.accessRepository.AddRoleEndpoint(ctx, endpoint, roles)
+	if err = validatePattern(endpoint); err != nil {
+		return err
+	}
+	if err = s.validateRoles(roles); err != nil {
+		return err
+	}
+	cond, err := s.compileCondition(condition)
+	if err != nil {
+		return err
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if errTx := s.accessRepository.AddRoleEndpoint(ctx, endpoint, roles, condition); errTx != nil {
+			return errTx
+		}
+
+		changes := audit.Changes{}.Add("roles", nil, roles).Add("condition", "", condition)
+
+		return s.recordAudit(ctx, claims, model.AuditActionEndpointPolicyAdded, endpointTarget(endpoint), changes)
+	})
 	if err != nil {
 		if errors.Is(err, ErrEndpointAlreadyExists) {
 			return ErrEndpointAlreadyExists
@@ -103,18 +175,50 @@ func (s *accessService) AddRoleEndpoint(ctx context.Context, endpoint string, ro
 	defer s.rolesMutex.Unlock()
 
 	s.accessibleRoles[endpoint] = roles
+	s.patterns = sortPatterns(s.accessibleRoles)
+	s.setCondition(endpoint, cond)
 
 	return nil
 }
 
 // UpdateRoleEndpoint edits an existing resource after verifying access permissions.
-func (s *accessService) UpdateRoleEndpoint(ctx context.Context, endpoint string, roles []string) error {
-	err := s.Check(ctx, updateRoleEndpointEndpoint)
+// The condition is kept when it is nil and removed when it is empty.
+func (s *accessService) UpdateRoleEndpoint(
+	ctx context.Context,
+	endpoint string,
+	roles []string,
+	condition *string,
+) error {
+	claims, err := s.authorize(ctx, updateRoleEndpointEndpoint, nil)
 	if err != nil {
 		return err
 	}
 
-	err = s.accessRepository.UpdateRoleEndpoint(ctx, endpoint, roles)
+	if err = validatePattern(endpoint); err != nil {
+		return err
+	}
+	if err = s.validateRoles(roles); err != nil {
+		return err
+	}
+	var cond *compiledCondition
+	if condition != nil {
+		if cond, err = s.compileCondition(*condition); err != nil {
+			return err
+		}
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if errTx := s.accessRepository.UpdateRoleEndpoint(ctx, endpoint, roles, condition); errTx != nil {
+			return errTx
+		}
+
+		changes := audit.Changes{}.Add("roles", s.endpointRoles(endpoint), roles)
+		if condition != nil {
+			changes = changes.Add("condition", s.endpointCondition(endpoint), *condition)
+		}
+
+		return s.recordAudit(ctx, claims, model.AuditActionEndpointPolicyUpdated, endpointTarget(endpoint), changes)
+	})
 	if err != nil {
 		return ErrFailedToUpdateEndpoint
 	}
@@ -123,18 +227,31 @@ func (s *accessService) UpdateRoleEndpoint(ctx context.Context, endpoint string,
 	defer s.rolesMutex.Unlock()
 
 	s.accessibleRoles[endpoint] = roles
+	s.patterns = sortPatterns(s.accessibleRoles)
+	if condition != nil {
+		s.setCondition(endpoint, cond)
+	}
 
 	return nil
 }
 
 // DeleteRoleEndpoint deletes a resource after verifying access permissions.
 func (s *accessService) DeleteRoleEndpoint(ctx context.Context, endpoint string) error {
-	err := s.Check(ctx, deleteRoleEndpointEndpoint)
+	claims, err := s.authorize(ctx, deleteRoleEndpointEndpoint, nil)
 	if err != nil {
 		return err
 	}
 
-	err = s.accessRepository.DeleteRoleEndpoint(ctx, endpoint)
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if errTx := s.accessRepository.DeleteRoleEndpoint(ctx, endpoint); errTx != nil {
+			return errTx
+		}
+
+		changes := audit.Changes{}.Add("roles", s.endpointRoles(endpoint), nil).
+			Add("condition", s.endpointCondition(endpoint), "")
+
+		return s.recordAudit(ctx, claims, model.AuditActionEndpointPolicyDeleted, endpointTarget(endpoint), changes)
+	})
 	if err != nil {
 		return ErrFailedToDeleteEndpoint
 	}
@@ -143,6 +260,97 @@ func (s *accessService) DeleteRoleEndpoint(ctx context.Context, endpoint string)
 	defer s.rolesMutex.Unlock()
 
 	delete(s.accessibleRoles, endpoint)
+	delete(s.conditions, endpoint)
+	s.patterns = sortPatterns(s.accessibleRoles)
 
 	return nil
 }
+
+// validateRoles checks that the roles allowed to access an endpoint are given and defined.
+func (s *accessService) validateRoles(roles []string) error {
+	if len(roles) == 0 {
+		return ErrNoRoles
+	}
+	if err := s.roleService.ValidateRoles(roles); err != nil {
+		return ErrUnknownRole
+	}
+
+	return nil
+}
+
+// intersects reports whether the lists have a name in common.
+func intersects(names, others []string) bool {
+	return slices.ContainsFunc(names, func(name string) bool { return slices.Contains(others, name) })
+}
+
+// policy returns the endpoint or the pattern, the roles allowed and the condition of the policy of the endpoint,
+// or else of the policy of the most specific pattern matching it. The pattern is empty when none matches it.
+func (s *accessService) policy(endpoint string) (string, []string, *compiledCondition) {
+	s.rolesMutex.RLock()
+	defer s.rolesMutex.RUnlock()
+
+	if roles, ok := s.accessibleRoles[endpoint]; ok {
+		return endpoint, roles, s.conditions[endpoint]
+	}
+	for _, pattern := range s.patterns {
+		if matches(pattern, endpoint) {
+			return pattern, s.accessibleRoles[pattern], s.conditions[pattern]
+		}
+	}
+
+	return "", nil, nil
+}
+
+// endpointRoles returns the roles currently allowed to access the endpoint.
+func (s *accessService) endpointRoles(endpoint string) []string {
+	s.rolesMutex.RLock()
+	defer s.rolesMutex.RUnlock()
+
+	return s.accessibleRoles[endpoint]
+}
+
+// endpointCondition returns the current condition of the policy of the endpoint, empty for none.
+func (s *accessService) endpointCondition(endpoint string) string {
+	s.rolesMutex.RLock()
+	defer s.rolesMutex.RUnlock()
+
+	if c, ok := s.conditions[endpoint]; ok {
+		return c.expression
+	}
+
+	return ""
+}
+
+// setCondition replaces the condition of the policy of the endpoint, a nil condition removes it.
+// The caller must hold the lock of the roles.
+func (s *accessService) setCondition(endpoint string, cond *compiledCondition) {
+	if cond == nil {
+		delete(s.conditions, endpoint)
+
+		return
+	}
+	s.conditions[endpoint] = cond
+}
+
+// recordAudit records a change of the access policy made by the caller.
+func (s *accessService) recordAudit(
+	ctx context.Context,
+	claims *model.UserClaims,
+	action model.AuditAction,
+	target model.AuditTarget,
+	changes audit.Changes,
+) error {
+	event, err := audit.NewEvent(ctx, action, target)
+	if err != nil {
+		return err
+	}
+	event.ActorID = claims.Subject
+	event.Changes = changes
+
+	return s.auditRepository.Record(ctx, event)
+}
+
+// endpointTarget returns the audit target of the access policy of the endpoint.
+func endpointTarget(endpoint string) model.AuditTarget {
+	return model.AuditTarget{Type: model.AuditTargetEndpoint, ID: endpoint}
+}
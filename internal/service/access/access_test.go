@@ -3,18 +3,26 @@ package access
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 
+	"github.com/8thgencore/microservice-common/pkg/db"
+	"github.com/8thgencore/microservice-common/pkg/db/transaction"
 	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/8thgencore/microservice-auth/internal/model"
 	"github.com/8thgencore/microservice-auth/internal/repository"
 	repositoryMocks "github.com/8thgencore/microservice-auth/internal/repository/mocks"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
 	"github.com/8thgencore/microservice-auth/internal/tokens"
 	tokenMocks "github.com/8thgencore/microservice-auth/internal/tokens/mocks"
 	"github.com/8thgencore/microservice-auth/pkg/utils"
+	dbMocks "github.com/8thgencore/microservice-common/pkg/db/mocks"
 )
 
 var (
@@ -31,24 +39,154 @@ var (
 	roleUser  = "USER"
 	roleAdmin = "ADMIN"
 
+	roleSupport = "SUPPORT"
+
+	permissionModerate = "chat.moderate"
+	endpointModerate   = "/chat_v1.ChatV1/Moderate"
+
 	token = "access_token"
 
+	adminID = "admin-id"
+
 	claimsAdmin = &model.UserClaims{
-		Username: username,
-		Role:     roleAdmin,
+		RegisteredClaims: jwt.RegisteredClaims{Subject: adminID},
+		Username:         username,
+		Roles:            model.ClaimRoles{roleAdmin},
+	}
+
+	claimsSupport = &model.UserClaims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: adminID},
+		Username:         username,
+		Roles:            model.ClaimRoles{roleSupport},
 	}
 
 	claimsUser = &model.UserClaims{
 		Username: username,
-		Role:     roleUser,
+		Roles:    model.ClaimRoles{roleUser},
 	}
 )
 
 type (
 	accessRepositoryMockFunc func(mc *minimock.Controller) repository.AccessRepository
+	auditRepositoryMockFunc  func(mc *minimock.Controller) repository.AuditRepository
 	tokenOperationsMockFunc  func(mc *minimock.Controller) tokens.TokenOperations
+	transactorMockFunc       func(mc *minimock.Controller) db.Transactor
+)
+
+var (
+	opts = pgx.TxOptions{IsoLevel: pgx.ReadCommitted}
+
+	transactorCommitMock = func(mc *minimock.Controller) db.Transactor {
+		mock := dbMocks.NewTransactorMock(mc)
+		txMock := dbMocks.NewTxMock(mc)
+		mock.BeginTxMock.Expect(minimock.AnyContext, opts).Return(txMock, nil)
+		txMock.CommitMock.Expect(minimock.AnyContext).Return(nil)
+		return mock
+	}
+
+	transactorRollbackMock = func(mc *minimock.Controller) db.Transactor {
+		mock := dbMocks.NewTransactorMock(mc)
+		txMock := dbMocks.NewTxMock(mc)
+		mock.BeginTxMock.Expect(minimock.AnyContext, opts).Return(txMock, nil)
+		txMock.RollbackMock.Expect(minimock.AnyContext).Return(nil)
+		return mock
+	}
+
+	emptyTransactorMock = func(mc *minimock.Controller) db.Transactor {
+		return dbMocks.NewTransactorMock(mc)
+	}
+
+	emptyAuditRepositoryMock = func(mc *minimock.Controller) repository.AuditRepository {
+		return repositoryMocks.NewAuditRepositoryMock(mc)
+	}
 )
 
+// auditedMock expects the change of the roles allowed to access the endpoint to be recorded.
+func auditedMock(action model.AuditAction, endpoint string, from, to any) auditRepositoryMockFunc {
+	return func(mc *minimock.Controller) repository.AuditRepository {
+		mock := repositoryMocks.NewAuditRepositoryMock(mc)
+		mock.RecordMock.Set(func(_ context.Context, event *model.AuditEvent) error {
+			require.Equal(mc, action, event.Action)
+			require.Equal(mc, model.AuditTarget{Type: model.AuditTargetEndpoint, ID: endpoint}, event.Target)
+			require.Equal(mc, adminID, event.ActorID)
+			require.Equal(mc, model.AuditChange{Old: from, New: to}, event.Changes["roles"])
+			return nil
+		})
+		return mock
+	}
+}
+
+// newTestService creates a service with the access policy read by the access repository mock.
+func newTestService(
+	mc *minimock.Controller,
+	accessRepository repository.AccessRepository,
+	auditRepository repository.AuditRepository,
+	roleService service.RoleService,
+	permissionService service.PermissionService,
+	tokenOperations tokens.TokenOperations,
+	transactor db.Transactor,
+) (service.AccessService, error) {
+	return NewService(ctx, accessRepository, policyVersionRepositoryMock(mc), nil, nil, auditRepository, roleService,
+		permissionService, tokenOperations, transaction.NewTransactionManager(transactor))
+}
+
+// policyVersionRepositoryMock keeps the version of the deny rules, which no other replica changes.
+func policyVersionRepositoryMock(mc *minimock.Controller) repository.PolicyVersionRepository {
+	mock := repositoryMocks.NewPolicyVersionRepositoryMock(mc)
+	mock.GetDenyRulesVersionMock.Optional().Return("version", nil)
+	mock.SetDenyRulesVersionMock.Optional().Return(nil)
+	return mock
+}
+
+// parents is the role hierarchy where the support role inherits the admin role
+// and the admin role inherits the user role.
+var parents = map[string]string{roleSupport: roleAdmin, roleAdmin: roleUser}
+
+// effectiveRoles returns the roles and the roles they inherit in the hierarchy.
+func effectiveRoles(roles []string) []string {
+	var effective []string
+	for _, role := range roles {
+		for ok := true; ok; role, ok = parents[role] {
+			effective = append(effective, role)
+		}
+	}
+	return effective
+}
+
+// roleServiceMock resolves the roles of the hierarchy.
+func roleServiceMock(mc *minimock.Controller) service.RoleService {
+	mock := serviceMocks.NewRoleServiceMock(mc)
+	mock.EffectiveRolesMock.Optional().Set(effectiveRoles)
+	mock.ValidateRolesMock.Optional().Set(func(roles []string) error {
+		for _, role := range roles {
+			if _, ok := parents[role]; !ok && role != roleUser {
+				return errors.New("role not found")
+			}
+		}
+		return nil
+	})
+	return mock
+}
+
+// permissionServiceMock resolves the moderate permission, which gives access to the moderate endpoint
+// and is granted to the admin role.
+func permissionServiceMock(mc *minimock.Controller) service.PermissionService {
+	mock := serviceMocks.NewPermissionServiceMock(mc)
+	mock.RolePermissionsMock.Optional().Set(func(roles []string) []string {
+		if slices.Contains(effectiveRoles(roles), roleAdmin) {
+			return []string{permissionModerate}
+		}
+		return nil
+	})
+	mock.EndpointPermissionsMock.Optional().Set(func(endpoint string) []string {
+		if endpoint == endpointModerate {
+			return []string{permissionModerate}
+		}
+		return nil
+	})
+	return mock
+}
+
 func TestNewService(t *testing.T) {
 	t.Parallel()
 
@@ -78,6 +216,7 @@ func TestNewService(t *testing.T) {
 			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
 				mock := repositoryMocks.NewAccessRepositoryMock(mc)
 				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
 				return mock
 			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
@@ -104,7 +243,8 @@ func TestNewService(t *testing.T) {
 			accessRepositoryMock := tt.accessRepositoryMock(mc)
 			tokenOperationsMock := tt.tokenOperationsMock(mc)
 
-			srv, err := NewService(ctx, accessRepositoryMock, tokenOperationsMock)
+			srv, err := newTestService(mc, accessRepositoryMock, repositoryMocks.NewAuditRepositoryMock(mc), roleServiceMock(mc),
+				permissionServiceMock(mc), tokenOperationsMock, dbMocks.NewTransactorMock(mc))
 			if tt.expectedErr != nil {
 				require.Error(t, err)
 				require.Equal(t, tt.expectedErr, err)
@@ -163,6 +303,7 @@ func TestCheck(t *testing.T) {
 			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
 				mock := repositoryMocks.NewAccessRepositoryMock(mc)
 				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
 				return mock
 			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
@@ -180,6 +321,7 @@ func TestCheck(t *testing.T) {
 			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
 				mock := repositoryMocks.NewAccessRepositoryMock(mc)
 				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
 				return mock
 			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
@@ -198,6 +340,7 @@ func TestCheck(t *testing.T) {
 			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
 				mock := repositoryMocks.NewAccessRepositoryMock(mc)
 				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
 				return mock
 			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
@@ -215,6 +358,7 @@ func TestCheck(t *testing.T) {
 			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
 				mock := repositoryMocks.NewAccessRepositoryMock(mc)
 				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
 				return mock
 			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
@@ -233,6 +377,7 @@ func TestCheck(t *testing.T) {
 			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
 				mock := repositoryMocks.NewAccessRepositoryMock(mc)
 				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
 				return mock
 			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
@@ -253,6 +398,7 @@ func TestCheck(t *testing.T) {
 			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
 				mock := repositoryMocks.NewAccessRepositoryMock(mc)
 				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
 				return mock
 			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
@@ -271,6 +417,7 @@ func TestCheck(t *testing.T) {
 			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
 				mock := repositoryMocks.NewAccessRepositoryMock(mc)
 				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
 				return mock
 			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
@@ -279,6 +426,63 @@ func TestCheck(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "permission success case",
+			args: args{
+				ctx: ctx,
+				req: endpointModerate,
+			},
+			err: nil,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repositoryMocks.NewAccessRepositoryMock(mc)
+				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
+				return mock
+			},
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyAccessTokenMock.Expect(token).Return(claimsSupport, nil)
+				return mock
+			},
+		},
+		{
+			name: "permission denied error case",
+			args: args{
+				ctx: ctx,
+				req: endpointModerate,
+			},
+			err: ErrAccessDenied,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repositoryMocks.NewAccessRepositoryMock(mc)
+				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
+				return mock
+			},
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyAccessTokenMock.Expect(token).Return(claimsUser, nil)
+				return mock
+			},
+		},
+		{
+			name: "inherited role success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: nil,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repositoryMocks.NewAccessRepositoryMock(mc)
+				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
+				return mock
+			},
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyAccessTokenMock.Expect(token).Return(claimsSupport, nil)
+				return mock
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -286,10 +490,151 @@ func TestCheck(t *testing.T) {
 			accessRepositoryMock := tt.accessRepositoryMock(mc)
 			tokenOperationsMock := tt.tokenOperationsMock(mc)
 
-			srv, err := NewService(ctx, accessRepositoryMock, tokenOperationsMock)
+			srv, err := newTestService(mc, accessRepositoryMock, repositoryMocks.NewAuditRepositoryMock(mc), roleServiceMock(mc),
+				permissionServiceMock(mc), tokenOperationsMock, dbMocks.NewTransactorMock(mc))
 			require.NoError(t, err)
 
-			err = srv.Check(tt.args.ctx, tt.args.req)
+			err = srv.Check(tt.args.ctx, tt.args.req, nil)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestCheckPattern(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		// The user role may call any method of the chat service but the ones starting with Delete,
+		// and the admin role may call any method of the chat services of any version.
+		endpointPermissions = []*model.EndpointPermissions{
+			{Endpoint: "/chat_v1.ChatV1/*", Roles: []string{roleUser}},
+			{Endpoint: "/chat_v1.ChatV1/Delete*", Roles: []string{roleAdmin}},
+			{Endpoint: "/chat_v?.*/*", Roles: []string{roleAdmin}},
+		}
+	)
+
+	tests := []struct {
+		name     string
+		endpoint string
+		claims   *model.UserClaims
+		err      error
+	}{
+		{
+			name:     "service pattern success case",
+			endpoint: "/chat_v1.ChatV1/SendMessage",
+			claims:   claimsUser,
+			err:      nil,
+		},
+		{
+			name:     "more specific pattern error case",
+			endpoint: "/chat_v1.ChatV1/DeleteMessage",
+			claims:   claimsUser,
+			err:      ErrAccessDenied,
+		},
+		{
+			name:     "more specific pattern success case",
+			endpoint: "/chat_v1.ChatV1/DeleteMessage",
+			claims:   claimsAdmin,
+			err:      nil,
+		},
+		{
+			name:     "less specific pattern error case",
+			endpoint: "/chat_v2.ChatV2/SendMessage",
+			claims:   claimsUser,
+			err:      ErrAccessDenied,
+		},
+		{
+			name:     "no matching pattern error case",
+			endpoint: "/user_v1.UserV1/Get",
+			claims:   claimsAdmin,
+			err:      ErrEndpointNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessRepositoryMock := repositoryMocks.NewAccessRepositoryMock(mc)
+			accessRepositoryMock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+			accessRepositoryMock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
+			tokenOperationsMock := tokenMocks.NewTokenOperationsMock(mc)
+			tokenOperationsMock.VerifyAccessTokenMock.Expect(token).Return(tt.claims, nil)
+
+			srv, err := newTestService(mc, accessRepositoryMock, emptyAuditRepositoryMock(mc), roleServiceMock(mc),
+				permissionServiceMock(mc), tokenOperationsMock, emptyTransactorMock(mc))
+			require.NoError(t, err)
+
+			err = srv.Check(ctx, tt.endpoint, nil)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestCheckPermission(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		permission string
+		claims     *model.UserClaims
+		err        error
+	}{
+		{
+			name:       "metadata not provided error case",
+			ctx:        ctxNoMd,
+			permission: permissionModerate,
+			err:        utils.ErrMetadataNotProvided,
+		},
+		{
+			name:       "success case",
+			ctx:        ctx,
+			permission: permissionModerate,
+			claims:     claimsAdmin,
+			err:        nil,
+		},
+		{
+			name:       "inherited permission success case",
+			ctx:        ctx,
+			permission: permissionModerate,
+			claims:     claimsSupport,
+			err:        nil,
+		},
+		{
+			name:       "permission not held error case",
+			ctx:        ctx,
+			permission: permissionModerate,
+			claims:     claimsUser,
+			err:        ErrAccessDenied,
+		},
+		{
+			name:       "unknown permission error case",
+			ctx:        ctx,
+			permission: "chat.unknown",
+			claims:     claimsAdmin,
+			err:        ErrAccessDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessRepositoryMock := repositoryMocks.NewAccessRepositoryMock(mc)
+			accessRepositoryMock.GetRoleEndpointsMock.Expect(ctx).Return(nil, nil)
+			accessRepositoryMock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
+
+			tokenOperationsMock := tokenMocks.NewTokenOperationsMock(mc)
+			if tt.claims != nil {
+				tokenOperationsMock.VerifyAccessTokenMock.Expect(token).Return(tt.claims, nil)
+			}
+
+			srv, err := newTestService(mc, accessRepositoryMock, emptyAuditRepositoryMock(mc), roleServiceMock(mc),
+				permissionServiceMock(mc), tokenOperationsMock, emptyTransactorMock(mc))
+			require.NoError(t, err)
+
+			err = srv.CheckPermission(tt.ctx, tt.permission)
 			require.Equal(t, tt.err, err)
 		})
 	}
@@ -326,6 +671,7 @@ func TestGetRoleEndpoints(t *testing.T) {
 			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
 				mock := repositoryMocks.NewAccessRepositoryMock(mc)
 				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
 				return mock
 			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
@@ -341,6 +687,7 @@ func TestGetRoleEndpoints(t *testing.T) {
 			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
 				mock := repositoryMocks.NewAccessRepositoryMock(mc)
 				mock.GetRoleEndpointsMock.When(ctx).Then(endpointPermissions, nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
 				mock.GetRoleEndpointsMock.When(ctxSecond).Then(nil, ErrFailedToGetEndpoint)
 				return mock
 			},
@@ -357,6 +704,7 @@ func TestGetRoleEndpoints(t *testing.T) {
 			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
 				mock := repositoryMocks.NewAccessRepositoryMock(mc)
 				mock.GetRoleEndpointsMock.When(ctx).Then(endpointPermissions, nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
 				mock.GetRoleEndpointsMock.When(ctxSecond).Then(endpointPermissions, nil)
 				return mock
 			},
@@ -373,17 +721,47 @@ func TestGetRoleEndpoints(t *testing.T) {
 			accessRepositoryMock := tt.accessRepositoryMock(mc)
 			tokenOperationsMock := tt.tokenOperationsMock(mc)
 
-			srv, err := NewService(ctx, accessRepositoryMock, tokenOperationsMock)
+			srv, err := newTestService(mc, accessRepositoryMock, repositoryMocks.NewAuditRepositoryMock(mc), roleServiceMock(mc),
+				permissionServiceMock(mc), tokenOperationsMock, dbMocks.NewTransactorMock(mc))
 			require.NoError(t, err)
 			require.NotNil(t, srv)
 
-			result, err := srv.GetRoleEndpoints(ctxSecond)
+			result, err := srv.GetRoleEndpoints(ctxSecond, "")
 			require.Equal(t, tt.expectedErr, err)
 			require.Equal(t, tt.expectedResult, result)
 		})
 	}
 }
 
+func TestGetRoleEndpointsMatching(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		servicePattern = &model.EndpointPermissions{Endpoint: "/chat_v1.ChatV1/*", Roles: []string{roleUser}}
+		methodPattern  = &model.EndpointPermissions{Endpoint: "/chat_v1.ChatV1/Get*", Roles: []string{roleAdmin}}
+		otherPattern   = &model.EndpointPermissions{Endpoint: "/user_v1.UserV1/*", Roles: []string{roleAdmin}}
+		policy         = &model.EndpointPermissions{Endpoint: getRoleEndpointsEndpoint, Roles: []string{roleAdmin}}
+	)
+
+	accessRepositoryMock := repositoryMocks.NewAccessRepositoryMock(mc)
+	accessRepositoryMock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
+	accessRepositoryMock.GetRoleEndpointsMock.Set(func(context.Context) ([]*model.EndpointPermissions, error) {
+		return []*model.EndpointPermissions{policy, servicePattern, otherPattern, methodPattern}, nil
+	})
+	tokenOperationsMock := tokenMocks.NewTokenOperationsMock(mc)
+	tokenOperationsMock.VerifyAccessTokenMock.Expect(token).Return(claimsAdmin, nil)
+
+	srv, err := newTestService(mc, accessRepositoryMock, emptyAuditRepositoryMock(mc), roleServiceMock(mc),
+		permissionServiceMock(mc), tokenOperationsMock, emptyTransactorMock(mc))
+	require.NoError(t, err)
+
+	result, err := srv.GetRoleEndpoints(ctx, "/chat_v1.ChatV1/GetMessages")
+	require.NoError(t, err)
+	require.Equal(t, []*model.EndpointPermissions{methodPattern, servicePattern}, result)
+}
+
 func TestAddRoleEndpoint(t *testing.T) {
 	t.Parallel()
 
@@ -402,14 +780,19 @@ func TestAddRoleEndpoint(t *testing.T) {
 		name                 string
 		err                  error
 		accessRepositoryMock accessRepositoryMockFunc
+		auditRepositoryMock  auditRepositoryMockFunc
+		transactorMock       transactorMockFunc
 		tokenOperationsMock  tokenOperationsMockFunc
 	}{
 		{
-			name: "check endpoint error case",
-			err:  ErrAccessDenied,
+			name:                "check endpoint error case",
+			err:                 ErrAccessDenied,
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			transactorMock:      emptyTransactorMock,
 			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
 				mock := repositoryMocks.NewAccessRepositoryMock(mc)
 				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
 				return mock
 			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
@@ -419,12 +802,15 @@ func TestAddRoleEndpoint(t *testing.T) {
 			},
 		},
 		{
-			name: "add role exists endpoint error case",
-			err:  ErrEndpointAlreadyExists,
+			name:                "add role exists endpoint error case",
+			err:                 ErrEndpointAlreadyExists,
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			transactorMock:      transactorRollbackMock,
 			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
 				mock := repositoryMocks.NewAccessRepositoryMock(mc)
 				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
-				mock.AddRoleEndpointMock.Expect(ctx, endpoint, roles).Return(ErrEndpointAlreadyExists)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
+				mock.AddRoleEndpointMock.Expect(minimock.AnyContext, endpoint, roles, "").Return(ErrEndpointAlreadyExists)
 				return mock
 			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
@@ -434,12 +820,15 @@ func TestAddRoleEndpoint(t *testing.T) {
 			},
 		},
 		{
-			name: "add role endpoint error case",
-			err:  ErrFailedToAddEndpoint,
+			name:                "add role endpoint error case",
+			err:                 ErrFailedToAddEndpoint,
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			transactorMock:      transactorRollbackMock,
 			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
 				mock := repositoryMocks.NewAccessRepositoryMock(mc)
 				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
-				mock.AddRoleEndpointMock.Expect(ctx, endpoint, roles).Return(ErrFailedToAddEndpoint)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
+				mock.AddRoleEndpointMock.Expect(minimock.AnyContext, endpoint, roles, "").Return(ErrFailedToAddEndpoint)
 				return mock
 			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
@@ -449,12 +838,15 @@ func TestAddRoleEndpoint(t *testing.T) {
 			},
 		},
 		{
-			name: "add role endpoint success case",
-			err:  nil,
+			name:                "add role endpoint success case",
+			err:                 nil,
+			auditRepositoryMock: auditedMock(model.AuditActionEndpointPolicyAdded, endpoint, nil, roles),
+			transactorMock:      transactorCommitMock,
 			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
 				mock := repositoryMocks.NewAccessRepositoryMock(mc)
 				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
-				mock.AddRoleEndpointMock.Expect(ctx, endpoint, roles).Return(nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
+				mock.AddRoleEndpointMock.Expect(minimock.AnyContext, endpoint, roles, "").Return(nil)
 				return mock
 			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
@@ -469,9 +861,67 @@ func TestAddRoleEndpoint(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			accessRepositoryMock := tt.accessRepositoryMock(mc)
 			tokenOperationsMock := tt.tokenOperationsMock(mc)
-			srv, _ := NewService(ctx, accessRepositoryMock, tokenOperationsMock)
+			srv, _ := newTestService(mc, accessRepositoryMock, tt.auditRepositoryMock(mc), roleServiceMock(mc),
+				permissionServiceMock(mc), tokenOperationsMock, tt.transactorMock(mc))
 
-			err := srv.AddRoleEndpoint(ctx, endpoint, roles)
+			err := srv.AddRoleEndpoint(ctx, endpoint, roles, "")
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestAddRoleEndpointInvalidRoles(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		endpoint = addRoleEndpointEndpoint
+
+		endpointPermissions = []*model.EndpointPermissions{
+			{Endpoint: endpoint, Roles: []string{roleAdmin}},
+		}
+	)
+
+	tests := []struct {
+		name     string
+		endpoint string
+		roles    []string
+		err      error
+	}{
+		{
+			name:     "no roles error case",
+			endpoint: endpoint,
+			roles:    nil,
+			err:      ErrNoRoles,
+		},
+		{
+			name:     "unknown role error case",
+			endpoint: endpoint,
+			roles:    []string{roleAdmin, "UNKNOWN"},
+			err:      ErrUnknownRole,
+		},
+		{
+			name:     "invalid pattern error case",
+			endpoint: "/chat_v1.ChatV1/*/*",
+			roles:    []string{roleAdmin},
+			err:      ErrInvalidEndpointPattern,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessRepositoryMock := repositoryMocks.NewAccessRepositoryMock(mc)
+			accessRepositoryMock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+			accessRepositoryMock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
+			tokenOperationsMock := tokenMocks.NewTokenOperationsMock(mc)
+			tokenOperationsMock.VerifyAccessTokenMock.Expect(token).Return(claimsAdmin, nil)
+
+			srv, err := newTestService(mc, accessRepositoryMock, emptyAuditRepositoryMock(mc), roleServiceMock(mc),
+				permissionServiceMock(mc), tokenOperationsMock, emptyTransactorMock(mc))
+			require.NoError(t, err)
+
+			err = srv.AddRoleEndpoint(ctx, tt.endpoint, tt.roles, "")
 			require.Equal(t, tt.err, err)
 		})
 	}
@@ -482,7 +932,7 @@ func TestUpdateRoleEndpoint(t *testing.T) {
 
 	var (
 		mc    = minimock.NewController(t)
-		roles = []string{roleAdmin}
+		roles = []string{roleAdmin, roleUser}
 
 		endpoint = updateRoleEndpointEndpoint
 
@@ -495,14 +945,19 @@ func TestUpdateRoleEndpoint(t *testing.T) {
 		name                 string
 		err                  error
 		accessRepositoryMock func(mc *minimock.Controller) repository.AccessRepository
+		auditRepositoryMock  auditRepositoryMockFunc
+		transactorMock       transactorMockFunc
 		tokenOperationsMock  func(mc *minimock.Controller) tokens.TokenOperations
 	}{
 		{
-			name: "check endpoint error case",
-			err:  ErrAccessDenied,
+			name:                "check endpoint error case",
+			err:                 ErrAccessDenied,
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			transactorMock:      emptyTransactorMock,
 			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
 				mock := repositoryMocks.NewAccessRepositoryMock(mc)
 				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
 
 				return mock
 			},
@@ -513,12 +968,15 @@ func TestUpdateRoleEndpoint(t *testing.T) {
 			},
 		},
 		{
-			name: "update role endpoint error case",
-			err:  ErrFailedToUpdateEndpoint,
+			name:                "update role endpoint error case",
+			err:                 ErrFailedToUpdateEndpoint,
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			transactorMock:      transactorRollbackMock,
 			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
 				mock := repositoryMocks.NewAccessRepositoryMock(mc)
 				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
-				mock.UpdateRoleEndpointMock.Expect(ctx, endpoint, roles).Return(ErrFailedToUpdateEndpoint)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
+				mock.UpdateRoleEndpointMock.Expect(minimock.AnyContext, endpoint, roles, nil).Return(ErrFailedToUpdateEndpoint)
 				return mock
 			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
@@ -528,12 +986,15 @@ func TestUpdateRoleEndpoint(t *testing.T) {
 			},
 		},
 		{
-			name: "update role endpoint success case",
-			err:  nil,
+			name:                "update role endpoint success case",
+			err:                 nil,
+			auditRepositoryMock: auditedMock(model.AuditActionEndpointPolicyUpdated, endpoint, []string{roleAdmin}, roles),
+			transactorMock:      transactorCommitMock,
 			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
 				mock := repositoryMocks.NewAccessRepositoryMock(mc)
 				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
-				mock.UpdateRoleEndpointMock.Expect(ctx, endpoint, roles).Return(nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
+				mock.UpdateRoleEndpointMock.Expect(minimock.AnyContext, endpoint, roles, nil).Return(nil)
 				return mock
 			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
@@ -548,9 +1009,10 @@ func TestUpdateRoleEndpoint(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			accessRepositoryMock := tt.accessRepositoryMock(mc)
 			tokenOperationsMock := tt.tokenOperationsMock(mc)
-			srv, _ := NewService(ctx, accessRepositoryMock, tokenOperationsMock)
+			srv, _ := newTestService(mc, accessRepositoryMock, tt.auditRepositoryMock(mc), roleServiceMock(mc),
+				permissionServiceMock(mc), tokenOperationsMock, tt.transactorMock(mc))
 
-			err := srv.UpdateRoleEndpoint(ctx, endpoint, roles)
+			err := srv.UpdateRoleEndpoint(ctx, endpoint, roles, nil)
 			require.Equal(t, tt.err, err)
 		})
 	}
@@ -573,14 +1035,19 @@ func TestDeleteRoleEndpoint(t *testing.T) {
 		name                 string
 		err                  error
 		accessRepositoryMock func(mc *minimock.Controller) repository.AccessRepository
+		auditRepositoryMock  auditRepositoryMockFunc
+		transactorMock       transactorMockFunc
 		tokenOperationsMock  func(mc *minimock.Controller) tokens.TokenOperations
 	}{
 		{
-			name: "check endpoint error case",
-			err:  ErrAccessDenied,
+			name:                "check endpoint error case",
+			err:                 ErrAccessDenied,
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			transactorMock:      emptyTransactorMock,
 			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
 				mock := repositoryMocks.NewAccessRepositoryMock(mc)
 				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
 				return mock
 			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
@@ -590,12 +1057,15 @@ func TestDeleteRoleEndpoint(t *testing.T) {
 			},
 		},
 		{
-			name: "delete role endpoint error case",
-			err:  ErrFailedToDeleteEndpoint,
+			name:                "delete role endpoint error case",
+			err:                 ErrFailedToDeleteEndpoint,
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			transactorMock:      transactorRollbackMock,
 			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
 				mock := repositoryMocks.NewAccessRepositoryMock(mc)
 				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
-				mock.DeleteRoleEndpointMock.Expect(ctx, endpoint).Return(ErrFailedToDeleteEndpoint)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
+				mock.DeleteRoleEndpointMock.Expect(minimock.AnyContext, endpoint).Return(ErrFailedToDeleteEndpoint)
 				return mock
 			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
@@ -605,12 +1075,15 @@ func TestDeleteRoleEndpoint(t *testing.T) {
 			},
 		},
 		{
-			name: "delete role endpoint success case",
-			err:  nil,
+			name:                "delete role endpoint success case",
+			err:                 nil,
+			auditRepositoryMock: auditedMock(model.AuditActionEndpointPolicyDeleted, endpoint, []string{roleAdmin}, nil),
+			transactorMock:      transactorCommitMock,
 			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
 				mock := repositoryMocks.NewAccessRepositoryMock(mc)
 				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
-				mock.DeleteRoleEndpointMock.Expect(ctx, endpoint).Return(nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
+				mock.DeleteRoleEndpointMock.Expect(minimock.AnyContext, endpoint).Return(nil)
 				return mock
 			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
@@ -625,7 +1098,8 @@ func TestDeleteRoleEndpoint(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			accessRepositoryMock := tt.accessRepositoryMock(mc)
 			tokenOperationsMock := tt.tokenOperationsMock(mc)
-			srv, _ := NewService(ctx, accessRepositoryMock, tokenOperationsMock)
+			srv, _ := newTestService(mc, accessRepositoryMock, tt.auditRepositoryMock(mc), roleServiceMock(mc),
+				permissionServiceMock(mc), tokenOperationsMock, tt.transactorMock(mc))
 
 			err := srv.DeleteRoleEndpoint(ctx, endpoint)
 			require.Equal(t, tt.err, err)
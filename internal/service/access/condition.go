@@ -0,0 +1,180 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/pkg/utils"
+)
+
+// Conditions are CEL expressions of the access policies which must evaluate to true for access to be granted,
+// such as `attributes.owner_id == subject || "ADMIN" in roles` or `ip.inCIDR("10.0.0.0/8")`.
+// They are evaluated against the variables:
+//
+//	subject      string               the ID of the caller
+//	username     string               the name of the caller
+//	roles        list(string)         the roles of the caller and the roles they inherit
+//	permissions  list(string)         the permissions held by the caller
+//	endpoint     string               the endpoint being accessed
+//	ip           string               the IP address of the client
+//	headers      map(string, string)  the request metadata without the authorization header
+//	attributes   map(string, string)  the attributes passed to the access check
+//	now          timestamp            the time of the access check
+//
+// The string.inCIDR(string) function reports whether an IP address is in a CIDR block.
+const (
+	// conditionCostLimit bounds the evaluation of a condition.
+	conditionCostLimit = 10000
+	// conditionInterruptFrequency is the number of comprehension iterations between checks
+	// of the cancellation of the context of a check.
+	conditionInterruptFrequency = 100
+
+	authorizationHeader = "authorization"
+)
+
+// compiledCondition is the compiled condition of an access policy.
+type compiledCondition struct {
+	expression string
+	// program is nil when the stored expression no longer compiles, then access is denied.
+	program cel.Program
+}
+
+// newConditionEnv creates the environment the conditions are compiled in.
+func newConditionEnv() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("subject", cel.StringType),
+		cel.Variable("username", cel.StringType),
+		cel.Variable("roles", cel.ListType(cel.StringType)),
+		cel.Variable("permissions", cel.ListType(cel.StringType)),
+		cel.Variable("endpoint", cel.StringType),
+		cel.Variable("ip", cel.StringType),
+		cel.Variable("headers", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("attributes", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("now", cel.TimestampType),
+		cel.Function("inCIDR",
+			cel.MemberOverload("string_in_cidr_string", []*cel.Type{cel.StringType, cel.StringType}, cel.BoolType,
+				cel.BinaryBinding(inCIDR),
+			),
+		),
+	)
+}
+
+// inCIDR reports whether the IP address is in the CIDR block. An invalid IP address is in no block.
+func inCIDR(ip, cidr ref.Val) ref.Val {
+	prefix, err := netip.ParsePrefix(string(cidr.(types.String)))
+	if err != nil {
+		return types.NewErr("invalid CIDR block %q", cidr.Value())
+	}
+	addr, err := netip.ParseAddr(string(ip.(types.String)))
+	if err != nil {
+		return types.False
+	}
+
+	return types.Bool(prefix.Contains(addr.Unmap()))
+}
+
+// compileCondition compiles the condition of a policy, an empty expression has no condition.
+func (s *accessService) compileCondition(expression string) (*compiledCondition, error) {
+	if expression == "" {
+		return nil, nil
+	}
+
+	ast, issues := s.conditionEnv.Compile(expression)
+	if issues.Err() != nil {
+		return nil, invalidCondition(issues.Err())
+	}
+	if !ast.OutputType().IsExactType(cel.BoolType) {
+		return nil, invalidCondition("the condition must evaluate to a bool, not " + ast.OutputType().String())
+	}
+
+	program, err := s.conditionEnv.Program(ast,
+		cel.EvalOptions(cel.OptOptimize),
+		cel.CostLimit(conditionCostLimit),
+		cel.InterruptCheckFrequency(conditionInterruptFrequency),
+	)
+	if err != nil {
+		return nil, invalidCondition(err)
+	}
+
+	return &compiledCondition{expression: expression, program: program}, nil
+}
+
+// invalidCondition returns ErrInvalidCondition with the reason the condition is invalid.
+func invalidCondition(reason any) error {
+	return fmt.Errorf("%w: %v", ErrInvalidCondition, reason)
+}
+
+// compileConditions compiles the conditions of the policies by endpoint. Conditions which no longer compile
+// are kept without a program, so that access to their endpoints is denied rather than allowed.
+func (s *accessService) compileConditions(policies []*model.EndpointPermissions) map[string]*compiledCondition {
+	conditions := make(map[string]*compiledCondition)
+	for _, policy := range policies {
+		c, err := s.compileCondition(policy.Condition)
+		if err != nil {
+			c = &compiledCondition{expression: policy.Condition}
+		}
+		if c != nil {
+			conditions[policy.Endpoint] = c
+		}
+	}
+
+	return conditions
+}
+
+// evaluate reports whether the condition holds for the caller accessing the endpoint.
+func (s *accessService) evaluate(
+	ctx context.Context,
+	c *compiledCondition,
+	claims *model.UserClaims,
+	endpoint string,
+	attributes map[string]string,
+) bool {
+	if c.program == nil {
+		return false
+	}
+	if attributes == nil {
+		attributes = map[string]string{}
+	}
+
+	out, _, err := c.program.ContextEval(ctx, map[string]any{
+		"subject":     claims.Subject,
+		"username":    claims.Username,
+		"roles":       s.roleService.EffectiveRoles(claims.Roles),
+		"permissions": s.permissionService.RolePermissions(claims.Roles),
+		"endpoint":    endpoint,
+		"ip":          utils.ExtractClientIP(ctx),
+		"headers":     requestHeaders(ctx),
+		"attributes":  attributes,
+		"now":         time.Now(),
+	})
+	if err != nil {
+		return false
+	}
+	allowed, ok := out.Value().(bool)
+
+	return ok && allowed
+}
+
+// requestHeaders returns the metadata of the request without the authorization header,
+// the values of a header are joined by commas.
+func requestHeaders(ctx context.Context) map[string]string {
+	headers := map[string]string{}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for name, values := range md {
+		if name != authorizationHeader {
+			headers[name] = strings.Join(values, ",")
+		}
+	}
+
+	return headers
+}
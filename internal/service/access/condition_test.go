@@ -0,0 +1,357 @@
+package access
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	repositoryMocks "github.com/8thgencore/microservice-auth/internal/repository/mocks"
+	"github.com/8thgencore/microservice-auth/internal/tokens"
+	tokenMocks "github.com/8thgencore/microservice-auth/internal/tokens/mocks"
+	"github.com/8thgencore/microservice-auth/pkg/utils"
+)
+
+func TestCompileCondition(t *testing.T) {
+	t.Parallel()
+
+	env, err := newConditionEnv()
+	require.NoError(t, err)
+	s := &accessService{conditionEnv: env}
+
+	tests := []struct {
+		name       string
+		expression string
+		valid      bool
+	}{
+		{name: "empty", expression: "", valid: true},
+		{name: "owner or admin", expression: `attributes.owner_id == subject || "ADMIN" in roles`, valid: true},
+		{name: "ip in cidr", expression: `ip.inCIDR("10.0.0.0/8")`, valid: true},
+		{name: "time", expression: `now < timestamp("2100-01-01T00:00:00Z")`, valid: true},
+		{name: "syntax error", expression: `subject ==`, valid: false},
+		{name: "undeclared variable", expression: `owner == subject`, valid: false},
+		{name: "not a bool", expression: `subject + username`, valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := s.compileCondition(tt.expression)
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrInvalidCondition)
+			}
+		})
+	}
+}
+
+func TestCheckCondition(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		ownerID = "owner-id"
+
+		claimsOwner = &model.UserClaims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: ownerID},
+			Username:         username,
+			Roles:            model.ClaimRoles{roleUser},
+		}
+
+		ctxInternal = utils.WithClientIP(metadata.NewIncomingContext(ctxNoMd, metadata.New(map[string]string{
+			"Authorization": "Bearer access_token",
+			"X-Tenant":      "acme",
+		})), "10.1.2.3")
+		ctxExternal = utils.WithClientIP(metadata.NewIncomingContext(ctxNoMd, metadata.New(map[string]string{
+			"Authorization": "Bearer access_token",
+		})), "192.0.2.1")
+
+		// Documents may be read by their owner or an admin, written from the internal network,
+		// and exported by the acme tenant only. The condition of the archive no longer compiles.
+		endpointPermissions = []*model.EndpointPermissions{
+			{
+				Endpoint:  "/doc_v1.DocV1/Get",
+				Roles:     []string{roleUser},
+				Condition: `attributes.owner_id == subject || "ADMIN" in roles`,
+			},
+			{Endpoint: "/doc_v1.DocV1/*", Roles: []string{roleUser}, Condition: `ip.inCIDR("10.0.0.0/8")`},
+			{
+				Endpoint:  "/doc_v1.DocV1/Export",
+				Roles:     []string{roleUser},
+				Condition: `headers["x-tenant"] == "acme" && now > timestamp("2000-01-01T00:00:00Z")`,
+			},
+			{Endpoint: "/doc_v1.DocV1/Archive", Roles: []string{roleUser}, Condition: `archived ==`},
+			{Endpoint: "/doc_v1.DocV1/List", Roles: []string{roleAdmin}},
+		}
+	)
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		endpoint   string
+		attributes map[string]string
+		claims     *model.UserClaims
+		err        error
+	}{
+		{
+			name:       "owner success case",
+			ctx:        ctx,
+			endpoint:   "/doc_v1.DocV1/Get",
+			attributes: map[string]string{"owner_id": ownerID},
+			claims:     claimsOwner,
+			err:        nil,
+		},
+		{
+			name:       "inherited admin role success case",
+			ctx:        ctx,
+			endpoint:   "/doc_v1.DocV1/Get",
+			attributes: map[string]string{"owner_id": ownerID},
+			claims:     claimsSupport,
+			err:        nil,
+		},
+		{
+			name:       "not owner error case",
+			ctx:        ctx,
+			endpoint:   "/doc_v1.DocV1/Get",
+			attributes: map[string]string{"owner_id": "other-id"},
+			claims:     claimsOwner,
+			err:        ErrAccessDenied,
+		},
+		{
+			name:       "missing attribute error case",
+			ctx:        ctx,
+			endpoint:   "/doc_v1.DocV1/Get",
+			attributes: nil,
+			claims:     claimsOwner,
+			err:        ErrAccessDenied,
+		},
+		{
+			name:     "internal network success case",
+			ctx:      ctxInternal,
+			endpoint: "/doc_v1.DocV1/Update",
+			claims:   claimsOwner,
+			err:      nil,
+		},
+		{
+			name:     "external network error case",
+			ctx:      ctxExternal,
+			endpoint: "/doc_v1.DocV1/Update",
+			claims:   claimsOwner,
+			err:      ErrAccessDenied,
+		},
+		{
+			name:     "header success case",
+			ctx:      ctxInternal,
+			endpoint: "/doc_v1.DocV1/Export",
+			claims:   claimsOwner,
+			err:      nil,
+		},
+		{
+			name:     "header error case",
+			ctx:      ctxExternal,
+			endpoint: "/doc_v1.DocV1/Export",
+			claims:   claimsOwner,
+			err:      ErrAccessDenied,
+		},
+		{
+			name:     "invalid stored condition error case",
+			ctx:      ctxInternal,
+			endpoint: "/doc_v1.DocV1/Archive",
+			claims:   claimsAdmin,
+			err:      ErrAccessDenied,
+		},
+		{
+			name:     "role denied before condition error case",
+			ctx:      ctxInternal,
+			endpoint: "/doc_v1.DocV1/List",
+			claims:   claimsOwner,
+			err:      ErrAccessDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessRepositoryMock := repositoryMocks.NewAccessRepositoryMock(mc)
+			accessRepositoryMock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+			accessRepositoryMock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
+			tokenOperationsMock := tokenMocks.NewTokenOperationsMock(mc)
+			tokenOperationsMock.VerifyAccessTokenMock.Expect(token).Return(tt.claims, nil)
+
+			srv, err := newTestService(mc, accessRepositoryMock, emptyAuditRepositoryMock(mc), roleServiceMock(mc),
+				permissionServiceMock(mc), tokenOperationsMock, emptyTransactorMock(mc))
+			require.NoError(t, err)
+
+			err = srv.Check(tt.ctx, tt.endpoint, tt.attributes)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestRoleEndpointCondition(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc    = minimock.NewController(t)
+		roles = []string{roleUser}
+
+		endpoint  = "/doc_v1.DocV1/Get"
+		condition = `attributes.owner_id == subject`
+		removed   = ""
+
+		endpointPermissions = []*model.EndpointPermissions{
+			{Endpoint: addRoleEndpointEndpoint, Roles: []string{roleAdmin}},
+			{Endpoint: updateRoleEndpointEndpoint, Roles: []string{roleAdmin}},
+			{Endpoint: endpoint, Roles: roles, Condition: condition},
+		}
+	)
+
+	tokenOperationsMock := func(mc *minimock.Controller) tokens.TokenOperations {
+		mock := tokenMocks.NewTokenOperationsMock(mc)
+		mock.VerifyAccessTokenMock.Expect(token).Return(claimsAdmin, nil)
+		return mock
+	}
+
+	tests := []struct {
+		name                 string
+		err                  error
+		conditions           map[string]string
+		change               func(srv *accessService) error
+		accessRepositoryMock accessRepositoryMockFunc
+		auditRepositoryMock  auditRepositoryMockFunc
+		transactorMock       transactorMockFunc
+	}{
+		{
+			name:                "add invalid condition error case",
+			err:                 ErrInvalidCondition,
+			conditions:          map[string]string{endpoint: condition},
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			transactorMock:      emptyTransactorMock,
+			change: func(srv *accessService) error {
+				return srv.AddRoleEndpoint(ctx, "/doc_v1.DocV1/Delete", roles, `subject +`)
+			},
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repositoryMocks.NewAccessRepositoryMock(mc)
+				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "add condition success case",
+			err:  nil,
+			conditions: map[string]string{
+				endpoint:               condition,
+				"/doc_v1.DocV1/Delete": condition,
+			},
+			auditRepositoryMock: conditionAuditedMock(model.AuditActionEndpointPolicyAdded, "", condition),
+			transactorMock:      transactorCommitMock,
+			change: func(srv *accessService) error {
+				return srv.AddRoleEndpoint(ctx, "/doc_v1.DocV1/Delete", roles, condition)
+			},
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repositoryMocks.NewAccessRepositoryMock(mc)
+				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
+				mock.AddRoleEndpointMock.Expect(minimock.AnyContext, "/doc_v1.DocV1/Delete", roles, condition).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:                "update invalid condition error case",
+			err:                 ErrInvalidCondition,
+			conditions:          map[string]string{endpoint: condition},
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			transactorMock:      emptyTransactorMock,
+			change: func(srv *accessService) error {
+				invalid := `attributes.owner_id`
+				return srv.UpdateRoleEndpoint(ctx, endpoint, roles, &invalid)
+			},
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repositoryMocks.NewAccessRepositoryMock(mc)
+				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name:                "update keeps condition success case",
+			err:                 nil,
+			conditions:          map[string]string{endpoint: condition},
+			auditRepositoryMock: conditionAuditedMock(model.AuditActionEndpointPolicyUpdated, nil, nil),
+			transactorMock:      transactorCommitMock,
+			change: func(srv *accessService) error {
+				return srv.UpdateRoleEndpoint(ctx, endpoint, roles, nil)
+			},
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repositoryMocks.NewAccessRepositoryMock(mc)
+				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
+				mock.UpdateRoleEndpointMock.Expect(minimock.AnyContext, endpoint, roles, nil).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:                "update removes condition success case",
+			err:                 nil,
+			conditions:          map[string]string{},
+			auditRepositoryMock: conditionAuditedMock(model.AuditActionEndpointPolicyUpdated, condition, ""),
+			transactorMock:      transactorCommitMock,
+			change: func(srv *accessService) error {
+				return srv.UpdateRoleEndpoint(ctx, endpoint, roles, &removed)
+			},
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repositoryMocks.NewAccessRepositoryMock(mc)
+				mock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+				mock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
+				mock.UpdateRoleEndpointMock.Expect(minimock.AnyContext, endpoint, roles, &removed).Return(nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, err := newTestService(mc, tt.accessRepositoryMock(mc), tt.auditRepositoryMock(mc), roleServiceMock(mc),
+				permissionServiceMock(mc), tokenOperationsMock(mc), tt.transactorMock(mc))
+			require.NoError(t, err)
+			accessSrv, ok := srv.(*accessService)
+			require.True(t, ok)
+
+			err = tt.change(accessSrv)
+			require.ErrorIs(t, err, tt.err)
+
+			conditions := map[string]string{}
+			for endpoint, c := range accessSrv.conditions {
+				conditions[endpoint] = c.expression
+			}
+			require.Equal(t, tt.conditions, conditions)
+		})
+	}
+}
+
+// conditionAuditedMock expects the change of the condition of the endpoint to be recorded, or no change when
+// both values are nil.
+func conditionAuditedMock(action model.AuditAction, from, to any) auditRepositoryMockFunc {
+	return func(mc *minimock.Controller) repository.AuditRepository {
+		mock := repositoryMocks.NewAuditRepositoryMock(mc)
+		mock.RecordMock.Set(func(_ context.Context, event *model.AuditEvent) error {
+			require.Equal(mc, action, event.Action)
+			change, ok := event.Changes["condition"]
+			if from == nil && to == nil {
+				require.False(mc, ok)
+			} else {
+				require.Equal(mc, model.AuditChange{Old: from, New: to}, change)
+			}
+			return nil
+		})
+		return mock
+	}
+}
@@ -0,0 +1,142 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	userService "github.com/8thgencore/microservice-auth/internal/service/user"
+)
+
+// checkOnBehalfPermission is the permission to check the access of other users.
+const checkOnBehalfPermission = "access:check_on_behalf"
+
+// Batch check errors
+var (
+	// ErrUserNotFound occurs when checking the access of a user who does not exist.
+	ErrUserNotFound = errors.New("user not found")
+	// ErrFailedToGetUser occurs when there is a problem retrieving the user whose access is checked.
+	ErrFailedToGetUser = errors.New("failed to get user")
+)
+
+// BatchCheck decides on the access of the caller, or of the user with the ID when it is set, to every endpoint.
+// Checking the access of another user requires the permission to check on behalf of users.
+func (s *accessService) BatchCheck(
+	ctx context.Context,
+	userID string,
+	checks []*model.AccessCheck,
+) ([]*model.AccessDecision, error) {
+	claims, err := s.verifyCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	active := true
+	if userID != "" && userID != claims.Subject {
+		if !slices.Contains(s.permissionService.RolePermissions(claims.Roles), checkOnBehalfPermission) {
+			return nil, ErrAccessDenied
+		}
+
+		user, err := s.userRepository.Get(ctx, userID)
+		if err != nil {
+			if errors.Is(err, userService.ErrUserNotFound) {
+				return nil, ErrUserNotFound
+			}
+			return nil, ErrFailedToGetUser
+		}
+
+		claims = &model.UserClaims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: user.ID},
+			Username:         user.Name,
+			Roles:            user.Roles,
+		}
+		active = user.IsActive()
+	}
+
+	decisions := make([]*model.AccessDecision, 0, len(checks))
+	for _, check := range checks {
+		if !active {
+			decisions = append(decisions, &model.AccessDecision{
+				Endpoint: check.Endpoint,
+				Reason:   model.AccessReasonUserInactive,
+			})
+			continue
+		}
+		decisions = append(decisions, s.decide(ctx, claims, check.Endpoint, check.Attributes))
+	}
+
+	return decisions, nil
+}
+
+// decide decides on the access of the user with the claims to the endpoint. The user may access it if no deny rule
+// denies them access, if one of their roles is allowed to or they hold a permission granting access to it,
+// and if the condition of the policy of the endpoint holds.
+func (s *accessService) decide(
+	ctx context.Context,
+	claims *model.UserClaims,
+	endpoint string,
+	attributes map[string]string,
+) *model.AccessDecision {
+	policy, roles, cond := s.policy(endpoint)
+
+	decision := &model.AccessDecision{
+		Endpoint:     endpoint,
+		Policy:       policy,
+		AllowedRoles: roles,
+		Permissions:  s.permissionService.EndpointPermissions(endpoint),
+		Roles:        s.roleService.EffectiveRoles(claims.Roles),
+	}
+	if cond != nil {
+		decision.Condition = cond.expression
+	}
+
+	// Deny rules override the policies allowing access.
+	if rule := s.firedDenyRule(ctx, claims, endpoint); rule != "" {
+		decision.Reason = model.AccessReasonDenyRule
+		decision.DenyRule = rule
+
+		return decision
+	}
+
+	switch {
+	case policy == "" && len(decision.Permissions) == 0:
+		decision.Reason = model.AccessReasonNoPolicy
+	// The roles the user inherits are allowed too.
+	case intersects(roles, decision.Roles):
+		decision.Reason = model.AccessReasonRoleAllowed
+	case len(decision.Permissions) > 0 &&
+		intersects(decision.Permissions, s.permissionService.RolePermissions(claims.Roles)):
+		decision.Reason = model.AccessReasonPermissionGranted
+	default:
+		decision.Reason = model.AccessReasonNotAllowed
+	}
+	if decision.Reason != model.AccessReasonRoleAllowed && decision.Reason != model.AccessReasonPermissionGranted {
+		return decision
+	}
+
+	if cond != nil && !s.evaluate(ctx, cond, claims, endpoint, attributes) {
+		decision.Reason = model.AccessReasonConditionFailed
+
+		return decision
+	}
+	decision.Allowed = true
+
+	return decision
+}
+
+// decisionError returns the error of a decision denying access, nil for a decision granting it.
+func decisionError(decision *model.AccessDecision) error {
+	switch {
+	case decision.Allowed:
+		return nil
+	case decision.Reason == model.AccessReasonDenyRule:
+		return &DenyError{Rule: decision.DenyRule}
+	case decision.Reason == model.AccessReasonNoPolicy:
+		return ErrEndpointNotFound
+	default:
+		return ErrAccessDenied
+	}
+}
@@ -0,0 +1,237 @@
+package access
+
+import (
+	"database/sql"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/8thgencore/microservice-common/pkg/db/transaction"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	repositoryMocks "github.com/8thgencore/microservice-auth/internal/repository/mocks"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
+	userService "github.com/8thgencore/microservice-auth/internal/service/user"
+	tokenMocks "github.com/8thgencore/microservice-auth/internal/tokens/mocks"
+)
+
+var (
+	endpointSend    = "/chat_v1.ChatV1/SendMessage"
+	endpointDelete  = "/chat_v1.ChatV1/Delete"
+	endpointConnect = "/chat_v1.ChatV1/Connect"
+	endpointUnknown = "/other_v1.OtherV1/Get"
+
+	ownerCondition = "attributes.owner_id == subject"
+
+	userID = "user-id"
+)
+
+// onBehalfPermissionServiceMock resolves the moderate permission like permissionServiceMock,
+// and grants the admin role the permission to check on behalf of users.
+func onBehalfPermissionServiceMock(mc *minimock.Controller) service.PermissionService {
+	mock := serviceMocks.NewPermissionServiceMock(mc)
+	mock.RolePermissionsMock.Optional().Set(func(roles []string) []string {
+		if slices.Contains(effectiveRoles(roles), roleAdmin) {
+			return []string{permissionModerate, checkOnBehalfPermission}
+		}
+		return nil
+	})
+	mock.EndpointPermissionsMock.Optional().Set(func(endpoint string) []string {
+		if endpoint == endpointModerate {
+			return []string{permissionModerate}
+		}
+		return nil
+	})
+	return mock
+}
+
+// newDecisionTestService creates a service where users may send messages, admins may delete the chats they own
+// and the moderate permission gives access to the moderate endpoint, and where connecting is denied to users.
+func newDecisionTestService(
+	mc *minimock.Controller,
+	caller *model.UserClaims,
+	userRepository repository.UserRepository,
+) service.AccessService {
+	accessRepositoryMock := repositoryMocks.NewAccessRepositoryMock(mc)
+	accessRepositoryMock.GetRoleEndpointsMock.Expect(ctx).Return([]*model.EndpointPermissions{
+		{Endpoint: endpointSend, Roles: []string{roleUser}},
+		{Endpoint: endpointConnect, Roles: []string{roleUser}},
+		{Endpoint: endpointDelete, Roles: []string{roleAdmin}, Condition: ownerCondition},
+	}, nil)
+	accessRepositoryMock.GetDenyRulesMock.Expect(ctx).Return([]*model.DenyRule{
+		{Name: "maintenance", Endpoint: endpointConnect, Roles: []string{roleUser}},
+	}, nil)
+
+	tokenOperationsMock := tokenMocks.NewTokenOperationsMock(mc)
+	tokenOperationsMock.VerifyAccessTokenMock.Expect(token).Return(caller, nil)
+
+	srv, err := NewService(ctx, accessRepositoryMock, policyVersionRepositoryMock(mc), userRepository, nil,
+		emptyAuditRepositoryMock(mc), roleServiceMock(mc), onBehalfPermissionServiceMock(mc), tokenOperationsMock,
+		transaction.NewTransactionManager(emptyTransactorMock(mc)))
+	require.NoError(mc, err)
+
+	return srv
+}
+
+func TestBatchCheck(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+
+	adminRoles := []string{roleAdmin, roleUser}
+
+	srv := newDecisionTestService(mc, claimsAdmin, repositoryMocks.NewUserRepositoryMock(mc))
+
+	decisions, err := srv.BatchCheck(ctx, "", []*model.AccessCheck{
+		{Endpoint: endpointSend},
+		{Endpoint: endpointDelete, Attributes: map[string]string{"owner_id": adminID}},
+		{Endpoint: endpointDelete, Attributes: map[string]string{"owner_id": userID}},
+		{Endpoint: endpointModerate},
+		{Endpoint: endpointConnect},
+		{Endpoint: endpointUnknown},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*model.AccessDecision{
+		{
+			Endpoint:     endpointSend,
+			Allowed:      true,
+			Reason:       model.AccessReasonRoleAllowed,
+			Policy:       endpointSend,
+			AllowedRoles: []string{roleUser},
+			Roles:        adminRoles,
+		},
+		{
+			Endpoint:     endpointDelete,
+			Allowed:      true,
+			Reason:       model.AccessReasonRoleAllowed,
+			Policy:       endpointDelete,
+			AllowedRoles: []string{roleAdmin},
+			Condition:    ownerCondition,
+			Roles:        adminRoles,
+		},
+		{
+			Endpoint:     endpointDelete,
+			Reason:       model.AccessReasonConditionFailed,
+			Policy:       endpointDelete,
+			AllowedRoles: []string{roleAdmin},
+			Condition:    ownerCondition,
+			Roles:        adminRoles,
+		},
+		{
+			Endpoint:    endpointModerate,
+			Allowed:     true,
+			Reason:      model.AccessReasonPermissionGranted,
+			Permissions: []string{permissionModerate},
+			Roles:       adminRoles,
+		},
+		{
+			Endpoint:     endpointConnect,
+			Reason:       model.AccessReasonDenyRule,
+			Policy:       endpointConnect,
+			AllowedRoles: []string{roleUser},
+			Roles:        adminRoles,
+			DenyRule:     "maintenance",
+		},
+		{
+			Endpoint: endpointUnknown,
+			Reason:   model.AccessReasonNoPolicy,
+			Roles:    adminRoles,
+		},
+	}, decisions)
+}
+
+func TestBatchCheckOnBehalf(t *testing.T) {
+	t.Parallel()
+
+	checks := []*model.AccessCheck{{Endpoint: endpointSend}, {Endpoint: endpointDelete}}
+
+	tests := []struct {
+		name               string
+		caller             *model.UserClaims
+		err                error
+		reasons            []model.AccessDecisionReason
+		userRepositoryMock func(mc *minimock.Controller) repository.UserRepository
+	}{
+		{
+			name:    "success case",
+			caller:  claimsAdmin,
+			reasons: []model.AccessDecisionReason{model.AccessReasonRoleAllowed, model.AccessReasonNotAllowed},
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, userID).Return(&model.User{
+					ID:     userID,
+					Roles:  []string{roleUser},
+					Status: model.UserStatusActive,
+				}, nil)
+				return mock
+			},
+		},
+		{
+			name:    "suspended user case",
+			caller:  claimsAdmin,
+			reasons: []model.AccessDecisionReason{model.AccessReasonUserInactive, model.AccessReasonUserInactive},
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, userID).Return(&model.User{
+					ID:             userID,
+					Roles:          []string{roleAdmin},
+					Status:         model.UserStatusSuspended,
+					SuspendedUntil: sql.NullTime{Time: time.Now().Add(time.Hour), Valid: true},
+				}, nil)
+				return mock
+			},
+		},
+		{
+			name:   "user not found error case",
+			caller: claimsAdmin,
+			err:    ErrUserNotFound,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, userID).Return(nil, userService.ErrUserNotFound)
+				return mock
+			},
+		},
+		{
+			name:   "user repository error case",
+			caller: claimsAdmin,
+			err:    ErrFailedToGetUser,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, userID).Return(nil, errors.New("some error"))
+				return mock
+			},
+		},
+		{
+			name:   "permission denied error case",
+			caller: claimsUser,
+			err:    ErrAccessDenied,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			srv := newDecisionTestService(mc, tt.caller, tt.userRepositoryMock(mc))
+
+			decisions, err := srv.BatchCheck(ctx, userID, checks)
+			require.Equal(t, tt.err, err)
+
+			var reasons []model.AccessDecisionReason
+			for _, decision := range decisions {
+				reasons = append(reasons, decision.Reason)
+			}
+			require.Equal(t, tt.reasons, reasons)
+		})
+	}
+}
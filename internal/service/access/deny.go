@@ -0,0 +1,288 @@
+package access
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/8thgencore/microservice-auth/internal/audit"
+	"github.com/8thgencore/microservice-auth/internal/model"
+)
+
+const (
+	getDenyRulesEndpoint   = "/access_v1.AccessV1/GetDenyRules"
+	addDenyRuleEndpoint    = "/access_v1.AccessV1/AddDenyRule"
+	deleteDenyRuleEndpoint = "/access_v1.AccessV1/DeleteDenyRule"
+)
+
+// Deny rule errors
+var (
+	// ErrDenyRuleAlreadyExists occurs when trying to add a deny rule with the name of an existing one.
+	ErrDenyRuleAlreadyExists = errors.New("deny rule already exists")
+	// ErrDenyRuleNotFound occurs when the specified deny rule is not found.
+	ErrDenyRuleNotFound = errors.New("deny rule not found")
+	// ErrFailedToGetDenyRules occurs when there is a problem retrieving the deny rules.
+	ErrFailedToGetDenyRules = errors.New("failed to get deny rules")
+	// ErrFailedToAddDenyRule occurs when there is a problem adding a new deny rule.
+	ErrFailedToAddDenyRule = errors.New("failed to add deny rule")
+	// ErrFailedToDeleteDenyRule occurs when there is a problem deleting a deny rule.
+	ErrFailedToDeleteDenyRule = errors.New("failed to delete deny rule")
+	// ErrFailedToPublishDenyRules occurs when the deny rules changed but other replicas may still apply the old ones.
+	ErrFailedToPublishDenyRules = errors.New("failed to publish deny rules")
+)
+
+// DenyError is returned when access is denied by a deny rule, whatever the policies allowing it.
+type DenyError struct {
+	Rule string
+}
+
+func (e *DenyError) Error() string {
+	return fmt.Sprintf("%s by deny rule %q", ErrAccessDenied, e.Rule)
+}
+
+func (e *DenyError) Unwrap() error {
+	return ErrAccessDenied
+}
+
+// GetDenyRules retrieves the deny rules after verifying access permissions.
+func (s *accessService) GetDenyRules(ctx context.Context) ([]*model.DenyRule, error) {
+	err := s.Check(ctx, getDenyRulesEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.accessRepository.GetDenyRules(ctx)
+	if err != nil {
+		return nil, ErrFailedToGetDenyRules
+	}
+
+	return rules, nil
+}
+
+// AddDenyRule adds a new deny rule after verifying access permissions.
+func (s *accessService) AddDenyRule(ctx context.Context, rule *model.DenyRule) error {
+	claims, err := s.authorize(ctx, addDenyRuleEndpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	if err = validatePattern(rule.Endpoint); err != nil {
+		return err
+	}
+	for _, roles := range [][]string{rule.Roles, rule.ExceptRoles} {
+		if len(roles) > 0 && s.roleService.ValidateRoles(roles) != nil {
+			return ErrUnknownRole
+		}
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if errTx := s.accessRepository.AddDenyRule(ctx, rule); errTx != nil {
+			return errTx
+		}
+
+		target := model.AuditTarget{Type: model.AuditTargetDenyRule, ID: rule.Name}
+
+		return s.recordAudit(ctx, claims, model.AuditActionDenyRuleAdded, target, denyRuleChanges(nil, rule))
+	})
+	if err != nil {
+		if errors.Is(err, ErrDenyRuleAlreadyExists) {
+			return ErrDenyRuleAlreadyExists
+		}
+		return ErrFailedToAddDenyRule
+	}
+
+	s.rolesMutex.Lock()
+	s.denyRules = sortDenyRules(append(slices.Clone(s.denyRules), rule))
+	s.rolesMutex.Unlock()
+
+	return s.publishDenyRules(ctx)
+}
+
+// DeleteDenyRule deletes a deny rule after verifying access permissions.
+func (s *accessService) DeleteDenyRule(ctx context.Context, name string) error {
+	claims, err := s.authorize(ctx, deleteDenyRuleEndpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if errTx := s.accessRepository.DeleteDenyRule(ctx, name); errTx != nil {
+			return errTx
+		}
+
+		target := model.AuditTarget{Type: model.AuditTargetDenyRule, ID: name}
+		changes := denyRuleChanges(s.cachedDenyRule(name), nil)
+
+		return s.recordAudit(ctx, claims, model.AuditActionDenyRuleDeleted, target, changes)
+	})
+	if err != nil {
+		if errors.Is(err, ErrDenyRuleNotFound) {
+			return ErrDenyRuleNotFound
+		}
+		return ErrFailedToDeleteDenyRule
+	}
+
+	s.rolesMutex.Lock()
+	s.denyRules = slices.DeleteFunc(slices.Clone(s.denyRules), func(rule *model.DenyRule) bool {
+		return rule.Name == name
+	})
+	s.rolesMutex.Unlock()
+
+	return s.publishDenyRules(ctx)
+}
+
+// publishDenyRules gives the deny rules a new version, so that every replica reads them again.
+// When the version can't be replaced it is removed, which makes the replicas read the rules again too.
+func (s *accessService) publishDenyRules(ctx context.Context) error {
+	err := s.policyRepository.SetDenyRulesVersion(ctx, uuid.NewString())
+	if err == nil {
+		return nil
+	}
+
+	if err = s.policyRepository.DeleteDenyRulesVersion(ctx); err != nil {
+		return ErrFailedToPublishDenyRules
+	}
+
+	return nil
+}
+
+// sharedDenyRulesVersion returns the version of the deny rules shared by the replicas.
+// When none is cached a new one is, which the rules read afterwards are at.
+func (s *accessService) sharedDenyRulesVersion(ctx context.Context) (string, error) {
+	version, err := s.policyRepository.GetDenyRulesVersion(ctx)
+	if err != nil || version != "" {
+		return version, err
+	}
+
+	version = uuid.NewString()
+	added, err := s.policyRepository.AddDenyRulesVersion(ctx, version)
+	if err != nil {
+		return "", err
+	}
+	if !added {
+		return s.policyRepository.GetDenyRulesVersion(ctx)
+	}
+
+	return version, nil
+}
+
+// currentDenyRules returns the deny rules, read again when another replica changed them since they were read.
+// The rules read last are kept while the version or the rules can't be read.
+func (s *accessService) currentDenyRules(ctx context.Context) []*model.DenyRule {
+	s.rolesMutex.RLock()
+	rules, cached := s.denyRules, s.denyRulesVersion
+	s.rolesMutex.RUnlock()
+
+	version, err := s.sharedDenyRulesVersion(ctx)
+	if err != nil || version == "" || version == cached {
+		return rules
+	}
+
+	loaded, err := s.accessRepository.GetDenyRules(ctx)
+	if err != nil {
+		return rules
+	}
+	loaded = sortDenyRules(loaded)
+
+	s.rolesMutex.Lock()
+	defer s.rolesMutex.Unlock()
+
+	s.denyRules, s.denyRulesVersion = loaded, version
+
+	return loaded
+}
+
+// firedDenyRule returns the name of the most specific deny rule denying the caller access to the endpoint,
+// or an empty name when none does.
+func (s *accessService) firedDenyRule(ctx context.Context, claims *model.UserClaims, endpoint string) string {
+	rules := s.currentDenyRules(ctx)
+	if len(rules) == 0 {
+		return ""
+	}
+
+	roles := s.roleService.EffectiveRoles(claims.Roles)
+	for _, rule := range rules {
+		if matches(rule.Endpoint, endpoint) && denies(rule, claims.Subject, roles) {
+			return rule.Name
+		}
+	}
+
+	return ""
+}
+
+// denies reports whether the rule denies the user with the effective roles.
+func denies(rule *model.DenyRule, subject string, roles []string) bool {
+	if intersects(rule.ExceptRoles, roles) {
+		return false
+	}
+	if len(rule.Roles) == 0 && len(rule.UserIDs) == 0 {
+		return true
+	}
+
+	return intersects(rule.Roles, roles) || slices.Contains(rule.UserIDs, subject)
+}
+
+// sortDenyRules orders the deny rules from the one of the most specific endpoint to the one of the least
+// specific endpoint, rules of the same endpoint are ordered by name.
+func sortDenyRules(rules []*model.DenyRule) []*model.DenyRule {
+	slices.SortFunc(rules, func(a, b *model.DenyRule) int {
+		return cmp.Or(comparePatterns(a.Endpoint, b.Endpoint), strings.Compare(a.Name, b.Name))
+	})
+
+	return rules
+}
+
+// cachedDenyRule returns the deny rule with the name, nil when there is none.
+func (s *accessService) cachedDenyRule(name string) *model.DenyRule {
+	s.rolesMutex.RLock()
+	defer s.rolesMutex.RUnlock()
+
+	i := slices.IndexFunc(s.denyRules, func(rule *model.DenyRule) bool { return rule.Name == name })
+	if i < 0 {
+		return nil
+	}
+
+	return s.denyRules[i]
+}
+
+// denyRuleChanges returns the changes of the fields of a deny rule from one rule to another,
+// nil for a rule added or deleted.
+func denyRuleChanges(from, to *model.DenyRule) audit.Changes {
+	old, updated := denyRuleFields(from), denyRuleFields(to)
+
+	changes := audit.Changes{}
+	for _, field := range []string{"endpoint", "roles", "user_ids", "except_roles", "reason"} {
+		changes = changes.Add(field, old[field], updated[field])
+	}
+
+	return changes
+}
+
+// denyRuleFields returns the fields of the deny rule which are set.
+func denyRuleFields(rule *model.DenyRule) map[string]any {
+	fields := map[string]any{}
+	if rule == nil {
+		return fields
+	}
+
+	fields["endpoint"] = rule.Endpoint
+	for field, names := range map[string][]string{
+		"roles":        rule.Roles,
+		"user_ids":     rule.UserIDs,
+		"except_roles": rule.ExceptRoles,
+	} {
+		if len(names) > 0 {
+			fields[field] = names
+		}
+	}
+	if rule.Reason != "" {
+		fields["reason"] = rule.Reason
+	}
+
+	return fields
+}
@@ -0,0 +1,408 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/8thgencore/microservice-common/pkg/db/transaction"
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	repositoryMocks "github.com/8thgencore/microservice-auth/internal/repository/mocks"
+	tokenMocks "github.com/8thgencore/microservice-auth/internal/tokens/mocks"
+)
+
+func TestCheckDenyRules(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		blockedID = "blocked-id"
+
+		claimsBlocked = &model.UserClaims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: blockedID},
+			Username:         username,
+			Roles:            model.ClaimRoles{roleAdmin},
+		}
+
+		endpointPermissions = []*model.EndpointPermissions{
+			{Endpoint: "/chat_v1.ChatV1/*", Roles: []string{roleUser}},
+			{Endpoint: "/user_v1.UserV1/*", Roles: []string{roleUser}},
+		}
+
+		// During the incident only the admins may send messages, a user is blocked from every chat
+		// method, and nobody may delete users but the support.
+		denyRules = []*model.DenyRule{
+			{Name: "incident", Endpoint: "/chat_v1.ChatV1/SendMessage", ExceptRoles: []string{roleAdmin}},
+			{Name: "blocked-user", Endpoint: "/chat_v1.ChatV1/*", UserIDs: []string{blockedID}},
+			{Name: "no-delete", Endpoint: "/user_v1.UserV1/Delete", ExceptRoles: []string{roleSupport}},
+			{Name: "no-user-writes", Endpoint: "/user_v1.UserV1/Update*", Roles: []string{roleUser}},
+		}
+	)
+
+	tests := []struct {
+		name     string
+		endpoint string
+		claims   *model.UserClaims
+		err      error
+	}{
+		{
+			name:     "everyone but excepted role error case",
+			endpoint: "/chat_v1.ChatV1/SendMessage",
+			claims:   claimsUser,
+			err:      &DenyError{Rule: "incident"},
+		},
+		{
+			name:     "excepted role success case",
+			endpoint: "/chat_v1.ChatV1/SendMessage",
+			claims:   claimsAdmin,
+			err:      nil,
+		},
+		{
+			name:     "inherited excepted role success case",
+			endpoint: "/chat_v1.ChatV1/SendMessage",
+			claims:   claimsSupport,
+			err:      nil,
+		},
+		{
+			name:     "denied user error case",
+			endpoint: "/chat_v1.ChatV1/Connect",
+			claims:   claimsBlocked,
+			err:      &DenyError{Rule: "blocked-user"},
+		},
+		{
+			name:     "most specific rule error case",
+			endpoint: "/chat_v1.ChatV1/SendMessage",
+			claims:   &model.UserClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: blockedID}, Roles: []string{roleUser}},
+			err:      &DenyError{Rule: "incident"},
+		},
+		{
+			name:     "not denied user success case",
+			endpoint: "/chat_v1.ChatV1/Connect",
+			claims:   claimsAdmin,
+			err:      nil,
+		},
+		{
+			name:     "deny overrides allow error case",
+			endpoint: "/user_v1.UserV1/Delete",
+			claims:   claimsAdmin,
+			err:      &DenyError{Rule: "no-delete"},
+		},
+		{
+			name:     "inherited denied role error case",
+			endpoint: "/user_v1.UserV1/UpdateProfile",
+			claims:   claimsAdmin,
+			err:      &DenyError{Rule: "no-user-writes"},
+		},
+		{
+			name:     "no matching rule success case",
+			endpoint: "/user_v1.UserV1/Get",
+			claims:   claimsUser,
+			err:      nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessRepositoryMock := repositoryMocks.NewAccessRepositoryMock(mc)
+			accessRepositoryMock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+			accessRepositoryMock.GetDenyRulesMock.Expect(ctx).Return(denyRules, nil)
+			tokenOperationsMock := tokenMocks.NewTokenOperationsMock(mc)
+			tokenOperationsMock.VerifyAccessTokenMock.Expect(token).Return(tt.claims, nil)
+
+			srv, err := newTestService(mc, accessRepositoryMock, emptyAuditRepositoryMock(mc), roleServiceMock(mc),
+				permissionServiceMock(mc), tokenOperationsMock, emptyTransactorMock(mc))
+			require.NoError(t, err)
+
+			err = srv.Check(ctx, tt.endpoint, nil)
+			require.Equal(t, tt.err, err)
+			if err != nil {
+				require.ErrorIs(t, err, ErrAccessDenied)
+			}
+		})
+	}
+}
+
+func TestAddDenyRule(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		endpointPermissions = []*model.EndpointPermissions{
+			{Endpoint: addDenyRuleEndpoint, Roles: []string{roleAdmin}},
+			{Endpoint: "/chat_v1.ChatV1/*", Roles: []string{roleUser}},
+		}
+
+		rule = &model.DenyRule{
+			Name:        "incident",
+			Endpoint:    "/chat_v1.ChatV1/*",
+			ExceptRoles: []string{roleAdmin},
+			Reason:      "spam",
+		}
+	)
+
+	tests := []struct {
+		name                 string
+		rule                 *model.DenyRule
+		err                  error
+		denied               bool
+		accessRepositoryMock accessRepositoryMockFunc
+		auditRepositoryMock  auditRepositoryMockFunc
+		transactorMock       transactorMockFunc
+	}{
+		{
+			name:                "invalid pattern error case",
+			rule:                &model.DenyRule{Name: "incident", Endpoint: "/chat_v1.ChatV1/*/*"},
+			err:                 ErrInvalidEndpointPattern,
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			transactorMock:      emptyTransactorMock,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				return repositoryMocks.NewAccessRepositoryMock(mc)
+			},
+		},
+		{
+			name: "unknown excepted role error case",
+			rule: &model.DenyRule{
+				Name:        "incident",
+				Endpoint:    "/chat_v1.ChatV1/*",
+				ExceptRoles: []string{"UNKNOWN"},
+			},
+			err:                 ErrUnknownRole,
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			transactorMock:      emptyTransactorMock,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				return repositoryMocks.NewAccessRepositoryMock(mc)
+			},
+		},
+		{
+			name:                "deny rule exists error case",
+			rule:                rule,
+			err:                 ErrDenyRuleAlreadyExists,
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			transactorMock:      transactorRollbackMock,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repositoryMocks.NewAccessRepositoryMock(mc)
+				mock.AddDenyRuleMock.Expect(minimock.AnyContext, rule).Return(ErrDenyRuleAlreadyExists)
+				return mock
+			},
+		},
+		{
+			name:   "add deny rule success case",
+			rule:   rule,
+			err:    nil,
+			denied: true,
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Set(func(_ context.Context, event *model.AuditEvent) error {
+					require.Equal(mc, model.AuditActionDenyRuleAdded, event.Action)
+					require.Equal(mc, model.AuditTarget{Type: model.AuditTargetDenyRule, ID: rule.Name}, event.Target)
+					require.Equal(mc, model.AuditChange{New: rule.ExceptRoles}, event.Changes["except_roles"])
+					require.NotContains(mc, event.Changes, "roles")
+					return nil
+				})
+				return mock
+			},
+			transactorMock: transactorCommitMock,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repositoryMocks.NewAccessRepositoryMock(mc)
+				mock.AddDenyRuleMock.Expect(minimock.AnyContext, rule).Return(nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessRepositoryMock := tt.accessRepositoryMock(mc).(*repositoryMocks.AccessRepositoryMock)
+			accessRepositoryMock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+			accessRepositoryMock.GetDenyRulesMock.Expect(ctx).Return(nil, nil)
+			tokenOperationsMock := tokenMocks.NewTokenOperationsMock(mc)
+			tokenOperationsMock.VerifyAccessTokenMock.Expect(token).Return(claimsAdmin, nil)
+
+			srv, err := newTestService(mc, accessRepositoryMock, tt.auditRepositoryMock(mc), roleServiceMock(mc),
+				permissionServiceMock(mc), tokenOperationsMock, tt.transactorMock(mc))
+			require.NoError(t, err)
+
+			err = srv.AddDenyRule(ctx, tt.rule)
+			require.Equal(t, tt.err, err)
+
+			// The rule applies to the following checks once added.
+			accessSrv, ok := srv.(*accessService)
+			require.True(t, ok)
+			denied := accessSrv.firedDenyRule(ctx, claimsUser, "/chat_v1.ChatV1/SendMessage") != ""
+			require.Equal(t, tt.denied, denied)
+		})
+	}
+}
+
+func TestDeleteDenyRule(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		name = "incident"
+
+		endpointPermissions = []*model.EndpointPermissions{
+			{Endpoint: deleteDenyRuleEndpoint, Roles: []string{roleAdmin}},
+		}
+
+		denyRules = []*model.DenyRule{
+			{Name: name, Endpoint: "/chat_v1.ChatV1/*", Roles: []string{roleUser}},
+		}
+	)
+
+	tests := []struct {
+		name                 string
+		err                  error
+		denied               bool
+		accessRepositoryMock accessRepositoryMockFunc
+		auditRepositoryMock  auditRepositoryMockFunc
+		transactorMock       transactorMockFunc
+	}{
+		{
+			name:                "deny rule not found error case",
+			err:                 ErrDenyRuleNotFound,
+			denied:              true,
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			transactorMock:      transactorRollbackMock,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repositoryMocks.NewAccessRepositoryMock(mc)
+				mock.DeleteDenyRuleMock.Expect(minimock.AnyContext, name).Return(ErrDenyRuleNotFound)
+				return mock
+			},
+		},
+		{
+			name:                "delete deny rule error case",
+			err:                 ErrFailedToDeleteDenyRule,
+			denied:              true,
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			transactorMock:      transactorRollbackMock,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repositoryMocks.NewAccessRepositoryMock(mc)
+				mock.DeleteDenyRuleMock.Expect(minimock.AnyContext, name).Return(errors.New("some error"))
+				return mock
+			},
+		},
+		{
+			name:   "delete deny rule success case",
+			err:    nil,
+			denied: false,
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Set(func(_ context.Context, event *model.AuditEvent) error {
+					require.Equal(mc, model.AuditActionDenyRuleDeleted, event.Action)
+					require.Equal(mc, model.AuditChange{Old: []string{roleUser}}, event.Changes["roles"])
+					return nil
+				})
+				return mock
+			},
+			transactorMock: transactorCommitMock,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repositoryMocks.NewAccessRepositoryMock(mc)
+				mock.DeleteDenyRuleMock.Expect(minimock.AnyContext, name).Return(nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessRepositoryMock := tt.accessRepositoryMock(mc).(*repositoryMocks.AccessRepositoryMock)
+			accessRepositoryMock.GetRoleEndpointsMock.Expect(ctx).Return(endpointPermissions, nil)
+			accessRepositoryMock.GetDenyRulesMock.Expect(ctx).Return(denyRules, nil)
+			tokenOperationsMock := tokenMocks.NewTokenOperationsMock(mc)
+			tokenOperationsMock.VerifyAccessTokenMock.Expect(token).Return(claimsAdmin, nil)
+
+			srv, err := newTestService(mc, accessRepositoryMock, tt.auditRepositoryMock(mc), roleServiceMock(mc),
+				permissionServiceMock(mc), tokenOperationsMock, tt.transactorMock(mc))
+			require.NoError(t, err)
+
+			err = srv.DeleteDenyRule(ctx, name)
+			require.Equal(t, tt.err, err)
+
+			accessSrv, ok := srv.(*accessService)
+			require.True(t, ok)
+			denied := accessSrv.firedDenyRule(ctx, claimsUser, "/chat_v1.ChatV1/Connect") != ""
+			require.Equal(t, tt.denied, denied)
+		})
+	}
+}
+
+func TestCheckDenyRulesOfOtherReplica(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		endpoint = "/chat_v1.ChatV1/SendMessage"
+		rule     = &model.DenyRule{Name: "incident", Endpoint: endpoint, Roles: []string{roleUser}}
+
+		version = "before"
+		rules   []*model.DenyRule
+	)
+
+	accessRepositoryMock := repositoryMocks.NewAccessRepositoryMock(mc)
+	accessRepositoryMock.GetRoleEndpointsMock.Expect(ctx).Return([]*model.EndpointPermissions{
+		{Endpoint: endpoint, Roles: []string{roleUser}},
+	}, nil)
+	accessRepositoryMock.GetDenyRulesMock.Set(func(context.Context) ([]*model.DenyRule, error) {
+		return rules, nil
+	})
+	policyRepositoryMock := repositoryMocks.NewPolicyVersionRepositoryMock(mc)
+	policyRepositoryMock.GetDenyRulesVersionMock.Set(func(context.Context) (string, error) {
+		return version, nil
+	})
+	tokenOperationsMock := tokenMocks.NewTokenOperationsMock(mc)
+	tokenOperationsMock.VerifyAccessTokenMock.Expect(token).Return(claimsUser, nil)
+
+	srv, err := NewService(ctx, accessRepositoryMock, policyRepositoryMock, nil, nil, emptyAuditRepositoryMock(mc),
+		roleServiceMock(mc), permissionServiceMock(mc), tokenOperationsMock,
+		transaction.NewTransactionManager(emptyTransactorMock(mc)))
+	require.NoError(t, err)
+	require.NoError(t, srv.Check(ctx, endpoint, nil))
+
+	// Another replica adds the rule, which changes the version of the rules.
+	rules, version = []*model.DenyRule{rule}, "after"
+
+	require.Equal(t, &DenyError{Rule: rule.Name}, srv.Check(ctx, endpoint, nil))
+}
+
+func TestPublishDenyRules(t *testing.T) {
+	t.Parallel()
+
+	cacheErr := errors.New("cache error")
+
+	tests := []struct {
+		name      string
+		setErr    error
+		deleteErr error
+		err       error
+	}{
+		{name: "new version case"},
+		{name: "version removed case", setErr: cacheErr},
+		{name: "version kept error case", setErr: cacheErr, deleteErr: cacheErr, err: ErrFailedToPublishDenyRules},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			policyRepositoryMock := repositoryMocks.NewPolicyVersionRepositoryMock(mc)
+			policyRepositoryMock.SetDenyRulesVersionMock.Return(tt.setErr)
+			if tt.setErr != nil {
+				policyRepositoryMock.DeleteDenyRulesVersionMock.Expect(ctx).Return(tt.deleteErr)
+			}
+
+			s := &accessService{policyRepository: policyRepositoryMock}
+			require.Equal(t, tt.err, s.publishDenyRules(ctx))
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package access
+
+import (
+	"cmp"
+	"path"
+	"slices"
+	"strings"
+)
+
+// Endpoint patterns are full methods such as /chat_v1.ChatV1/SendMessage in which '*' matches any
+// sequence of characters other than '/' and '?' matches any single one, so /chat_v1.ChatV1/* matches
+// every method of the service and /chat_v1.ChatV1/Get* every method starting with Get.
+const wildcards = "*?"
+
+// unsupported are the special characters of path.Match which patterns may not use: a character class or
+// an escape would not be told apart from a full method, nor ordered, as patterns are.
+const unsupported = `[\`
+
+// validatePattern checks that the endpoint is a full method or a pattern of full methods.
+func validatePattern(endpoint string) error {
+	service, method, ok := strings.Cut(strings.TrimPrefix(endpoint, "/"), "/")
+	if !strings.HasPrefix(endpoint, "/") || !ok || service == "" || method == "" || strings.Contains(method, "/") {
+		return ErrInvalidEndpointPattern
+	}
+	if strings.ContainsAny(endpoint, unsupported) {
+		return ErrInvalidEndpointPattern
+	}
+
+	return nil
+}
+
+// isPattern reports whether the endpoint contains wildcards.
+func isPattern(endpoint string) bool {
+	return strings.ContainsAny(endpoint, wildcards)
+}
+
+// matches reports whether the endpoint or pattern matches the endpoint.
+func matches(pattern, endpoint string) bool {
+	if !isPattern(pattern) {
+		return pattern == endpoint
+	}
+	ok, err := path.Match(pattern, endpoint)
+
+	return err == nil && ok
+}
+
+// comparePatterns orders endpoints and patterns from the most specific to the least specific one:
+// full methods come first, then patterns with a longer literal prefix, then patterns with more literal
+// characters, then patterns with fewer wildcards. Patterns alike are ordered by name so the order is
+// deterministic.
+func comparePatterns(a, b string) int {
+	return cmp.Or(
+		cmp.Compare(literalPrefix(b), literalPrefix(a)),
+		cmp.Compare(literals(b), literals(a)),
+		cmp.Compare(strings.Count(a, "*"), strings.Count(b, "*")),
+		strings.Compare(a, b),
+	)
+}
+
+// literalPrefix returns the length of the pattern before its first wildcard,
+// which is longer than any pattern for a full method.
+func literalPrefix(pattern string) int {
+	if i := strings.IndexAny(pattern, wildcards); i >= 0 {
+		return i
+	}
+
+	return len(pattern) + 1
+}
+
+// literals returns the number of characters of the pattern which are not wildcards.
+func literals(pattern string) int {
+	return len(pattern) - strings.Count(pattern, "*") - strings.Count(pattern, "?")
+}
+
+// sortPatterns returns the patterns among the endpoints ordered from the most specific to the least specific one.
+func sortPatterns(endpoints map[string][]string) []string {
+	var patterns []string
+	for endpoint := range endpoints {
+		if isPattern(endpoint) {
+			patterns = append(patterns, endpoint)
+		}
+	}
+	slices.SortFunc(patterns, comparePatterns)
+
+	return patterns
+}
@@ -0,0 +1,75 @@
+package access
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePattern(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		endpoint string
+		err      error
+	}{
+		{endpoint: "/chat_v1.ChatV1/SendMessage", err: nil},
+		{endpoint: "/chat_v1.ChatV1/*", err: nil},
+		{endpoint: "/chat_v1.ChatV1/Get*", err: nil},
+		{endpoint: "/chat_v?.*/*", err: nil},
+		{endpoint: "chat_v1.ChatV1/SendMessage", err: ErrInvalidEndpointPattern},
+		{endpoint: "/chat_v1.ChatV1", err: ErrInvalidEndpointPattern},
+		{endpoint: "/chat_v1.ChatV1/", err: ErrInvalidEndpointPattern},
+		{endpoint: "//SendMessage", err: ErrInvalidEndpointPattern},
+		{endpoint: "/chat_v1.ChatV1/Send/Message", err: ErrInvalidEndpointPattern},
+		{endpoint: "/chat_v1.ChatV1/[GS]et*", err: ErrInvalidEndpointPattern},
+		{endpoint: "/chat_v1.ChatV1/Get[", err: ErrInvalidEndpointPattern},
+		{endpoint: `/chat_v1.ChatV1/Get\*`, err: ErrInvalidEndpointPattern},
+		{endpoint: `/chat_v1.ChatV1/Get\`, err: ErrInvalidEndpointPattern},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.err, validatePattern(tt.endpoint))
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	t.Parallel()
+
+	endpoint := "/chat_v1.ChatV1/GetMessages"
+
+	require.True(t, matches(endpoint, endpoint))
+	require.True(t, matches("/chat_v1.ChatV1/*", endpoint))
+	require.True(t, matches("/chat_v1.ChatV1/Get*", endpoint))
+	require.True(t, matches("/chat_v?.*/*", endpoint))
+	require.False(t, matches("/chat_v1.ChatV1/Send*", endpoint))
+	require.False(t, matches("/chat_v1.ChatV1", endpoint))
+	require.False(t, matches("/*", endpoint))
+}
+
+func TestComparePatterns(t *testing.T) {
+	t.Parallel()
+
+	want := []string{
+		"/chat_v1.ChatV1/GetMessages",
+		"/chat_v1.ChatV1/GetMessage?",
+		"/chat_v1.ChatV1/Get*",
+		"/chat_v1.ChatV1/*",
+		"/chat_v1.*/*",
+		"/*/*",
+	}
+
+	patterns := slices.Clone(want)
+	slices.Reverse(patterns)
+	slices.SortFunc(patterns, comparePatterns)
+	require.Equal(t, want, patterns)
+
+	require.Equal(t, want[1:], sortPatterns(map[string][]string{
+		want[0]: nil, want[1]: nil, want[2]: nil, want[3]: nil, want[4]: nil, want[5]: nil,
+	}))
+}
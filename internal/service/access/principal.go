@@ -0,0 +1,86 @@
+package access
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/tokens"
+)
+
+// Principal check errors
+var (
+	// ErrServiceCallerRequired occurs when a caller which is not an authenticated service checks
+	// the access of a principal.
+	ErrServiceCallerRequired = errors.New("only authenticated services may check the access of a principal")
+	// ErrAccessTokenExpired occurs when the access token of a principal was issued before the tokens
+	// of the user were revoked.
+	ErrAccessTokenExpired = errors.New("access token is expired")
+	// ErrFailedToGetTokenVersion occurs when there is a problem retrieving the token version of a principal.
+	ErrFailedToGetTokenVersion = errors.New("failed to get token version")
+)
+
+// CheckAs checks that the principal may access the endpoint, as Check does for the caller.
+func (s *accessService) CheckAs(
+	ctx context.Context,
+	principal *model.AccessPrincipal,
+	endpoint string,
+	attributes map[string]string,
+) error {
+	claims, err := s.verifyPrincipal(ctx, principal)
+	if err != nil {
+		return err
+	}
+
+	return decisionError(s.decide(ctx, claims, endpoint, attributes))
+}
+
+// CheckPermissionAs checks that the principal holds the permission, as CheckPermission does for the caller.
+func (s *accessService) CheckPermissionAs(
+	ctx context.Context,
+	principal *model.AccessPrincipal,
+	permission string,
+) error {
+	claims, err := s.verifyPrincipal(ctx, principal)
+	if err != nil {
+		return err
+	}
+
+	return s.holdsPermission(claims, permission)
+}
+
+// verifyPrincipal returns the claims of the principal once the caller is known to be an authenticated service.
+// The access token of the principal is verified the way the access tokens of callers are, the user ID and roles
+// of a principal without one are trusted as given.
+func (s *accessService) verifyPrincipal(
+	ctx context.Context,
+	principal *model.AccessPrincipal,
+) (*model.UserClaims, error) {
+	if tokens.Service(ctx) == "" {
+		return nil, ErrServiceCallerRequired
+	}
+
+	if principal.AccessToken == "" {
+		return &model.UserClaims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: principal.UserID},
+			Roles:            principal.Roles,
+		}, nil
+	}
+
+	claims, err := s.tokenOperations.VerifyAccessToken(principal.AccessToken)
+	if err != nil {
+		return nil, ErrInvalidAccessToken
+	}
+
+	version, err := tokens.CurrentVersion(ctx, s.tokenRepository, s.userRepository, claims.Subject)
+	if err != nil {
+		return nil, ErrFailedToGetTokenVersion
+	}
+	if claims.Version < version {
+		return nil, ErrAccessTokenExpired
+	}
+
+	return claims, nil
+}
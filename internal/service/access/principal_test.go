@@ -0,0 +1,266 @@
+package access
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/8thgencore/microservice-common/pkg/db/transaction"
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	repositoryMocks "github.com/8thgencore/microservice-auth/internal/repository/mocks"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	"github.com/8thgencore/microservice-auth/internal/tokens"
+	tokenMocks "github.com/8thgencore/microservice-auth/internal/tokens/mocks"
+)
+
+var (
+	serviceCtx = tokens.WithService(ctxNoMd, "gateway")
+
+	principalToken = "principal_access_token"
+
+	claimsPrincipal = &model.UserClaims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: userID},
+		Username:         username,
+		Roles:            model.ClaimRoles{roleUser},
+		Version:          2,
+	}
+)
+
+// newPrincipalTestService creates a service with the policies of newDecisionTestService,
+// which verifies the access tokens of principals with the mocks.
+func newPrincipalTestService(
+	mc *minimock.Controller,
+	tokenOperations tokens.TokenOperations,
+	userRepository repository.UserRepository,
+	tokenRepository repository.TokenRepository,
+) service.AccessService {
+	accessRepositoryMock := repositoryMocks.NewAccessRepositoryMock(mc)
+	accessRepositoryMock.GetRoleEndpointsMock.Expect(ctx).Return([]*model.EndpointPermissions{
+		{Endpoint: endpointSend, Roles: []string{roleUser}},
+		{Endpoint: endpointConnect, Roles: []string{roleUser}},
+		{Endpoint: endpointDelete, Roles: []string{roleAdmin}, Condition: ownerCondition},
+	}, nil)
+	accessRepositoryMock.GetDenyRulesMock.Expect(ctx).Return([]*model.DenyRule{
+		{Name: "maintenance", Endpoint: endpointConnect, Roles: []string{roleUser}},
+	}, nil)
+
+	srv, err := NewService(ctx, accessRepositoryMock, policyVersionRepositoryMock(mc), userRepository, tokenRepository,
+		emptyAuditRepositoryMock(mc), roleServiceMock(mc), onBehalfPermissionServiceMock(mc), tokenOperations,
+		transaction.NewTransactionManager(emptyTransactorMock(mc)))
+	require.NoError(mc, err)
+
+	return srv
+}
+
+func TestCheckAs(t *testing.T) {
+	t.Parallel()
+
+	subjectUser := &model.AccessPrincipal{UserID: userID, Roles: []string{roleUser}}
+	subjectAdmin := &model.AccessPrincipal{UserID: adminID, Roles: []string{roleAdmin}}
+	tokenPrincipal := &model.AccessPrincipal{AccessToken: principalToken}
+
+	verifiedMock := func(mc *minimock.Controller) tokens.TokenOperations {
+		mock := tokenMocks.NewTokenOperationsMock(mc)
+		mock.VerifyAccessTokenMock.Expect(principalToken).Return(claimsPrincipal, nil)
+		return mock
+	}
+	cachedVersionMock := func(version int) func(mc *minimock.Controller) repository.TokenRepository {
+		return func(mc *minimock.Controller) repository.TokenRepository {
+			mock := repositoryMocks.NewTokenRepositoryMock(mc)
+			mock.GetTokenVersionMock.Expect(serviceCtx, userID).Return(version, nil)
+			return mock
+		}
+	}
+
+	tests := []struct {
+		name                string
+		notService          bool
+		principal           *model.AccessPrincipal
+		endpoint            string
+		attributes          map[string]string
+		err                 error
+		tokenOperationsMock tokenOperationsMockFunc
+		userRepositoryMock  func(mc *minimock.Controller) repository.UserRepository
+		tokenRepositoryMock func(mc *minimock.Controller) repository.TokenRepository
+	}{
+		{
+			name:      "subject allowed case",
+			principal: subjectUser,
+			endpoint:  endpointSend,
+		},
+		{
+			name:       "subject condition case",
+			principal:  subjectAdmin,
+			endpoint:   endpointDelete,
+			attributes: map[string]string{"owner_id": adminID},
+		},
+		{
+			name:       "subject condition failed case",
+			principal:  subjectAdmin,
+			endpoint:   endpointDelete,
+			attributes: map[string]string{"owner_id": userID},
+			err:        ErrAccessDenied,
+		},
+		{
+			name:      "subject not allowed case",
+			principal: subjectUser,
+			endpoint:  endpointDelete,
+			err:       ErrAccessDenied,
+		},
+		{
+			name:      "subject deny rule case",
+			principal: subjectUser,
+			endpoint:  endpointConnect,
+			err:       &DenyError{Rule: "maintenance"},
+		},
+		{
+			name:      "subject no policy case",
+			principal: subjectUser,
+			endpoint:  endpointUnknown,
+			err:       ErrEndpointNotFound,
+		},
+		{
+			name:                "access token cached version case",
+			principal:           tokenPrincipal,
+			endpoint:            endpointSend,
+			tokenOperationsMock: verifiedMock,
+			tokenRepositoryMock: cachedVersionMock(2),
+		},
+		{
+			name:                "access token stored version case",
+			principal:           tokenPrincipal,
+			endpoint:            endpointSend,
+			tokenOperationsMock: verifiedMock,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetVersionMock.Expect(serviceCtx, userID).Return(2, nil)
+				return mock
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				mock.GetTokenVersionMock.Expect(serviceCtx, userID).Return(0, nil)
+				mock.SetTokenVersionMock.Expect(serviceCtx, userID, 2).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:                "access token not allowed case",
+			principal:           tokenPrincipal,
+			endpoint:            endpointDelete,
+			err:                 ErrAccessDenied,
+			tokenOperationsMock: verifiedMock,
+			tokenRepositoryMock: cachedVersionMock(2),
+		},
+		{
+			name:                "access token expired case",
+			principal:           tokenPrincipal,
+			endpoint:            endpointSend,
+			err:                 ErrAccessTokenExpired,
+			tokenOperationsMock: verifiedMock,
+			tokenRepositoryMock: cachedVersionMock(3),
+		},
+		{
+			name:                "token version error case",
+			principal:           tokenPrincipal,
+			endpoint:            endpointSend,
+			err:                 ErrFailedToGetTokenVersion,
+			tokenOperationsMock: verifiedMock,
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				mock.GetTokenVersionMock.Expect(serviceCtx, userID).Return(0, errors.New("cache error"))
+				return mock
+			},
+		},
+		{
+			name:      "invalid access token case",
+			principal: tokenPrincipal,
+			endpoint:  endpointSend,
+			err:       ErrInvalidAccessToken,
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyAccessTokenMock.Expect(principalToken).Return(nil, errors.New("invalid token"))
+				return mock
+			},
+		},
+		{
+			name:       "caller is not a service case",
+			notService: true,
+			principal:  subjectUser,
+			endpoint:   endpointSend,
+			err:        ErrServiceCallerRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			tokenOperations := tokens.TokenOperations(tokenMocks.NewTokenOperationsMock(mc))
+			if tt.tokenOperationsMock != nil {
+				tokenOperations = tt.tokenOperationsMock(mc)
+			}
+			userRepository := repository.UserRepository(repositoryMocks.NewUserRepositoryMock(mc))
+			if tt.userRepositoryMock != nil {
+				userRepository = tt.userRepositoryMock(mc)
+			}
+			tokenRepository := repository.TokenRepository(repositoryMocks.NewTokenRepositoryMock(mc))
+			if tt.tokenRepositoryMock != nil {
+				tokenRepository = tt.tokenRepositoryMock(mc)
+			}
+
+			srv := newPrincipalTestService(mc, tokenOperations, userRepository, tokenRepository)
+
+			callCtx := serviceCtx
+			if tt.notService {
+				callCtx = ctx
+			}
+
+			err := srv.CheckAs(callCtx, tt.principal, tt.endpoint, tt.attributes)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestCheckPermissionAs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		principal *model.AccessPrincipal
+		err       error
+	}{
+		{
+			name:      "success case",
+			principal: &model.AccessPrincipal{UserID: adminID, Roles: []string{roleAdmin}},
+		},
+		{
+			name:      "inherited role case",
+			principal: &model.AccessPrincipal{UserID: adminID, Roles: []string{roleSupport}},
+		},
+		{
+			name:      "permission not held case",
+			principal: &model.AccessPrincipal{UserID: userID, Roles: []string{roleUser}},
+			err:       ErrAccessDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			srv := newPrincipalTestService(mc, tokenMocks.NewTokenOperationsMock(mc),
+				repositoryMocks.NewUserRepositoryMock(mc), repositoryMocks.NewTokenRepositoryMock(mc))
+
+			err := srv.CheckPermissionAs(serviceCtx, tt.principal, permissionModerate)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
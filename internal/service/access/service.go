@@ -4,16 +4,36 @@ import (
 	"context"
 	"sync"
 
+	"github.com/google/cel-go/cel"
+
 	"github.com/8thgencore/microservice-auth/internal/converter"
+	"github.com/8thgencore/microservice-auth/internal/model"
 	"github.com/8thgencore/microservice-auth/internal/repository"
 	"github.com/8thgencore/microservice-auth/internal/service"
 	"github.com/8thgencore/microservice-auth/internal/tokens"
+	"github.com/8thgencore/microservice-common/pkg/db"
 )
 
 type accessService struct {
-	accessRepository repository.AccessRepository
-	tokenOperations  tokens.TokenOperations
-	accessibleRoles  map[string][]string
+	accessRepository  repository.AccessRepository
+	policyRepository  repository.PolicyVersionRepository
+	userRepository    repository.UserRepository
+	tokenRepository   repository.TokenRepository
+	auditRepository   repository.AuditRepository
+	roleService       service.RoleService
+	permissionService service.PermissionService
+	tokenOperations   tokens.TokenOperations
+	txManager         db.TxManager
+	accessibleRoles   map[string][]string
+	// patterns are the endpoint patterns of accessibleRoles from the most specific to the least specific one.
+	patterns []string
+	// conditions are the compiled conditions of the policies which have one, by endpoint.
+	conditions   map[string]*compiledCondition
+	conditionEnv *cel.Env
+	// denyRules are ordered from the rule of the most specific endpoint to the rule of the least specific one.
+	denyRules []*model.DenyRule
+	// denyRulesVersion is the version the deny rules were read at, empty when it is unknown.
+	denyRulesVersion string
 	rolesMutex       sync.RWMutex
 }
 
@@ -21,7 +41,14 @@ type accessService struct {
 func NewService(
 	ctx context.Context,
 	accessRepository repository.AccessRepository,
+	policyRepository repository.PolicyVersionRepository,
+	userRepository repository.UserRepository,
+	tokenRepository repository.TokenRepository,
+	auditRepository repository.AuditRepository,
+	roleService service.RoleService,
+	permissionService service.PermissionService,
 	tokenOperations tokens.TokenOperations,
+	txManager db.TxManager,
 ) (service.AccessService, error) {
 	endpointPermissions, err := accessRepository.GetRoleEndpoints(ctx)
 	if err != nil {
@@ -29,9 +56,35 @@ func NewService(
 	}
 	accessibleRoles := converter.ToEndpointPermissionsMap(endpointPermissions)
 
-	return &accessService{
-		accessRepository: accessRepository,
-		tokenOperations:  tokenOperations,
-		accessibleRoles:  accessibleRoles,
-	}, nil
+	conditionEnv, err := newConditionEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &accessService{
+		accessRepository:  accessRepository,
+		policyRepository:  policyRepository,
+		userRepository:    userRepository,
+		tokenRepository:   tokenRepository,
+		auditRepository:   auditRepository,
+		roleService:       roleService,
+		permissionService: permissionService,
+		tokenOperations:   tokenOperations,
+		txManager:         txManager,
+		accessibleRoles:   accessibleRoles,
+		patterns:          sortPatterns(accessibleRoles),
+		conditionEnv:      conditionEnv,
+	}
+	s.conditions = s.compileConditions(endpointPermissions)
+
+	// The version is read before the rules, so that the rules changed meanwhile are read again by the next check.
+	// A version that can't be read is left unknown, and the next check reads the rules again as well.
+	version, _ := s.sharedDenyRulesVersion(ctx)
+	denyRules, err := accessRepository.GetDenyRules(ctx)
+	if err != nil {
+		return nil, ErrFailedToReadAccessPolicy
+	}
+	s.denyRules, s.denyRulesVersion = sortDenyRules(denyRules), version
+
+	return s, nil
 }
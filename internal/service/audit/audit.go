@@ -0,0 +1,123 @@
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-common/pkg/logger/sl"
+)
+
+// Page sizes of audit event listings
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+// Errors
+var (
+	ErrInvalidPageToken = errors.New("invalid page token")
+	ErrInvalidTimeRange = errors.New("start of time range is after its end")
+	ErrAuditList        = errors.New("failed to list audit events")
+	ErrAuditVerify      = errors.New("failed to verify audit chain")
+	ErrAuditSeal        = errors.New("failed to seal audit chain")
+)
+
+// pageToken is the content of an opaque page token: the cursor of the last listed event and
+// the filter of the listing, so a token can not be used to continue a different listing.
+type pageToken struct {
+	Filter     string    `json:"f"`
+	OccurredAt time.Time `json:"t"`
+	ID         string    `json:"id"`
+}
+
+// ListEvents returns a page of the audit events matching the filter, newest first.
+func (s *auditService) ListEvents(ctx context.Context, params *model.AuditListParams) (*model.AuditPage, error) {
+	if params.Filter.From != nil && params.Filter.To != nil && params.Filter.From.After(*params.Filter.To) {
+		return nil, ErrInvalidTimeRange
+	}
+
+	pageSize := params.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	pageSize = min(pageSize, maxPageSize)
+
+	filter := filterFingerprint(&params.Filter)
+
+	query := &model.AuditListQuery{
+		Filter: params.Filter,
+		// One more event than asked for tells whether there is a next page.
+		Limit: pageSize + 1,
+	}
+	if params.PageToken != "" {
+		token, err := decodePageToken(params.PageToken)
+		if err != nil || token.Filter != filter {
+			return nil, ErrInvalidPageToken
+		}
+		query.Before = &model.AuditCursor{OccurredAt: token.OccurredAt, ID: token.ID}
+	}
+
+	events, err := s.auditRepository.List(ctx, query)
+	if err != nil {
+		s.logger.Error("failed to list audit events", sl.Err(err))
+		return nil, ErrAuditList
+	}
+
+	page := &model.AuditPage{Events: events}
+	if len(events) > pageSize {
+		page.Events = events[:pageSize]
+		last := page.Events[pageSize-1]
+
+		page.NextPageToken, err = encodePageToken(&pageToken{
+			Filter:     filter,
+			OccurredAt: last.OccurredAt,
+			ID:         last.ID,
+		})
+		if err != nil {
+			s.logger.Error("failed to encode page token", sl.Err(err))
+			return nil, ErrAuditList
+		}
+	}
+
+	return page, nil
+}
+
+// filterFingerprint returns a short digest of the filter.
+func filterFingerprint(filter *model.AuditFilter) string {
+	data, _ := json.Marshal(filter)
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:8])
+}
+
+func encodePageToken(token *pageToken) (string, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodePageToken(raw string) (*pageToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var token pageToken
+	if err = json.Unmarshal(data, &token); err != nil {
+		return nil, err
+	}
+	if token.ID == "" {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &token, nil
+}
@@ -0,0 +1,202 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	repositoryMocks "github.com/8thgencore/microservice-auth/internal/repository/mocks"
+	loggerMocks "github.com/8thgencore/microservice-common/pkg/logger/mocks"
+)
+
+type auditRepositoryMockFunc func(mc *minimock.Controller) repository.AuditRepository
+
+// recordedEvents returns events that occurred a second apart, newest first.
+func recordedEvents(n int) []*model.AuditEvent {
+	events := make([]*model.AuditEvent, 0, n)
+	for i := range n {
+		events = append(events, &model.AuditEvent{
+			ID:         fmt.Sprintf("id-%02d", n-i),
+			OccurredAt: time.Date(2025, 5, 4, 0, 0, n-i, 0, time.UTC),
+			Action:     model.AuditActionUserUpdated,
+		})
+	}
+
+	return events
+}
+
+func TestListEventsPages(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		mc     = minimock.NewController(t)
+		events = recordedEvents(7)
+	)
+
+	// The repository mock lists the events before the cursor, like the database would.
+	auditRepositoryMock := repositoryMocks.NewAuditRepositoryMock(mc)
+	auditRepositoryMock.ListMock.Set(func(_ context.Context, query *model.AuditListQuery) ([]*model.AuditEvent, error) {
+		require.Equal(mc, model.AuditActionUserUpdated, query.Filter.Action)
+
+		var page []*model.AuditEvent
+		for _, event := range events {
+			if query.Before != nil && !event.OccurredAt.Before(query.Before.OccurredAt) {
+				continue
+			}
+			if len(page) < query.Limit {
+				page = append(page, event)
+			}
+		}
+		return page, nil
+	})
+
+	srv := NewService(loggerMocks.NewMockLogger(), auditRepositoryMock)
+
+	params := &model.AuditListParams{
+		Filter:   model.AuditFilter{Action: model.AuditActionUserUpdated},
+		PageSize: 3,
+	}
+
+	var ids []string
+	for pages := 1; ; pages++ {
+		page, err := srv.ListEvents(ctx, params)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(page.Events), 3)
+
+		for _, event := range page.Events {
+			ids = append(ids, event.ID)
+		}
+		if page.NextPageToken == "" {
+			require.Equal(t, 3, pages)
+			break
+		}
+		params.PageToken = page.NextPageToken
+	}
+
+	require.Equal(t, []string{"id-07", "id-06", "id-05", "id-04", "id-03", "id-02", "id-01"}, ids)
+}
+
+func TestListEvents(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		mc     = minimock.NewController(t)
+		events = recordedEvents(3)
+
+		actorID = "actor-id"
+		from    = time.Date(2025, 5, 4, 0, 0, 0, 0, time.UTC)
+		to      = from.Add(time.Hour)
+		filter  = model.AuditFilter{ActorID: actorID, From: &from, To: &to}
+	)
+
+	nextPageToken, err := encodePageToken(&pageToken{
+		Filter:     filterFingerprint(&filter),
+		OccurredAt: events[1].OccurredAt,
+		ID:         events[1].ID,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name                string
+		params              *model.AuditListParams
+		want                *model.AuditPage
+		err                 error
+		auditRepositoryMock auditRepositoryMockFunc
+	}{
+		{
+			name:   "first page case",
+			params: &model.AuditListParams{Filter: filter, PageSize: 2},
+			want: &model.AuditPage{
+				Events:        events[:2],
+				NextPageToken: nextPageToken,
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.ListMock.Expect(ctx, &model.AuditListQuery{Filter: filter, Limit: 3}).Return(events, nil)
+				return mock
+			},
+		},
+		{
+			name:   "last page case",
+			params: &model.AuditListParams{Filter: filter, PageSize: 2, PageToken: nextPageToken},
+			want:   &model.AuditPage{Events: events[2:]},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.ListMock.Expect(ctx, &model.AuditListQuery{
+					Filter: filter,
+					Before: &model.AuditCursor{OccurredAt: events[1].OccurredAt, ID: events[1].ID},
+					Limit:  3,
+				}).Return(events[2:], nil)
+				return mock
+			},
+		},
+		{
+			name:   "default page size case",
+			params: &model.AuditListParams{},
+			want:   &model.AuditPage{Events: events},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.ListMock.Expect(ctx, &model.AuditListQuery{Limit: defaultPageSize + 1}).Return(events, nil)
+				return mock
+			},
+		},
+		{
+			name:   "malformed page token case",
+			params: &model.AuditListParams{PageToken: "not a token"},
+			err:    ErrInvalidPageToken,
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return repositoryMocks.NewAuditRepositoryMock(mc)
+			},
+		},
+		{
+			name: "page token of other filter case",
+			params: &model.AuditListParams{
+				Filter:    model.AuditFilter{Action: model.AuditActionLogin},
+				PageToken: nextPageToken,
+			},
+			err: ErrInvalidPageToken,
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return repositoryMocks.NewAuditRepositoryMock(mc)
+			},
+		},
+		{
+			name:   "invalid time range case",
+			params: &model.AuditListParams{Filter: model.AuditFilter{From: &to, To: &from}},
+			err:    ErrInvalidTimeRange,
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return repositoryMocks.NewAuditRepositoryMock(mc)
+			},
+		},
+		{
+			name:   "list error case",
+			params: &model.AuditListParams{},
+			err:    ErrAuditList,
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.ListMock.Return(nil, errors.New("db error"))
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := NewService(loggerMocks.NewMockLogger(), tt.auditRepositoryMock(mc))
+
+			page, err := srv.ListEvents(ctx, tt.params)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, page)
+		})
+	}
+}
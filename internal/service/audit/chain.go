@@ -0,0 +1,119 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/8thgencore/microservice-auth/internal/audit"
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-common/pkg/logger/sl"
+)
+
+const (
+	// verifyBatchSize is the number of events read at once while walking the hash chain.
+	verifyBatchSize = 1000
+	// sealBatchSize is the number of events linked to the hash chain at once.
+	sealBatchSize = 1000
+)
+
+// SealChain links the events recorded since the last seal to the hash chain, in batches.
+func (s *auditService) SealChain(ctx context.Context) (int, error) {
+	var total int
+	for {
+		sealed, err := s.auditRepository.Seal(ctx, sealBatchSize)
+		if err != nil {
+			s.logger.Error("failed to seal audit chain", sl.Err(err))
+			return total, ErrAuditSeal
+		}
+		total += sealed
+
+		if sealed < sealBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// VerifyChain walks the hash chain of the audit log from its start and reports its first broken link.
+// Legacy events, recorded before the audit log was chained, are counted apart. Events not sealed yet
+// are not part of the chain.
+func (s *auditService) VerifyChain(ctx context.Context) (*model.AuditChainVerification, error) {
+	result := &model.AuditChainVerification{Verified: true}
+
+	var prev *model.AuditEvent
+	for {
+		var afterSeq int64
+		if prev != nil {
+			afterSeq = prev.Seq
+		}
+
+		events, err := s.auditRepository.ListChain(ctx, afterSeq, verifyBatchSize)
+		if err != nil {
+			s.logger.Error("failed to list audit chain", sl.Err(err))
+			return nil, ErrAuditVerify
+		}
+
+		for _, event := range events {
+			if reason := checkLink(prev, event); reason != "" {
+				result.Verified = false
+				result.Break = &model.AuditChainBreak{
+					Seq:     event.Seq,
+					EventID: event.ID,
+					Reason:  reason,
+				}
+
+				return result, nil
+			}
+
+			result.Checked++
+			if event.Hash == "" {
+				result.Unchained++
+			}
+			result.HeadSeq = event.Seq
+			result.HeadHash = event.Hash
+			prev = event
+		}
+
+		if len(events) < verifyBatchSize {
+			return result, nil
+		}
+	}
+}
+
+// checkLink returns why the event is not correctly linked to the previous one, empty if it is.
+func checkLink(prev, event *model.AuditEvent) model.AuditChainBreakReason {
+	var expectedSeq int64 = 1
+	var expectedPrevHash string
+	if prev != nil {
+		expectedSeq = prev.Seq + 1
+		expectedPrevHash = prev.Hash
+	}
+
+	if event.Seq != expectedSeq {
+		return model.AuditChainBreakSequenceGap
+	}
+
+	if event.Hash == "" {
+		return checkLegacy(expectedPrevHash, event)
+	}
+
+	if event.PrevHash != expectedPrevHash {
+		return model.AuditChainBreakPrevHashMismatch
+	}
+
+	hash, err := audit.Hash(event)
+	if err != nil || hash != event.Hash {
+		return model.AuditChainBreakHashMismatch
+	}
+
+	return ""
+}
+
+// checkLegacy returns why the event without a hash is not a legacy event, empty if it is.
+// The legacy events were numbered by the migration chaining the audit log, which left them without hashes,
+// so they have no previous hash and only precede the first chained event.
+func checkLegacy(expectedPrevHash string, event *model.AuditEvent) model.AuditChainBreakReason {
+	if expectedPrevHash != "" || event.PrevHash != "" {
+		return model.AuditChainBreakUnchained
+	}
+
+	return ""
+}
@@ -0,0 +1,254 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/8thgencore/microservice-auth/internal/audit"
+	"github.com/8thgencore/microservice-auth/internal/model"
+	repositoryMocks "github.com/8thgencore/microservice-auth/internal/repository/mocks"
+	loggerMocks "github.com/8thgencore/microservice-common/pkg/logger/mocks"
+)
+
+// chain returns an audit log of unchained events followed by chained ones, as recorded.
+func chain(t *testing.T, unchained, chained int) []*model.AuditEvent {
+	events := make([]*model.AuditEvent, 0, unchained+chained)
+	var prevHash string
+	for i := range unchained + chained {
+		event := &model.AuditEvent{
+			ID:         fmt.Sprintf("id-%02d", i+1),
+			OccurredAt: time.Date(2025, 5, 11, 0, 0, i, 0, time.UTC),
+			Action:     model.AuditActionUserUpdated,
+			Target:     model.AuditTarget{Type: model.AuditTargetUser, ID: "user-id"},
+			Changes:    audit.Changes{}.Add("name", "old name", fmt.Sprintf("name %d", i)),
+			Seq:        int64(i + 1),
+		}
+		if i >= unchained {
+			var err error
+			event.PrevHash = prevHash
+			event.Hash, err = audit.Hash(event)
+			require.NoError(t, err)
+			prevHash = event.Hash
+		}
+		events = append(events, event)
+	}
+
+	return events
+}
+
+// chainRepositoryMock lists the events following the sequence number, like the database would.
+func chainRepositoryMock(mc *minimock.Controller, events []*model.AuditEvent) *repositoryMocks.AuditRepositoryMock {
+	mock := repositoryMocks.NewAuditRepositoryMock(mc)
+	mock.ListChainMock.Set(func(_ context.Context, afterSeq int64, limit int) ([]*model.AuditEvent, error) {
+		var page []*model.AuditEvent
+		for _, event := range events {
+			if event.Seq > afterSeq && len(page) < limit {
+				page = append(page, event)
+			}
+		}
+		return page, nil
+	})
+
+	return mock
+}
+
+func TestVerifyChain(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		alter     func(events []*model.AuditEvent) []*model.AuditEvent
+		want      *model.AuditChainVerification
+		headIndex int
+	}{
+		{
+			name:  "intact case",
+			alter: func(events []*model.AuditEvent) []*model.AuditEvent { return events },
+		},
+		{
+			name: "altered event case",
+			alter: func(events []*model.AuditEvent) []*model.AuditEvent {
+				events[3].Changes["name"] = model.AuditChange{Old: "old name", New: "forged"}
+				return events
+			},
+			want: &model.AuditChainVerification{
+				Checked: 3, Unchained: 2, HeadSeq: 3,
+				Break: &model.AuditChainBreak{Seq: 4, EventID: "id-04", Reason: model.AuditChainBreakHashMismatch},
+			},
+			headIndex: 2,
+		},
+		{
+			name: "removed event case",
+			alter: func(events []*model.AuditEvent) []*model.AuditEvent {
+				return append(events[:3], events[4:]...)
+			},
+			want: &model.AuditChainVerification{
+				Checked: 3, Unchained: 2, HeadSeq: 3,
+				Break: &model.AuditChainBreak{Seq: 5, EventID: "id-05", Reason: model.AuditChainBreakSequenceGap},
+			},
+			headIndex: 2,
+		},
+		{
+			name: "rebuilt link case",
+			alter: func(events []*model.AuditEvent) []*model.AuditEvent {
+				events[4].PrevHash = events[2].Hash
+				events[4].Hash, _ = audit.Hash(events[4])
+				return events
+			},
+			want: &model.AuditChainVerification{
+				Checked: 4, Unchained: 2, HeadSeq: 4,
+				Break: &model.AuditChainBreak{Seq: 5, EventID: "id-05", Reason: model.AuditChainBreakPrevHashMismatch},
+			},
+			headIndex: 3,
+		},
+		{
+			name: "unchained event after chain case",
+			alter: func(events []*model.AuditEvent) []*model.AuditEvent {
+				events[5].Hash = ""
+				return events
+			},
+			want: &model.AuditChainVerification{
+				Checked: 5, Unchained: 2, HeadSeq: 5,
+				Break: &model.AuditChainBreak{Seq: 6, EventID: "id-06", Reason: model.AuditChainBreakUnchained},
+			},
+			headIndex: 4,
+		},
+		{
+			name: "legacy event with a previous hash case",
+			alter: func(events []*model.AuditEvent) []*model.AuditEvent {
+				events[1].PrevHash = events[3].Hash
+				return events
+			},
+			want: &model.AuditChainVerification{
+				Checked: 1, Unchained: 1, HeadSeq: 1,
+				Break: &model.AuditChainBreak{Seq: 2, EventID: "id-02", Reason: model.AuditChainBreakUnchained},
+			},
+			headIndex: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			intact := chain(t, 2, 4)
+			events := tt.alter(chain(t, 2, 4))
+
+			srv := NewService(loggerMocks.NewMockLogger(), chainRepositoryMock(mc, events))
+
+			res, err := srv.VerifyChain(ctx)
+			require.NoError(t, err)
+
+			want := tt.want
+			if want == nil {
+				want = &model.AuditChainVerification{
+					Verified:  true,
+					Checked:   6,
+					Unchained: 2,
+					HeadSeq:   6,
+					HeadHash:  intact[5].Hash,
+				}
+			} else {
+				want.HeadHash = intact[tt.headIndex].Hash
+			}
+			require.Equal(t, want, res)
+		})
+	}
+}
+
+func TestVerifyChainBatches(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	events := chain(t, 0, verifyBatchSize+1)
+
+	srv := NewService(loggerMocks.NewMockLogger(), chainRepositoryMock(mc, events))
+
+	res, err := srv.VerifyChain(context.Background())
+	require.NoError(t, err)
+	require.True(t, res.Verified)
+	require.Equal(t, int64(verifyBatchSize+1), res.Checked)
+	require.Equal(t, events[verifyBatchSize].Hash, res.HeadHash)
+}
+
+func TestVerifyChainRepositoryError(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+
+	auditRepositoryMock := repositoryMocks.NewAuditRepositoryMock(mc)
+	auditRepositoryMock.ListChainMock.Expect(minimock.AnyContext, 0, verifyBatchSize).
+		Return(nil, errors.New("repository error"))
+
+	srv := NewService(loggerMocks.NewMockLogger(), auditRepositoryMock)
+
+	res, err := srv.VerifyChain(context.Background())
+	require.ErrorIs(t, err, ErrAuditVerify)
+	require.Nil(t, res)
+}
+
+func TestSealChain(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		sealed  []int
+		sealErr error
+		want    int
+		err     error
+	}{
+		{
+			name:   "nothing to seal case",
+			sealed: []int{0},
+			want:   0,
+		},
+		{
+			name:   "several batches case",
+			sealed: []int{sealBatchSize, sealBatchSize, 3},
+			want:   2*sealBatchSize + 3,
+		},
+		{
+			name:    "repository error case",
+			sealed:  []int{sealBatchSize},
+			sealErr: errors.New("repository error"),
+			want:    sealBatchSize,
+			err:     ErrAuditSeal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			var calls int
+			auditRepositoryMock := repositoryMocks.NewAuditRepositoryMock(mc)
+			auditRepositoryMock.SealMock.Set(func(_ context.Context, limit int) (int, error) {
+				require.Equal(t, sealBatchSize, limit)
+				calls++
+				if calls > len(tt.sealed) {
+					return 0, tt.sealErr
+				}
+				return tt.sealed[calls-1], nil
+			})
+
+			srv := NewService(loggerMocks.NewMockLogger(), auditRepositoryMock)
+
+			sealed, err := srv.SealChain(ctx)
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.want, sealed)
+		})
+	}
+}
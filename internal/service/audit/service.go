@@ -0,0 +1,21 @@
+package audit
+
+import (
+	"log/slog"
+
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	"github.com/8thgencore/microservice-auth/internal/service"
+)
+
+type auditService struct {
+	logger          *slog.Logger
+	auditRepository repository.AuditRepository
+}
+
+// NewService creates new object of service layer.
+func NewService(logger *slog.Logger, auditRepository repository.AuditRepository) service.AuditService {
+	return &auditService{
+		logger:          logger,
+		auditRepository: auditRepository,
+	}
+}
@@ -2,78 +2,132 @@ package auth
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"log/slog"
 
+	"github.com/8thgencore/microservice-common/pkg/logger/sl"
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 
+	"github.com/8thgencore/microservice-auth/internal/audit"
 	"github.com/8thgencore/microservice-auth/internal/model"
+	userService "github.com/8thgencore/microservice-auth/internal/service/user"
+	"github.com/8thgencore/microservice-auth/internal/tokens"
 )
 
 // Errors
 var (
-	ErrUserNotFound    = errors.New("user not found")
-	ErrWrongPassword   = errors.New("wrong password")
-	ErrTokenGeneration = errors.New("failed to generate token")
-	ErrInvalidRefresh  = errors.New("invalid refresh token")
-	ErrLogoutFailed    = errors.New("failed to logout")
+	ErrUserNotFound        = errors.New("user not found")
+	ErrWrongPassword       = errors.New("wrong password")
+	ErrTokenGeneration     = errors.New("failed to generate token")
+	ErrInvalidRefresh      = errors.New("invalid refresh token")
+	ErrRefreshReused       = errors.New("refresh token has already been used")
+	ErrLogoutFailed        = errors.New("failed to logout")
+	ErrTokenFamilyNotFound = errors.New("refresh token family not found")
+	ErrSessionNotFound     = errors.New("session not found")
+	ErrSessionsRead        = errors.New("failed to read sessions")
+	ErrSessionRevoke       = errors.New("failed to revoke session")
+	ErrEmailNotVerified    = errors.New("email is not verified")
+	ErrUserSuspended       = errors.New("user is suspended")
+	ErrUserDeleted         = errors.New("user is deleted")
 )
 
-// Login checks the user's credentials and returns a token pair if they are valid
-func (s *authService) Login(ctx context.Context, creds *model.UserCreds) (*model.TokenPair, error) {
+// Login checks the user's credentials and returns a token pair if they are valid.
+// Users with multi-factor authentication get an MFA challenge token to pass to VerifyMfa instead.
+func (s *authService) Login(
+	ctx context.Context,
+	creds *model.UserCreds,
+	client *model.ClientInfo,
+) (*model.LoginResult, error) {
+	// Refused attempts are not counted, so waiting out the backoff is enough to try again.
+	attempts, err := s.reserveLoginAttempt(ctx, creds.Username, client.IPAddress)
+	if err != nil {
+		return nil, err
+	}
+
 	authInfo, err := s.userRepository.GetAuthInfo(ctx, creds.Username)
 	if err != nil {
+		s.recordLoginFailure(attempts)
 		return nil, ErrWrongPassword
 	}
 
 	err = bcrypt.CompareHashAndPassword([]byte(authInfo.Password), []byte(creds.Password))
 	if err != nil {
+		s.recordLoginFailure(attempts)
+
+		metadata := map[string]string{"reason": "wrong_password"}
+		if err = s.recordAudit(ctx, model.AuditActionLoginFailed, authInfo.ID, nil, metadata); err != nil {
+			s.logger.Error("failed to record failed login", sl.Err(err))
+		}
+
 		return nil, ErrWrongPassword
 	}
 
-	accessToken, err := s.tokenOperations.GenerateAccessToken(model.User{
-		ID:      authInfo.ID,
-		Name:    authInfo.Username,
-		Role:    authInfo.Role,
-		Version: authInfo.Version,
-	},
-	)
+	// The failures of the username are forgotten, the earlier ones from the client IP still count.
+	s.releaseLoginAttempts(ctx, attempts)
+	if err = s.loginAttemptRepository.Reset(ctx, usernameKey(creds.Username)); err != nil {
+		s.logger.Error("failed to reset login failures", sl.Err(err))
+	}
+
+	// The status is only revealed to someone who knows the password.
+	if err = checkAccountStatus(authInfo.Status, authInfo.SuspendedUntil); err != nil {
+		return nil, err
+	}
+	if s.verificationConfig.Required && !authInfo.EmailVerified {
+		return nil, ErrEmailNotVerified
+	}
+
+	mfaEnabled, err := s.mfaEnabled(ctx, authInfo.ID)
 	if err != nil {
-		return nil, ErrTokenGeneration
+		s.logger.Error("failed to check multi-factor authentication", sl.Err(err))
+		return nil, ErrMfaFailed
+	}
+	if mfaEnabled {
+		mfaToken, err := s.tokenOperations.GenerateMfaToken(authInfo.ID)
+		if err != nil {
+			return nil, ErrTokenGeneration
+		}
+
+		return &model.LoginResult{MfaToken: mfaToken}, nil
 	}
 
-	refreshToken, err := s.tokenOperations.GenerateRefreshToken(authInfo.ID)
+	tokenPair, err := s.issueTokenPair(ctx, model.User{
+		ID:      authInfo.ID,
+		Name:    authInfo.Username,
+		Roles:   authInfo.Roles,
+		Version: authInfo.Version,
+	}, client)
 	if err != nil {
-		return nil, ErrTokenGeneration
+		return nil, err
 	}
 
-	return &model.TokenPair{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-	}, nil
+	return &model.LoginResult{Tokens: tokenPair}, nil
 }
 
-// GetAccessToken generates a new access token for a user given a valid refresh token
+// GetAccessToken generates a new access token for a user given a valid refresh token.
+// Refresh tokens of users that are no longer active are refused.
 func (s *authService) GetAccessToken(ctx context.Context, refreshToken string) (string, error) {
-	if err := s.validateRefreshToken(refreshToken); err != nil {
-		return "", err
-	}
-
-	claims, err := s.tokenOperations.VerifyRefreshToken(refreshToken)
+	claims, err := s.verifyRefreshToken(ctx, refreshToken)
 	if err != nil {
-		return "", ErrInvalidRefresh
+		return "", err
 	}
 
 	user, err := s.userRepository.Get(ctx, claims.Subject)
 	if err != nil {
 		return "", ErrUserNotFound
 	}
+	if err = checkAccountStatus(user.Status, user.SuspendedUntil); err != nil {
+		return "", err
+	}
 
-	accessToken, err := s.tokenOperations.GenerateAccessToken(model.User{
+	accessToken, err := s.tokenOperations.GenerateAccessToken(s.withPermissions(model.User{
 		ID:      user.ID,
 		Name:    user.Name,
-		Role:    user.Role,
+		Roles:   user.Roles,
 		Version: user.Version,
-	},
+	}),
+		claims.FamilyID,
 	)
 	if err != nil {
 		return "", ErrTokenGeneration
@@ -82,46 +136,285 @@ func (s *authService) GetAccessToken(ctx context.Context, refreshToken string) (
 	return accessToken, nil
 }
 
-// GetRefreshToken generates a new refresh token for a user given a valid old refresh token
-func (s *authService) GetRefreshToken(ctx context.Context, oldRefreshToken string) (string, error) {
-	if err := s.validateRefreshToken(oldRefreshToken); err != nil {
+// GetRefreshToken rotates a valid refresh token: the old token is consumed and a new one
+// of the same token family is returned.
+func (s *authService) GetRefreshToken(
+	ctx context.Context,
+	oldRefreshToken string,
+	client *model.ClientInfo,
+) (string, error) {
+	claims, err := s.verifyRefreshToken(ctx, oldRefreshToken)
+	if err != nil {
 		return "", err
 	}
 
-	claims, err := s.tokenOperations.VerifyRefreshToken(oldRefreshToken)
+	// Tokens issued before token families were introduced start a new family.
+	if claims.FamilyID == "" {
+		_, refreshToken, err := s.startTokenFamily(ctx, claims.Subject, client)
+		if err != nil {
+			return "", err
+		}
+
+		if err = s.tokenRepository.AddRevokedToken(ctx, oldRefreshToken); err != nil {
+			return "", ErrTokenGeneration
+		}
+
+		return refreshToken, nil
+	}
+
+	tokenID, err := uuid.NewV7()
 	if err != nil {
-		return "", ErrInvalidRefresh
+		return "", ErrTokenGeneration
 	}
 
-	refreshToken, err := s.tokenOperations.GenerateRefreshToken(claims.Subject)
+	refreshToken, err := s.tokenOperations.GenerateRefreshToken(claims.Subject, claims.FamilyID, tokenID.String())
 	if err != nil {
 		return "", ErrTokenGeneration
 	}
 
-	if err = s.tokenRepository.AddRevokedToken(ctx, oldRefreshToken); err != nil {
+	rotated, err := s.familyRepository.Rotate(ctx, claims.FamilyID, claims.ID, tokenID.String(), client)
+	if err != nil {
+		s.logger.Error("failed to rotate refresh token", sl.Err(err))
 		return "", ErrTokenGeneration
 	}
+	if !rotated {
+		// Another request has rotated the same token first.
+		s.revokeReusedFamily(ctx, claims)
+		return "", ErrRefreshReused
+	}
 
 	return refreshToken, nil
 }
 
-// Logout invalidates the refresh token by adding it to the list of revoked tokens
+// Logout invalidates the refresh token together with its token family
 func (s *authService) Logout(ctx context.Context, refreshToken string) error {
-	_, err := s.tokenOperations.VerifyRefreshToken(refreshToken)
+	claims, err := s.tokenOperations.VerifyRefreshToken(refreshToken)
 	if err != nil {
 		return ErrInvalidRefresh
 	}
 
-	if err = s.tokenRepository.AddRevokedToken(ctx, refreshToken); err != nil {
+	if claims.FamilyID == "" {
+		err = s.tokenRepository.AddRevokedToken(ctx, refreshToken)
+	} else {
+		err = s.familyRepository.Revoke(ctx, claims.FamilyID)
+	}
+	if err != nil {
 		return ErrLogoutFailed
 	}
 
 	return nil
 }
 
-// validateRefreshToken checks if a refresh token is valid and not revoked
-func (s *authService) validateRefreshToken(refreshToken string) error {
-	revoked, err := s.tokenRepository.IsTokenRevoked(context.Background(), refreshToken)
+// LogoutAll signs the user out everywhere: the token version is bumped so that every access token
+// issued before is rejected, and every token family is revoked so that no refresh token can be used.
+func (s *authService) LogoutAll(ctx context.Context, userID string) error {
+	var version int
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		version, errTx = s.userRepository.IncrementVersion(ctx, userID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if errTx = s.familyRepository.RevokeAll(ctx, userID, ""); errTx != nil {
+			return errTx
+		}
+
+		return s.recordAudit(ctx, model.AuditActionLogoutAll, userID, nil, nil)
+	})
+	if err != nil {
+		if errors.Is(err, userService.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+
+		s.logger.Error("failed to logout from all sessions", sl.Err(err))
+		return ErrLogoutFailed
+	}
+
+	// The access tokens are revoked once their cached version is replaced.
+	if err = tokens.StoreVersion(ctx, s.tokenRepository, userID, version); err != nil {
+		s.logger.Error("failed to cache token version", sl.Err(err))
+		return ErrLogoutFailed
+	}
+
+	return nil
+}
+
+// ListSessions returns the active sessions of the user
+func (s *authService) ListSessions(ctx context.Context, userID string) ([]*model.TokenFamily, error) {
+	sessions, err := s.familyRepository.ListActive(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to list sessions", sl.Err(err))
+		return nil, ErrSessionsRead
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession revokes a session of the user
+func (s *authService) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	session, err := s.familyRepository.Get(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, ErrTokenFamilyNotFound) {
+			return ErrSessionNotFound
+		}
+
+		s.logger.Error("failed to get session", sl.Err(err))
+		return ErrSessionRevoke
+	}
+	if session.UserID != userID {
+		return ErrSessionNotFound
+	}
+
+	if err = s.familyRepository.Revoke(ctx, sessionID); err != nil {
+		s.logger.Error("failed to revoke session", sl.Err(err))
+		return ErrSessionRevoke
+	}
+
+	return nil
+}
+
+// RevokeAllSessions revokes every session of the user except exceptSessionID, if given
+func (s *authService) RevokeAllSessions(ctx context.Context, userID, exceptSessionID string) error {
+	if err := s.familyRepository.RevokeAll(ctx, userID, exceptSessionID); err != nil {
+		s.logger.Error("failed to revoke sessions", sl.Err(err))
+		return ErrSessionRevoke
+	}
+
+	return nil
+}
+
+// issueTokenPair starts a new session of the user and returns its tokens
+func (s *authService) issueTokenPair(
+	ctx context.Context,
+	user model.User,
+	client *model.ClientInfo,
+) (*model.TokenPair, error) {
+	sessionID, refreshToken, err := s.startTokenFamily(ctx, user.ID, client)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := s.tokenOperations.GenerateAccessToken(s.withPermissions(user), sessionID)
+	if err != nil {
+		return nil, ErrTokenGeneration
+	}
+
+	// Every way of signing in ends here, the user signing in is the actor.
+	metadata := map[string]string{"session_id": sessionID}
+	if err = s.recordAudit(audit.WithActor(ctx, user.ID), model.AuditActionLogin, user.ID, nil, metadata); err != nil {
+		s.logger.Error("failed to record login", sl.Err(err))
+	}
+
+	return &model.TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// withPermissions sets the permissions the user holds through their roles, when they are embedded in access tokens.
+func (s *authService) withPermissions(user model.User) model.User {
+	if s.permissionService != nil {
+		user.Permissions = s.permissionService.RolePermissions(user.Roles)
+	}
+
+	return user
+}
+
+// startTokenFamily creates a new token family for the user and returns its ID and first refresh token
+func (s *authService) startTokenFamily(
+	ctx context.Context,
+	userID string,
+	client *model.ClientInfo,
+) (string, string, error) {
+	familyID, err := uuid.NewV7()
+	if err != nil {
+		return "", "", ErrTokenGeneration
+	}
+
+	tokenID, err := uuid.NewV7()
+	if err != nil {
+		return "", "", ErrTokenGeneration
+	}
+
+	refreshToken, err := s.tokenOperations.GenerateRefreshToken(userID, familyID.String(), tokenID.String())
+	if err != nil {
+		return "", "", ErrTokenGeneration
+	}
+
+	err = s.familyRepository.Create(ctx, &model.TokenFamily{
+		ID:             familyID.String(),
+		UserID:         userID,
+		CurrentTokenID: tokenID.String(),
+		IPAddress:      client.IPAddress,
+		UserAgent:      client.UserAgent,
+	})
+	if err != nil {
+		s.logger.Error("failed to create token family", sl.Err(err))
+		return "", "", ErrTokenGeneration
+	}
+
+	return familyID.String(), refreshToken, nil
+}
+
+// verifyRefreshToken checks the refresh token signature and that it is the current token of
+// an active token family. Presenting a token that was already rotated revokes the whole family.
+func (s *authService) verifyRefreshToken(ctx context.Context, refreshToken string) (*model.RefreshClaims, error) {
+	claims, err := s.tokenOperations.VerifyRefreshToken(refreshToken)
+	if err != nil {
+		return nil, ErrInvalidRefresh
+	}
+
+	if claims.FamilyID == "" {
+		if err = s.validateRefreshToken(ctx, refreshToken); err != nil {
+			return nil, err
+		}
+
+		return claims, nil
+	}
+
+	family, err := s.familyRepository.Get(ctx, claims.FamilyID)
+	if err != nil {
+		return nil, ErrInvalidRefresh
+	}
+	if family.UserID != claims.Subject || family.RevokedAt.Valid {
+		return nil, ErrInvalidRefresh
+	}
+	if family.CurrentTokenID != claims.ID {
+		s.revokeReusedFamily(ctx, claims)
+		return nil, ErrRefreshReused
+	}
+
+	return claims, nil
+}
+
+// revokeReusedFamily revokes the token family of a refresh token that was presented after
+// it had been rotated and records the security event. Either the legitimate client or an
+// attacker holds a stolen copy, so every token of the family is cut off.
+func (s *authService) revokeReusedFamily(ctx context.Context, claims *model.RefreshClaims) {
+	s.logger.Warn("refresh token reuse detected",
+		slog.String("user_id", claims.Subject),
+		slog.String("family_id", claims.FamilyID),
+		slog.String("token_id", claims.ID),
+	)
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if errTx := s.familyRepository.Revoke(ctx, claims.FamilyID); errTx != nil {
+			return errTx
+		}
+
+		metadata := map[string]string{"session_id": claims.FamilyID}
+
+		return s.recordAudit(ctx, model.AuditActionRefreshTokenReused, claims.Subject, nil, metadata)
+	})
+	if err != nil {
+		s.logger.Error("failed to revoke reused token family", sl.Err(err))
+	}
+}
+
+// validateRefreshToken checks if a refresh token issued without a token family is not revoked
+func (s *authService) validateRefreshToken(ctx context.Context, refreshToken string) error {
+	revoked, err := s.tokenRepository.IsTokenRevoked(ctx, refreshToken)
 	if err != nil {
 		return ErrInvalidRefresh
 	}
@@ -131,3 +424,34 @@ func (s *authService) validateRefreshToken(refreshToken string) error {
 
 	return nil
 }
+
+// checkAccountStatus refuses users that are suspended, deactivated or deleted.
+// A suspension that has ended no longer refuses the user.
+func checkAccountStatus(status model.UserStatus, suspendedUntil sql.NullTime) error {
+	switch {
+	case status.IsActive(suspendedUntil):
+		return nil
+	case status == model.UserStatusSuspended:
+		return ErrUserSuspended
+	default:
+		return ErrUserDeleted
+	}
+}
+
+// recordAudit records an action performed on the sign-in or the credentials of a user.
+func (s *authService) recordAudit(
+	ctx context.Context,
+	action model.AuditAction,
+	userID string,
+	changes audit.Changes,
+	metadata map[string]string,
+) error {
+	event, err := audit.NewEvent(ctx, action, model.AuditTarget{Type: model.AuditTargetUser, ID: userID})
+	if err != nil {
+		return err
+	}
+	event.Changes = changes
+	event.Metadata = metadata
+
+	return s.auditRepository.Record(ctx, event)
+}
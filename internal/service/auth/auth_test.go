@@ -2,18 +2,29 @@ package auth
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/8thgencore/microservice-common/pkg/db"
+	"github.com/8thgencore/microservice-common/pkg/db/transaction"
 	"github.com/gojuno/minimock/v3"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/bcrypt"
 
+	"github.com/8thgencore/microservice-auth/internal/config"
 	"github.com/8thgencore/microservice-auth/internal/model"
 	"github.com/8thgencore/microservice-auth/internal/repository"
 	repositoryMocks "github.com/8thgencore/microservice-auth/internal/repository/mocks"
+	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
+	userService "github.com/8thgencore/microservice-auth/internal/service/user"
 	"github.com/8thgencore/microservice-auth/internal/tokens"
 	tokenMocks "github.com/8thgencore/microservice-auth/internal/tokens/mocks"
+	dbMocks "github.com/8thgencore/microservice-common/pkg/db/mocks"
+	loggerMocks "github.com/8thgencore/microservice-common/pkg/logger/mocks"
 )
 
 var (
@@ -21,22 +32,176 @@ var (
 	username        = "username"
 	password        = "password"
 	passwordWrong   = "passwordWrong"
-	role            = "USER"
+	roles           = []string{"USER"}
 	refreshToken    = "refresh_token"
 	oldRefreshToken = "old_refresh_token"
 	accessToken     = "access_token"
+	familyID        = "family_uuid"
+	tokenID         = "token_uuid"
+	rotatedTokenID  = "rotated_token_uuid"
+	mfaToken        = "mfa_token"
+	totpSecret      = "JBSWY3DPEHPK3PXP"
+
+	client = &model.ClientInfo{
+		IPAddress: "127.0.0.1",
+		UserAgent: "grpc-go",
+	}
 
 	user = model.User{
-		ID:   userID,
-		Name: username,
-		Role: role,
+		ID:    userID,
+		Name:  username,
+		Roles: roles,
+	}
+
+	// familyClaims are the claims of the current refresh token of the family.
+	familyClaims = &model.RefreshClaims{
+		RegisteredClaims: jwt.RegisteredClaims{ID: tokenID, Subject: userID},
+		FamilyID:         familyID,
+	}
+
+	family = &model.TokenFamily{
+		ID:             familyID,
+		UserID:         userID,
+		CurrentTokenID: tokenID,
+	}
+
+	rotatedFamily = &model.TokenFamily{
+		ID:             familyID,
+		UserID:         userID,
+		CurrentTokenID: rotatedTokenID,
+	}
+
+	revokedFamily = &model.TokenFamily{
+		ID:             familyID,
+		UserID:         userID,
+		CurrentTokenID: tokenID,
+		RevokedAt:      sql.NullTime{Time: time.Now(), Valid: true},
+	}
+
+	mfaConfig = &config.MFAConfig{
+		Issuer:               "auth",
+		ChallengeTTL:         5 * time.Minute,
+		MaxChallengeFailures: 3,
+		MaxFailures:          10,
+	}
+
+	verificationConfig = &config.EmailVerificationConfig{
+		Required: true,
+		TokenTTL: 24 * time.Hour,
+	}
+
+	loginThrottleConfig = &config.LoginThrottleConfig{
+		BackoffAfter:    3,
+		LockoutAfter:    5,
+		IPBackoffAfter:  10,
+		IPLockoutAfter:  20,
+		BackoffBase:     time.Second,
+		BackoffMax:      time.Minute,
+		LockoutDuration: 15 * time.Minute,
+		FailureWindow:   time.Hour,
+	}
+
+	// noLoginFailuresMock counts login attempts without ever throttling them.
+	noLoginFailuresMock = func(mc *minimock.Controller) repository.LoginAttemptRepository {
+		mock := repositoryMocks.NewLoginAttemptRepositoryMock(mc)
+		mock.ReserveMock.Optional().Return(&model.LoginFailures{}, nil)
+		mock.ReleaseMock.Optional().Return(nil)
+		mock.ResetMock.Optional().Return(nil)
+		return mock
+	}
+
+	pendingTotp = &model.Totp{
+		UserID: userID,
+		Secret: totpSecret,
+	}
+
+	confirmedTotp = &model.Totp{
+		UserID:      userID,
+		Secret:      totpSecret,
+		ConfirmedAt: sql.NullTime{Time: time.Now(), Valid: true},
+	}
+
+	opts = pgx.TxOptions{IsoLevel: pgx.ReadCommitted}
+
+	transactorCommitMock = func(mc *minimock.Controller) db.Transactor {
+		mock := dbMocks.NewTransactorMock(mc)
+		txMock := dbMocks.NewTxMock(mc)
+		mock.BeginTxMock.Expect(minimock.AnyContext, opts).Return(txMock, nil)
+		txMock.CommitMock.Expect(minimock.AnyContext).Return(nil)
+		return mock
+	}
+
+	transactorRollbackMock = func(mc *minimock.Controller) db.Transactor {
+		mock := dbMocks.NewTransactorMock(mc)
+		txMock := dbMocks.NewTxMock(mc)
+		mock.BeginTxMock.Expect(minimock.AnyContext, opts).Return(txMock, nil)
+		txMock.RollbackMock.Expect(minimock.AnyContext).Return(nil)
+		return mock
 	}
 )
 
 type (
-	userRepositoryMockFunc  func(mc *minimock.Controller) repository.UserRepository
-	tokenRepositoryMockFunc func(mc *minimock.Controller) repository.TokenRepository
-	tokenOperationsMockFunc func(mc *minimock.Controller) tokens.TokenOperations
+	userRepositoryMockFunc   func(mc *minimock.Controller) repository.UserRepository
+	tokenRepositoryMockFunc  func(mc *minimock.Controller) repository.TokenRepository
+	familyRepositoryMockFunc func(mc *minimock.Controller) repository.TokenFamilyRepository
+	mfaRepositoryMockFunc    func(mc *minimock.Controller) repository.MfaRepository
+	auditRepositoryMockFunc  func(mc *minimock.Controller) repository.AuditRepository
+	tokenOperationsMockFunc  func(mc *minimock.Controller) tokens.TokenOperations
+	transactorMockFunc       func(mc *minimock.Controller) db.Transactor
+)
+
+// Mocks of a rotated token being presented again: the token family is revoked and the
+// security event is logged.
+var (
+	familyRevokeMock = func(mc *minimock.Controller) repository.TokenFamilyRepository {
+		mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+		mock.GetMock.Expect(minimock.AnyContext, familyID).Return(rotatedFamily, nil)
+		mock.RevokeMock.Expect(minimock.AnyContext, familyID).Return(nil)
+		return mock
+	}
+
+	logSecurityEventMock = func(mc *minimock.Controller) repository.AuditRepository {
+		mock := repositoryMocks.NewAuditRepositoryMock(mc)
+		mock.RecordMock.Set(func(_ context.Context, event *model.AuditEvent) error {
+			require.Equal(mc, model.AuditActionRefreshTokenReused, event.Action)
+			require.Equal(mc, familyID, event.Metadata["session_id"])
+			return nil
+		})
+		return mock
+	}
+
+	mfaNotEnrolledMock = func(mc *minimock.Controller) repository.MfaRepository {
+		mock := repositoryMocks.NewMfaRepositoryMock(mc)
+		mock.GetTotpMock.Expect(minimock.AnyContext, userID).Return(nil, ErrMfaNotEnrolled)
+		return mock
+	}
+
+	emptyMfaRepositoryMock = func(mc *minimock.Controller) repository.MfaRepository {
+		return repositoryMocks.NewMfaRepositoryMock(mc)
+	}
+
+	emptyLoginAttemptRepositoryMock = func(mc *minimock.Controller) repository.LoginAttemptRepository {
+		return repositoryMocks.NewLoginAttemptRepositoryMock(mc)
+	}
+
+	emptyAuditRepositoryMock = func(mc *minimock.Controller) repository.AuditRepository {
+		return repositoryMocks.NewAuditRepositoryMock(mc)
+	}
+
+	// signInAuditedMock accepts sign-ins and failed sign-ins of the user being recorded.
+	signInAuditedMock = func(mc *minimock.Controller) repository.AuditRepository {
+		mock := repositoryMocks.NewAuditRepositoryMock(mc)
+		mock.RecordMock.Optional().Set(func(_ context.Context, event *model.AuditEvent) error {
+			require.Contains(mc, []model.AuditAction{model.AuditActionLogin, model.AuditActionLoginFailed}, event.Action)
+			require.Equal(mc, model.AuditTarget{Type: model.AuditTargetUser, ID: userID}, event.Target)
+			return nil
+		})
+		return mock
+	}
+
+	emptyTransactorMock = func(mc *minimock.Controller) db.Transactor {
+		return dbMocks.NewTransactorMock(mc)
+	}
 )
 
 func TestLogin(t *testing.T) {
@@ -55,10 +220,27 @@ func TestLogin(t *testing.T) {
 		mc  = minimock.NewController(t)
 
 		authInfo = &model.AuthInfo{
+			ID:            userID,
+			Username:      username,
+			Password:      string(hashedPassword),
+			Roles:         roles,
+			EmailVerified: true,
+		}
+
+		unverifiedAuthInfo = &model.AuthInfo{
 			ID:       userID,
 			Username: username,
 			Password: string(hashedPassword),
-			Role:     role,
+			Roles:    roles,
+		}
+
+		suspendedAuthInfo = &model.AuthInfo{
+			ID:            userID,
+			Username:      username,
+			Password:      string(hashedPassword),
+			Roles:         roles,
+			EmailVerified: true,
+			Status:        model.UserStatusSuspended,
 		}
 
 		req = &model.UserCreds{
@@ -71,20 +253,23 @@ func TestLogin(t *testing.T) {
 			Password: passwordWrong,
 		}
 
-		res = &model.TokenPair{
-			AccessToken:  accessToken,
-			RefreshToken: refreshToken,
+		res = &model.LoginResult{
+			Tokens: &model.TokenPair{
+				AccessToken:  accessToken,
+				RefreshToken: refreshToken,
+			},
 		}
 	)
 
 	tests := []struct {
-		name                string
-		args                args
-		want                *model.TokenPair
-		err                 error
-		userRepositoryMock  userRepositoryMockFunc
-		tokenRepositoryMock tokenRepositoryMockFunc
-		tokenOperationsMock tokenOperationsMockFunc
+		name                 string
+		args                 args
+		want                 *model.LoginResult
+		err                  error
+		userRepositoryMock   userRepositoryMockFunc
+		familyRepositoryMock familyRepositoryMockFunc
+		mfaRepositoryMock    mfaRepositoryMockFunc
+		tokenOperationsMock  tokenOperationsMockFunc
 	}{
 		{
 			name: "user repository error case",
@@ -99,10 +284,11 @@ func TestLogin(t *testing.T) {
 				mock.GetAuthInfoMock.Expect(minimock.AnyContext, username).Return(nil, ErrWrongPassword)
 				return mock
 			},
-			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
-				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
 				return mock
 			},
+			mfaRepositoryMock: emptyMfaRepositoryMock,
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
 				mock := tokenMocks.NewTokenOperationsMock(mc)
 				return mock
@@ -121,300 +307,269 @@ func TestLogin(t *testing.T) {
 				mock.GetAuthInfoMock.Expect(minimock.AnyContext, username).Return(authInfo, nil)
 				return mock
 			},
-			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
-				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
 				return mock
 			},
+			mfaRepositoryMock: emptyMfaRepositoryMock,
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
 				mock := tokenMocks.NewTokenOperationsMock(mc)
 				return mock
 			},
 		},
 		{
-			name: "token generate error case",
+			name: "email not verified error case",
 			args: args{
 				ctx: ctx,
 				req: req,
 			},
 			want: nil,
-			err:  ErrTokenGeneration,
+			err:  ErrEmailNotVerified,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repositoryMocks.NewUserRepositoryMock(mc)
-				mock.GetAuthInfoMock.Expect(minimock.AnyContext, username).Return(authInfo, nil)
+				mock.GetAuthInfoMock.Expect(minimock.AnyContext, username).Return(unverifiedAuthInfo, nil)
 				return mock
 			},
-			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
-				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
 				return mock
 			},
+			mfaRepositoryMock: emptyMfaRepositoryMock,
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
 				mock := tokenMocks.NewTokenOperationsMock(mc)
-				mock.GenerateAccessTokenMock.
-					Expect(user).
-					Return("", ErrTokenGeneration)
 				return mock
 			},
 		},
 		{
-			name: "refresh token generate error case",
+			name: "suspended user error case",
 			args: args{
 				ctx: ctx,
 				req: req,
 			},
 			want: nil,
-			err:  ErrTokenGeneration,
+			err:  ErrUserSuspended,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repositoryMocks.NewUserRepositoryMock(mc)
-				mock.GetAuthInfoMock.Expect(minimock.AnyContext, username).Return(authInfo, nil)
+				mock.GetAuthInfoMock.Expect(minimock.AnyContext, username).Return(suspendedAuthInfo, nil)
 				return mock
 			},
-			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
-				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
 				return mock
 			},
+			mfaRepositoryMock: emptyMfaRepositoryMock,
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
 				mock := tokenMocks.NewTokenOperationsMock(mc)
-				mock.GenerateAccessTokenMock.
-					Expect(user).
-					Return(accessToken, nil)
-				mock.GenerateRefreshTokenMock.
-					Expect(user.ID).
-					Return("", ErrTokenGeneration)
-
 				return mock
 			},
 		},
 		{
-			name: "success case",
+			name: "refresh token generate error case",
 			args: args{
 				ctx: ctx,
 				req: req,
 			},
-			want: res,
-			err:  nil,
+			want: nil,
+			err:  ErrTokenGeneration,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repositoryMocks.NewUserRepositoryMock(mc)
 				mock.GetAuthInfoMock.Expect(minimock.AnyContext, username).Return(authInfo, nil)
 				return mock
 			},
-			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
-				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
 				return mock
 			},
+			mfaRepositoryMock: mfaNotEnrolledMock,
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
 				mock := tokenMocks.NewTokenOperationsMock(mc)
-				// Expect Generate to be called for access token
-				mock.GenerateAccessTokenMock.
-					Expect(user).
-					Return(accessToken, nil)
-				// Expect Generate to be called for refresh token
 				mock.GenerateRefreshTokenMock.
-					Expect(user.ID).
-					Return(refreshToken, nil)
+					ExpectUserIDParam1(user.ID).
+					Return("", ErrTokenGeneration)
 
 				return mock
 			},
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-
-			userRepositoryMock := tt.userRepositoryMock(mc)
-			tokenRepositoryMock := tt.tokenRepositoryMock(mc)
-			tokenOperationsMock := tt.tokenOperationsMock(mc)
-			srv := NewService(
-				userRepositoryMock,
-				tokenRepositoryMock,
-				tokenOperationsMock,
-			)
-
-			res, err := srv.Login(tt.args.ctx, tt.args.req)
-			require.Equal(t, tt.err, err)
-			require.Equal(t, tt.want, res)
-		})
-	}
-}
-
-func TestGetAccessToken(t *testing.T) {
-	t.Parallel()
-
-	type args struct {
-		ctx context.Context
-		req string
-	}
-
-	var (
-		ctx = context.Background()
-		mc  = minimock.NewController(t)
-
-		refreshClaims = &model.RefreshClaims{}
-
-		req = refreshToken
-		res = accessToken
-	)
-
-	tests := []struct {
-		name                string
-		args                args
-		want                string
-		err                 error
-		userRepositoryMock  userRepositoryMockFunc
-		tokenRepositoryMock tokenRepositoryMockFunc
-		tokenOperationsMock tokenOperationsMockFunc
-	}{
 		{
-			name: "success case",
+			name: "create token family error case",
 			args: args{
 				ctx: ctx,
 				req: req,
 			},
-			want: res,
-			err:  nil,
+			want: nil,
+			err:  ErrTokenGeneration,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repositoryMocks.NewUserRepositoryMock(mc)
-				mock.GetMock.Expect(ctx, refreshClaims.Subject).Return(&user, nil)
+				mock.GetAuthInfoMock.Expect(minimock.AnyContext, username).Return(authInfo, nil)
 				return mock
 			},
-			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
-				mock := repositoryMocks.NewTokenRepositoryMock(mc)
-				mock.IsTokenRevokedMock.Expect(ctx, refreshToken).Return(false, nil)
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.CreateMock.Return(errors.New("db error"))
 				return mock
 			},
+			mfaRepositoryMock: mfaNotEnrolledMock,
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
 				mock := tokenMocks.NewTokenOperationsMock(mc)
-				mock.VerifyRefreshTokenMock.
-					Expect(refreshToken).
-					Return(refreshClaims, nil)
-				mock.GenerateAccessTokenMock.
-					Expect(user).
-					Return(accessToken, nil)
+				mock.GenerateRefreshTokenMock.
+					ExpectUserIDParam1(user.ID).
+					Return(refreshToken, nil)
 
 				return mock
 			},
 		},
 		{
-			name: "refresh token revoked case",
+			name: "token generate error case",
 			args: args{
 				ctx: ctx,
 				req: req,
 			},
-			want: "",
-			err:  ErrInvalidRefresh,
+			want: nil,
+			err:  ErrTokenGeneration,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetAuthInfoMock.Expect(minimock.AnyContext, username).Return(authInfo, nil)
 				return mock
 			},
-			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
-				mock := repositoryMocks.NewTokenRepositoryMock(mc)
-				mock.IsTokenRevokedMock.Expect(ctx, refreshToken).Return(true, nil)
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.CreateMock.Return(nil)
 				return mock
 			},
+			mfaRepositoryMock: mfaNotEnrolledMock,
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
 				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.GenerateRefreshTokenMock.
+					ExpectUserIDParam1(user.ID).
+					Return(refreshToken, nil)
+				mock.GenerateAccessTokenMock.
+					ExpectUserParam1(user).
+					Return("", ErrTokenGeneration)
 				return mock
 			},
 		},
 		{
-			name: "error checking revoked token case",
+			name: "success case",
 			args: args{
 				ctx: ctx,
 				req: req,
 			},
-			want: "",
-			err:  ErrInvalidRefresh,
+			want: res,
+			err:  nil,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetAuthInfoMock.Expect(minimock.AnyContext, username).Return(authInfo, nil)
 				return mock
 			},
-			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
-				mock := repositoryMocks.NewTokenRepositoryMock(mc)
-				mock.IsTokenRevokedMock.Expect(ctx, refreshToken).Return(false, errors.New("db error"))
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.CreateMock.Set(func(_ context.Context, family *model.TokenFamily) error {
+					require.Equal(mc, userID, family.UserID)
+					require.Equal(mc, client.IPAddress, family.IPAddress)
+					require.Equal(mc, client.UserAgent, family.UserAgent)
+					require.NotEmpty(mc, family.ID)
+					require.NotEmpty(mc, family.CurrentTokenID)
+					return nil
+				})
 				return mock
 			},
+			mfaRepositoryMock: mfaNotEnrolledMock,
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
 				mock := tokenMocks.NewTokenOperationsMock(mc)
+				// Expect Generate to be called for refresh token of a new session
+				mock.GenerateRefreshTokenMock.
+					ExpectUserIDParam1(user.ID).
+					Return(refreshToken, nil)
+				// Expect Generate to be called for access token of the session
+				mock.GenerateAccessTokenMock.Set(func(u model.User, sessionID string) (string, error) {
+					require.Equal(mc, user, u)
+					require.NotEmpty(mc, sessionID)
+					return accessToken, nil
+				})
+
 				return mock
 			},
 		},
 		{
-			name: "token verify error case",
+			name: "mfa required case",
 			args: args{
 				ctx: ctx,
 				req: req,
 			},
-			want: "",
-			err:  ErrInvalidRefresh,
+			want: &model.LoginResult{MfaToken: mfaToken},
+			err:  nil,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetAuthInfoMock.Expect(minimock.AnyContext, username).Return(authInfo, nil)
 				return mock
 			},
-			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
-				mock := repositoryMocks.NewTokenRepositoryMock(mc)
-				mock.IsTokenRevokedMock.Expect(ctx, refreshToken).Return(false, nil)
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				return repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+			},
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(minimock.AnyContext, userID).Return(confirmedTotp, nil)
 				return mock
 			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
 				mock := tokenMocks.NewTokenOperationsMock(mc)
-				mock.VerifyRefreshTokenMock.
-					Expect(refreshToken).
-					Return(nil, ErrInvalidRefresh)
+				mock.GenerateMfaTokenMock.Expect(userID).Return(mfaToken, nil)
 				return mock
 			},
 		},
 		{
-			name: "get user error case",
+			name: "pending mfa enrollment case",
 			args: args{
 				ctx: ctx,
 				req: req,
 			},
-			want: "",
-			err:  ErrUserNotFound,
+			want: res,
+			err:  nil,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repositoryMocks.NewUserRepositoryMock(mc)
-				mock.GetMock.Expect(ctx, refreshClaims.Subject).Return(nil, ErrUserNotFound)
+				mock.GetAuthInfoMock.Expect(minimock.AnyContext, username).Return(authInfo, nil)
 				return mock
 			},
-			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
-				mock := repositoryMocks.NewTokenRepositoryMock(mc)
-				mock.IsTokenRevokedMock.Expect(ctx, refreshToken).Return(false, nil)
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.CreateMock.Return(nil)
+				return mock
+			},
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(minimock.AnyContext, userID).Return(pendingTotp, nil)
 				return mock
 			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
 				mock := tokenMocks.NewTokenOperationsMock(mc)
-				mock.VerifyRefreshTokenMock.
-					Expect(refreshToken).
-					Return(refreshClaims, nil)
+				mock.GenerateRefreshTokenMock.Return(refreshToken, nil)
+				mock.GenerateAccessTokenMock.Return(accessToken, nil)
 				return mock
 			},
 		},
 		{
-			name: "token generate error case",
+			name: "mfa check error case",
 			args: args{
 				ctx: ctx,
 				req: req,
 			},
-			want: "",
-			err:  ErrTokenGeneration,
+			want: nil,
+			err:  ErrMfaFailed,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repositoryMocks.NewUserRepositoryMock(mc)
-				mock.GetMock.Expect(ctx, refreshClaims.Subject).Return(&user, nil)
+				mock.GetAuthInfoMock.Expect(minimock.AnyContext, username).Return(authInfo, nil)
 				return mock
 			},
-			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
-				mock := repositoryMocks.NewTokenRepositoryMock(mc)
-				mock.IsTokenRevokedMock.Expect(ctx, refreshToken).Return(false, nil)
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				return repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+			},
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(minimock.AnyContext, userID).Return(nil, errors.New("db error"))
 				return mock
 			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
-				mock := tokenMocks.NewTokenOperationsMock(mc)
-				mock.VerifyRefreshTokenMock.Expect(refreshToken).Return(refreshClaims, nil)
-				mock.GenerateAccessTokenMock.
-					Expect(user).
-					Return("", ErrTokenGeneration)
-
-				return mock
+				return tokenMocks.NewTokenOperationsMock(mc)
 			},
 		},
 	}
@@ -423,22 +578,36 @@ func TestGetAccessToken(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			userRepositoryMock := tt.userRepositoryMock(mc)
-			tokenRepositoryMock := tt.tokenRepositoryMock(mc)
-			tokenOperationsMock := tt.tokenOperationsMock(mc)
 			srv := NewService(
-				userRepositoryMock,
-				tokenRepositoryMock,
-				tokenOperationsMock,
+				loggerMocks.NewMockLogger(),
+				tt.userRepositoryMock(mc),
+				repositoryMocks.NewTokenRepositoryMock(mc),
+				tt.familyRepositoryMock(mc),
+				tt.mfaRepositoryMock(mc),
+				nil,
+				nil,
+				nil,
+				noLoginFailuresMock(mc),
+				signInAuditedMock(mc),
+				tt.tokenOperationsMock(mc),
+				nil,
+				nil,
+				transaction.NewTransactionManager(dbMocks.NewTransactorMock(mc)),
+				mfaConfig,
+				nil,
+				verificationConfig,
+				loginThrottleConfig,
+				nil,
 			)
-			res, err := srv.GetAccessToken(tt.args.ctx, tt.args.req)
+
+			res, err := srv.Login(tt.args.ctx, tt.args.req, client)
 			require.Equal(t, tt.err, err)
 			require.Equal(t, tt.want, res)
 		})
 	}
 }
 
-func TestGetRefreshToken(t *testing.T) {
+func TestGetAccessToken(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
@@ -452,19 +621,21 @@ func TestGetRefreshToken(t *testing.T) {
 
 		refreshClaims = &model.RefreshClaims{}
 
-		req = oldRefreshToken
-		res = refreshToken
+		req = refreshToken
+		res = accessToken
 	)
 
 	tests := []struct {
-		name                string
-		args                args
-		want                string
-		err                 error
-		userRepositoryMock  userRepositoryMockFunc
-		tokenRepositoryMock tokenRepositoryMockFunc
-
-		tokenOperationsMock tokenOperationsMockFunc
+		name                 string
+		args                 args
+		want                 string
+		err                  error
+		userRepositoryMock   userRepositoryMockFunc
+		tokenRepositoryMock  tokenRepositoryMockFunc
+		familyRepositoryMock familyRepositoryMockFunc
+		auditRepositoryMock  auditRepositoryMockFunc
+		tokenOperationsMock  tokenOperationsMockFunc
+		transactorMock       transactorMockFunc
 	}{
 		{
 			name: "success case",
@@ -476,95 +647,694 @@ func TestGetRefreshToken(t *testing.T) {
 			err:  nil,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, userID).Return(&user, nil)
 				return mock
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
 				mock := repositoryMocks.NewTokenRepositoryMock(mc)
-				mock.IsTokenRevokedMock.Expect(ctx, oldRefreshToken).Return(false, nil)
-				mock.AddRevokedTokenMock.Expect(ctx, oldRefreshToken).Return(nil)
 				return mock
 			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, familyID).Return(family, nil)
+				return mock
+			},
+			auditRepositoryMock: emptyAuditRepositoryMock,
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
 				mock := tokenMocks.NewTokenOperationsMock(mc)
-				mock.VerifyRefreshTokenMock.Expect(oldRefreshToken).Return(refreshClaims, nil)
-				mock.GenerateRefreshTokenMock.
-					Expect(refreshClaims.Subject).
-					Return(refreshToken, nil)
+				mock.VerifyRefreshTokenMock.
+					Expect(refreshToken).
+					Return(familyClaims, nil)
+				mock.GenerateAccessTokenMock.
+					Expect(user, familyID).
+					Return(accessToken, nil)
 
 				return mock
 			},
+			transactorMock: emptyTransactorMock,
 		},
 		{
-			name: "error checking revoked token case",
+			name: "deleted user case",
 			args: args{
 				ctx: ctx,
 				req: req,
 			},
 			want: "",
-			err:  ErrInvalidRefresh,
+			err:  ErrUserDeleted,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, userID).Return(&model.User{ID: userID, Status: model.UserStatusDeleted}, nil)
 				return mock
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
 				mock := repositoryMocks.NewTokenRepositoryMock(mc)
-				mock.IsTokenRevokedMock.Expect(ctx, oldRefreshToken).Return(false, errors.New("db error"))
 				return mock
 			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, familyID).Return(family, nil)
+				return mock
+			},
+			auditRepositoryMock: emptyAuditRepositoryMock,
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
 				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyRefreshTokenMock.
+					Expect(refreshToken).
+					Return(familyClaims, nil)
+
 				return mock
 			},
+			transactorMock: emptyTransactorMock,
 		},
 		{
-			name: "token verify error case",
+			name: "legacy token success case",
 			args: args{
 				ctx: ctx,
 				req: req,
 			},
-			want: "",
-			err:  ErrInvalidRefresh,
+			want: res,
+			err:  nil,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, refreshClaims.Subject).Return(&user, nil)
 				return mock
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
 				mock := repositoryMocks.NewTokenRepositoryMock(mc)
-				mock.IsTokenRevokedMock.Expect(ctx, oldRefreshToken).Return(false, nil)
+				mock.IsTokenRevokedMock.Expect(ctx, refreshToken).Return(false, nil)
 				return mock
 			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				return mock
+			},
+			auditRepositoryMock: emptyAuditRepositoryMock,
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
 				mock := tokenMocks.NewTokenOperationsMock(mc)
-				mock.VerifyRefreshTokenMock.Expect(oldRefreshToken).Return(nil, ErrInvalidRefresh)
+				mock.VerifyRefreshTokenMock.
+					Expect(refreshToken).
+					Return(refreshClaims, nil)
+				mock.GenerateAccessTokenMock.
+					Expect(user, "").
+					Return(accessToken, nil)
+
 				return mock
 			},
+			transactorMock: emptyTransactorMock,
 		},
 		{
-			name: "token generate error case",
+			name: "refresh token revoked case",
 			args: args{
 				ctx: ctx,
 				req: req,
 			},
 			want: "",
-			err:  ErrTokenGeneration,
+			err:  ErrInvalidRefresh,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repositoryMocks.NewUserRepositoryMock(mc)
 				return mock
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
 				mock := repositoryMocks.NewTokenRepositoryMock(mc)
-				mock.IsTokenRevokedMock.Expect(ctx, oldRefreshToken).Return(false, nil)
+				mock.IsTokenRevokedMock.Expect(ctx, refreshToken).Return(true, nil)
 				return mock
 			},
-			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				return mock
+			},
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyRefreshTokenMock.
+					Expect(refreshToken).
+					Return(refreshClaims, nil)
+				return mock
+			},
+			transactorMock: emptyTransactorMock,
+		},
+		{
+			name: "error checking revoked token case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: "",
+			err:  ErrInvalidRefresh,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				return mock
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				mock.IsTokenRevokedMock.Expect(ctx, refreshToken).Return(false, errors.New("db error"))
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				return mock
+			},
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyRefreshTokenMock.
+					Expect(refreshToken).
+					Return(refreshClaims, nil)
+				return mock
+			},
+			transactorMock: emptyTransactorMock,
+		},
+		{
+			name: "token verify error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: "",
+			err:  ErrInvalidRefresh,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				return mock
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				return mock
+			},
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyRefreshTokenMock.
+					Expect(refreshToken).
+					Return(nil, ErrInvalidRefresh)
+				return mock
+			},
+			transactorMock: emptyTransactorMock,
+		},
+		{
+			name: "token family not found case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: "",
+			err:  ErrInvalidRefresh,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				return mock
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, familyID).Return(nil, ErrTokenFamilyNotFound)
+				return mock
+			},
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyRefreshTokenMock.
+					Expect(refreshToken).
+					Return(familyClaims, nil)
+				return mock
+			},
+			transactorMock: emptyTransactorMock,
+		},
+		{
+			name: "token family revoked case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: "",
+			err:  ErrInvalidRefresh,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				return mock
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, familyID).Return(revokedFamily, nil)
+				return mock
+			},
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyRefreshTokenMock.
+					Expect(refreshToken).
+					Return(familyClaims, nil)
+				return mock
+			},
+			transactorMock: emptyTransactorMock,
+		},
+		{
+			name: "rotated token reuse case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: "",
+			err:  ErrRefreshReused,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				return mock
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				return mock
+			},
+			familyRepositoryMock: familyRevokeMock,
+			auditRepositoryMock:  logSecurityEventMock,
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyRefreshTokenMock.
+					Expect(refreshToken).
+					Return(familyClaims, nil)
+				return mock
+			},
+			transactorMock: transactorCommitMock,
+		},
+		{
+			name: "get user error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: "",
+			err:  ErrUserNotFound,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, userID).Return(nil, ErrUserNotFound)
+				return mock
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, familyID).Return(family, nil)
+				return mock
+			},
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyRefreshTokenMock.
+					Expect(refreshToken).
+					Return(familyClaims, nil)
+				return mock
+			},
+			transactorMock: emptyTransactorMock,
+		},
+		{
+			name: "token generate error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: "",
+			err:  ErrTokenGeneration,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, userID).Return(&user, nil)
+				return mock
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, familyID).Return(family, nil)
+				return mock
+			},
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyRefreshTokenMock.Expect(refreshToken).Return(familyClaims, nil)
+				mock.GenerateAccessTokenMock.
+					Expect(user, familyID).
+					Return("", ErrTokenGeneration)
+
+				return mock
+			},
+			transactorMock: emptyTransactorMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := NewService(
+				loggerMocks.NewMockLogger(),
+				tt.userRepositoryMock(mc),
+				tt.tokenRepositoryMock(mc),
+				tt.familyRepositoryMock(mc),
+				repositoryMocks.NewMfaRepositoryMock(mc),
+				nil,
+				nil,
+				nil,
+				nil,
+				tt.auditRepositoryMock(mc),
+				tt.tokenOperationsMock(mc),
+				nil,
+				nil,
+				transaction.NewTransactionManager(tt.transactorMock(mc)),
+				mfaConfig,
+				nil,
+				verificationConfig,
+				loginThrottleConfig,
+				nil,
+			)
+			res, err := srv.GetAccessToken(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetAccessTokenEmbedsPermissions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		permissions = []string{"chat.delete", "chat.moderate"}
+	)
+
+	userRepositoryMock := repositoryMocks.NewUserRepositoryMock(mc)
+	userRepositoryMock.GetMock.Expect(ctx, userID).Return(&user, nil)
+
+	familyRepositoryMock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+	familyRepositoryMock.GetMock.Expect(ctx, familyID).Return(family, nil)
+
+	permissionServiceMock := serviceMocks.NewPermissionServiceMock(mc)
+	permissionServiceMock.RolePermissionsMock.Expect(user.Roles).Return(permissions)
+
+	withPermissions := user
+	withPermissions.Permissions = permissions
+
+	tokenOperationsMock := tokenMocks.NewTokenOperationsMock(mc)
+	tokenOperationsMock.VerifyRefreshTokenMock.Expect(refreshToken).Return(familyClaims, nil)
+	tokenOperationsMock.GenerateAccessTokenMock.Expect(withPermissions, familyID).Return(accessToken, nil)
+
+	srv := NewService(
+		loggerMocks.NewMockLogger(),
+		userRepositoryMock,
+		repositoryMocks.NewTokenRepositoryMock(mc),
+		familyRepositoryMock,
+		repositoryMocks.NewMfaRepositoryMock(mc),
+		nil,
+		nil,
+		nil,
+		nil,
+		emptyAuditRepositoryMock(mc),
+		tokenOperationsMock,
+		nil,
+		permissionServiceMock,
+		transaction.NewTransactionManager(emptyTransactorMock(mc)),
+		mfaConfig,
+		nil,
+		verificationConfig,
+		loginThrottleConfig,
+		nil,
+	)
+	res, err := srv.GetAccessToken(ctx, refreshToken)
+	require.NoError(t, err)
+	require.Equal(t, accessToken, res)
+}
+
+func TestGetRefreshToken(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx context.Context
+		req string
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		refreshClaims = &model.RefreshClaims{}
+
+		req = oldRefreshToken
+		res = refreshToken
+	)
+
+	tests := []struct {
+		name                 string
+		args                 args
+		want                 string
+		err                  error
+		tokenRepositoryMock  tokenRepositoryMockFunc
+		familyRepositoryMock familyRepositoryMockFunc
+		auditRepositoryMock  auditRepositoryMockFunc
+		tokenOperationsMock  tokenOperationsMockFunc
+		transactorMock       transactorMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, familyID).Return(family, nil)
+				mock.RotateMock.Set(func(
+					_ context.Context,
+					id, currentTokenID, nextTokenID string,
+					clientInfo *model.ClientInfo,
+				) (bool, error) {
+					require.Equal(mc, familyID, id)
+					require.Equal(mc, tokenID, currentTokenID)
+					require.NotEqual(mc, tokenID, nextTokenID)
+					require.Equal(mc, client, clientInfo)
+					return true, nil
+				})
+				return mock
+			},
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyRefreshTokenMock.Expect(oldRefreshToken).Return(familyClaims, nil)
+				mock.GenerateRefreshTokenMock.
+					ExpectUserIDParam1(userID).
+					ExpectFamilyIDParam2(familyID).
+					Return(refreshToken, nil)
+
+				return mock
+			},
+			transactorMock: emptyTransactorMock,
+		},
+		{
+			name: "legacy token success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				mock.IsTokenRevokedMock.Expect(ctx, oldRefreshToken).Return(false, nil)
+				mock.AddRevokedTokenMock.Expect(ctx, oldRefreshToken).Return(nil)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.CreateMock.Return(nil)
+				return mock
+			},
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
 				mock := tokenMocks.NewTokenOperationsMock(mc)
 				mock.VerifyRefreshTokenMock.Expect(oldRefreshToken).Return(refreshClaims, nil)
 				mock.GenerateRefreshTokenMock.
-					Expect(refreshClaims.Subject).
-					Return("", ErrTokenGeneration)
+					ExpectUserIDParam1(refreshClaims.Subject).
+					Return(refreshToken, nil)
+
+				return mock
+			},
+			transactorMock: emptyTransactorMock,
+		},
+		{
+			name: "error checking revoked token case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: "",
+			err:  ErrInvalidRefresh,
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				mock.IsTokenRevokedMock.Expect(ctx, oldRefreshToken).Return(false, errors.New("db error"))
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				return mock
+			},
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyRefreshTokenMock.Expect(oldRefreshToken).Return(refreshClaims, nil)
+				return mock
+			},
+			transactorMock: emptyTransactorMock,
+		},
+		{
+			name: "token verify error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: "",
+			err:  ErrInvalidRefresh,
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				return mock
+			},
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyRefreshTokenMock.Expect(oldRefreshToken).Return(nil, ErrInvalidRefresh)
+				return mock
+			},
+			transactorMock: emptyTransactorMock,
+		},
+		{
+			name: "rotated token reuse case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: "",
+			err:  ErrRefreshReused,
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				return mock
+			},
+			familyRepositoryMock: familyRevokeMock,
+			auditRepositoryMock:  logSecurityEventMock,
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyRefreshTokenMock.Expect(oldRefreshToken).Return(familyClaims, nil)
+				return mock
+			},
+			transactorMock: transactorCommitMock,
+		},
+		{
+			name: "concurrent rotation reuse case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: "",
+			err:  ErrRefreshReused,
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, familyID).Return(family, nil)
+				mock.RotateMock.Return(false, nil)
+				mock.RevokeMock.Expect(minimock.AnyContext, familyID).Return(nil)
+				return mock
+			},
+			auditRepositoryMock: logSecurityEventMock,
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyRefreshTokenMock.Expect(oldRefreshToken).Return(familyClaims, nil)
+				mock.GenerateRefreshTokenMock.
+					ExpectUserIDParam1(userID).
+					ExpectFamilyIDParam2(familyID).
+					Return(refreshToken, nil)
+				return mock
+			},
+			transactorMock: transactorCommitMock,
+		},
+		{
+			name: "token generate error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: "",
+			err:  ErrTokenGeneration,
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, familyID).Return(family, nil)
+				return mock
+			},
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyRefreshTokenMock.Expect(oldRefreshToken).Return(familyClaims, nil)
+				mock.GenerateRefreshTokenMock.
+					ExpectUserIDParam1(userID).
+					Return("", ErrTokenGeneration)
+
+				return mock
+			},
+			transactorMock: emptyTransactorMock,
+		},
+		{
+			name: "rotate token family error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: "",
+			err:  ErrTokenGeneration,
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, familyID).Return(family, nil)
+				mock.RotateMock.Return(false, errors.New("db error"))
+				return mock
+			},
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyRefreshTokenMock.Expect(oldRefreshToken).Return(familyClaims, nil)
+				mock.GenerateRefreshTokenMock.
+					ExpectUserIDParam1(userID).
+					Return(refreshToken, nil)
 
 				return mock
 			},
+			transactorMock: emptyTransactorMock,
 		},
 		{
 			name: "add revoked token error case",
@@ -574,25 +1344,28 @@ func TestGetRefreshToken(t *testing.T) {
 			},
 			want: "",
 			err:  ErrTokenGeneration,
-			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
-				mock := repositoryMocks.NewUserRepositoryMock(mc)
-				return mock
-			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
 				mock := repositoryMocks.NewTokenRepositoryMock(mc)
 				mock.IsTokenRevokedMock.Expect(ctx, oldRefreshToken).Return(false, nil)
 				mock.AddRevokedTokenMock.Expect(ctx, oldRefreshToken).Return(ErrTokenGeneration)
 				return mock
 			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.CreateMock.Return(nil)
+				return mock
+			},
+			auditRepositoryMock: emptyAuditRepositoryMock,
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
 				mock := tokenMocks.NewTokenOperationsMock(mc)
 				mock.VerifyRefreshTokenMock.Expect(oldRefreshToken).Return(refreshClaims, nil)
 				mock.GenerateRefreshTokenMock.
-					Expect(refreshClaims.Subject).
+					ExpectUserIDParam1(refreshClaims.Subject).
 					Return(refreshToken, nil)
 
 				return mock
 			},
+			transactorMock: emptyTransactorMock,
 		},
 	}
 
@@ -600,15 +1373,28 @@ func TestGetRefreshToken(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			userRepositoryMock := tt.userRepositoryMock(mc)
-			tokenRepositoryMock := tt.tokenRepositoryMock(mc)
-			tokenOperationsMock := tt.tokenOperationsMock(mc)
 			srv := NewService(
-				userRepositoryMock,
-				tokenRepositoryMock,
-				tokenOperationsMock,
+				loggerMocks.NewMockLogger(),
+				repositoryMocks.NewUserRepositoryMock(mc),
+				tt.tokenRepositoryMock(mc),
+				tt.familyRepositoryMock(mc),
+				repositoryMocks.NewMfaRepositoryMock(mc),
+				nil,
+				nil,
+				nil,
+				nil,
+				tt.auditRepositoryMock(mc),
+				tt.tokenOperationsMock(mc),
+				nil,
+				nil,
+				transaction.NewTransactionManager(tt.transactorMock(mc)),
+				mfaConfig,
+				nil,
+				verificationConfig,
+				loginThrottleConfig,
+				nil,
 			)
-			res, err := srv.GetRefreshToken(tt.args.ctx, tt.args.req)
+			res, err := srv.GetRefreshToken(tt.args.ctx, tt.args.req, client)
 			require.Equal(t, tt.err, err)
 			require.Equal(t, tt.want, res)
 		})
@@ -630,11 +1416,12 @@ func TestLogout(t *testing.T) {
 	)
 
 	tests := []struct {
-		name                string
-		args                args
-		err                 error
-		tokenRepositoryMock tokenRepositoryMockFunc
-		tokenOperationsMock tokenOperationsMockFunc
+		name                 string
+		args                 args
+		err                  error
+		tokenRepositoryMock  tokenRepositoryMockFunc
+		familyRepositoryMock familyRepositoryMockFunc
+		tokenOperationsMock  tokenOperationsMockFunc
 	}{
 		{
 			name: "success case",
@@ -643,11 +1430,37 @@ func TestLogout(t *testing.T) {
 				refreshToken: refreshToken,
 			},
 			err: nil,
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.RevokeMock.Expect(ctx, familyID).Return(nil)
+				return mock
+			},
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyRefreshTokenMock.Expect(refreshToken).Return(familyClaims, nil)
+				return mock
+			},
+		},
+		{
+			name: "legacy token success case",
+			args: args{
+				ctx:          ctx,
+				refreshToken: refreshToken,
+			},
+			err: nil,
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
 				mock := repositoryMocks.NewTokenRepositoryMock(mc)
 				mock.AddRevokedTokenMock.Expect(ctx, refreshToken).Return(nil)
 				return mock
 			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				return mock
+			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
 				mock := tokenMocks.NewTokenOperationsMock(mc)
 				mock.VerifyRefreshTokenMock.Expect(refreshToken).Return(&model.RefreshClaims{}, nil)
@@ -665,12 +1478,38 @@ func TestLogout(t *testing.T) {
 				mock := repositoryMocks.NewTokenRepositoryMock(mc)
 				return mock
 			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				return mock
+			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
 				mock := tokenMocks.NewTokenOperationsMock(mc)
 				mock.VerifyRefreshTokenMock.Expect(refreshToken).Return(nil, ErrInvalidRefresh)
 				return mock
 			},
 		},
+		{
+			name: "token family repository failure case",
+			args: args{
+				ctx:          ctx,
+				refreshToken: refreshToken,
+			},
+			err: ErrLogoutFailed,
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.RevokeMock.Expect(ctx, familyID).Return(errors.New("db error"))
+				return mock
+			},
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyRefreshTokenMock.Expect(refreshToken).Return(familyClaims, nil)
+				return mock
+			},
+		},
 		{
 			name: "token repository failure case",
 			args: args{
@@ -683,6 +1522,10 @@ func TestLogout(t *testing.T) {
 				mock.AddRevokedTokenMock.Expect(ctx, refreshToken).Return(errors.New("db error"))
 				return mock
 			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				return mock
+			},
 			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
 				mock := tokenMocks.NewTokenOperationsMock(mc)
 				mock.VerifyRefreshTokenMock.Expect(refreshToken).Return(&model.RefreshClaims{}, nil)
@@ -695,12 +1538,26 @@ func TestLogout(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			tokenRepositoryMock := tt.tokenRepositoryMock(mc)
-			tokenOperationsMock := tt.tokenOperationsMock(mc)
 			srv := NewService(
-				nil, // userRepository не используется в этом методе
-				tokenRepositoryMock,
-				tokenOperationsMock,
+				loggerMocks.NewMockLogger(),
+				nil, // userRepository is not used by this method
+				tt.tokenRepositoryMock(mc),
+				tt.familyRepositoryMock(mc),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				tt.tokenOperationsMock(mc),
+				nil,
+				nil,
+				nil,
+				mfaConfig,
+				nil,
+				verificationConfig,
+				loginThrottleConfig,
+				nil,
 			)
 
 			err := srv.Logout(tt.args.ctx, tt.args.refreshToken)
@@ -708,3 +1565,415 @@ func TestLogout(t *testing.T) {
 		})
 	}
 }
+
+func TestListSessions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		sessions = []*model.TokenFamily{family}
+	)
+
+	tests := []struct {
+		name                 string
+		want                 []*model.TokenFamily
+		err                  error
+		familyRepositoryMock familyRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			want: sessions,
+			err:  nil,
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.ListActiveMock.Expect(ctx, userID).Return(sessions, nil)
+				return mock
+			},
+		},
+		{
+			name: "repository error case",
+			want: nil,
+			err:  ErrSessionsRead,
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.ListActiveMock.Expect(ctx, userID).Return(nil, errors.New("db error"))
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := NewService(
+				loggerMocks.NewMockLogger(),
+				nil,
+				nil,
+				tt.familyRepositoryMock(mc),
+				repositoryMocks.NewMfaRepositoryMock(mc),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				mfaConfig,
+				nil,
+				verificationConfig,
+				loginThrottleConfig,
+				nil,
+			)
+
+			res, err := srv.ListSessions(ctx, userID)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestRevokeSession(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		otherUserFamily = &model.TokenFamily{
+			ID:     familyID,
+			UserID: "other_uuid",
+		}
+	)
+
+	tests := []struct {
+		name                 string
+		err                  error
+		familyRepositoryMock familyRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, familyID).Return(family, nil)
+				mock.RevokeMock.Expect(ctx, familyID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "session not found case",
+			err:  ErrSessionNotFound,
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, familyID).Return(nil, ErrTokenFamilyNotFound)
+				return mock
+			},
+		},
+		{
+			name: "session of other user case",
+			err:  ErrSessionNotFound,
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, familyID).Return(otherUserFamily, nil)
+				return mock
+			},
+		},
+		{
+			name: "revoke error case",
+			err:  ErrSessionRevoke,
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, familyID).Return(family, nil)
+				mock.RevokeMock.Expect(ctx, familyID).Return(errors.New("db error"))
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := NewService(
+				loggerMocks.NewMockLogger(),
+				nil,
+				nil,
+				tt.familyRepositoryMock(mc),
+				repositoryMocks.NewMfaRepositoryMock(mc),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				mfaConfig,
+				nil,
+				verificationConfig,
+				loginThrottleConfig,
+				nil,
+			)
+
+			err := srv.RevokeSession(ctx, userID, familyID)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestRevokeAllSessions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+	)
+
+	tests := []struct {
+		name                 string
+		err                  error
+		familyRepositoryMock familyRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.RevokeAllMock.Expect(ctx, userID, familyID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "repository error case",
+			err:  ErrSessionRevoke,
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.RevokeAllMock.Expect(ctx, userID, familyID).Return(errors.New("db error"))
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := NewService(
+				loggerMocks.NewMockLogger(),
+				nil,
+				nil,
+				tt.familyRepositoryMock(mc),
+				repositoryMocks.NewMfaRepositoryMock(mc),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				mfaConfig,
+				nil,
+				verificationConfig,
+				loginThrottleConfig,
+				nil,
+			)
+
+			err := srv.RevokeAllSessions(ctx, userID, familyID)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestLogoutAll(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		version = 3
+	)
+
+	tests := []struct {
+		name                 string
+		err                  error
+		userRepositoryMock   userRepositoryMockFunc
+		tokenRepositoryMock  tokenRepositoryMockFunc
+		familyRepositoryMock familyRepositoryMockFunc
+		auditRepositoryMock  auditRepositoryMockFunc
+		transactorMock       transactorMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.IncrementVersionMock.Expect(minimock.AnyContext, userID).Return(version, nil)
+				return mock
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				mock.SetTokenVersionMock.Expect(ctx, userID, version).Return(nil)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.RevokeAllMock.Expect(minimock.AnyContext, userID, "").Return(nil)
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Set(func(_ context.Context, event *model.AuditEvent) error {
+					require.Equal(mc, model.AuditActionLogoutAll, event.Action)
+					require.Equal(mc, userID, event.Target.ID)
+					return nil
+				})
+				return mock
+			},
+			transactorMock: transactorCommitMock,
+		},
+		{
+			name: "user not found case",
+			err:  ErrUserNotFound,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.IncrementVersionMock.Expect(minimock.AnyContext, userID).Return(0, userService.ErrUserNotFound)
+				return mock
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				return repositoryMocks.NewTokenRepositoryMock(mc)
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				return repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+			},
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			transactorMock:      transactorRollbackMock,
+		},
+		{
+			name: "revoke families error case",
+			err:  ErrLogoutFailed,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.IncrementVersionMock.Expect(minimock.AnyContext, userID).Return(version, nil)
+				return mock
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				return repositoryMocks.NewTokenRepositoryMock(mc)
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.RevokeAllMock.Expect(minimock.AnyContext, userID, "").Return(errors.New("db error"))
+				return mock
+			},
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			transactorMock:      transactorRollbackMock,
+		},
+		{
+			name: "stale version removed case",
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.IncrementVersionMock.Expect(minimock.AnyContext, userID).Return(version, nil)
+				return mock
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				mock.SetTokenVersionMock.Expect(ctx, userID, version).Return(errors.New("redis error"))
+				mock.DeleteTokenVersionMock.Expect(ctx, userID).Return(nil)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.RevokeAllMock.Expect(minimock.AnyContext, userID, "").Return(nil)
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Return(nil)
+				return mock
+			},
+			transactorMock: transactorCommitMock,
+		},
+		{
+			name: "cache error case",
+			err:  ErrLogoutFailed,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.IncrementVersionMock.Expect(minimock.AnyContext, userID).Return(version, nil)
+				return mock
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				mock.SetTokenVersionMock.Expect(ctx, userID, version).Return(errors.New("redis error"))
+				mock.DeleteTokenVersionMock.Expect(ctx, userID).Return(errors.New("redis error"))
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.RevokeAllMock.Expect(minimock.AnyContext, userID, "").Return(nil)
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Return(nil)
+				return mock
+			},
+			transactorMock: transactorCommitMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := NewService(
+				loggerMocks.NewMockLogger(),
+				tt.userRepositoryMock(mc),
+				tt.tokenRepositoryMock(mc),
+				tt.familyRepositoryMock(mc),
+				repositoryMocks.NewMfaRepositoryMock(mc),
+				nil,
+				nil,
+				nil,
+				nil,
+				tt.auditRepositoryMock(mc),
+				nil,
+				nil,
+				nil,
+				transaction.NewTransactionManager(tt.transactorMock(mc)),
+				mfaConfig,
+				nil,
+				verificationConfig,
+				loginThrottleConfig,
+				nil,
+			)
+
+			err := srv.LogoutAll(ctx, userID)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestCheckAccountStatus(t *testing.T) {
+	t.Parallel()
+
+	var (
+		past   = sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true}
+		future = sql.NullTime{Time: time.Now().Add(time.Minute), Valid: true}
+	)
+
+	require.NoError(t, checkAccountStatus(model.UserStatusActive, sql.NullTime{}))
+	require.Equal(t, ErrUserSuspended, checkAccountStatus(model.UserStatusSuspended, sql.NullTime{}))
+	require.Equal(t, ErrUserSuspended, checkAccountStatus(model.UserStatusSuspended, future))
+	require.NoError(t, checkAccountStatus(model.UserStatusSuspended, past))
+	require.Equal(t, ErrUserDeleted, checkAccountStatus(model.UserStatusDeactivated, sql.NullTime{}))
+	require.Equal(t, ErrUserDeleted, checkAccountStatus(model.UserStatusDeleted, sql.NullTime{}))
+}
@@ -0,0 +1,447 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/8thgencore/microservice-common/pkg/logger/sl"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+
+	"github.com/8thgencore/microservice-auth/internal/audit"
+	"github.com/8thgencore/microservice-auth/internal/model"
+	userService "github.com/8thgencore/microservice-auth/internal/service/user"
+)
+
+// MFA errors
+var (
+	ErrMfaNotEnrolled    = errors.New("multi-factor authentication is not enrolled")
+	ErrMfaAlreadyEnabled = errors.New("multi-factor authentication is already enabled")
+	ErrInvalidMfaCode    = errors.New("invalid multi-factor authentication code")
+	ErrInvalidMfaToken   = errors.New("invalid or expired mfa token")
+	ErrMfaFailed         = errors.New("failed to process multi-factor authentication")
+	ErrMfaLocked         = errors.New("too many wrong multi-factor authentication codes, try again later")
+)
+
+const (
+	totpPeriod = 30
+	totpDigits = otp.DigitsSix
+	// totpSkew is the number of time steps a code is accepted before and after the current one.
+	totpSkew = 1
+
+	recoveryCodeCount = 10
+	recoveryCodeBytes = 10
+)
+
+// BeginTotpEnrollment generates a new TOTP secret for the user. It takes effect once confirmed.
+func (s *authService) BeginTotpEnrollment(ctx context.Context, userID string) (*model.TotpEnrollment, error) {
+	user, err := s.userRepository.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, userService.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		s.logger.Error("failed to get user", sl.Err(err))
+		return nil, ErrMfaFailed
+	}
+
+	current, err := s.mfaRepository.GetTotp(ctx, userID)
+	if err != nil && !errors.Is(err, ErrMfaNotEnrolled) {
+		s.logger.Error("failed to get totp", sl.Err(err))
+		return nil, ErrMfaFailed
+	}
+	if err == nil && current.ConfirmedAt.Valid {
+		return nil, ErrMfaAlreadyEnabled
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      s.mfaConfig.Issuer,
+		AccountName: user.Name,
+		Period:      totpPeriod,
+		Digits:      totpDigits,
+		Algorithm:   otp.AlgorithmSHA1,
+	})
+	if err != nil {
+		s.logger.Error("failed to generate totp secret", sl.Err(err))
+		return nil, ErrMfaFailed
+	}
+
+	if err = s.mfaRepository.SaveTotp(ctx, userID, key.Secret()); err != nil {
+		s.logger.Error("failed to save totp", sl.Err(err))
+		return nil, ErrMfaFailed
+	}
+
+	return &model.TotpEnrollment{
+		Secret: key.Secret(),
+		URI:    key.URL(),
+	}, nil
+}
+
+// ConfirmTotpEnrollment turns TOTP on once the user proves the authenticator app works
+// and returns the recovery codes. They are only stored hashed and never shown again.
+func (s *authService) ConfirmTotpEnrollment(ctx context.Context, userID, code string) ([]string, error) {
+	current, err := s.mfaRepository.GetTotp(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ErrMfaNotEnrolled) {
+			return nil, ErrMfaNotEnrolled
+		}
+
+		s.logger.Error("failed to get totp", sl.Err(err))
+		return nil, ErrMfaFailed
+	}
+	if current.ConfirmedAt.Valid {
+		return nil, ErrMfaAlreadyEnabled
+	}
+
+	if err = s.countMfaCode(ctx, userID); err != nil {
+		return nil, err
+	}
+	if err = s.verifyTotpCode(ctx, current, code); err != nil {
+		return nil, err
+	}
+	s.resetMfaFailures(ctx, mfaUserKey(userID))
+
+	codes, codeHashes, err := generateRecoveryCodes()
+	if err != nil {
+		s.logger.Error("failed to generate recovery codes", sl.Err(err))
+		return nil, ErrMfaFailed
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if errTx := s.mfaRepository.ConfirmTotp(ctx, userID); errTx != nil {
+			return errTx
+		}
+
+		if errTx := s.mfaRepository.ReplaceRecoveryCodes(ctx, userID, codeHashes); errTx != nil {
+			return errTx
+		}
+
+		return s.recordAudit(ctx, model.AuditActionMfaEnabled, userID, audit.Changes{}.Secret("totp"), nil)
+	})
+	if err != nil {
+		if errors.Is(err, ErrMfaNotEnrolled) {
+			return nil, ErrMfaNotEnrolled
+		}
+
+		s.logger.Error("failed to confirm totp", sl.Err(err))
+		return nil, ErrMfaFailed
+	}
+
+	return codes, nil
+}
+
+// DisableTotp turns TOTP off and removes the recovery codes.
+// An enabled TOTP can only be disabled with a valid TOTP or recovery code, the codes count towards
+// the limit of the codes of the user as on login.
+func (s *authService) DisableTotp(ctx context.Context, userID, code string) error {
+	current, err := s.mfaRepository.GetTotp(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ErrMfaNotEnrolled) {
+			return ErrMfaNotEnrolled
+		}
+
+		s.logger.Error("failed to get totp", sl.Err(err))
+		return ErrMfaFailed
+	}
+
+	if current.ConfirmedAt.Valid {
+		if err = s.countMfaCode(ctx, userID); err != nil {
+			return err
+		}
+		if err = s.verifySecondFactor(ctx, current, code); err != nil {
+			return err
+		}
+		s.resetMfaFailures(ctx, mfaUserKey(userID))
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if errTx := s.mfaRepository.DeleteTotp(ctx, userID); errTx != nil {
+			return errTx
+		}
+
+		if errTx := s.mfaRepository.DeleteRecoveryCodes(ctx, userID); errTx != nil {
+			return errTx
+		}
+
+		return s.recordAudit(ctx, model.AuditActionMfaDisabled, userID, audit.Changes{}.Secret("totp"), nil)
+	})
+	if err != nil {
+		s.logger.Error("failed to disable totp", sl.Err(err))
+		return ErrMfaFailed
+	}
+
+	return nil
+}
+
+// VerifyMfa exchanges the MFA challenge token of a login and a TOTP or recovery code for a token pair.
+// A challenge token can only be exchanged once and is revoked after too many wrong codes. The code is
+// checked before the challenge is consumed but only used after, so of concurrent exchanges the ones
+// losing the challenge use up no code.
+func (s *authService) VerifyMfa(
+	ctx context.Context,
+	mfaToken, code string,
+	client *model.ClientInfo,
+) (*model.TokenPair, error) {
+	claims, err := s.tokenOperations.VerifyMfaToken(mfaToken)
+	if err != nil {
+		return nil, ErrInvalidMfaToken
+	}
+
+	revoked, err := s.tokenRepository.IsTokenRevoked(ctx, mfaToken)
+	if err != nil || revoked {
+		return nil, ErrInvalidMfaToken
+	}
+
+	current, err := s.mfaRepository.GetTotp(ctx, claims.Subject)
+	if err != nil || !current.ConfirmedAt.Valid {
+		return nil, ErrInvalidMfaToken
+	}
+
+	if err = s.countMfaAttempt(ctx, mfaToken, claims); err != nil {
+		return nil, err
+	}
+
+	if err = s.checkSecondFactor(ctx, current, code); err != nil {
+		return nil, err
+	}
+
+	// Of concurrent exchanges of the challenge only the one revoking it goes on.
+	consumed, err := s.tokenRepository.RevokeToken(ctx, mfaToken)
+	if err != nil {
+		s.logger.Error("failed to revoke mfa token", sl.Err(err))
+		return nil, ErrMfaFailed
+	}
+	if !consumed {
+		return nil, ErrInvalidMfaToken
+	}
+
+	// The code may have been used since it was checked, by another use of the same code.
+	if err = s.verifySecondFactor(ctx, current, code); err != nil {
+		return nil, err
+	}
+
+	s.resetMfaFailures(ctx, mfaChallengeKey(claims.ID), mfaUserKey(claims.Subject))
+
+	user, err := s.userRepository.Get(ctx, claims.Subject)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	if err = checkAccountStatus(user.Status, user.SuspendedUntil); err != nil {
+		return nil, err
+	}
+
+	return s.issueTokenPair(ctx, model.User{
+		ID:      user.ID,
+		Name:    user.Name,
+		Roles:   user.Roles,
+		Version: user.Version,
+	}, client)
+}
+
+// countMfaAttempt counts a code presented for the challenge before it is checked, so concurrent guesses
+// can not get past the limits. The challenge is revoked once it had too many codes and the codes of the user
+// are refused as countMfaCode does. The counts are reset by a valid code.
+func (s *authService) countMfaAttempt(ctx context.Context, mfaToken string, claims *model.MfaClaims) error {
+	challengeFailures, err := s.loginAttemptRepository.AddFailure(ctx, mfaChallengeKey(claims.ID))
+	if err != nil {
+		s.logger.Error("failed to count mfa attempt", sl.Err(err))
+		return ErrMfaFailed
+	}
+	if challengeFailures.Count > s.mfaConfig.MaxChallengeFailures {
+		if err = s.tokenRepository.AddRevokedToken(ctx, mfaToken); err != nil {
+			s.logger.Error("failed to revoke mfa token", sl.Err(err))
+		}
+
+		return ErrInvalidMfaToken
+	}
+
+	return s.countMfaCode(ctx, claims.Subject)
+}
+
+// countMfaCode counts a code presented by the user before it is checked, on login as well as to enable
+// or disable TOTP. The codes of the user are refused once they had too many, until the lockout ends or
+// a valid code resets the count. Unlike the login throttling the check fails closed, a code is short enough
+// to be guessed.
+func (s *authService) countMfaCode(ctx context.Context, userID string) error {
+	failures, err := s.loginAttemptRepository.AddFailure(ctx, mfaUserKey(userID))
+	if err != nil {
+		s.logger.Error("failed to count mfa attempt", sl.Err(err))
+		return ErrMfaFailed
+	}
+	if failures.Count > s.mfaConfig.MaxFailures {
+		return &RetryError{Err: ErrMfaLocked, RetryAfter: s.loginThrottleConfig.LockoutDuration}
+	}
+
+	return nil
+}
+
+// resetMfaFailures forgets the codes counted under the keys once a valid code was presented.
+func (s *authService) resetMfaFailures(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if err := s.loginAttemptRepository.Reset(ctx, key); err != nil {
+			s.logger.Error("failed to reset mfa failures", sl.Err(err))
+		}
+	}
+}
+
+// mfaEnabled reports whether the user has to present a second factor on login.
+func (s *authService) mfaEnabled(ctx context.Context, userID string) (bool, error) {
+	current, err := s.mfaRepository.GetTotp(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ErrMfaNotEnrolled) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return current.ConfirmedAt.Valid, nil
+}
+
+// checkSecondFactor checks a TOTP code or a recovery code like verifySecondFactor, without using it.
+func (s *authService) checkSecondFactor(ctx context.Context, current *model.Totp, code string) error {
+	if isTotpCode(code) {
+		step, ok := matchTotpCode(current.Secret, code, time.Now())
+		if !ok || step <= current.LastUsedStep {
+			return ErrInvalidMfaCode
+		}
+
+		return nil
+	}
+
+	found, err := s.mfaRepository.HasRecoveryCode(ctx, current.UserID, hashRecoveryCode(code))
+	if err != nil {
+		s.logger.Error("failed to check recovery code", sl.Err(err))
+		return ErrMfaFailed
+	}
+	if !found {
+		return ErrInvalidMfaCode
+	}
+
+	return nil
+}
+
+// verifySecondFactor accepts either a TOTP code or an unused recovery code and uses it.
+func (s *authService) verifySecondFactor(ctx context.Context, current *model.Totp, code string) error {
+	if isTotpCode(code) {
+		return s.verifyTotpCode(ctx, current, code)
+	}
+
+	used, err := s.mfaRepository.UseRecoveryCode(ctx, current.UserID, hashRecoveryCode(code))
+	if err != nil {
+		s.logger.Error("failed to use recovery code", sl.Err(err))
+		return ErrMfaFailed
+	}
+	if !used {
+		return ErrInvalidMfaCode
+	}
+
+	return nil
+}
+
+// verifyTotpCode checks the code against the TOTP secret. A code is accepted only once.
+func (s *authService) verifyTotpCode(ctx context.Context, current *model.Totp, code string) error {
+	step, ok := matchTotpCode(current.Secret, code, time.Now())
+	if !ok {
+		return ErrInvalidMfaCode
+	}
+
+	used, err := s.mfaRepository.UseTotpStep(ctx, current.UserID, step)
+	if err != nil {
+		s.logger.Error("failed to use totp step", sl.Err(err))
+		return ErrMfaFailed
+	}
+	if !used {
+		return ErrInvalidMfaCode
+	}
+
+	return nil
+}
+
+// matchTotpCode returns the time step the code was generated for, allowing for clock skew.
+func matchTotpCode(secret, code string, now time.Time) (int64, bool) {
+	if !isTotpCode(code) {
+		return 0, false
+	}
+
+	opts := totp.ValidateOpts{
+		Period:    totpPeriod,
+		Digits:    totpDigits,
+		Algorithm: otp.AlgorithmSHA1,
+	}
+
+	current := now.Unix() / totpPeriod
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		expected, err := totp.GenerateCodeCustom(secret, time.Unix(step*totpPeriod, 0), opts)
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// isTotpCode reports whether the code looks like a TOTP code rather than a recovery code.
+func isTotpCode(code string) bool {
+	if len(code) != totpDigits.Length() {
+		return false
+	}
+
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// generateRecoveryCodes returns the recovery codes to show to the user and their hashes to store.
+// Codes carry 80 random bits, so a fast unsalted hash is enough to make them useless when leaked.
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	codeHashes := make([]string, 0, recoveryCodeCount)
+
+	for range recoveryCodeCount {
+		raw := make([]byte, recoveryCodeBytes)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, err
+		}
+
+		code := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(raw)
+		code = code[0:4] + "-" + code[4:8] + "-" + code[8:12] + "-" + code[12:16]
+
+		codes = append(codes, code)
+		codeHashes = append(codeHashes, hashRecoveryCode(code))
+	}
+
+	return codes, codeHashes, nil
+}
+
+// mfaChallengeKey returns the key the codes presented for a challenge are counted under.
+func mfaChallengeKey(challengeID string) string {
+	return "mfa_challenge:" + challengeID
+}
+
+// mfaUserKey returns the key the codes presented by a user are counted under.
+func mfaUserKey(userID string) string {
+	return "mfa:" + userID
+}
+
+// hashRecoveryCode hashes a recovery code ignoring case, dashes and spaces.
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+
+	return hex.EncodeToString(sum[:])
+}
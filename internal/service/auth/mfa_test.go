@@ -0,0 +1,910 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/8thgencore/microservice-common/pkg/db/transaction"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	repositoryMocks "github.com/8thgencore/microservice-auth/internal/repository/mocks"
+	userService "github.com/8thgencore/microservice-auth/internal/service/user"
+	"github.com/8thgencore/microservice-auth/internal/tokens"
+	tokenMocks "github.com/8thgencore/microservice-auth/internal/tokens/mocks"
+	loggerMocks "github.com/8thgencore/microservice-common/pkg/logger/mocks"
+)
+
+var recoveryCode = "ABCD-EFGH-IJKL-MNOP"
+
+// totpCode returns the current code of the test secret.
+func totpCode(t *testing.T) string {
+	t.Helper()
+
+	code, err := totp.GenerateCode(totpSecret, time.Now())
+	require.NoError(t, err)
+
+	return code
+}
+
+// mfaCodeCountedMock counts the code of the user as the given attempt, a valid code resets the count.
+func mfaCodeCountedMock(count int, valid bool) loginAttemptRepositoryMockFunc {
+	return func(mc *minimock.Controller) repository.LoginAttemptRepository {
+		mock := repositoryMocks.NewLoginAttemptRepositoryMock(mc)
+		mock.AddFailureMock.Expect(minimock.AnyContext, mfaUserKey(userID)).Return(&model.LoginFailures{Count: count}, nil)
+		if valid {
+			mock.ResetMock.Expect(minimock.AnyContext, mfaUserKey(userID)).Return(nil)
+		}
+		return mock
+	}
+}
+
+// useTotpStepMock accepts the time step of a current code.
+func useTotpStepMock(mc *minimock.Controller, mock *repositoryMocks.MfaRepositoryMock, used bool) {
+	mock.UseTotpStepMock.Set(func(_ context.Context, id string, step int64) (bool, error) {
+		require.Equal(mc, userID, id)
+		require.InDelta(mc, time.Now().Unix()/totpPeriod, step, totpSkew)
+		return used, nil
+	})
+}
+
+func TestBeginTotpEnrollment(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userModel = &model.User{ID: userID, Name: username}
+	)
+
+	tests := []struct {
+		name               string
+		err                error
+		userRepositoryMock userRepositoryMockFunc
+		mfaRepositoryMock  mfaRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, userID).Return(userModel, nil)
+				return mock
+			},
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(ctx, userID).Return(nil, ErrMfaNotEnrolled)
+				mock.SaveTotpMock.Set(func(_ context.Context, id, secret string) error {
+					require.Equal(mc, userID, id)
+					require.NotEmpty(mc, secret)
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "pending enrollment is replaced case",
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, userID).Return(userModel, nil)
+				return mock
+			},
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(ctx, userID).Return(pendingTotp, nil)
+				mock.SaveTotpMock.Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "already enabled case",
+			err:  ErrMfaAlreadyEnabled,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, userID).Return(userModel, nil)
+				return mock
+			},
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(ctx, userID).Return(confirmedTotp, nil)
+				return mock
+			},
+		},
+		{
+			name: "user not found case",
+			err:  ErrUserNotFound,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, userID).Return(nil, userService.ErrUserNotFound)
+				return mock
+			},
+			mfaRepositoryMock: emptyMfaRepositoryMock,
+		},
+		{
+			name: "save error case",
+			err:  ErrMfaFailed,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, userID).Return(userModel, nil)
+				return mock
+			},
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(ctx, userID).Return(nil, ErrMfaNotEnrolled)
+				mock.SaveTotpMock.Return(errors.New("db error"))
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := NewService(
+				loggerMocks.NewMockLogger(),
+				tt.userRepositoryMock(mc),
+				nil,
+				nil,
+				tt.mfaRepositoryMock(mc),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				mfaConfig,
+				nil,
+				verificationConfig,
+				loginThrottleConfig,
+				nil,
+			)
+
+			res, err := srv.BeginTotpEnrollment(ctx, userID)
+			require.Equal(t, tt.err, err)
+			if tt.err != nil {
+				require.Nil(t, res)
+				return
+			}
+
+			require.NotEmpty(t, res.Secret)
+			require.True(t, strings.HasPrefix(res.URI, "otpauth://totp/"))
+			require.Contains(t, res.URI, "issuer="+mfaConfig.Issuer)
+			require.Contains(t, res.URI, "secret="+res.Secret)
+		})
+	}
+}
+
+func TestConfirmTotpEnrollment(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		code = totpCode(t)
+	)
+
+	tests := []struct {
+		name                       string
+		code                       string
+		err                        error
+		mfaRepositoryMock          mfaRepositoryMockFunc
+		loginAttemptRepositoryMock loginAttemptRepositoryMockFunc
+		auditRepositoryMock        auditRepositoryMockFunc
+		transactorMock             transactorMockFunc
+	}{
+		{
+			name: "success case",
+			code: code,
+			err:  nil,
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(ctx, userID).Return(pendingTotp, nil)
+				useTotpStepMock(mc, mock, true)
+				mock.ConfirmTotpMock.Expect(minimock.AnyContext, userID).Return(nil)
+				mock.ReplaceRecoveryCodesMock.Set(func(_ context.Context, id string, codeHashes []string) error {
+					require.Equal(mc, userID, id)
+					require.Len(mc, codeHashes, recoveryCodeCount)
+					return nil
+				})
+				return mock
+			},
+			loginAttemptRepositoryMock: mfaCodeCountedMock(1, true),
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Return(nil)
+				return mock
+			},
+			transactorMock: transactorCommitMock,
+		},
+		{
+			name:                       "not enrolled case",
+			code:                       code,
+			err:                        ErrMfaNotEnrolled,
+			mfaRepositoryMock:          mfaNotEnrolledMock,
+			loginAttemptRepositoryMock: emptyLoginAttemptRepositoryMock,
+			auditRepositoryMock:        emptyAuditRepositoryMock,
+			transactorMock:             emptyTransactorMock,
+		},
+		{
+			name: "already enabled case",
+			code: code,
+			err:  ErrMfaAlreadyEnabled,
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(ctx, userID).Return(confirmedTotp, nil)
+				return mock
+			},
+			loginAttemptRepositoryMock: emptyLoginAttemptRepositoryMock,
+			auditRepositoryMock:        emptyAuditRepositoryMock,
+			transactorMock:             emptyTransactorMock,
+		},
+		{
+			name: "wrong code case",
+			code: "000000",
+			err:  ErrInvalidMfaCode,
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(ctx, userID).Return(&model.Totp{UserID: userID, Secret: "GEZDGNBVGY3TQOJQ"}, nil)
+				return mock
+			},
+			loginAttemptRepositoryMock: mfaCodeCountedMock(1, false),
+			auditRepositoryMock:        emptyAuditRepositoryMock,
+			transactorMock:             emptyTransactorMock,
+		},
+		{
+			name: "replayed code case",
+			code: code,
+			err:  ErrInvalidMfaCode,
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(ctx, userID).Return(pendingTotp, nil)
+				useTotpStepMock(mc, mock, false)
+				return mock
+			},
+			loginAttemptRepositoryMock: mfaCodeCountedMock(1, false),
+			auditRepositoryMock:        emptyAuditRepositoryMock,
+			transactorMock:             emptyTransactorMock,
+		},
+		{
+			name: "store recovery codes error case",
+			code: code,
+			err:  ErrMfaFailed,
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(ctx, userID).Return(pendingTotp, nil)
+				useTotpStepMock(mc, mock, true)
+				mock.ConfirmTotpMock.Expect(minimock.AnyContext, userID).Return(nil)
+				mock.ReplaceRecoveryCodesMock.Return(errors.New("db error"))
+				return mock
+			},
+			loginAttemptRepositoryMock: mfaCodeCountedMock(1, true),
+			auditRepositoryMock:        emptyAuditRepositoryMock,
+			transactorMock:             transactorRollbackMock,
+		},
+		{
+			name: "too many codes case",
+			code: code,
+			err:  &RetryError{Err: ErrMfaLocked, RetryAfter: loginThrottleConfig.LockoutDuration},
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(ctx, userID).Return(pendingTotp, nil)
+				return mock
+			},
+			loginAttemptRepositoryMock: mfaCodeCountedMock(11, false),
+			auditRepositoryMock:        emptyAuditRepositoryMock,
+			transactorMock:             emptyTransactorMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := NewService(
+				loggerMocks.NewMockLogger(),
+				nil,
+				nil,
+				nil,
+				tt.mfaRepositoryMock(mc),
+				nil,
+				nil,
+				nil,
+				tt.loginAttemptRepositoryMock(mc),
+				tt.auditRepositoryMock(mc),
+				nil,
+				nil,
+				nil,
+				transaction.NewTransactionManager(tt.transactorMock(mc)),
+				mfaConfig,
+				nil,
+				verificationConfig,
+				loginThrottleConfig,
+				nil,
+			)
+
+			codes, err := srv.ConfirmTotpEnrollment(ctx, userID, tt.code)
+			require.Equal(t, tt.err, err)
+			if tt.err != nil {
+				require.Nil(t, codes)
+				return
+			}
+
+			require.Len(t, codes, recoveryCodeCount)
+		})
+	}
+}
+
+func TestDisableTotp(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		code = totpCode(t)
+	)
+
+	deleteMock := func(mock *repositoryMocks.MfaRepositoryMock) {
+		mock.DeleteTotpMock.Expect(minimock.AnyContext, userID).Return(nil)
+		mock.DeleteRecoveryCodesMock.Expect(minimock.AnyContext, userID).Return(nil)
+	}
+
+	auditMock := func(mc *minimock.Controller) repository.AuditRepository {
+		mock := repositoryMocks.NewAuditRepositoryMock(mc)
+		mock.RecordMock.Return(nil)
+		return mock
+	}
+
+	tests := []struct {
+		name                       string
+		code                       string
+		err                        error
+		mfaRepositoryMock          mfaRepositoryMockFunc
+		loginAttemptRepositoryMock loginAttemptRepositoryMockFunc
+		auditRepositoryMock        auditRepositoryMockFunc
+		transactorMock             transactorMockFunc
+	}{
+		{
+			name: "totp code case",
+			code: code,
+			err:  nil,
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(ctx, userID).Return(confirmedTotp, nil)
+				useTotpStepMock(mc, mock, true)
+				deleteMock(mock)
+				return mock
+			},
+			loginAttemptRepositoryMock: mfaCodeCountedMock(1, true),
+			auditRepositoryMock:        auditMock,
+			transactorMock:             transactorCommitMock,
+		},
+		{
+			name: "recovery code case",
+			code: "abcd efgh ijkl mnop",
+			err:  nil,
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(ctx, userID).Return(confirmedTotp, nil)
+				mock.UseRecoveryCodeMock.Expect(ctx, userID, hashRecoveryCode(recoveryCode)).Return(true, nil)
+				deleteMock(mock)
+				return mock
+			},
+			loginAttemptRepositoryMock: mfaCodeCountedMock(1, true),
+			auditRepositoryMock:        auditMock,
+			transactorMock:             transactorCommitMock,
+		},
+		{
+			name: "pending enrollment case",
+			code: "000000",
+			err:  nil,
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(ctx, userID).Return(pendingTotp, nil)
+				deleteMock(mock)
+				return mock
+			},
+			loginAttemptRepositoryMock: emptyLoginAttemptRepositoryMock,
+			auditRepositoryMock:        auditMock,
+			transactorMock:             transactorCommitMock,
+		},
+		{
+			name: "used recovery code case",
+			code: recoveryCode,
+			err:  ErrInvalidMfaCode,
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(ctx, userID).Return(confirmedTotp, nil)
+				mock.UseRecoveryCodeMock.Expect(ctx, userID, hashRecoveryCode(recoveryCode)).Return(false, nil)
+				return mock
+			},
+			loginAttemptRepositoryMock: mfaCodeCountedMock(1, false),
+			auditRepositoryMock:        emptyAuditRepositoryMock,
+			transactorMock:             emptyTransactorMock,
+		},
+		{
+			name:                       "not enrolled case",
+			code:                       code,
+			err:                        ErrMfaNotEnrolled,
+			mfaRepositoryMock:          mfaNotEnrolledMock,
+			loginAttemptRepositoryMock: emptyLoginAttemptRepositoryMock,
+			auditRepositoryMock:        emptyAuditRepositoryMock,
+			transactorMock:             emptyTransactorMock,
+		},
+		{
+			name: "too many codes case",
+			code: code,
+			err:  &RetryError{Err: ErrMfaLocked, RetryAfter: loginThrottleConfig.LockoutDuration},
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(ctx, userID).Return(confirmedTotp, nil)
+				return mock
+			},
+			loginAttemptRepositoryMock: mfaCodeCountedMock(11, false),
+			auditRepositoryMock:        emptyAuditRepositoryMock,
+			transactorMock:             emptyTransactorMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := NewService(
+				loggerMocks.NewMockLogger(),
+				nil,
+				nil,
+				nil,
+				tt.mfaRepositoryMock(mc),
+				nil,
+				nil,
+				nil,
+				tt.loginAttemptRepositoryMock(mc),
+				tt.auditRepositoryMock(mc),
+				nil,
+				nil,
+				nil,
+				transaction.NewTransactionManager(tt.transactorMock(mc)),
+				mfaConfig,
+				nil,
+				verificationConfig,
+				loginThrottleConfig,
+				nil,
+			)
+
+			err := srv.DisableTotp(ctx, userID, tt.code)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestVerifyMfa(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		code = totpCode(t)
+
+		mfaClaims = &model.MfaClaims{}
+
+		userModel = &model.User{ID: userID, Name: username, Roles: roles}
+
+		res = &model.TokenPair{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+		}
+	)
+	mfaClaims.ID = "challenge_uuid"
+	mfaClaims.Subject = userID
+
+	challengeKey := mfaChallengeKey(mfaClaims.ID)
+	userKey := mfaUserKey(userID)
+
+	// mfaAttemptsMock counts the code as the given attempt of the challenge and of the user.
+	mfaAttemptsMock := func(challengeCount, userCount int) loginAttemptRepositoryMockFunc {
+		return func(mc *minimock.Controller) repository.LoginAttemptRepository {
+			mock := repositoryMocks.NewLoginAttemptRepositoryMock(mc)
+			mock.AddFailureMock.When(ctx, challengeKey).Then(&model.LoginFailures{Count: challengeCount}, nil)
+			mock.AddFailureMock.When(ctx, userKey).Then(&model.LoginFailures{Count: userCount}, nil)
+			return mock
+		}
+	}
+
+	verifyMfaTokenMock := func(mc *minimock.Controller) *tokenMocks.TokenOperationsMock {
+		mock := tokenMocks.NewTokenOperationsMock(mc)
+		mock.VerifyMfaTokenMock.Expect(mfaToken).Return(mfaClaims, nil)
+		return mock
+	}
+
+	tests := []struct {
+		name                       string
+		code                       string
+		want                       *model.TokenPair
+		err                        error
+		userRepositoryMock         userRepositoryMockFunc
+		tokenRepositoryMock        tokenRepositoryMockFunc
+		familyRepositoryMock       familyRepositoryMockFunc
+		mfaRepositoryMock          mfaRepositoryMockFunc
+		loginAttemptRepositoryMock loginAttemptRepositoryMockFunc
+		tokenOperationsMock        tokenOperationsMockFunc
+	}{
+		{
+			name: "success case",
+			code: code,
+			want: res,
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, userID).Return(userModel, nil)
+				return mock
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				mock.IsTokenRevokedMock.Expect(ctx, mfaToken).Return(false, nil)
+				mock.RevokeTokenMock.Expect(ctx, mfaToken).Return(true, nil)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.CreateMock.Set(func(_ context.Context, family *model.TokenFamily) error {
+					require.Equal(mc, userID, family.UserID)
+					require.Equal(mc, client.IPAddress, family.IPAddress)
+					return nil
+				})
+				return mock
+			},
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(ctx, userID).Return(confirmedTotp, nil)
+				useTotpStepMock(mc, mock, true)
+				return mock
+			},
+			loginAttemptRepositoryMock: func(mc *minimock.Controller) repository.LoginAttemptRepository {
+				mock := repositoryMocks.NewLoginAttemptRepositoryMock(mc)
+				mock.AddFailureMock.When(ctx, challengeKey).Then(&model.LoginFailures{Count: 2}, nil)
+				mock.AddFailureMock.When(ctx, userKey).Then(&model.LoginFailures{Count: 4}, nil)
+				mock.ResetMock.When(ctx, challengeKey).Then(nil)
+				mock.ResetMock.When(ctx, userKey).Then(nil)
+				return mock
+			},
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := verifyMfaTokenMock(mc)
+				mock.GenerateRefreshTokenMock.ExpectUserIDParam1(userID).Return(refreshToken, nil)
+				mock.GenerateAccessTokenMock.Set(func(u model.User, sessionID string) (string, error) {
+					require.Equal(mc, user, u)
+					require.NotEmpty(mc, sessionID)
+					return accessToken, nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "invalid token case",
+			code: code,
+			want: nil,
+			err:  ErrInvalidMfaToken,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				return repositoryMocks.NewTokenRepositoryMock(mc)
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				return repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+			},
+			mfaRepositoryMock:          emptyMfaRepositoryMock,
+			loginAttemptRepositoryMock: emptyLoginAttemptRepositoryMock,
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.VerifyMfaTokenMock.Expect(mfaToken).Return(nil, errors.New("expired"))
+				return mock
+			},
+		},
+		{
+			name: "already exchanged token case",
+			code: code,
+			want: nil,
+			err:  ErrInvalidMfaToken,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				mock.IsTokenRevokedMock.Expect(ctx, mfaToken).Return(true, nil)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				return repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+			},
+			mfaRepositoryMock:          emptyMfaRepositoryMock,
+			loginAttemptRepositoryMock: emptyLoginAttemptRepositoryMock,
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				return verifyMfaTokenMock(mc)
+			},
+		},
+		{
+			name: "mfa disabled meanwhile case",
+			code: code,
+			want: nil,
+			err:  ErrInvalidMfaToken,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				mock.IsTokenRevokedMock.Expect(ctx, mfaToken).Return(false, nil)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				return repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+			},
+			mfaRepositoryMock:          mfaNotEnrolledMock,
+			loginAttemptRepositoryMock: emptyLoginAttemptRepositoryMock,
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				return verifyMfaTokenMock(mc)
+			},
+		},
+		{
+			name: "wrong code case",
+			code: "NOT-A-RECOVERY-CODE",
+			want: nil,
+			err:  ErrInvalidMfaCode,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				mock.IsTokenRevokedMock.Expect(ctx, mfaToken).Return(false, nil)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				return repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+			},
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(ctx, userID).Return(confirmedTotp, nil)
+				mock.HasRecoveryCodeMock.Expect(ctx, userID, hashRecoveryCode("NOT-A-RECOVERY-CODE")).Return(false, nil)
+				return mock
+			},
+			loginAttemptRepositoryMock: mfaAttemptsMock(1, 1),
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				return verifyMfaTokenMock(mc)
+			},
+		},
+		{
+			name: "concurrently exchanged token case",
+			code: code,
+			want: nil,
+			err:  ErrInvalidMfaToken,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				mock.IsTokenRevokedMock.Expect(ctx, mfaToken).Return(false, nil)
+				mock.RevokeTokenMock.Expect(ctx, mfaToken).Return(false, nil)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				return repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+			},
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				// The code of a request losing the challenge is not used.
+				mock.GetTotpMock.Expect(ctx, userID).Return(confirmedTotp, nil)
+				return mock
+			},
+			loginAttemptRepositoryMock: mfaAttemptsMock(1, 1),
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				return verifyMfaTokenMock(mc)
+			},
+		},
+		{
+			name: "concurrently exchanged with a recovery code case",
+			code: recoveryCode,
+			want: nil,
+			err:  ErrInvalidMfaToken,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				mock.IsTokenRevokedMock.Expect(ctx, mfaToken).Return(false, nil)
+				mock.RevokeTokenMock.Expect(ctx, mfaToken).Return(false, nil)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				return repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+			},
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				// The recovery code is checked but not used up.
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(ctx, userID).Return(confirmedTotp, nil)
+				mock.HasRecoveryCodeMock.Expect(ctx, userID, hashRecoveryCode(recoveryCode)).Return(true, nil)
+				return mock
+			},
+			loginAttemptRepositoryMock: mfaAttemptsMock(1, 1),
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				return verifyMfaTokenMock(mc)
+			},
+		},
+		{
+			name: "code used meanwhile case",
+			code: code,
+			want: nil,
+			err:  ErrInvalidMfaCode,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				mock.IsTokenRevokedMock.Expect(ctx, mfaToken).Return(false, nil)
+				mock.RevokeTokenMock.Expect(ctx, mfaToken).Return(true, nil)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				return repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+			},
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(ctx, userID).Return(confirmedTotp, nil)
+				useTotpStepMock(mc, mock, false)
+				return mock
+			},
+			loginAttemptRepositoryMock: mfaAttemptsMock(1, 1),
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				return verifyMfaTokenMock(mc)
+			},
+		},
+		{
+			name: "too many codes for the challenge case",
+			code: code,
+			want: nil,
+			err:  ErrInvalidMfaToken,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				mock.IsTokenRevokedMock.Expect(ctx, mfaToken).Return(false, nil)
+				mock.AddRevokedTokenMock.Expect(ctx, mfaToken).Return(nil)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				return repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+			},
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(ctx, userID).Return(confirmedTotp, nil)
+				return mock
+			},
+			loginAttemptRepositoryMock: func(mc *minimock.Controller) repository.LoginAttemptRepository {
+				mock := repositoryMocks.NewLoginAttemptRepositoryMock(mc)
+				mock.AddFailureMock.Expect(ctx, challengeKey).Return(&model.LoginFailures{Count: 4}, nil)
+				return mock
+			},
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				return verifyMfaTokenMock(mc)
+			},
+		},
+		{
+			name: "too many codes of the user case",
+			code: code,
+			want: nil,
+			err:  &RetryError{Err: ErrMfaLocked, RetryAfter: loginThrottleConfig.LockoutDuration},
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				mock.IsTokenRevokedMock.Expect(ctx, mfaToken).Return(false, nil)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				return repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+			},
+			mfaRepositoryMock: func(mc *minimock.Controller) repository.MfaRepository {
+				mock := repositoryMocks.NewMfaRepositoryMock(mc)
+				mock.GetTotpMock.Expect(ctx, userID).Return(confirmedTotp, nil)
+				return mock
+			},
+			loginAttemptRepositoryMock: mfaAttemptsMock(1, 11),
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				return verifyMfaTokenMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := NewService(
+				loggerMocks.NewMockLogger(),
+				tt.userRepositoryMock(mc),
+				tt.tokenRepositoryMock(mc),
+				tt.familyRepositoryMock(mc),
+				tt.mfaRepositoryMock(mc),
+				nil,
+				nil,
+				nil,
+				tt.loginAttemptRepositoryMock(mc),
+				signInAuditedMock(mc),
+				tt.tokenOperationsMock(mc),
+				nil,
+				nil,
+				nil,
+				mfaConfig,
+				nil,
+				verificationConfig,
+				loginThrottleConfig,
+				nil,
+			)
+
+			res, err := srv.VerifyMfa(ctx, mfaToken, tt.code, client)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestMatchTotpCode(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_000_000, 0)
+	step := now.Unix() / totpPeriod
+
+	codeAt := func(at time.Time) string {
+		code, err := totp.GenerateCode(totpSecret, at)
+		require.NoError(t, err)
+		return code
+	}
+
+	got, ok := matchTotpCode(totpSecret, codeAt(now), now)
+	require.True(t, ok)
+	require.Equal(t, step, got)
+
+	// Codes of the adjacent time steps are accepted for clock skew.
+	got, ok = matchTotpCode(totpSecret, codeAt(now.Add(-totpPeriod*time.Second)), now)
+	require.True(t, ok)
+	require.Equal(t, step-1, got)
+
+	_, ok = matchTotpCode(totpSecret, codeAt(now.Add(-2*totpPeriod*time.Second)), now)
+	require.False(t, ok)
+	_, ok = matchTotpCode(totpSecret, "12345", now)
+	require.False(t, ok)
+}
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	t.Parallel()
+
+	codes, codeHashes, err := generateRecoveryCodes()
+	require.NoError(t, err)
+	require.Len(t, codes, recoveryCodeCount)
+
+	seen := make(map[string]struct{}, len(codes))
+	for i, code := range codes {
+		require.Regexp(t, `^[A-Z2-7]{4}(-[A-Z2-7]{4}){3}$`, code)
+		require.Equal(t, hashRecoveryCode(code), codeHashes[i])
+		require.Equal(t, codeHashes[i], hashRecoveryCode(strings.ToLower(strings.ReplaceAll(code, "-", ""))))
+		require.False(t, isTotpCode(code))
+
+		seen[code] = struct{}{}
+	}
+	require.Len(t, seen, recoveryCodeCount)
+}
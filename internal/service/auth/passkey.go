@@ -0,0 +1,315 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"log/slog"
+
+	"github.com/8thgencore/microservice-common/pkg/logger/sl"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	userService "github.com/8thgencore/microservice-auth/internal/service/user"
+)
+
+// Passkey errors
+var (
+	ErrInvalidPasskeyCeremony = errors.New("invalid or expired passkey ceremony")
+	ErrInvalidPasskey         = errors.New("invalid passkey")
+	ErrPasskeyFailed          = errors.New("failed to process passkey")
+)
+
+// BeginPasskeyRegistration starts the registration of a passkey for the user.
+// Passkeys must be discoverable and verify the user, so that they replace the password and the second factor.
+func (s *authService) BeginPasskeyRegistration(ctx context.Context, userID string) (*model.PasskeyChallenge, error) {
+	user, err := s.passkeyUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	creation, session, err := s.webAuthn.BeginRegistration(user,
+		webauthn.WithAuthenticatorSelection(protocol.AuthenticatorSelection{
+			ResidentKey:      protocol.ResidentKeyRequirementRequired,
+			UserVerification: protocol.VerificationRequired,
+		}),
+		webauthn.WithExclusions(webauthn.Credentials(user.credentials).CredentialDescriptors()),
+	)
+	if err != nil {
+		s.logger.Error("failed to begin passkey registration", sl.Err(err))
+		return nil, ErrPasskeyFailed
+	}
+
+	return s.startPasskeyCeremony(ctx, userID, creation, session)
+}
+
+// FinishPasskeyRegistration verifies the attestation of the authenticator and stores the new passkey.
+func (s *authService) FinishPasskeyRegistration(
+	ctx context.Context,
+	userID, ceremonyID string,
+	credential []byte,
+) error {
+	session, err := s.takePasskeyCeremony(ctx, ceremonyID, userID)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(credential)
+	if err != nil {
+		return ErrInvalidPasskey
+	}
+
+	user, err := s.passkeyUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	created, err := s.webAuthn.CreateCredential(user, *session, parsed)
+	if err != nil {
+		return ErrInvalidPasskey
+	}
+
+	transports := make([]string, 0, len(created.Transport))
+	for _, transport := range created.Transport {
+		transports = append(transports, string(transport))
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.passkeyRepository.Create(ctx, &model.Passkey{
+			ID:              created.ID,
+			UserID:          userID,
+			PublicKey:       created.PublicKey,
+			AttestationType: created.AttestationType,
+			Transports:      transports,
+			AAGUID:          created.Authenticator.AAGUID,
+			SignCount:       created.Authenticator.SignCount,
+			BackupEligible:  created.Flags.BackupEligible,
+			BackupState:     created.Flags.BackupState,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		metadata := map[string]string{"credential_id": base64.RawURLEncoding.EncodeToString(created.ID)}
+
+		return s.recordAudit(ctx, model.AuditActionPasskeyRegistered, userID, nil, metadata)
+	})
+	if err != nil {
+		s.logger.Error("failed to save passkey", sl.Err(err))
+		return ErrPasskeyFailed
+	}
+
+	return nil
+}
+
+// BeginPasskeyLogin starts a passwordless login. The authenticator picks the passkey and with it the user.
+func (s *authService) BeginPasskeyLogin(ctx context.Context) (*model.PasskeyChallenge, error) {
+	assertion, session, err := s.webAuthn.BeginDiscoverableLogin(
+		webauthn.WithUserVerification(protocol.VerificationRequired),
+	)
+	if err != nil {
+		s.logger.Error("failed to begin passkey login", sl.Err(err))
+		return nil, ErrPasskeyFailed
+	}
+
+	return s.startPasskeyCeremony(ctx, "", assertion, session)
+}
+
+// FinishPasskeyLogin verifies the assertion of the authenticator and returns a token pair.
+// An assertion of an authenticator whose signature counter fell behind is rejected as it may be cloned.
+func (s *authService) FinishPasskeyLogin(
+	ctx context.Context,
+	ceremonyID string,
+	credential []byte,
+	client *model.ClientInfo,
+) (*model.TokenPair, error) {
+	session, err := s.takePasskeyCeremony(ctx, ceremonyID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(credential)
+	if err != nil {
+		return nil, ErrInvalidPasskey
+	}
+
+	var user *passkeyUser
+	_, used, err := s.webAuthn.ValidatePasskeyLogin(
+		func(_, userHandle []byte) (webauthn.User, error) {
+			user, err = s.passkeyUser(ctx, string(userHandle))
+			return user, err
+		},
+		*session,
+		parsed,
+	)
+	if err != nil {
+		return nil, ErrInvalidPasskey
+	}
+
+	if used.Authenticator.CloneWarning {
+		s.logger.Warn("possibly cloned passkey detected",
+			slog.String("user_id", user.ID),
+			slog.Uint64("sign_count", uint64(used.Authenticator.SignCount)),
+		)
+		return nil, ErrInvalidPasskey
+	}
+
+	if err = checkAccountStatus(user.Status, user.SuspendedUntil); err != nil {
+		return nil, err
+	}
+	if s.verificationConfig.Required && !user.EmailVerified {
+		return nil, ErrEmailNotVerified
+	}
+
+	ok, err := s.passkeyRepository.Use(ctx, used.ID, used.Authenticator.SignCount, used.Flags.BackupState)
+	if err != nil {
+		s.logger.Error("failed to use passkey", sl.Err(err))
+		return nil, ErrPasskeyFailed
+	}
+	if !ok {
+		return nil, ErrInvalidPasskey
+	}
+
+	return s.issueTokenPair(ctx, model.User{
+		ID:      user.ID,
+		Name:    user.Name,
+		Roles:   user.Roles,
+		Version: user.Version,
+	}, client)
+}
+
+// startPasskeyCeremony keeps the session data of a ceremony and returns the options for the authenticator.
+func (s *authService) startPasskeyCeremony(
+	ctx context.Context,
+	userID string,
+	options any,
+	session *webauthn.SessionData,
+) (*model.PasskeyChallenge, error) {
+	optionsJSON, err := json.Marshal(options)
+	if err != nil {
+		s.logger.Error("failed to encode passkey options", sl.Err(err))
+		return nil, ErrPasskeyFailed
+	}
+
+	sessionJSON, err := json.Marshal(session)
+	if err != nil {
+		s.logger.Error("failed to encode passkey session", sl.Err(err))
+		return nil, ErrPasskeyFailed
+	}
+
+	ceremonyID := uuid.NewString()
+	err = s.ceremonyRepository.Save(ctx, ceremonyID, &model.PasskeyCeremony{
+		UserID:  userID,
+		Session: sessionJSON,
+	})
+	if err != nil {
+		s.logger.Error("failed to save passkey ceremony", sl.Err(err))
+		return nil, ErrPasskeyFailed
+	}
+
+	return &model.PasskeyChallenge{
+		CeremonyID: ceremonyID,
+		Options:    optionsJSON,
+	}, nil
+}
+
+// takePasskeyCeremony returns the session data of a ceremony started for the user, or for a login if userID is empty.
+// A ceremony can only be finished once.
+func (s *authService) takePasskeyCeremony(
+	ctx context.Context,
+	ceremonyID, userID string,
+) (*webauthn.SessionData, error) {
+	ceremony, err := s.ceremonyRepository.Take(ctx, ceremonyID)
+	if err != nil {
+		if errors.Is(err, ErrInvalidPasskeyCeremony) {
+			return nil, ErrInvalidPasskeyCeremony
+		}
+
+		s.logger.Error("failed to take passkey ceremony", sl.Err(err))
+		return nil, ErrPasskeyFailed
+	}
+	if ceremony.UserID != userID {
+		return nil, ErrInvalidPasskeyCeremony
+	}
+
+	var session webauthn.SessionData
+	if err = json.Unmarshal(ceremony.Session, &session); err != nil {
+		s.logger.Error("failed to decode passkey session", sl.Err(err))
+		return nil, ErrPasskeyFailed
+	}
+
+	return &session, nil
+}
+
+// passkeyUser returns the user together with their passkeys.
+func (s *authService) passkeyUser(ctx context.Context, userID string) (*passkeyUser, error) {
+	user, err := s.userRepository.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, userService.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		s.logger.Error("failed to get user", sl.Err(err))
+		return nil, ErrPasskeyFailed
+	}
+
+	passkeys, err := s.passkeyRepository.ListByUser(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to list passkeys", sl.Err(err))
+		return nil, ErrPasskeyFailed
+	}
+
+	return newPasskeyUser(user, passkeys), nil
+}
+
+// passkeyUser is a user as seen by WebAuthn. The user ID is the user handle stored in the passkeys.
+type passkeyUser struct {
+	*model.User
+	credentials []webauthn.Credential
+}
+
+func newPasskeyUser(user *model.User, passkeys []*model.Passkey) *passkeyUser {
+	credentials := make([]webauthn.Credential, 0, len(passkeys))
+	for _, passkey := range passkeys {
+		transports := make([]protocol.AuthenticatorTransport, 0, len(passkey.Transports))
+		for _, transport := range passkey.Transports {
+			transports = append(transports, protocol.AuthenticatorTransport(transport))
+		}
+
+		credentials = append(credentials, webauthn.Credential{
+			ID:              passkey.ID,
+			PublicKey:       passkey.PublicKey,
+			AttestationType: passkey.AttestationType,
+			Transport:       transports,
+			Flags: webauthn.CredentialFlags{
+				BackupEligible: passkey.BackupEligible,
+				BackupState:    passkey.BackupState,
+			},
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    passkey.AAGUID,
+				SignCount: passkey.SignCount,
+			},
+		})
+	}
+
+	return &passkeyUser{User: user, credentials: credentials}
+}
+
+func (u *passkeyUser) WebAuthnID() []byte {
+	return []byte(u.ID)
+}
+
+func (u *passkeyUser) WebAuthnName() string {
+	return u.Name
+}
+
+func (u *passkeyUser) WebAuthnDisplayName() string {
+	return u.Name
+}
+
+func (u *passkeyUser) WebAuthnCredentials() []webauthn.Credential {
+	return u.credentials
+}
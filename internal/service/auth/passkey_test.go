@@ -0,0 +1,230 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/descope/virtualwebauthn"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	repositoryMocks "github.com/8thgencore/microservice-auth/internal/repository/mocks"
+	tokenMocks "github.com/8thgencore/microservice-auth/internal/tokens/mocks"
+	"github.com/8thgencore/microservice-common/pkg/db/transaction"
+	loggerMocks "github.com/8thgencore/microservice-common/pkg/logger/mocks"
+)
+
+var relyingParty = virtualwebauthn.RelyingParty{
+	ID:     "example.com",
+	Name:   "auth",
+	Origin: "https://example.com",
+}
+
+// passkeyStore keeps the pending ceremonies and the passkeys in memory.
+type passkeyStore struct {
+	ceremonies map[string]*model.PasskeyCeremony
+	passkeys   []*model.Passkey
+}
+
+func newPasskeyService(t *testing.T, store *passkeyStore) *authService {
+	t.Helper()
+
+	mc := minimock.NewController(t)
+
+	webAuthn, err := webauthn.New(&webauthn.Config{
+		RPID:          relyingParty.ID,
+		RPDisplayName: relyingParty.Name,
+		RPOrigins:     []string{relyingParty.Origin},
+	})
+	require.NoError(t, err)
+
+	userRepositoryMock := repositoryMocks.NewUserRepositoryMock(mc)
+	userRepositoryMock.GetMock.Set(func(_ context.Context, id string) (*model.User, error) {
+		if id != userID {
+			return nil, ErrUserNotFound
+		}
+		return &model.User{ID: userID, Name: username, Roles: roles, EmailVerified: true}, nil
+	})
+
+	passkeyRepositoryMock := repositoryMocks.NewPasskeyRepositoryMock(mc)
+	passkeyRepositoryMock.CreateMock.Set(func(_ context.Context, passkey *model.Passkey) error {
+		store.passkeys = append(store.passkeys, passkey)
+		return nil
+	})
+	passkeyRepositoryMock.ListByUserMock.Set(func(_ context.Context, id string) ([]*model.Passkey, error) {
+		var passkeys []*model.Passkey
+		for _, passkey := range store.passkeys {
+			if passkey.UserID == id {
+				passkeys = append(passkeys, passkey)
+			}
+		}
+		return passkeys, nil
+	})
+	passkeyRepositoryMock.UseMock.Set(func(_ context.Context, id []byte, signCount uint32, _ bool) (bool, error) {
+		for _, passkey := range store.passkeys {
+			if bytes.Equal(passkey.ID, id) && passkey.SignCount < signCount {
+				passkey.SignCount = signCount
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+
+	ceremonyRepositoryMock := repositoryMocks.NewPasskeyCeremonyRepositoryMock(mc)
+	ceremonyRepositoryMock.SaveMock.Set(func(_ context.Context, id string, ceremony *model.PasskeyCeremony) error {
+		store.ceremonies[id] = ceremony
+		return nil
+	})
+	ceremonyRepositoryMock.TakeMock.Set(func(_ context.Context, id string) (*model.PasskeyCeremony, error) {
+		ceremony, ok := store.ceremonies[id]
+		if !ok {
+			return nil, ErrInvalidPasskeyCeremony
+		}
+		delete(store.ceremonies, id)
+		return ceremony, nil
+	})
+
+	familyRepositoryMock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+	familyRepositoryMock.CreateMock.Set(func(_ context.Context, family *model.TokenFamily) error {
+		require.Equal(mc, userID, family.UserID)
+		return nil
+	})
+
+	auditRepositoryMock := repositoryMocks.NewAuditRepositoryMock(mc)
+	auditRepositoryMock.RecordMock.Set(func(_ context.Context, event *model.AuditEvent) error {
+		require.Contains(mc, []model.AuditAction{model.AuditActionPasskeyRegistered, model.AuditActionLogin}, event.Action)
+		require.Equal(mc, userID, event.Target.ID)
+		return nil
+	})
+
+	tokenOperationsMock := tokenMocks.NewTokenOperationsMock(mc)
+	tokenOperationsMock.GenerateRefreshTokenMock.Return(refreshToken, nil)
+	tokenOperationsMock.GenerateAccessTokenMock.Return(accessToken, nil)
+
+	return NewService(
+		loggerMocks.NewMockLogger(),
+		userRepositoryMock,
+		nil,
+		familyRepositoryMock,
+		nil,
+		passkeyRepositoryMock,
+		ceremonyRepositoryMock,
+		nil,
+		nil,
+		auditRepositoryMock,
+		tokenOperationsMock,
+		nil,
+		nil,
+		transaction.NewTransactionManager(transactorCommitMock(mc)),
+		mfaConfig,
+		nil,
+		verificationConfig,
+		loginThrottleConfig,
+		webAuthn,
+	).(*authService)
+}
+
+func TestPasskey(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx   = context.Background()
+		store = &passkeyStore{ceremonies: map[string]*model.PasskeyCeremony{}}
+		srv   = newPasskeyService(t, store)
+
+		authenticator = virtualwebauthn.NewAuthenticatorWithOptions(virtualwebauthn.AuthenticatorOptions{
+			UserHandle: []byte(userID),
+		})
+		credential = virtualwebauthn.NewCredential(virtualwebauthn.KeyTypeEC2)
+	)
+
+	// register creates a passkey with the software authenticator.
+	register := func(t *testing.T) (string, string) {
+		challenge, err := srv.BeginPasskeyRegistration(ctx, userID)
+		require.NoError(t, err)
+
+		options, err := virtualwebauthn.ParseAttestationOptions(string(challenge.Options))
+		require.NoError(t, err)
+		require.Equal(t, userID, options.UserID)
+
+		return challenge.CeremonyID, virtualwebauthn.CreateAttestationResponse(
+			relyingParty, authenticator, credential, *options,
+		)
+	}
+
+	// assert signs a login challenge with the software authenticator.
+	assert := func(t *testing.T, rp virtualwebauthn.RelyingParty) (string, string) {
+		challenge, err := srv.BeginPasskeyLogin(ctx)
+		require.NoError(t, err)
+
+		options, err := virtualwebauthn.ParseAssertionOptions(string(challenge.Options))
+		require.NoError(t, err)
+		require.Empty(t, options.AllowCredentials)
+
+		return challenge.CeremonyID, virtualwebauthn.CreateAssertionResponse(rp, authenticator, credential, *options)
+	}
+
+	t.Run("registration", func(t *testing.T) {
+		ceremonyID, attestation := register(t)
+
+		err := srv.FinishPasskeyRegistration(ctx, "other_uuid", ceremonyID, []byte(attestation))
+		require.Equal(t, ErrInvalidPasskeyCeremony, err)
+
+		ceremonyID, attestation = register(t)
+
+		err = srv.FinishPasskeyRegistration(ctx, userID, ceremonyID, []byte(attestation))
+		require.NoError(t, err)
+		require.Len(t, store.passkeys, 1)
+		require.Equal(t, credential.ID, store.passkeys[0].ID)
+		require.Equal(t, userID, store.passkeys[0].UserID)
+		authenticator.AddCredential(credential)
+
+		// A ceremony can only be finished once.
+		err = srv.FinishPasskeyRegistration(ctx, userID, ceremonyID, []byte(attestation))
+		require.Equal(t, ErrInvalidPasskeyCeremony, err)
+	})
+
+	t.Run("login", func(t *testing.T) {
+		credential.Counter++
+		ceremonyID, assertion := assert(t, relyingParty)
+
+		res, err := srv.FinishPasskeyLogin(ctx, ceremonyID, []byte(assertion), client)
+		require.NoError(t, err)
+		require.Equal(t, &model.TokenPair{AccessToken: accessToken, RefreshToken: refreshToken}, res)
+		require.Equal(t, credential.Counter, store.passkeys[0].SignCount)
+
+		_, err = srv.FinishPasskeyLogin(ctx, ceremonyID, []byte(assertion), client)
+		require.Equal(t, ErrInvalidPasskeyCeremony, err)
+	})
+
+	t.Run("cloned authenticator", func(t *testing.T) {
+		ceremonyID, assertion := assert(t, relyingParty)
+
+		_, err := srv.FinishPasskeyLogin(ctx, ceremonyID, []byte(assertion), client)
+		require.Equal(t, ErrInvalidPasskey, err)
+	})
+
+	t.Run("other origin", func(t *testing.T) {
+		credential.Counter++
+		ceremonyID, assertion := assert(t, virtualwebauthn.RelyingParty{
+			ID:     relyingParty.ID,
+			Name:   relyingParty.Name,
+			Origin: "https://phishing.example.org",
+		})
+
+		_, err := srv.FinishPasskeyLogin(ctx, ceremonyID, []byte(assertion), client)
+		require.Equal(t, ErrInvalidPasskey, err)
+	})
+
+	t.Run("registration ceremony", func(t *testing.T) {
+		ceremonyID, _ := register(t)
+		credential.Counter++
+		_, assertion := assert(t, relyingParty)
+
+		_, err := srv.FinishPasskeyLogin(ctx, ceremonyID, []byte(assertion), client)
+		require.Equal(t, ErrInvalidPasskeyCeremony, err)
+	})
+}
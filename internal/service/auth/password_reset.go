@@ -0,0 +1,123 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/8thgencore/microservice-common/pkg/logger/sl"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/8thgencore/microservice-auth/internal/audit"
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/notifier"
+	"github.com/8thgencore/microservice-auth/internal/tokens"
+)
+
+// Password reset errors
+var (
+	ErrInvalidResetToken   = errors.New("invalid or expired password reset token")
+	ErrPasswordResetFailed = errors.New("failed to reset password")
+)
+
+// RequestPasswordReset issues a single-use reset token for the user with the email.
+// It succeeds whether or not there is such a user, so it cannot be used to find out registered emails.
+func (s *authService) RequestPasswordReset(ctx context.Context, email string) error {
+	user, err := s.userRepository.FindByEmail(ctx, email)
+	if err != nil {
+		s.logger.Error("failed to find user by email", sl.Err(err))
+		return ErrPasswordResetFailed
+	}
+	if user == nil {
+		return nil
+	}
+
+	token, tokenHash, err := tokens.GenerateOpaqueToken()
+	if err != nil {
+		s.logger.Error("failed to generate password reset token", sl.Err(err))
+		return nil
+	}
+
+	// The message is only sent if the token is saved.
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.passwordResetRepository.Create(
+			ctx,
+			user.ID,
+			tokenHash,
+			time.Now().Add(s.passwordResetConfig.TokenTTL),
+		)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.notificationService.Notify(ctx, user.Email, notifier.PasswordResetTemplate, "", notifier.PasswordResetData{
+			Name:             user.Name,
+			Link:             s.passwordResetConfig.URL + token,
+			ExpiresInMinutes: int(s.passwordResetConfig.TokenTTL.Minutes()),
+		})
+	})
+	if err != nil {
+		s.logger.Error("failed to request password reset", slog.String("user_id", user.ID), sl.Err(err))
+	}
+
+	return nil
+}
+
+// ResetPassword sets a new password with a reset token and signs the user out everywhere.
+// The token and every other outstanding token of the user can not be used again.
+func (s *authService) ResetPassword(ctx context.Context, token, newPassword string) error {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return ErrPasswordResetFailed
+	}
+
+	var (
+		userID  string
+		version int
+	)
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		userID, errTx = s.passwordResetRepository.Use(ctx, tokens.HashOpaqueToken(token))
+		if errTx != nil {
+			return errTx
+		}
+
+		if errTx = s.userRepository.UpdatePassword(ctx, userID, string(hashedPassword)); errTx != nil {
+			return errTx
+		}
+
+		if errTx = s.passwordResetRepository.DeleteByUser(ctx, userID); errTx != nil {
+			return errTx
+		}
+
+		version, errTx = s.userRepository.IncrementVersion(ctx, userID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if errTx = s.familyRepository.RevokeAll(ctx, userID, ""); errTx != nil {
+			return errTx
+		}
+
+		// The user resets their password without being signed in.
+		return s.recordAudit(audit.WithActor(ctx, userID), model.AuditActionPasswordReset, userID,
+			audit.Changes{}.Secret("password"), nil)
+	})
+	if err != nil {
+		if errors.Is(err, ErrInvalidResetToken) {
+			return ErrInvalidResetToken
+		}
+
+		s.logger.Error("failed to reset password", sl.Err(err))
+		return ErrPasswordResetFailed
+	}
+
+	// The tokens issued before the reset are revoked once their cached version is replaced.
+	if err = tokens.StoreVersion(ctx, s.tokenRepository, userID, version); err != nil {
+		s.logger.Error("failed to cache token version", sl.Err(err))
+		return ErrPasswordResetFailed
+	}
+
+	return nil
+}
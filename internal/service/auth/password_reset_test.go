@@ -0,0 +1,389 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/8thgencore/microservice-common/pkg/db/transaction"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/8thgencore/microservice-auth/internal/config"
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/notifier"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	repositoryMocks "github.com/8thgencore/microservice-auth/internal/repository/mocks"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
+	"github.com/8thgencore/microservice-auth/internal/tokens"
+	loggerMocks "github.com/8thgencore/microservice-common/pkg/logger/mocks"
+)
+
+var (
+	email      = "user@example.com"
+	resetToken = "reset_token"
+
+	passwordResetConfig = &config.PasswordResetConfig{
+		TokenTTL: 30 * time.Minute,
+		URL:      "http://localhost:8480/reset-password?token=",
+	}
+)
+
+type (
+	passwordResetRepositoryMockFunc func(mc *minimock.Controller) repository.PasswordResetRepository
+	notificationServiceMockFunc     func(mc *minimock.Controller) service.NotificationService
+)
+
+func TestRequestPasswordReset(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		emailUser = &model.User{ID: userID, Name: username, Email: email}
+		resetData = notifier.PasswordResetData{
+			Name:             username,
+			ExpiresInMinutes: 30,
+		}
+	)
+
+	tests := []struct {
+		name                        string
+		err                         error
+		userRepositoryMock          userRepositoryMockFunc
+		passwordResetRepositoryMock passwordResetRepositoryMockFunc
+		notificationServiceMock     notificationServiceMockFunc
+		transactorMock              transactorMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.FindByEmailMock.Expect(ctx, email).Return(emailUser, nil)
+				return mock
+			},
+			passwordResetRepositoryMock: func(mc *minimock.Controller) repository.PasswordResetRepository {
+				mock := repositoryMocks.NewPasswordResetRepositoryMock(mc)
+				mock.CreateMock.Set(func(_ context.Context, id, tokenHash string, expiresAt time.Time) error {
+					require.Equal(mc, userID, id)
+					require.Len(mc, tokenHash, 64)
+					require.WithinDuration(mc, time.Now().Add(passwordResetConfig.TokenTTL), expiresAt, time.Minute)
+					return nil
+				})
+				return mock
+			},
+			notificationServiceMock: func(mc *minimock.Controller) service.NotificationService {
+				mock := serviceMocks.NewNotificationServiceMock(mc)
+				mock.NotifyMock.Set(func(_ context.Context, recipient, template, locale string, data any) error {
+					require.Equal(mc, email, recipient)
+					require.Equal(mc, notifier.PasswordResetTemplate, template)
+					require.Empty(mc, locale)
+
+					got := data.(notifier.PasswordResetData)
+					require.True(mc, strings.HasPrefix(got.Link, passwordResetConfig.URL))
+					got.Link = ""
+					require.Equal(mc, resetData, got)
+					return nil
+				})
+				return mock
+			},
+			transactorMock: transactorCommitMock,
+		},
+		{
+			name: "unknown email case",
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.FindByEmailMock.Expect(ctx, email).Return(nil, nil)
+				return mock
+			},
+			passwordResetRepositoryMock: func(mc *minimock.Controller) repository.PasswordResetRepository {
+				return repositoryMocks.NewPasswordResetRepositoryMock(mc)
+			},
+			notificationServiceMock: func(mc *minimock.Controller) service.NotificationService {
+				return serviceMocks.NewNotificationServiceMock(mc)
+			},
+			transactorMock: emptyTransactorMock,
+		},
+		{
+			name: "notify error case",
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.FindByEmailMock.Expect(ctx, email).Return(emailUser, nil)
+				return mock
+			},
+			passwordResetRepositoryMock: func(mc *minimock.Controller) repository.PasswordResetRepository {
+				mock := repositoryMocks.NewPasswordResetRepositoryMock(mc)
+				mock.CreateMock.Return(nil)
+				return mock
+			},
+			notificationServiceMock: func(mc *minimock.Controller) service.NotificationService {
+				mock := serviceMocks.NewNotificationServiceMock(mc)
+				mock.NotifyMock.Return(errors.New("outbox error"))
+				return mock
+			},
+			transactorMock: transactorRollbackMock,
+		},
+		{
+			name: "find user error case",
+			err:  ErrPasswordResetFailed,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.FindByEmailMock.Expect(ctx, email).Return(nil, errors.New("db error"))
+				return mock
+			},
+			passwordResetRepositoryMock: func(mc *minimock.Controller) repository.PasswordResetRepository {
+				return repositoryMocks.NewPasswordResetRepositoryMock(mc)
+			},
+			notificationServiceMock: func(mc *minimock.Controller) service.NotificationService {
+				return serviceMocks.NewNotificationServiceMock(mc)
+			},
+			transactorMock: emptyTransactorMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := NewService(
+				loggerMocks.NewMockLogger(),
+				tt.userRepositoryMock(mc),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				tt.passwordResetRepositoryMock(mc),
+				nil,
+				nil,
+				nil,
+				tt.notificationServiceMock(mc),
+				nil,
+				transaction.NewTransactionManager(tt.transactorMock(mc)),
+				mfaConfig,
+				passwordResetConfig,
+				verificationConfig,
+				loginThrottleConfig,
+				nil,
+			)
+
+			err := srv.RequestPasswordReset(ctx, email)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestResetPassword(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		version = 3
+	)
+
+	tests := []struct {
+		name                        string
+		err                         error
+		userRepositoryMock          userRepositoryMockFunc
+		tokenRepositoryMock         tokenRepositoryMockFunc
+		familyRepositoryMock        familyRepositoryMockFunc
+		passwordResetRepositoryMock passwordResetRepositoryMockFunc
+		auditRepositoryMock         auditRepositoryMockFunc
+		transactorMock              transactorMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.UpdatePasswordMock.Set(func(_ context.Context, id, hashedPassword string) error {
+					require.Equal(mc, userID, id)
+					require.NoError(mc, bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password)))
+					return nil
+				})
+				mock.IncrementVersionMock.Expect(minimock.AnyContext, userID).Return(version, nil)
+				return mock
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				mock.SetTokenVersionMock.Expect(ctx, userID, version).Return(nil)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.RevokeAllMock.Expect(minimock.AnyContext, userID, "").Return(nil)
+				return mock
+			},
+			passwordResetRepositoryMock: func(mc *minimock.Controller) repository.PasswordResetRepository {
+				mock := repositoryMocks.NewPasswordResetRepositoryMock(mc)
+				mock.UseMock.Expect(minimock.AnyContext, tokens.HashOpaqueToken(resetToken)).Return(userID, nil)
+				mock.DeleteByUserMock.Expect(minimock.AnyContext, userID).Return(nil)
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Set(func(_ context.Context, event *model.AuditEvent) error {
+					require.Equal(mc, model.AuditActionPasswordReset, event.Action)
+					require.Equal(mc, userID, event.ActorID)
+					require.Contains(mc, event.Changes, "password")
+					return nil
+				})
+				return mock
+			},
+			transactorMock: transactorCommitMock,
+		},
+		{
+			name: "invalid token case",
+			err:  ErrInvalidResetToken,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				return repositoryMocks.NewTokenRepositoryMock(mc)
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				return repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+			},
+			passwordResetRepositoryMock: func(mc *minimock.Controller) repository.PasswordResetRepository {
+				mock := repositoryMocks.NewPasswordResetRepositoryMock(mc)
+				mock.UseMock.Expect(minimock.AnyContext, tokens.HashOpaqueToken(resetToken)).Return("", ErrInvalidResetToken)
+				return mock
+			},
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			transactorMock:      transactorRollbackMock,
+		},
+		{
+			name: "revoke families error case",
+			err:  ErrPasswordResetFailed,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.UpdatePasswordMock.Return(nil)
+				mock.IncrementVersionMock.Expect(minimock.AnyContext, userID).Return(version, nil)
+				return mock
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				return repositoryMocks.NewTokenRepositoryMock(mc)
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.RevokeAllMock.Expect(minimock.AnyContext, userID, "").Return(errors.New("db error"))
+				return mock
+			},
+			passwordResetRepositoryMock: func(mc *minimock.Controller) repository.PasswordResetRepository {
+				mock := repositoryMocks.NewPasswordResetRepositoryMock(mc)
+				mock.UseMock.Expect(minimock.AnyContext, tokens.HashOpaqueToken(resetToken)).Return(userID, nil)
+				mock.DeleteByUserMock.Expect(minimock.AnyContext, userID).Return(nil)
+				return mock
+			},
+			auditRepositoryMock: emptyAuditRepositoryMock,
+			transactorMock:      transactorRollbackMock,
+		},
+		{
+			name: "cache error case",
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.UpdatePasswordMock.Return(nil)
+				mock.IncrementVersionMock.Expect(minimock.AnyContext, userID).Return(version, nil)
+				return mock
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				mock.SetTokenVersionMock.Expect(ctx, userID, version).Return(errors.New("redis error"))
+				mock.DeleteTokenVersionMock.Expect(ctx, userID).Return(nil)
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.RevokeAllMock.Expect(minimock.AnyContext, userID, "").Return(nil)
+				return mock
+			},
+			passwordResetRepositoryMock: func(mc *minimock.Controller) repository.PasswordResetRepository {
+				mock := repositoryMocks.NewPasswordResetRepositoryMock(mc)
+				mock.UseMock.Expect(minimock.AnyContext, tokens.HashOpaqueToken(resetToken)).Return(userID, nil)
+				mock.DeleteByUserMock.Expect(minimock.AnyContext, userID).Return(nil)
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Return(nil)
+				return mock
+			},
+			transactorMock: transactorCommitMock,
+		},
+		{
+			name: "stale cache error case",
+			err:  ErrPasswordResetFailed,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.UpdatePasswordMock.Return(nil)
+				mock.IncrementVersionMock.Expect(minimock.AnyContext, userID).Return(version, nil)
+				return mock
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				mock.SetTokenVersionMock.Expect(ctx, userID, version).Return(errors.New("redis error"))
+				mock.DeleteTokenVersionMock.Expect(ctx, userID).Return(errors.New("redis error"))
+				return mock
+			},
+			familyRepositoryMock: func(mc *minimock.Controller) repository.TokenFamilyRepository {
+				mock := repositoryMocks.NewTokenFamilyRepositoryMock(mc)
+				mock.RevokeAllMock.Expect(minimock.AnyContext, userID, "").Return(nil)
+				return mock
+			},
+			passwordResetRepositoryMock: func(mc *minimock.Controller) repository.PasswordResetRepository {
+				mock := repositoryMocks.NewPasswordResetRepositoryMock(mc)
+				mock.UseMock.Expect(minimock.AnyContext, tokens.HashOpaqueToken(resetToken)).Return(userID, nil)
+				mock.DeleteByUserMock.Expect(minimock.AnyContext, userID).Return(nil)
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Return(nil)
+				return mock
+			},
+			transactorMock: transactorCommitMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := NewService(
+				loggerMocks.NewMockLogger(),
+				tt.userRepositoryMock(mc),
+				tt.tokenRepositoryMock(mc),
+				tt.familyRepositoryMock(mc),
+				nil,
+				nil,
+				nil,
+				tt.passwordResetRepositoryMock(mc),
+				nil,
+				tt.auditRepositoryMock(mc),
+				nil,
+				nil,
+				nil,
+				transaction.NewTransactionManager(tt.transactorMock(mc)),
+				mfaConfig,
+				passwordResetConfig,
+				verificationConfig,
+				loginThrottleConfig,
+				nil,
+			)
+
+			err := srv.ResetPassword(ctx, resetToken, password)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -1,26 +1,80 @@
 package auth
 
 import (
+	"log/slog"
+
+	"github.com/8thgencore/microservice-auth/internal/config"
 	"github.com/8thgencore/microservice-auth/internal/repository"
 	"github.com/8thgencore/microservice-auth/internal/service"
 	"github.com/8thgencore/microservice-auth/internal/tokens"
+	"github.com/8thgencore/microservice-common/pkg/db"
+	"github.com/go-webauthn/webauthn/webauthn"
 )
 
 type authService struct {
-	userRepository  repository.UserRepository
-	tokenRepository repository.TokenRepository
-	tokenOperations tokens.TokenOperations
+	logger                  *slog.Logger
+	userRepository          repository.UserRepository
+	tokenRepository         repository.TokenRepository
+	familyRepository        repository.TokenFamilyRepository
+	mfaRepository           repository.MfaRepository
+	passkeyRepository       repository.PasskeyRepository
+	ceremonyRepository      repository.PasskeyCeremonyRepository
+	passwordResetRepository repository.PasswordResetRepository
+	loginAttemptRepository  repository.LoginAttemptRepository
+	auditRepository         repository.AuditRepository
+	tokenOperations         tokens.TokenOperations
+	notificationService     service.NotificationService
+	// permissionService resolves the permissions embedded in access tokens, nil when they are not embedded.
+	permissionService   service.PermissionService
+	txManager           db.TxManager
+	mfaConfig           *config.MFAConfig
+	passwordResetConfig *config.PasswordResetConfig
+	verificationConfig  *config.EmailVerificationConfig
+	loginThrottleConfig *config.LoginThrottleConfig
+	webAuthn            *webauthn.WebAuthn
 }
 
 // NewService creates new object of service layer.
 func NewService(
+	logger *slog.Logger,
 	userRepository repository.UserRepository,
 	tokenRepository repository.TokenRepository,
+	familyRepository repository.TokenFamilyRepository,
+	mfaRepository repository.MfaRepository,
+	passkeyRepository repository.PasskeyRepository,
+	ceremonyRepository repository.PasskeyCeremonyRepository,
+	passwordResetRepository repository.PasswordResetRepository,
+	loginAttemptRepository repository.LoginAttemptRepository,
+	auditRepository repository.AuditRepository,
 	tokenOperations tokens.TokenOperations,
+	notificationService service.NotificationService,
+	permissionService service.PermissionService,
+	txManager db.TxManager,
+	mfaConfig *config.MFAConfig,
+	passwordResetConfig *config.PasswordResetConfig,
+	verificationConfig *config.EmailVerificationConfig,
+	loginThrottleConfig *config.LoginThrottleConfig,
+	webAuthn *webauthn.WebAuthn,
 ) service.AuthService {
 	return &authService{
-		userRepository:  userRepository,
-		tokenRepository: tokenRepository,
-		tokenOperations: tokenOperations,
+		logger:                  logger,
+		userRepository:          userRepository,
+		tokenRepository:         tokenRepository,
+		familyRepository:        familyRepository,
+		mfaRepository:           mfaRepository,
+		passkeyRepository:       passkeyRepository,
+		ceremonyRepository:      ceremonyRepository,
+		passwordResetRepository: passwordResetRepository,
+		loginAttemptRepository:  loginAttemptRepository,
+		auditRepository:         auditRepository,
+		tokenOperations:         tokenOperations,
+		notificationService:     notificationService,
+		permissionService:       permissionService,
+		txManager:               txManager,
+		mfaConfig:               mfaConfig,
+		passwordResetConfig:     passwordResetConfig,
+		verificationConfig:      verificationConfig,
+		loginThrottleConfig:     loginThrottleConfig,
+		webAuthn:                webAuthn,
 	}
 }
@@ -0,0 +1,188 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/8thgencore/microservice-common/pkg/logger/sl"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	userService "github.com/8thgencore/microservice-auth/internal/service/user"
+)
+
+// Login throttling errors
+var (
+	ErrLoginThrottled = errors.New("too many failed login attempts, try again later")
+	ErrAccountLocked  = errors.New("account is temporarily locked after too many failed login attempts")
+	ErrUnlockFailed   = errors.New("failed to unlock user")
+)
+
+// RetryError is returned when a login is refused until RetryAfter has passed.
+type RetryError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func (e *RetryError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// loginLimit is the throttling of the failed logins counted under a key.
+type loginLimit struct {
+	key          string
+	backoffAfter int
+	lockoutAfter int
+	lockoutErr   error
+}
+
+// loginLimits returns the limits that apply to a login of the username from the client IP.
+func (s *authService) loginLimits(username, ip string) []loginLimit {
+	limits := []loginLimit{{
+		key:          usernameKey(username),
+		backoffAfter: s.loginThrottleConfig.BackoffAfter,
+		lockoutAfter: s.loginThrottleConfig.LockoutAfter,
+		lockoutErr:   ErrAccountLocked,
+	}}
+
+	if ip != "" {
+		limits = append(limits, loginLimit{
+			key:          "ip:" + ip,
+			backoffAfter: s.loginThrottleConfig.IPBackoffAfter,
+			lockoutAfter: s.loginThrottleConfig.IPLockoutAfter,
+			lockoutErr:   ErrLoginThrottled,
+		})
+	}
+
+	return limits
+}
+
+// loginAttempt is an attempt counted under the key of a limit before its outcome is known.
+type loginAttempt struct {
+	limit  loginLimit
+	at     time.Time
+	before *model.LoginFailures
+}
+
+// reserveLoginAttempt counts the login under the username and the client IP before the password is checked,
+// so that concurrent attempts can not all pass the throttle before any of them fails. The login is refused
+// while the username or the client IP is backing off or locked out, refused attempts are taken back.
+// A limit is skipped when its failures can not be counted, so an outage of Redis does not block every login.
+func (s *authService) reserveLoginAttempt(ctx context.Context, username, ip string) ([]loginAttempt, error) {
+	now := time.Now()
+
+	var (
+		attempts []loginAttempt
+		refusal  *RetryError
+	)
+	for _, limit := range s.loginLimits(username, ip) {
+		before, err := s.loginAttemptRepository.Reserve(ctx, limit.key, now)
+		if err != nil {
+			s.logger.Error("failed to count login attempt", sl.Err(err))
+			continue
+		}
+		attempts = append(attempts, loginAttempt{limit: limit, at: now, before: before})
+
+		// Of several refusals the one with the longest wait is returned.
+		limitRefusal := s.refusal(limit, before, now)
+		if limitRefusal != nil && (refusal == nil || limitRefusal.RetryAfter > refusal.RetryAfter) {
+			refusal = limitRefusal
+		}
+	}
+
+	if refusal == nil {
+		return attempts, nil
+	}
+
+	s.releaseLoginAttempts(ctx, attempts)
+
+	return nil, refusal
+}
+
+// refusal returns the error a login is refused with after the failures, or nil if it may go ahead.
+func (s *authService) refusal(limit loginLimit, failures *model.LoginFailures, now time.Time) *RetryError {
+	var (
+		until time.Time
+		err   error
+	)
+	switch {
+	case limit.lockoutAfter > 0 && failures.Count >= limit.lockoutAfter:
+		until, err = failures.LastAt.Add(s.loginThrottleConfig.LockoutDuration), limit.lockoutErr
+	case limit.backoffAfter > 0 && failures.Count >= limit.backoffAfter:
+		until, err = failures.LastAt.Add(s.backoff(failures.Count-limit.backoffAfter)), ErrLoginThrottled
+	default:
+		return nil
+	}
+
+	if !until.After(now) {
+		return nil
+	}
+
+	return &RetryError{Err: err, RetryAfter: until.Sub(now)}
+}
+
+// backoff returns the wait after a failure, doubling with each of the failures past the threshold.
+func (s *authService) backoff(exceeded int) time.Duration {
+	backoff := s.loginThrottleConfig.BackoffBase
+	for range exceeded {
+		if backoff >= s.loginThrottleConfig.BackoffMax {
+			break
+		}
+		backoff *= 2
+	}
+
+	return min(backoff, s.loginThrottleConfig.BackoffMax)
+}
+
+// releaseLoginAttempts takes back the attempts of a login that was not a failure.
+func (s *authService) releaseLoginAttempts(ctx context.Context, attempts []loginAttempt) {
+	for _, attempt := range attempts {
+		err := s.loginAttemptRepository.Release(ctx, attempt.limit.key, attempt.at, attempt.before)
+		if err != nil {
+			s.logger.Error("failed to release login attempt", sl.Err(err))
+		}
+	}
+}
+
+// recordLoginFailure keeps the attempts of a failed login counted and logs the lockouts they lead to.
+func (s *authService) recordLoginFailure(attempts []loginAttempt) {
+	for _, attempt := range attempts {
+		if attempt.limit.lockoutAfter > 0 && attempt.before.Count+1 == attempt.limit.lockoutAfter {
+			s.logger.Warn("login locked out after too many failures", slog.String("key", attempt.limit.key))
+		}
+	}
+}
+
+// UnlockUser lifts the lockout of a user and forgets the failed logins of their username.
+func (s *authService) UnlockUser(ctx context.Context, userID string) error {
+	user, err := s.userRepository.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, userService.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+
+		s.logger.Error("failed to get user", sl.Err(err))
+		return ErrUnlockFailed
+	}
+
+	if err = s.loginAttemptRepository.Reset(ctx, usernameKey(user.Name)); err != nil {
+		s.logger.Error("failed to reset login failures", sl.Err(err))
+		return ErrUnlockFailed
+	}
+
+	if err = s.recordAudit(ctx, model.AuditActionLoginUnlocked, userID, nil, nil); err != nil {
+		s.logger.Error("failed to log unlock", sl.Err(err))
+	}
+
+	return nil
+}
+
+// usernameKey returns the key the failed logins of a username are counted under.
+func usernameKey(username string) string {
+	return "user:" + username
+}
@@ -0,0 +1,312 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/8thgencore/microservice-common/pkg/db/transaction"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	repositoryMocks "github.com/8thgencore/microservice-auth/internal/repository/mocks"
+	userService "github.com/8thgencore/microservice-auth/internal/service/user"
+	dbMocks "github.com/8thgencore/microservice-common/pkg/db/mocks"
+	loggerMocks "github.com/8thgencore/microservice-common/pkg/logger/mocks"
+)
+
+type loginAttemptRepositoryMockFunc func(mc *minimock.Controller) repository.LoginAttemptRepository
+
+func newThrottleService(
+	mc *minimock.Controller,
+	userRepository repository.UserRepository,
+	loginAttemptRepository repository.LoginAttemptRepository,
+	auditRepository repository.AuditRepository,
+) *authService {
+	return NewService(
+		loggerMocks.NewMockLogger(),
+		userRepository,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		loginAttemptRepository,
+		auditRepository,
+		nil,
+		nil,
+		nil,
+		transaction.NewTransactionManager(dbMocks.NewTransactorMock(mc)),
+		mfaConfig,
+		nil,
+		verificationConfig,
+		loginThrottleConfig,
+		nil,
+	).(*authService)
+}
+
+func TestLoginThrottle(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		req = &model.UserCreds{
+			Username: username,
+			Password: password,
+		}
+
+		userKey = "user:" + username
+		ipKey   = "ip:" + client.IPAddress
+	)
+
+	// failures are a number of failures, the last one age ago.
+	type failures struct {
+		count int
+		age   time.Duration
+	}
+
+	// reserveMock counts the attempt after the failures of the username and of the client IP. The time of
+	// the last failure is taken when the mock is created, so that the subtests don't depend on when they are run.
+	reserveMock := func(
+		mc *minimock.Controller,
+		userFailures, ipFailures failures,
+	) *repositoryMocks.LoginAttemptRepositoryMock {
+		now := time.Now()
+		mock := repositoryMocks.NewLoginAttemptRepositoryMock(mc)
+		mock.ReserveMock.Set(func(_ context.Context, key string, _ time.Time) (*model.LoginFailures, error) {
+			switch key {
+			case userKey:
+				return &model.LoginFailures{Count: userFailures.count, LastAt: now.Add(-userFailures.age)}, nil
+			case ipKey:
+				return &model.LoginFailures{Count: ipFailures.count, LastAt: now.Add(-ipFailures.age)}, nil
+			}
+			return nil, errors.New("unexpected key")
+		})
+		return mock
+	}
+
+	// refusedMock takes back the attempts of the refused login.
+	refusedMock := func(userFailures, ipFailures failures) loginAttemptRepositoryMockFunc {
+		return func(mc *minimock.Controller) repository.LoginAttemptRepository {
+			mock := reserveMock(mc, userFailures, ipFailures)
+			releaseMock(mc, mock)
+			return mock
+		}
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name                       string
+		err                        error
+		retryAfter                 time.Duration
+		userRepositoryMock         userRepositoryMockFunc
+		loginAttemptRepositoryMock loginAttemptRepositoryMockFunc
+	}{
+		{
+			name:       "username backoff case",
+			err:        ErrLoginThrottled,
+			retryAfter: 2 * time.Second,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			loginAttemptRepositoryMock: refusedMock(
+				failures{count: 4},
+				failures{},
+			),
+		},
+		{
+			name:       "username lockout case",
+			err:        ErrAccountLocked,
+			retryAfter: 15 * time.Minute,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			loginAttemptRepositoryMock: refusedMock(
+				failures{count: 5},
+				failures{count: 5},
+			),
+		},
+		{
+			name:       "client ip lockout case",
+			err:        ErrLoginThrottled,
+			retryAfter: 15 * time.Minute,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			loginAttemptRepositoryMock: refusedMock(
+				failures{},
+				failures{count: 20},
+			),
+		},
+		{
+			name: "backoff elapsed case",
+			err:  ErrWrongPassword,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetAuthInfoMock.Expect(minimock.AnyContext, username).Return(nil, userService.ErrUserNotFound)
+				return mock
+			},
+			// The attempts stay counted as failures.
+			loginAttemptRepositoryMock: func(mc *minimock.Controller) repository.LoginAttemptRepository {
+				return reserveMock(mc, failures{count: 3, age: 2 * time.Second}, failures{count: 3, age: 2 * time.Second})
+			},
+		},
+		{
+			name: "right password case",
+			err:  ErrUserDeleted,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetAuthInfoMock.Expect(minimock.AnyContext, username).Return(&model.AuthInfo{
+					ID:       userID,
+					Username: username,
+					Password: string(hashedPassword),
+					Status:   model.UserStatusDeleted,
+				}, nil)
+				return mock
+			},
+			// The attempts are taken back and the failures of the username forgotten.
+			loginAttemptRepositoryMock: func(mc *minimock.Controller) repository.LoginAttemptRepository {
+				mock := reserveMock(mc, failures{count: 2}, failures{count: 2})
+				releaseMock(mc, mock)
+				mock.ResetMock.Expect(minimock.AnyContext, userKey).Return(nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newThrottleService(mc, tt.userRepositoryMock(mc), tt.loginAttemptRepositoryMock(mc), nil)
+
+			res, err := srv.Login(ctx, req, client)
+			require.Nil(t, res)
+			require.ErrorIs(t, err, tt.err)
+
+			var retryErr *RetryError
+			if tt.retryAfter == 0 {
+				require.False(t, errors.As(err, &retryErr))
+				return
+			}
+			require.ErrorAs(t, err, &retryErr)
+			require.InDelta(t, tt.retryAfter, retryErr.RetryAfter, float64(time.Second))
+		})
+	}
+}
+
+// releaseMock expects the attempts of the username and of the client IP to be taken back.
+func releaseMock(mc *minimock.Controller, mock *repositoryMocks.LoginAttemptRepositoryMock) {
+	mock.ReleaseMock.Times(2).Set(func(_ context.Context, key string, _ time.Time, _ *model.LoginFailures) error {
+		require.Contains(mc, []string{"user:" + username, "ip:" + client.IPAddress}, key)
+		return nil
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	srv := &authService{loginThrottleConfig: loginThrottleConfig}
+
+	require.Equal(t, time.Second, srv.backoff(0))
+	require.Equal(t, 2*time.Second, srv.backoff(1))
+	require.Equal(t, 32*time.Second, srv.backoff(5))
+	require.Equal(t, time.Minute, srv.backoff(6))
+	require.Equal(t, time.Minute, srv.backoff(1000))
+}
+
+func TestUnlockUser(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		lockedUser = &model.User{ID: userID, Name: username}
+	)
+
+	tests := []struct {
+		name                       string
+		err                        error
+		userRepositoryMock         userRepositoryMockFunc
+		loginAttemptRepositoryMock loginAttemptRepositoryMockFunc
+		auditRepositoryMock        auditRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, userID).Return(lockedUser, nil)
+				return mock
+			},
+			loginAttemptRepositoryMock: func(mc *minimock.Controller) repository.LoginAttemptRepository {
+				mock := repositoryMocks.NewLoginAttemptRepositoryMock(mc)
+				mock.ResetMock.Expect(ctx, "user:"+username).Return(nil)
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "user not found case",
+			err:  ErrUserNotFound,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, userID).Return(nil, userService.ErrUserNotFound)
+				return mock
+			},
+			loginAttemptRepositoryMock: func(mc *minimock.Controller) repository.LoginAttemptRepository {
+				return repositoryMocks.NewLoginAttemptRepositoryMock(mc)
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return repositoryMocks.NewAuditRepositoryMock(mc)
+			},
+		},
+		{
+			name: "reset error case",
+			err:  ErrUnlockFailed,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, userID).Return(lockedUser, nil)
+				return mock
+			},
+			loginAttemptRepositoryMock: func(mc *minimock.Controller) repository.LoginAttemptRepository {
+				mock := repositoryMocks.NewLoginAttemptRepositoryMock(mc)
+				mock.ResetMock.Return(errors.New("redis error"))
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return repositoryMocks.NewAuditRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newThrottleService(
+				mc,
+				tt.userRepositoryMock(mc),
+				tt.loginAttemptRepositoryMock(mc),
+				tt.auditRepositoryMock(mc),
+			)
+
+			err := srv.UnlockUser(ctx, userID)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
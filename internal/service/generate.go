@@ -4,3 +4,8 @@ package service
 //go:generate ./../../bin/minimock -g -i UserService -o ./mocks/ -s "_minimock.go"
 //go:generate ./../../bin/minimock -g -i AuthService -o ./mocks/ -s "_minimock.go"
 //go:generate ./../../bin/minimock -g -i AccessService -o ./mocks/ -s "_minimock.go"
+//go:generate ./../../bin/minimock -g -i RelationService -o ./mocks/ -s "_minimock.go"
+//go:generate ./../../bin/minimock -g -i RoleService -o ./mocks/ -s "_minimock.go"
+//go:generate ./../../bin/minimock -g -i PermissionService -o ./mocks/ -s "_minimock.go"
+//go:generate ./../../bin/minimock -g -i AuditService -o ./mocks/ -s "_minimock.go"
+//go:generate ./../../bin/minimock -g -i NotificationService -o ./mocks/ -s "_minimock.go"
@@ -17,20 +17,62 @@ type AccessServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcAddRoleEndpoint          func(ctx context.Context, endpoint string, roles []string) (err error)
+	funcAddDenyRule          func(ctx context.Context, rule *model.DenyRule) (err error)
+	funcAddDenyRuleOrigin    string
+	inspectFuncAddDenyRule   func(ctx context.Context, rule *model.DenyRule)
+	afterAddDenyRuleCounter  uint64
+	beforeAddDenyRuleCounter uint64
+	AddDenyRuleMock          mAccessServiceMockAddDenyRule
+
+	funcAddRoleEndpoint          func(ctx context.Context, endpoint string, roles []string, condition string) (err error)
 	funcAddRoleEndpointOrigin    string
-	inspectFuncAddRoleEndpoint   func(ctx context.Context, endpoint string, roles []string)
+	inspectFuncAddRoleEndpoint   func(ctx context.Context, endpoint string, roles []string, condition string)
 	afterAddRoleEndpointCounter  uint64
 	beforeAddRoleEndpointCounter uint64
 	AddRoleEndpointMock          mAccessServiceMockAddRoleEndpoint
 
-	funcCheck          func(ctx context.Context, endpoint string) (err error)
+	funcBatchCheck          func(ctx context.Context, userID string, checks []*model.AccessCheck) (apa1 []*model.AccessDecision, err error)
+	funcBatchCheckOrigin    string
+	inspectFuncBatchCheck   func(ctx context.Context, userID string, checks []*model.AccessCheck)
+	afterBatchCheckCounter  uint64
+	beforeBatchCheckCounter uint64
+	BatchCheckMock          mAccessServiceMockBatchCheck
+
+	funcCheck          func(ctx context.Context, endpoint string, attributes map[string]string) (err error)
 	funcCheckOrigin    string
-	inspectFuncCheck   func(ctx context.Context, endpoint string)
+	inspectFuncCheck   func(ctx context.Context, endpoint string, attributes map[string]string)
 	afterCheckCounter  uint64
 	beforeCheckCounter uint64
 	CheckMock          mAccessServiceMockCheck
 
+	funcCheckAs          func(ctx context.Context, principal *model.AccessPrincipal, endpoint string, attributes map[string]string) (err error)
+	funcCheckAsOrigin    string
+	inspectFuncCheckAs   func(ctx context.Context, principal *model.AccessPrincipal, endpoint string, attributes map[string]string)
+	afterCheckAsCounter  uint64
+	beforeCheckAsCounter uint64
+	CheckAsMock          mAccessServiceMockCheckAs
+
+	funcCheckPermission          func(ctx context.Context, permission string) (err error)
+	funcCheckPermissionOrigin    string
+	inspectFuncCheckPermission   func(ctx context.Context, permission string)
+	afterCheckPermissionCounter  uint64
+	beforeCheckPermissionCounter uint64
+	CheckPermissionMock          mAccessServiceMockCheckPermission
+
+	funcCheckPermissionAs          func(ctx context.Context, principal *model.AccessPrincipal, permission string) (err error)
+	funcCheckPermissionAsOrigin    string
+	inspectFuncCheckPermissionAs   func(ctx context.Context, principal *model.AccessPrincipal, permission string)
+	afterCheckPermissionAsCounter  uint64
+	beforeCheckPermissionAsCounter uint64
+	CheckPermissionAsMock          mAccessServiceMockCheckPermissionAs
+
+	funcDeleteDenyRule          func(ctx context.Context, name string) (err error)
+	funcDeleteDenyRuleOrigin    string
+	inspectFuncDeleteDenyRule   func(ctx context.Context, name string)
+	afterDeleteDenyRuleCounter  uint64
+	beforeDeleteDenyRuleCounter uint64
+	DeleteDenyRuleMock          mAccessServiceMockDeleteDenyRule
+
 	funcDeleteRoleEndpoint          func(ctx context.Context, endpoint string) (err error)
 	funcDeleteRoleEndpointOrigin    string
 	inspectFuncDeleteRoleEndpoint   func(ctx context.Context, endpoint string)
@@ -38,16 +80,23 @@ type AccessServiceMock struct {
 	beforeDeleteRoleEndpointCounter uint64
 	DeleteRoleEndpointMock          mAccessServiceMockDeleteRoleEndpoint
 
-	funcGetRoleEndpoints          func(ctx context.Context) (epa1 []*model.EndpointPermissions, err error)
+	funcGetDenyRules          func(ctx context.Context) (dpa1 []*model.DenyRule, err error)
+	funcGetDenyRulesOrigin    string
+	inspectFuncGetDenyRules   func(ctx context.Context)
+	afterGetDenyRulesCounter  uint64
+	beforeGetDenyRulesCounter uint64
+	GetDenyRulesMock          mAccessServiceMockGetDenyRules
+
+	funcGetRoleEndpoints          func(ctx context.Context, endpoint string) (epa1 []*model.EndpointPermissions, err error)
 	funcGetRoleEndpointsOrigin    string
-	inspectFuncGetRoleEndpoints   func(ctx context.Context)
+	inspectFuncGetRoleEndpoints   func(ctx context.Context, endpoint string)
 	afterGetRoleEndpointsCounter  uint64
 	beforeGetRoleEndpointsCounter uint64
 	GetRoleEndpointsMock          mAccessServiceMockGetRoleEndpoints
 
-	funcUpdateRoleEndpoint          func(ctx context.Context, endpoint string, roles []string) (err error)
+	funcUpdateRoleEndpoint          func(ctx context.Context, endpoint string, roles []string, condition *string) (err error)
 	funcUpdateRoleEndpointOrigin    string
-	inspectFuncUpdateRoleEndpoint   func(ctx context.Context, endpoint string, roles []string)
+	inspectFuncUpdateRoleEndpoint   func(ctx context.Context, endpoint string, roles []string, condition *string)
 	afterUpdateRoleEndpointCounter  uint64
 	beforeUpdateRoleEndpointCounter uint64
 	UpdateRoleEndpointMock          mAccessServiceMockUpdateRoleEndpoint
@@ -61,15 +110,36 @@ func NewAccessServiceMock(t minimock.Tester) *AccessServiceMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AddDenyRuleMock = mAccessServiceMockAddDenyRule{mock: m}
+	m.AddDenyRuleMock.callArgs = []*AccessServiceMockAddDenyRuleParams{}
+
 	m.AddRoleEndpointMock = mAccessServiceMockAddRoleEndpoint{mock: m}
 	m.AddRoleEndpointMock.callArgs = []*AccessServiceMockAddRoleEndpointParams{}
 
+	m.BatchCheckMock = mAccessServiceMockBatchCheck{mock: m}
+	m.BatchCheckMock.callArgs = []*AccessServiceMockBatchCheckParams{}
+
 	m.CheckMock = mAccessServiceMockCheck{mock: m}
 	m.CheckMock.callArgs = []*AccessServiceMockCheckParams{}
 
+	m.CheckAsMock = mAccessServiceMockCheckAs{mock: m}
+	m.CheckAsMock.callArgs = []*AccessServiceMockCheckAsParams{}
+
+	m.CheckPermissionMock = mAccessServiceMockCheckPermission{mock: m}
+	m.CheckPermissionMock.callArgs = []*AccessServiceMockCheckPermissionParams{}
+
+	m.CheckPermissionAsMock = mAccessServiceMockCheckPermissionAs{mock: m}
+	m.CheckPermissionAsMock.callArgs = []*AccessServiceMockCheckPermissionAsParams{}
+
+	m.DeleteDenyRuleMock = mAccessServiceMockDeleteDenyRule{mock: m}
+	m.DeleteDenyRuleMock.callArgs = []*AccessServiceMockDeleteDenyRuleParams{}
+
 	m.DeleteRoleEndpointMock = mAccessServiceMockDeleteRoleEndpoint{mock: m}
 	m.DeleteRoleEndpointMock.callArgs = []*AccessServiceMockDeleteRoleEndpointParams{}
 
+	m.GetDenyRulesMock = mAccessServiceMockGetDenyRules{mock: m}
+	m.GetDenyRulesMock.callArgs = []*AccessServiceMockGetDenyRulesParams{}
+
 	m.GetRoleEndpointsMock = mAccessServiceMockGetRoleEndpoints{mock: m}
 	m.GetRoleEndpointsMock.callArgs = []*AccessServiceMockGetRoleEndpointsParams{}
 
@@ -81,6 +151,348 @@ func NewAccessServiceMock(t minimock.Tester) *AccessServiceMock {
 	return m
 }
 
+type mAccessServiceMockAddDenyRule struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockAddDenyRuleExpectation
+	expectations       []*AccessServiceMockAddDenyRuleExpectation
+
+	callArgs []*AccessServiceMockAddDenyRuleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessServiceMockAddDenyRuleExpectation specifies expectation struct of the AccessService.AddDenyRule
+type AccessServiceMockAddDenyRuleExpectation struct {
+	mock               *AccessServiceMock
+	params             *AccessServiceMockAddDenyRuleParams
+	paramPtrs          *AccessServiceMockAddDenyRuleParamPtrs
+	expectationOrigins AccessServiceMockAddDenyRuleExpectationOrigins
+	results            *AccessServiceMockAddDenyRuleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessServiceMockAddDenyRuleParams contains parameters of the AccessService.AddDenyRule
+type AccessServiceMockAddDenyRuleParams struct {
+	ctx  context.Context
+	rule *model.DenyRule
+}
+
+// AccessServiceMockAddDenyRuleParamPtrs contains pointers to parameters of the AccessService.AddDenyRule
+type AccessServiceMockAddDenyRuleParamPtrs struct {
+	ctx  *context.Context
+	rule **model.DenyRule
+}
+
+// AccessServiceMockAddDenyRuleResults contains results of the AccessService.AddDenyRule
+type AccessServiceMockAddDenyRuleResults struct {
+	err error
+}
+
+// AccessServiceMockAddDenyRuleOrigins contains origins of expectations of the AccessService.AddDenyRule
+type AccessServiceMockAddDenyRuleExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originRule string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddDenyRule *mAccessServiceMockAddDenyRule) Optional() *mAccessServiceMockAddDenyRule {
+	mmAddDenyRule.optional = true
+	return mmAddDenyRule
+}
+
+// Expect sets up expected params for AccessService.AddDenyRule
+func (mmAddDenyRule *mAccessServiceMockAddDenyRule) Expect(ctx context.Context, rule *model.DenyRule) *mAccessServiceMockAddDenyRule {
+	if mmAddDenyRule.mock.funcAddDenyRule != nil {
+		mmAddDenyRule.mock.t.Fatalf("AccessServiceMock.AddDenyRule mock is already set by Set")
+	}
+
+	if mmAddDenyRule.defaultExpectation == nil {
+		mmAddDenyRule.defaultExpectation = &AccessServiceMockAddDenyRuleExpectation{}
+	}
+
+	if mmAddDenyRule.defaultExpectation.paramPtrs != nil {
+		mmAddDenyRule.mock.t.Fatalf("AccessServiceMock.AddDenyRule mock is already set by ExpectParams functions")
+	}
+
+	mmAddDenyRule.defaultExpectation.params = &AccessServiceMockAddDenyRuleParams{ctx, rule}
+	mmAddDenyRule.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddDenyRule.expectations {
+		if minimock.Equal(e.params, mmAddDenyRule.defaultExpectation.params) {
+			mmAddDenyRule.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddDenyRule.defaultExpectation.params)
+		}
+	}
+
+	return mmAddDenyRule
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.AddDenyRule
+func (mmAddDenyRule *mAccessServiceMockAddDenyRule) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockAddDenyRule {
+	if mmAddDenyRule.mock.funcAddDenyRule != nil {
+		mmAddDenyRule.mock.t.Fatalf("AccessServiceMock.AddDenyRule mock is already set by Set")
+	}
+
+	if mmAddDenyRule.defaultExpectation == nil {
+		mmAddDenyRule.defaultExpectation = &AccessServiceMockAddDenyRuleExpectation{}
+	}
+
+	if mmAddDenyRule.defaultExpectation.params != nil {
+		mmAddDenyRule.mock.t.Fatalf("AccessServiceMock.AddDenyRule mock is already set by Expect")
+	}
+
+	if mmAddDenyRule.defaultExpectation.paramPtrs == nil {
+		mmAddDenyRule.defaultExpectation.paramPtrs = &AccessServiceMockAddDenyRuleParamPtrs{}
+	}
+	mmAddDenyRule.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddDenyRule.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddDenyRule
+}
+
+// ExpectRuleParam2 sets up expected param rule for AccessService.AddDenyRule
+func (mmAddDenyRule *mAccessServiceMockAddDenyRule) ExpectRuleParam2(rule *model.DenyRule) *mAccessServiceMockAddDenyRule {
+	if mmAddDenyRule.mock.funcAddDenyRule != nil {
+		mmAddDenyRule.mock.t.Fatalf("AccessServiceMock.AddDenyRule mock is already set by Set")
+	}
+
+	if mmAddDenyRule.defaultExpectation == nil {
+		mmAddDenyRule.defaultExpectation = &AccessServiceMockAddDenyRuleExpectation{}
+	}
+
+	if mmAddDenyRule.defaultExpectation.params != nil {
+		mmAddDenyRule.mock.t.Fatalf("AccessServiceMock.AddDenyRule mock is already set by Expect")
+	}
+
+	if mmAddDenyRule.defaultExpectation.paramPtrs == nil {
+		mmAddDenyRule.defaultExpectation.paramPtrs = &AccessServiceMockAddDenyRuleParamPtrs{}
+	}
+	mmAddDenyRule.defaultExpectation.paramPtrs.rule = &rule
+	mmAddDenyRule.defaultExpectation.expectationOrigins.originRule = minimock.CallerInfo(1)
+
+	return mmAddDenyRule
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.AddDenyRule
+func (mmAddDenyRule *mAccessServiceMockAddDenyRule) Inspect(f func(ctx context.Context, rule *model.DenyRule)) *mAccessServiceMockAddDenyRule {
+	if mmAddDenyRule.mock.inspectFuncAddDenyRule != nil {
+		mmAddDenyRule.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.AddDenyRule")
+	}
+
+	mmAddDenyRule.mock.inspectFuncAddDenyRule = f
+
+	return mmAddDenyRule
+}
+
+// Return sets up results that will be returned by AccessService.AddDenyRule
+func (mmAddDenyRule *mAccessServiceMockAddDenyRule) Return(err error) *AccessServiceMock {
+	if mmAddDenyRule.mock.funcAddDenyRule != nil {
+		mmAddDenyRule.mock.t.Fatalf("AccessServiceMock.AddDenyRule mock is already set by Set")
+	}
+
+	if mmAddDenyRule.defaultExpectation == nil {
+		mmAddDenyRule.defaultExpectation = &AccessServiceMockAddDenyRuleExpectation{mock: mmAddDenyRule.mock}
+	}
+	mmAddDenyRule.defaultExpectation.results = &AccessServiceMockAddDenyRuleResults{err}
+	mmAddDenyRule.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddDenyRule.mock
+}
+
+// Set uses given function f to mock the AccessService.AddDenyRule method
+func (mmAddDenyRule *mAccessServiceMockAddDenyRule) Set(f func(ctx context.Context, rule *model.DenyRule) (err error)) *AccessServiceMock {
+	if mmAddDenyRule.defaultExpectation != nil {
+		mmAddDenyRule.mock.t.Fatalf("Default expectation is already set for the AccessService.AddDenyRule method")
+	}
+
+	if len(mmAddDenyRule.expectations) > 0 {
+		mmAddDenyRule.mock.t.Fatalf("Some expectations are already set for the AccessService.AddDenyRule method")
+	}
+
+	mmAddDenyRule.mock.funcAddDenyRule = f
+	mmAddDenyRule.mock.funcAddDenyRuleOrigin = minimock.CallerInfo(1)
+	return mmAddDenyRule.mock
+}
+
+// When sets expectation for the AccessService.AddDenyRule which will trigger the result defined by the following
+// Then helper
+func (mmAddDenyRule *mAccessServiceMockAddDenyRule) When(ctx context.Context, rule *model.DenyRule) *AccessServiceMockAddDenyRuleExpectation {
+	if mmAddDenyRule.mock.funcAddDenyRule != nil {
+		mmAddDenyRule.mock.t.Fatalf("AccessServiceMock.AddDenyRule mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockAddDenyRuleExpectation{
+		mock:               mmAddDenyRule.mock,
+		params:             &AccessServiceMockAddDenyRuleParams{ctx, rule},
+		expectationOrigins: AccessServiceMockAddDenyRuleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddDenyRule.expectations = append(mmAddDenyRule.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.AddDenyRule return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockAddDenyRuleExpectation) Then(err error) *AccessServiceMock {
+	e.results = &AccessServiceMockAddDenyRuleResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.AddDenyRule should be invoked
+func (mmAddDenyRule *mAccessServiceMockAddDenyRule) Times(n uint64) *mAccessServiceMockAddDenyRule {
+	if n == 0 {
+		mmAddDenyRule.mock.t.Fatalf("Times of AccessServiceMock.AddDenyRule mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddDenyRule.expectedInvocations, n)
+	mmAddDenyRule.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddDenyRule
+}
+
+func (mmAddDenyRule *mAccessServiceMockAddDenyRule) invocationsDone() bool {
+	if len(mmAddDenyRule.expectations) == 0 && mmAddDenyRule.defaultExpectation == nil && mmAddDenyRule.mock.funcAddDenyRule == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddDenyRule.mock.afterAddDenyRuleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddDenyRule.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddDenyRule implements mm_service.AccessService
+func (mmAddDenyRule *AccessServiceMock) AddDenyRule(ctx context.Context, rule *model.DenyRule) (err error) {
+	mm_atomic.AddUint64(&mmAddDenyRule.beforeAddDenyRuleCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddDenyRule.afterAddDenyRuleCounter, 1)
+
+	mmAddDenyRule.t.Helper()
+
+	if mmAddDenyRule.inspectFuncAddDenyRule != nil {
+		mmAddDenyRule.inspectFuncAddDenyRule(ctx, rule)
+	}
+
+	mm_params := AccessServiceMockAddDenyRuleParams{ctx, rule}
+
+	// Record call args
+	mmAddDenyRule.AddDenyRuleMock.mutex.Lock()
+	mmAddDenyRule.AddDenyRuleMock.callArgs = append(mmAddDenyRule.AddDenyRuleMock.callArgs, &mm_params)
+	mmAddDenyRule.AddDenyRuleMock.mutex.Unlock()
+
+	for _, e := range mmAddDenyRule.AddDenyRuleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddDenyRule.AddDenyRuleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddDenyRule.AddDenyRuleMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddDenyRule.AddDenyRuleMock.defaultExpectation.params
+		mm_want_ptrs := mmAddDenyRule.AddDenyRuleMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockAddDenyRuleParams{ctx, rule}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddDenyRule.t.Errorf("AccessServiceMock.AddDenyRule got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddDenyRule.AddDenyRuleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.rule != nil && !minimock.Equal(*mm_want_ptrs.rule, mm_got.rule) {
+				mmAddDenyRule.t.Errorf("AccessServiceMock.AddDenyRule got unexpected parameter rule, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddDenyRule.AddDenyRuleMock.defaultExpectation.expectationOrigins.originRule, *mm_want_ptrs.rule, mm_got.rule, minimock.Diff(*mm_want_ptrs.rule, mm_got.rule))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddDenyRule.t.Errorf("AccessServiceMock.AddDenyRule got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddDenyRule.AddDenyRuleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddDenyRule.AddDenyRuleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddDenyRule.t.Fatal("No results are set for the AccessServiceMock.AddDenyRule")
+		}
+		return (*mm_results).err
+	}
+	if mmAddDenyRule.funcAddDenyRule != nil {
+		return mmAddDenyRule.funcAddDenyRule(ctx, rule)
+	}
+	mmAddDenyRule.t.Fatalf("Unexpected call to AccessServiceMock.AddDenyRule. %v %v", ctx, rule)
+	return
+}
+
+// AddDenyRuleAfterCounter returns a count of finished AccessServiceMock.AddDenyRule invocations
+func (mmAddDenyRule *AccessServiceMock) AddDenyRuleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddDenyRule.afterAddDenyRuleCounter)
+}
+
+// AddDenyRuleBeforeCounter returns a count of AccessServiceMock.AddDenyRule invocations
+func (mmAddDenyRule *AccessServiceMock) AddDenyRuleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddDenyRule.beforeAddDenyRuleCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.AddDenyRule.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddDenyRule *mAccessServiceMockAddDenyRule) Calls() []*AccessServiceMockAddDenyRuleParams {
+	mmAddDenyRule.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockAddDenyRuleParams, len(mmAddDenyRule.callArgs))
+	copy(argCopy, mmAddDenyRule.callArgs)
+
+	mmAddDenyRule.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddDenyRuleDone returns true if the count of the AddDenyRule invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockAddDenyRuleDone() bool {
+	if m.AddDenyRuleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddDenyRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddDenyRuleMock.invocationsDone()
+}
+
+// MinimockAddDenyRuleInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockAddDenyRuleInspect() {
+	for _, e := range m.AddDenyRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.AddDenyRule at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddDenyRuleCounter := mm_atomic.LoadUint64(&m.afterAddDenyRuleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddDenyRuleMock.defaultExpectation != nil && afterAddDenyRuleCounter < 1 {
+		if m.AddDenyRuleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessServiceMock.AddDenyRule at\n%s", m.AddDenyRuleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.AddDenyRule at\n%s with params: %#v", m.AddDenyRuleMock.defaultExpectation.expectationOrigins.origin, *m.AddDenyRuleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddDenyRule != nil && afterAddDenyRuleCounter < 1 {
+		m.t.Errorf("Expected call to AccessServiceMock.AddDenyRule at\n%s", m.funcAddDenyRuleOrigin)
+	}
+
+	if !m.AddDenyRuleMock.invocationsDone() && afterAddDenyRuleCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.AddDenyRule at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddDenyRuleMock.expectedInvocations), m.AddDenyRuleMock.expectedInvocationsOrigin, afterAddDenyRuleCounter)
+	}
+}
+
 type mAccessServiceMockAddRoleEndpoint struct {
 	optional           bool
 	mock               *AccessServiceMock
@@ -107,16 +519,18 @@ type AccessServiceMockAddRoleEndpointExpectation struct {
 
 // AccessServiceMockAddRoleEndpointParams contains parameters of the AccessService.AddRoleEndpoint
 type AccessServiceMockAddRoleEndpointParams struct {
-	ctx      context.Context
-	endpoint string
-	roles    []string
+	ctx       context.Context
+	endpoint  string
+	roles     []string
+	condition string
 }
 
 // AccessServiceMockAddRoleEndpointParamPtrs contains pointers to parameters of the AccessService.AddRoleEndpoint
 type AccessServiceMockAddRoleEndpointParamPtrs struct {
-	ctx      *context.Context
-	endpoint *string
-	roles    *[]string
+	ctx       *context.Context
+	endpoint  *string
+	roles     *[]string
+	condition *string
 }
 
 // AccessServiceMockAddRoleEndpointResults contains results of the AccessService.AddRoleEndpoint
@@ -126,10 +540,11 @@ type AccessServiceMockAddRoleEndpointResults struct {
 
 // AccessServiceMockAddRoleEndpointOrigins contains origins of expectations of the AccessService.AddRoleEndpoint
 type AccessServiceMockAddRoleEndpointExpectationOrigins struct {
-	origin         string
-	originCtx      string
-	originEndpoint string
-	originRoles    string
+	origin          string
+	originCtx       string
+	originEndpoint  string
+	originRoles     string
+	originCondition string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -143,7 +558,7 @@ func (mmAddRoleEndpoint *mAccessServiceMockAddRoleEndpoint) Optional() *mAccessS
 }
 
 // Expect sets up expected params for AccessService.AddRoleEndpoint
-func (mmAddRoleEndpoint *mAccessServiceMockAddRoleEndpoint) Expect(ctx context.Context, endpoint string, roles []string) *mAccessServiceMockAddRoleEndpoint {
+func (mmAddRoleEndpoint *mAccessServiceMockAddRoleEndpoint) Expect(ctx context.Context, endpoint string, roles []string, condition string) *mAccessServiceMockAddRoleEndpoint {
 	if mmAddRoleEndpoint.mock.funcAddRoleEndpoint != nil {
 		mmAddRoleEndpoint.mock.t.Fatalf("AccessServiceMock.AddRoleEndpoint mock is already set by Set")
 	}
@@ -156,7 +571,7 @@ func (mmAddRoleEndpoint *mAccessServiceMockAddRoleEndpoint) Expect(ctx context.C
 		mmAddRoleEndpoint.mock.t.Fatalf("AccessServiceMock.AddRoleEndpoint mock is already set by ExpectParams functions")
 	}
 
-	mmAddRoleEndpoint.defaultExpectation.params = &AccessServiceMockAddRoleEndpointParams{ctx, endpoint, roles}
+	mmAddRoleEndpoint.defaultExpectation.params = &AccessServiceMockAddRoleEndpointParams{ctx, endpoint, roles, condition}
 	mmAddRoleEndpoint.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmAddRoleEndpoint.expectations {
 		if minimock.Equal(e.params, mmAddRoleEndpoint.defaultExpectation.params) {
@@ -236,8 +651,31 @@ func (mmAddRoleEndpoint *mAccessServiceMockAddRoleEndpoint) ExpectRolesParam3(ro
 	return mmAddRoleEndpoint
 }
 
+// ExpectConditionParam4 sets up expected param condition for AccessService.AddRoleEndpoint
+func (mmAddRoleEndpoint *mAccessServiceMockAddRoleEndpoint) ExpectConditionParam4(condition string) *mAccessServiceMockAddRoleEndpoint {
+	if mmAddRoleEndpoint.mock.funcAddRoleEndpoint != nil {
+		mmAddRoleEndpoint.mock.t.Fatalf("AccessServiceMock.AddRoleEndpoint mock is already set by Set")
+	}
+
+	if mmAddRoleEndpoint.defaultExpectation == nil {
+		mmAddRoleEndpoint.defaultExpectation = &AccessServiceMockAddRoleEndpointExpectation{}
+	}
+
+	if mmAddRoleEndpoint.defaultExpectation.params != nil {
+		mmAddRoleEndpoint.mock.t.Fatalf("AccessServiceMock.AddRoleEndpoint mock is already set by Expect")
+	}
+
+	if mmAddRoleEndpoint.defaultExpectation.paramPtrs == nil {
+		mmAddRoleEndpoint.defaultExpectation.paramPtrs = &AccessServiceMockAddRoleEndpointParamPtrs{}
+	}
+	mmAddRoleEndpoint.defaultExpectation.paramPtrs.condition = &condition
+	mmAddRoleEndpoint.defaultExpectation.expectationOrigins.originCondition = minimock.CallerInfo(1)
+
+	return mmAddRoleEndpoint
+}
+
 // Inspect accepts an inspector function that has same arguments as the AccessService.AddRoleEndpoint
-func (mmAddRoleEndpoint *mAccessServiceMockAddRoleEndpoint) Inspect(f func(ctx context.Context, endpoint string, roles []string)) *mAccessServiceMockAddRoleEndpoint {
+func (mmAddRoleEndpoint *mAccessServiceMockAddRoleEndpoint) Inspect(f func(ctx context.Context, endpoint string, roles []string, condition string)) *mAccessServiceMockAddRoleEndpoint {
 	if mmAddRoleEndpoint.mock.inspectFuncAddRoleEndpoint != nil {
 		mmAddRoleEndpoint.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.AddRoleEndpoint")
 	}
@@ -262,7 +700,7 @@ func (mmAddRoleEndpoint *mAccessServiceMockAddRoleEndpoint) Return(err error) *A
 }
 
 // Set uses given function f to mock the AccessService.AddRoleEndpoint method
-func (mmAddRoleEndpoint *mAccessServiceMockAddRoleEndpoint) Set(f func(ctx context.Context, endpoint string, roles []string) (err error)) *AccessServiceMock {
+func (mmAddRoleEndpoint *mAccessServiceMockAddRoleEndpoint) Set(f func(ctx context.Context, endpoint string, roles []string, condition string) (err error)) *AccessServiceMock {
 	if mmAddRoleEndpoint.defaultExpectation != nil {
 		mmAddRoleEndpoint.mock.t.Fatalf("Default expectation is already set for the AccessService.AddRoleEndpoint method")
 	}
@@ -278,14 +716,14 @@ func (mmAddRoleEndpoint *mAccessServiceMockAddRoleEndpoint) Set(f func(ctx conte
 
 // When sets expectation for the AccessService.AddRoleEndpoint which will trigger the result defined by the following
 // Then helper
-func (mmAddRoleEndpoint *mAccessServiceMockAddRoleEndpoint) When(ctx context.Context, endpoint string, roles []string) *AccessServiceMockAddRoleEndpointExpectation {
+func (mmAddRoleEndpoint *mAccessServiceMockAddRoleEndpoint) When(ctx context.Context, endpoint string, roles []string, condition string) *AccessServiceMockAddRoleEndpointExpectation {
 	if mmAddRoleEndpoint.mock.funcAddRoleEndpoint != nil {
 		mmAddRoleEndpoint.mock.t.Fatalf("AccessServiceMock.AddRoleEndpoint mock is already set by Set")
 	}
 
 	expectation := &AccessServiceMockAddRoleEndpointExpectation{
 		mock:               mmAddRoleEndpoint.mock,
-		params:             &AccessServiceMockAddRoleEndpointParams{ctx, endpoint, roles},
+		params:             &AccessServiceMockAddRoleEndpointParams{ctx, endpoint, roles, condition},
 		expectationOrigins: AccessServiceMockAddRoleEndpointExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmAddRoleEndpoint.expectations = append(mmAddRoleEndpoint.expectations, expectation)
@@ -320,17 +758,17 @@ func (mmAddRoleEndpoint *mAccessServiceMockAddRoleEndpoint) invocationsDone() bo
 }
 
 // AddRoleEndpoint implements mm_service.AccessService
-func (mmAddRoleEndpoint *AccessServiceMock) AddRoleEndpoint(ctx context.Context, endpoint string, roles []string) (err error) {
+func (mmAddRoleEndpoint *AccessServiceMock) AddRoleEndpoint(ctx context.Context, endpoint string, roles []string, condition string) (err error) {
 	mm_atomic.AddUint64(&mmAddRoleEndpoint.beforeAddRoleEndpointCounter, 1)
 	defer mm_atomic.AddUint64(&mmAddRoleEndpoint.afterAddRoleEndpointCounter, 1)
 
 	mmAddRoleEndpoint.t.Helper()
 
 	if mmAddRoleEndpoint.inspectFuncAddRoleEndpoint != nil {
-		mmAddRoleEndpoint.inspectFuncAddRoleEndpoint(ctx, endpoint, roles)
+		mmAddRoleEndpoint.inspectFuncAddRoleEndpoint(ctx, endpoint, roles, condition)
 	}
 
-	mm_params := AccessServiceMockAddRoleEndpointParams{ctx, endpoint, roles}
+	mm_params := AccessServiceMockAddRoleEndpointParams{ctx, endpoint, roles, condition}
 
 	// Record call args
 	mmAddRoleEndpoint.AddRoleEndpointMock.mutex.Lock()
@@ -349,7 +787,7 @@ func (mmAddRoleEndpoint *AccessServiceMock) AddRoleEndpoint(ctx context.Context,
 		mm_want := mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation.params
 		mm_want_ptrs := mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation.paramPtrs
 
-		mm_got := AccessServiceMockAddRoleEndpointParams{ctx, endpoint, roles}
+		mm_got := AccessServiceMockAddRoleEndpointParams{ctx, endpoint, roles, condition}
 
 		if mm_want_ptrs != nil {
 
@@ -368,6 +806,11 @@ func (mmAddRoleEndpoint *AccessServiceMock) AddRoleEndpoint(ctx context.Context,
 					mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation.expectationOrigins.originRoles, *mm_want_ptrs.roles, mm_got.roles, minimock.Diff(*mm_want_ptrs.roles, mm_got.roles))
 			}
 
+			if mm_want_ptrs.condition != nil && !minimock.Equal(*mm_want_ptrs.condition, mm_got.condition) {
+				mmAddRoleEndpoint.t.Errorf("AccessServiceMock.AddRoleEndpoint got unexpected parameter condition, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation.expectationOrigins.originCondition, *mm_want_ptrs.condition, mm_got.condition, minimock.Diff(*mm_want_ptrs.condition, mm_got.condition))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmAddRoleEndpoint.t.Errorf("AccessServiceMock.AddRoleEndpoint got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmAddRoleEndpoint.AddRoleEndpointMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -380,9 +823,9 @@ func (mmAddRoleEndpoint *AccessServiceMock) AddRoleEndpoint(ctx context.Context,
 		return (*mm_results).err
 	}
 	if mmAddRoleEndpoint.funcAddRoleEndpoint != nil {
-		return mmAddRoleEndpoint.funcAddRoleEndpoint(ctx, endpoint, roles)
+		return mmAddRoleEndpoint.funcAddRoleEndpoint(ctx, endpoint, roles, condition)
 	}
-	mmAddRoleEndpoint.t.Fatalf("Unexpected call to AccessServiceMock.AddRoleEndpoint. %v %v %v", ctx, endpoint, roles)
+	mmAddRoleEndpoint.t.Fatalf("Unexpected call to AccessServiceMock.AddRoleEndpoint. %v %v %v %v", ctx, endpoint, roles, condition)
 	return
 }
 
@@ -454,52 +897,56 @@ func (m *AccessServiceMock) MinimockAddRoleEndpointInspect() {
 	}
 }
 
-type mAccessServiceMockCheck struct {
+type mAccessServiceMockBatchCheck struct {
 	optional           bool
 	mock               *AccessServiceMock
-	defaultExpectation *AccessServiceMockCheckExpectation
-	expectations       []*AccessServiceMockCheckExpectation
+	defaultExpectation *AccessServiceMockBatchCheckExpectation
+	expectations       []*AccessServiceMockBatchCheckExpectation
 
-	callArgs []*AccessServiceMockCheckParams
+	callArgs []*AccessServiceMockBatchCheckParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// AccessServiceMockCheckExpectation specifies expectation struct of the AccessService.Check
-type AccessServiceMockCheckExpectation struct {
+// AccessServiceMockBatchCheckExpectation specifies expectation struct of the AccessService.BatchCheck
+type AccessServiceMockBatchCheckExpectation struct {
 	mock               *AccessServiceMock
-	params             *AccessServiceMockCheckParams
-	paramPtrs          *AccessServiceMockCheckParamPtrs
-	expectationOrigins AccessServiceMockCheckExpectationOrigins
-	results            *AccessServiceMockCheckResults
+	params             *AccessServiceMockBatchCheckParams
+	paramPtrs          *AccessServiceMockBatchCheckParamPtrs
+	expectationOrigins AccessServiceMockBatchCheckExpectationOrigins
+	results            *AccessServiceMockBatchCheckResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// AccessServiceMockCheckParams contains parameters of the AccessService.Check
-type AccessServiceMockCheckParams struct {
-	ctx      context.Context
-	endpoint string
+// AccessServiceMockBatchCheckParams contains parameters of the AccessService.BatchCheck
+type AccessServiceMockBatchCheckParams struct {
+	ctx    context.Context
+	userID string
+	checks []*model.AccessCheck
 }
 
-// AccessServiceMockCheckParamPtrs contains pointers to parameters of the AccessService.Check
-type AccessServiceMockCheckParamPtrs struct {
-	ctx      *context.Context
-	endpoint *string
+// AccessServiceMockBatchCheckParamPtrs contains pointers to parameters of the AccessService.BatchCheck
+type AccessServiceMockBatchCheckParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+	checks *[]*model.AccessCheck
 }
 
-// AccessServiceMockCheckResults contains results of the AccessService.Check
-type AccessServiceMockCheckResults struct {
-	err error
+// AccessServiceMockBatchCheckResults contains results of the AccessService.BatchCheck
+type AccessServiceMockBatchCheckResults struct {
+	apa1 []*model.AccessDecision
+	err  error
 }
 
-// AccessServiceMockCheckOrigins contains origins of expectations of the AccessService.Check
-type AccessServiceMockCheckExpectationOrigins struct {
-	origin         string
-	originCtx      string
-	originEndpoint string
+// AccessServiceMockBatchCheckOrigins contains origins of expectations of the AccessService.BatchCheck
+type AccessServiceMockBatchCheckExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originChecks string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -507,48 +954,421 @@ type AccessServiceMockCheckExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCheck *mAccessServiceMockCheck) Optional() *mAccessServiceMockCheck {
-	mmCheck.optional = true
-	return mmCheck
+func (mmBatchCheck *mAccessServiceMockBatchCheck) Optional() *mAccessServiceMockBatchCheck {
+	mmBatchCheck.optional = true
+	return mmBatchCheck
 }
 
-// Expect sets up expected params for AccessService.Check
-func (mmCheck *mAccessServiceMockCheck) Expect(ctx context.Context, endpoint string) *mAccessServiceMockCheck {
-	if mmCheck.mock.funcCheck != nil {
-		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Set")
+// Expect sets up expected params for AccessService.BatchCheck
+func (mmBatchCheck *mAccessServiceMockBatchCheck) Expect(ctx context.Context, userID string, checks []*model.AccessCheck) *mAccessServiceMockBatchCheck {
+	if mmBatchCheck.mock.funcBatchCheck != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Set")
 	}
 
-	if mmCheck.defaultExpectation == nil {
-		mmCheck.defaultExpectation = &AccessServiceMockCheckExpectation{}
+	if mmBatchCheck.defaultExpectation == nil {
+		mmBatchCheck.defaultExpectation = &AccessServiceMockBatchCheckExpectation{}
 	}
 
-	if mmCheck.defaultExpectation.paramPtrs != nil {
-		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by ExpectParams functions")
+	if mmBatchCheck.defaultExpectation.paramPtrs != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by ExpectParams functions")
 	}
 
-	mmCheck.defaultExpectation.params = &AccessServiceMockCheckParams{ctx, endpoint}
-	mmCheck.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCheck.expectations {
-		if minimock.Equal(e.params, mmCheck.defaultExpectation.params) {
-			mmCheck.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheck.defaultExpectation.params)
+	mmBatchCheck.defaultExpectation.params = &AccessServiceMockBatchCheckParams{ctx, userID, checks}
+	mmBatchCheck.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmBatchCheck.expectations {
+		if minimock.Equal(e.params, mmBatchCheck.defaultExpectation.params) {
+			mmBatchCheck.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBatchCheck.defaultExpectation.params)
 		}
 	}
 
-	return mmCheck
+	return mmBatchCheck
 }
 
-// ExpectCtxParam1 sets up expected param ctx for AccessService.Check
-func (mmCheck *mAccessServiceMockCheck) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockCheck {
-	if mmCheck.mock.funcCheck != nil {
-		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for AccessService.BatchCheck
+func (mmBatchCheck *mAccessServiceMockBatchCheck) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockBatchCheck {
+	if mmBatchCheck.mock.funcBatchCheck != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Set")
 	}
 
-	if mmCheck.defaultExpectation == nil {
-		mmCheck.defaultExpectation = &AccessServiceMockCheckExpectation{}
+	if mmBatchCheck.defaultExpectation == nil {
+		mmBatchCheck.defaultExpectation = &AccessServiceMockBatchCheckExpectation{}
 	}
 
-	if mmCheck.defaultExpectation.params != nil {
-		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Expect")
+	if mmBatchCheck.defaultExpectation.params != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Expect")
+	}
+
+	if mmBatchCheck.defaultExpectation.paramPtrs == nil {
+		mmBatchCheck.defaultExpectation.paramPtrs = &AccessServiceMockBatchCheckParamPtrs{}
+	}
+	mmBatchCheck.defaultExpectation.paramPtrs.ctx = &ctx
+	mmBatchCheck.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmBatchCheck
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AccessService.BatchCheck
+func (mmBatchCheck *mAccessServiceMockBatchCheck) ExpectUserIDParam2(userID string) *mAccessServiceMockBatchCheck {
+	if mmBatchCheck.mock.funcBatchCheck != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Set")
+	}
+
+	if mmBatchCheck.defaultExpectation == nil {
+		mmBatchCheck.defaultExpectation = &AccessServiceMockBatchCheckExpectation{}
+	}
+
+	if mmBatchCheck.defaultExpectation.params != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Expect")
+	}
+
+	if mmBatchCheck.defaultExpectation.paramPtrs == nil {
+		mmBatchCheck.defaultExpectation.paramPtrs = &AccessServiceMockBatchCheckParamPtrs{}
+	}
+	mmBatchCheck.defaultExpectation.paramPtrs.userID = &userID
+	mmBatchCheck.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmBatchCheck
+}
+
+// ExpectChecksParam3 sets up expected param checks for AccessService.BatchCheck
+func (mmBatchCheck *mAccessServiceMockBatchCheck) ExpectChecksParam3(checks []*model.AccessCheck) *mAccessServiceMockBatchCheck {
+	if mmBatchCheck.mock.funcBatchCheck != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Set")
+	}
+
+	if mmBatchCheck.defaultExpectation == nil {
+		mmBatchCheck.defaultExpectation = &AccessServiceMockBatchCheckExpectation{}
+	}
+
+	if mmBatchCheck.defaultExpectation.params != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Expect")
+	}
+
+	if mmBatchCheck.defaultExpectation.paramPtrs == nil {
+		mmBatchCheck.defaultExpectation.paramPtrs = &AccessServiceMockBatchCheckParamPtrs{}
+	}
+	mmBatchCheck.defaultExpectation.paramPtrs.checks = &checks
+	mmBatchCheck.defaultExpectation.expectationOrigins.originChecks = minimock.CallerInfo(1)
+
+	return mmBatchCheck
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.BatchCheck
+func (mmBatchCheck *mAccessServiceMockBatchCheck) Inspect(f func(ctx context.Context, userID string, checks []*model.AccessCheck)) *mAccessServiceMockBatchCheck {
+	if mmBatchCheck.mock.inspectFuncBatchCheck != nil {
+		mmBatchCheck.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.BatchCheck")
+	}
+
+	mmBatchCheck.mock.inspectFuncBatchCheck = f
+
+	return mmBatchCheck
+}
+
+// Return sets up results that will be returned by AccessService.BatchCheck
+func (mmBatchCheck *mAccessServiceMockBatchCheck) Return(apa1 []*model.AccessDecision, err error) *AccessServiceMock {
+	if mmBatchCheck.mock.funcBatchCheck != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Set")
+	}
+
+	if mmBatchCheck.defaultExpectation == nil {
+		mmBatchCheck.defaultExpectation = &AccessServiceMockBatchCheckExpectation{mock: mmBatchCheck.mock}
+	}
+	mmBatchCheck.defaultExpectation.results = &AccessServiceMockBatchCheckResults{apa1, err}
+	mmBatchCheck.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmBatchCheck.mock
+}
+
+// Set uses given function f to mock the AccessService.BatchCheck method
+func (mmBatchCheck *mAccessServiceMockBatchCheck) Set(f func(ctx context.Context, userID string, checks []*model.AccessCheck) (apa1 []*model.AccessDecision, err error)) *AccessServiceMock {
+	if mmBatchCheck.defaultExpectation != nil {
+		mmBatchCheck.mock.t.Fatalf("Default expectation is already set for the AccessService.BatchCheck method")
+	}
+
+	if len(mmBatchCheck.expectations) > 0 {
+		mmBatchCheck.mock.t.Fatalf("Some expectations are already set for the AccessService.BatchCheck method")
+	}
+
+	mmBatchCheck.mock.funcBatchCheck = f
+	mmBatchCheck.mock.funcBatchCheckOrigin = minimock.CallerInfo(1)
+	return mmBatchCheck.mock
+}
+
+// When sets expectation for the AccessService.BatchCheck which will trigger the result defined by the following
+// Then helper
+func (mmBatchCheck *mAccessServiceMockBatchCheck) When(ctx context.Context, userID string, checks []*model.AccessCheck) *AccessServiceMockBatchCheckExpectation {
+	if mmBatchCheck.mock.funcBatchCheck != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockBatchCheckExpectation{
+		mock:               mmBatchCheck.mock,
+		params:             &AccessServiceMockBatchCheckParams{ctx, userID, checks},
+		expectationOrigins: AccessServiceMockBatchCheckExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmBatchCheck.expectations = append(mmBatchCheck.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.BatchCheck return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockBatchCheckExpectation) Then(apa1 []*model.AccessDecision, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockBatchCheckResults{apa1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.BatchCheck should be invoked
+func (mmBatchCheck *mAccessServiceMockBatchCheck) Times(n uint64) *mAccessServiceMockBatchCheck {
+	if n == 0 {
+		mmBatchCheck.mock.t.Fatalf("Times of AccessServiceMock.BatchCheck mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmBatchCheck.expectedInvocations, n)
+	mmBatchCheck.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmBatchCheck
+}
+
+func (mmBatchCheck *mAccessServiceMockBatchCheck) invocationsDone() bool {
+	if len(mmBatchCheck.expectations) == 0 && mmBatchCheck.defaultExpectation == nil && mmBatchCheck.mock.funcBatchCheck == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmBatchCheck.mock.afterBatchCheckCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmBatchCheck.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// BatchCheck implements mm_service.AccessService
+func (mmBatchCheck *AccessServiceMock) BatchCheck(ctx context.Context, userID string, checks []*model.AccessCheck) (apa1 []*model.AccessDecision, err error) {
+	mm_atomic.AddUint64(&mmBatchCheck.beforeBatchCheckCounter, 1)
+	defer mm_atomic.AddUint64(&mmBatchCheck.afterBatchCheckCounter, 1)
+
+	mmBatchCheck.t.Helper()
+
+	if mmBatchCheck.inspectFuncBatchCheck != nil {
+		mmBatchCheck.inspectFuncBatchCheck(ctx, userID, checks)
+	}
+
+	mm_params := AccessServiceMockBatchCheckParams{ctx, userID, checks}
+
+	// Record call args
+	mmBatchCheck.BatchCheckMock.mutex.Lock()
+	mmBatchCheck.BatchCheckMock.callArgs = append(mmBatchCheck.BatchCheckMock.callArgs, &mm_params)
+	mmBatchCheck.BatchCheckMock.mutex.Unlock()
+
+	for _, e := range mmBatchCheck.BatchCheckMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.apa1, e.results.err
+		}
+	}
+
+	if mmBatchCheck.BatchCheckMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBatchCheck.BatchCheckMock.defaultExpectation.Counter, 1)
+		mm_want := mmBatchCheck.BatchCheckMock.defaultExpectation.params
+		mm_want_ptrs := mmBatchCheck.BatchCheckMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockBatchCheckParams{ctx, userID, checks}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmBatchCheck.t.Errorf("AccessServiceMock.BatchCheck got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBatchCheck.BatchCheckMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmBatchCheck.t.Errorf("AccessServiceMock.BatchCheck got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBatchCheck.BatchCheckMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.checks != nil && !minimock.Equal(*mm_want_ptrs.checks, mm_got.checks) {
+				mmBatchCheck.t.Errorf("AccessServiceMock.BatchCheck got unexpected parameter checks, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBatchCheck.BatchCheckMock.defaultExpectation.expectationOrigins.originChecks, *mm_want_ptrs.checks, mm_got.checks, minimock.Diff(*mm_want_ptrs.checks, mm_got.checks))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmBatchCheck.t.Errorf("AccessServiceMock.BatchCheck got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmBatchCheck.BatchCheckMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmBatchCheck.BatchCheckMock.defaultExpectation.results
+		if mm_results == nil {
+			mmBatchCheck.t.Fatal("No results are set for the AccessServiceMock.BatchCheck")
+		}
+		return (*mm_results).apa1, (*mm_results).err
+	}
+	if mmBatchCheck.funcBatchCheck != nil {
+		return mmBatchCheck.funcBatchCheck(ctx, userID, checks)
+	}
+	mmBatchCheck.t.Fatalf("Unexpected call to AccessServiceMock.BatchCheck. %v %v %v", ctx, userID, checks)
+	return
+}
+
+// BatchCheckAfterCounter returns a count of finished AccessServiceMock.BatchCheck invocations
+func (mmBatchCheck *AccessServiceMock) BatchCheckAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBatchCheck.afterBatchCheckCounter)
+}
+
+// BatchCheckBeforeCounter returns a count of AccessServiceMock.BatchCheck invocations
+func (mmBatchCheck *AccessServiceMock) BatchCheckBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBatchCheck.beforeBatchCheckCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.BatchCheck.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmBatchCheck *mAccessServiceMockBatchCheck) Calls() []*AccessServiceMockBatchCheckParams {
+	mmBatchCheck.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockBatchCheckParams, len(mmBatchCheck.callArgs))
+	copy(argCopy, mmBatchCheck.callArgs)
+
+	mmBatchCheck.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockBatchCheckDone returns true if the count of the BatchCheck invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockBatchCheckDone() bool {
+	if m.BatchCheckMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.BatchCheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.BatchCheckMock.invocationsDone()
+}
+
+// MinimockBatchCheckInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockBatchCheckInspect() {
+	for _, e := range m.BatchCheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.BatchCheck at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterBatchCheckCounter := mm_atomic.LoadUint64(&m.afterBatchCheckCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BatchCheckMock.defaultExpectation != nil && afterBatchCheckCounter < 1 {
+		if m.BatchCheckMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessServiceMock.BatchCheck at\n%s", m.BatchCheckMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.BatchCheck at\n%s with params: %#v", m.BatchCheckMock.defaultExpectation.expectationOrigins.origin, *m.BatchCheckMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBatchCheck != nil && afterBatchCheckCounter < 1 {
+		m.t.Errorf("Expected call to AccessServiceMock.BatchCheck at\n%s", m.funcBatchCheckOrigin)
+	}
+
+	if !m.BatchCheckMock.invocationsDone() && afterBatchCheckCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.BatchCheck at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.BatchCheckMock.expectedInvocations), m.BatchCheckMock.expectedInvocationsOrigin, afterBatchCheckCounter)
+	}
+}
+
+type mAccessServiceMockCheck struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockCheckExpectation
+	expectations       []*AccessServiceMockCheckExpectation
+
+	callArgs []*AccessServiceMockCheckParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessServiceMockCheckExpectation specifies expectation struct of the AccessService.Check
+type AccessServiceMockCheckExpectation struct {
+	mock               *AccessServiceMock
+	params             *AccessServiceMockCheckParams
+	paramPtrs          *AccessServiceMockCheckParamPtrs
+	expectationOrigins AccessServiceMockCheckExpectationOrigins
+	results            *AccessServiceMockCheckResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessServiceMockCheckParams contains parameters of the AccessService.Check
+type AccessServiceMockCheckParams struct {
+	ctx        context.Context
+	endpoint   string
+	attributes map[string]string
+}
+
+// AccessServiceMockCheckParamPtrs contains pointers to parameters of the AccessService.Check
+type AccessServiceMockCheckParamPtrs struct {
+	ctx        *context.Context
+	endpoint   *string
+	attributes *map[string]string
+}
+
+// AccessServiceMockCheckResults contains results of the AccessService.Check
+type AccessServiceMockCheckResults struct {
+	err error
+}
+
+// AccessServiceMockCheckOrigins contains origins of expectations of the AccessService.Check
+type AccessServiceMockCheckExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originEndpoint   string
+	originAttributes string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheck *mAccessServiceMockCheck) Optional() *mAccessServiceMockCheck {
+	mmCheck.optional = true
+	return mmCheck
+}
+
+// Expect sets up expected params for AccessService.Check
+func (mmCheck *mAccessServiceMockCheck) Expect(ctx context.Context, endpoint string, attributes map[string]string) *mAccessServiceMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &AccessServiceMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by ExpectParams functions")
+	}
+
+	mmCheck.defaultExpectation.params = &AccessServiceMockCheckParams{ctx, endpoint, attributes}
+	mmCheck.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheck.expectations {
+		if minimock.Equal(e.params, mmCheck.defaultExpectation.params) {
+			mmCheck.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheck.defaultExpectation.params)
+		}
+	}
+
+	return mmCheck
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.Check
+func (mmCheck *mAccessServiceMockCheck) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &AccessServiceMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Expect")
 	}
 
 	if mmCheck.defaultExpectation.paramPtrs == nil {
@@ -583,8 +1403,31 @@ func (mmCheck *mAccessServiceMockCheck) ExpectEndpointParam2(endpoint string) *m
 	return mmCheck
 }
 
+// ExpectAttributesParam3 sets up expected param attributes for AccessService.Check
+func (mmCheck *mAccessServiceMockCheck) ExpectAttributesParam3(attributes map[string]string) *mAccessServiceMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &AccessServiceMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &AccessServiceMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.attributes = &attributes
+	mmCheck.defaultExpectation.expectationOrigins.originAttributes = minimock.CallerInfo(1)
+
+	return mmCheck
+}
+
 // Inspect accepts an inspector function that has same arguments as the AccessService.Check
-func (mmCheck *mAccessServiceMockCheck) Inspect(f func(ctx context.Context, endpoint string)) *mAccessServiceMockCheck {
+func (mmCheck *mAccessServiceMockCheck) Inspect(f func(ctx context.Context, endpoint string, attributes map[string]string)) *mAccessServiceMockCheck {
 	if mmCheck.mock.inspectFuncCheck != nil {
 		mmCheck.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.Check")
 	}
@@ -609,7 +1452,7 @@ func (mmCheck *mAccessServiceMockCheck) Return(err error) *AccessServiceMock {
 }
 
 // Set uses given function f to mock the AccessService.Check method
-func (mmCheck *mAccessServiceMockCheck) Set(f func(ctx context.Context, endpoint string) (err error)) *AccessServiceMock {
+func (mmCheck *mAccessServiceMockCheck) Set(f func(ctx context.Context, endpoint string, attributes map[string]string) (err error)) *AccessServiceMock {
 	if mmCheck.defaultExpectation != nil {
 		mmCheck.mock.t.Fatalf("Default expectation is already set for the AccessService.Check method")
 	}
@@ -625,14 +1468,14 @@ func (mmCheck *mAccessServiceMockCheck) Set(f func(ctx context.Context, endpoint
 
 // When sets expectation for the AccessService.Check which will trigger the result defined by the following
 // Then helper
-func (mmCheck *mAccessServiceMockCheck) When(ctx context.Context, endpoint string) *AccessServiceMockCheckExpectation {
+func (mmCheck *mAccessServiceMockCheck) When(ctx context.Context, endpoint string, attributes map[string]string) *AccessServiceMockCheckExpectation {
 	if mmCheck.mock.funcCheck != nil {
 		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Set")
 	}
 
 	expectation := &AccessServiceMockCheckExpectation{
 		mock:               mmCheck.mock,
-		params:             &AccessServiceMockCheckParams{ctx, endpoint},
+		params:             &AccessServiceMockCheckParams{ctx, endpoint, attributes},
 		expectationOrigins: AccessServiceMockCheckExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmCheck.expectations = append(mmCheck.expectations, expectation)
@@ -667,17 +1510,17 @@ func (mmCheck *mAccessServiceMockCheck) invocationsDone() bool {
 }
 
 // Check implements mm_service.AccessService
-func (mmCheck *AccessServiceMock) Check(ctx context.Context, endpoint string) (err error) {
+func (mmCheck *AccessServiceMock) Check(ctx context.Context, endpoint string, attributes map[string]string) (err error) {
 	mm_atomic.AddUint64(&mmCheck.beforeCheckCounter, 1)
 	defer mm_atomic.AddUint64(&mmCheck.afterCheckCounter, 1)
 
 	mmCheck.t.Helper()
 
 	if mmCheck.inspectFuncCheck != nil {
-		mmCheck.inspectFuncCheck(ctx, endpoint)
+		mmCheck.inspectFuncCheck(ctx, endpoint, attributes)
 	}
 
-	mm_params := AccessServiceMockCheckParams{ctx, endpoint}
+	mm_params := AccessServiceMockCheckParams{ctx, endpoint, attributes}
 
 	// Record call args
 	mmCheck.CheckMock.mutex.Lock()
@@ -696,7 +1539,7 @@ func (mmCheck *AccessServiceMock) Check(ctx context.Context, endpoint string) (e
 		mm_want := mmCheck.CheckMock.defaultExpectation.params
 		mm_want_ptrs := mmCheck.CheckMock.defaultExpectation.paramPtrs
 
-		mm_got := AccessServiceMockCheckParams{ctx, endpoint}
+		mm_got := AccessServiceMockCheckParams{ctx, endpoint, attributes}
 
 		if mm_want_ptrs != nil {
 
@@ -710,6 +1553,11 @@ func (mmCheck *AccessServiceMock) Check(ctx context.Context, endpoint string) (e
 					mmCheck.CheckMock.defaultExpectation.expectationOrigins.originEndpoint, *mm_want_ptrs.endpoint, mm_got.endpoint, minimock.Diff(*mm_want_ptrs.endpoint, mm_got.endpoint))
 			}
 
+			if mm_want_ptrs.attributes != nil && !minimock.Equal(*mm_want_ptrs.attributes, mm_got.attributes) {
+				mmCheck.t.Errorf("AccessServiceMock.Check got unexpected parameter attributes, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheck.CheckMock.defaultExpectation.expectationOrigins.originAttributes, *mm_want_ptrs.attributes, mm_got.attributes, minimock.Diff(*mm_want_ptrs.attributes, mm_got.attributes))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmCheck.t.Errorf("AccessServiceMock.Check got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmCheck.CheckMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -722,9 +1570,9 @@ func (mmCheck *AccessServiceMock) Check(ctx context.Context, endpoint string) (e
 		return (*mm_results).err
 	}
 	if mmCheck.funcCheck != nil {
-		return mmCheck.funcCheck(ctx, endpoint)
+		return mmCheck.funcCheck(ctx, endpoint, attributes)
 	}
-	mmCheck.t.Fatalf("Unexpected call to AccessServiceMock.Check. %v %v", ctx, endpoint)
+	mmCheck.t.Fatalf("Unexpected call to AccessServiceMock.Check. %v %v %v", ctx, endpoint, attributes)
 	return
 }
 
@@ -733,66 +1581,1527 @@ func (mmCheck *AccessServiceMock) CheckAfterCounter() uint64 {
 	return mm_atomic.LoadUint64(&mmCheck.afterCheckCounter)
 }
 
-// CheckBeforeCounter returns a count of AccessServiceMock.Check invocations
-func (mmCheck *AccessServiceMock) CheckBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCheck.beforeCheckCounter)
+// CheckBeforeCounter returns a count of AccessServiceMock.Check invocations
+func (mmCheck *AccessServiceMock) CheckBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheck.beforeCheckCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.Check.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheck *mAccessServiceMockCheck) Calls() []*AccessServiceMockCheckParams {
+	mmCheck.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockCheckParams, len(mmCheck.callArgs))
+	copy(argCopy, mmCheck.callArgs)
+
+	mmCheck.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckDone returns true if the count of the Check invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockCheckDone() bool {
+	if m.CheckMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckMock.invocationsDone()
+}
+
+// MinimockCheckInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockCheckInspect() {
+	for _, e := range m.CheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.Check at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckCounter := mm_atomic.LoadUint64(&m.afterCheckCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckMock.defaultExpectation != nil && afterCheckCounter < 1 {
+		if m.CheckMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessServiceMock.Check at\n%s", m.CheckMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.Check at\n%s with params: %#v", m.CheckMock.defaultExpectation.expectationOrigins.origin, *m.CheckMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheck != nil && afterCheckCounter < 1 {
+		m.t.Errorf("Expected call to AccessServiceMock.Check at\n%s", m.funcCheckOrigin)
+	}
+
+	if !m.CheckMock.invocationsDone() && afterCheckCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.Check at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckMock.expectedInvocations), m.CheckMock.expectedInvocationsOrigin, afterCheckCounter)
+	}
+}
+
+type mAccessServiceMockCheckAs struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockCheckAsExpectation
+	expectations       []*AccessServiceMockCheckAsExpectation
+
+	callArgs []*AccessServiceMockCheckAsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessServiceMockCheckAsExpectation specifies expectation struct of the AccessService.CheckAs
+type AccessServiceMockCheckAsExpectation struct {
+	mock               *AccessServiceMock
+	params             *AccessServiceMockCheckAsParams
+	paramPtrs          *AccessServiceMockCheckAsParamPtrs
+	expectationOrigins AccessServiceMockCheckAsExpectationOrigins
+	results            *AccessServiceMockCheckAsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessServiceMockCheckAsParams contains parameters of the AccessService.CheckAs
+type AccessServiceMockCheckAsParams struct {
+	ctx        context.Context
+	principal  *model.AccessPrincipal
+	endpoint   string
+	attributes map[string]string
+}
+
+// AccessServiceMockCheckAsParamPtrs contains pointers to parameters of the AccessService.CheckAs
+type AccessServiceMockCheckAsParamPtrs struct {
+	ctx        *context.Context
+	principal  **model.AccessPrincipal
+	endpoint   *string
+	attributes *map[string]string
+}
+
+// AccessServiceMockCheckAsResults contains results of the AccessService.CheckAs
+type AccessServiceMockCheckAsResults struct {
+	err error
+}
+
+// AccessServiceMockCheckAsOrigins contains origins of expectations of the AccessService.CheckAs
+type AccessServiceMockCheckAsExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originPrincipal  string
+	originEndpoint   string
+	originAttributes string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheckAs *mAccessServiceMockCheckAs) Optional() *mAccessServiceMockCheckAs {
+	mmCheckAs.optional = true
+	return mmCheckAs
+}
+
+// Expect sets up expected params for AccessService.CheckAs
+func (mmCheckAs *mAccessServiceMockCheckAs) Expect(ctx context.Context, principal *model.AccessPrincipal, endpoint string, attributes map[string]string) *mAccessServiceMockCheckAs {
+	if mmCheckAs.mock.funcCheckAs != nil {
+		mmCheckAs.mock.t.Fatalf("AccessServiceMock.CheckAs mock is already set by Set")
+	}
+
+	if mmCheckAs.defaultExpectation == nil {
+		mmCheckAs.defaultExpectation = &AccessServiceMockCheckAsExpectation{}
+	}
+
+	if mmCheckAs.defaultExpectation.paramPtrs != nil {
+		mmCheckAs.mock.t.Fatalf("AccessServiceMock.CheckAs mock is already set by ExpectParams functions")
+	}
+
+	mmCheckAs.defaultExpectation.params = &AccessServiceMockCheckAsParams{ctx, principal, endpoint, attributes}
+	mmCheckAs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheckAs.expectations {
+		if minimock.Equal(e.params, mmCheckAs.defaultExpectation.params) {
+			mmCheckAs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckAs.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckAs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.CheckAs
+func (mmCheckAs *mAccessServiceMockCheckAs) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockCheckAs {
+	if mmCheckAs.mock.funcCheckAs != nil {
+		mmCheckAs.mock.t.Fatalf("AccessServiceMock.CheckAs mock is already set by Set")
+	}
+
+	if mmCheckAs.defaultExpectation == nil {
+		mmCheckAs.defaultExpectation = &AccessServiceMockCheckAsExpectation{}
+	}
+
+	if mmCheckAs.defaultExpectation.params != nil {
+		mmCheckAs.mock.t.Fatalf("AccessServiceMock.CheckAs mock is already set by Expect")
+	}
+
+	if mmCheckAs.defaultExpectation.paramPtrs == nil {
+		mmCheckAs.defaultExpectation.paramPtrs = &AccessServiceMockCheckAsParamPtrs{}
+	}
+	mmCheckAs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheckAs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCheckAs
+}
+
+// ExpectPrincipalParam2 sets up expected param principal for AccessService.CheckAs
+func (mmCheckAs *mAccessServiceMockCheckAs) ExpectPrincipalParam2(principal *model.AccessPrincipal) *mAccessServiceMockCheckAs {
+	if mmCheckAs.mock.funcCheckAs != nil {
+		mmCheckAs.mock.t.Fatalf("AccessServiceMock.CheckAs mock is already set by Set")
+	}
+
+	if mmCheckAs.defaultExpectation == nil {
+		mmCheckAs.defaultExpectation = &AccessServiceMockCheckAsExpectation{}
+	}
+
+	if mmCheckAs.defaultExpectation.params != nil {
+		mmCheckAs.mock.t.Fatalf("AccessServiceMock.CheckAs mock is already set by Expect")
+	}
+
+	if mmCheckAs.defaultExpectation.paramPtrs == nil {
+		mmCheckAs.defaultExpectation.paramPtrs = &AccessServiceMockCheckAsParamPtrs{}
+	}
+	mmCheckAs.defaultExpectation.paramPtrs.principal = &principal
+	mmCheckAs.defaultExpectation.expectationOrigins.originPrincipal = minimock.CallerInfo(1)
+
+	return mmCheckAs
+}
+
+// ExpectEndpointParam3 sets up expected param endpoint for AccessService.CheckAs
+func (mmCheckAs *mAccessServiceMockCheckAs) ExpectEndpointParam3(endpoint string) *mAccessServiceMockCheckAs {
+	if mmCheckAs.mock.funcCheckAs != nil {
+		mmCheckAs.mock.t.Fatalf("AccessServiceMock.CheckAs mock is already set by Set")
+	}
+
+	if mmCheckAs.defaultExpectation == nil {
+		mmCheckAs.defaultExpectation = &AccessServiceMockCheckAsExpectation{}
+	}
+
+	if mmCheckAs.defaultExpectation.params != nil {
+		mmCheckAs.mock.t.Fatalf("AccessServiceMock.CheckAs mock is already set by Expect")
+	}
+
+	if mmCheckAs.defaultExpectation.paramPtrs == nil {
+		mmCheckAs.defaultExpectation.paramPtrs = &AccessServiceMockCheckAsParamPtrs{}
+	}
+	mmCheckAs.defaultExpectation.paramPtrs.endpoint = &endpoint
+	mmCheckAs.defaultExpectation.expectationOrigins.originEndpoint = minimock.CallerInfo(1)
+
+	return mmCheckAs
+}
+
+// ExpectAttributesParam4 sets up expected param attributes for AccessService.CheckAs
+func (mmCheckAs *mAccessServiceMockCheckAs) ExpectAttributesParam4(attributes map[string]string) *mAccessServiceMockCheckAs {
+	if mmCheckAs.mock.funcCheckAs != nil {
+		mmCheckAs.mock.t.Fatalf("AccessServiceMock.CheckAs mock is already set by Set")
+	}
+
+	if mmCheckAs.defaultExpectation == nil {
+		mmCheckAs.defaultExpectation = &AccessServiceMockCheckAsExpectation{}
+	}
+
+	if mmCheckAs.defaultExpectation.params != nil {
+		mmCheckAs.mock.t.Fatalf("AccessServiceMock.CheckAs mock is already set by Expect")
+	}
+
+	if mmCheckAs.defaultExpectation.paramPtrs == nil {
+		mmCheckAs.defaultExpectation.paramPtrs = &AccessServiceMockCheckAsParamPtrs{}
+	}
+	mmCheckAs.defaultExpectation.paramPtrs.attributes = &attributes
+	mmCheckAs.defaultExpectation.expectationOrigins.originAttributes = minimock.CallerInfo(1)
+
+	return mmCheckAs
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.CheckAs
+func (mmCheckAs *mAccessServiceMockCheckAs) Inspect(f func(ctx context.Context, principal *model.AccessPrincipal, endpoint string, attributes map[string]string)) *mAccessServiceMockCheckAs {
+	if mmCheckAs.mock.inspectFuncCheckAs != nil {
+		mmCheckAs.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.CheckAs")
+	}
+
+	mmCheckAs.mock.inspectFuncCheckAs = f
+
+	return mmCheckAs
+}
+
+// Return sets up results that will be returned by AccessService.CheckAs
+func (mmCheckAs *mAccessServiceMockCheckAs) Return(err error) *AccessServiceMock {
+	if mmCheckAs.mock.funcCheckAs != nil {
+		mmCheckAs.mock.t.Fatalf("AccessServiceMock.CheckAs mock is already set by Set")
+	}
+
+	if mmCheckAs.defaultExpectation == nil {
+		mmCheckAs.defaultExpectation = &AccessServiceMockCheckAsExpectation{mock: mmCheckAs.mock}
+	}
+	mmCheckAs.defaultExpectation.results = &AccessServiceMockCheckAsResults{err}
+	mmCheckAs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheckAs.mock
+}
+
+// Set uses given function f to mock the AccessService.CheckAs method
+func (mmCheckAs *mAccessServiceMockCheckAs) Set(f func(ctx context.Context, principal *model.AccessPrincipal, endpoint string, attributes map[string]string) (err error)) *AccessServiceMock {
+	if mmCheckAs.defaultExpectation != nil {
+		mmCheckAs.mock.t.Fatalf("Default expectation is already set for the AccessService.CheckAs method")
+	}
+
+	if len(mmCheckAs.expectations) > 0 {
+		mmCheckAs.mock.t.Fatalf("Some expectations are already set for the AccessService.CheckAs method")
+	}
+
+	mmCheckAs.mock.funcCheckAs = f
+	mmCheckAs.mock.funcCheckAsOrigin = minimock.CallerInfo(1)
+	return mmCheckAs.mock
+}
+
+// When sets expectation for the AccessService.CheckAs which will trigger the result defined by the following
+// Then helper
+func (mmCheckAs *mAccessServiceMockCheckAs) When(ctx context.Context, principal *model.AccessPrincipal, endpoint string, attributes map[string]string) *AccessServiceMockCheckAsExpectation {
+	if mmCheckAs.mock.funcCheckAs != nil {
+		mmCheckAs.mock.t.Fatalf("AccessServiceMock.CheckAs mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockCheckAsExpectation{
+		mock:               mmCheckAs.mock,
+		params:             &AccessServiceMockCheckAsParams{ctx, principal, endpoint, attributes},
+		expectationOrigins: AccessServiceMockCheckAsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheckAs.expectations = append(mmCheckAs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.CheckAs return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockCheckAsExpectation) Then(err error) *AccessServiceMock {
+	e.results = &AccessServiceMockCheckAsResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.CheckAs should be invoked
+func (mmCheckAs *mAccessServiceMockCheckAs) Times(n uint64) *mAccessServiceMockCheckAs {
+	if n == 0 {
+		mmCheckAs.mock.t.Fatalf("Times of AccessServiceMock.CheckAs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckAs.expectedInvocations, n)
+	mmCheckAs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheckAs
+}
+
+func (mmCheckAs *mAccessServiceMockCheckAs) invocationsDone() bool {
+	if len(mmCheckAs.expectations) == 0 && mmCheckAs.defaultExpectation == nil && mmCheckAs.mock.funcCheckAs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckAs.mock.afterCheckAsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckAs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckAs implements mm_service.AccessService
+func (mmCheckAs *AccessServiceMock) CheckAs(ctx context.Context, principal *model.AccessPrincipal, endpoint string, attributes map[string]string) (err error) {
+	mm_atomic.AddUint64(&mmCheckAs.beforeCheckAsCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckAs.afterCheckAsCounter, 1)
+
+	mmCheckAs.t.Helper()
+
+	if mmCheckAs.inspectFuncCheckAs != nil {
+		mmCheckAs.inspectFuncCheckAs(ctx, principal, endpoint, attributes)
+	}
+
+	mm_params := AccessServiceMockCheckAsParams{ctx, principal, endpoint, attributes}
+
+	// Record call args
+	mmCheckAs.CheckAsMock.mutex.Lock()
+	mmCheckAs.CheckAsMock.callArgs = append(mmCheckAs.CheckAsMock.callArgs, &mm_params)
+	mmCheckAs.CheckAsMock.mutex.Unlock()
+
+	for _, e := range mmCheckAs.CheckAsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheckAs.CheckAsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckAs.CheckAsMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckAs.CheckAsMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckAs.CheckAsMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockCheckAsParams{ctx, principal, endpoint, attributes}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckAs.t.Errorf("AccessServiceMock.CheckAs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckAs.CheckAsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.principal != nil && !minimock.Equal(*mm_want_ptrs.principal, mm_got.principal) {
+				mmCheckAs.t.Errorf("AccessServiceMock.CheckAs got unexpected parameter principal, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckAs.CheckAsMock.defaultExpectation.expectationOrigins.originPrincipal, *mm_want_ptrs.principal, mm_got.principal, minimock.Diff(*mm_want_ptrs.principal, mm_got.principal))
+			}
+
+			if mm_want_ptrs.endpoint != nil && !minimock.Equal(*mm_want_ptrs.endpoint, mm_got.endpoint) {
+				mmCheckAs.t.Errorf("AccessServiceMock.CheckAs got unexpected parameter endpoint, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckAs.CheckAsMock.defaultExpectation.expectationOrigins.originEndpoint, *mm_want_ptrs.endpoint, mm_got.endpoint, minimock.Diff(*mm_want_ptrs.endpoint, mm_got.endpoint))
+			}
+
+			if mm_want_ptrs.attributes != nil && !minimock.Equal(*mm_want_ptrs.attributes, mm_got.attributes) {
+				mmCheckAs.t.Errorf("AccessServiceMock.CheckAs got unexpected parameter attributes, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckAs.CheckAsMock.defaultExpectation.expectationOrigins.originAttributes, *mm_want_ptrs.attributes, mm_got.attributes, minimock.Diff(*mm_want_ptrs.attributes, mm_got.attributes))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckAs.t.Errorf("AccessServiceMock.CheckAs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheckAs.CheckAsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckAs.CheckAsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckAs.t.Fatal("No results are set for the AccessServiceMock.CheckAs")
+		}
+		return (*mm_results).err
+	}
+	if mmCheckAs.funcCheckAs != nil {
+		return mmCheckAs.funcCheckAs(ctx, principal, endpoint, attributes)
+	}
+	mmCheckAs.t.Fatalf("Unexpected call to AccessServiceMock.CheckAs. %v %v %v %v", ctx, principal, endpoint, attributes)
+	return
+}
+
+// CheckAsAfterCounter returns a count of finished AccessServiceMock.CheckAs invocations
+func (mmCheckAs *AccessServiceMock) CheckAsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckAs.afterCheckAsCounter)
+}
+
+// CheckAsBeforeCounter returns a count of AccessServiceMock.CheckAs invocations
+func (mmCheckAs *AccessServiceMock) CheckAsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckAs.beforeCheckAsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.CheckAs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckAs *mAccessServiceMockCheckAs) Calls() []*AccessServiceMockCheckAsParams {
+	mmCheckAs.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockCheckAsParams, len(mmCheckAs.callArgs))
+	copy(argCopy, mmCheckAs.callArgs)
+
+	mmCheckAs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckAsDone returns true if the count of the CheckAs invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockCheckAsDone() bool {
+	if m.CheckAsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckAsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckAsMock.invocationsDone()
+}
+
+// MinimockCheckAsInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockCheckAsInspect() {
+	for _, e := range m.CheckAsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.CheckAs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckAsCounter := mm_atomic.LoadUint64(&m.afterCheckAsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckAsMock.defaultExpectation != nil && afterCheckAsCounter < 1 {
+		if m.CheckAsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessServiceMock.CheckAs at\n%s", m.CheckAsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.CheckAs at\n%s with params: %#v", m.CheckAsMock.defaultExpectation.expectationOrigins.origin, *m.CheckAsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckAs != nil && afterCheckAsCounter < 1 {
+		m.t.Errorf("Expected call to AccessServiceMock.CheckAs at\n%s", m.funcCheckAsOrigin)
+	}
+
+	if !m.CheckAsMock.invocationsDone() && afterCheckAsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.CheckAs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckAsMock.expectedInvocations), m.CheckAsMock.expectedInvocationsOrigin, afterCheckAsCounter)
+	}
+}
+
+type mAccessServiceMockCheckPermission struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockCheckPermissionExpectation
+	expectations       []*AccessServiceMockCheckPermissionExpectation
+
+	callArgs []*AccessServiceMockCheckPermissionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessServiceMockCheckPermissionExpectation specifies expectation struct of the AccessService.CheckPermission
+type AccessServiceMockCheckPermissionExpectation struct {
+	mock               *AccessServiceMock
+	params             *AccessServiceMockCheckPermissionParams
+	paramPtrs          *AccessServiceMockCheckPermissionParamPtrs
+	expectationOrigins AccessServiceMockCheckPermissionExpectationOrigins
+	results            *AccessServiceMockCheckPermissionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessServiceMockCheckPermissionParams contains parameters of the AccessService.CheckPermission
+type AccessServiceMockCheckPermissionParams struct {
+	ctx        context.Context
+	permission string
+}
+
+// AccessServiceMockCheckPermissionParamPtrs contains pointers to parameters of the AccessService.CheckPermission
+type AccessServiceMockCheckPermissionParamPtrs struct {
+	ctx        *context.Context
+	permission *string
+}
+
+// AccessServiceMockCheckPermissionResults contains results of the AccessService.CheckPermission
+type AccessServiceMockCheckPermissionResults struct {
+	err error
+}
+
+// AccessServiceMockCheckPermissionOrigins contains origins of expectations of the AccessService.CheckPermission
+type AccessServiceMockCheckPermissionExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originPermission string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheckPermission *mAccessServiceMockCheckPermission) Optional() *mAccessServiceMockCheckPermission {
+	mmCheckPermission.optional = true
+	return mmCheckPermission
+}
+
+// Expect sets up expected params for AccessService.CheckPermission
+func (mmCheckPermission *mAccessServiceMockCheckPermission) Expect(ctx context.Context, permission string) *mAccessServiceMockCheckPermission {
+	if mmCheckPermission.mock.funcCheckPermission != nil {
+		mmCheckPermission.mock.t.Fatalf("AccessServiceMock.CheckPermission mock is already set by Set")
+	}
+
+	if mmCheckPermission.defaultExpectation == nil {
+		mmCheckPermission.defaultExpectation = &AccessServiceMockCheckPermissionExpectation{}
+	}
+
+	if mmCheckPermission.defaultExpectation.paramPtrs != nil {
+		mmCheckPermission.mock.t.Fatalf("AccessServiceMock.CheckPermission mock is already set by ExpectParams functions")
+	}
+
+	mmCheckPermission.defaultExpectation.params = &AccessServiceMockCheckPermissionParams{ctx, permission}
+	mmCheckPermission.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheckPermission.expectations {
+		if minimock.Equal(e.params, mmCheckPermission.defaultExpectation.params) {
+			mmCheckPermission.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckPermission.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckPermission
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.CheckPermission
+func (mmCheckPermission *mAccessServiceMockCheckPermission) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockCheckPermission {
+	if mmCheckPermission.mock.funcCheckPermission != nil {
+		mmCheckPermission.mock.t.Fatalf("AccessServiceMock.CheckPermission mock is already set by Set")
+	}
+
+	if mmCheckPermission.defaultExpectation == nil {
+		mmCheckPermission.defaultExpectation = &AccessServiceMockCheckPermissionExpectation{}
+	}
+
+	if mmCheckPermission.defaultExpectation.params != nil {
+		mmCheckPermission.mock.t.Fatalf("AccessServiceMock.CheckPermission mock is already set by Expect")
+	}
+
+	if mmCheckPermission.defaultExpectation.paramPtrs == nil {
+		mmCheckPermission.defaultExpectation.paramPtrs = &AccessServiceMockCheckPermissionParamPtrs{}
+	}
+	mmCheckPermission.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheckPermission.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCheckPermission
+}
+
+// ExpectPermissionParam2 sets up expected param permission for AccessService.CheckPermission
+func (mmCheckPermission *mAccessServiceMockCheckPermission) ExpectPermissionParam2(permission string) *mAccessServiceMockCheckPermission {
+	if mmCheckPermission.mock.funcCheckPermission != nil {
+		mmCheckPermission.mock.t.Fatalf("AccessServiceMock.CheckPermission mock is already set by Set")
+	}
+
+	if mmCheckPermission.defaultExpectation == nil {
+		mmCheckPermission.defaultExpectation = &AccessServiceMockCheckPermissionExpectation{}
+	}
+
+	if mmCheckPermission.defaultExpectation.params != nil {
+		mmCheckPermission.mock.t.Fatalf("AccessServiceMock.CheckPermission mock is already set by Expect")
+	}
+
+	if mmCheckPermission.defaultExpectation.paramPtrs == nil {
+		mmCheckPermission.defaultExpectation.paramPtrs = &AccessServiceMockCheckPermissionParamPtrs{}
+	}
+	mmCheckPermission.defaultExpectation.paramPtrs.permission = &permission
+	mmCheckPermission.defaultExpectation.expectationOrigins.originPermission = minimock.CallerInfo(1)
+
+	return mmCheckPermission
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.CheckPermission
+func (mmCheckPermission *mAccessServiceMockCheckPermission) Inspect(f func(ctx context.Context, permission string)) *mAccessServiceMockCheckPermission {
+	if mmCheckPermission.mock.inspectFuncCheckPermission != nil {
+		mmCheckPermission.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.CheckPermission")
+	}
+
+	mmCheckPermission.mock.inspectFuncCheckPermission = f
+
+	return mmCheckPermission
+}
+
+// Return sets up results that will be returned by AccessService.CheckPermission
+func (mmCheckPermission *mAccessServiceMockCheckPermission) Return(err error) *AccessServiceMock {
+	if mmCheckPermission.mock.funcCheckPermission != nil {
+		mmCheckPermission.mock.t.Fatalf("AccessServiceMock.CheckPermission mock is already set by Set")
+	}
+
+	if mmCheckPermission.defaultExpectation == nil {
+		mmCheckPermission.defaultExpectation = &AccessServiceMockCheckPermissionExpectation{mock: mmCheckPermission.mock}
+	}
+	mmCheckPermission.defaultExpectation.results = &AccessServiceMockCheckPermissionResults{err}
+	mmCheckPermission.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheckPermission.mock
+}
+
+// Set uses given function f to mock the AccessService.CheckPermission method
+func (mmCheckPermission *mAccessServiceMockCheckPermission) Set(f func(ctx context.Context, permission string) (err error)) *AccessServiceMock {
+	if mmCheckPermission.defaultExpectation != nil {
+		mmCheckPermission.mock.t.Fatalf("Default expectation is already set for the AccessService.CheckPermission method")
+	}
+
+	if len(mmCheckPermission.expectations) > 0 {
+		mmCheckPermission.mock.t.Fatalf("Some expectations are already set for the AccessService.CheckPermission method")
+	}
+
+	mmCheckPermission.mock.funcCheckPermission = f
+	mmCheckPermission.mock.funcCheckPermissionOrigin = minimock.CallerInfo(1)
+	return mmCheckPermission.mock
+}
+
+// When sets expectation for the AccessService.CheckPermission which will trigger the result defined by the following
+// Then helper
+func (mmCheckPermission *mAccessServiceMockCheckPermission) When(ctx context.Context, permission string) *AccessServiceMockCheckPermissionExpectation {
+	if mmCheckPermission.mock.funcCheckPermission != nil {
+		mmCheckPermission.mock.t.Fatalf("AccessServiceMock.CheckPermission mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockCheckPermissionExpectation{
+		mock:               mmCheckPermission.mock,
+		params:             &AccessServiceMockCheckPermissionParams{ctx, permission},
+		expectationOrigins: AccessServiceMockCheckPermissionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheckPermission.expectations = append(mmCheckPermission.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.CheckPermission return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockCheckPermissionExpectation) Then(err error) *AccessServiceMock {
+	e.results = &AccessServiceMockCheckPermissionResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.CheckPermission should be invoked
+func (mmCheckPermission *mAccessServiceMockCheckPermission) Times(n uint64) *mAccessServiceMockCheckPermission {
+	if n == 0 {
+		mmCheckPermission.mock.t.Fatalf("Times of AccessServiceMock.CheckPermission mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckPermission.expectedInvocations, n)
+	mmCheckPermission.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheckPermission
+}
+
+func (mmCheckPermission *mAccessServiceMockCheckPermission) invocationsDone() bool {
+	if len(mmCheckPermission.expectations) == 0 && mmCheckPermission.defaultExpectation == nil && mmCheckPermission.mock.funcCheckPermission == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckPermission.mock.afterCheckPermissionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckPermission.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckPermission implements mm_service.AccessService
+func (mmCheckPermission *AccessServiceMock) CheckPermission(ctx context.Context, permission string) (err error) {
+	mm_atomic.AddUint64(&mmCheckPermission.beforeCheckPermissionCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckPermission.afterCheckPermissionCounter, 1)
+
+	mmCheckPermission.t.Helper()
+
+	if mmCheckPermission.inspectFuncCheckPermission != nil {
+		mmCheckPermission.inspectFuncCheckPermission(ctx, permission)
+	}
+
+	mm_params := AccessServiceMockCheckPermissionParams{ctx, permission}
+
+	// Record call args
+	mmCheckPermission.CheckPermissionMock.mutex.Lock()
+	mmCheckPermission.CheckPermissionMock.callArgs = append(mmCheckPermission.CheckPermissionMock.callArgs, &mm_params)
+	mmCheckPermission.CheckPermissionMock.mutex.Unlock()
+
+	for _, e := range mmCheckPermission.CheckPermissionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheckPermission.CheckPermissionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckPermission.CheckPermissionMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckPermission.CheckPermissionMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckPermission.CheckPermissionMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockCheckPermissionParams{ctx, permission}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckPermission.t.Errorf("AccessServiceMock.CheckPermission got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckPermission.CheckPermissionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.permission != nil && !minimock.Equal(*mm_want_ptrs.permission, mm_got.permission) {
+				mmCheckPermission.t.Errorf("AccessServiceMock.CheckPermission got unexpected parameter permission, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckPermission.CheckPermissionMock.defaultExpectation.expectationOrigins.originPermission, *mm_want_ptrs.permission, mm_got.permission, minimock.Diff(*mm_want_ptrs.permission, mm_got.permission))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckPermission.t.Errorf("AccessServiceMock.CheckPermission got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheckPermission.CheckPermissionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckPermission.CheckPermissionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckPermission.t.Fatal("No results are set for the AccessServiceMock.CheckPermission")
+		}
+		return (*mm_results).err
+	}
+	if mmCheckPermission.funcCheckPermission != nil {
+		return mmCheckPermission.funcCheckPermission(ctx, permission)
+	}
+	mmCheckPermission.t.Fatalf("Unexpected call to AccessServiceMock.CheckPermission. %v %v", ctx, permission)
+	return
+}
+
+// CheckPermissionAfterCounter returns a count of finished AccessServiceMock.CheckPermission invocations
+func (mmCheckPermission *AccessServiceMock) CheckPermissionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckPermission.afterCheckPermissionCounter)
+}
+
+// CheckPermissionBeforeCounter returns a count of AccessServiceMock.CheckPermission invocations
+func (mmCheckPermission *AccessServiceMock) CheckPermissionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckPermission.beforeCheckPermissionCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.CheckPermission.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckPermission *mAccessServiceMockCheckPermission) Calls() []*AccessServiceMockCheckPermissionParams {
+	mmCheckPermission.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockCheckPermissionParams, len(mmCheckPermission.callArgs))
+	copy(argCopy, mmCheckPermission.callArgs)
+
+	mmCheckPermission.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckPermissionDone returns true if the count of the CheckPermission invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockCheckPermissionDone() bool {
+	if m.CheckPermissionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckPermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckPermissionMock.invocationsDone()
+}
+
+// MinimockCheckPermissionInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockCheckPermissionInspect() {
+	for _, e := range m.CheckPermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.CheckPermission at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckPermissionCounter := mm_atomic.LoadUint64(&m.afterCheckPermissionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckPermissionMock.defaultExpectation != nil && afterCheckPermissionCounter < 1 {
+		if m.CheckPermissionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessServiceMock.CheckPermission at\n%s", m.CheckPermissionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.CheckPermission at\n%s with params: %#v", m.CheckPermissionMock.defaultExpectation.expectationOrigins.origin, *m.CheckPermissionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckPermission != nil && afterCheckPermissionCounter < 1 {
+		m.t.Errorf("Expected call to AccessServiceMock.CheckPermission at\n%s", m.funcCheckPermissionOrigin)
+	}
+
+	if !m.CheckPermissionMock.invocationsDone() && afterCheckPermissionCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.CheckPermission at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckPermissionMock.expectedInvocations), m.CheckPermissionMock.expectedInvocationsOrigin, afterCheckPermissionCounter)
+	}
+}
+
+type mAccessServiceMockCheckPermissionAs struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockCheckPermissionAsExpectation
+	expectations       []*AccessServiceMockCheckPermissionAsExpectation
+
+	callArgs []*AccessServiceMockCheckPermissionAsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessServiceMockCheckPermissionAsExpectation specifies expectation struct of the AccessService.CheckPermissionAs
+type AccessServiceMockCheckPermissionAsExpectation struct {
+	mock               *AccessServiceMock
+	params             *AccessServiceMockCheckPermissionAsParams
+	paramPtrs          *AccessServiceMockCheckPermissionAsParamPtrs
+	expectationOrigins AccessServiceMockCheckPermissionAsExpectationOrigins
+	results            *AccessServiceMockCheckPermissionAsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessServiceMockCheckPermissionAsParams contains parameters of the AccessService.CheckPermissionAs
+type AccessServiceMockCheckPermissionAsParams struct {
+	ctx        context.Context
+	principal  *model.AccessPrincipal
+	permission string
+}
+
+// AccessServiceMockCheckPermissionAsParamPtrs contains pointers to parameters of the AccessService.CheckPermissionAs
+type AccessServiceMockCheckPermissionAsParamPtrs struct {
+	ctx        *context.Context
+	principal  **model.AccessPrincipal
+	permission *string
+}
+
+// AccessServiceMockCheckPermissionAsResults contains results of the AccessService.CheckPermissionAs
+type AccessServiceMockCheckPermissionAsResults struct {
+	err error
+}
+
+// AccessServiceMockCheckPermissionAsOrigins contains origins of expectations of the AccessService.CheckPermissionAs
+type AccessServiceMockCheckPermissionAsExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originPrincipal  string
+	originPermission string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheckPermissionAs *mAccessServiceMockCheckPermissionAs) Optional() *mAccessServiceMockCheckPermissionAs {
+	mmCheckPermissionAs.optional = true
+	return mmCheckPermissionAs
+}
+
+// Expect sets up expected params for AccessService.CheckPermissionAs
+func (mmCheckPermissionAs *mAccessServiceMockCheckPermissionAs) Expect(ctx context.Context, principal *model.AccessPrincipal, permission string) *mAccessServiceMockCheckPermissionAs {
+	if mmCheckPermissionAs.mock.funcCheckPermissionAs != nil {
+		mmCheckPermissionAs.mock.t.Fatalf("AccessServiceMock.CheckPermissionAs mock is already set by Set")
+	}
+
+	if mmCheckPermissionAs.defaultExpectation == nil {
+		mmCheckPermissionAs.defaultExpectation = &AccessServiceMockCheckPermissionAsExpectation{}
+	}
+
+	if mmCheckPermissionAs.defaultExpectation.paramPtrs != nil {
+		mmCheckPermissionAs.mock.t.Fatalf("AccessServiceMock.CheckPermissionAs mock is already set by ExpectParams functions")
+	}
+
+	mmCheckPermissionAs.defaultExpectation.params = &AccessServiceMockCheckPermissionAsParams{ctx, principal, permission}
+	mmCheckPermissionAs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheckPermissionAs.expectations {
+		if minimock.Equal(e.params, mmCheckPermissionAs.defaultExpectation.params) {
+			mmCheckPermissionAs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckPermissionAs.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckPermissionAs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.CheckPermissionAs
+func (mmCheckPermissionAs *mAccessServiceMockCheckPermissionAs) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockCheckPermissionAs {
+	if mmCheckPermissionAs.mock.funcCheckPermissionAs != nil {
+		mmCheckPermissionAs.mock.t.Fatalf("AccessServiceMock.CheckPermissionAs mock is already set by Set")
+	}
+
+	if mmCheckPermissionAs.defaultExpectation == nil {
+		mmCheckPermissionAs.defaultExpectation = &AccessServiceMockCheckPermissionAsExpectation{}
+	}
+
+	if mmCheckPermissionAs.defaultExpectation.params != nil {
+		mmCheckPermissionAs.mock.t.Fatalf("AccessServiceMock.CheckPermissionAs mock is already set by Expect")
+	}
+
+	if mmCheckPermissionAs.defaultExpectation.paramPtrs == nil {
+		mmCheckPermissionAs.defaultExpectation.paramPtrs = &AccessServiceMockCheckPermissionAsParamPtrs{}
+	}
+	mmCheckPermissionAs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheckPermissionAs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCheckPermissionAs
+}
+
+// ExpectPrincipalParam2 sets up expected param principal for AccessService.CheckPermissionAs
+func (mmCheckPermissionAs *mAccessServiceMockCheckPermissionAs) ExpectPrincipalParam2(principal *model.AccessPrincipal) *mAccessServiceMockCheckPermissionAs {
+	if mmCheckPermissionAs.mock.funcCheckPermissionAs != nil {
+		mmCheckPermissionAs.mock.t.Fatalf("AccessServiceMock.CheckPermissionAs mock is already set by Set")
+	}
+
+	if mmCheckPermissionAs.defaultExpectation == nil {
+		mmCheckPermissionAs.defaultExpectation = &AccessServiceMockCheckPermissionAsExpectation{}
+	}
+
+	if mmCheckPermissionAs.defaultExpectation.params != nil {
+		mmCheckPermissionAs.mock.t.Fatalf("AccessServiceMock.CheckPermissionAs mock is already set by Expect")
+	}
+
+	if mmCheckPermissionAs.defaultExpectation.paramPtrs == nil {
+		mmCheckPermissionAs.defaultExpectation.paramPtrs = &AccessServiceMockCheckPermissionAsParamPtrs{}
+	}
+	mmCheckPermissionAs.defaultExpectation.paramPtrs.principal = &principal
+	mmCheckPermissionAs.defaultExpectation.expectationOrigins.originPrincipal = minimock.CallerInfo(1)
+
+	return mmCheckPermissionAs
+}
+
+// ExpectPermissionParam3 sets up expected param permission for AccessService.CheckPermissionAs
+func (mmCheckPermissionAs *mAccessServiceMockCheckPermissionAs) ExpectPermissionParam3(permission string) *mAccessServiceMockCheckPermissionAs {
+	if mmCheckPermissionAs.mock.funcCheckPermissionAs != nil {
+		mmCheckPermissionAs.mock.t.Fatalf("AccessServiceMock.CheckPermissionAs mock is already set by Set")
+	}
+
+	if mmCheckPermissionAs.defaultExpectation == nil {
+		mmCheckPermissionAs.defaultExpectation = &AccessServiceMockCheckPermissionAsExpectation{}
+	}
+
+	if mmCheckPermissionAs.defaultExpectation.params != nil {
+		mmCheckPermissionAs.mock.t.Fatalf("AccessServiceMock.CheckPermissionAs mock is already set by Expect")
+	}
+
+	if mmCheckPermissionAs.defaultExpectation.paramPtrs == nil {
+		mmCheckPermissionAs.defaultExpectation.paramPtrs = &AccessServiceMockCheckPermissionAsParamPtrs{}
+	}
+	mmCheckPermissionAs.defaultExpectation.paramPtrs.permission = &permission
+	mmCheckPermissionAs.defaultExpectation.expectationOrigins.originPermission = minimock.CallerInfo(1)
+
+	return mmCheckPermissionAs
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.CheckPermissionAs
+func (mmCheckPermissionAs *mAccessServiceMockCheckPermissionAs) Inspect(f func(ctx context.Context, principal *model.AccessPrincipal, permission string)) *mAccessServiceMockCheckPermissionAs {
+	if mmCheckPermissionAs.mock.inspectFuncCheckPermissionAs != nil {
+		mmCheckPermissionAs.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.CheckPermissionAs")
+	}
+
+	mmCheckPermissionAs.mock.inspectFuncCheckPermissionAs = f
+
+	return mmCheckPermissionAs
+}
+
+// Return sets up results that will be returned by AccessService.CheckPermissionAs
+func (mmCheckPermissionAs *mAccessServiceMockCheckPermissionAs) Return(err error) *AccessServiceMock {
+	if mmCheckPermissionAs.mock.funcCheckPermissionAs != nil {
+		mmCheckPermissionAs.mock.t.Fatalf("AccessServiceMock.CheckPermissionAs mock is already set by Set")
+	}
+
+	if mmCheckPermissionAs.defaultExpectation == nil {
+		mmCheckPermissionAs.defaultExpectation = &AccessServiceMockCheckPermissionAsExpectation{mock: mmCheckPermissionAs.mock}
+	}
+	mmCheckPermissionAs.defaultExpectation.results = &AccessServiceMockCheckPermissionAsResults{err}
+	mmCheckPermissionAs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheckPermissionAs.mock
+}
+
+// Set uses given function f to mock the AccessService.CheckPermissionAs method
+func (mmCheckPermissionAs *mAccessServiceMockCheckPermissionAs) Set(f func(ctx context.Context, principal *model.AccessPrincipal, permission string) (err error)) *AccessServiceMock {
+	if mmCheckPermissionAs.defaultExpectation != nil {
+		mmCheckPermissionAs.mock.t.Fatalf("Default expectation is already set for the AccessService.CheckPermissionAs method")
+	}
+
+	if len(mmCheckPermissionAs.expectations) > 0 {
+		mmCheckPermissionAs.mock.t.Fatalf("Some expectations are already set for the AccessService.CheckPermissionAs method")
+	}
+
+	mmCheckPermissionAs.mock.funcCheckPermissionAs = f
+	mmCheckPermissionAs.mock.funcCheckPermissionAsOrigin = minimock.CallerInfo(1)
+	return mmCheckPermissionAs.mock
+}
+
+// When sets expectation for the AccessService.CheckPermissionAs which will trigger the result defined by the following
+// Then helper
+func (mmCheckPermissionAs *mAccessServiceMockCheckPermissionAs) When(ctx context.Context, principal *model.AccessPrincipal, permission string) *AccessServiceMockCheckPermissionAsExpectation {
+	if mmCheckPermissionAs.mock.funcCheckPermissionAs != nil {
+		mmCheckPermissionAs.mock.t.Fatalf("AccessServiceMock.CheckPermissionAs mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockCheckPermissionAsExpectation{
+		mock:               mmCheckPermissionAs.mock,
+		params:             &AccessServiceMockCheckPermissionAsParams{ctx, principal, permission},
+		expectationOrigins: AccessServiceMockCheckPermissionAsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheckPermissionAs.expectations = append(mmCheckPermissionAs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.CheckPermissionAs return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockCheckPermissionAsExpectation) Then(err error) *AccessServiceMock {
+	e.results = &AccessServiceMockCheckPermissionAsResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.CheckPermissionAs should be invoked
+func (mmCheckPermissionAs *mAccessServiceMockCheckPermissionAs) Times(n uint64) *mAccessServiceMockCheckPermissionAs {
+	if n == 0 {
+		mmCheckPermissionAs.mock.t.Fatalf("Times of AccessServiceMock.CheckPermissionAs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckPermissionAs.expectedInvocations, n)
+	mmCheckPermissionAs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheckPermissionAs
+}
+
+func (mmCheckPermissionAs *mAccessServiceMockCheckPermissionAs) invocationsDone() bool {
+	if len(mmCheckPermissionAs.expectations) == 0 && mmCheckPermissionAs.defaultExpectation == nil && mmCheckPermissionAs.mock.funcCheckPermissionAs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckPermissionAs.mock.afterCheckPermissionAsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckPermissionAs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckPermissionAs implements mm_service.AccessService
+func (mmCheckPermissionAs *AccessServiceMock) CheckPermissionAs(ctx context.Context, principal *model.AccessPrincipal, permission string) (err error) {
+	mm_atomic.AddUint64(&mmCheckPermissionAs.beforeCheckPermissionAsCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckPermissionAs.afterCheckPermissionAsCounter, 1)
+
+	mmCheckPermissionAs.t.Helper()
+
+	if mmCheckPermissionAs.inspectFuncCheckPermissionAs != nil {
+		mmCheckPermissionAs.inspectFuncCheckPermissionAs(ctx, principal, permission)
+	}
+
+	mm_params := AccessServiceMockCheckPermissionAsParams{ctx, principal, permission}
+
+	// Record call args
+	mmCheckPermissionAs.CheckPermissionAsMock.mutex.Lock()
+	mmCheckPermissionAs.CheckPermissionAsMock.callArgs = append(mmCheckPermissionAs.CheckPermissionAsMock.callArgs, &mm_params)
+	mmCheckPermissionAs.CheckPermissionAsMock.mutex.Unlock()
+
+	for _, e := range mmCheckPermissionAs.CheckPermissionAsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheckPermissionAs.CheckPermissionAsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckPermissionAs.CheckPermissionAsMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckPermissionAs.CheckPermissionAsMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckPermissionAs.CheckPermissionAsMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockCheckPermissionAsParams{ctx, principal, permission}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckPermissionAs.t.Errorf("AccessServiceMock.CheckPermissionAs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckPermissionAs.CheckPermissionAsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.principal != nil && !minimock.Equal(*mm_want_ptrs.principal, mm_got.principal) {
+				mmCheckPermissionAs.t.Errorf("AccessServiceMock.CheckPermissionAs got unexpected parameter principal, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckPermissionAs.CheckPermissionAsMock.defaultExpectation.expectationOrigins.originPrincipal, *mm_want_ptrs.principal, mm_got.principal, minimock.Diff(*mm_want_ptrs.principal, mm_got.principal))
+			}
+
+			if mm_want_ptrs.permission != nil && !minimock.Equal(*mm_want_ptrs.permission, mm_got.permission) {
+				mmCheckPermissionAs.t.Errorf("AccessServiceMock.CheckPermissionAs got unexpected parameter permission, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckPermissionAs.CheckPermissionAsMock.defaultExpectation.expectationOrigins.originPermission, *mm_want_ptrs.permission, mm_got.permission, minimock.Diff(*mm_want_ptrs.permission, mm_got.permission))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckPermissionAs.t.Errorf("AccessServiceMock.CheckPermissionAs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheckPermissionAs.CheckPermissionAsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckPermissionAs.CheckPermissionAsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckPermissionAs.t.Fatal("No results are set for the AccessServiceMock.CheckPermissionAs")
+		}
+		return (*mm_results).err
+	}
+	if mmCheckPermissionAs.funcCheckPermissionAs != nil {
+		return mmCheckPermissionAs.funcCheckPermissionAs(ctx, principal, permission)
+	}
+	mmCheckPermissionAs.t.Fatalf("Unexpected call to AccessServiceMock.CheckPermissionAs. %v %v %v", ctx, principal, permission)
+	return
+}
+
+// CheckPermissionAsAfterCounter returns a count of finished AccessServiceMock.CheckPermissionAs invocations
+func (mmCheckPermissionAs *AccessServiceMock) CheckPermissionAsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckPermissionAs.afterCheckPermissionAsCounter)
+}
+
+// CheckPermissionAsBeforeCounter returns a count of AccessServiceMock.CheckPermissionAs invocations
+func (mmCheckPermissionAs *AccessServiceMock) CheckPermissionAsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckPermissionAs.beforeCheckPermissionAsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.CheckPermissionAs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckPermissionAs *mAccessServiceMockCheckPermissionAs) Calls() []*AccessServiceMockCheckPermissionAsParams {
+	mmCheckPermissionAs.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockCheckPermissionAsParams, len(mmCheckPermissionAs.callArgs))
+	copy(argCopy, mmCheckPermissionAs.callArgs)
+
+	mmCheckPermissionAs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckPermissionAsDone returns true if the count of the CheckPermissionAs invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockCheckPermissionAsDone() bool {
+	if m.CheckPermissionAsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckPermissionAsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckPermissionAsMock.invocationsDone()
+}
+
+// MinimockCheckPermissionAsInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockCheckPermissionAsInspect() {
+	for _, e := range m.CheckPermissionAsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.CheckPermissionAs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckPermissionAsCounter := mm_atomic.LoadUint64(&m.afterCheckPermissionAsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckPermissionAsMock.defaultExpectation != nil && afterCheckPermissionAsCounter < 1 {
+		if m.CheckPermissionAsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessServiceMock.CheckPermissionAs at\n%s", m.CheckPermissionAsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.CheckPermissionAs at\n%s with params: %#v", m.CheckPermissionAsMock.defaultExpectation.expectationOrigins.origin, *m.CheckPermissionAsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckPermissionAs != nil && afterCheckPermissionAsCounter < 1 {
+		m.t.Errorf("Expected call to AccessServiceMock.CheckPermissionAs at\n%s", m.funcCheckPermissionAsOrigin)
+	}
+
+	if !m.CheckPermissionAsMock.invocationsDone() && afterCheckPermissionAsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.CheckPermissionAs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckPermissionAsMock.expectedInvocations), m.CheckPermissionAsMock.expectedInvocationsOrigin, afterCheckPermissionAsCounter)
+	}
+}
+
+type mAccessServiceMockDeleteDenyRule struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockDeleteDenyRuleExpectation
+	expectations       []*AccessServiceMockDeleteDenyRuleExpectation
+
+	callArgs []*AccessServiceMockDeleteDenyRuleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessServiceMockDeleteDenyRuleExpectation specifies expectation struct of the AccessService.DeleteDenyRule
+type AccessServiceMockDeleteDenyRuleExpectation struct {
+	mock               *AccessServiceMock
+	params             *AccessServiceMockDeleteDenyRuleParams
+	paramPtrs          *AccessServiceMockDeleteDenyRuleParamPtrs
+	expectationOrigins AccessServiceMockDeleteDenyRuleExpectationOrigins
+	results            *AccessServiceMockDeleteDenyRuleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessServiceMockDeleteDenyRuleParams contains parameters of the AccessService.DeleteDenyRule
+type AccessServiceMockDeleteDenyRuleParams struct {
+	ctx  context.Context
+	name string
+}
+
+// AccessServiceMockDeleteDenyRuleParamPtrs contains pointers to parameters of the AccessService.DeleteDenyRule
+type AccessServiceMockDeleteDenyRuleParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// AccessServiceMockDeleteDenyRuleResults contains results of the AccessService.DeleteDenyRule
+type AccessServiceMockDeleteDenyRuleResults struct {
+	err error
+}
+
+// AccessServiceMockDeleteDenyRuleOrigins contains origins of expectations of the AccessService.DeleteDenyRule
+type AccessServiceMockDeleteDenyRuleExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteDenyRule *mAccessServiceMockDeleteDenyRule) Optional() *mAccessServiceMockDeleteDenyRule {
+	mmDeleteDenyRule.optional = true
+	return mmDeleteDenyRule
+}
+
+// Expect sets up expected params for AccessService.DeleteDenyRule
+func (mmDeleteDenyRule *mAccessServiceMockDeleteDenyRule) Expect(ctx context.Context, name string) *mAccessServiceMockDeleteDenyRule {
+	if mmDeleteDenyRule.mock.funcDeleteDenyRule != nil {
+		mmDeleteDenyRule.mock.t.Fatalf("AccessServiceMock.DeleteDenyRule mock is already set by Set")
+	}
+
+	if mmDeleteDenyRule.defaultExpectation == nil {
+		mmDeleteDenyRule.defaultExpectation = &AccessServiceMockDeleteDenyRuleExpectation{}
+	}
+
+	if mmDeleteDenyRule.defaultExpectation.paramPtrs != nil {
+		mmDeleteDenyRule.mock.t.Fatalf("AccessServiceMock.DeleteDenyRule mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteDenyRule.defaultExpectation.params = &AccessServiceMockDeleteDenyRuleParams{ctx, name}
+	mmDeleteDenyRule.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteDenyRule.expectations {
+		if minimock.Equal(e.params, mmDeleteDenyRule.defaultExpectation.params) {
+			mmDeleteDenyRule.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteDenyRule.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteDenyRule
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.DeleteDenyRule
+func (mmDeleteDenyRule *mAccessServiceMockDeleteDenyRule) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockDeleteDenyRule {
+	if mmDeleteDenyRule.mock.funcDeleteDenyRule != nil {
+		mmDeleteDenyRule.mock.t.Fatalf("AccessServiceMock.DeleteDenyRule mock is already set by Set")
+	}
+
+	if mmDeleteDenyRule.defaultExpectation == nil {
+		mmDeleteDenyRule.defaultExpectation = &AccessServiceMockDeleteDenyRuleExpectation{}
+	}
+
+	if mmDeleteDenyRule.defaultExpectation.params != nil {
+		mmDeleteDenyRule.mock.t.Fatalf("AccessServiceMock.DeleteDenyRule mock is already set by Expect")
+	}
+
+	if mmDeleteDenyRule.defaultExpectation.paramPtrs == nil {
+		mmDeleteDenyRule.defaultExpectation.paramPtrs = &AccessServiceMockDeleteDenyRuleParamPtrs{}
+	}
+	mmDeleteDenyRule.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteDenyRule.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteDenyRule
+}
+
+// ExpectNameParam2 sets up expected param name for AccessService.DeleteDenyRule
+func (mmDeleteDenyRule *mAccessServiceMockDeleteDenyRule) ExpectNameParam2(name string) *mAccessServiceMockDeleteDenyRule {
+	if mmDeleteDenyRule.mock.funcDeleteDenyRule != nil {
+		mmDeleteDenyRule.mock.t.Fatalf("AccessServiceMock.DeleteDenyRule mock is already set by Set")
+	}
+
+	if mmDeleteDenyRule.defaultExpectation == nil {
+		mmDeleteDenyRule.defaultExpectation = &AccessServiceMockDeleteDenyRuleExpectation{}
+	}
+
+	if mmDeleteDenyRule.defaultExpectation.params != nil {
+		mmDeleteDenyRule.mock.t.Fatalf("AccessServiceMock.DeleteDenyRule mock is already set by Expect")
+	}
+
+	if mmDeleteDenyRule.defaultExpectation.paramPtrs == nil {
+		mmDeleteDenyRule.defaultExpectation.paramPtrs = &AccessServiceMockDeleteDenyRuleParamPtrs{}
+	}
+	mmDeleteDenyRule.defaultExpectation.paramPtrs.name = &name
+	mmDeleteDenyRule.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmDeleteDenyRule
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.DeleteDenyRule
+func (mmDeleteDenyRule *mAccessServiceMockDeleteDenyRule) Inspect(f func(ctx context.Context, name string)) *mAccessServiceMockDeleteDenyRule {
+	if mmDeleteDenyRule.mock.inspectFuncDeleteDenyRule != nil {
+		mmDeleteDenyRule.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.DeleteDenyRule")
+	}
+
+	mmDeleteDenyRule.mock.inspectFuncDeleteDenyRule = f
+
+	return mmDeleteDenyRule
+}
+
+// Return sets up results that will be returned by AccessService.DeleteDenyRule
+func (mmDeleteDenyRule *mAccessServiceMockDeleteDenyRule) Return(err error) *AccessServiceMock {
+	if mmDeleteDenyRule.mock.funcDeleteDenyRule != nil {
+		mmDeleteDenyRule.mock.t.Fatalf("AccessServiceMock.DeleteDenyRule mock is already set by Set")
+	}
+
+	if mmDeleteDenyRule.defaultExpectation == nil {
+		mmDeleteDenyRule.defaultExpectation = &AccessServiceMockDeleteDenyRuleExpectation{mock: mmDeleteDenyRule.mock}
+	}
+	mmDeleteDenyRule.defaultExpectation.results = &AccessServiceMockDeleteDenyRuleResults{err}
+	mmDeleteDenyRule.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteDenyRule.mock
+}
+
+// Set uses given function f to mock the AccessService.DeleteDenyRule method
+func (mmDeleteDenyRule *mAccessServiceMockDeleteDenyRule) Set(f func(ctx context.Context, name string) (err error)) *AccessServiceMock {
+	if mmDeleteDenyRule.defaultExpectation != nil {
+		mmDeleteDenyRule.mock.t.Fatalf("Default expectation is already set for the AccessService.DeleteDenyRule method")
+	}
+
+	if len(mmDeleteDenyRule.expectations) > 0 {
+		mmDeleteDenyRule.mock.t.Fatalf("Some expectations are already set for the AccessService.DeleteDenyRule method")
+	}
+
+	mmDeleteDenyRule.mock.funcDeleteDenyRule = f
+	mmDeleteDenyRule.mock.funcDeleteDenyRuleOrigin = minimock.CallerInfo(1)
+	return mmDeleteDenyRule.mock
+}
+
+// When sets expectation for the AccessService.DeleteDenyRule which will trigger the result defined by the following
+// Then helper
+func (mmDeleteDenyRule *mAccessServiceMockDeleteDenyRule) When(ctx context.Context, name string) *AccessServiceMockDeleteDenyRuleExpectation {
+	if mmDeleteDenyRule.mock.funcDeleteDenyRule != nil {
+		mmDeleteDenyRule.mock.t.Fatalf("AccessServiceMock.DeleteDenyRule mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockDeleteDenyRuleExpectation{
+		mock:               mmDeleteDenyRule.mock,
+		params:             &AccessServiceMockDeleteDenyRuleParams{ctx, name},
+		expectationOrigins: AccessServiceMockDeleteDenyRuleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteDenyRule.expectations = append(mmDeleteDenyRule.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.DeleteDenyRule return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockDeleteDenyRuleExpectation) Then(err error) *AccessServiceMock {
+	e.results = &AccessServiceMockDeleteDenyRuleResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.DeleteDenyRule should be invoked
+func (mmDeleteDenyRule *mAccessServiceMockDeleteDenyRule) Times(n uint64) *mAccessServiceMockDeleteDenyRule {
+	if n == 0 {
+		mmDeleteDenyRule.mock.t.Fatalf("Times of AccessServiceMock.DeleteDenyRule mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteDenyRule.expectedInvocations, n)
+	mmDeleteDenyRule.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteDenyRule
+}
+
+func (mmDeleteDenyRule *mAccessServiceMockDeleteDenyRule) invocationsDone() bool {
+	if len(mmDeleteDenyRule.expectations) == 0 && mmDeleteDenyRule.defaultExpectation == nil && mmDeleteDenyRule.mock.funcDeleteDenyRule == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteDenyRule.mock.afterDeleteDenyRuleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteDenyRule.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteDenyRule implements mm_service.AccessService
+func (mmDeleteDenyRule *AccessServiceMock) DeleteDenyRule(ctx context.Context, name string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteDenyRule.beforeDeleteDenyRuleCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteDenyRule.afterDeleteDenyRuleCounter, 1)
+
+	mmDeleteDenyRule.t.Helper()
+
+	if mmDeleteDenyRule.inspectFuncDeleteDenyRule != nil {
+		mmDeleteDenyRule.inspectFuncDeleteDenyRule(ctx, name)
+	}
+
+	mm_params := AccessServiceMockDeleteDenyRuleParams{ctx, name}
+
+	// Record call args
+	mmDeleteDenyRule.DeleteDenyRuleMock.mutex.Lock()
+	mmDeleteDenyRule.DeleteDenyRuleMock.callArgs = append(mmDeleteDenyRule.DeleteDenyRuleMock.callArgs, &mm_params)
+	mmDeleteDenyRule.DeleteDenyRuleMock.mutex.Unlock()
+
+	for _, e := range mmDeleteDenyRule.DeleteDenyRuleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteDenyRule.DeleteDenyRuleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteDenyRule.DeleteDenyRuleMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteDenyRule.DeleteDenyRuleMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteDenyRule.DeleteDenyRuleMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockDeleteDenyRuleParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteDenyRule.t.Errorf("AccessServiceMock.DeleteDenyRule got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteDenyRule.DeleteDenyRuleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmDeleteDenyRule.t.Errorf("AccessServiceMock.DeleteDenyRule got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteDenyRule.DeleteDenyRuleMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteDenyRule.t.Errorf("AccessServiceMock.DeleteDenyRule got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteDenyRule.DeleteDenyRuleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteDenyRule.DeleteDenyRuleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteDenyRule.t.Fatal("No results are set for the AccessServiceMock.DeleteDenyRule")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteDenyRule.funcDeleteDenyRule != nil {
+		return mmDeleteDenyRule.funcDeleteDenyRule(ctx, name)
+	}
+	mmDeleteDenyRule.t.Fatalf("Unexpected call to AccessServiceMock.DeleteDenyRule. %v %v", ctx, name)
+	return
+}
+
+// DeleteDenyRuleAfterCounter returns a count of finished AccessServiceMock.DeleteDenyRule invocations
+func (mmDeleteDenyRule *AccessServiceMock) DeleteDenyRuleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteDenyRule.afterDeleteDenyRuleCounter)
 }
 
-// Calls returns a list of arguments used in each call to AccessServiceMock.Check.
+// DeleteDenyRuleBeforeCounter returns a count of AccessServiceMock.DeleteDenyRule invocations
+func (mmDeleteDenyRule *AccessServiceMock) DeleteDenyRuleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteDenyRule.beforeDeleteDenyRuleCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.DeleteDenyRule.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCheck *mAccessServiceMockCheck) Calls() []*AccessServiceMockCheckParams {
-	mmCheck.mutex.RLock()
+func (mmDeleteDenyRule *mAccessServiceMockDeleteDenyRule) Calls() []*AccessServiceMockDeleteDenyRuleParams {
+	mmDeleteDenyRule.mutex.RLock()
 
-	argCopy := make([]*AccessServiceMockCheckParams, len(mmCheck.callArgs))
-	copy(argCopy, mmCheck.callArgs)
+	argCopy := make([]*AccessServiceMockDeleteDenyRuleParams, len(mmDeleteDenyRule.callArgs))
+	copy(argCopy, mmDeleteDenyRule.callArgs)
 
-	mmCheck.mutex.RUnlock()
+	mmDeleteDenyRule.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCheckDone returns true if the count of the Check invocations corresponds
+// MinimockDeleteDenyRuleDone returns true if the count of the DeleteDenyRule invocations corresponds
 // the number of defined expectations
-func (m *AccessServiceMock) MinimockCheckDone() bool {
-	if m.CheckMock.optional {
+func (m *AccessServiceMock) MinimockDeleteDenyRuleDone() bool {
+	if m.DeleteDenyRuleMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CheckMock.expectations {
+	for _, e := range m.DeleteDenyRuleMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CheckMock.invocationsDone()
+	return m.DeleteDenyRuleMock.invocationsDone()
 }
 
-// MinimockCheckInspect logs each unmet expectation
-func (m *AccessServiceMock) MinimockCheckInspect() {
-	for _, e := range m.CheckMock.expectations {
+// MinimockDeleteDenyRuleInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockDeleteDenyRuleInspect() {
+	for _, e := range m.DeleteDenyRuleMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AccessServiceMock.Check at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to AccessServiceMock.DeleteDenyRule at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCheckCounter := mm_atomic.LoadUint64(&m.afterCheckCounter)
+	afterDeleteDenyRuleCounter := mm_atomic.LoadUint64(&m.afterDeleteDenyRuleCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CheckMock.defaultExpectation != nil && afterCheckCounter < 1 {
-		if m.CheckMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to AccessServiceMock.Check at\n%s", m.CheckMock.defaultExpectation.returnOrigin)
+	if m.DeleteDenyRuleMock.defaultExpectation != nil && afterDeleteDenyRuleCounter < 1 {
+		if m.DeleteDenyRuleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessServiceMock.DeleteDenyRule at\n%s", m.DeleteDenyRuleMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to AccessServiceMock.Check at\n%s with params: %#v", m.CheckMock.defaultExpectation.expectationOrigins.origin, *m.CheckMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AccessServiceMock.DeleteDenyRule at\n%s with params: %#v", m.DeleteDenyRuleMock.defaultExpectation.expectationOrigins.origin, *m.DeleteDenyRuleMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCheck != nil && afterCheckCounter < 1 {
-		m.t.Errorf("Expected call to AccessServiceMock.Check at\n%s", m.funcCheckOrigin)
+	if m.funcDeleteDenyRule != nil && afterDeleteDenyRuleCounter < 1 {
+		m.t.Errorf("Expected call to AccessServiceMock.DeleteDenyRule at\n%s", m.funcDeleteDenyRuleOrigin)
 	}
 
-	if !m.CheckMock.invocationsDone() && afterCheckCounter > 0 {
-		m.t.Errorf("Expected %d calls to AccessServiceMock.Check at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CheckMock.expectedInvocations), m.CheckMock.expectedInvocationsOrigin, afterCheckCounter)
+	if !m.DeleteDenyRuleMock.invocationsDone() && afterDeleteDenyRuleCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.DeleteDenyRule at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteDenyRuleMock.expectedInvocations), m.DeleteDenyRuleMock.expectedInvocationsOrigin, afterDeleteDenyRuleCounter)
 	}
 }
 
@@ -1138,6 +3447,318 @@ func (m *AccessServiceMock) MinimockDeleteRoleEndpointInspect() {
 	}
 }
 
+type mAccessServiceMockGetDenyRules struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockGetDenyRulesExpectation
+	expectations       []*AccessServiceMockGetDenyRulesExpectation
+
+	callArgs []*AccessServiceMockGetDenyRulesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessServiceMockGetDenyRulesExpectation specifies expectation struct of the AccessService.GetDenyRules
+type AccessServiceMockGetDenyRulesExpectation struct {
+	mock               *AccessServiceMock
+	params             *AccessServiceMockGetDenyRulesParams
+	paramPtrs          *AccessServiceMockGetDenyRulesParamPtrs
+	expectationOrigins AccessServiceMockGetDenyRulesExpectationOrigins
+	results            *AccessServiceMockGetDenyRulesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessServiceMockGetDenyRulesParams contains parameters of the AccessService.GetDenyRules
+type AccessServiceMockGetDenyRulesParams struct {
+	ctx context.Context
+}
+
+// AccessServiceMockGetDenyRulesParamPtrs contains pointers to parameters of the AccessService.GetDenyRules
+type AccessServiceMockGetDenyRulesParamPtrs struct {
+	ctx *context.Context
+}
+
+// AccessServiceMockGetDenyRulesResults contains results of the AccessService.GetDenyRules
+type AccessServiceMockGetDenyRulesResults struct {
+	dpa1 []*model.DenyRule
+	err  error
+}
+
+// AccessServiceMockGetDenyRulesOrigins contains origins of expectations of the AccessService.GetDenyRules
+type AccessServiceMockGetDenyRulesExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetDenyRules *mAccessServiceMockGetDenyRules) Optional() *mAccessServiceMockGetDenyRules {
+	mmGetDenyRules.optional = true
+	return mmGetDenyRules
+}
+
+// Expect sets up expected params for AccessService.GetDenyRules
+func (mmGetDenyRules *mAccessServiceMockGetDenyRules) Expect(ctx context.Context) *mAccessServiceMockGetDenyRules {
+	if mmGetDenyRules.mock.funcGetDenyRules != nil {
+		mmGetDenyRules.mock.t.Fatalf("AccessServiceMock.GetDenyRules mock is already set by Set")
+	}
+
+	if mmGetDenyRules.defaultExpectation == nil {
+		mmGetDenyRules.defaultExpectation = &AccessServiceMockGetDenyRulesExpectation{}
+	}
+
+	if mmGetDenyRules.defaultExpectation.paramPtrs != nil {
+		mmGetDenyRules.mock.t.Fatalf("AccessServiceMock.GetDenyRules mock is already set by ExpectParams functions")
+	}
+
+	mmGetDenyRules.defaultExpectation.params = &AccessServiceMockGetDenyRulesParams{ctx}
+	mmGetDenyRules.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetDenyRules.expectations {
+		if minimock.Equal(e.params, mmGetDenyRules.defaultExpectation.params) {
+			mmGetDenyRules.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetDenyRules.defaultExpectation.params)
+		}
+	}
+
+	return mmGetDenyRules
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.GetDenyRules
+func (mmGetDenyRules *mAccessServiceMockGetDenyRules) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockGetDenyRules {
+	if mmGetDenyRules.mock.funcGetDenyRules != nil {
+		mmGetDenyRules.mock.t.Fatalf("AccessServiceMock.GetDenyRules mock is already set by Set")
+	}
+
+	if mmGetDenyRules.defaultExpectation == nil {
+		mmGetDenyRules.defaultExpectation = &AccessServiceMockGetDenyRulesExpectation{}
+	}
+
+	if mmGetDenyRules.defaultExpectation.params != nil {
+		mmGetDenyRules.mock.t.Fatalf("AccessServiceMock.GetDenyRules mock is already set by Expect")
+	}
+
+	if mmGetDenyRules.defaultExpectation.paramPtrs == nil {
+		mmGetDenyRules.defaultExpectation.paramPtrs = &AccessServiceMockGetDenyRulesParamPtrs{}
+	}
+	mmGetDenyRules.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetDenyRules.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetDenyRules
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.GetDenyRules
+func (mmGetDenyRules *mAccessServiceMockGetDenyRules) Inspect(f func(ctx context.Context)) *mAccessServiceMockGetDenyRules {
+	if mmGetDenyRules.mock.inspectFuncGetDenyRules != nil {
+		mmGetDenyRules.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.GetDenyRules")
+	}
+
+	mmGetDenyRules.mock.inspectFuncGetDenyRules = f
+
+	return mmGetDenyRules
+}
+
+// Return sets up results that will be returned by AccessService.GetDenyRules
+func (mmGetDenyRules *mAccessServiceMockGetDenyRules) Return(dpa1 []*model.DenyRule, err error) *AccessServiceMock {
+	if mmGetDenyRules.mock.funcGetDenyRules != nil {
+		mmGetDenyRules.mock.t.Fatalf("AccessServiceMock.GetDenyRules mock is already set by Set")
+	}
+
+	if mmGetDenyRules.defaultExpectation == nil {
+		mmGetDenyRules.defaultExpectation = &AccessServiceMockGetDenyRulesExpectation{mock: mmGetDenyRules.mock}
+	}
+	mmGetDenyRules.defaultExpectation.results = &AccessServiceMockGetDenyRulesResults{dpa1, err}
+	mmGetDenyRules.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetDenyRules.mock
+}
+
+// Set uses given function f to mock the AccessService.GetDenyRules method
+func (mmGetDenyRules *mAccessServiceMockGetDenyRules) Set(f func(ctx context.Context) (dpa1 []*model.DenyRule, err error)) *AccessServiceMock {
+	if mmGetDenyRules.defaultExpectation != nil {
+		mmGetDenyRules.mock.t.Fatalf("Default expectation is already set for the AccessService.GetDenyRules method")
+	}
+
+	if len(mmGetDenyRules.expectations) > 0 {
+		mmGetDenyRules.mock.t.Fatalf("Some expectations are already set for the AccessService.GetDenyRules method")
+	}
+
+	mmGetDenyRules.mock.funcGetDenyRules = f
+	mmGetDenyRules.mock.funcGetDenyRulesOrigin = minimock.CallerInfo(1)
+	return mmGetDenyRules.mock
+}
+
+// When sets expectation for the AccessService.GetDenyRules which will trigger the result defined by the following
+// Then helper
+func (mmGetDenyRules *mAccessServiceMockGetDenyRules) When(ctx context.Context) *AccessServiceMockGetDenyRulesExpectation {
+	if mmGetDenyRules.mock.funcGetDenyRules != nil {
+		mmGetDenyRules.mock.t.Fatalf("AccessServiceMock.GetDenyRules mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockGetDenyRulesExpectation{
+		mock:               mmGetDenyRules.mock,
+		params:             &AccessServiceMockGetDenyRulesParams{ctx},
+		expectationOrigins: AccessServiceMockGetDenyRulesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetDenyRules.expectations = append(mmGetDenyRules.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.GetDenyRules return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockGetDenyRulesExpectation) Then(dpa1 []*model.DenyRule, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockGetDenyRulesResults{dpa1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.GetDenyRules should be invoked
+func (mmGetDenyRules *mAccessServiceMockGetDenyRules) Times(n uint64) *mAccessServiceMockGetDenyRules {
+	if n == 0 {
+		mmGetDenyRules.mock.t.Fatalf("Times of AccessServiceMock.GetDenyRules mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetDenyRules.expectedInvocations, n)
+	mmGetDenyRules.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetDenyRules
+}
+
+func (mmGetDenyRules *mAccessServiceMockGetDenyRules) invocationsDone() bool {
+	if len(mmGetDenyRules.expectations) == 0 && mmGetDenyRules.defaultExpectation == nil && mmGetDenyRules.mock.funcGetDenyRules == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetDenyRules.mock.afterGetDenyRulesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetDenyRules.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetDenyRules implements mm_service.AccessService
+func (mmGetDenyRules *AccessServiceMock) GetDenyRules(ctx context.Context) (dpa1 []*model.DenyRule, err error) {
+	mm_atomic.AddUint64(&mmGetDenyRules.beforeGetDenyRulesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetDenyRules.afterGetDenyRulesCounter, 1)
+
+	mmGetDenyRules.t.Helper()
+
+	if mmGetDenyRules.inspectFuncGetDenyRules != nil {
+		mmGetDenyRules.inspectFuncGetDenyRules(ctx)
+	}
+
+	mm_params := AccessServiceMockGetDenyRulesParams{ctx}
+
+	// Record call args
+	mmGetDenyRules.GetDenyRulesMock.mutex.Lock()
+	mmGetDenyRules.GetDenyRulesMock.callArgs = append(mmGetDenyRules.GetDenyRulesMock.callArgs, &mm_params)
+	mmGetDenyRules.GetDenyRulesMock.mutex.Unlock()
+
+	for _, e := range mmGetDenyRules.GetDenyRulesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.dpa1, e.results.err
+		}
+	}
+
+	if mmGetDenyRules.GetDenyRulesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetDenyRules.GetDenyRulesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetDenyRules.GetDenyRulesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetDenyRules.GetDenyRulesMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockGetDenyRulesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetDenyRules.t.Errorf("AccessServiceMock.GetDenyRules got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDenyRules.GetDenyRulesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetDenyRules.t.Errorf("AccessServiceMock.GetDenyRules got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetDenyRules.GetDenyRulesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetDenyRules.GetDenyRulesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetDenyRules.t.Fatal("No results are set for the AccessServiceMock.GetDenyRules")
+		}
+		return (*mm_results).dpa1, (*mm_results).err
+	}
+	if mmGetDenyRules.funcGetDenyRules != nil {
+		return mmGetDenyRules.funcGetDenyRules(ctx)
+	}
+	mmGetDenyRules.t.Fatalf("Unexpected call to AccessServiceMock.GetDenyRules. %v", ctx)
+	return
+}
+
+// GetDenyRulesAfterCounter returns a count of finished AccessServiceMock.GetDenyRules invocations
+func (mmGetDenyRules *AccessServiceMock) GetDenyRulesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDenyRules.afterGetDenyRulesCounter)
+}
+
+// GetDenyRulesBeforeCounter returns a count of AccessServiceMock.GetDenyRules invocations
+func (mmGetDenyRules *AccessServiceMock) GetDenyRulesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDenyRules.beforeGetDenyRulesCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.GetDenyRules.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetDenyRules *mAccessServiceMockGetDenyRules) Calls() []*AccessServiceMockGetDenyRulesParams {
+	mmGetDenyRules.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockGetDenyRulesParams, len(mmGetDenyRules.callArgs))
+	copy(argCopy, mmGetDenyRules.callArgs)
+
+	mmGetDenyRules.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDenyRulesDone returns true if the count of the GetDenyRules invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockGetDenyRulesDone() bool {
+	if m.GetDenyRulesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetDenyRulesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetDenyRulesMock.invocationsDone()
+}
+
+// MinimockGetDenyRulesInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockGetDenyRulesInspect() {
+	for _, e := range m.GetDenyRulesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.GetDenyRules at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetDenyRulesCounter := mm_atomic.LoadUint64(&m.afterGetDenyRulesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetDenyRulesMock.defaultExpectation != nil && afterGetDenyRulesCounter < 1 {
+		if m.GetDenyRulesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessServiceMock.GetDenyRules at\n%s", m.GetDenyRulesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.GetDenyRules at\n%s with params: %#v", m.GetDenyRulesMock.defaultExpectation.expectationOrigins.origin, *m.GetDenyRulesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetDenyRules != nil && afterGetDenyRulesCounter < 1 {
+		m.t.Errorf("Expected call to AccessServiceMock.GetDenyRules at\n%s", m.funcGetDenyRulesOrigin)
+	}
+
+	if !m.GetDenyRulesMock.invocationsDone() && afterGetDenyRulesCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.GetDenyRules at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetDenyRulesMock.expectedInvocations), m.GetDenyRulesMock.expectedInvocationsOrigin, afterGetDenyRulesCounter)
+	}
+}
+
 type mAccessServiceMockGetRoleEndpoints struct {
 	optional           bool
 	mock               *AccessServiceMock
@@ -1164,12 +3785,14 @@ type AccessServiceMockGetRoleEndpointsExpectation struct {
 
 // AccessServiceMockGetRoleEndpointsParams contains parameters of the AccessService.GetRoleEndpoints
 type AccessServiceMockGetRoleEndpointsParams struct {
-	ctx context.Context
+	ctx      context.Context
+	endpoint string
 }
 
 // AccessServiceMockGetRoleEndpointsParamPtrs contains pointers to parameters of the AccessService.GetRoleEndpoints
 type AccessServiceMockGetRoleEndpointsParamPtrs struct {
-	ctx *context.Context
+	ctx      *context.Context
+	endpoint *string
 }
 
 // AccessServiceMockGetRoleEndpointsResults contains results of the AccessService.GetRoleEndpoints
@@ -1180,8 +3803,9 @@ type AccessServiceMockGetRoleEndpointsResults struct {
 
 // AccessServiceMockGetRoleEndpointsOrigins contains origins of expectations of the AccessService.GetRoleEndpoints
 type AccessServiceMockGetRoleEndpointsExpectationOrigins struct {
-	origin    string
-	originCtx string
+	origin         string
+	originCtx      string
+	originEndpoint string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1195,7 +3819,7 @@ func (mmGetRoleEndpoints *mAccessServiceMockGetRoleEndpoints) Optional() *mAcces
 }
 
 // Expect sets up expected params for AccessService.GetRoleEndpoints
-func (mmGetRoleEndpoints *mAccessServiceMockGetRoleEndpoints) Expect(ctx context.Context) *mAccessServiceMockGetRoleEndpoints {
+func (mmGetRoleEndpoints *mAccessServiceMockGetRoleEndpoints) Expect(ctx context.Context, endpoint string) *mAccessServiceMockGetRoleEndpoints {
 	if mmGetRoleEndpoints.mock.funcGetRoleEndpoints != nil {
 		mmGetRoleEndpoints.mock.t.Fatalf("AccessServiceMock.GetRoleEndpoints mock is already set by Set")
 	}
@@ -1208,7 +3832,7 @@ func (mmGetRoleEndpoints *mAccessServiceMockGetRoleEndpoints) Expect(ctx context
 		mmGetRoleEndpoints.mock.t.Fatalf("AccessServiceMock.GetRoleEndpoints mock is already set by ExpectParams functions")
 	}
 
-	mmGetRoleEndpoints.defaultExpectation.params = &AccessServiceMockGetRoleEndpointsParams{ctx}
+	mmGetRoleEndpoints.defaultExpectation.params = &AccessServiceMockGetRoleEndpointsParams{ctx, endpoint}
 	mmGetRoleEndpoints.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmGetRoleEndpoints.expectations {
 		if minimock.Equal(e.params, mmGetRoleEndpoints.defaultExpectation.params) {
@@ -1242,8 +3866,31 @@ func (mmGetRoleEndpoints *mAccessServiceMockGetRoleEndpoints) ExpectCtxParam1(ct
 	return mmGetRoleEndpoints
 }
 
+// ExpectEndpointParam2 sets up expected param endpoint for AccessService.GetRoleEndpoints
+func (mmGetRoleEndpoints *mAccessServiceMockGetRoleEndpoints) ExpectEndpointParam2(endpoint string) *mAccessServiceMockGetRoleEndpoints {
+	if mmGetRoleEndpoints.mock.funcGetRoleEndpoints != nil {
+		mmGetRoleEndpoints.mock.t.Fatalf("AccessServiceMock.GetRoleEndpoints mock is already set by Set")
+	}
+
+	if mmGetRoleEndpoints.defaultExpectation == nil {
+		mmGetRoleEndpoints.defaultExpectation = &AccessServiceMockGetRoleEndpointsExpectation{}
+	}
+
+	if mmGetRoleEndpoints.defaultExpectation.params != nil {
+		mmGetRoleEndpoints.mock.t.Fatalf("AccessServiceMock.GetRoleEndpoints mock is already set by Expect")
+	}
+
+	if mmGetRoleEndpoints.defaultExpectation.paramPtrs == nil {
+		mmGetRoleEndpoints.defaultExpectation.paramPtrs = &AccessServiceMockGetRoleEndpointsParamPtrs{}
+	}
+	mmGetRoleEndpoints.defaultExpectation.paramPtrs.endpoint = &endpoint
+	mmGetRoleEndpoints.defaultExpectation.expectationOrigins.originEndpoint = minimock.CallerInfo(1)
+
+	return mmGetRoleEndpoints
+}
+
 // Inspect accepts an inspector function that has same arguments as the AccessService.GetRoleEndpoints
-func (mmGetRoleEndpoints *mAccessServiceMockGetRoleEndpoints) Inspect(f func(ctx context.Context)) *mAccessServiceMockGetRoleEndpoints {
+func (mmGetRoleEndpoints *mAccessServiceMockGetRoleEndpoints) Inspect(f func(ctx context.Context, endpoint string)) *mAccessServiceMockGetRoleEndpoints {
 	if mmGetRoleEndpoints.mock.inspectFuncGetRoleEndpoints != nil {
 		mmGetRoleEndpoints.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.GetRoleEndpoints")
 	}
@@ -1268,7 +3915,7 @@ func (mmGetRoleEndpoints *mAccessServiceMockGetRoleEndpoints) Return(epa1 []*mod
 }
 
 // Set uses given function f to mock the AccessService.GetRoleEndpoints method
-func (mmGetRoleEndpoints *mAccessServiceMockGetRoleEndpoints) Set(f func(ctx context.Context) (epa1 []*model.EndpointPermissions, err error)) *AccessServiceMock {
+func (mmGetRoleEndpoints *mAccessServiceMockGetRoleEndpoints) Set(f func(ctx context.Context, endpoint string) (epa1 []*model.EndpointPermissions, err error)) *AccessServiceMock {
 	if mmGetRoleEndpoints.defaultExpectation != nil {
 		mmGetRoleEndpoints.mock.t.Fatalf("Default expectation is already set for the AccessService.GetRoleEndpoints method")
 	}
@@ -1284,14 +3931,14 @@ func (mmGetRoleEndpoints *mAccessServiceMockGetRoleEndpoints) Set(f func(ctx con
 
 // When sets expectation for the AccessService.GetRoleEndpoints which will trigger the result defined by the following
 // Then helper
-func (mmGetRoleEndpoints *mAccessServiceMockGetRoleEndpoints) When(ctx context.Context) *AccessServiceMockGetRoleEndpointsExpectation {
+func (mmGetRoleEndpoints *mAccessServiceMockGetRoleEndpoints) When(ctx context.Context, endpoint string) *AccessServiceMockGetRoleEndpointsExpectation {
 	if mmGetRoleEndpoints.mock.funcGetRoleEndpoints != nil {
 		mmGetRoleEndpoints.mock.t.Fatalf("AccessServiceMock.GetRoleEndpoints mock is already set by Set")
 	}
 
 	expectation := &AccessServiceMockGetRoleEndpointsExpectation{
 		mock:               mmGetRoleEndpoints.mock,
-		params:             &AccessServiceMockGetRoleEndpointsParams{ctx},
+		params:             &AccessServiceMockGetRoleEndpointsParams{ctx, endpoint},
 		expectationOrigins: AccessServiceMockGetRoleEndpointsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmGetRoleEndpoints.expectations = append(mmGetRoleEndpoints.expectations, expectation)
@@ -1326,17 +3973,17 @@ func (mmGetRoleEndpoints *mAccessServiceMockGetRoleEndpoints) invocationsDone()
 }
 
 // GetRoleEndpoints implements mm_service.AccessService
-func (mmGetRoleEndpoints *AccessServiceMock) GetRoleEndpoints(ctx context.Context) (epa1 []*model.EndpointPermissions, err error) {
+func (mmGetRoleEndpoints *AccessServiceMock) GetRoleEndpoints(ctx context.Context, endpoint string) (epa1 []*model.EndpointPermissions, err error) {
 	mm_atomic.AddUint64(&mmGetRoleEndpoints.beforeGetRoleEndpointsCounter, 1)
 	defer mm_atomic.AddUint64(&mmGetRoleEndpoints.afterGetRoleEndpointsCounter, 1)
 
 	mmGetRoleEndpoints.t.Helper()
 
 	if mmGetRoleEndpoints.inspectFuncGetRoleEndpoints != nil {
-		mmGetRoleEndpoints.inspectFuncGetRoleEndpoints(ctx)
+		mmGetRoleEndpoints.inspectFuncGetRoleEndpoints(ctx, endpoint)
 	}
 
-	mm_params := AccessServiceMockGetRoleEndpointsParams{ctx}
+	mm_params := AccessServiceMockGetRoleEndpointsParams{ctx, endpoint}
 
 	// Record call args
 	mmGetRoleEndpoints.GetRoleEndpointsMock.mutex.Lock()
@@ -1355,7 +4002,7 @@ func (mmGetRoleEndpoints *AccessServiceMock) GetRoleEndpoints(ctx context.Contex
 		mm_want := mmGetRoleEndpoints.GetRoleEndpointsMock.defaultExpectation.params
 		mm_want_ptrs := mmGetRoleEndpoints.GetRoleEndpointsMock.defaultExpectation.paramPtrs
 
-		mm_got := AccessServiceMockGetRoleEndpointsParams{ctx}
+		mm_got := AccessServiceMockGetRoleEndpointsParams{ctx, endpoint}
 
 		if mm_want_ptrs != nil {
 
@@ -1364,6 +4011,11 @@ func (mmGetRoleEndpoints *AccessServiceMock) GetRoleEndpoints(ctx context.Contex
 					mmGetRoleEndpoints.GetRoleEndpointsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
+			if mm_want_ptrs.endpoint != nil && !minimock.Equal(*mm_want_ptrs.endpoint, mm_got.endpoint) {
+				mmGetRoleEndpoints.t.Errorf("AccessServiceMock.GetRoleEndpoints got unexpected parameter endpoint, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRoleEndpoints.GetRoleEndpointsMock.defaultExpectation.expectationOrigins.originEndpoint, *mm_want_ptrs.endpoint, mm_got.endpoint, minimock.Diff(*mm_want_ptrs.endpoint, mm_got.endpoint))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmGetRoleEndpoints.t.Errorf("AccessServiceMock.GetRoleEndpoints got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmGetRoleEndpoints.GetRoleEndpointsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -1376,9 +4028,9 @@ func (mmGetRoleEndpoints *AccessServiceMock) GetRoleEndpoints(ctx context.Contex
 		return (*mm_results).epa1, (*mm_results).err
 	}
 	if mmGetRoleEndpoints.funcGetRoleEndpoints != nil {
-		return mmGetRoleEndpoints.funcGetRoleEndpoints(ctx)
+		return mmGetRoleEndpoints.funcGetRoleEndpoints(ctx, endpoint)
 	}
-	mmGetRoleEndpoints.t.Fatalf("Unexpected call to AccessServiceMock.GetRoleEndpoints. %v", ctx)
+	mmGetRoleEndpoints.t.Fatalf("Unexpected call to AccessServiceMock.GetRoleEndpoints. %v %v", ctx, endpoint)
 	return
 }
 
@@ -1476,16 +4128,18 @@ type AccessServiceMockUpdateRoleEndpointExpectation struct {
 
 // AccessServiceMockUpdateRoleEndpointParams contains parameters of the AccessService.UpdateRoleEndpoint
 type AccessServiceMockUpdateRoleEndpointParams struct {
-	ctx      context.Context
-	endpoint string
-	roles    []string
+	ctx       context.Context
+	endpoint  string
+	roles     []string
+	condition *string
 }
 
 // AccessServiceMockUpdateRoleEndpointParamPtrs contains pointers to parameters of the AccessService.UpdateRoleEndpoint
 type AccessServiceMockUpdateRoleEndpointParamPtrs struct {
-	ctx      *context.Context
-	endpoint *string
-	roles    *[]string
+	ctx       *context.Context
+	endpoint  *string
+	roles     *[]string
+	condition **string
 }
 
 // AccessServiceMockUpdateRoleEndpointResults contains results of the AccessService.UpdateRoleEndpoint
@@ -1495,10 +4149,11 @@ type AccessServiceMockUpdateRoleEndpointResults struct {
 
 // AccessServiceMockUpdateRoleEndpointOrigins contains origins of expectations of the AccessService.UpdateRoleEndpoint
 type AccessServiceMockUpdateRoleEndpointExpectationOrigins struct {
-	origin         string
-	originCtx      string
-	originEndpoint string
-	originRoles    string
+	origin          string
+	originCtx       string
+	originEndpoint  string
+	originRoles     string
+	originCondition string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1512,7 +4167,7 @@ func (mmUpdateRoleEndpoint *mAccessServiceMockUpdateRoleEndpoint) Optional() *mA
 }
 
 // Expect sets up expected params for AccessService.UpdateRoleEndpoint
-func (mmUpdateRoleEndpoint *mAccessServiceMockUpdateRoleEndpoint) Expect(ctx context.Context, endpoint string, roles []string) *mAccessServiceMockUpdateRoleEndpoint {
+func (mmUpdateRoleEndpoint *mAccessServiceMockUpdateRoleEndpoint) Expect(ctx context.Context, endpoint string, roles []string, condition *string) *mAccessServiceMockUpdateRoleEndpoint {
 	if mmUpdateRoleEndpoint.mock.funcUpdateRoleEndpoint != nil {
 		mmUpdateRoleEndpoint.mock.t.Fatalf("AccessServiceMock.UpdateRoleEndpoint mock is already set by Set")
 	}
@@ -1525,7 +4180,7 @@ func (mmUpdateRoleEndpoint *mAccessServiceMockUpdateRoleEndpoint) Expect(ctx con
 		mmUpdateRoleEndpoint.mock.t.Fatalf("AccessServiceMock.UpdateRoleEndpoint mock is already set by ExpectParams functions")
 	}
 
-	mmUpdateRoleEndpoint.defaultExpectation.params = &AccessServiceMockUpdateRoleEndpointParams{ctx, endpoint, roles}
+	mmUpdateRoleEndpoint.defaultExpectation.params = &AccessServiceMockUpdateRoleEndpointParams{ctx, endpoint, roles, condition}
 	mmUpdateRoleEndpoint.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmUpdateRoleEndpoint.expectations {
 		if minimock.Equal(e.params, mmUpdateRoleEndpoint.defaultExpectation.params) {
@@ -1605,8 +4260,31 @@ func (mmUpdateRoleEndpoint *mAccessServiceMockUpdateRoleEndpoint) ExpectRolesPar
 	return mmUpdateRoleEndpoint
 }
 
+// ExpectConditionParam4 sets up expected param condition for AccessService.UpdateRoleEndpoint
+func (mmUpdateRoleEndpoint *mAccessServiceMockUpdateRoleEndpoint) ExpectConditionParam4(condition *string) *mAccessServiceMockUpdateRoleEndpoint {
+	if mmUpdateRoleEndpoint.mock.funcUpdateRoleEndpoint != nil {
+		mmUpdateRoleEndpoint.mock.t.Fatalf("AccessServiceMock.UpdateRoleEndpoint mock is already set by Set")
+	}
+
+	if mmUpdateRoleEndpoint.defaultExpectation == nil {
+		mmUpdateRoleEndpoint.defaultExpectation = &AccessServiceMockUpdateRoleEndpointExpectation{}
+	}
+
+	if mmUpdateRoleEndpoint.defaultExpectation.params != nil {
+		mmUpdateRoleEndpoint.mock.t.Fatalf("AccessServiceMock.UpdateRoleEndpoint mock is already set by Expect")
+	}
+
+	if mmUpdateRoleEndpoint.defaultExpectation.paramPtrs == nil {
+		mmUpdateRoleEndpoint.defaultExpectation.paramPtrs = &AccessServiceMockUpdateRoleEndpointParamPtrs{}
+	}
+	mmUpdateRoleEndpoint.defaultExpectation.paramPtrs.condition = &condition
+	mmUpdateRoleEndpoint.defaultExpectation.expectationOrigins.originCondition = minimock.CallerInfo(1)
+
+	return mmUpdateRoleEndpoint
+}
+
 // Inspect accepts an inspector function that has same arguments as the AccessService.UpdateRoleEndpoint
-func (mmUpdateRoleEndpoint *mAccessServiceMockUpdateRoleEndpoint) Inspect(f func(ctx context.Context, endpoint string, roles []string)) *mAccessServiceMockUpdateRoleEndpoint {
+func (mmUpdateRoleEndpoint *mAccessServiceMockUpdateRoleEndpoint) Inspect(f func(ctx context.Context, endpoint string, roles []string, condition *string)) *mAccessServiceMockUpdateRoleEndpoint {
 	if mmUpdateRoleEndpoint.mock.inspectFuncUpdateRoleEndpoint != nil {
 		mmUpdateRoleEndpoint.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.UpdateRoleEndpoint")
 	}
@@ -1631,7 +4309,7 @@ func (mmUpdateRoleEndpoint *mAccessServiceMockUpdateRoleEndpoint) Return(err err
 }
 
 // Set uses given function f to mock the AccessService.UpdateRoleEndpoint method
-func (mmUpdateRoleEndpoint *mAccessServiceMockUpdateRoleEndpoint) Set(f func(ctx context.Context, endpoint string, roles []string) (err error)) *AccessServiceMock {
+func (mmUpdateRoleEndpoint *mAccessServiceMockUpdateRoleEndpoint) Set(f func(ctx context.Context, endpoint string, roles []string, condition *string) (err error)) *AccessServiceMock {
 	if mmUpdateRoleEndpoint.defaultExpectation != nil {
 		mmUpdateRoleEndpoint.mock.t.Fatalf("Default expectation is already set for the AccessService.UpdateRoleEndpoint method")
 	}
@@ -1647,14 +4325,14 @@ func (mmUpdateRoleEndpoint *mAccessServiceMockUpdateRoleEndpoint) Set(f func(ctx
 
 // When sets expectation for the AccessService.UpdateRoleEndpoint which will trigger the result defined by the following
 // Then helper
-func (mmUpdateRoleEndpoint *mAccessServiceMockUpdateRoleEndpoint) When(ctx context.Context, endpoint string, roles []string) *AccessServiceMockUpdateRoleEndpointExpectation {
+func (mmUpdateRoleEndpoint *mAccessServiceMockUpdateRoleEndpoint) When(ctx context.Context, endpoint string, roles []string, condition *string) *AccessServiceMockUpdateRoleEndpointExpectation {
 	if mmUpdateRoleEndpoint.mock.funcUpdateRoleEndpoint != nil {
 		mmUpdateRoleEndpoint.mock.t.Fatalf("AccessServiceMock.UpdateRoleEndpoint mock is already set by Set")
 	}
 
 	expectation := &AccessServiceMockUpdateRoleEndpointExpectation{
 		mock:               mmUpdateRoleEndpoint.mock,
-		params:             &AccessServiceMockUpdateRoleEndpointParams{ctx, endpoint, roles},
+		params:             &AccessServiceMockUpdateRoleEndpointParams{ctx, endpoint, roles, condition},
 		expectationOrigins: AccessServiceMockUpdateRoleEndpointExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmUpdateRoleEndpoint.expectations = append(mmUpdateRoleEndpoint.expectations, expectation)
@@ -1689,17 +4367,17 @@ func (mmUpdateRoleEndpoint *mAccessServiceMockUpdateRoleEndpoint) invocationsDon
 }
 
 // UpdateRoleEndpoint implements mm_service.AccessService
-func (mmUpdateRoleEndpoint *AccessServiceMock) UpdateRoleEndpoint(ctx context.Context, endpoint string, roles []string) (err error) {
+func (mmUpdateRoleEndpoint *AccessServiceMock) UpdateRoleEndpoint(ctx context.Context, endpoint string, roles []string, condition *string) (err error) {
 	mm_atomic.AddUint64(&mmUpdateRoleEndpoint.beforeUpdateRoleEndpointCounter, 1)
 	defer mm_atomic.AddUint64(&mmUpdateRoleEndpoint.afterUpdateRoleEndpointCounter, 1)
 
 	mmUpdateRoleEndpoint.t.Helper()
 
 	if mmUpdateRoleEndpoint.inspectFuncUpdateRoleEndpoint != nil {
-		mmUpdateRoleEndpoint.inspectFuncUpdateRoleEndpoint(ctx, endpoint, roles)
+		mmUpdateRoleEndpoint.inspectFuncUpdateRoleEndpoint(ctx, endpoint, roles, condition)
 	}
 
-	mm_params := AccessServiceMockUpdateRoleEndpointParams{ctx, endpoint, roles}
+	mm_params := AccessServiceMockUpdateRoleEndpointParams{ctx, endpoint, roles, condition}
 
 	// Record call args
 	mmUpdateRoleEndpoint.UpdateRoleEndpointMock.mutex.Lock()
@@ -1718,7 +4396,7 @@ func (mmUpdateRoleEndpoint *AccessServiceMock) UpdateRoleEndpoint(ctx context.Co
 		mm_want := mmUpdateRoleEndpoint.UpdateRoleEndpointMock.defaultExpectation.params
 		mm_want_ptrs := mmUpdateRoleEndpoint.UpdateRoleEndpointMock.defaultExpectation.paramPtrs
 
-		mm_got := AccessServiceMockUpdateRoleEndpointParams{ctx, endpoint, roles}
+		mm_got := AccessServiceMockUpdateRoleEndpointParams{ctx, endpoint, roles, condition}
 
 		if mm_want_ptrs != nil {
 
@@ -1737,6 +4415,11 @@ func (mmUpdateRoleEndpoint *AccessServiceMock) UpdateRoleEndpoint(ctx context.Co
 					mmUpdateRoleEndpoint.UpdateRoleEndpointMock.defaultExpectation.expectationOrigins.originRoles, *mm_want_ptrs.roles, mm_got.roles, minimock.Diff(*mm_want_ptrs.roles, mm_got.roles))
 			}
 
+			if mm_want_ptrs.condition != nil && !minimock.Equal(*mm_want_ptrs.condition, mm_got.condition) {
+				mmUpdateRoleEndpoint.t.Errorf("AccessServiceMock.UpdateRoleEndpoint got unexpected parameter condition, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateRoleEndpoint.UpdateRoleEndpointMock.defaultExpectation.expectationOrigins.originCondition, *mm_want_ptrs.condition, mm_got.condition, minimock.Diff(*mm_want_ptrs.condition, mm_got.condition))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmUpdateRoleEndpoint.t.Errorf("AccessServiceMock.UpdateRoleEndpoint got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmUpdateRoleEndpoint.UpdateRoleEndpointMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -1749,9 +4432,9 @@ func (mmUpdateRoleEndpoint *AccessServiceMock) UpdateRoleEndpoint(ctx context.Co
 		return (*mm_results).err
 	}
 	if mmUpdateRoleEndpoint.funcUpdateRoleEndpoint != nil {
-		return mmUpdateRoleEndpoint.funcUpdateRoleEndpoint(ctx, endpoint, roles)
+		return mmUpdateRoleEndpoint.funcUpdateRoleEndpoint(ctx, endpoint, roles, condition)
 	}
-	mmUpdateRoleEndpoint.t.Fatalf("Unexpected call to AccessServiceMock.UpdateRoleEndpoint. %v %v %v", ctx, endpoint, roles)
+	mmUpdateRoleEndpoint.t.Fatalf("Unexpected call to AccessServiceMock.UpdateRoleEndpoint. %v %v %v %v", ctx, endpoint, roles, condition)
 	return
 }
 
@@ -1827,12 +4510,26 @@ func (m *AccessServiceMock) MinimockUpdateRoleEndpointInspect() {
 func (m *AccessServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAddDenyRuleInspect()
+
 			m.MinimockAddRoleEndpointInspect()
 
+			m.MinimockBatchCheckInspect()
+
 			m.MinimockCheckInspect()
 
+			m.MinimockCheckAsInspect()
+
+			m.MinimockCheckPermissionInspect()
+
+			m.MinimockCheckPermissionAsInspect()
+
+			m.MinimockDeleteDenyRuleInspect()
+
 			m.MinimockDeleteRoleEndpointInspect()
 
+			m.MinimockGetDenyRulesInspect()
+
 			m.MinimockGetRoleEndpointsInspect()
 
 			m.MinimockUpdateRoleEndpointInspect()
@@ -1859,9 +4556,16 @@ func (m *AccessServiceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *AccessServiceMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAddDenyRuleDone() &&
 		m.MinimockAddRoleEndpointDone() &&
+		m.MinimockBatchCheckDone() &&
 		m.MinimockCheckDone() &&
+		m.MinimockCheckAsDone() &&
+		m.MinimockCheckPermissionDone() &&
+		m.MinimockCheckPermissionAsDone() &&
+		m.MinimockDeleteDenyRuleDone() &&
 		m.MinimockDeleteRoleEndpointDone() &&
+		m.MinimockGetDenyRulesDone() &&
 		m.MinimockGetRoleEndpointsDone() &&
 		m.MinimockUpdateRoleEndpointDone()
 }
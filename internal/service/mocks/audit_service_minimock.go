@@ -0,0 +1,1066 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// AuditServiceMock implements mm_service.AuditService
+type AuditServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcListEvents          func(ctx context.Context, params *model.AuditListParams) (ap1 *model.AuditPage, err error)
+	funcListEventsOrigin    string
+	inspectFuncListEvents   func(ctx context.Context, params *model.AuditListParams)
+	afterListEventsCounter  uint64
+	beforeListEventsCounter uint64
+	ListEventsMock          mAuditServiceMockListEvents
+
+	funcSealChain          func(ctx context.Context) (i1 int, err error)
+	funcSealChainOrigin    string
+	inspectFuncSealChain   func(ctx context.Context)
+	afterSealChainCounter  uint64
+	beforeSealChainCounter uint64
+	SealChainMock          mAuditServiceMockSealChain
+
+	funcVerifyChain          func(ctx context.Context) (ap1 *model.AuditChainVerification, err error)
+	funcVerifyChainOrigin    string
+	inspectFuncVerifyChain   func(ctx context.Context)
+	afterVerifyChainCounter  uint64
+	beforeVerifyChainCounter uint64
+	VerifyChainMock          mAuditServiceMockVerifyChain
+}
+
+// NewAuditServiceMock returns a mock for mm_service.AuditService
+func NewAuditServiceMock(t minimock.Tester) *AuditServiceMock {
+	m := &AuditServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ListEventsMock = mAuditServiceMockListEvents{mock: m}
+	m.ListEventsMock.callArgs = []*AuditServiceMockListEventsParams{}
+
+	m.SealChainMock = mAuditServiceMockSealChain{mock: m}
+	m.SealChainMock.callArgs = []*AuditServiceMockSealChainParams{}
+
+	m.VerifyChainMock = mAuditServiceMockVerifyChain{mock: m}
+	m.VerifyChainMock.callArgs = []*AuditServiceMockVerifyChainParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuditServiceMockListEvents struct {
+	optional           bool
+	mock               *AuditServiceMock
+	defaultExpectation *AuditServiceMockListEventsExpectation
+	expectations       []*AuditServiceMockListEventsExpectation
+
+	callArgs []*AuditServiceMockListEventsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditServiceMockListEventsExpectation specifies expectation struct of the AuditService.ListEvents
+type AuditServiceMockListEventsExpectation struct {
+	mock               *AuditServiceMock
+	params             *AuditServiceMockListEventsParams
+	paramPtrs          *AuditServiceMockListEventsParamPtrs
+	expectationOrigins AuditServiceMockListEventsExpectationOrigins
+	results            *AuditServiceMockListEventsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditServiceMockListEventsParams contains parameters of the AuditService.ListEvents
+type AuditServiceMockListEventsParams struct {
+	ctx    context.Context
+	params *model.AuditListParams
+}
+
+// AuditServiceMockListEventsParamPtrs contains pointers to parameters of the AuditService.ListEvents
+type AuditServiceMockListEventsParamPtrs struct {
+	ctx    *context.Context
+	params **model.AuditListParams
+}
+
+// AuditServiceMockListEventsResults contains results of the AuditService.ListEvents
+type AuditServiceMockListEventsResults struct {
+	ap1 *model.AuditPage
+	err error
+}
+
+// AuditServiceMockListEventsOrigins contains origins of expectations of the AuditService.ListEvents
+type AuditServiceMockListEventsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListEvents *mAuditServiceMockListEvents) Optional() *mAuditServiceMockListEvents {
+	mmListEvents.optional = true
+	return mmListEvents
+}
+
+// Expect sets up expected params for AuditService.ListEvents
+func (mmListEvents *mAuditServiceMockListEvents) Expect(ctx context.Context, params *model.AuditListParams) *mAuditServiceMockListEvents {
+	if mmListEvents.mock.funcListEvents != nil {
+		mmListEvents.mock.t.Fatalf("AuditServiceMock.ListEvents mock is already set by Set")
+	}
+
+	if mmListEvents.defaultExpectation == nil {
+		mmListEvents.defaultExpectation = &AuditServiceMockListEventsExpectation{}
+	}
+
+	if mmListEvents.defaultExpectation.paramPtrs != nil {
+		mmListEvents.mock.t.Fatalf("AuditServiceMock.ListEvents mock is already set by ExpectParams functions")
+	}
+
+	mmListEvents.defaultExpectation.params = &AuditServiceMockListEventsParams{ctx, params}
+	mmListEvents.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListEvents.expectations {
+		if minimock.Equal(e.params, mmListEvents.defaultExpectation.params) {
+			mmListEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmListEvents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditService.ListEvents
+func (mmListEvents *mAuditServiceMockListEvents) ExpectCtxParam1(ctx context.Context) *mAuditServiceMockListEvents {
+	if mmListEvents.mock.funcListEvents != nil {
+		mmListEvents.mock.t.Fatalf("AuditServiceMock.ListEvents mock is already set by Set")
+	}
+
+	if mmListEvents.defaultExpectation == nil {
+		mmListEvents.defaultExpectation = &AuditServiceMockListEventsExpectation{}
+	}
+
+	if mmListEvents.defaultExpectation.params != nil {
+		mmListEvents.mock.t.Fatalf("AuditServiceMock.ListEvents mock is already set by Expect")
+	}
+
+	if mmListEvents.defaultExpectation.paramPtrs == nil {
+		mmListEvents.defaultExpectation.paramPtrs = &AuditServiceMockListEventsParamPtrs{}
+	}
+	mmListEvents.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListEvents.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListEvents
+}
+
+// ExpectParamsParam2 sets up expected param params for AuditService.ListEvents
+func (mmListEvents *mAuditServiceMockListEvents) ExpectParamsParam2(params *model.AuditListParams) *mAuditServiceMockListEvents {
+	if mmListEvents.mock.funcListEvents != nil {
+		mmListEvents.mock.t.Fatalf("AuditServiceMock.ListEvents mock is already set by Set")
+	}
+
+	if mmListEvents.defaultExpectation == nil {
+		mmListEvents.defaultExpectation = &AuditServiceMockListEventsExpectation{}
+	}
+
+	if mmListEvents.defaultExpectation.params != nil {
+		mmListEvents.mock.t.Fatalf("AuditServiceMock.ListEvents mock is already set by Expect")
+	}
+
+	if mmListEvents.defaultExpectation.paramPtrs == nil {
+		mmListEvents.defaultExpectation.paramPtrs = &AuditServiceMockListEventsParamPtrs{}
+	}
+	mmListEvents.defaultExpectation.paramPtrs.params = &params
+	mmListEvents.defaultExpectation.expectationOrigins.originParams = minimock.CallerInfo(1)
+
+	return mmListEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditService.ListEvents
+func (mmListEvents *mAuditServiceMockListEvents) Inspect(f func(ctx context.Context, params *model.AuditListParams)) *mAuditServiceMockListEvents {
+	if mmListEvents.mock.inspectFuncListEvents != nil {
+		mmListEvents.mock.t.Fatalf("Inspect function is already set for AuditServiceMock.ListEvents")
+	}
+
+	mmListEvents.mock.inspectFuncListEvents = f
+
+	return mmListEvents
+}
+
+// Return sets up results that will be returned by AuditService.ListEvents
+func (mmListEvents *mAuditServiceMockListEvents) Return(ap1 *model.AuditPage, err error) *AuditServiceMock {
+	if mmListEvents.mock.funcListEvents != nil {
+		mmListEvents.mock.t.Fatalf("AuditServiceMock.ListEvents mock is already set by Set")
+	}
+
+	if mmListEvents.defaultExpectation == nil {
+		mmListEvents.defaultExpectation = &AuditServiceMockListEventsExpectation{mock: mmListEvents.mock}
+	}
+	mmListEvents.defaultExpectation.results = &AuditServiceMockListEventsResults{ap1, err}
+	mmListEvents.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListEvents.mock
+}
+
+// Set uses given function f to mock the AuditService.ListEvents method
+func (mmListEvents *mAuditServiceMockListEvents) Set(f func(ctx context.Context, params *model.AuditListParams) (ap1 *model.AuditPage, err error)) *AuditServiceMock {
+	if mmListEvents.defaultExpectation != nil {
+		mmListEvents.mock.t.Fatalf("Default expectation is already set for the AuditService.ListEvents method")
+	}
+
+	if len(mmListEvents.expectations) > 0 {
+		mmListEvents.mock.t.Fatalf("Some expectations are already set for the AuditService.ListEvents method")
+	}
+
+	mmListEvents.mock.funcListEvents = f
+	mmListEvents.mock.funcListEventsOrigin = minimock.CallerInfo(1)
+	return mmListEvents.mock
+}
+
+// When sets expectation for the AuditService.ListEvents which will trigger the result defined by the following
+// Then helper
+func (mmListEvents *mAuditServiceMockListEvents) When(ctx context.Context, params *model.AuditListParams) *AuditServiceMockListEventsExpectation {
+	if mmListEvents.mock.funcListEvents != nil {
+		mmListEvents.mock.t.Fatalf("AuditServiceMock.ListEvents mock is already set by Set")
+	}
+
+	expectation := &AuditServiceMockListEventsExpectation{
+		mock:               mmListEvents.mock,
+		params:             &AuditServiceMockListEventsParams{ctx, params},
+		expectationOrigins: AuditServiceMockListEventsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListEvents.expectations = append(mmListEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditService.ListEvents return parameters for the expectation previously defined by the When method
+func (e *AuditServiceMockListEventsExpectation) Then(ap1 *model.AuditPage, err error) *AuditServiceMock {
+	e.results = &AuditServiceMockListEventsResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times AuditService.ListEvents should be invoked
+func (mmListEvents *mAuditServiceMockListEvents) Times(n uint64) *mAuditServiceMockListEvents {
+	if n == 0 {
+		mmListEvents.mock.t.Fatalf("Times of AuditServiceMock.ListEvents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListEvents.expectedInvocations, n)
+	mmListEvents.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListEvents
+}
+
+func (mmListEvents *mAuditServiceMockListEvents) invocationsDone() bool {
+	if len(mmListEvents.expectations) == 0 && mmListEvents.defaultExpectation == nil && mmListEvents.mock.funcListEvents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListEvents.mock.afterListEventsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListEvents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListEvents implements mm_service.AuditService
+func (mmListEvents *AuditServiceMock) ListEvents(ctx context.Context, params *model.AuditListParams) (ap1 *model.AuditPage, err error) {
+	mm_atomic.AddUint64(&mmListEvents.beforeListEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListEvents.afterListEventsCounter, 1)
+
+	mmListEvents.t.Helper()
+
+	if mmListEvents.inspectFuncListEvents != nil {
+		mmListEvents.inspectFuncListEvents(ctx, params)
+	}
+
+	mm_params := AuditServiceMockListEventsParams{ctx, params}
+
+	// Record call args
+	mmListEvents.ListEventsMock.mutex.Lock()
+	mmListEvents.ListEventsMock.callArgs = append(mmListEvents.ListEventsMock.callArgs, &mm_params)
+	mmListEvents.ListEventsMock.mutex.Unlock()
+
+	for _, e := range mmListEvents.ListEventsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmListEvents.ListEventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListEvents.ListEventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListEvents.ListEventsMock.defaultExpectation.params
+		mm_want_ptrs := mmListEvents.ListEventsMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditServiceMockListEventsParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListEvents.t.Errorf("AuditServiceMock.ListEvents got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListEvents.ListEventsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListEvents.t.Errorf("AuditServiceMock.ListEvents got unexpected parameter params, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListEvents.ListEventsMock.defaultExpectation.expectationOrigins.originParams, *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListEvents.t.Errorf("AuditServiceMock.ListEvents got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListEvents.ListEventsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListEvents.ListEventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListEvents.t.Fatal("No results are set for the AuditServiceMock.ListEvents")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmListEvents.funcListEvents != nil {
+		return mmListEvents.funcListEvents(ctx, params)
+	}
+	mmListEvents.t.Fatalf("Unexpected call to AuditServiceMock.ListEvents. %v %v", ctx, params)
+	return
+}
+
+// ListEventsAfterCounter returns a count of finished AuditServiceMock.ListEvents invocations
+func (mmListEvents *AuditServiceMock) ListEventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListEvents.afterListEventsCounter)
+}
+
+// ListEventsBeforeCounter returns a count of AuditServiceMock.ListEvents invocations
+func (mmListEvents *AuditServiceMock) ListEventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListEvents.beforeListEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditServiceMock.ListEvents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListEvents *mAuditServiceMockListEvents) Calls() []*AuditServiceMockListEventsParams {
+	mmListEvents.mutex.RLock()
+
+	argCopy := make([]*AuditServiceMockListEventsParams, len(mmListEvents.callArgs))
+	copy(argCopy, mmListEvents.callArgs)
+
+	mmListEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListEventsDone returns true if the count of the ListEvents invocations corresponds
+// the number of defined expectations
+func (m *AuditServiceMock) MinimockListEventsDone() bool {
+	if m.ListEventsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListEventsMock.invocationsDone()
+}
+
+// MinimockListEventsInspect logs each unmet expectation
+func (m *AuditServiceMock) MinimockListEventsInspect() {
+	for _, e := range m.ListEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditServiceMock.ListEvents at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListEventsCounter := mm_atomic.LoadUint64(&m.afterListEventsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListEventsMock.defaultExpectation != nil && afterListEventsCounter < 1 {
+		if m.ListEventsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditServiceMock.ListEvents at\n%s", m.ListEventsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditServiceMock.ListEvents at\n%s with params: %#v", m.ListEventsMock.defaultExpectation.expectationOrigins.origin, *m.ListEventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListEvents != nil && afterListEventsCounter < 1 {
+		m.t.Errorf("Expected call to AuditServiceMock.ListEvents at\n%s", m.funcListEventsOrigin)
+	}
+
+	if !m.ListEventsMock.invocationsDone() && afterListEventsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditServiceMock.ListEvents at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListEventsMock.expectedInvocations), m.ListEventsMock.expectedInvocationsOrigin, afterListEventsCounter)
+	}
+}
+
+type mAuditServiceMockSealChain struct {
+	optional           bool
+	mock               *AuditServiceMock
+	defaultExpectation *AuditServiceMockSealChainExpectation
+	expectations       []*AuditServiceMockSealChainExpectation
+
+	callArgs []*AuditServiceMockSealChainParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditServiceMockSealChainExpectation specifies expectation struct of the AuditService.SealChain
+type AuditServiceMockSealChainExpectation struct {
+	mock               *AuditServiceMock
+	params             *AuditServiceMockSealChainParams
+	paramPtrs          *AuditServiceMockSealChainParamPtrs
+	expectationOrigins AuditServiceMockSealChainExpectationOrigins
+	results            *AuditServiceMockSealChainResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditServiceMockSealChainParams contains parameters of the AuditService.SealChain
+type AuditServiceMockSealChainParams struct {
+	ctx context.Context
+}
+
+// AuditServiceMockSealChainParamPtrs contains pointers to parameters of the AuditService.SealChain
+type AuditServiceMockSealChainParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuditServiceMockSealChainResults contains results of the AuditService.SealChain
+type AuditServiceMockSealChainResults struct {
+	i1  int
+	err error
+}
+
+// AuditServiceMockSealChainOrigins contains origins of expectations of the AuditService.SealChain
+type AuditServiceMockSealChainExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSealChain *mAuditServiceMockSealChain) Optional() *mAuditServiceMockSealChain {
+	mmSealChain.optional = true
+	return mmSealChain
+}
+
+// Expect sets up expected params for AuditService.SealChain
+func (mmSealChain *mAuditServiceMockSealChain) Expect(ctx context.Context) *mAuditServiceMockSealChain {
+	if mmSealChain.mock.funcSealChain != nil {
+		mmSealChain.mock.t.Fatalf("AuditServiceMock.SealChain mock is already set by Set")
+	}
+
+	if mmSealChain.defaultExpectation == nil {
+		mmSealChain.defaultExpectation = &AuditServiceMockSealChainExpectation{}
+	}
+
+	if mmSealChain.defaultExpectation.paramPtrs != nil {
+		mmSealChain.mock.t.Fatalf("AuditServiceMock.SealChain mock is already set by ExpectParams functions")
+	}
+
+	mmSealChain.defaultExpectation.params = &AuditServiceMockSealChainParams{ctx}
+	mmSealChain.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSealChain.expectations {
+		if minimock.Equal(e.params, mmSealChain.defaultExpectation.params) {
+			mmSealChain.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSealChain.defaultExpectation.params)
+		}
+	}
+
+	return mmSealChain
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditService.SealChain
+func (mmSealChain *mAuditServiceMockSealChain) ExpectCtxParam1(ctx context.Context) *mAuditServiceMockSealChain {
+	if mmSealChain.mock.funcSealChain != nil {
+		mmSealChain.mock.t.Fatalf("AuditServiceMock.SealChain mock is already set by Set")
+	}
+
+	if mmSealChain.defaultExpectation == nil {
+		mmSealChain.defaultExpectation = &AuditServiceMockSealChainExpectation{}
+	}
+
+	if mmSealChain.defaultExpectation.params != nil {
+		mmSealChain.mock.t.Fatalf("AuditServiceMock.SealChain mock is already set by Expect")
+	}
+
+	if mmSealChain.defaultExpectation.paramPtrs == nil {
+		mmSealChain.defaultExpectation.paramPtrs = &AuditServiceMockSealChainParamPtrs{}
+	}
+	mmSealChain.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSealChain.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSealChain
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditService.SealChain
+func (mmSealChain *mAuditServiceMockSealChain) Inspect(f func(ctx context.Context)) *mAuditServiceMockSealChain {
+	if mmSealChain.mock.inspectFuncSealChain != nil {
+		mmSealChain.mock.t.Fatalf("Inspect function is already set for AuditServiceMock.SealChain")
+	}
+
+	mmSealChain.mock.inspectFuncSealChain = f
+
+	return mmSealChain
+}
+
+// Return sets up results that will be returned by AuditService.SealChain
+func (mmSealChain *mAuditServiceMockSealChain) Return(i1 int, err error) *AuditServiceMock {
+	if mmSealChain.mock.funcSealChain != nil {
+		mmSealChain.mock.t.Fatalf("AuditServiceMock.SealChain mock is already set by Set")
+	}
+
+	if mmSealChain.defaultExpectation == nil {
+		mmSealChain.defaultExpectation = &AuditServiceMockSealChainExpectation{mock: mmSealChain.mock}
+	}
+	mmSealChain.defaultExpectation.results = &AuditServiceMockSealChainResults{i1, err}
+	mmSealChain.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSealChain.mock
+}
+
+// Set uses given function f to mock the AuditService.SealChain method
+func (mmSealChain *mAuditServiceMockSealChain) Set(f func(ctx context.Context) (i1 int, err error)) *AuditServiceMock {
+	if mmSealChain.defaultExpectation != nil {
+		mmSealChain.mock.t.Fatalf("Default expectation is already set for the AuditService.SealChain method")
+	}
+
+	if len(mmSealChain.expectations) > 0 {
+		mmSealChain.mock.t.Fatalf("Some expectations are already set for the AuditService.SealChain method")
+	}
+
+	mmSealChain.mock.funcSealChain = f
+	mmSealChain.mock.funcSealChainOrigin = minimock.CallerInfo(1)
+	return mmSealChain.mock
+}
+
+// When sets expectation for the AuditService.SealChain which will trigger the result defined by the following
+// Then helper
+func (mmSealChain *mAuditServiceMockSealChain) When(ctx context.Context) *AuditServiceMockSealChainExpectation {
+	if mmSealChain.mock.funcSealChain != nil {
+		mmSealChain.mock.t.Fatalf("AuditServiceMock.SealChain mock is already set by Set")
+	}
+
+	expectation := &AuditServiceMockSealChainExpectation{
+		mock:               mmSealChain.mock,
+		params:             &AuditServiceMockSealChainParams{ctx},
+		expectationOrigins: AuditServiceMockSealChainExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSealChain.expectations = append(mmSealChain.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditService.SealChain return parameters for the expectation previously defined by the When method
+func (e *AuditServiceMockSealChainExpectation) Then(i1 int, err error) *AuditServiceMock {
+	e.results = &AuditServiceMockSealChainResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times AuditService.SealChain should be invoked
+func (mmSealChain *mAuditServiceMockSealChain) Times(n uint64) *mAuditServiceMockSealChain {
+	if n == 0 {
+		mmSealChain.mock.t.Fatalf("Times of AuditServiceMock.SealChain mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSealChain.expectedInvocations, n)
+	mmSealChain.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSealChain
+}
+
+func (mmSealChain *mAuditServiceMockSealChain) invocationsDone() bool {
+	if len(mmSealChain.expectations) == 0 && mmSealChain.defaultExpectation == nil && mmSealChain.mock.funcSealChain == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSealChain.mock.afterSealChainCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSealChain.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SealChain implements mm_service.AuditService
+func (mmSealChain *AuditServiceMock) SealChain(ctx context.Context) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmSealChain.beforeSealChainCounter, 1)
+	defer mm_atomic.AddUint64(&mmSealChain.afterSealChainCounter, 1)
+
+	mmSealChain.t.Helper()
+
+	if mmSealChain.inspectFuncSealChain != nil {
+		mmSealChain.inspectFuncSealChain(ctx)
+	}
+
+	mm_params := AuditServiceMockSealChainParams{ctx}
+
+	// Record call args
+	mmSealChain.SealChainMock.mutex.Lock()
+	mmSealChain.SealChainMock.callArgs = append(mmSealChain.SealChainMock.callArgs, &mm_params)
+	mmSealChain.SealChainMock.mutex.Unlock()
+
+	for _, e := range mmSealChain.SealChainMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmSealChain.SealChainMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSealChain.SealChainMock.defaultExpectation.Counter, 1)
+		mm_want := mmSealChain.SealChainMock.defaultExpectation.params
+		mm_want_ptrs := mmSealChain.SealChainMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditServiceMockSealChainParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSealChain.t.Errorf("AuditServiceMock.SealChain got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSealChain.SealChainMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSealChain.t.Errorf("AuditServiceMock.SealChain got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSealChain.SealChainMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSealChain.SealChainMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSealChain.t.Fatal("No results are set for the AuditServiceMock.SealChain")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmSealChain.funcSealChain != nil {
+		return mmSealChain.funcSealChain(ctx)
+	}
+	mmSealChain.t.Fatalf("Unexpected call to AuditServiceMock.SealChain. %v", ctx)
+	return
+}
+
+// SealChainAfterCounter returns a count of finished AuditServiceMock.SealChain invocations
+func (mmSealChain *AuditServiceMock) SealChainAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSealChain.afterSealChainCounter)
+}
+
+// SealChainBeforeCounter returns a count of AuditServiceMock.SealChain invocations
+func (mmSealChain *AuditServiceMock) SealChainBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSealChain.beforeSealChainCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditServiceMock.SealChain.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSealChain *mAuditServiceMockSealChain) Calls() []*AuditServiceMockSealChainParams {
+	mmSealChain.mutex.RLock()
+
+	argCopy := make([]*AuditServiceMockSealChainParams, len(mmSealChain.callArgs))
+	copy(argCopy, mmSealChain.callArgs)
+
+	mmSealChain.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSealChainDone returns true if the count of the SealChain invocations corresponds
+// the number of defined expectations
+func (m *AuditServiceMock) MinimockSealChainDone() bool {
+	if m.SealChainMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SealChainMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SealChainMock.invocationsDone()
+}
+
+// MinimockSealChainInspect logs each unmet expectation
+func (m *AuditServiceMock) MinimockSealChainInspect() {
+	for _, e := range m.SealChainMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditServiceMock.SealChain at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSealChainCounter := mm_atomic.LoadUint64(&m.afterSealChainCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SealChainMock.defaultExpectation != nil && afterSealChainCounter < 1 {
+		if m.SealChainMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditServiceMock.SealChain at\n%s", m.SealChainMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditServiceMock.SealChain at\n%s with params: %#v", m.SealChainMock.defaultExpectation.expectationOrigins.origin, *m.SealChainMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSealChain != nil && afterSealChainCounter < 1 {
+		m.t.Errorf("Expected call to AuditServiceMock.SealChain at\n%s", m.funcSealChainOrigin)
+	}
+
+	if !m.SealChainMock.invocationsDone() && afterSealChainCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditServiceMock.SealChain at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SealChainMock.expectedInvocations), m.SealChainMock.expectedInvocationsOrigin, afterSealChainCounter)
+	}
+}
+
+type mAuditServiceMockVerifyChain struct {
+	optional           bool
+	mock               *AuditServiceMock
+	defaultExpectation *AuditServiceMockVerifyChainExpectation
+	expectations       []*AuditServiceMockVerifyChainExpectation
+
+	callArgs []*AuditServiceMockVerifyChainParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditServiceMockVerifyChainExpectation specifies expectation struct of the AuditService.VerifyChain
+type AuditServiceMockVerifyChainExpectation struct {
+	mock               *AuditServiceMock
+	params             *AuditServiceMockVerifyChainParams
+	paramPtrs          *AuditServiceMockVerifyChainParamPtrs
+	expectationOrigins AuditServiceMockVerifyChainExpectationOrigins
+	results            *AuditServiceMockVerifyChainResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditServiceMockVerifyChainParams contains parameters of the AuditService.VerifyChain
+type AuditServiceMockVerifyChainParams struct {
+	ctx context.Context
+}
+
+// AuditServiceMockVerifyChainParamPtrs contains pointers to parameters of the AuditService.VerifyChain
+type AuditServiceMockVerifyChainParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuditServiceMockVerifyChainResults contains results of the AuditService.VerifyChain
+type AuditServiceMockVerifyChainResults struct {
+	ap1 *model.AuditChainVerification
+	err error
+}
+
+// AuditServiceMockVerifyChainOrigins contains origins of expectations of the AuditService.VerifyChain
+type AuditServiceMockVerifyChainExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmVerifyChain *mAuditServiceMockVerifyChain) Optional() *mAuditServiceMockVerifyChain {
+	mmVerifyChain.optional = true
+	return mmVerifyChain
+}
+
+// Expect sets up expected params for AuditService.VerifyChain
+func (mmVerifyChain *mAuditServiceMockVerifyChain) Expect(ctx context.Context) *mAuditServiceMockVerifyChain {
+	if mmVerifyChain.mock.funcVerifyChain != nil {
+		mmVerifyChain.mock.t.Fatalf("AuditServiceMock.VerifyChain mock is already set by Set")
+	}
+
+	if mmVerifyChain.defaultExpectation == nil {
+		mmVerifyChain.defaultExpectation = &AuditServiceMockVerifyChainExpectation{}
+	}
+
+	if mmVerifyChain.defaultExpectation.paramPtrs != nil {
+		mmVerifyChain.mock.t.Fatalf("AuditServiceMock.VerifyChain mock is already set by ExpectParams functions")
+	}
+
+	mmVerifyChain.defaultExpectation.params = &AuditServiceMockVerifyChainParams{ctx}
+	mmVerifyChain.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmVerifyChain.expectations {
+		if minimock.Equal(e.params, mmVerifyChain.defaultExpectation.params) {
+			mmVerifyChain.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmVerifyChain.defaultExpectation.params)
+		}
+	}
+
+	return mmVerifyChain
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditService.VerifyChain
+func (mmVerifyChain *mAuditServiceMockVerifyChain) ExpectCtxParam1(ctx context.Context) *mAuditServiceMockVerifyChain {
+	if mmVerifyChain.mock.funcVerifyChain != nil {
+		mmVerifyChain.mock.t.Fatalf("AuditServiceMock.VerifyChain mock is already set by Set")
+	}
+
+	if mmVerifyChain.defaultExpectation == nil {
+		mmVerifyChain.defaultExpectation = &AuditServiceMockVerifyChainExpectation{}
+	}
+
+	if mmVerifyChain.defaultExpectation.params != nil {
+		mmVerifyChain.mock.t.Fatalf("AuditServiceMock.VerifyChain mock is already set by Expect")
+	}
+
+	if mmVerifyChain.defaultExpectation.paramPtrs == nil {
+		mmVerifyChain.defaultExpectation.paramPtrs = &AuditServiceMockVerifyChainParamPtrs{}
+	}
+	mmVerifyChain.defaultExpectation.paramPtrs.ctx = &ctx
+	mmVerifyChain.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmVerifyChain
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditService.VerifyChain
+func (mmVerifyChain *mAuditServiceMockVerifyChain) Inspect(f func(ctx context.Context)) *mAuditServiceMockVerifyChain {
+	if mmVerifyChain.mock.inspectFuncVerifyChain != nil {
+		mmVerifyChain.mock.t.Fatalf("Inspect function is already set for AuditServiceMock.VerifyChain")
+	}
+
+	mmVerifyChain.mock.inspectFuncVerifyChain = f
+
+	return mmVerifyChain
+}
+
+// Return sets up results that will be returned by AuditService.VerifyChain
+func (mmVerifyChain *mAuditServiceMockVerifyChain) Return(ap1 *model.AuditChainVerification, err error) *AuditServiceMock {
+	if mmVerifyChain.mock.funcVerifyChain != nil {
+		mmVerifyChain.mock.t.Fatalf("AuditServiceMock.VerifyChain mock is already set by Set")
+	}
+
+	if mmVerifyChain.defaultExpectation == nil {
+		mmVerifyChain.defaultExpectation = &AuditServiceMockVerifyChainExpectation{mock: mmVerifyChain.mock}
+	}
+	mmVerifyChain.defaultExpectation.results = &AuditServiceMockVerifyChainResults{ap1, err}
+	mmVerifyChain.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmVerifyChain.mock
+}
+
+// Set uses given function f to mock the AuditService.VerifyChain method
+func (mmVerifyChain *mAuditServiceMockVerifyChain) Set(f func(ctx context.Context) (ap1 *model.AuditChainVerification, err error)) *AuditServiceMock {
+	if mmVerifyChain.defaultExpectation != nil {
+		mmVerifyChain.mock.t.Fatalf("Default expectation is already set for the AuditService.VerifyChain method")
+	}
+
+	if len(mmVerifyChain.expectations) > 0 {
+		mmVerifyChain.mock.t.Fatalf("Some expectations are already set for the AuditService.VerifyChain method")
+	}
+
+	mmVerifyChain.mock.funcVerifyChain = f
+	mmVerifyChain.mock.funcVerifyChainOrigin = minimock.CallerInfo(1)
+	return mmVerifyChain.mock
+}
+
+// When sets expectation for the AuditService.VerifyChain which will trigger the result defined by the following
+// Then helper
+func (mmVerifyChain *mAuditServiceMockVerifyChain) When(ctx context.Context) *AuditServiceMockVerifyChainExpectation {
+	if mmVerifyChain.mock.funcVerifyChain != nil {
+		mmVerifyChain.mock.t.Fatalf("AuditServiceMock.VerifyChain mock is already set by Set")
+	}
+
+	expectation := &AuditServiceMockVerifyChainExpectation{
+		mock:               mmVerifyChain.mock,
+		params:             &AuditServiceMockVerifyChainParams{ctx},
+		expectationOrigins: AuditServiceMockVerifyChainExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmVerifyChain.expectations = append(mmVerifyChain.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditService.VerifyChain return parameters for the expectation previously defined by the When method
+func (e *AuditServiceMockVerifyChainExpectation) Then(ap1 *model.AuditChainVerification, err error) *AuditServiceMock {
+	e.results = &AuditServiceMockVerifyChainResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times AuditService.VerifyChain should be invoked
+func (mmVerifyChain *mAuditServiceMockVerifyChain) Times(n uint64) *mAuditServiceMockVerifyChain {
+	if n == 0 {
+		mmVerifyChain.mock.t.Fatalf("Times of AuditServiceMock.VerifyChain mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmVerifyChain.expectedInvocations, n)
+	mmVerifyChain.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmVerifyChain
+}
+
+func (mmVerifyChain *mAuditServiceMockVerifyChain) invocationsDone() bool {
+	if len(mmVerifyChain.expectations) == 0 && mmVerifyChain.defaultExpectation == nil && mmVerifyChain.mock.funcVerifyChain == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmVerifyChain.mock.afterVerifyChainCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmVerifyChain.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// VerifyChain implements mm_service.AuditService
+func (mmVerifyChain *AuditServiceMock) VerifyChain(ctx context.Context) (ap1 *model.AuditChainVerification, err error) {
+	mm_atomic.AddUint64(&mmVerifyChain.beforeVerifyChainCounter, 1)
+	defer mm_atomic.AddUint64(&mmVerifyChain.afterVerifyChainCounter, 1)
+
+	mmVerifyChain.t.Helper()
+
+	if mmVerifyChain.inspectFuncVerifyChain != nil {
+		mmVerifyChain.inspectFuncVerifyChain(ctx)
+	}
+
+	mm_params := AuditServiceMockVerifyChainParams{ctx}
+
+	// Record call args
+	mmVerifyChain.VerifyChainMock.mutex.Lock()
+	mmVerifyChain.VerifyChainMock.callArgs = append(mmVerifyChain.VerifyChainMock.callArgs, &mm_params)
+	mmVerifyChain.VerifyChainMock.mutex.Unlock()
+
+	for _, e := range mmVerifyChain.VerifyChainMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmVerifyChain.VerifyChainMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmVerifyChain.VerifyChainMock.defaultExpectation.Counter, 1)
+		mm_want := mmVerifyChain.VerifyChainMock.defaultExpectation.params
+		mm_want_ptrs := mmVerifyChain.VerifyChainMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditServiceMockVerifyChainParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmVerifyChain.t.Errorf("AuditServiceMock.VerifyChain got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVerifyChain.VerifyChainMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmVerifyChain.t.Errorf("AuditServiceMock.VerifyChain got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmVerifyChain.VerifyChainMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmVerifyChain.VerifyChainMock.defaultExpectation.results
+		if mm_results == nil {
+			mmVerifyChain.t.Fatal("No results are set for the AuditServiceMock.VerifyChain")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmVerifyChain.funcVerifyChain != nil {
+		return mmVerifyChain.funcVerifyChain(ctx)
+	}
+	mmVerifyChain.t.Fatalf("Unexpected call to AuditServiceMock.VerifyChain. %v", ctx)
+	return
+}
+
+// VerifyChainAfterCounter returns a count of finished AuditServiceMock.VerifyChain invocations
+func (mmVerifyChain *AuditServiceMock) VerifyChainAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerifyChain.afterVerifyChainCounter)
+}
+
+// VerifyChainBeforeCounter returns a count of AuditServiceMock.VerifyChain invocations
+func (mmVerifyChain *AuditServiceMock) VerifyChainBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerifyChain.beforeVerifyChainCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditServiceMock.VerifyChain.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmVerifyChain *mAuditServiceMockVerifyChain) Calls() []*AuditServiceMockVerifyChainParams {
+	mmVerifyChain.mutex.RLock()
+
+	argCopy := make([]*AuditServiceMockVerifyChainParams, len(mmVerifyChain.callArgs))
+	copy(argCopy, mmVerifyChain.callArgs)
+
+	mmVerifyChain.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockVerifyChainDone returns true if the count of the VerifyChain invocations corresponds
+// the number of defined expectations
+func (m *AuditServiceMock) MinimockVerifyChainDone() bool {
+	if m.VerifyChainMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.VerifyChainMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.VerifyChainMock.invocationsDone()
+}
+
+// MinimockVerifyChainInspect logs each unmet expectation
+func (m *AuditServiceMock) MinimockVerifyChainInspect() {
+	for _, e := range m.VerifyChainMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditServiceMock.VerifyChain at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterVerifyChainCounter := mm_atomic.LoadUint64(&m.afterVerifyChainCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.VerifyChainMock.defaultExpectation != nil && afterVerifyChainCounter < 1 {
+		if m.VerifyChainMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditServiceMock.VerifyChain at\n%s", m.VerifyChainMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditServiceMock.VerifyChain at\n%s with params: %#v", m.VerifyChainMock.defaultExpectation.expectationOrigins.origin, *m.VerifyChainMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcVerifyChain != nil && afterVerifyChainCounter < 1 {
+		m.t.Errorf("Expected call to AuditServiceMock.VerifyChain at\n%s", m.funcVerifyChainOrigin)
+	}
+
+	if !m.VerifyChainMock.invocationsDone() && afterVerifyChainCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditServiceMock.VerifyChain at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.VerifyChainMock.expectedInvocations), m.VerifyChainMock.expectedInvocationsOrigin, afterVerifyChainCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuditServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockListEventsInspect()
+
+			m.MinimockSealChainInspect()
+
+			m.MinimockVerifyChainInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuditServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuditServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockListEventsDone() &&
+		m.MinimockSealChainDone() &&
+		m.MinimockVerifyChainDone()
+}
@@ -17,6 +17,55 @@ type AuthServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcBeginPasskeyLogin          func(ctx context.Context) (pp1 *model.PasskeyChallenge, err error)
+	funcBeginPasskeyLoginOrigin    string
+	inspectFuncBeginPasskeyLogin   func(ctx context.Context)
+	afterBeginPasskeyLoginCounter  uint64
+	beforeBeginPasskeyLoginCounter uint64
+	BeginPasskeyLoginMock          mAuthServiceMockBeginPasskeyLogin
+
+	funcBeginPasskeyRegistration          func(ctx context.Context, userID string) (pp1 *model.PasskeyChallenge, err error)
+	funcBeginPasskeyRegistrationOrigin    string
+	inspectFuncBeginPasskeyRegistration   func(ctx context.Context, userID string)
+	afterBeginPasskeyRegistrationCounter  uint64
+	beforeBeginPasskeyRegistrationCounter uint64
+	BeginPasskeyRegistrationMock          mAuthServiceMockBeginPasskeyRegistration
+
+	funcBeginTotpEnrollment          func(ctx context.Context, userID string) (tp1 *model.TotpEnrollment, err error)
+	funcBeginTotpEnrollmentOrigin    string
+	inspectFuncBeginTotpEnrollment   func(ctx context.Context, userID string)
+	afterBeginTotpEnrollmentCounter  uint64
+	beforeBeginTotpEnrollmentCounter uint64
+	BeginTotpEnrollmentMock          mAuthServiceMockBeginTotpEnrollment
+
+	funcConfirmTotpEnrollment          func(ctx context.Context, userID string, code string) (sa1 []string, err error)
+	funcConfirmTotpEnrollmentOrigin    string
+	inspectFuncConfirmTotpEnrollment   func(ctx context.Context, userID string, code string)
+	afterConfirmTotpEnrollmentCounter  uint64
+	beforeConfirmTotpEnrollmentCounter uint64
+	ConfirmTotpEnrollmentMock          mAuthServiceMockConfirmTotpEnrollment
+
+	funcDisableTotp          func(ctx context.Context, userID string, code string) (err error)
+	funcDisableTotpOrigin    string
+	inspectFuncDisableTotp   func(ctx context.Context, userID string, code string)
+	afterDisableTotpCounter  uint64
+	beforeDisableTotpCounter uint64
+	DisableTotpMock          mAuthServiceMockDisableTotp
+
+	funcFinishPasskeyLogin          func(ctx context.Context, ceremonyID string, credential []byte, client *model.ClientInfo) (tp1 *model.TokenPair, err error)
+	funcFinishPasskeyLoginOrigin    string
+	inspectFuncFinishPasskeyLogin   func(ctx context.Context, ceremonyID string, credential []byte, client *model.ClientInfo)
+	afterFinishPasskeyLoginCounter  uint64
+	beforeFinishPasskeyLoginCounter uint64
+	FinishPasskeyLoginMock          mAuthServiceMockFinishPasskeyLogin
+
+	funcFinishPasskeyRegistration          func(ctx context.Context, userID string, ceremonyID string, credential []byte) (err error)
+	funcFinishPasskeyRegistrationOrigin    string
+	inspectFuncFinishPasskeyRegistration   func(ctx context.Context, userID string, ceremonyID string, credential []byte)
+	afterFinishPasskeyRegistrationCounter  uint64
+	beforeFinishPasskeyRegistrationCounter uint64
+	FinishPasskeyRegistrationMock          mAuthServiceMockFinishPasskeyRegistration
+
 	funcGetAccessToken          func(ctx context.Context, refreshToken string) (s1 string, err error)
 	funcGetAccessTokenOrigin    string
 	inspectFuncGetAccessToken   func(ctx context.Context, refreshToken string)
@@ -24,16 +73,23 @@ type AuthServiceMock struct {
 	beforeGetAccessTokenCounter uint64
 	GetAccessTokenMock          mAuthServiceMockGetAccessToken
 
-	funcGetRefreshToken          func(ctx context.Context, oldRefreshToken string) (s1 string, err error)
+	funcGetRefreshToken          func(ctx context.Context, oldRefreshToken string, client *model.ClientInfo) (s1 string, err error)
 	funcGetRefreshTokenOrigin    string
-	inspectFuncGetRefreshToken   func(ctx context.Context, oldRefreshToken string)
+	inspectFuncGetRefreshToken   func(ctx context.Context, oldRefreshToken string, client *model.ClientInfo)
 	afterGetRefreshTokenCounter  uint64
 	beforeGetRefreshTokenCounter uint64
 	GetRefreshTokenMock          mAuthServiceMockGetRefreshToken
 
-	funcLogin          func(ctx context.Context, creds *model.UserCreds) (tp1 *model.TokenPair, err error)
+	funcListSessions          func(ctx context.Context, userID string) (tpa1 []*model.TokenFamily, err error)
+	funcListSessionsOrigin    string
+	inspectFuncListSessions   func(ctx context.Context, userID string)
+	afterListSessionsCounter  uint64
+	beforeListSessionsCounter uint64
+	ListSessionsMock          mAuthServiceMockListSessions
+
+	funcLogin          func(ctx context.Context, creds *model.UserCreds, client *model.ClientInfo) (lp1 *model.LoginResult, err error)
 	funcLoginOrigin    string
-	inspectFuncLogin   func(ctx context.Context, creds *model.UserCreds)
+	inspectFuncLogin   func(ctx context.Context, creds *model.UserCreds, client *model.ClientInfo)
 	afterLoginCounter  uint64
 	beforeLoginCounter uint64
 	LoginMock          mAuthServiceMockLogin
@@ -44,6 +100,55 @@ type AuthServiceMock struct {
 	afterLogoutCounter  uint64
 	beforeLogoutCounter uint64
 	LogoutMock          mAuthServiceMockLogout
+
+	funcLogoutAll          func(ctx context.Context, userID string) (err error)
+	funcLogoutAllOrigin    string
+	inspectFuncLogoutAll   func(ctx context.Context, userID string)
+	afterLogoutAllCounter  uint64
+	beforeLogoutAllCounter uint64
+	LogoutAllMock          mAuthServiceMockLogoutAll
+
+	funcRequestPasswordReset          func(ctx context.Context, email string) (err error)
+	funcRequestPasswordResetOrigin    string
+	inspectFuncRequestPasswordReset   func(ctx context.Context, email string)
+	afterRequestPasswordResetCounter  uint64
+	beforeRequestPasswordResetCounter uint64
+	RequestPasswordResetMock          mAuthServiceMockRequestPasswordReset
+
+	funcResetPassword          func(ctx context.Context, token string, newPassword string) (err error)
+	funcResetPasswordOrigin    string
+	inspectFuncResetPassword   func(ctx context.Context, token string, newPassword string)
+	afterResetPasswordCounter  uint64
+	beforeResetPasswordCounter uint64
+	ResetPasswordMock          mAuthServiceMockResetPassword
+
+	funcRevokeAllSessions          func(ctx context.Context, userID string, exceptSessionID string) (err error)
+	funcRevokeAllSessionsOrigin    string
+	inspectFuncRevokeAllSessions   func(ctx context.Context, userID string, exceptSessionID string)
+	afterRevokeAllSessionsCounter  uint64
+	beforeRevokeAllSessionsCounter uint64
+	RevokeAllSessionsMock          mAuthServiceMockRevokeAllSessions
+
+	funcRevokeSession          func(ctx context.Context, userID string, sessionID string) (err error)
+	funcRevokeSessionOrigin    string
+	inspectFuncRevokeSession   func(ctx context.Context, userID string, sessionID string)
+	afterRevokeSessionCounter  uint64
+	beforeRevokeSessionCounter uint64
+	RevokeSessionMock          mAuthServiceMockRevokeSession
+
+	funcUnlockUser          func(ctx context.Context, userID string) (err error)
+	funcUnlockUserOrigin    string
+	inspectFuncUnlockUser   func(ctx context.Context, userID string)
+	afterUnlockUserCounter  uint64
+	beforeUnlockUserCounter uint64
+	UnlockUserMock          mAuthServiceMockUnlockUser
+
+	funcVerifyMfa          func(ctx context.Context, mfaToken string, code string, client *model.ClientInfo) (tp1 *model.TokenPair, err error)
+	funcVerifyMfaOrigin    string
+	inspectFuncVerifyMfa   func(ctx context.Context, mfaToken string, code string, client *model.ClientInfo)
+	afterVerifyMfaCounter  uint64
+	beforeVerifyMfaCounter uint64
+	VerifyMfaMock          mAuthServiceMockVerifyMfa
 }
 
 // NewAuthServiceMock returns a mock for mm_service.AuthService
@@ -54,70 +159,112 @@ func NewAuthServiceMock(t minimock.Tester) *AuthServiceMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.BeginPasskeyLoginMock = mAuthServiceMockBeginPasskeyLogin{mock: m}
+	m.BeginPasskeyLoginMock.callArgs = []*AuthServiceMockBeginPasskeyLoginParams{}
+
+	m.BeginPasskeyRegistrationMock = mAuthServiceMockBeginPasskeyRegistration{mock: m}
+	m.BeginPasskeyRegistrationMock.callArgs = []*AuthServiceMockBeginPasskeyRegistrationParams{}
+
+	m.BeginTotpEnrollmentMock = mAuthServiceMockBeginTotpEnrollment{mock: m}
+	m.BeginTotpEnrollmentMock.callArgs = []*AuthServiceMockBeginTotpEnrollmentParams{}
+
+	m.ConfirmTotpEnrollmentMock = mAuthServiceMockConfirmTotpEnrollment{mock: m}
+	m.ConfirmTotpEnrollmentMock.callArgs = []*AuthServiceMockConfirmTotpEnrollmentParams{}
+
+	m.DisableTotpMock = mAuthServiceMockDisableTotp{mock: m}
+	m.DisableTotpMock.callArgs = []*AuthServiceMockDisableTotpParams{}
+
+	m.FinishPasskeyLoginMock = mAuthServiceMockFinishPasskeyLogin{mock: m}
+	m.FinishPasskeyLoginMock.callArgs = []*AuthServiceMockFinishPasskeyLoginParams{}
+
+	m.FinishPasskeyRegistrationMock = mAuthServiceMockFinishPasskeyRegistration{mock: m}
+	m.FinishPasskeyRegistrationMock.callArgs = []*AuthServiceMockFinishPasskeyRegistrationParams{}
+
 	m.GetAccessTokenMock = mAuthServiceMockGetAccessToken{mock: m}
 	m.GetAccessTokenMock.callArgs = []*AuthServiceMockGetAccessTokenParams{}
 
 	m.GetRefreshTokenMock = mAuthServiceMockGetRefreshToken{mock: m}
 	m.GetRefreshTokenMock.callArgs = []*AuthServiceMockGetRefreshTokenParams{}
 
+	m.ListSessionsMock = mAuthServiceMockListSessions{mock: m}
+	m.ListSessionsMock.callArgs = []*AuthServiceMockListSessionsParams{}
+
 	m.LoginMock = mAuthServiceMockLogin{mock: m}
 	m.LoginMock.callArgs = []*AuthServiceMockLoginParams{}
 
 	m.LogoutMock = mAuthServiceMockLogout{mock: m}
 	m.LogoutMock.callArgs = []*AuthServiceMockLogoutParams{}
 
+	m.LogoutAllMock = mAuthServiceMockLogoutAll{mock: m}
+	m.LogoutAllMock.callArgs = []*AuthServiceMockLogoutAllParams{}
+
+	m.RequestPasswordResetMock = mAuthServiceMockRequestPasswordReset{mock: m}
+	m.RequestPasswordResetMock.callArgs = []*AuthServiceMockRequestPasswordResetParams{}
+
+	m.ResetPasswordMock = mAuthServiceMockResetPassword{mock: m}
+	m.ResetPasswordMock.callArgs = []*AuthServiceMockResetPasswordParams{}
+
+	m.RevokeAllSessionsMock = mAuthServiceMockRevokeAllSessions{mock: m}
+	m.RevokeAllSessionsMock.callArgs = []*AuthServiceMockRevokeAllSessionsParams{}
+
+	m.RevokeSessionMock = mAuthServiceMockRevokeSession{mock: m}
+	m.RevokeSessionMock.callArgs = []*AuthServiceMockRevokeSessionParams{}
+
+	m.UnlockUserMock = mAuthServiceMockUnlockUser{mock: m}
+	m.UnlockUserMock.callArgs = []*AuthServiceMockUnlockUserParams{}
+
+	m.VerifyMfaMock = mAuthServiceMockVerifyMfa{mock: m}
+	m.VerifyMfaMock.callArgs = []*AuthServiceMockVerifyMfaParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mAuthServiceMockGetAccessToken struct {
+type mAuthServiceMockBeginPasskeyLogin struct {
 	optional           bool
 	mock               *AuthServiceMock
-	defaultExpectation *AuthServiceMockGetAccessTokenExpectation
-	expectations       []*AuthServiceMockGetAccessTokenExpectation
+	defaultExpectation *AuthServiceMockBeginPasskeyLoginExpectation
+	expectations       []*AuthServiceMockBeginPasskeyLoginExpectation
 
-	callArgs []*AuthServiceMockGetAccessTokenParams
+	callArgs []*AuthServiceMockBeginPasskeyLoginParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// AuthServiceMockGetAccessTokenExpectation specifies expectation struct of the AuthService.GetAccessToken
-type AuthServiceMockGetAccessTokenExpectation struct {
+// AuthServiceMockBeginPasskeyLoginExpectation specifies expectation struct of the AuthService.BeginPasskeyLogin
+type AuthServiceMockBeginPasskeyLoginExpectation struct {
 	mock               *AuthServiceMock
-	params             *AuthServiceMockGetAccessTokenParams
-	paramPtrs          *AuthServiceMockGetAccessTokenParamPtrs
-	expectationOrigins AuthServiceMockGetAccessTokenExpectationOrigins
-	results            *AuthServiceMockGetAccessTokenResults
+	params             *AuthServiceMockBeginPasskeyLoginParams
+	paramPtrs          *AuthServiceMockBeginPasskeyLoginParamPtrs
+	expectationOrigins AuthServiceMockBeginPasskeyLoginExpectationOrigins
+	results            *AuthServiceMockBeginPasskeyLoginResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// AuthServiceMockGetAccessTokenParams contains parameters of the AuthService.GetAccessToken
-type AuthServiceMockGetAccessTokenParams struct {
-	ctx          context.Context
-	refreshToken string
+// AuthServiceMockBeginPasskeyLoginParams contains parameters of the AuthService.BeginPasskeyLogin
+type AuthServiceMockBeginPasskeyLoginParams struct {
+	ctx context.Context
 }
 
-// AuthServiceMockGetAccessTokenParamPtrs contains pointers to parameters of the AuthService.GetAccessToken
-type AuthServiceMockGetAccessTokenParamPtrs struct {
-	ctx          *context.Context
-	refreshToken *string
+// AuthServiceMockBeginPasskeyLoginParamPtrs contains pointers to parameters of the AuthService.BeginPasskeyLogin
+type AuthServiceMockBeginPasskeyLoginParamPtrs struct {
+	ctx *context.Context
 }
 
-// AuthServiceMockGetAccessTokenResults contains results of the AuthService.GetAccessToken
-type AuthServiceMockGetAccessTokenResults struct {
-	s1  string
+// AuthServiceMockBeginPasskeyLoginResults contains results of the AuthService.BeginPasskeyLogin
+type AuthServiceMockBeginPasskeyLoginResults struct {
+	pp1 *model.PasskeyChallenge
 	err error
 }
 
-// AuthServiceMockGetAccessTokenOrigins contains origins of expectations of the AuthService.GetAccessToken
-type AuthServiceMockGetAccessTokenExpectationOrigins struct {
-	origin             string
-	originCtx          string
-	originRefreshToken string
+// AuthServiceMockBeginPasskeyLoginOrigins contains origins of expectations of the AuthService.BeginPasskeyLogin
+type AuthServiceMockBeginPasskeyLoginExpectationOrigins struct {
+	origin    string
+	originCtx string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -125,342 +272,314 @@ type AuthServiceMockGetAccessTokenExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Optional() *mAuthServiceMockGetAccessToken {
-	mmGetAccessToken.optional = true
-	return mmGetAccessToken
+func (mmBeginPasskeyLogin *mAuthServiceMockBeginPasskeyLogin) Optional() *mAuthServiceMockBeginPasskeyLogin {
+	mmBeginPasskeyLogin.optional = true
+	return mmBeginPasskeyLogin
 }
 
-// Expect sets up expected params for AuthService.GetAccessToken
-func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Expect(ctx context.Context, refreshToken string) *mAuthServiceMockGetAccessToken {
-	if mmGetAccessToken.mock.funcGetAccessToken != nil {
-		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Set")
+// Expect sets up expected params for AuthService.BeginPasskeyLogin
+func (mmBeginPasskeyLogin *mAuthServiceMockBeginPasskeyLogin) Expect(ctx context.Context) *mAuthServiceMockBeginPasskeyLogin {
+	if mmBeginPasskeyLogin.mock.funcBeginPasskeyLogin != nil {
+		mmBeginPasskeyLogin.mock.t.Fatalf("AuthServiceMock.BeginPasskeyLogin mock is already set by Set")
 	}
 
-	if mmGetAccessToken.defaultExpectation == nil {
-		mmGetAccessToken.defaultExpectation = &AuthServiceMockGetAccessTokenExpectation{}
+	if mmBeginPasskeyLogin.defaultExpectation == nil {
+		mmBeginPasskeyLogin.defaultExpectation = &AuthServiceMockBeginPasskeyLoginExpectation{}
 	}
 
-	if mmGetAccessToken.defaultExpectation.paramPtrs != nil {
-		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by ExpectParams functions")
+	if mmBeginPasskeyLogin.defaultExpectation.paramPtrs != nil {
+		mmBeginPasskeyLogin.mock.t.Fatalf("AuthServiceMock.BeginPasskeyLogin mock is already set by ExpectParams functions")
 	}
 
-	mmGetAccessToken.defaultExpectation.params = &AuthServiceMockGetAccessTokenParams{ctx, refreshToken}
-	mmGetAccessToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetAccessToken.expectations {
-		if minimock.Equal(e.params, mmGetAccessToken.defaultExpectation.params) {
-			mmGetAccessToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAccessToken.defaultExpectation.params)
+	mmBeginPasskeyLogin.defaultExpectation.params = &AuthServiceMockBeginPasskeyLoginParams{ctx}
+	mmBeginPasskeyLogin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmBeginPasskeyLogin.expectations {
+		if minimock.Equal(e.params, mmBeginPasskeyLogin.defaultExpectation.params) {
+			mmBeginPasskeyLogin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBeginPasskeyLogin.defaultExpectation.params)
 		}
 	}
 
-	return mmGetAccessToken
-}
-
-// ExpectCtxParam1 sets up expected param ctx for AuthService.GetAccessToken
-func (mmGetAccessToken *mAuthServiceMockGetAccessToken) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockGetAccessToken {
-	if mmGetAccessToken.mock.funcGetAccessToken != nil {
-		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Set")
-	}
-
-	if mmGetAccessToken.defaultExpectation == nil {
-		mmGetAccessToken.defaultExpectation = &AuthServiceMockGetAccessTokenExpectation{}
-	}
-
-	if mmGetAccessToken.defaultExpectation.params != nil {
-		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Expect")
-	}
-
-	if mmGetAccessToken.defaultExpectation.paramPtrs == nil {
-		mmGetAccessToken.defaultExpectation.paramPtrs = &AuthServiceMockGetAccessTokenParamPtrs{}
-	}
-	mmGetAccessToken.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetAccessToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmGetAccessToken
+	return mmBeginPasskeyLogin
 }
 
-// ExpectRefreshTokenParam2 sets up expected param refreshToken for AuthService.GetAccessToken
-func (mmGetAccessToken *mAuthServiceMockGetAccessToken) ExpectRefreshTokenParam2(refreshToken string) *mAuthServiceMockGetAccessToken {
-	if mmGetAccessToken.mock.funcGetAccessToken != nil {
-		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for AuthService.BeginPasskeyLogin
+func (mmBeginPasskeyLogin *mAuthServiceMockBeginPasskeyLogin) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockBeginPasskeyLogin {
+	if mmBeginPasskeyLogin.mock.funcBeginPasskeyLogin != nil {
+		mmBeginPasskeyLogin.mock.t.Fatalf("AuthServiceMock.BeginPasskeyLogin mock is already set by Set")
 	}
 
-	if mmGetAccessToken.defaultExpectation == nil {
-		mmGetAccessToken.defaultExpectation = &AuthServiceMockGetAccessTokenExpectation{}
+	if mmBeginPasskeyLogin.defaultExpectation == nil {
+		mmBeginPasskeyLogin.defaultExpectation = &AuthServiceMockBeginPasskeyLoginExpectation{}
 	}
 
-	if mmGetAccessToken.defaultExpectation.params != nil {
-		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Expect")
+	if mmBeginPasskeyLogin.defaultExpectation.params != nil {
+		mmBeginPasskeyLogin.mock.t.Fatalf("AuthServiceMock.BeginPasskeyLogin mock is already set by Expect")
 	}
 
-	if mmGetAccessToken.defaultExpectation.paramPtrs == nil {
-		mmGetAccessToken.defaultExpectation.paramPtrs = &AuthServiceMockGetAccessTokenParamPtrs{}
+	if mmBeginPasskeyLogin.defaultExpectation.paramPtrs == nil {
+		mmBeginPasskeyLogin.defaultExpectation.paramPtrs = &AuthServiceMockBeginPasskeyLoginParamPtrs{}
 	}
-	mmGetAccessToken.defaultExpectation.paramPtrs.refreshToken = &refreshToken
-	mmGetAccessToken.defaultExpectation.expectationOrigins.originRefreshToken = minimock.CallerInfo(1)
+	mmBeginPasskeyLogin.defaultExpectation.paramPtrs.ctx = &ctx
+	mmBeginPasskeyLogin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetAccessToken
+	return mmBeginPasskeyLogin
 }
 
-// Inspect accepts an inspector function that has same arguments as the AuthService.GetAccessToken
-func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Inspect(f func(ctx context.Context, refreshToken string)) *mAuthServiceMockGetAccessToken {
-	if mmGetAccessToken.mock.inspectFuncGetAccessToken != nil {
-		mmGetAccessToken.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.GetAccessToken")
+// Inspect accepts an inspector function that has same arguments as the AuthService.BeginPasskeyLogin
+func (mmBeginPasskeyLogin *mAuthServiceMockBeginPasskeyLogin) Inspect(f func(ctx context.Context)) *mAuthServiceMockBeginPasskeyLogin {
+	if mmBeginPasskeyLogin.mock.inspectFuncBeginPasskeyLogin != nil {
+		mmBeginPasskeyLogin.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.BeginPasskeyLogin")
 	}
 
-	mmGetAccessToken.mock.inspectFuncGetAccessToken = f
+	mmBeginPasskeyLogin.mock.inspectFuncBeginPasskeyLogin = f
 
-	return mmGetAccessToken
+	return mmBeginPasskeyLogin
 }
 
-// Return sets up results that will be returned by AuthService.GetAccessToken
-func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Return(s1 string, err error) *AuthServiceMock {
-	if mmGetAccessToken.mock.funcGetAccessToken != nil {
-		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Set")
+// Return sets up results that will be returned by AuthService.BeginPasskeyLogin
+func (mmBeginPasskeyLogin *mAuthServiceMockBeginPasskeyLogin) Return(pp1 *model.PasskeyChallenge, err error) *AuthServiceMock {
+	if mmBeginPasskeyLogin.mock.funcBeginPasskeyLogin != nil {
+		mmBeginPasskeyLogin.mock.t.Fatalf("AuthServiceMock.BeginPasskeyLogin mock is already set by Set")
 	}
 
-	if mmGetAccessToken.defaultExpectation == nil {
-		mmGetAccessToken.defaultExpectation = &AuthServiceMockGetAccessTokenExpectation{mock: mmGetAccessToken.mock}
+	if mmBeginPasskeyLogin.defaultExpectation == nil {
+		mmBeginPasskeyLogin.defaultExpectation = &AuthServiceMockBeginPasskeyLoginExpectation{mock: mmBeginPasskeyLogin.mock}
 	}
-	mmGetAccessToken.defaultExpectation.results = &AuthServiceMockGetAccessTokenResults{s1, err}
-	mmGetAccessToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetAccessToken.mock
+	mmBeginPasskeyLogin.defaultExpectation.results = &AuthServiceMockBeginPasskeyLoginResults{pp1, err}
+	mmBeginPasskeyLogin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmBeginPasskeyLogin.mock
 }
 
-// Set uses given function f to mock the AuthService.GetAccessToken method
-func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Set(f func(ctx context.Context, refreshToken string) (s1 string, err error)) *AuthServiceMock {
-	if mmGetAccessToken.defaultExpectation != nil {
-		mmGetAccessToken.mock.t.Fatalf("Default expectation is already set for the AuthService.GetAccessToken method")
+// Set uses given function f to mock the AuthService.BeginPasskeyLogin method
+func (mmBeginPasskeyLogin *mAuthServiceMockBeginPasskeyLogin) Set(f func(ctx context.Context) (pp1 *model.PasskeyChallenge, err error)) *AuthServiceMock {
+	if mmBeginPasskeyLogin.defaultExpectation != nil {
+		mmBeginPasskeyLogin.mock.t.Fatalf("Default expectation is already set for the AuthService.BeginPasskeyLogin method")
 	}
 
-	if len(mmGetAccessToken.expectations) > 0 {
-		mmGetAccessToken.mock.t.Fatalf("Some expectations are already set for the AuthService.GetAccessToken method")
+	if len(mmBeginPasskeyLogin.expectations) > 0 {
+		mmBeginPasskeyLogin.mock.t.Fatalf("Some expectations are already set for the AuthService.BeginPasskeyLogin method")
 	}
 
-	mmGetAccessToken.mock.funcGetAccessToken = f
-	mmGetAccessToken.mock.funcGetAccessTokenOrigin = minimock.CallerInfo(1)
-	return mmGetAccessToken.mock
+	mmBeginPasskeyLogin.mock.funcBeginPasskeyLogin = f
+	mmBeginPasskeyLogin.mock.funcBeginPasskeyLoginOrigin = minimock.CallerInfo(1)
+	return mmBeginPasskeyLogin.mock
 }
 
-// When sets expectation for the AuthService.GetAccessToken which will trigger the result defined by the following
+// When sets expectation for the AuthService.BeginPasskeyLogin which will trigger the result defined by the following
 // Then helper
-func (mmGetAccessToken *mAuthServiceMockGetAccessToken) When(ctx context.Context, refreshToken string) *AuthServiceMockGetAccessTokenExpectation {
-	if mmGetAccessToken.mock.funcGetAccessToken != nil {
-		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Set")
+func (mmBeginPasskeyLogin *mAuthServiceMockBeginPasskeyLogin) When(ctx context.Context) *AuthServiceMockBeginPasskeyLoginExpectation {
+	if mmBeginPasskeyLogin.mock.funcBeginPasskeyLogin != nil {
+		mmBeginPasskeyLogin.mock.t.Fatalf("AuthServiceMock.BeginPasskeyLogin mock is already set by Set")
 	}
 
-	expectation := &AuthServiceMockGetAccessTokenExpectation{
-		mock:               mmGetAccessToken.mock,
-		params:             &AuthServiceMockGetAccessTokenParams{ctx, refreshToken},
-		expectationOrigins: AuthServiceMockGetAccessTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &AuthServiceMockBeginPasskeyLoginExpectation{
+		mock:               mmBeginPasskeyLogin.mock,
+		params:             &AuthServiceMockBeginPasskeyLoginParams{ctx},
+		expectationOrigins: AuthServiceMockBeginPasskeyLoginExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetAccessToken.expectations = append(mmGetAccessToken.expectations, expectation)
+	mmBeginPasskeyLogin.expectations = append(mmBeginPasskeyLogin.expectations, expectation)
 	return expectation
 }
 
-// Then sets up AuthService.GetAccessToken return parameters for the expectation previously defined by the When method
-func (e *AuthServiceMockGetAccessTokenExpectation) Then(s1 string, err error) *AuthServiceMock {
-	e.results = &AuthServiceMockGetAccessTokenResults{s1, err}
+// Then sets up AuthService.BeginPasskeyLogin return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockBeginPasskeyLoginExpectation) Then(pp1 *model.PasskeyChallenge, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockBeginPasskeyLoginResults{pp1, err}
 	return e.mock
 }
 
-// Times sets number of times AuthService.GetAccessToken should be invoked
-func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Times(n uint64) *mAuthServiceMockGetAccessToken {
+// Times sets number of times AuthService.BeginPasskeyLogin should be invoked
+func (mmBeginPasskeyLogin *mAuthServiceMockBeginPasskeyLogin) Times(n uint64) *mAuthServiceMockBeginPasskeyLogin {
 	if n == 0 {
-		mmGetAccessToken.mock.t.Fatalf("Times of AuthServiceMock.GetAccessToken mock can not be zero")
+		mmBeginPasskeyLogin.mock.t.Fatalf("Times of AuthServiceMock.BeginPasskeyLogin mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetAccessToken.expectedInvocations, n)
-	mmGetAccessToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetAccessToken
+	mm_atomic.StoreUint64(&mmBeginPasskeyLogin.expectedInvocations, n)
+	mmBeginPasskeyLogin.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmBeginPasskeyLogin
 }
 
-func (mmGetAccessToken *mAuthServiceMockGetAccessToken) invocationsDone() bool {
-	if len(mmGetAccessToken.expectations) == 0 && mmGetAccessToken.defaultExpectation == nil && mmGetAccessToken.mock.funcGetAccessToken == nil {
+func (mmBeginPasskeyLogin *mAuthServiceMockBeginPasskeyLogin) invocationsDone() bool {
+	if len(mmBeginPasskeyLogin.expectations) == 0 && mmBeginPasskeyLogin.defaultExpectation == nil && mmBeginPasskeyLogin.mock.funcBeginPasskeyLogin == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetAccessToken.mock.afterGetAccessTokenCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetAccessToken.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmBeginPasskeyLogin.mock.afterBeginPasskeyLoginCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmBeginPasskeyLogin.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetAccessToken implements mm_service.AuthService
-func (mmGetAccessToken *AuthServiceMock) GetAccessToken(ctx context.Context, refreshToken string) (s1 string, err error) {
-	mm_atomic.AddUint64(&mmGetAccessToken.beforeGetAccessTokenCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetAccessToken.afterGetAccessTokenCounter, 1)
+// BeginPasskeyLogin implements mm_service.AuthService
+func (mmBeginPasskeyLogin *AuthServiceMock) BeginPasskeyLogin(ctx context.Context) (pp1 *model.PasskeyChallenge, err error) {
+	mm_atomic.AddUint64(&mmBeginPasskeyLogin.beforeBeginPasskeyLoginCounter, 1)
+	defer mm_atomic.AddUint64(&mmBeginPasskeyLogin.afterBeginPasskeyLoginCounter, 1)
 
-	mmGetAccessToken.t.Helper()
+	mmBeginPasskeyLogin.t.Helper()
 
-	if mmGetAccessToken.inspectFuncGetAccessToken != nil {
-		mmGetAccessToken.inspectFuncGetAccessToken(ctx, refreshToken)
+	if mmBeginPasskeyLogin.inspectFuncBeginPasskeyLogin != nil {
+		mmBeginPasskeyLogin.inspectFuncBeginPasskeyLogin(ctx)
 	}
 
-	mm_params := AuthServiceMockGetAccessTokenParams{ctx, refreshToken}
+	mm_params := AuthServiceMockBeginPasskeyLoginParams{ctx}
 
 	// Record call args
-	mmGetAccessToken.GetAccessTokenMock.mutex.Lock()
-	mmGetAccessToken.GetAccessTokenMock.callArgs = append(mmGetAccessToken.GetAccessTokenMock.callArgs, &mm_params)
-	mmGetAccessToken.GetAccessTokenMock.mutex.Unlock()
+	mmBeginPasskeyLogin.BeginPasskeyLoginMock.mutex.Lock()
+	mmBeginPasskeyLogin.BeginPasskeyLoginMock.callArgs = append(mmBeginPasskeyLogin.BeginPasskeyLoginMock.callArgs, &mm_params)
+	mmBeginPasskeyLogin.BeginPasskeyLoginMock.mutex.Unlock()
 
-	for _, e := range mmGetAccessToken.GetAccessTokenMock.expectations {
+	for _, e := range mmBeginPasskeyLogin.BeginPasskeyLoginMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.s1, e.results.err
+			return e.results.pp1, e.results.err
 		}
 	}
 
-	if mmGetAccessToken.GetAccessTokenMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetAccessToken.GetAccessTokenMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetAccessToken.GetAccessTokenMock.defaultExpectation.params
-		mm_want_ptrs := mmGetAccessToken.GetAccessTokenMock.defaultExpectation.paramPtrs
+	if mmBeginPasskeyLogin.BeginPasskeyLoginMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBeginPasskeyLogin.BeginPasskeyLoginMock.defaultExpectation.Counter, 1)
+		mm_want := mmBeginPasskeyLogin.BeginPasskeyLoginMock.defaultExpectation.params
+		mm_want_ptrs := mmBeginPasskeyLogin.BeginPasskeyLoginMock.defaultExpectation.paramPtrs
 
-		mm_got := AuthServiceMockGetAccessTokenParams{ctx, refreshToken}
+		mm_got := AuthServiceMockBeginPasskeyLoginParams{ctx}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetAccessToken.t.Errorf("AuthServiceMock.GetAccessToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetAccessToken.GetAccessTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.refreshToken != nil && !minimock.Equal(*mm_want_ptrs.refreshToken, mm_got.refreshToken) {
-				mmGetAccessToken.t.Errorf("AuthServiceMock.GetAccessToken got unexpected parameter refreshToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetAccessToken.GetAccessTokenMock.defaultExpectation.expectationOrigins.originRefreshToken, *mm_want_ptrs.refreshToken, mm_got.refreshToken, minimock.Diff(*mm_want_ptrs.refreshToken, mm_got.refreshToken))
+				mmBeginPasskeyLogin.t.Errorf("AuthServiceMock.BeginPasskeyLogin got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBeginPasskeyLogin.BeginPasskeyLoginMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetAccessToken.t.Errorf("AuthServiceMock.GetAccessToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetAccessToken.GetAccessTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmBeginPasskeyLogin.t.Errorf("AuthServiceMock.BeginPasskeyLogin got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmBeginPasskeyLogin.BeginPasskeyLoginMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetAccessToken.GetAccessTokenMock.defaultExpectation.results
+		mm_results := mmBeginPasskeyLogin.BeginPasskeyLoginMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetAccessToken.t.Fatal("No results are set for the AuthServiceMock.GetAccessToken")
+			mmBeginPasskeyLogin.t.Fatal("No results are set for the AuthServiceMock.BeginPasskeyLogin")
 		}
-		return (*mm_results).s1, (*mm_results).err
+		return (*mm_results).pp1, (*mm_results).err
 	}
-	if mmGetAccessToken.funcGetAccessToken != nil {
-		return mmGetAccessToken.funcGetAccessToken(ctx, refreshToken)
+	if mmBeginPasskeyLogin.funcBeginPasskeyLogin != nil {
+		return mmBeginPasskeyLogin.funcBeginPasskeyLogin(ctx)
 	}
-	mmGetAccessToken.t.Fatalf("Unexpected call to AuthServiceMock.GetAccessToken. %v %v", ctx, refreshToken)
+	mmBeginPasskeyLogin.t.Fatalf("Unexpected call to AuthServiceMock.BeginPasskeyLogin. %v", ctx)
 	return
 }
 
-// GetAccessTokenAfterCounter returns a count of finished AuthServiceMock.GetAccessToken invocations
-func (mmGetAccessToken *AuthServiceMock) GetAccessTokenAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetAccessToken.afterGetAccessTokenCounter)
+// BeginPasskeyLoginAfterCounter returns a count of finished AuthServiceMock.BeginPasskeyLogin invocations
+func (mmBeginPasskeyLogin *AuthServiceMock) BeginPasskeyLoginAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBeginPasskeyLogin.afterBeginPasskeyLoginCounter)
 }
 
-// GetAccessTokenBeforeCounter returns a count of AuthServiceMock.GetAccessToken invocations
-func (mmGetAccessToken *AuthServiceMock) GetAccessTokenBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetAccessToken.beforeGetAccessTokenCounter)
+// BeginPasskeyLoginBeforeCounter returns a count of AuthServiceMock.BeginPasskeyLogin invocations
+func (mmBeginPasskeyLogin *AuthServiceMock) BeginPasskeyLoginBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBeginPasskeyLogin.beforeBeginPasskeyLoginCounter)
 }
 
-// Calls returns a list of arguments used in each call to AuthServiceMock.GetAccessToken.
+// Calls returns a list of arguments used in each call to AuthServiceMock.BeginPasskeyLogin.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Calls() []*AuthServiceMockGetAccessTokenParams {
-	mmGetAccessToken.mutex.RLock()
+func (mmBeginPasskeyLogin *mAuthServiceMockBeginPasskeyLogin) Calls() []*AuthServiceMockBeginPasskeyLoginParams {
+	mmBeginPasskeyLogin.mutex.RLock()
 
-	argCopy := make([]*AuthServiceMockGetAccessTokenParams, len(mmGetAccessToken.callArgs))
-	copy(argCopy, mmGetAccessToken.callArgs)
+	argCopy := make([]*AuthServiceMockBeginPasskeyLoginParams, len(mmBeginPasskeyLogin.callArgs))
+	copy(argCopy, mmBeginPasskeyLogin.callArgs)
 
-	mmGetAccessToken.mutex.RUnlock()
+	mmBeginPasskeyLogin.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetAccessTokenDone returns true if the count of the GetAccessToken invocations corresponds
+// MinimockBeginPasskeyLoginDone returns true if the count of the BeginPasskeyLogin invocations corresponds
 // the number of defined expectations
-func (m *AuthServiceMock) MinimockGetAccessTokenDone() bool {
-	if m.GetAccessTokenMock.optional {
+func (m *AuthServiceMock) MinimockBeginPasskeyLoginDone() bool {
+	if m.BeginPasskeyLoginMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetAccessTokenMock.expectations {
+	for _, e := range m.BeginPasskeyLoginMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetAccessTokenMock.invocationsDone()
+	return m.BeginPasskeyLoginMock.invocationsDone()
 }
 
-// MinimockGetAccessTokenInspect logs each unmet expectation
-func (m *AuthServiceMock) MinimockGetAccessTokenInspect() {
-	for _, e := range m.GetAccessTokenMock.expectations {
+// MinimockBeginPasskeyLoginInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockBeginPasskeyLoginInspect() {
+	for _, e := range m.BeginPasskeyLoginMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AuthServiceMock.GetAccessToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to AuthServiceMock.BeginPasskeyLogin at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetAccessTokenCounter := mm_atomic.LoadUint64(&m.afterGetAccessTokenCounter)
+	afterBeginPasskeyLoginCounter := mm_atomic.LoadUint64(&m.afterBeginPasskeyLoginCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetAccessTokenMock.defaultExpectation != nil && afterGetAccessTokenCounter < 1 {
-		if m.GetAccessTokenMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to AuthServiceMock.GetAccessToken at\n%s", m.GetAccessTokenMock.defaultExpectation.returnOrigin)
+	if m.BeginPasskeyLoginMock.defaultExpectation != nil && afterBeginPasskeyLoginCounter < 1 {
+		if m.BeginPasskeyLoginMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.BeginPasskeyLogin at\n%s", m.BeginPasskeyLoginMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to AuthServiceMock.GetAccessToken at\n%s with params: %#v", m.GetAccessTokenMock.defaultExpectation.expectationOrigins.origin, *m.GetAccessTokenMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AuthServiceMock.BeginPasskeyLogin at\n%s with params: %#v", m.BeginPasskeyLoginMock.defaultExpectation.expectationOrigins.origin, *m.BeginPasskeyLoginMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetAccessToken != nil && afterGetAccessTokenCounter < 1 {
-		m.t.Errorf("Expected call to AuthServiceMock.GetAccessToken at\n%s", m.funcGetAccessTokenOrigin)
+	if m.funcBeginPasskeyLogin != nil && afterBeginPasskeyLoginCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.BeginPasskeyLogin at\n%s", m.funcBeginPasskeyLoginOrigin)
 	}
 
-	if !m.GetAccessTokenMock.invocationsDone() && afterGetAccessTokenCounter > 0 {
-		m.t.Errorf("Expected %d calls to AuthServiceMock.GetAccessToken at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetAccessTokenMock.expectedInvocations), m.GetAccessTokenMock.expectedInvocationsOrigin, afterGetAccessTokenCounter)
+	if !m.BeginPasskeyLoginMock.invocationsDone() && afterBeginPasskeyLoginCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.BeginPasskeyLogin at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.BeginPasskeyLoginMock.expectedInvocations), m.BeginPasskeyLoginMock.expectedInvocationsOrigin, afterBeginPasskeyLoginCounter)
 	}
 }
 
-type mAuthServiceMockGetRefreshToken struct {
+type mAuthServiceMockBeginPasskeyRegistration struct {
 	optional           bool
 	mock               *AuthServiceMock
-	defaultExpectation *AuthServiceMockGetRefreshTokenExpectation
-	expectations       []*AuthServiceMockGetRefreshTokenExpectation
+	defaultExpectation *AuthServiceMockBeginPasskeyRegistrationExpectation
+	expectations       []*AuthServiceMockBeginPasskeyRegistrationExpectation
 
-	callArgs []*AuthServiceMockGetRefreshTokenParams
+	callArgs []*AuthServiceMockBeginPasskeyRegistrationParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// AuthServiceMockGetRefreshTokenExpectation specifies expectation struct of the AuthService.GetRefreshToken
-type AuthServiceMockGetRefreshTokenExpectation struct {
+// AuthServiceMockBeginPasskeyRegistrationExpectation specifies expectation struct of the AuthService.BeginPasskeyRegistration
+type AuthServiceMockBeginPasskeyRegistrationExpectation struct {
 	mock               *AuthServiceMock
-	params             *AuthServiceMockGetRefreshTokenParams
-	paramPtrs          *AuthServiceMockGetRefreshTokenParamPtrs
-	expectationOrigins AuthServiceMockGetRefreshTokenExpectationOrigins
-	results            *AuthServiceMockGetRefreshTokenResults
+	params             *AuthServiceMockBeginPasskeyRegistrationParams
+	paramPtrs          *AuthServiceMockBeginPasskeyRegistrationParamPtrs
+	expectationOrigins AuthServiceMockBeginPasskeyRegistrationExpectationOrigins
+	results            *AuthServiceMockBeginPasskeyRegistrationResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// AuthServiceMockGetRefreshTokenParams contains parameters of the AuthService.GetRefreshToken
-type AuthServiceMockGetRefreshTokenParams struct {
-	ctx             context.Context
-	oldRefreshToken string
+// AuthServiceMockBeginPasskeyRegistrationParams contains parameters of the AuthService.BeginPasskeyRegistration
+type AuthServiceMockBeginPasskeyRegistrationParams struct {
+	ctx    context.Context
+	userID string
 }
 
-// AuthServiceMockGetRefreshTokenParamPtrs contains pointers to parameters of the AuthService.GetRefreshToken
-type AuthServiceMockGetRefreshTokenParamPtrs struct {
-	ctx             *context.Context
-	oldRefreshToken *string
+// AuthServiceMockBeginPasskeyRegistrationParamPtrs contains pointers to parameters of the AuthService.BeginPasskeyRegistration
+type AuthServiceMockBeginPasskeyRegistrationParamPtrs struct {
+	ctx    *context.Context
+	userID *string
 }
 
-// AuthServiceMockGetRefreshTokenResults contains results of the AuthService.GetRefreshToken
-type AuthServiceMockGetRefreshTokenResults struct {
-	s1  string
+// AuthServiceMockBeginPasskeyRegistrationResults contains results of the AuthService.BeginPasskeyRegistration
+type AuthServiceMockBeginPasskeyRegistrationResults struct {
+	pp1 *model.PasskeyChallenge
 	err error
 }
 
-// AuthServiceMockGetRefreshTokenOrigins contains origins of expectations of the AuthService.GetRefreshToken
-type AuthServiceMockGetRefreshTokenExpectationOrigins struct {
-	origin                string
-	originCtx             string
-	originOldRefreshToken string
+// AuthServiceMockBeginPasskeyRegistrationOrigins contains origins of expectations of the AuthService.BeginPasskeyRegistration
+type AuthServiceMockBeginPasskeyRegistrationExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -468,342 +587,342 @@ type AuthServiceMockGetRefreshTokenExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Optional() *mAuthServiceMockGetRefreshToken {
-	mmGetRefreshToken.optional = true
-	return mmGetRefreshToken
+func (mmBeginPasskeyRegistration *mAuthServiceMockBeginPasskeyRegistration) Optional() *mAuthServiceMockBeginPasskeyRegistration {
+	mmBeginPasskeyRegistration.optional = true
+	return mmBeginPasskeyRegistration
 }
 
-// Expect sets up expected params for AuthService.GetRefreshToken
-func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Expect(ctx context.Context, oldRefreshToken string) *mAuthServiceMockGetRefreshToken {
-	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
-		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Set")
+// Expect sets up expected params for AuthService.BeginPasskeyRegistration
+func (mmBeginPasskeyRegistration *mAuthServiceMockBeginPasskeyRegistration) Expect(ctx context.Context, userID string) *mAuthServiceMockBeginPasskeyRegistration {
+	if mmBeginPasskeyRegistration.mock.funcBeginPasskeyRegistration != nil {
+		mmBeginPasskeyRegistration.mock.t.Fatalf("AuthServiceMock.BeginPasskeyRegistration mock is already set by Set")
 	}
 
-	if mmGetRefreshToken.defaultExpectation == nil {
-		mmGetRefreshToken.defaultExpectation = &AuthServiceMockGetRefreshTokenExpectation{}
+	if mmBeginPasskeyRegistration.defaultExpectation == nil {
+		mmBeginPasskeyRegistration.defaultExpectation = &AuthServiceMockBeginPasskeyRegistrationExpectation{}
 	}
 
-	if mmGetRefreshToken.defaultExpectation.paramPtrs != nil {
-		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by ExpectParams functions")
+	if mmBeginPasskeyRegistration.defaultExpectation.paramPtrs != nil {
+		mmBeginPasskeyRegistration.mock.t.Fatalf("AuthServiceMock.BeginPasskeyRegistration mock is already set by ExpectParams functions")
 	}
 
-	mmGetRefreshToken.defaultExpectation.params = &AuthServiceMockGetRefreshTokenParams{ctx, oldRefreshToken}
-	mmGetRefreshToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetRefreshToken.expectations {
-		if minimock.Equal(e.params, mmGetRefreshToken.defaultExpectation.params) {
-			mmGetRefreshToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRefreshToken.defaultExpectation.params)
+	mmBeginPasskeyRegistration.defaultExpectation.params = &AuthServiceMockBeginPasskeyRegistrationParams{ctx, userID}
+	mmBeginPasskeyRegistration.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmBeginPasskeyRegistration.expectations {
+		if minimock.Equal(e.params, mmBeginPasskeyRegistration.defaultExpectation.params) {
+			mmBeginPasskeyRegistration.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBeginPasskeyRegistration.defaultExpectation.params)
 		}
 	}
 
-	return mmGetRefreshToken
+	return mmBeginPasskeyRegistration
 }
 
-// ExpectCtxParam1 sets up expected param ctx for AuthService.GetRefreshToken
-func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockGetRefreshToken {
-	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
-		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for AuthService.BeginPasskeyRegistration
+func (mmBeginPasskeyRegistration *mAuthServiceMockBeginPasskeyRegistration) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockBeginPasskeyRegistration {
+	if mmBeginPasskeyRegistration.mock.funcBeginPasskeyRegistration != nil {
+		mmBeginPasskeyRegistration.mock.t.Fatalf("AuthServiceMock.BeginPasskeyRegistration mock is already set by Set")
 	}
 
-	if mmGetRefreshToken.defaultExpectation == nil {
-		mmGetRefreshToken.defaultExpectation = &AuthServiceMockGetRefreshTokenExpectation{}
+	if mmBeginPasskeyRegistration.defaultExpectation == nil {
+		mmBeginPasskeyRegistration.defaultExpectation = &AuthServiceMockBeginPasskeyRegistrationExpectation{}
 	}
 
-	if mmGetRefreshToken.defaultExpectation.params != nil {
-		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Expect")
+	if mmBeginPasskeyRegistration.defaultExpectation.params != nil {
+		mmBeginPasskeyRegistration.mock.t.Fatalf("AuthServiceMock.BeginPasskeyRegistration mock is already set by Expect")
 	}
 
-	if mmGetRefreshToken.defaultExpectation.paramPtrs == nil {
-		mmGetRefreshToken.defaultExpectation.paramPtrs = &AuthServiceMockGetRefreshTokenParamPtrs{}
+	if mmBeginPasskeyRegistration.defaultExpectation.paramPtrs == nil {
+		mmBeginPasskeyRegistration.defaultExpectation.paramPtrs = &AuthServiceMockBeginPasskeyRegistrationParamPtrs{}
 	}
-	mmGetRefreshToken.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetRefreshToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmBeginPasskeyRegistration.defaultExpectation.paramPtrs.ctx = &ctx
+	mmBeginPasskeyRegistration.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetRefreshToken
+	return mmBeginPasskeyRegistration
 }
 
-// ExpectOldRefreshTokenParam2 sets up expected param oldRefreshToken for AuthService.GetRefreshToken
-func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) ExpectOldRefreshTokenParam2(oldRefreshToken string) *mAuthServiceMockGetRefreshToken {
-	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
-		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for AuthService.BeginPasskeyRegistration
+func (mmBeginPasskeyRegistration *mAuthServiceMockBeginPasskeyRegistration) ExpectUserIDParam2(userID string) *mAuthServiceMockBeginPasskeyRegistration {
+	if mmBeginPasskeyRegistration.mock.funcBeginPasskeyRegistration != nil {
+		mmBeginPasskeyRegistration.mock.t.Fatalf("AuthServiceMock.BeginPasskeyRegistration mock is already set by Set")
 	}
 
-	if mmGetRefreshToken.defaultExpectation == nil {
-		mmGetRefreshToken.defaultExpectation = &AuthServiceMockGetRefreshTokenExpectation{}
+	if mmBeginPasskeyRegistration.defaultExpectation == nil {
+		mmBeginPasskeyRegistration.defaultExpectation = &AuthServiceMockBeginPasskeyRegistrationExpectation{}
 	}
 
-	if mmGetRefreshToken.defaultExpectation.params != nil {
-		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Expect")
+	if mmBeginPasskeyRegistration.defaultExpectation.params != nil {
+		mmBeginPasskeyRegistration.mock.t.Fatalf("AuthServiceMock.BeginPasskeyRegistration mock is already set by Expect")
 	}
 
-	if mmGetRefreshToken.defaultExpectation.paramPtrs == nil {
-		mmGetRefreshToken.defaultExpectation.paramPtrs = &AuthServiceMockGetRefreshTokenParamPtrs{}
+	if mmBeginPasskeyRegistration.defaultExpectation.paramPtrs == nil {
+		mmBeginPasskeyRegistration.defaultExpectation.paramPtrs = &AuthServiceMockBeginPasskeyRegistrationParamPtrs{}
 	}
-	mmGetRefreshToken.defaultExpectation.paramPtrs.oldRefreshToken = &oldRefreshToken
-	mmGetRefreshToken.defaultExpectation.expectationOrigins.originOldRefreshToken = minimock.CallerInfo(1)
+	mmBeginPasskeyRegistration.defaultExpectation.paramPtrs.userID = &userID
+	mmBeginPasskeyRegistration.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmGetRefreshToken
+	return mmBeginPasskeyRegistration
 }
 
-// Inspect accepts an inspector function that has same arguments as the AuthService.GetRefreshToken
-func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Inspect(f func(ctx context.Context, oldRefreshToken string)) *mAuthServiceMockGetRefreshToken {
-	if mmGetRefreshToken.mock.inspectFuncGetRefreshToken != nil {
-		mmGetRefreshToken.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.GetRefreshToken")
+// Inspect accepts an inspector function that has same arguments as the AuthService.BeginPasskeyRegistration
+func (mmBeginPasskeyRegistration *mAuthServiceMockBeginPasskeyRegistration) Inspect(f func(ctx context.Context, userID string)) *mAuthServiceMockBeginPasskeyRegistration {
+	if mmBeginPasskeyRegistration.mock.inspectFuncBeginPasskeyRegistration != nil {
+		mmBeginPasskeyRegistration.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.BeginPasskeyRegistration")
 	}
 
-	mmGetRefreshToken.mock.inspectFuncGetRefreshToken = f
+	mmBeginPasskeyRegistration.mock.inspectFuncBeginPasskeyRegistration = f
 
-	return mmGetRefreshToken
+	return mmBeginPasskeyRegistration
 }
 
-// Return sets up results that will be returned by AuthService.GetRefreshToken
-func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Return(s1 string, err error) *AuthServiceMock {
-	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
-		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Set")
+// Return sets up results that will be returned by AuthService.BeginPasskeyRegistration
+func (mmBeginPasskeyRegistration *mAuthServiceMockBeginPasskeyRegistration) Return(pp1 *model.PasskeyChallenge, err error) *AuthServiceMock {
+	if mmBeginPasskeyRegistration.mock.funcBeginPasskeyRegistration != nil {
+		mmBeginPasskeyRegistration.mock.t.Fatalf("AuthServiceMock.BeginPasskeyRegistration mock is already set by Set")
 	}
 
-	if mmGetRefreshToken.defaultExpectation == nil {
-		mmGetRefreshToken.defaultExpectation = &AuthServiceMockGetRefreshTokenExpectation{mock: mmGetRefreshToken.mock}
+	if mmBeginPasskeyRegistration.defaultExpectation == nil {
+		mmBeginPasskeyRegistration.defaultExpectation = &AuthServiceMockBeginPasskeyRegistrationExpectation{mock: mmBeginPasskeyRegistration.mock}
 	}
-	mmGetRefreshToken.defaultExpectation.results = &AuthServiceMockGetRefreshTokenResults{s1, err}
-	mmGetRefreshToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetRefreshToken.mock
+	mmBeginPasskeyRegistration.defaultExpectation.results = &AuthServiceMockBeginPasskeyRegistrationResults{pp1, err}
+	mmBeginPasskeyRegistration.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmBeginPasskeyRegistration.mock
 }
 
-// Set uses given function f to mock the AuthService.GetRefreshToken method
-func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Set(f func(ctx context.Context, oldRefreshToken string) (s1 string, err error)) *AuthServiceMock {
-	if mmGetRefreshToken.defaultExpectation != nil {
-		mmGetRefreshToken.mock.t.Fatalf("Default expectation is already set for the AuthService.GetRefreshToken method")
+// Set uses given function f to mock the AuthService.BeginPasskeyRegistration method
+func (mmBeginPasskeyRegistration *mAuthServiceMockBeginPasskeyRegistration) Set(f func(ctx context.Context, userID string) (pp1 *model.PasskeyChallenge, err error)) *AuthServiceMock {
+	if mmBeginPasskeyRegistration.defaultExpectation != nil {
+		mmBeginPasskeyRegistration.mock.t.Fatalf("Default expectation is already set for the AuthService.BeginPasskeyRegistration method")
 	}
 
-	if len(mmGetRefreshToken.expectations) > 0 {
-		mmGetRefreshToken.mock.t.Fatalf("Some expectations are already set for the AuthService.GetRefreshToken method")
+	if len(mmBeginPasskeyRegistration.expectations) > 0 {
+		mmBeginPasskeyRegistration.mock.t.Fatalf("Some expectations are already set for the AuthService.BeginPasskeyRegistration method")
 	}
 
-	mmGetRefreshToken.mock.funcGetRefreshToken = f
-	mmGetRefreshToken.mock.funcGetRefreshTokenOrigin = minimock.CallerInfo(1)
-	return mmGetRefreshToken.mock
+	mmBeginPasskeyRegistration.mock.funcBeginPasskeyRegistration = f
+	mmBeginPasskeyRegistration.mock.funcBeginPasskeyRegistrationOrigin = minimock.CallerInfo(1)
+	return mmBeginPasskeyRegistration.mock
 }
 
-// When sets expectation for the AuthService.GetRefreshToken which will trigger the result defined by the following
+// When sets expectation for the AuthService.BeginPasskeyRegistration which will trigger the result defined by the following
 // Then helper
-func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) When(ctx context.Context, oldRefreshToken string) *AuthServiceMockGetRefreshTokenExpectation {
-	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
-		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Set")
+func (mmBeginPasskeyRegistration *mAuthServiceMockBeginPasskeyRegistration) When(ctx context.Context, userID string) *AuthServiceMockBeginPasskeyRegistrationExpectation {
+	if mmBeginPasskeyRegistration.mock.funcBeginPasskeyRegistration != nil {
+		mmBeginPasskeyRegistration.mock.t.Fatalf("AuthServiceMock.BeginPasskeyRegistration mock is already set by Set")
 	}
 
-	expectation := &AuthServiceMockGetRefreshTokenExpectation{
-		mock:               mmGetRefreshToken.mock,
-		params:             &AuthServiceMockGetRefreshTokenParams{ctx, oldRefreshToken},
-		expectationOrigins: AuthServiceMockGetRefreshTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &AuthServiceMockBeginPasskeyRegistrationExpectation{
+		mock:               mmBeginPasskeyRegistration.mock,
+		params:             &AuthServiceMockBeginPasskeyRegistrationParams{ctx, userID},
+		expectationOrigins: AuthServiceMockBeginPasskeyRegistrationExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetRefreshToken.expectations = append(mmGetRefreshToken.expectations, expectation)
+	mmBeginPasskeyRegistration.expectations = append(mmBeginPasskeyRegistration.expectations, expectation)
 	return expectation
 }
 
-// Then sets up AuthService.GetRefreshToken return parameters for the expectation previously defined by the When method
-func (e *AuthServiceMockGetRefreshTokenExpectation) Then(s1 string, err error) *AuthServiceMock {
-	e.results = &AuthServiceMockGetRefreshTokenResults{s1, err}
+// Then sets up AuthService.BeginPasskeyRegistration return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockBeginPasskeyRegistrationExpectation) Then(pp1 *model.PasskeyChallenge, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockBeginPasskeyRegistrationResults{pp1, err}
 	return e.mock
 }
 
-// Times sets number of times AuthService.GetRefreshToken should be invoked
-func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Times(n uint64) *mAuthServiceMockGetRefreshToken {
+// Times sets number of times AuthService.BeginPasskeyRegistration should be invoked
+func (mmBeginPasskeyRegistration *mAuthServiceMockBeginPasskeyRegistration) Times(n uint64) *mAuthServiceMockBeginPasskeyRegistration {
 	if n == 0 {
-		mmGetRefreshToken.mock.t.Fatalf("Times of AuthServiceMock.GetRefreshToken mock can not be zero")
+		mmBeginPasskeyRegistration.mock.t.Fatalf("Times of AuthServiceMock.BeginPasskeyRegistration mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetRefreshToken.expectedInvocations, n)
-	mmGetRefreshToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetRefreshToken
+	mm_atomic.StoreUint64(&mmBeginPasskeyRegistration.expectedInvocations, n)
+	mmBeginPasskeyRegistration.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmBeginPasskeyRegistration
 }
 
-func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) invocationsDone() bool {
-	if len(mmGetRefreshToken.expectations) == 0 && mmGetRefreshToken.defaultExpectation == nil && mmGetRefreshToken.mock.funcGetRefreshToken == nil {
+func (mmBeginPasskeyRegistration *mAuthServiceMockBeginPasskeyRegistration) invocationsDone() bool {
+	if len(mmBeginPasskeyRegistration.expectations) == 0 && mmBeginPasskeyRegistration.defaultExpectation == nil && mmBeginPasskeyRegistration.mock.funcBeginPasskeyRegistration == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetRefreshToken.mock.afterGetRefreshTokenCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetRefreshToken.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmBeginPasskeyRegistration.mock.afterBeginPasskeyRegistrationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmBeginPasskeyRegistration.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetRefreshToken implements mm_service.AuthService
-func (mmGetRefreshToken *AuthServiceMock) GetRefreshToken(ctx context.Context, oldRefreshToken string) (s1 string, err error) {
-	mm_atomic.AddUint64(&mmGetRefreshToken.beforeGetRefreshTokenCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetRefreshToken.afterGetRefreshTokenCounter, 1)
+// BeginPasskeyRegistration implements mm_service.AuthService
+func (mmBeginPasskeyRegistration *AuthServiceMock) BeginPasskeyRegistration(ctx context.Context, userID string) (pp1 *model.PasskeyChallenge, err error) {
+	mm_atomic.AddUint64(&mmBeginPasskeyRegistration.beforeBeginPasskeyRegistrationCounter, 1)
+	defer mm_atomic.AddUint64(&mmBeginPasskeyRegistration.afterBeginPasskeyRegistrationCounter, 1)
 
-	mmGetRefreshToken.t.Helper()
+	mmBeginPasskeyRegistration.t.Helper()
 
-	if mmGetRefreshToken.inspectFuncGetRefreshToken != nil {
-		mmGetRefreshToken.inspectFuncGetRefreshToken(ctx, oldRefreshToken)
+	if mmBeginPasskeyRegistration.inspectFuncBeginPasskeyRegistration != nil {
+		mmBeginPasskeyRegistration.inspectFuncBeginPasskeyRegistration(ctx, userID)
 	}
 
-	mm_params := AuthServiceMockGetRefreshTokenParams{ctx, oldRefreshToken}
+	mm_params := AuthServiceMockBeginPasskeyRegistrationParams{ctx, userID}
 
 	// Record call args
-	mmGetRefreshToken.GetRefreshTokenMock.mutex.Lock()
-	mmGetRefreshToken.GetRefreshTokenMock.callArgs = append(mmGetRefreshToken.GetRefreshTokenMock.callArgs, &mm_params)
-	mmGetRefreshToken.GetRefreshTokenMock.mutex.Unlock()
+	mmBeginPasskeyRegistration.BeginPasskeyRegistrationMock.mutex.Lock()
+	mmBeginPasskeyRegistration.BeginPasskeyRegistrationMock.callArgs = append(mmBeginPasskeyRegistration.BeginPasskeyRegistrationMock.callArgs, &mm_params)
+	mmBeginPasskeyRegistration.BeginPasskeyRegistrationMock.mutex.Unlock()
 
-	for _, e := range mmGetRefreshToken.GetRefreshTokenMock.expectations {
+	for _, e := range mmBeginPasskeyRegistration.BeginPasskeyRegistrationMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.s1, e.results.err
+			return e.results.pp1, e.results.err
 		}
 	}
 
-	if mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.params
-		mm_want_ptrs := mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.paramPtrs
+	if mmBeginPasskeyRegistration.BeginPasskeyRegistrationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBeginPasskeyRegistration.BeginPasskeyRegistrationMock.defaultExpectation.Counter, 1)
+		mm_want := mmBeginPasskeyRegistration.BeginPasskeyRegistrationMock.defaultExpectation.params
+		mm_want_ptrs := mmBeginPasskeyRegistration.BeginPasskeyRegistrationMock.defaultExpectation.paramPtrs
 
-		mm_got := AuthServiceMockGetRefreshTokenParams{ctx, oldRefreshToken}
+		mm_got := AuthServiceMockBeginPasskeyRegistrationParams{ctx, userID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetRefreshToken.t.Errorf("AuthServiceMock.GetRefreshToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmBeginPasskeyRegistration.t.Errorf("AuthServiceMock.BeginPasskeyRegistration got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBeginPasskeyRegistration.BeginPasskeyRegistrationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.oldRefreshToken != nil && !minimock.Equal(*mm_want_ptrs.oldRefreshToken, mm_got.oldRefreshToken) {
-				mmGetRefreshToken.t.Errorf("AuthServiceMock.GetRefreshToken got unexpected parameter oldRefreshToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.expectationOrigins.originOldRefreshToken, *mm_want_ptrs.oldRefreshToken, mm_got.oldRefreshToken, minimock.Diff(*mm_want_ptrs.oldRefreshToken, mm_got.oldRefreshToken))
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmBeginPasskeyRegistration.t.Errorf("AuthServiceMock.BeginPasskeyRegistration got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBeginPasskeyRegistration.BeginPasskeyRegistrationMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetRefreshToken.t.Errorf("AuthServiceMock.GetRefreshToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmBeginPasskeyRegistration.t.Errorf("AuthServiceMock.BeginPasskeyRegistration got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmBeginPasskeyRegistration.BeginPasskeyRegistrationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.results
+		mm_results := mmBeginPasskeyRegistration.BeginPasskeyRegistrationMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetRefreshToken.t.Fatal("No results are set for the AuthServiceMock.GetRefreshToken")
+			mmBeginPasskeyRegistration.t.Fatal("No results are set for the AuthServiceMock.BeginPasskeyRegistration")
 		}
-		return (*mm_results).s1, (*mm_results).err
+		return (*mm_results).pp1, (*mm_results).err
 	}
-	if mmGetRefreshToken.funcGetRefreshToken != nil {
-		return mmGetRefreshToken.funcGetRefreshToken(ctx, oldRefreshToken)
+	if mmBeginPasskeyRegistration.funcBeginPasskeyRegistration != nil {
+		return mmBeginPasskeyRegistration.funcBeginPasskeyRegistration(ctx, userID)
 	}
-	mmGetRefreshToken.t.Fatalf("Unexpected call to AuthServiceMock.GetRefreshToken. %v %v", ctx, oldRefreshToken)
+	mmBeginPasskeyRegistration.t.Fatalf("Unexpected call to AuthServiceMock.BeginPasskeyRegistration. %v %v", ctx, userID)
 	return
 }
 
-// GetRefreshTokenAfterCounter returns a count of finished AuthServiceMock.GetRefreshToken invocations
-func (mmGetRefreshToken *AuthServiceMock) GetRefreshTokenAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetRefreshToken.afterGetRefreshTokenCounter)
+// BeginPasskeyRegistrationAfterCounter returns a count of finished AuthServiceMock.BeginPasskeyRegistration invocations
+func (mmBeginPasskeyRegistration *AuthServiceMock) BeginPasskeyRegistrationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBeginPasskeyRegistration.afterBeginPasskeyRegistrationCounter)
 }
 
-// GetRefreshTokenBeforeCounter returns a count of AuthServiceMock.GetRefreshToken invocations
-func (mmGetRefreshToken *AuthServiceMock) GetRefreshTokenBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetRefreshToken.beforeGetRefreshTokenCounter)
+// BeginPasskeyRegistrationBeforeCounter returns a count of AuthServiceMock.BeginPasskeyRegistration invocations
+func (mmBeginPasskeyRegistration *AuthServiceMock) BeginPasskeyRegistrationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBeginPasskeyRegistration.beforeBeginPasskeyRegistrationCounter)
 }
 
-// Calls returns a list of arguments used in each call to AuthServiceMock.GetRefreshToken.
+// Calls returns a list of arguments used in each call to AuthServiceMock.BeginPasskeyRegistration.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Calls() []*AuthServiceMockGetRefreshTokenParams {
-	mmGetRefreshToken.mutex.RLock()
+func (mmBeginPasskeyRegistration *mAuthServiceMockBeginPasskeyRegistration) Calls() []*AuthServiceMockBeginPasskeyRegistrationParams {
+	mmBeginPasskeyRegistration.mutex.RLock()
 
-	argCopy := make([]*AuthServiceMockGetRefreshTokenParams, len(mmGetRefreshToken.callArgs))
-	copy(argCopy, mmGetRefreshToken.callArgs)
+	argCopy := make([]*AuthServiceMockBeginPasskeyRegistrationParams, len(mmBeginPasskeyRegistration.callArgs))
+	copy(argCopy, mmBeginPasskeyRegistration.callArgs)
 
-	mmGetRefreshToken.mutex.RUnlock()
+	mmBeginPasskeyRegistration.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetRefreshTokenDone returns true if the count of the GetRefreshToken invocations corresponds
+// MinimockBeginPasskeyRegistrationDone returns true if the count of the BeginPasskeyRegistration invocations corresponds
 // the number of defined expectations
-func (m *AuthServiceMock) MinimockGetRefreshTokenDone() bool {
-	if m.GetRefreshTokenMock.optional {
+func (m *AuthServiceMock) MinimockBeginPasskeyRegistrationDone() bool {
+	if m.BeginPasskeyRegistrationMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetRefreshTokenMock.expectations {
+	for _, e := range m.BeginPasskeyRegistrationMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetRefreshTokenMock.invocationsDone()
+	return m.BeginPasskeyRegistrationMock.invocationsDone()
 }
 
-// MinimockGetRefreshTokenInspect logs each unmet expectation
-func (m *AuthServiceMock) MinimockGetRefreshTokenInspect() {
-	for _, e := range m.GetRefreshTokenMock.expectations {
+// MinimockBeginPasskeyRegistrationInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockBeginPasskeyRegistrationInspect() {
+	for _, e := range m.BeginPasskeyRegistrationMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AuthServiceMock.GetRefreshToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to AuthServiceMock.BeginPasskeyRegistration at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetRefreshTokenCounter := mm_atomic.LoadUint64(&m.afterGetRefreshTokenCounter)
+	afterBeginPasskeyRegistrationCounter := mm_atomic.LoadUint64(&m.afterBeginPasskeyRegistrationCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetRefreshTokenMock.defaultExpectation != nil && afterGetRefreshTokenCounter < 1 {
-		if m.GetRefreshTokenMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to AuthServiceMock.GetRefreshToken at\n%s", m.GetRefreshTokenMock.defaultExpectation.returnOrigin)
+	if m.BeginPasskeyRegistrationMock.defaultExpectation != nil && afterBeginPasskeyRegistrationCounter < 1 {
+		if m.BeginPasskeyRegistrationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.BeginPasskeyRegistration at\n%s", m.BeginPasskeyRegistrationMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to AuthServiceMock.GetRefreshToken at\n%s with params: %#v", m.GetRefreshTokenMock.defaultExpectation.expectationOrigins.origin, *m.GetRefreshTokenMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AuthServiceMock.BeginPasskeyRegistration at\n%s with params: %#v", m.BeginPasskeyRegistrationMock.defaultExpectation.expectationOrigins.origin, *m.BeginPasskeyRegistrationMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetRefreshToken != nil && afterGetRefreshTokenCounter < 1 {
-		m.t.Errorf("Expected call to AuthServiceMock.GetRefreshToken at\n%s", m.funcGetRefreshTokenOrigin)
+	if m.funcBeginPasskeyRegistration != nil && afterBeginPasskeyRegistrationCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.BeginPasskeyRegistration at\n%s", m.funcBeginPasskeyRegistrationOrigin)
 	}
 
-	if !m.GetRefreshTokenMock.invocationsDone() && afterGetRefreshTokenCounter > 0 {
-		m.t.Errorf("Expected %d calls to AuthServiceMock.GetRefreshToken at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetRefreshTokenMock.expectedInvocations), m.GetRefreshTokenMock.expectedInvocationsOrigin, afterGetRefreshTokenCounter)
+	if !m.BeginPasskeyRegistrationMock.invocationsDone() && afterBeginPasskeyRegistrationCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.BeginPasskeyRegistration at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.BeginPasskeyRegistrationMock.expectedInvocations), m.BeginPasskeyRegistrationMock.expectedInvocationsOrigin, afterBeginPasskeyRegistrationCounter)
 	}
 }
 
-type mAuthServiceMockLogin struct {
+type mAuthServiceMockBeginTotpEnrollment struct {
 	optional           bool
 	mock               *AuthServiceMock
-	defaultExpectation *AuthServiceMockLoginExpectation
-	expectations       []*AuthServiceMockLoginExpectation
+	defaultExpectation *AuthServiceMockBeginTotpEnrollmentExpectation
+	expectations       []*AuthServiceMockBeginTotpEnrollmentExpectation
 
-	callArgs []*AuthServiceMockLoginParams
+	callArgs []*AuthServiceMockBeginTotpEnrollmentParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// AuthServiceMockLoginExpectation specifies expectation struct of the AuthService.Login
-type AuthServiceMockLoginExpectation struct {
+// AuthServiceMockBeginTotpEnrollmentExpectation specifies expectation struct of the AuthService.BeginTotpEnrollment
+type AuthServiceMockBeginTotpEnrollmentExpectation struct {
 	mock               *AuthServiceMock
-	params             *AuthServiceMockLoginParams
-	paramPtrs          *AuthServiceMockLoginParamPtrs
-	expectationOrigins AuthServiceMockLoginExpectationOrigins
-	results            *AuthServiceMockLoginResults
+	params             *AuthServiceMockBeginTotpEnrollmentParams
+	paramPtrs          *AuthServiceMockBeginTotpEnrollmentParamPtrs
+	expectationOrigins AuthServiceMockBeginTotpEnrollmentExpectationOrigins
+	results            *AuthServiceMockBeginTotpEnrollmentResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// AuthServiceMockLoginParams contains parameters of the AuthService.Login
-type AuthServiceMockLoginParams struct {
-	ctx   context.Context
-	creds *model.UserCreds
+// AuthServiceMockBeginTotpEnrollmentParams contains parameters of the AuthService.BeginTotpEnrollment
+type AuthServiceMockBeginTotpEnrollmentParams struct {
+	ctx    context.Context
+	userID string
 }
 
-// AuthServiceMockLoginParamPtrs contains pointers to parameters of the AuthService.Login
-type AuthServiceMockLoginParamPtrs struct {
-	ctx   *context.Context
-	creds **model.UserCreds
+// AuthServiceMockBeginTotpEnrollmentParamPtrs contains pointers to parameters of the AuthService.BeginTotpEnrollment
+type AuthServiceMockBeginTotpEnrollmentParamPtrs struct {
+	ctx    *context.Context
+	userID *string
 }
 
-// AuthServiceMockLoginResults contains results of the AuthService.Login
-type AuthServiceMockLoginResults struct {
-	tp1 *model.TokenPair
+// AuthServiceMockBeginTotpEnrollmentResults contains results of the AuthService.BeginTotpEnrollment
+type AuthServiceMockBeginTotpEnrollmentResults struct {
+	tp1 *model.TotpEnrollment
 	err error
 }
 
-// AuthServiceMockLoginOrigins contains origins of expectations of the AuthService.Login
-type AuthServiceMockLoginExpectationOrigins struct {
-	origin      string
-	originCtx   string
-	originCreds string
+// AuthServiceMockBeginTotpEnrollmentOrigins contains origins of expectations of the AuthService.BeginTotpEnrollment
+type AuthServiceMockBeginTotpEnrollmentExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -811,341 +930,5825 @@ type AuthServiceMockLoginExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmLogin *mAuthServiceMockLogin) Optional() *mAuthServiceMockLogin {
-	mmLogin.optional = true
-	return mmLogin
+func (mmBeginTotpEnrollment *mAuthServiceMockBeginTotpEnrollment) Optional() *mAuthServiceMockBeginTotpEnrollment {
+	mmBeginTotpEnrollment.optional = true
+	return mmBeginTotpEnrollment
 }
 
-// Expect sets up expected params for AuthService.Login
-func (mmLogin *mAuthServiceMockLogin) Expect(ctx context.Context, creds *model.UserCreds) *mAuthServiceMockLogin {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+// Expect sets up expected params for AuthService.BeginTotpEnrollment
+func (mmBeginTotpEnrollment *mAuthServiceMockBeginTotpEnrollment) Expect(ctx context.Context, userID string) *mAuthServiceMockBeginTotpEnrollment {
+	if mmBeginTotpEnrollment.mock.funcBeginTotpEnrollment != nil {
+		mmBeginTotpEnrollment.mock.t.Fatalf("AuthServiceMock.BeginTotpEnrollment mock is already set by Set")
 	}
 
-	if mmLogin.defaultExpectation == nil {
-		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	if mmBeginTotpEnrollment.defaultExpectation == nil {
+		mmBeginTotpEnrollment.defaultExpectation = &AuthServiceMockBeginTotpEnrollmentExpectation{}
 	}
 
-	if mmLogin.defaultExpectation.paramPtrs != nil {
-		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by ExpectParams functions")
+	if mmBeginTotpEnrollment.defaultExpectation.paramPtrs != nil {
+		mmBeginTotpEnrollment.mock.t.Fatalf("AuthServiceMock.BeginTotpEnrollment mock is already set by ExpectParams functions")
 	}
 
-	mmLogin.defaultExpectation.params = &AuthServiceMockLoginParams{ctx, creds}
-	mmLogin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmLogin.expectations {
-		if minimock.Equal(e.params, mmLogin.defaultExpectation.params) {
-			mmLogin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogin.defaultExpectation.params)
+	mmBeginTotpEnrollment.defaultExpectation.params = &AuthServiceMockBeginTotpEnrollmentParams{ctx, userID}
+	mmBeginTotpEnrollment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmBeginTotpEnrollment.expectations {
+		if minimock.Equal(e.params, mmBeginTotpEnrollment.defaultExpectation.params) {
+			mmBeginTotpEnrollment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBeginTotpEnrollment.defaultExpectation.params)
 		}
 	}
 
-	return mmLogin
+	return mmBeginTotpEnrollment
 }
 
-// ExpectCtxParam1 sets up expected param ctx for AuthService.Login
-func (mmLogin *mAuthServiceMockLogin) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockLogin {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for AuthService.BeginTotpEnrollment
+func (mmBeginTotpEnrollment *mAuthServiceMockBeginTotpEnrollment) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockBeginTotpEnrollment {
+	if mmBeginTotpEnrollment.mock.funcBeginTotpEnrollment != nil {
+		mmBeginTotpEnrollment.mock.t.Fatalf("AuthServiceMock.BeginTotpEnrollment mock is already set by Set")
 	}
 
-	if mmLogin.defaultExpectation == nil {
-		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	if mmBeginTotpEnrollment.defaultExpectation == nil {
+		mmBeginTotpEnrollment.defaultExpectation = &AuthServiceMockBeginTotpEnrollmentExpectation{}
 	}
 
-	if mmLogin.defaultExpectation.params != nil {
-		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Expect")
+	if mmBeginTotpEnrollment.defaultExpectation.params != nil {
+		mmBeginTotpEnrollment.mock.t.Fatalf("AuthServiceMock.BeginTotpEnrollment mock is already set by Expect")
 	}
 
-	if mmLogin.defaultExpectation.paramPtrs == nil {
-		mmLogin.defaultExpectation.paramPtrs = &AuthServiceMockLoginParamPtrs{}
+	if mmBeginTotpEnrollment.defaultExpectation.paramPtrs == nil {
+		mmBeginTotpEnrollment.defaultExpectation.paramPtrs = &AuthServiceMockBeginTotpEnrollmentParamPtrs{}
 	}
-	mmLogin.defaultExpectation.paramPtrs.ctx = &ctx
-	mmLogin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmBeginTotpEnrollment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmBeginTotpEnrollment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmLogin
+	return mmBeginTotpEnrollment
 }
 
-// ExpectCredsParam2 sets up expected param creds for AuthService.Login
-func (mmLogin *mAuthServiceMockLogin) ExpectCredsParam2(creds *model.UserCreds) *mAuthServiceMockLogin {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for AuthService.BeginTotpEnrollment
+func (mmBeginTotpEnrollment *mAuthServiceMockBeginTotpEnrollment) ExpectUserIDParam2(userID string) *mAuthServiceMockBeginTotpEnrollment {
+	if mmBeginTotpEnrollment.mock.funcBeginTotpEnrollment != nil {
+		mmBeginTotpEnrollment.mock.t.Fatalf("AuthServiceMock.BeginTotpEnrollment mock is already set by Set")
 	}
 
-	if mmLogin.defaultExpectation == nil {
-		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	if mmBeginTotpEnrollment.defaultExpectation == nil {
+		mmBeginTotpEnrollment.defaultExpectation = &AuthServiceMockBeginTotpEnrollmentExpectation{}
 	}
 
-	if mmLogin.defaultExpectation.params != nil {
-		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Expect")
+	if mmBeginTotpEnrollment.defaultExpectation.params != nil {
+		mmBeginTotpEnrollment.mock.t.Fatalf("AuthServiceMock.BeginTotpEnrollment mock is already set by Expect")
 	}
 
-	if mmLogin.defaultExpectation.paramPtrs == nil {
-		mmLogin.defaultExpectation.paramPtrs = &AuthServiceMockLoginParamPtrs{}
+	if mmBeginTotpEnrollment.defaultExpectation.paramPtrs == nil {
+		mmBeginTotpEnrollment.defaultExpectation.paramPtrs = &AuthServiceMockBeginTotpEnrollmentParamPtrs{}
 	}
-	mmLogin.defaultExpectation.paramPtrs.creds = &creds
-	mmLogin.defaultExpectation.expectationOrigins.originCreds = minimock.CallerInfo(1)
+	mmBeginTotpEnrollment.defaultExpectation.paramPtrs.userID = &userID
+	mmBeginTotpEnrollment.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmLogin
+	return mmBeginTotpEnrollment
 }
 
-// Inspect accepts an inspector function that has same arguments as the AuthService.Login
-func (mmLogin *mAuthServiceMockLogin) Inspect(f func(ctx context.Context, creds *model.UserCreds)) *mAuthServiceMockLogin {
-	if mmLogin.mock.inspectFuncLogin != nil {
-		mmLogin.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.Login")
+// Inspect accepts an inspector function that has same arguments as the AuthService.BeginTotpEnrollment
+func (mmBeginTotpEnrollment *mAuthServiceMockBeginTotpEnrollment) Inspect(f func(ctx context.Context, userID string)) *mAuthServiceMockBeginTotpEnrollment {
+	if mmBeginTotpEnrollment.mock.inspectFuncBeginTotpEnrollment != nil {
+		mmBeginTotpEnrollment.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.BeginTotpEnrollment")
 	}
 
-	mmLogin.mock.inspectFuncLogin = f
+	mmBeginTotpEnrollment.mock.inspectFuncBeginTotpEnrollment = f
 
-	return mmLogin
+	return mmBeginTotpEnrollment
 }
 
-// Return sets up results that will be returned by AuthService.Login
-func (mmLogin *mAuthServiceMockLogin) Return(tp1 *model.TokenPair, err error) *AuthServiceMock {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+// Return sets up results that will be returned by AuthService.BeginTotpEnrollment
+func (mmBeginTotpEnrollment *mAuthServiceMockBeginTotpEnrollment) Return(tp1 *model.TotpEnrollment, err error) *AuthServiceMock {
+	if mmBeginTotpEnrollment.mock.funcBeginTotpEnrollment != nil {
+		mmBeginTotpEnrollment.mock.t.Fatalf("AuthServiceMock.BeginTotpEnrollment mock is already set by Set")
 	}
 
-	if mmLogin.defaultExpectation == nil {
-		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{mock: mmLogin.mock}
+	if mmBeginTotpEnrollment.defaultExpectation == nil {
+		mmBeginTotpEnrollment.defaultExpectation = &AuthServiceMockBeginTotpEnrollmentExpectation{mock: mmBeginTotpEnrollment.mock}
 	}
-	mmLogin.defaultExpectation.results = &AuthServiceMockLoginResults{tp1, err}
-	mmLogin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmLogin.mock
+	mmBeginTotpEnrollment.defaultExpectation.results = &AuthServiceMockBeginTotpEnrollmentResults{tp1, err}
+	mmBeginTotpEnrollment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmBeginTotpEnrollment.mock
 }
 
-// Set uses given function f to mock the AuthService.Login method
-func (mmLogin *mAuthServiceMockLogin) Set(f func(ctx context.Context, creds *model.UserCreds) (tp1 *model.TokenPair, err error)) *AuthServiceMock {
-	if mmLogin.defaultExpectation != nil {
-		mmLogin.mock.t.Fatalf("Default expectation is already set for the AuthService.Login method")
+// Set uses given function f to mock the AuthService.BeginTotpEnrollment method
+func (mmBeginTotpEnrollment *mAuthServiceMockBeginTotpEnrollment) Set(f func(ctx context.Context, userID string) (tp1 *model.TotpEnrollment, err error)) *AuthServiceMock {
+	if mmBeginTotpEnrollment.defaultExpectation != nil {
+		mmBeginTotpEnrollment.mock.t.Fatalf("Default expectation is already set for the AuthService.BeginTotpEnrollment method")
 	}
 
-	if len(mmLogin.expectations) > 0 {
-		mmLogin.mock.t.Fatalf("Some expectations are already set for the AuthService.Login method")
+	if len(mmBeginTotpEnrollment.expectations) > 0 {
+		mmBeginTotpEnrollment.mock.t.Fatalf("Some expectations are already set for the AuthService.BeginTotpEnrollment method")
 	}
 
-	mmLogin.mock.funcLogin = f
-	mmLogin.mock.funcLoginOrigin = minimock.CallerInfo(1)
-	return mmLogin.mock
+	mmBeginTotpEnrollment.mock.funcBeginTotpEnrollment = f
+	mmBeginTotpEnrollment.mock.funcBeginTotpEnrollmentOrigin = minimock.CallerInfo(1)
+	return mmBeginTotpEnrollment.mock
 }
 
-// When sets expectation for the AuthService.Login which will trigger the result defined by the following
+// When sets expectation for the AuthService.BeginTotpEnrollment which will trigger the result defined by the following
 // Then helper
-func (mmLogin *mAuthServiceMockLogin) When(ctx context.Context, creds *model.UserCreds) *AuthServiceMockLoginExpectation {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+func (mmBeginTotpEnrollment *mAuthServiceMockBeginTotpEnrollment) When(ctx context.Context, userID string) *AuthServiceMockBeginTotpEnrollmentExpectation {
+	if mmBeginTotpEnrollment.mock.funcBeginTotpEnrollment != nil {
+		mmBeginTotpEnrollment.mock.t.Fatalf("AuthServiceMock.BeginTotpEnrollment mock is already set by Set")
 	}
 
-	expectation := &AuthServiceMockLoginExpectation{
-		mock:               mmLogin.mock,
-		params:             &AuthServiceMockLoginParams{ctx, creds},
-		expectationOrigins: AuthServiceMockLoginExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &AuthServiceMockBeginTotpEnrollmentExpectation{
+		mock:               mmBeginTotpEnrollment.mock,
+		params:             &AuthServiceMockBeginTotpEnrollmentParams{ctx, userID},
+		expectationOrigins: AuthServiceMockBeginTotpEnrollmentExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmLogin.expectations = append(mmLogin.expectations, expectation)
+	mmBeginTotpEnrollment.expectations = append(mmBeginTotpEnrollment.expectations, expectation)
 	return expectation
 }
 
-// Then sets up AuthService.Login return parameters for the expectation previously defined by the When method
-func (e *AuthServiceMockLoginExpectation) Then(tp1 *model.TokenPair, err error) *AuthServiceMock {
-	e.results = &AuthServiceMockLoginResults{tp1, err}
+// Then sets up AuthService.BeginTotpEnrollment return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockBeginTotpEnrollmentExpectation) Then(tp1 *model.TotpEnrollment, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockBeginTotpEnrollmentResults{tp1, err}
 	return e.mock
 }
 
-// Times sets number of times AuthService.Login should be invoked
-func (mmLogin *mAuthServiceMockLogin) Times(n uint64) *mAuthServiceMockLogin {
+// Times sets number of times AuthService.BeginTotpEnrollment should be invoked
+func (mmBeginTotpEnrollment *mAuthServiceMockBeginTotpEnrollment) Times(n uint64) *mAuthServiceMockBeginTotpEnrollment {
 	if n == 0 {
-		mmLogin.mock.t.Fatalf("Times of AuthServiceMock.Login mock can not be zero")
+		mmBeginTotpEnrollment.mock.t.Fatalf("Times of AuthServiceMock.BeginTotpEnrollment mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmLogin.expectedInvocations, n)
-	mmLogin.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmLogin
+	mm_atomic.StoreUint64(&mmBeginTotpEnrollment.expectedInvocations, n)
+	mmBeginTotpEnrollment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmBeginTotpEnrollment
 }
 
-func (mmLogin *mAuthServiceMockLogin) invocationsDone() bool {
-	if len(mmLogin.expectations) == 0 && mmLogin.defaultExpectation == nil && mmLogin.mock.funcLogin == nil {
+func (mmBeginTotpEnrollment *mAuthServiceMockBeginTotpEnrollment) invocationsDone() bool {
+	if len(mmBeginTotpEnrollment.expectations) == 0 && mmBeginTotpEnrollment.defaultExpectation == nil && mmBeginTotpEnrollment.mock.funcBeginTotpEnrollment == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmLogin.mock.afterLoginCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmLogin.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmBeginTotpEnrollment.mock.afterBeginTotpEnrollmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmBeginTotpEnrollment.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Login implements mm_service.AuthService
-func (mmLogin *AuthServiceMock) Login(ctx context.Context, creds *model.UserCreds) (tp1 *model.TokenPair, err error) {
-	mm_atomic.AddUint64(&mmLogin.beforeLoginCounter, 1)
-	defer mm_atomic.AddUint64(&mmLogin.afterLoginCounter, 1)
+// BeginTotpEnrollment implements mm_service.AuthService
+func (mmBeginTotpEnrollment *AuthServiceMock) BeginTotpEnrollment(ctx context.Context, userID string) (tp1 *model.TotpEnrollment, err error) {
+	mm_atomic.AddUint64(&mmBeginTotpEnrollment.beforeBeginTotpEnrollmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmBeginTotpEnrollment.afterBeginTotpEnrollmentCounter, 1)
 
-	mmLogin.t.Helper()
+	mmBeginTotpEnrollment.t.Helper()
 
-	if mmLogin.inspectFuncLogin != nil {
-		mmLogin.inspectFuncLogin(ctx, creds)
+	if mmBeginTotpEnrollment.inspectFuncBeginTotpEnrollment != nil {
+		mmBeginTotpEnrollment.inspectFuncBeginTotpEnrollment(ctx, userID)
 	}
 
-	mm_params := AuthServiceMockLoginParams{ctx, creds}
+	mm_params := AuthServiceMockBeginTotpEnrollmentParams{ctx, userID}
 
 	// Record call args
-	mmLogin.LoginMock.mutex.Lock()
-	mmLogin.LoginMock.callArgs = append(mmLogin.LoginMock.callArgs, &mm_params)
-	mmLogin.LoginMock.mutex.Unlock()
+	mmBeginTotpEnrollment.BeginTotpEnrollmentMock.mutex.Lock()
+	mmBeginTotpEnrollment.BeginTotpEnrollmentMock.callArgs = append(mmBeginTotpEnrollment.BeginTotpEnrollmentMock.callArgs, &mm_params)
+	mmBeginTotpEnrollment.BeginTotpEnrollmentMock.mutex.Unlock()
 
-	for _, e := range mmLogin.LoginMock.expectations {
+	for _, e := range mmBeginTotpEnrollment.BeginTotpEnrollmentMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.tp1, e.results.err
 		}
 	}
 
-	if mmLogin.LoginMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmLogin.LoginMock.defaultExpectation.Counter, 1)
-		mm_want := mmLogin.LoginMock.defaultExpectation.params
-		mm_want_ptrs := mmLogin.LoginMock.defaultExpectation.paramPtrs
+	if mmBeginTotpEnrollment.BeginTotpEnrollmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBeginTotpEnrollment.BeginTotpEnrollmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmBeginTotpEnrollment.BeginTotpEnrollmentMock.defaultExpectation.params
+		mm_want_ptrs := mmBeginTotpEnrollment.BeginTotpEnrollmentMock.defaultExpectation.paramPtrs
 
-		mm_got := AuthServiceMockLoginParams{ctx, creds}
+		mm_got := AuthServiceMockBeginTotpEnrollmentParams{ctx, userID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmBeginTotpEnrollment.t.Errorf("AuthServiceMock.BeginTotpEnrollment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBeginTotpEnrollment.BeginTotpEnrollmentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.creds != nil && !minimock.Equal(*mm_want_ptrs.creds, mm_got.creds) {
-				mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameter creds, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originCreds, *mm_want_ptrs.creds, mm_got.creds, minimock.Diff(*mm_want_ptrs.creds, mm_got.creds))
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmBeginTotpEnrollment.t.Errorf("AuthServiceMock.BeginTotpEnrollment got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBeginTotpEnrollment.BeginTotpEnrollmentMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmLogin.LoginMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmBeginTotpEnrollment.t.Errorf("AuthServiceMock.BeginTotpEnrollment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmBeginTotpEnrollment.BeginTotpEnrollmentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmLogin.LoginMock.defaultExpectation.results
+		mm_results := mmBeginTotpEnrollment.BeginTotpEnrollmentMock.defaultExpectation.results
 		if mm_results == nil {
-			mmLogin.t.Fatal("No results are set for the AuthServiceMock.Login")
+			mmBeginTotpEnrollment.t.Fatal("No results are set for the AuthServiceMock.BeginTotpEnrollment")
 		}
 		return (*mm_results).tp1, (*mm_results).err
 	}
-	if mmLogin.funcLogin != nil {
-		return mmLogin.funcLogin(ctx, creds)
+	if mmBeginTotpEnrollment.funcBeginTotpEnrollment != nil {
+		return mmBeginTotpEnrollment.funcBeginTotpEnrollment(ctx, userID)
 	}
-	mmLogin.t.Fatalf("Unexpected call to AuthServiceMock.Login. %v %v", ctx, creds)
+	mmBeginTotpEnrollment.t.Fatalf("Unexpected call to AuthServiceMock.BeginTotpEnrollment. %v %v", ctx, userID)
 	return
 }
 
-// LoginAfterCounter returns a count of finished AuthServiceMock.Login invocations
-func (mmLogin *AuthServiceMock) LoginAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmLogin.afterLoginCounter)
+// BeginTotpEnrollmentAfterCounter returns a count of finished AuthServiceMock.BeginTotpEnrollment invocations
+func (mmBeginTotpEnrollment *AuthServiceMock) BeginTotpEnrollmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBeginTotpEnrollment.afterBeginTotpEnrollmentCounter)
 }
 
-// LoginBeforeCounter returns a count of AuthServiceMock.Login invocations
-func (mmLogin *AuthServiceMock) LoginBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmLogin.beforeLoginCounter)
+// BeginTotpEnrollmentBeforeCounter returns a count of AuthServiceMock.BeginTotpEnrollment invocations
+func (mmBeginTotpEnrollment *AuthServiceMock) BeginTotpEnrollmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBeginTotpEnrollment.beforeBeginTotpEnrollmentCounter)
 }
 
-// Calls returns a list of arguments used in each call to AuthServiceMock.Login.
+// Calls returns a list of arguments used in each call to AuthServiceMock.BeginTotpEnrollment.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmLogin *mAuthServiceMockLogin) Calls() []*AuthServiceMockLoginParams {
-	mmLogin.mutex.RLock()
+func (mmBeginTotpEnrollment *mAuthServiceMockBeginTotpEnrollment) Calls() []*AuthServiceMockBeginTotpEnrollmentParams {
+	mmBeginTotpEnrollment.mutex.RLock()
 
-	argCopy := make([]*AuthServiceMockLoginParams, len(mmLogin.callArgs))
-	copy(argCopy, mmLogin.callArgs)
+	argCopy := make([]*AuthServiceMockBeginTotpEnrollmentParams, len(mmBeginTotpEnrollment.callArgs))
+	copy(argCopy, mmBeginTotpEnrollment.callArgs)
 
-	mmLogin.mutex.RUnlock()
+	mmBeginTotpEnrollment.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockLoginDone returns true if the count of the Login invocations corresponds
+// MinimockBeginTotpEnrollmentDone returns true if the count of the BeginTotpEnrollment invocations corresponds
 // the number of defined expectations
-func (m *AuthServiceMock) MinimockLoginDone() bool {
-	if m.LoginMock.optional {
+func (m *AuthServiceMock) MinimockBeginTotpEnrollmentDone() bool {
+	if m.BeginTotpEnrollmentMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
+	for _, e := range m.BeginTotpEnrollmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.BeginTotpEnrollmentMock.invocationsDone()
+}
+
+// MinimockBeginTotpEnrollmentInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockBeginTotpEnrollmentInspect() {
+	for _, e := range m.BeginTotpEnrollmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.BeginTotpEnrollment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterBeginTotpEnrollmentCounter := mm_atomic.LoadUint64(&m.afterBeginTotpEnrollmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BeginTotpEnrollmentMock.defaultExpectation != nil && afterBeginTotpEnrollmentCounter < 1 {
+		if m.BeginTotpEnrollmentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.BeginTotpEnrollment at\n%s", m.BeginTotpEnrollmentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.BeginTotpEnrollment at\n%s with params: %#v", m.BeginTotpEnrollmentMock.defaultExpectation.expectationOrigins.origin, *m.BeginTotpEnrollmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBeginTotpEnrollment != nil && afterBeginTotpEnrollmentCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.BeginTotpEnrollment at\n%s", m.funcBeginTotpEnrollmentOrigin)
+	}
+
+	if !m.BeginTotpEnrollmentMock.invocationsDone() && afterBeginTotpEnrollmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.BeginTotpEnrollment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.BeginTotpEnrollmentMock.expectedInvocations), m.BeginTotpEnrollmentMock.expectedInvocationsOrigin, afterBeginTotpEnrollmentCounter)
+	}
+}
+
+type mAuthServiceMockConfirmTotpEnrollment struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockConfirmTotpEnrollmentExpectation
+	expectations       []*AuthServiceMockConfirmTotpEnrollmentExpectation
+
+	callArgs []*AuthServiceMockConfirmTotpEnrollmentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockConfirmTotpEnrollmentExpectation specifies expectation struct of the AuthService.ConfirmTotpEnrollment
+type AuthServiceMockConfirmTotpEnrollmentExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockConfirmTotpEnrollmentParams
+	paramPtrs          *AuthServiceMockConfirmTotpEnrollmentParamPtrs
+	expectationOrigins AuthServiceMockConfirmTotpEnrollmentExpectationOrigins
+	results            *AuthServiceMockConfirmTotpEnrollmentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockConfirmTotpEnrollmentParams contains parameters of the AuthService.ConfirmTotpEnrollment
+type AuthServiceMockConfirmTotpEnrollmentParams struct {
+	ctx    context.Context
+	userID string
+	code   string
+}
+
+// AuthServiceMockConfirmTotpEnrollmentParamPtrs contains pointers to parameters of the AuthService.ConfirmTotpEnrollment
+type AuthServiceMockConfirmTotpEnrollmentParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+	code   *string
+}
+
+// AuthServiceMockConfirmTotpEnrollmentResults contains results of the AuthService.ConfirmTotpEnrollment
+type AuthServiceMockConfirmTotpEnrollmentResults struct {
+	sa1 []string
+	err error
+}
+
+// AuthServiceMockConfirmTotpEnrollmentOrigins contains origins of expectations of the AuthService.ConfirmTotpEnrollment
+type AuthServiceMockConfirmTotpEnrollmentExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originCode   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmConfirmTotpEnrollment *mAuthServiceMockConfirmTotpEnrollment) Optional() *mAuthServiceMockConfirmTotpEnrollment {
+	mmConfirmTotpEnrollment.optional = true
+	return mmConfirmTotpEnrollment
+}
+
+// Expect sets up expected params for AuthService.ConfirmTotpEnrollment
+func (mmConfirmTotpEnrollment *mAuthServiceMockConfirmTotpEnrollment) Expect(ctx context.Context, userID string, code string) *mAuthServiceMockConfirmTotpEnrollment {
+	if mmConfirmTotpEnrollment.mock.funcConfirmTotpEnrollment != nil {
+		mmConfirmTotpEnrollment.mock.t.Fatalf("AuthServiceMock.ConfirmTotpEnrollment mock is already set by Set")
+	}
+
+	if mmConfirmTotpEnrollment.defaultExpectation == nil {
+		mmConfirmTotpEnrollment.defaultExpectation = &AuthServiceMockConfirmTotpEnrollmentExpectation{}
+	}
+
+	if mmConfirmTotpEnrollment.defaultExpectation.paramPtrs != nil {
+		mmConfirmTotpEnrollment.mock.t.Fatalf("AuthServiceMock.ConfirmTotpEnrollment mock is already set by ExpectParams functions")
+	}
+
+	mmConfirmTotpEnrollment.defaultExpectation.params = &AuthServiceMockConfirmTotpEnrollmentParams{ctx, userID, code}
+	mmConfirmTotpEnrollment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmConfirmTotpEnrollment.expectations {
+		if minimock.Equal(e.params, mmConfirmTotpEnrollment.defaultExpectation.params) {
+			mmConfirmTotpEnrollment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConfirmTotpEnrollment.defaultExpectation.params)
+		}
+	}
+
+	return mmConfirmTotpEnrollment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.ConfirmTotpEnrollment
+func (mmConfirmTotpEnrollment *mAuthServiceMockConfirmTotpEnrollment) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockConfirmTotpEnrollment {
+	if mmConfirmTotpEnrollment.mock.funcConfirmTotpEnrollment != nil {
+		mmConfirmTotpEnrollment.mock.t.Fatalf("AuthServiceMock.ConfirmTotpEnrollment mock is already set by Set")
+	}
+
+	if mmConfirmTotpEnrollment.defaultExpectation == nil {
+		mmConfirmTotpEnrollment.defaultExpectation = &AuthServiceMockConfirmTotpEnrollmentExpectation{}
+	}
+
+	if mmConfirmTotpEnrollment.defaultExpectation.params != nil {
+		mmConfirmTotpEnrollment.mock.t.Fatalf("AuthServiceMock.ConfirmTotpEnrollment mock is already set by Expect")
+	}
+
+	if mmConfirmTotpEnrollment.defaultExpectation.paramPtrs == nil {
+		mmConfirmTotpEnrollment.defaultExpectation.paramPtrs = &AuthServiceMockConfirmTotpEnrollmentParamPtrs{}
+	}
+	mmConfirmTotpEnrollment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmConfirmTotpEnrollment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmConfirmTotpEnrollment
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthService.ConfirmTotpEnrollment
+func (mmConfirmTotpEnrollment *mAuthServiceMockConfirmTotpEnrollment) ExpectUserIDParam2(userID string) *mAuthServiceMockConfirmTotpEnrollment {
+	if mmConfirmTotpEnrollment.mock.funcConfirmTotpEnrollment != nil {
+		mmConfirmTotpEnrollment.mock.t.Fatalf("AuthServiceMock.ConfirmTotpEnrollment mock is already set by Set")
+	}
+
+	if mmConfirmTotpEnrollment.defaultExpectation == nil {
+		mmConfirmTotpEnrollment.defaultExpectation = &AuthServiceMockConfirmTotpEnrollmentExpectation{}
+	}
+
+	if mmConfirmTotpEnrollment.defaultExpectation.params != nil {
+		mmConfirmTotpEnrollment.mock.t.Fatalf("AuthServiceMock.ConfirmTotpEnrollment mock is already set by Expect")
+	}
+
+	if mmConfirmTotpEnrollment.defaultExpectation.paramPtrs == nil {
+		mmConfirmTotpEnrollment.defaultExpectation.paramPtrs = &AuthServiceMockConfirmTotpEnrollmentParamPtrs{}
+	}
+	mmConfirmTotpEnrollment.defaultExpectation.paramPtrs.userID = &userID
+	mmConfirmTotpEnrollment.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmConfirmTotpEnrollment
+}
+
+// ExpectCodeParam3 sets up expected param code for AuthService.ConfirmTotpEnrollment
+func (mmConfirmTotpEnrollment *mAuthServiceMockConfirmTotpEnrollment) ExpectCodeParam3(code string) *mAuthServiceMockConfirmTotpEnrollment {
+	if mmConfirmTotpEnrollment.mock.funcConfirmTotpEnrollment != nil {
+		mmConfirmTotpEnrollment.mock.t.Fatalf("AuthServiceMock.ConfirmTotpEnrollment mock is already set by Set")
+	}
+
+	if mmConfirmTotpEnrollment.defaultExpectation == nil {
+		mmConfirmTotpEnrollment.defaultExpectation = &AuthServiceMockConfirmTotpEnrollmentExpectation{}
+	}
+
+	if mmConfirmTotpEnrollment.defaultExpectation.params != nil {
+		mmConfirmTotpEnrollment.mock.t.Fatalf("AuthServiceMock.ConfirmTotpEnrollment mock is already set by Expect")
+	}
+
+	if mmConfirmTotpEnrollment.defaultExpectation.paramPtrs == nil {
+		mmConfirmTotpEnrollment.defaultExpectation.paramPtrs = &AuthServiceMockConfirmTotpEnrollmentParamPtrs{}
+	}
+	mmConfirmTotpEnrollment.defaultExpectation.paramPtrs.code = &code
+	mmConfirmTotpEnrollment.defaultExpectation.expectationOrigins.originCode = minimock.CallerInfo(1)
+
+	return mmConfirmTotpEnrollment
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.ConfirmTotpEnrollment
+func (mmConfirmTotpEnrollment *mAuthServiceMockConfirmTotpEnrollment) Inspect(f func(ctx context.Context, userID string, code string)) *mAuthServiceMockConfirmTotpEnrollment {
+	if mmConfirmTotpEnrollment.mock.inspectFuncConfirmTotpEnrollment != nil {
+		mmConfirmTotpEnrollment.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.ConfirmTotpEnrollment")
+	}
+
+	mmConfirmTotpEnrollment.mock.inspectFuncConfirmTotpEnrollment = f
+
+	return mmConfirmTotpEnrollment
+}
+
+// Return sets up results that will be returned by AuthService.ConfirmTotpEnrollment
+func (mmConfirmTotpEnrollment *mAuthServiceMockConfirmTotpEnrollment) Return(sa1 []string, err error) *AuthServiceMock {
+	if mmConfirmTotpEnrollment.mock.funcConfirmTotpEnrollment != nil {
+		mmConfirmTotpEnrollment.mock.t.Fatalf("AuthServiceMock.ConfirmTotpEnrollment mock is already set by Set")
+	}
+
+	if mmConfirmTotpEnrollment.defaultExpectation == nil {
+		mmConfirmTotpEnrollment.defaultExpectation = &AuthServiceMockConfirmTotpEnrollmentExpectation{mock: mmConfirmTotpEnrollment.mock}
+	}
+	mmConfirmTotpEnrollment.defaultExpectation.results = &AuthServiceMockConfirmTotpEnrollmentResults{sa1, err}
+	mmConfirmTotpEnrollment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmConfirmTotpEnrollment.mock
+}
+
+// Set uses given function f to mock the AuthService.ConfirmTotpEnrollment method
+func (mmConfirmTotpEnrollment *mAuthServiceMockConfirmTotpEnrollment) Set(f func(ctx context.Context, userID string, code string) (sa1 []string, err error)) *AuthServiceMock {
+	if mmConfirmTotpEnrollment.defaultExpectation != nil {
+		mmConfirmTotpEnrollment.mock.t.Fatalf("Default expectation is already set for the AuthService.ConfirmTotpEnrollment method")
+	}
+
+	if len(mmConfirmTotpEnrollment.expectations) > 0 {
+		mmConfirmTotpEnrollment.mock.t.Fatalf("Some expectations are already set for the AuthService.ConfirmTotpEnrollment method")
+	}
+
+	mmConfirmTotpEnrollment.mock.funcConfirmTotpEnrollment = f
+	mmConfirmTotpEnrollment.mock.funcConfirmTotpEnrollmentOrigin = minimock.CallerInfo(1)
+	return mmConfirmTotpEnrollment.mock
+}
+
+// When sets expectation for the AuthService.ConfirmTotpEnrollment which will trigger the result defined by the following
+// Then helper
+func (mmConfirmTotpEnrollment *mAuthServiceMockConfirmTotpEnrollment) When(ctx context.Context, userID string, code string) *AuthServiceMockConfirmTotpEnrollmentExpectation {
+	if mmConfirmTotpEnrollment.mock.funcConfirmTotpEnrollment != nil {
+		mmConfirmTotpEnrollment.mock.t.Fatalf("AuthServiceMock.ConfirmTotpEnrollment mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockConfirmTotpEnrollmentExpectation{
+		mock:               mmConfirmTotpEnrollment.mock,
+		params:             &AuthServiceMockConfirmTotpEnrollmentParams{ctx, userID, code},
+		expectationOrigins: AuthServiceMockConfirmTotpEnrollmentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmConfirmTotpEnrollment.expectations = append(mmConfirmTotpEnrollment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.ConfirmTotpEnrollment return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockConfirmTotpEnrollmentExpectation) Then(sa1 []string, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockConfirmTotpEnrollmentResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.ConfirmTotpEnrollment should be invoked
+func (mmConfirmTotpEnrollment *mAuthServiceMockConfirmTotpEnrollment) Times(n uint64) *mAuthServiceMockConfirmTotpEnrollment {
+	if n == 0 {
+		mmConfirmTotpEnrollment.mock.t.Fatalf("Times of AuthServiceMock.ConfirmTotpEnrollment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmConfirmTotpEnrollment.expectedInvocations, n)
+	mmConfirmTotpEnrollment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmConfirmTotpEnrollment
+}
+
+func (mmConfirmTotpEnrollment *mAuthServiceMockConfirmTotpEnrollment) invocationsDone() bool {
+	if len(mmConfirmTotpEnrollment.expectations) == 0 && mmConfirmTotpEnrollment.defaultExpectation == nil && mmConfirmTotpEnrollment.mock.funcConfirmTotpEnrollment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmConfirmTotpEnrollment.mock.afterConfirmTotpEnrollmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmConfirmTotpEnrollment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ConfirmTotpEnrollment implements mm_service.AuthService
+func (mmConfirmTotpEnrollment *AuthServiceMock) ConfirmTotpEnrollment(ctx context.Context, userID string, code string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmConfirmTotpEnrollment.beforeConfirmTotpEnrollmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmConfirmTotpEnrollment.afterConfirmTotpEnrollmentCounter, 1)
+
+	mmConfirmTotpEnrollment.t.Helper()
+
+	if mmConfirmTotpEnrollment.inspectFuncConfirmTotpEnrollment != nil {
+		mmConfirmTotpEnrollment.inspectFuncConfirmTotpEnrollment(ctx, userID, code)
+	}
+
+	mm_params := AuthServiceMockConfirmTotpEnrollmentParams{ctx, userID, code}
+
+	// Record call args
+	mmConfirmTotpEnrollment.ConfirmTotpEnrollmentMock.mutex.Lock()
+	mmConfirmTotpEnrollment.ConfirmTotpEnrollmentMock.callArgs = append(mmConfirmTotpEnrollment.ConfirmTotpEnrollmentMock.callArgs, &mm_params)
+	mmConfirmTotpEnrollment.ConfirmTotpEnrollmentMock.mutex.Unlock()
+
+	for _, e := range mmConfirmTotpEnrollment.ConfirmTotpEnrollmentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmConfirmTotpEnrollment.ConfirmTotpEnrollmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConfirmTotpEnrollment.ConfirmTotpEnrollmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmConfirmTotpEnrollment.ConfirmTotpEnrollmentMock.defaultExpectation.params
+		mm_want_ptrs := mmConfirmTotpEnrollment.ConfirmTotpEnrollmentMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockConfirmTotpEnrollmentParams{ctx, userID, code}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmConfirmTotpEnrollment.t.Errorf("AuthServiceMock.ConfirmTotpEnrollment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConfirmTotpEnrollment.ConfirmTotpEnrollmentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmConfirmTotpEnrollment.t.Errorf("AuthServiceMock.ConfirmTotpEnrollment got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConfirmTotpEnrollment.ConfirmTotpEnrollmentMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.code != nil && !minimock.Equal(*mm_want_ptrs.code, mm_got.code) {
+				mmConfirmTotpEnrollment.t.Errorf("AuthServiceMock.ConfirmTotpEnrollment got unexpected parameter code, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConfirmTotpEnrollment.ConfirmTotpEnrollmentMock.defaultExpectation.expectationOrigins.originCode, *mm_want_ptrs.code, mm_got.code, minimock.Diff(*mm_want_ptrs.code, mm_got.code))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConfirmTotpEnrollment.t.Errorf("AuthServiceMock.ConfirmTotpEnrollment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmConfirmTotpEnrollment.ConfirmTotpEnrollmentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConfirmTotpEnrollment.ConfirmTotpEnrollmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConfirmTotpEnrollment.t.Fatal("No results are set for the AuthServiceMock.ConfirmTotpEnrollment")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmConfirmTotpEnrollment.funcConfirmTotpEnrollment != nil {
+		return mmConfirmTotpEnrollment.funcConfirmTotpEnrollment(ctx, userID, code)
+	}
+	mmConfirmTotpEnrollment.t.Fatalf("Unexpected call to AuthServiceMock.ConfirmTotpEnrollment. %v %v %v", ctx, userID, code)
+	return
+}
+
+// ConfirmTotpEnrollmentAfterCounter returns a count of finished AuthServiceMock.ConfirmTotpEnrollment invocations
+func (mmConfirmTotpEnrollment *AuthServiceMock) ConfirmTotpEnrollmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConfirmTotpEnrollment.afterConfirmTotpEnrollmentCounter)
+}
+
+// ConfirmTotpEnrollmentBeforeCounter returns a count of AuthServiceMock.ConfirmTotpEnrollment invocations
+func (mmConfirmTotpEnrollment *AuthServiceMock) ConfirmTotpEnrollmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConfirmTotpEnrollment.beforeConfirmTotpEnrollmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.ConfirmTotpEnrollment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConfirmTotpEnrollment *mAuthServiceMockConfirmTotpEnrollment) Calls() []*AuthServiceMockConfirmTotpEnrollmentParams {
+	mmConfirmTotpEnrollment.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockConfirmTotpEnrollmentParams, len(mmConfirmTotpEnrollment.callArgs))
+	copy(argCopy, mmConfirmTotpEnrollment.callArgs)
+
+	mmConfirmTotpEnrollment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConfirmTotpEnrollmentDone returns true if the count of the ConfirmTotpEnrollment invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockConfirmTotpEnrollmentDone() bool {
+	if m.ConfirmTotpEnrollmentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ConfirmTotpEnrollmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ConfirmTotpEnrollmentMock.invocationsDone()
+}
+
+// MinimockConfirmTotpEnrollmentInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockConfirmTotpEnrollmentInspect() {
+	for _, e := range m.ConfirmTotpEnrollmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.ConfirmTotpEnrollment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterConfirmTotpEnrollmentCounter := mm_atomic.LoadUint64(&m.afterConfirmTotpEnrollmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConfirmTotpEnrollmentMock.defaultExpectation != nil && afterConfirmTotpEnrollmentCounter < 1 {
+		if m.ConfirmTotpEnrollmentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.ConfirmTotpEnrollment at\n%s", m.ConfirmTotpEnrollmentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.ConfirmTotpEnrollment at\n%s with params: %#v", m.ConfirmTotpEnrollmentMock.defaultExpectation.expectationOrigins.origin, *m.ConfirmTotpEnrollmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConfirmTotpEnrollment != nil && afterConfirmTotpEnrollmentCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.ConfirmTotpEnrollment at\n%s", m.funcConfirmTotpEnrollmentOrigin)
+	}
+
+	if !m.ConfirmTotpEnrollmentMock.invocationsDone() && afterConfirmTotpEnrollmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.ConfirmTotpEnrollment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ConfirmTotpEnrollmentMock.expectedInvocations), m.ConfirmTotpEnrollmentMock.expectedInvocationsOrigin, afterConfirmTotpEnrollmentCounter)
+	}
+}
+
+type mAuthServiceMockDisableTotp struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockDisableTotpExpectation
+	expectations       []*AuthServiceMockDisableTotpExpectation
+
+	callArgs []*AuthServiceMockDisableTotpParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockDisableTotpExpectation specifies expectation struct of the AuthService.DisableTotp
+type AuthServiceMockDisableTotpExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockDisableTotpParams
+	paramPtrs          *AuthServiceMockDisableTotpParamPtrs
+	expectationOrigins AuthServiceMockDisableTotpExpectationOrigins
+	results            *AuthServiceMockDisableTotpResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockDisableTotpParams contains parameters of the AuthService.DisableTotp
+type AuthServiceMockDisableTotpParams struct {
+	ctx    context.Context
+	userID string
+	code   string
+}
+
+// AuthServiceMockDisableTotpParamPtrs contains pointers to parameters of the AuthService.DisableTotp
+type AuthServiceMockDisableTotpParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+	code   *string
+}
+
+// AuthServiceMockDisableTotpResults contains results of the AuthService.DisableTotp
+type AuthServiceMockDisableTotpResults struct {
+	err error
+}
+
+// AuthServiceMockDisableTotpOrigins contains origins of expectations of the AuthService.DisableTotp
+type AuthServiceMockDisableTotpExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originCode   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDisableTotp *mAuthServiceMockDisableTotp) Optional() *mAuthServiceMockDisableTotp {
+	mmDisableTotp.optional = true
+	return mmDisableTotp
+}
+
+// Expect sets up expected params for AuthService.DisableTotp
+func (mmDisableTotp *mAuthServiceMockDisableTotp) Expect(ctx context.Context, userID string, code string) *mAuthServiceMockDisableTotp {
+	if mmDisableTotp.mock.funcDisableTotp != nil {
+		mmDisableTotp.mock.t.Fatalf("AuthServiceMock.DisableTotp mock is already set by Set")
+	}
+
+	if mmDisableTotp.defaultExpectation == nil {
+		mmDisableTotp.defaultExpectation = &AuthServiceMockDisableTotpExpectation{}
+	}
+
+	if mmDisableTotp.defaultExpectation.paramPtrs != nil {
+		mmDisableTotp.mock.t.Fatalf("AuthServiceMock.DisableTotp mock is already set by ExpectParams functions")
+	}
+
+	mmDisableTotp.defaultExpectation.params = &AuthServiceMockDisableTotpParams{ctx, userID, code}
+	mmDisableTotp.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDisableTotp.expectations {
+		if minimock.Equal(e.params, mmDisableTotp.defaultExpectation.params) {
+			mmDisableTotp.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDisableTotp.defaultExpectation.params)
+		}
+	}
+
+	return mmDisableTotp
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.DisableTotp
+func (mmDisableTotp *mAuthServiceMockDisableTotp) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockDisableTotp {
+	if mmDisableTotp.mock.funcDisableTotp != nil {
+		mmDisableTotp.mock.t.Fatalf("AuthServiceMock.DisableTotp mock is already set by Set")
+	}
+
+	if mmDisableTotp.defaultExpectation == nil {
+		mmDisableTotp.defaultExpectation = &AuthServiceMockDisableTotpExpectation{}
+	}
+
+	if mmDisableTotp.defaultExpectation.params != nil {
+		mmDisableTotp.mock.t.Fatalf("AuthServiceMock.DisableTotp mock is already set by Expect")
+	}
+
+	if mmDisableTotp.defaultExpectation.paramPtrs == nil {
+		mmDisableTotp.defaultExpectation.paramPtrs = &AuthServiceMockDisableTotpParamPtrs{}
+	}
+	mmDisableTotp.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDisableTotp.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDisableTotp
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthService.DisableTotp
+func (mmDisableTotp *mAuthServiceMockDisableTotp) ExpectUserIDParam2(userID string) *mAuthServiceMockDisableTotp {
+	if mmDisableTotp.mock.funcDisableTotp != nil {
+		mmDisableTotp.mock.t.Fatalf("AuthServiceMock.DisableTotp mock is already set by Set")
+	}
+
+	if mmDisableTotp.defaultExpectation == nil {
+		mmDisableTotp.defaultExpectation = &AuthServiceMockDisableTotpExpectation{}
+	}
+
+	if mmDisableTotp.defaultExpectation.params != nil {
+		mmDisableTotp.mock.t.Fatalf("AuthServiceMock.DisableTotp mock is already set by Expect")
+	}
+
+	if mmDisableTotp.defaultExpectation.paramPtrs == nil {
+		mmDisableTotp.defaultExpectation.paramPtrs = &AuthServiceMockDisableTotpParamPtrs{}
+	}
+	mmDisableTotp.defaultExpectation.paramPtrs.userID = &userID
+	mmDisableTotp.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDisableTotp
+}
+
+// ExpectCodeParam3 sets up expected param code for AuthService.DisableTotp
+func (mmDisableTotp *mAuthServiceMockDisableTotp) ExpectCodeParam3(code string) *mAuthServiceMockDisableTotp {
+	if mmDisableTotp.mock.funcDisableTotp != nil {
+		mmDisableTotp.mock.t.Fatalf("AuthServiceMock.DisableTotp mock is already set by Set")
+	}
+
+	if mmDisableTotp.defaultExpectation == nil {
+		mmDisableTotp.defaultExpectation = &AuthServiceMockDisableTotpExpectation{}
+	}
+
+	if mmDisableTotp.defaultExpectation.params != nil {
+		mmDisableTotp.mock.t.Fatalf("AuthServiceMock.DisableTotp mock is already set by Expect")
+	}
+
+	if mmDisableTotp.defaultExpectation.paramPtrs == nil {
+		mmDisableTotp.defaultExpectation.paramPtrs = &AuthServiceMockDisableTotpParamPtrs{}
+	}
+	mmDisableTotp.defaultExpectation.paramPtrs.code = &code
+	mmDisableTotp.defaultExpectation.expectationOrigins.originCode = minimock.CallerInfo(1)
+
+	return mmDisableTotp
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.DisableTotp
+func (mmDisableTotp *mAuthServiceMockDisableTotp) Inspect(f func(ctx context.Context, userID string, code string)) *mAuthServiceMockDisableTotp {
+	if mmDisableTotp.mock.inspectFuncDisableTotp != nil {
+		mmDisableTotp.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.DisableTotp")
+	}
+
+	mmDisableTotp.mock.inspectFuncDisableTotp = f
+
+	return mmDisableTotp
+}
+
+// Return sets up results that will be returned by AuthService.DisableTotp
+func (mmDisableTotp *mAuthServiceMockDisableTotp) Return(err error) *AuthServiceMock {
+	if mmDisableTotp.mock.funcDisableTotp != nil {
+		mmDisableTotp.mock.t.Fatalf("AuthServiceMock.DisableTotp mock is already set by Set")
+	}
+
+	if mmDisableTotp.defaultExpectation == nil {
+		mmDisableTotp.defaultExpectation = &AuthServiceMockDisableTotpExpectation{mock: mmDisableTotp.mock}
+	}
+	mmDisableTotp.defaultExpectation.results = &AuthServiceMockDisableTotpResults{err}
+	mmDisableTotp.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDisableTotp.mock
+}
+
+// Set uses given function f to mock the AuthService.DisableTotp method
+func (mmDisableTotp *mAuthServiceMockDisableTotp) Set(f func(ctx context.Context, userID string, code string) (err error)) *AuthServiceMock {
+	if mmDisableTotp.defaultExpectation != nil {
+		mmDisableTotp.mock.t.Fatalf("Default expectation is already set for the AuthService.DisableTotp method")
+	}
+
+	if len(mmDisableTotp.expectations) > 0 {
+		mmDisableTotp.mock.t.Fatalf("Some expectations are already set for the AuthService.DisableTotp method")
+	}
+
+	mmDisableTotp.mock.funcDisableTotp = f
+	mmDisableTotp.mock.funcDisableTotpOrigin = minimock.CallerInfo(1)
+	return mmDisableTotp.mock
+}
+
+// When sets expectation for the AuthService.DisableTotp which will trigger the result defined by the following
+// Then helper
+func (mmDisableTotp *mAuthServiceMockDisableTotp) When(ctx context.Context, userID string, code string) *AuthServiceMockDisableTotpExpectation {
+	if mmDisableTotp.mock.funcDisableTotp != nil {
+		mmDisableTotp.mock.t.Fatalf("AuthServiceMock.DisableTotp mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockDisableTotpExpectation{
+		mock:               mmDisableTotp.mock,
+		params:             &AuthServiceMockDisableTotpParams{ctx, userID, code},
+		expectationOrigins: AuthServiceMockDisableTotpExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDisableTotp.expectations = append(mmDisableTotp.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.DisableTotp return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockDisableTotpExpectation) Then(err error) *AuthServiceMock {
+	e.results = &AuthServiceMockDisableTotpResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.DisableTotp should be invoked
+func (mmDisableTotp *mAuthServiceMockDisableTotp) Times(n uint64) *mAuthServiceMockDisableTotp {
+	if n == 0 {
+		mmDisableTotp.mock.t.Fatalf("Times of AuthServiceMock.DisableTotp mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDisableTotp.expectedInvocations, n)
+	mmDisableTotp.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDisableTotp
+}
+
+func (mmDisableTotp *mAuthServiceMockDisableTotp) invocationsDone() bool {
+	if len(mmDisableTotp.expectations) == 0 && mmDisableTotp.defaultExpectation == nil && mmDisableTotp.mock.funcDisableTotp == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDisableTotp.mock.afterDisableTotpCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDisableTotp.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DisableTotp implements mm_service.AuthService
+func (mmDisableTotp *AuthServiceMock) DisableTotp(ctx context.Context, userID string, code string) (err error) {
+	mm_atomic.AddUint64(&mmDisableTotp.beforeDisableTotpCounter, 1)
+	defer mm_atomic.AddUint64(&mmDisableTotp.afterDisableTotpCounter, 1)
+
+	mmDisableTotp.t.Helper()
+
+	if mmDisableTotp.inspectFuncDisableTotp != nil {
+		mmDisableTotp.inspectFuncDisableTotp(ctx, userID, code)
+	}
+
+	mm_params := AuthServiceMockDisableTotpParams{ctx, userID, code}
+
+	// Record call args
+	mmDisableTotp.DisableTotpMock.mutex.Lock()
+	mmDisableTotp.DisableTotpMock.callArgs = append(mmDisableTotp.DisableTotpMock.callArgs, &mm_params)
+	mmDisableTotp.DisableTotpMock.mutex.Unlock()
+
+	for _, e := range mmDisableTotp.DisableTotpMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDisableTotp.DisableTotpMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDisableTotp.DisableTotpMock.defaultExpectation.Counter, 1)
+		mm_want := mmDisableTotp.DisableTotpMock.defaultExpectation.params
+		mm_want_ptrs := mmDisableTotp.DisableTotpMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockDisableTotpParams{ctx, userID, code}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDisableTotp.t.Errorf("AuthServiceMock.DisableTotp got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDisableTotp.DisableTotpMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDisableTotp.t.Errorf("AuthServiceMock.DisableTotp got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDisableTotp.DisableTotpMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.code != nil && !minimock.Equal(*mm_want_ptrs.code, mm_got.code) {
+				mmDisableTotp.t.Errorf("AuthServiceMock.DisableTotp got unexpected parameter code, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDisableTotp.DisableTotpMock.defaultExpectation.expectationOrigins.originCode, *mm_want_ptrs.code, mm_got.code, minimock.Diff(*mm_want_ptrs.code, mm_got.code))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDisableTotp.t.Errorf("AuthServiceMock.DisableTotp got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDisableTotp.DisableTotpMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDisableTotp.DisableTotpMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDisableTotp.t.Fatal("No results are set for the AuthServiceMock.DisableTotp")
+		}
+		return (*mm_results).err
+	}
+	if mmDisableTotp.funcDisableTotp != nil {
+		return mmDisableTotp.funcDisableTotp(ctx, userID, code)
+	}
+	mmDisableTotp.t.Fatalf("Unexpected call to AuthServiceMock.DisableTotp. %v %v %v", ctx, userID, code)
+	return
+}
+
+// DisableTotpAfterCounter returns a count of finished AuthServiceMock.DisableTotp invocations
+func (mmDisableTotp *AuthServiceMock) DisableTotpAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDisableTotp.afterDisableTotpCounter)
+}
+
+// DisableTotpBeforeCounter returns a count of AuthServiceMock.DisableTotp invocations
+func (mmDisableTotp *AuthServiceMock) DisableTotpBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDisableTotp.beforeDisableTotpCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.DisableTotp.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDisableTotp *mAuthServiceMockDisableTotp) Calls() []*AuthServiceMockDisableTotpParams {
+	mmDisableTotp.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockDisableTotpParams, len(mmDisableTotp.callArgs))
+	copy(argCopy, mmDisableTotp.callArgs)
+
+	mmDisableTotp.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDisableTotpDone returns true if the count of the DisableTotp invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockDisableTotpDone() bool {
+	if m.DisableTotpMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DisableTotpMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DisableTotpMock.invocationsDone()
+}
+
+// MinimockDisableTotpInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockDisableTotpInspect() {
+	for _, e := range m.DisableTotpMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.DisableTotp at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDisableTotpCounter := mm_atomic.LoadUint64(&m.afterDisableTotpCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DisableTotpMock.defaultExpectation != nil && afterDisableTotpCounter < 1 {
+		if m.DisableTotpMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.DisableTotp at\n%s", m.DisableTotpMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.DisableTotp at\n%s with params: %#v", m.DisableTotpMock.defaultExpectation.expectationOrigins.origin, *m.DisableTotpMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDisableTotp != nil && afterDisableTotpCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.DisableTotp at\n%s", m.funcDisableTotpOrigin)
+	}
+
+	if !m.DisableTotpMock.invocationsDone() && afterDisableTotpCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.DisableTotp at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DisableTotpMock.expectedInvocations), m.DisableTotpMock.expectedInvocationsOrigin, afterDisableTotpCounter)
+	}
+}
+
+type mAuthServiceMockFinishPasskeyLogin struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockFinishPasskeyLoginExpectation
+	expectations       []*AuthServiceMockFinishPasskeyLoginExpectation
+
+	callArgs []*AuthServiceMockFinishPasskeyLoginParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockFinishPasskeyLoginExpectation specifies expectation struct of the AuthService.FinishPasskeyLogin
+type AuthServiceMockFinishPasskeyLoginExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockFinishPasskeyLoginParams
+	paramPtrs          *AuthServiceMockFinishPasskeyLoginParamPtrs
+	expectationOrigins AuthServiceMockFinishPasskeyLoginExpectationOrigins
+	results            *AuthServiceMockFinishPasskeyLoginResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockFinishPasskeyLoginParams contains parameters of the AuthService.FinishPasskeyLogin
+type AuthServiceMockFinishPasskeyLoginParams struct {
+	ctx        context.Context
+	ceremonyID string
+	credential []byte
+	client     *model.ClientInfo
+}
+
+// AuthServiceMockFinishPasskeyLoginParamPtrs contains pointers to parameters of the AuthService.FinishPasskeyLogin
+type AuthServiceMockFinishPasskeyLoginParamPtrs struct {
+	ctx        *context.Context
+	ceremonyID *string
+	credential *[]byte
+	client     **model.ClientInfo
+}
+
+// AuthServiceMockFinishPasskeyLoginResults contains results of the AuthService.FinishPasskeyLogin
+type AuthServiceMockFinishPasskeyLoginResults struct {
+	tp1 *model.TokenPair
+	err error
+}
+
+// AuthServiceMockFinishPasskeyLoginOrigins contains origins of expectations of the AuthService.FinishPasskeyLogin
+type AuthServiceMockFinishPasskeyLoginExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originCeremonyID string
+	originCredential string
+	originClient     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFinishPasskeyLogin *mAuthServiceMockFinishPasskeyLogin) Optional() *mAuthServiceMockFinishPasskeyLogin {
+	mmFinishPasskeyLogin.optional = true
+	return mmFinishPasskeyLogin
+}
+
+// Expect sets up expected params for AuthService.FinishPasskeyLogin
+func (mmFinishPasskeyLogin *mAuthServiceMockFinishPasskeyLogin) Expect(ctx context.Context, ceremonyID string, credential []byte, client *model.ClientInfo) *mAuthServiceMockFinishPasskeyLogin {
+	if mmFinishPasskeyLogin.mock.funcFinishPasskeyLogin != nil {
+		mmFinishPasskeyLogin.mock.t.Fatalf("AuthServiceMock.FinishPasskeyLogin mock is already set by Set")
+	}
+
+	if mmFinishPasskeyLogin.defaultExpectation == nil {
+		mmFinishPasskeyLogin.defaultExpectation = &AuthServiceMockFinishPasskeyLoginExpectation{}
+	}
+
+	if mmFinishPasskeyLogin.defaultExpectation.paramPtrs != nil {
+		mmFinishPasskeyLogin.mock.t.Fatalf("AuthServiceMock.FinishPasskeyLogin mock is already set by ExpectParams functions")
+	}
+
+	mmFinishPasskeyLogin.defaultExpectation.params = &AuthServiceMockFinishPasskeyLoginParams{ctx, ceremonyID, credential, client}
+	mmFinishPasskeyLogin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFinishPasskeyLogin.expectations {
+		if minimock.Equal(e.params, mmFinishPasskeyLogin.defaultExpectation.params) {
+			mmFinishPasskeyLogin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFinishPasskeyLogin.defaultExpectation.params)
+		}
+	}
+
+	return mmFinishPasskeyLogin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.FinishPasskeyLogin
+func (mmFinishPasskeyLogin *mAuthServiceMockFinishPasskeyLogin) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockFinishPasskeyLogin {
+	if mmFinishPasskeyLogin.mock.funcFinishPasskeyLogin != nil {
+		mmFinishPasskeyLogin.mock.t.Fatalf("AuthServiceMock.FinishPasskeyLogin mock is already set by Set")
+	}
+
+	if mmFinishPasskeyLogin.defaultExpectation == nil {
+		mmFinishPasskeyLogin.defaultExpectation = &AuthServiceMockFinishPasskeyLoginExpectation{}
+	}
+
+	if mmFinishPasskeyLogin.defaultExpectation.params != nil {
+		mmFinishPasskeyLogin.mock.t.Fatalf("AuthServiceMock.FinishPasskeyLogin mock is already set by Expect")
+	}
+
+	if mmFinishPasskeyLogin.defaultExpectation.paramPtrs == nil {
+		mmFinishPasskeyLogin.defaultExpectation.paramPtrs = &AuthServiceMockFinishPasskeyLoginParamPtrs{}
+	}
+	mmFinishPasskeyLogin.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFinishPasskeyLogin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFinishPasskeyLogin
+}
+
+// ExpectCeremonyIDParam2 sets up expected param ceremonyID for AuthService.FinishPasskeyLogin
+func (mmFinishPasskeyLogin *mAuthServiceMockFinishPasskeyLogin) ExpectCeremonyIDParam2(ceremonyID string) *mAuthServiceMockFinishPasskeyLogin {
+	if mmFinishPasskeyLogin.mock.funcFinishPasskeyLogin != nil {
+		mmFinishPasskeyLogin.mock.t.Fatalf("AuthServiceMock.FinishPasskeyLogin mock is already set by Set")
+	}
+
+	if mmFinishPasskeyLogin.defaultExpectation == nil {
+		mmFinishPasskeyLogin.defaultExpectation = &AuthServiceMockFinishPasskeyLoginExpectation{}
+	}
+
+	if mmFinishPasskeyLogin.defaultExpectation.params != nil {
+		mmFinishPasskeyLogin.mock.t.Fatalf("AuthServiceMock.FinishPasskeyLogin mock is already set by Expect")
+	}
+
+	if mmFinishPasskeyLogin.defaultExpectation.paramPtrs == nil {
+		mmFinishPasskeyLogin.defaultExpectation.paramPtrs = &AuthServiceMockFinishPasskeyLoginParamPtrs{}
+	}
+	mmFinishPasskeyLogin.defaultExpectation.paramPtrs.ceremonyID = &ceremonyID
+	mmFinishPasskeyLogin.defaultExpectation.expectationOrigins.originCeremonyID = minimock.CallerInfo(1)
+
+	return mmFinishPasskeyLogin
+}
+
+// ExpectCredentialParam3 sets up expected param credential for AuthService.FinishPasskeyLogin
+func (mmFinishPasskeyLogin *mAuthServiceMockFinishPasskeyLogin) ExpectCredentialParam3(credential []byte) *mAuthServiceMockFinishPasskeyLogin {
+	if mmFinishPasskeyLogin.mock.funcFinishPasskeyLogin != nil {
+		mmFinishPasskeyLogin.mock.t.Fatalf("AuthServiceMock.FinishPasskeyLogin mock is already set by Set")
+	}
+
+	if mmFinishPasskeyLogin.defaultExpectation == nil {
+		mmFinishPasskeyLogin.defaultExpectation = &AuthServiceMockFinishPasskeyLoginExpectation{}
+	}
+
+	if mmFinishPasskeyLogin.defaultExpectation.params != nil {
+		mmFinishPasskeyLogin.mock.t.Fatalf("AuthServiceMock.FinishPasskeyLogin mock is already set by Expect")
+	}
+
+	if mmFinishPasskeyLogin.defaultExpectation.paramPtrs == nil {
+		mmFinishPasskeyLogin.defaultExpectation.paramPtrs = &AuthServiceMockFinishPasskeyLoginParamPtrs{}
+	}
+	mmFinishPasskeyLogin.defaultExpectation.paramPtrs.credential = &credential
+	mmFinishPasskeyLogin.defaultExpectation.expectationOrigins.originCredential = minimock.CallerInfo(1)
+
+	return mmFinishPasskeyLogin
+}
+
+// ExpectClientParam4 sets up expected param client for AuthService.FinishPasskeyLogin
+func (mmFinishPasskeyLogin *mAuthServiceMockFinishPasskeyLogin) ExpectClientParam4(client *model.ClientInfo) *mAuthServiceMockFinishPasskeyLogin {
+	if mmFinishPasskeyLogin.mock.funcFinishPasskeyLogin != nil {
+		mmFinishPasskeyLogin.mock.t.Fatalf("AuthServiceMock.FinishPasskeyLogin mock is already set by Set")
+	}
+
+	if mmFinishPasskeyLogin.defaultExpectation == nil {
+		mmFinishPasskeyLogin.defaultExpectation = &AuthServiceMockFinishPasskeyLoginExpectation{}
+	}
+
+	if mmFinishPasskeyLogin.defaultExpectation.params != nil {
+		mmFinishPasskeyLogin.mock.t.Fatalf("AuthServiceMock.FinishPasskeyLogin mock is already set by Expect")
+	}
+
+	if mmFinishPasskeyLogin.defaultExpectation.paramPtrs == nil {
+		mmFinishPasskeyLogin.defaultExpectation.paramPtrs = &AuthServiceMockFinishPasskeyLoginParamPtrs{}
+	}
+	mmFinishPasskeyLogin.defaultExpectation.paramPtrs.client = &client
+	mmFinishPasskeyLogin.defaultExpectation.expectationOrigins.originClient = minimock.CallerInfo(1)
+
+	return mmFinishPasskeyLogin
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.FinishPasskeyLogin
+func (mmFinishPasskeyLogin *mAuthServiceMockFinishPasskeyLogin) Inspect(f func(ctx context.Context, ceremonyID string, credential []byte, client *model.ClientInfo)) *mAuthServiceMockFinishPasskeyLogin {
+	if mmFinishPasskeyLogin.mock.inspectFuncFinishPasskeyLogin != nil {
+		mmFinishPasskeyLogin.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.FinishPasskeyLogin")
+	}
+
+	mmFinishPasskeyLogin.mock.inspectFuncFinishPasskeyLogin = f
+
+	return mmFinishPasskeyLogin
+}
+
+// Return sets up results that will be returned by AuthService.FinishPasskeyLogin
+func (mmFinishPasskeyLogin *mAuthServiceMockFinishPasskeyLogin) Return(tp1 *model.TokenPair, err error) *AuthServiceMock {
+	if mmFinishPasskeyLogin.mock.funcFinishPasskeyLogin != nil {
+		mmFinishPasskeyLogin.mock.t.Fatalf("AuthServiceMock.FinishPasskeyLogin mock is already set by Set")
+	}
+
+	if mmFinishPasskeyLogin.defaultExpectation == nil {
+		mmFinishPasskeyLogin.defaultExpectation = &AuthServiceMockFinishPasskeyLoginExpectation{mock: mmFinishPasskeyLogin.mock}
+	}
+	mmFinishPasskeyLogin.defaultExpectation.results = &AuthServiceMockFinishPasskeyLoginResults{tp1, err}
+	mmFinishPasskeyLogin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFinishPasskeyLogin.mock
+}
+
+// Set uses given function f to mock the AuthService.FinishPasskeyLogin method
+func (mmFinishPasskeyLogin *mAuthServiceMockFinishPasskeyLogin) Set(f func(ctx context.Context, ceremonyID string, credential []byte, client *model.ClientInfo) (tp1 *model.TokenPair, err error)) *AuthServiceMock {
+	if mmFinishPasskeyLogin.defaultExpectation != nil {
+		mmFinishPasskeyLogin.mock.t.Fatalf("Default expectation is already set for the AuthService.FinishPasskeyLogin method")
+	}
+
+	if len(mmFinishPasskeyLogin.expectations) > 0 {
+		mmFinishPasskeyLogin.mock.t.Fatalf("Some expectations are already set for the AuthService.FinishPasskeyLogin method")
+	}
+
+	mmFinishPasskeyLogin.mock.funcFinishPasskeyLogin = f
+	mmFinishPasskeyLogin.mock.funcFinishPasskeyLoginOrigin = minimock.CallerInfo(1)
+	return mmFinishPasskeyLogin.mock
+}
+
+// When sets expectation for the AuthService.FinishPasskeyLogin which will trigger the result defined by the following
+// Then helper
+func (mmFinishPasskeyLogin *mAuthServiceMockFinishPasskeyLogin) When(ctx context.Context, ceremonyID string, credential []byte, client *model.ClientInfo) *AuthServiceMockFinishPasskeyLoginExpectation {
+	if mmFinishPasskeyLogin.mock.funcFinishPasskeyLogin != nil {
+		mmFinishPasskeyLogin.mock.t.Fatalf("AuthServiceMock.FinishPasskeyLogin mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockFinishPasskeyLoginExpectation{
+		mock:               mmFinishPasskeyLogin.mock,
+		params:             &AuthServiceMockFinishPasskeyLoginParams{ctx, ceremonyID, credential, client},
+		expectationOrigins: AuthServiceMockFinishPasskeyLoginExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFinishPasskeyLogin.expectations = append(mmFinishPasskeyLogin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.FinishPasskeyLogin return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockFinishPasskeyLoginExpectation) Then(tp1 *model.TokenPair, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockFinishPasskeyLoginResults{tp1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.FinishPasskeyLogin should be invoked
+func (mmFinishPasskeyLogin *mAuthServiceMockFinishPasskeyLogin) Times(n uint64) *mAuthServiceMockFinishPasskeyLogin {
+	if n == 0 {
+		mmFinishPasskeyLogin.mock.t.Fatalf("Times of AuthServiceMock.FinishPasskeyLogin mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFinishPasskeyLogin.expectedInvocations, n)
+	mmFinishPasskeyLogin.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFinishPasskeyLogin
+}
+
+func (mmFinishPasskeyLogin *mAuthServiceMockFinishPasskeyLogin) invocationsDone() bool {
+	if len(mmFinishPasskeyLogin.expectations) == 0 && mmFinishPasskeyLogin.defaultExpectation == nil && mmFinishPasskeyLogin.mock.funcFinishPasskeyLogin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFinishPasskeyLogin.mock.afterFinishPasskeyLoginCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFinishPasskeyLogin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FinishPasskeyLogin implements mm_service.AuthService
+func (mmFinishPasskeyLogin *AuthServiceMock) FinishPasskeyLogin(ctx context.Context, ceremonyID string, credential []byte, client *model.ClientInfo) (tp1 *model.TokenPair, err error) {
+	mm_atomic.AddUint64(&mmFinishPasskeyLogin.beforeFinishPasskeyLoginCounter, 1)
+	defer mm_atomic.AddUint64(&mmFinishPasskeyLogin.afterFinishPasskeyLoginCounter, 1)
+
+	mmFinishPasskeyLogin.t.Helper()
+
+	if mmFinishPasskeyLogin.inspectFuncFinishPasskeyLogin != nil {
+		mmFinishPasskeyLogin.inspectFuncFinishPasskeyLogin(ctx, ceremonyID, credential, client)
+	}
+
+	mm_params := AuthServiceMockFinishPasskeyLoginParams{ctx, ceremonyID, credential, client}
+
+	// Record call args
+	mmFinishPasskeyLogin.FinishPasskeyLoginMock.mutex.Lock()
+	mmFinishPasskeyLogin.FinishPasskeyLoginMock.callArgs = append(mmFinishPasskeyLogin.FinishPasskeyLoginMock.callArgs, &mm_params)
+	mmFinishPasskeyLogin.FinishPasskeyLoginMock.mutex.Unlock()
+
+	for _, e := range mmFinishPasskeyLogin.FinishPasskeyLoginMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tp1, e.results.err
+		}
+	}
+
+	if mmFinishPasskeyLogin.FinishPasskeyLoginMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFinishPasskeyLogin.FinishPasskeyLoginMock.defaultExpectation.Counter, 1)
+		mm_want := mmFinishPasskeyLogin.FinishPasskeyLoginMock.defaultExpectation.params
+		mm_want_ptrs := mmFinishPasskeyLogin.FinishPasskeyLoginMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockFinishPasskeyLoginParams{ctx, ceremonyID, credential, client}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFinishPasskeyLogin.t.Errorf("AuthServiceMock.FinishPasskeyLogin got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinishPasskeyLogin.FinishPasskeyLoginMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ceremonyID != nil && !minimock.Equal(*mm_want_ptrs.ceremonyID, mm_got.ceremonyID) {
+				mmFinishPasskeyLogin.t.Errorf("AuthServiceMock.FinishPasskeyLogin got unexpected parameter ceremonyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinishPasskeyLogin.FinishPasskeyLoginMock.defaultExpectation.expectationOrigins.originCeremonyID, *mm_want_ptrs.ceremonyID, mm_got.ceremonyID, minimock.Diff(*mm_want_ptrs.ceremonyID, mm_got.ceremonyID))
+			}
+
+			if mm_want_ptrs.credential != nil && !minimock.Equal(*mm_want_ptrs.credential, mm_got.credential) {
+				mmFinishPasskeyLogin.t.Errorf("AuthServiceMock.FinishPasskeyLogin got unexpected parameter credential, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinishPasskeyLogin.FinishPasskeyLoginMock.defaultExpectation.expectationOrigins.originCredential, *mm_want_ptrs.credential, mm_got.credential, minimock.Diff(*mm_want_ptrs.credential, mm_got.credential))
+			}
+
+			if mm_want_ptrs.client != nil && !minimock.Equal(*mm_want_ptrs.client, mm_got.client) {
+				mmFinishPasskeyLogin.t.Errorf("AuthServiceMock.FinishPasskeyLogin got unexpected parameter client, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinishPasskeyLogin.FinishPasskeyLoginMock.defaultExpectation.expectationOrigins.originClient, *mm_want_ptrs.client, mm_got.client, minimock.Diff(*mm_want_ptrs.client, mm_got.client))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFinishPasskeyLogin.t.Errorf("AuthServiceMock.FinishPasskeyLogin got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFinishPasskeyLogin.FinishPasskeyLoginMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFinishPasskeyLogin.FinishPasskeyLoginMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFinishPasskeyLogin.t.Fatal("No results are set for the AuthServiceMock.FinishPasskeyLogin")
+		}
+		return (*mm_results).tp1, (*mm_results).err
+	}
+	if mmFinishPasskeyLogin.funcFinishPasskeyLogin != nil {
+		return mmFinishPasskeyLogin.funcFinishPasskeyLogin(ctx, ceremonyID, credential, client)
+	}
+	mmFinishPasskeyLogin.t.Fatalf("Unexpected call to AuthServiceMock.FinishPasskeyLogin. %v %v %v %v", ctx, ceremonyID, credential, client)
+	return
+}
+
+// FinishPasskeyLoginAfterCounter returns a count of finished AuthServiceMock.FinishPasskeyLogin invocations
+func (mmFinishPasskeyLogin *AuthServiceMock) FinishPasskeyLoginAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFinishPasskeyLogin.afterFinishPasskeyLoginCounter)
+}
+
+// FinishPasskeyLoginBeforeCounter returns a count of AuthServiceMock.FinishPasskeyLogin invocations
+func (mmFinishPasskeyLogin *AuthServiceMock) FinishPasskeyLoginBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFinishPasskeyLogin.beforeFinishPasskeyLoginCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.FinishPasskeyLogin.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFinishPasskeyLogin *mAuthServiceMockFinishPasskeyLogin) Calls() []*AuthServiceMockFinishPasskeyLoginParams {
+	mmFinishPasskeyLogin.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockFinishPasskeyLoginParams, len(mmFinishPasskeyLogin.callArgs))
+	copy(argCopy, mmFinishPasskeyLogin.callArgs)
+
+	mmFinishPasskeyLogin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFinishPasskeyLoginDone returns true if the count of the FinishPasskeyLogin invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockFinishPasskeyLoginDone() bool {
+	if m.FinishPasskeyLoginMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FinishPasskeyLoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FinishPasskeyLoginMock.invocationsDone()
+}
+
+// MinimockFinishPasskeyLoginInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockFinishPasskeyLoginInspect() {
+	for _, e := range m.FinishPasskeyLoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.FinishPasskeyLogin at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFinishPasskeyLoginCounter := mm_atomic.LoadUint64(&m.afterFinishPasskeyLoginCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FinishPasskeyLoginMock.defaultExpectation != nil && afterFinishPasskeyLoginCounter < 1 {
+		if m.FinishPasskeyLoginMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.FinishPasskeyLogin at\n%s", m.FinishPasskeyLoginMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.FinishPasskeyLogin at\n%s with params: %#v", m.FinishPasskeyLoginMock.defaultExpectation.expectationOrigins.origin, *m.FinishPasskeyLoginMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFinishPasskeyLogin != nil && afterFinishPasskeyLoginCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.FinishPasskeyLogin at\n%s", m.funcFinishPasskeyLoginOrigin)
+	}
+
+	if !m.FinishPasskeyLoginMock.invocationsDone() && afterFinishPasskeyLoginCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.FinishPasskeyLogin at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FinishPasskeyLoginMock.expectedInvocations), m.FinishPasskeyLoginMock.expectedInvocationsOrigin, afterFinishPasskeyLoginCounter)
+	}
+}
+
+type mAuthServiceMockFinishPasskeyRegistration struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockFinishPasskeyRegistrationExpectation
+	expectations       []*AuthServiceMockFinishPasskeyRegistrationExpectation
+
+	callArgs []*AuthServiceMockFinishPasskeyRegistrationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockFinishPasskeyRegistrationExpectation specifies expectation struct of the AuthService.FinishPasskeyRegistration
+type AuthServiceMockFinishPasskeyRegistrationExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockFinishPasskeyRegistrationParams
+	paramPtrs          *AuthServiceMockFinishPasskeyRegistrationParamPtrs
+	expectationOrigins AuthServiceMockFinishPasskeyRegistrationExpectationOrigins
+	results            *AuthServiceMockFinishPasskeyRegistrationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockFinishPasskeyRegistrationParams contains parameters of the AuthService.FinishPasskeyRegistration
+type AuthServiceMockFinishPasskeyRegistrationParams struct {
+	ctx        context.Context
+	userID     string
+	ceremonyID string
+	credential []byte
+}
+
+// AuthServiceMockFinishPasskeyRegistrationParamPtrs contains pointers to parameters of the AuthService.FinishPasskeyRegistration
+type AuthServiceMockFinishPasskeyRegistrationParamPtrs struct {
+	ctx        *context.Context
+	userID     *string
+	ceremonyID *string
+	credential *[]byte
+}
+
+// AuthServiceMockFinishPasskeyRegistrationResults contains results of the AuthService.FinishPasskeyRegistration
+type AuthServiceMockFinishPasskeyRegistrationResults struct {
+	err error
+}
+
+// AuthServiceMockFinishPasskeyRegistrationOrigins contains origins of expectations of the AuthService.FinishPasskeyRegistration
+type AuthServiceMockFinishPasskeyRegistrationExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUserID     string
+	originCeremonyID string
+	originCredential string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFinishPasskeyRegistration *mAuthServiceMockFinishPasskeyRegistration) Optional() *mAuthServiceMockFinishPasskeyRegistration {
+	mmFinishPasskeyRegistration.optional = true
+	return mmFinishPasskeyRegistration
+}
+
+// Expect sets up expected params for AuthService.FinishPasskeyRegistration
+func (mmFinishPasskeyRegistration *mAuthServiceMockFinishPasskeyRegistration) Expect(ctx context.Context, userID string, ceremonyID string, credential []byte) *mAuthServiceMockFinishPasskeyRegistration {
+	if mmFinishPasskeyRegistration.mock.funcFinishPasskeyRegistration != nil {
+		mmFinishPasskeyRegistration.mock.t.Fatalf("AuthServiceMock.FinishPasskeyRegistration mock is already set by Set")
+	}
+
+	if mmFinishPasskeyRegistration.defaultExpectation == nil {
+		mmFinishPasskeyRegistration.defaultExpectation = &AuthServiceMockFinishPasskeyRegistrationExpectation{}
+	}
+
+	if mmFinishPasskeyRegistration.defaultExpectation.paramPtrs != nil {
+		mmFinishPasskeyRegistration.mock.t.Fatalf("AuthServiceMock.FinishPasskeyRegistration mock is already set by ExpectParams functions")
+	}
+
+	mmFinishPasskeyRegistration.defaultExpectation.params = &AuthServiceMockFinishPasskeyRegistrationParams{ctx, userID, ceremonyID, credential}
+	mmFinishPasskeyRegistration.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFinishPasskeyRegistration.expectations {
+		if minimock.Equal(e.params, mmFinishPasskeyRegistration.defaultExpectation.params) {
+			mmFinishPasskeyRegistration.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFinishPasskeyRegistration.defaultExpectation.params)
+		}
+	}
+
+	return mmFinishPasskeyRegistration
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.FinishPasskeyRegistration
+func (mmFinishPasskeyRegistration *mAuthServiceMockFinishPasskeyRegistration) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockFinishPasskeyRegistration {
+	if mmFinishPasskeyRegistration.mock.funcFinishPasskeyRegistration != nil {
+		mmFinishPasskeyRegistration.mock.t.Fatalf("AuthServiceMock.FinishPasskeyRegistration mock is already set by Set")
+	}
+
+	if mmFinishPasskeyRegistration.defaultExpectation == nil {
+		mmFinishPasskeyRegistration.defaultExpectation = &AuthServiceMockFinishPasskeyRegistrationExpectation{}
+	}
+
+	if mmFinishPasskeyRegistration.defaultExpectation.params != nil {
+		mmFinishPasskeyRegistration.mock.t.Fatalf("AuthServiceMock.FinishPasskeyRegistration mock is already set by Expect")
+	}
+
+	if mmFinishPasskeyRegistration.defaultExpectation.paramPtrs == nil {
+		mmFinishPasskeyRegistration.defaultExpectation.paramPtrs = &AuthServiceMockFinishPasskeyRegistrationParamPtrs{}
+	}
+	mmFinishPasskeyRegistration.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFinishPasskeyRegistration.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFinishPasskeyRegistration
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthService.FinishPasskeyRegistration
+func (mmFinishPasskeyRegistration *mAuthServiceMockFinishPasskeyRegistration) ExpectUserIDParam2(userID string) *mAuthServiceMockFinishPasskeyRegistration {
+	if mmFinishPasskeyRegistration.mock.funcFinishPasskeyRegistration != nil {
+		mmFinishPasskeyRegistration.mock.t.Fatalf("AuthServiceMock.FinishPasskeyRegistration mock is already set by Set")
+	}
+
+	if mmFinishPasskeyRegistration.defaultExpectation == nil {
+		mmFinishPasskeyRegistration.defaultExpectation = &AuthServiceMockFinishPasskeyRegistrationExpectation{}
+	}
+
+	if mmFinishPasskeyRegistration.defaultExpectation.params != nil {
+		mmFinishPasskeyRegistration.mock.t.Fatalf("AuthServiceMock.FinishPasskeyRegistration mock is already set by Expect")
+	}
+
+	if mmFinishPasskeyRegistration.defaultExpectation.paramPtrs == nil {
+		mmFinishPasskeyRegistration.defaultExpectation.paramPtrs = &AuthServiceMockFinishPasskeyRegistrationParamPtrs{}
+	}
+	mmFinishPasskeyRegistration.defaultExpectation.paramPtrs.userID = &userID
+	mmFinishPasskeyRegistration.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmFinishPasskeyRegistration
+}
+
+// ExpectCeremonyIDParam3 sets up expected param ceremonyID for AuthService.FinishPasskeyRegistration
+func (mmFinishPasskeyRegistration *mAuthServiceMockFinishPasskeyRegistration) ExpectCeremonyIDParam3(ceremonyID string) *mAuthServiceMockFinishPasskeyRegistration {
+	if mmFinishPasskeyRegistration.mock.funcFinishPasskeyRegistration != nil {
+		mmFinishPasskeyRegistration.mock.t.Fatalf("AuthServiceMock.FinishPasskeyRegistration mock is already set by Set")
+	}
+
+	if mmFinishPasskeyRegistration.defaultExpectation == nil {
+		mmFinishPasskeyRegistration.defaultExpectation = &AuthServiceMockFinishPasskeyRegistrationExpectation{}
+	}
+
+	if mmFinishPasskeyRegistration.defaultExpectation.params != nil {
+		mmFinishPasskeyRegistration.mock.t.Fatalf("AuthServiceMock.FinishPasskeyRegistration mock is already set by Expect")
+	}
+
+	if mmFinishPasskeyRegistration.defaultExpectation.paramPtrs == nil {
+		mmFinishPasskeyRegistration.defaultExpectation.paramPtrs = &AuthServiceMockFinishPasskeyRegistrationParamPtrs{}
+	}
+	mmFinishPasskeyRegistration.defaultExpectation.paramPtrs.ceremonyID = &ceremonyID
+	mmFinishPasskeyRegistration.defaultExpectation.expectationOrigins.originCeremonyID = minimock.CallerInfo(1)
+
+	return mmFinishPasskeyRegistration
+}
+
+// ExpectCredentialParam4 sets up expected param credential for AuthService.FinishPasskeyRegistration
+func (mmFinishPasskeyRegistration *mAuthServiceMockFinishPasskeyRegistration) ExpectCredentialParam4(credential []byte) *mAuthServiceMockFinishPasskeyRegistration {
+	if mmFinishPasskeyRegistration.mock.funcFinishPasskeyRegistration != nil {
+		mmFinishPasskeyRegistration.mock.t.Fatalf("AuthServiceMock.FinishPasskeyRegistration mock is already set by Set")
+	}
+
+	if mmFinishPasskeyRegistration.defaultExpectation == nil {
+		mmFinishPasskeyRegistration.defaultExpectation = &AuthServiceMockFinishPasskeyRegistrationExpectation{}
+	}
+
+	if mmFinishPasskeyRegistration.defaultExpectation.params != nil {
+		mmFinishPasskeyRegistration.mock.t.Fatalf("AuthServiceMock.FinishPasskeyRegistration mock is already set by Expect")
+	}
+
+	if mmFinishPasskeyRegistration.defaultExpectation.paramPtrs == nil {
+		mmFinishPasskeyRegistration.defaultExpectation.paramPtrs = &AuthServiceMockFinishPasskeyRegistrationParamPtrs{}
+	}
+	mmFinishPasskeyRegistration.defaultExpectation.paramPtrs.credential = &credential
+	mmFinishPasskeyRegistration.defaultExpectation.expectationOrigins.originCredential = minimock.CallerInfo(1)
+
+	return mmFinishPasskeyRegistration
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.FinishPasskeyRegistration
+func (mmFinishPasskeyRegistration *mAuthServiceMockFinishPasskeyRegistration) Inspect(f func(ctx context.Context, userID string, ceremonyID string, credential []byte)) *mAuthServiceMockFinishPasskeyRegistration {
+	if mmFinishPasskeyRegistration.mock.inspectFuncFinishPasskeyRegistration != nil {
+		mmFinishPasskeyRegistration.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.FinishPasskeyRegistration")
+	}
+
+	mmFinishPasskeyRegistration.mock.inspectFuncFinishPasskeyRegistration = f
+
+	return mmFinishPasskeyRegistration
+}
+
+// Return sets up results that will be returned by AuthService.FinishPasskeyRegistration
+func (mmFinishPasskeyRegistration *mAuthServiceMockFinishPasskeyRegistration) Return(err error) *AuthServiceMock {
+	if mmFinishPasskeyRegistration.mock.funcFinishPasskeyRegistration != nil {
+		mmFinishPasskeyRegistration.mock.t.Fatalf("AuthServiceMock.FinishPasskeyRegistration mock is already set by Set")
+	}
+
+	if mmFinishPasskeyRegistration.defaultExpectation == nil {
+		mmFinishPasskeyRegistration.defaultExpectation = &AuthServiceMockFinishPasskeyRegistrationExpectation{mock: mmFinishPasskeyRegistration.mock}
+	}
+	mmFinishPasskeyRegistration.defaultExpectation.results = &AuthServiceMockFinishPasskeyRegistrationResults{err}
+	mmFinishPasskeyRegistration.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFinishPasskeyRegistration.mock
+}
+
+// Set uses given function f to mock the AuthService.FinishPasskeyRegistration method
+func (mmFinishPasskeyRegistration *mAuthServiceMockFinishPasskeyRegistration) Set(f func(ctx context.Context, userID string, ceremonyID string, credential []byte) (err error)) *AuthServiceMock {
+	if mmFinishPasskeyRegistration.defaultExpectation != nil {
+		mmFinishPasskeyRegistration.mock.t.Fatalf("Default expectation is already set for the AuthService.FinishPasskeyRegistration method")
+	}
+
+	if len(mmFinishPasskeyRegistration.expectations) > 0 {
+		mmFinishPasskeyRegistration.mock.t.Fatalf("Some expectations are already set for the AuthService.FinishPasskeyRegistration method")
+	}
+
+	mmFinishPasskeyRegistration.mock.funcFinishPasskeyRegistration = f
+	mmFinishPasskeyRegistration.mock.funcFinishPasskeyRegistrationOrigin = minimock.CallerInfo(1)
+	return mmFinishPasskeyRegistration.mock
+}
+
+// When sets expectation for the AuthService.FinishPasskeyRegistration which will trigger the result defined by the following
+// Then helper
+func (mmFinishPasskeyRegistration *mAuthServiceMockFinishPasskeyRegistration) When(ctx context.Context, userID string, ceremonyID string, credential []byte) *AuthServiceMockFinishPasskeyRegistrationExpectation {
+	if mmFinishPasskeyRegistration.mock.funcFinishPasskeyRegistration != nil {
+		mmFinishPasskeyRegistration.mock.t.Fatalf("AuthServiceMock.FinishPasskeyRegistration mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockFinishPasskeyRegistrationExpectation{
+		mock:               mmFinishPasskeyRegistration.mock,
+		params:             &AuthServiceMockFinishPasskeyRegistrationParams{ctx, userID, ceremonyID, credential},
+		expectationOrigins: AuthServiceMockFinishPasskeyRegistrationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFinishPasskeyRegistration.expectations = append(mmFinishPasskeyRegistration.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.FinishPasskeyRegistration return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockFinishPasskeyRegistrationExpectation) Then(err error) *AuthServiceMock {
+	e.results = &AuthServiceMockFinishPasskeyRegistrationResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.FinishPasskeyRegistration should be invoked
+func (mmFinishPasskeyRegistration *mAuthServiceMockFinishPasskeyRegistration) Times(n uint64) *mAuthServiceMockFinishPasskeyRegistration {
+	if n == 0 {
+		mmFinishPasskeyRegistration.mock.t.Fatalf("Times of AuthServiceMock.FinishPasskeyRegistration mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFinishPasskeyRegistration.expectedInvocations, n)
+	mmFinishPasskeyRegistration.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFinishPasskeyRegistration
+}
+
+func (mmFinishPasskeyRegistration *mAuthServiceMockFinishPasskeyRegistration) invocationsDone() bool {
+	if len(mmFinishPasskeyRegistration.expectations) == 0 && mmFinishPasskeyRegistration.defaultExpectation == nil && mmFinishPasskeyRegistration.mock.funcFinishPasskeyRegistration == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFinishPasskeyRegistration.mock.afterFinishPasskeyRegistrationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFinishPasskeyRegistration.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FinishPasskeyRegistration implements mm_service.AuthService
+func (mmFinishPasskeyRegistration *AuthServiceMock) FinishPasskeyRegistration(ctx context.Context, userID string, ceremonyID string, credential []byte) (err error) {
+	mm_atomic.AddUint64(&mmFinishPasskeyRegistration.beforeFinishPasskeyRegistrationCounter, 1)
+	defer mm_atomic.AddUint64(&mmFinishPasskeyRegistration.afterFinishPasskeyRegistrationCounter, 1)
+
+	mmFinishPasskeyRegistration.t.Helper()
+
+	if mmFinishPasskeyRegistration.inspectFuncFinishPasskeyRegistration != nil {
+		mmFinishPasskeyRegistration.inspectFuncFinishPasskeyRegistration(ctx, userID, ceremonyID, credential)
+	}
+
+	mm_params := AuthServiceMockFinishPasskeyRegistrationParams{ctx, userID, ceremonyID, credential}
+
+	// Record call args
+	mmFinishPasskeyRegistration.FinishPasskeyRegistrationMock.mutex.Lock()
+	mmFinishPasskeyRegistration.FinishPasskeyRegistrationMock.callArgs = append(mmFinishPasskeyRegistration.FinishPasskeyRegistrationMock.callArgs, &mm_params)
+	mmFinishPasskeyRegistration.FinishPasskeyRegistrationMock.mutex.Unlock()
+
+	for _, e := range mmFinishPasskeyRegistration.FinishPasskeyRegistrationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmFinishPasskeyRegistration.FinishPasskeyRegistrationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFinishPasskeyRegistration.FinishPasskeyRegistrationMock.defaultExpectation.Counter, 1)
+		mm_want := mmFinishPasskeyRegistration.FinishPasskeyRegistrationMock.defaultExpectation.params
+		mm_want_ptrs := mmFinishPasskeyRegistration.FinishPasskeyRegistrationMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockFinishPasskeyRegistrationParams{ctx, userID, ceremonyID, credential}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFinishPasskeyRegistration.t.Errorf("AuthServiceMock.FinishPasskeyRegistration got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinishPasskeyRegistration.FinishPasskeyRegistrationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmFinishPasskeyRegistration.t.Errorf("AuthServiceMock.FinishPasskeyRegistration got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinishPasskeyRegistration.FinishPasskeyRegistrationMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.ceremonyID != nil && !minimock.Equal(*mm_want_ptrs.ceremonyID, mm_got.ceremonyID) {
+				mmFinishPasskeyRegistration.t.Errorf("AuthServiceMock.FinishPasskeyRegistration got unexpected parameter ceremonyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinishPasskeyRegistration.FinishPasskeyRegistrationMock.defaultExpectation.expectationOrigins.originCeremonyID, *mm_want_ptrs.ceremonyID, mm_got.ceremonyID, minimock.Diff(*mm_want_ptrs.ceremonyID, mm_got.ceremonyID))
+			}
+
+			if mm_want_ptrs.credential != nil && !minimock.Equal(*mm_want_ptrs.credential, mm_got.credential) {
+				mmFinishPasskeyRegistration.t.Errorf("AuthServiceMock.FinishPasskeyRegistration got unexpected parameter credential, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinishPasskeyRegistration.FinishPasskeyRegistrationMock.defaultExpectation.expectationOrigins.originCredential, *mm_want_ptrs.credential, mm_got.credential, minimock.Diff(*mm_want_ptrs.credential, mm_got.credential))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFinishPasskeyRegistration.t.Errorf("AuthServiceMock.FinishPasskeyRegistration got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFinishPasskeyRegistration.FinishPasskeyRegistrationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFinishPasskeyRegistration.FinishPasskeyRegistrationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFinishPasskeyRegistration.t.Fatal("No results are set for the AuthServiceMock.FinishPasskeyRegistration")
+		}
+		return (*mm_results).err
+	}
+	if mmFinishPasskeyRegistration.funcFinishPasskeyRegistration != nil {
+		return mmFinishPasskeyRegistration.funcFinishPasskeyRegistration(ctx, userID, ceremonyID, credential)
+	}
+	mmFinishPasskeyRegistration.t.Fatalf("Unexpected call to AuthServiceMock.FinishPasskeyRegistration. %v %v %v %v", ctx, userID, ceremonyID, credential)
+	return
+}
+
+// FinishPasskeyRegistrationAfterCounter returns a count of finished AuthServiceMock.FinishPasskeyRegistration invocations
+func (mmFinishPasskeyRegistration *AuthServiceMock) FinishPasskeyRegistrationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFinishPasskeyRegistration.afterFinishPasskeyRegistrationCounter)
+}
+
+// FinishPasskeyRegistrationBeforeCounter returns a count of AuthServiceMock.FinishPasskeyRegistration invocations
+func (mmFinishPasskeyRegistration *AuthServiceMock) FinishPasskeyRegistrationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFinishPasskeyRegistration.beforeFinishPasskeyRegistrationCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.FinishPasskeyRegistration.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFinishPasskeyRegistration *mAuthServiceMockFinishPasskeyRegistration) Calls() []*AuthServiceMockFinishPasskeyRegistrationParams {
+	mmFinishPasskeyRegistration.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockFinishPasskeyRegistrationParams, len(mmFinishPasskeyRegistration.callArgs))
+	copy(argCopy, mmFinishPasskeyRegistration.callArgs)
+
+	mmFinishPasskeyRegistration.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFinishPasskeyRegistrationDone returns true if the count of the FinishPasskeyRegistration invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockFinishPasskeyRegistrationDone() bool {
+	if m.FinishPasskeyRegistrationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FinishPasskeyRegistrationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FinishPasskeyRegistrationMock.invocationsDone()
+}
+
+// MinimockFinishPasskeyRegistrationInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockFinishPasskeyRegistrationInspect() {
+	for _, e := range m.FinishPasskeyRegistrationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.FinishPasskeyRegistration at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFinishPasskeyRegistrationCounter := mm_atomic.LoadUint64(&m.afterFinishPasskeyRegistrationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FinishPasskeyRegistrationMock.defaultExpectation != nil && afterFinishPasskeyRegistrationCounter < 1 {
+		if m.FinishPasskeyRegistrationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.FinishPasskeyRegistration at\n%s", m.FinishPasskeyRegistrationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.FinishPasskeyRegistration at\n%s with params: %#v", m.FinishPasskeyRegistrationMock.defaultExpectation.expectationOrigins.origin, *m.FinishPasskeyRegistrationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFinishPasskeyRegistration != nil && afterFinishPasskeyRegistrationCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.FinishPasskeyRegistration at\n%s", m.funcFinishPasskeyRegistrationOrigin)
+	}
+
+	if !m.FinishPasskeyRegistrationMock.invocationsDone() && afterFinishPasskeyRegistrationCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.FinishPasskeyRegistration at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FinishPasskeyRegistrationMock.expectedInvocations), m.FinishPasskeyRegistrationMock.expectedInvocationsOrigin, afterFinishPasskeyRegistrationCounter)
+	}
+}
+
+type mAuthServiceMockGetAccessToken struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockGetAccessTokenExpectation
+	expectations       []*AuthServiceMockGetAccessTokenExpectation
+
+	callArgs []*AuthServiceMockGetAccessTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockGetAccessTokenExpectation specifies expectation struct of the AuthService.GetAccessToken
+type AuthServiceMockGetAccessTokenExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockGetAccessTokenParams
+	paramPtrs          *AuthServiceMockGetAccessTokenParamPtrs
+	expectationOrigins AuthServiceMockGetAccessTokenExpectationOrigins
+	results            *AuthServiceMockGetAccessTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockGetAccessTokenParams contains parameters of the AuthService.GetAccessToken
+type AuthServiceMockGetAccessTokenParams struct {
+	ctx          context.Context
+	refreshToken string
+}
+
+// AuthServiceMockGetAccessTokenParamPtrs contains pointers to parameters of the AuthService.GetAccessToken
+type AuthServiceMockGetAccessTokenParamPtrs struct {
+	ctx          *context.Context
+	refreshToken *string
+}
+
+// AuthServiceMockGetAccessTokenResults contains results of the AuthService.GetAccessToken
+type AuthServiceMockGetAccessTokenResults struct {
+	s1  string
+	err error
+}
+
+// AuthServiceMockGetAccessTokenOrigins contains origins of expectations of the AuthService.GetAccessToken
+type AuthServiceMockGetAccessTokenExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originRefreshToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Optional() *mAuthServiceMockGetAccessToken {
+	mmGetAccessToken.optional = true
+	return mmGetAccessToken
+}
+
+// Expect sets up expected params for AuthService.GetAccessToken
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Expect(ctx context.Context, refreshToken string) *mAuthServiceMockGetAccessToken {
+	if mmGetAccessToken.mock.funcGetAccessToken != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Set")
+	}
+
+	if mmGetAccessToken.defaultExpectation == nil {
+		mmGetAccessToken.defaultExpectation = &AuthServiceMockGetAccessTokenExpectation{}
+	}
+
+	if mmGetAccessToken.defaultExpectation.paramPtrs != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by ExpectParams functions")
+	}
+
+	mmGetAccessToken.defaultExpectation.params = &AuthServiceMockGetAccessTokenParams{ctx, refreshToken}
+	mmGetAccessToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAccessToken.expectations {
+		if minimock.Equal(e.params, mmGetAccessToken.defaultExpectation.params) {
+			mmGetAccessToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAccessToken.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAccessToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.GetAccessToken
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockGetAccessToken {
+	if mmGetAccessToken.mock.funcGetAccessToken != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Set")
+	}
+
+	if mmGetAccessToken.defaultExpectation == nil {
+		mmGetAccessToken.defaultExpectation = &AuthServiceMockGetAccessTokenExpectation{}
+	}
+
+	if mmGetAccessToken.defaultExpectation.params != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Expect")
+	}
+
+	if mmGetAccessToken.defaultExpectation.paramPtrs == nil {
+		mmGetAccessToken.defaultExpectation.paramPtrs = &AuthServiceMockGetAccessTokenParamPtrs{}
+	}
+	mmGetAccessToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAccessToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAccessToken
+}
+
+// ExpectRefreshTokenParam2 sets up expected param refreshToken for AuthService.GetAccessToken
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) ExpectRefreshTokenParam2(refreshToken string) *mAuthServiceMockGetAccessToken {
+	if mmGetAccessToken.mock.funcGetAccessToken != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Set")
+	}
+
+	if mmGetAccessToken.defaultExpectation == nil {
+		mmGetAccessToken.defaultExpectation = &AuthServiceMockGetAccessTokenExpectation{}
+	}
+
+	if mmGetAccessToken.defaultExpectation.params != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Expect")
+	}
+
+	if mmGetAccessToken.defaultExpectation.paramPtrs == nil {
+		mmGetAccessToken.defaultExpectation.paramPtrs = &AuthServiceMockGetAccessTokenParamPtrs{}
+	}
+	mmGetAccessToken.defaultExpectation.paramPtrs.refreshToken = &refreshToken
+	mmGetAccessToken.defaultExpectation.expectationOrigins.originRefreshToken = minimock.CallerInfo(1)
+
+	return mmGetAccessToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.GetAccessToken
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Inspect(f func(ctx context.Context, refreshToken string)) *mAuthServiceMockGetAccessToken {
+	if mmGetAccessToken.mock.inspectFuncGetAccessToken != nil {
+		mmGetAccessToken.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.GetAccessToken")
+	}
+
+	mmGetAccessToken.mock.inspectFuncGetAccessToken = f
+
+	return mmGetAccessToken
+}
+
+// Return sets up results that will be returned by AuthService.GetAccessToken
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Return(s1 string, err error) *AuthServiceMock {
+	if mmGetAccessToken.mock.funcGetAccessToken != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Set")
+	}
+
+	if mmGetAccessToken.defaultExpectation == nil {
+		mmGetAccessToken.defaultExpectation = &AuthServiceMockGetAccessTokenExpectation{mock: mmGetAccessToken.mock}
+	}
+	mmGetAccessToken.defaultExpectation.results = &AuthServiceMockGetAccessTokenResults{s1, err}
+	mmGetAccessToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAccessToken.mock
+}
+
+// Set uses given function f to mock the AuthService.GetAccessToken method
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Set(f func(ctx context.Context, refreshToken string) (s1 string, err error)) *AuthServiceMock {
+	if mmGetAccessToken.defaultExpectation != nil {
+		mmGetAccessToken.mock.t.Fatalf("Default expectation is already set for the AuthService.GetAccessToken method")
+	}
+
+	if len(mmGetAccessToken.expectations) > 0 {
+		mmGetAccessToken.mock.t.Fatalf("Some expectations are already set for the AuthService.GetAccessToken method")
+	}
+
+	mmGetAccessToken.mock.funcGetAccessToken = f
+	mmGetAccessToken.mock.funcGetAccessTokenOrigin = minimock.CallerInfo(1)
+	return mmGetAccessToken.mock
+}
+
+// When sets expectation for the AuthService.GetAccessToken which will trigger the result defined by the following
+// Then helper
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) When(ctx context.Context, refreshToken string) *AuthServiceMockGetAccessTokenExpectation {
+	if mmGetAccessToken.mock.funcGetAccessToken != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockGetAccessTokenExpectation{
+		mock:               mmGetAccessToken.mock,
+		params:             &AuthServiceMockGetAccessTokenParams{ctx, refreshToken},
+		expectationOrigins: AuthServiceMockGetAccessTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAccessToken.expectations = append(mmGetAccessToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.GetAccessToken return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockGetAccessTokenExpectation) Then(s1 string, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockGetAccessTokenResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.GetAccessToken should be invoked
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Times(n uint64) *mAuthServiceMockGetAccessToken {
+	if n == 0 {
+		mmGetAccessToken.mock.t.Fatalf("Times of AuthServiceMock.GetAccessToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAccessToken.expectedInvocations, n)
+	mmGetAccessToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAccessToken
+}
+
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) invocationsDone() bool {
+	if len(mmGetAccessToken.expectations) == 0 && mmGetAccessToken.defaultExpectation == nil && mmGetAccessToken.mock.funcGetAccessToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAccessToken.mock.afterGetAccessTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAccessToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAccessToken implements mm_service.AuthService
+func (mmGetAccessToken *AuthServiceMock) GetAccessToken(ctx context.Context, refreshToken string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmGetAccessToken.beforeGetAccessTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAccessToken.afterGetAccessTokenCounter, 1)
+
+	mmGetAccessToken.t.Helper()
+
+	if mmGetAccessToken.inspectFuncGetAccessToken != nil {
+		mmGetAccessToken.inspectFuncGetAccessToken(ctx, refreshToken)
+	}
+
+	mm_params := AuthServiceMockGetAccessTokenParams{ctx, refreshToken}
+
+	// Record call args
+	mmGetAccessToken.GetAccessTokenMock.mutex.Lock()
+	mmGetAccessToken.GetAccessTokenMock.callArgs = append(mmGetAccessToken.GetAccessTokenMock.callArgs, &mm_params)
+	mmGetAccessToken.GetAccessTokenMock.mutex.Unlock()
+
+	for _, e := range mmGetAccessToken.GetAccessTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetAccessToken.GetAccessTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAccessToken.GetAccessTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAccessToken.GetAccessTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAccessToken.GetAccessTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockGetAccessTokenParams{ctx, refreshToken}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAccessToken.t.Errorf("AuthServiceMock.GetAccessToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAccessToken.GetAccessTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.refreshToken != nil && !minimock.Equal(*mm_want_ptrs.refreshToken, mm_got.refreshToken) {
+				mmGetAccessToken.t.Errorf("AuthServiceMock.GetAccessToken got unexpected parameter refreshToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAccessToken.GetAccessTokenMock.defaultExpectation.expectationOrigins.originRefreshToken, *mm_want_ptrs.refreshToken, mm_got.refreshToken, minimock.Diff(*mm_want_ptrs.refreshToken, mm_got.refreshToken))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAccessToken.t.Errorf("AuthServiceMock.GetAccessToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAccessToken.GetAccessTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAccessToken.GetAccessTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAccessToken.t.Fatal("No results are set for the AuthServiceMock.GetAccessToken")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetAccessToken.funcGetAccessToken != nil {
+		return mmGetAccessToken.funcGetAccessToken(ctx, refreshToken)
+	}
+	mmGetAccessToken.t.Fatalf("Unexpected call to AuthServiceMock.GetAccessToken. %v %v", ctx, refreshToken)
+	return
+}
+
+// GetAccessTokenAfterCounter returns a count of finished AuthServiceMock.GetAccessToken invocations
+func (mmGetAccessToken *AuthServiceMock) GetAccessTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAccessToken.afterGetAccessTokenCounter)
+}
+
+// GetAccessTokenBeforeCounter returns a count of AuthServiceMock.GetAccessToken invocations
+func (mmGetAccessToken *AuthServiceMock) GetAccessTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAccessToken.beforeGetAccessTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.GetAccessToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Calls() []*AuthServiceMockGetAccessTokenParams {
+	mmGetAccessToken.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockGetAccessTokenParams, len(mmGetAccessToken.callArgs))
+	copy(argCopy, mmGetAccessToken.callArgs)
+
+	mmGetAccessToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAccessTokenDone returns true if the count of the GetAccessToken invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockGetAccessTokenDone() bool {
+	if m.GetAccessTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAccessTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAccessTokenMock.invocationsDone()
+}
+
+// MinimockGetAccessTokenInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockGetAccessTokenInspect() {
+	for _, e := range m.GetAccessTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.GetAccessToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAccessTokenCounter := mm_atomic.LoadUint64(&m.afterGetAccessTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAccessTokenMock.defaultExpectation != nil && afterGetAccessTokenCounter < 1 {
+		if m.GetAccessTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.GetAccessToken at\n%s", m.GetAccessTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.GetAccessToken at\n%s with params: %#v", m.GetAccessTokenMock.defaultExpectation.expectationOrigins.origin, *m.GetAccessTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAccessToken != nil && afterGetAccessTokenCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.GetAccessToken at\n%s", m.funcGetAccessTokenOrigin)
+	}
+
+	if !m.GetAccessTokenMock.invocationsDone() && afterGetAccessTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.GetAccessToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAccessTokenMock.expectedInvocations), m.GetAccessTokenMock.expectedInvocationsOrigin, afterGetAccessTokenCounter)
+	}
+}
+
+type mAuthServiceMockGetRefreshToken struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockGetRefreshTokenExpectation
+	expectations       []*AuthServiceMockGetRefreshTokenExpectation
+
+	callArgs []*AuthServiceMockGetRefreshTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockGetRefreshTokenExpectation specifies expectation struct of the AuthService.GetRefreshToken
+type AuthServiceMockGetRefreshTokenExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockGetRefreshTokenParams
+	paramPtrs          *AuthServiceMockGetRefreshTokenParamPtrs
+	expectationOrigins AuthServiceMockGetRefreshTokenExpectationOrigins
+	results            *AuthServiceMockGetRefreshTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockGetRefreshTokenParams contains parameters of the AuthService.GetRefreshToken
+type AuthServiceMockGetRefreshTokenParams struct {
+	ctx             context.Context
+	oldRefreshToken string
+	client          *model.ClientInfo
+}
+
+// AuthServiceMockGetRefreshTokenParamPtrs contains pointers to parameters of the AuthService.GetRefreshToken
+type AuthServiceMockGetRefreshTokenParamPtrs struct {
+	ctx             *context.Context
+	oldRefreshToken *string
+	client          **model.ClientInfo
+}
+
+// AuthServiceMockGetRefreshTokenResults contains results of the AuthService.GetRefreshToken
+type AuthServiceMockGetRefreshTokenResults struct {
+	s1  string
+	err error
+}
+
+// AuthServiceMockGetRefreshTokenOrigins contains origins of expectations of the AuthService.GetRefreshToken
+type AuthServiceMockGetRefreshTokenExpectationOrigins struct {
+	origin                string
+	originCtx             string
+	originOldRefreshToken string
+	originClient          string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Optional() *mAuthServiceMockGetRefreshToken {
+	mmGetRefreshToken.optional = true
+	return mmGetRefreshToken
+}
+
+// Expect sets up expected params for AuthService.GetRefreshToken
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Expect(ctx context.Context, oldRefreshToken string, client *model.ClientInfo) *mAuthServiceMockGetRefreshToken {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Set")
+	}
+
+	if mmGetRefreshToken.defaultExpectation == nil {
+		mmGetRefreshToken.defaultExpectation = &AuthServiceMockGetRefreshTokenExpectation{}
+	}
+
+	if mmGetRefreshToken.defaultExpectation.paramPtrs != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by ExpectParams functions")
+	}
+
+	mmGetRefreshToken.defaultExpectation.params = &AuthServiceMockGetRefreshTokenParams{ctx, oldRefreshToken, client}
+	mmGetRefreshToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetRefreshToken.expectations {
+		if minimock.Equal(e.params, mmGetRefreshToken.defaultExpectation.params) {
+			mmGetRefreshToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRefreshToken.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRefreshToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.GetRefreshToken
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockGetRefreshToken {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Set")
+	}
+
+	if mmGetRefreshToken.defaultExpectation == nil {
+		mmGetRefreshToken.defaultExpectation = &AuthServiceMockGetRefreshTokenExpectation{}
+	}
+
+	if mmGetRefreshToken.defaultExpectation.params != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Expect")
+	}
+
+	if mmGetRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmGetRefreshToken.defaultExpectation.paramPtrs = &AuthServiceMockGetRefreshTokenParamPtrs{}
+	}
+	mmGetRefreshToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetRefreshToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetRefreshToken
+}
+
+// ExpectOldRefreshTokenParam2 sets up expected param oldRefreshToken for AuthService.GetRefreshToken
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) ExpectOldRefreshTokenParam2(oldRefreshToken string) *mAuthServiceMockGetRefreshToken {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Set")
+	}
+
+	if mmGetRefreshToken.defaultExpectation == nil {
+		mmGetRefreshToken.defaultExpectation = &AuthServiceMockGetRefreshTokenExpectation{}
+	}
+
+	if mmGetRefreshToken.defaultExpectation.params != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Expect")
+	}
+
+	if mmGetRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmGetRefreshToken.defaultExpectation.paramPtrs = &AuthServiceMockGetRefreshTokenParamPtrs{}
+	}
+	mmGetRefreshToken.defaultExpectation.paramPtrs.oldRefreshToken = &oldRefreshToken
+	mmGetRefreshToken.defaultExpectation.expectationOrigins.originOldRefreshToken = minimock.CallerInfo(1)
+
+	return mmGetRefreshToken
+}
+
+// ExpectClientParam3 sets up expected param client for AuthService.GetRefreshToken
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) ExpectClientParam3(client *model.ClientInfo) *mAuthServiceMockGetRefreshToken {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Set")
+	}
+
+	if mmGetRefreshToken.defaultExpectation == nil {
+		mmGetRefreshToken.defaultExpectation = &AuthServiceMockGetRefreshTokenExpectation{}
+	}
+
+	if mmGetRefreshToken.defaultExpectation.params != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Expect")
+	}
+
+	if mmGetRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmGetRefreshToken.defaultExpectation.paramPtrs = &AuthServiceMockGetRefreshTokenParamPtrs{}
+	}
+	mmGetRefreshToken.defaultExpectation.paramPtrs.client = &client
+	mmGetRefreshToken.defaultExpectation.expectationOrigins.originClient = minimock.CallerInfo(1)
+
+	return mmGetRefreshToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.GetRefreshToken
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Inspect(f func(ctx context.Context, oldRefreshToken string, client *model.ClientInfo)) *mAuthServiceMockGetRefreshToken {
+	if mmGetRefreshToken.mock.inspectFuncGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.GetRefreshToken")
+	}
+
+	mmGetRefreshToken.mock.inspectFuncGetRefreshToken = f
+
+	return mmGetRefreshToken
+}
+
+// Return sets up results that will be returned by AuthService.GetRefreshToken
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Return(s1 string, err error) *AuthServiceMock {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Set")
+	}
+
+	if mmGetRefreshToken.defaultExpectation == nil {
+		mmGetRefreshToken.defaultExpectation = &AuthServiceMockGetRefreshTokenExpectation{mock: mmGetRefreshToken.mock}
+	}
+	mmGetRefreshToken.defaultExpectation.results = &AuthServiceMockGetRefreshTokenResults{s1, err}
+	mmGetRefreshToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetRefreshToken.mock
+}
+
+// Set uses given function f to mock the AuthService.GetRefreshToken method
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Set(f func(ctx context.Context, oldRefreshToken string, client *model.ClientInfo) (s1 string, err error)) *AuthServiceMock {
+	if mmGetRefreshToken.defaultExpectation != nil {
+		mmGetRefreshToken.mock.t.Fatalf("Default expectation is already set for the AuthService.GetRefreshToken method")
+	}
+
+	if len(mmGetRefreshToken.expectations) > 0 {
+		mmGetRefreshToken.mock.t.Fatalf("Some expectations are already set for the AuthService.GetRefreshToken method")
+	}
+
+	mmGetRefreshToken.mock.funcGetRefreshToken = f
+	mmGetRefreshToken.mock.funcGetRefreshTokenOrigin = minimock.CallerInfo(1)
+	return mmGetRefreshToken.mock
+}
+
+// When sets expectation for the AuthService.GetRefreshToken which will trigger the result defined by the following
+// Then helper
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) When(ctx context.Context, oldRefreshToken string, client *model.ClientInfo) *AuthServiceMockGetRefreshTokenExpectation {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockGetRefreshTokenExpectation{
+		mock:               mmGetRefreshToken.mock,
+		params:             &AuthServiceMockGetRefreshTokenParams{ctx, oldRefreshToken, client},
+		expectationOrigins: AuthServiceMockGetRefreshTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetRefreshToken.expectations = append(mmGetRefreshToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.GetRefreshToken return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockGetRefreshTokenExpectation) Then(s1 string, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockGetRefreshTokenResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.GetRefreshToken should be invoked
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Times(n uint64) *mAuthServiceMockGetRefreshToken {
+	if n == 0 {
+		mmGetRefreshToken.mock.t.Fatalf("Times of AuthServiceMock.GetRefreshToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetRefreshToken.expectedInvocations, n)
+	mmGetRefreshToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetRefreshToken
+}
+
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) invocationsDone() bool {
+	if len(mmGetRefreshToken.expectations) == 0 && mmGetRefreshToken.defaultExpectation == nil && mmGetRefreshToken.mock.funcGetRefreshToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetRefreshToken.mock.afterGetRefreshTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetRefreshToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetRefreshToken implements mm_service.AuthService
+func (mmGetRefreshToken *AuthServiceMock) GetRefreshToken(ctx context.Context, oldRefreshToken string, client *model.ClientInfo) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmGetRefreshToken.beforeGetRefreshTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRefreshToken.afterGetRefreshTokenCounter, 1)
+
+	mmGetRefreshToken.t.Helper()
+
+	if mmGetRefreshToken.inspectFuncGetRefreshToken != nil {
+		mmGetRefreshToken.inspectFuncGetRefreshToken(ctx, oldRefreshToken, client)
+	}
+
+	mm_params := AuthServiceMockGetRefreshTokenParams{ctx, oldRefreshToken, client}
+
+	// Record call args
+	mmGetRefreshToken.GetRefreshTokenMock.mutex.Lock()
+	mmGetRefreshToken.GetRefreshTokenMock.callArgs = append(mmGetRefreshToken.GetRefreshTokenMock.callArgs, &mm_params)
+	mmGetRefreshToken.GetRefreshTokenMock.mutex.Unlock()
+
+	for _, e := range mmGetRefreshToken.GetRefreshTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockGetRefreshTokenParams{ctx, oldRefreshToken, client}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetRefreshToken.t.Errorf("AuthServiceMock.GetRefreshToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.oldRefreshToken != nil && !minimock.Equal(*mm_want_ptrs.oldRefreshToken, mm_got.oldRefreshToken) {
+				mmGetRefreshToken.t.Errorf("AuthServiceMock.GetRefreshToken got unexpected parameter oldRefreshToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.expectationOrigins.originOldRefreshToken, *mm_want_ptrs.oldRefreshToken, mm_got.oldRefreshToken, minimock.Diff(*mm_want_ptrs.oldRefreshToken, mm_got.oldRefreshToken))
+			}
+
+			if mm_want_ptrs.client != nil && !minimock.Equal(*mm_want_ptrs.client, mm_got.client) {
+				mmGetRefreshToken.t.Errorf("AuthServiceMock.GetRefreshToken got unexpected parameter client, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.expectationOrigins.originClient, *mm_want_ptrs.client, mm_got.client, minimock.Diff(*mm_want_ptrs.client, mm_got.client))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRefreshToken.t.Errorf("AuthServiceMock.GetRefreshToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRefreshToken.t.Fatal("No results are set for the AuthServiceMock.GetRefreshToken")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetRefreshToken.funcGetRefreshToken != nil {
+		return mmGetRefreshToken.funcGetRefreshToken(ctx, oldRefreshToken, client)
+	}
+	mmGetRefreshToken.t.Fatalf("Unexpected call to AuthServiceMock.GetRefreshToken. %v %v %v", ctx, oldRefreshToken, client)
+	return
+}
+
+// GetRefreshTokenAfterCounter returns a count of finished AuthServiceMock.GetRefreshToken invocations
+func (mmGetRefreshToken *AuthServiceMock) GetRefreshTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRefreshToken.afterGetRefreshTokenCounter)
+}
+
+// GetRefreshTokenBeforeCounter returns a count of AuthServiceMock.GetRefreshToken invocations
+func (mmGetRefreshToken *AuthServiceMock) GetRefreshTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRefreshToken.beforeGetRefreshTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.GetRefreshToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Calls() []*AuthServiceMockGetRefreshTokenParams {
+	mmGetRefreshToken.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockGetRefreshTokenParams, len(mmGetRefreshToken.callArgs))
+	copy(argCopy, mmGetRefreshToken.callArgs)
+
+	mmGetRefreshToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRefreshTokenDone returns true if the count of the GetRefreshToken invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockGetRefreshTokenDone() bool {
+	if m.GetRefreshTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetRefreshTokenMock.invocationsDone()
+}
+
+// MinimockGetRefreshTokenInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockGetRefreshTokenInspect() {
+	for _, e := range m.GetRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.GetRefreshToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetRefreshTokenCounter := mm_atomic.LoadUint64(&m.afterGetRefreshTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRefreshTokenMock.defaultExpectation != nil && afterGetRefreshTokenCounter < 1 {
+		if m.GetRefreshTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.GetRefreshToken at\n%s", m.GetRefreshTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.GetRefreshToken at\n%s with params: %#v", m.GetRefreshTokenMock.defaultExpectation.expectationOrigins.origin, *m.GetRefreshTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRefreshToken != nil && afterGetRefreshTokenCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.GetRefreshToken at\n%s", m.funcGetRefreshTokenOrigin)
+	}
+
+	if !m.GetRefreshTokenMock.invocationsDone() && afterGetRefreshTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.GetRefreshToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetRefreshTokenMock.expectedInvocations), m.GetRefreshTokenMock.expectedInvocationsOrigin, afterGetRefreshTokenCounter)
+	}
+}
+
+type mAuthServiceMockListSessions struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockListSessionsExpectation
+	expectations       []*AuthServiceMockListSessionsExpectation
+
+	callArgs []*AuthServiceMockListSessionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockListSessionsExpectation specifies expectation struct of the AuthService.ListSessions
+type AuthServiceMockListSessionsExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockListSessionsParams
+	paramPtrs          *AuthServiceMockListSessionsParamPtrs
+	expectationOrigins AuthServiceMockListSessionsExpectationOrigins
+	results            *AuthServiceMockListSessionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockListSessionsParams contains parameters of the AuthService.ListSessions
+type AuthServiceMockListSessionsParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// AuthServiceMockListSessionsParamPtrs contains pointers to parameters of the AuthService.ListSessions
+type AuthServiceMockListSessionsParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// AuthServiceMockListSessionsResults contains results of the AuthService.ListSessions
+type AuthServiceMockListSessionsResults struct {
+	tpa1 []*model.TokenFamily
+	err  error
+}
+
+// AuthServiceMockListSessionsOrigins contains origins of expectations of the AuthService.ListSessions
+type AuthServiceMockListSessionsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListSessions *mAuthServiceMockListSessions) Optional() *mAuthServiceMockListSessions {
+	mmListSessions.optional = true
+	return mmListSessions
+}
+
+// Expect sets up expected params for AuthService.ListSessions
+func (mmListSessions *mAuthServiceMockListSessions) Expect(ctx context.Context, userID string) *mAuthServiceMockListSessions {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("AuthServiceMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &AuthServiceMockListSessionsExpectation{}
+	}
+
+	if mmListSessions.defaultExpectation.paramPtrs != nil {
+		mmListSessions.mock.t.Fatalf("AuthServiceMock.ListSessions mock is already set by ExpectParams functions")
+	}
+
+	mmListSessions.defaultExpectation.params = &AuthServiceMockListSessionsParams{ctx, userID}
+	mmListSessions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListSessions.expectations {
+		if minimock.Equal(e.params, mmListSessions.defaultExpectation.params) {
+			mmListSessions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListSessions.defaultExpectation.params)
+		}
+	}
+
+	return mmListSessions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.ListSessions
+func (mmListSessions *mAuthServiceMockListSessions) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockListSessions {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("AuthServiceMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &AuthServiceMockListSessionsExpectation{}
+	}
+
+	if mmListSessions.defaultExpectation.params != nil {
+		mmListSessions.mock.t.Fatalf("AuthServiceMock.ListSessions mock is already set by Expect")
+	}
+
+	if mmListSessions.defaultExpectation.paramPtrs == nil {
+		mmListSessions.defaultExpectation.paramPtrs = &AuthServiceMockListSessionsParamPtrs{}
+	}
+	mmListSessions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListSessions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListSessions
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthService.ListSessions
+func (mmListSessions *mAuthServiceMockListSessions) ExpectUserIDParam2(userID string) *mAuthServiceMockListSessions {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("AuthServiceMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &AuthServiceMockListSessionsExpectation{}
+	}
+
+	if mmListSessions.defaultExpectation.params != nil {
+		mmListSessions.mock.t.Fatalf("AuthServiceMock.ListSessions mock is already set by Expect")
+	}
+
+	if mmListSessions.defaultExpectation.paramPtrs == nil {
+		mmListSessions.defaultExpectation.paramPtrs = &AuthServiceMockListSessionsParamPtrs{}
+	}
+	mmListSessions.defaultExpectation.paramPtrs.userID = &userID
+	mmListSessions.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListSessions
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.ListSessions
+func (mmListSessions *mAuthServiceMockListSessions) Inspect(f func(ctx context.Context, userID string)) *mAuthServiceMockListSessions {
+	if mmListSessions.mock.inspectFuncListSessions != nil {
+		mmListSessions.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.ListSessions")
+	}
+
+	mmListSessions.mock.inspectFuncListSessions = f
+
+	return mmListSessions
+}
+
+// Return sets up results that will be returned by AuthService.ListSessions
+func (mmListSessions *mAuthServiceMockListSessions) Return(tpa1 []*model.TokenFamily, err error) *AuthServiceMock {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("AuthServiceMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &AuthServiceMockListSessionsExpectation{mock: mmListSessions.mock}
+	}
+	mmListSessions.defaultExpectation.results = &AuthServiceMockListSessionsResults{tpa1, err}
+	mmListSessions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListSessions.mock
+}
+
+// Set uses given function f to mock the AuthService.ListSessions method
+func (mmListSessions *mAuthServiceMockListSessions) Set(f func(ctx context.Context, userID string) (tpa1 []*model.TokenFamily, err error)) *AuthServiceMock {
+	if mmListSessions.defaultExpectation != nil {
+		mmListSessions.mock.t.Fatalf("Default expectation is already set for the AuthService.ListSessions method")
+	}
+
+	if len(mmListSessions.expectations) > 0 {
+		mmListSessions.mock.t.Fatalf("Some expectations are already set for the AuthService.ListSessions method")
+	}
+
+	mmListSessions.mock.funcListSessions = f
+	mmListSessions.mock.funcListSessionsOrigin = minimock.CallerInfo(1)
+	return mmListSessions.mock
+}
+
+// When sets expectation for the AuthService.ListSessions which will trigger the result defined by the following
+// Then helper
+func (mmListSessions *mAuthServiceMockListSessions) When(ctx context.Context, userID string) *AuthServiceMockListSessionsExpectation {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("AuthServiceMock.ListSessions mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockListSessionsExpectation{
+		mock:               mmListSessions.mock,
+		params:             &AuthServiceMockListSessionsParams{ctx, userID},
+		expectationOrigins: AuthServiceMockListSessionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListSessions.expectations = append(mmListSessions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.ListSessions return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockListSessionsExpectation) Then(tpa1 []*model.TokenFamily, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockListSessionsResults{tpa1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.ListSessions should be invoked
+func (mmListSessions *mAuthServiceMockListSessions) Times(n uint64) *mAuthServiceMockListSessions {
+	if n == 0 {
+		mmListSessions.mock.t.Fatalf("Times of AuthServiceMock.ListSessions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListSessions.expectedInvocations, n)
+	mmListSessions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListSessions
+}
+
+func (mmListSessions *mAuthServiceMockListSessions) invocationsDone() bool {
+	if len(mmListSessions.expectations) == 0 && mmListSessions.defaultExpectation == nil && mmListSessions.mock.funcListSessions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListSessions.mock.afterListSessionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListSessions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListSessions implements mm_service.AuthService
+func (mmListSessions *AuthServiceMock) ListSessions(ctx context.Context, userID string) (tpa1 []*model.TokenFamily, err error) {
+	mm_atomic.AddUint64(&mmListSessions.beforeListSessionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListSessions.afterListSessionsCounter, 1)
+
+	mmListSessions.t.Helper()
+
+	if mmListSessions.inspectFuncListSessions != nil {
+		mmListSessions.inspectFuncListSessions(ctx, userID)
+	}
+
+	mm_params := AuthServiceMockListSessionsParams{ctx, userID}
+
+	// Record call args
+	mmListSessions.ListSessionsMock.mutex.Lock()
+	mmListSessions.ListSessionsMock.callArgs = append(mmListSessions.ListSessionsMock.callArgs, &mm_params)
+	mmListSessions.ListSessionsMock.mutex.Unlock()
+
+	for _, e := range mmListSessions.ListSessionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tpa1, e.results.err
+		}
+	}
+
+	if mmListSessions.ListSessionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListSessions.ListSessionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListSessions.ListSessionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListSessions.ListSessionsMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockListSessionsParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListSessions.t.Errorf("AuthServiceMock.ListSessions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSessions.ListSessionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListSessions.t.Errorf("AuthServiceMock.ListSessions got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSessions.ListSessionsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListSessions.t.Errorf("AuthServiceMock.ListSessions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListSessions.ListSessionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListSessions.ListSessionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListSessions.t.Fatal("No results are set for the AuthServiceMock.ListSessions")
+		}
+		return (*mm_results).tpa1, (*mm_results).err
+	}
+	if mmListSessions.funcListSessions != nil {
+		return mmListSessions.funcListSessions(ctx, userID)
+	}
+	mmListSessions.t.Fatalf("Unexpected call to AuthServiceMock.ListSessions. %v %v", ctx, userID)
+	return
+}
+
+// ListSessionsAfterCounter returns a count of finished AuthServiceMock.ListSessions invocations
+func (mmListSessions *AuthServiceMock) ListSessionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSessions.afterListSessionsCounter)
+}
+
+// ListSessionsBeforeCounter returns a count of AuthServiceMock.ListSessions invocations
+func (mmListSessions *AuthServiceMock) ListSessionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSessions.beforeListSessionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.ListSessions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListSessions *mAuthServiceMockListSessions) Calls() []*AuthServiceMockListSessionsParams {
+	mmListSessions.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockListSessionsParams, len(mmListSessions.callArgs))
+	copy(argCopy, mmListSessions.callArgs)
+
+	mmListSessions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListSessionsDone returns true if the count of the ListSessions invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockListSessionsDone() bool {
+	if m.ListSessionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListSessionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListSessionsMock.invocationsDone()
+}
+
+// MinimockListSessionsInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockListSessionsInspect() {
+	for _, e := range m.ListSessionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.ListSessions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListSessionsCounter := mm_atomic.LoadUint64(&m.afterListSessionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListSessionsMock.defaultExpectation != nil && afterListSessionsCounter < 1 {
+		if m.ListSessionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.ListSessions at\n%s", m.ListSessionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.ListSessions at\n%s with params: %#v", m.ListSessionsMock.defaultExpectation.expectationOrigins.origin, *m.ListSessionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListSessions != nil && afterListSessionsCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.ListSessions at\n%s", m.funcListSessionsOrigin)
+	}
+
+	if !m.ListSessionsMock.invocationsDone() && afterListSessionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.ListSessions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListSessionsMock.expectedInvocations), m.ListSessionsMock.expectedInvocationsOrigin, afterListSessionsCounter)
+	}
+}
+
+type mAuthServiceMockLogin struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockLoginExpectation
+	expectations       []*AuthServiceMockLoginExpectation
+
+	callArgs []*AuthServiceMockLoginParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockLoginExpectation specifies expectation struct of the AuthService.Login
+type AuthServiceMockLoginExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockLoginParams
+	paramPtrs          *AuthServiceMockLoginParamPtrs
+	expectationOrigins AuthServiceMockLoginExpectationOrigins
+	results            *AuthServiceMockLoginResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockLoginParams contains parameters of the AuthService.Login
+type AuthServiceMockLoginParams struct {
+	ctx    context.Context
+	creds  *model.UserCreds
+	client *model.ClientInfo
+}
+
+// AuthServiceMockLoginParamPtrs contains pointers to parameters of the AuthService.Login
+type AuthServiceMockLoginParamPtrs struct {
+	ctx    *context.Context
+	creds  **model.UserCreds
+	client **model.ClientInfo
+}
+
+// AuthServiceMockLoginResults contains results of the AuthService.Login
+type AuthServiceMockLoginResults struct {
+	lp1 *model.LoginResult
+	err error
+}
+
+// AuthServiceMockLoginOrigins contains origins of expectations of the AuthService.Login
+type AuthServiceMockLoginExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originCreds  string
+	originClient string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLogin *mAuthServiceMockLogin) Optional() *mAuthServiceMockLogin {
+	mmLogin.optional = true
+	return mmLogin
+}
+
+// Expect sets up expected params for AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) Expect(ctx context.Context, creds *model.UserCreds, client *model.ClientInfo) *mAuthServiceMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by ExpectParams functions")
+	}
+
+	mmLogin.defaultExpectation.params = &AuthServiceMockLoginParams{ctx, creds, client}
+	mmLogin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLogin.expectations {
+		if minimock.Equal(e.params, mmLogin.defaultExpectation.params) {
+			mmLogin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogin.defaultExpectation.params)
+		}
+	}
+
+	return mmLogin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.params != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Expect")
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs == nil {
+		mmLogin.defaultExpectation.paramPtrs = &AuthServiceMockLoginParamPtrs{}
+	}
+	mmLogin.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLogin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLogin
+}
+
+// ExpectCredsParam2 sets up expected param creds for AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) ExpectCredsParam2(creds *model.UserCreds) *mAuthServiceMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.params != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Expect")
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs == nil {
+		mmLogin.defaultExpectation.paramPtrs = &AuthServiceMockLoginParamPtrs{}
+	}
+	mmLogin.defaultExpectation.paramPtrs.creds = &creds
+	mmLogin.defaultExpectation.expectationOrigins.originCreds = minimock.CallerInfo(1)
+
+	return mmLogin
+}
+
+// ExpectClientParam3 sets up expected param client for AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) ExpectClientParam3(client *model.ClientInfo) *mAuthServiceMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.params != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Expect")
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs == nil {
+		mmLogin.defaultExpectation.paramPtrs = &AuthServiceMockLoginParamPtrs{}
+	}
+	mmLogin.defaultExpectation.paramPtrs.client = &client
+	mmLogin.defaultExpectation.expectationOrigins.originClient = minimock.CallerInfo(1)
+
+	return mmLogin
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) Inspect(f func(ctx context.Context, creds *model.UserCreds, client *model.ClientInfo)) *mAuthServiceMockLogin {
+	if mmLogin.mock.inspectFuncLogin != nil {
+		mmLogin.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.Login")
+	}
+
+	mmLogin.mock.inspectFuncLogin = f
+
+	return mmLogin
+}
+
+// Return sets up results that will be returned by AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) Return(lp1 *model.LoginResult, err error) *AuthServiceMock {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{mock: mmLogin.mock}
+	}
+	mmLogin.defaultExpectation.results = &AuthServiceMockLoginResults{lp1, err}
+	mmLogin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLogin.mock
+}
+
+// Set uses given function f to mock the AuthService.Login method
+func (mmLogin *mAuthServiceMockLogin) Set(f func(ctx context.Context, creds *model.UserCreds, client *model.ClientInfo) (lp1 *model.LoginResult, err error)) *AuthServiceMock {
+	if mmLogin.defaultExpectation != nil {
+		mmLogin.mock.t.Fatalf("Default expectation is already set for the AuthService.Login method")
+	}
+
+	if len(mmLogin.expectations) > 0 {
+		mmLogin.mock.t.Fatalf("Some expectations are already set for the AuthService.Login method")
+	}
+
+	mmLogin.mock.funcLogin = f
+	mmLogin.mock.funcLoginOrigin = minimock.CallerInfo(1)
+	return mmLogin.mock
+}
+
+// When sets expectation for the AuthService.Login which will trigger the result defined by the following
+// Then helper
+func (mmLogin *mAuthServiceMockLogin) When(ctx context.Context, creds *model.UserCreds, client *model.ClientInfo) *AuthServiceMockLoginExpectation {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockLoginExpectation{
+		mock:               mmLogin.mock,
+		params:             &AuthServiceMockLoginParams{ctx, creds, client},
+		expectationOrigins: AuthServiceMockLoginExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLogin.expectations = append(mmLogin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.Login return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockLoginExpectation) Then(lp1 *model.LoginResult, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockLoginResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.Login should be invoked
+func (mmLogin *mAuthServiceMockLogin) Times(n uint64) *mAuthServiceMockLogin {
+	if n == 0 {
+		mmLogin.mock.t.Fatalf("Times of AuthServiceMock.Login mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLogin.expectedInvocations, n)
+	mmLogin.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLogin
+}
+
+func (mmLogin *mAuthServiceMockLogin) invocationsDone() bool {
+	if len(mmLogin.expectations) == 0 && mmLogin.defaultExpectation == nil && mmLogin.mock.funcLogin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLogin.mock.afterLoginCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLogin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Login implements mm_service.AuthService
+func (mmLogin *AuthServiceMock) Login(ctx context.Context, creds *model.UserCreds, client *model.ClientInfo) (lp1 *model.LoginResult, err error) {
+	mm_atomic.AddUint64(&mmLogin.beforeLoginCounter, 1)
+	defer mm_atomic.AddUint64(&mmLogin.afterLoginCounter, 1)
+
+	mmLogin.t.Helper()
+
+	if mmLogin.inspectFuncLogin != nil {
+		mmLogin.inspectFuncLogin(ctx, creds, client)
+	}
+
+	mm_params := AuthServiceMockLoginParams{ctx, creds, client}
+
+	// Record call args
+	mmLogin.LoginMock.mutex.Lock()
+	mmLogin.LoginMock.callArgs = append(mmLogin.LoginMock.callArgs, &mm_params)
+	mmLogin.LoginMock.mutex.Unlock()
+
+	for _, e := range mmLogin.LoginMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmLogin.LoginMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLogin.LoginMock.defaultExpectation.Counter, 1)
+		mm_want := mmLogin.LoginMock.defaultExpectation.params
+		mm_want_ptrs := mmLogin.LoginMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockLoginParams{ctx, creds, client}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.creds != nil && !minimock.Equal(*mm_want_ptrs.creds, mm_got.creds) {
+				mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameter creds, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originCreds, *mm_want_ptrs.creds, mm_got.creds, minimock.Diff(*mm_want_ptrs.creds, mm_got.creds))
+			}
+
+			if mm_want_ptrs.client != nil && !minimock.Equal(*mm_want_ptrs.client, mm_got.client) {
+				mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameter client, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originClient, *mm_want_ptrs.client, mm_got.client, minimock.Diff(*mm_want_ptrs.client, mm_got.client))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLogin.LoginMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLogin.LoginMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLogin.t.Fatal("No results are set for the AuthServiceMock.Login")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmLogin.funcLogin != nil {
+		return mmLogin.funcLogin(ctx, creds, client)
+	}
+	mmLogin.t.Fatalf("Unexpected call to AuthServiceMock.Login. %v %v %v", ctx, creds, client)
+	return
+}
+
+// LoginAfterCounter returns a count of finished AuthServiceMock.Login invocations
+func (mmLogin *AuthServiceMock) LoginAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogin.afterLoginCounter)
+}
+
+// LoginBeforeCounter returns a count of AuthServiceMock.Login invocations
+func (mmLogin *AuthServiceMock) LoginBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogin.beforeLoginCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.Login.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLogin *mAuthServiceMockLogin) Calls() []*AuthServiceMockLoginParams {
+	mmLogin.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockLoginParams, len(mmLogin.callArgs))
+	copy(argCopy, mmLogin.callArgs)
+
+	mmLogin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLoginDone returns true if the count of the Login invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockLoginDone() bool {
+	if m.LoginMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LoginMock.invocationsDone()
+}
+
+// MinimockLoginInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockLoginInspect() {
 	for _, e := range m.LoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLoginCounter := mm_atomic.LoadUint64(&m.afterLoginCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LoginMock.defaultExpectation != nil && afterLoginCounter < 1 {
+		if m.LoginMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s", m.LoginMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s with params: %#v", m.LoginMock.defaultExpectation.expectationOrigins.origin, *m.LoginMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLogin != nil && afterLoginCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s", m.funcLoginOrigin)
+	}
+
+	if !m.LoginMock.invocationsDone() && afterLoginCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.Login at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LoginMock.expectedInvocations), m.LoginMock.expectedInvocationsOrigin, afterLoginCounter)
+	}
+}
+
+type mAuthServiceMockLogout struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockLogoutExpectation
+	expectations       []*AuthServiceMockLogoutExpectation
+
+	callArgs []*AuthServiceMockLogoutParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockLogoutExpectation specifies expectation struct of the AuthService.Logout
+type AuthServiceMockLogoutExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockLogoutParams
+	paramPtrs          *AuthServiceMockLogoutParamPtrs
+	expectationOrigins AuthServiceMockLogoutExpectationOrigins
+	results            *AuthServiceMockLogoutResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockLogoutParams contains parameters of the AuthService.Logout
+type AuthServiceMockLogoutParams struct {
+	ctx          context.Context
+	refreshToken string
+}
+
+// AuthServiceMockLogoutParamPtrs contains pointers to parameters of the AuthService.Logout
+type AuthServiceMockLogoutParamPtrs struct {
+	ctx          *context.Context
+	refreshToken *string
+}
+
+// AuthServiceMockLogoutResults contains results of the AuthService.Logout
+type AuthServiceMockLogoutResults struct {
+	err error
+}
+
+// AuthServiceMockLogoutOrigins contains origins of expectations of the AuthService.Logout
+type AuthServiceMockLogoutExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originRefreshToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLogout *mAuthServiceMockLogout) Optional() *mAuthServiceMockLogout {
+	mmLogout.optional = true
+	return mmLogout
+}
+
+// Expect sets up expected params for AuthService.Logout
+func (mmLogout *mAuthServiceMockLogout) Expect(ctx context.Context, refreshToken string) *mAuthServiceMockLogout {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("AuthServiceMock.Logout mock is already set by Set")
+	}
+
+	if mmLogout.defaultExpectation == nil {
+		mmLogout.defaultExpectation = &AuthServiceMockLogoutExpectation{}
+	}
+
+	if mmLogout.defaultExpectation.paramPtrs != nil {
+		mmLogout.mock.t.Fatalf("AuthServiceMock.Logout mock is already set by ExpectParams functions")
+	}
+
+	mmLogout.defaultExpectation.params = &AuthServiceMockLogoutParams{ctx, refreshToken}
+	mmLogout.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLogout.expectations {
+		if minimock.Equal(e.params, mmLogout.defaultExpectation.params) {
+			mmLogout.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogout.defaultExpectation.params)
+		}
+	}
+
+	return mmLogout
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.Logout
+func (mmLogout *mAuthServiceMockLogout) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockLogout {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("AuthServiceMock.Logout mock is already set by Set")
+	}
+
+	if mmLogout.defaultExpectation == nil {
+		mmLogout.defaultExpectation = &AuthServiceMockLogoutExpectation{}
+	}
+
+	if mmLogout.defaultExpectation.params != nil {
+		mmLogout.mock.t.Fatalf("AuthServiceMock.Logout mock is already set by Expect")
+	}
+
+	if mmLogout.defaultExpectation.paramPtrs == nil {
+		mmLogout.defaultExpectation.paramPtrs = &AuthServiceMockLogoutParamPtrs{}
+	}
+	mmLogout.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLogout.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLogout
+}
+
+// ExpectRefreshTokenParam2 sets up expected param refreshToken for AuthService.Logout
+func (mmLogout *mAuthServiceMockLogout) ExpectRefreshTokenParam2(refreshToken string) *mAuthServiceMockLogout {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("AuthServiceMock.Logout mock is already set by Set")
+	}
+
+	if mmLogout.defaultExpectation == nil {
+		mmLogout.defaultExpectation = &AuthServiceMockLogoutExpectation{}
+	}
+
+	if mmLogout.defaultExpectation.params != nil {
+		mmLogout.mock.t.Fatalf("AuthServiceMock.Logout mock is already set by Expect")
+	}
+
+	if mmLogout.defaultExpectation.paramPtrs == nil {
+		mmLogout.defaultExpectation.paramPtrs = &AuthServiceMockLogoutParamPtrs{}
+	}
+	mmLogout.defaultExpectation.paramPtrs.refreshToken = &refreshToken
+	mmLogout.defaultExpectation.expectationOrigins.originRefreshToken = minimock.CallerInfo(1)
+
+	return mmLogout
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.Logout
+func (mmLogout *mAuthServiceMockLogout) Inspect(f func(ctx context.Context, refreshToken string)) *mAuthServiceMockLogout {
+	if mmLogout.mock.inspectFuncLogout != nil {
+		mmLogout.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.Logout")
+	}
+
+	mmLogout.mock.inspectFuncLogout = f
+
+	return mmLogout
+}
+
+// Return sets up results that will be returned by AuthService.Logout
+func (mmLogout *mAuthServiceMockLogout) Return(err error) *AuthServiceMock {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("AuthServiceMock.Logout mock is already set by Set")
+	}
+
+	if mmLogout.defaultExpectation == nil {
+		mmLogout.defaultExpectation = &AuthServiceMockLogoutExpectation{mock: mmLogout.mock}
+	}
+	mmLogout.defaultExpectation.results = &AuthServiceMockLogoutResults{err}
+	mmLogout.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLogout.mock
+}
+
+// Set uses given function f to mock the AuthService.Logout method
+func (mmLogout *mAuthServiceMockLogout) Set(f func(ctx context.Context, refreshToken string) (err error)) *AuthServiceMock {
+	if mmLogout.defaultExpectation != nil {
+		mmLogout.mock.t.Fatalf("Default expectation is already set for the AuthService.Logout method")
+	}
+
+	if len(mmLogout.expectations) > 0 {
+		mmLogout.mock.t.Fatalf("Some expectations are already set for the AuthService.Logout method")
+	}
+
+	mmLogout.mock.funcLogout = f
+	mmLogout.mock.funcLogoutOrigin = minimock.CallerInfo(1)
+	return mmLogout.mock
+}
+
+// When sets expectation for the AuthService.Logout which will trigger the result defined by the following
+// Then helper
+func (mmLogout *mAuthServiceMockLogout) When(ctx context.Context, refreshToken string) *AuthServiceMockLogoutExpectation {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("AuthServiceMock.Logout mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockLogoutExpectation{
+		mock:               mmLogout.mock,
+		params:             &AuthServiceMockLogoutParams{ctx, refreshToken},
+		expectationOrigins: AuthServiceMockLogoutExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLogout.expectations = append(mmLogout.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.Logout return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockLogoutExpectation) Then(err error) *AuthServiceMock {
+	e.results = &AuthServiceMockLogoutResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.Logout should be invoked
+func (mmLogout *mAuthServiceMockLogout) Times(n uint64) *mAuthServiceMockLogout {
+	if n == 0 {
+		mmLogout.mock.t.Fatalf("Times of AuthServiceMock.Logout mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLogout.expectedInvocations, n)
+	mmLogout.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLogout
+}
+
+func (mmLogout *mAuthServiceMockLogout) invocationsDone() bool {
+	if len(mmLogout.expectations) == 0 && mmLogout.defaultExpectation == nil && mmLogout.mock.funcLogout == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLogout.mock.afterLogoutCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLogout.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Logout implements mm_service.AuthService
+func (mmLogout *AuthServiceMock) Logout(ctx context.Context, refreshToken string) (err error) {
+	mm_atomic.AddUint64(&mmLogout.beforeLogoutCounter, 1)
+	defer mm_atomic.AddUint64(&mmLogout.afterLogoutCounter, 1)
+
+	mmLogout.t.Helper()
+
+	if mmLogout.inspectFuncLogout != nil {
+		mmLogout.inspectFuncLogout(ctx, refreshToken)
+	}
+
+	mm_params := AuthServiceMockLogoutParams{ctx, refreshToken}
+
+	// Record call args
+	mmLogout.LogoutMock.mutex.Lock()
+	mmLogout.LogoutMock.callArgs = append(mmLogout.LogoutMock.callArgs, &mm_params)
+	mmLogout.LogoutMock.mutex.Unlock()
+
+	for _, e := range mmLogout.LogoutMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmLogout.LogoutMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLogout.LogoutMock.defaultExpectation.Counter, 1)
+		mm_want := mmLogout.LogoutMock.defaultExpectation.params
+		mm_want_ptrs := mmLogout.LogoutMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockLogoutParams{ctx, refreshToken}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLogout.t.Errorf("AuthServiceMock.Logout got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogout.LogoutMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.refreshToken != nil && !minimock.Equal(*mm_want_ptrs.refreshToken, mm_got.refreshToken) {
+				mmLogout.t.Errorf("AuthServiceMock.Logout got unexpected parameter refreshToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogout.LogoutMock.defaultExpectation.expectationOrigins.originRefreshToken, *mm_want_ptrs.refreshToken, mm_got.refreshToken, minimock.Diff(*mm_want_ptrs.refreshToken, mm_got.refreshToken))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLogout.t.Errorf("AuthServiceMock.Logout got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLogout.LogoutMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLogout.LogoutMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLogout.t.Fatal("No results are set for the AuthServiceMock.Logout")
+		}
+		return (*mm_results).err
+	}
+	if mmLogout.funcLogout != nil {
+		return mmLogout.funcLogout(ctx, refreshToken)
+	}
+	mmLogout.t.Fatalf("Unexpected call to AuthServiceMock.Logout. %v %v", ctx, refreshToken)
+	return
+}
+
+// LogoutAfterCounter returns a count of finished AuthServiceMock.Logout invocations
+func (mmLogout *AuthServiceMock) LogoutAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogout.afterLogoutCounter)
+}
+
+// LogoutBeforeCounter returns a count of AuthServiceMock.Logout invocations
+func (mmLogout *AuthServiceMock) LogoutBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogout.beforeLogoutCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.Logout.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLogout *mAuthServiceMockLogout) Calls() []*AuthServiceMockLogoutParams {
+	mmLogout.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockLogoutParams, len(mmLogout.callArgs))
+	copy(argCopy, mmLogout.callArgs)
+
+	mmLogout.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLogoutDone returns true if the count of the Logout invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockLogoutDone() bool {
+	if m.LogoutMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LogoutMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LogoutMock.invocationsDone()
+}
+
+// MinimockLogoutInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockLogoutInspect() {
+	for _, e := range m.LogoutMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.Logout at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLogoutCounter := mm_atomic.LoadUint64(&m.afterLogoutCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LogoutMock.defaultExpectation != nil && afterLogoutCounter < 1 {
+		if m.LogoutMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.Logout at\n%s", m.LogoutMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.Logout at\n%s with params: %#v", m.LogoutMock.defaultExpectation.expectationOrigins.origin, *m.LogoutMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLogout != nil && afterLogoutCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.Logout at\n%s", m.funcLogoutOrigin)
+	}
+
+	if !m.LogoutMock.invocationsDone() && afterLogoutCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.Logout at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LogoutMock.expectedInvocations), m.LogoutMock.expectedInvocationsOrigin, afterLogoutCounter)
+	}
+}
+
+type mAuthServiceMockLogoutAll struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockLogoutAllExpectation
+	expectations       []*AuthServiceMockLogoutAllExpectation
+
+	callArgs []*AuthServiceMockLogoutAllParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockLogoutAllExpectation specifies expectation struct of the AuthService.LogoutAll
+type AuthServiceMockLogoutAllExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockLogoutAllParams
+	paramPtrs          *AuthServiceMockLogoutAllParamPtrs
+	expectationOrigins AuthServiceMockLogoutAllExpectationOrigins
+	results            *AuthServiceMockLogoutAllResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockLogoutAllParams contains parameters of the AuthService.LogoutAll
+type AuthServiceMockLogoutAllParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// AuthServiceMockLogoutAllParamPtrs contains pointers to parameters of the AuthService.LogoutAll
+type AuthServiceMockLogoutAllParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// AuthServiceMockLogoutAllResults contains results of the AuthService.LogoutAll
+type AuthServiceMockLogoutAllResults struct {
+	err error
+}
+
+// AuthServiceMockLogoutAllOrigins contains origins of expectations of the AuthService.LogoutAll
+type AuthServiceMockLogoutAllExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLogoutAll *mAuthServiceMockLogoutAll) Optional() *mAuthServiceMockLogoutAll {
+	mmLogoutAll.optional = true
+	return mmLogoutAll
+}
+
+// Expect sets up expected params for AuthService.LogoutAll
+func (mmLogoutAll *mAuthServiceMockLogoutAll) Expect(ctx context.Context, userID string) *mAuthServiceMockLogoutAll {
+	if mmLogoutAll.mock.funcLogoutAll != nil {
+		mmLogoutAll.mock.t.Fatalf("AuthServiceMock.LogoutAll mock is already set by Set")
+	}
+
+	if mmLogoutAll.defaultExpectation == nil {
+		mmLogoutAll.defaultExpectation = &AuthServiceMockLogoutAllExpectation{}
+	}
+
+	if mmLogoutAll.defaultExpectation.paramPtrs != nil {
+		mmLogoutAll.mock.t.Fatalf("AuthServiceMock.LogoutAll mock is already set by ExpectParams functions")
+	}
+
+	mmLogoutAll.defaultExpectation.params = &AuthServiceMockLogoutAllParams{ctx, userID}
+	mmLogoutAll.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLogoutAll.expectations {
+		if minimock.Equal(e.params, mmLogoutAll.defaultExpectation.params) {
+			mmLogoutAll.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogoutAll.defaultExpectation.params)
+		}
+	}
+
+	return mmLogoutAll
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.LogoutAll
+func (mmLogoutAll *mAuthServiceMockLogoutAll) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockLogoutAll {
+	if mmLogoutAll.mock.funcLogoutAll != nil {
+		mmLogoutAll.mock.t.Fatalf("AuthServiceMock.LogoutAll mock is already set by Set")
+	}
+
+	if mmLogoutAll.defaultExpectation == nil {
+		mmLogoutAll.defaultExpectation = &AuthServiceMockLogoutAllExpectation{}
+	}
+
+	if mmLogoutAll.defaultExpectation.params != nil {
+		mmLogoutAll.mock.t.Fatalf("AuthServiceMock.LogoutAll mock is already set by Expect")
+	}
+
+	if mmLogoutAll.defaultExpectation.paramPtrs == nil {
+		mmLogoutAll.defaultExpectation.paramPtrs = &AuthServiceMockLogoutAllParamPtrs{}
+	}
+	mmLogoutAll.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLogoutAll.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLogoutAll
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthService.LogoutAll
+func (mmLogoutAll *mAuthServiceMockLogoutAll) ExpectUserIDParam2(userID string) *mAuthServiceMockLogoutAll {
+	if mmLogoutAll.mock.funcLogoutAll != nil {
+		mmLogoutAll.mock.t.Fatalf("AuthServiceMock.LogoutAll mock is already set by Set")
+	}
+
+	if mmLogoutAll.defaultExpectation == nil {
+		mmLogoutAll.defaultExpectation = &AuthServiceMockLogoutAllExpectation{}
+	}
+
+	if mmLogoutAll.defaultExpectation.params != nil {
+		mmLogoutAll.mock.t.Fatalf("AuthServiceMock.LogoutAll mock is already set by Expect")
+	}
+
+	if mmLogoutAll.defaultExpectation.paramPtrs == nil {
+		mmLogoutAll.defaultExpectation.paramPtrs = &AuthServiceMockLogoutAllParamPtrs{}
+	}
+	mmLogoutAll.defaultExpectation.paramPtrs.userID = &userID
+	mmLogoutAll.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmLogoutAll
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.LogoutAll
+func (mmLogoutAll *mAuthServiceMockLogoutAll) Inspect(f func(ctx context.Context, userID string)) *mAuthServiceMockLogoutAll {
+	if mmLogoutAll.mock.inspectFuncLogoutAll != nil {
+		mmLogoutAll.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.LogoutAll")
+	}
+
+	mmLogoutAll.mock.inspectFuncLogoutAll = f
+
+	return mmLogoutAll
+}
+
+// Return sets up results that will be returned by AuthService.LogoutAll
+func (mmLogoutAll *mAuthServiceMockLogoutAll) Return(err error) *AuthServiceMock {
+	if mmLogoutAll.mock.funcLogoutAll != nil {
+		mmLogoutAll.mock.t.Fatalf("AuthServiceMock.LogoutAll mock is already set by Set")
+	}
+
+	if mmLogoutAll.defaultExpectation == nil {
+		mmLogoutAll.defaultExpectation = &AuthServiceMockLogoutAllExpectation{mock: mmLogoutAll.mock}
+	}
+	mmLogoutAll.defaultExpectation.results = &AuthServiceMockLogoutAllResults{err}
+	mmLogoutAll.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLogoutAll.mock
+}
+
+// Set uses given function f to mock the AuthService.LogoutAll method
+func (mmLogoutAll *mAuthServiceMockLogoutAll) Set(f func(ctx context.Context, userID string) (err error)) *AuthServiceMock {
+	if mmLogoutAll.defaultExpectation != nil {
+		mmLogoutAll.mock.t.Fatalf("Default expectation is already set for the AuthService.LogoutAll method")
+	}
+
+	if len(mmLogoutAll.expectations) > 0 {
+		mmLogoutAll.mock.t.Fatalf("Some expectations are already set for the AuthService.LogoutAll method")
+	}
+
+	mmLogoutAll.mock.funcLogoutAll = f
+	mmLogoutAll.mock.funcLogoutAllOrigin = minimock.CallerInfo(1)
+	return mmLogoutAll.mock
+}
+
+// When sets expectation for the AuthService.LogoutAll which will trigger the result defined by the following
+// Then helper
+func (mmLogoutAll *mAuthServiceMockLogoutAll) When(ctx context.Context, userID string) *AuthServiceMockLogoutAllExpectation {
+	if mmLogoutAll.mock.funcLogoutAll != nil {
+		mmLogoutAll.mock.t.Fatalf("AuthServiceMock.LogoutAll mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockLogoutAllExpectation{
+		mock:               mmLogoutAll.mock,
+		params:             &AuthServiceMockLogoutAllParams{ctx, userID},
+		expectationOrigins: AuthServiceMockLogoutAllExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLogoutAll.expectations = append(mmLogoutAll.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.LogoutAll return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockLogoutAllExpectation) Then(err error) *AuthServiceMock {
+	e.results = &AuthServiceMockLogoutAllResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.LogoutAll should be invoked
+func (mmLogoutAll *mAuthServiceMockLogoutAll) Times(n uint64) *mAuthServiceMockLogoutAll {
+	if n == 0 {
+		mmLogoutAll.mock.t.Fatalf("Times of AuthServiceMock.LogoutAll mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLogoutAll.expectedInvocations, n)
+	mmLogoutAll.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLogoutAll
+}
+
+func (mmLogoutAll *mAuthServiceMockLogoutAll) invocationsDone() bool {
+	if len(mmLogoutAll.expectations) == 0 && mmLogoutAll.defaultExpectation == nil && mmLogoutAll.mock.funcLogoutAll == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLogoutAll.mock.afterLogoutAllCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLogoutAll.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LogoutAll implements mm_service.AuthService
+func (mmLogoutAll *AuthServiceMock) LogoutAll(ctx context.Context, userID string) (err error) {
+	mm_atomic.AddUint64(&mmLogoutAll.beforeLogoutAllCounter, 1)
+	defer mm_atomic.AddUint64(&mmLogoutAll.afterLogoutAllCounter, 1)
+
+	mmLogoutAll.t.Helper()
+
+	if mmLogoutAll.inspectFuncLogoutAll != nil {
+		mmLogoutAll.inspectFuncLogoutAll(ctx, userID)
+	}
+
+	mm_params := AuthServiceMockLogoutAllParams{ctx, userID}
+
+	// Record call args
+	mmLogoutAll.LogoutAllMock.mutex.Lock()
+	mmLogoutAll.LogoutAllMock.callArgs = append(mmLogoutAll.LogoutAllMock.callArgs, &mm_params)
+	mmLogoutAll.LogoutAllMock.mutex.Unlock()
+
+	for _, e := range mmLogoutAll.LogoutAllMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmLogoutAll.LogoutAllMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLogoutAll.LogoutAllMock.defaultExpectation.Counter, 1)
+		mm_want := mmLogoutAll.LogoutAllMock.defaultExpectation.params
+		mm_want_ptrs := mmLogoutAll.LogoutAllMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockLogoutAllParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLogoutAll.t.Errorf("AuthServiceMock.LogoutAll got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogoutAll.LogoutAllMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmLogoutAll.t.Errorf("AuthServiceMock.LogoutAll got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogoutAll.LogoutAllMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLogoutAll.t.Errorf("AuthServiceMock.LogoutAll got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLogoutAll.LogoutAllMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLogoutAll.LogoutAllMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLogoutAll.t.Fatal("No results are set for the AuthServiceMock.LogoutAll")
+		}
+		return (*mm_results).err
+	}
+	if mmLogoutAll.funcLogoutAll != nil {
+		return mmLogoutAll.funcLogoutAll(ctx, userID)
+	}
+	mmLogoutAll.t.Fatalf("Unexpected call to AuthServiceMock.LogoutAll. %v %v", ctx, userID)
+	return
+}
+
+// LogoutAllAfterCounter returns a count of finished AuthServiceMock.LogoutAll invocations
+func (mmLogoutAll *AuthServiceMock) LogoutAllAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogoutAll.afterLogoutAllCounter)
+}
+
+// LogoutAllBeforeCounter returns a count of AuthServiceMock.LogoutAll invocations
+func (mmLogoutAll *AuthServiceMock) LogoutAllBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogoutAll.beforeLogoutAllCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.LogoutAll.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLogoutAll *mAuthServiceMockLogoutAll) Calls() []*AuthServiceMockLogoutAllParams {
+	mmLogoutAll.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockLogoutAllParams, len(mmLogoutAll.callArgs))
+	copy(argCopy, mmLogoutAll.callArgs)
+
+	mmLogoutAll.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLogoutAllDone returns true if the count of the LogoutAll invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockLogoutAllDone() bool {
+	if m.LogoutAllMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LogoutAllMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LogoutAllMock.invocationsDone()
+}
+
+// MinimockLogoutAllInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockLogoutAllInspect() {
+	for _, e := range m.LogoutAllMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.LogoutAll at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLogoutAllCounter := mm_atomic.LoadUint64(&m.afterLogoutAllCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LogoutAllMock.defaultExpectation != nil && afterLogoutAllCounter < 1 {
+		if m.LogoutAllMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.LogoutAll at\n%s", m.LogoutAllMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.LogoutAll at\n%s with params: %#v", m.LogoutAllMock.defaultExpectation.expectationOrigins.origin, *m.LogoutAllMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLogoutAll != nil && afterLogoutAllCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.LogoutAll at\n%s", m.funcLogoutAllOrigin)
+	}
+
+	if !m.LogoutAllMock.invocationsDone() && afterLogoutAllCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.LogoutAll at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LogoutAllMock.expectedInvocations), m.LogoutAllMock.expectedInvocationsOrigin, afterLogoutAllCounter)
+	}
+}
+
+type mAuthServiceMockRequestPasswordReset struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockRequestPasswordResetExpectation
+	expectations       []*AuthServiceMockRequestPasswordResetExpectation
+
+	callArgs []*AuthServiceMockRequestPasswordResetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockRequestPasswordResetExpectation specifies expectation struct of the AuthService.RequestPasswordReset
+type AuthServiceMockRequestPasswordResetExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockRequestPasswordResetParams
+	paramPtrs          *AuthServiceMockRequestPasswordResetParamPtrs
+	expectationOrigins AuthServiceMockRequestPasswordResetExpectationOrigins
+	results            *AuthServiceMockRequestPasswordResetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockRequestPasswordResetParams contains parameters of the AuthService.RequestPasswordReset
+type AuthServiceMockRequestPasswordResetParams struct {
+	ctx   context.Context
+	email string
+}
+
+// AuthServiceMockRequestPasswordResetParamPtrs contains pointers to parameters of the AuthService.RequestPasswordReset
+type AuthServiceMockRequestPasswordResetParamPtrs struct {
+	ctx   *context.Context
+	email *string
+}
+
+// AuthServiceMockRequestPasswordResetResults contains results of the AuthService.RequestPasswordReset
+type AuthServiceMockRequestPasswordResetResults struct {
+	err error
+}
+
+// AuthServiceMockRequestPasswordResetOrigins contains origins of expectations of the AuthService.RequestPasswordReset
+type AuthServiceMockRequestPasswordResetExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEmail string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRequestPasswordReset *mAuthServiceMockRequestPasswordReset) Optional() *mAuthServiceMockRequestPasswordReset {
+	mmRequestPasswordReset.optional = true
+	return mmRequestPasswordReset
+}
+
+// Expect sets up expected params for AuthService.RequestPasswordReset
+func (mmRequestPasswordReset *mAuthServiceMockRequestPasswordReset) Expect(ctx context.Context, email string) *mAuthServiceMockRequestPasswordReset {
+	if mmRequestPasswordReset.mock.funcRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("AuthServiceMock.RequestPasswordReset mock is already set by Set")
+	}
+
+	if mmRequestPasswordReset.defaultExpectation == nil {
+		mmRequestPasswordReset.defaultExpectation = &AuthServiceMockRequestPasswordResetExpectation{}
+	}
+
+	if mmRequestPasswordReset.defaultExpectation.paramPtrs != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("AuthServiceMock.RequestPasswordReset mock is already set by ExpectParams functions")
+	}
+
+	mmRequestPasswordReset.defaultExpectation.params = &AuthServiceMockRequestPasswordResetParams{ctx, email}
+	mmRequestPasswordReset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRequestPasswordReset.expectations {
+		if minimock.Equal(e.params, mmRequestPasswordReset.defaultExpectation.params) {
+			mmRequestPasswordReset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRequestPasswordReset.defaultExpectation.params)
+		}
+	}
+
+	return mmRequestPasswordReset
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.RequestPasswordReset
+func (mmRequestPasswordReset *mAuthServiceMockRequestPasswordReset) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockRequestPasswordReset {
+	if mmRequestPasswordReset.mock.funcRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("AuthServiceMock.RequestPasswordReset mock is already set by Set")
+	}
+
+	if mmRequestPasswordReset.defaultExpectation == nil {
+		mmRequestPasswordReset.defaultExpectation = &AuthServiceMockRequestPasswordResetExpectation{}
+	}
+
+	if mmRequestPasswordReset.defaultExpectation.params != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("AuthServiceMock.RequestPasswordReset mock is already set by Expect")
+	}
+
+	if mmRequestPasswordReset.defaultExpectation.paramPtrs == nil {
+		mmRequestPasswordReset.defaultExpectation.paramPtrs = &AuthServiceMockRequestPasswordResetParamPtrs{}
+	}
+	mmRequestPasswordReset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRequestPasswordReset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRequestPasswordReset
+}
+
+// ExpectEmailParam2 sets up expected param email for AuthService.RequestPasswordReset
+func (mmRequestPasswordReset *mAuthServiceMockRequestPasswordReset) ExpectEmailParam2(email string) *mAuthServiceMockRequestPasswordReset {
+	if mmRequestPasswordReset.mock.funcRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("AuthServiceMock.RequestPasswordReset mock is already set by Set")
+	}
+
+	if mmRequestPasswordReset.defaultExpectation == nil {
+		mmRequestPasswordReset.defaultExpectation = &AuthServiceMockRequestPasswordResetExpectation{}
+	}
+
+	if mmRequestPasswordReset.defaultExpectation.params != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("AuthServiceMock.RequestPasswordReset mock is already set by Expect")
+	}
+
+	if mmRequestPasswordReset.defaultExpectation.paramPtrs == nil {
+		mmRequestPasswordReset.defaultExpectation.paramPtrs = &AuthServiceMockRequestPasswordResetParamPtrs{}
+	}
+	mmRequestPasswordReset.defaultExpectation.paramPtrs.email = &email
+	mmRequestPasswordReset.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmRequestPasswordReset
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.RequestPasswordReset
+func (mmRequestPasswordReset *mAuthServiceMockRequestPasswordReset) Inspect(f func(ctx context.Context, email string)) *mAuthServiceMockRequestPasswordReset {
+	if mmRequestPasswordReset.mock.inspectFuncRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.RequestPasswordReset")
+	}
+
+	mmRequestPasswordReset.mock.inspectFuncRequestPasswordReset = f
+
+	return mmRequestPasswordReset
+}
+
+// Return sets up results that will be returned by AuthService.RequestPasswordReset
+func (mmRequestPasswordReset *mAuthServiceMockRequestPasswordReset) Return(err error) *AuthServiceMock {
+	if mmRequestPasswordReset.mock.funcRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("AuthServiceMock.RequestPasswordReset mock is already set by Set")
+	}
+
+	if mmRequestPasswordReset.defaultExpectation == nil {
+		mmRequestPasswordReset.defaultExpectation = &AuthServiceMockRequestPasswordResetExpectation{mock: mmRequestPasswordReset.mock}
+	}
+	mmRequestPasswordReset.defaultExpectation.results = &AuthServiceMockRequestPasswordResetResults{err}
+	mmRequestPasswordReset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRequestPasswordReset.mock
+}
+
+// Set uses given function f to mock the AuthService.RequestPasswordReset method
+func (mmRequestPasswordReset *mAuthServiceMockRequestPasswordReset) Set(f func(ctx context.Context, email string) (err error)) *AuthServiceMock {
+	if mmRequestPasswordReset.defaultExpectation != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("Default expectation is already set for the AuthService.RequestPasswordReset method")
+	}
+
+	if len(mmRequestPasswordReset.expectations) > 0 {
+		mmRequestPasswordReset.mock.t.Fatalf("Some expectations are already set for the AuthService.RequestPasswordReset method")
+	}
+
+	mmRequestPasswordReset.mock.funcRequestPasswordReset = f
+	mmRequestPasswordReset.mock.funcRequestPasswordResetOrigin = minimock.CallerInfo(1)
+	return mmRequestPasswordReset.mock
+}
+
+// When sets expectation for the AuthService.RequestPasswordReset which will trigger the result defined by the following
+// Then helper
+func (mmRequestPasswordReset *mAuthServiceMockRequestPasswordReset) When(ctx context.Context, email string) *AuthServiceMockRequestPasswordResetExpectation {
+	if mmRequestPasswordReset.mock.funcRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("AuthServiceMock.RequestPasswordReset mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockRequestPasswordResetExpectation{
+		mock:               mmRequestPasswordReset.mock,
+		params:             &AuthServiceMockRequestPasswordResetParams{ctx, email},
+		expectationOrigins: AuthServiceMockRequestPasswordResetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRequestPasswordReset.expectations = append(mmRequestPasswordReset.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.RequestPasswordReset return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockRequestPasswordResetExpectation) Then(err error) *AuthServiceMock {
+	e.results = &AuthServiceMockRequestPasswordResetResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.RequestPasswordReset should be invoked
+func (mmRequestPasswordReset *mAuthServiceMockRequestPasswordReset) Times(n uint64) *mAuthServiceMockRequestPasswordReset {
+	if n == 0 {
+		mmRequestPasswordReset.mock.t.Fatalf("Times of AuthServiceMock.RequestPasswordReset mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRequestPasswordReset.expectedInvocations, n)
+	mmRequestPasswordReset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRequestPasswordReset
+}
+
+func (mmRequestPasswordReset *mAuthServiceMockRequestPasswordReset) invocationsDone() bool {
+	if len(mmRequestPasswordReset.expectations) == 0 && mmRequestPasswordReset.defaultExpectation == nil && mmRequestPasswordReset.mock.funcRequestPasswordReset == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRequestPasswordReset.mock.afterRequestPasswordResetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRequestPasswordReset.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RequestPasswordReset implements mm_service.AuthService
+func (mmRequestPasswordReset *AuthServiceMock) RequestPasswordReset(ctx context.Context, email string) (err error) {
+	mm_atomic.AddUint64(&mmRequestPasswordReset.beforeRequestPasswordResetCounter, 1)
+	defer mm_atomic.AddUint64(&mmRequestPasswordReset.afterRequestPasswordResetCounter, 1)
+
+	mmRequestPasswordReset.t.Helper()
+
+	if mmRequestPasswordReset.inspectFuncRequestPasswordReset != nil {
+		mmRequestPasswordReset.inspectFuncRequestPasswordReset(ctx, email)
+	}
+
+	mm_params := AuthServiceMockRequestPasswordResetParams{ctx, email}
+
+	// Record call args
+	mmRequestPasswordReset.RequestPasswordResetMock.mutex.Lock()
+	mmRequestPasswordReset.RequestPasswordResetMock.callArgs = append(mmRequestPasswordReset.RequestPasswordResetMock.callArgs, &mm_params)
+	mmRequestPasswordReset.RequestPasswordResetMock.mutex.Unlock()
+
+	for _, e := range mmRequestPasswordReset.RequestPasswordResetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.Counter, 1)
+		mm_want := mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.params
+		mm_want_ptrs := mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockRequestPasswordResetParams{ctx, email}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRequestPasswordReset.t.Errorf("AuthServiceMock.RequestPasswordReset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmRequestPasswordReset.t.Errorf("AuthServiceMock.RequestPasswordReset got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRequestPasswordReset.t.Errorf("AuthServiceMock.RequestPasswordReset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRequestPasswordReset.t.Fatal("No results are set for the AuthServiceMock.RequestPasswordReset")
+		}
+		return (*mm_results).err
+	}
+	if mmRequestPasswordReset.funcRequestPasswordReset != nil {
+		return mmRequestPasswordReset.funcRequestPasswordReset(ctx, email)
+	}
+	mmRequestPasswordReset.t.Fatalf("Unexpected call to AuthServiceMock.RequestPasswordReset. %v %v", ctx, email)
+	return
+}
+
+// RequestPasswordResetAfterCounter returns a count of finished AuthServiceMock.RequestPasswordReset invocations
+func (mmRequestPasswordReset *AuthServiceMock) RequestPasswordResetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestPasswordReset.afterRequestPasswordResetCounter)
+}
+
+// RequestPasswordResetBeforeCounter returns a count of AuthServiceMock.RequestPasswordReset invocations
+func (mmRequestPasswordReset *AuthServiceMock) RequestPasswordResetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestPasswordReset.beforeRequestPasswordResetCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.RequestPasswordReset.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRequestPasswordReset *mAuthServiceMockRequestPasswordReset) Calls() []*AuthServiceMockRequestPasswordResetParams {
+	mmRequestPasswordReset.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockRequestPasswordResetParams, len(mmRequestPasswordReset.callArgs))
+	copy(argCopy, mmRequestPasswordReset.callArgs)
+
+	mmRequestPasswordReset.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRequestPasswordResetDone returns true if the count of the RequestPasswordReset invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockRequestPasswordResetDone() bool {
+	if m.RequestPasswordResetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RequestPasswordResetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RequestPasswordResetMock.invocationsDone()
+}
+
+// MinimockRequestPasswordResetInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockRequestPasswordResetInspect() {
+	for _, e := range m.RequestPasswordResetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.RequestPasswordReset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRequestPasswordResetCounter := mm_atomic.LoadUint64(&m.afterRequestPasswordResetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RequestPasswordResetMock.defaultExpectation != nil && afterRequestPasswordResetCounter < 1 {
+		if m.RequestPasswordResetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.RequestPasswordReset at\n%s", m.RequestPasswordResetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.RequestPasswordReset at\n%s with params: %#v", m.RequestPasswordResetMock.defaultExpectation.expectationOrigins.origin, *m.RequestPasswordResetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRequestPasswordReset != nil && afterRequestPasswordResetCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.RequestPasswordReset at\n%s", m.funcRequestPasswordResetOrigin)
+	}
+
+	if !m.RequestPasswordResetMock.invocationsDone() && afterRequestPasswordResetCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.RequestPasswordReset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RequestPasswordResetMock.expectedInvocations), m.RequestPasswordResetMock.expectedInvocationsOrigin, afterRequestPasswordResetCounter)
+	}
+}
+
+type mAuthServiceMockResetPassword struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockResetPasswordExpectation
+	expectations       []*AuthServiceMockResetPasswordExpectation
+
+	callArgs []*AuthServiceMockResetPasswordParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockResetPasswordExpectation specifies expectation struct of the AuthService.ResetPassword
+type AuthServiceMockResetPasswordExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockResetPasswordParams
+	paramPtrs          *AuthServiceMockResetPasswordParamPtrs
+	expectationOrigins AuthServiceMockResetPasswordExpectationOrigins
+	results            *AuthServiceMockResetPasswordResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockResetPasswordParams contains parameters of the AuthService.ResetPassword
+type AuthServiceMockResetPasswordParams struct {
+	ctx         context.Context
+	token       string
+	newPassword string
+}
+
+// AuthServiceMockResetPasswordParamPtrs contains pointers to parameters of the AuthService.ResetPassword
+type AuthServiceMockResetPasswordParamPtrs struct {
+	ctx         *context.Context
+	token       *string
+	newPassword *string
+}
+
+// AuthServiceMockResetPasswordResults contains results of the AuthService.ResetPassword
+type AuthServiceMockResetPasswordResults struct {
+	err error
+}
+
+// AuthServiceMockResetPasswordOrigins contains origins of expectations of the AuthService.ResetPassword
+type AuthServiceMockResetPasswordExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originToken       string
+	originNewPassword string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmResetPassword *mAuthServiceMockResetPassword) Optional() *mAuthServiceMockResetPassword {
+	mmResetPassword.optional = true
+	return mmResetPassword
+}
+
+// Expect sets up expected params for AuthService.ResetPassword
+func (mmResetPassword *mAuthServiceMockResetPassword) Expect(ctx context.Context, token string, newPassword string) *mAuthServiceMockResetPassword {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("AuthServiceMock.ResetPassword mock is already set by Set")
+	}
+
+	if mmResetPassword.defaultExpectation == nil {
+		mmResetPassword.defaultExpectation = &AuthServiceMockResetPasswordExpectation{}
+	}
+
+	if mmResetPassword.defaultExpectation.paramPtrs != nil {
+		mmResetPassword.mock.t.Fatalf("AuthServiceMock.ResetPassword mock is already set by ExpectParams functions")
+	}
+
+	mmResetPassword.defaultExpectation.params = &AuthServiceMockResetPasswordParams{ctx, token, newPassword}
+	mmResetPassword.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmResetPassword.expectations {
+		if minimock.Equal(e.params, mmResetPassword.defaultExpectation.params) {
+			mmResetPassword.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmResetPassword.defaultExpectation.params)
+		}
+	}
+
+	return mmResetPassword
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.ResetPassword
+func (mmResetPassword *mAuthServiceMockResetPassword) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockResetPassword {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("AuthServiceMock.ResetPassword mock is already set by Set")
+	}
+
+	if mmResetPassword.defaultExpectation == nil {
+		mmResetPassword.defaultExpectation = &AuthServiceMockResetPasswordExpectation{}
+	}
+
+	if mmResetPassword.defaultExpectation.params != nil {
+		mmResetPassword.mock.t.Fatalf("AuthServiceMock.ResetPassword mock is already set by Expect")
+	}
+
+	if mmResetPassword.defaultExpectation.paramPtrs == nil {
+		mmResetPassword.defaultExpectation.paramPtrs = &AuthServiceMockResetPasswordParamPtrs{}
+	}
+	mmResetPassword.defaultExpectation.paramPtrs.ctx = &ctx
+	mmResetPassword.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmResetPassword
+}
+
+// ExpectTokenParam2 sets up expected param token for AuthService.ResetPassword
+func (mmResetPassword *mAuthServiceMockResetPassword) ExpectTokenParam2(token string) *mAuthServiceMockResetPassword {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("AuthServiceMock.ResetPassword mock is already set by Set")
+	}
+
+	if mmResetPassword.defaultExpectation == nil {
+		mmResetPassword.defaultExpectation = &AuthServiceMockResetPasswordExpectation{}
+	}
+
+	if mmResetPassword.defaultExpectation.params != nil {
+		mmResetPassword.mock.t.Fatalf("AuthServiceMock.ResetPassword mock is already set by Expect")
+	}
+
+	if mmResetPassword.defaultExpectation.paramPtrs == nil {
+		mmResetPassword.defaultExpectation.paramPtrs = &AuthServiceMockResetPasswordParamPtrs{}
+	}
+	mmResetPassword.defaultExpectation.paramPtrs.token = &token
+	mmResetPassword.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmResetPassword
+}
+
+// ExpectNewPasswordParam3 sets up expected param newPassword for AuthService.ResetPassword
+func (mmResetPassword *mAuthServiceMockResetPassword) ExpectNewPasswordParam3(newPassword string) *mAuthServiceMockResetPassword {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("AuthServiceMock.ResetPassword mock is already set by Set")
+	}
+
+	if mmResetPassword.defaultExpectation == nil {
+		mmResetPassword.defaultExpectation = &AuthServiceMockResetPasswordExpectation{}
+	}
+
+	if mmResetPassword.defaultExpectation.params != nil {
+		mmResetPassword.mock.t.Fatalf("AuthServiceMock.ResetPassword mock is already set by Expect")
+	}
+
+	if mmResetPassword.defaultExpectation.paramPtrs == nil {
+		mmResetPassword.defaultExpectation.paramPtrs = &AuthServiceMockResetPasswordParamPtrs{}
+	}
+	mmResetPassword.defaultExpectation.paramPtrs.newPassword = &newPassword
+	mmResetPassword.defaultExpectation.expectationOrigins.originNewPassword = minimock.CallerInfo(1)
+
+	return mmResetPassword
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.ResetPassword
+func (mmResetPassword *mAuthServiceMockResetPassword) Inspect(f func(ctx context.Context, token string, newPassword string)) *mAuthServiceMockResetPassword {
+	if mmResetPassword.mock.inspectFuncResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.ResetPassword")
+	}
+
+	mmResetPassword.mock.inspectFuncResetPassword = f
+
+	return mmResetPassword
+}
+
+// Return sets up results that will be returned by AuthService.ResetPassword
+func (mmResetPassword *mAuthServiceMockResetPassword) Return(err error) *AuthServiceMock {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("AuthServiceMock.ResetPassword mock is already set by Set")
+	}
+
+	if mmResetPassword.defaultExpectation == nil {
+		mmResetPassword.defaultExpectation = &AuthServiceMockResetPasswordExpectation{mock: mmResetPassword.mock}
+	}
+	mmResetPassword.defaultExpectation.results = &AuthServiceMockResetPasswordResults{err}
+	mmResetPassword.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmResetPassword.mock
+}
+
+// Set uses given function f to mock the AuthService.ResetPassword method
+func (mmResetPassword *mAuthServiceMockResetPassword) Set(f func(ctx context.Context, token string, newPassword string) (err error)) *AuthServiceMock {
+	if mmResetPassword.defaultExpectation != nil {
+		mmResetPassword.mock.t.Fatalf("Default expectation is already set for the AuthService.ResetPassword method")
+	}
+
+	if len(mmResetPassword.expectations) > 0 {
+		mmResetPassword.mock.t.Fatalf("Some expectations are already set for the AuthService.ResetPassword method")
+	}
+
+	mmResetPassword.mock.funcResetPassword = f
+	mmResetPassword.mock.funcResetPasswordOrigin = minimock.CallerInfo(1)
+	return mmResetPassword.mock
+}
+
+// When sets expectation for the AuthService.ResetPassword which will trigger the result defined by the following
+// Then helper
+func (mmResetPassword *mAuthServiceMockResetPassword) When(ctx context.Context, token string, newPassword string) *AuthServiceMockResetPasswordExpectation {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("AuthServiceMock.ResetPassword mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockResetPasswordExpectation{
+		mock:               mmResetPassword.mock,
+		params:             &AuthServiceMockResetPasswordParams{ctx, token, newPassword},
+		expectationOrigins: AuthServiceMockResetPasswordExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmResetPassword.expectations = append(mmResetPassword.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.ResetPassword return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockResetPasswordExpectation) Then(err error) *AuthServiceMock {
+	e.results = &AuthServiceMockResetPasswordResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.ResetPassword should be invoked
+func (mmResetPassword *mAuthServiceMockResetPassword) Times(n uint64) *mAuthServiceMockResetPassword {
+	if n == 0 {
+		mmResetPassword.mock.t.Fatalf("Times of AuthServiceMock.ResetPassword mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmResetPassword.expectedInvocations, n)
+	mmResetPassword.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmResetPassword
+}
+
+func (mmResetPassword *mAuthServiceMockResetPassword) invocationsDone() bool {
+	if len(mmResetPassword.expectations) == 0 && mmResetPassword.defaultExpectation == nil && mmResetPassword.mock.funcResetPassword == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmResetPassword.mock.afterResetPasswordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmResetPassword.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ResetPassword implements mm_service.AuthService
+func (mmResetPassword *AuthServiceMock) ResetPassword(ctx context.Context, token string, newPassword string) (err error) {
+	mm_atomic.AddUint64(&mmResetPassword.beforeResetPasswordCounter, 1)
+	defer mm_atomic.AddUint64(&mmResetPassword.afterResetPasswordCounter, 1)
+
+	mmResetPassword.t.Helper()
+
+	if mmResetPassword.inspectFuncResetPassword != nil {
+		mmResetPassword.inspectFuncResetPassword(ctx, token, newPassword)
+	}
+
+	mm_params := AuthServiceMockResetPasswordParams{ctx, token, newPassword}
+
+	// Record call args
+	mmResetPassword.ResetPasswordMock.mutex.Lock()
+	mmResetPassword.ResetPasswordMock.callArgs = append(mmResetPassword.ResetPasswordMock.callArgs, &mm_params)
+	mmResetPassword.ResetPasswordMock.mutex.Unlock()
+
+	for _, e := range mmResetPassword.ResetPasswordMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmResetPassword.ResetPasswordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmResetPassword.ResetPasswordMock.defaultExpectation.Counter, 1)
+		mm_want := mmResetPassword.ResetPasswordMock.defaultExpectation.params
+		mm_want_ptrs := mmResetPassword.ResetPasswordMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockResetPasswordParams{ctx, token, newPassword}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmResetPassword.t.Errorf("AuthServiceMock.ResetPassword got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResetPassword.ResetPasswordMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmResetPassword.t.Errorf("AuthServiceMock.ResetPassword got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResetPassword.ResetPasswordMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+			if mm_want_ptrs.newPassword != nil && !minimock.Equal(*mm_want_ptrs.newPassword, mm_got.newPassword) {
+				mmResetPassword.t.Errorf("AuthServiceMock.ResetPassword got unexpected parameter newPassword, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResetPassword.ResetPasswordMock.defaultExpectation.expectationOrigins.originNewPassword, *mm_want_ptrs.newPassword, mm_got.newPassword, minimock.Diff(*mm_want_ptrs.newPassword, mm_got.newPassword))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmResetPassword.t.Errorf("AuthServiceMock.ResetPassword got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmResetPassword.ResetPasswordMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmResetPassword.ResetPasswordMock.defaultExpectation.results
+		if mm_results == nil {
+			mmResetPassword.t.Fatal("No results are set for the AuthServiceMock.ResetPassword")
+		}
+		return (*mm_results).err
+	}
+	if mmResetPassword.funcResetPassword != nil {
+		return mmResetPassword.funcResetPassword(ctx, token, newPassword)
+	}
+	mmResetPassword.t.Fatalf("Unexpected call to AuthServiceMock.ResetPassword. %v %v %v", ctx, token, newPassword)
+	return
+}
+
+// ResetPasswordAfterCounter returns a count of finished AuthServiceMock.ResetPassword invocations
+func (mmResetPassword *AuthServiceMock) ResetPasswordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResetPassword.afterResetPasswordCounter)
+}
+
+// ResetPasswordBeforeCounter returns a count of AuthServiceMock.ResetPassword invocations
+func (mmResetPassword *AuthServiceMock) ResetPasswordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResetPassword.beforeResetPasswordCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.ResetPassword.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmResetPassword *mAuthServiceMockResetPassword) Calls() []*AuthServiceMockResetPasswordParams {
+	mmResetPassword.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockResetPasswordParams, len(mmResetPassword.callArgs))
+	copy(argCopy, mmResetPassword.callArgs)
+
+	mmResetPassword.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockResetPasswordDone returns true if the count of the ResetPassword invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockResetPasswordDone() bool {
+	if m.ResetPasswordMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ResetPasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ResetPasswordMock.invocationsDone()
+}
+
+// MinimockResetPasswordInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockResetPasswordInspect() {
+	for _, e := range m.ResetPasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.ResetPassword at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterResetPasswordCounter := mm_atomic.LoadUint64(&m.afterResetPasswordCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResetPasswordMock.defaultExpectation != nil && afterResetPasswordCounter < 1 {
+		if m.ResetPasswordMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.ResetPassword at\n%s", m.ResetPasswordMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.ResetPassword at\n%s with params: %#v", m.ResetPasswordMock.defaultExpectation.expectationOrigins.origin, *m.ResetPasswordMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcResetPassword != nil && afterResetPasswordCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.ResetPassword at\n%s", m.funcResetPasswordOrigin)
+	}
+
+	if !m.ResetPasswordMock.invocationsDone() && afterResetPasswordCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.ResetPassword at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ResetPasswordMock.expectedInvocations), m.ResetPasswordMock.expectedInvocationsOrigin, afterResetPasswordCounter)
+	}
+}
+
+type mAuthServiceMockRevokeAllSessions struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockRevokeAllSessionsExpectation
+	expectations       []*AuthServiceMockRevokeAllSessionsExpectation
+
+	callArgs []*AuthServiceMockRevokeAllSessionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockRevokeAllSessionsExpectation specifies expectation struct of the AuthService.RevokeAllSessions
+type AuthServiceMockRevokeAllSessionsExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockRevokeAllSessionsParams
+	paramPtrs          *AuthServiceMockRevokeAllSessionsParamPtrs
+	expectationOrigins AuthServiceMockRevokeAllSessionsExpectationOrigins
+	results            *AuthServiceMockRevokeAllSessionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockRevokeAllSessionsParams contains parameters of the AuthService.RevokeAllSessions
+type AuthServiceMockRevokeAllSessionsParams struct {
+	ctx             context.Context
+	userID          string
+	exceptSessionID string
+}
+
+// AuthServiceMockRevokeAllSessionsParamPtrs contains pointers to parameters of the AuthService.RevokeAllSessions
+type AuthServiceMockRevokeAllSessionsParamPtrs struct {
+	ctx             *context.Context
+	userID          *string
+	exceptSessionID *string
+}
+
+// AuthServiceMockRevokeAllSessionsResults contains results of the AuthService.RevokeAllSessions
+type AuthServiceMockRevokeAllSessionsResults struct {
+	err error
+}
+
+// AuthServiceMockRevokeAllSessionsOrigins contains origins of expectations of the AuthService.RevokeAllSessions
+type AuthServiceMockRevokeAllSessionsExpectationOrigins struct {
+	origin                string
+	originCtx             string
+	originUserID          string
+	originExceptSessionID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeAllSessions *mAuthServiceMockRevokeAllSessions) Optional() *mAuthServiceMockRevokeAllSessions {
+	mmRevokeAllSessions.optional = true
+	return mmRevokeAllSessions
+}
+
+// Expect sets up expected params for AuthService.RevokeAllSessions
+func (mmRevokeAllSessions *mAuthServiceMockRevokeAllSessions) Expect(ctx context.Context, userID string, exceptSessionID string) *mAuthServiceMockRevokeAllSessions {
+	if mmRevokeAllSessions.mock.funcRevokeAllSessions != nil {
+		mmRevokeAllSessions.mock.t.Fatalf("AuthServiceMock.RevokeAllSessions mock is already set by Set")
+	}
+
+	if mmRevokeAllSessions.defaultExpectation == nil {
+		mmRevokeAllSessions.defaultExpectation = &AuthServiceMockRevokeAllSessionsExpectation{}
+	}
+
+	if mmRevokeAllSessions.defaultExpectation.paramPtrs != nil {
+		mmRevokeAllSessions.mock.t.Fatalf("AuthServiceMock.RevokeAllSessions mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeAllSessions.defaultExpectation.params = &AuthServiceMockRevokeAllSessionsParams{ctx, userID, exceptSessionID}
+	mmRevokeAllSessions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevokeAllSessions.expectations {
+		if minimock.Equal(e.params, mmRevokeAllSessions.defaultExpectation.params) {
+			mmRevokeAllSessions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeAllSessions.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeAllSessions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.RevokeAllSessions
+func (mmRevokeAllSessions *mAuthServiceMockRevokeAllSessions) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockRevokeAllSessions {
+	if mmRevokeAllSessions.mock.funcRevokeAllSessions != nil {
+		mmRevokeAllSessions.mock.t.Fatalf("AuthServiceMock.RevokeAllSessions mock is already set by Set")
+	}
+
+	if mmRevokeAllSessions.defaultExpectation == nil {
+		mmRevokeAllSessions.defaultExpectation = &AuthServiceMockRevokeAllSessionsExpectation{}
+	}
+
+	if mmRevokeAllSessions.defaultExpectation.params != nil {
+		mmRevokeAllSessions.mock.t.Fatalf("AuthServiceMock.RevokeAllSessions mock is already set by Expect")
+	}
+
+	if mmRevokeAllSessions.defaultExpectation.paramPtrs == nil {
+		mmRevokeAllSessions.defaultExpectation.paramPtrs = &AuthServiceMockRevokeAllSessionsParamPtrs{}
+	}
+	mmRevokeAllSessions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevokeAllSessions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevokeAllSessions
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthService.RevokeAllSessions
+func (mmRevokeAllSessions *mAuthServiceMockRevokeAllSessions) ExpectUserIDParam2(userID string) *mAuthServiceMockRevokeAllSessions {
+	if mmRevokeAllSessions.mock.funcRevokeAllSessions != nil {
+		mmRevokeAllSessions.mock.t.Fatalf("AuthServiceMock.RevokeAllSessions mock is already set by Set")
+	}
+
+	if mmRevokeAllSessions.defaultExpectation == nil {
+		mmRevokeAllSessions.defaultExpectation = &AuthServiceMockRevokeAllSessionsExpectation{}
+	}
+
+	if mmRevokeAllSessions.defaultExpectation.params != nil {
+		mmRevokeAllSessions.mock.t.Fatalf("AuthServiceMock.RevokeAllSessions mock is already set by Expect")
+	}
+
+	if mmRevokeAllSessions.defaultExpectation.paramPtrs == nil {
+		mmRevokeAllSessions.defaultExpectation.paramPtrs = &AuthServiceMockRevokeAllSessionsParamPtrs{}
+	}
+	mmRevokeAllSessions.defaultExpectation.paramPtrs.userID = &userID
+	mmRevokeAllSessions.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRevokeAllSessions
+}
+
+// ExpectExceptSessionIDParam3 sets up expected param exceptSessionID for AuthService.RevokeAllSessions
+func (mmRevokeAllSessions *mAuthServiceMockRevokeAllSessions) ExpectExceptSessionIDParam3(exceptSessionID string) *mAuthServiceMockRevokeAllSessions {
+	if mmRevokeAllSessions.mock.funcRevokeAllSessions != nil {
+		mmRevokeAllSessions.mock.t.Fatalf("AuthServiceMock.RevokeAllSessions mock is already set by Set")
+	}
+
+	if mmRevokeAllSessions.defaultExpectation == nil {
+		mmRevokeAllSessions.defaultExpectation = &AuthServiceMockRevokeAllSessionsExpectation{}
+	}
+
+	if mmRevokeAllSessions.defaultExpectation.params != nil {
+		mmRevokeAllSessions.mock.t.Fatalf("AuthServiceMock.RevokeAllSessions mock is already set by Expect")
+	}
+
+	if mmRevokeAllSessions.defaultExpectation.paramPtrs == nil {
+		mmRevokeAllSessions.defaultExpectation.paramPtrs = &AuthServiceMockRevokeAllSessionsParamPtrs{}
+	}
+	mmRevokeAllSessions.defaultExpectation.paramPtrs.exceptSessionID = &exceptSessionID
+	mmRevokeAllSessions.defaultExpectation.expectationOrigins.originExceptSessionID = minimock.CallerInfo(1)
+
+	return mmRevokeAllSessions
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.RevokeAllSessions
+func (mmRevokeAllSessions *mAuthServiceMockRevokeAllSessions) Inspect(f func(ctx context.Context, userID string, exceptSessionID string)) *mAuthServiceMockRevokeAllSessions {
+	if mmRevokeAllSessions.mock.inspectFuncRevokeAllSessions != nil {
+		mmRevokeAllSessions.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.RevokeAllSessions")
+	}
+
+	mmRevokeAllSessions.mock.inspectFuncRevokeAllSessions = f
+
+	return mmRevokeAllSessions
+}
+
+// Return sets up results that will be returned by AuthService.RevokeAllSessions
+func (mmRevokeAllSessions *mAuthServiceMockRevokeAllSessions) Return(err error) *AuthServiceMock {
+	if mmRevokeAllSessions.mock.funcRevokeAllSessions != nil {
+		mmRevokeAllSessions.mock.t.Fatalf("AuthServiceMock.RevokeAllSessions mock is already set by Set")
+	}
+
+	if mmRevokeAllSessions.defaultExpectation == nil {
+		mmRevokeAllSessions.defaultExpectation = &AuthServiceMockRevokeAllSessionsExpectation{mock: mmRevokeAllSessions.mock}
+	}
+	mmRevokeAllSessions.defaultExpectation.results = &AuthServiceMockRevokeAllSessionsResults{err}
+	mmRevokeAllSessions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevokeAllSessions.mock
+}
+
+// Set uses given function f to mock the AuthService.RevokeAllSessions method
+func (mmRevokeAllSessions *mAuthServiceMockRevokeAllSessions) Set(f func(ctx context.Context, userID string, exceptSessionID string) (err error)) *AuthServiceMock {
+	if mmRevokeAllSessions.defaultExpectation != nil {
+		mmRevokeAllSessions.mock.t.Fatalf("Default expectation is already set for the AuthService.RevokeAllSessions method")
+	}
+
+	if len(mmRevokeAllSessions.expectations) > 0 {
+		mmRevokeAllSessions.mock.t.Fatalf("Some expectations are already set for the AuthService.RevokeAllSessions method")
+	}
+
+	mmRevokeAllSessions.mock.funcRevokeAllSessions = f
+	mmRevokeAllSessions.mock.funcRevokeAllSessionsOrigin = minimock.CallerInfo(1)
+	return mmRevokeAllSessions.mock
+}
+
+// When sets expectation for the AuthService.RevokeAllSessions which will trigger the result defined by the following
+// Then helper
+func (mmRevokeAllSessions *mAuthServiceMockRevokeAllSessions) When(ctx context.Context, userID string, exceptSessionID string) *AuthServiceMockRevokeAllSessionsExpectation {
+	if mmRevokeAllSessions.mock.funcRevokeAllSessions != nil {
+		mmRevokeAllSessions.mock.t.Fatalf("AuthServiceMock.RevokeAllSessions mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockRevokeAllSessionsExpectation{
+		mock:               mmRevokeAllSessions.mock,
+		params:             &AuthServiceMockRevokeAllSessionsParams{ctx, userID, exceptSessionID},
+		expectationOrigins: AuthServiceMockRevokeAllSessionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevokeAllSessions.expectations = append(mmRevokeAllSessions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.RevokeAllSessions return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockRevokeAllSessionsExpectation) Then(err error) *AuthServiceMock {
+	e.results = &AuthServiceMockRevokeAllSessionsResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.RevokeAllSessions should be invoked
+func (mmRevokeAllSessions *mAuthServiceMockRevokeAllSessions) Times(n uint64) *mAuthServiceMockRevokeAllSessions {
+	if n == 0 {
+		mmRevokeAllSessions.mock.t.Fatalf("Times of AuthServiceMock.RevokeAllSessions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeAllSessions.expectedInvocations, n)
+	mmRevokeAllSessions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevokeAllSessions
+}
+
+func (mmRevokeAllSessions *mAuthServiceMockRevokeAllSessions) invocationsDone() bool {
+	if len(mmRevokeAllSessions.expectations) == 0 && mmRevokeAllSessions.defaultExpectation == nil && mmRevokeAllSessions.mock.funcRevokeAllSessions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeAllSessions.mock.afterRevokeAllSessionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeAllSessions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeAllSessions implements mm_service.AuthService
+func (mmRevokeAllSessions *AuthServiceMock) RevokeAllSessions(ctx context.Context, userID string, exceptSessionID string) (err error) {
+	mm_atomic.AddUint64(&mmRevokeAllSessions.beforeRevokeAllSessionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeAllSessions.afterRevokeAllSessionsCounter, 1)
+
+	mmRevokeAllSessions.t.Helper()
+
+	if mmRevokeAllSessions.inspectFuncRevokeAllSessions != nil {
+		mmRevokeAllSessions.inspectFuncRevokeAllSessions(ctx, userID, exceptSessionID)
+	}
+
+	mm_params := AuthServiceMockRevokeAllSessionsParams{ctx, userID, exceptSessionID}
+
+	// Record call args
+	mmRevokeAllSessions.RevokeAllSessionsMock.mutex.Lock()
+	mmRevokeAllSessions.RevokeAllSessionsMock.callArgs = append(mmRevokeAllSessions.RevokeAllSessionsMock.callArgs, &mm_params)
+	mmRevokeAllSessions.RevokeAllSessionsMock.mutex.Unlock()
+
+	for _, e := range mmRevokeAllSessions.RevokeAllSessionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeAllSessions.RevokeAllSessionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeAllSessions.RevokeAllSessionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeAllSessions.RevokeAllSessionsMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeAllSessions.RevokeAllSessionsMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockRevokeAllSessionsParams{ctx, userID, exceptSessionID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeAllSessions.t.Errorf("AuthServiceMock.RevokeAllSessions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeAllSessions.RevokeAllSessionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRevokeAllSessions.t.Errorf("AuthServiceMock.RevokeAllSessions got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeAllSessions.RevokeAllSessionsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.exceptSessionID != nil && !minimock.Equal(*mm_want_ptrs.exceptSessionID, mm_got.exceptSessionID) {
+				mmRevokeAllSessions.t.Errorf("AuthServiceMock.RevokeAllSessions got unexpected parameter exceptSessionID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeAllSessions.RevokeAllSessionsMock.defaultExpectation.expectationOrigins.originExceptSessionID, *mm_want_ptrs.exceptSessionID, mm_got.exceptSessionID, minimock.Diff(*mm_want_ptrs.exceptSessionID, mm_got.exceptSessionID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeAllSessions.t.Errorf("AuthServiceMock.RevokeAllSessions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevokeAllSessions.RevokeAllSessionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeAllSessions.RevokeAllSessionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeAllSessions.t.Fatal("No results are set for the AuthServiceMock.RevokeAllSessions")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeAllSessions.funcRevokeAllSessions != nil {
+		return mmRevokeAllSessions.funcRevokeAllSessions(ctx, userID, exceptSessionID)
+	}
+	mmRevokeAllSessions.t.Fatalf("Unexpected call to AuthServiceMock.RevokeAllSessions. %v %v %v", ctx, userID, exceptSessionID)
+	return
+}
+
+// RevokeAllSessionsAfterCounter returns a count of finished AuthServiceMock.RevokeAllSessions invocations
+func (mmRevokeAllSessions *AuthServiceMock) RevokeAllSessionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeAllSessions.afterRevokeAllSessionsCounter)
+}
+
+// RevokeAllSessionsBeforeCounter returns a count of AuthServiceMock.RevokeAllSessions invocations
+func (mmRevokeAllSessions *AuthServiceMock) RevokeAllSessionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeAllSessions.beforeRevokeAllSessionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.RevokeAllSessions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeAllSessions *mAuthServiceMockRevokeAllSessions) Calls() []*AuthServiceMockRevokeAllSessionsParams {
+	mmRevokeAllSessions.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockRevokeAllSessionsParams, len(mmRevokeAllSessions.callArgs))
+	copy(argCopy, mmRevokeAllSessions.callArgs)
+
+	mmRevokeAllSessions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeAllSessionsDone returns true if the count of the RevokeAllSessions invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockRevokeAllSessionsDone() bool {
+	if m.RevokeAllSessionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeAllSessionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeAllSessionsMock.invocationsDone()
+}
+
+// MinimockRevokeAllSessionsInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockRevokeAllSessionsInspect() {
+	for _, e := range m.RevokeAllSessionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.RevokeAllSessions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevokeAllSessionsCounter := mm_atomic.LoadUint64(&m.afterRevokeAllSessionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeAllSessionsMock.defaultExpectation != nil && afterRevokeAllSessionsCounter < 1 {
+		if m.RevokeAllSessionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.RevokeAllSessions at\n%s", m.RevokeAllSessionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.RevokeAllSessions at\n%s with params: %#v", m.RevokeAllSessionsMock.defaultExpectation.expectationOrigins.origin, *m.RevokeAllSessionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeAllSessions != nil && afterRevokeAllSessionsCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.RevokeAllSessions at\n%s", m.funcRevokeAllSessionsOrigin)
+	}
+
+	if !m.RevokeAllSessionsMock.invocationsDone() && afterRevokeAllSessionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.RevokeAllSessions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeAllSessionsMock.expectedInvocations), m.RevokeAllSessionsMock.expectedInvocationsOrigin, afterRevokeAllSessionsCounter)
+	}
+}
+
+type mAuthServiceMockRevokeSession struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockRevokeSessionExpectation
+	expectations       []*AuthServiceMockRevokeSessionExpectation
+
+	callArgs []*AuthServiceMockRevokeSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockRevokeSessionExpectation specifies expectation struct of the AuthService.RevokeSession
+type AuthServiceMockRevokeSessionExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockRevokeSessionParams
+	paramPtrs          *AuthServiceMockRevokeSessionParamPtrs
+	expectationOrigins AuthServiceMockRevokeSessionExpectationOrigins
+	results            *AuthServiceMockRevokeSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockRevokeSessionParams contains parameters of the AuthService.RevokeSession
+type AuthServiceMockRevokeSessionParams struct {
+	ctx       context.Context
+	userID    string
+	sessionID string
+}
+
+// AuthServiceMockRevokeSessionParamPtrs contains pointers to parameters of the AuthService.RevokeSession
+type AuthServiceMockRevokeSessionParamPtrs struct {
+	ctx       *context.Context
+	userID    *string
+	sessionID *string
+}
+
+// AuthServiceMockRevokeSessionResults contains results of the AuthService.RevokeSession
+type AuthServiceMockRevokeSessionResults struct {
+	err error
+}
+
+// AuthServiceMockRevokeSessionOrigins contains origins of expectations of the AuthService.RevokeSession
+type AuthServiceMockRevokeSessionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUserID    string
+	originSessionID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeSession *mAuthServiceMockRevokeSession) Optional() *mAuthServiceMockRevokeSession {
+	mmRevokeSession.optional = true
+	return mmRevokeSession
+}
+
+// Expect sets up expected params for AuthService.RevokeSession
+func (mmRevokeSession *mAuthServiceMockRevokeSession) Expect(ctx context.Context, userID string, sessionID string) *mAuthServiceMockRevokeSession {
+	if mmRevokeSession.mock.funcRevokeSession != nil {
+		mmRevokeSession.mock.t.Fatalf("AuthServiceMock.RevokeSession mock is already set by Set")
+	}
+
+	if mmRevokeSession.defaultExpectation == nil {
+		mmRevokeSession.defaultExpectation = &AuthServiceMockRevokeSessionExpectation{}
+	}
+
+	if mmRevokeSession.defaultExpectation.paramPtrs != nil {
+		mmRevokeSession.mock.t.Fatalf("AuthServiceMock.RevokeSession mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeSession.defaultExpectation.params = &AuthServiceMockRevokeSessionParams{ctx, userID, sessionID}
+	mmRevokeSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevokeSession.expectations {
+		if minimock.Equal(e.params, mmRevokeSession.defaultExpectation.params) {
+			mmRevokeSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeSession.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.RevokeSession
+func (mmRevokeSession *mAuthServiceMockRevokeSession) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockRevokeSession {
+	if mmRevokeSession.mock.funcRevokeSession != nil {
+		mmRevokeSession.mock.t.Fatalf("AuthServiceMock.RevokeSession mock is already set by Set")
+	}
+
+	if mmRevokeSession.defaultExpectation == nil {
+		mmRevokeSession.defaultExpectation = &AuthServiceMockRevokeSessionExpectation{}
+	}
+
+	if mmRevokeSession.defaultExpectation.params != nil {
+		mmRevokeSession.mock.t.Fatalf("AuthServiceMock.RevokeSession mock is already set by Expect")
+	}
+
+	if mmRevokeSession.defaultExpectation.paramPtrs == nil {
+		mmRevokeSession.defaultExpectation.paramPtrs = &AuthServiceMockRevokeSessionParamPtrs{}
+	}
+	mmRevokeSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevokeSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevokeSession
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthService.RevokeSession
+func (mmRevokeSession *mAuthServiceMockRevokeSession) ExpectUserIDParam2(userID string) *mAuthServiceMockRevokeSession {
+	if mmRevokeSession.mock.funcRevokeSession != nil {
+		mmRevokeSession.mock.t.Fatalf("AuthServiceMock.RevokeSession mock is already set by Set")
+	}
+
+	if mmRevokeSession.defaultExpectation == nil {
+		mmRevokeSession.defaultExpectation = &AuthServiceMockRevokeSessionExpectation{}
+	}
+
+	if mmRevokeSession.defaultExpectation.params != nil {
+		mmRevokeSession.mock.t.Fatalf("AuthServiceMock.RevokeSession mock is already set by Expect")
+	}
+
+	if mmRevokeSession.defaultExpectation.paramPtrs == nil {
+		mmRevokeSession.defaultExpectation.paramPtrs = &AuthServiceMockRevokeSessionParamPtrs{}
+	}
+	mmRevokeSession.defaultExpectation.paramPtrs.userID = &userID
+	mmRevokeSession.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRevokeSession
+}
+
+// ExpectSessionIDParam3 sets up expected param sessionID for AuthService.RevokeSession
+func (mmRevokeSession *mAuthServiceMockRevokeSession) ExpectSessionIDParam3(sessionID string) *mAuthServiceMockRevokeSession {
+	if mmRevokeSession.mock.funcRevokeSession != nil {
+		mmRevokeSession.mock.t.Fatalf("AuthServiceMock.RevokeSession mock is already set by Set")
+	}
+
+	if mmRevokeSession.defaultExpectation == nil {
+		mmRevokeSession.defaultExpectation = &AuthServiceMockRevokeSessionExpectation{}
+	}
+
+	if mmRevokeSession.defaultExpectation.params != nil {
+		mmRevokeSession.mock.t.Fatalf("AuthServiceMock.RevokeSession mock is already set by Expect")
+	}
+
+	if mmRevokeSession.defaultExpectation.paramPtrs == nil {
+		mmRevokeSession.defaultExpectation.paramPtrs = &AuthServiceMockRevokeSessionParamPtrs{}
+	}
+	mmRevokeSession.defaultExpectation.paramPtrs.sessionID = &sessionID
+	mmRevokeSession.defaultExpectation.expectationOrigins.originSessionID = minimock.CallerInfo(1)
+
+	return mmRevokeSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.RevokeSession
+func (mmRevokeSession *mAuthServiceMockRevokeSession) Inspect(f func(ctx context.Context, userID string, sessionID string)) *mAuthServiceMockRevokeSession {
+	if mmRevokeSession.mock.inspectFuncRevokeSession != nil {
+		mmRevokeSession.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.RevokeSession")
+	}
+
+	mmRevokeSession.mock.inspectFuncRevokeSession = f
+
+	return mmRevokeSession
+}
+
+// Return sets up results that will be returned by AuthService.RevokeSession
+func (mmRevokeSession *mAuthServiceMockRevokeSession) Return(err error) *AuthServiceMock {
+	if mmRevokeSession.mock.funcRevokeSession != nil {
+		mmRevokeSession.mock.t.Fatalf("AuthServiceMock.RevokeSession mock is already set by Set")
+	}
+
+	if mmRevokeSession.defaultExpectation == nil {
+		mmRevokeSession.defaultExpectation = &AuthServiceMockRevokeSessionExpectation{mock: mmRevokeSession.mock}
+	}
+	mmRevokeSession.defaultExpectation.results = &AuthServiceMockRevokeSessionResults{err}
+	mmRevokeSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevokeSession.mock
+}
+
+// Set uses given function f to mock the AuthService.RevokeSession method
+func (mmRevokeSession *mAuthServiceMockRevokeSession) Set(f func(ctx context.Context, userID string, sessionID string) (err error)) *AuthServiceMock {
+	if mmRevokeSession.defaultExpectation != nil {
+		mmRevokeSession.mock.t.Fatalf("Default expectation is already set for the AuthService.RevokeSession method")
+	}
+
+	if len(mmRevokeSession.expectations) > 0 {
+		mmRevokeSession.mock.t.Fatalf("Some expectations are already set for the AuthService.RevokeSession method")
+	}
+
+	mmRevokeSession.mock.funcRevokeSession = f
+	mmRevokeSession.mock.funcRevokeSessionOrigin = minimock.CallerInfo(1)
+	return mmRevokeSession.mock
+}
+
+// When sets expectation for the AuthService.RevokeSession which will trigger the result defined by the following
+// Then helper
+func (mmRevokeSession *mAuthServiceMockRevokeSession) When(ctx context.Context, userID string, sessionID string) *AuthServiceMockRevokeSessionExpectation {
+	if mmRevokeSession.mock.funcRevokeSession != nil {
+		mmRevokeSession.mock.t.Fatalf("AuthServiceMock.RevokeSession mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockRevokeSessionExpectation{
+		mock:               mmRevokeSession.mock,
+		params:             &AuthServiceMockRevokeSessionParams{ctx, userID, sessionID},
+		expectationOrigins: AuthServiceMockRevokeSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevokeSession.expectations = append(mmRevokeSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.RevokeSession return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockRevokeSessionExpectation) Then(err error) *AuthServiceMock {
+	e.results = &AuthServiceMockRevokeSessionResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.RevokeSession should be invoked
+func (mmRevokeSession *mAuthServiceMockRevokeSession) Times(n uint64) *mAuthServiceMockRevokeSession {
+	if n == 0 {
+		mmRevokeSession.mock.t.Fatalf("Times of AuthServiceMock.RevokeSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeSession.expectedInvocations, n)
+	mmRevokeSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevokeSession
+}
+
+func (mmRevokeSession *mAuthServiceMockRevokeSession) invocationsDone() bool {
+	if len(mmRevokeSession.expectations) == 0 && mmRevokeSession.defaultExpectation == nil && mmRevokeSession.mock.funcRevokeSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeSession.mock.afterRevokeSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeSession implements mm_service.AuthService
+func (mmRevokeSession *AuthServiceMock) RevokeSession(ctx context.Context, userID string, sessionID string) (err error) {
+	mm_atomic.AddUint64(&mmRevokeSession.beforeRevokeSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeSession.afterRevokeSessionCounter, 1)
+
+	mmRevokeSession.t.Helper()
+
+	if mmRevokeSession.inspectFuncRevokeSession != nil {
+		mmRevokeSession.inspectFuncRevokeSession(ctx, userID, sessionID)
+	}
+
+	mm_params := AuthServiceMockRevokeSessionParams{ctx, userID, sessionID}
+
+	// Record call args
+	mmRevokeSession.RevokeSessionMock.mutex.Lock()
+	mmRevokeSession.RevokeSessionMock.callArgs = append(mmRevokeSession.RevokeSessionMock.callArgs, &mm_params)
+	mmRevokeSession.RevokeSessionMock.mutex.Unlock()
+
+	for _, e := range mmRevokeSession.RevokeSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeSession.RevokeSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeSession.RevokeSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeSession.RevokeSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeSession.RevokeSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockRevokeSessionParams{ctx, userID, sessionID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeSession.t.Errorf("AuthServiceMock.RevokeSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeSession.RevokeSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRevokeSession.t.Errorf("AuthServiceMock.RevokeSession got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeSession.RevokeSessionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.sessionID != nil && !minimock.Equal(*mm_want_ptrs.sessionID, mm_got.sessionID) {
+				mmRevokeSession.t.Errorf("AuthServiceMock.RevokeSession got unexpected parameter sessionID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeSession.RevokeSessionMock.defaultExpectation.expectationOrigins.originSessionID, *mm_want_ptrs.sessionID, mm_got.sessionID, minimock.Diff(*mm_want_ptrs.sessionID, mm_got.sessionID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeSession.t.Errorf("AuthServiceMock.RevokeSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevokeSession.RevokeSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeSession.RevokeSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeSession.t.Fatal("No results are set for the AuthServiceMock.RevokeSession")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeSession.funcRevokeSession != nil {
+		return mmRevokeSession.funcRevokeSession(ctx, userID, sessionID)
+	}
+	mmRevokeSession.t.Fatalf("Unexpected call to AuthServiceMock.RevokeSession. %v %v %v", ctx, userID, sessionID)
+	return
+}
+
+// RevokeSessionAfterCounter returns a count of finished AuthServiceMock.RevokeSession invocations
+func (mmRevokeSession *AuthServiceMock) RevokeSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeSession.afterRevokeSessionCounter)
+}
+
+// RevokeSessionBeforeCounter returns a count of AuthServiceMock.RevokeSession invocations
+func (mmRevokeSession *AuthServiceMock) RevokeSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeSession.beforeRevokeSessionCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.RevokeSession.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeSession *mAuthServiceMockRevokeSession) Calls() []*AuthServiceMockRevokeSessionParams {
+	mmRevokeSession.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockRevokeSessionParams, len(mmRevokeSession.callArgs))
+	copy(argCopy, mmRevokeSession.callArgs)
+
+	mmRevokeSession.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeSessionDone returns true if the count of the RevokeSession invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockRevokeSessionDone() bool {
+	if m.RevokeSessionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeSessionMock.invocationsDone()
+}
+
+// MinimockRevokeSessionInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockRevokeSessionInspect() {
+	for _, e := range m.RevokeSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.RevokeSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevokeSessionCounter := mm_atomic.LoadUint64(&m.afterRevokeSessionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeSessionMock.defaultExpectation != nil && afterRevokeSessionCounter < 1 {
+		if m.RevokeSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.RevokeSession at\n%s", m.RevokeSessionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.RevokeSession at\n%s with params: %#v", m.RevokeSessionMock.defaultExpectation.expectationOrigins.origin, *m.RevokeSessionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeSession != nil && afterRevokeSessionCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.RevokeSession at\n%s", m.funcRevokeSessionOrigin)
+	}
+
+	if !m.RevokeSessionMock.invocationsDone() && afterRevokeSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.RevokeSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeSessionMock.expectedInvocations), m.RevokeSessionMock.expectedInvocationsOrigin, afterRevokeSessionCounter)
+	}
+}
+
+type mAuthServiceMockUnlockUser struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockUnlockUserExpectation
+	expectations       []*AuthServiceMockUnlockUserExpectation
+
+	callArgs []*AuthServiceMockUnlockUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockUnlockUserExpectation specifies expectation struct of the AuthService.UnlockUser
+type AuthServiceMockUnlockUserExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockUnlockUserParams
+	paramPtrs          *AuthServiceMockUnlockUserParamPtrs
+	expectationOrigins AuthServiceMockUnlockUserExpectationOrigins
+	results            *AuthServiceMockUnlockUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockUnlockUserParams contains parameters of the AuthService.UnlockUser
+type AuthServiceMockUnlockUserParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// AuthServiceMockUnlockUserParamPtrs contains pointers to parameters of the AuthService.UnlockUser
+type AuthServiceMockUnlockUserParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// AuthServiceMockUnlockUserResults contains results of the AuthService.UnlockUser
+type AuthServiceMockUnlockUserResults struct {
+	err error
+}
+
+// AuthServiceMockUnlockUserOrigins contains origins of expectations of the AuthService.UnlockUser
+type AuthServiceMockUnlockUserExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUnlockUser *mAuthServiceMockUnlockUser) Optional() *mAuthServiceMockUnlockUser {
+	mmUnlockUser.optional = true
+	return mmUnlockUser
+}
+
+// Expect sets up expected params for AuthService.UnlockUser
+func (mmUnlockUser *mAuthServiceMockUnlockUser) Expect(ctx context.Context, userID string) *mAuthServiceMockUnlockUser {
+	if mmUnlockUser.mock.funcUnlockUser != nil {
+		mmUnlockUser.mock.t.Fatalf("AuthServiceMock.UnlockUser mock is already set by Set")
+	}
+
+	if mmUnlockUser.defaultExpectation == nil {
+		mmUnlockUser.defaultExpectation = &AuthServiceMockUnlockUserExpectation{}
+	}
+
+	if mmUnlockUser.defaultExpectation.paramPtrs != nil {
+		mmUnlockUser.mock.t.Fatalf("AuthServiceMock.UnlockUser mock is already set by ExpectParams functions")
+	}
+
+	mmUnlockUser.defaultExpectation.params = &AuthServiceMockUnlockUserParams{ctx, userID}
+	mmUnlockUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUnlockUser.expectations {
+		if minimock.Equal(e.params, mmUnlockUser.defaultExpectation.params) {
+			mmUnlockUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUnlockUser.defaultExpectation.params)
+		}
+	}
+
+	return mmUnlockUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.UnlockUser
+func (mmUnlockUser *mAuthServiceMockUnlockUser) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockUnlockUser {
+	if mmUnlockUser.mock.funcUnlockUser != nil {
+		mmUnlockUser.mock.t.Fatalf("AuthServiceMock.UnlockUser mock is already set by Set")
+	}
+
+	if mmUnlockUser.defaultExpectation == nil {
+		mmUnlockUser.defaultExpectation = &AuthServiceMockUnlockUserExpectation{}
+	}
+
+	if mmUnlockUser.defaultExpectation.params != nil {
+		mmUnlockUser.mock.t.Fatalf("AuthServiceMock.UnlockUser mock is already set by Expect")
+	}
+
+	if mmUnlockUser.defaultExpectation.paramPtrs == nil {
+		mmUnlockUser.defaultExpectation.paramPtrs = &AuthServiceMockUnlockUserParamPtrs{}
+	}
+	mmUnlockUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUnlockUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUnlockUser
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthService.UnlockUser
+func (mmUnlockUser *mAuthServiceMockUnlockUser) ExpectUserIDParam2(userID string) *mAuthServiceMockUnlockUser {
+	if mmUnlockUser.mock.funcUnlockUser != nil {
+		mmUnlockUser.mock.t.Fatalf("AuthServiceMock.UnlockUser mock is already set by Set")
+	}
+
+	if mmUnlockUser.defaultExpectation == nil {
+		mmUnlockUser.defaultExpectation = &AuthServiceMockUnlockUserExpectation{}
+	}
+
+	if mmUnlockUser.defaultExpectation.params != nil {
+		mmUnlockUser.mock.t.Fatalf("AuthServiceMock.UnlockUser mock is already set by Expect")
+	}
+
+	if mmUnlockUser.defaultExpectation.paramPtrs == nil {
+		mmUnlockUser.defaultExpectation.paramPtrs = &AuthServiceMockUnlockUserParamPtrs{}
+	}
+	mmUnlockUser.defaultExpectation.paramPtrs.userID = &userID
+	mmUnlockUser.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUnlockUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.UnlockUser
+func (mmUnlockUser *mAuthServiceMockUnlockUser) Inspect(f func(ctx context.Context, userID string)) *mAuthServiceMockUnlockUser {
+	if mmUnlockUser.mock.inspectFuncUnlockUser != nil {
+		mmUnlockUser.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.UnlockUser")
+	}
+
+	mmUnlockUser.mock.inspectFuncUnlockUser = f
+
+	return mmUnlockUser
+}
+
+// Return sets up results that will be returned by AuthService.UnlockUser
+func (mmUnlockUser *mAuthServiceMockUnlockUser) Return(err error) *AuthServiceMock {
+	if mmUnlockUser.mock.funcUnlockUser != nil {
+		mmUnlockUser.mock.t.Fatalf("AuthServiceMock.UnlockUser mock is already set by Set")
+	}
+
+	if mmUnlockUser.defaultExpectation == nil {
+		mmUnlockUser.defaultExpectation = &AuthServiceMockUnlockUserExpectation{mock: mmUnlockUser.mock}
+	}
+	mmUnlockUser.defaultExpectation.results = &AuthServiceMockUnlockUserResults{err}
+	mmUnlockUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUnlockUser.mock
+}
+
+// Set uses given function f to mock the AuthService.UnlockUser method
+func (mmUnlockUser *mAuthServiceMockUnlockUser) Set(f func(ctx context.Context, userID string) (err error)) *AuthServiceMock {
+	if mmUnlockUser.defaultExpectation != nil {
+		mmUnlockUser.mock.t.Fatalf("Default expectation is already set for the AuthService.UnlockUser method")
+	}
+
+	if len(mmUnlockUser.expectations) > 0 {
+		mmUnlockUser.mock.t.Fatalf("Some expectations are already set for the AuthService.UnlockUser method")
+	}
+
+	mmUnlockUser.mock.funcUnlockUser = f
+	mmUnlockUser.mock.funcUnlockUserOrigin = minimock.CallerInfo(1)
+	return mmUnlockUser.mock
+}
+
+// When sets expectation for the AuthService.UnlockUser which will trigger the result defined by the following
+// Then helper
+func (mmUnlockUser *mAuthServiceMockUnlockUser) When(ctx context.Context, userID string) *AuthServiceMockUnlockUserExpectation {
+	if mmUnlockUser.mock.funcUnlockUser != nil {
+		mmUnlockUser.mock.t.Fatalf("AuthServiceMock.UnlockUser mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockUnlockUserExpectation{
+		mock:               mmUnlockUser.mock,
+		params:             &AuthServiceMockUnlockUserParams{ctx, userID},
+		expectationOrigins: AuthServiceMockUnlockUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUnlockUser.expectations = append(mmUnlockUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.UnlockUser return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockUnlockUserExpectation) Then(err error) *AuthServiceMock {
+	e.results = &AuthServiceMockUnlockUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.UnlockUser should be invoked
+func (mmUnlockUser *mAuthServiceMockUnlockUser) Times(n uint64) *mAuthServiceMockUnlockUser {
+	if n == 0 {
+		mmUnlockUser.mock.t.Fatalf("Times of AuthServiceMock.UnlockUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUnlockUser.expectedInvocations, n)
+	mmUnlockUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUnlockUser
+}
+
+func (mmUnlockUser *mAuthServiceMockUnlockUser) invocationsDone() bool {
+	if len(mmUnlockUser.expectations) == 0 && mmUnlockUser.defaultExpectation == nil && mmUnlockUser.mock.funcUnlockUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUnlockUser.mock.afterUnlockUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUnlockUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UnlockUser implements mm_service.AuthService
+func (mmUnlockUser *AuthServiceMock) UnlockUser(ctx context.Context, userID string) (err error) {
+	mm_atomic.AddUint64(&mmUnlockUser.beforeUnlockUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmUnlockUser.afterUnlockUserCounter, 1)
+
+	mmUnlockUser.t.Helper()
+
+	if mmUnlockUser.inspectFuncUnlockUser != nil {
+		mmUnlockUser.inspectFuncUnlockUser(ctx, userID)
+	}
+
+	mm_params := AuthServiceMockUnlockUserParams{ctx, userID}
+
+	// Record call args
+	mmUnlockUser.UnlockUserMock.mutex.Lock()
+	mmUnlockUser.UnlockUserMock.callArgs = append(mmUnlockUser.UnlockUserMock.callArgs, &mm_params)
+	mmUnlockUser.UnlockUserMock.mutex.Unlock()
+
+	for _, e := range mmUnlockUser.UnlockUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUnlockUser.UnlockUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUnlockUser.UnlockUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmUnlockUser.UnlockUserMock.defaultExpectation.params
+		mm_want_ptrs := mmUnlockUser.UnlockUserMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockUnlockUserParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUnlockUser.t.Errorf("AuthServiceMock.UnlockUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnlockUser.UnlockUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUnlockUser.t.Errorf("AuthServiceMock.UnlockUser got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnlockUser.UnlockUserMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUnlockUser.t.Errorf("AuthServiceMock.UnlockUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUnlockUser.UnlockUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUnlockUser.UnlockUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUnlockUser.t.Fatal("No results are set for the AuthServiceMock.UnlockUser")
+		}
+		return (*mm_results).err
+	}
+	if mmUnlockUser.funcUnlockUser != nil {
+		return mmUnlockUser.funcUnlockUser(ctx, userID)
+	}
+	mmUnlockUser.t.Fatalf("Unexpected call to AuthServiceMock.UnlockUser. %v %v", ctx, userID)
+	return
+}
+
+// UnlockUserAfterCounter returns a count of finished AuthServiceMock.UnlockUser invocations
+func (mmUnlockUser *AuthServiceMock) UnlockUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnlockUser.afterUnlockUserCounter)
+}
+
+// UnlockUserBeforeCounter returns a count of AuthServiceMock.UnlockUser invocations
+func (mmUnlockUser *AuthServiceMock) UnlockUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnlockUser.beforeUnlockUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.UnlockUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUnlockUser *mAuthServiceMockUnlockUser) Calls() []*AuthServiceMockUnlockUserParams {
+	mmUnlockUser.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockUnlockUserParams, len(mmUnlockUser.callArgs))
+	copy(argCopy, mmUnlockUser.callArgs)
+
+	mmUnlockUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUnlockUserDone returns true if the count of the UnlockUser invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockUnlockUserDone() bool {
+	if m.UnlockUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UnlockUserMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.LoginMock.invocationsDone()
+	return m.UnlockUserMock.invocationsDone()
 }
 
-// MinimockLoginInspect logs each unmet expectation
-func (m *AuthServiceMock) MinimockLoginInspect() {
-	for _, e := range m.LoginMock.expectations {
+// MinimockUnlockUserInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockUnlockUserInspect() {
+	for _, e := range m.UnlockUserMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to AuthServiceMock.UnlockUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterLoginCounter := mm_atomic.LoadUint64(&m.afterLoginCounter)
+	afterUnlockUserCounter := mm_atomic.LoadUint64(&m.afterUnlockUserCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.LoginMock.defaultExpectation != nil && afterLoginCounter < 1 {
-		if m.LoginMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s", m.LoginMock.defaultExpectation.returnOrigin)
+	if m.UnlockUserMock.defaultExpectation != nil && afterUnlockUserCounter < 1 {
+		if m.UnlockUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.UnlockUser at\n%s", m.UnlockUserMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s with params: %#v", m.LoginMock.defaultExpectation.expectationOrigins.origin, *m.LoginMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AuthServiceMock.UnlockUser at\n%s with params: %#v", m.UnlockUserMock.defaultExpectation.expectationOrigins.origin, *m.UnlockUserMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcLogin != nil && afterLoginCounter < 1 {
-		m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s", m.funcLoginOrigin)
+	if m.funcUnlockUser != nil && afterUnlockUserCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.UnlockUser at\n%s", m.funcUnlockUserOrigin)
 	}
 
-	if !m.LoginMock.invocationsDone() && afterLoginCounter > 0 {
-		m.t.Errorf("Expected %d calls to AuthServiceMock.Login at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.LoginMock.expectedInvocations), m.LoginMock.expectedInvocationsOrigin, afterLoginCounter)
+	if !m.UnlockUserMock.invocationsDone() && afterUnlockUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.UnlockUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UnlockUserMock.expectedInvocations), m.UnlockUserMock.expectedInvocationsOrigin, afterUnlockUserCounter)
 	}
 }
 
-type mAuthServiceMockLogout struct {
+type mAuthServiceMockVerifyMfa struct {
 	optional           bool
 	mock               *AuthServiceMock
-	defaultExpectation *AuthServiceMockLogoutExpectation
-	expectations       []*AuthServiceMockLogoutExpectation
+	defaultExpectation *AuthServiceMockVerifyMfaExpectation
+	expectations       []*AuthServiceMockVerifyMfaExpectation
 
-	callArgs []*AuthServiceMockLogoutParams
+	callArgs []*AuthServiceMockVerifyMfaParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// AuthServiceMockLogoutExpectation specifies expectation struct of the AuthService.Logout
-type AuthServiceMockLogoutExpectation struct {
+// AuthServiceMockVerifyMfaExpectation specifies expectation struct of the AuthService.VerifyMfa
+type AuthServiceMockVerifyMfaExpectation struct {
 	mock               *AuthServiceMock
-	params             *AuthServiceMockLogoutParams
-	paramPtrs          *AuthServiceMockLogoutParamPtrs
-	expectationOrigins AuthServiceMockLogoutExpectationOrigins
-	results            *AuthServiceMockLogoutResults
+	params             *AuthServiceMockVerifyMfaParams
+	paramPtrs          *AuthServiceMockVerifyMfaParamPtrs
+	expectationOrigins AuthServiceMockVerifyMfaExpectationOrigins
+	results            *AuthServiceMockVerifyMfaResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// AuthServiceMockLogoutParams contains parameters of the AuthService.Logout
-type AuthServiceMockLogoutParams struct {
-	ctx          context.Context
-	refreshToken string
+// AuthServiceMockVerifyMfaParams contains parameters of the AuthService.VerifyMfa
+type AuthServiceMockVerifyMfaParams struct {
+	ctx      context.Context
+	mfaToken string
+	code     string
+	client   *model.ClientInfo
 }
 
-// AuthServiceMockLogoutParamPtrs contains pointers to parameters of the AuthService.Logout
-type AuthServiceMockLogoutParamPtrs struct {
-	ctx          *context.Context
-	refreshToken *string
+// AuthServiceMockVerifyMfaParamPtrs contains pointers to parameters of the AuthService.VerifyMfa
+type AuthServiceMockVerifyMfaParamPtrs struct {
+	ctx      *context.Context
+	mfaToken *string
+	code     *string
+	client   **model.ClientInfo
 }
 
-// AuthServiceMockLogoutResults contains results of the AuthService.Logout
-type AuthServiceMockLogoutResults struct {
+// AuthServiceMockVerifyMfaResults contains results of the AuthService.VerifyMfa
+type AuthServiceMockVerifyMfaResults struct {
+	tp1 *model.TokenPair
 	err error
 }
 
-// AuthServiceMockLogoutOrigins contains origins of expectations of the AuthService.Logout
-type AuthServiceMockLogoutExpectationOrigins struct {
-	origin             string
-	originCtx          string
-	originRefreshToken string
+// AuthServiceMockVerifyMfaOrigins contains origins of expectations of the AuthService.VerifyMfa
+type AuthServiceMockVerifyMfaExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originMfaToken string
+	originCode     string
+	originClient   string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1153,292 +6756,348 @@ type AuthServiceMockLogoutExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmLogout *mAuthServiceMockLogout) Optional() *mAuthServiceMockLogout {
-	mmLogout.optional = true
-	return mmLogout
+func (mmVerifyMfa *mAuthServiceMockVerifyMfa) Optional() *mAuthServiceMockVerifyMfa {
+	mmVerifyMfa.optional = true
+	return mmVerifyMfa
 }
 
-// Expect sets up expected params for AuthService.Logout
-func (mmLogout *mAuthServiceMockLogout) Expect(ctx context.Context, refreshToken string) *mAuthServiceMockLogout {
-	if mmLogout.mock.funcLogout != nil {
-		mmLogout.mock.t.Fatalf("AuthServiceMock.Logout mock is already set by Set")
+// Expect sets up expected params for AuthService.VerifyMfa
+func (mmVerifyMfa *mAuthServiceMockVerifyMfa) Expect(ctx context.Context, mfaToken string, code string, client *model.ClientInfo) *mAuthServiceMockVerifyMfa {
+	if mmVerifyMfa.mock.funcVerifyMfa != nil {
+		mmVerifyMfa.mock.t.Fatalf("AuthServiceMock.VerifyMfa mock is already set by Set")
 	}
 
-	if mmLogout.defaultExpectation == nil {
-		mmLogout.defaultExpectation = &AuthServiceMockLogoutExpectation{}
+	if mmVerifyMfa.defaultExpectation == nil {
+		mmVerifyMfa.defaultExpectation = &AuthServiceMockVerifyMfaExpectation{}
 	}
 
-	if mmLogout.defaultExpectation.paramPtrs != nil {
-		mmLogout.mock.t.Fatalf("AuthServiceMock.Logout mock is already set by ExpectParams functions")
+	if mmVerifyMfa.defaultExpectation.paramPtrs != nil {
+		mmVerifyMfa.mock.t.Fatalf("AuthServiceMock.VerifyMfa mock is already set by ExpectParams functions")
 	}
 
-	mmLogout.defaultExpectation.params = &AuthServiceMockLogoutParams{ctx, refreshToken}
-	mmLogout.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmLogout.expectations {
-		if minimock.Equal(e.params, mmLogout.defaultExpectation.params) {
-			mmLogout.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogout.defaultExpectation.params)
+	mmVerifyMfa.defaultExpectation.params = &AuthServiceMockVerifyMfaParams{ctx, mfaToken, code, client}
+	mmVerifyMfa.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmVerifyMfa.expectations {
+		if minimock.Equal(e.params, mmVerifyMfa.defaultExpectation.params) {
+			mmVerifyMfa.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmVerifyMfa.defaultExpectation.params)
 		}
 	}
 
-	return mmLogout
+	return mmVerifyMfa
 }
 
-// ExpectCtxParam1 sets up expected param ctx for AuthService.Logout
-func (mmLogout *mAuthServiceMockLogout) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockLogout {
-	if mmLogout.mock.funcLogout != nil {
-		mmLogout.mock.t.Fatalf("AuthServiceMock.Logout mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for AuthService.VerifyMfa
+func (mmVerifyMfa *mAuthServiceMockVerifyMfa) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockVerifyMfa {
+	if mmVerifyMfa.mock.funcVerifyMfa != nil {
+		mmVerifyMfa.mock.t.Fatalf("AuthServiceMock.VerifyMfa mock is already set by Set")
 	}
 
-	if mmLogout.defaultExpectation == nil {
-		mmLogout.defaultExpectation = &AuthServiceMockLogoutExpectation{}
+	if mmVerifyMfa.defaultExpectation == nil {
+		mmVerifyMfa.defaultExpectation = &AuthServiceMockVerifyMfaExpectation{}
 	}
 
-	if mmLogout.defaultExpectation.params != nil {
-		mmLogout.mock.t.Fatalf("AuthServiceMock.Logout mock is already set by Expect")
+	if mmVerifyMfa.defaultExpectation.params != nil {
+		mmVerifyMfa.mock.t.Fatalf("AuthServiceMock.VerifyMfa mock is already set by Expect")
 	}
 
-	if mmLogout.defaultExpectation.paramPtrs == nil {
-		mmLogout.defaultExpectation.paramPtrs = &AuthServiceMockLogoutParamPtrs{}
+	if mmVerifyMfa.defaultExpectation.paramPtrs == nil {
+		mmVerifyMfa.defaultExpectation.paramPtrs = &AuthServiceMockVerifyMfaParamPtrs{}
 	}
-	mmLogout.defaultExpectation.paramPtrs.ctx = &ctx
-	mmLogout.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmVerifyMfa.defaultExpectation.paramPtrs.ctx = &ctx
+	mmVerifyMfa.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmLogout
+	return mmVerifyMfa
 }
 
-// ExpectRefreshTokenParam2 sets up expected param refreshToken for AuthService.Logout
-func (mmLogout *mAuthServiceMockLogout) ExpectRefreshTokenParam2(refreshToken string) *mAuthServiceMockLogout {
-	if mmLogout.mock.funcLogout != nil {
-		mmLogout.mock.t.Fatalf("AuthServiceMock.Logout mock is already set by Set")
+// ExpectMfaTokenParam2 sets up expected param mfaToken for AuthService.VerifyMfa
+func (mmVerifyMfa *mAuthServiceMockVerifyMfa) ExpectMfaTokenParam2(mfaToken string) *mAuthServiceMockVerifyMfa {
+	if mmVerifyMfa.mock.funcVerifyMfa != nil {
+		mmVerifyMfa.mock.t.Fatalf("AuthServiceMock.VerifyMfa mock is already set by Set")
 	}
 
-	if mmLogout.defaultExpectation == nil {
-		mmLogout.defaultExpectation = &AuthServiceMockLogoutExpectation{}
+	if mmVerifyMfa.defaultExpectation == nil {
+		mmVerifyMfa.defaultExpectation = &AuthServiceMockVerifyMfaExpectation{}
 	}
 
-	if mmLogout.defaultExpectation.params != nil {
-		mmLogout.mock.t.Fatalf("AuthServiceMock.Logout mock is already set by Expect")
+	if mmVerifyMfa.defaultExpectation.params != nil {
+		mmVerifyMfa.mock.t.Fatalf("AuthServiceMock.VerifyMfa mock is already set by Expect")
 	}
 
-	if mmLogout.defaultExpectation.paramPtrs == nil {
-		mmLogout.defaultExpectation.paramPtrs = &AuthServiceMockLogoutParamPtrs{}
+	if mmVerifyMfa.defaultExpectation.paramPtrs == nil {
+		mmVerifyMfa.defaultExpectation.paramPtrs = &AuthServiceMockVerifyMfaParamPtrs{}
 	}
-	mmLogout.defaultExpectation.paramPtrs.refreshToken = &refreshToken
-	mmLogout.defaultExpectation.expectationOrigins.originRefreshToken = minimock.CallerInfo(1)
+	mmVerifyMfa.defaultExpectation.paramPtrs.mfaToken = &mfaToken
+	mmVerifyMfa.defaultExpectation.expectationOrigins.originMfaToken = minimock.CallerInfo(1)
 
-	return mmLogout
+	return mmVerifyMfa
 }
 
-// Inspect accepts an inspector function that has same arguments as the AuthService.Logout
-func (mmLogout *mAuthServiceMockLogout) Inspect(f func(ctx context.Context, refreshToken string)) *mAuthServiceMockLogout {
-	if mmLogout.mock.inspectFuncLogout != nil {
-		mmLogout.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.Logout")
+// ExpectCodeParam3 sets up expected param code for AuthService.VerifyMfa
+func (mmVerifyMfa *mAuthServiceMockVerifyMfa) ExpectCodeParam3(code string) *mAuthServiceMockVerifyMfa {
+	if mmVerifyMfa.mock.funcVerifyMfa != nil {
+		mmVerifyMfa.mock.t.Fatalf("AuthServiceMock.VerifyMfa mock is already set by Set")
 	}
 
-	mmLogout.mock.inspectFuncLogout = f
+	if mmVerifyMfa.defaultExpectation == nil {
+		mmVerifyMfa.defaultExpectation = &AuthServiceMockVerifyMfaExpectation{}
+	}
 
-	return mmLogout
+	if mmVerifyMfa.defaultExpectation.params != nil {
+		mmVerifyMfa.mock.t.Fatalf("AuthServiceMock.VerifyMfa mock is already set by Expect")
+	}
+
+	if mmVerifyMfa.defaultExpectation.paramPtrs == nil {
+		mmVerifyMfa.defaultExpectation.paramPtrs = &AuthServiceMockVerifyMfaParamPtrs{}
+	}
+	mmVerifyMfa.defaultExpectation.paramPtrs.code = &code
+	mmVerifyMfa.defaultExpectation.expectationOrigins.originCode = minimock.CallerInfo(1)
+
+	return mmVerifyMfa
 }
 
-// Return sets up results that will be returned by AuthService.Logout
-func (mmLogout *mAuthServiceMockLogout) Return(err error) *AuthServiceMock {
-	if mmLogout.mock.funcLogout != nil {
-		mmLogout.mock.t.Fatalf("AuthServiceMock.Logout mock is already set by Set")
+// ExpectClientParam4 sets up expected param client for AuthService.VerifyMfa
+func (mmVerifyMfa *mAuthServiceMockVerifyMfa) ExpectClientParam4(client *model.ClientInfo) *mAuthServiceMockVerifyMfa {
+	if mmVerifyMfa.mock.funcVerifyMfa != nil {
+		mmVerifyMfa.mock.t.Fatalf("AuthServiceMock.VerifyMfa mock is already set by Set")
 	}
 
-	if mmLogout.defaultExpectation == nil {
-		mmLogout.defaultExpectation = &AuthServiceMockLogoutExpectation{mock: mmLogout.mock}
+	if mmVerifyMfa.defaultExpectation == nil {
+		mmVerifyMfa.defaultExpectation = &AuthServiceMockVerifyMfaExpectation{}
 	}
-	mmLogout.defaultExpectation.results = &AuthServiceMockLogoutResults{err}
-	mmLogout.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmLogout.mock
+
+	if mmVerifyMfa.defaultExpectation.params != nil {
+		mmVerifyMfa.mock.t.Fatalf("AuthServiceMock.VerifyMfa mock is already set by Expect")
+	}
+
+	if mmVerifyMfa.defaultExpectation.paramPtrs == nil {
+		mmVerifyMfa.defaultExpectation.paramPtrs = &AuthServiceMockVerifyMfaParamPtrs{}
+	}
+	mmVerifyMfa.defaultExpectation.paramPtrs.client = &client
+	mmVerifyMfa.defaultExpectation.expectationOrigins.originClient = minimock.CallerInfo(1)
+
+	return mmVerifyMfa
 }
 
-// Set uses given function f to mock the AuthService.Logout method
-func (mmLogout *mAuthServiceMockLogout) Set(f func(ctx context.Context, refreshToken string) (err error)) *AuthServiceMock {
-	if mmLogout.defaultExpectation != nil {
-		mmLogout.mock.t.Fatalf("Default expectation is already set for the AuthService.Logout method")
+// Inspect accepts an inspector function that has same arguments as the AuthService.VerifyMfa
+func (mmVerifyMfa *mAuthServiceMockVerifyMfa) Inspect(f func(ctx context.Context, mfaToken string, code string, client *model.ClientInfo)) *mAuthServiceMockVerifyMfa {
+	if mmVerifyMfa.mock.inspectFuncVerifyMfa != nil {
+		mmVerifyMfa.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.VerifyMfa")
 	}
 
-	if len(mmLogout.expectations) > 0 {
-		mmLogout.mock.t.Fatalf("Some expectations are already set for the AuthService.Logout method")
+	mmVerifyMfa.mock.inspectFuncVerifyMfa = f
+
+	return mmVerifyMfa
+}
+
+// Return sets up results that will be returned by AuthService.VerifyMfa
+func (mmVerifyMfa *mAuthServiceMockVerifyMfa) Return(tp1 *model.TokenPair, err error) *AuthServiceMock {
+	if mmVerifyMfa.mock.funcVerifyMfa != nil {
+		mmVerifyMfa.mock.t.Fatalf("AuthServiceMock.VerifyMfa mock is already set by Set")
 	}
 
-	mmLogout.mock.funcLogout = f
-	mmLogout.mock.funcLogoutOrigin = minimock.CallerInfo(1)
-	return mmLogout.mock
+	if mmVerifyMfa.defaultExpectation == nil {
+		mmVerifyMfa.defaultExpectation = &AuthServiceMockVerifyMfaExpectation{mock: mmVerifyMfa.mock}
+	}
+	mmVerifyMfa.defaultExpectation.results = &AuthServiceMockVerifyMfaResults{tp1, err}
+	mmVerifyMfa.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmVerifyMfa.mock
 }
 
-// When sets expectation for the AuthService.Logout which will trigger the result defined by the following
+// Set uses given function f to mock the AuthService.VerifyMfa method
+func (mmVerifyMfa *mAuthServiceMockVerifyMfa) Set(f func(ctx context.Context, mfaToken string, code string, client *model.ClientInfo) (tp1 *model.TokenPair, err error)) *AuthServiceMock {
+	if mmVerifyMfa.defaultExpectation != nil {
+		mmVerifyMfa.mock.t.Fatalf("Default expectation is already set for the AuthService.VerifyMfa method")
+	}
+
+	if len(mmVerifyMfa.expectations) > 0 {
+		mmVerifyMfa.mock.t.Fatalf("Some expectations are already set for the AuthService.VerifyMfa method")
+	}
+
+	mmVerifyMfa.mock.funcVerifyMfa = f
+	mmVerifyMfa.mock.funcVerifyMfaOrigin = minimock.CallerInfo(1)
+	return mmVerifyMfa.mock
+}
+
+// When sets expectation for the AuthService.VerifyMfa which will trigger the result defined by the following
 // Then helper
-func (mmLogout *mAuthServiceMockLogout) When(ctx context.Context, refreshToken string) *AuthServiceMockLogoutExpectation {
-	if mmLogout.mock.funcLogout != nil {
-		mmLogout.mock.t.Fatalf("AuthServiceMock.Logout mock is already set by Set")
+func (mmVerifyMfa *mAuthServiceMockVerifyMfa) When(ctx context.Context, mfaToken string, code string, client *model.ClientInfo) *AuthServiceMockVerifyMfaExpectation {
+	if mmVerifyMfa.mock.funcVerifyMfa != nil {
+		mmVerifyMfa.mock.t.Fatalf("AuthServiceMock.VerifyMfa mock is already set by Set")
 	}
 
-	expectation := &AuthServiceMockLogoutExpectation{
-		mock:               mmLogout.mock,
-		params:             &AuthServiceMockLogoutParams{ctx, refreshToken},
-		expectationOrigins: AuthServiceMockLogoutExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &AuthServiceMockVerifyMfaExpectation{
+		mock:               mmVerifyMfa.mock,
+		params:             &AuthServiceMockVerifyMfaParams{ctx, mfaToken, code, client},
+		expectationOrigins: AuthServiceMockVerifyMfaExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmLogout.expectations = append(mmLogout.expectations, expectation)
+	mmVerifyMfa.expectations = append(mmVerifyMfa.expectations, expectation)
 	return expectation
 }
 
-// Then sets up AuthService.Logout return parameters for the expectation previously defined by the When method
-func (e *AuthServiceMockLogoutExpectation) Then(err error) *AuthServiceMock {
-	e.results = &AuthServiceMockLogoutResults{err}
+// Then sets up AuthService.VerifyMfa return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockVerifyMfaExpectation) Then(tp1 *model.TokenPair, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockVerifyMfaResults{tp1, err}
 	return e.mock
 }
 
-// Times sets number of times AuthService.Logout should be invoked
-func (mmLogout *mAuthServiceMockLogout) Times(n uint64) *mAuthServiceMockLogout {
+// Times sets number of times AuthService.VerifyMfa should be invoked
+func (mmVerifyMfa *mAuthServiceMockVerifyMfa) Times(n uint64) *mAuthServiceMockVerifyMfa {
 	if n == 0 {
-		mmLogout.mock.t.Fatalf("Times of AuthServiceMock.Logout mock can not be zero")
+		mmVerifyMfa.mock.t.Fatalf("Times of AuthServiceMock.VerifyMfa mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmLogout.expectedInvocations, n)
-	mmLogout.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmLogout
+	mm_atomic.StoreUint64(&mmVerifyMfa.expectedInvocations, n)
+	mmVerifyMfa.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmVerifyMfa
 }
 
-func (mmLogout *mAuthServiceMockLogout) invocationsDone() bool {
-	if len(mmLogout.expectations) == 0 && mmLogout.defaultExpectation == nil && mmLogout.mock.funcLogout == nil {
+func (mmVerifyMfa *mAuthServiceMockVerifyMfa) invocationsDone() bool {
+	if len(mmVerifyMfa.expectations) == 0 && mmVerifyMfa.defaultExpectation == nil && mmVerifyMfa.mock.funcVerifyMfa == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmLogout.mock.afterLogoutCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmLogout.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmVerifyMfa.mock.afterVerifyMfaCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmVerifyMfa.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Logout implements mm_service.AuthService
-func (mmLogout *AuthServiceMock) Logout(ctx context.Context, refreshToken string) (err error) {
-	mm_atomic.AddUint64(&mmLogout.beforeLogoutCounter, 1)
-	defer mm_atomic.AddUint64(&mmLogout.afterLogoutCounter, 1)
+// VerifyMfa implements mm_service.AuthService
+func (mmVerifyMfa *AuthServiceMock) VerifyMfa(ctx context.Context, mfaToken string, code string, client *model.ClientInfo) (tp1 *model.TokenPair, err error) {
+	mm_atomic.AddUint64(&mmVerifyMfa.beforeVerifyMfaCounter, 1)
+	defer mm_atomic.AddUint64(&mmVerifyMfa.afterVerifyMfaCounter, 1)
 
-	mmLogout.t.Helper()
+	mmVerifyMfa.t.Helper()
 
-	if mmLogout.inspectFuncLogout != nil {
-		mmLogout.inspectFuncLogout(ctx, refreshToken)
+	if mmVerifyMfa.inspectFuncVerifyMfa != nil {
+		mmVerifyMfa.inspectFuncVerifyMfa(ctx, mfaToken, code, client)
 	}
 
-	mm_params := AuthServiceMockLogoutParams{ctx, refreshToken}
+	mm_params := AuthServiceMockVerifyMfaParams{ctx, mfaToken, code, client}
 
 	// Record call args
-	mmLogout.LogoutMock.mutex.Lock()
-	mmLogout.LogoutMock.callArgs = append(mmLogout.LogoutMock.callArgs, &mm_params)
-	mmLogout.LogoutMock.mutex.Unlock()
+	mmVerifyMfa.VerifyMfaMock.mutex.Lock()
+	mmVerifyMfa.VerifyMfaMock.callArgs = append(mmVerifyMfa.VerifyMfaMock.callArgs, &mm_params)
+	mmVerifyMfa.VerifyMfaMock.mutex.Unlock()
 
-	for _, e := range mmLogout.LogoutMock.expectations {
+	for _, e := range mmVerifyMfa.VerifyMfaMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.tp1, e.results.err
 		}
 	}
 
-	if mmLogout.LogoutMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmLogout.LogoutMock.defaultExpectation.Counter, 1)
-		mm_want := mmLogout.LogoutMock.defaultExpectation.params
-		mm_want_ptrs := mmLogout.LogoutMock.defaultExpectation.paramPtrs
+	if mmVerifyMfa.VerifyMfaMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmVerifyMfa.VerifyMfaMock.defaultExpectation.Counter, 1)
+		mm_want := mmVerifyMfa.VerifyMfaMock.defaultExpectation.params
+		mm_want_ptrs := mmVerifyMfa.VerifyMfaMock.defaultExpectation.paramPtrs
 
-		mm_got := AuthServiceMockLogoutParams{ctx, refreshToken}
+		mm_got := AuthServiceMockVerifyMfaParams{ctx, mfaToken, code, client}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmLogout.t.Errorf("AuthServiceMock.Logout got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmLogout.LogoutMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmVerifyMfa.t.Errorf("AuthServiceMock.VerifyMfa got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVerifyMfa.VerifyMfaMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.refreshToken != nil && !minimock.Equal(*mm_want_ptrs.refreshToken, mm_got.refreshToken) {
-				mmLogout.t.Errorf("AuthServiceMock.Logout got unexpected parameter refreshToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmLogout.LogoutMock.defaultExpectation.expectationOrigins.originRefreshToken, *mm_want_ptrs.refreshToken, mm_got.refreshToken, minimock.Diff(*mm_want_ptrs.refreshToken, mm_got.refreshToken))
+			if mm_want_ptrs.mfaToken != nil && !minimock.Equal(*mm_want_ptrs.mfaToken, mm_got.mfaToken) {
+				mmVerifyMfa.t.Errorf("AuthServiceMock.VerifyMfa got unexpected parameter mfaToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVerifyMfa.VerifyMfaMock.defaultExpectation.expectationOrigins.originMfaToken, *mm_want_ptrs.mfaToken, mm_got.mfaToken, minimock.Diff(*mm_want_ptrs.mfaToken, mm_got.mfaToken))
+			}
+
+			if mm_want_ptrs.code != nil && !minimock.Equal(*mm_want_ptrs.code, mm_got.code) {
+				mmVerifyMfa.t.Errorf("AuthServiceMock.VerifyMfa got unexpected parameter code, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVerifyMfa.VerifyMfaMock.defaultExpectation.expectationOrigins.originCode, *mm_want_ptrs.code, mm_got.code, minimock.Diff(*mm_want_ptrs.code, mm_got.code))
+			}
+
+			if mm_want_ptrs.client != nil && !minimock.Equal(*mm_want_ptrs.client, mm_got.client) {
+				mmVerifyMfa.t.Errorf("AuthServiceMock.VerifyMfa got unexpected parameter client, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVerifyMfa.VerifyMfaMock.defaultExpectation.expectationOrigins.originClient, *mm_want_ptrs.client, mm_got.client, minimock.Diff(*mm_want_ptrs.client, mm_got.client))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmLogout.t.Errorf("AuthServiceMock.Logout got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmLogout.LogoutMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmVerifyMfa.t.Errorf("AuthServiceMock.VerifyMfa got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmVerifyMfa.VerifyMfaMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmLogout.LogoutMock.defaultExpectation.results
+		mm_results := mmVerifyMfa.VerifyMfaMock.defaultExpectation.results
 		if mm_results == nil {
-			mmLogout.t.Fatal("No results are set for the AuthServiceMock.Logout")
+			mmVerifyMfa.t.Fatal("No results are set for the AuthServiceMock.VerifyMfa")
 		}
-		return (*mm_results).err
+		return (*mm_results).tp1, (*mm_results).err
 	}
-	if mmLogout.funcLogout != nil {
-		return mmLogout.funcLogout(ctx, refreshToken)
+	if mmVerifyMfa.funcVerifyMfa != nil {
+		return mmVerifyMfa.funcVerifyMfa(ctx, mfaToken, code, client)
 	}
-	mmLogout.t.Fatalf("Unexpected call to AuthServiceMock.Logout. %v %v", ctx, refreshToken)
+	mmVerifyMfa.t.Fatalf("Unexpected call to AuthServiceMock.VerifyMfa. %v %v %v %v", ctx, mfaToken, code, client)
 	return
 }
 
-// LogoutAfterCounter returns a count of finished AuthServiceMock.Logout invocations
-func (mmLogout *AuthServiceMock) LogoutAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmLogout.afterLogoutCounter)
+// VerifyMfaAfterCounter returns a count of finished AuthServiceMock.VerifyMfa invocations
+func (mmVerifyMfa *AuthServiceMock) VerifyMfaAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerifyMfa.afterVerifyMfaCounter)
 }
 
-// LogoutBeforeCounter returns a count of AuthServiceMock.Logout invocations
-func (mmLogout *AuthServiceMock) LogoutBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmLogout.beforeLogoutCounter)
+// VerifyMfaBeforeCounter returns a count of AuthServiceMock.VerifyMfa invocations
+func (mmVerifyMfa *AuthServiceMock) VerifyMfaBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerifyMfa.beforeVerifyMfaCounter)
 }
 
-// Calls returns a list of arguments used in each call to AuthServiceMock.Logout.
+// Calls returns a list of arguments used in each call to AuthServiceMock.VerifyMfa.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmLogout *mAuthServiceMockLogout) Calls() []*AuthServiceMockLogoutParams {
-	mmLogout.mutex.RLock()
+func (mmVerifyMfa *mAuthServiceMockVerifyMfa) Calls() []*AuthServiceMockVerifyMfaParams {
+	mmVerifyMfa.mutex.RLock()
 
-	argCopy := make([]*AuthServiceMockLogoutParams, len(mmLogout.callArgs))
-	copy(argCopy, mmLogout.callArgs)
+	argCopy := make([]*AuthServiceMockVerifyMfaParams, len(mmVerifyMfa.callArgs))
+	copy(argCopy, mmVerifyMfa.callArgs)
 
-	mmLogout.mutex.RUnlock()
+	mmVerifyMfa.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockLogoutDone returns true if the count of the Logout invocations corresponds
+// MinimockVerifyMfaDone returns true if the count of the VerifyMfa invocations corresponds
 // the number of defined expectations
-func (m *AuthServiceMock) MinimockLogoutDone() bool {
-	if m.LogoutMock.optional {
+func (m *AuthServiceMock) MinimockVerifyMfaDone() bool {
+	if m.VerifyMfaMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.LogoutMock.expectations {
+	for _, e := range m.VerifyMfaMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.LogoutMock.invocationsDone()
+	return m.VerifyMfaMock.invocationsDone()
 }
 
-// MinimockLogoutInspect logs each unmet expectation
-func (m *AuthServiceMock) MinimockLogoutInspect() {
-	for _, e := range m.LogoutMock.expectations {
+// MinimockVerifyMfaInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockVerifyMfaInspect() {
+	for _, e := range m.VerifyMfaMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AuthServiceMock.Logout at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to AuthServiceMock.VerifyMfa at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterLogoutCounter := mm_atomic.LoadUint64(&m.afterLogoutCounter)
+	afterVerifyMfaCounter := mm_atomic.LoadUint64(&m.afterVerifyMfaCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.LogoutMock.defaultExpectation != nil && afterLogoutCounter < 1 {
-		if m.LogoutMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to AuthServiceMock.Logout at\n%s", m.LogoutMock.defaultExpectation.returnOrigin)
+	if m.VerifyMfaMock.defaultExpectation != nil && afterVerifyMfaCounter < 1 {
+		if m.VerifyMfaMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.VerifyMfa at\n%s", m.VerifyMfaMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to AuthServiceMock.Logout at\n%s with params: %#v", m.LogoutMock.defaultExpectation.expectationOrigins.origin, *m.LogoutMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AuthServiceMock.VerifyMfa at\n%s with params: %#v", m.VerifyMfaMock.defaultExpectation.expectationOrigins.origin, *m.VerifyMfaMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcLogout != nil && afterLogoutCounter < 1 {
-		m.t.Errorf("Expected call to AuthServiceMock.Logout at\n%s", m.funcLogoutOrigin)
+	if m.funcVerifyMfa != nil && afterVerifyMfaCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.VerifyMfa at\n%s", m.funcVerifyMfaOrigin)
 	}
 
-	if !m.LogoutMock.invocationsDone() && afterLogoutCounter > 0 {
-		m.t.Errorf("Expected %d calls to AuthServiceMock.Logout at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.LogoutMock.expectedInvocations), m.LogoutMock.expectedInvocationsOrigin, afterLogoutCounter)
+	if !m.VerifyMfaMock.invocationsDone() && afterVerifyMfaCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.VerifyMfa at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.VerifyMfaMock.expectedInvocations), m.VerifyMfaMock.expectedInvocationsOrigin, afterVerifyMfaCounter)
 	}
 }
 
@@ -1446,13 +7105,43 @@ func (m *AuthServiceMock) MinimockLogoutInspect() {
 func (m *AuthServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockBeginPasskeyLoginInspect()
+
+			m.MinimockBeginPasskeyRegistrationInspect()
+
+			m.MinimockBeginTotpEnrollmentInspect()
+
+			m.MinimockConfirmTotpEnrollmentInspect()
+
+			m.MinimockDisableTotpInspect()
+
+			m.MinimockFinishPasskeyLoginInspect()
+
+			m.MinimockFinishPasskeyRegistrationInspect()
+
 			m.MinimockGetAccessTokenInspect()
 
 			m.MinimockGetRefreshTokenInspect()
 
+			m.MinimockListSessionsInspect()
+
 			m.MinimockLoginInspect()
 
 			m.MinimockLogoutInspect()
+
+			m.MinimockLogoutAllInspect()
+
+			m.MinimockRequestPasswordResetInspect()
+
+			m.MinimockResetPasswordInspect()
+
+			m.MinimockRevokeAllSessionsInspect()
+
+			m.MinimockRevokeSessionInspect()
+
+			m.MinimockUnlockUserInspect()
+
+			m.MinimockVerifyMfaInspect()
 		}
 	})
 }
@@ -1476,8 +7165,23 @@ func (m *AuthServiceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *AuthServiceMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockBeginPasskeyLoginDone() &&
+		m.MinimockBeginPasskeyRegistrationDone() &&
+		m.MinimockBeginTotpEnrollmentDone() &&
+		m.MinimockConfirmTotpEnrollmentDone() &&
+		m.MinimockDisableTotpDone() &&
+		m.MinimockFinishPasskeyLoginDone() &&
+		m.MinimockFinishPasskeyRegistrationDone() &&
 		m.MinimockGetAccessTokenDone() &&
 		m.MinimockGetRefreshTokenDone() &&
+		m.MinimockListSessionsDone() &&
 		m.MinimockLoginDone() &&
-		m.MinimockLogoutDone()
+		m.MinimockLogoutDone() &&
+		m.MinimockLogoutAllDone() &&
+		m.MinimockRequestPasswordResetDone() &&
+		m.MinimockResetPasswordDone() &&
+		m.MinimockRevokeAllSessionsDone() &&
+		m.MinimockRevokeSessionDone() &&
+		m.MinimockUnlockUserDone() &&
+		m.MinimockVerifyMfaDone()
 }
@@ -0,0 +1,831 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// NotificationServiceMock implements mm_service.NotificationService
+type NotificationServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDispatchPending          func(ctx context.Context) (err error)
+	funcDispatchPendingOrigin    string
+	inspectFuncDispatchPending   func(ctx context.Context)
+	afterDispatchPendingCounter  uint64
+	beforeDispatchPendingCounter uint64
+	DispatchPendingMock          mNotificationServiceMockDispatchPending
+
+	funcNotify          func(ctx context.Context, recipient string, template string, locale string, data any) (err error)
+	funcNotifyOrigin    string
+	inspectFuncNotify   func(ctx context.Context, recipient string, template string, locale string, data any)
+	afterNotifyCounter  uint64
+	beforeNotifyCounter uint64
+	NotifyMock          mNotificationServiceMockNotify
+}
+
+// NewNotificationServiceMock returns a mock for mm_service.NotificationService
+func NewNotificationServiceMock(t minimock.Tester) *NotificationServiceMock {
+	m := &NotificationServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DispatchPendingMock = mNotificationServiceMockDispatchPending{mock: m}
+	m.DispatchPendingMock.callArgs = []*NotificationServiceMockDispatchPendingParams{}
+
+	m.NotifyMock = mNotificationServiceMockNotify{mock: m}
+	m.NotifyMock.callArgs = []*NotificationServiceMockNotifyParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mNotificationServiceMockDispatchPending struct {
+	optional           bool
+	mock               *NotificationServiceMock
+	defaultExpectation *NotificationServiceMockDispatchPendingExpectation
+	expectations       []*NotificationServiceMockDispatchPendingExpectation
+
+	callArgs []*NotificationServiceMockDispatchPendingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// NotificationServiceMockDispatchPendingExpectation specifies expectation struct of the NotificationService.DispatchPending
+type NotificationServiceMockDispatchPendingExpectation struct {
+	mock               *NotificationServiceMock
+	params             *NotificationServiceMockDispatchPendingParams
+	paramPtrs          *NotificationServiceMockDispatchPendingParamPtrs
+	expectationOrigins NotificationServiceMockDispatchPendingExpectationOrigins
+	results            *NotificationServiceMockDispatchPendingResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// NotificationServiceMockDispatchPendingParams contains parameters of the NotificationService.DispatchPending
+type NotificationServiceMockDispatchPendingParams struct {
+	ctx context.Context
+}
+
+// NotificationServiceMockDispatchPendingParamPtrs contains pointers to parameters of the NotificationService.DispatchPending
+type NotificationServiceMockDispatchPendingParamPtrs struct {
+	ctx *context.Context
+}
+
+// NotificationServiceMockDispatchPendingResults contains results of the NotificationService.DispatchPending
+type NotificationServiceMockDispatchPendingResults struct {
+	err error
+}
+
+// NotificationServiceMockDispatchPendingOrigins contains origins of expectations of the NotificationService.DispatchPending
+type NotificationServiceMockDispatchPendingExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDispatchPending *mNotificationServiceMockDispatchPending) Optional() *mNotificationServiceMockDispatchPending {
+	mmDispatchPending.optional = true
+	return mmDispatchPending
+}
+
+// Expect sets up expected params for NotificationService.DispatchPending
+func (mmDispatchPending *mNotificationServiceMockDispatchPending) Expect(ctx context.Context) *mNotificationServiceMockDispatchPending {
+	if mmDispatchPending.mock.funcDispatchPending != nil {
+		mmDispatchPending.mock.t.Fatalf("NotificationServiceMock.DispatchPending mock is already set by Set")
+	}
+
+	if mmDispatchPending.defaultExpectation == nil {
+		mmDispatchPending.defaultExpectation = &NotificationServiceMockDispatchPendingExpectation{}
+	}
+
+	if mmDispatchPending.defaultExpectation.paramPtrs != nil {
+		mmDispatchPending.mock.t.Fatalf("NotificationServiceMock.DispatchPending mock is already set by ExpectParams functions")
+	}
+
+	mmDispatchPending.defaultExpectation.params = &NotificationServiceMockDispatchPendingParams{ctx}
+	mmDispatchPending.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDispatchPending.expectations {
+		if minimock.Equal(e.params, mmDispatchPending.defaultExpectation.params) {
+			mmDispatchPending.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDispatchPending.defaultExpectation.params)
+		}
+	}
+
+	return mmDispatchPending
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationService.DispatchPending
+func (mmDispatchPending *mNotificationServiceMockDispatchPending) ExpectCtxParam1(ctx context.Context) *mNotificationServiceMockDispatchPending {
+	if mmDispatchPending.mock.funcDispatchPending != nil {
+		mmDispatchPending.mock.t.Fatalf("NotificationServiceMock.DispatchPending mock is already set by Set")
+	}
+
+	if mmDispatchPending.defaultExpectation == nil {
+		mmDispatchPending.defaultExpectation = &NotificationServiceMockDispatchPendingExpectation{}
+	}
+
+	if mmDispatchPending.defaultExpectation.params != nil {
+		mmDispatchPending.mock.t.Fatalf("NotificationServiceMock.DispatchPending mock is already set by Expect")
+	}
+
+	if mmDispatchPending.defaultExpectation.paramPtrs == nil {
+		mmDispatchPending.defaultExpectation.paramPtrs = &NotificationServiceMockDispatchPendingParamPtrs{}
+	}
+	mmDispatchPending.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDispatchPending.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDispatchPending
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationService.DispatchPending
+func (mmDispatchPending *mNotificationServiceMockDispatchPending) Inspect(f func(ctx context.Context)) *mNotificationServiceMockDispatchPending {
+	if mmDispatchPending.mock.inspectFuncDispatchPending != nil {
+		mmDispatchPending.mock.t.Fatalf("Inspect function is already set for NotificationServiceMock.DispatchPending")
+	}
+
+	mmDispatchPending.mock.inspectFuncDispatchPending = f
+
+	return mmDispatchPending
+}
+
+// Return sets up results that will be returned by NotificationService.DispatchPending
+func (mmDispatchPending *mNotificationServiceMockDispatchPending) Return(err error) *NotificationServiceMock {
+	if mmDispatchPending.mock.funcDispatchPending != nil {
+		mmDispatchPending.mock.t.Fatalf("NotificationServiceMock.DispatchPending mock is already set by Set")
+	}
+
+	if mmDispatchPending.defaultExpectation == nil {
+		mmDispatchPending.defaultExpectation = &NotificationServiceMockDispatchPendingExpectation{mock: mmDispatchPending.mock}
+	}
+	mmDispatchPending.defaultExpectation.results = &NotificationServiceMockDispatchPendingResults{err}
+	mmDispatchPending.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDispatchPending.mock
+}
+
+// Set uses given function f to mock the NotificationService.DispatchPending method
+func (mmDispatchPending *mNotificationServiceMockDispatchPending) Set(f func(ctx context.Context) (err error)) *NotificationServiceMock {
+	if mmDispatchPending.defaultExpectation != nil {
+		mmDispatchPending.mock.t.Fatalf("Default expectation is already set for the NotificationService.DispatchPending method")
+	}
+
+	if len(mmDispatchPending.expectations) > 0 {
+		mmDispatchPending.mock.t.Fatalf("Some expectations are already set for the NotificationService.DispatchPending method")
+	}
+
+	mmDispatchPending.mock.funcDispatchPending = f
+	mmDispatchPending.mock.funcDispatchPendingOrigin = minimock.CallerInfo(1)
+	return mmDispatchPending.mock
+}
+
+// When sets expectation for the NotificationService.DispatchPending which will trigger the result defined by the following
+// Then helper
+func (mmDispatchPending *mNotificationServiceMockDispatchPending) When(ctx context.Context) *NotificationServiceMockDispatchPendingExpectation {
+	if mmDispatchPending.mock.funcDispatchPending != nil {
+		mmDispatchPending.mock.t.Fatalf("NotificationServiceMock.DispatchPending mock is already set by Set")
+	}
+
+	expectation := &NotificationServiceMockDispatchPendingExpectation{
+		mock:               mmDispatchPending.mock,
+		params:             &NotificationServiceMockDispatchPendingParams{ctx},
+		expectationOrigins: NotificationServiceMockDispatchPendingExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDispatchPending.expectations = append(mmDispatchPending.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationService.DispatchPending return parameters for the expectation previously defined by the When method
+func (e *NotificationServiceMockDispatchPendingExpectation) Then(err error) *NotificationServiceMock {
+	e.results = &NotificationServiceMockDispatchPendingResults{err}
+	return e.mock
+}
+
+// Times sets number of times NotificationService.DispatchPending should be invoked
+func (mmDispatchPending *mNotificationServiceMockDispatchPending) Times(n uint64) *mNotificationServiceMockDispatchPending {
+	if n == 0 {
+		mmDispatchPending.mock.t.Fatalf("Times of NotificationServiceMock.DispatchPending mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDispatchPending.expectedInvocations, n)
+	mmDispatchPending.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDispatchPending
+}
+
+func (mmDispatchPending *mNotificationServiceMockDispatchPending) invocationsDone() bool {
+	if len(mmDispatchPending.expectations) == 0 && mmDispatchPending.defaultExpectation == nil && mmDispatchPending.mock.funcDispatchPending == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDispatchPending.mock.afterDispatchPendingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDispatchPending.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DispatchPending implements mm_service.NotificationService
+func (mmDispatchPending *NotificationServiceMock) DispatchPending(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmDispatchPending.beforeDispatchPendingCounter, 1)
+	defer mm_atomic.AddUint64(&mmDispatchPending.afterDispatchPendingCounter, 1)
+
+	mmDispatchPending.t.Helper()
+
+	if mmDispatchPending.inspectFuncDispatchPending != nil {
+		mmDispatchPending.inspectFuncDispatchPending(ctx)
+	}
+
+	mm_params := NotificationServiceMockDispatchPendingParams{ctx}
+
+	// Record call args
+	mmDispatchPending.DispatchPendingMock.mutex.Lock()
+	mmDispatchPending.DispatchPendingMock.callArgs = append(mmDispatchPending.DispatchPendingMock.callArgs, &mm_params)
+	mmDispatchPending.DispatchPendingMock.mutex.Unlock()
+
+	for _, e := range mmDispatchPending.DispatchPendingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDispatchPending.DispatchPendingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDispatchPending.DispatchPendingMock.defaultExpectation.Counter, 1)
+		mm_want := mmDispatchPending.DispatchPendingMock.defaultExpectation.params
+		mm_want_ptrs := mmDispatchPending.DispatchPendingMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationServiceMockDispatchPendingParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDispatchPending.t.Errorf("NotificationServiceMock.DispatchPending got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDispatchPending.DispatchPendingMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDispatchPending.t.Errorf("NotificationServiceMock.DispatchPending got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDispatchPending.DispatchPendingMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDispatchPending.DispatchPendingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDispatchPending.t.Fatal("No results are set for the NotificationServiceMock.DispatchPending")
+		}
+		return (*mm_results).err
+	}
+	if mmDispatchPending.funcDispatchPending != nil {
+		return mmDispatchPending.funcDispatchPending(ctx)
+	}
+	mmDispatchPending.t.Fatalf("Unexpected call to NotificationServiceMock.DispatchPending. %v", ctx)
+	return
+}
+
+// DispatchPendingAfterCounter returns a count of finished NotificationServiceMock.DispatchPending invocations
+func (mmDispatchPending *NotificationServiceMock) DispatchPendingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDispatchPending.afterDispatchPendingCounter)
+}
+
+// DispatchPendingBeforeCounter returns a count of NotificationServiceMock.DispatchPending invocations
+func (mmDispatchPending *NotificationServiceMock) DispatchPendingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDispatchPending.beforeDispatchPendingCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationServiceMock.DispatchPending.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDispatchPending *mNotificationServiceMockDispatchPending) Calls() []*NotificationServiceMockDispatchPendingParams {
+	mmDispatchPending.mutex.RLock()
+
+	argCopy := make([]*NotificationServiceMockDispatchPendingParams, len(mmDispatchPending.callArgs))
+	copy(argCopy, mmDispatchPending.callArgs)
+
+	mmDispatchPending.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDispatchPendingDone returns true if the count of the DispatchPending invocations corresponds
+// the number of defined expectations
+func (m *NotificationServiceMock) MinimockDispatchPendingDone() bool {
+	if m.DispatchPendingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DispatchPendingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DispatchPendingMock.invocationsDone()
+}
+
+// MinimockDispatchPendingInspect logs each unmet expectation
+func (m *NotificationServiceMock) MinimockDispatchPendingInspect() {
+	for _, e := range m.DispatchPendingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationServiceMock.DispatchPending at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDispatchPendingCounter := mm_atomic.LoadUint64(&m.afterDispatchPendingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DispatchPendingMock.defaultExpectation != nil && afterDispatchPendingCounter < 1 {
+		if m.DispatchPendingMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to NotificationServiceMock.DispatchPending at\n%s", m.DispatchPendingMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to NotificationServiceMock.DispatchPending at\n%s with params: %#v", m.DispatchPendingMock.defaultExpectation.expectationOrigins.origin, *m.DispatchPendingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDispatchPending != nil && afterDispatchPendingCounter < 1 {
+		m.t.Errorf("Expected call to NotificationServiceMock.DispatchPending at\n%s", m.funcDispatchPendingOrigin)
+	}
+
+	if !m.DispatchPendingMock.invocationsDone() && afterDispatchPendingCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationServiceMock.DispatchPending at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DispatchPendingMock.expectedInvocations), m.DispatchPendingMock.expectedInvocationsOrigin, afterDispatchPendingCounter)
+	}
+}
+
+type mNotificationServiceMockNotify struct {
+	optional           bool
+	mock               *NotificationServiceMock
+	defaultExpectation *NotificationServiceMockNotifyExpectation
+	expectations       []*NotificationServiceMockNotifyExpectation
+
+	callArgs []*NotificationServiceMockNotifyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// NotificationServiceMockNotifyExpectation specifies expectation struct of the NotificationService.Notify
+type NotificationServiceMockNotifyExpectation struct {
+	mock               *NotificationServiceMock
+	params             *NotificationServiceMockNotifyParams
+	paramPtrs          *NotificationServiceMockNotifyParamPtrs
+	expectationOrigins NotificationServiceMockNotifyExpectationOrigins
+	results            *NotificationServiceMockNotifyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// NotificationServiceMockNotifyParams contains parameters of the NotificationService.Notify
+type NotificationServiceMockNotifyParams struct {
+	ctx       context.Context
+	recipient string
+	template  string
+	locale    string
+	data      any
+}
+
+// NotificationServiceMockNotifyParamPtrs contains pointers to parameters of the NotificationService.Notify
+type NotificationServiceMockNotifyParamPtrs struct {
+	ctx       *context.Context
+	recipient *string
+	template  *string
+	locale    *string
+	data      *any
+}
+
+// NotificationServiceMockNotifyResults contains results of the NotificationService.Notify
+type NotificationServiceMockNotifyResults struct {
+	err error
+}
+
+// NotificationServiceMockNotifyOrigins contains origins of expectations of the NotificationService.Notify
+type NotificationServiceMockNotifyExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originRecipient string
+	originTemplate  string
+	originLocale    string
+	originData      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmNotify *mNotificationServiceMockNotify) Optional() *mNotificationServiceMockNotify {
+	mmNotify.optional = true
+	return mmNotify
+}
+
+// Expect sets up expected params for NotificationService.Notify
+func (mmNotify *mNotificationServiceMockNotify) Expect(ctx context.Context, recipient string, template string, locale string, data any) *mNotificationServiceMockNotify {
+	if mmNotify.mock.funcNotify != nil {
+		mmNotify.mock.t.Fatalf("NotificationServiceMock.Notify mock is already set by Set")
+	}
+
+	if mmNotify.defaultExpectation == nil {
+		mmNotify.defaultExpectation = &NotificationServiceMockNotifyExpectation{}
+	}
+
+	if mmNotify.defaultExpectation.paramPtrs != nil {
+		mmNotify.mock.t.Fatalf("NotificationServiceMock.Notify mock is already set by ExpectParams functions")
+	}
+
+	mmNotify.defaultExpectation.params = &NotificationServiceMockNotifyParams{ctx, recipient, template, locale, data}
+	mmNotify.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmNotify.expectations {
+		if minimock.Equal(e.params, mmNotify.defaultExpectation.params) {
+			mmNotify.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmNotify.defaultExpectation.params)
+		}
+	}
+
+	return mmNotify
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationService.Notify
+func (mmNotify *mNotificationServiceMockNotify) ExpectCtxParam1(ctx context.Context) *mNotificationServiceMockNotify {
+	if mmNotify.mock.funcNotify != nil {
+		mmNotify.mock.t.Fatalf("NotificationServiceMock.Notify mock is already set by Set")
+	}
+
+	if mmNotify.defaultExpectation == nil {
+		mmNotify.defaultExpectation = &NotificationServiceMockNotifyExpectation{}
+	}
+
+	if mmNotify.defaultExpectation.params != nil {
+		mmNotify.mock.t.Fatalf("NotificationServiceMock.Notify mock is already set by Expect")
+	}
+
+	if mmNotify.defaultExpectation.paramPtrs == nil {
+		mmNotify.defaultExpectation.paramPtrs = &NotificationServiceMockNotifyParamPtrs{}
+	}
+	mmNotify.defaultExpectation.paramPtrs.ctx = &ctx
+	mmNotify.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmNotify
+}
+
+// ExpectRecipientParam2 sets up expected param recipient for NotificationService.Notify
+func (mmNotify *mNotificationServiceMockNotify) ExpectRecipientParam2(recipient string) *mNotificationServiceMockNotify {
+	if mmNotify.mock.funcNotify != nil {
+		mmNotify.mock.t.Fatalf("NotificationServiceMock.Notify mock is already set by Set")
+	}
+
+	if mmNotify.defaultExpectation == nil {
+		mmNotify.defaultExpectation = &NotificationServiceMockNotifyExpectation{}
+	}
+
+	if mmNotify.defaultExpectation.params != nil {
+		mmNotify.mock.t.Fatalf("NotificationServiceMock.Notify mock is already set by Expect")
+	}
+
+	if mmNotify.defaultExpectation.paramPtrs == nil {
+		mmNotify.defaultExpectation.paramPtrs = &NotificationServiceMockNotifyParamPtrs{}
+	}
+	mmNotify.defaultExpectation.paramPtrs.recipient = &recipient
+	mmNotify.defaultExpectation.expectationOrigins.originRecipient = minimock.CallerInfo(1)
+
+	return mmNotify
+}
+
+// ExpectTemplateParam3 sets up expected param template for NotificationService.Notify
+func (mmNotify *mNotificationServiceMockNotify) ExpectTemplateParam3(template string) *mNotificationServiceMockNotify {
+	if mmNotify.mock.funcNotify != nil {
+		mmNotify.mock.t.Fatalf("NotificationServiceMock.Notify mock is already set by Set")
+	}
+
+	if mmNotify.defaultExpectation == nil {
+		mmNotify.defaultExpectation = &NotificationServiceMockNotifyExpectation{}
+	}
+
+	if mmNotify.defaultExpectation.params != nil {
+		mmNotify.mock.t.Fatalf("NotificationServiceMock.Notify mock is already set by Expect")
+	}
+
+	if mmNotify.defaultExpectation.paramPtrs == nil {
+		mmNotify.defaultExpectation.paramPtrs = &NotificationServiceMockNotifyParamPtrs{}
+	}
+	mmNotify.defaultExpectation.paramPtrs.template = &template
+	mmNotify.defaultExpectation.expectationOrigins.originTemplate = minimock.CallerInfo(1)
+
+	return mmNotify
+}
+
+// ExpectLocaleParam4 sets up expected param locale for NotificationService.Notify
+func (mmNotify *mNotificationServiceMockNotify) ExpectLocaleParam4(locale string) *mNotificationServiceMockNotify {
+	if mmNotify.mock.funcNotify != nil {
+		mmNotify.mock.t.Fatalf("NotificationServiceMock.Notify mock is already set by Set")
+	}
+
+	if mmNotify.defaultExpectation == nil {
+		mmNotify.defaultExpectation = &NotificationServiceMockNotifyExpectation{}
+	}
+
+	if mmNotify.defaultExpectation.params != nil {
+		mmNotify.mock.t.Fatalf("NotificationServiceMock.Notify mock is already set by Expect")
+	}
+
+	if mmNotify.defaultExpectation.paramPtrs == nil {
+		mmNotify.defaultExpectation.paramPtrs = &NotificationServiceMockNotifyParamPtrs{}
+	}
+	mmNotify.defaultExpectation.paramPtrs.locale = &locale
+	mmNotify.defaultExpectation.expectationOrigins.originLocale = minimock.CallerInfo(1)
+
+	return mmNotify
+}
+
+// ExpectDataParam5 sets up expected param data for NotificationService.Notify
+func (mmNotify *mNotificationServiceMockNotify) ExpectDataParam5(data any) *mNotificationServiceMockNotify {
+	if mmNotify.mock.funcNotify != nil {
+		mmNotify.mock.t.Fatalf("NotificationServiceMock.Notify mock is already set by Set")
+	}
+
+	if mmNotify.defaultExpectation == nil {
+		mmNotify.defaultExpectation = &NotificationServiceMockNotifyExpectation{}
+	}
+
+	if mmNotify.defaultExpectation.params != nil {
+		mmNotify.mock.t.Fatalf("NotificationServiceMock.Notify mock is already set by Expect")
+	}
+
+	if mmNotify.defaultExpectation.paramPtrs == nil {
+		mmNotify.defaultExpectation.paramPtrs = &NotificationServiceMockNotifyParamPtrs{}
+	}
+	mmNotify.defaultExpectation.paramPtrs.data = &data
+	mmNotify.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
+
+	return mmNotify
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationService.Notify
+func (mmNotify *mNotificationServiceMockNotify) Inspect(f func(ctx context.Context, recipient string, template string, locale string, data any)) *mNotificationServiceMockNotify {
+	if mmNotify.mock.inspectFuncNotify != nil {
+		mmNotify.mock.t.Fatalf("Inspect function is already set for NotificationServiceMock.Notify")
+	}
+
+	mmNotify.mock.inspectFuncNotify = f
+
+	return mmNotify
+}
+
+// Return sets up results that will be returned by NotificationService.Notify
+func (mmNotify *mNotificationServiceMockNotify) Return(err error) *NotificationServiceMock {
+	if mmNotify.mock.funcNotify != nil {
+		mmNotify.mock.t.Fatalf("NotificationServiceMock.Notify mock is already set by Set")
+	}
+
+	if mmNotify.defaultExpectation == nil {
+		mmNotify.defaultExpectation = &NotificationServiceMockNotifyExpectation{mock: mmNotify.mock}
+	}
+	mmNotify.defaultExpectation.results = &NotificationServiceMockNotifyResults{err}
+	mmNotify.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmNotify.mock
+}
+
+// Set uses given function f to mock the NotificationService.Notify method
+func (mmNotify *mNotificationServiceMockNotify) Set(f func(ctx context.Context, recipient string, template string, locale string, data any) (err error)) *NotificationServiceMock {
+	if mmNotify.defaultExpectation != nil {
+		mmNotify.mock.t.Fatalf("Default expectation is already set for the NotificationService.Notify method")
+	}
+
+	if len(mmNotify.expectations) > 0 {
+		mmNotify.mock.t.Fatalf("Some expectations are already set for the NotificationService.Notify method")
+	}
+
+	mmNotify.mock.funcNotify = f
+	mmNotify.mock.funcNotifyOrigin = minimock.CallerInfo(1)
+	return mmNotify.mock
+}
+
+// When sets expectation for the NotificationService.Notify which will trigger the result defined by the following
+// Then helper
+func (mmNotify *mNotificationServiceMockNotify) When(ctx context.Context, recipient string, template string, locale string, data any) *NotificationServiceMockNotifyExpectation {
+	if mmNotify.mock.funcNotify != nil {
+		mmNotify.mock.t.Fatalf("NotificationServiceMock.Notify mock is already set by Set")
+	}
+
+	expectation := &NotificationServiceMockNotifyExpectation{
+		mock:               mmNotify.mock,
+		params:             &NotificationServiceMockNotifyParams{ctx, recipient, template, locale, data},
+		expectationOrigins: NotificationServiceMockNotifyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmNotify.expectations = append(mmNotify.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationService.Notify return parameters for the expectation previously defined by the When method
+func (e *NotificationServiceMockNotifyExpectation) Then(err error) *NotificationServiceMock {
+	e.results = &NotificationServiceMockNotifyResults{err}
+	return e.mock
+}
+
+// Times sets number of times NotificationService.Notify should be invoked
+func (mmNotify *mNotificationServiceMockNotify) Times(n uint64) *mNotificationServiceMockNotify {
+	if n == 0 {
+		mmNotify.mock.t.Fatalf("Times of NotificationServiceMock.Notify mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmNotify.expectedInvocations, n)
+	mmNotify.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmNotify
+}
+
+func (mmNotify *mNotificationServiceMockNotify) invocationsDone() bool {
+	if len(mmNotify.expectations) == 0 && mmNotify.defaultExpectation == nil && mmNotify.mock.funcNotify == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmNotify.mock.afterNotifyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmNotify.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Notify implements mm_service.NotificationService
+func (mmNotify *NotificationServiceMock) Notify(ctx context.Context, recipient string, template string, locale string, data any) (err error) {
+	mm_atomic.AddUint64(&mmNotify.beforeNotifyCounter, 1)
+	defer mm_atomic.AddUint64(&mmNotify.afterNotifyCounter, 1)
+
+	mmNotify.t.Helper()
+
+	if mmNotify.inspectFuncNotify != nil {
+		mmNotify.inspectFuncNotify(ctx, recipient, template, locale, data)
+	}
+
+	mm_params := NotificationServiceMockNotifyParams{ctx, recipient, template, locale, data}
+
+	// Record call args
+	mmNotify.NotifyMock.mutex.Lock()
+	mmNotify.NotifyMock.callArgs = append(mmNotify.NotifyMock.callArgs, &mm_params)
+	mmNotify.NotifyMock.mutex.Unlock()
+
+	for _, e := range mmNotify.NotifyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmNotify.NotifyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmNotify.NotifyMock.defaultExpectation.Counter, 1)
+		mm_want := mmNotify.NotifyMock.defaultExpectation.params
+		mm_want_ptrs := mmNotify.NotifyMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationServiceMockNotifyParams{ctx, recipient, template, locale, data}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmNotify.t.Errorf("NotificationServiceMock.Notify got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmNotify.NotifyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.recipient != nil && !minimock.Equal(*mm_want_ptrs.recipient, mm_got.recipient) {
+				mmNotify.t.Errorf("NotificationServiceMock.Notify got unexpected parameter recipient, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmNotify.NotifyMock.defaultExpectation.expectationOrigins.originRecipient, *mm_want_ptrs.recipient, mm_got.recipient, minimock.Diff(*mm_want_ptrs.recipient, mm_got.recipient))
+			}
+
+			if mm_want_ptrs.template != nil && !minimock.Equal(*mm_want_ptrs.template, mm_got.template) {
+				mmNotify.t.Errorf("NotificationServiceMock.Notify got unexpected parameter template, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmNotify.NotifyMock.defaultExpectation.expectationOrigins.originTemplate, *mm_want_ptrs.template, mm_got.template, minimock.Diff(*mm_want_ptrs.template, mm_got.template))
+			}
+
+			if mm_want_ptrs.locale != nil && !minimock.Equal(*mm_want_ptrs.locale, mm_got.locale) {
+				mmNotify.t.Errorf("NotificationServiceMock.Notify got unexpected parameter locale, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmNotify.NotifyMock.defaultExpectation.expectationOrigins.originLocale, *mm_want_ptrs.locale, mm_got.locale, minimock.Diff(*mm_want_ptrs.locale, mm_got.locale))
+			}
+
+			if mm_want_ptrs.data != nil && !minimock.Equal(*mm_want_ptrs.data, mm_got.data) {
+				mmNotify.t.Errorf("NotificationServiceMock.Notify got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmNotify.NotifyMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmNotify.t.Errorf("NotificationServiceMock.Notify got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmNotify.NotifyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmNotify.NotifyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmNotify.t.Fatal("No results are set for the NotificationServiceMock.Notify")
+		}
+		return (*mm_results).err
+	}
+	if mmNotify.funcNotify != nil {
+		return mmNotify.funcNotify(ctx, recipient, template, locale, data)
+	}
+	mmNotify.t.Fatalf("Unexpected call to NotificationServiceMock.Notify. %v %v %v %v %v", ctx, recipient, template, locale, data)
+	return
+}
+
+// NotifyAfterCounter returns a count of finished NotificationServiceMock.Notify invocations
+func (mmNotify *NotificationServiceMock) NotifyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmNotify.afterNotifyCounter)
+}
+
+// NotifyBeforeCounter returns a count of NotificationServiceMock.Notify invocations
+func (mmNotify *NotificationServiceMock) NotifyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmNotify.beforeNotifyCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationServiceMock.Notify.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmNotify *mNotificationServiceMockNotify) Calls() []*NotificationServiceMockNotifyParams {
+	mmNotify.mutex.RLock()
+
+	argCopy := make([]*NotificationServiceMockNotifyParams, len(mmNotify.callArgs))
+	copy(argCopy, mmNotify.callArgs)
+
+	mmNotify.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockNotifyDone returns true if the count of the Notify invocations corresponds
+// the number of defined expectations
+func (m *NotificationServiceMock) MinimockNotifyDone() bool {
+	if m.NotifyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.NotifyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.NotifyMock.invocationsDone()
+}
+
+// MinimockNotifyInspect logs each unmet expectation
+func (m *NotificationServiceMock) MinimockNotifyInspect() {
+	for _, e := range m.NotifyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationServiceMock.Notify at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterNotifyCounter := mm_atomic.LoadUint64(&m.afterNotifyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.NotifyMock.defaultExpectation != nil && afterNotifyCounter < 1 {
+		if m.NotifyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to NotificationServiceMock.Notify at\n%s", m.NotifyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to NotificationServiceMock.Notify at\n%s with params: %#v", m.NotifyMock.defaultExpectation.expectationOrigins.origin, *m.NotifyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcNotify != nil && afterNotifyCounter < 1 {
+		m.t.Errorf("Expected call to NotificationServiceMock.Notify at\n%s", m.funcNotifyOrigin)
+	}
+
+	if !m.NotifyMock.invocationsDone() && afterNotifyCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationServiceMock.Notify at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.NotifyMock.expectedInvocations), m.NotifyMock.expectedInvocationsOrigin, afterNotifyCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *NotificationServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDispatchPendingInspect()
+
+			m.MinimockNotifyInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *NotificationServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *NotificationServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDispatchPendingDone() &&
+		m.MinimockNotifyDone()
+}
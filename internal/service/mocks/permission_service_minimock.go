@@ -0,0 +1,2454 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// PermissionServiceMock implements mm_service.PermissionService
+type PermissionServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, permission *model.Permission) (err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, permission *model.Permission)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mPermissionServiceMockCreate
+
+	funcDelete          func(ctx context.Context, name string) (err error)
+	funcDeleteOrigin    string
+	inspectFuncDelete   func(ctx context.Context, name string)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mPermissionServiceMockDelete
+
+	funcEndpointPermissions          func(endpoint string) (sa1 []string)
+	funcEndpointPermissionsOrigin    string
+	inspectFuncEndpointPermissions   func(endpoint string)
+	afterEndpointPermissionsCounter  uint64
+	beforeEndpointPermissionsCounter uint64
+	EndpointPermissionsMock          mPermissionServiceMockEndpointPermissions
+
+	funcGet          func(ctx context.Context, name string) (pp1 *model.Permission, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, name string)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mPermissionServiceMockGet
+
+	funcList          func(ctx context.Context) (ppa1 []*model.Permission, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mPermissionServiceMockList
+
+	funcRolePermissions          func(roles []string) (sa1 []string)
+	funcRolePermissionsOrigin    string
+	inspectFuncRolePermissions   func(roles []string)
+	afterRolePermissionsCounter  uint64
+	beforeRolePermissionsCounter uint64
+	RolePermissionsMock          mPermissionServiceMockRolePermissions
+
+	funcUpdate          func(ctx context.Context, update *model.PermissionUpdate) (err error)
+	funcUpdateOrigin    string
+	inspectFuncUpdate   func(ctx context.Context, update *model.PermissionUpdate)
+	afterUpdateCounter  uint64
+	beforeUpdateCounter uint64
+	UpdateMock          mPermissionServiceMockUpdate
+}
+
+// NewPermissionServiceMock returns a mock for mm_service.PermissionService
+func NewPermissionServiceMock(t minimock.Tester) *PermissionServiceMock {
+	m := &PermissionServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mPermissionServiceMockCreate{mock: m}
+	m.CreateMock.callArgs = []*PermissionServiceMockCreateParams{}
+
+	m.DeleteMock = mPermissionServiceMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*PermissionServiceMockDeleteParams{}
+
+	m.EndpointPermissionsMock = mPermissionServiceMockEndpointPermissions{mock: m}
+	m.EndpointPermissionsMock.callArgs = []*PermissionServiceMockEndpointPermissionsParams{}
+
+	m.GetMock = mPermissionServiceMockGet{mock: m}
+	m.GetMock.callArgs = []*PermissionServiceMockGetParams{}
+
+	m.ListMock = mPermissionServiceMockList{mock: m}
+	m.ListMock.callArgs = []*PermissionServiceMockListParams{}
+
+	m.RolePermissionsMock = mPermissionServiceMockRolePermissions{mock: m}
+	m.RolePermissionsMock.callArgs = []*PermissionServiceMockRolePermissionsParams{}
+
+	m.UpdateMock = mPermissionServiceMockUpdate{mock: m}
+	m.UpdateMock.callArgs = []*PermissionServiceMockUpdateParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPermissionServiceMockCreate struct {
+	optional           bool
+	mock               *PermissionServiceMock
+	defaultExpectation *PermissionServiceMockCreateExpectation
+	expectations       []*PermissionServiceMockCreateExpectation
+
+	callArgs []*PermissionServiceMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PermissionServiceMockCreateExpectation specifies expectation struct of the PermissionService.Create
+type PermissionServiceMockCreateExpectation struct {
+	mock               *PermissionServiceMock
+	params             *PermissionServiceMockCreateParams
+	paramPtrs          *PermissionServiceMockCreateParamPtrs
+	expectationOrigins PermissionServiceMockCreateExpectationOrigins
+	results            *PermissionServiceMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PermissionServiceMockCreateParams contains parameters of the PermissionService.Create
+type PermissionServiceMockCreateParams struct {
+	ctx        context.Context
+	permission *model.Permission
+}
+
+// PermissionServiceMockCreateParamPtrs contains pointers to parameters of the PermissionService.Create
+type PermissionServiceMockCreateParamPtrs struct {
+	ctx        *context.Context
+	permission **model.Permission
+}
+
+// PermissionServiceMockCreateResults contains results of the PermissionService.Create
+type PermissionServiceMockCreateResults struct {
+	err error
+}
+
+// PermissionServiceMockCreateOrigins contains origins of expectations of the PermissionService.Create
+type PermissionServiceMockCreateExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originPermission string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mPermissionServiceMockCreate) Optional() *mPermissionServiceMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for PermissionService.Create
+func (mmCreate *mPermissionServiceMockCreate) Expect(ctx context.Context, permission *model.Permission) *mPermissionServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PermissionServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &PermissionServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("PermissionServiceMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &PermissionServiceMockCreateParams{ctx, permission}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PermissionService.Create
+func (mmCreate *mPermissionServiceMockCreate) ExpectCtxParam1(ctx context.Context) *mPermissionServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PermissionServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &PermissionServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("PermissionServiceMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &PermissionServiceMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectPermissionParam2 sets up expected param permission for PermissionService.Create
+func (mmCreate *mPermissionServiceMockCreate) ExpectPermissionParam2(permission *model.Permission) *mPermissionServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PermissionServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &PermissionServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("PermissionServiceMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &PermissionServiceMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.permission = &permission
+	mmCreate.defaultExpectation.expectationOrigins.originPermission = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionService.Create
+func (mmCreate *mPermissionServiceMockCreate) Inspect(f func(ctx context.Context, permission *model.Permission)) *mPermissionServiceMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for PermissionServiceMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by PermissionService.Create
+func (mmCreate *mPermissionServiceMockCreate) Return(err error) *PermissionServiceMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PermissionServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &PermissionServiceMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &PermissionServiceMockCreateResults{err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the PermissionService.Create method
+func (mmCreate *mPermissionServiceMockCreate) Set(f func(ctx context.Context, permission *model.Permission) (err error)) *PermissionServiceMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the PermissionService.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the PermissionService.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the PermissionService.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mPermissionServiceMockCreate) When(ctx context.Context, permission *model.Permission) *PermissionServiceMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PermissionServiceMock.Create mock is already set by Set")
+	}
+
+	expectation := &PermissionServiceMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &PermissionServiceMockCreateParams{ctx, permission},
+		expectationOrigins: PermissionServiceMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionService.Create return parameters for the expectation previously defined by the When method
+func (e *PermissionServiceMockCreateExpectation) Then(err error) *PermissionServiceMock {
+	e.results = &PermissionServiceMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times PermissionService.Create should be invoked
+func (mmCreate *mPermissionServiceMockCreate) Times(n uint64) *mPermissionServiceMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of PermissionServiceMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mPermissionServiceMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_service.PermissionService
+func (mmCreate *PermissionServiceMock) Create(ctx context.Context, permission *model.Permission) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, permission)
+	}
+
+	mm_params := PermissionServiceMockCreateParams{ctx, permission}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionServiceMockCreateParams{ctx, permission}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("PermissionServiceMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.permission != nil && !minimock.Equal(*mm_want_ptrs.permission, mm_got.permission) {
+				mmCreate.t.Errorf("PermissionServiceMock.Create got unexpected parameter permission, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originPermission, *mm_want_ptrs.permission, mm_got.permission, minimock.Diff(*mm_want_ptrs.permission, mm_got.permission))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("PermissionServiceMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the PermissionServiceMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, permission)
+	}
+	mmCreate.t.Fatalf("Unexpected call to PermissionServiceMock.Create. %v %v", ctx, permission)
+	return
+}
+
+// CreateAfterCounter returns a count of finished PermissionServiceMock.Create invocations
+func (mmCreate *PermissionServiceMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of PermissionServiceMock.Create invocations
+func (mmCreate *PermissionServiceMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionServiceMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mPermissionServiceMockCreate) Calls() []*PermissionServiceMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*PermissionServiceMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *PermissionServiceMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *PermissionServiceMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionServiceMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PermissionServiceMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PermissionServiceMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to PermissionServiceMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionServiceMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mPermissionServiceMockDelete struct {
+	optional           bool
+	mock               *PermissionServiceMock
+	defaultExpectation *PermissionServiceMockDeleteExpectation
+	expectations       []*PermissionServiceMockDeleteExpectation
+
+	callArgs []*PermissionServiceMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PermissionServiceMockDeleteExpectation specifies expectation struct of the PermissionService.Delete
+type PermissionServiceMockDeleteExpectation struct {
+	mock               *PermissionServiceMock
+	params             *PermissionServiceMockDeleteParams
+	paramPtrs          *PermissionServiceMockDeleteParamPtrs
+	expectationOrigins PermissionServiceMockDeleteExpectationOrigins
+	results            *PermissionServiceMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PermissionServiceMockDeleteParams contains parameters of the PermissionService.Delete
+type PermissionServiceMockDeleteParams struct {
+	ctx  context.Context
+	name string
+}
+
+// PermissionServiceMockDeleteParamPtrs contains pointers to parameters of the PermissionService.Delete
+type PermissionServiceMockDeleteParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// PermissionServiceMockDeleteResults contains results of the PermissionService.Delete
+type PermissionServiceMockDeleteResults struct {
+	err error
+}
+
+// PermissionServiceMockDeleteOrigins contains origins of expectations of the PermissionService.Delete
+type PermissionServiceMockDeleteExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mPermissionServiceMockDelete) Optional() *mPermissionServiceMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for PermissionService.Delete
+func (mmDelete *mPermissionServiceMockDelete) Expect(ctx context.Context, name string) *mPermissionServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("PermissionServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &PermissionServiceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("PermissionServiceMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &PermissionServiceMockDeleteParams{ctx, name}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PermissionService.Delete
+func (mmDelete *mPermissionServiceMockDelete) ExpectCtxParam1(ctx context.Context) *mPermissionServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("PermissionServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &PermissionServiceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("PermissionServiceMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &PermissionServiceMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectNameParam2 sets up expected param name for PermissionService.Delete
+func (mmDelete *mPermissionServiceMockDelete) ExpectNameParam2(name string) *mPermissionServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("PermissionServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &PermissionServiceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("PermissionServiceMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &PermissionServiceMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.name = &name
+	mmDelete.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionService.Delete
+func (mmDelete *mPermissionServiceMockDelete) Inspect(f func(ctx context.Context, name string)) *mPermissionServiceMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for PermissionServiceMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by PermissionService.Delete
+func (mmDelete *mPermissionServiceMockDelete) Return(err error) *PermissionServiceMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("PermissionServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &PermissionServiceMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &PermissionServiceMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the PermissionService.Delete method
+func (mmDelete *mPermissionServiceMockDelete) Set(f func(ctx context.Context, name string) (err error)) *PermissionServiceMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the PermissionService.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the PermissionService.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the PermissionService.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mPermissionServiceMockDelete) When(ctx context.Context, name string) *PermissionServiceMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("PermissionServiceMock.Delete mock is already set by Set")
+	}
+
+	expectation := &PermissionServiceMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &PermissionServiceMockDeleteParams{ctx, name},
+		expectationOrigins: PermissionServiceMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionService.Delete return parameters for the expectation previously defined by the When method
+func (e *PermissionServiceMockDeleteExpectation) Then(err error) *PermissionServiceMock {
+	e.results = &PermissionServiceMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times PermissionService.Delete should be invoked
+func (mmDelete *mPermissionServiceMockDelete) Times(n uint64) *mPermissionServiceMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of PermissionServiceMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mPermissionServiceMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_service.PermissionService
+func (mmDelete *PermissionServiceMock) Delete(ctx context.Context, name string) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, name)
+	}
+
+	mm_params := PermissionServiceMockDeleteParams{ctx, name}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionServiceMockDeleteParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("PermissionServiceMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmDelete.t.Errorf("PermissionServiceMock.Delete got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("PermissionServiceMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the PermissionServiceMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, name)
+	}
+	mmDelete.t.Fatalf("Unexpected call to PermissionServiceMock.Delete. %v %v", ctx, name)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished PermissionServiceMock.Delete invocations
+func (mmDelete *PermissionServiceMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of PermissionServiceMock.Delete invocations
+func (mmDelete *PermissionServiceMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionServiceMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mPermissionServiceMockDelete) Calls() []*PermissionServiceMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*PermissionServiceMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *PermissionServiceMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *PermissionServiceMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionServiceMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PermissionServiceMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PermissionServiceMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to PermissionServiceMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionServiceMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mPermissionServiceMockEndpointPermissions struct {
+	optional           bool
+	mock               *PermissionServiceMock
+	defaultExpectation *PermissionServiceMockEndpointPermissionsExpectation
+	expectations       []*PermissionServiceMockEndpointPermissionsExpectation
+
+	callArgs []*PermissionServiceMockEndpointPermissionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PermissionServiceMockEndpointPermissionsExpectation specifies expectation struct of the PermissionService.EndpointPermissions
+type PermissionServiceMockEndpointPermissionsExpectation struct {
+	mock               *PermissionServiceMock
+	params             *PermissionServiceMockEndpointPermissionsParams
+	paramPtrs          *PermissionServiceMockEndpointPermissionsParamPtrs
+	expectationOrigins PermissionServiceMockEndpointPermissionsExpectationOrigins
+	results            *PermissionServiceMockEndpointPermissionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PermissionServiceMockEndpointPermissionsParams contains parameters of the PermissionService.EndpointPermissions
+type PermissionServiceMockEndpointPermissionsParams struct {
+	endpoint string
+}
+
+// PermissionServiceMockEndpointPermissionsParamPtrs contains pointers to parameters of the PermissionService.EndpointPermissions
+type PermissionServiceMockEndpointPermissionsParamPtrs struct {
+	endpoint *string
+}
+
+// PermissionServiceMockEndpointPermissionsResults contains results of the PermissionService.EndpointPermissions
+type PermissionServiceMockEndpointPermissionsResults struct {
+	sa1 []string
+}
+
+// PermissionServiceMockEndpointPermissionsOrigins contains origins of expectations of the PermissionService.EndpointPermissions
+type PermissionServiceMockEndpointPermissionsExpectationOrigins struct {
+	origin         string
+	originEndpoint string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEndpointPermissions *mPermissionServiceMockEndpointPermissions) Optional() *mPermissionServiceMockEndpointPermissions {
+	mmEndpointPermissions.optional = true
+	return mmEndpointPermissions
+}
+
+// Expect sets up expected params for PermissionService.EndpointPermissions
+func (mmEndpointPermissions *mPermissionServiceMockEndpointPermissions) Expect(endpoint string) *mPermissionServiceMockEndpointPermissions {
+	if mmEndpointPermissions.mock.funcEndpointPermissions != nil {
+		mmEndpointPermissions.mock.t.Fatalf("PermissionServiceMock.EndpointPermissions mock is already set by Set")
+	}
+
+	if mmEndpointPermissions.defaultExpectation == nil {
+		mmEndpointPermissions.defaultExpectation = &PermissionServiceMockEndpointPermissionsExpectation{}
+	}
+
+	if mmEndpointPermissions.defaultExpectation.paramPtrs != nil {
+		mmEndpointPermissions.mock.t.Fatalf("PermissionServiceMock.EndpointPermissions mock is already set by ExpectParams functions")
+	}
+
+	mmEndpointPermissions.defaultExpectation.params = &PermissionServiceMockEndpointPermissionsParams{endpoint}
+	mmEndpointPermissions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEndpointPermissions.expectations {
+		if minimock.Equal(e.params, mmEndpointPermissions.defaultExpectation.params) {
+			mmEndpointPermissions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEndpointPermissions.defaultExpectation.params)
+		}
+	}
+
+	return mmEndpointPermissions
+}
+
+// ExpectEndpointParam1 sets up expected param endpoint for PermissionService.EndpointPermissions
+func (mmEndpointPermissions *mPermissionServiceMockEndpointPermissions) ExpectEndpointParam1(endpoint string) *mPermissionServiceMockEndpointPermissions {
+	if mmEndpointPermissions.mock.funcEndpointPermissions != nil {
+		mmEndpointPermissions.mock.t.Fatalf("PermissionServiceMock.EndpointPermissions mock is already set by Set")
+	}
+
+	if mmEndpointPermissions.defaultExpectation == nil {
+		mmEndpointPermissions.defaultExpectation = &PermissionServiceMockEndpointPermissionsExpectation{}
+	}
+
+	if mmEndpointPermissions.defaultExpectation.params != nil {
+		mmEndpointPermissions.mock.t.Fatalf("PermissionServiceMock.EndpointPermissions mock is already set by Expect")
+	}
+
+	if mmEndpointPermissions.defaultExpectation.paramPtrs == nil {
+		mmEndpointPermissions.defaultExpectation.paramPtrs = &PermissionServiceMockEndpointPermissionsParamPtrs{}
+	}
+	mmEndpointPermissions.defaultExpectation.paramPtrs.endpoint = &endpoint
+	mmEndpointPermissions.defaultExpectation.expectationOrigins.originEndpoint = minimock.CallerInfo(1)
+
+	return mmEndpointPermissions
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionService.EndpointPermissions
+func (mmEndpointPermissions *mPermissionServiceMockEndpointPermissions) Inspect(f func(endpoint string)) *mPermissionServiceMockEndpointPermissions {
+	if mmEndpointPermissions.mock.inspectFuncEndpointPermissions != nil {
+		mmEndpointPermissions.mock.t.Fatalf("Inspect function is already set for PermissionServiceMock.EndpointPermissions")
+	}
+
+	mmEndpointPermissions.mock.inspectFuncEndpointPermissions = f
+
+	return mmEndpointPermissions
+}
+
+// Return sets up results that will be returned by PermissionService.EndpointPermissions
+func (mmEndpointPermissions *mPermissionServiceMockEndpointPermissions) Return(sa1 []string) *PermissionServiceMock {
+	if mmEndpointPermissions.mock.funcEndpointPermissions != nil {
+		mmEndpointPermissions.mock.t.Fatalf("PermissionServiceMock.EndpointPermissions mock is already set by Set")
+	}
+
+	if mmEndpointPermissions.defaultExpectation == nil {
+		mmEndpointPermissions.defaultExpectation = &PermissionServiceMockEndpointPermissionsExpectation{mock: mmEndpointPermissions.mock}
+	}
+	mmEndpointPermissions.defaultExpectation.results = &PermissionServiceMockEndpointPermissionsResults{sa1}
+	mmEndpointPermissions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEndpointPermissions.mock
+}
+
+// Set uses given function f to mock the PermissionService.EndpointPermissions method
+func (mmEndpointPermissions *mPermissionServiceMockEndpointPermissions) Set(f func(endpoint string) (sa1 []string)) *PermissionServiceMock {
+	if mmEndpointPermissions.defaultExpectation != nil {
+		mmEndpointPermissions.mock.t.Fatalf("Default expectation is already set for the PermissionService.EndpointPermissions method")
+	}
+
+	if len(mmEndpointPermissions.expectations) > 0 {
+		mmEndpointPermissions.mock.t.Fatalf("Some expectations are already set for the PermissionService.EndpointPermissions method")
+	}
+
+	mmEndpointPermissions.mock.funcEndpointPermissions = f
+	mmEndpointPermissions.mock.funcEndpointPermissionsOrigin = minimock.CallerInfo(1)
+	return mmEndpointPermissions.mock
+}
+
+// When sets expectation for the PermissionService.EndpointPermissions which will trigger the result defined by the following
+// Then helper
+func (mmEndpointPermissions *mPermissionServiceMockEndpointPermissions) When(endpoint string) *PermissionServiceMockEndpointPermissionsExpectation {
+	if mmEndpointPermissions.mock.funcEndpointPermissions != nil {
+		mmEndpointPermissions.mock.t.Fatalf("PermissionServiceMock.EndpointPermissions mock is already set by Set")
+	}
+
+	expectation := &PermissionServiceMockEndpointPermissionsExpectation{
+		mock:               mmEndpointPermissions.mock,
+		params:             &PermissionServiceMockEndpointPermissionsParams{endpoint},
+		expectationOrigins: PermissionServiceMockEndpointPermissionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEndpointPermissions.expectations = append(mmEndpointPermissions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionService.EndpointPermissions return parameters for the expectation previously defined by the When method
+func (e *PermissionServiceMockEndpointPermissionsExpectation) Then(sa1 []string) *PermissionServiceMock {
+	e.results = &PermissionServiceMockEndpointPermissionsResults{sa1}
+	return e.mock
+}
+
+// Times sets number of times PermissionService.EndpointPermissions should be invoked
+func (mmEndpointPermissions *mPermissionServiceMockEndpointPermissions) Times(n uint64) *mPermissionServiceMockEndpointPermissions {
+	if n == 0 {
+		mmEndpointPermissions.mock.t.Fatalf("Times of PermissionServiceMock.EndpointPermissions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEndpointPermissions.expectedInvocations, n)
+	mmEndpointPermissions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEndpointPermissions
+}
+
+func (mmEndpointPermissions *mPermissionServiceMockEndpointPermissions) invocationsDone() bool {
+	if len(mmEndpointPermissions.expectations) == 0 && mmEndpointPermissions.defaultExpectation == nil && mmEndpointPermissions.mock.funcEndpointPermissions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEndpointPermissions.mock.afterEndpointPermissionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEndpointPermissions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// EndpointPermissions implements mm_service.PermissionService
+func (mmEndpointPermissions *PermissionServiceMock) EndpointPermissions(endpoint string) (sa1 []string) {
+	mm_atomic.AddUint64(&mmEndpointPermissions.beforeEndpointPermissionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmEndpointPermissions.afterEndpointPermissionsCounter, 1)
+
+	mmEndpointPermissions.t.Helper()
+
+	if mmEndpointPermissions.inspectFuncEndpointPermissions != nil {
+		mmEndpointPermissions.inspectFuncEndpointPermissions(endpoint)
+	}
+
+	mm_params := PermissionServiceMockEndpointPermissionsParams{endpoint}
+
+	// Record call args
+	mmEndpointPermissions.EndpointPermissionsMock.mutex.Lock()
+	mmEndpointPermissions.EndpointPermissionsMock.callArgs = append(mmEndpointPermissions.EndpointPermissionsMock.callArgs, &mm_params)
+	mmEndpointPermissions.EndpointPermissionsMock.mutex.Unlock()
+
+	for _, e := range mmEndpointPermissions.EndpointPermissionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1
+		}
+	}
+
+	if mmEndpointPermissions.EndpointPermissionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEndpointPermissions.EndpointPermissionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmEndpointPermissions.EndpointPermissionsMock.defaultExpectation.params
+		mm_want_ptrs := mmEndpointPermissions.EndpointPermissionsMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionServiceMockEndpointPermissionsParams{endpoint}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.endpoint != nil && !minimock.Equal(*mm_want_ptrs.endpoint, mm_got.endpoint) {
+				mmEndpointPermissions.t.Errorf("PermissionServiceMock.EndpointPermissions got unexpected parameter endpoint, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEndpointPermissions.EndpointPermissionsMock.defaultExpectation.expectationOrigins.originEndpoint, *mm_want_ptrs.endpoint, mm_got.endpoint, minimock.Diff(*mm_want_ptrs.endpoint, mm_got.endpoint))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEndpointPermissions.t.Errorf("PermissionServiceMock.EndpointPermissions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEndpointPermissions.EndpointPermissionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEndpointPermissions.EndpointPermissionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEndpointPermissions.t.Fatal("No results are set for the PermissionServiceMock.EndpointPermissions")
+		}
+		return (*mm_results).sa1
+	}
+	if mmEndpointPermissions.funcEndpointPermissions != nil {
+		return mmEndpointPermissions.funcEndpointPermissions(endpoint)
+	}
+	mmEndpointPermissions.t.Fatalf("Unexpected call to PermissionServiceMock.EndpointPermissions. %v", endpoint)
+	return
+}
+
+// EndpointPermissionsAfterCounter returns a count of finished PermissionServiceMock.EndpointPermissions invocations
+func (mmEndpointPermissions *PermissionServiceMock) EndpointPermissionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEndpointPermissions.afterEndpointPermissionsCounter)
+}
+
+// EndpointPermissionsBeforeCounter returns a count of PermissionServiceMock.EndpointPermissions invocations
+func (mmEndpointPermissions *PermissionServiceMock) EndpointPermissionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEndpointPermissions.beforeEndpointPermissionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionServiceMock.EndpointPermissions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEndpointPermissions *mPermissionServiceMockEndpointPermissions) Calls() []*PermissionServiceMockEndpointPermissionsParams {
+	mmEndpointPermissions.mutex.RLock()
+
+	argCopy := make([]*PermissionServiceMockEndpointPermissionsParams, len(mmEndpointPermissions.callArgs))
+	copy(argCopy, mmEndpointPermissions.callArgs)
+
+	mmEndpointPermissions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEndpointPermissionsDone returns true if the count of the EndpointPermissions invocations corresponds
+// the number of defined expectations
+func (m *PermissionServiceMock) MinimockEndpointPermissionsDone() bool {
+	if m.EndpointPermissionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EndpointPermissionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EndpointPermissionsMock.invocationsDone()
+}
+
+// MinimockEndpointPermissionsInspect logs each unmet expectation
+func (m *PermissionServiceMock) MinimockEndpointPermissionsInspect() {
+	for _, e := range m.EndpointPermissionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionServiceMock.EndpointPermissions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEndpointPermissionsCounter := mm_atomic.LoadUint64(&m.afterEndpointPermissionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EndpointPermissionsMock.defaultExpectation != nil && afterEndpointPermissionsCounter < 1 {
+		if m.EndpointPermissionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PermissionServiceMock.EndpointPermissions at\n%s", m.EndpointPermissionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PermissionServiceMock.EndpointPermissions at\n%s with params: %#v", m.EndpointPermissionsMock.defaultExpectation.expectationOrigins.origin, *m.EndpointPermissionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEndpointPermissions != nil && afterEndpointPermissionsCounter < 1 {
+		m.t.Errorf("Expected call to PermissionServiceMock.EndpointPermissions at\n%s", m.funcEndpointPermissionsOrigin)
+	}
+
+	if !m.EndpointPermissionsMock.invocationsDone() && afterEndpointPermissionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionServiceMock.EndpointPermissions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EndpointPermissionsMock.expectedInvocations), m.EndpointPermissionsMock.expectedInvocationsOrigin, afterEndpointPermissionsCounter)
+	}
+}
+
+type mPermissionServiceMockGet struct {
+	optional           bool
+	mock               *PermissionServiceMock
+	defaultExpectation *PermissionServiceMockGetExpectation
+	expectations       []*PermissionServiceMockGetExpectation
+
+	callArgs []*PermissionServiceMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PermissionServiceMockGetExpectation specifies expectation struct of the PermissionService.Get
+type PermissionServiceMockGetExpectation struct {
+	mock               *PermissionServiceMock
+	params             *PermissionServiceMockGetParams
+	paramPtrs          *PermissionServiceMockGetParamPtrs
+	expectationOrigins PermissionServiceMockGetExpectationOrigins
+	results            *PermissionServiceMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PermissionServiceMockGetParams contains parameters of the PermissionService.Get
+type PermissionServiceMockGetParams struct {
+	ctx  context.Context
+	name string
+}
+
+// PermissionServiceMockGetParamPtrs contains pointers to parameters of the PermissionService.Get
+type PermissionServiceMockGetParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// PermissionServiceMockGetResults contains results of the PermissionService.Get
+type PermissionServiceMockGetResults struct {
+	pp1 *model.Permission
+	err error
+}
+
+// PermissionServiceMockGetOrigins contains origins of expectations of the PermissionService.Get
+type PermissionServiceMockGetExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mPermissionServiceMockGet) Optional() *mPermissionServiceMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for PermissionService.Get
+func (mmGet *mPermissionServiceMockGet) Expect(ctx context.Context, name string) *mPermissionServiceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("PermissionServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &PermissionServiceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("PermissionServiceMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &PermissionServiceMockGetParams{ctx, name}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PermissionService.Get
+func (mmGet *mPermissionServiceMockGet) ExpectCtxParam1(ctx context.Context) *mPermissionServiceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("PermissionServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &PermissionServiceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("PermissionServiceMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &PermissionServiceMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectNameParam2 sets up expected param name for PermissionService.Get
+func (mmGet *mPermissionServiceMockGet) ExpectNameParam2(name string) *mPermissionServiceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("PermissionServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &PermissionServiceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("PermissionServiceMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &PermissionServiceMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.name = &name
+	mmGet.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionService.Get
+func (mmGet *mPermissionServiceMockGet) Inspect(f func(ctx context.Context, name string)) *mPermissionServiceMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for PermissionServiceMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by PermissionService.Get
+func (mmGet *mPermissionServiceMockGet) Return(pp1 *model.Permission, err error) *PermissionServiceMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("PermissionServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &PermissionServiceMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &PermissionServiceMockGetResults{pp1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the PermissionService.Get method
+func (mmGet *mPermissionServiceMockGet) Set(f func(ctx context.Context, name string) (pp1 *model.Permission, err error)) *PermissionServiceMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the PermissionService.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the PermissionService.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the PermissionService.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mPermissionServiceMockGet) When(ctx context.Context, name string) *PermissionServiceMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("PermissionServiceMock.Get mock is already set by Set")
+	}
+
+	expectation := &PermissionServiceMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &PermissionServiceMockGetParams{ctx, name},
+		expectationOrigins: PermissionServiceMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionService.Get return parameters for the expectation previously defined by the When method
+func (e *PermissionServiceMockGetExpectation) Then(pp1 *model.Permission, err error) *PermissionServiceMock {
+	e.results = &PermissionServiceMockGetResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times PermissionService.Get should be invoked
+func (mmGet *mPermissionServiceMockGet) Times(n uint64) *mPermissionServiceMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of PermissionServiceMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mPermissionServiceMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_service.PermissionService
+func (mmGet *PermissionServiceMock) Get(ctx context.Context, name string) (pp1 *model.Permission, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, name)
+	}
+
+	mm_params := PermissionServiceMockGetParams{ctx, name}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionServiceMockGetParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("PermissionServiceMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmGet.t.Errorf("PermissionServiceMock.Get got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("PermissionServiceMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the PermissionServiceMock.Get")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, name)
+	}
+	mmGet.t.Fatalf("Unexpected call to PermissionServiceMock.Get. %v %v", ctx, name)
+	return
+}
+
+// GetAfterCounter returns a count of finished PermissionServiceMock.Get invocations
+func (mmGet *PermissionServiceMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of PermissionServiceMock.Get invocations
+func (mmGet *PermissionServiceMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionServiceMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mPermissionServiceMockGet) Calls() []*PermissionServiceMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*PermissionServiceMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *PermissionServiceMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *PermissionServiceMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionServiceMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PermissionServiceMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PermissionServiceMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to PermissionServiceMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionServiceMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mPermissionServiceMockList struct {
+	optional           bool
+	mock               *PermissionServiceMock
+	defaultExpectation *PermissionServiceMockListExpectation
+	expectations       []*PermissionServiceMockListExpectation
+
+	callArgs []*PermissionServiceMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PermissionServiceMockListExpectation specifies expectation struct of the PermissionService.List
+type PermissionServiceMockListExpectation struct {
+	mock               *PermissionServiceMock
+	params             *PermissionServiceMockListParams
+	paramPtrs          *PermissionServiceMockListParamPtrs
+	expectationOrigins PermissionServiceMockListExpectationOrigins
+	results            *PermissionServiceMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PermissionServiceMockListParams contains parameters of the PermissionService.List
+type PermissionServiceMockListParams struct {
+	ctx context.Context
+}
+
+// PermissionServiceMockListParamPtrs contains pointers to parameters of the PermissionService.List
+type PermissionServiceMockListParamPtrs struct {
+	ctx *context.Context
+}
+
+// PermissionServiceMockListResults contains results of the PermissionService.List
+type PermissionServiceMockListResults struct {
+	ppa1 []*model.Permission
+	err  error
+}
+
+// PermissionServiceMockListOrigins contains origins of expectations of the PermissionService.List
+type PermissionServiceMockListExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mPermissionServiceMockList) Optional() *mPermissionServiceMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for PermissionService.List
+func (mmList *mPermissionServiceMockList) Expect(ctx context.Context) *mPermissionServiceMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("PermissionServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &PermissionServiceMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("PermissionServiceMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &PermissionServiceMockListParams{ctx}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PermissionService.List
+func (mmList *mPermissionServiceMockList) ExpectCtxParam1(ctx context.Context) *mPermissionServiceMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("PermissionServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &PermissionServiceMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("PermissionServiceMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &PermissionServiceMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionService.List
+func (mmList *mPermissionServiceMockList) Inspect(f func(ctx context.Context)) *mPermissionServiceMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for PermissionServiceMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by PermissionService.List
+func (mmList *mPermissionServiceMockList) Return(ppa1 []*model.Permission, err error) *PermissionServiceMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("PermissionServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &PermissionServiceMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &PermissionServiceMockListResults{ppa1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the PermissionService.List method
+func (mmList *mPermissionServiceMockList) Set(f func(ctx context.Context) (ppa1 []*model.Permission, err error)) *PermissionServiceMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the PermissionService.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the PermissionService.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the PermissionService.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mPermissionServiceMockList) When(ctx context.Context) *PermissionServiceMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("PermissionServiceMock.List mock is already set by Set")
+	}
+
+	expectation := &PermissionServiceMockListExpectation{
+		mock:               mmList.mock,
+		params:             &PermissionServiceMockListParams{ctx},
+		expectationOrigins: PermissionServiceMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionService.List return parameters for the expectation previously defined by the When method
+func (e *PermissionServiceMockListExpectation) Then(ppa1 []*model.Permission, err error) *PermissionServiceMock {
+	e.results = &PermissionServiceMockListResults{ppa1, err}
+	return e.mock
+}
+
+// Times sets number of times PermissionService.List should be invoked
+func (mmList *mPermissionServiceMockList) Times(n uint64) *mPermissionServiceMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of PermissionServiceMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mPermissionServiceMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_service.PermissionService
+func (mmList *PermissionServiceMock) List(ctx context.Context) (ppa1 []*model.Permission, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx)
+	}
+
+	mm_params := PermissionServiceMockListParams{ctx}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionServiceMockListParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("PermissionServiceMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("PermissionServiceMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the PermissionServiceMock.List")
+		}
+		return (*mm_results).ppa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx)
+	}
+	mmList.t.Fatalf("Unexpected call to PermissionServiceMock.List. %v", ctx)
+	return
+}
+
+// ListAfterCounter returns a count of finished PermissionServiceMock.List invocations
+func (mmList *PermissionServiceMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of PermissionServiceMock.List invocations
+func (mmList *PermissionServiceMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionServiceMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mPermissionServiceMockList) Calls() []*PermissionServiceMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*PermissionServiceMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *PermissionServiceMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *PermissionServiceMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionServiceMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PermissionServiceMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PermissionServiceMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to PermissionServiceMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionServiceMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+type mPermissionServiceMockRolePermissions struct {
+	optional           bool
+	mock               *PermissionServiceMock
+	defaultExpectation *PermissionServiceMockRolePermissionsExpectation
+	expectations       []*PermissionServiceMockRolePermissionsExpectation
+
+	callArgs []*PermissionServiceMockRolePermissionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PermissionServiceMockRolePermissionsExpectation specifies expectation struct of the PermissionService.RolePermissions
+type PermissionServiceMockRolePermissionsExpectation struct {
+	mock               *PermissionServiceMock
+	params             *PermissionServiceMockRolePermissionsParams
+	paramPtrs          *PermissionServiceMockRolePermissionsParamPtrs
+	expectationOrigins PermissionServiceMockRolePermissionsExpectationOrigins
+	results            *PermissionServiceMockRolePermissionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PermissionServiceMockRolePermissionsParams contains parameters of the PermissionService.RolePermissions
+type PermissionServiceMockRolePermissionsParams struct {
+	roles []string
+}
+
+// PermissionServiceMockRolePermissionsParamPtrs contains pointers to parameters of the PermissionService.RolePermissions
+type PermissionServiceMockRolePermissionsParamPtrs struct {
+	roles *[]string
+}
+
+// PermissionServiceMockRolePermissionsResults contains results of the PermissionService.RolePermissions
+type PermissionServiceMockRolePermissionsResults struct {
+	sa1 []string
+}
+
+// PermissionServiceMockRolePermissionsOrigins contains origins of expectations of the PermissionService.RolePermissions
+type PermissionServiceMockRolePermissionsExpectationOrigins struct {
+	origin      string
+	originRoles string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRolePermissions *mPermissionServiceMockRolePermissions) Optional() *mPermissionServiceMockRolePermissions {
+	mmRolePermissions.optional = true
+	return mmRolePermissions
+}
+
+// Expect sets up expected params for PermissionService.RolePermissions
+func (mmRolePermissions *mPermissionServiceMockRolePermissions) Expect(roles []string) *mPermissionServiceMockRolePermissions {
+	if mmRolePermissions.mock.funcRolePermissions != nil {
+		mmRolePermissions.mock.t.Fatalf("PermissionServiceMock.RolePermissions mock is already set by Set")
+	}
+
+	if mmRolePermissions.defaultExpectation == nil {
+		mmRolePermissions.defaultExpectation = &PermissionServiceMockRolePermissionsExpectation{}
+	}
+
+	if mmRolePermissions.defaultExpectation.paramPtrs != nil {
+		mmRolePermissions.mock.t.Fatalf("PermissionServiceMock.RolePermissions mock is already set by ExpectParams functions")
+	}
+
+	mmRolePermissions.defaultExpectation.params = &PermissionServiceMockRolePermissionsParams{roles}
+	mmRolePermissions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRolePermissions.expectations {
+		if minimock.Equal(e.params, mmRolePermissions.defaultExpectation.params) {
+			mmRolePermissions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRolePermissions.defaultExpectation.params)
+		}
+	}
+
+	return mmRolePermissions
+}
+
+// ExpectRolesParam1 sets up expected param roles for PermissionService.RolePermissions
+func (mmRolePermissions *mPermissionServiceMockRolePermissions) ExpectRolesParam1(roles []string) *mPermissionServiceMockRolePermissions {
+	if mmRolePermissions.mock.funcRolePermissions != nil {
+		mmRolePermissions.mock.t.Fatalf("PermissionServiceMock.RolePermissions mock is already set by Set")
+	}
+
+	if mmRolePermissions.defaultExpectation == nil {
+		mmRolePermissions.defaultExpectation = &PermissionServiceMockRolePermissionsExpectation{}
+	}
+
+	if mmRolePermissions.defaultExpectation.params != nil {
+		mmRolePermissions.mock.t.Fatalf("PermissionServiceMock.RolePermissions mock is already set by Expect")
+	}
+
+	if mmRolePermissions.defaultExpectation.paramPtrs == nil {
+		mmRolePermissions.defaultExpectation.paramPtrs = &PermissionServiceMockRolePermissionsParamPtrs{}
+	}
+	mmRolePermissions.defaultExpectation.paramPtrs.roles = &roles
+	mmRolePermissions.defaultExpectation.expectationOrigins.originRoles = minimock.CallerInfo(1)
+
+	return mmRolePermissions
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionService.RolePermissions
+func (mmRolePermissions *mPermissionServiceMockRolePermissions) Inspect(f func(roles []string)) *mPermissionServiceMockRolePermissions {
+	if mmRolePermissions.mock.inspectFuncRolePermissions != nil {
+		mmRolePermissions.mock.t.Fatalf("Inspect function is already set for PermissionServiceMock.RolePermissions")
+	}
+
+	mmRolePermissions.mock.inspectFuncRolePermissions = f
+
+	return mmRolePermissions
+}
+
+// Return sets up results that will be returned by PermissionService.RolePermissions
+func (mmRolePermissions *mPermissionServiceMockRolePermissions) Return(sa1 []string) *PermissionServiceMock {
+	if mmRolePermissions.mock.funcRolePermissions != nil {
+		mmRolePermissions.mock.t.Fatalf("PermissionServiceMock.RolePermissions mock is already set by Set")
+	}
+
+	if mmRolePermissions.defaultExpectation == nil {
+		mmRolePermissions.defaultExpectation = &PermissionServiceMockRolePermissionsExpectation{mock: mmRolePermissions.mock}
+	}
+	mmRolePermissions.defaultExpectation.results = &PermissionServiceMockRolePermissionsResults{sa1}
+	mmRolePermissions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRolePermissions.mock
+}
+
+// Set uses given function f to mock the PermissionService.RolePermissions method
+func (mmRolePermissions *mPermissionServiceMockRolePermissions) Set(f func(roles []string) (sa1 []string)) *PermissionServiceMock {
+	if mmRolePermissions.defaultExpectation != nil {
+		mmRolePermissions.mock.t.Fatalf("Default expectation is already set for the PermissionService.RolePermissions method")
+	}
+
+	if len(mmRolePermissions.expectations) > 0 {
+		mmRolePermissions.mock.t.Fatalf("Some expectations are already set for the PermissionService.RolePermissions method")
+	}
+
+	mmRolePermissions.mock.funcRolePermissions = f
+	mmRolePermissions.mock.funcRolePermissionsOrigin = minimock.CallerInfo(1)
+	return mmRolePermissions.mock
+}
+
+// When sets expectation for the PermissionService.RolePermissions which will trigger the result defined by the following
+// Then helper
+func (mmRolePermissions *mPermissionServiceMockRolePermissions) When(roles []string) *PermissionServiceMockRolePermissionsExpectation {
+	if mmRolePermissions.mock.funcRolePermissions != nil {
+		mmRolePermissions.mock.t.Fatalf("PermissionServiceMock.RolePermissions mock is already set by Set")
+	}
+
+	expectation := &PermissionServiceMockRolePermissionsExpectation{
+		mock:               mmRolePermissions.mock,
+		params:             &PermissionServiceMockRolePermissionsParams{roles},
+		expectationOrigins: PermissionServiceMockRolePermissionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRolePermissions.expectations = append(mmRolePermissions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionService.RolePermissions return parameters for the expectation previously defined by the When method
+func (e *PermissionServiceMockRolePermissionsExpectation) Then(sa1 []string) *PermissionServiceMock {
+	e.results = &PermissionServiceMockRolePermissionsResults{sa1}
+	return e.mock
+}
+
+// Times sets number of times PermissionService.RolePermissions should be invoked
+func (mmRolePermissions *mPermissionServiceMockRolePermissions) Times(n uint64) *mPermissionServiceMockRolePermissions {
+	if n == 0 {
+		mmRolePermissions.mock.t.Fatalf("Times of PermissionServiceMock.RolePermissions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRolePermissions.expectedInvocations, n)
+	mmRolePermissions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRolePermissions
+}
+
+func (mmRolePermissions *mPermissionServiceMockRolePermissions) invocationsDone() bool {
+	if len(mmRolePermissions.expectations) == 0 && mmRolePermissions.defaultExpectation == nil && mmRolePermissions.mock.funcRolePermissions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRolePermissions.mock.afterRolePermissionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRolePermissions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RolePermissions implements mm_service.PermissionService
+func (mmRolePermissions *PermissionServiceMock) RolePermissions(roles []string) (sa1 []string) {
+	mm_atomic.AddUint64(&mmRolePermissions.beforeRolePermissionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmRolePermissions.afterRolePermissionsCounter, 1)
+
+	mmRolePermissions.t.Helper()
+
+	if mmRolePermissions.inspectFuncRolePermissions != nil {
+		mmRolePermissions.inspectFuncRolePermissions(roles)
+	}
+
+	mm_params := PermissionServiceMockRolePermissionsParams{roles}
+
+	// Record call args
+	mmRolePermissions.RolePermissionsMock.mutex.Lock()
+	mmRolePermissions.RolePermissionsMock.callArgs = append(mmRolePermissions.RolePermissionsMock.callArgs, &mm_params)
+	mmRolePermissions.RolePermissionsMock.mutex.Unlock()
+
+	for _, e := range mmRolePermissions.RolePermissionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1
+		}
+	}
+
+	if mmRolePermissions.RolePermissionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRolePermissions.RolePermissionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmRolePermissions.RolePermissionsMock.defaultExpectation.params
+		mm_want_ptrs := mmRolePermissions.RolePermissionsMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionServiceMockRolePermissionsParams{roles}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.roles != nil && !minimock.Equal(*mm_want_ptrs.roles, mm_got.roles) {
+				mmRolePermissions.t.Errorf("PermissionServiceMock.RolePermissions got unexpected parameter roles, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRolePermissions.RolePermissionsMock.defaultExpectation.expectationOrigins.originRoles, *mm_want_ptrs.roles, mm_got.roles, minimock.Diff(*mm_want_ptrs.roles, mm_got.roles))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRolePermissions.t.Errorf("PermissionServiceMock.RolePermissions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRolePermissions.RolePermissionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRolePermissions.RolePermissionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRolePermissions.t.Fatal("No results are set for the PermissionServiceMock.RolePermissions")
+		}
+		return (*mm_results).sa1
+	}
+	if mmRolePermissions.funcRolePermissions != nil {
+		return mmRolePermissions.funcRolePermissions(roles)
+	}
+	mmRolePermissions.t.Fatalf("Unexpected call to PermissionServiceMock.RolePermissions. %v", roles)
+	return
+}
+
+// RolePermissionsAfterCounter returns a count of finished PermissionServiceMock.RolePermissions invocations
+func (mmRolePermissions *PermissionServiceMock) RolePermissionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRolePermissions.afterRolePermissionsCounter)
+}
+
+// RolePermissionsBeforeCounter returns a count of PermissionServiceMock.RolePermissions invocations
+func (mmRolePermissions *PermissionServiceMock) RolePermissionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRolePermissions.beforeRolePermissionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionServiceMock.RolePermissions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRolePermissions *mPermissionServiceMockRolePermissions) Calls() []*PermissionServiceMockRolePermissionsParams {
+	mmRolePermissions.mutex.RLock()
+
+	argCopy := make([]*PermissionServiceMockRolePermissionsParams, len(mmRolePermissions.callArgs))
+	copy(argCopy, mmRolePermissions.callArgs)
+
+	mmRolePermissions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRolePermissionsDone returns true if the count of the RolePermissions invocations corresponds
+// the number of defined expectations
+func (m *PermissionServiceMock) MinimockRolePermissionsDone() bool {
+	if m.RolePermissionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RolePermissionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RolePermissionsMock.invocationsDone()
+}
+
+// MinimockRolePermissionsInspect logs each unmet expectation
+func (m *PermissionServiceMock) MinimockRolePermissionsInspect() {
+	for _, e := range m.RolePermissionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionServiceMock.RolePermissions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRolePermissionsCounter := mm_atomic.LoadUint64(&m.afterRolePermissionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RolePermissionsMock.defaultExpectation != nil && afterRolePermissionsCounter < 1 {
+		if m.RolePermissionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PermissionServiceMock.RolePermissions at\n%s", m.RolePermissionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PermissionServiceMock.RolePermissions at\n%s with params: %#v", m.RolePermissionsMock.defaultExpectation.expectationOrigins.origin, *m.RolePermissionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRolePermissions != nil && afterRolePermissionsCounter < 1 {
+		m.t.Errorf("Expected call to PermissionServiceMock.RolePermissions at\n%s", m.funcRolePermissionsOrigin)
+	}
+
+	if !m.RolePermissionsMock.invocationsDone() && afterRolePermissionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionServiceMock.RolePermissions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RolePermissionsMock.expectedInvocations), m.RolePermissionsMock.expectedInvocationsOrigin, afterRolePermissionsCounter)
+	}
+}
+
+type mPermissionServiceMockUpdate struct {
+	optional           bool
+	mock               *PermissionServiceMock
+	defaultExpectation *PermissionServiceMockUpdateExpectation
+	expectations       []*PermissionServiceMockUpdateExpectation
+
+	callArgs []*PermissionServiceMockUpdateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PermissionServiceMockUpdateExpectation specifies expectation struct of the PermissionService.Update
+type PermissionServiceMockUpdateExpectation struct {
+	mock               *PermissionServiceMock
+	params             *PermissionServiceMockUpdateParams
+	paramPtrs          *PermissionServiceMockUpdateParamPtrs
+	expectationOrigins PermissionServiceMockUpdateExpectationOrigins
+	results            *PermissionServiceMockUpdateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PermissionServiceMockUpdateParams contains parameters of the PermissionService.Update
+type PermissionServiceMockUpdateParams struct {
+	ctx    context.Context
+	update *model.PermissionUpdate
+}
+
+// PermissionServiceMockUpdateParamPtrs contains pointers to parameters of the PermissionService.Update
+type PermissionServiceMockUpdateParamPtrs struct {
+	ctx    *context.Context
+	update **model.PermissionUpdate
+}
+
+// PermissionServiceMockUpdateResults contains results of the PermissionService.Update
+type PermissionServiceMockUpdateResults struct {
+	err error
+}
+
+// PermissionServiceMockUpdateOrigins contains origins of expectations of the PermissionService.Update
+type PermissionServiceMockUpdateExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUpdate string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdate *mPermissionServiceMockUpdate) Optional() *mPermissionServiceMockUpdate {
+	mmUpdate.optional = true
+	return mmUpdate
+}
+
+// Expect sets up expected params for PermissionService.Update
+func (mmUpdate *mPermissionServiceMockUpdate) Expect(ctx context.Context, update *model.PermissionUpdate) *mPermissionServiceMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("PermissionServiceMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &PermissionServiceMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs != nil {
+		mmUpdate.mock.t.Fatalf("PermissionServiceMock.Update mock is already set by ExpectParams functions")
+	}
+
+	mmUpdate.defaultExpectation.params = &PermissionServiceMockUpdateParams{ctx, update}
+	mmUpdate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PermissionService.Update
+func (mmUpdate *mPermissionServiceMockUpdate) ExpectCtxParam1(ctx context.Context) *mPermissionServiceMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("PermissionServiceMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &PermissionServiceMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("PermissionServiceMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &PermissionServiceMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// ExpectUpdateParam2 sets up expected param update for PermissionService.Update
+func (mmUpdate *mPermissionServiceMockUpdate) ExpectUpdateParam2(update *model.PermissionUpdate) *mPermissionServiceMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("PermissionServiceMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &PermissionServiceMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("PermissionServiceMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &PermissionServiceMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.update = &update
+	mmUpdate.defaultExpectation.expectationOrigins.originUpdate = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionService.Update
+func (mmUpdate *mPermissionServiceMockUpdate) Inspect(f func(ctx context.Context, update *model.PermissionUpdate)) *mPermissionServiceMockUpdate {
+	if mmUpdate.mock.inspectFuncUpdate != nil {
+		mmUpdate.mock.t.Fatalf("Inspect function is already set for PermissionServiceMock.Update")
+	}
+
+	mmUpdate.mock.inspectFuncUpdate = f
+
+	return mmUpdate
+}
+
+// Return sets up results that will be returned by PermissionService.Update
+func (mmUpdate *mPermissionServiceMockUpdate) Return(err error) *PermissionServiceMock {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("PermissionServiceMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &PermissionServiceMockUpdateExpectation{mock: mmUpdate.mock}
+	}
+	mmUpdate.defaultExpectation.results = &PermissionServiceMockUpdateResults{err}
+	mmUpdate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// Set uses given function f to mock the PermissionService.Update method
+func (mmUpdate *mPermissionServiceMockUpdate) Set(f func(ctx context.Context, update *model.PermissionUpdate) (err error)) *PermissionServiceMock {
+	if mmUpdate.defaultExpectation != nil {
+		mmUpdate.mock.t.Fatalf("Default expectation is already set for the PermissionService.Update method")
+	}
+
+	if len(mmUpdate.expectations) > 0 {
+		mmUpdate.mock.t.Fatalf("Some expectations are already set for the PermissionService.Update method")
+	}
+
+	mmUpdate.mock.funcUpdate = f
+	mmUpdate.mock.funcUpdateOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// When sets expectation for the PermissionService.Update which will trigger the result defined by the following
+// Then helper
+func (mmUpdate *mPermissionServiceMockUpdate) When(ctx context.Context, update *model.PermissionUpdate) *PermissionServiceMockUpdateExpectation {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("PermissionServiceMock.Update mock is already set by Set")
+	}
+
+	expectation := &PermissionServiceMockUpdateExpectation{
+		mock:               mmUpdate.mock,
+		params:             &PermissionServiceMockUpdateParams{ctx, update},
+		expectationOrigins: PermissionServiceMockUpdateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionService.Update return parameters for the expectation previously defined by the When method
+func (e *PermissionServiceMockUpdateExpectation) Then(err error) *PermissionServiceMock {
+	e.results = &PermissionServiceMockUpdateResults{err}
+	return e.mock
+}
+
+// Times sets number of times PermissionService.Update should be invoked
+func (mmUpdate *mPermissionServiceMockUpdate) Times(n uint64) *mPermissionServiceMockUpdate {
+	if n == 0 {
+		mmUpdate.mock.t.Fatalf("Times of PermissionServiceMock.Update mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdate.expectedInvocations, n)
+	mmUpdate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdate
+}
+
+func (mmUpdate *mPermissionServiceMockUpdate) invocationsDone() bool {
+	if len(mmUpdate.expectations) == 0 && mmUpdate.defaultExpectation == nil && mmUpdate.mock.funcUpdate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdate.mock.afterUpdateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Update implements mm_service.PermissionService
+func (mmUpdate *PermissionServiceMock) Update(ctx context.Context, update *model.PermissionUpdate) (err error) {
+	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+
+	mmUpdate.t.Helper()
+
+	if mmUpdate.inspectFuncUpdate != nil {
+		mmUpdate.inspectFuncUpdate(ctx, update)
+	}
+
+	mm_params := PermissionServiceMockUpdateParams{ctx, update}
+
+	// Record call args
+	mmUpdate.UpdateMock.mutex.Lock()
+	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
+	mmUpdate.UpdateMock.mutex.Unlock()
+
+	for _, e := range mmUpdate.UpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdate.UpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionServiceMockUpdateParams{ctx, update}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdate.t.Errorf("PermissionServiceMock.Update got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.update != nil && !minimock.Equal(*mm_want_ptrs.update, mm_got.update) {
+				mmUpdate.t.Errorf("PermissionServiceMock.Update got unexpected parameter update, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originUpdate, *mm_want_ptrs.update, mm_got.update, minimock.Diff(*mm_want_ptrs.update, mm_got.update))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdate.t.Errorf("PermissionServiceMock.Update got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdate.t.Fatal("No results are set for the PermissionServiceMock.Update")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdate.funcUpdate != nil {
+		return mmUpdate.funcUpdate(ctx, update)
+	}
+	mmUpdate.t.Fatalf("Unexpected call to PermissionServiceMock.Update. %v %v", ctx, update)
+	return
+}
+
+// UpdateAfterCounter returns a count of finished PermissionServiceMock.Update invocations
+func (mmUpdate *PermissionServiceMock) UpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+}
+
+// UpdateBeforeCounter returns a count of PermissionServiceMock.Update invocations
+func (mmUpdate *PermissionServiceMock) UpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionServiceMock.Update.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdate *mPermissionServiceMockUpdate) Calls() []*PermissionServiceMockUpdateParams {
+	mmUpdate.mutex.RLock()
+
+	argCopy := make([]*PermissionServiceMockUpdateParams, len(mmUpdate.callArgs))
+	copy(argCopy, mmUpdate.callArgs)
+
+	mmUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// the number of defined expectations
+func (m *PermissionServiceMock) MinimockUpdateDone() bool {
+	if m.UpdateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateMock.invocationsDone()
+}
+
+// MinimockUpdateInspect logs each unmet expectation
+func (m *PermissionServiceMock) MinimockUpdateInspect() {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionServiceMock.Update at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateCounter := mm_atomic.LoadUint64(&m.afterUpdateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && afterUpdateCounter < 1 {
+		if m.UpdateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PermissionServiceMock.Update at\n%s", m.UpdateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PermissionServiceMock.Update at\n%s with params: %#v", m.UpdateMock.defaultExpectation.expectationOrigins.origin, *m.UpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && afterUpdateCounter < 1 {
+		m.t.Errorf("Expected call to PermissionServiceMock.Update at\n%s", m.funcUpdateOrigin)
+	}
+
+	if !m.UpdateMock.invocationsDone() && afterUpdateCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionServiceMock.Update at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateMock.expectedInvocations), m.UpdateMock.expectedInvocationsOrigin, afterUpdateCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PermissionServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockEndpointPermissionsInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockRolePermissionsInspect()
+
+			m.MinimockUpdateInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PermissionServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PermissionServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockEndpointPermissionsDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockListDone() &&
+		m.MinimockRolePermissionsDone() &&
+		m.MinimockUpdateDone()
+}
@@ -0,0 +1,1516 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RelationServiceMock implements mm_service.RelationService
+type RelationServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCheckRelation          func(ctx context.Context, tuple *model.RelationTuple, token string) (b1 bool, s1 string, err error)
+	funcCheckRelationOrigin    string
+	inspectFuncCheckRelation   func(ctx context.Context, tuple *model.RelationTuple, token string)
+	afterCheckRelationCounter  uint64
+	beforeCheckRelationCounter uint64
+	CheckRelationMock          mRelationServiceMockCheckRelation
+
+	funcDeleteTuples          func(ctx context.Context, tuples []*model.RelationTuple) (s1 string, err error)
+	funcDeleteTuplesOrigin    string
+	inspectFuncDeleteTuples   func(ctx context.Context, tuples []*model.RelationTuple)
+	afterDeleteTuplesCounter  uint64
+	beforeDeleteTuplesCounter uint64
+	DeleteTuplesMock          mRelationServiceMockDeleteTuples
+
+	funcListObjects          func(ctx context.Context, params *model.RelationObjectsParams) (rp1 *model.RelationObjectsPage, err error)
+	funcListObjectsOrigin    string
+	inspectFuncListObjects   func(ctx context.Context, params *model.RelationObjectsParams)
+	afterListObjectsCounter  uint64
+	beforeListObjectsCounter uint64
+	ListObjectsMock          mRelationServiceMockListObjects
+
+	funcWriteTuples          func(ctx context.Context, tuples []*model.RelationTuple) (s1 string, err error)
+	funcWriteTuplesOrigin    string
+	inspectFuncWriteTuples   func(ctx context.Context, tuples []*model.RelationTuple)
+	afterWriteTuplesCounter  uint64
+	beforeWriteTuplesCounter uint64
+	WriteTuplesMock          mRelationServiceMockWriteTuples
+}
+
+// NewRelationServiceMock returns a mock for mm_service.RelationService
+func NewRelationServiceMock(t minimock.Tester) *RelationServiceMock {
+	m := &RelationServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CheckRelationMock = mRelationServiceMockCheckRelation{mock: m}
+	m.CheckRelationMock.callArgs = []*RelationServiceMockCheckRelationParams{}
+
+	m.DeleteTuplesMock = mRelationServiceMockDeleteTuples{mock: m}
+	m.DeleteTuplesMock.callArgs = []*RelationServiceMockDeleteTuplesParams{}
+
+	m.ListObjectsMock = mRelationServiceMockListObjects{mock: m}
+	m.ListObjectsMock.callArgs = []*RelationServiceMockListObjectsParams{}
+
+	m.WriteTuplesMock = mRelationServiceMockWriteTuples{mock: m}
+	m.WriteTuplesMock.callArgs = []*RelationServiceMockWriteTuplesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRelationServiceMockCheckRelation struct {
+	optional           bool
+	mock               *RelationServiceMock
+	defaultExpectation *RelationServiceMockCheckRelationExpectation
+	expectations       []*RelationServiceMockCheckRelationExpectation
+
+	callArgs []*RelationServiceMockCheckRelationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationServiceMockCheckRelationExpectation specifies expectation struct of the RelationService.CheckRelation
+type RelationServiceMockCheckRelationExpectation struct {
+	mock               *RelationServiceMock
+	params             *RelationServiceMockCheckRelationParams
+	paramPtrs          *RelationServiceMockCheckRelationParamPtrs
+	expectationOrigins RelationServiceMockCheckRelationExpectationOrigins
+	results            *RelationServiceMockCheckRelationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationServiceMockCheckRelationParams contains parameters of the RelationService.CheckRelation
+type RelationServiceMockCheckRelationParams struct {
+	ctx   context.Context
+	tuple *model.RelationTuple
+	token string
+}
+
+// RelationServiceMockCheckRelationParamPtrs contains pointers to parameters of the RelationService.CheckRelation
+type RelationServiceMockCheckRelationParamPtrs struct {
+	ctx   *context.Context
+	tuple **model.RelationTuple
+	token *string
+}
+
+// RelationServiceMockCheckRelationResults contains results of the RelationService.CheckRelation
+type RelationServiceMockCheckRelationResults struct {
+	b1  bool
+	s1  string
+	err error
+}
+
+// RelationServiceMockCheckRelationOrigins contains origins of expectations of the RelationService.CheckRelation
+type RelationServiceMockCheckRelationExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originTuple string
+	originToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheckRelation *mRelationServiceMockCheckRelation) Optional() *mRelationServiceMockCheckRelation {
+	mmCheckRelation.optional = true
+	return mmCheckRelation
+}
+
+// Expect sets up expected params for RelationService.CheckRelation
+func (mmCheckRelation *mRelationServiceMockCheckRelation) Expect(ctx context.Context, tuple *model.RelationTuple, token string) *mRelationServiceMockCheckRelation {
+	if mmCheckRelation.mock.funcCheckRelation != nil {
+		mmCheckRelation.mock.t.Fatalf("RelationServiceMock.CheckRelation mock is already set by Set")
+	}
+
+	if mmCheckRelation.defaultExpectation == nil {
+		mmCheckRelation.defaultExpectation = &RelationServiceMockCheckRelationExpectation{}
+	}
+
+	if mmCheckRelation.defaultExpectation.paramPtrs != nil {
+		mmCheckRelation.mock.t.Fatalf("RelationServiceMock.CheckRelation mock is already set by ExpectParams functions")
+	}
+
+	mmCheckRelation.defaultExpectation.params = &RelationServiceMockCheckRelationParams{ctx, tuple, token}
+	mmCheckRelation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheckRelation.expectations {
+		if minimock.Equal(e.params, mmCheckRelation.defaultExpectation.params) {
+			mmCheckRelation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckRelation.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckRelation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationService.CheckRelation
+func (mmCheckRelation *mRelationServiceMockCheckRelation) ExpectCtxParam1(ctx context.Context) *mRelationServiceMockCheckRelation {
+	if mmCheckRelation.mock.funcCheckRelation != nil {
+		mmCheckRelation.mock.t.Fatalf("RelationServiceMock.CheckRelation mock is already set by Set")
+	}
+
+	if mmCheckRelation.defaultExpectation == nil {
+		mmCheckRelation.defaultExpectation = &RelationServiceMockCheckRelationExpectation{}
+	}
+
+	if mmCheckRelation.defaultExpectation.params != nil {
+		mmCheckRelation.mock.t.Fatalf("RelationServiceMock.CheckRelation mock is already set by Expect")
+	}
+
+	if mmCheckRelation.defaultExpectation.paramPtrs == nil {
+		mmCheckRelation.defaultExpectation.paramPtrs = &RelationServiceMockCheckRelationParamPtrs{}
+	}
+	mmCheckRelation.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheckRelation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCheckRelation
+}
+
+// ExpectTupleParam2 sets up expected param tuple for RelationService.CheckRelation
+func (mmCheckRelation *mRelationServiceMockCheckRelation) ExpectTupleParam2(tuple *model.RelationTuple) *mRelationServiceMockCheckRelation {
+	if mmCheckRelation.mock.funcCheckRelation != nil {
+		mmCheckRelation.mock.t.Fatalf("RelationServiceMock.CheckRelation mock is already set by Set")
+	}
+
+	if mmCheckRelation.defaultExpectation == nil {
+		mmCheckRelation.defaultExpectation = &RelationServiceMockCheckRelationExpectation{}
+	}
+
+	if mmCheckRelation.defaultExpectation.params != nil {
+		mmCheckRelation.mock.t.Fatalf("RelationServiceMock.CheckRelation mock is already set by Expect")
+	}
+
+	if mmCheckRelation.defaultExpectation.paramPtrs == nil {
+		mmCheckRelation.defaultExpectation.paramPtrs = &RelationServiceMockCheckRelationParamPtrs{}
+	}
+	mmCheckRelation.defaultExpectation.paramPtrs.tuple = &tuple
+	mmCheckRelation.defaultExpectation.expectationOrigins.originTuple = minimock.CallerInfo(1)
+
+	return mmCheckRelation
+}
+
+// ExpectTokenParam3 sets up expected param token for RelationService.CheckRelation
+func (mmCheckRelation *mRelationServiceMockCheckRelation) ExpectTokenParam3(token string) *mRelationServiceMockCheckRelation {
+	if mmCheckRelation.mock.funcCheckRelation != nil {
+		mmCheckRelation.mock.t.Fatalf("RelationServiceMock.CheckRelation mock is already set by Set")
+	}
+
+	if mmCheckRelation.defaultExpectation == nil {
+		mmCheckRelation.defaultExpectation = &RelationServiceMockCheckRelationExpectation{}
+	}
+
+	if mmCheckRelation.defaultExpectation.params != nil {
+		mmCheckRelation.mock.t.Fatalf("RelationServiceMock.CheckRelation mock is already set by Expect")
+	}
+
+	if mmCheckRelation.defaultExpectation.paramPtrs == nil {
+		mmCheckRelation.defaultExpectation.paramPtrs = &RelationServiceMockCheckRelationParamPtrs{}
+	}
+	mmCheckRelation.defaultExpectation.paramPtrs.token = &token
+	mmCheckRelation.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmCheckRelation
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationService.CheckRelation
+func (mmCheckRelation *mRelationServiceMockCheckRelation) Inspect(f func(ctx context.Context, tuple *model.RelationTuple, token string)) *mRelationServiceMockCheckRelation {
+	if mmCheckRelation.mock.inspectFuncCheckRelation != nil {
+		mmCheckRelation.mock.t.Fatalf("Inspect function is already set for RelationServiceMock.CheckRelation")
+	}
+
+	mmCheckRelation.mock.inspectFuncCheckRelation = f
+
+	return mmCheckRelation
+}
+
+// Return sets up results that will be returned by RelationService.CheckRelation
+func (mmCheckRelation *mRelationServiceMockCheckRelation) Return(b1 bool, s1 string, err error) *RelationServiceMock {
+	if mmCheckRelation.mock.funcCheckRelation != nil {
+		mmCheckRelation.mock.t.Fatalf("RelationServiceMock.CheckRelation mock is already set by Set")
+	}
+
+	if mmCheckRelation.defaultExpectation == nil {
+		mmCheckRelation.defaultExpectation = &RelationServiceMockCheckRelationExpectation{mock: mmCheckRelation.mock}
+	}
+	mmCheckRelation.defaultExpectation.results = &RelationServiceMockCheckRelationResults{b1, s1, err}
+	mmCheckRelation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheckRelation.mock
+}
+
+// Set uses given function f to mock the RelationService.CheckRelation method
+func (mmCheckRelation *mRelationServiceMockCheckRelation) Set(f func(ctx context.Context, tuple *model.RelationTuple, token string) (b1 bool, s1 string, err error)) *RelationServiceMock {
+	if mmCheckRelation.defaultExpectation != nil {
+		mmCheckRelation.mock.t.Fatalf("Default expectation is already set for the RelationService.CheckRelation method")
+	}
+
+	if len(mmCheckRelation.expectations) > 0 {
+		mmCheckRelation.mock.t.Fatalf("Some expectations are already set for the RelationService.CheckRelation method")
+	}
+
+	mmCheckRelation.mock.funcCheckRelation = f
+	mmCheckRelation.mock.funcCheckRelationOrigin = minimock.CallerInfo(1)
+	return mmCheckRelation.mock
+}
+
+// When sets expectation for the RelationService.CheckRelation which will trigger the result defined by the following
+// Then helper
+func (mmCheckRelation *mRelationServiceMockCheckRelation) When(ctx context.Context, tuple *model.RelationTuple, token string) *RelationServiceMockCheckRelationExpectation {
+	if mmCheckRelation.mock.funcCheckRelation != nil {
+		mmCheckRelation.mock.t.Fatalf("RelationServiceMock.CheckRelation mock is already set by Set")
+	}
+
+	expectation := &RelationServiceMockCheckRelationExpectation{
+		mock:               mmCheckRelation.mock,
+		params:             &RelationServiceMockCheckRelationParams{ctx, tuple, token},
+		expectationOrigins: RelationServiceMockCheckRelationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheckRelation.expectations = append(mmCheckRelation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationService.CheckRelation return parameters for the expectation previously defined by the When method
+func (e *RelationServiceMockCheckRelationExpectation) Then(b1 bool, s1 string, err error) *RelationServiceMock {
+	e.results = &RelationServiceMockCheckRelationResults{b1, s1, err}
+	return e.mock
+}
+
+// Times sets number of times RelationService.CheckRelation should be invoked
+func (mmCheckRelation *mRelationServiceMockCheckRelation) Times(n uint64) *mRelationServiceMockCheckRelation {
+	if n == 0 {
+		mmCheckRelation.mock.t.Fatalf("Times of RelationServiceMock.CheckRelation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckRelation.expectedInvocations, n)
+	mmCheckRelation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheckRelation
+}
+
+func (mmCheckRelation *mRelationServiceMockCheckRelation) invocationsDone() bool {
+	if len(mmCheckRelation.expectations) == 0 && mmCheckRelation.defaultExpectation == nil && mmCheckRelation.mock.funcCheckRelation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckRelation.mock.afterCheckRelationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckRelation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckRelation implements mm_service.RelationService
+func (mmCheckRelation *RelationServiceMock) CheckRelation(ctx context.Context, tuple *model.RelationTuple, token string) (b1 bool, s1 string, err error) {
+	mm_atomic.AddUint64(&mmCheckRelation.beforeCheckRelationCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckRelation.afterCheckRelationCounter, 1)
+
+	mmCheckRelation.t.Helper()
+
+	if mmCheckRelation.inspectFuncCheckRelation != nil {
+		mmCheckRelation.inspectFuncCheckRelation(ctx, tuple, token)
+	}
+
+	mm_params := RelationServiceMockCheckRelationParams{ctx, tuple, token}
+
+	// Record call args
+	mmCheckRelation.CheckRelationMock.mutex.Lock()
+	mmCheckRelation.CheckRelationMock.callArgs = append(mmCheckRelation.CheckRelationMock.callArgs, &mm_params)
+	mmCheckRelation.CheckRelationMock.mutex.Unlock()
+
+	for _, e := range mmCheckRelation.CheckRelationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.s1, e.results.err
+		}
+	}
+
+	if mmCheckRelation.CheckRelationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckRelation.CheckRelationMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckRelation.CheckRelationMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckRelation.CheckRelationMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationServiceMockCheckRelationParams{ctx, tuple, token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckRelation.t.Errorf("RelationServiceMock.CheckRelation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckRelation.CheckRelationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tuple != nil && !minimock.Equal(*mm_want_ptrs.tuple, mm_got.tuple) {
+				mmCheckRelation.t.Errorf("RelationServiceMock.CheckRelation got unexpected parameter tuple, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckRelation.CheckRelationMock.defaultExpectation.expectationOrigins.originTuple, *mm_want_ptrs.tuple, mm_got.tuple, minimock.Diff(*mm_want_ptrs.tuple, mm_got.tuple))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmCheckRelation.t.Errorf("RelationServiceMock.CheckRelation got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckRelation.CheckRelationMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckRelation.t.Errorf("RelationServiceMock.CheckRelation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheckRelation.CheckRelationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckRelation.CheckRelationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckRelation.t.Fatal("No results are set for the RelationServiceMock.CheckRelation")
+		}
+		return (*mm_results).b1, (*mm_results).s1, (*mm_results).err
+	}
+	if mmCheckRelation.funcCheckRelation != nil {
+		return mmCheckRelation.funcCheckRelation(ctx, tuple, token)
+	}
+	mmCheckRelation.t.Fatalf("Unexpected call to RelationServiceMock.CheckRelation. %v %v %v", ctx, tuple, token)
+	return
+}
+
+// CheckRelationAfterCounter returns a count of finished RelationServiceMock.CheckRelation invocations
+func (mmCheckRelation *RelationServiceMock) CheckRelationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckRelation.afterCheckRelationCounter)
+}
+
+// CheckRelationBeforeCounter returns a count of RelationServiceMock.CheckRelation invocations
+func (mmCheckRelation *RelationServiceMock) CheckRelationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckRelation.beforeCheckRelationCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationServiceMock.CheckRelation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckRelation *mRelationServiceMockCheckRelation) Calls() []*RelationServiceMockCheckRelationParams {
+	mmCheckRelation.mutex.RLock()
+
+	argCopy := make([]*RelationServiceMockCheckRelationParams, len(mmCheckRelation.callArgs))
+	copy(argCopy, mmCheckRelation.callArgs)
+
+	mmCheckRelation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckRelationDone returns true if the count of the CheckRelation invocations corresponds
+// the number of defined expectations
+func (m *RelationServiceMock) MinimockCheckRelationDone() bool {
+	if m.CheckRelationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckRelationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckRelationMock.invocationsDone()
+}
+
+// MinimockCheckRelationInspect logs each unmet expectation
+func (m *RelationServiceMock) MinimockCheckRelationInspect() {
+	for _, e := range m.CheckRelationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationServiceMock.CheckRelation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckRelationCounter := mm_atomic.LoadUint64(&m.afterCheckRelationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckRelationMock.defaultExpectation != nil && afterCheckRelationCounter < 1 {
+		if m.CheckRelationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationServiceMock.CheckRelation at\n%s", m.CheckRelationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationServiceMock.CheckRelation at\n%s with params: %#v", m.CheckRelationMock.defaultExpectation.expectationOrigins.origin, *m.CheckRelationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckRelation != nil && afterCheckRelationCounter < 1 {
+		m.t.Errorf("Expected call to RelationServiceMock.CheckRelation at\n%s", m.funcCheckRelationOrigin)
+	}
+
+	if !m.CheckRelationMock.invocationsDone() && afterCheckRelationCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationServiceMock.CheckRelation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckRelationMock.expectedInvocations), m.CheckRelationMock.expectedInvocationsOrigin, afterCheckRelationCounter)
+	}
+}
+
+type mRelationServiceMockDeleteTuples struct {
+	optional           bool
+	mock               *RelationServiceMock
+	defaultExpectation *RelationServiceMockDeleteTuplesExpectation
+	expectations       []*RelationServiceMockDeleteTuplesExpectation
+
+	callArgs []*RelationServiceMockDeleteTuplesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationServiceMockDeleteTuplesExpectation specifies expectation struct of the RelationService.DeleteTuples
+type RelationServiceMockDeleteTuplesExpectation struct {
+	mock               *RelationServiceMock
+	params             *RelationServiceMockDeleteTuplesParams
+	paramPtrs          *RelationServiceMockDeleteTuplesParamPtrs
+	expectationOrigins RelationServiceMockDeleteTuplesExpectationOrigins
+	results            *RelationServiceMockDeleteTuplesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationServiceMockDeleteTuplesParams contains parameters of the RelationService.DeleteTuples
+type RelationServiceMockDeleteTuplesParams struct {
+	ctx    context.Context
+	tuples []*model.RelationTuple
+}
+
+// RelationServiceMockDeleteTuplesParamPtrs contains pointers to parameters of the RelationService.DeleteTuples
+type RelationServiceMockDeleteTuplesParamPtrs struct {
+	ctx    *context.Context
+	tuples *[]*model.RelationTuple
+}
+
+// RelationServiceMockDeleteTuplesResults contains results of the RelationService.DeleteTuples
+type RelationServiceMockDeleteTuplesResults struct {
+	s1  string
+	err error
+}
+
+// RelationServiceMockDeleteTuplesOrigins contains origins of expectations of the RelationService.DeleteTuples
+type RelationServiceMockDeleteTuplesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originTuples string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteTuples *mRelationServiceMockDeleteTuples) Optional() *mRelationServiceMockDeleteTuples {
+	mmDeleteTuples.optional = true
+	return mmDeleteTuples
+}
+
+// Expect sets up expected params for RelationService.DeleteTuples
+func (mmDeleteTuples *mRelationServiceMockDeleteTuples) Expect(ctx context.Context, tuples []*model.RelationTuple) *mRelationServiceMockDeleteTuples {
+	if mmDeleteTuples.mock.funcDeleteTuples != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationServiceMock.DeleteTuples mock is already set by Set")
+	}
+
+	if mmDeleteTuples.defaultExpectation == nil {
+		mmDeleteTuples.defaultExpectation = &RelationServiceMockDeleteTuplesExpectation{}
+	}
+
+	if mmDeleteTuples.defaultExpectation.paramPtrs != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationServiceMock.DeleteTuples mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteTuples.defaultExpectation.params = &RelationServiceMockDeleteTuplesParams{ctx, tuples}
+	mmDeleteTuples.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteTuples.expectations {
+		if minimock.Equal(e.params, mmDeleteTuples.defaultExpectation.params) {
+			mmDeleteTuples.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteTuples.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteTuples
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationService.DeleteTuples
+func (mmDeleteTuples *mRelationServiceMockDeleteTuples) ExpectCtxParam1(ctx context.Context) *mRelationServiceMockDeleteTuples {
+	if mmDeleteTuples.mock.funcDeleteTuples != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationServiceMock.DeleteTuples mock is already set by Set")
+	}
+
+	if mmDeleteTuples.defaultExpectation == nil {
+		mmDeleteTuples.defaultExpectation = &RelationServiceMockDeleteTuplesExpectation{}
+	}
+
+	if mmDeleteTuples.defaultExpectation.params != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationServiceMock.DeleteTuples mock is already set by Expect")
+	}
+
+	if mmDeleteTuples.defaultExpectation.paramPtrs == nil {
+		mmDeleteTuples.defaultExpectation.paramPtrs = &RelationServiceMockDeleteTuplesParamPtrs{}
+	}
+	mmDeleteTuples.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteTuples.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteTuples
+}
+
+// ExpectTuplesParam2 sets up expected param tuples for RelationService.DeleteTuples
+func (mmDeleteTuples *mRelationServiceMockDeleteTuples) ExpectTuplesParam2(tuples []*model.RelationTuple) *mRelationServiceMockDeleteTuples {
+	if mmDeleteTuples.mock.funcDeleteTuples != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationServiceMock.DeleteTuples mock is already set by Set")
+	}
+
+	if mmDeleteTuples.defaultExpectation == nil {
+		mmDeleteTuples.defaultExpectation = &RelationServiceMockDeleteTuplesExpectation{}
+	}
+
+	if mmDeleteTuples.defaultExpectation.params != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationServiceMock.DeleteTuples mock is already set by Expect")
+	}
+
+	if mmDeleteTuples.defaultExpectation.paramPtrs == nil {
+		mmDeleteTuples.defaultExpectation.paramPtrs = &RelationServiceMockDeleteTuplesParamPtrs{}
+	}
+	mmDeleteTuples.defaultExpectation.paramPtrs.tuples = &tuples
+	mmDeleteTuples.defaultExpectation.expectationOrigins.originTuples = minimock.CallerInfo(1)
+
+	return mmDeleteTuples
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationService.DeleteTuples
+func (mmDeleteTuples *mRelationServiceMockDeleteTuples) Inspect(f func(ctx context.Context, tuples []*model.RelationTuple)) *mRelationServiceMockDeleteTuples {
+	if mmDeleteTuples.mock.inspectFuncDeleteTuples != nil {
+		mmDeleteTuples.mock.t.Fatalf("Inspect function is already set for RelationServiceMock.DeleteTuples")
+	}
+
+	mmDeleteTuples.mock.inspectFuncDeleteTuples = f
+
+	return mmDeleteTuples
+}
+
+// Return sets up results that will be returned by RelationService.DeleteTuples
+func (mmDeleteTuples *mRelationServiceMockDeleteTuples) Return(s1 string, err error) *RelationServiceMock {
+	if mmDeleteTuples.mock.funcDeleteTuples != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationServiceMock.DeleteTuples mock is already set by Set")
+	}
+
+	if mmDeleteTuples.defaultExpectation == nil {
+		mmDeleteTuples.defaultExpectation = &RelationServiceMockDeleteTuplesExpectation{mock: mmDeleteTuples.mock}
+	}
+	mmDeleteTuples.defaultExpectation.results = &RelationServiceMockDeleteTuplesResults{s1, err}
+	mmDeleteTuples.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteTuples.mock
+}
+
+// Set uses given function f to mock the RelationService.DeleteTuples method
+func (mmDeleteTuples *mRelationServiceMockDeleteTuples) Set(f func(ctx context.Context, tuples []*model.RelationTuple) (s1 string, err error)) *RelationServiceMock {
+	if mmDeleteTuples.defaultExpectation != nil {
+		mmDeleteTuples.mock.t.Fatalf("Default expectation is already set for the RelationService.DeleteTuples method")
+	}
+
+	if len(mmDeleteTuples.expectations) > 0 {
+		mmDeleteTuples.mock.t.Fatalf("Some expectations are already set for the RelationService.DeleteTuples method")
+	}
+
+	mmDeleteTuples.mock.funcDeleteTuples = f
+	mmDeleteTuples.mock.funcDeleteTuplesOrigin = minimock.CallerInfo(1)
+	return mmDeleteTuples.mock
+}
+
+// When sets expectation for the RelationService.DeleteTuples which will trigger the result defined by the following
+// Then helper
+func (mmDeleteTuples *mRelationServiceMockDeleteTuples) When(ctx context.Context, tuples []*model.RelationTuple) *RelationServiceMockDeleteTuplesExpectation {
+	if mmDeleteTuples.mock.funcDeleteTuples != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationServiceMock.DeleteTuples mock is already set by Set")
+	}
+
+	expectation := &RelationServiceMockDeleteTuplesExpectation{
+		mock:               mmDeleteTuples.mock,
+		params:             &RelationServiceMockDeleteTuplesParams{ctx, tuples},
+		expectationOrigins: RelationServiceMockDeleteTuplesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteTuples.expectations = append(mmDeleteTuples.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationService.DeleteTuples return parameters for the expectation previously defined by the When method
+func (e *RelationServiceMockDeleteTuplesExpectation) Then(s1 string, err error) *RelationServiceMock {
+	e.results = &RelationServiceMockDeleteTuplesResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times RelationService.DeleteTuples should be invoked
+func (mmDeleteTuples *mRelationServiceMockDeleteTuples) Times(n uint64) *mRelationServiceMockDeleteTuples {
+	if n == 0 {
+		mmDeleteTuples.mock.t.Fatalf("Times of RelationServiceMock.DeleteTuples mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteTuples.expectedInvocations, n)
+	mmDeleteTuples.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteTuples
+}
+
+func (mmDeleteTuples *mRelationServiceMockDeleteTuples) invocationsDone() bool {
+	if len(mmDeleteTuples.expectations) == 0 && mmDeleteTuples.defaultExpectation == nil && mmDeleteTuples.mock.funcDeleteTuples == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteTuples.mock.afterDeleteTuplesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteTuples.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteTuples implements mm_service.RelationService
+func (mmDeleteTuples *RelationServiceMock) DeleteTuples(ctx context.Context, tuples []*model.RelationTuple) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmDeleteTuples.beforeDeleteTuplesCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteTuples.afterDeleteTuplesCounter, 1)
+
+	mmDeleteTuples.t.Helper()
+
+	if mmDeleteTuples.inspectFuncDeleteTuples != nil {
+		mmDeleteTuples.inspectFuncDeleteTuples(ctx, tuples)
+	}
+
+	mm_params := RelationServiceMockDeleteTuplesParams{ctx, tuples}
+
+	// Record call args
+	mmDeleteTuples.DeleteTuplesMock.mutex.Lock()
+	mmDeleteTuples.DeleteTuplesMock.callArgs = append(mmDeleteTuples.DeleteTuplesMock.callArgs, &mm_params)
+	mmDeleteTuples.DeleteTuplesMock.mutex.Unlock()
+
+	for _, e := range mmDeleteTuples.DeleteTuplesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmDeleteTuples.DeleteTuplesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteTuples.DeleteTuplesMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteTuples.DeleteTuplesMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteTuples.DeleteTuplesMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationServiceMockDeleteTuplesParams{ctx, tuples}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteTuples.t.Errorf("RelationServiceMock.DeleteTuples got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTuples.DeleteTuplesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tuples != nil && !minimock.Equal(*mm_want_ptrs.tuples, mm_got.tuples) {
+				mmDeleteTuples.t.Errorf("RelationServiceMock.DeleteTuples got unexpected parameter tuples, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTuples.DeleteTuplesMock.defaultExpectation.expectationOrigins.originTuples, *mm_want_ptrs.tuples, mm_got.tuples, minimock.Diff(*mm_want_ptrs.tuples, mm_got.tuples))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteTuples.t.Errorf("RelationServiceMock.DeleteTuples got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteTuples.DeleteTuplesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteTuples.DeleteTuplesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteTuples.t.Fatal("No results are set for the RelationServiceMock.DeleteTuples")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmDeleteTuples.funcDeleteTuples != nil {
+		return mmDeleteTuples.funcDeleteTuples(ctx, tuples)
+	}
+	mmDeleteTuples.t.Fatalf("Unexpected call to RelationServiceMock.DeleteTuples. %v %v", ctx, tuples)
+	return
+}
+
+// DeleteTuplesAfterCounter returns a count of finished RelationServiceMock.DeleteTuples invocations
+func (mmDeleteTuples *RelationServiceMock) DeleteTuplesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTuples.afterDeleteTuplesCounter)
+}
+
+// DeleteTuplesBeforeCounter returns a count of RelationServiceMock.DeleteTuples invocations
+func (mmDeleteTuples *RelationServiceMock) DeleteTuplesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTuples.beforeDeleteTuplesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationServiceMock.DeleteTuples.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteTuples *mRelationServiceMockDeleteTuples) Calls() []*RelationServiceMockDeleteTuplesParams {
+	mmDeleteTuples.mutex.RLock()
+
+	argCopy := make([]*RelationServiceMockDeleteTuplesParams, len(mmDeleteTuples.callArgs))
+	copy(argCopy, mmDeleteTuples.callArgs)
+
+	mmDeleteTuples.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteTuplesDone returns true if the count of the DeleteTuples invocations corresponds
+// the number of defined expectations
+func (m *RelationServiceMock) MinimockDeleteTuplesDone() bool {
+	if m.DeleteTuplesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteTuplesMock.invocationsDone()
+}
+
+// MinimockDeleteTuplesInspect logs each unmet expectation
+func (m *RelationServiceMock) MinimockDeleteTuplesInspect() {
+	for _, e := range m.DeleteTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationServiceMock.DeleteTuples at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteTuplesCounter := mm_atomic.LoadUint64(&m.afterDeleteTuplesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteTuplesMock.defaultExpectation != nil && afterDeleteTuplesCounter < 1 {
+		if m.DeleteTuplesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationServiceMock.DeleteTuples at\n%s", m.DeleteTuplesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationServiceMock.DeleteTuples at\n%s with params: %#v", m.DeleteTuplesMock.defaultExpectation.expectationOrigins.origin, *m.DeleteTuplesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteTuples != nil && afterDeleteTuplesCounter < 1 {
+		m.t.Errorf("Expected call to RelationServiceMock.DeleteTuples at\n%s", m.funcDeleteTuplesOrigin)
+	}
+
+	if !m.DeleteTuplesMock.invocationsDone() && afterDeleteTuplesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationServiceMock.DeleteTuples at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteTuplesMock.expectedInvocations), m.DeleteTuplesMock.expectedInvocationsOrigin, afterDeleteTuplesCounter)
+	}
+}
+
+type mRelationServiceMockListObjects struct {
+	optional           bool
+	mock               *RelationServiceMock
+	defaultExpectation *RelationServiceMockListObjectsExpectation
+	expectations       []*RelationServiceMockListObjectsExpectation
+
+	callArgs []*RelationServiceMockListObjectsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationServiceMockListObjectsExpectation specifies expectation struct of the RelationService.ListObjects
+type RelationServiceMockListObjectsExpectation struct {
+	mock               *RelationServiceMock
+	params             *RelationServiceMockListObjectsParams
+	paramPtrs          *RelationServiceMockListObjectsParamPtrs
+	expectationOrigins RelationServiceMockListObjectsExpectationOrigins
+	results            *RelationServiceMockListObjectsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationServiceMockListObjectsParams contains parameters of the RelationService.ListObjects
+type RelationServiceMockListObjectsParams struct {
+	ctx    context.Context
+	params *model.RelationObjectsParams
+}
+
+// RelationServiceMockListObjectsParamPtrs contains pointers to parameters of the RelationService.ListObjects
+type RelationServiceMockListObjectsParamPtrs struct {
+	ctx    *context.Context
+	params **model.RelationObjectsParams
+}
+
+// RelationServiceMockListObjectsResults contains results of the RelationService.ListObjects
+type RelationServiceMockListObjectsResults struct {
+	rp1 *model.RelationObjectsPage
+	err error
+}
+
+// RelationServiceMockListObjectsOrigins contains origins of expectations of the RelationService.ListObjects
+type RelationServiceMockListObjectsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListObjects *mRelationServiceMockListObjects) Optional() *mRelationServiceMockListObjects {
+	mmListObjects.optional = true
+	return mmListObjects
+}
+
+// Expect sets up expected params for RelationService.ListObjects
+func (mmListObjects *mRelationServiceMockListObjects) Expect(ctx context.Context, params *model.RelationObjectsParams) *mRelationServiceMockListObjects {
+	if mmListObjects.mock.funcListObjects != nil {
+		mmListObjects.mock.t.Fatalf("RelationServiceMock.ListObjects mock is already set by Set")
+	}
+
+	if mmListObjects.defaultExpectation == nil {
+		mmListObjects.defaultExpectation = &RelationServiceMockListObjectsExpectation{}
+	}
+
+	if mmListObjects.defaultExpectation.paramPtrs != nil {
+		mmListObjects.mock.t.Fatalf("RelationServiceMock.ListObjects mock is already set by ExpectParams functions")
+	}
+
+	mmListObjects.defaultExpectation.params = &RelationServiceMockListObjectsParams{ctx, params}
+	mmListObjects.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListObjects.expectations {
+		if minimock.Equal(e.params, mmListObjects.defaultExpectation.params) {
+			mmListObjects.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListObjects.defaultExpectation.params)
+		}
+	}
+
+	return mmListObjects
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationService.ListObjects
+func (mmListObjects *mRelationServiceMockListObjects) ExpectCtxParam1(ctx context.Context) *mRelationServiceMockListObjects {
+	if mmListObjects.mock.funcListObjects != nil {
+		mmListObjects.mock.t.Fatalf("RelationServiceMock.ListObjects mock is already set by Set")
+	}
+
+	if mmListObjects.defaultExpectation == nil {
+		mmListObjects.defaultExpectation = &RelationServiceMockListObjectsExpectation{}
+	}
+
+	if mmListObjects.defaultExpectation.params != nil {
+		mmListObjects.mock.t.Fatalf("RelationServiceMock.ListObjects mock is already set by Expect")
+	}
+
+	if mmListObjects.defaultExpectation.paramPtrs == nil {
+		mmListObjects.defaultExpectation.paramPtrs = &RelationServiceMockListObjectsParamPtrs{}
+	}
+	mmListObjects.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListObjects.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListObjects
+}
+
+// ExpectParamsParam2 sets up expected param params for RelationService.ListObjects
+func (mmListObjects *mRelationServiceMockListObjects) ExpectParamsParam2(params *model.RelationObjectsParams) *mRelationServiceMockListObjects {
+	if mmListObjects.mock.funcListObjects != nil {
+		mmListObjects.mock.t.Fatalf("RelationServiceMock.ListObjects mock is already set by Set")
+	}
+
+	if mmListObjects.defaultExpectation == nil {
+		mmListObjects.defaultExpectation = &RelationServiceMockListObjectsExpectation{}
+	}
+
+	if mmListObjects.defaultExpectation.params != nil {
+		mmListObjects.mock.t.Fatalf("RelationServiceMock.ListObjects mock is already set by Expect")
+	}
+
+	if mmListObjects.defaultExpectation.paramPtrs == nil {
+		mmListObjects.defaultExpectation.paramPtrs = &RelationServiceMockListObjectsParamPtrs{}
+	}
+	mmListObjects.defaultExpectation.paramPtrs.params = &params
+	mmListObjects.defaultExpectation.expectationOrigins.originParams = minimock.CallerInfo(1)
+
+	return mmListObjects
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationService.ListObjects
+func (mmListObjects *mRelationServiceMockListObjects) Inspect(f func(ctx context.Context, params *model.RelationObjectsParams)) *mRelationServiceMockListObjects {
+	if mmListObjects.mock.inspectFuncListObjects != nil {
+		mmListObjects.mock.t.Fatalf("Inspect function is already set for RelationServiceMock.ListObjects")
+	}
+
+	mmListObjects.mock.inspectFuncListObjects = f
+
+	return mmListObjects
+}
+
+// Return sets up results that will be returned by RelationService.ListObjects
+func (mmListObjects *mRelationServiceMockListObjects) Return(rp1 *model.RelationObjectsPage, err error) *RelationServiceMock {
+	if mmListObjects.mock.funcListObjects != nil {
+		mmListObjects.mock.t.Fatalf("RelationServiceMock.ListObjects mock is already set by Set")
+	}
+
+	if mmListObjects.defaultExpectation == nil {
+		mmListObjects.defaultExpectation = &RelationServiceMockListObjectsExpectation{mock: mmListObjects.mock}
+	}
+	mmListObjects.defaultExpectation.results = &RelationServiceMockListObjectsResults{rp1, err}
+	mmListObjects.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListObjects.mock
+}
+
+// Set uses given function f to mock the RelationService.ListObjects method
+func (mmListObjects *mRelationServiceMockListObjects) Set(f func(ctx context.Context, params *model.RelationObjectsParams) (rp1 *model.RelationObjectsPage, err error)) *RelationServiceMock {
+	if mmListObjects.defaultExpectation != nil {
+		mmListObjects.mock.t.Fatalf("Default expectation is already set for the RelationService.ListObjects method")
+	}
+
+	if len(mmListObjects.expectations) > 0 {
+		mmListObjects.mock.t.Fatalf("Some expectations are already set for the RelationService.ListObjects method")
+	}
+
+	mmListObjects.mock.funcListObjects = f
+	mmListObjects.mock.funcListObjectsOrigin = minimock.CallerInfo(1)
+	return mmListObjects.mock
+}
+
+// When sets expectation for the RelationService.ListObjects which will trigger the result defined by the following
+// Then helper
+func (mmListObjects *mRelationServiceMockListObjects) When(ctx context.Context, params *model.RelationObjectsParams) *RelationServiceMockListObjectsExpectation {
+	if mmListObjects.mock.funcListObjects != nil {
+		mmListObjects.mock.t.Fatalf("RelationServiceMock.ListObjects mock is already set by Set")
+	}
+
+	expectation := &RelationServiceMockListObjectsExpectation{
+		mock:               mmListObjects.mock,
+		params:             &RelationServiceMockListObjectsParams{ctx, params},
+		expectationOrigins: RelationServiceMockListObjectsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListObjects.expectations = append(mmListObjects.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationService.ListObjects return parameters for the expectation previously defined by the When method
+func (e *RelationServiceMockListObjectsExpectation) Then(rp1 *model.RelationObjectsPage, err error) *RelationServiceMock {
+	e.results = &RelationServiceMockListObjectsResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times RelationService.ListObjects should be invoked
+func (mmListObjects *mRelationServiceMockListObjects) Times(n uint64) *mRelationServiceMockListObjects {
+	if n == 0 {
+		mmListObjects.mock.t.Fatalf("Times of RelationServiceMock.ListObjects mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListObjects.expectedInvocations, n)
+	mmListObjects.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListObjects
+}
+
+func (mmListObjects *mRelationServiceMockListObjects) invocationsDone() bool {
+	if len(mmListObjects.expectations) == 0 && mmListObjects.defaultExpectation == nil && mmListObjects.mock.funcListObjects == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListObjects.mock.afterListObjectsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListObjects.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListObjects implements mm_service.RelationService
+func (mmListObjects *RelationServiceMock) ListObjects(ctx context.Context, params *model.RelationObjectsParams) (rp1 *model.RelationObjectsPage, err error) {
+	mm_atomic.AddUint64(&mmListObjects.beforeListObjectsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListObjects.afterListObjectsCounter, 1)
+
+	mmListObjects.t.Helper()
+
+	if mmListObjects.inspectFuncListObjects != nil {
+		mmListObjects.inspectFuncListObjects(ctx, params)
+	}
+
+	mm_params := RelationServiceMockListObjectsParams{ctx, params}
+
+	// Record call args
+	mmListObjects.ListObjectsMock.mutex.Lock()
+	mmListObjects.ListObjectsMock.callArgs = append(mmListObjects.ListObjectsMock.callArgs, &mm_params)
+	mmListObjects.ListObjectsMock.mutex.Unlock()
+
+	for _, e := range mmListObjects.ListObjectsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmListObjects.ListObjectsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListObjects.ListObjectsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListObjects.ListObjectsMock.defaultExpectation.params
+		mm_want_ptrs := mmListObjects.ListObjectsMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationServiceMockListObjectsParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListObjects.t.Errorf("RelationServiceMock.ListObjects got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListObjects.ListObjectsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListObjects.t.Errorf("RelationServiceMock.ListObjects got unexpected parameter params, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListObjects.ListObjectsMock.defaultExpectation.expectationOrigins.originParams, *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListObjects.t.Errorf("RelationServiceMock.ListObjects got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListObjects.ListObjectsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListObjects.ListObjectsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListObjects.t.Fatal("No results are set for the RelationServiceMock.ListObjects")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmListObjects.funcListObjects != nil {
+		return mmListObjects.funcListObjects(ctx, params)
+	}
+	mmListObjects.t.Fatalf("Unexpected call to RelationServiceMock.ListObjects. %v %v", ctx, params)
+	return
+}
+
+// ListObjectsAfterCounter returns a count of finished RelationServiceMock.ListObjects invocations
+func (mmListObjects *RelationServiceMock) ListObjectsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListObjects.afterListObjectsCounter)
+}
+
+// ListObjectsBeforeCounter returns a count of RelationServiceMock.ListObjects invocations
+func (mmListObjects *RelationServiceMock) ListObjectsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListObjects.beforeListObjectsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationServiceMock.ListObjects.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListObjects *mRelationServiceMockListObjects) Calls() []*RelationServiceMockListObjectsParams {
+	mmListObjects.mutex.RLock()
+
+	argCopy := make([]*RelationServiceMockListObjectsParams, len(mmListObjects.callArgs))
+	copy(argCopy, mmListObjects.callArgs)
+
+	mmListObjects.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListObjectsDone returns true if the count of the ListObjects invocations corresponds
+// the number of defined expectations
+func (m *RelationServiceMock) MinimockListObjectsDone() bool {
+	if m.ListObjectsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListObjectsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListObjectsMock.invocationsDone()
+}
+
+// MinimockListObjectsInspect logs each unmet expectation
+func (m *RelationServiceMock) MinimockListObjectsInspect() {
+	for _, e := range m.ListObjectsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationServiceMock.ListObjects at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListObjectsCounter := mm_atomic.LoadUint64(&m.afterListObjectsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListObjectsMock.defaultExpectation != nil && afterListObjectsCounter < 1 {
+		if m.ListObjectsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationServiceMock.ListObjects at\n%s", m.ListObjectsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationServiceMock.ListObjects at\n%s with params: %#v", m.ListObjectsMock.defaultExpectation.expectationOrigins.origin, *m.ListObjectsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListObjects != nil && afterListObjectsCounter < 1 {
+		m.t.Errorf("Expected call to RelationServiceMock.ListObjects at\n%s", m.funcListObjectsOrigin)
+	}
+
+	if !m.ListObjectsMock.invocationsDone() && afterListObjectsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationServiceMock.ListObjects at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListObjectsMock.expectedInvocations), m.ListObjectsMock.expectedInvocationsOrigin, afterListObjectsCounter)
+	}
+}
+
+type mRelationServiceMockWriteTuples struct {
+	optional           bool
+	mock               *RelationServiceMock
+	defaultExpectation *RelationServiceMockWriteTuplesExpectation
+	expectations       []*RelationServiceMockWriteTuplesExpectation
+
+	callArgs []*RelationServiceMockWriteTuplesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RelationServiceMockWriteTuplesExpectation specifies expectation struct of the RelationService.WriteTuples
+type RelationServiceMockWriteTuplesExpectation struct {
+	mock               *RelationServiceMock
+	params             *RelationServiceMockWriteTuplesParams
+	paramPtrs          *RelationServiceMockWriteTuplesParamPtrs
+	expectationOrigins RelationServiceMockWriteTuplesExpectationOrigins
+	results            *RelationServiceMockWriteTuplesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RelationServiceMockWriteTuplesParams contains parameters of the RelationService.WriteTuples
+type RelationServiceMockWriteTuplesParams struct {
+	ctx    context.Context
+	tuples []*model.RelationTuple
+}
+
+// RelationServiceMockWriteTuplesParamPtrs contains pointers to parameters of the RelationService.WriteTuples
+type RelationServiceMockWriteTuplesParamPtrs struct {
+	ctx    *context.Context
+	tuples *[]*model.RelationTuple
+}
+
+// RelationServiceMockWriteTuplesResults contains results of the RelationService.WriteTuples
+type RelationServiceMockWriteTuplesResults struct {
+	s1  string
+	err error
+}
+
+// RelationServiceMockWriteTuplesOrigins contains origins of expectations of the RelationService.WriteTuples
+type RelationServiceMockWriteTuplesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originTuples string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmWriteTuples *mRelationServiceMockWriteTuples) Optional() *mRelationServiceMockWriteTuples {
+	mmWriteTuples.optional = true
+	return mmWriteTuples
+}
+
+// Expect sets up expected params for RelationService.WriteTuples
+func (mmWriteTuples *mRelationServiceMockWriteTuples) Expect(ctx context.Context, tuples []*model.RelationTuple) *mRelationServiceMockWriteTuples {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Set")
+	}
+
+	if mmWriteTuples.defaultExpectation == nil {
+		mmWriteTuples.defaultExpectation = &RelationServiceMockWriteTuplesExpectation{}
+	}
+
+	if mmWriteTuples.defaultExpectation.paramPtrs != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by ExpectParams functions")
+	}
+
+	mmWriteTuples.defaultExpectation.params = &RelationServiceMockWriteTuplesParams{ctx, tuples}
+	mmWriteTuples.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmWriteTuples.expectations {
+		if minimock.Equal(e.params, mmWriteTuples.defaultExpectation.params) {
+			mmWriteTuples.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWriteTuples.defaultExpectation.params)
+		}
+	}
+
+	return mmWriteTuples
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationService.WriteTuples
+func (mmWriteTuples *mRelationServiceMockWriteTuples) ExpectCtxParam1(ctx context.Context) *mRelationServiceMockWriteTuples {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Set")
+	}
+
+	if mmWriteTuples.defaultExpectation == nil {
+		mmWriteTuples.defaultExpectation = &RelationServiceMockWriteTuplesExpectation{}
+	}
+
+	if mmWriteTuples.defaultExpectation.params != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Expect")
+	}
+
+	if mmWriteTuples.defaultExpectation.paramPtrs == nil {
+		mmWriteTuples.defaultExpectation.paramPtrs = &RelationServiceMockWriteTuplesParamPtrs{}
+	}
+	mmWriteTuples.defaultExpectation.paramPtrs.ctx = &ctx
+	mmWriteTuples.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmWriteTuples
+}
+
+// ExpectTuplesParam2 sets up expected param tuples for RelationService.WriteTuples
+func (mmWriteTuples *mRelationServiceMockWriteTuples) ExpectTuplesParam2(tuples []*model.RelationTuple) *mRelationServiceMockWriteTuples {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Set")
+	}
+
+	if mmWriteTuples.defaultExpectation == nil {
+		mmWriteTuples.defaultExpectation = &RelationServiceMockWriteTuplesExpectation{}
+	}
+
+	if mmWriteTuples.defaultExpectation.params != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Expect")
+	}
+
+	if mmWriteTuples.defaultExpectation.paramPtrs == nil {
+		mmWriteTuples.defaultExpectation.paramPtrs = &RelationServiceMockWriteTuplesParamPtrs{}
+	}
+	mmWriteTuples.defaultExpectation.paramPtrs.tuples = &tuples
+	mmWriteTuples.defaultExpectation.expectationOrigins.originTuples = minimock.CallerInfo(1)
+
+	return mmWriteTuples
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationService.WriteTuples
+func (mmWriteTuples *mRelationServiceMockWriteTuples) Inspect(f func(ctx context.Context, tuples []*model.RelationTuple)) *mRelationServiceMockWriteTuples {
+	if mmWriteTuples.mock.inspectFuncWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("Inspect function is already set for RelationServiceMock.WriteTuples")
+	}
+
+	mmWriteTuples.mock.inspectFuncWriteTuples = f
+
+	return mmWriteTuples
+}
+
+// Return sets up results that will be returned by RelationService.WriteTuples
+func (mmWriteTuples *mRelationServiceMockWriteTuples) Return(s1 string, err error) *RelationServiceMock {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Set")
+	}
+
+	if mmWriteTuples.defaultExpectation == nil {
+		mmWriteTuples.defaultExpectation = &RelationServiceMockWriteTuplesExpectation{mock: mmWriteTuples.mock}
+	}
+	mmWriteTuples.defaultExpectation.results = &RelationServiceMockWriteTuplesResults{s1, err}
+	mmWriteTuples.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmWriteTuples.mock
+}
+
+// Set uses given function f to mock the RelationService.WriteTuples method
+func (mmWriteTuples *mRelationServiceMockWriteTuples) Set(f func(ctx context.Context, tuples []*model.RelationTuple) (s1 string, err error)) *RelationServiceMock {
+	if mmWriteTuples.defaultExpectation != nil {
+		mmWriteTuples.mock.t.Fatalf("Default expectation is already set for the RelationService.WriteTuples method")
+	}
+
+	if len(mmWriteTuples.expectations) > 0 {
+		mmWriteTuples.mock.t.Fatalf("Some expectations are already set for the RelationService.WriteTuples method")
+	}
+
+	mmWriteTuples.mock.funcWriteTuples = f
+	mmWriteTuples.mock.funcWriteTuplesOrigin = minimock.CallerInfo(1)
+	return mmWriteTuples.mock
+}
+
+// When sets expectation for the RelationService.WriteTuples which will trigger the result defined by the following
+// Then helper
+func (mmWriteTuples *mRelationServiceMockWriteTuples) When(ctx context.Context, tuples []*model.RelationTuple) *RelationServiceMockWriteTuplesExpectation {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Set")
+	}
+
+	expectation := &RelationServiceMockWriteTuplesExpectation{
+		mock:               mmWriteTuples.mock,
+		params:             &RelationServiceMockWriteTuplesParams{ctx, tuples},
+		expectationOrigins: RelationServiceMockWriteTuplesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmWriteTuples.expectations = append(mmWriteTuples.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationService.WriteTuples return parameters for the expectation previously defined by the When method
+func (e *RelationServiceMockWriteTuplesExpectation) Then(s1 string, err error) *RelationServiceMock {
+	e.results = &RelationServiceMockWriteTuplesResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times RelationService.WriteTuples should be invoked
+func (mmWriteTuples *mRelationServiceMockWriteTuples) Times(n uint64) *mRelationServiceMockWriteTuples {
+	if n == 0 {
+		mmWriteTuples.mock.t.Fatalf("Times of RelationServiceMock.WriteTuples mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmWriteTuples.expectedInvocations, n)
+	mmWriteTuples.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmWriteTuples
+}
+
+func (mmWriteTuples *mRelationServiceMockWriteTuples) invocationsDone() bool {
+	if len(mmWriteTuples.expectations) == 0 && mmWriteTuples.defaultExpectation == nil && mmWriteTuples.mock.funcWriteTuples == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmWriteTuples.mock.afterWriteTuplesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWriteTuples.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// WriteTuples implements mm_service.RelationService
+func (mmWriteTuples *RelationServiceMock) WriteTuples(ctx context.Context, tuples []*model.RelationTuple) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmWriteTuples.beforeWriteTuplesCounter, 1)
+	defer mm_atomic.AddUint64(&mmWriteTuples.afterWriteTuplesCounter, 1)
+
+	mmWriteTuples.t.Helper()
+
+	if mmWriteTuples.inspectFuncWriteTuples != nil {
+		mmWriteTuples.inspectFuncWriteTuples(ctx, tuples)
+	}
+
+	mm_params := RelationServiceMockWriteTuplesParams{ctx, tuples}
+
+	// Record call args
+	mmWriteTuples.WriteTuplesMock.mutex.Lock()
+	mmWriteTuples.WriteTuplesMock.callArgs = append(mmWriteTuples.WriteTuplesMock.callArgs, &mm_params)
+	mmWriteTuples.WriteTuplesMock.mutex.Unlock()
+
+	for _, e := range mmWriteTuples.WriteTuplesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmWriteTuples.WriteTuplesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWriteTuples.WriteTuplesMock.defaultExpectation.Counter, 1)
+		mm_want := mmWriteTuples.WriteTuplesMock.defaultExpectation.params
+		mm_want_ptrs := mmWriteTuples.WriteTuplesMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationServiceMockWriteTuplesParams{ctx, tuples}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmWriteTuples.t.Errorf("RelationServiceMock.WriteTuples got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWriteTuples.WriteTuplesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tuples != nil && !minimock.Equal(*mm_want_ptrs.tuples, mm_got.tuples) {
+				mmWriteTuples.t.Errorf("RelationServiceMock.WriteTuples got unexpected parameter tuples, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWriteTuples.WriteTuplesMock.defaultExpectation.expectationOrigins.originTuples, *mm_want_ptrs.tuples, mm_got.tuples, minimock.Diff(*mm_want_ptrs.tuples, mm_got.tuples))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmWriteTuples.t.Errorf("RelationServiceMock.WriteTuples got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmWriteTuples.WriteTuplesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmWriteTuples.WriteTuplesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmWriteTuples.t.Fatal("No results are set for the RelationServiceMock.WriteTuples")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmWriteTuples.funcWriteTuples != nil {
+		return mmWriteTuples.funcWriteTuples(ctx, tuples)
+	}
+	mmWriteTuples.t.Fatalf("Unexpected call to RelationServiceMock.WriteTuples. %v %v", ctx, tuples)
+	return
+}
+
+// WriteTuplesAfterCounter returns a count of finished RelationServiceMock.WriteTuples invocations
+func (mmWriteTuples *RelationServiceMock) WriteTuplesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWriteTuples.afterWriteTuplesCounter)
+}
+
+// WriteTuplesBeforeCounter returns a count of RelationServiceMock.WriteTuples invocations
+func (mmWriteTuples *RelationServiceMock) WriteTuplesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWriteTuples.beforeWriteTuplesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationServiceMock.WriteTuples.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmWriteTuples *mRelationServiceMockWriteTuples) Calls() []*RelationServiceMockWriteTuplesParams {
+	mmWriteTuples.mutex.RLock()
+
+	argCopy := make([]*RelationServiceMockWriteTuplesParams, len(mmWriteTuples.callArgs))
+	copy(argCopy, mmWriteTuples.callArgs)
+
+	mmWriteTuples.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockWriteTuplesDone returns true if the count of the WriteTuples invocations corresponds
+// the number of defined expectations
+func (m *RelationServiceMock) MinimockWriteTuplesDone() bool {
+	if m.WriteTuplesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.WriteTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.WriteTuplesMock.invocationsDone()
+}
+
+// MinimockWriteTuplesInspect logs each unmet expectation
+func (m *RelationServiceMock) MinimockWriteTuplesInspect() {
+	for _, e := range m.WriteTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationServiceMock.WriteTuples at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterWriteTuplesCounter := mm_atomic.LoadUint64(&m.afterWriteTuplesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.WriteTuplesMock.defaultExpectation != nil && afterWriteTuplesCounter < 1 {
+		if m.WriteTuplesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RelationServiceMock.WriteTuples at\n%s", m.WriteTuplesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RelationServiceMock.WriteTuples at\n%s with params: %#v", m.WriteTuplesMock.defaultExpectation.expectationOrigins.origin, *m.WriteTuplesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcWriteTuples != nil && afterWriteTuplesCounter < 1 {
+		m.t.Errorf("Expected call to RelationServiceMock.WriteTuples at\n%s", m.funcWriteTuplesOrigin)
+	}
+
+	if !m.WriteTuplesMock.invocationsDone() && afterWriteTuplesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationServiceMock.WriteTuples at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.WriteTuplesMock.expectedInvocations), m.WriteTuplesMock.expectedInvocationsOrigin, afterWriteTuplesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RelationServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCheckRelationInspect()
+
+			m.MinimockDeleteTuplesInspect()
+
+			m.MinimockListObjectsInspect()
+
+			m.MinimockWriteTuplesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RelationServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RelationServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCheckRelationDone() &&
+		m.MinimockDeleteTuplesDone() &&
+		m.MinimockListObjectsDone() &&
+		m.MinimockWriteTuplesDone()
+}
@@ -0,0 +1,2454 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RoleServiceMock implements mm_service.RoleService
+type RoleServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, role *model.Role) (err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, role *model.Role)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mRoleServiceMockCreate
+
+	funcDelete          func(ctx context.Context, name string) (err error)
+	funcDeleteOrigin    string
+	inspectFuncDelete   func(ctx context.Context, name string)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mRoleServiceMockDelete
+
+	funcEffectiveRoles          func(roles []string) (sa1 []string)
+	funcEffectiveRolesOrigin    string
+	inspectFuncEffectiveRoles   func(roles []string)
+	afterEffectiveRolesCounter  uint64
+	beforeEffectiveRolesCounter uint64
+	EffectiveRolesMock          mRoleServiceMockEffectiveRoles
+
+	funcGet          func(ctx context.Context, name string) (rp1 *model.Role, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, name string)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mRoleServiceMockGet
+
+	funcList          func(ctx context.Context) (rpa1 []*model.Role, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mRoleServiceMockList
+
+	funcUpdate          func(ctx context.Context, update *model.RoleUpdate) (err error)
+	funcUpdateOrigin    string
+	inspectFuncUpdate   func(ctx context.Context, update *model.RoleUpdate)
+	afterUpdateCounter  uint64
+	beforeUpdateCounter uint64
+	UpdateMock          mRoleServiceMockUpdate
+
+	funcValidateRoles          func(roles []string) (err error)
+	funcValidateRolesOrigin    string
+	inspectFuncValidateRoles   func(roles []string)
+	afterValidateRolesCounter  uint64
+	beforeValidateRolesCounter uint64
+	ValidateRolesMock          mRoleServiceMockValidateRoles
+}
+
+// NewRoleServiceMock returns a mock for mm_service.RoleService
+func NewRoleServiceMock(t minimock.Tester) *RoleServiceMock {
+	m := &RoleServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mRoleServiceMockCreate{mock: m}
+	m.CreateMock.callArgs = []*RoleServiceMockCreateParams{}
+
+	m.DeleteMock = mRoleServiceMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*RoleServiceMockDeleteParams{}
+
+	m.EffectiveRolesMock = mRoleServiceMockEffectiveRoles{mock: m}
+	m.EffectiveRolesMock.callArgs = []*RoleServiceMockEffectiveRolesParams{}
+
+	m.GetMock = mRoleServiceMockGet{mock: m}
+	m.GetMock.callArgs = []*RoleServiceMockGetParams{}
+
+	m.ListMock = mRoleServiceMockList{mock: m}
+	m.ListMock.callArgs = []*RoleServiceMockListParams{}
+
+	m.UpdateMock = mRoleServiceMockUpdate{mock: m}
+	m.UpdateMock.callArgs = []*RoleServiceMockUpdateParams{}
+
+	m.ValidateRolesMock = mRoleServiceMockValidateRoles{mock: m}
+	m.ValidateRolesMock.callArgs = []*RoleServiceMockValidateRolesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRoleServiceMockCreate struct {
+	optional           bool
+	mock               *RoleServiceMock
+	defaultExpectation *RoleServiceMockCreateExpectation
+	expectations       []*RoleServiceMockCreateExpectation
+
+	callArgs []*RoleServiceMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleServiceMockCreateExpectation specifies expectation struct of the RoleService.Create
+type RoleServiceMockCreateExpectation struct {
+	mock               *RoleServiceMock
+	params             *RoleServiceMockCreateParams
+	paramPtrs          *RoleServiceMockCreateParamPtrs
+	expectationOrigins RoleServiceMockCreateExpectationOrigins
+	results            *RoleServiceMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleServiceMockCreateParams contains parameters of the RoleService.Create
+type RoleServiceMockCreateParams struct {
+	ctx  context.Context
+	role *model.Role
+}
+
+// RoleServiceMockCreateParamPtrs contains pointers to parameters of the RoleService.Create
+type RoleServiceMockCreateParamPtrs struct {
+	ctx  *context.Context
+	role **model.Role
+}
+
+// RoleServiceMockCreateResults contains results of the RoleService.Create
+type RoleServiceMockCreateResults struct {
+	err error
+}
+
+// RoleServiceMockCreateOrigins contains origins of expectations of the RoleService.Create
+type RoleServiceMockCreateExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originRole string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mRoleServiceMockCreate) Optional() *mRoleServiceMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for RoleService.Create
+func (mmCreate *mRoleServiceMockCreate) Expect(ctx context.Context, role *model.Role) *mRoleServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RoleServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RoleServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("RoleServiceMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &RoleServiceMockCreateParams{ctx, role}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleService.Create
+func (mmCreate *mRoleServiceMockCreate) ExpectCtxParam1(ctx context.Context) *mRoleServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RoleServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RoleServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("RoleServiceMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &RoleServiceMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectRoleParam2 sets up expected param role for RoleService.Create
+func (mmCreate *mRoleServiceMockCreate) ExpectRoleParam2(role *model.Role) *mRoleServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RoleServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RoleServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("RoleServiceMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &RoleServiceMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.role = &role
+	mmCreate.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleService.Create
+func (mmCreate *mRoleServiceMockCreate) Inspect(f func(ctx context.Context, role *model.Role)) *mRoleServiceMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for RoleServiceMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by RoleService.Create
+func (mmCreate *mRoleServiceMockCreate) Return(err error) *RoleServiceMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RoleServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RoleServiceMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &RoleServiceMockCreateResults{err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the RoleService.Create method
+func (mmCreate *mRoleServiceMockCreate) Set(f func(ctx context.Context, role *model.Role) (err error)) *RoleServiceMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the RoleService.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the RoleService.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the RoleService.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mRoleServiceMockCreate) When(ctx context.Context, role *model.Role) *RoleServiceMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RoleServiceMock.Create mock is already set by Set")
+	}
+
+	expectation := &RoleServiceMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &RoleServiceMockCreateParams{ctx, role},
+		expectationOrigins: RoleServiceMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleService.Create return parameters for the expectation previously defined by the When method
+func (e *RoleServiceMockCreateExpectation) Then(err error) *RoleServiceMock {
+	e.results = &RoleServiceMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleService.Create should be invoked
+func (mmCreate *mRoleServiceMockCreate) Times(n uint64) *mRoleServiceMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of RoleServiceMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mRoleServiceMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_service.RoleService
+func (mmCreate *RoleServiceMock) Create(ctx context.Context, role *model.Role) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, role)
+	}
+
+	mm_params := RoleServiceMockCreateParams{ctx, role}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleServiceMockCreateParams{ctx, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("RoleServiceMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmCreate.t.Errorf("RoleServiceMock.Create got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("RoleServiceMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the RoleServiceMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, role)
+	}
+	mmCreate.t.Fatalf("Unexpected call to RoleServiceMock.Create. %v %v", ctx, role)
+	return
+}
+
+// CreateAfterCounter returns a count of finished RoleServiceMock.Create invocations
+func (mmCreate *RoleServiceMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of RoleServiceMock.Create invocations
+func (mmCreate *RoleServiceMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleServiceMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mRoleServiceMockCreate) Calls() []*RoleServiceMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*RoleServiceMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *RoleServiceMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *RoleServiceMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleServiceMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleServiceMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleServiceMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to RoleServiceMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleServiceMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mRoleServiceMockDelete struct {
+	optional           bool
+	mock               *RoleServiceMock
+	defaultExpectation *RoleServiceMockDeleteExpectation
+	expectations       []*RoleServiceMockDeleteExpectation
+
+	callArgs []*RoleServiceMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleServiceMockDeleteExpectation specifies expectation struct of the RoleService.Delete
+type RoleServiceMockDeleteExpectation struct {
+	mock               *RoleServiceMock
+	params             *RoleServiceMockDeleteParams
+	paramPtrs          *RoleServiceMockDeleteParamPtrs
+	expectationOrigins RoleServiceMockDeleteExpectationOrigins
+	results            *RoleServiceMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleServiceMockDeleteParams contains parameters of the RoleService.Delete
+type RoleServiceMockDeleteParams struct {
+	ctx  context.Context
+	name string
+}
+
+// RoleServiceMockDeleteParamPtrs contains pointers to parameters of the RoleService.Delete
+type RoleServiceMockDeleteParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// RoleServiceMockDeleteResults contains results of the RoleService.Delete
+type RoleServiceMockDeleteResults struct {
+	err error
+}
+
+// RoleServiceMockDeleteOrigins contains origins of expectations of the RoleService.Delete
+type RoleServiceMockDeleteExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mRoleServiceMockDelete) Optional() *mRoleServiceMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for RoleService.Delete
+func (mmDelete *mRoleServiceMockDelete) Expect(ctx context.Context, name string) *mRoleServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RoleServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RoleServiceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("RoleServiceMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &RoleServiceMockDeleteParams{ctx, name}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleService.Delete
+func (mmDelete *mRoleServiceMockDelete) ExpectCtxParam1(ctx context.Context) *mRoleServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RoleServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RoleServiceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("RoleServiceMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &RoleServiceMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectNameParam2 sets up expected param name for RoleService.Delete
+func (mmDelete *mRoleServiceMockDelete) ExpectNameParam2(name string) *mRoleServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RoleServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RoleServiceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("RoleServiceMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &RoleServiceMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.name = &name
+	mmDelete.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleService.Delete
+func (mmDelete *mRoleServiceMockDelete) Inspect(f func(ctx context.Context, name string)) *mRoleServiceMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for RoleServiceMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by RoleService.Delete
+func (mmDelete *mRoleServiceMockDelete) Return(err error) *RoleServiceMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RoleServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RoleServiceMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &RoleServiceMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the RoleService.Delete method
+func (mmDelete *mRoleServiceMockDelete) Set(f func(ctx context.Context, name string) (err error)) *RoleServiceMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the RoleService.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the RoleService.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the RoleService.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mRoleServiceMockDelete) When(ctx context.Context, name string) *RoleServiceMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RoleServiceMock.Delete mock is already set by Set")
+	}
+
+	expectation := &RoleServiceMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &RoleServiceMockDeleteParams{ctx, name},
+		expectationOrigins: RoleServiceMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleService.Delete return parameters for the expectation previously defined by the When method
+func (e *RoleServiceMockDeleteExpectation) Then(err error) *RoleServiceMock {
+	e.results = &RoleServiceMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleService.Delete should be invoked
+func (mmDelete *mRoleServiceMockDelete) Times(n uint64) *mRoleServiceMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of RoleServiceMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mRoleServiceMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_service.RoleService
+func (mmDelete *RoleServiceMock) Delete(ctx context.Context, name string) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, name)
+	}
+
+	mm_params := RoleServiceMockDeleteParams{ctx, name}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleServiceMockDeleteParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("RoleServiceMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmDelete.t.Errorf("RoleServiceMock.Delete got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("RoleServiceMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the RoleServiceMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, name)
+	}
+	mmDelete.t.Fatalf("Unexpected call to RoleServiceMock.Delete. %v %v", ctx, name)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished RoleServiceMock.Delete invocations
+func (mmDelete *RoleServiceMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of RoleServiceMock.Delete invocations
+func (mmDelete *RoleServiceMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleServiceMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mRoleServiceMockDelete) Calls() []*RoleServiceMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*RoleServiceMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *RoleServiceMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *RoleServiceMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleServiceMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleServiceMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleServiceMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to RoleServiceMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleServiceMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mRoleServiceMockEffectiveRoles struct {
+	optional           bool
+	mock               *RoleServiceMock
+	defaultExpectation *RoleServiceMockEffectiveRolesExpectation
+	expectations       []*RoleServiceMockEffectiveRolesExpectation
+
+	callArgs []*RoleServiceMockEffectiveRolesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleServiceMockEffectiveRolesExpectation specifies expectation struct of the RoleService.EffectiveRoles
+type RoleServiceMockEffectiveRolesExpectation struct {
+	mock               *RoleServiceMock
+	params             *RoleServiceMockEffectiveRolesParams
+	paramPtrs          *RoleServiceMockEffectiveRolesParamPtrs
+	expectationOrigins RoleServiceMockEffectiveRolesExpectationOrigins
+	results            *RoleServiceMockEffectiveRolesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleServiceMockEffectiveRolesParams contains parameters of the RoleService.EffectiveRoles
+type RoleServiceMockEffectiveRolesParams struct {
+	roles []string
+}
+
+// RoleServiceMockEffectiveRolesParamPtrs contains pointers to parameters of the RoleService.EffectiveRoles
+type RoleServiceMockEffectiveRolesParamPtrs struct {
+	roles *[]string
+}
+
+// RoleServiceMockEffectiveRolesResults contains results of the RoleService.EffectiveRoles
+type RoleServiceMockEffectiveRolesResults struct {
+	sa1 []string
+}
+
+// RoleServiceMockEffectiveRolesOrigins contains origins of expectations of the RoleService.EffectiveRoles
+type RoleServiceMockEffectiveRolesExpectationOrigins struct {
+	origin      string
+	originRoles string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEffectiveRoles *mRoleServiceMockEffectiveRoles) Optional() *mRoleServiceMockEffectiveRoles {
+	mmEffectiveRoles.optional = true
+	return mmEffectiveRoles
+}
+
+// Expect sets up expected params for RoleService.EffectiveRoles
+func (mmEffectiveRoles *mRoleServiceMockEffectiveRoles) Expect(roles []string) *mRoleServiceMockEffectiveRoles {
+	if mmEffectiveRoles.mock.funcEffectiveRoles != nil {
+		mmEffectiveRoles.mock.t.Fatalf("RoleServiceMock.EffectiveRoles mock is already set by Set")
+	}
+
+	if mmEffectiveRoles.defaultExpectation == nil {
+		mmEffectiveRoles.defaultExpectation = &RoleServiceMockEffectiveRolesExpectation{}
+	}
+
+	if mmEffectiveRoles.defaultExpectation.paramPtrs != nil {
+		mmEffectiveRoles.mock.t.Fatalf("RoleServiceMock.EffectiveRoles mock is already set by ExpectParams functions")
+	}
+
+	mmEffectiveRoles.defaultExpectation.params = &RoleServiceMockEffectiveRolesParams{roles}
+	mmEffectiveRoles.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEffectiveRoles.expectations {
+		if minimock.Equal(e.params, mmEffectiveRoles.defaultExpectation.params) {
+			mmEffectiveRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEffectiveRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmEffectiveRoles
+}
+
+// ExpectRolesParam1 sets up expected param roles for RoleService.EffectiveRoles
+func (mmEffectiveRoles *mRoleServiceMockEffectiveRoles) ExpectRolesParam1(roles []string) *mRoleServiceMockEffectiveRoles {
+	if mmEffectiveRoles.mock.funcEffectiveRoles != nil {
+		mmEffectiveRoles.mock.t.Fatalf("RoleServiceMock.EffectiveRoles mock is already set by Set")
+	}
+
+	if mmEffectiveRoles.defaultExpectation == nil {
+		mmEffectiveRoles.defaultExpectation = &RoleServiceMockEffectiveRolesExpectation{}
+	}
+
+	if mmEffectiveRoles.defaultExpectation.params != nil {
+		mmEffectiveRoles.mock.t.Fatalf("RoleServiceMock.EffectiveRoles mock is already set by Expect")
+	}
+
+	if mmEffectiveRoles.defaultExpectation.paramPtrs == nil {
+		mmEffectiveRoles.defaultExpectation.paramPtrs = &RoleServiceMockEffectiveRolesParamPtrs{}
+	}
+	mmEffectiveRoles.defaultExpectation.paramPtrs.roles = &roles
+	mmEffectiveRoles.defaultExpectation.expectationOrigins.originRoles = minimock.CallerInfo(1)
+
+	return mmEffectiveRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleService.EffectiveRoles
+func (mmEffectiveRoles *mRoleServiceMockEffectiveRoles) Inspect(f func(roles []string)) *mRoleServiceMockEffectiveRoles {
+	if mmEffectiveRoles.mock.inspectFuncEffectiveRoles != nil {
+		mmEffectiveRoles.mock.t.Fatalf("Inspect function is already set for RoleServiceMock.EffectiveRoles")
+	}
+
+	mmEffectiveRoles.mock.inspectFuncEffectiveRoles = f
+
+	return mmEffectiveRoles
+}
+
+// Return sets up results that will be returned by RoleService.EffectiveRoles
+func (mmEffectiveRoles *mRoleServiceMockEffectiveRoles) Return(sa1 []string) *RoleServiceMock {
+	if mmEffectiveRoles.mock.funcEffectiveRoles != nil {
+		mmEffectiveRoles.mock.t.Fatalf("RoleServiceMock.EffectiveRoles mock is already set by Set")
+	}
+
+	if mmEffectiveRoles.defaultExpectation == nil {
+		mmEffectiveRoles.defaultExpectation = &RoleServiceMockEffectiveRolesExpectation{mock: mmEffectiveRoles.mock}
+	}
+	mmEffectiveRoles.defaultExpectation.results = &RoleServiceMockEffectiveRolesResults{sa1}
+	mmEffectiveRoles.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEffectiveRoles.mock
+}
+
+// Set uses given function f to mock the RoleService.EffectiveRoles method
+func (mmEffectiveRoles *mRoleServiceMockEffectiveRoles) Set(f func(roles []string) (sa1 []string)) *RoleServiceMock {
+	if mmEffectiveRoles.defaultExpectation != nil {
+		mmEffectiveRoles.mock.t.Fatalf("Default expectation is already set for the RoleService.EffectiveRoles method")
+	}
+
+	if len(mmEffectiveRoles.expectations) > 0 {
+		mmEffectiveRoles.mock.t.Fatalf("Some expectations are already set for the RoleService.EffectiveRoles method")
+	}
+
+	mmEffectiveRoles.mock.funcEffectiveRoles = f
+	mmEffectiveRoles.mock.funcEffectiveRolesOrigin = minimock.CallerInfo(1)
+	return mmEffectiveRoles.mock
+}
+
+// When sets expectation for the RoleService.EffectiveRoles which will trigger the result defined by the following
+// Then helper
+func (mmEffectiveRoles *mRoleServiceMockEffectiveRoles) When(roles []string) *RoleServiceMockEffectiveRolesExpectation {
+	if mmEffectiveRoles.mock.funcEffectiveRoles != nil {
+		mmEffectiveRoles.mock.t.Fatalf("RoleServiceMock.EffectiveRoles mock is already set by Set")
+	}
+
+	expectation := &RoleServiceMockEffectiveRolesExpectation{
+		mock:               mmEffectiveRoles.mock,
+		params:             &RoleServiceMockEffectiveRolesParams{roles},
+		expectationOrigins: RoleServiceMockEffectiveRolesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEffectiveRoles.expectations = append(mmEffectiveRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleService.EffectiveRoles return parameters for the expectation previously defined by the When method
+func (e *RoleServiceMockEffectiveRolesExpectation) Then(sa1 []string) *RoleServiceMock {
+	e.results = &RoleServiceMockEffectiveRolesResults{sa1}
+	return e.mock
+}
+
+// Times sets number of times RoleService.EffectiveRoles should be invoked
+func (mmEffectiveRoles *mRoleServiceMockEffectiveRoles) Times(n uint64) *mRoleServiceMockEffectiveRoles {
+	if n == 0 {
+		mmEffectiveRoles.mock.t.Fatalf("Times of RoleServiceMock.EffectiveRoles mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEffectiveRoles.expectedInvocations, n)
+	mmEffectiveRoles.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEffectiveRoles
+}
+
+func (mmEffectiveRoles *mRoleServiceMockEffectiveRoles) invocationsDone() bool {
+	if len(mmEffectiveRoles.expectations) == 0 && mmEffectiveRoles.defaultExpectation == nil && mmEffectiveRoles.mock.funcEffectiveRoles == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEffectiveRoles.mock.afterEffectiveRolesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEffectiveRoles.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// EffectiveRoles implements mm_service.RoleService
+func (mmEffectiveRoles *RoleServiceMock) EffectiveRoles(roles []string) (sa1 []string) {
+	mm_atomic.AddUint64(&mmEffectiveRoles.beforeEffectiveRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmEffectiveRoles.afterEffectiveRolesCounter, 1)
+
+	mmEffectiveRoles.t.Helper()
+
+	if mmEffectiveRoles.inspectFuncEffectiveRoles != nil {
+		mmEffectiveRoles.inspectFuncEffectiveRoles(roles)
+	}
+
+	mm_params := RoleServiceMockEffectiveRolesParams{roles}
+
+	// Record call args
+	mmEffectiveRoles.EffectiveRolesMock.mutex.Lock()
+	mmEffectiveRoles.EffectiveRolesMock.callArgs = append(mmEffectiveRoles.EffectiveRolesMock.callArgs, &mm_params)
+	mmEffectiveRoles.EffectiveRolesMock.mutex.Unlock()
+
+	for _, e := range mmEffectiveRoles.EffectiveRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1
+		}
+	}
+
+	if mmEffectiveRoles.EffectiveRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEffectiveRoles.EffectiveRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmEffectiveRoles.EffectiveRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmEffectiveRoles.EffectiveRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleServiceMockEffectiveRolesParams{roles}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.roles != nil && !minimock.Equal(*mm_want_ptrs.roles, mm_got.roles) {
+				mmEffectiveRoles.t.Errorf("RoleServiceMock.EffectiveRoles got unexpected parameter roles, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEffectiveRoles.EffectiveRolesMock.defaultExpectation.expectationOrigins.originRoles, *mm_want_ptrs.roles, mm_got.roles, minimock.Diff(*mm_want_ptrs.roles, mm_got.roles))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEffectiveRoles.t.Errorf("RoleServiceMock.EffectiveRoles got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEffectiveRoles.EffectiveRolesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEffectiveRoles.EffectiveRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEffectiveRoles.t.Fatal("No results are set for the RoleServiceMock.EffectiveRoles")
+		}
+		return (*mm_results).sa1
+	}
+	if mmEffectiveRoles.funcEffectiveRoles != nil {
+		return mmEffectiveRoles.funcEffectiveRoles(roles)
+	}
+	mmEffectiveRoles.t.Fatalf("Unexpected call to RoleServiceMock.EffectiveRoles. %v", roles)
+	return
+}
+
+// EffectiveRolesAfterCounter returns a count of finished RoleServiceMock.EffectiveRoles invocations
+func (mmEffectiveRoles *RoleServiceMock) EffectiveRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEffectiveRoles.afterEffectiveRolesCounter)
+}
+
+// EffectiveRolesBeforeCounter returns a count of RoleServiceMock.EffectiveRoles invocations
+func (mmEffectiveRoles *RoleServiceMock) EffectiveRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEffectiveRoles.beforeEffectiveRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleServiceMock.EffectiveRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEffectiveRoles *mRoleServiceMockEffectiveRoles) Calls() []*RoleServiceMockEffectiveRolesParams {
+	mmEffectiveRoles.mutex.RLock()
+
+	argCopy := make([]*RoleServiceMockEffectiveRolesParams, len(mmEffectiveRoles.callArgs))
+	copy(argCopy, mmEffectiveRoles.callArgs)
+
+	mmEffectiveRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEffectiveRolesDone returns true if the count of the EffectiveRoles invocations corresponds
+// the number of defined expectations
+func (m *RoleServiceMock) MinimockEffectiveRolesDone() bool {
+	if m.EffectiveRolesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EffectiveRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EffectiveRolesMock.invocationsDone()
+}
+
+// MinimockEffectiveRolesInspect logs each unmet expectation
+func (m *RoleServiceMock) MinimockEffectiveRolesInspect() {
+	for _, e := range m.EffectiveRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleServiceMock.EffectiveRoles at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEffectiveRolesCounter := mm_atomic.LoadUint64(&m.afterEffectiveRolesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EffectiveRolesMock.defaultExpectation != nil && afterEffectiveRolesCounter < 1 {
+		if m.EffectiveRolesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleServiceMock.EffectiveRoles at\n%s", m.EffectiveRolesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleServiceMock.EffectiveRoles at\n%s with params: %#v", m.EffectiveRolesMock.defaultExpectation.expectationOrigins.origin, *m.EffectiveRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEffectiveRoles != nil && afterEffectiveRolesCounter < 1 {
+		m.t.Errorf("Expected call to RoleServiceMock.EffectiveRoles at\n%s", m.funcEffectiveRolesOrigin)
+	}
+
+	if !m.EffectiveRolesMock.invocationsDone() && afterEffectiveRolesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleServiceMock.EffectiveRoles at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EffectiveRolesMock.expectedInvocations), m.EffectiveRolesMock.expectedInvocationsOrigin, afterEffectiveRolesCounter)
+	}
+}
+
+type mRoleServiceMockGet struct {
+	optional           bool
+	mock               *RoleServiceMock
+	defaultExpectation *RoleServiceMockGetExpectation
+	expectations       []*RoleServiceMockGetExpectation
+
+	callArgs []*RoleServiceMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleServiceMockGetExpectation specifies expectation struct of the RoleService.Get
+type RoleServiceMockGetExpectation struct {
+	mock               *RoleServiceMock
+	params             *RoleServiceMockGetParams
+	paramPtrs          *RoleServiceMockGetParamPtrs
+	expectationOrigins RoleServiceMockGetExpectationOrigins
+	results            *RoleServiceMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleServiceMockGetParams contains parameters of the RoleService.Get
+type RoleServiceMockGetParams struct {
+	ctx  context.Context
+	name string
+}
+
+// RoleServiceMockGetParamPtrs contains pointers to parameters of the RoleService.Get
+type RoleServiceMockGetParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// RoleServiceMockGetResults contains results of the RoleService.Get
+type RoleServiceMockGetResults struct {
+	rp1 *model.Role
+	err error
+}
+
+// RoleServiceMockGetOrigins contains origins of expectations of the RoleService.Get
+type RoleServiceMockGetExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mRoleServiceMockGet) Optional() *mRoleServiceMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for RoleService.Get
+func (mmGet *mRoleServiceMockGet) Expect(ctx context.Context, name string) *mRoleServiceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RoleServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RoleServiceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("RoleServiceMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &RoleServiceMockGetParams{ctx, name}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleService.Get
+func (mmGet *mRoleServiceMockGet) ExpectCtxParam1(ctx context.Context) *mRoleServiceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RoleServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RoleServiceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("RoleServiceMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &RoleServiceMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectNameParam2 sets up expected param name for RoleService.Get
+func (mmGet *mRoleServiceMockGet) ExpectNameParam2(name string) *mRoleServiceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RoleServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RoleServiceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("RoleServiceMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &RoleServiceMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.name = &name
+	mmGet.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleService.Get
+func (mmGet *mRoleServiceMockGet) Inspect(f func(ctx context.Context, name string)) *mRoleServiceMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for RoleServiceMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by RoleService.Get
+func (mmGet *mRoleServiceMockGet) Return(rp1 *model.Role, err error) *RoleServiceMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RoleServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RoleServiceMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &RoleServiceMockGetResults{rp1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the RoleService.Get method
+func (mmGet *mRoleServiceMockGet) Set(f func(ctx context.Context, name string) (rp1 *model.Role, err error)) *RoleServiceMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the RoleService.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the RoleService.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the RoleService.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mRoleServiceMockGet) When(ctx context.Context, name string) *RoleServiceMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RoleServiceMock.Get mock is already set by Set")
+	}
+
+	expectation := &RoleServiceMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &RoleServiceMockGetParams{ctx, name},
+		expectationOrigins: RoleServiceMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleService.Get return parameters for the expectation previously defined by the When method
+func (e *RoleServiceMockGetExpectation) Then(rp1 *model.Role, err error) *RoleServiceMock {
+	e.results = &RoleServiceMockGetResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times RoleService.Get should be invoked
+func (mmGet *mRoleServiceMockGet) Times(n uint64) *mRoleServiceMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of RoleServiceMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mRoleServiceMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_service.RoleService
+func (mmGet *RoleServiceMock) Get(ctx context.Context, name string) (rp1 *model.Role, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, name)
+	}
+
+	mm_params := RoleServiceMockGetParams{ctx, name}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleServiceMockGetParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("RoleServiceMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmGet.t.Errorf("RoleServiceMock.Get got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("RoleServiceMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the RoleServiceMock.Get")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, name)
+	}
+	mmGet.t.Fatalf("Unexpected call to RoleServiceMock.Get. %v %v", ctx, name)
+	return
+}
+
+// GetAfterCounter returns a count of finished RoleServiceMock.Get invocations
+func (mmGet *RoleServiceMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of RoleServiceMock.Get invocations
+func (mmGet *RoleServiceMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleServiceMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mRoleServiceMockGet) Calls() []*RoleServiceMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*RoleServiceMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *RoleServiceMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *RoleServiceMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleServiceMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleServiceMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleServiceMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to RoleServiceMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleServiceMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mRoleServiceMockList struct {
+	optional           bool
+	mock               *RoleServiceMock
+	defaultExpectation *RoleServiceMockListExpectation
+	expectations       []*RoleServiceMockListExpectation
+
+	callArgs []*RoleServiceMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleServiceMockListExpectation specifies expectation struct of the RoleService.List
+type RoleServiceMockListExpectation struct {
+	mock               *RoleServiceMock
+	params             *RoleServiceMockListParams
+	paramPtrs          *RoleServiceMockListParamPtrs
+	expectationOrigins RoleServiceMockListExpectationOrigins
+	results            *RoleServiceMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleServiceMockListParams contains parameters of the RoleService.List
+type RoleServiceMockListParams struct {
+	ctx context.Context
+}
+
+// RoleServiceMockListParamPtrs contains pointers to parameters of the RoleService.List
+type RoleServiceMockListParamPtrs struct {
+	ctx *context.Context
+}
+
+// RoleServiceMockListResults contains results of the RoleService.List
+type RoleServiceMockListResults struct {
+	rpa1 []*model.Role
+	err  error
+}
+
+// RoleServiceMockListOrigins contains origins of expectations of the RoleService.List
+type RoleServiceMockListExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mRoleServiceMockList) Optional() *mRoleServiceMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for RoleService.List
+func (mmList *mRoleServiceMockList) Expect(ctx context.Context) *mRoleServiceMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RoleServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RoleServiceMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("RoleServiceMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &RoleServiceMockListParams{ctx}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleService.List
+func (mmList *mRoleServiceMockList) ExpectCtxParam1(ctx context.Context) *mRoleServiceMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RoleServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RoleServiceMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("RoleServiceMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &RoleServiceMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleService.List
+func (mmList *mRoleServiceMockList) Inspect(f func(ctx context.Context)) *mRoleServiceMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for RoleServiceMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by RoleService.List
+func (mmList *mRoleServiceMockList) Return(rpa1 []*model.Role, err error) *RoleServiceMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RoleServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RoleServiceMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &RoleServiceMockListResults{rpa1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the RoleService.List method
+func (mmList *mRoleServiceMockList) Set(f func(ctx context.Context) (rpa1 []*model.Role, err error)) *RoleServiceMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the RoleService.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the RoleService.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the RoleService.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mRoleServiceMockList) When(ctx context.Context) *RoleServiceMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RoleServiceMock.List mock is already set by Set")
+	}
+
+	expectation := &RoleServiceMockListExpectation{
+		mock:               mmList.mock,
+		params:             &RoleServiceMockListParams{ctx},
+		expectationOrigins: RoleServiceMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleService.List return parameters for the expectation previously defined by the When method
+func (e *RoleServiceMockListExpectation) Then(rpa1 []*model.Role, err error) *RoleServiceMock {
+	e.results = &RoleServiceMockListResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times RoleService.List should be invoked
+func (mmList *mRoleServiceMockList) Times(n uint64) *mRoleServiceMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of RoleServiceMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mRoleServiceMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_service.RoleService
+func (mmList *RoleServiceMock) List(ctx context.Context) (rpa1 []*model.Role, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx)
+	}
+
+	mm_params := RoleServiceMockListParams{ctx}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleServiceMockListParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("RoleServiceMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("RoleServiceMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the RoleServiceMock.List")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx)
+	}
+	mmList.t.Fatalf("Unexpected call to RoleServiceMock.List. %v", ctx)
+	return
+}
+
+// ListAfterCounter returns a count of finished RoleServiceMock.List invocations
+func (mmList *RoleServiceMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of RoleServiceMock.List invocations
+func (mmList *RoleServiceMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleServiceMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mRoleServiceMockList) Calls() []*RoleServiceMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*RoleServiceMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *RoleServiceMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *RoleServiceMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleServiceMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleServiceMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleServiceMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to RoleServiceMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleServiceMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+type mRoleServiceMockUpdate struct {
+	optional           bool
+	mock               *RoleServiceMock
+	defaultExpectation *RoleServiceMockUpdateExpectation
+	expectations       []*RoleServiceMockUpdateExpectation
+
+	callArgs []*RoleServiceMockUpdateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleServiceMockUpdateExpectation specifies expectation struct of the RoleService.Update
+type RoleServiceMockUpdateExpectation struct {
+	mock               *RoleServiceMock
+	params             *RoleServiceMockUpdateParams
+	paramPtrs          *RoleServiceMockUpdateParamPtrs
+	expectationOrigins RoleServiceMockUpdateExpectationOrigins
+	results            *RoleServiceMockUpdateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleServiceMockUpdateParams contains parameters of the RoleService.Update
+type RoleServiceMockUpdateParams struct {
+	ctx    context.Context
+	update *model.RoleUpdate
+}
+
+// RoleServiceMockUpdateParamPtrs contains pointers to parameters of the RoleService.Update
+type RoleServiceMockUpdateParamPtrs struct {
+	ctx    *context.Context
+	update **model.RoleUpdate
+}
+
+// RoleServiceMockUpdateResults contains results of the RoleService.Update
+type RoleServiceMockUpdateResults struct {
+	err error
+}
+
+// RoleServiceMockUpdateOrigins contains origins of expectations of the RoleService.Update
+type RoleServiceMockUpdateExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUpdate string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdate *mRoleServiceMockUpdate) Optional() *mRoleServiceMockUpdate {
+	mmUpdate.optional = true
+	return mmUpdate
+}
+
+// Expect sets up expected params for RoleService.Update
+func (mmUpdate *mRoleServiceMockUpdate) Expect(ctx context.Context, update *model.RoleUpdate) *mRoleServiceMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RoleServiceMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RoleServiceMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs != nil {
+		mmUpdate.mock.t.Fatalf("RoleServiceMock.Update mock is already set by ExpectParams functions")
+	}
+
+	mmUpdate.defaultExpectation.params = &RoleServiceMockUpdateParams{ctx, update}
+	mmUpdate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleService.Update
+func (mmUpdate *mRoleServiceMockUpdate) ExpectCtxParam1(ctx context.Context) *mRoleServiceMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RoleServiceMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RoleServiceMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("RoleServiceMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &RoleServiceMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// ExpectUpdateParam2 sets up expected param update for RoleService.Update
+func (mmUpdate *mRoleServiceMockUpdate) ExpectUpdateParam2(update *model.RoleUpdate) *mRoleServiceMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RoleServiceMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RoleServiceMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("RoleServiceMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &RoleServiceMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.update = &update
+	mmUpdate.defaultExpectation.expectationOrigins.originUpdate = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleService.Update
+func (mmUpdate *mRoleServiceMockUpdate) Inspect(f func(ctx context.Context, update *model.RoleUpdate)) *mRoleServiceMockUpdate {
+	if mmUpdate.mock.inspectFuncUpdate != nil {
+		mmUpdate.mock.t.Fatalf("Inspect function is already set for RoleServiceMock.Update")
+	}
+
+	mmUpdate.mock.inspectFuncUpdate = f
+
+	return mmUpdate
+}
+
+// Return sets up results that will be returned by RoleService.Update
+func (mmUpdate *mRoleServiceMockUpdate) Return(err error) *RoleServiceMock {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RoleServiceMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RoleServiceMockUpdateExpectation{mock: mmUpdate.mock}
+	}
+	mmUpdate.defaultExpectation.results = &RoleServiceMockUpdateResults{err}
+	mmUpdate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// Set uses given function f to mock the RoleService.Update method
+func (mmUpdate *mRoleServiceMockUpdate) Set(f func(ctx context.Context, update *model.RoleUpdate) (err error)) *RoleServiceMock {
+	if mmUpdate.defaultExpectation != nil {
+		mmUpdate.mock.t.Fatalf("Default expectation is already set for the RoleService.Update method")
+	}
+
+	if len(mmUpdate.expectations) > 0 {
+		mmUpdate.mock.t.Fatalf("Some expectations are already set for the RoleService.Update method")
+	}
+
+	mmUpdate.mock.funcUpdate = f
+	mmUpdate.mock.funcUpdateOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// When sets expectation for the RoleService.Update which will trigger the result defined by the following
+// Then helper
+func (mmUpdate *mRoleServiceMockUpdate) When(ctx context.Context, update *model.RoleUpdate) *RoleServiceMockUpdateExpectation {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RoleServiceMock.Update mock is already set by Set")
+	}
+
+	expectation := &RoleServiceMockUpdateExpectation{
+		mock:               mmUpdate.mock,
+		params:             &RoleServiceMockUpdateParams{ctx, update},
+		expectationOrigins: RoleServiceMockUpdateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleService.Update return parameters for the expectation previously defined by the When method
+func (e *RoleServiceMockUpdateExpectation) Then(err error) *RoleServiceMock {
+	e.results = &RoleServiceMockUpdateResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleService.Update should be invoked
+func (mmUpdate *mRoleServiceMockUpdate) Times(n uint64) *mRoleServiceMockUpdate {
+	if n == 0 {
+		mmUpdate.mock.t.Fatalf("Times of RoleServiceMock.Update mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdate.expectedInvocations, n)
+	mmUpdate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdate
+}
+
+func (mmUpdate *mRoleServiceMockUpdate) invocationsDone() bool {
+	if len(mmUpdate.expectations) == 0 && mmUpdate.defaultExpectation == nil && mmUpdate.mock.funcUpdate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdate.mock.afterUpdateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Update implements mm_service.RoleService
+func (mmUpdate *RoleServiceMock) Update(ctx context.Context, update *model.RoleUpdate) (err error) {
+	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+
+	mmUpdate.t.Helper()
+
+	if mmUpdate.inspectFuncUpdate != nil {
+		mmUpdate.inspectFuncUpdate(ctx, update)
+	}
+
+	mm_params := RoleServiceMockUpdateParams{ctx, update}
+
+	// Record call args
+	mmUpdate.UpdateMock.mutex.Lock()
+	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
+	mmUpdate.UpdateMock.mutex.Unlock()
+
+	for _, e := range mmUpdate.UpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdate.UpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleServiceMockUpdateParams{ctx, update}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdate.t.Errorf("RoleServiceMock.Update got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.update != nil && !minimock.Equal(*mm_want_ptrs.update, mm_got.update) {
+				mmUpdate.t.Errorf("RoleServiceMock.Update got unexpected parameter update, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originUpdate, *mm_want_ptrs.update, mm_got.update, minimock.Diff(*mm_want_ptrs.update, mm_got.update))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdate.t.Errorf("RoleServiceMock.Update got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdate.t.Fatal("No results are set for the RoleServiceMock.Update")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdate.funcUpdate != nil {
+		return mmUpdate.funcUpdate(ctx, update)
+	}
+	mmUpdate.t.Fatalf("Unexpected call to RoleServiceMock.Update. %v %v", ctx, update)
+	return
+}
+
+// UpdateAfterCounter returns a count of finished RoleServiceMock.Update invocations
+func (mmUpdate *RoleServiceMock) UpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+}
+
+// UpdateBeforeCounter returns a count of RoleServiceMock.Update invocations
+func (mmUpdate *RoleServiceMock) UpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleServiceMock.Update.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdate *mRoleServiceMockUpdate) Calls() []*RoleServiceMockUpdateParams {
+	mmUpdate.mutex.RLock()
+
+	argCopy := make([]*RoleServiceMockUpdateParams, len(mmUpdate.callArgs))
+	copy(argCopy, mmUpdate.callArgs)
+
+	mmUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// the number of defined expectations
+func (m *RoleServiceMock) MinimockUpdateDone() bool {
+	if m.UpdateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateMock.invocationsDone()
+}
+
+// MinimockUpdateInspect logs each unmet expectation
+func (m *RoleServiceMock) MinimockUpdateInspect() {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleServiceMock.Update at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateCounter := mm_atomic.LoadUint64(&m.afterUpdateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && afterUpdateCounter < 1 {
+		if m.UpdateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleServiceMock.Update at\n%s", m.UpdateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleServiceMock.Update at\n%s with params: %#v", m.UpdateMock.defaultExpectation.expectationOrigins.origin, *m.UpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && afterUpdateCounter < 1 {
+		m.t.Errorf("Expected call to RoleServiceMock.Update at\n%s", m.funcUpdateOrigin)
+	}
+
+	if !m.UpdateMock.invocationsDone() && afterUpdateCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleServiceMock.Update at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateMock.expectedInvocations), m.UpdateMock.expectedInvocationsOrigin, afterUpdateCounter)
+	}
+}
+
+type mRoleServiceMockValidateRoles struct {
+	optional           bool
+	mock               *RoleServiceMock
+	defaultExpectation *RoleServiceMockValidateRolesExpectation
+	expectations       []*RoleServiceMockValidateRolesExpectation
+
+	callArgs []*RoleServiceMockValidateRolesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleServiceMockValidateRolesExpectation specifies expectation struct of the RoleService.ValidateRoles
+type RoleServiceMockValidateRolesExpectation struct {
+	mock               *RoleServiceMock
+	params             *RoleServiceMockValidateRolesParams
+	paramPtrs          *RoleServiceMockValidateRolesParamPtrs
+	expectationOrigins RoleServiceMockValidateRolesExpectationOrigins
+	results            *RoleServiceMockValidateRolesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleServiceMockValidateRolesParams contains parameters of the RoleService.ValidateRoles
+type RoleServiceMockValidateRolesParams struct {
+	roles []string
+}
+
+// RoleServiceMockValidateRolesParamPtrs contains pointers to parameters of the RoleService.ValidateRoles
+type RoleServiceMockValidateRolesParamPtrs struct {
+	roles *[]string
+}
+
+// RoleServiceMockValidateRolesResults contains results of the RoleService.ValidateRoles
+type RoleServiceMockValidateRolesResults struct {
+	err error
+}
+
+// RoleServiceMockValidateRolesOrigins contains origins of expectations of the RoleService.ValidateRoles
+type RoleServiceMockValidateRolesExpectationOrigins struct {
+	origin      string
+	originRoles string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmValidateRoles *mRoleServiceMockValidateRoles) Optional() *mRoleServiceMockValidateRoles {
+	mmValidateRoles.optional = true
+	return mmValidateRoles
+}
+
+// Expect sets up expected params for RoleService.ValidateRoles
+func (mmValidateRoles *mRoleServiceMockValidateRoles) Expect(roles []string) *mRoleServiceMockValidateRoles {
+	if mmValidateRoles.mock.funcValidateRoles != nil {
+		mmValidateRoles.mock.t.Fatalf("RoleServiceMock.ValidateRoles mock is already set by Set")
+	}
+
+	if mmValidateRoles.defaultExpectation == nil {
+		mmValidateRoles.defaultExpectation = &RoleServiceMockValidateRolesExpectation{}
+	}
+
+	if mmValidateRoles.defaultExpectation.paramPtrs != nil {
+		mmValidateRoles.mock.t.Fatalf("RoleServiceMock.ValidateRoles mock is already set by ExpectParams functions")
+	}
+
+	mmValidateRoles.defaultExpectation.params = &RoleServiceMockValidateRolesParams{roles}
+	mmValidateRoles.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmValidateRoles.expectations {
+		if minimock.Equal(e.params, mmValidateRoles.defaultExpectation.params) {
+			mmValidateRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidateRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmValidateRoles
+}
+
+// ExpectRolesParam1 sets up expected param roles for RoleService.ValidateRoles
+func (mmValidateRoles *mRoleServiceMockValidateRoles) ExpectRolesParam1(roles []string) *mRoleServiceMockValidateRoles {
+	if mmValidateRoles.mock.funcValidateRoles != nil {
+		mmValidateRoles.mock.t.Fatalf("RoleServiceMock.ValidateRoles mock is already set by Set")
+	}
+
+	if mmValidateRoles.defaultExpectation == nil {
+		mmValidateRoles.defaultExpectation = &RoleServiceMockValidateRolesExpectation{}
+	}
+
+	if mmValidateRoles.defaultExpectation.params != nil {
+		mmValidateRoles.mock.t.Fatalf("RoleServiceMock.ValidateRoles mock is already set by Expect")
+	}
+
+	if mmValidateRoles.defaultExpectation.paramPtrs == nil {
+		mmValidateRoles.defaultExpectation.paramPtrs = &RoleServiceMockValidateRolesParamPtrs{}
+	}
+	mmValidateRoles.defaultExpectation.paramPtrs.roles = &roles
+	mmValidateRoles.defaultExpectation.expectationOrigins.originRoles = minimock.CallerInfo(1)
+
+	return mmValidateRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleService.ValidateRoles
+func (mmValidateRoles *mRoleServiceMockValidateRoles) Inspect(f func(roles []string)) *mRoleServiceMockValidateRoles {
+	if mmValidateRoles.mock.inspectFuncValidateRoles != nil {
+		mmValidateRoles.mock.t.Fatalf("Inspect function is already set for RoleServiceMock.ValidateRoles")
+	}
+
+	mmValidateRoles.mock.inspectFuncValidateRoles = f
+
+	return mmValidateRoles
+}
+
+// Return sets up results that will be returned by RoleService.ValidateRoles
+func (mmValidateRoles *mRoleServiceMockValidateRoles) Return(err error) *RoleServiceMock {
+	if mmValidateRoles.mock.funcValidateRoles != nil {
+		mmValidateRoles.mock.t.Fatalf("RoleServiceMock.ValidateRoles mock is already set by Set")
+	}
+
+	if mmValidateRoles.defaultExpectation == nil {
+		mmValidateRoles.defaultExpectation = &RoleServiceMockValidateRolesExpectation{mock: mmValidateRoles.mock}
+	}
+	mmValidateRoles.defaultExpectation.results = &RoleServiceMockValidateRolesResults{err}
+	mmValidateRoles.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmValidateRoles.mock
+}
+
+// Set uses given function f to mock the RoleService.ValidateRoles method
+func (mmValidateRoles *mRoleServiceMockValidateRoles) Set(f func(roles []string) (err error)) *RoleServiceMock {
+	if mmValidateRoles.defaultExpectation != nil {
+		mmValidateRoles.mock.t.Fatalf("Default expectation is already set for the RoleService.ValidateRoles method")
+	}
+
+	if len(mmValidateRoles.expectations) > 0 {
+		mmValidateRoles.mock.t.Fatalf("Some expectations are already set for the RoleService.ValidateRoles method")
+	}
+
+	mmValidateRoles.mock.funcValidateRoles = f
+	mmValidateRoles.mock.funcValidateRolesOrigin = minimock.CallerInfo(1)
+	return mmValidateRoles.mock
+}
+
+// When sets expectation for the RoleService.ValidateRoles which will trigger the result defined by the following
+// Then helper
+func (mmValidateRoles *mRoleServiceMockValidateRoles) When(roles []string) *RoleServiceMockValidateRolesExpectation {
+	if mmValidateRoles.mock.funcValidateRoles != nil {
+		mmValidateRoles.mock.t.Fatalf("RoleServiceMock.ValidateRoles mock is already set by Set")
+	}
+
+	expectation := &RoleServiceMockValidateRolesExpectation{
+		mock:               mmValidateRoles.mock,
+		params:             &RoleServiceMockValidateRolesParams{roles},
+		expectationOrigins: RoleServiceMockValidateRolesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmValidateRoles.expectations = append(mmValidateRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleService.ValidateRoles return parameters for the expectation previously defined by the When method
+func (e *RoleServiceMockValidateRolesExpectation) Then(err error) *RoleServiceMock {
+	e.results = &RoleServiceMockValidateRolesResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleService.ValidateRoles should be invoked
+func (mmValidateRoles *mRoleServiceMockValidateRoles) Times(n uint64) *mRoleServiceMockValidateRoles {
+	if n == 0 {
+		mmValidateRoles.mock.t.Fatalf("Times of RoleServiceMock.ValidateRoles mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmValidateRoles.expectedInvocations, n)
+	mmValidateRoles.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmValidateRoles
+}
+
+func (mmValidateRoles *mRoleServiceMockValidateRoles) invocationsDone() bool {
+	if len(mmValidateRoles.expectations) == 0 && mmValidateRoles.defaultExpectation == nil && mmValidateRoles.mock.funcValidateRoles == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmValidateRoles.mock.afterValidateRolesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmValidateRoles.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ValidateRoles implements mm_service.RoleService
+func (mmValidateRoles *RoleServiceMock) ValidateRoles(roles []string) (err error) {
+	mm_atomic.AddUint64(&mmValidateRoles.beforeValidateRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmValidateRoles.afterValidateRolesCounter, 1)
+
+	mmValidateRoles.t.Helper()
+
+	if mmValidateRoles.inspectFuncValidateRoles != nil {
+		mmValidateRoles.inspectFuncValidateRoles(roles)
+	}
+
+	mm_params := RoleServiceMockValidateRolesParams{roles}
+
+	// Record call args
+	mmValidateRoles.ValidateRolesMock.mutex.Lock()
+	mmValidateRoles.ValidateRolesMock.callArgs = append(mmValidateRoles.ValidateRolesMock.callArgs, &mm_params)
+	mmValidateRoles.ValidateRolesMock.mutex.Unlock()
+
+	for _, e := range mmValidateRoles.ValidateRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmValidateRoles.ValidateRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmValidateRoles.ValidateRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmValidateRoles.ValidateRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmValidateRoles.ValidateRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleServiceMockValidateRolesParams{roles}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.roles != nil && !minimock.Equal(*mm_want_ptrs.roles, mm_got.roles) {
+				mmValidateRoles.t.Errorf("RoleServiceMock.ValidateRoles got unexpected parameter roles, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateRoles.ValidateRolesMock.defaultExpectation.expectationOrigins.originRoles, *mm_want_ptrs.roles, mm_got.roles, minimock.Diff(*mm_want_ptrs.roles, mm_got.roles))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmValidateRoles.t.Errorf("RoleServiceMock.ValidateRoles got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmValidateRoles.ValidateRolesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmValidateRoles.ValidateRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmValidateRoles.t.Fatal("No results are set for the RoleServiceMock.ValidateRoles")
+		}
+		return (*mm_results).err
+	}
+	if mmValidateRoles.funcValidateRoles != nil {
+		return mmValidateRoles.funcValidateRoles(roles)
+	}
+	mmValidateRoles.t.Fatalf("Unexpected call to RoleServiceMock.ValidateRoles. %v", roles)
+	return
+}
+
+// ValidateRolesAfterCounter returns a count of finished RoleServiceMock.ValidateRoles invocations
+func (mmValidateRoles *RoleServiceMock) ValidateRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateRoles.afterValidateRolesCounter)
+}
+
+// ValidateRolesBeforeCounter returns a count of RoleServiceMock.ValidateRoles invocations
+func (mmValidateRoles *RoleServiceMock) ValidateRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateRoles.beforeValidateRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleServiceMock.ValidateRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmValidateRoles *mRoleServiceMockValidateRoles) Calls() []*RoleServiceMockValidateRolesParams {
+	mmValidateRoles.mutex.RLock()
+
+	argCopy := make([]*RoleServiceMockValidateRolesParams, len(mmValidateRoles.callArgs))
+	copy(argCopy, mmValidateRoles.callArgs)
+
+	mmValidateRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockValidateRolesDone returns true if the count of the ValidateRoles invocations corresponds
+// the number of defined expectations
+func (m *RoleServiceMock) MinimockValidateRolesDone() bool {
+	if m.ValidateRolesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ValidateRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ValidateRolesMock.invocationsDone()
+}
+
+// MinimockValidateRolesInspect logs each unmet expectation
+func (m *RoleServiceMock) MinimockValidateRolesInspect() {
+	for _, e := range m.ValidateRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleServiceMock.ValidateRoles at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterValidateRolesCounter := mm_atomic.LoadUint64(&m.afterValidateRolesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ValidateRolesMock.defaultExpectation != nil && afterValidateRolesCounter < 1 {
+		if m.ValidateRolesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleServiceMock.ValidateRoles at\n%s", m.ValidateRolesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleServiceMock.ValidateRoles at\n%s with params: %#v", m.ValidateRolesMock.defaultExpectation.expectationOrigins.origin, *m.ValidateRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcValidateRoles != nil && afterValidateRolesCounter < 1 {
+		m.t.Errorf("Expected call to RoleServiceMock.ValidateRoles at\n%s", m.funcValidateRolesOrigin)
+	}
+
+	if !m.ValidateRolesMock.invocationsDone() && afterValidateRolesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleServiceMock.ValidateRoles at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ValidateRolesMock.expectedInvocations), m.ValidateRolesMock.expectedInvocationsOrigin, afterValidateRolesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RoleServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockEffectiveRolesInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockUpdateInspect()
+
+			m.MinimockValidateRolesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RoleServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RoleServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockEffectiveRolesDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockListDone() &&
+		m.MinimockUpdateDone() &&
+		m.MinimockValidateRolesDone()
+}
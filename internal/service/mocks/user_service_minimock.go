@@ -6,6 +6,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/8thgencore/microservice-auth/internal/model"
@@ -31,6 +32,13 @@ type UserServiceMock struct {
 	beforeCreateCounter uint64
 	CreateMock          mUserServiceMockCreate
 
+	funcDeactivate          func(ctx context.Context, id string) (err error)
+	funcDeactivateOrigin    string
+	inspectFuncDeactivate   func(ctx context.Context, id string)
+	afterDeactivateCounter  uint64
+	beforeDeactivateCounter uint64
+	DeactivateMock          mUserServiceMockDeactivate
+
 	funcDelete          func(ctx context.Context, id string) (err error)
 	funcDeleteOrigin    string
 	inspectFuncDelete   func(ctx context.Context, id string)
@@ -52,12 +60,61 @@ type UserServiceMock struct {
 	beforeGetCounter uint64
 	GetMock          mUserServiceMockGet
 
+	funcListUsers          func(ctx context.Context, params *model.UserListParams) (up1 *model.UserPage, err error)
+	funcListUsersOrigin    string
+	inspectFuncListUsers   func(ctx context.Context, params *model.UserListParams)
+	afterListUsersCounter  uint64
+	beforeListUsersCounter uint64
+	ListUsersMock          mUserServiceMockListUsers
+
+	funcPurge          func(ctx context.Context, id string) (err error)
+	funcPurgeOrigin    string
+	inspectFuncPurge   func(ctx context.Context, id string)
+	afterPurgeCounter  uint64
+	beforePurgeCounter uint64
+	PurgeMock          mUserServiceMockPurge
+
+	funcPurgeExpired          func(ctx context.Context) (i1 int, err error)
+	funcPurgeExpiredOrigin    string
+	inspectFuncPurgeExpired   func(ctx context.Context)
+	afterPurgeExpiredCounter  uint64
+	beforePurgeExpiredCounter uint64
+	PurgeExpiredMock          mUserServiceMockPurgeExpired
+
+	funcRestore          func(ctx context.Context, id string) (err error)
+	funcRestoreOrigin    string
+	inspectFuncRestore   func(ctx context.Context, id string)
+	afterRestoreCounter  uint64
+	beforeRestoreCounter uint64
+	RestoreMock          mUserServiceMockRestore
+
+	funcSendVerificationEmail          func(ctx context.Context, email string) (err error)
+	funcSendVerificationEmailOrigin    string
+	inspectFuncSendVerificationEmail   func(ctx context.Context, email string)
+	afterSendVerificationEmailCounter  uint64
+	beforeSendVerificationEmailCounter uint64
+	SendVerificationEmailMock          mUserServiceMockSendVerificationEmail
+
+	funcSuspend          func(ctx context.Context, id string, until *time.Time) (err error)
+	funcSuspendOrigin    string
+	inspectFuncSuspend   func(ctx context.Context, id string, until *time.Time)
+	afterSuspendCounter  uint64
+	beforeSuspendCounter uint64
+	SuspendMock          mUserServiceMockSuspend
+
 	funcUpdate          func(ctx context.Context, user *model.UserUpdate) (err error)
 	funcUpdateOrigin    string
 	inspectFuncUpdate   func(ctx context.Context, user *model.UserUpdate)
 	afterUpdateCounter  uint64
 	beforeUpdateCounter uint64
 	UpdateMock          mUserServiceMockUpdate
+
+	funcVerifyEmail          func(ctx context.Context, token string) (err error)
+	funcVerifyEmailOrigin    string
+	inspectFuncVerifyEmail   func(ctx context.Context, token string)
+	afterVerifyEmailCounter  uint64
+	beforeVerifyEmailCounter uint64
+	VerifyEmailMock          mUserServiceMockVerifyEmail
 }
 
 // NewUserServiceMock returns a mock for mm_service.UserService
@@ -74,6 +131,9 @@ func NewUserServiceMock(t minimock.Tester) *UserServiceMock {
 	m.CreateMock = mUserServiceMockCreate{mock: m}
 	m.CreateMock.callArgs = []*UserServiceMockCreateParams{}
 
+	m.DeactivateMock = mUserServiceMockDeactivate{mock: m}
+	m.DeactivateMock.callArgs = []*UserServiceMockDeactivateParams{}
+
 	m.DeleteMock = mUserServiceMockDelete{mock: m}
 	m.DeleteMock.callArgs = []*UserServiceMockDeleteParams{}
 
@@ -83,9 +143,30 @@ func NewUserServiceMock(t minimock.Tester) *UserServiceMock {
 	m.GetMock = mUserServiceMockGet{mock: m}
 	m.GetMock.callArgs = []*UserServiceMockGetParams{}
 
+	m.ListUsersMock = mUserServiceMockListUsers{mock: m}
+	m.ListUsersMock.callArgs = []*UserServiceMockListUsersParams{}
+
+	m.PurgeMock = mUserServiceMockPurge{mock: m}
+	m.PurgeMock.callArgs = []*UserServiceMockPurgeParams{}
+
+	m.PurgeExpiredMock = mUserServiceMockPurgeExpired{mock: m}
+	m.PurgeExpiredMock.callArgs = []*UserServiceMockPurgeExpiredParams{}
+
+	m.RestoreMock = mUserServiceMockRestore{mock: m}
+	m.RestoreMock.callArgs = []*UserServiceMockRestoreParams{}
+
+	m.SendVerificationEmailMock = mUserServiceMockSendVerificationEmail{mock: m}
+	m.SendVerificationEmailMock.callArgs = []*UserServiceMockSendVerificationEmailParams{}
+
+	m.SuspendMock = mUserServiceMockSuspend{mock: m}
+	m.SuspendMock.callArgs = []*UserServiceMockSuspendParams{}
+
 	m.UpdateMock = mUserServiceMockUpdate{mock: m}
 	m.UpdateMock.callArgs = []*UserServiceMockUpdateParams{}
 
+	m.VerifyEmailMock = mUserServiceMockVerifyEmail{mock: m}
+	m.VerifyEmailMock.callArgs = []*UserServiceMockVerifyEmailParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -838,6 +919,348 @@ func (m *UserServiceMock) MinimockCreateInspect() {
 	}
 }
 
+type mUserServiceMockDeactivate struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockDeactivateExpectation
+	expectations       []*UserServiceMockDeactivateExpectation
+
+	callArgs []*UserServiceMockDeactivateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockDeactivateExpectation specifies expectation struct of the UserService.Deactivate
+type UserServiceMockDeactivateExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockDeactivateParams
+	paramPtrs          *UserServiceMockDeactivateParamPtrs
+	expectationOrigins UserServiceMockDeactivateExpectationOrigins
+	results            *UserServiceMockDeactivateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockDeactivateParams contains parameters of the UserService.Deactivate
+type UserServiceMockDeactivateParams struct {
+	ctx context.Context
+	id  string
+}
+
+// UserServiceMockDeactivateParamPtrs contains pointers to parameters of the UserService.Deactivate
+type UserServiceMockDeactivateParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// UserServiceMockDeactivateResults contains results of the UserService.Deactivate
+type UserServiceMockDeactivateResults struct {
+	err error
+}
+
+// UserServiceMockDeactivateOrigins contains origins of expectations of the UserService.Deactivate
+type UserServiceMockDeactivateExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeactivate *mUserServiceMockDeactivate) Optional() *mUserServiceMockDeactivate {
+	mmDeactivate.optional = true
+	return mmDeactivate
+}
+
+// Expect sets up expected params for UserService.Deactivate
+func (mmDeactivate *mUserServiceMockDeactivate) Expect(ctx context.Context, id string) *mUserServiceMockDeactivate {
+	if mmDeactivate.mock.funcDeactivate != nil {
+		mmDeactivate.mock.t.Fatalf("UserServiceMock.Deactivate mock is already set by Set")
+	}
+
+	if mmDeactivate.defaultExpectation == nil {
+		mmDeactivate.defaultExpectation = &UserServiceMockDeactivateExpectation{}
+	}
+
+	if mmDeactivate.defaultExpectation.paramPtrs != nil {
+		mmDeactivate.mock.t.Fatalf("UserServiceMock.Deactivate mock is already set by ExpectParams functions")
+	}
+
+	mmDeactivate.defaultExpectation.params = &UserServiceMockDeactivateParams{ctx, id}
+	mmDeactivate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeactivate.expectations {
+		if minimock.Equal(e.params, mmDeactivate.defaultExpectation.params) {
+			mmDeactivate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeactivate.defaultExpectation.params)
+		}
+	}
+
+	return mmDeactivate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.Deactivate
+func (mmDeactivate *mUserServiceMockDeactivate) ExpectCtxParam1(ctx context.Context) *mUserServiceMockDeactivate {
+	if mmDeactivate.mock.funcDeactivate != nil {
+		mmDeactivate.mock.t.Fatalf("UserServiceMock.Deactivate mock is already set by Set")
+	}
+
+	if mmDeactivate.defaultExpectation == nil {
+		mmDeactivate.defaultExpectation = &UserServiceMockDeactivateExpectation{}
+	}
+
+	if mmDeactivate.defaultExpectation.params != nil {
+		mmDeactivate.mock.t.Fatalf("UserServiceMock.Deactivate mock is already set by Expect")
+	}
+
+	if mmDeactivate.defaultExpectation.paramPtrs == nil {
+		mmDeactivate.defaultExpectation.paramPtrs = &UserServiceMockDeactivateParamPtrs{}
+	}
+	mmDeactivate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeactivate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeactivate
+}
+
+// ExpectIdParam2 sets up expected param id for UserService.Deactivate
+func (mmDeactivate *mUserServiceMockDeactivate) ExpectIdParam2(id string) *mUserServiceMockDeactivate {
+	if mmDeactivate.mock.funcDeactivate != nil {
+		mmDeactivate.mock.t.Fatalf("UserServiceMock.Deactivate mock is already set by Set")
+	}
+
+	if mmDeactivate.defaultExpectation == nil {
+		mmDeactivate.defaultExpectation = &UserServiceMockDeactivateExpectation{}
+	}
+
+	if mmDeactivate.defaultExpectation.params != nil {
+		mmDeactivate.mock.t.Fatalf("UserServiceMock.Deactivate mock is already set by Expect")
+	}
+
+	if mmDeactivate.defaultExpectation.paramPtrs == nil {
+		mmDeactivate.defaultExpectation.paramPtrs = &UserServiceMockDeactivateParamPtrs{}
+	}
+	mmDeactivate.defaultExpectation.paramPtrs.id = &id
+	mmDeactivate.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeactivate
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.Deactivate
+func (mmDeactivate *mUserServiceMockDeactivate) Inspect(f func(ctx context.Context, id string)) *mUserServiceMockDeactivate {
+	if mmDeactivate.mock.inspectFuncDeactivate != nil {
+		mmDeactivate.mock.t.Fatalf("Inspect function is already set for UserServiceMock.Deactivate")
+	}
+
+	mmDeactivate.mock.inspectFuncDeactivate = f
+
+	return mmDeactivate
+}
+
+// Return sets up results that will be returned by UserService.Deactivate
+func (mmDeactivate *mUserServiceMockDeactivate) Return(err error) *UserServiceMock {
+	if mmDeactivate.mock.funcDeactivate != nil {
+		mmDeactivate.mock.t.Fatalf("UserServiceMock.Deactivate mock is already set by Set")
+	}
+
+	if mmDeactivate.defaultExpectation == nil {
+		mmDeactivate.defaultExpectation = &UserServiceMockDeactivateExpectation{mock: mmDeactivate.mock}
+	}
+	mmDeactivate.defaultExpectation.results = &UserServiceMockDeactivateResults{err}
+	mmDeactivate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeactivate.mock
+}
+
+// Set uses given function f to mock the UserService.Deactivate method
+func (mmDeactivate *mUserServiceMockDeactivate) Set(f func(ctx context.Context, id string) (err error)) *UserServiceMock {
+	if mmDeactivate.defaultExpectation != nil {
+		mmDeactivate.mock.t.Fatalf("Default expectation is already set for the UserService.Deactivate method")
+	}
+
+	if len(mmDeactivate.expectations) > 0 {
+		mmDeactivate.mock.t.Fatalf("Some expectations are already set for the UserService.Deactivate method")
+	}
+
+	mmDeactivate.mock.funcDeactivate = f
+	mmDeactivate.mock.funcDeactivateOrigin = minimock.CallerInfo(1)
+	return mmDeactivate.mock
+}
+
+// When sets expectation for the UserService.Deactivate which will trigger the result defined by the following
+// Then helper
+func (mmDeactivate *mUserServiceMockDeactivate) When(ctx context.Context, id string) *UserServiceMockDeactivateExpectation {
+	if mmDeactivate.mock.funcDeactivate != nil {
+		mmDeactivate.mock.t.Fatalf("UserServiceMock.Deactivate mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockDeactivateExpectation{
+		mock:               mmDeactivate.mock,
+		params:             &UserServiceMockDeactivateParams{ctx, id},
+		expectationOrigins: UserServiceMockDeactivateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeactivate.expectations = append(mmDeactivate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.Deactivate return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockDeactivateExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockDeactivateResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserService.Deactivate should be invoked
+func (mmDeactivate *mUserServiceMockDeactivate) Times(n uint64) *mUserServiceMockDeactivate {
+	if n == 0 {
+		mmDeactivate.mock.t.Fatalf("Times of UserServiceMock.Deactivate mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeactivate.expectedInvocations, n)
+	mmDeactivate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeactivate
+}
+
+func (mmDeactivate *mUserServiceMockDeactivate) invocationsDone() bool {
+	if len(mmDeactivate.expectations) == 0 && mmDeactivate.defaultExpectation == nil && mmDeactivate.mock.funcDeactivate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeactivate.mock.afterDeactivateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeactivate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Deactivate implements mm_service.UserService
+func (mmDeactivate *UserServiceMock) Deactivate(ctx context.Context, id string) (err error) {
+	mm_atomic.AddUint64(&mmDeactivate.beforeDeactivateCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeactivate.afterDeactivateCounter, 1)
+
+	mmDeactivate.t.Helper()
+
+	if mmDeactivate.inspectFuncDeactivate != nil {
+		mmDeactivate.inspectFuncDeactivate(ctx, id)
+	}
+
+	mm_params := UserServiceMockDeactivateParams{ctx, id}
+
+	// Record call args
+	mmDeactivate.DeactivateMock.mutex.Lock()
+	mmDeactivate.DeactivateMock.callArgs = append(mmDeactivate.DeactivateMock.callArgs, &mm_params)
+	mmDeactivate.DeactivateMock.mutex.Unlock()
+
+	for _, e := range mmDeactivate.DeactivateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeactivate.DeactivateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeactivate.DeactivateMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeactivate.DeactivateMock.defaultExpectation.params
+		mm_want_ptrs := mmDeactivate.DeactivateMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockDeactivateParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeactivate.t.Errorf("UserServiceMock.Deactivate got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeactivate.DeactivateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeactivate.t.Errorf("UserServiceMock.Deactivate got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeactivate.DeactivateMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeactivate.t.Errorf("UserServiceMock.Deactivate got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeactivate.DeactivateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeactivate.DeactivateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeactivate.t.Fatal("No results are set for the UserServiceMock.Deactivate")
+		}
+		return (*mm_results).err
+	}
+	if mmDeactivate.funcDeactivate != nil {
+		return mmDeactivate.funcDeactivate(ctx, id)
+	}
+	mmDeactivate.t.Fatalf("Unexpected call to UserServiceMock.Deactivate. %v %v", ctx, id)
+	return
+}
+
+// DeactivateAfterCounter returns a count of finished UserServiceMock.Deactivate invocations
+func (mmDeactivate *UserServiceMock) DeactivateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeactivate.afterDeactivateCounter)
+}
+
+// DeactivateBeforeCounter returns a count of UserServiceMock.Deactivate invocations
+func (mmDeactivate *UserServiceMock) DeactivateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeactivate.beforeDeactivateCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.Deactivate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeactivate *mUserServiceMockDeactivate) Calls() []*UserServiceMockDeactivateParams {
+	mmDeactivate.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockDeactivateParams, len(mmDeactivate.callArgs))
+	copy(argCopy, mmDeactivate.callArgs)
+
+	mmDeactivate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeactivateDone returns true if the count of the Deactivate invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockDeactivateDone() bool {
+	if m.DeactivateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeactivateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeactivateMock.invocationsDone()
+}
+
+// MinimockDeactivateInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockDeactivateInspect() {
+	for _, e := range m.DeactivateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.Deactivate at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeactivateCounter := mm_atomic.LoadUint64(&m.afterDeactivateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeactivateMock.defaultExpectation != nil && afterDeactivateCounter < 1 {
+		if m.DeactivateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.Deactivate at\n%s", m.DeactivateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.Deactivate at\n%s with params: %#v", m.DeactivateMock.defaultExpectation.expectationOrigins.origin, *m.DeactivateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeactivate != nil && afterDeactivateCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.Deactivate at\n%s", m.funcDeactivateOrigin)
+	}
+
+	if !m.DeactivateMock.invocationsDone() && afterDeactivateCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.Deactivate at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeactivateMock.expectedInvocations), m.DeactivateMock.expectedInvocationsOrigin, afterDeactivateCounter)
+	}
+}
+
 type mUserServiceMockDelete struct {
 	optional           bool
 	mock               *UserServiceMock
@@ -1834,52 +2257,53 @@ func (m *UserServiceMock) MinimockGetInspect() {
 	}
 }
 
-type mUserServiceMockUpdate struct {
+type mUserServiceMockListUsers struct {
 	optional           bool
 	mock               *UserServiceMock
-	defaultExpectation *UserServiceMockUpdateExpectation
-	expectations       []*UserServiceMockUpdateExpectation
+	defaultExpectation *UserServiceMockListUsersExpectation
+	expectations       []*UserServiceMockListUsersExpectation
 
-	callArgs []*UserServiceMockUpdateParams
+	callArgs []*UserServiceMockListUsersParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserServiceMockUpdateExpectation specifies expectation struct of the UserService.Update
-type UserServiceMockUpdateExpectation struct {
+// UserServiceMockListUsersExpectation specifies expectation struct of the UserService.ListUsers
+type UserServiceMockListUsersExpectation struct {
 	mock               *UserServiceMock
-	params             *UserServiceMockUpdateParams
-	paramPtrs          *UserServiceMockUpdateParamPtrs
-	expectationOrigins UserServiceMockUpdateExpectationOrigins
-	results            *UserServiceMockUpdateResults
+	params             *UserServiceMockListUsersParams
+	paramPtrs          *UserServiceMockListUsersParamPtrs
+	expectationOrigins UserServiceMockListUsersExpectationOrigins
+	results            *UserServiceMockListUsersResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserServiceMockUpdateParams contains parameters of the UserService.Update
-type UserServiceMockUpdateParams struct {
-	ctx  context.Context
-	user *model.UserUpdate
+// UserServiceMockListUsersParams contains parameters of the UserService.ListUsers
+type UserServiceMockListUsersParams struct {
+	ctx    context.Context
+	params *model.UserListParams
 }
 
-// UserServiceMockUpdateParamPtrs contains pointers to parameters of the UserService.Update
-type UserServiceMockUpdateParamPtrs struct {
-	ctx  *context.Context
-	user **model.UserUpdate
+// UserServiceMockListUsersParamPtrs contains pointers to parameters of the UserService.ListUsers
+type UserServiceMockListUsersParamPtrs struct {
+	ctx    *context.Context
+	params **model.UserListParams
 }
 
-// UserServiceMockUpdateResults contains results of the UserService.Update
-type UserServiceMockUpdateResults struct {
+// UserServiceMockListUsersResults contains results of the UserService.ListUsers
+type UserServiceMockListUsersResults struct {
+	up1 *model.UserPage
 	err error
 }
 
-// UserServiceMockUpdateOrigins contains origins of expectations of the UserService.Update
-type UserServiceMockUpdateExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originUser string
+// UserServiceMockListUsersOrigins contains origins of expectations of the UserService.ListUsers
+type UserServiceMockListUsersExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originParams string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1887,83 +2311,2136 @@ type UserServiceMockUpdateExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmUpdate *mUserServiceMockUpdate) Optional() *mUserServiceMockUpdate {
-	mmUpdate.optional = true
-	return mmUpdate
+func (mmListUsers *mUserServiceMockListUsers) Optional() *mUserServiceMockListUsers {
+	mmListUsers.optional = true
+	return mmListUsers
 }
 
-// Expect sets up expected params for UserService.Update
-func (mmUpdate *mUserServiceMockUpdate) Expect(ctx context.Context, user *model.UserUpdate) *mUserServiceMockUpdate {
-	if mmUpdate.mock.funcUpdate != nil {
-		mmUpdate.mock.t.Fatalf("UserServiceMock.Update mock is already set by Set")
+// Expect sets up expected params for UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) Expect(ctx context.Context, params *model.UserListParams) *mUserServiceMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
 	}
 
-	if mmUpdate.defaultExpectation == nil {
-		mmUpdate.defaultExpectation = &UserServiceMockUpdateExpectation{}
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserServiceMockListUsersExpectation{}
 	}
 
-	if mmUpdate.defaultExpectation.paramPtrs != nil {
-		mmUpdate.mock.t.Fatalf("UserServiceMock.Update mock is already set by ExpectParams functions")
+	if mmListUsers.defaultExpectation.paramPtrs != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by ExpectParams functions")
 	}
 
-	mmUpdate.defaultExpectation.params = &UserServiceMockUpdateParams{ctx, user}
-	mmUpdate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmUpdate.expectations {
-		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
-			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+	mmListUsers.defaultExpectation.params = &UserServiceMockListUsersParams{ctx, params}
+	mmListUsers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListUsers.expectations {
+		if minimock.Equal(e.params, mmListUsers.defaultExpectation.params) {
+			mmListUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListUsers.defaultExpectation.params)
 		}
 	}
 
-	return mmUpdate
+	return mmListUsers
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserService.Update
-func (mmUpdate *mUserServiceMockUpdate) ExpectCtxParam1(ctx context.Context) *mUserServiceMockUpdate {
-	if mmUpdate.mock.funcUpdate != nil {
-		mmUpdate.mock.t.Fatalf("UserServiceMock.Update mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) ExpectCtxParam1(ctx context.Context) *mUserServiceMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
 	}
 
-	if mmUpdate.defaultExpectation == nil {
-		mmUpdate.defaultExpectation = &UserServiceMockUpdateExpectation{}
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserServiceMockListUsersExpectation{}
 	}
 
-	if mmUpdate.defaultExpectation.params != nil {
-		mmUpdate.mock.t.Fatalf("UserServiceMock.Update mock is already set by Expect")
+	if mmListUsers.defaultExpectation.params != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Expect")
 	}
 
-	if mmUpdate.defaultExpectation.paramPtrs == nil {
-		mmUpdate.defaultExpectation.paramPtrs = &UserServiceMockUpdateParamPtrs{}
+	if mmListUsers.defaultExpectation.paramPtrs == nil {
+		mmListUsers.defaultExpectation.paramPtrs = &UserServiceMockListUsersParamPtrs{}
 	}
-	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
-	mmUpdate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmListUsers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListUsers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmUpdate
+	return mmListUsers
 }
 
-// ExpectUserParam2 sets up expected param user for UserService.Update
-func (mmUpdate *mUserServiceMockUpdate) ExpectUserParam2(user *model.UserUpdate) *mUserServiceMockUpdate {
-	if mmUpdate.mock.funcUpdate != nil {
-		mmUpdate.mock.t.Fatalf("UserServiceMock.Update mock is already set by Set")
+// ExpectParamsParam2 sets up expected param params for UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) ExpectParamsParam2(params *model.UserListParams) *mUserServiceMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
 	}
 
-	if mmUpdate.defaultExpectation == nil {
-		mmUpdate.defaultExpectation = &UserServiceMockUpdateExpectation{}
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserServiceMockListUsersExpectation{}
 	}
 
-	if mmUpdate.defaultExpectation.params != nil {
-		mmUpdate.mock.t.Fatalf("UserServiceMock.Update mock is already set by Expect")
+	if mmListUsers.defaultExpectation.params != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Expect")
 	}
 
-	if mmUpdate.defaultExpectation.paramPtrs == nil {
-		mmUpdate.defaultExpectation.paramPtrs = &UserServiceMockUpdateParamPtrs{}
+	if mmListUsers.defaultExpectation.paramPtrs == nil {
+		mmListUsers.defaultExpectation.paramPtrs = &UserServiceMockListUsersParamPtrs{}
 	}
-	mmUpdate.defaultExpectation.paramPtrs.user = &user
-	mmUpdate.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+	mmListUsers.defaultExpectation.paramPtrs.params = &params
+	mmListUsers.defaultExpectation.expectationOrigins.originParams = minimock.CallerInfo(1)
 
-	return mmUpdate
+	return mmListUsers
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserService.Update
+// Inspect accepts an inspector function that has same arguments as the UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) Inspect(f func(ctx context.Context, params *model.UserListParams)) *mUserServiceMockListUsers {
+	if mmListUsers.mock.inspectFuncListUsers != nil {
+		mmListUsers.mock.t.Fatalf("Inspect function is already set for UserServiceMock.ListUsers")
+	}
+
+	mmListUsers.mock.inspectFuncListUsers = f
+
+	return mmListUsers
+}
+
+// Return sets up results that will be returned by UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) Return(up1 *model.UserPage, err error) *UserServiceMock {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
+	}
+
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserServiceMockListUsersExpectation{mock: mmListUsers.mock}
+	}
+	mmListUsers.defaultExpectation.results = &UserServiceMockListUsersResults{up1, err}
+	mmListUsers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListUsers.mock
+}
+
+// Set uses given function f to mock the UserService.ListUsers method
+func (mmListUsers *mUserServiceMockListUsers) Set(f func(ctx context.Context, params *model.UserListParams) (up1 *model.UserPage, err error)) *UserServiceMock {
+	if mmListUsers.defaultExpectation != nil {
+		mmListUsers.mock.t.Fatalf("Default expectation is already set for the UserService.ListUsers method")
+	}
+
+	if len(mmListUsers.expectations) > 0 {
+		mmListUsers.mock.t.Fatalf("Some expectations are already set for the UserService.ListUsers method")
+	}
+
+	mmListUsers.mock.funcListUsers = f
+	mmListUsers.mock.funcListUsersOrigin = minimock.CallerInfo(1)
+	return mmListUsers.mock
+}
+
+// When sets expectation for the UserService.ListUsers which will trigger the result defined by the following
+// Then helper
+func (mmListUsers *mUserServiceMockListUsers) When(ctx context.Context, params *model.UserListParams) *UserServiceMockListUsersExpectation {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockListUsersExpectation{
+		mock:               mmListUsers.mock,
+		params:             &UserServiceMockListUsersParams{ctx, params},
+		expectationOrigins: UserServiceMockListUsersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListUsers.expectations = append(mmListUsers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.ListUsers return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockListUsersExpectation) Then(up1 *model.UserPage, err error) *UserServiceMock {
+	e.results = &UserServiceMockListUsersResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserService.ListUsers should be invoked
+func (mmListUsers *mUserServiceMockListUsers) Times(n uint64) *mUserServiceMockListUsers {
+	if n == 0 {
+		mmListUsers.mock.t.Fatalf("Times of UserServiceMock.ListUsers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListUsers.expectedInvocations, n)
+	mmListUsers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListUsers
+}
+
+func (mmListUsers *mUserServiceMockListUsers) invocationsDone() bool {
+	if len(mmListUsers.expectations) == 0 && mmListUsers.defaultExpectation == nil && mmListUsers.mock.funcListUsers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListUsers.mock.afterListUsersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListUsers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListUsers implements mm_service.UserService
+func (mmListUsers *UserServiceMock) ListUsers(ctx context.Context, params *model.UserListParams) (up1 *model.UserPage, err error) {
+	mm_atomic.AddUint64(&mmListUsers.beforeListUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListUsers.afterListUsersCounter, 1)
+
+	mmListUsers.t.Helper()
+
+	if mmListUsers.inspectFuncListUsers != nil {
+		mmListUsers.inspectFuncListUsers(ctx, params)
+	}
+
+	mm_params := UserServiceMockListUsersParams{ctx, params}
+
+	// Record call args
+	mmListUsers.ListUsersMock.mutex.Lock()
+	mmListUsers.ListUsersMock.callArgs = append(mmListUsers.ListUsersMock.callArgs, &mm_params)
+	mmListUsers.ListUsersMock.mutex.Unlock()
+
+	for _, e := range mmListUsers.ListUsersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmListUsers.ListUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListUsers.ListUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListUsers.ListUsersMock.defaultExpectation.params
+		mm_want_ptrs := mmListUsers.ListUsersMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockListUsersParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListUsers.t.Errorf("UserServiceMock.ListUsers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUsers.ListUsersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListUsers.t.Errorf("UserServiceMock.ListUsers got unexpected parameter params, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUsers.ListUsersMock.defaultExpectation.expectationOrigins.originParams, *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListUsers.t.Errorf("UserServiceMock.ListUsers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListUsers.ListUsersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListUsers.ListUsersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListUsers.t.Fatal("No results are set for the UserServiceMock.ListUsers")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmListUsers.funcListUsers != nil {
+		return mmListUsers.funcListUsers(ctx, params)
+	}
+	mmListUsers.t.Fatalf("Unexpected call to UserServiceMock.ListUsers. %v %v", ctx, params)
+	return
+}
+
+// ListUsersAfterCounter returns a count of finished UserServiceMock.ListUsers invocations
+func (mmListUsers *UserServiceMock) ListUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUsers.afterListUsersCounter)
+}
+
+// ListUsersBeforeCounter returns a count of UserServiceMock.ListUsers invocations
+func (mmListUsers *UserServiceMock) ListUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUsers.beforeListUsersCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.ListUsers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListUsers *mUserServiceMockListUsers) Calls() []*UserServiceMockListUsersParams {
+	mmListUsers.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockListUsersParams, len(mmListUsers.callArgs))
+	copy(argCopy, mmListUsers.callArgs)
+
+	mmListUsers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListUsersDone returns true if the count of the ListUsers invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockListUsersDone() bool {
+	if m.ListUsersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListUsersMock.invocationsDone()
+}
+
+// MinimockListUsersInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockListUsersInspect() {
+	for _, e := range m.ListUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.ListUsers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListUsersCounter := mm_atomic.LoadUint64(&m.afterListUsersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListUsersMock.defaultExpectation != nil && afterListUsersCounter < 1 {
+		if m.ListUsersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.ListUsers at\n%s", m.ListUsersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.ListUsers at\n%s with params: %#v", m.ListUsersMock.defaultExpectation.expectationOrigins.origin, *m.ListUsersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListUsers != nil && afterListUsersCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.ListUsers at\n%s", m.funcListUsersOrigin)
+	}
+
+	if !m.ListUsersMock.invocationsDone() && afterListUsersCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.ListUsers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListUsersMock.expectedInvocations), m.ListUsersMock.expectedInvocationsOrigin, afterListUsersCounter)
+	}
+}
+
+type mUserServiceMockPurge struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockPurgeExpectation
+	expectations       []*UserServiceMockPurgeExpectation
+
+	callArgs []*UserServiceMockPurgeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockPurgeExpectation specifies expectation struct of the UserService.Purge
+type UserServiceMockPurgeExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockPurgeParams
+	paramPtrs          *UserServiceMockPurgeParamPtrs
+	expectationOrigins UserServiceMockPurgeExpectationOrigins
+	results            *UserServiceMockPurgeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockPurgeParams contains parameters of the UserService.Purge
+type UserServiceMockPurgeParams struct {
+	ctx context.Context
+	id  string
+}
+
+// UserServiceMockPurgeParamPtrs contains pointers to parameters of the UserService.Purge
+type UserServiceMockPurgeParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// UserServiceMockPurgeResults contains results of the UserService.Purge
+type UserServiceMockPurgeResults struct {
+	err error
+}
+
+// UserServiceMockPurgeOrigins contains origins of expectations of the UserService.Purge
+type UserServiceMockPurgeExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurge *mUserServiceMockPurge) Optional() *mUserServiceMockPurge {
+	mmPurge.optional = true
+	return mmPurge
+}
+
+// Expect sets up expected params for UserService.Purge
+func (mmPurge *mUserServiceMockPurge) Expect(ctx context.Context, id string) *mUserServiceMockPurge {
+	if mmPurge.mock.funcPurge != nil {
+		mmPurge.mock.t.Fatalf("UserServiceMock.Purge mock is already set by Set")
+	}
+
+	if mmPurge.defaultExpectation == nil {
+		mmPurge.defaultExpectation = &UserServiceMockPurgeExpectation{}
+	}
+
+	if mmPurge.defaultExpectation.paramPtrs != nil {
+		mmPurge.mock.t.Fatalf("UserServiceMock.Purge mock is already set by ExpectParams functions")
+	}
+
+	mmPurge.defaultExpectation.params = &UserServiceMockPurgeParams{ctx, id}
+	mmPurge.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurge.expectations {
+		if minimock.Equal(e.params, mmPurge.defaultExpectation.params) {
+			mmPurge.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurge.defaultExpectation.params)
+		}
+	}
+
+	return mmPurge
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.Purge
+func (mmPurge *mUserServiceMockPurge) ExpectCtxParam1(ctx context.Context) *mUserServiceMockPurge {
+	if mmPurge.mock.funcPurge != nil {
+		mmPurge.mock.t.Fatalf("UserServiceMock.Purge mock is already set by Set")
+	}
+
+	if mmPurge.defaultExpectation == nil {
+		mmPurge.defaultExpectation = &UserServiceMockPurgeExpectation{}
+	}
+
+	if mmPurge.defaultExpectation.params != nil {
+		mmPurge.mock.t.Fatalf("UserServiceMock.Purge mock is already set by Expect")
+	}
+
+	if mmPurge.defaultExpectation.paramPtrs == nil {
+		mmPurge.defaultExpectation.paramPtrs = &UserServiceMockPurgeParamPtrs{}
+	}
+	mmPurge.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurge.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurge
+}
+
+// ExpectIdParam2 sets up expected param id for UserService.Purge
+func (mmPurge *mUserServiceMockPurge) ExpectIdParam2(id string) *mUserServiceMockPurge {
+	if mmPurge.mock.funcPurge != nil {
+		mmPurge.mock.t.Fatalf("UserServiceMock.Purge mock is already set by Set")
+	}
+
+	if mmPurge.defaultExpectation == nil {
+		mmPurge.defaultExpectation = &UserServiceMockPurgeExpectation{}
+	}
+
+	if mmPurge.defaultExpectation.params != nil {
+		mmPurge.mock.t.Fatalf("UserServiceMock.Purge mock is already set by Expect")
+	}
+
+	if mmPurge.defaultExpectation.paramPtrs == nil {
+		mmPurge.defaultExpectation.paramPtrs = &UserServiceMockPurgeParamPtrs{}
+	}
+	mmPurge.defaultExpectation.paramPtrs.id = &id
+	mmPurge.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmPurge
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.Purge
+func (mmPurge *mUserServiceMockPurge) Inspect(f func(ctx context.Context, id string)) *mUserServiceMockPurge {
+	if mmPurge.mock.inspectFuncPurge != nil {
+		mmPurge.mock.t.Fatalf("Inspect function is already set for UserServiceMock.Purge")
+	}
+
+	mmPurge.mock.inspectFuncPurge = f
+
+	return mmPurge
+}
+
+// Return sets up results that will be returned by UserService.Purge
+func (mmPurge *mUserServiceMockPurge) Return(err error) *UserServiceMock {
+	if mmPurge.mock.funcPurge != nil {
+		mmPurge.mock.t.Fatalf("UserServiceMock.Purge mock is already set by Set")
+	}
+
+	if mmPurge.defaultExpectation == nil {
+		mmPurge.defaultExpectation = &UserServiceMockPurgeExpectation{mock: mmPurge.mock}
+	}
+	mmPurge.defaultExpectation.results = &UserServiceMockPurgeResults{err}
+	mmPurge.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurge.mock
+}
+
+// Set uses given function f to mock the UserService.Purge method
+func (mmPurge *mUserServiceMockPurge) Set(f func(ctx context.Context, id string) (err error)) *UserServiceMock {
+	if mmPurge.defaultExpectation != nil {
+		mmPurge.mock.t.Fatalf("Default expectation is already set for the UserService.Purge method")
+	}
+
+	if len(mmPurge.expectations) > 0 {
+		mmPurge.mock.t.Fatalf("Some expectations are already set for the UserService.Purge method")
+	}
+
+	mmPurge.mock.funcPurge = f
+	mmPurge.mock.funcPurgeOrigin = minimock.CallerInfo(1)
+	return mmPurge.mock
+}
+
+// When sets expectation for the UserService.Purge which will trigger the result defined by the following
+// Then helper
+func (mmPurge *mUserServiceMockPurge) When(ctx context.Context, id string) *UserServiceMockPurgeExpectation {
+	if mmPurge.mock.funcPurge != nil {
+		mmPurge.mock.t.Fatalf("UserServiceMock.Purge mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockPurgeExpectation{
+		mock:               mmPurge.mock,
+		params:             &UserServiceMockPurgeParams{ctx, id},
+		expectationOrigins: UserServiceMockPurgeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurge.expectations = append(mmPurge.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.Purge return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockPurgeExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockPurgeResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserService.Purge should be invoked
+func (mmPurge *mUserServiceMockPurge) Times(n uint64) *mUserServiceMockPurge {
+	if n == 0 {
+		mmPurge.mock.t.Fatalf("Times of UserServiceMock.Purge mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurge.expectedInvocations, n)
+	mmPurge.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurge
+}
+
+func (mmPurge *mUserServiceMockPurge) invocationsDone() bool {
+	if len(mmPurge.expectations) == 0 && mmPurge.defaultExpectation == nil && mmPurge.mock.funcPurge == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurge.mock.afterPurgeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurge.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Purge implements mm_service.UserService
+func (mmPurge *UserServiceMock) Purge(ctx context.Context, id string) (err error) {
+	mm_atomic.AddUint64(&mmPurge.beforePurgeCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurge.afterPurgeCounter, 1)
+
+	mmPurge.t.Helper()
+
+	if mmPurge.inspectFuncPurge != nil {
+		mmPurge.inspectFuncPurge(ctx, id)
+	}
+
+	mm_params := UserServiceMockPurgeParams{ctx, id}
+
+	// Record call args
+	mmPurge.PurgeMock.mutex.Lock()
+	mmPurge.PurgeMock.callArgs = append(mmPurge.PurgeMock.callArgs, &mm_params)
+	mmPurge.PurgeMock.mutex.Unlock()
+
+	for _, e := range mmPurge.PurgeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPurge.PurgeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurge.PurgeMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurge.PurgeMock.defaultExpectation.params
+		mm_want_ptrs := mmPurge.PurgeMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockPurgeParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurge.t.Errorf("UserServiceMock.Purge got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurge.PurgeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmPurge.t.Errorf("UserServiceMock.Purge got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurge.PurgeMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurge.t.Errorf("UserServiceMock.Purge got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurge.PurgeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurge.PurgeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurge.t.Fatal("No results are set for the UserServiceMock.Purge")
+		}
+		return (*mm_results).err
+	}
+	if mmPurge.funcPurge != nil {
+		return mmPurge.funcPurge(ctx, id)
+	}
+	mmPurge.t.Fatalf("Unexpected call to UserServiceMock.Purge. %v %v", ctx, id)
+	return
+}
+
+// PurgeAfterCounter returns a count of finished UserServiceMock.Purge invocations
+func (mmPurge *UserServiceMock) PurgeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurge.afterPurgeCounter)
+}
+
+// PurgeBeforeCounter returns a count of UserServiceMock.Purge invocations
+func (mmPurge *UserServiceMock) PurgeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurge.beforePurgeCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.Purge.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurge *mUserServiceMockPurge) Calls() []*UserServiceMockPurgeParams {
+	mmPurge.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockPurgeParams, len(mmPurge.callArgs))
+	copy(argCopy, mmPurge.callArgs)
+
+	mmPurge.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeDone returns true if the count of the Purge invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockPurgeDone() bool {
+	if m.PurgeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeMock.invocationsDone()
+}
+
+// MinimockPurgeInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockPurgeInspect() {
+	for _, e := range m.PurgeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.Purge at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeCounter := mm_atomic.LoadUint64(&m.afterPurgeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeMock.defaultExpectation != nil && afterPurgeCounter < 1 {
+		if m.PurgeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.Purge at\n%s", m.PurgeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.Purge at\n%s with params: %#v", m.PurgeMock.defaultExpectation.expectationOrigins.origin, *m.PurgeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurge != nil && afterPurgeCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.Purge at\n%s", m.funcPurgeOrigin)
+	}
+
+	if !m.PurgeMock.invocationsDone() && afterPurgeCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.Purge at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeMock.expectedInvocations), m.PurgeMock.expectedInvocationsOrigin, afterPurgeCounter)
+	}
+}
+
+type mUserServiceMockPurgeExpired struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockPurgeExpiredExpectation
+	expectations       []*UserServiceMockPurgeExpiredExpectation
+
+	callArgs []*UserServiceMockPurgeExpiredParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockPurgeExpiredExpectation specifies expectation struct of the UserService.PurgeExpired
+type UserServiceMockPurgeExpiredExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockPurgeExpiredParams
+	paramPtrs          *UserServiceMockPurgeExpiredParamPtrs
+	expectationOrigins UserServiceMockPurgeExpiredExpectationOrigins
+	results            *UserServiceMockPurgeExpiredResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockPurgeExpiredParams contains parameters of the UserService.PurgeExpired
+type UserServiceMockPurgeExpiredParams struct {
+	ctx context.Context
+}
+
+// UserServiceMockPurgeExpiredParamPtrs contains pointers to parameters of the UserService.PurgeExpired
+type UserServiceMockPurgeExpiredParamPtrs struct {
+	ctx *context.Context
+}
+
+// UserServiceMockPurgeExpiredResults contains results of the UserService.PurgeExpired
+type UserServiceMockPurgeExpiredResults struct {
+	i1  int
+	err error
+}
+
+// UserServiceMockPurgeExpiredOrigins contains origins of expectations of the UserService.PurgeExpired
+type UserServiceMockPurgeExpiredExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeExpired *mUserServiceMockPurgeExpired) Optional() *mUserServiceMockPurgeExpired {
+	mmPurgeExpired.optional = true
+	return mmPurgeExpired
+}
+
+// Expect sets up expected params for UserService.PurgeExpired
+func (mmPurgeExpired *mUserServiceMockPurgeExpired) Expect(ctx context.Context) *mUserServiceMockPurgeExpired {
+	if mmPurgeExpired.mock.funcPurgeExpired != nil {
+		mmPurgeExpired.mock.t.Fatalf("UserServiceMock.PurgeExpired mock is already set by Set")
+	}
+
+	if mmPurgeExpired.defaultExpectation == nil {
+		mmPurgeExpired.defaultExpectation = &UserServiceMockPurgeExpiredExpectation{}
+	}
+
+	if mmPurgeExpired.defaultExpectation.paramPtrs != nil {
+		mmPurgeExpired.mock.t.Fatalf("UserServiceMock.PurgeExpired mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeExpired.defaultExpectation.params = &UserServiceMockPurgeExpiredParams{ctx}
+	mmPurgeExpired.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeExpired.expectations {
+		if minimock.Equal(e.params, mmPurgeExpired.defaultExpectation.params) {
+			mmPurgeExpired.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeExpired.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeExpired
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.PurgeExpired
+func (mmPurgeExpired *mUserServiceMockPurgeExpired) ExpectCtxParam1(ctx context.Context) *mUserServiceMockPurgeExpired {
+	if mmPurgeExpired.mock.funcPurgeExpired != nil {
+		mmPurgeExpired.mock.t.Fatalf("UserServiceMock.PurgeExpired mock is already set by Set")
+	}
+
+	if mmPurgeExpired.defaultExpectation == nil {
+		mmPurgeExpired.defaultExpectation = &UserServiceMockPurgeExpiredExpectation{}
+	}
+
+	if mmPurgeExpired.defaultExpectation.params != nil {
+		mmPurgeExpired.mock.t.Fatalf("UserServiceMock.PurgeExpired mock is already set by Expect")
+	}
+
+	if mmPurgeExpired.defaultExpectation.paramPtrs == nil {
+		mmPurgeExpired.defaultExpectation.paramPtrs = &UserServiceMockPurgeExpiredParamPtrs{}
+	}
+	mmPurgeExpired.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeExpired.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeExpired
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.PurgeExpired
+func (mmPurgeExpired *mUserServiceMockPurgeExpired) Inspect(f func(ctx context.Context)) *mUserServiceMockPurgeExpired {
+	if mmPurgeExpired.mock.inspectFuncPurgeExpired != nil {
+		mmPurgeExpired.mock.t.Fatalf("Inspect function is already set for UserServiceMock.PurgeExpired")
+	}
+
+	mmPurgeExpired.mock.inspectFuncPurgeExpired = f
+
+	return mmPurgeExpired
+}
+
+// Return sets up results that will be returned by UserService.PurgeExpired
+func (mmPurgeExpired *mUserServiceMockPurgeExpired) Return(i1 int, err error) *UserServiceMock {
+	if mmPurgeExpired.mock.funcPurgeExpired != nil {
+		mmPurgeExpired.mock.t.Fatalf("UserServiceMock.PurgeExpired mock is already set by Set")
+	}
+
+	if mmPurgeExpired.defaultExpectation == nil {
+		mmPurgeExpired.defaultExpectation = &UserServiceMockPurgeExpiredExpectation{mock: mmPurgeExpired.mock}
+	}
+	mmPurgeExpired.defaultExpectation.results = &UserServiceMockPurgeExpiredResults{i1, err}
+	mmPurgeExpired.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeExpired.mock
+}
+
+// Set uses given function f to mock the UserService.PurgeExpired method
+func (mmPurgeExpired *mUserServiceMockPurgeExpired) Set(f func(ctx context.Context) (i1 int, err error)) *UserServiceMock {
+	if mmPurgeExpired.defaultExpectation != nil {
+		mmPurgeExpired.mock.t.Fatalf("Default expectation is already set for the UserService.PurgeExpired method")
+	}
+
+	if len(mmPurgeExpired.expectations) > 0 {
+		mmPurgeExpired.mock.t.Fatalf("Some expectations are already set for the UserService.PurgeExpired method")
+	}
+
+	mmPurgeExpired.mock.funcPurgeExpired = f
+	mmPurgeExpired.mock.funcPurgeExpiredOrigin = minimock.CallerInfo(1)
+	return mmPurgeExpired.mock
+}
+
+// When sets expectation for the UserService.PurgeExpired which will trigger the result defined by the following
+// Then helper
+func (mmPurgeExpired *mUserServiceMockPurgeExpired) When(ctx context.Context) *UserServiceMockPurgeExpiredExpectation {
+	if mmPurgeExpired.mock.funcPurgeExpired != nil {
+		mmPurgeExpired.mock.t.Fatalf("UserServiceMock.PurgeExpired mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockPurgeExpiredExpectation{
+		mock:               mmPurgeExpired.mock,
+		params:             &UserServiceMockPurgeExpiredParams{ctx},
+		expectationOrigins: UserServiceMockPurgeExpiredExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeExpired.expectations = append(mmPurgeExpired.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.PurgeExpired return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockPurgeExpiredExpectation) Then(i1 int, err error) *UserServiceMock {
+	e.results = &UserServiceMockPurgeExpiredResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times UserService.PurgeExpired should be invoked
+func (mmPurgeExpired *mUserServiceMockPurgeExpired) Times(n uint64) *mUserServiceMockPurgeExpired {
+	if n == 0 {
+		mmPurgeExpired.mock.t.Fatalf("Times of UserServiceMock.PurgeExpired mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeExpired.expectedInvocations, n)
+	mmPurgeExpired.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeExpired
+}
+
+func (mmPurgeExpired *mUserServiceMockPurgeExpired) invocationsDone() bool {
+	if len(mmPurgeExpired.expectations) == 0 && mmPurgeExpired.defaultExpectation == nil && mmPurgeExpired.mock.funcPurgeExpired == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeExpired.mock.afterPurgeExpiredCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeExpired.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeExpired implements mm_service.UserService
+func (mmPurgeExpired *UserServiceMock) PurgeExpired(ctx context.Context) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmPurgeExpired.beforePurgeExpiredCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeExpired.afterPurgeExpiredCounter, 1)
+
+	mmPurgeExpired.t.Helper()
+
+	if mmPurgeExpired.inspectFuncPurgeExpired != nil {
+		mmPurgeExpired.inspectFuncPurgeExpired(ctx)
+	}
+
+	mm_params := UserServiceMockPurgeExpiredParams{ctx}
+
+	// Record call args
+	mmPurgeExpired.PurgeExpiredMock.mutex.Lock()
+	mmPurgeExpired.PurgeExpiredMock.callArgs = append(mmPurgeExpired.PurgeExpiredMock.callArgs, &mm_params)
+	mmPurgeExpired.PurgeExpiredMock.mutex.Unlock()
+
+	for _, e := range mmPurgeExpired.PurgeExpiredMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurgeExpired.PurgeExpiredMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeExpired.PurgeExpiredMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeExpired.PurgeExpiredMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeExpired.PurgeExpiredMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockPurgeExpiredParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeExpired.t.Errorf("UserServiceMock.PurgeExpired got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeExpired.PurgeExpiredMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeExpired.t.Errorf("UserServiceMock.PurgeExpired got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeExpired.PurgeExpiredMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeExpired.PurgeExpiredMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeExpired.t.Fatal("No results are set for the UserServiceMock.PurgeExpired")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurgeExpired.funcPurgeExpired != nil {
+		return mmPurgeExpired.funcPurgeExpired(ctx)
+	}
+	mmPurgeExpired.t.Fatalf("Unexpected call to UserServiceMock.PurgeExpired. %v", ctx)
+	return
+}
+
+// PurgeExpiredAfterCounter returns a count of finished UserServiceMock.PurgeExpired invocations
+func (mmPurgeExpired *UserServiceMock) PurgeExpiredAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeExpired.afterPurgeExpiredCounter)
+}
+
+// PurgeExpiredBeforeCounter returns a count of UserServiceMock.PurgeExpired invocations
+func (mmPurgeExpired *UserServiceMock) PurgeExpiredBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeExpired.beforePurgeExpiredCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.PurgeExpired.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeExpired *mUserServiceMockPurgeExpired) Calls() []*UserServiceMockPurgeExpiredParams {
+	mmPurgeExpired.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockPurgeExpiredParams, len(mmPurgeExpired.callArgs))
+	copy(argCopy, mmPurgeExpired.callArgs)
+
+	mmPurgeExpired.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeExpiredDone returns true if the count of the PurgeExpired invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockPurgeExpiredDone() bool {
+	if m.PurgeExpiredMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeExpiredMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeExpiredMock.invocationsDone()
+}
+
+// MinimockPurgeExpiredInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockPurgeExpiredInspect() {
+	for _, e := range m.PurgeExpiredMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.PurgeExpired at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeExpiredCounter := mm_atomic.LoadUint64(&m.afterPurgeExpiredCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeExpiredMock.defaultExpectation != nil && afterPurgeExpiredCounter < 1 {
+		if m.PurgeExpiredMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.PurgeExpired at\n%s", m.PurgeExpiredMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.PurgeExpired at\n%s with params: %#v", m.PurgeExpiredMock.defaultExpectation.expectationOrigins.origin, *m.PurgeExpiredMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeExpired != nil && afterPurgeExpiredCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.PurgeExpired at\n%s", m.funcPurgeExpiredOrigin)
+	}
+
+	if !m.PurgeExpiredMock.invocationsDone() && afterPurgeExpiredCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.PurgeExpired at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeExpiredMock.expectedInvocations), m.PurgeExpiredMock.expectedInvocationsOrigin, afterPurgeExpiredCounter)
+	}
+}
+
+type mUserServiceMockRestore struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockRestoreExpectation
+	expectations       []*UserServiceMockRestoreExpectation
+
+	callArgs []*UserServiceMockRestoreParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockRestoreExpectation specifies expectation struct of the UserService.Restore
+type UserServiceMockRestoreExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockRestoreParams
+	paramPtrs          *UserServiceMockRestoreParamPtrs
+	expectationOrigins UserServiceMockRestoreExpectationOrigins
+	results            *UserServiceMockRestoreResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockRestoreParams contains parameters of the UserService.Restore
+type UserServiceMockRestoreParams struct {
+	ctx context.Context
+	id  string
+}
+
+// UserServiceMockRestoreParamPtrs contains pointers to parameters of the UserService.Restore
+type UserServiceMockRestoreParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// UserServiceMockRestoreResults contains results of the UserService.Restore
+type UserServiceMockRestoreResults struct {
+	err error
+}
+
+// UserServiceMockRestoreOrigins contains origins of expectations of the UserService.Restore
+type UserServiceMockRestoreExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRestore *mUserServiceMockRestore) Optional() *mUserServiceMockRestore {
+	mmRestore.optional = true
+	return mmRestore
+}
+
+// Expect sets up expected params for UserService.Restore
+func (mmRestore *mUserServiceMockRestore) Expect(ctx context.Context, id string) *mUserServiceMockRestore {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("UserServiceMock.Restore mock is already set by Set")
+	}
+
+	if mmRestore.defaultExpectation == nil {
+		mmRestore.defaultExpectation = &UserServiceMockRestoreExpectation{}
+	}
+
+	if mmRestore.defaultExpectation.paramPtrs != nil {
+		mmRestore.mock.t.Fatalf("UserServiceMock.Restore mock is already set by ExpectParams functions")
+	}
+
+	mmRestore.defaultExpectation.params = &UserServiceMockRestoreParams{ctx, id}
+	mmRestore.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRestore.expectations {
+		if minimock.Equal(e.params, mmRestore.defaultExpectation.params) {
+			mmRestore.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRestore.defaultExpectation.params)
+		}
+	}
+
+	return mmRestore
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.Restore
+func (mmRestore *mUserServiceMockRestore) ExpectCtxParam1(ctx context.Context) *mUserServiceMockRestore {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("UserServiceMock.Restore mock is already set by Set")
+	}
+
+	if mmRestore.defaultExpectation == nil {
+		mmRestore.defaultExpectation = &UserServiceMockRestoreExpectation{}
+	}
+
+	if mmRestore.defaultExpectation.params != nil {
+		mmRestore.mock.t.Fatalf("UserServiceMock.Restore mock is already set by Expect")
+	}
+
+	if mmRestore.defaultExpectation.paramPtrs == nil {
+		mmRestore.defaultExpectation.paramPtrs = &UserServiceMockRestoreParamPtrs{}
+	}
+	mmRestore.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRestore.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRestore
+}
+
+// ExpectIdParam2 sets up expected param id for UserService.Restore
+func (mmRestore *mUserServiceMockRestore) ExpectIdParam2(id string) *mUserServiceMockRestore {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("UserServiceMock.Restore mock is already set by Set")
+	}
+
+	if mmRestore.defaultExpectation == nil {
+		mmRestore.defaultExpectation = &UserServiceMockRestoreExpectation{}
+	}
+
+	if mmRestore.defaultExpectation.params != nil {
+		mmRestore.mock.t.Fatalf("UserServiceMock.Restore mock is already set by Expect")
+	}
+
+	if mmRestore.defaultExpectation.paramPtrs == nil {
+		mmRestore.defaultExpectation.paramPtrs = &UserServiceMockRestoreParamPtrs{}
+	}
+	mmRestore.defaultExpectation.paramPtrs.id = &id
+	mmRestore.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmRestore
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.Restore
+func (mmRestore *mUserServiceMockRestore) Inspect(f func(ctx context.Context, id string)) *mUserServiceMockRestore {
+	if mmRestore.mock.inspectFuncRestore != nil {
+		mmRestore.mock.t.Fatalf("Inspect function is already set for UserServiceMock.Restore")
+	}
+
+	mmRestore.mock.inspectFuncRestore = f
+
+	return mmRestore
+}
+
+// Return sets up results that will be returned by UserService.Restore
+func (mmRestore *mUserServiceMockRestore) Return(err error) *UserServiceMock {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("UserServiceMock.Restore mock is already set by Set")
+	}
+
+	if mmRestore.defaultExpectation == nil {
+		mmRestore.defaultExpectation = &UserServiceMockRestoreExpectation{mock: mmRestore.mock}
+	}
+	mmRestore.defaultExpectation.results = &UserServiceMockRestoreResults{err}
+	mmRestore.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRestore.mock
+}
+
+// Set uses given function f to mock the UserService.Restore method
+func (mmRestore *mUserServiceMockRestore) Set(f func(ctx context.Context, id string) (err error)) *UserServiceMock {
+	if mmRestore.defaultExpectation != nil {
+		mmRestore.mock.t.Fatalf("Default expectation is already set for the UserService.Restore method")
+	}
+
+	if len(mmRestore.expectations) > 0 {
+		mmRestore.mock.t.Fatalf("Some expectations are already set for the UserService.Restore method")
+	}
+
+	mmRestore.mock.funcRestore = f
+	mmRestore.mock.funcRestoreOrigin = minimock.CallerInfo(1)
+	return mmRestore.mock
+}
+
+// When sets expectation for the UserService.Restore which will trigger the result defined by the following
+// Then helper
+func (mmRestore *mUserServiceMockRestore) When(ctx context.Context, id string) *UserServiceMockRestoreExpectation {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("UserServiceMock.Restore mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockRestoreExpectation{
+		mock:               mmRestore.mock,
+		params:             &UserServiceMockRestoreParams{ctx, id},
+		expectationOrigins: UserServiceMockRestoreExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRestore.expectations = append(mmRestore.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.Restore return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockRestoreExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockRestoreResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserService.Restore should be invoked
+func (mmRestore *mUserServiceMockRestore) Times(n uint64) *mUserServiceMockRestore {
+	if n == 0 {
+		mmRestore.mock.t.Fatalf("Times of UserServiceMock.Restore mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRestore.expectedInvocations, n)
+	mmRestore.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRestore
+}
+
+func (mmRestore *mUserServiceMockRestore) invocationsDone() bool {
+	if len(mmRestore.expectations) == 0 && mmRestore.defaultExpectation == nil && mmRestore.mock.funcRestore == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRestore.mock.afterRestoreCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRestore.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Restore implements mm_service.UserService
+func (mmRestore *UserServiceMock) Restore(ctx context.Context, id string) (err error) {
+	mm_atomic.AddUint64(&mmRestore.beforeRestoreCounter, 1)
+	defer mm_atomic.AddUint64(&mmRestore.afterRestoreCounter, 1)
+
+	mmRestore.t.Helper()
+
+	if mmRestore.inspectFuncRestore != nil {
+		mmRestore.inspectFuncRestore(ctx, id)
+	}
+
+	mm_params := UserServiceMockRestoreParams{ctx, id}
+
+	// Record call args
+	mmRestore.RestoreMock.mutex.Lock()
+	mmRestore.RestoreMock.callArgs = append(mmRestore.RestoreMock.callArgs, &mm_params)
+	mmRestore.RestoreMock.mutex.Unlock()
+
+	for _, e := range mmRestore.RestoreMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRestore.RestoreMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRestore.RestoreMock.defaultExpectation.Counter, 1)
+		mm_want := mmRestore.RestoreMock.defaultExpectation.params
+		mm_want_ptrs := mmRestore.RestoreMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockRestoreParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRestore.t.Errorf("UserServiceMock.Restore got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestore.RestoreMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRestore.t.Errorf("UserServiceMock.Restore got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestore.RestoreMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRestore.t.Errorf("UserServiceMock.Restore got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRestore.RestoreMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRestore.RestoreMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRestore.t.Fatal("No results are set for the UserServiceMock.Restore")
+		}
+		return (*mm_results).err
+	}
+	if mmRestore.funcRestore != nil {
+		return mmRestore.funcRestore(ctx, id)
+	}
+	mmRestore.t.Fatalf("Unexpected call to UserServiceMock.Restore. %v %v", ctx, id)
+	return
+}
+
+// RestoreAfterCounter returns a count of finished UserServiceMock.Restore invocations
+func (mmRestore *UserServiceMock) RestoreAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestore.afterRestoreCounter)
+}
+
+// RestoreBeforeCounter returns a count of UserServiceMock.Restore invocations
+func (mmRestore *UserServiceMock) RestoreBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestore.beforeRestoreCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.Restore.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRestore *mUserServiceMockRestore) Calls() []*UserServiceMockRestoreParams {
+	mmRestore.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockRestoreParams, len(mmRestore.callArgs))
+	copy(argCopy, mmRestore.callArgs)
+
+	mmRestore.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRestoreDone returns true if the count of the Restore invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockRestoreDone() bool {
+	if m.RestoreMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RestoreMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RestoreMock.invocationsDone()
+}
+
+// MinimockRestoreInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockRestoreInspect() {
+	for _, e := range m.RestoreMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.Restore at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRestoreCounter := mm_atomic.LoadUint64(&m.afterRestoreCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RestoreMock.defaultExpectation != nil && afterRestoreCounter < 1 {
+		if m.RestoreMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.Restore at\n%s", m.RestoreMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.Restore at\n%s with params: %#v", m.RestoreMock.defaultExpectation.expectationOrigins.origin, *m.RestoreMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRestore != nil && afterRestoreCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.Restore at\n%s", m.funcRestoreOrigin)
+	}
+
+	if !m.RestoreMock.invocationsDone() && afterRestoreCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.Restore at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RestoreMock.expectedInvocations), m.RestoreMock.expectedInvocationsOrigin, afterRestoreCounter)
+	}
+}
+
+type mUserServiceMockSendVerificationEmail struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockSendVerificationEmailExpectation
+	expectations       []*UserServiceMockSendVerificationEmailExpectation
+
+	callArgs []*UserServiceMockSendVerificationEmailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockSendVerificationEmailExpectation specifies expectation struct of the UserService.SendVerificationEmail
+type UserServiceMockSendVerificationEmailExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockSendVerificationEmailParams
+	paramPtrs          *UserServiceMockSendVerificationEmailParamPtrs
+	expectationOrigins UserServiceMockSendVerificationEmailExpectationOrigins
+	results            *UserServiceMockSendVerificationEmailResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockSendVerificationEmailParams contains parameters of the UserService.SendVerificationEmail
+type UserServiceMockSendVerificationEmailParams struct {
+	ctx   context.Context
+	email string
+}
+
+// UserServiceMockSendVerificationEmailParamPtrs contains pointers to parameters of the UserService.SendVerificationEmail
+type UserServiceMockSendVerificationEmailParamPtrs struct {
+	ctx   *context.Context
+	email *string
+}
+
+// UserServiceMockSendVerificationEmailResults contains results of the UserService.SendVerificationEmail
+type UserServiceMockSendVerificationEmailResults struct {
+	err error
+}
+
+// UserServiceMockSendVerificationEmailOrigins contains origins of expectations of the UserService.SendVerificationEmail
+type UserServiceMockSendVerificationEmailExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEmail string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSendVerificationEmail *mUserServiceMockSendVerificationEmail) Optional() *mUserServiceMockSendVerificationEmail {
+	mmSendVerificationEmail.optional = true
+	return mmSendVerificationEmail
+}
+
+// Expect sets up expected params for UserService.SendVerificationEmail
+func (mmSendVerificationEmail *mUserServiceMockSendVerificationEmail) Expect(ctx context.Context, email string) *mUserServiceMockSendVerificationEmail {
+	if mmSendVerificationEmail.mock.funcSendVerificationEmail != nil {
+		mmSendVerificationEmail.mock.t.Fatalf("UserServiceMock.SendVerificationEmail mock is already set by Set")
+	}
+
+	if mmSendVerificationEmail.defaultExpectation == nil {
+		mmSendVerificationEmail.defaultExpectation = &UserServiceMockSendVerificationEmailExpectation{}
+	}
+
+	if mmSendVerificationEmail.defaultExpectation.paramPtrs != nil {
+		mmSendVerificationEmail.mock.t.Fatalf("UserServiceMock.SendVerificationEmail mock is already set by ExpectParams functions")
+	}
+
+	mmSendVerificationEmail.defaultExpectation.params = &UserServiceMockSendVerificationEmailParams{ctx, email}
+	mmSendVerificationEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSendVerificationEmail.expectations {
+		if minimock.Equal(e.params, mmSendVerificationEmail.defaultExpectation.params) {
+			mmSendVerificationEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendVerificationEmail.defaultExpectation.params)
+		}
+	}
+
+	return mmSendVerificationEmail
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.SendVerificationEmail
+func (mmSendVerificationEmail *mUserServiceMockSendVerificationEmail) ExpectCtxParam1(ctx context.Context) *mUserServiceMockSendVerificationEmail {
+	if mmSendVerificationEmail.mock.funcSendVerificationEmail != nil {
+		mmSendVerificationEmail.mock.t.Fatalf("UserServiceMock.SendVerificationEmail mock is already set by Set")
+	}
+
+	if mmSendVerificationEmail.defaultExpectation == nil {
+		mmSendVerificationEmail.defaultExpectation = &UserServiceMockSendVerificationEmailExpectation{}
+	}
+
+	if mmSendVerificationEmail.defaultExpectation.params != nil {
+		mmSendVerificationEmail.mock.t.Fatalf("UserServiceMock.SendVerificationEmail mock is already set by Expect")
+	}
+
+	if mmSendVerificationEmail.defaultExpectation.paramPtrs == nil {
+		mmSendVerificationEmail.defaultExpectation.paramPtrs = &UserServiceMockSendVerificationEmailParamPtrs{}
+	}
+	mmSendVerificationEmail.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSendVerificationEmail.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSendVerificationEmail
+}
+
+// ExpectEmailParam2 sets up expected param email for UserService.SendVerificationEmail
+func (mmSendVerificationEmail *mUserServiceMockSendVerificationEmail) ExpectEmailParam2(email string) *mUserServiceMockSendVerificationEmail {
+	if mmSendVerificationEmail.mock.funcSendVerificationEmail != nil {
+		mmSendVerificationEmail.mock.t.Fatalf("UserServiceMock.SendVerificationEmail mock is already set by Set")
+	}
+
+	if mmSendVerificationEmail.defaultExpectation == nil {
+		mmSendVerificationEmail.defaultExpectation = &UserServiceMockSendVerificationEmailExpectation{}
+	}
+
+	if mmSendVerificationEmail.defaultExpectation.params != nil {
+		mmSendVerificationEmail.mock.t.Fatalf("UserServiceMock.SendVerificationEmail mock is already set by Expect")
+	}
+
+	if mmSendVerificationEmail.defaultExpectation.paramPtrs == nil {
+		mmSendVerificationEmail.defaultExpectation.paramPtrs = &UserServiceMockSendVerificationEmailParamPtrs{}
+	}
+	mmSendVerificationEmail.defaultExpectation.paramPtrs.email = &email
+	mmSendVerificationEmail.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmSendVerificationEmail
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.SendVerificationEmail
+func (mmSendVerificationEmail *mUserServiceMockSendVerificationEmail) Inspect(f func(ctx context.Context, email string)) *mUserServiceMockSendVerificationEmail {
+	if mmSendVerificationEmail.mock.inspectFuncSendVerificationEmail != nil {
+		mmSendVerificationEmail.mock.t.Fatalf("Inspect function is already set for UserServiceMock.SendVerificationEmail")
+	}
+
+	mmSendVerificationEmail.mock.inspectFuncSendVerificationEmail = f
+
+	return mmSendVerificationEmail
+}
+
+// Return sets up results that will be returned by UserService.SendVerificationEmail
+func (mmSendVerificationEmail *mUserServiceMockSendVerificationEmail) Return(err error) *UserServiceMock {
+	if mmSendVerificationEmail.mock.funcSendVerificationEmail != nil {
+		mmSendVerificationEmail.mock.t.Fatalf("UserServiceMock.SendVerificationEmail mock is already set by Set")
+	}
+
+	if mmSendVerificationEmail.defaultExpectation == nil {
+		mmSendVerificationEmail.defaultExpectation = &UserServiceMockSendVerificationEmailExpectation{mock: mmSendVerificationEmail.mock}
+	}
+	mmSendVerificationEmail.defaultExpectation.results = &UserServiceMockSendVerificationEmailResults{err}
+	mmSendVerificationEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSendVerificationEmail.mock
+}
+
+// Set uses given function f to mock the UserService.SendVerificationEmail method
+func (mmSendVerificationEmail *mUserServiceMockSendVerificationEmail) Set(f func(ctx context.Context, email string) (err error)) *UserServiceMock {
+	if mmSendVerificationEmail.defaultExpectation != nil {
+		mmSendVerificationEmail.mock.t.Fatalf("Default expectation is already set for the UserService.SendVerificationEmail method")
+	}
+
+	if len(mmSendVerificationEmail.expectations) > 0 {
+		mmSendVerificationEmail.mock.t.Fatalf("Some expectations are already set for the UserService.SendVerificationEmail method")
+	}
+
+	mmSendVerificationEmail.mock.funcSendVerificationEmail = f
+	mmSendVerificationEmail.mock.funcSendVerificationEmailOrigin = minimock.CallerInfo(1)
+	return mmSendVerificationEmail.mock
+}
+
+// When sets expectation for the UserService.SendVerificationEmail which will trigger the result defined by the following
+// Then helper
+func (mmSendVerificationEmail *mUserServiceMockSendVerificationEmail) When(ctx context.Context, email string) *UserServiceMockSendVerificationEmailExpectation {
+	if mmSendVerificationEmail.mock.funcSendVerificationEmail != nil {
+		mmSendVerificationEmail.mock.t.Fatalf("UserServiceMock.SendVerificationEmail mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockSendVerificationEmailExpectation{
+		mock:               mmSendVerificationEmail.mock,
+		params:             &UserServiceMockSendVerificationEmailParams{ctx, email},
+		expectationOrigins: UserServiceMockSendVerificationEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSendVerificationEmail.expectations = append(mmSendVerificationEmail.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.SendVerificationEmail return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockSendVerificationEmailExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockSendVerificationEmailResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserService.SendVerificationEmail should be invoked
+func (mmSendVerificationEmail *mUserServiceMockSendVerificationEmail) Times(n uint64) *mUserServiceMockSendVerificationEmail {
+	if n == 0 {
+		mmSendVerificationEmail.mock.t.Fatalf("Times of UserServiceMock.SendVerificationEmail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSendVerificationEmail.expectedInvocations, n)
+	mmSendVerificationEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSendVerificationEmail
+}
+
+func (mmSendVerificationEmail *mUserServiceMockSendVerificationEmail) invocationsDone() bool {
+	if len(mmSendVerificationEmail.expectations) == 0 && mmSendVerificationEmail.defaultExpectation == nil && mmSendVerificationEmail.mock.funcSendVerificationEmail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSendVerificationEmail.mock.afterSendVerificationEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSendVerificationEmail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SendVerificationEmail implements mm_service.UserService
+func (mmSendVerificationEmail *UserServiceMock) SendVerificationEmail(ctx context.Context, email string) (err error) {
+	mm_atomic.AddUint64(&mmSendVerificationEmail.beforeSendVerificationEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmSendVerificationEmail.afterSendVerificationEmailCounter, 1)
+
+	mmSendVerificationEmail.t.Helper()
+
+	if mmSendVerificationEmail.inspectFuncSendVerificationEmail != nil {
+		mmSendVerificationEmail.inspectFuncSendVerificationEmail(ctx, email)
+	}
+
+	mm_params := UserServiceMockSendVerificationEmailParams{ctx, email}
+
+	// Record call args
+	mmSendVerificationEmail.SendVerificationEmailMock.mutex.Lock()
+	mmSendVerificationEmail.SendVerificationEmailMock.callArgs = append(mmSendVerificationEmail.SendVerificationEmailMock.callArgs, &mm_params)
+	mmSendVerificationEmail.SendVerificationEmailMock.mutex.Unlock()
+
+	for _, e := range mmSendVerificationEmail.SendVerificationEmailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSendVerificationEmail.SendVerificationEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSendVerificationEmail.SendVerificationEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmSendVerificationEmail.SendVerificationEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmSendVerificationEmail.SendVerificationEmailMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockSendVerificationEmailParams{ctx, email}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSendVerificationEmail.t.Errorf("UserServiceMock.SendVerificationEmail got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendVerificationEmail.SendVerificationEmailMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmSendVerificationEmail.t.Errorf("UserServiceMock.SendVerificationEmail got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendVerificationEmail.SendVerificationEmailMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSendVerificationEmail.t.Errorf("UserServiceMock.SendVerificationEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSendVerificationEmail.SendVerificationEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSendVerificationEmail.SendVerificationEmailMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSendVerificationEmail.t.Fatal("No results are set for the UserServiceMock.SendVerificationEmail")
+		}
+		return (*mm_results).err
+	}
+	if mmSendVerificationEmail.funcSendVerificationEmail != nil {
+		return mmSendVerificationEmail.funcSendVerificationEmail(ctx, email)
+	}
+	mmSendVerificationEmail.t.Fatalf("Unexpected call to UserServiceMock.SendVerificationEmail. %v %v", ctx, email)
+	return
+}
+
+// SendVerificationEmailAfterCounter returns a count of finished UserServiceMock.SendVerificationEmail invocations
+func (mmSendVerificationEmail *UserServiceMock) SendVerificationEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendVerificationEmail.afterSendVerificationEmailCounter)
+}
+
+// SendVerificationEmailBeforeCounter returns a count of UserServiceMock.SendVerificationEmail invocations
+func (mmSendVerificationEmail *UserServiceMock) SendVerificationEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendVerificationEmail.beforeSendVerificationEmailCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.SendVerificationEmail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSendVerificationEmail *mUserServiceMockSendVerificationEmail) Calls() []*UserServiceMockSendVerificationEmailParams {
+	mmSendVerificationEmail.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockSendVerificationEmailParams, len(mmSendVerificationEmail.callArgs))
+	copy(argCopy, mmSendVerificationEmail.callArgs)
+
+	mmSendVerificationEmail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendVerificationEmailDone returns true if the count of the SendVerificationEmail invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockSendVerificationEmailDone() bool {
+	if m.SendVerificationEmailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendVerificationEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendVerificationEmailMock.invocationsDone()
+}
+
+// MinimockSendVerificationEmailInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockSendVerificationEmailInspect() {
+	for _, e := range m.SendVerificationEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.SendVerificationEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSendVerificationEmailCounter := mm_atomic.LoadUint64(&m.afterSendVerificationEmailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendVerificationEmailMock.defaultExpectation != nil && afterSendVerificationEmailCounter < 1 {
+		if m.SendVerificationEmailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.SendVerificationEmail at\n%s", m.SendVerificationEmailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.SendVerificationEmail at\n%s with params: %#v", m.SendVerificationEmailMock.defaultExpectation.expectationOrigins.origin, *m.SendVerificationEmailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSendVerificationEmail != nil && afterSendVerificationEmailCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.SendVerificationEmail at\n%s", m.funcSendVerificationEmailOrigin)
+	}
+
+	if !m.SendVerificationEmailMock.invocationsDone() && afterSendVerificationEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.SendVerificationEmail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SendVerificationEmailMock.expectedInvocations), m.SendVerificationEmailMock.expectedInvocationsOrigin, afterSendVerificationEmailCounter)
+	}
+}
+
+type mUserServiceMockSuspend struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockSuspendExpectation
+	expectations       []*UserServiceMockSuspendExpectation
+
+	callArgs []*UserServiceMockSuspendParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockSuspendExpectation specifies expectation struct of the UserService.Suspend
+type UserServiceMockSuspendExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockSuspendParams
+	paramPtrs          *UserServiceMockSuspendParamPtrs
+	expectationOrigins UserServiceMockSuspendExpectationOrigins
+	results            *UserServiceMockSuspendResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockSuspendParams contains parameters of the UserService.Suspend
+type UserServiceMockSuspendParams struct {
+	ctx   context.Context
+	id    string
+	until *time.Time
+}
+
+// UserServiceMockSuspendParamPtrs contains pointers to parameters of the UserService.Suspend
+type UserServiceMockSuspendParamPtrs struct {
+	ctx   *context.Context
+	id    *string
+	until **time.Time
+}
+
+// UserServiceMockSuspendResults contains results of the UserService.Suspend
+type UserServiceMockSuspendResults struct {
+	err error
+}
+
+// UserServiceMockSuspendOrigins contains origins of expectations of the UserService.Suspend
+type UserServiceMockSuspendExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originId    string
+	originUntil string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSuspend *mUserServiceMockSuspend) Optional() *mUserServiceMockSuspend {
+	mmSuspend.optional = true
+	return mmSuspend
+}
+
+// Expect sets up expected params for UserService.Suspend
+func (mmSuspend *mUserServiceMockSuspend) Expect(ctx context.Context, id string, until *time.Time) *mUserServiceMockSuspend {
+	if mmSuspend.mock.funcSuspend != nil {
+		mmSuspend.mock.t.Fatalf("UserServiceMock.Suspend mock is already set by Set")
+	}
+
+	if mmSuspend.defaultExpectation == nil {
+		mmSuspend.defaultExpectation = &UserServiceMockSuspendExpectation{}
+	}
+
+	if mmSuspend.defaultExpectation.paramPtrs != nil {
+		mmSuspend.mock.t.Fatalf("UserServiceMock.Suspend mock is already set by ExpectParams functions")
+	}
+
+	mmSuspend.defaultExpectation.params = &UserServiceMockSuspendParams{ctx, id, until}
+	mmSuspend.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSuspend.expectations {
+		if minimock.Equal(e.params, mmSuspend.defaultExpectation.params) {
+			mmSuspend.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSuspend.defaultExpectation.params)
+		}
+	}
+
+	return mmSuspend
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.Suspend
+func (mmSuspend *mUserServiceMockSuspend) ExpectCtxParam1(ctx context.Context) *mUserServiceMockSuspend {
+	if mmSuspend.mock.funcSuspend != nil {
+		mmSuspend.mock.t.Fatalf("UserServiceMock.Suspend mock is already set by Set")
+	}
+
+	if mmSuspend.defaultExpectation == nil {
+		mmSuspend.defaultExpectation = &UserServiceMockSuspendExpectation{}
+	}
+
+	if mmSuspend.defaultExpectation.params != nil {
+		mmSuspend.mock.t.Fatalf("UserServiceMock.Suspend mock is already set by Expect")
+	}
+
+	if mmSuspend.defaultExpectation.paramPtrs == nil {
+		mmSuspend.defaultExpectation.paramPtrs = &UserServiceMockSuspendParamPtrs{}
+	}
+	mmSuspend.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSuspend.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSuspend
+}
+
+// ExpectIdParam2 sets up expected param id for UserService.Suspend
+func (mmSuspend *mUserServiceMockSuspend) ExpectIdParam2(id string) *mUserServiceMockSuspend {
+	if mmSuspend.mock.funcSuspend != nil {
+		mmSuspend.mock.t.Fatalf("UserServiceMock.Suspend mock is already set by Set")
+	}
+
+	if mmSuspend.defaultExpectation == nil {
+		mmSuspend.defaultExpectation = &UserServiceMockSuspendExpectation{}
+	}
+
+	if mmSuspend.defaultExpectation.params != nil {
+		mmSuspend.mock.t.Fatalf("UserServiceMock.Suspend mock is already set by Expect")
+	}
+
+	if mmSuspend.defaultExpectation.paramPtrs == nil {
+		mmSuspend.defaultExpectation.paramPtrs = &UserServiceMockSuspendParamPtrs{}
+	}
+	mmSuspend.defaultExpectation.paramPtrs.id = &id
+	mmSuspend.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmSuspend
+}
+
+// ExpectUntilParam3 sets up expected param until for UserService.Suspend
+func (mmSuspend *mUserServiceMockSuspend) ExpectUntilParam3(until *time.Time) *mUserServiceMockSuspend {
+	if mmSuspend.mock.funcSuspend != nil {
+		mmSuspend.mock.t.Fatalf("UserServiceMock.Suspend mock is already set by Set")
+	}
+
+	if mmSuspend.defaultExpectation == nil {
+		mmSuspend.defaultExpectation = &UserServiceMockSuspendExpectation{}
+	}
+
+	if mmSuspend.defaultExpectation.params != nil {
+		mmSuspend.mock.t.Fatalf("UserServiceMock.Suspend mock is already set by Expect")
+	}
+
+	if mmSuspend.defaultExpectation.paramPtrs == nil {
+		mmSuspend.defaultExpectation.paramPtrs = &UserServiceMockSuspendParamPtrs{}
+	}
+	mmSuspend.defaultExpectation.paramPtrs.until = &until
+	mmSuspend.defaultExpectation.expectationOrigins.originUntil = minimock.CallerInfo(1)
+
+	return mmSuspend
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.Suspend
+func (mmSuspend *mUserServiceMockSuspend) Inspect(f func(ctx context.Context, id string, until *time.Time)) *mUserServiceMockSuspend {
+	if mmSuspend.mock.inspectFuncSuspend != nil {
+		mmSuspend.mock.t.Fatalf("Inspect function is already set for UserServiceMock.Suspend")
+	}
+
+	mmSuspend.mock.inspectFuncSuspend = f
+
+	return mmSuspend
+}
+
+// Return sets up results that will be returned by UserService.Suspend
+func (mmSuspend *mUserServiceMockSuspend) Return(err error) *UserServiceMock {
+	if mmSuspend.mock.funcSuspend != nil {
+		mmSuspend.mock.t.Fatalf("UserServiceMock.Suspend mock is already set by Set")
+	}
+
+	if mmSuspend.defaultExpectation == nil {
+		mmSuspend.defaultExpectation = &UserServiceMockSuspendExpectation{mock: mmSuspend.mock}
+	}
+	mmSuspend.defaultExpectation.results = &UserServiceMockSuspendResults{err}
+	mmSuspend.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSuspend.mock
+}
+
+// Set uses given function f to mock the UserService.Suspend method
+func (mmSuspend *mUserServiceMockSuspend) Set(f func(ctx context.Context, id string, until *time.Time) (err error)) *UserServiceMock {
+	if mmSuspend.defaultExpectation != nil {
+		mmSuspend.mock.t.Fatalf("Default expectation is already set for the UserService.Suspend method")
+	}
+
+	if len(mmSuspend.expectations) > 0 {
+		mmSuspend.mock.t.Fatalf("Some expectations are already set for the UserService.Suspend method")
+	}
+
+	mmSuspend.mock.funcSuspend = f
+	mmSuspend.mock.funcSuspendOrigin = minimock.CallerInfo(1)
+	return mmSuspend.mock
+}
+
+// When sets expectation for the UserService.Suspend which will trigger the result defined by the following
+// Then helper
+func (mmSuspend *mUserServiceMockSuspend) When(ctx context.Context, id string, until *time.Time) *UserServiceMockSuspendExpectation {
+	if mmSuspend.mock.funcSuspend != nil {
+		mmSuspend.mock.t.Fatalf("UserServiceMock.Suspend mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockSuspendExpectation{
+		mock:               mmSuspend.mock,
+		params:             &UserServiceMockSuspendParams{ctx, id, until},
+		expectationOrigins: UserServiceMockSuspendExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSuspend.expectations = append(mmSuspend.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.Suspend return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockSuspendExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockSuspendResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserService.Suspend should be invoked
+func (mmSuspend *mUserServiceMockSuspend) Times(n uint64) *mUserServiceMockSuspend {
+	if n == 0 {
+		mmSuspend.mock.t.Fatalf("Times of UserServiceMock.Suspend mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSuspend.expectedInvocations, n)
+	mmSuspend.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSuspend
+}
+
+func (mmSuspend *mUserServiceMockSuspend) invocationsDone() bool {
+	if len(mmSuspend.expectations) == 0 && mmSuspend.defaultExpectation == nil && mmSuspend.mock.funcSuspend == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSuspend.mock.afterSuspendCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSuspend.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Suspend implements mm_service.UserService
+func (mmSuspend *UserServiceMock) Suspend(ctx context.Context, id string, until *time.Time) (err error) {
+	mm_atomic.AddUint64(&mmSuspend.beforeSuspendCounter, 1)
+	defer mm_atomic.AddUint64(&mmSuspend.afterSuspendCounter, 1)
+
+	mmSuspend.t.Helper()
+
+	if mmSuspend.inspectFuncSuspend != nil {
+		mmSuspend.inspectFuncSuspend(ctx, id, until)
+	}
+
+	mm_params := UserServiceMockSuspendParams{ctx, id, until}
+
+	// Record call args
+	mmSuspend.SuspendMock.mutex.Lock()
+	mmSuspend.SuspendMock.callArgs = append(mmSuspend.SuspendMock.callArgs, &mm_params)
+	mmSuspend.SuspendMock.mutex.Unlock()
+
+	for _, e := range mmSuspend.SuspendMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSuspend.SuspendMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSuspend.SuspendMock.defaultExpectation.Counter, 1)
+		mm_want := mmSuspend.SuspendMock.defaultExpectation.params
+		mm_want_ptrs := mmSuspend.SuspendMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockSuspendParams{ctx, id, until}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSuspend.t.Errorf("UserServiceMock.Suspend got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSuspend.SuspendMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmSuspend.t.Errorf("UserServiceMock.Suspend got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSuspend.SuspendMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.until != nil && !minimock.Equal(*mm_want_ptrs.until, mm_got.until) {
+				mmSuspend.t.Errorf("UserServiceMock.Suspend got unexpected parameter until, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSuspend.SuspendMock.defaultExpectation.expectationOrigins.originUntil, *mm_want_ptrs.until, mm_got.until, minimock.Diff(*mm_want_ptrs.until, mm_got.until))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSuspend.t.Errorf("UserServiceMock.Suspend got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSuspend.SuspendMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSuspend.SuspendMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSuspend.t.Fatal("No results are set for the UserServiceMock.Suspend")
+		}
+		return (*mm_results).err
+	}
+	if mmSuspend.funcSuspend != nil {
+		return mmSuspend.funcSuspend(ctx, id, until)
+	}
+	mmSuspend.t.Fatalf("Unexpected call to UserServiceMock.Suspend. %v %v %v", ctx, id, until)
+	return
+}
+
+// SuspendAfterCounter returns a count of finished UserServiceMock.Suspend invocations
+func (mmSuspend *UserServiceMock) SuspendAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSuspend.afterSuspendCounter)
+}
+
+// SuspendBeforeCounter returns a count of UserServiceMock.Suspend invocations
+func (mmSuspend *UserServiceMock) SuspendBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSuspend.beforeSuspendCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.Suspend.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSuspend *mUserServiceMockSuspend) Calls() []*UserServiceMockSuspendParams {
+	mmSuspend.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockSuspendParams, len(mmSuspend.callArgs))
+	copy(argCopy, mmSuspend.callArgs)
+
+	mmSuspend.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSuspendDone returns true if the count of the Suspend invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockSuspendDone() bool {
+	if m.SuspendMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SuspendMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SuspendMock.invocationsDone()
+}
+
+// MinimockSuspendInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockSuspendInspect() {
+	for _, e := range m.SuspendMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.Suspend at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSuspendCounter := mm_atomic.LoadUint64(&m.afterSuspendCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SuspendMock.defaultExpectation != nil && afterSuspendCounter < 1 {
+		if m.SuspendMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.Suspend at\n%s", m.SuspendMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.Suspend at\n%s with params: %#v", m.SuspendMock.defaultExpectation.expectationOrigins.origin, *m.SuspendMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSuspend != nil && afterSuspendCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.Suspend at\n%s", m.funcSuspendOrigin)
+	}
+
+	if !m.SuspendMock.invocationsDone() && afterSuspendCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.Suspend at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SuspendMock.expectedInvocations), m.SuspendMock.expectedInvocationsOrigin, afterSuspendCounter)
+	}
+}
+
+type mUserServiceMockUpdate struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockUpdateExpectation
+	expectations       []*UserServiceMockUpdateExpectation
+
+	callArgs []*UserServiceMockUpdateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockUpdateExpectation specifies expectation struct of the UserService.Update
+type UserServiceMockUpdateExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockUpdateParams
+	paramPtrs          *UserServiceMockUpdateParamPtrs
+	expectationOrigins UserServiceMockUpdateExpectationOrigins
+	results            *UserServiceMockUpdateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockUpdateParams contains parameters of the UserService.Update
+type UserServiceMockUpdateParams struct {
+	ctx  context.Context
+	user *model.UserUpdate
+}
+
+// UserServiceMockUpdateParamPtrs contains pointers to parameters of the UserService.Update
+type UserServiceMockUpdateParamPtrs struct {
+	ctx  *context.Context
+	user **model.UserUpdate
+}
+
+// UserServiceMockUpdateResults contains results of the UserService.Update
+type UserServiceMockUpdateResults struct {
+	err error
+}
+
+// UserServiceMockUpdateOrigins contains origins of expectations of the UserService.Update
+type UserServiceMockUpdateExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originUser string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdate *mUserServiceMockUpdate) Optional() *mUserServiceMockUpdate {
+	mmUpdate.optional = true
+	return mmUpdate
+}
+
+// Expect sets up expected params for UserService.Update
+func (mmUpdate *mUserServiceMockUpdate) Expect(ctx context.Context, user *model.UserUpdate) *mUserServiceMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("UserServiceMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &UserServiceMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs != nil {
+		mmUpdate.mock.t.Fatalf("UserServiceMock.Update mock is already set by ExpectParams functions")
+	}
+
+	mmUpdate.defaultExpectation.params = &UserServiceMockUpdateParams{ctx, user}
+	mmUpdate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.Update
+func (mmUpdate *mUserServiceMockUpdate) ExpectCtxParam1(ctx context.Context) *mUserServiceMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("UserServiceMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &UserServiceMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("UserServiceMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &UserServiceMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// ExpectUserParam2 sets up expected param user for UserService.Update
+func (mmUpdate *mUserServiceMockUpdate) ExpectUserParam2(user *model.UserUpdate) *mUserServiceMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("UserServiceMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &UserServiceMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("UserServiceMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &UserServiceMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.user = &user
+	mmUpdate.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.Update
 func (mmUpdate *mUserServiceMockUpdate) Inspect(f func(ctx context.Context, user *model.UserUpdate)) *mUserServiceMockUpdate {
 	if mmUpdate.mock.inspectFuncUpdate != nil {
 		mmUpdate.mock.t.Fatalf("Inspect function is already set for UserServiceMock.Update")
@@ -2176,6 +4653,348 @@ func (m *UserServiceMock) MinimockUpdateInspect() {
 	}
 }
 
+type mUserServiceMockVerifyEmail struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockVerifyEmailExpectation
+	expectations       []*UserServiceMockVerifyEmailExpectation
+
+	callArgs []*UserServiceMockVerifyEmailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockVerifyEmailExpectation specifies expectation struct of the UserService.VerifyEmail
+type UserServiceMockVerifyEmailExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockVerifyEmailParams
+	paramPtrs          *UserServiceMockVerifyEmailParamPtrs
+	expectationOrigins UserServiceMockVerifyEmailExpectationOrigins
+	results            *UserServiceMockVerifyEmailResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockVerifyEmailParams contains parameters of the UserService.VerifyEmail
+type UserServiceMockVerifyEmailParams struct {
+	ctx   context.Context
+	token string
+}
+
+// UserServiceMockVerifyEmailParamPtrs contains pointers to parameters of the UserService.VerifyEmail
+type UserServiceMockVerifyEmailParamPtrs struct {
+	ctx   *context.Context
+	token *string
+}
+
+// UserServiceMockVerifyEmailResults contains results of the UserService.VerifyEmail
+type UserServiceMockVerifyEmailResults struct {
+	err error
+}
+
+// UserServiceMockVerifyEmailOrigins contains origins of expectations of the UserService.VerifyEmail
+type UserServiceMockVerifyEmailExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmVerifyEmail *mUserServiceMockVerifyEmail) Optional() *mUserServiceMockVerifyEmail {
+	mmVerifyEmail.optional = true
+	return mmVerifyEmail
+}
+
+// Expect sets up expected params for UserService.VerifyEmail
+func (mmVerifyEmail *mUserServiceMockVerifyEmail) Expect(ctx context.Context, token string) *mUserServiceMockVerifyEmail {
+	if mmVerifyEmail.mock.funcVerifyEmail != nil {
+		mmVerifyEmail.mock.t.Fatalf("UserServiceMock.VerifyEmail mock is already set by Set")
+	}
+
+	if mmVerifyEmail.defaultExpectation == nil {
+		mmVerifyEmail.defaultExpectation = &UserServiceMockVerifyEmailExpectation{}
+	}
+
+	if mmVerifyEmail.defaultExpectation.paramPtrs != nil {
+		mmVerifyEmail.mock.t.Fatalf("UserServiceMock.VerifyEmail mock is already set by ExpectParams functions")
+	}
+
+	mmVerifyEmail.defaultExpectation.params = &UserServiceMockVerifyEmailParams{ctx, token}
+	mmVerifyEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmVerifyEmail.expectations {
+		if minimock.Equal(e.params, mmVerifyEmail.defaultExpectation.params) {
+			mmVerifyEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmVerifyEmail.defaultExpectation.params)
+		}
+	}
+
+	return mmVerifyEmail
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.VerifyEmail
+func (mmVerifyEmail *mUserServiceMockVerifyEmail) ExpectCtxParam1(ctx context.Context) *mUserServiceMockVerifyEmail {
+	if mmVerifyEmail.mock.funcVerifyEmail != nil {
+		mmVerifyEmail.mock.t.Fatalf("UserServiceMock.VerifyEmail mock is already set by Set")
+	}
+
+	if mmVerifyEmail.defaultExpectation == nil {
+		mmVerifyEmail.defaultExpectation = &UserServiceMockVerifyEmailExpectation{}
+	}
+
+	if mmVerifyEmail.defaultExpectation.params != nil {
+		mmVerifyEmail.mock.t.Fatalf("UserServiceMock.VerifyEmail mock is already set by Expect")
+	}
+
+	if mmVerifyEmail.defaultExpectation.paramPtrs == nil {
+		mmVerifyEmail.defaultExpectation.paramPtrs = &UserServiceMockVerifyEmailParamPtrs{}
+	}
+	mmVerifyEmail.defaultExpectation.paramPtrs.ctx = &ctx
+	mmVerifyEmail.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmVerifyEmail
+}
+
+// ExpectTokenParam2 sets up expected param token for UserService.VerifyEmail
+func (mmVerifyEmail *mUserServiceMockVerifyEmail) ExpectTokenParam2(token string) *mUserServiceMockVerifyEmail {
+	if mmVerifyEmail.mock.funcVerifyEmail != nil {
+		mmVerifyEmail.mock.t.Fatalf("UserServiceMock.VerifyEmail mock is already set by Set")
+	}
+
+	if mmVerifyEmail.defaultExpectation == nil {
+		mmVerifyEmail.defaultExpectation = &UserServiceMockVerifyEmailExpectation{}
+	}
+
+	if mmVerifyEmail.defaultExpectation.params != nil {
+		mmVerifyEmail.mock.t.Fatalf("UserServiceMock.VerifyEmail mock is already set by Expect")
+	}
+
+	if mmVerifyEmail.defaultExpectation.paramPtrs == nil {
+		mmVerifyEmail.defaultExpectation.paramPtrs = &UserServiceMockVerifyEmailParamPtrs{}
+	}
+	mmVerifyEmail.defaultExpectation.paramPtrs.token = &token
+	mmVerifyEmail.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmVerifyEmail
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.VerifyEmail
+func (mmVerifyEmail *mUserServiceMockVerifyEmail) Inspect(f func(ctx context.Context, token string)) *mUserServiceMockVerifyEmail {
+	if mmVerifyEmail.mock.inspectFuncVerifyEmail != nil {
+		mmVerifyEmail.mock.t.Fatalf("Inspect function is already set for UserServiceMock.VerifyEmail")
+	}
+
+	mmVerifyEmail.mock.inspectFuncVerifyEmail = f
+
+	return mmVerifyEmail
+}
+
+// Return sets up results that will be returned by UserService.VerifyEmail
+func (mmVerifyEmail *mUserServiceMockVerifyEmail) Return(err error) *UserServiceMock {
+	if mmVerifyEmail.mock.funcVerifyEmail != nil {
+		mmVerifyEmail.mock.t.Fatalf("UserServiceMock.VerifyEmail mock is already set by Set")
+	}
+
+	if mmVerifyEmail.defaultExpectation == nil {
+		mmVerifyEmail.defaultExpectation = &UserServiceMockVerifyEmailExpectation{mock: mmVerifyEmail.mock}
+	}
+	mmVerifyEmail.defaultExpectation.results = &UserServiceMockVerifyEmailResults{err}
+	mmVerifyEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmVerifyEmail.mock
+}
+
+// Set uses given function f to mock the UserService.VerifyEmail method
+func (mmVerifyEmail *mUserServiceMockVerifyEmail) Set(f func(ctx context.Context, token string) (err error)) *UserServiceMock {
+	if mmVerifyEmail.defaultExpectation != nil {
+		mmVerifyEmail.mock.t.Fatalf("Default expectation is already set for the UserService.VerifyEmail method")
+	}
+
+	if len(mmVerifyEmail.expectations) > 0 {
+		mmVerifyEmail.mock.t.Fatalf("Some expectations are already set for the UserService.VerifyEmail method")
+	}
+
+	mmVerifyEmail.mock.funcVerifyEmail = f
+	mmVerifyEmail.mock.funcVerifyEmailOrigin = minimock.CallerInfo(1)
+	return mmVerifyEmail.mock
+}
+
+// When sets expectation for the UserService.VerifyEmail which will trigger the result defined by the following
+// Then helper
+func (mmVerifyEmail *mUserServiceMockVerifyEmail) When(ctx context.Context, token string) *UserServiceMockVerifyEmailExpectation {
+	if mmVerifyEmail.mock.funcVerifyEmail != nil {
+		mmVerifyEmail.mock.t.Fatalf("UserServiceMock.VerifyEmail mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockVerifyEmailExpectation{
+		mock:               mmVerifyEmail.mock,
+		params:             &UserServiceMockVerifyEmailParams{ctx, token},
+		expectationOrigins: UserServiceMockVerifyEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmVerifyEmail.expectations = append(mmVerifyEmail.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.VerifyEmail return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockVerifyEmailExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockVerifyEmailResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserService.VerifyEmail should be invoked
+func (mmVerifyEmail *mUserServiceMockVerifyEmail) Times(n uint64) *mUserServiceMockVerifyEmail {
+	if n == 0 {
+		mmVerifyEmail.mock.t.Fatalf("Times of UserServiceMock.VerifyEmail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmVerifyEmail.expectedInvocations, n)
+	mmVerifyEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmVerifyEmail
+}
+
+func (mmVerifyEmail *mUserServiceMockVerifyEmail) invocationsDone() bool {
+	if len(mmVerifyEmail.expectations) == 0 && mmVerifyEmail.defaultExpectation == nil && mmVerifyEmail.mock.funcVerifyEmail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmVerifyEmail.mock.afterVerifyEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmVerifyEmail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// VerifyEmail implements mm_service.UserService
+func (mmVerifyEmail *UserServiceMock) VerifyEmail(ctx context.Context, token string) (err error) {
+	mm_atomic.AddUint64(&mmVerifyEmail.beforeVerifyEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmVerifyEmail.afterVerifyEmailCounter, 1)
+
+	mmVerifyEmail.t.Helper()
+
+	if mmVerifyEmail.inspectFuncVerifyEmail != nil {
+		mmVerifyEmail.inspectFuncVerifyEmail(ctx, token)
+	}
+
+	mm_params := UserServiceMockVerifyEmailParams{ctx, token}
+
+	// Record call args
+	mmVerifyEmail.VerifyEmailMock.mutex.Lock()
+	mmVerifyEmail.VerifyEmailMock.callArgs = append(mmVerifyEmail.VerifyEmailMock.callArgs, &mm_params)
+	mmVerifyEmail.VerifyEmailMock.mutex.Unlock()
+
+	for _, e := range mmVerifyEmail.VerifyEmailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmVerifyEmail.VerifyEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmVerifyEmail.VerifyEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmVerifyEmail.VerifyEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmVerifyEmail.VerifyEmailMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockVerifyEmailParams{ctx, token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmVerifyEmail.t.Errorf("UserServiceMock.VerifyEmail got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVerifyEmail.VerifyEmailMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmVerifyEmail.t.Errorf("UserServiceMock.VerifyEmail got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVerifyEmail.VerifyEmailMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmVerifyEmail.t.Errorf("UserServiceMock.VerifyEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmVerifyEmail.VerifyEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmVerifyEmail.VerifyEmailMock.defaultExpectation.results
+		if mm_results == nil {
+			mmVerifyEmail.t.Fatal("No results are set for the UserServiceMock.VerifyEmail")
+		}
+		return (*mm_results).err
+	}
+	if mmVerifyEmail.funcVerifyEmail != nil {
+		return mmVerifyEmail.funcVerifyEmail(ctx, token)
+	}
+	mmVerifyEmail.t.Fatalf("Unexpected call to UserServiceMock.VerifyEmail. %v %v", ctx, token)
+	return
+}
+
+// VerifyEmailAfterCounter returns a count of finished UserServiceMock.VerifyEmail invocations
+func (mmVerifyEmail *UserServiceMock) VerifyEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerifyEmail.afterVerifyEmailCounter)
+}
+
+// VerifyEmailBeforeCounter returns a count of UserServiceMock.VerifyEmail invocations
+func (mmVerifyEmail *UserServiceMock) VerifyEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerifyEmail.beforeVerifyEmailCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.VerifyEmail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmVerifyEmail *mUserServiceMockVerifyEmail) Calls() []*UserServiceMockVerifyEmailParams {
+	mmVerifyEmail.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockVerifyEmailParams, len(mmVerifyEmail.callArgs))
+	copy(argCopy, mmVerifyEmail.callArgs)
+
+	mmVerifyEmail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockVerifyEmailDone returns true if the count of the VerifyEmail invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockVerifyEmailDone() bool {
+	if m.VerifyEmailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.VerifyEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.VerifyEmailMock.invocationsDone()
+}
+
+// MinimockVerifyEmailInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockVerifyEmailInspect() {
+	for _, e := range m.VerifyEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.VerifyEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterVerifyEmailCounter := mm_atomic.LoadUint64(&m.afterVerifyEmailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.VerifyEmailMock.defaultExpectation != nil && afterVerifyEmailCounter < 1 {
+		if m.VerifyEmailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.VerifyEmail at\n%s", m.VerifyEmailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.VerifyEmail at\n%s with params: %#v", m.VerifyEmailMock.defaultExpectation.expectationOrigins.origin, *m.VerifyEmailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcVerifyEmail != nil && afterVerifyEmailCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.VerifyEmail at\n%s", m.funcVerifyEmailOrigin)
+	}
+
+	if !m.VerifyEmailMock.invocationsDone() && afterVerifyEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.VerifyEmail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.VerifyEmailMock.expectedInvocations), m.VerifyEmailMock.expectedInvocationsOrigin, afterVerifyEmailCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *UserServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
@@ -2184,13 +5003,29 @@ func (m *UserServiceMock) MinimockFinish() {
 
 			m.MinimockCreateInspect()
 
+			m.MinimockDeactivateInspect()
+
 			m.MinimockDeleteInspect()
 
 			m.MinimockEnsureAdminExistsInspect()
 
 			m.MinimockGetInspect()
 
+			m.MinimockListUsersInspect()
+
+			m.MinimockPurgeInspect()
+
+			m.MinimockPurgeExpiredInspect()
+
+			m.MinimockRestoreInspect()
+
+			m.MinimockSendVerificationEmailInspect()
+
+			m.MinimockSuspendInspect()
+
 			m.MinimockUpdateInspect()
+
+			m.MinimockVerifyEmailInspect()
 		}
 	})
 }
@@ -2216,8 +5051,16 @@ func (m *UserServiceMock) minimockDone() bool {
 	return done &&
 		m.MinimockChangePasswordDone() &&
 		m.MinimockCreateDone() &&
+		m.MinimockDeactivateDone() &&
 		m.MinimockDeleteDone() &&
 		m.MinimockEnsureAdminExistsDone() &&
 		m.MinimockGetDone() &&
-		m.MinimockUpdateDone()
+		m.MinimockListUsersDone() &&
+		m.MinimockPurgeDone() &&
+		m.MinimockPurgeExpiredDone() &&
+		m.MinimockRestoreDone() &&
+		m.MinimockSendVerificationEmailDone() &&
+		m.MinimockSuspendDone() &&
+		m.MinimockUpdateDone() &&
+		m.MinimockVerifyEmailDone()
 }
@@ -0,0 +1,97 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/8thgencore/microservice-common/pkg/logger/sl"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/notifier"
+)
+
+// Notification errors
+var (
+	ErrNotificationFailed = errors.New("failed to queue notification")
+)
+
+// Notify renders a message from the template and puts it into the outbox.
+// Called within a transaction, the message is only sent once the transaction commits.
+func (s *notificationService) Notify(ctx context.Context, recipient, template, locale string, data any) error {
+	subject, body, err := s.templates.Render(template, locale, data)
+	if err != nil {
+		s.logger.Error("failed to render notification", slog.String("template", template), sl.Err(err))
+		return ErrNotificationFailed
+	}
+
+	err = s.notificationRepository.Create(ctx, &model.Notification{
+		Recipient: recipient,
+		Subject:   subject,
+		Body:      body,
+	})
+	if err != nil {
+		s.logger.Error("failed to queue notification", sl.Err(err))
+		return ErrNotificationFailed
+	}
+
+	return nil
+}
+
+// DispatchPending sends the messages of the outbox that are due until none is left.
+// A failed message is retried with an exponential backoff until it runs out of attempts.
+func (s *notificationService) DispatchPending(ctx context.Context) error {
+	for {
+		notifications, err := s.notificationRepository.ClaimDue(
+			ctx,
+			s.config.BatchSize,
+			s.config.MaxAttempts,
+			s.config.RetryBackoff,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, notification := range notifications {
+			s.dispatch(ctx, notification)
+		}
+
+		if len(notifications) < s.config.BatchSize {
+			return nil
+		}
+	}
+}
+
+func (s *notificationService) dispatch(ctx context.Context, notification *model.Notification) {
+	err := s.sender.Send(ctx, &notifier.Message{
+		To:      notification.Recipient,
+		Subject: notification.Subject,
+		Body:    notification.Body,
+	})
+	if err == nil {
+		if err = s.notificationRepository.MarkSent(ctx, notification.ID); err != nil {
+			// The message stays claimed and may be sent once more when the lease runs out.
+			s.logger.Error("failed to mark notification as sent", slog.String("id", notification.ID), sl.Err(err))
+		}
+		return
+	}
+
+	if notification.Attempts >= s.config.MaxAttempts {
+		s.logger.Error("giving up on notification",
+			slog.String("id", notification.ID),
+			slog.Int("attempts", notification.Attempts),
+			sl.Err(err),
+		)
+	} else {
+		s.logger.Warn("failed to send notification",
+			slog.String("id", notification.ID),
+			slog.Int("attempts", notification.Attempts),
+			sl.Err(err),
+		)
+	}
+
+	retryIn := s.config.RetryBackoff << (notification.Attempts - 1)
+	if err = s.notificationRepository.MarkFailed(ctx, notification.ID, retryIn, err.Error()); err != nil {
+		s.logger.Error("failed to mark notification as failed", slog.String("id", notification.ID), sl.Err(err))
+	}
+}
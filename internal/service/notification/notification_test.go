@@ -0,0 +1,250 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/8thgencore/microservice-auth/internal/config"
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/notifier"
+	notifierMocks "github.com/8thgencore/microservice-auth/internal/notifier/mocks"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	repositoryMocks "github.com/8thgencore/microservice-auth/internal/repository/mocks"
+	loggerMocks "github.com/8thgencore/microservice-common/pkg/logger/mocks"
+)
+
+var (
+	recipient = "user@example.com"
+
+	notifierConfig = &config.NotifierConfig{
+		DefaultLocale: "en",
+		BatchSize:     2,
+		MaxAttempts:   3,
+		RetryBackoff:  30 * time.Second,
+	}
+
+	resetData = notifier.PasswordResetData{
+		Name:             "username",
+		Link:             "http://localhost/reset-password?token=reset_token",
+		ExpiresInMinutes: 30,
+	}
+)
+
+type (
+	notificationRepositoryMockFunc func(mc *minimock.Controller) repository.NotificationRepository
+	senderMockFunc                 func(mc *minimock.Controller) notifier.Sender
+)
+
+func newTemplates(t *testing.T) *notifier.Templates {
+	t.Helper()
+
+	templates, err := notifier.NewTemplates(notifierConfig.DefaultLocale)
+	require.NoError(t, err)
+
+	return templates
+}
+
+func TestNotify(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+	)
+
+	tests := []struct {
+		name                       string
+		template                   string
+		locale                     string
+		err                        error
+		notificationRepositoryMock notificationRepositoryMockFunc
+	}{
+		{
+			name:     "success case",
+			template: notifier.PasswordResetTemplate,
+			locale:   "en-US",
+			err:      nil,
+			notificationRepositoryMock: func(mc *minimock.Controller) repository.NotificationRepository {
+				mock := repositoryMocks.NewNotificationRepositoryMock(mc)
+				mock.CreateMock.Set(func(_ context.Context, notification *model.Notification) error {
+					require.Equal(mc, recipient, notification.Recipient)
+					require.Equal(mc, "Reset your password", notification.Subject)
+					require.Contains(mc, notification.Body, resetData.Link)
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name:     "localized case",
+			template: notifier.PasswordResetTemplate,
+			locale:   "ru_RU",
+			err:      nil,
+			notificationRepositoryMock: func(mc *minimock.Controller) repository.NotificationRepository {
+				mock := repositoryMocks.NewNotificationRepositoryMock(mc)
+				mock.CreateMock.Set(func(_ context.Context, notification *model.Notification) error {
+					require.Equal(mc, "Сброс пароля", notification.Subject)
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name:     "unknown template case",
+			template: "unknown",
+			err:      ErrNotificationFailed,
+			notificationRepositoryMock: func(mc *minimock.Controller) repository.NotificationRepository {
+				return repositoryMocks.NewNotificationRepositoryMock(mc)
+			},
+		},
+		{
+			name:     "repository error case",
+			template: notifier.PasswordResetTemplate,
+			err:      ErrNotificationFailed,
+			notificationRepositoryMock: func(mc *minimock.Controller) repository.NotificationRepository {
+				mock := repositoryMocks.NewNotificationRepositoryMock(mc)
+				mock.CreateMock.Return(errors.New("db error"))
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := NewService(
+				loggerMocks.NewMockLogger(),
+				tt.notificationRepositoryMock(mc),
+				nil,
+				newTemplates(t),
+				notifierConfig,
+			)
+
+			err := srv.Notify(ctx, recipient, tt.template, tt.locale, resetData)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestDispatchPending(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		first  = &model.Notification{ID: "first_uuid", Recipient: recipient, Subject: "subject", Body: "body", Attempts: 1}
+		second = &model.Notification{ID: "second_uuid", Recipient: recipient, Subject: "subject", Body: "body", Attempts: 2}
+		last   = &model.Notification{ID: "last_uuid", Recipient: recipient, Subject: "subject", Body: "body", Attempts: 3}
+	)
+
+	tests := []struct {
+		name                       string
+		err                        error
+		notificationRepositoryMock notificationRepositoryMockFunc
+		senderMock                 senderMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			notificationRepositoryMock: func(mc *minimock.Controller) repository.NotificationRepository {
+				mock := repositoryMocks.NewNotificationRepositoryMock(mc)
+				mock.ClaimDueMock.
+					Expect(ctx, notifierConfig.BatchSize, notifierConfig.MaxAttempts, notifierConfig.RetryBackoff).
+					Return([]*model.Notification{first}, nil)
+				mock.MarkSentMock.Expect(ctx, first.ID).Return(nil)
+				return mock
+			},
+			senderMock: func(mc *minimock.Controller) notifier.Sender {
+				mock := notifierMocks.NewSenderMock(mc)
+				mock.SendMock.Expect(ctx, &notifier.Message{To: recipient, Subject: "subject", Body: "body"}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "full batch case",
+			err:  nil,
+			notificationRepositoryMock: func(mc *minimock.Controller) repository.NotificationRepository {
+				mock := repositoryMocks.NewNotificationRepositoryMock(mc)
+				mock.ClaimDueMock.Set(func(_ context.Context, _, _ int, _ time.Duration) ([]*model.Notification, error) {
+					if mock.ClaimDueBeforeCounter() == 1 {
+						return []*model.Notification{first, second}, nil
+					}
+					return nil, nil
+				})
+				mock.MarkSentMock.Return(nil)
+				return mock
+			},
+			senderMock: func(mc *minimock.Controller) notifier.Sender {
+				mock := notifierMocks.NewSenderMock(mc)
+				mock.SendMock.Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "send error case",
+			err:  nil,
+			notificationRepositoryMock: func(mc *minimock.Controller) repository.NotificationRepository {
+				mock := repositoryMocks.NewNotificationRepositoryMock(mc)
+				mock.ClaimDueMock.Set(func(_ context.Context, _, _ int, _ time.Duration) ([]*model.Notification, error) {
+					if mock.ClaimDueBeforeCounter() == 1 {
+						return []*model.Notification{second, last}, nil
+					}
+					return nil, nil
+				})
+				mock.MarkFailedMock.Set(func(_ context.Context, id string, retryIn time.Duration, lastError string) error {
+					switch id {
+					case second.ID:
+						require.Equal(mc, 2*notifierConfig.RetryBackoff, retryIn)
+					case last.ID:
+						require.Equal(mc, 4*notifierConfig.RetryBackoff, retryIn)
+					}
+					require.True(mc, strings.Contains(lastError, "connection refused"))
+					return nil
+				})
+				return mock
+			},
+			senderMock: func(mc *minimock.Controller) notifier.Sender {
+				mock := notifierMocks.NewSenderMock(mc)
+				mock.SendMock.Return(errors.New("connection refused"))
+				return mock
+			},
+		},
+		{
+			name: "claim error case",
+			err:  errors.New("db error"),
+			notificationRepositoryMock: func(mc *minimock.Controller) repository.NotificationRepository {
+				mock := repositoryMocks.NewNotificationRepositoryMock(mc)
+				mock.ClaimDueMock.Return(nil, errors.New("db error"))
+				return mock
+			},
+			senderMock: func(mc *minimock.Controller) notifier.Sender {
+				return notifierMocks.NewSenderMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := NewService(
+				loggerMocks.NewMockLogger(),
+				tt.notificationRepositoryMock(mc),
+				tt.senderMock(mc),
+				newTemplates(t),
+				notifierConfig,
+			)
+
+			err := srv.DispatchPending(ctx)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
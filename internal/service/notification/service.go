@@ -0,0 +1,35 @@
+package notification
+
+import (
+	"log/slog"
+
+	"github.com/8thgencore/microservice-auth/internal/config"
+	"github.com/8thgencore/microservice-auth/internal/notifier"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	"github.com/8thgencore/microservice-auth/internal/service"
+)
+
+type notificationService struct {
+	logger                 *slog.Logger
+	notificationRepository repository.NotificationRepository
+	sender                 notifier.Sender
+	templates              *notifier.Templates
+	config                 *config.NotifierConfig
+}
+
+// NewService creates new object of service layer.
+func NewService(
+	logger *slog.Logger,
+	notificationRepository repository.NotificationRepository,
+	sender notifier.Sender,
+	templates *notifier.Templates,
+	config *config.NotifierConfig,
+) service.NotificationService {
+	return &notificationService{
+		logger:                 logger,
+		notificationRepository: notificationRepository,
+		sender:                 sender,
+		templates:              templates,
+		config:                 config,
+	}
+}
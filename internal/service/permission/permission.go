@@ -0,0 +1,249 @@
+package permission
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/8thgencore/microservice-auth/internal/audit"
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-common/pkg/logger/sl"
+)
+
+// Errors
+var (
+	ErrFailedToReadPermissions = errors.New("failed to read permissions")
+	ErrPermissionExists        = errors.New("permission already exists")
+	ErrPermissionNotFound      = errors.New("permission not found")
+	ErrUnknownRole             = errors.New("unknown role")
+	ErrPermissionCreate        = errors.New("failed to create permission")
+	ErrPermissionRead          = errors.New("failed to read permission")
+	ErrPermissionUpdate        = errors.New("failed to update permission")
+	ErrPermissionDelete        = errors.New("failed to delete permission")
+)
+
+// Create creates a new permission granted to its roles.
+func (s *permissionService) Create(ctx context.Context, permission *model.Permission) error {
+	permission.Endpoints = compact(permission.Endpoints)
+	permission.Roles = compact(permission.Roles)
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if errTx := s.permissionRepository.Create(ctx, permission); errTx != nil {
+			return errTx
+		}
+
+		changes := audit.Changes{}.
+			Add("description", nil, permission.Description).
+			Add("endpoints", nil, permission.Endpoints).
+			Add("roles", nil, permission.Roles)
+
+		return s.recordAudit(ctx, model.AuditActionPermissionCreated, permission.Name, changes)
+	})
+	if err != nil {
+		if errors.Is(err, ErrPermissionExists) {
+			return ErrPermissionExists
+		}
+		if errors.Is(err, ErrUnknownRole) {
+			return ErrUnknownRole
+		}
+
+		s.logger.Error("failed to create permission", sl.Err(err))
+		return ErrPermissionCreate
+	}
+
+	s.reloadMappings(ctx)
+
+	return nil
+}
+
+// Get returns a permission by its name.
+func (s *permissionService) Get(ctx context.Context, name string) (*model.Permission, error) {
+	permission, err := s.permissionRepository.Get(ctx, name)
+	if err != nil {
+		if errors.Is(err, ErrPermissionNotFound) {
+			return nil, ErrPermissionNotFound
+		}
+
+		s.logger.Error("failed to read permission", sl.Err(err))
+		return nil, ErrPermissionRead
+	}
+
+	return permission, nil
+}
+
+// List returns every permission, ordered by name.
+func (s *permissionService) List(ctx context.Context) ([]*model.Permission, error) {
+	permissions, err := s.permissionRepository.List(ctx)
+	if err != nil {
+		s.logger.Error("failed to list permissions", sl.Err(err))
+		return nil, ErrPermissionRead
+	}
+
+	return permissions, nil
+}
+
+// Update updates the description of a permission and replaces its endpoints and roles.
+func (s *permissionService) Update(ctx context.Context, update *model.PermissionUpdate) error {
+	if update.Endpoints != nil {
+		endpoints := compact(*update.Endpoints)
+		update.Endpoints = &endpoints
+	}
+	if update.Roles != nil {
+		roles := compact(*update.Roles)
+		update.Roles = &roles
+	}
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		current, errTx := s.permissionRepository.Get(ctx, update.Name)
+		if errTx != nil {
+			return errTx
+		}
+
+		if errTx = s.permissionRepository.Update(ctx, update); errTx != nil {
+			return errTx
+		}
+
+		changes := audit.Changes{}
+		if update.Description != nil {
+			changes.Add("description", current.Description, *update.Description)
+		}
+		if update.Endpoints != nil {
+			changes.Add("endpoints", current.Endpoints, *update.Endpoints)
+		}
+		if update.Roles != nil {
+			changes.Add("roles", current.Roles, *update.Roles)
+		}
+
+		return s.recordAudit(ctx, model.AuditActionPermissionUpdated, update.Name, changes)
+	})
+	if err != nil {
+		if errors.Is(err, ErrPermissionNotFound) {
+			return ErrPermissionNotFound
+		}
+		if errors.Is(err, ErrUnknownRole) {
+			return ErrUnknownRole
+		}
+
+		s.logger.Error("failed to update permission", sl.Err(err))
+		return ErrPermissionUpdate
+	}
+
+	s.reloadMappings(ctx)
+
+	return nil
+}
+
+// Delete deletes a permission, the roles granted it lose it.
+func (s *permissionService) Delete(ctx context.Context, name string) error {
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		current, errTx := s.permissionRepository.Get(ctx, name)
+		if errTx != nil {
+			return errTx
+		}
+
+		if errTx = s.permissionRepository.Delete(ctx, name); errTx != nil {
+			return errTx
+		}
+
+		changes := audit.Changes{}.
+			Add("description", current.Description, nil).
+			Add("endpoints", current.Endpoints, nil).
+			Add("roles", current.Roles, nil)
+
+		return s.recordAudit(ctx, model.AuditActionPermissionDeleted, name, changes)
+	})
+	if err != nil {
+		if errors.Is(err, ErrPermissionNotFound) {
+			return ErrPermissionNotFound
+		}
+
+		s.logger.Error("failed to delete permission", sl.Err(err))
+		return ErrPermissionDelete
+	}
+
+	s.reloadMappings(ctx)
+
+	return nil
+}
+
+// RolePermissions returns the permissions granted to the roles and to every role they inherit, ordered by name.
+func (s *permissionService) RolePermissions(roles []string) []string {
+	effectiveRoles := s.roleService.EffectiveRoles(roles)
+
+	s.mappingsMutex.RLock()
+	defer s.mappingsMutex.RUnlock()
+
+	var permissions []string
+	for _, role := range effectiveRoles {
+		permissions = append(permissions, s.grants[role]...)
+	}
+
+	return compact(permissions)
+}
+
+// EndpointPermissions returns the permissions granting access to the endpoint, ordered by name.
+func (s *permissionService) EndpointPermissions(endpoint string) []string {
+	s.mappingsMutex.RLock()
+	defer s.mappingsMutex.RUnlock()
+
+	return slices.Clone(s.endpoints[endpoint])
+}
+
+// loadMappings reads the permissions and maps them by role and by endpoint.
+func (s *permissionService) loadMappings(ctx context.Context) error {
+	permissions, err := s.permissionRepository.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	grants := make(map[string][]string)
+	endpoints := make(map[string][]string)
+	for _, permission := range permissions {
+		for _, role := range permission.Roles {
+			grants[role] = append(grants[role], permission.Name)
+		}
+		for _, endpoint := range permission.Endpoints {
+			endpoints[endpoint] = append(endpoints[endpoint], permission.Name)
+		}
+	}
+
+	s.mappingsMutex.Lock()
+	defer s.mappingsMutex.Unlock()
+
+	s.grants = grants
+	s.endpoints = endpoints
+
+	return nil
+}
+
+// reloadMappings reads the permissions again after a change. The change is already
+// committed, so a failure is only logged and the permissions are refreshed by the next change.
+func (s *permissionService) reloadMappings(ctx context.Context) {
+	if err := s.loadMappings(ctx); err != nil {
+		s.logger.Error("failed to reload permissions", sl.Err(err))
+	}
+}
+
+// recordAudit records a change of a permission.
+func (s *permissionService) recordAudit(
+	ctx context.Context,
+	action model.AuditAction,
+	name string,
+	changes audit.Changes,
+) error {
+	event, err := audit.NewEvent(ctx, action, model.AuditTarget{Type: model.AuditTargetPermission, ID: name})
+	if err != nil {
+		return err
+	}
+	event.Changes = changes
+
+	return s.auditRepository.Record(ctx, event)
+}
+
+// compact returns the names sorted and without duplicates.
+func compact(names []string) []string {
+	names = slices.Clone(names)
+	slices.Sort(names)
+
+	return slices.Compact(names)
+}
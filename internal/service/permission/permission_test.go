@@ -0,0 +1,390 @@
+package permission
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/8thgencore/microservice-common/pkg/db"
+	"github.com/8thgencore/microservice-common/pkg/db/transaction"
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	repositoryMocks "github.com/8thgencore/microservice-auth/internal/repository/mocks"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
+	dbMocks "github.com/8thgencore/microservice-common/pkg/db/mocks"
+	loggerMocks "github.com/8thgencore/microservice-common/pkg/logger/mocks"
+)
+
+var (
+	ctx = context.Background()
+
+	endpointDelete   = "/chat_v1.ChatV1/Delete"
+	endpointModerate = "/chat_v1.ChatV1/Moderate"
+
+	// permissions are granted to the admin and auditor roles, which the support role inherits from the admin role.
+	permissions = []*model.Permission{
+		{Name: "chat.delete", Endpoints: []string{endpointDelete}, Roles: []string{"ADMIN"}},
+		{Name: "chat.moderate", Endpoints: []string{endpointDelete, endpointModerate}, Roles: []string{"ADMIN", "AUDITOR"}},
+		{Name: "chat.read", Roles: []string{"USER"}},
+	}
+
+	opts = pgx.TxOptions{IsoLevel: pgx.ReadCommitted}
+)
+
+type (
+	permissionRepositoryMockFunc func(mc *minimock.Controller) repository.PermissionRepository
+	auditRepositoryMockFunc      func(mc *minimock.Controller) repository.AuditRepository
+	transactorMockFunc           func(mc *minimock.Controller) db.Transactor
+)
+
+var (
+	transactorCommitMock = func(mc *minimock.Controller) db.Transactor {
+		mock := dbMocks.NewTransactorMock(mc)
+		txMock := dbMocks.NewTxMock(mc)
+		mock.BeginTxMock.Expect(minimock.AnyContext, opts).Return(txMock, nil)
+		txMock.CommitMock.Expect(minimock.AnyContext).Return(nil)
+		return mock
+	}
+
+	transactorRollbackMock = func(mc *minimock.Controller) db.Transactor {
+		mock := dbMocks.NewTransactorMock(mc)
+		txMock := dbMocks.NewTxMock(mc)
+		mock.BeginTxMock.Expect(minimock.AnyContext, opts).Return(txMock, nil)
+		txMock.RollbackMock.Expect(minimock.AnyContext).Return(nil)
+		return mock
+	}
+
+	emptyTransactorMock = func(mc *minimock.Controller) db.Transactor {
+		return dbMocks.NewTransactorMock(mc)
+	}
+
+	emptyAuditRepositoryMock = func(mc *minimock.Controller) repository.AuditRepository {
+		return repositoryMocks.NewAuditRepositoryMock(mc)
+	}
+)
+
+// auditedMock expects the change of a permission to be recorded.
+func auditedMock(action model.AuditAction, name string) auditRepositoryMockFunc {
+	return func(mc *minimock.Controller) repository.AuditRepository {
+		mock := repositoryMocks.NewAuditRepositoryMock(mc)
+		mock.RecordMock.Set(func(_ context.Context, event *model.AuditEvent) error {
+			require.Equal(mc, action, event.Action)
+			require.Equal(mc, model.AuditTarget{Type: model.AuditTargetPermission, ID: name}, event.Target)
+			return nil
+		})
+		return mock
+	}
+}
+
+// roleServiceMock resolves the roles of the hierarchy where the support role inherits the admin role
+// and the admin and auditor roles inherit the user role.
+func roleServiceMock(mc *minimock.Controller) service.RoleService {
+	parents := map[string]string{"SUPPORT": "ADMIN", "ADMIN": "USER", "AUDITOR": "USER"}
+
+	mock := serviceMocks.NewRoleServiceMock(mc)
+	mock.EffectiveRolesMock.Optional().Set(func(roles []string) []string {
+		var effective []string
+		for _, role := range roles {
+			for ok := true; ok; role, ok = parents[role] {
+				effective = append(effective, role)
+			}
+		}
+		return effective
+	})
+	return mock
+}
+
+// newTestService creates a service with the permissions listed by the permission repository mock.
+func newTestService(
+	mc *minimock.Controller,
+	permissionRepository repository.PermissionRepository,
+	auditRepository repository.AuditRepository,
+	transactor db.Transactor,
+) (service.PermissionService, error) {
+	return NewService(ctx, loggerMocks.NewMockLogger(), permissionRepository, auditRepository, roleServiceMock(mc),
+		transaction.NewTransactionManager(transactor))
+}
+
+func TestNewService(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+
+	permissionRepositoryMock := repositoryMocks.NewPermissionRepositoryMock(mc)
+	permissionRepositoryMock.ListMock.Expect(ctx).Return(nil, errors.New("some error"))
+
+	_, err := newTestService(mc, permissionRepositoryMock, emptyAuditRepositoryMock(mc), emptyTransactorMock(mc))
+	require.Equal(t, ErrFailedToReadPermissions, err)
+}
+
+func TestRolePermissions(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+
+	permissionRepositoryMock := repositoryMocks.NewPermissionRepositoryMock(mc)
+	permissionRepositoryMock.ListMock.Expect(ctx).Return(permissions, nil)
+
+	srv, err := newTestService(mc, permissionRepositoryMock, emptyAuditRepositoryMock(mc), emptyTransactorMock(mc))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		roles []string
+		want  []string
+	}{
+		{
+			name:  "no roles case",
+			roles: nil,
+			want:  []string{},
+		},
+		{
+			name:  "granted permissions case",
+			roles: []string{"USER"},
+			want:  []string{"chat.read"},
+		},
+		{
+			name:  "inherited permissions case",
+			roles: []string{"SUPPORT"},
+			want:  []string{"chat.delete", "chat.moderate", "chat.read"},
+		},
+		{
+			name:  "several roles case",
+			roles: []string{"AUDITOR", "ADMIN"},
+			want:  []string{"chat.delete", "chat.moderate", "chat.read"},
+		},
+		{
+			name:  "unknown role case",
+			roles: []string{"DELETED"},
+			want:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := srv.RolePermissions(tt.roles)
+			require.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
+
+func TestEndpointPermissions(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+
+	permissionRepositoryMock := repositoryMocks.NewPermissionRepositoryMock(mc)
+	permissionRepositoryMock.ListMock.Expect(ctx).Return(permissions, nil)
+
+	srv, err := newTestService(mc, permissionRepositoryMock, emptyAuditRepositoryMock(mc), emptyTransactorMock(mc))
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"chat.delete", "chat.moderate"}, srv.EndpointPermissions(endpointDelete))
+	require.Equal(t, []string{"chat.moderate"}, srv.EndpointPermissions(endpointModerate))
+	require.Empty(t, srv.EndpointPermissions("/chat_v1.ChatV1/Create"))
+}
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		permission = &model.Permission{
+			Name:      "chat.export",
+			Endpoints: []string{"/chat_v1.ChatV1/Export"},
+			Roles:     []string{"AUDITOR", "ADMIN", "AUDITOR"},
+		}
+	)
+
+	tests := []struct {
+		name                     string
+		err                      error
+		permissionRepositoryMock permissionRepositoryMockFunc
+		auditRepository          auditRepositoryMockFunc
+		transactorMock           transactorMockFunc
+	}{
+		{
+			name: "permission exists error case",
+			err:  ErrPermissionExists,
+			permissionRepositoryMock: func(mc *minimock.Controller) repository.PermissionRepository {
+				mock := repositoryMocks.NewPermissionRepositoryMock(mc)
+				mock.ListMock.Expect(ctx).Return(permissions, nil)
+				mock.CreateMock.Return(ErrPermissionExists)
+				return mock
+			},
+			auditRepository: emptyAuditRepositoryMock,
+			transactorMock:  transactorRollbackMock,
+		},
+		{
+			name: "unknown role error case",
+			err:  ErrUnknownRole,
+			permissionRepositoryMock: func(mc *minimock.Controller) repository.PermissionRepository {
+				mock := repositoryMocks.NewPermissionRepositoryMock(mc)
+				mock.ListMock.Expect(ctx).Return(permissions, nil)
+				mock.CreateMock.Return(ErrUnknownRole)
+				return mock
+			},
+			auditRepository: emptyAuditRepositoryMock,
+			transactorMock:  transactorRollbackMock,
+		},
+		{
+			name: "repository error case",
+			err:  ErrPermissionCreate,
+			permissionRepositoryMock: func(mc *minimock.Controller) repository.PermissionRepository {
+				mock := repositoryMocks.NewPermissionRepositoryMock(mc)
+				mock.ListMock.Expect(ctx).Return(permissions, nil)
+				mock.CreateMock.Return(errors.New("some error"))
+				return mock
+			},
+			auditRepository: emptyAuditRepositoryMock,
+			transactorMock:  transactorRollbackMock,
+		},
+		{
+			name: "success case",
+			err:  nil,
+			permissionRepositoryMock: func(mc *minimock.Controller) repository.PermissionRepository {
+				mock := repositoryMocks.NewPermissionRepositoryMock(mc)
+				mock.ListMock.Return(permissions, nil)
+				mock.CreateMock.Set(func(_ context.Context, permission *model.Permission) error {
+					require.Equal(mc, []string{"ADMIN", "AUDITOR"}, permission.Roles)
+					return nil
+				})
+				return mock
+			},
+			auditRepository: auditedMock(model.AuditActionPermissionCreated, permission.Name),
+			transactorMock:  transactorCommitMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, err := newTestService(mc, tt.permissionRepositoryMock(mc), tt.auditRepository(mc),
+				tt.transactorMock(mc))
+			require.NoError(t, err)
+
+			err = srv.Create(ctx, permission)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		description = "Moderate chats"
+		roles       = []string{"SUPPORT"}
+	)
+
+	tests := []struct {
+		name                     string
+		update                   *model.PermissionUpdate
+		err                      error
+		permissionRepositoryMock permissionRepositoryMockFunc
+		auditRepository          auditRepositoryMockFunc
+		transactorMock           transactorMockFunc
+	}{
+		{
+			name:   "permission not found error case",
+			update: &model.PermissionUpdate{Name: "chat.deleted", Description: &description},
+			err:    ErrPermissionNotFound,
+			permissionRepositoryMock: func(mc *minimock.Controller) repository.PermissionRepository {
+				mock := repositoryMocks.NewPermissionRepositoryMock(mc)
+				mock.ListMock.Return(permissions, nil)
+				mock.GetMock.Expect(minimock.AnyContext, "chat.deleted").Return(nil, ErrPermissionNotFound)
+				return mock
+			},
+			auditRepository: emptyAuditRepositoryMock,
+			transactorMock:  transactorRollbackMock,
+		},
+		{
+			name:   "success case",
+			update: &model.PermissionUpdate{Name: "chat.moderate", Description: &description, Roles: &roles},
+			err:    nil,
+			permissionRepositoryMock: func(mc *minimock.Controller) repository.PermissionRepository {
+				mock := repositoryMocks.NewPermissionRepositoryMock(mc)
+				mock.ListMock.Return(permissions, nil)
+				mock.GetMock.Expect(minimock.AnyContext, "chat.moderate").Return(permissions[1], nil)
+				mock.UpdateMock.Return(nil)
+				return mock
+			},
+			auditRepository: auditedMock(model.AuditActionPermissionUpdated, "chat.moderate"),
+			transactorMock:  transactorCommitMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, err := newTestService(mc, tt.permissionRepositoryMock(mc), tt.auditRepository(mc),
+				tt.transactorMock(mc))
+			require.NoError(t, err)
+
+			err = srv.Update(ctx, tt.update)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+
+	tests := []struct {
+		name                     string
+		permission               string
+		err                      error
+		permissionRepositoryMock permissionRepositoryMockFunc
+		auditRepository          auditRepositoryMockFunc
+		transactorMock           transactorMockFunc
+	}{
+		{
+			name:       "permission not found error case",
+			permission: "chat.deleted",
+			err:        ErrPermissionNotFound,
+			permissionRepositoryMock: func(mc *minimock.Controller) repository.PermissionRepository {
+				mock := repositoryMocks.NewPermissionRepositoryMock(mc)
+				mock.ListMock.Return(permissions, nil)
+				mock.GetMock.Expect(minimock.AnyContext, "chat.deleted").Return(nil, ErrPermissionNotFound)
+				return mock
+			},
+			auditRepository: emptyAuditRepositoryMock,
+			transactorMock:  transactorRollbackMock,
+		},
+		{
+			name:       "success case",
+			permission: "chat.delete",
+			err:        nil,
+			permissionRepositoryMock: func(mc *minimock.Controller) repository.PermissionRepository {
+				mock := repositoryMocks.NewPermissionRepositoryMock(mc)
+				mock.ListMock.Return(permissions, nil)
+				mock.GetMock.Expect(minimock.AnyContext, "chat.delete").Return(permissions[0], nil)
+				mock.DeleteMock.Expect(minimock.AnyContext, "chat.delete").Return(nil)
+				return mock
+			},
+			auditRepository: auditedMock(model.AuditActionPermissionDeleted, "chat.delete"),
+			transactorMock:  transactorCommitMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, err := newTestService(mc, tt.permissionRepositoryMock(mc), tt.auditRepository(mc),
+				tt.transactorMock(mc))
+			require.NoError(t, err)
+
+			err = srv.Delete(ctx, tt.permission)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package permission
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	"github.com/8thgencore/microservice-common/pkg/db"
+)
+
+type permissionService struct {
+	logger               *slog.Logger
+	permissionRepository repository.PermissionRepository
+	auditRepository      repository.AuditRepository
+	roleService          service.RoleService
+	txManager            db.TxManager
+	// grants are the permissions granted to every role by name.
+	grants map[string][]string
+	// endpoints are the permissions granting access to every endpoint.
+	endpoints     map[string][]string
+	mappingsMutex sync.RWMutex
+}
+
+// NewService creates new object of service layer and loads the permissions of the roles and endpoints.
+func NewService(
+	ctx context.Context,
+	logger *slog.Logger,
+	permissionRepository repository.PermissionRepository,
+	auditRepository repository.AuditRepository,
+	roleService service.RoleService,
+	txManager db.TxManager,
+) (service.PermissionService, error) {
+	s := &permissionService{
+		logger:               logger,
+		permissionRepository: permissionRepository,
+		auditRepository:      auditRepository,
+		roleService:          roleService,
+		txManager:            txManager,
+	}
+
+	if err := s.loadMappings(ctx); err != nil {
+		return nil, ErrFailedToReadPermissions
+	}
+
+	return s, nil
+}
@@ -0,0 +1,537 @@
+package relation
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"slices"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+)
+
+const (
+	writeTuplesEndpoint   = "/access_v1.AccessV1/WriteTuples"
+	deleteTuplesEndpoint  = "/access_v1.AccessV1/DeleteTuples"
+	checkRelationEndpoint = "/access_v1.AccessV1/CheckRelation"
+	listObjectsEndpoint   = "/access_v1.AccessV1/ListObjects"
+)
+
+// Errors
+var (
+	// ErrUnknownNamespace occurs when a tuple or a check refers to a namespace missing from the schema.
+	ErrUnknownNamespace = errors.New("unknown namespace")
+	// ErrUnknownRelation occurs when a tuple or a check refers to a relation missing from the schema.
+	ErrUnknownRelation = errors.New("unknown relation")
+	// ErrInvalidConsistencyToken occurs when a consistency token is malformed or was not issued yet.
+	ErrInvalidConsistencyToken = errors.New("invalid consistency token")
+	// ErrInvalidPageToken occurs when a page token is malformed or was issued for a different listing.
+	ErrInvalidPageToken = errors.New("invalid page token")
+	// ErrDepthExceeded occurs when checking a relation traverses the relation graph deeper than the limit.
+	ErrDepthExceeded = errors.New("relation graph depth limit exceeded")
+	// ErrFailedToWriteTuples occurs when there is a problem writing relation tuples.
+	ErrFailedToWriteTuples = errors.New("failed to write relation tuples")
+	// ErrFailedToDeleteTuples occurs when there is a problem deleting relation tuples.
+	ErrFailedToDeleteTuples = errors.New("failed to delete relation tuples")
+	// ErrFailedToReadTuples occurs when there is a problem reading relation tuples.
+	ErrFailedToReadTuples = errors.New("failed to read relation tuples")
+)
+
+// Page sizes of object listings
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+// consistencyToken is the content of an opaque consistency token: the revision of the tuples
+// a response saw at least.
+type consistencyToken struct {
+	Revision int64 `json:"r"`
+}
+
+// pageToken is the content of an opaque page token: the last listed object, the listing it belongs to
+// and the revision of the tuples it saw, so the next pages see at least the same tuples.
+type pageToken struct {
+	Listing  string `json:"l"`
+	Revision int64  `json:"r"`
+	After    string `json:"a"`
+}
+
+// WriteTuples writes the relation tuples after verifying access permissions.
+func (s *relationService) WriteTuples(ctx context.Context, tuples []*model.RelationTuple) (string, error) {
+	if err := s.accessService.Check(ctx, writeTuplesEndpoint, nil); err != nil {
+		return "", err
+	}
+
+	for _, tuple := range tuples {
+		if err := s.validateTuple(tuple); err != nil {
+			return "", err
+		}
+	}
+
+	var revision int64
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		revision, errTx = s.relationRepository.NextRevision(ctx)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.relationRepository.WriteTuples(ctx, tuples, revision)
+	})
+	if err != nil {
+		return "", ErrFailedToWriteTuples
+	}
+
+	return encodeConsistencyToken(revision)
+}
+
+// DeleteTuples deletes the relation tuples after verifying access permissions.
+func (s *relationService) DeleteTuples(ctx context.Context, tuples []*model.RelationTuple) (string, error) {
+	if err := s.accessService.Check(ctx, deleteTuplesEndpoint, nil); err != nil {
+		return "", err
+	}
+
+	var revision int64
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		revision, errTx = s.relationRepository.NextRevision(ctx)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.relationRepository.DeleteTuples(ctx, tuples)
+	})
+	if err != nil {
+		return "", ErrFailedToDeleteTuples
+	}
+
+	return encodeConsistencyToken(revision)
+}
+
+// CheckRelation checks whether the subject has the relation to the object after verifying access permissions.
+func (s *relationService) CheckRelation(
+	ctx context.Context,
+	tuple *model.RelationTuple,
+	token string,
+) (bool, string, error) {
+	if err := s.accessService.Check(ctx, checkRelationEndpoint, nil); err != nil {
+		return false, "", err
+	}
+
+	if err := s.validateTuple(tuple); err != nil {
+		return false, "", err
+	}
+
+	revision, err := s.snapshot(ctx, token)
+	if err != nil {
+		return false, "", err
+	}
+
+	allowed, err := s.check(ctx, tuple.Object, tuple.Relation, tuple.Subject, 0, map[visit]bool{})
+	if err != nil {
+		return false, "", err
+	}
+
+	token, err = encodeConsistencyToken(revision)
+	if err != nil {
+		return false, "", err
+	}
+
+	return allowed, token, nil
+}
+
+// ListObjects lists a page of the objects of the namespace the subject has the relation to after verifying
+// access permissions.
+func (s *relationService) ListObjects(
+	ctx context.Context,
+	params *model.RelationObjectsParams,
+) (*model.RelationObjectsPage, error) {
+	if err := s.accessService.Check(ctx, listObjectsEndpoint, nil); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateRelation(params.Namespace, params.Relation); err != nil {
+		return nil, err
+	}
+	if err := s.validateSubject(params.Subject); err != nil {
+		return nil, err
+	}
+
+	pageSize := params.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	pageSize = min(pageSize, maxPageSize)
+
+	listing := listingOf(params)
+
+	var after *pageToken
+	if params.PageToken != "" {
+		token, err := decodePageToken(params.PageToken)
+		if err != nil || token.Listing != listing {
+			return nil, ErrInvalidPageToken
+		}
+		after = token
+	}
+
+	revision, err := s.snapshot(ctx, params.ConsistencyToken)
+	if err != nil {
+		return nil, err
+	}
+	if after != nil && after.Revision > revision {
+		return nil, ErrInvalidPageToken
+	}
+
+	ids, err := s.objects(ctx, params.Namespace, params.Relation, params.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	if after != nil {
+		i, found := slices.BinarySearch(ids, after.After)
+		if found {
+			i++
+		}
+		ids = ids[i:]
+	}
+
+	page := &model.RelationObjectsPage{IDs: ids}
+	if len(ids) > pageSize {
+		page.IDs = ids[:pageSize]
+
+		page.NextPageToken, err = encodePageToken(&pageToken{
+			Listing:  listing,
+			Revision: revision,
+			After:    page.IDs[pageSize-1],
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	page.ConsistencyToken, err = encodeConsistencyToken(revision)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// objects returns the IDs of the objects of the namespace the subject has the relation to, ordered.
+// Rather than checking every object of the namespace, the relation graph is walked backwards from the subject:
+// each relation of an object the subject has reaches the relations of the objects whose tuples have it
+// as a userset, the relations computed from it and the relations of the objects related to the object itself,
+// not to a userset of it, by a tupleset. The depth is the number of relations followed, as for a check.
+func (s *relationService) objects(
+	ctx context.Context,
+	namespace, relation string,
+	subject model.RelationSubject,
+) ([]string, error) {
+	type reached struct {
+		visit
+		depth int
+	}
+
+	var (
+		queue   []reached
+		visited = map[visit]bool{}
+		// subjectTuples holds the tuples of the objects read so far as subjects.
+		subjectTuples = map[model.RelationObject][]*model.RelationTuple{}
+	)
+
+	reach := func(v visit, depth int) error {
+		if visited[v] {
+			return nil
+		}
+		if depth > s.maxDepth {
+			return ErrDepthExceeded
+		}
+		visited[v] = true
+		queue = append(queue, reached{visit: v, depth: depth})
+
+		return nil
+	}
+
+	readSubjectTuples := func(object model.RelationObject) ([]*model.RelationTuple, error) {
+		if tuples, ok := subjectTuples[object]; ok {
+			return tuples, nil
+		}
+
+		tuples, err := s.relationRepository.ReadSubjectTuples(ctx, object)
+		if err != nil {
+			return nil, ErrFailedToReadTuples
+		}
+		subjectTuples[object] = tuples
+
+		return tuples, nil
+	}
+
+	// A userset has the relation of the userset to its object.
+	subjectObject := model.RelationObject{Namespace: subject.Namespace, ID: subject.ID}
+	if subject.Relation != "" {
+		if err := reach(visit{object: subjectObject, relation: subject.Relation}, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	tuples, err := readSubjectTuples(subjectObject)
+	if err != nil {
+		return nil, err
+	}
+	for _, tuple := range tuples {
+		if tuple.Subject == subject {
+			if err = reach(visit{object: tuple.Object, relation: tuple.Relation}, 0); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, computed := range s.schema.computedFrom[current.object.Namespace][current.relation] {
+			if err = reach(visit{object: current.object, relation: computed}, current.depth+1); err != nil {
+				return nil, err
+			}
+		}
+
+		tuples, err = readSubjectTuples(current.object)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tuple := range tuples {
+			if tuple.Subject.Relation == current.relation {
+				err = reach(visit{object: tuple.Object, relation: tuple.Relation}, current.depth+1)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			if tuple.Subject.Relation != "" {
+				continue
+			}
+			for _, ttu := range s.schema.usersetFrom[current.relation] {
+				if ttu.namespace != tuple.Object.Namespace || ttu.tupleset != tuple.Relation {
+					continue
+				}
+				if err = reach(visit{object: tuple.Object, relation: ttu.relation}, current.depth+1); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	var ids []string
+	for v := range visited {
+		if v.object.Namespace == namespace && v.relation == relation {
+			ids = append(ids, v.object.ID)
+		}
+	}
+	slices.Sort(ids)
+
+	return ids, nil
+}
+
+// visit is a relation of an object being checked, so that cycles of the relation graph are not followed.
+type visit struct {
+	object   model.RelationObject
+	relation string
+}
+
+// check reports whether the subject has the relation to the object, either through a tuple of the relation,
+// through a userset of such a tuple, or through the computed usersets and the tuple to usersets of the relation.
+// The depth is the number of relations followed to reach the object.
+func (s *relationService) check(
+	ctx context.Context,
+	object model.RelationObject,
+	relation string,
+	subject model.RelationSubject,
+	depth int,
+	visited map[visit]bool,
+) (bool, error) {
+	if depth > s.maxDepth {
+		return false, ErrDepthExceeded
+	}
+
+	definition := s.schema.relation(object.Namespace, relation)
+	if definition == nil {
+		return false, nil
+	}
+
+	v := visit{object: object, relation: relation}
+	if visited[v] {
+		return false, nil
+	}
+	visited[v] = true
+	defer delete(visited, v)
+
+	if subject == (model.RelationSubject{Namespace: object.Namespace, ID: object.ID, Relation: relation}) {
+		return true, nil
+	}
+
+	tuples, err := s.relationRepository.ReadTuples(ctx, object, relation)
+	if err != nil {
+		return false, ErrFailedToReadTuples
+	}
+
+	for _, tuple := range tuples {
+		if tuple.Subject == subject {
+			return true, nil
+		}
+	}
+
+	for _, tuple := range tuples {
+		if tuple.Subject.Relation == "" {
+			continue
+		}
+
+		userset := model.RelationObject{Namespace: tuple.Subject.Namespace, ID: tuple.Subject.ID}
+		if ok, err := s.check(ctx, userset, tuple.Subject.Relation, subject, depth+1, visited); ok || err != nil {
+			return ok, err
+		}
+	}
+
+	for _, computed := range definition.ComputedUsersets {
+		if ok, err := s.check(ctx, object, computed, subject, depth+1, visited); ok || err != nil {
+			return ok, err
+		}
+	}
+
+	for _, ttu := range definition.TupleToUsersets {
+		related, err := s.relationRepository.ReadTuples(ctx, object, ttu.Tupleset)
+		if err != nil {
+			return false, ErrFailedToReadTuples
+		}
+
+		for _, tuple := range related {
+			// The tupleset relates objects, so a userset subject of it is not followed.
+			if tuple.Subject.Relation != "" {
+				continue
+			}
+
+			userset := model.RelationObject{Namespace: tuple.Subject.Namespace, ID: tuple.Subject.ID}
+			if ok, err := s.check(ctx, userset, ttu.ComputedUserset, subject, depth+1, visited); ok || err != nil {
+				return ok, err
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// snapshot returns the revision a read sees, checking that it is not older than the consistency token.
+// The revision is taken before reading any tuple, so that the read sees at least the writes up to it.
+func (s *relationService) snapshot(ctx context.Context, token string) (int64, error) {
+	revision, err := s.relationRepository.Revision(ctx)
+	if err != nil {
+		return 0, ErrFailedToReadTuples
+	}
+
+	if token != "" {
+		consistency, err := decodeConsistencyToken(token)
+		if err != nil || consistency.Revision > revision {
+			return 0, ErrInvalidConsistencyToken
+		}
+	}
+
+	return revision, nil
+}
+
+// validateTuple checks that the schema defines the relation of the tuple and its subject.
+func (s *relationService) validateTuple(tuple *model.RelationTuple) error {
+	if err := s.validateRelation(tuple.Object.Namespace, tuple.Relation); err != nil {
+		return err
+	}
+
+	return s.validateSubject(tuple.Subject)
+}
+
+// validateSubject checks that the schema defines the namespace of the subject, and its relation for a userset.
+func (s *relationService) validateSubject(subject model.RelationSubject) error {
+	if subject.Relation == "" {
+		if !s.schema.hasNamespace(subject.Namespace) {
+			return ErrUnknownNamespace
+		}
+
+		return nil
+	}
+
+	return s.validateRelation(subject.Namespace, subject.Relation)
+}
+
+// validateRelation checks that the schema defines the relation of the namespace.
+func (s *relationService) validateRelation(namespace, relation string) error {
+	if !s.schema.hasNamespace(namespace) {
+		return ErrUnknownNamespace
+	}
+	if s.schema.relation(namespace, relation) == nil {
+		return ErrUnknownRelation
+	}
+
+	return nil
+}
+
+// listingOf returns the listing the objects of the parameters are, written namespace#relation@subject.
+func listingOf(params *model.RelationObjectsParams) string {
+	listing := params.Namespace + "#" + params.Relation + "@" + params.Subject.Namespace + ":" + params.Subject.ID
+	if params.Subject.Relation != "" {
+		listing += "#" + params.Subject.Relation
+	}
+
+	return listing
+}
+
+func encodePageToken(token *pageToken) (string, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodePageToken(raw string) (*pageToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var token pageToken
+	if err = json.Unmarshal(data, &token); err != nil {
+		return nil, err
+	}
+	if token.After == "" {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &token, nil
+}
+
+func encodeConsistencyToken(revision int64) (string, error) {
+	data, err := json.Marshal(&consistencyToken{Revision: revision})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeConsistencyToken(raw string) (*consistencyToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var token consistencyToken
+	if err = json.Unmarshal(data, &token); err != nil {
+		return nil, err
+	}
+	if token.Revision < 0 {
+		return nil, ErrInvalidConsistencyToken
+	}
+
+	return &token, nil
+}
@@ -0,0 +1,617 @@
+package relation
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/8thgencore/microservice-common/pkg/db"
+	"github.com/8thgencore/microservice-common/pkg/db/transaction"
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	repositoryMocks "github.com/8thgencore/microservice-auth/internal/repository/mocks"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	"github.com/8thgencore/microservice-auth/internal/service/access"
+	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
+	dbMocks "github.com/8thgencore/microservice-common/pkg/db/mocks"
+)
+
+var (
+	ctx = context.Background()
+
+	opts = pgx.TxOptions{IsoLevel: pgx.ReadCommitted}
+
+	// testSchema makes the owners of a chat and the members of its groups members of the chat.
+	testSchema = func() *Schema {
+		schema, err := NewSchema(map[string][]Relation{
+			"user":  nil,
+			"group": {{Name: "member"}},
+			"chat": {
+				{Name: "owner"},
+				{Name: "group"},
+				{
+					Name:             "member",
+					ComputedUsersets: []string{"owner"},
+					TupleToUsersets:  []TupleToUserset{{Tupleset: "group", ComputedUserset: "member"}},
+				},
+			},
+		})
+		if err != nil {
+			panic(err)
+		}
+		return schema
+	}()
+
+	// tuples relate users to chats and groups, the eng group is nested in the staff group which is nested
+	// in the eng group again. The members of the staff group are given as the group of chat 4, which a tupleset
+	// does not follow.
+	tuples = []*model.RelationTuple{
+		tuple("chat:1#owner@user:1"),
+		tuple("chat:1#member@user:2"),
+		tuple("chat:1#group@group:eng"),
+		tuple("chat:2#member@group:staff#member"),
+		tuple("chat:3#member@user:4"),
+		tuple("chat:4#group@group:staff#member"),
+		tuple("group:eng#member@user:3"),
+		tuple("group:eng#member@group:staff#member"),
+		tuple("group:staff#member@user:5"),
+		tuple("group:staff#member@group:eng#member"),
+	}
+)
+
+type (
+	relationRepositoryMockFunc func(mc *minimock.Controller) repository.RelationRepository
+	accessServiceMockFunc      func(mc *minimock.Controller) service.AccessService
+	transactorMockFunc         func(mc *minimock.Controller) db.Transactor
+)
+
+var (
+	transactorCommitMock = func(mc *minimock.Controller) db.Transactor {
+		mock := dbMocks.NewTransactorMock(mc)
+		txMock := dbMocks.NewTxMock(mc)
+		mock.BeginTxMock.Expect(minimock.AnyContext, opts).Return(txMock, nil)
+		txMock.CommitMock.Expect(minimock.AnyContext).Return(nil)
+		return mock
+	}
+
+	transactorRollbackMock = func(mc *minimock.Controller) db.Transactor {
+		mock := dbMocks.NewTransactorMock(mc)
+		txMock := dbMocks.NewTxMock(mc)
+		mock.BeginTxMock.Expect(minimock.AnyContext, opts).Return(txMock, nil)
+		txMock.RollbackMock.Expect(minimock.AnyContext).Return(nil)
+		return mock
+	}
+
+	emptyTransactorMock = func(mc *minimock.Controller) db.Transactor {
+		return dbMocks.NewTransactorMock(mc)
+	}
+
+	emptyRelationRepositoryMock = func(mc *minimock.Controller) repository.RelationRepository {
+		return repositoryMocks.NewRelationRepositoryMock(mc)
+	}
+)
+
+// tuple parses a tuple written object#relation@subject.
+func tuple(s string) *model.RelationTuple {
+	object, subject, _ := strings.Cut(s, "@")
+	object, relation, _ := strings.Cut(object, "#")
+	objectNamespace, objectID, _ := strings.Cut(object, ":")
+	subject, subjectRelation, _ := strings.Cut(subject, "#")
+	subjectNamespace, subjectID, _ := strings.Cut(subject, ":")
+
+	return &model.RelationTuple{
+		Object:   model.RelationObject{Namespace: objectNamespace, ID: objectID},
+		Relation: relation,
+		Subject:  model.RelationSubject{Namespace: subjectNamespace, ID: subjectID, Relation: subjectRelation},
+	}
+}
+
+// storedMock reads the tuples at the revision.
+func storedMock(revision int64, stored []*model.RelationTuple) relationRepositoryMockFunc {
+	return func(mc *minimock.Controller) repository.RelationRepository {
+		mock := repositoryMocks.NewRelationRepositoryMock(mc)
+		mock.RevisionMock.Expect(ctx).Return(revision, nil)
+		mock.ReadTuplesMock.Optional().Set(
+			func(_ context.Context, object model.RelationObject, relation string) ([]*model.RelationTuple, error) {
+				var res []*model.RelationTuple
+				for _, t := range stored {
+					if t.Object == object && t.Relation == relation {
+						res = append(res, t)
+					}
+				}
+				return res, nil
+			})
+		mock.ReadSubjectTuplesMock.Optional().Set(
+			func(_ context.Context, subject model.RelationObject) ([]*model.RelationTuple, error) {
+				var res []*model.RelationTuple
+				for _, t := range stored {
+					if t.Subject.Namespace == subject.Namespace && t.Subject.ID == subject.ID {
+						res = append(res, t)
+					}
+				}
+				return res, nil
+			})
+		return mock
+	}
+}
+
+// allowedMock lets the caller access the endpoint.
+func allowedMock(endpoint string) accessServiceMockFunc {
+	return func(mc *minimock.Controller) service.AccessService {
+		mock := serviceMocks.NewAccessServiceMock(mc)
+		mock.CheckMock.Expect(ctx, endpoint, nil).Return(nil)
+		return mock
+	}
+}
+
+// deniedMock denies the caller access to the endpoint.
+func deniedMock(endpoint string) accessServiceMockFunc {
+	return func(mc *minimock.Controller) service.AccessService {
+		mock := serviceMocks.NewAccessServiceMock(mc)
+		mock.CheckMock.Expect(ctx, endpoint, nil).Return(access.ErrAccessDenied)
+		return mock
+	}
+}
+
+func revisionToken(t *testing.T, revision int64) string {
+	token, err := encodeConsistencyToken(revision)
+	require.NoError(t, err)
+	return token
+}
+
+func TestCheckRelation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                   string
+		tuple                  string
+		token                  string
+		maxDepth               int
+		allowed                bool
+		err                    error
+		relationRepositoryMock relationRepositoryMockFunc
+		accessServiceMock      accessServiceMockFunc
+	}{
+		{
+			name:                   "direct tuple",
+			tuple:                  "chat:1#member@user:2",
+			allowed:                true,
+			relationRepositoryMock: storedMock(7, tuples),
+			accessServiceMock:      allowedMock(checkRelationEndpoint),
+		},
+		{
+			name:                   "computed userset",
+			tuple:                  "chat:1#member@user:1",
+			allowed:                true,
+			relationRepositoryMock: storedMock(7, tuples),
+			accessServiceMock:      allowedMock(checkRelationEndpoint),
+		},
+		{
+			name:                   "tuple to userset",
+			tuple:                  "chat:1#member@user:3",
+			allowed:                true,
+			relationRepositoryMock: storedMock(7, tuples),
+			accessServiceMock:      allowedMock(checkRelationEndpoint),
+		},
+		{
+			name:                   "tuple to userset through a nested group",
+			tuple:                  "chat:1#member@user:5",
+			allowed:                true,
+			relationRepositoryMock: storedMock(7, tuples),
+			accessServiceMock:      allowedMock(checkRelationEndpoint),
+		},
+		{
+			name:                   "userset tuple",
+			tuple:                  "chat:2#member@user:3",
+			allowed:                true,
+			relationRepositoryMock: storedMock(7, tuples),
+			accessServiceMock:      allowedMock(checkRelationEndpoint),
+		},
+		{
+			name:                   "userset subject",
+			tuple:                  "chat:2#member@group:eng#member",
+			allowed:                true,
+			relationRepositoryMock: storedMock(7, tuples),
+			accessServiceMock:      allowedMock(checkRelationEndpoint),
+		},
+		{
+			name:                   "not related through a cycle",
+			tuple:                  "chat:2#member@user:4",
+			allowed:                false,
+			relationRepositoryMock: storedMock(7, tuples),
+			accessServiceMock:      allowedMock(checkRelationEndpoint),
+		},
+		{
+			name:                   "relation not inherited by the computed userset",
+			tuple:                  "chat:1#owner@user:2",
+			allowed:                false,
+			relationRepositoryMock: storedMock(7, tuples),
+			accessServiceMock:      allowedMock(checkRelationEndpoint),
+		},
+		{
+			name:                   "depth limit exceeded",
+			tuple:                  "chat:1#member@user:5",
+			maxDepth:               1,
+			err:                    ErrDepthExceeded,
+			relationRepositoryMock: storedMock(7, tuples),
+			accessServiceMock:      allowedMock(checkRelationEndpoint),
+		},
+		{
+			name:                   "userset in tupleset not followed",
+			tuple:                  "chat:4#member@user:5",
+			allowed:                false,
+			relationRepositoryMock: storedMock(7, tuples),
+			accessServiceMock:      allowedMock(checkRelationEndpoint),
+		},
+		{
+			name:                   "consistency token",
+			tuple:                  "chat:1#member@user:2",
+			token:                  revisionToken(t, 7),
+			allowed:                true,
+			relationRepositoryMock: storedMock(7, tuples),
+			accessServiceMock:      allowedMock(checkRelationEndpoint),
+		},
+		{
+			name:  "consistency token not issued yet",
+			tuple: "chat:1#member@user:2",
+			token: revisionToken(t, 8),
+			err:   ErrInvalidConsistencyToken,
+			relationRepositoryMock: func(mc *minimock.Controller) repository.RelationRepository {
+				mock := repositoryMocks.NewRelationRepositoryMock(mc)
+				mock.RevisionMock.Expect(ctx).Return(7, nil)
+				return mock
+			},
+			accessServiceMock: allowedMock(checkRelationEndpoint),
+		},
+		{
+			name:  "malformed consistency token",
+			tuple: "chat:1#member@user:2",
+			token: "not a token",
+			err:   ErrInvalidConsistencyToken,
+			relationRepositoryMock: func(mc *minimock.Controller) repository.RelationRepository {
+				mock := repositoryMocks.NewRelationRepositoryMock(mc)
+				mock.RevisionMock.Expect(ctx).Return(7, nil)
+				return mock
+			},
+			accessServiceMock: allowedMock(checkRelationEndpoint),
+		},
+		{
+			name:                   "unknown relation",
+			tuple:                  "chat:1#admin@user:2",
+			err:                    ErrUnknownRelation,
+			relationRepositoryMock: emptyRelationRepositoryMock,
+			accessServiceMock:      allowedMock(checkRelationEndpoint),
+		},
+		{
+			name:                   "unknown subject namespace",
+			tuple:                  "chat:1#member@bot:2",
+			err:                    ErrUnknownNamespace,
+			relationRepositoryMock: emptyRelationRepositoryMock,
+			accessServiceMock:      allowedMock(checkRelationEndpoint),
+		},
+		{
+			name:                   "access denied",
+			tuple:                  "chat:1#member@user:2",
+			err:                    access.ErrAccessDenied,
+			relationRepositoryMock: emptyRelationRepositoryMock,
+			accessServiceMock:      deniedMock(checkRelationEndpoint),
+		},
+		{
+			name:  "read error",
+			tuple: "chat:1#member@user:2",
+			err:   ErrFailedToReadTuples,
+			relationRepositoryMock: func(mc *minimock.Controller) repository.RelationRepository {
+				mock := repositoryMocks.NewRelationRepositoryMock(mc)
+				mock.RevisionMock.Expect(ctx).Return(7, nil)
+				mock.ReadTuplesMock.Return(nil, errors.New("some error"))
+				return mock
+			},
+			accessServiceMock: allowedMock(checkRelationEndpoint),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			maxDepth := tt.maxDepth
+			if maxDepth == 0 {
+				maxDepth = 10
+			}
+
+			srv := NewService(tt.relationRepositoryMock(mc), tt.accessServiceMock(mc),
+				transaction.NewTransactionManager(emptyTransactorMock(mc)), testSchema, maxDepth)
+
+			allowed, token, err := srv.CheckRelation(ctx, tuple(tt.tuple), tt.token)
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.allowed, allowed)
+			if tt.err == nil {
+				require.Equal(t, revisionToken(t, 7), token)
+			}
+		})
+	}
+}
+
+func TestListObjects(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		relation string
+		subject  string
+		ids      []string
+	}{
+		{
+			name:     "through tuple to userset case",
+			relation: "member",
+			subject:  "user:3",
+			ids:      []string{"1", "2"},
+		},
+		{
+			name:     "through computed userset case",
+			relation: "member",
+			subject:  "user:1",
+			ids:      []string{"1"},
+		},
+		{
+			name:     "through nested usersets case",
+			relation: "member",
+			subject:  "user:5",
+			ids:      []string{"1", "2"},
+		},
+		{
+			name:     "userset subject case",
+			relation: "member",
+			subject:  "group:staff#member",
+			ids:      []string{"1", "2"},
+		},
+		{
+			name:     "direct tuple case",
+			relation: "owner",
+			subject:  "user:1",
+			ids:      []string{"1"},
+		},
+		{
+			name:     "no objects case",
+			relation: "owner",
+			subject:  "user:3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			srv := NewService(storedMock(7, tuples)(mc), allowedMock(listObjectsEndpoint)(mc),
+				transaction.NewTransactionManager(emptyTransactorMock(mc)), testSchema, 10)
+
+			page, err := srv.ListObjects(ctx, &model.RelationObjectsParams{
+				Namespace: "chat",
+				Relation:  tt.relation,
+				Subject:   tuple("chat:0#" + tt.relation + "@" + tt.subject).Subject,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tt.ids, page.IDs)
+			require.Empty(t, page.NextPageToken)
+			require.Equal(t, revisionToken(t, 7), page.ConsistencyToken)
+		})
+	}
+}
+
+// TestListObjectsMatchesCheck lists the objects of every subject and relation and checks each object
+// of the namespace, the listing walking the relation graph backwards must agree with the checks.
+func TestListObjectsMatchesCheck(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+
+	accessServiceMock := serviceMocks.NewAccessServiceMock(mc)
+	accessServiceMock.CheckMock.Return(nil)
+
+	srv := NewService(storedMock(7, tuples)(mc), accessServiceMock,
+		transaction.NewTransactionManager(emptyTransactorMock(mc)), testSchema, 10)
+
+	subjects := []string{
+		"user:1", "user:2", "user:3", "user:4", "user:5", "group:eng#member", "group:staff#member",
+	}
+	objects := map[string][]string{"chat": {"1", "2", "3", "4"}, "group": {"eng", "staff"}}
+	relations := map[string][]string{"chat": {"owner", "group", "member"}, "group": {"member"}}
+
+	for namespace, ids := range objects {
+		for _, relation := range relations[namespace] {
+			for _, subject := range subjects {
+				var want []string
+				for _, id := range ids {
+					allowed, _, err := srv.CheckRelation(ctx, tuple(namespace+":"+id+"#"+relation+"@"+subject), "")
+					require.NoError(t, err)
+					if allowed {
+						want = append(want, id)
+					}
+				}
+
+				page, err := srv.ListObjects(ctx, &model.RelationObjectsParams{
+					Namespace: namespace,
+					Relation:  relation,
+					Subject:   tuple("x:0#r@" + subject).Subject,
+				})
+				require.NoError(t, err)
+				require.Equal(t, want, page.IDs, "%s#%s@%s", namespace, relation, subject)
+			}
+		}
+	}
+}
+
+func TestListObjectsPages(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+
+	stored := []*model.RelationTuple{
+		tuple("chat:1#member@user:1"),
+		tuple("chat:2#member@user:1"),
+		tuple("chat:3#owner@user:1"),
+		tuple("chat:4#member@user:2"),
+		tuple("chat:5#member@user:1"),
+	}
+	accessServiceMock := serviceMocks.NewAccessServiceMock(mc)
+	accessServiceMock.CheckMock.Return(nil)
+
+	srv := NewService(storedMock(7, stored)(mc), accessServiceMock,
+		transaction.NewTransactionManager(emptyTransactorMock(mc)), testSchema, 10)
+
+	params := &model.RelationObjectsParams{
+		Namespace: "chat",
+		Relation:  "member",
+		Subject:   model.RelationSubject{Namespace: "user", ID: "1"},
+		PageSize:  2,
+	}
+
+	var ids []string
+	for range 3 {
+		page, err := srv.ListObjects(ctx, params)
+		require.NoError(t, err)
+		ids = append(ids, page.IDs...)
+		params.PageToken = page.NextPageToken
+		if params.PageToken == "" {
+			break
+		}
+	}
+	require.Equal(t, []string{"1", "2", "3", "5"}, ids)
+	require.Empty(t, params.PageToken)
+
+	// A page token only continues the listing it was issued for.
+	page, err := srv.ListObjects(ctx, &model.RelationObjectsParams{
+		Namespace: "chat",
+		Relation:  "member",
+		Subject:   model.RelationSubject{Namespace: "user", ID: "1"},
+		PageSize:  2,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, page.NextPageToken)
+
+	_, err = srv.ListObjects(ctx, &model.RelationObjectsParams{
+		Namespace: "chat",
+		Relation:  "owner",
+		Subject:   model.RelationSubject{Namespace: "user", ID: "1"},
+		PageToken: page.NextPageToken,
+	})
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+
+	_, err = srv.ListObjects(ctx, &model.RelationObjectsParams{
+		Namespace: "chat",
+		Relation:  "member",
+		Subject:   model.RelationSubject{Namespace: "user", ID: "1"},
+		PageToken: "not a token",
+	})
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+}
+
+func TestWriteTuples(t *testing.T) {
+	t.Parallel()
+
+	written := []*model.RelationTuple{tuple("chat:1#member@user:2"), tuple("chat:1#group@group:eng")}
+
+	tests := []struct {
+		name                   string
+		tuples                 []*model.RelationTuple
+		token                  string
+		err                    error
+		relationRepositoryMock relationRepositoryMockFunc
+		accessServiceMock      accessServiceMockFunc
+		transactorMock         transactorMockFunc
+	}{
+		{
+			name:   "success case",
+			tuples: written,
+			token:  revisionToken(t, 8),
+			relationRepositoryMock: func(mc *minimock.Controller) repository.RelationRepository {
+				mock := repositoryMocks.NewRelationRepositoryMock(mc)
+				mock.NextRevisionMock.Expect(minimock.AnyContext).Return(8, nil)
+				mock.WriteTuplesMock.Expect(minimock.AnyContext, written, 8).Return(nil)
+				return mock
+			},
+			accessServiceMock: allowedMock(writeTuplesEndpoint),
+			transactorMock:    transactorCommitMock,
+		},
+		{
+			name:                   "unknown relation",
+			tuples:                 []*model.RelationTuple{tuple("chat:1#admin@user:2")},
+			err:                    ErrUnknownRelation,
+			relationRepositoryMock: emptyRelationRepositoryMock,
+			accessServiceMock:      allowedMock(writeTuplesEndpoint),
+			transactorMock:         emptyTransactorMock,
+		},
+		{
+			name:                   "unknown userset relation",
+			tuples:                 []*model.RelationTuple{tuple("chat:1#member@group:eng#owner")},
+			err:                    ErrUnknownRelation,
+			relationRepositoryMock: emptyRelationRepositoryMock,
+			accessServiceMock:      allowedMock(writeTuplesEndpoint),
+			transactorMock:         emptyTransactorMock,
+		},
+		{
+			name:                   "access denied",
+			tuples:                 written,
+			err:                    access.ErrAccessDenied,
+			relationRepositoryMock: emptyRelationRepositoryMock,
+			accessServiceMock:      deniedMock(writeTuplesEndpoint),
+			transactorMock:         emptyTransactorMock,
+		},
+		{
+			name:   "write error",
+			tuples: written,
+			err:    ErrFailedToWriteTuples,
+			relationRepositoryMock: func(mc *minimock.Controller) repository.RelationRepository {
+				mock := repositoryMocks.NewRelationRepositoryMock(mc)
+				mock.NextRevisionMock.Expect(minimock.AnyContext).Return(8, nil)
+				mock.WriteTuplesMock.Expect(minimock.AnyContext, written, 8).Return(errors.New("some error"))
+				return mock
+			},
+			accessServiceMock: allowedMock(writeTuplesEndpoint),
+			transactorMock:    transactorRollbackMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			srv := NewService(tt.relationRepositoryMock(mc), tt.accessServiceMock(mc),
+				transaction.NewTransactionManager(tt.transactorMock(mc)), testSchema, 10)
+
+			token, err := srv.WriteTuples(ctx, tt.tuples)
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.token, token)
+		})
+	}
+}
+
+func TestDeleteTuples(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+
+	deleted := []*model.RelationTuple{tuple("chat:1#member@user:2")}
+
+	relationRepositoryMock := repositoryMocks.NewRelationRepositoryMock(mc)
+	relationRepositoryMock.NextRevisionMock.Expect(minimock.AnyContext).Return(9, nil)
+	relationRepositoryMock.DeleteTuplesMock.Expect(minimock.AnyContext, deleted).Return(nil)
+
+	srv := NewService(relationRepositoryMock, allowedMock(deleteTuplesEndpoint)(mc),
+		transaction.NewTransactionManager(transactorCommitMock(mc)), testSchema, 10)
+
+	token, err := srv.DeleteTuples(ctx, deleted)
+	require.NoError(t, err)
+	require.Equal(t, revisionToken(t, 9), token)
+}
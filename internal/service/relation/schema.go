@@ -0,0 +1,182 @@
+package relation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// schemaFile is the JSON document describing the namespaces and their relations, e.g.:
+//
+//	{"namespaces": [
+//	  {"name": "group", "relations": [{"name": "member"}]},
+//	  {"name": "chat", "relations": [
+//	    {"name": "owner"},
+//	    {"name": "member", "computed_usersets": ["owner"],
+//	     "tuple_to_usersets": [{"tupleset": "group", "computed_userset": "member"}]}
+//	  ]}
+//	]}
+//
+// Here the members of a chat are the subjects of its member tuples, its owners, and the members of the
+// groups of its group tuples.
+type schemaFile struct {
+	Namespaces []namespaceEntry `json:"namespaces"`
+}
+
+type namespaceEntry struct {
+	Name      string     `json:"name"`
+	Relations []Relation `json:"relations"`
+}
+
+// Relation is the definition of a relation of the objects of a namespace. Beside the subjects of its tuples,
+// the subjects of a relation are the subjects of its computed usersets and of its tuple to usersets.
+type Relation struct {
+	Name string `json:"name"`
+	// ComputedUsersets are the relations of the same object whose subjects have this relation,
+	// such as the owners of a chat being members of it.
+	ComputedUsersets []string `json:"computed_usersets,omitempty"`
+	// TupleToUsersets are the relations of the objects related by a tupleset whose subjects have this relation.
+	TupleToUsersets []TupleToUserset `json:"tuple_to_usersets,omitempty"`
+}
+
+// TupleToUserset gives a relation to the subjects having the computed userset relation to the subjects
+// of the tupleset relation of the object, such as the members of the groups of a chat being members of it.
+type TupleToUserset struct {
+	Tupleset        string `json:"tupleset"`
+	ComputedUserset string `json:"computed_userset"`
+}
+
+// Schema holds the relations of every namespace by name.
+type Schema struct {
+	namespaces map[string]map[string]*Relation
+	// computedFrom maps a relation of a namespace to the relations of the namespace having it as a computed
+	// userset, and usersetFrom maps a relation to the tuple to usersets having it as their computed userset,
+	// for walking the relations backwards from a subject.
+	computedFrom map[string]map[string][]string
+	usersetFrom  map[string][]rewrite
+}
+
+// rewrite is the tuple to userset of the relation of the namespace.
+type rewrite struct {
+	namespace string
+	relation  string
+	tupleset  string
+}
+
+// LoadSchema reads the schema file, an empty path gives a schema without namespaces.
+func LoadSchema(path string) (*Schema, error) {
+	if path == "" {
+		return NewSchema(nil)
+	}
+
+	data, err := os.ReadFile(path) // #nosec G304 -- path comes from service configuration
+	if err != nil {
+		return nil, fmt.Errorf("could not read relation schema: %w", err)
+	}
+
+	var file schemaFile
+	if err = json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("could not parse relation schema: %w", err)
+	}
+
+	namespaces := make(map[string][]Relation, len(file.Namespaces))
+	for _, namespace := range file.Namespaces {
+		if _, ok := namespaces[namespace.Name]; ok {
+			return nil, fmt.Errorf("duplicate relation schema namespace %q", namespace.Name)
+		}
+		namespaces[namespace.Name] = namespace.Relations
+	}
+
+	return NewSchema(namespaces)
+}
+
+// NewSchema creates a schema of the relations of the namespaces by name,
+// checking that every relation referenced is defined.
+func NewSchema(namespaces map[string][]Relation) (*Schema, error) {
+	s := &Schema{
+		namespaces:   make(map[string]map[string]*Relation, len(namespaces)),
+		computedFrom: make(map[string]map[string][]string, len(namespaces)),
+		usersetFrom:  make(map[string][]rewrite),
+	}
+
+	for name, relations := range namespaces {
+		if name == "" {
+			return nil, errors.New("relation schema namespace name is required")
+		}
+
+		s.namespaces[name] = make(map[string]*Relation, len(relations))
+		for i := range relations {
+			relation := &relations[i]
+			if relation.Name == "" {
+				return nil, fmt.Errorf("relation schema namespace %q: relation name is required", name)
+			}
+			if _, ok := s.namespaces[name][relation.Name]; ok {
+				return nil, fmt.Errorf("relation schema namespace %q: duplicate relation %q", name, relation.Name)
+			}
+			s.namespaces[name][relation.Name] = relation
+		}
+	}
+
+	for name, relations := range s.namespaces {
+		s.computedFrom[name] = make(map[string][]string)
+		for _, relation := range relations {
+			if err := s.validate(name, relation); err != nil {
+				return nil, fmt.Errorf("relation schema relation %s#%s: %w", name, relation.Name, err)
+			}
+
+			for _, computed := range relation.ComputedUsersets {
+				s.computedFrom[name][computed] = append(s.computedFrom[name][computed], relation.Name)
+			}
+			for _, ttu := range relation.TupleToUsersets {
+				s.usersetFrom[ttu.ComputedUserset] = append(s.usersetFrom[ttu.ComputedUserset],
+					rewrite{namespace: name, relation: relation.Name, tupleset: ttu.Tupleset})
+			}
+		}
+	}
+
+	return s, nil
+}
+
+// validate checks that the relations referenced by the relation of the namespace are defined.
+func (s *Schema) validate(namespace string, relation *Relation) error {
+	for _, computed := range relation.ComputedUsersets {
+		if s.relation(namespace, computed) == nil {
+			return fmt.Errorf("undefined computed userset %q", computed)
+		}
+	}
+
+	for _, ttu := range relation.TupleToUsersets {
+		if s.relation(namespace, ttu.Tupleset) == nil {
+			return fmt.Errorf("undefined tupleset %q", ttu.Tupleset)
+		}
+		if !s.defines(ttu.ComputedUserset) {
+			return fmt.Errorf("computed userset %q is not defined by any namespace", ttu.ComputedUserset)
+		}
+	}
+
+	return nil
+}
+
+// relation returns the relation of the namespace, nil when either is not defined.
+func (s *Schema) relation(namespace, name string) *Relation {
+	return s.namespaces[namespace][name]
+}
+
+// defines reports whether a namespace defines the relation.
+func (s *Schema) defines(name string) bool {
+	for _, relations := range s.namespaces {
+		if _, ok := relations[name]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasNamespace reports whether the namespace is defined.
+func (s *Schema) hasNamespace(namespace string) bool {
+	_, ok := s.namespaces[namespace]
+
+	return ok
+}
@@ -0,0 +1,92 @@
+package relation
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadSchema(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		schema string
+		err    string
+	}{
+		{
+			name: "success case",
+			schema: `{"namespaces": [
+				{"name": "user"},
+				{"name": "group", "relations": [{"name": "member"}]},
+				{"name": "chat", "relations": [{"name": "owner"}, {"name": "group"},
+					{"name": "member", "computed_usersets": ["owner"],
+					 "tuple_to_usersets": [{"tupleset": "group", "computed_userset": "member"}]}]}
+			]}`,
+		},
+		{
+			name:   "malformed schema",
+			schema: `{"namespaces": {}}`,
+			err:    "could not parse relation schema",
+		},
+		{
+			name:   "duplicate namespace",
+			schema: `{"namespaces": [{"name": "chat"}, {"name": "chat"}]}`,
+			err:    `duplicate relation schema namespace "chat"`,
+		},
+		{
+			name:   "duplicate relation",
+			schema: `{"namespaces": [{"name": "chat", "relations": [{"name": "owner"}, {"name": "owner"}]}]}`,
+			err:    `duplicate relation "owner"`,
+		},
+		{
+			name:   "undefined computed userset",
+			schema: `{"namespaces": [{"name": "chat", "relations": [{"name": "member", "computed_usersets": ["owner"]}]}]}`,
+			err:    `chat#member: undefined computed userset "owner"`,
+		},
+		{
+			name: "undefined tupleset",
+			schema: `{"namespaces": [{"name": "chat", "relations": [{"name": "member",
+				"tuple_to_usersets": [{"tupleset": "group", "computed_userset": "member"}]}]}]}`,
+			err: `chat#member: undefined tupleset "group"`,
+		},
+		{
+			name: "undefined tuple to userset computed userset",
+			schema: `{"namespaces": [{"name": "chat", "relations": [{"name": "group"}, {"name": "member",
+				"tuple_to_usersets": [{"tupleset": "group", "computed_userset": "admin"}]}]}]}`,
+			err: `computed userset "admin" is not defined by any namespace`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "relations.json")
+			require.NoError(t, os.WriteFile(path, []byte(tt.schema), 0o600))
+
+			schema, err := LoadSchema(path)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, schema.relation("chat", "member"))
+			require.True(t, schema.hasNamespace("user"))
+		})
+	}
+}
+
+func TestLoadSchemaExample(t *testing.T) {
+	t.Parallel()
+
+	schema, err := LoadSchema("../../../relations.example.json")
+	require.NoError(t, err)
+	require.Equal(t, []string{"owner"}, schema.relation("chat", "member").ComputedUsersets)
+
+	schema, err = LoadSchema("")
+	require.NoError(t, err)
+	require.False(t, schema.hasNamespace("chat"))
+}
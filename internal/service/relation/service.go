@@ -0,0 +1,33 @@
+package relation
+
+import (
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	"github.com/8thgencore/microservice-common/pkg/db"
+)
+
+type relationService struct {
+	relationRepository repository.RelationRepository
+	accessService      service.AccessService
+	txManager          db.TxManager
+	schema             *Schema
+	// maxDepth is how deep the relation graph is traversed to check a relation.
+	maxDepth int
+}
+
+// NewService creates new object of service layer.
+func NewService(
+	relationRepository repository.RelationRepository,
+	accessService service.AccessService,
+	txManager db.TxManager,
+	schema *Schema,
+	maxDepth int,
+) service.RelationService {
+	return &relationService{
+		relationRepository: relationRepository,
+		accessService:      accessService,
+		txManager:          txManager,
+		schema:             schema,
+		maxDepth:           maxDepth,
+	}
+}
@@ -0,0 +1,335 @@
+package role
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/8thgencore/microservice-auth/internal/audit"
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-common/pkg/logger/sl"
+)
+
+// Errors
+var (
+	ErrFailedToReadRoles  = errors.New("failed to read roles")
+	ErrRoleExists         = errors.New("role already exists")
+	ErrRoleNotFound       = errors.New("role not found")
+	ErrParentRoleNotFound = errors.New("parent role not found")
+	ErrRoleCycle          = errors.New("role can not inherit itself")
+	ErrRoleInUse          = errors.New("role is allowed to access endpoints")
+	ErrBuiltinRole        = errors.New("built-in role can not be deleted")
+	ErrRoleCreate         = errors.New("failed to create role")
+	ErrRoleRead           = errors.New("failed to read role")
+	ErrRoleUpdate         = errors.New("failed to update role")
+	ErrRoleDelete         = errors.New("failed to delete role")
+)
+
+// builtinRoles are the roles the service itself relies on.
+var builtinRoles = []string{string(model.UserRoleUser), string(model.UserRoleAdmin)}
+
+// Create creates a new role inheriting its parents.
+func (s *roleService) Create(ctx context.Context, role *model.Role) error {
+	role.Parents = compact(role.Parents)
+	if slices.Contains(role.Parents, role.Name) {
+		return ErrRoleCycle
+	}
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if errTx := s.roleRepository.Create(ctx, role); errTx != nil {
+			return errTx
+		}
+
+		changes := audit.Changes{}.
+			Add("description", nil, role.Description).
+			Add("parents", nil, role.Parents)
+
+		return s.recordAudit(ctx, model.AuditActionRoleCreated, role.Name, changes)
+	})
+	if err != nil {
+		if errors.Is(err, ErrRoleExists) {
+			return ErrRoleExists
+		}
+		if errors.Is(err, ErrParentRoleNotFound) {
+			return ErrParentRoleNotFound
+		}
+
+		s.logger.Error("failed to create role", sl.Err(err))
+		return ErrRoleCreate
+	}
+
+	s.reloadHierarchy(ctx)
+
+	return nil
+}
+
+// Get returns a role by its name.
+func (s *roleService) Get(ctx context.Context, name string) (*model.Role, error) {
+	role, err := s.roleRepository.Get(ctx, name)
+	if err != nil {
+		if errors.Is(err, ErrRoleNotFound) {
+			return nil, ErrRoleNotFound
+		}
+
+		s.logger.Error("failed to read role", sl.Err(err))
+		return nil, ErrRoleRead
+	}
+
+	return role, nil
+}
+
+// List returns every role, ordered by name.
+func (s *roleService) List(ctx context.Context) ([]*model.Role, error) {
+	roles, err := s.roleRepository.List(ctx)
+	if err != nil {
+		s.logger.Error("failed to list roles", sl.Err(err))
+		return nil, ErrRoleRead
+	}
+
+	return roles, nil
+}
+
+// Update updates the description of a role and replaces its parents.
+// A role can not inherit itself, neither directly nor through its parents.
+func (s *roleService) Update(ctx context.Context, update *model.RoleUpdate) error {
+	if update.Parents != nil {
+		parents := compact(*update.Parents)
+		update.Parents = &parents
+	}
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		roles, errTx := s.roleRepository.List(ctx)
+		if errTx != nil {
+			return errTx
+		}
+
+		hierarchy := toHierarchy(roles)
+		current, ok := roleByName(roles, update.Name)
+		if !ok {
+			return ErrRoleNotFound
+		}
+
+		changes := audit.Changes{}
+		if update.Description != nil {
+			changes.Add("description", current.Description, *update.Description)
+		}
+		if update.Parents != nil {
+			for _, parent := range *update.Parents {
+				if parent == update.Name || inherits(hierarchy, parent, update.Name) {
+					return ErrRoleCycle
+				}
+			}
+			changes.Add("parents", current.Parents, *update.Parents)
+		}
+
+		if errTx = s.roleRepository.Update(ctx, update); errTx != nil {
+			return errTx
+		}
+
+		return s.recordAudit(ctx, model.AuditActionRoleUpdated, update.Name, changes)
+	})
+	if err != nil {
+		if errors.Is(err, ErrRoleNotFound) {
+			return ErrRoleNotFound
+		}
+		if errors.Is(err, ErrParentRoleNotFound) {
+			return ErrParentRoleNotFound
+		}
+		if errors.Is(err, ErrRoleCycle) {
+			return ErrRoleCycle
+		}
+
+		s.logger.Error("failed to update role", sl.Err(err))
+		return ErrRoleUpdate
+	}
+
+	s.reloadHierarchy(ctx)
+
+	return nil
+}
+
+// Delete deletes a role that no endpoint allows. The users holding it and the roles inheriting it lose it.
+func (s *roleService) Delete(ctx context.Context, name string) error {
+	if slices.Contains(builtinRoles, name) {
+		return ErrBuiltinRole
+	}
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		current, errTx := s.roleRepository.Get(ctx, name)
+		if errTx != nil {
+			return errTx
+		}
+
+		policies, errTx := s.accessRepository.GetRoleEndpoints(ctx)
+		if errTx != nil {
+			return errTx
+		}
+		for _, policy := range policies {
+			if slices.Contains(policy.Roles, name) {
+				return ErrRoleInUse
+			}
+		}
+
+		if errTx = s.roleRepository.Delete(ctx, name); errTx != nil {
+			return errTx
+		}
+
+		changes := audit.Changes{}.
+			Add("description", current.Description, nil).
+			Add("parents", current.Parents, nil)
+
+		return s.recordAudit(ctx, model.AuditActionRoleDeleted, name, changes)
+	})
+	if err != nil {
+		if errors.Is(err, ErrRoleNotFound) {
+			return ErrRoleNotFound
+		}
+		if errors.Is(err, ErrRoleInUse) {
+			return ErrRoleInUse
+		}
+
+		s.logger.Error("failed to delete role", sl.Err(err))
+		return ErrRoleDelete
+	}
+
+	s.reloadHierarchy(ctx)
+
+	return nil
+}
+
+// EffectiveRoles returns the defined roles among the given ones together with every role they inherit,
+// ordered by name. Roles that are not defined, such as deleted roles still carried by tokens, are left out.
+func (s *roleService) EffectiveRoles(roles []string) []string {
+	s.parentsMutex.RLock()
+	defer s.parentsMutex.RUnlock()
+
+	effective := make(map[string]struct{})
+	pending := slices.Clone(roles)
+	for len(pending) > 0 {
+		role := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		parents, ok := s.parents[role]
+		if !ok {
+			continue
+		}
+		if _, seen := effective[role]; seen {
+			continue
+		}
+		effective[role] = struct{}{}
+		pending = append(pending, parents...)
+	}
+
+	res := make([]string, 0, len(effective))
+	for role := range effective {
+		res = append(res, role)
+	}
+	slices.Sort(res)
+
+	return res
+}
+
+// ValidateRoles returns ErrRoleNotFound if one of the roles is not defined.
+func (s *roleService) ValidateRoles(roles []string) error {
+	s.parentsMutex.RLock()
+	defer s.parentsMutex.RUnlock()
+
+	for _, role := range roles {
+		if _, ok := s.parents[role]; !ok {
+			return ErrRoleNotFound
+		}
+	}
+
+	return nil
+}
+
+// loadHierarchy reads the roles and their parents.
+func (s *roleService) loadHierarchy(ctx context.Context) error {
+	roles, err := s.roleRepository.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.parentsMutex.Lock()
+	defer s.parentsMutex.Unlock()
+
+	s.parents = toHierarchy(roles)
+
+	return nil
+}
+
+// reloadHierarchy reads the role hierarchy again after a change. The change is already
+// committed, so a failure is only logged and the hierarchy is refreshed by the next change.
+func (s *roleService) reloadHierarchy(ctx context.Context) {
+	if err := s.loadHierarchy(ctx); err != nil {
+		s.logger.Error("failed to reload role hierarchy", sl.Err(err))
+	}
+}
+
+// recordAudit records a change of a role.
+func (s *roleService) recordAudit(
+	ctx context.Context,
+	action model.AuditAction,
+	name string,
+	changes audit.Changes,
+) error {
+	event, err := audit.NewEvent(ctx, action, model.AuditTarget{Type: model.AuditTargetRole, ID: name})
+	if err != nil {
+		return err
+	}
+	event.Changes = changes
+
+	return s.auditRepository.Record(ctx, event)
+}
+
+// toHierarchy returns the parents of the roles by name.
+func toHierarchy(roles []*model.Role) map[string][]string {
+	hierarchy := make(map[string][]string, len(roles))
+	for _, role := range roles {
+		hierarchy[role.Name] = role.Parents
+	}
+
+	return hierarchy
+}
+
+// inherits reports whether the role inherits the ancestor, directly or through other roles.
+func inherits(hierarchy map[string][]string, role, ancestor string) bool {
+	visited := make(map[string]struct{})
+	pending := []string{role}
+	for len(pending) > 0 {
+		current := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		if _, seen := visited[current]; seen {
+			continue
+		}
+		visited[current] = struct{}{}
+
+		for _, parent := range hierarchy[current] {
+			if parent == ancestor {
+				return true
+			}
+			pending = append(pending, parent)
+		}
+	}
+
+	return false
+}
+
+func roleByName(roles []*model.Role, name string) (*model.Role, bool) {
+	for _, role := range roles {
+		if role.Name == name {
+			return role, true
+		}
+	}
+
+	return nil, false
+}
+
+// compact returns the names sorted and without duplicates.
+func compact(names []string) []string {
+	names = slices.Clone(names)
+	slices.Sort(names)
+
+	return slices.Compact(names)
+}
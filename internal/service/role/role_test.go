@@ -0,0 +1,406 @@
+package role
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/8thgencore/microservice-common/pkg/db"
+	"github.com/8thgencore/microservice-common/pkg/db/transaction"
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	repositoryMocks "github.com/8thgencore/microservice-auth/internal/repository/mocks"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	dbMocks "github.com/8thgencore/microservice-common/pkg/db/mocks"
+	loggerMocks "github.com/8thgencore/microservice-common/pkg/logger/mocks"
+)
+
+var (
+	ctx = context.Background()
+
+	// roles is the hierarchy where the support role inherits the admin role
+	// and the admin and auditor roles inherit the user role.
+	roles = []*model.Role{
+		{Name: "ADMIN", Parents: []string{"USER"}},
+		{Name: "AUDITOR", Parents: []string{"USER"}},
+		{Name: "SUPPORT", Parents: []string{"ADMIN"}},
+		{Name: "USER"},
+	}
+
+	opts = pgx.TxOptions{IsoLevel: pgx.ReadCommitted}
+)
+
+type (
+	roleRepositoryMockFunc   func(mc *minimock.Controller) repository.RoleRepository
+	accessRepositoryMockFunc func(mc *minimock.Controller) repository.AccessRepository
+	auditRepositoryMockFunc  func(mc *minimock.Controller) repository.AuditRepository
+	transactorMockFunc       func(mc *minimock.Controller) db.Transactor
+)
+
+var (
+	transactorCommitMock = func(mc *minimock.Controller) db.Transactor {
+		mock := dbMocks.NewTransactorMock(mc)
+		txMock := dbMocks.NewTxMock(mc)
+		mock.BeginTxMock.Expect(minimock.AnyContext, opts).Return(txMock, nil)
+		txMock.CommitMock.Expect(minimock.AnyContext).Return(nil)
+		return mock
+	}
+
+	transactorRollbackMock = func(mc *minimock.Controller) db.Transactor {
+		mock := dbMocks.NewTransactorMock(mc)
+		txMock := dbMocks.NewTxMock(mc)
+		mock.BeginTxMock.Expect(minimock.AnyContext, opts).Return(txMock, nil)
+		txMock.RollbackMock.Expect(minimock.AnyContext).Return(nil)
+		return mock
+	}
+
+	emptyTransactorMock = func(mc *minimock.Controller) db.Transactor {
+		return dbMocks.NewTransactorMock(mc)
+	}
+
+	emptyAccessRepositoryMock = func(mc *minimock.Controller) repository.AccessRepository {
+		return repositoryMocks.NewAccessRepositoryMock(mc)
+	}
+
+	emptyAuditRepositoryMock = func(mc *minimock.Controller) repository.AuditRepository {
+		return repositoryMocks.NewAuditRepositoryMock(mc)
+	}
+)
+
+// auditedMock expects the change of a role to be recorded.
+func auditedMock(action model.AuditAction, name string) auditRepositoryMockFunc {
+	return func(mc *minimock.Controller) repository.AuditRepository {
+		mock := repositoryMocks.NewAuditRepositoryMock(mc)
+		mock.RecordMock.Set(func(_ context.Context, event *model.AuditEvent) error {
+			require.Equal(mc, action, event.Action)
+			require.Equal(mc, model.AuditTarget{Type: model.AuditTargetRole, ID: name}, event.Target)
+			return nil
+		})
+		return mock
+	}
+}
+
+// newTestService creates a service with the role hierarchy listed by the role repository mock.
+func newTestService(
+	roleRepository repository.RoleRepository,
+	accessRepository repository.AccessRepository,
+	auditRepository repository.AuditRepository,
+	transactor db.Transactor,
+) (service.RoleService, error) {
+	return NewService(ctx, loggerMocks.NewMockLogger(), roleRepository, accessRepository, auditRepository,
+		transaction.NewTransactionManager(transactor))
+}
+
+func TestNewService(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+
+	roleRepositoryMock := repositoryMocks.NewRoleRepositoryMock(mc)
+	roleRepositoryMock.ListMock.Expect(ctx).Return(nil, errors.New("some error"))
+
+	_, err := newTestService(roleRepositoryMock, emptyAccessRepositoryMock(mc), emptyAuditRepositoryMock(mc),
+		emptyTransactorMock(mc))
+	require.Equal(t, ErrFailedToReadRoles, err)
+}
+
+func TestEffectiveRoles(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+
+	roleRepositoryMock := repositoryMocks.NewRoleRepositoryMock(mc)
+	roleRepositoryMock.ListMock.Expect(ctx).Return(roles, nil)
+
+	srv, err := newTestService(roleRepositoryMock, emptyAccessRepositoryMock(mc), emptyAuditRepositoryMock(mc),
+		emptyTransactorMock(mc))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		roles []string
+		want  []string
+	}{
+		{
+			name:  "no roles case",
+			roles: nil,
+			want:  []string{},
+		},
+		{
+			name:  "root role case",
+			roles: []string{"USER"},
+			want:  []string{"USER"},
+		},
+		{
+			name:  "inherited roles case",
+			roles: []string{"SUPPORT"},
+			want:  []string{"ADMIN", "SUPPORT", "USER"},
+		},
+		{
+			name:  "shared parent case",
+			roles: []string{"AUDITOR", "ADMIN"},
+			want:  []string{"ADMIN", "AUDITOR", "USER"},
+		},
+		{
+			name:  "unknown role case",
+			roles: []string{"DELETED", "AUDITOR"},
+			want:  []string{"AUDITOR", "USER"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, srv.EffectiveRoles(tt.roles))
+		})
+	}
+
+	require.NoError(t, srv.ValidateRoles([]string{"ADMIN", "AUDITOR"}))
+	require.Equal(t, ErrRoleNotFound, srv.ValidateRoles([]string{"ADMIN", "DELETED"}))
+}
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		role = &model.Role{Name: "MODERATOR", Description: "Moderator", Parents: []string{"USER", "AUDITOR", "USER"}}
+	)
+
+	tests := []struct {
+		name               string
+		role               *model.Role
+		err                error
+		roleRepositoryMock roleRepositoryMockFunc
+		auditRepository    auditRepositoryMockFunc
+		transactorMock     transactorMockFunc
+	}{
+		{
+			name: "inherits itself error case",
+			role: &model.Role{Name: "MODERATOR", Parents: []string{"MODERATOR"}},
+			err:  ErrRoleCycle,
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repositoryMocks.NewRoleRepositoryMock(mc)
+				mock.ListMock.Expect(ctx).Return(roles, nil)
+				return mock
+			},
+			auditRepository: emptyAuditRepositoryMock,
+			transactorMock:  emptyTransactorMock,
+		},
+		{
+			name: "role exists error case",
+			role: role,
+			err:  ErrRoleExists,
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repositoryMocks.NewRoleRepositoryMock(mc)
+				mock.ListMock.Expect(ctx).Return(roles, nil)
+				mock.CreateMock.Return(ErrRoleExists)
+				return mock
+			},
+			auditRepository: emptyAuditRepositoryMock,
+			transactorMock:  transactorRollbackMock,
+		},
+		{
+			name: "repository error case",
+			role: role,
+			err:  ErrRoleCreate,
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repositoryMocks.NewRoleRepositoryMock(mc)
+				mock.ListMock.Expect(ctx).Return(roles, nil)
+				mock.CreateMock.Return(errors.New("some error"))
+				return mock
+			},
+			auditRepository: emptyAuditRepositoryMock,
+			transactorMock:  transactorRollbackMock,
+		},
+		{
+			name: "success case",
+			role: role,
+			err:  nil,
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repositoryMocks.NewRoleRepositoryMock(mc)
+				mock.ListMock.Return(roles, nil)
+				mock.CreateMock.Set(func(_ context.Context, role *model.Role) error {
+					require.Equal(mc, []string{"AUDITOR", "USER"}, role.Parents)
+					return nil
+				})
+				return mock
+			},
+			auditRepository: auditedMock(model.AuditActionRoleCreated, role.Name),
+			transactorMock:  transactorCommitMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, err := newTestService(tt.roleRepositoryMock(mc), emptyAccessRepositoryMock(mc),
+				tt.auditRepository(mc), tt.transactorMock(mc))
+			require.NoError(t, err)
+
+			err = srv.Create(ctx, tt.role)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		description = "Regular user"
+		cycle       = []string{"SUPPORT"}
+		parents     = []string{"AUDITOR"}
+	)
+
+	tests := []struct {
+		name               string
+		update             *model.RoleUpdate
+		err                error
+		roleRepositoryMock roleRepositoryMockFunc
+		auditRepository    auditRepositoryMockFunc
+		transactorMock     transactorMockFunc
+	}{
+		{
+			name:   "role not found error case",
+			update: &model.RoleUpdate{Name: "DELETED", Description: &description},
+			err:    ErrRoleNotFound,
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repositoryMocks.NewRoleRepositoryMock(mc)
+				mock.ListMock.Return(roles, nil)
+				return mock
+			},
+			auditRepository: emptyAuditRepositoryMock,
+			transactorMock:  transactorRollbackMock,
+		},
+		{
+			name:   "inherits itself through parents error case",
+			update: &model.RoleUpdate{Name: "USER", Parents: &cycle},
+			err:    ErrRoleCycle,
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repositoryMocks.NewRoleRepositoryMock(mc)
+				mock.ListMock.Return(roles, nil)
+				return mock
+			},
+			auditRepository: emptyAuditRepositoryMock,
+			transactorMock:  transactorRollbackMock,
+		},
+		{
+			name:   "success case",
+			update: &model.RoleUpdate{Name: "SUPPORT", Description: &description, Parents: &parents},
+			err:    nil,
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repositoryMocks.NewRoleRepositoryMock(mc)
+				mock.ListMock.Return(roles, nil)
+				mock.UpdateMock.Return(nil)
+				return mock
+			},
+			auditRepository: auditedMock(model.AuditActionRoleUpdated, "SUPPORT"),
+			transactorMock:  transactorCommitMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, err := newTestService(tt.roleRepositoryMock(mc), emptyAccessRepositoryMock(mc),
+				tt.auditRepository(mc), tt.transactorMock(mc))
+			require.NoError(t, err)
+
+			err = srv.Update(ctx, tt.update)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		support = &model.Role{Name: "SUPPORT", Parents: []string{"ADMIN"}}
+
+		policies = []*model.EndpointPermissions{
+			{Endpoint: "/chat_v1.ChatV1/Delete", Roles: []string{"ADMIN", "AUDITOR"}},
+		}
+	)
+
+	tests := []struct {
+		name                 string
+		role                 string
+		err                  error
+		roleRepositoryMock   roleRepositoryMockFunc
+		accessRepositoryMock accessRepositoryMockFunc
+		auditRepository      auditRepositoryMockFunc
+		transactorMock       transactorMockFunc
+	}{
+		{
+			name: "built-in role error case",
+			role: "ADMIN",
+			err:  ErrBuiltinRole,
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repositoryMocks.NewRoleRepositoryMock(mc)
+				mock.ListMock.Expect(ctx).Return(roles, nil)
+				return mock
+			},
+			accessRepositoryMock: emptyAccessRepositoryMock,
+			auditRepository:      emptyAuditRepositoryMock,
+			transactorMock:       emptyTransactorMock,
+		},
+		{
+			name: "role in use error case",
+			role: "AUDITOR",
+			err:  ErrRoleInUse,
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repositoryMocks.NewRoleRepositoryMock(mc)
+				mock.ListMock.Expect(ctx).Return(roles, nil)
+				mock.GetMock.Expect(minimock.AnyContext, "AUDITOR").Return(roles[1], nil)
+				return mock
+			},
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repositoryMocks.NewAccessRepositoryMock(mc)
+				mock.GetRoleEndpointsMock.Return(policies, nil)
+				return mock
+			},
+			auditRepository: emptyAuditRepositoryMock,
+			transactorMock:  transactorRollbackMock,
+		},
+		{
+			name: "success case",
+			role: support.Name,
+			err:  nil,
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repositoryMocks.NewRoleRepositoryMock(mc)
+				mock.ListMock.Return(roles, nil)
+				mock.GetMock.Expect(minimock.AnyContext, support.Name).Return(support, nil)
+				mock.DeleteMock.Expect(minimock.AnyContext, support.Name).Return(nil)
+				return mock
+			},
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repositoryMocks.NewAccessRepositoryMock(mc)
+				mock.GetRoleEndpointsMock.Return(policies, nil)
+				return mock
+			},
+			auditRepository: auditedMock(model.AuditActionRoleDeleted, support.Name),
+			transactorMock:  transactorCommitMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, err := newTestService(tt.roleRepositoryMock(mc), tt.accessRepositoryMock(mc),
+				tt.auditRepository(mc), tt.transactorMock(mc))
+			require.NoError(t, err)
+
+			err = srv.Delete(ctx, tt.role)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
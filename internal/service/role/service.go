@@ -0,0 +1,46 @@
+package role
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	"github.com/8thgencore/microservice-common/pkg/db"
+)
+
+type roleService struct {
+	logger           *slog.Logger
+	roleRepository   repository.RoleRepository
+	accessRepository repository.AccessRepository
+	auditRepository  repository.AuditRepository
+	txManager        db.TxManager
+	// parents are the parents of every defined role by name.
+	parents      map[string][]string
+	parentsMutex sync.RWMutex
+}
+
+// NewService creates new object of service layer and loads the role hierarchy.
+func NewService(
+	ctx context.Context,
+	logger *slog.Logger,
+	roleRepository repository.RoleRepository,
+	accessRepository repository.AccessRepository,
+	auditRepository repository.AuditRepository,
+	txManager db.TxManager,
+) (service.RoleService, error) {
+	s := &roleService{
+		logger:           logger,
+		roleRepository:   roleRepository,
+		accessRepository: accessRepository,
+		auditRepository:  auditRepository,
+		txManager:        txManager,
+	}
+
+	if err := s.loadHierarchy(ctx); err != nil {
+		return nil, ErrFailedToReadRoles
+	}
+
+	return s, nil
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/8thgencore/microservice-auth/internal/model"
 )
@@ -14,21 +15,124 @@ type UserService interface {
 	Delete(ctx context.Context, id string) error
 	EnsureAdminExists(ctx context.Context) error
 	ChangePassword(ctx context.Context, userID string, currentPassword, newPassword string) error
+	SendVerificationEmail(ctx context.Context, email string) error
+	VerifyEmail(ctx context.Context, token string) error
+	ListUsers(ctx context.Context, params *model.UserListParams) (*model.UserPage, error)
+	Deactivate(ctx context.Context, id string) error
+	Suspend(ctx context.Context, id string, until *time.Time) error
+	Restore(ctx context.Context, id string) error
+	Purge(ctx context.Context, id string) error
+	PurgeExpired(ctx context.Context) (int, error)
 }
 
 // AuthService is the interface for service communication.
 type AuthService interface {
-	Login(ctx context.Context, creds *model.UserCreds) (*model.TokenPair, error)
+	Login(ctx context.Context, creds *model.UserCreds, client *model.ClientInfo) (*model.LoginResult, error)
 	GetAccessToken(ctx context.Context, refreshToken string) (string, error)
-	GetRefreshToken(ctx context.Context, oldRefreshToken string) (string, error)
+	GetRefreshToken(ctx context.Context, oldRefreshToken string, client *model.ClientInfo) (string, error)
 	Logout(ctx context.Context, refreshToken string) error
+	LogoutAll(ctx context.Context, userID string) error
+	UnlockUser(ctx context.Context, userID string) error
+	ListSessions(ctx context.Context, userID string) ([]*model.TokenFamily, error)
+	RevokeSession(ctx context.Context, userID, sessionID string) error
+	RevokeAllSessions(ctx context.Context, userID, exceptSessionID string) error
+	BeginTotpEnrollment(ctx context.Context, userID string) (*model.TotpEnrollment, error)
+	ConfirmTotpEnrollment(ctx context.Context, userID, code string) ([]string, error)
+	DisableTotp(ctx context.Context, userID, code string) error
+	VerifyMfa(ctx context.Context, mfaToken, code string, client *model.ClientInfo) (*model.TokenPair, error)
+	BeginPasskeyRegistration(ctx context.Context, userID string) (*model.PasskeyChallenge, error)
+	FinishPasskeyRegistration(ctx context.Context, userID, ceremonyID string, credential []byte) error
+	BeginPasskeyLogin(ctx context.Context) (*model.PasskeyChallenge, error)
+	FinishPasskeyLogin(
+		ctx context.Context,
+		ceremonyID string,
+		credential []byte,
+		client *model.ClientInfo,
+	) (*model.TokenPair, error)
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, newPassword string) error
 }
 
 // AccessService is the interface for service communication.
 type AccessService interface {
-	Check(ctx context.Context, endpoint string) error
-	GetRoleEndpoints(ctx context.Context) ([]*model.EndpointPermissions, error)
-	AddRoleEndpoint(ctx context.Context, endpoint string, roles []string) error
-	UpdateRoleEndpoint(ctx context.Context, endpoint string, roles []string) error
+	// Check checks that the caller may access the endpoint, the attributes are passed to the condition of its policy.
+	// A decision is explained, or made on behalf of another user, by BatchCheck only.
+	Check(ctx context.Context, endpoint string, attributes map[string]string) error
+	// BatchCheck decides on the access of the caller, or of the user with the ID when it is set, to every endpoint.
+	BatchCheck(ctx context.Context, userID string, checks []*model.AccessCheck) ([]*model.AccessDecision, error)
+	// CheckPermission checks that the caller holds the permission.
+	CheckPermission(ctx context.Context, permission string) error
+	// CheckAs checks that the principal may access the endpoint, the caller must be an authenticated service.
+	CheckAs(ctx context.Context, principal *model.AccessPrincipal, endpoint string, attributes map[string]string) error
+	// CheckPermissionAs checks that the principal holds the permission, the caller must be an authenticated service.
+	CheckPermissionAs(ctx context.Context, principal *model.AccessPrincipal, permission string) error
+	// GetRoleEndpoints lists the access policies, or only the policies matching the endpoint when it is set,
+	// ordered from the policy applied to it to the least specific one.
+	GetRoleEndpoints(ctx context.Context, endpoint string) ([]*model.EndpointPermissions, error)
+	// AddRoleEndpoint adds the policy of an endpoint or of an endpoint pattern.
+	AddRoleEndpoint(ctx context.Context, endpoint string, roles []string, condition string) error
+	// UpdateRoleEndpoint replaces the roles of the policy of an endpoint, and its condition when it is not nil.
+	UpdateRoleEndpoint(ctx context.Context, endpoint string, roles []string, condition *string) error
 	DeleteRoleEndpoint(ctx context.Context, endpoint string) error
+	// GetDenyRules lists the deny rules, ordered by name.
+	GetDenyRules(ctx context.Context) ([]*model.DenyRule, error)
+	// AddDenyRule adds a rule denying access to an endpoint or to the endpoints matching a pattern.
+	AddDenyRule(ctx context.Context, rule *model.DenyRule) error
+	DeleteDenyRule(ctx context.Context, name string) error
+}
+
+// RelationService is the interface for the relationship-based authorization of resources.
+type RelationService interface {
+	// WriteTuples writes the relation tuples and returns the consistency token of the write.
+	WriteTuples(ctx context.Context, tuples []*model.RelationTuple) (string, error)
+	// DeleteTuples deletes the relation tuples and returns the consistency token of the delete.
+	DeleteTuples(ctx context.Context, tuples []*model.RelationTuple) (string, error)
+	// CheckRelation reports whether the subject of the tuple has its relation to its object, seeing at least
+	// the writes up to the consistency token when it is set. It returns the consistency token of the check.
+	CheckRelation(ctx context.Context, tuple *model.RelationTuple, token string) (bool, string, error)
+	// ListObjects returns a page of the IDs of the objects of the namespace the subject has the relation to,
+	// seeing at least the writes up to the consistency token when it is set.
+	ListObjects(ctx context.Context, params *model.RelationObjectsParams) (*model.RelationObjectsPage, error)
+}
+
+// RoleService is the interface for roles service communication.
+type RoleService interface {
+	Create(ctx context.Context, role *model.Role) error
+	Get(ctx context.Context, name string) (*model.Role, error)
+	List(ctx context.Context) ([]*model.Role, error)
+	Update(ctx context.Context, update *model.RoleUpdate) error
+	Delete(ctx context.Context, name string) error
+	// EffectiveRoles returns the defined roles among the given ones together with every role they inherit.
+	EffectiveRoles(roles []string) []string
+	// ValidateRoles returns an error if one of the roles is not defined.
+	ValidateRoles(roles []string) error
+}
+
+// PermissionService is the interface for permissions service communication.
+type PermissionService interface {
+	Create(ctx context.Context, permission *model.Permission) error
+	Get(ctx context.Context, name string) (*model.Permission, error)
+	List(ctx context.Context) ([]*model.Permission, error)
+	Update(ctx context.Context, update *model.PermissionUpdate) error
+	Delete(ctx context.Context, name string) error
+	// RolePermissions returns the permissions held by the roles and the roles they inherit, ordered by name.
+	RolePermissions(roles []string) []string
+	// EndpointPermissions returns the permissions granting access to the endpoint.
+	EndpointPermissions(endpoint string) []string
+}
+
+// AuditService is the interface for audit log service communication.
+type AuditService interface {
+	// ListEvents returns a page of the audit events matching the filter, newest first.
+	ListEvents(ctx context.Context, params *model.AuditListParams) (*model.AuditPage, error)
+	// VerifyChain walks the hash chain of the audit log and reports its first broken link.
+	VerifyChain(ctx context.Context) (*model.AuditChainVerification, error)
+	// SealChain links the events recorded since the last seal to the hash chain and returns how many it linked.
+	SealChain(ctx context.Context) (int, error)
+}
+
+// NotificationService is the interface for outbound notifications.
+type NotificationService interface {
+	Notify(ctx context.Context, recipient, template, locale string, data any) error
+	DispatchPending(ctx context.Context) error
 }
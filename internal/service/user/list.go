@@ -0,0 +1,150 @@
+package user
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-common/pkg/logger/sl"
+)
+
+// Page sizes of user listings
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+// Listing errors
+var (
+	ErrInvalidPageToken = errors.New("invalid page token")
+	ErrUserList         = errors.New("failed to list users")
+)
+
+// pageToken is the content of an opaque page token: the cursor of the last listed user and
+// what the listing was made with, so a token can not be used to continue a different listing.
+type pageToken struct {
+	OrderBy    model.UserOrderBy `json:"o"`
+	Descending bool              `json:"d"`
+	Filter     string            `json:"f"`
+	Value      string            `json:"v,omitempty"`
+	ID         string            `json:"id"`
+}
+
+// ListUsers returns a page of the users matching the filter in the requested order.
+func (s *userService) ListUsers(ctx context.Context, params *model.UserListParams) (*model.UserPage, error) {
+	if params.OrderBy == "" {
+		params.OrderBy = model.UserOrderByID
+	}
+
+	pageSize := params.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	pageSize = min(pageSize, maxPageSize)
+
+	filter := filterFingerprint(&params.Filter)
+
+	query := &model.UserListQuery{
+		Filter:     params.Filter,
+		OrderBy:    params.OrderBy,
+		Descending: params.Descending,
+		// One more user than asked for tells whether there is a next page.
+		Limit: pageSize + 1,
+	}
+	if params.PageToken != "" {
+		token, err := decodePageToken(params.PageToken)
+		if err != nil || token.OrderBy != params.OrderBy || token.Descending != params.Descending ||
+			token.Filter != filter {
+			return nil, ErrInvalidPageToken
+		}
+		query.After = &model.UserCursor{Value: token.Value, ID: token.ID}
+	}
+
+	users, err := s.userRepository.List(ctx, query)
+	if err != nil {
+		s.logger.Error("failed to list users", sl.Err(err))
+		return nil, ErrUserList
+	}
+
+	totalSize, err := s.userRepository.Count(ctx, &params.Filter)
+	if err != nil {
+		s.logger.Error("failed to count users", sl.Err(err))
+		return nil, ErrUserList
+	}
+
+	page := &model.UserPage{
+		Users:     users,
+		TotalSize: totalSize,
+	}
+	if len(users) > pageSize {
+		page.Users = users[:pageSize]
+		last := page.Users[pageSize-1]
+
+		page.NextPageToken, err = encodePageToken(&pageToken{
+			OrderBy:    params.OrderBy,
+			Descending: params.Descending,
+			Filter:     filter,
+			Value:      cursorValue(last, params.OrderBy),
+			ID:         last.ID,
+		})
+		if err != nil {
+			s.logger.Error("failed to encode page token", sl.Err(err))
+			return nil, ErrUserList
+		}
+	}
+
+	return page, nil
+}
+
+// cursorValue returns the value of the ordering field of the user.
+func cursorValue(user *model.User, orderBy model.UserOrderBy) string {
+	switch orderBy {
+	case model.UserOrderByName:
+		return user.Name
+	case model.UserOrderByEmail:
+		return user.Email
+	case model.UserOrderByCreatedAt:
+		return user.CreatedAt.Format(time.RFC3339Nano)
+	default:
+		return ""
+	}
+}
+
+// filterFingerprint returns a short digest of the filter.
+func filterFingerprint(filter *model.UserFilter) string {
+	data, _ := json.Marshal(filter)
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:8])
+}
+
+func encodePageToken(token *pageToken) (string, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodePageToken(raw string) (*pageToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var token pageToken
+	if err = json.Unmarshal(data, &token); err != nil {
+		return nil, err
+	}
+	if token.ID == "" {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &token, nil
+}
@@ -0,0 +1,279 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/8thgencore/microservice-common/pkg/db/transaction"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	repositoryMocks "github.com/8thgencore/microservice-auth/internal/repository/mocks"
+	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
+	dbMocks "github.com/8thgencore/microservice-common/pkg/db/mocks"
+)
+
+func newListService(mc *minimock.Controller, userRepository repository.UserRepository) *userService {
+	return newTestService(
+		userRepository,
+		repositoryMocks.NewAuditRepositoryMock(mc),
+		repositoryMocks.NewTokenRepositoryMock(mc),
+		repositoryMocks.NewEmailVerificationRepositoryMock(mc),
+		nil,
+		serviceMocks.NewNotificationServiceMock(mc),
+		transaction.NewTransactionManager(dbMocks.NewTransactorMock(mc)),
+		adminConfig,
+		verificationConfig,
+		retentionConfig,
+	).(*userService)
+}
+
+// listedUsers returns users whose names sort in the reverse order of their IDs.
+func listedUsers(n int) []*model.User {
+	users := make([]*model.User, 0, n)
+	for i := range n {
+		users = append(users, &model.User{
+			ID:        fmt.Sprintf("id-%02d", i),
+			Name:      fmt.Sprintf("name-%02d", n-i),
+			CreatedAt: time.Date(2025, 1, 1, 0, 0, i, 0, time.UTC),
+		})
+	}
+
+	return users
+}
+
+func TestListUsersPages(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx   = context.Background()
+		mc    = minimock.NewController(t)
+		users = listedUsers(7)
+	)
+
+	// The repository mock lists the users by name after the cursor, like the database would.
+	userRepositoryMock := repositoryMocks.NewUserRepositoryMock(mc)
+	userRepositoryMock.ListMock.Set(func(_ context.Context, query *model.UserListQuery) ([]*model.User, error) {
+		require.Equal(mc, model.UserOrderByName, query.OrderBy)
+		require.Equal(mc, "name-", query.Filter.NamePrefix)
+
+		sorted := append([]*model.User(nil), users...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+		var page []*model.User
+		for _, user := range sorted {
+			if query.After != nil && user.Name <= query.After.Value {
+				continue
+			}
+			if len(page) < query.Limit {
+				page = append(page, user)
+			}
+		}
+		return page, nil
+	})
+	userRepositoryMock.CountMock.Return(int64(len(users)), nil)
+
+	srv := newListService(mc, userRepositoryMock)
+
+	params := &model.UserListParams{
+		Filter:   model.UserFilter{NamePrefix: "name-"},
+		OrderBy:  model.UserOrderByName,
+		PageSize: 3,
+	}
+
+	var names []string
+	for pages := 1; ; pages++ {
+		page, err := srv.ListUsers(ctx, params)
+		require.NoError(t, err)
+		require.Equal(t, int64(7), page.TotalSize)
+		require.LessOrEqual(t, len(page.Users), 3)
+
+		for _, user := range page.Users {
+			names = append(names, user.Name)
+		}
+		if page.NextPageToken == "" {
+			require.Equal(t, 3, pages)
+			break
+		}
+		params.PageToken = page.NextPageToken
+	}
+
+	require.Equal(t, []string{
+		"name-01", "name-02", "name-03", "name-04", "name-05", "name-06", "name-07",
+	}, names)
+}
+
+func TestListUsers(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx   = context.Background()
+		mc    = minimock.NewController(t)
+		users = listedUsers(3)
+	)
+
+	nextPageToken, err := encodePageToken(&pageToken{
+		OrderBy:    model.UserOrderByCreatedAt,
+		Descending: true,
+		Filter:     filterFingerprint(&model.UserFilter{Role: "USER"}),
+		Value:      users[1].CreatedAt.Format(time.RFC3339Nano),
+		ID:         users[1].ID,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		params             *model.UserListParams
+		want               *model.UserPage
+		err                error
+		userRepositoryMock userRepositoryMockFunc
+	}{
+		{
+			name: "first page case",
+			params: &model.UserListParams{
+				Filter:     model.UserFilter{Role: "USER"},
+				OrderBy:    model.UserOrderByCreatedAt,
+				Descending: true,
+				PageSize:   2,
+			},
+			want: &model.UserPage{
+				Users:         users[:2],
+				NextPageToken: nextPageToken,
+				TotalSize:     3,
+			},
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.ListMock.Expect(ctx, &model.UserListQuery{
+					Filter:     model.UserFilter{Role: "USER"},
+					OrderBy:    model.UserOrderByCreatedAt,
+					Descending: true,
+					Limit:      3,
+				}).Return(users, nil)
+				mock.CountMock.Expect(ctx, &model.UserFilter{Role: "USER"}).Return(3, nil)
+				return mock
+			},
+		},
+		{
+			name: "last page case",
+			params: &model.UserListParams{
+				Filter:     model.UserFilter{Role: "USER"},
+				OrderBy:    model.UserOrderByCreatedAt,
+				Descending: true,
+				PageSize:   2,
+				PageToken:  nextPageToken,
+			},
+			want: &model.UserPage{
+				Users:     users[2:],
+				TotalSize: 3,
+			},
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.ListMock.Expect(ctx, &model.UserListQuery{
+					Filter:     model.UserFilter{Role: "USER"},
+					OrderBy:    model.UserOrderByCreatedAt,
+					Descending: true,
+					After: &model.UserCursor{
+						Value: users[1].CreatedAt.Format(time.RFC3339Nano),
+						ID:    users[1].ID,
+					},
+					Limit: 3,
+				}).Return(users[2:], nil)
+				mock.CountMock.Return(3, nil)
+				return mock
+			},
+		},
+		{
+			name: "default page size case",
+			params: &model.UserListParams{
+				PageSize: 0,
+			},
+			want: &model.UserPage{
+				Users:     users,
+				TotalSize: 3,
+			},
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.ListMock.Expect(ctx, &model.UserListQuery{
+					OrderBy: model.UserOrderByID,
+					Limit:   defaultPageSize + 1,
+				}).Return(users, nil)
+				mock.CountMock.Return(3, nil)
+				return mock
+			},
+		},
+		{
+			name: "malformed page token case",
+			params: &model.UserListParams{
+				PageToken: "not a token",
+			},
+			err: ErrInvalidPageToken,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+		},
+		{
+			name: "page token of other filter case",
+			params: &model.UserListParams{
+				Filter:     model.UserFilter{Role: "ADMIN"},
+				OrderBy:    model.UserOrderByCreatedAt,
+				Descending: true,
+				PageToken:  nextPageToken,
+			},
+			err: ErrInvalidPageToken,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+		},
+		{
+			name: "page token of other order case",
+			params: &model.UserListParams{
+				Filter:    model.UserFilter{Role: "USER"},
+				OrderBy:   model.UserOrderByCreatedAt,
+				PageToken: nextPageToken,
+			},
+			err: ErrInvalidPageToken,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+		},
+		{
+			name:   "list error case",
+			params: &model.UserListParams{},
+			err:    ErrUserList,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.ListMock.Return(nil, errors.New("db error"))
+				return mock
+			},
+		},
+		{
+			name:   "count error case",
+			params: &model.UserListParams{},
+			err:    ErrUserList,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.ListMock.Return(users, nil)
+				mock.CountMock.Return(0, errors.New("db error"))
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newListService(mc, tt.userRepositoryMock(mc))
+
+			page, err := srv.ListUsers(ctx, tt.params)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, page)
+		})
+	}
+}
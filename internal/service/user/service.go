@@ -13,33 +13,45 @@ import (
 )
 
 type userService struct {
-	logger          *slog.Logger
-	userRepository  repository.UserRepository
-	logRepository   repository.LogRepository
-	tokenRepository repository.TokenRepository
-	tokenOperations tokens.TokenOperations
-	txManager       db.TxManager
-	adminConfig     *config.AdminConfig
+	logger                 *slog.Logger
+	userRepository         repository.UserRepository
+	auditRepository        repository.AuditRepository
+	tokenRepository        repository.TokenRepository
+	verificationRepository repository.EmailVerificationRepository
+	tokenOperations        tokens.TokenOperations
+	notificationService    service.NotificationService
+	txManager              db.TxManager
+	adminConfig            *config.AdminConfig
+	verificationConfig     *config.EmailVerificationConfig
+	retentionConfig        *config.UserRetentionConfig
 }
 
 // NewService creates new object of service layer and ensures admin user exists.
 func NewService(
 	logger *slog.Logger,
 	userRepository repository.UserRepository,
-	logRepository repository.LogRepository,
+	auditRepository repository.AuditRepository,
 	tokenRepository repository.TokenRepository,
+	verificationRepository repository.EmailVerificationRepository,
 	tokenOperations tokens.TokenOperations,
+	notificationService service.NotificationService,
 	txManager db.TxManager,
 	adminConfig *config.AdminConfig,
+	verificationConfig *config.EmailVerificationConfig,
+	retentionConfig *config.UserRetentionConfig,
 ) service.UserService {
 	s := &userService{
-		logger:          logger,
-		userRepository:  userRepository,
-		logRepository:   logRepository,
-		tokenRepository: tokenRepository,
-		tokenOperations: tokenOperations,
-		txManager:       txManager,
-		adminConfig:     adminConfig,
+		logger:                 logger,
+		userRepository:         userRepository,
+		auditRepository:        auditRepository,
+		tokenRepository:        tokenRepository,
+		verificationRepository: verificationRepository,
+		tokenOperations:        tokenOperations,
+		notificationService:    notificationService,
+		txManager:              txManager,
+		adminConfig:            adminConfig,
+		verificationConfig:     verificationConfig,
+		retentionConfig:        retentionConfig,
 	}
 
 	// Ensure admin exists during service initialization
@@ -54,21 +66,29 @@ func NewService(
 // newTestService creates service instance without admin check (for testing only)
 func newTestService(
 	userRepository repository.UserRepository,
-	logRepository repository.LogRepository,
+	auditRepository repository.AuditRepository,
 	tokenRepository repository.TokenRepository,
+	verificationRepository repository.EmailVerificationRepository,
 	tokenOperations tokens.TokenOperations,
+	notificationService service.NotificationService,
 	txManager db.TxManager,
 	adminConfig *config.AdminConfig,
+	verificationConfig *config.EmailVerificationConfig,
+	retentionConfig *config.UserRetentionConfig,
 ) service.UserService {
 	mockLogger := loggerMocks.NewMockLogger()
 
 	return &userService{
-		logger:          mockLogger,
-		userRepository:  userRepository,
-		logRepository:   logRepository,
-		tokenRepository: tokenRepository,
-		tokenOperations: tokenOperations,
-		txManager:       txManager,
-		adminConfig:     adminConfig,
+		logger:                 mockLogger,
+		userRepository:         userRepository,
+		auditRepository:        auditRepository,
+		tokenRepository:        tokenRepository,
+		verificationRepository: verificationRepository,
+		tokenOperations:        tokenOperations,
+		notificationService:    notificationService,
+		txManager:              txManager,
+		adminConfig:            adminConfig,
+		verificationConfig:     verificationConfig,
+		retentionConfig:        retentionConfig,
 	}
 }
@@ -0,0 +1,212 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/8thgencore/microservice-common/pkg/logger/sl"
+
+	"github.com/8thgencore/microservice-auth/internal/audit"
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/tokens"
+)
+
+// Account status errors
+var (
+	ErrUserNotDeleted = errors.New("user is not deleted")
+	ErrUserDeleted    = errors.New("user is deleted")
+	ErrUserStatus     = errors.New("failed to change user status")
+	ErrUserPurge      = errors.New("failed to purge user")
+)
+
+// Deactivate handles the deletion of their own account by a user. Like a deletion by an admin,
+// the user can be restored until they are purged after the retention period.
+func (s *userService) Deactivate(ctx context.Context, id string) error {
+	err := s.changeStatus(ctx, id, &model.UserStatusChange{
+		Status:    model.UserStatusDeactivated,
+		DeletedAt: sql.NullTime{Time: time.Now(), Valid: true},
+	}, model.AuditActionUserDeactivated, nil)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+
+		s.logger.Error("failed to deactivate user", sl.Err(err))
+		return ErrUserDelete
+	}
+
+	return nil
+}
+
+// Suspend prevents the user from signing in until the time, or until they are restored if it is nil.
+// Deleted users can not be suspended, they have to be restored first.
+func (s *userService) Suspend(ctx context.Context, id string, until *time.Time) error {
+	change := &model.UserStatusChange{Status: model.UserStatusSuspended}
+	if until != nil {
+		change.SuspendedUntil = sql.NullTime{Time: *until, Valid: true}
+	}
+
+	err := s.changeStatus(ctx, id, change, model.AuditActionUserSuspended, func(user *model.User) error {
+		if user.DeletedAt.Valid {
+			return ErrUserDeleted
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+		if errors.Is(err, ErrUserDeleted) {
+			return ErrUserDeleted
+		}
+
+		s.logger.Error("failed to suspend user", sl.Err(err))
+		return ErrUserStatus
+	}
+
+	return nil
+}
+
+// Restore makes a suspended, deactivated or deleted user active again.
+func (s *userService) Restore(ctx context.Context, id string) error {
+	err := s.changeStatus(ctx, id, &model.UserStatusChange{Status: model.UserStatusActive},
+		model.AuditActionUserRestored, nil)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+
+		s.logger.Error("failed to restore user", sl.Err(err))
+		return ErrUserStatus
+	}
+
+	return nil
+}
+
+// Purge permanently deletes a deleted or deactivated user before the end of the retention period.
+func (s *userService) Purge(ctx context.Context, id string) error {
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		user, errTx := s.userRepository.Get(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+		if !user.DeletedAt.Valid {
+			return ErrUserNotDeleted
+		}
+
+		if errTx = s.userRepository.Delete(ctx, id); errTx != nil {
+			return errTx
+		}
+
+		return s.recordAudit(ctx, model.AuditActionUserPurged, id, nil)
+	})
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+		if errors.Is(err, ErrUserNotDeleted) {
+			return ErrUserNotDeleted
+		}
+
+		s.logger.Error("failed to purge user", sl.Err(err))
+		return ErrUserPurge
+	}
+
+	return nil
+}
+
+// PurgeExpired permanently deletes the users that were deleted or deactivated longer than
+// the retention period ago and returns how many were purged.
+func (s *userService) PurgeExpired(ctx context.Context) (int, error) {
+	deletedBefore := time.Now().Add(-s.retentionConfig.Period)
+
+	purged := 0
+	for {
+		var ids []string
+		err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+			var errTx error
+			ids, errTx = s.userRepository.PurgeDeleted(ctx, deletedBefore, s.retentionConfig.PurgeBatchSize)
+			if errTx != nil {
+				return errTx
+			}
+
+			for _, id := range ids {
+				if errTx = s.recordAudit(ctx, model.AuditActionUserPurged, id, nil); errTx != nil {
+					return errTx
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			s.logger.Error("failed to purge users", sl.Err(err))
+			return purged, ErrUserPurge
+		}
+
+		purged += len(ids)
+		if len(ids) < s.retentionConfig.PurgeBatchSize {
+			return purged, nil
+		}
+	}
+}
+
+// changeStatus changes the status of the user if check, when given, accepts the current user.
+// The token version is bumped with it, so no token issued before the change is accepted anymore.
+func (s *userService) changeStatus(
+	ctx context.Context,
+	id string,
+	change *model.UserStatusChange,
+	action model.AuditAction,
+	check func(user *model.User) error,
+) error {
+	var version int
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		// The former status is only known when the user is read for the check.
+		var status any
+		if check != nil {
+			user, errTx := s.userRepository.Get(ctx, id)
+			if errTx != nil {
+				return errTx
+			}
+			if errTx = check(user); errTx != nil {
+				return errTx
+			}
+			status = user.Status
+		}
+
+		var errTx error
+		version, errTx = s.userRepository.SetStatus(ctx, id, change)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.recordAudit(ctx, action, id, statusChanges(status, change))
+	})
+	if err != nil {
+		return err
+	}
+
+	// The access tokens of the user are revoked once their cached version is replaced.
+	if err = tokens.StoreVersion(ctx, s.tokenRepository, id, version); err != nil {
+		s.logger.Error("failed to cache token version", slog.String("user_id", id), sl.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// statusChanges returns the changes of the status fields of a user, from is their former status if known.
+func statusChanges(from any, change *model.UserStatusChange) audit.Changes {
+	changes := audit.Changes{}.Add("status", from, change.Status)
+	if change.SuspendedUntil.Valid {
+		changes.Add("suspended_until", nil, change.SuspendedUntil.Time)
+	}
+	if change.DeletedAt.Valid {
+		changes.Add("deleted_at", nil, change.DeletedAt.Time)
+	}
+
+	return changes
+}
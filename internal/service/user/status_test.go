@@ -0,0 +1,484 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/8thgencore/microservice-common/pkg/db"
+	"github.com/8thgencore/microservice-common/pkg/db/transaction"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	repositoryMocks "github.com/8thgencore/microservice-auth/internal/repository/mocks"
+	dbMocks "github.com/8thgencore/microservice-common/pkg/db/mocks"
+)
+
+func newStatusService(
+	mc *minimock.Controller,
+	userRepository repository.UserRepository,
+	auditRepository repository.AuditRepository,
+	tokenRepository repository.TokenRepository,
+	transactor db.Transactor,
+) *userService {
+	return newTestService(
+		userRepository,
+		auditRepository,
+		tokenRepository,
+		repositoryMocks.NewEmailVerificationRepositoryMock(mc),
+		nil,
+		nil,
+		transaction.NewTransactionManager(transactor),
+		adminConfig,
+		verificationConfig,
+		retentionConfig,
+	).(*userService)
+}
+
+// auditedMock expects the action to be recorded on the users in order.
+func auditedMock(mc *minimock.Controller, action model.AuditAction, ids ...string) repository.AuditRepository {
+	mock := repositoryMocks.NewAuditRepositoryMock(mc)
+	mock.RecordMock.Set(func(_ context.Context, event *model.AuditEvent) error {
+		require.NotEmpty(mc, ids)
+		require.Equal(mc, action, event.Action)
+		require.Equal(mc, model.AuditTarget{Type: model.AuditTargetUser, ID: ids[0]}, event.Target)
+		ids = ids[1:]
+		return nil
+	})
+	return mock
+}
+
+// versionCachedMock expects the new token version of the user to be cached.
+func versionCachedMock(mc *minimock.Controller, version int) repository.TokenRepository {
+	mock := repositoryMocks.NewTokenRepositoryMock(mc)
+	mock.SetTokenVersionMock.Expect(minimock.AnyContext, id, version).Return(nil)
+	return mock
+}
+
+func TestSuspend(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		until       = time.Now().Add(time.Hour)
+		activeUser  = &model.User{ID: id, Status: model.UserStatusActive}
+		deletedUser = &model.User{
+			ID:        id,
+			Status:    model.UserStatusDeleted,
+			DeletedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		}
+	)
+
+	tests := []struct {
+		name                string
+		until               *time.Time
+		err                 error
+		userRepositoryMock  userRepositoryMockFunc
+		auditRepositoryMock auditRepositoryMockFunc
+		tokenRepositoryMock tokenRepositoryMockFunc
+		transactorMock      transactorMockFunc
+	}{
+		{
+			name:  "success case",
+			until: &until,
+			err:   nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(activeUser, nil)
+				mock.SetStatusMock.Expect(minimock.AnyContext, id, &model.UserStatusChange{
+					Status:         model.UserStatusSuspended,
+					SuspendedUntil: sql.NullTime{Time: until, Valid: true},
+				}).Return(2, nil)
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return auditedMock(mc, model.AuditActionUserSuspended, id)
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				return versionCachedMock(mc, 2)
+			},
+			transactorMock: transactorCommitMock,
+		},
+		{
+			name: "without end case",
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(activeUser, nil)
+				mock.SetStatusMock.Expect(minimock.AnyContext, id, &model.UserStatusChange{
+					Status: model.UserStatusSuspended,
+				}).Return(2, nil)
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return auditedMock(mc, model.AuditActionUserSuspended, id)
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				return versionCachedMock(mc, 2)
+			},
+			transactorMock: transactorCommitMock,
+		},
+		{
+			name:  "deleted user case",
+			until: &until,
+			err:   ErrUserDeleted,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(deletedUser, nil)
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return repositoryMocks.NewAuditRepositoryMock(mc)
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				return repositoryMocks.NewTokenRepositoryMock(mc)
+			},
+			transactorMock: transactorRollbackMock,
+		},
+		{
+			name: "user not found case",
+			err:  ErrUserNotFound,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(nil, ErrUserNotFound)
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return repositoryMocks.NewAuditRepositoryMock(mc)
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				return repositoryMocks.NewTokenRepositoryMock(mc)
+			},
+			transactorMock: transactorRollbackMock,
+		},
+		{
+			name: "user repository error case",
+			err:  ErrUserStatus,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(activeUser, nil)
+				mock.SetStatusMock.Return(0, errors.New("db error"))
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return repositoryMocks.NewAuditRepositoryMock(mc)
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				return repositoryMocks.NewTokenRepositoryMock(mc)
+			},
+			transactorMock: transactorRollbackMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newStatusService(
+				mc,
+				tt.userRepositoryMock(mc),
+				tt.auditRepositoryMock(mc),
+				tt.tokenRepositoryMock(mc),
+				tt.transactorMock(mc),
+			)
+
+			err := srv.Suspend(ctx, id, tt.until)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestDeactivate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+	)
+
+	userRepositoryMock := repositoryMocks.NewUserRepositoryMock(mc)
+	userRepositoryMock.SetStatusMock.Set(func(_ context.Context, _ string, change *model.UserStatusChange) (int, error) {
+		require.Equal(mc, model.UserStatusDeactivated, change.Status)
+		require.True(mc, change.DeletedAt.Valid)
+		return 2, nil
+	})
+
+	srv := newStatusService(
+		mc,
+		userRepositoryMock,
+		auditedMock(mc, model.AuditActionUserDeactivated, id),
+		versionCachedMock(mc, 2),
+		transactorCommitMock(mc),
+	)
+
+	err := srv.Deactivate(ctx, id)
+	require.NoError(t, err)
+}
+
+func TestDeactivateCacheFailure(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+	)
+
+	userRepositoryMock := repositoryMocks.NewUserRepositoryMock(mc)
+	userRepositoryMock.SetStatusMock.Return(2, nil)
+	tokenRepositoryMock := repositoryMocks.NewTokenRepositoryMock(mc)
+	tokenRepositoryMock.SetTokenVersionMock.Expect(minimock.AnyContext, id, 2).Return(errors.New("cache error"))
+	tokenRepositoryMock.DeleteTokenVersionMock.Expect(minimock.AnyContext, id).Return(nil)
+
+	srv := newStatusService(
+		mc,
+		userRepositoryMock,
+		auditedMock(mc, model.AuditActionUserDeactivated, id),
+		tokenRepositoryMock,
+		transactorCommitMock(mc),
+	)
+
+	// The cached version is removed, so the version committed to the database revokes the tokens.
+	err := srv.Deactivate(ctx, id)
+	require.NoError(t, err)
+}
+
+func TestRestore(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+	)
+
+	tests := []struct {
+		name                string
+		err                 error
+		userRepositoryMock  userRepositoryMockFunc
+		auditRepositoryMock auditRepositoryMockFunc
+		tokenRepositoryMock tokenRepositoryMockFunc
+		transactorMock      transactorMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.SetStatusMock.Expect(minimock.AnyContext, id, &model.UserStatusChange{
+					Status: model.UserStatusActive,
+				}).Return(2, nil)
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return auditedMock(mc, model.AuditActionUserRestored, id)
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				return versionCachedMock(mc, 2)
+			},
+			transactorMock: transactorCommitMock,
+		},
+		{
+			name: "user not found case",
+			err:  ErrUserNotFound,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.SetStatusMock.Return(0, ErrUserNotFound)
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return repositoryMocks.NewAuditRepositoryMock(mc)
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				return repositoryMocks.NewTokenRepositoryMock(mc)
+			},
+			transactorMock: transactorRollbackMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newStatusService(
+				mc,
+				tt.userRepositoryMock(mc),
+				tt.auditRepositoryMock(mc),
+				tt.tokenRepositoryMock(mc),
+				tt.transactorMock(mc),
+			)
+
+			err := srv.Restore(ctx, id)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestPurge(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		activeUser      = &model.User{ID: id, Status: model.UserStatusActive}
+		deactivatedUser = &model.User{
+			ID:        id,
+			Status:    model.UserStatusDeactivated,
+			DeletedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		}
+	)
+
+	tests := []struct {
+		name                string
+		err                 error
+		userRepositoryMock  userRepositoryMockFunc
+		auditRepositoryMock auditRepositoryMockFunc
+		transactorMock      transactorMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(deactivatedUser, nil)
+				mock.DeleteMock.Expect(minimock.AnyContext, id).Return(nil)
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return auditedMock(mc, model.AuditActionUserPurged, id)
+			},
+			transactorMock: transactorCommitMock,
+		},
+		{
+			name: "user not deleted case",
+			err:  ErrUserNotDeleted,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(activeUser, nil)
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return repositoryMocks.NewAuditRepositoryMock(mc)
+			},
+			transactorMock: transactorRollbackMock,
+		},
+		{
+			name: "user not found case",
+			err:  ErrUserNotFound,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(nil, ErrUserNotFound)
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return repositoryMocks.NewAuditRepositoryMock(mc)
+			},
+			transactorMock: transactorRollbackMock,
+		},
+		{
+			name: "delete error case",
+			err:  ErrUserPurge,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(deactivatedUser, nil)
+				mock.DeleteMock.Return(errors.New("db error"))
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return repositoryMocks.NewAuditRepositoryMock(mc)
+			},
+			transactorMock: transactorRollbackMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newStatusService(
+				mc,
+				tt.userRepositoryMock(mc),
+				tt.auditRepositoryMock(mc),
+				repositoryMocks.NewTokenRepositoryMock(mc),
+				tt.transactorMock(mc),
+			)
+
+			err := srv.Purge(ctx, id)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestPurgeExpired(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+	)
+
+	// transactorsMock commits the given number of transactions.
+	transactorsMock := func(mc *minimock.Controller, commits int) db.Transactor {
+		mock := dbMocks.NewTransactorMock(mc)
+		txMock := dbMocks.NewTxMock(mc)
+		mock.BeginTxMock.Times(uint64(commits)).Expect(minimock.AnyContext, opts).Return(txMock, nil)
+		txMock.CommitMock.Times(uint64(commits)).Expect(minimock.AnyContext).Return(nil)
+		return mock
+	}
+
+	t.Run("batches case", func(t *testing.T) {
+		t.Parallel()
+
+		batches := [][]string{{"uuid1", "uuid2"}, {"uuid3"}}
+
+		userRepositoryMock := repositoryMocks.NewUserRepositoryMock(mc)
+		userRepositoryMock.PurgeDeletedMock.Set(func(
+			_ context.Context,
+			deletedBefore time.Time,
+			limit int,
+		) ([]string, error) {
+			require.WithinDuration(mc, time.Now().Add(-retentionConfig.Period), deletedBefore, time.Minute)
+			require.Equal(mc, retentionConfig.PurgeBatchSize, limit)
+
+			batch := batches[0]
+			batches = batches[1:]
+			return batch, nil
+		})
+
+		srv := newStatusService(
+			mc,
+			userRepositoryMock,
+			auditedMock(mc, model.AuditActionUserPurged, "uuid1", "uuid2", "uuid3"),
+			repositoryMocks.NewTokenRepositoryMock(mc),
+			transactorsMock(mc, 2),
+		)
+
+		purged, err := srv.PurgeExpired(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 3, purged)
+	})
+
+	t.Run("purge error case", func(t *testing.T) {
+		t.Parallel()
+
+		userRepositoryMock := repositoryMocks.NewUserRepositoryMock(mc)
+		userRepositoryMock.PurgeDeletedMock.Return(nil, errors.New("db error"))
+
+		srv := newStatusService(
+			mc,
+			userRepositoryMock,
+			repositoryMocks.NewAuditRepositoryMock(mc),
+			repositoryMocks.NewTokenRepositoryMock(mc),
+			transactorRollbackMock(mc),
+		)
+
+		purged, err := srv.PurgeExpired(ctx)
+		require.Equal(t, ErrUserPurge, err)
+		require.Zero(t, purged)
+	})
+}
@@ -2,10 +2,13 @@ package user
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"math"
+	"time"
 
+	"github.com/8thgencore/microservice-auth/internal/audit"
 	"github.com/8thgencore/microservice-auth/internal/model"
 	"github.com/8thgencore/microservice-common/pkg/logger/sl"
 	"github.com/google/uuid"
@@ -26,6 +29,7 @@ var (
 	ErrAdminCreation          = errors.New("failed to create admin user")
 	ErrInvalidCurrentPassword = errors.New("invalid current password")
 	ErrUserChangePassword     = errors.New("failed to change password")
+	ErrUnknownRole            = errors.New("unknown role")
 )
 
 // Create handles the creation of a new user.
@@ -49,6 +53,11 @@ func (s *userService) Create(ctx context.Context, user *model.UserCreate) (strin
 	}
 	user.ID = uuidv7.String()
 
+	// Users are regular users unless they are given roles
+	if len(user.Roles) == 0 {
+		user.Roles = []string{string(model.UserRoleUser)}
+	}
+
 	// Create the user
 	var id string
 	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
@@ -58,7 +67,19 @@ func (s *userService) Create(ctx context.Context, user *model.UserCreate) (strin
 			return errTx
 		}
 
-		return s.logUserAction(ctx, "Created user", id)
+		changes := audit.Changes{}.
+			Add("name", nil, user.Name).
+			Add("email", nil, user.Email).
+			Add("roles", nil, user.Roles)
+		if errTx = s.recordAudit(ctx, model.AuditActionUserCreated, id, changes); errTx != nil {
+			return errTx
+		}
+
+		if user.EmailVerified {
+			return nil
+		}
+
+		return s.sendVerification(ctx, id, user.Name, user.Email)
 	})
 	if err != nil {
 		if errors.Is(err, ErrUserNameExists) {
@@ -67,6 +88,9 @@ func (s *userService) Create(ctx context.Context, user *model.UserCreate) (strin
 		if errors.Is(err, ErrUserEmailExists) {
 			return "", ErrUserEmailExists
 		}
+		if errors.Is(err, ErrUnknownRole) {
+			return "", ErrUnknownRole
+		}
 
 		s.logger.Error("failed to create user", sl.Err(err))
 
@@ -86,7 +110,7 @@ func (s *userService) Get(ctx context.Context, id string) (*model.User, error) {
 			return errTx
 		}
 
-		return s.logUserAction(ctx, "Read user info", id)
+		return s.recordAudit(ctx, model.AuditActionUserRead, id, nil)
 	})
 	if err != nil {
 		if errors.Is(err, ErrUserNotFound) {
@@ -115,12 +139,36 @@ func (s *userService) Update(ctx context.Context, user *model.UserUpdate) error
 		}
 		user.Version = &convertedVersion32
 
+		// A new email only replaces the current one once it is verified.
+		if user.Email != nil && *user.Email != currentUser.Email {
+			var owner *model.User
+			owner, errTx = s.userRepository.FindByEmail(ctx, *user.Email)
+			if errTx != nil {
+				return errTx
+			}
+			if owner != nil {
+				return ErrUserEmailExists
+			}
+
+			user.PendingEmail = user.Email
+			user.Email = nil
+		}
+
 		errTx = s.userRepository.Update(ctx, user)
 		if errTx != nil {
 			return errTx
 		}
 
-		return s.logUserAction(ctx, "Updated user", user.ID)
+		changes := updateChanges(currentUser, user)
+		if errTx = s.recordAudit(ctx, model.AuditActionUserUpdated, user.ID, changes); errTx != nil {
+			return errTx
+		}
+
+		if user.PendingEmail == nil {
+			return nil
+		}
+
+		return s.sendVerification(ctx, user.ID, currentUser.Name, *user.PendingEmail)
 	})
 	if err != nil {
 		if errors.Is(err, ErrUserNotFound) {
@@ -132,36 +180,33 @@ func (s *userService) Update(ctx context.Context, user *model.UserUpdate) error
 		if errors.Is(err, ErrUserEmailExists) {
 			return ErrUserEmailExists
 		}
+		if errors.Is(err, ErrUnknownRole) {
+			return ErrUnknownRole
+		}
 
 		return ErrUserUpdate
 	}
 
-	if err := s.tokenRepository.SetTokenVersion(ctx, currentUser.ID, 1); err != nil {
+	if err := s.tokenRepository.SetTokenVersion(ctx, currentUser.ID, currentUser.Version+1); err != nil {
 		return ErrUserUpdate
 	}
 
 	return nil
 }
 
-// Delete handles the deletion of a user.
+// Delete handles the deletion of a user by an admin. The user is only marked as deleted,
+// so they can be restored until they are purged after the retention period.
 func (s *userService) Delete(ctx context.Context, id string) error {
-	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
-		_, errTx := s.userRepository.Get(ctx, id)
-		if errTx != nil {
-			return errTx
-		}
-
-		errTx = s.userRepository.Delete(ctx, id)
-		if errTx != nil {
-			return errTx
-		}
-
-		return s.logUserAction(ctx, "Deleted user", id)
-	})
+	err := s.changeStatus(ctx, id, &model.UserStatusChange{
+		Status:    model.UserStatusDeleted,
+		DeletedAt: sql.NullTime{Time: time.Now(), Valid: true},
+	}, model.AuditActionUserDeleted, nil)
 	if err != nil {
 		if errors.Is(err, ErrUserNotFound) {
 			return ErrUserNotFound
 		}
+
+		s.logger.Error("failed to delete user", sl.Err(err))
 		return ErrUserDelete
 	}
 
@@ -185,18 +230,36 @@ func safeIntToInt32(value int) (int32, error) {
 	return int32(value), nil
 }
 
-// logUserAction is a helper function to log actions performed on a user.
-func (s *userService) logUserAction(ctx context.Context, action string, userID string) error {
-	// Generate a UUIDv7 for the user
-	uuidv7, err := uuid.NewV7()
+// recordAudit records an action performed on a user with the changes of their fields.
+func (s *userService) recordAudit(
+	ctx context.Context,
+	action model.AuditAction,
+	userID string,
+	changes audit.Changes,
+) error {
+	event, err := audit.NewEvent(ctx, action, model.AuditTarget{Type: model.AuditTargetUser, ID: userID})
 	if err != nil {
 		return err
 	}
+	event.Changes = changes
 
-	return s.logRepository.Log(ctx, &model.Log{
-		ID:   uuidv7.String(),
-		Text: fmt.Sprintf("%s with id: %s", action, userID),
-	})
+	return s.auditRepository.Record(ctx, event)
+}
+
+// updateChanges returns the changes of the fields of the user made by the update.
+func updateChanges(current *model.User, update *model.UserUpdate) audit.Changes {
+	changes := audit.Changes{}
+	if update.Name != nil {
+		changes.Add("name", current.Name, *update.Name)
+	}
+	if update.Roles != nil {
+		changes.Add("roles", current.Roles, *update.Roles)
+	}
+	if update.PendingEmail != nil {
+		changes.Add("pending_email", current.PendingEmail.String, *update.PendingEmail)
+	}
+
+	return changes
 }
 
 // EnsureAdminExists checks if admin exists and creates one if not
@@ -213,9 +276,10 @@ func (s *userService) EnsureAdminExists(ctx context.Context) error {
 	adminUser := &model.UserCreate{
 		Name:            s.adminConfig.Name,
 		Email:           s.adminConfig.Email,
+		EmailVerified:   true,
 		Password:        s.adminConfig.Password,
 		PasswordConfirm: s.adminConfig.Password,
-		Role:            string(model.UserRoleAdmin),
+		Roles:           []string{string(model.UserRoleAdmin)},
 	}
 
 	_, err = s.Create(ctx, adminUser)
@@ -256,7 +320,7 @@ func (s *userService) ChangePassword(ctx context.Context, userID string, current
 			return err
 		}
 
-		return s.logUserAction(ctx, "Changed password", userID)
+		return s.recordAudit(ctx, model.AuditActionPasswordChanged, userID, audit.Changes{}.Secret("password"))
 	})
 	if err != nil {
 		s.logger.Error("failed to change password", sl.Err(err))
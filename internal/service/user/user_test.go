@@ -3,11 +3,15 @@ package user
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/8thgencore/microservice-auth/internal/audit"
 	"github.com/8thgencore/microservice-auth/internal/config"
 	"github.com/8thgencore/microservice-auth/internal/model"
 	"github.com/8thgencore/microservice-auth/internal/repository"
+	"github.com/8thgencore/microservice-auth/internal/service"
 	"github.com/8thgencore/microservice-auth/internal/tokens"
 	"github.com/8thgencore/microservice-common/pkg/db"
 	"github.com/8thgencore/microservice-common/pkg/db/transaction"
@@ -18,6 +22,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	repositoryMocks "github.com/8thgencore/microservice-auth/internal/repository/mocks"
+	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
 	tokenMocks "github.com/8thgencore/microservice-auth/internal/tokens/mocks"
 	dbMocks "github.com/8thgencore/microservice-common/pkg/db/mocks"
 )
@@ -28,17 +33,20 @@ var (
 	email           = "email"
 	password        = "password"
 	passwordConfirm = "passwordConfirm"
-	role            = "USER"
+	roles           = []string{"USER"}
 	createdAt       = timestamppb.Now()
 	updatedAt       = timestamppb.Now()
 )
 
 type (
 	userRepositoryMockFunc  func(mc *minimock.Controller) repository.UserRepository
-	logRepositoryMockFunc   func(mc *minimock.Controller) repository.LogRepository
+	auditRepositoryMockFunc func(mc *minimock.Controller) repository.AuditRepository
 	tokenRepositoryMockFunc func(mc *minimock.Controller) repository.TokenRepository
 	tokenOperationsMockFunc func(mc *minimock.Controller) tokens.TokenOperations
 	transactorMockFunc      func(mc *minimock.Controller) db.Transactor
+
+	verificationRepositoryMockFunc func(mc *minimock.Controller) repository.EmailVerificationRepository
+	notificationServiceMockFunc    func(mc *minimock.Controller) service.NotificationService
 )
 
 var (
@@ -60,11 +68,30 @@ var (
 		return mock
 	}
 
+	emptyVerificationRepositoryMock = func(mc *minimock.Controller) repository.EmailVerificationRepository {
+		return repositoryMocks.NewEmailVerificationRepositoryMock(mc)
+	}
+
+	emptyNotificationServiceMock = func(mc *minimock.Controller) service.NotificationService {
+		return serviceMocks.NewNotificationServiceMock(mc)
+	}
+
 	adminConfig = &config.AdminConfig{
 		Name:     "admin",
 		Email:    "admin@example.com",
 		Password: "admin123",
 	}
+
+	verificationConfig = &config.EmailVerificationConfig{
+		TokenTTL: 24 * time.Hour,
+		URL:      "http://localhost/verify-email?token=",
+	}
+
+	retentionConfig = &config.UserRetentionConfig{
+		Period:         30 * 24 * time.Hour,
+		PurgeInterval:  time.Hour,
+		PurgeBatchSize: 2,
+	}
 )
 
 // TestCreate tests the creation of a new user.
@@ -84,7 +111,7 @@ func TestCreate(t *testing.T) {
 			Email:           email,
 			Password:        password,
 			PasswordConfirm: password,
-			Role:            role,
+			Roles:           roles,
 		}
 
 		reqPassNotMatch = &model.UserCreate{
@@ -92,14 +119,14 @@ func TestCreate(t *testing.T) {
 			Email:           email,
 			Password:        password,
 			PasswordConfirm: passwordConfirm,
-			Role:            role,
+			Roles:           roles,
 		}
 
 		user = &model.User{
 			ID:        id,
 			Name:      name,
 			Email:     email,
-			Role:      role,
+			Roles:     roles,
 			Version:   0,
 			CreatedAt: createdAt.AsTime(),
 			UpdatedAt: sql.NullTime{
@@ -110,15 +137,17 @@ func TestCreate(t *testing.T) {
 	)
 
 	tests := []struct {
-		name                string
-		args                args
-		want                string
-		err                 error
-		userRepositoryMock  userRepositoryMockFunc
-		logRepositoryMock   logRepositoryMockFunc
-		tokenRepositoryMock tokenRepositoryMockFunc
-		tokenOperationsMock tokenOperationsMockFunc
-		transactorMock      transactorMockFunc
+		name                       string
+		args                       args
+		want                       string
+		err                        error
+		userRepositoryMock         userRepositoryMockFunc
+		auditRepositoryMock        auditRepositoryMockFunc
+		tokenRepositoryMock        tokenRepositoryMockFunc
+		tokenOperationsMock        tokenOperationsMockFunc
+		transactorMock             transactorMockFunc
+		verificationRepositoryMock verificationRepositoryMockFunc
+		notificationServiceMock    notificationServiceMockFunc
 	}{
 		{
 			name: "passwords match error case",
@@ -132,8 +161,8 @@ func TestCreate(t *testing.T) {
 				mock := repositoryMocks.NewUserRepositoryMock(mc)
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
 				return mock
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
@@ -147,6 +176,8 @@ func TestCreate(t *testing.T) {
 			transactorMock: func(mc *minimock.Controller) db.Transactor {
 				return dbMocks.NewTransactorMock(mc)
 			},
+			verificationRepositoryMock: emptyVerificationRepositoryMock,
+			notificationServiceMock:    emptyNotificationServiceMock,
 		},
 		{
 			name: "user repository error case",
@@ -161,8 +192,8 @@ func TestCreate(t *testing.T) {
 				mock.CreateMock.Optional().Return("", ErrUserCreate)
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
 				return mock
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
@@ -173,7 +204,9 @@ func TestCreate(t *testing.T) {
 				mock := tokenMocks.NewTokenOperationsMock(mc)
 				return mock
 			},
-			transactorMock: transactorRollbackMock,
+			transactorMock:             transactorRollbackMock,
+			verificationRepositoryMock: emptyVerificationRepositoryMock,
+			notificationServiceMock:    emptyNotificationServiceMock,
 		},
 		{
 			name: "log repository error case",
@@ -188,9 +221,9 @@ func TestCreate(t *testing.T) {
 				mock.CreateMock.Optional().Return(user.ID, nil)
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-				mock.LogMock.Optional().Return(ErrUserCreate)
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Optional().Return(ErrUserCreate)
 				return mock
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
@@ -201,7 +234,9 @@ func TestCreate(t *testing.T) {
 				mock := tokenMocks.NewTokenOperationsMock(mc)
 				return mock
 			},
-			transactorMock: transactorRollbackMock,
+			transactorMock:             transactorRollbackMock,
+			verificationRepositoryMock: emptyVerificationRepositoryMock,
+			notificationServiceMock:    emptyNotificationServiceMock,
 		},
 		{
 			name: "user with existing name",
@@ -216,8 +251,8 @@ func TestCreate(t *testing.T) {
 				mock.CreateMock.Optional().Return("", ErrUserNameExists)
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
 				return mock
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
@@ -228,7 +263,9 @@ func TestCreate(t *testing.T) {
 				mock := tokenMocks.NewTokenOperationsMock(mc)
 				return mock
 			},
-			transactorMock: transactorRollbackMock,
+			transactorMock:             transactorRollbackMock,
+			verificationRepositoryMock: emptyVerificationRepositoryMock,
+			notificationServiceMock:    emptyNotificationServiceMock,
 		},
 		{
 			name: "user with existing email",
@@ -243,8 +280,8 @@ func TestCreate(t *testing.T) {
 				mock.CreateMock.Optional().Return("", ErrUserEmailExists)
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
 				return mock
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
@@ -255,9 +292,48 @@ func TestCreate(t *testing.T) {
 				mock := tokenMocks.NewTokenOperationsMock(mc)
 				return mock
 			},
-			transactorMock: transactorRollbackMock,
+			transactorMock:             transactorRollbackMock,
+			verificationRepositoryMock: emptyVerificationRepositoryMock,
+			notificationServiceMock:    emptyNotificationServiceMock,
 		},
 		{
+			name: "send verification error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: "",
+			err:  ErrUserCreate,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.CreateMock.Optional().Return(id, nil)
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Optional().Return(nil)
+				return mock
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				return mock
+			},
+			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
+				mock := tokenMocks.NewTokenOperationsMock(mc)
+				return mock
+			},
+			transactorMock: transactorRollbackMock,
+			verificationRepositoryMock: func(mc *minimock.Controller) repository.EmailVerificationRepository {
+				mock := repositoryMocks.NewEmailVerificationRepositoryMock(mc)
+				mock.CreateMock.Return(nil)
+				return mock
+			},
+			notificationServiceMock: func(mc *minimock.Controller) service.NotificationService {
+				mock := serviceMocks.NewNotificationServiceMock(mc)
+				mock.NotifyMock.Return(ErrUserCreate)
+				return mock
+			},
+		}, {
 			name: "success case",
 			args: args{
 				ctx: ctx,
@@ -270,9 +346,9 @@ func TestCreate(t *testing.T) {
 				mock.CreateMock.Optional().Return(id, nil)
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-				mock.LogMock.Optional().Return(nil)
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Optional().Return(nil)
 				return mock
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
@@ -284,6 +360,16 @@ func TestCreate(t *testing.T) {
 				return mock
 			},
 			transactorMock: transactorCommitMock,
+			verificationRepositoryMock: func(mc *minimock.Controller) repository.EmailVerificationRepository {
+				mock := repositoryMocks.NewEmailVerificationRepositoryMock(mc)
+				mock.CreateMock.Return(nil)
+				return mock
+			},
+			notificationServiceMock: func(mc *minimock.Controller) service.NotificationService {
+				mock := serviceMocks.NewNotificationServiceMock(mc)
+				mock.NotifyMock.Return(nil)
+				return mock
+			},
 		},
 	}
 
@@ -294,7 +380,7 @@ func TestCreate(t *testing.T) {
 			mc := minimock.NewController(t)
 
 			userRepositoryMock := tt.userRepositoryMock(mc)
-			logRepositoryMock := tt.logRepositoryMock(mc)
+			auditRepositoryMock := tt.auditRepositoryMock(mc)
 			tokenRepositoryMock := tt.tokenRepositoryMock(mc)
 			tokenOperationsMock := tt.tokenOperationsMock(mc)
 
@@ -302,11 +388,15 @@ func TestCreate(t *testing.T) {
 
 			srv := newTestService(
 				userRepositoryMock,
-				logRepositoryMock,
+				auditRepositoryMock,
 				tokenRepositoryMock,
+				tt.verificationRepositoryMock(mc),
 				tokenOperationsMock,
+				tt.notificationServiceMock(mc),
 				txManagerMock,
 				adminConfig,
+				verificationConfig,
+				retentionConfig,
 			)
 
 			user := &model.UserCreate{}
@@ -338,7 +428,7 @@ func TestGet(t *testing.T) {
 			ID:        id,
 			Name:      name,
 			Email:     email,
-			Role:      role,
+			Roles:     roles,
 			Version:   0,
 			CreatedAt: createdAt.AsTime(),
 			UpdatedAt: sql.NullTime{
@@ -354,7 +444,7 @@ func TestGet(t *testing.T) {
 		want                *model.User
 		err                 error
 		userRepositoryMock  userRepositoryMockFunc
-		logRepositoryMock   logRepositoryMockFunc
+		auditRepositoryMock auditRepositoryMockFunc
 		tokenRepositoryMock tokenRepositoryMockFunc
 		tokenOperationsMock tokenOperationsMockFunc
 		transactorMock      transactorMockFunc
@@ -372,9 +462,9 @@ func TestGet(t *testing.T) {
 				mock.GetMock.Expect(minimock.AnyContext, id).Return(user, nil)
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-				mock.LogMock.Optional().Return(nil)
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Optional().Return(nil)
 				return mock
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
@@ -400,8 +490,8 @@ func TestGet(t *testing.T) {
 				mock.GetMock.Expect(minimock.AnyContext, id).Return(nil, ErrUserRead)
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
 				return mock
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
@@ -428,9 +518,9 @@ func TestGet(t *testing.T) {
 				mock.GetMock.Expect(minimock.AnyContext, id).Return(nil, ErrUserRead)
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-				mock.LogMock.Optional().Return(ErrUserRead)
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Optional().Return(ErrUserRead)
 				return mock
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
@@ -456,8 +546,8 @@ func TestGet(t *testing.T) {
 				mock.GetMock.Expect(minimock.AnyContext, id).Return(nil, ErrUserNotFound)
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
 				return mock
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
@@ -477,7 +567,7 @@ func TestGet(t *testing.T) {
 			t.Parallel()
 
 			userRepositoryMock := tt.userRepositoryMock(mc)
-			logRepositoryMock := tt.logRepositoryMock(mc)
+			auditRepositoryMock := tt.auditRepositoryMock(mc)
 			tokenRepositoryMock := tt.tokenRepositoryMock(mc)
 			tokenOperationsMock := tt.tokenOperationsMock(mc)
 
@@ -485,11 +575,15 @@ func TestGet(t *testing.T) {
 
 			srv := newTestService(
 				userRepositoryMock,
-				logRepositoryMock,
+				auditRepositoryMock,
 				tokenRepositoryMock,
+				nil,
 				tokenOperationsMock,
+				nil,
 				txManagerMock,
 				adminConfig,
+				verificationConfig,
+				retentionConfig,
 			)
 
 			res, err := srv.Get(tt.args.ctx, tt.args.req)
@@ -516,14 +610,14 @@ func TestUpdate(t *testing.T) {
 			ID:    id,
 			Name:  &name,
 			Email: &email,
-			Role:  &role,
+			Roles: &roles,
 		}
 
 		user = &model.User{
 			ID:        id,
 			Name:      name,
 			Email:     email,
-			Role:      role,
+			Roles:     roles,
 			Version:   0,
 			CreatedAt: createdAt.AsTime(),
 			UpdatedAt: sql.NullTime{
@@ -538,7 +632,7 @@ func TestUpdate(t *testing.T) {
 		args                args
 		err                 error
 		userRepositoryMock  userRepositoryMockFunc
-		logRepositoryMock   logRepositoryMockFunc
+		auditRepositoryMock auditRepositoryMockFunc
 		tokenRepositoryMock tokenRepositoryMockFunc
 		tokenOperationsMock tokenOperationsMockFunc
 		transactorMock      transactorMockFunc
@@ -556,9 +650,9 @@ func TestUpdate(t *testing.T) {
 				mock.UpdateMock.Expect(minimock.AnyContext, req).Return(nil)
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-				mock.LogMock.Optional().Return(nil)
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Optional().Return(nil)
 				return mock
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
@@ -584,8 +678,8 @@ func TestUpdate(t *testing.T) {
 				mock.GetMock.Expect(minimock.AnyContext, id).Return(nil, ErrUserUpdate)
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
 				return mock
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
@@ -611,8 +705,8 @@ func TestUpdate(t *testing.T) {
 				mock.UpdateMock.Expect(minimock.AnyContext, req).Return(ErrUserUpdate)
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
 				return mock
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
@@ -638,9 +732,9 @@ func TestUpdate(t *testing.T) {
 				mock.UpdateMock.Expect(minimock.AnyContext, req).Return(nil)
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-				mock.LogMock.Optional().Return(ErrUserUpdate)
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Optional().Return(ErrUserUpdate)
 				return mock
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
@@ -660,7 +754,7 @@ func TestUpdate(t *testing.T) {
 			t.Parallel()
 
 			userRepositoryMock := tt.userRepositoryMock(mc)
-			logRepositoryMock := tt.logRepositoryMock(mc)
+			auditRepositoryMock := tt.auditRepositoryMock(mc)
 			tokenRepositoryMock := tt.tokenRepositoryMock(mc)
 			tokenOperationsMock := tt.tokenOperationsMock(mc)
 
@@ -668,11 +762,15 @@ func TestUpdate(t *testing.T) {
 
 			srv := newTestService(
 				userRepositoryMock,
-				logRepositoryMock,
+				auditRepositoryMock,
 				tokenRepositoryMock,
+				nil,
 				tokenOperationsMock,
+				nil,
 				txManagerMock,
 				adminConfig,
+				verificationConfig,
+				retentionConfig,
 			)
 
 			err := srv.Update(tt.args.ctx, tt.args.req)
@@ -694,28 +792,29 @@ func TestDelete(t *testing.T) {
 		ctx = context.Background()
 		mc  = minimock.NewController(t)
 
-		user = &model.User{
-			ID:        id,
-			Name:      name,
-			Email:     email,
-			Role:      role,
-			Version:   0,
-			CreatedAt: createdAt.AsTime(),
-			UpdatedAt: sql.NullTime{
-				Time:  updatedAt.AsTime(),
-				Valid: true,
-			},
-		}
+		version = 3
 	)
 
+	// deletedMock expects the user to be marked as deleted.
+	deletedMock := func(mc *minimock.Controller, err error) *repositoryMocks.UserRepositoryMock {
+		mock := repositoryMocks.NewUserRepositoryMock(mc)
+		mock.SetStatusMock.Set(func(_ context.Context, userID string, change *model.UserStatusChange) (int, error) {
+			require.Equal(mc, id, userID)
+			require.Equal(mc, model.UserStatusDeleted, change.Status)
+			require.True(mc, change.DeletedAt.Valid)
+			require.False(mc, change.SuspendedUntil.Valid)
+			return version, err
+		})
+		return mock
+	}
+
 	tests := []struct {
 		name                string
 		args                args
 		err                 error
 		userRepositoryMock  userRepositoryMockFunc
-		logRepositoryMock   logRepositoryMockFunc
+		auditRepositoryMock auditRepositoryMockFunc
 		tokenRepositoryMock tokenRepositoryMockFunc
-		tokenOperationsMock tokenOperationsMockFunc
 		transactorMock      transactorMockFunc
 	}{
 		{
@@ -726,106 +825,80 @@ func TestDelete(t *testing.T) {
 			},
 			err: nil,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
-				mock := repositoryMocks.NewUserRepositoryMock(mc)
-				mock.GetMock.Expect(minimock.AnyContext, user.ID).Return(user, nil)
-				mock.DeleteMock.Expect(minimock.AnyContext, id).Return(nil)
-				return mock
+				return deletedMock(mc, nil)
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-				mock.LogMock.Optional().Return(nil)
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Return(nil)
 				return mock
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
 				mock := repositoryMocks.NewTokenRepositoryMock(mc)
-				return mock
-			},
-			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
-				mock := tokenMocks.NewTokenOperationsMock(mc)
+				mock.SetTokenVersionMock.Expect(minimock.AnyContext, id, version).Return(nil)
 				return mock
 			},
 			transactorMock: transactorCommitMock,
 		},
 		{
-			name: "user repository get error case",
+			name: "user repository error case",
 			args: args{
 				ctx: ctx,
 				req: id,
 			},
 			err: ErrUserDelete,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
-				mock := repositoryMocks.NewUserRepositoryMock(mc)
-				mock.GetMock.Expect(minimock.AnyContext, id).Return(nil, ErrUserDelete)
-				return mock
+				return deletedMock(mc, errors.New("db error"))
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-				return mock
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return repositoryMocks.NewAuditRepositoryMock(mc)
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
-				mock := repositoryMocks.NewTokenRepositoryMock(mc)
-				return mock
-			},
-			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
-				mock := tokenMocks.NewTokenOperationsMock(mc)
-				return mock
+				return repositoryMocks.NewTokenRepositoryMock(mc)
 			},
 			transactorMock: transactorRollbackMock,
 		},
 		{
-			name: "user repository delete error case",
+			name: "log repository error case",
 			args: args{
 				ctx: ctx,
 				req: id,
 			},
 			err: ErrUserDelete,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
-				mock := repositoryMocks.NewUserRepositoryMock(mc)
-				mock.GetMock.Expect(minimock.AnyContext, user.ID).Return(user, nil)
-				mock.DeleteMock.Expect(minimock.AnyContext, id).Return(ErrUserDelete)
-				return mock
+				return deletedMock(mc, nil)
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Return(errors.New("db error"))
 				return mock
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
-				mock := repositoryMocks.NewTokenRepositoryMock(mc)
-				return mock
-			},
-			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
-				mock := tokenMocks.NewTokenOperationsMock(mc)
-				return mock
+				return repositoryMocks.NewTokenRepositoryMock(mc)
 			},
 			transactorMock: transactorRollbackMock,
 		},
 		{
-			name: "log repository error case",
+			name: "token version cache error case",
 			args: args{
 				ctx: ctx,
 				req: id,
 			},
 			err: ErrUserDelete,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
-				mock := repositoryMocks.NewUserRepositoryMock(mc)
-				mock.GetMock.Expect(minimock.AnyContext, user.ID).Return(user, nil)
-				mock.DeleteMock.Expect(minimock.AnyContext, id).Return(nil)
-				return mock
+				return deletedMock(mc, nil)
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-				mock.LogMock.Optional().Return(ErrUserDelete)
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Return(nil)
 				return mock
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
 				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				mock.SetTokenVersionMock.Return(errors.New("redis error"))
+				mock.DeleteTokenVersionMock.Return(errors.New("redis error"))
 				return mock
 			},
-			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
-				mock := tokenMocks.NewTokenOperationsMock(mc)
-				return mock
-			},
-			transactorMock: transactorRollbackMock,
+			transactorMock: transactorCommitMock,
 		},
 		{
 			name: "user not found error case",
@@ -835,21 +908,13 @@ func TestDelete(t *testing.T) {
 			},
 			err: ErrUserNotFound,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
-				mock := repositoryMocks.NewUserRepositoryMock(mc)
-				mock.GetMock.Expect(minimock.AnyContext, id).Return(nil, ErrUserNotFound)
-				return mock
+				return deletedMock(mc, ErrUserNotFound)
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-				return mock
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return repositoryMocks.NewAuditRepositoryMock(mc)
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
-				mock := repositoryMocks.NewTokenRepositoryMock(mc)
-				return mock
-			},
-			tokenOperationsMock: func(mc *minimock.Controller) tokens.TokenOperations {
-				mock := tokenMocks.NewTokenOperationsMock(mc)
-				return mock
+				return repositoryMocks.NewTokenRepositoryMock(mc)
 			},
 			transactorMock: transactorRollbackMock,
 		},
@@ -859,19 +924,17 @@ func TestDelete(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			userRepositoryMock := tt.userRepositoryMock(mc)
-			logRepositoryMock := tt.logRepositoryMock(mc)
-			tokenRepositoryMock := tt.tokenRepositoryMock(mc)
-			tokenOperationsMock := tt.tokenOperationsMock(mc)
-			txManagerMock := transaction.NewTransactionManager(tt.transactorMock(mc))
-
 			srv := newTestService(
-				userRepositoryMock,
-				logRepositoryMock,
-				tokenRepositoryMock,
-				tokenOperationsMock,
-				txManagerMock,
+				tt.userRepositoryMock(mc),
+				tt.auditRepositoryMock(mc),
+				tt.tokenRepositoryMock(mc),
+				nil,
+				nil,
+				nil,
+				transaction.NewTransactionManager(tt.transactorMock(mc)),
 				adminConfig,
+				verificationConfig,
+				retentionConfig,
 			)
 
 			err := srv.Delete(tt.args.ctx, tt.args.req)
@@ -891,7 +954,7 @@ func TestEnsureAdminExists(t *testing.T) {
 			ID:      id,
 			Name:    adminConfig.Name,
 			Email:   adminConfig.Email,
-			Role:    string(model.UserRoleAdmin),
+			Roles:   []string{string(model.UserRoleAdmin)},
 			Version: 0,
 		}
 	)
@@ -900,7 +963,7 @@ func TestEnsureAdminExists(t *testing.T) {
 		name                string
 		err                 error
 		userRepositoryMock  userRepositoryMockFunc
-		logRepositoryMock   logRepositoryMockFunc
+		auditRepositoryMock auditRepositoryMockFunc
 		tokenRepositoryMock tokenRepositoryMockFunc
 		tokenOperationsMock tokenOperationsMockFunc
 		transactorMock      transactorMockFunc
@@ -913,8 +976,8 @@ func TestEnsureAdminExists(t *testing.T) {
 				mock.FindByNameMock.Expect(minimock.AnyContext, adminConfig.Name).Return(adminUser, nil)
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				return repositoryMocks.NewLogRepositoryMock(mc)
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return repositoryMocks.NewAuditRepositoryMock(mc)
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
 				return repositoryMocks.NewTokenRepositoryMock(mc)
@@ -935,9 +998,9 @@ func TestEnsureAdminExists(t *testing.T) {
 				mock.CreateMock.Optional().Return("admin_id", nil)
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-				mock.LogMock.Optional().Return(nil)
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Optional().Return(nil)
 				return mock
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
@@ -956,8 +1019,8 @@ func TestEnsureAdminExists(t *testing.T) {
 				mock.FindByNameMock.Expect(minimock.AnyContext, adminConfig.Name).Return(nil, ErrUserRead)
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				return repositoryMocks.NewLogRepositoryMock(mc)
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return repositoryMocks.NewAuditRepositoryMock(mc)
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
 				return repositoryMocks.NewTokenRepositoryMock(mc)
@@ -978,8 +1041,8 @@ func TestEnsureAdminExists(t *testing.T) {
 				mock.CreateMock.Optional().Return("", ErrUserCreate)
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				return repositoryMocks.NewLogRepositoryMock(mc)
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return repositoryMocks.NewAuditRepositoryMock(mc)
 			},
 			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
 				return repositoryMocks.NewTokenRepositoryMock(mc)
@@ -996,18 +1059,22 @@ func TestEnsureAdminExists(t *testing.T) {
 			t.Parallel()
 
 			userRepositoryMock := tt.userRepositoryMock(mc)
-			logRepositoryMock := tt.logRepositoryMock(mc)
+			auditRepositoryMock := tt.auditRepositoryMock(mc)
 			tokenRepositoryMock := tt.tokenRepositoryMock(mc)
 			tokenOperationsMock := tt.tokenOperationsMock(mc)
 			txManagerMock := transaction.NewTransactionManager(tt.transactorMock(mc))
 
 			srv := newTestService(
 				userRepositoryMock,
-				logRepositoryMock,
+				auditRepositoryMock,
 				tokenRepositoryMock,
+				nil,
 				tokenOperationsMock,
+				nil,
 				txManagerMock,
 				adminConfig,
+				verificationConfig,
+				retentionConfig,
 			)
 
 			err := srv.EnsureAdminExists(ctx)
@@ -1015,3 +1082,30 @@ func TestEnsureAdminExists(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateChanges(t *testing.T) {
+	t.Parallel()
+
+	var (
+		current = &model.User{
+			Name:         "old name",
+			Roles:        []string{"USER"},
+			PendingEmail: sql.NullString{String: "pending@example.com", Valid: true},
+		}
+
+		newName      = "new name"
+		sameRoles    = []string{"USER"}
+		pendingEmail = "new@example.com"
+	)
+
+	changes := updateChanges(current, &model.UserUpdate{
+		Name:         &newName,
+		Roles:        &sameRoles,
+		PendingEmail: &pendingEmail,
+	})
+
+	require.Equal(t, audit.Changes{
+		"name":          {Old: "old name", New: "new name"},
+		"pending_email": {Old: "pending@example.com", New: "new@example.com"},
+	}, changes)
+}
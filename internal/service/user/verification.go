@@ -0,0 +1,100 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/8thgencore/microservice-common/pkg/logger/sl"
+
+	"github.com/8thgencore/microservice-auth/internal/audit"
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/notifier"
+	"github.com/8thgencore/microservice-auth/internal/tokens"
+)
+
+// Email verification errors
+var (
+	ErrInvalidVerificationToken = errors.New("invalid or expired email verification token")
+	ErrEmailVerificationFailed  = errors.New("failed to verify email")
+)
+
+// SendVerificationEmail sends a new verification link to the user with the email if it is not verified yet.
+// It succeeds whether or not there is such a user, so it cannot be used to find out registered emails.
+func (s *userService) SendVerificationEmail(ctx context.Context, email string) error {
+	user, err := s.userRepository.FindByEmail(ctx, email)
+	if err != nil {
+		s.logger.Error("failed to find user by email", sl.Err(err))
+		return ErrEmailVerificationFailed
+	}
+	if user == nil || user.EmailVerified {
+		return nil
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		return s.sendVerification(ctx, user.ID, user.Name, user.Email)
+	})
+	if err != nil {
+		s.logger.Error("failed to send verification email", slog.String("user_id", user.ID), sl.Err(err))
+	}
+
+	return nil
+}
+
+// VerifyEmail confirms the email a verification token was issued for.
+// A pending email replaces the current email of the user.
+func (s *userService) VerifyEmail(ctx context.Context, token string) error {
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		verification, errTx := s.verificationRepository.Use(ctx, tokens.HashOpaqueToken(token))
+		if errTx != nil {
+			return errTx
+		}
+
+		if errTx = s.userRepository.ConfirmEmail(ctx, verification.UserID, verification.Email); errTx != nil {
+			return errTx
+		}
+
+		changes := audit.Changes{}.Add("email", nil, verification.Email).Add("email_verified", nil, true)
+
+		return s.recordAudit(ctx, model.AuditActionEmailVerified, verification.UserID, changes)
+	})
+	if err != nil {
+		if errors.Is(err, ErrInvalidVerificationToken) {
+			return ErrInvalidVerificationToken
+		}
+		if errors.Is(err, ErrUserEmailExists) {
+			return ErrUserEmailExists
+		}
+
+		s.logger.Error("failed to verify email", sl.Err(err))
+		return ErrEmailVerificationFailed
+	}
+
+	return nil
+}
+
+// sendVerification issues a verification token for the email and queues the message with its link.
+// It must run in a transaction, so the message is only sent if the token is saved.
+func (s *userService) sendVerification(ctx context.Context, userID, name, email string) error {
+	token, tokenHash, err := tokens.GenerateOpaqueToken()
+	if err != nil {
+		return err
+	}
+
+	err = s.verificationRepository.Create(ctx, &model.EmailVerification{
+		UserID:    userID,
+		Email:     email,
+		TokenHash: tokenHash,
+		ExpiresAt: time.Now().Add(s.verificationConfig.TokenTTL),
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.notificationService.Notify(ctx, email, notifier.EmailVerificationTemplate, "", notifier.EmailVerificationData{
+		Name:           name,
+		Link:           s.verificationConfig.URL + token,
+		ExpiresInHours: int(s.verificationConfig.TokenTTL.Hours()),
+	})
+}
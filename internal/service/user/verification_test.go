@@ -0,0 +1,367 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/8thgencore/microservice-common/pkg/db"
+	"github.com/8thgencore/microservice-common/pkg/db/transaction"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/notifier"
+	"github.com/8thgencore/microservice-auth/internal/repository"
+	repositoryMocks "github.com/8thgencore/microservice-auth/internal/repository/mocks"
+	"github.com/8thgencore/microservice-auth/internal/service"
+	serviceMocks "github.com/8thgencore/microservice-auth/internal/service/mocks"
+	"github.com/8thgencore/microservice-auth/internal/tokens"
+	dbMocks "github.com/8thgencore/microservice-common/pkg/db/mocks"
+)
+
+var (
+	newEmail          = "new@example.com"
+	verificationToken = "verification_token"
+
+	emptyTransactorMock = func(mc *minimock.Controller) db.Transactor {
+		return dbMocks.NewTransactorMock(mc)
+	}
+)
+
+func TestSendVerificationEmail(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		unverifiedUser = &model.User{ID: id, Name: name, Email: email}
+		verifiedUser   = &model.User{ID: id, Name: name, Email: email, EmailVerified: true}
+	)
+
+	tests := []struct {
+		name                       string
+		err                        error
+		userRepositoryMock         userRepositoryMockFunc
+		verificationRepositoryMock verificationRepositoryMockFunc
+		notificationServiceMock    notificationServiceMockFunc
+		transactorMock             transactorMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.FindByEmailMock.Expect(ctx, email).Return(unverifiedUser, nil)
+				return mock
+			},
+			verificationRepositoryMock: func(mc *minimock.Controller) repository.EmailVerificationRepository {
+				mock := repositoryMocks.NewEmailVerificationRepositoryMock(mc)
+				mock.CreateMock.Set(func(_ context.Context, verification *model.EmailVerification) error {
+					require.Equal(mc, id, verification.UserID)
+					require.Equal(mc, email, verification.Email)
+					require.Len(mc, verification.TokenHash, 64)
+					return nil
+				})
+				return mock
+			},
+			notificationServiceMock: func(mc *minimock.Controller) service.NotificationService {
+				mock := serviceMocks.NewNotificationServiceMock(mc)
+				mock.NotifyMock.Set(func(_ context.Context, recipient, template, _ string, data any) error {
+					require.Equal(mc, email, recipient)
+					require.Equal(mc, notifier.EmailVerificationTemplate, template)
+
+					got := data.(notifier.EmailVerificationData)
+					require.True(mc, strings.HasPrefix(got.Link, verificationConfig.URL))
+					require.Equal(mc, 24, got.ExpiresInHours)
+					return nil
+				})
+				return mock
+			},
+			transactorMock: transactorCommitMock,
+		},
+		{
+			name: "unknown email case",
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.FindByEmailMock.Expect(ctx, email).Return(nil, nil)
+				return mock
+			},
+			verificationRepositoryMock: emptyVerificationRepositoryMock,
+			notificationServiceMock:    emptyNotificationServiceMock,
+			transactorMock:             emptyTransactorMock,
+		},
+		{
+			name: "already verified case",
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.FindByEmailMock.Expect(ctx, email).Return(verifiedUser, nil)
+				return mock
+			},
+			verificationRepositoryMock: emptyVerificationRepositoryMock,
+			notificationServiceMock:    emptyNotificationServiceMock,
+			transactorMock:             emptyTransactorMock,
+		},
+		{
+			name: "find user error case",
+			err:  ErrEmailVerificationFailed,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.FindByEmailMock.Expect(ctx, email).Return(nil, errors.New("db error"))
+				return mock
+			},
+			verificationRepositoryMock: emptyVerificationRepositoryMock,
+			notificationServiceMock:    emptyNotificationServiceMock,
+			transactorMock:             emptyTransactorMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newTestService(
+				tt.userRepositoryMock(mc),
+				repositoryMocks.NewAuditRepositoryMock(mc),
+				repositoryMocks.NewTokenRepositoryMock(mc),
+				tt.verificationRepositoryMock(mc),
+				nil,
+				tt.notificationServiceMock(mc),
+				transaction.NewTransactionManager(tt.transactorMock(mc)),
+				adminConfig,
+				verificationConfig,
+				retentionConfig,
+			)
+
+			err := srv.SendVerificationEmail(ctx, email)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestVerifyEmail(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		verification = &model.EmailVerification{UserID: id, Email: newEmail}
+	)
+
+	tests := []struct {
+		name                       string
+		err                        error
+		userRepositoryMock         userRepositoryMockFunc
+		auditRepositoryMock        auditRepositoryMockFunc
+		verificationRepositoryMock verificationRepositoryMockFunc
+		transactorMock             transactorMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.ConfirmEmailMock.Expect(minimock.AnyContext, id, newEmail).Return(nil)
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Return(nil)
+				return mock
+			},
+			verificationRepositoryMock: func(mc *minimock.Controller) repository.EmailVerificationRepository {
+				mock := repositoryMocks.NewEmailVerificationRepositoryMock(mc)
+				mock.UseMock.Expect(minimock.AnyContext, tokens.HashOpaqueToken(verificationToken)).Return(verification, nil)
+				return mock
+			},
+			transactorMock: transactorCommitMock,
+		},
+		{
+			name: "invalid token case",
+			err:  ErrInvalidVerificationToken,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return repositoryMocks.NewAuditRepositoryMock(mc)
+			},
+			verificationRepositoryMock: func(mc *minimock.Controller) repository.EmailVerificationRepository {
+				mock := repositoryMocks.NewEmailVerificationRepositoryMock(mc)
+				mock.UseMock.Return(nil, ErrInvalidVerificationToken)
+				return mock
+			},
+			transactorMock: transactorRollbackMock,
+		},
+		{
+			name: "email taken case",
+			err:  ErrUserEmailExists,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.ConfirmEmailMock.Expect(minimock.AnyContext, id, newEmail).Return(ErrUserEmailExists)
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return repositoryMocks.NewAuditRepositoryMock(mc)
+			},
+			verificationRepositoryMock: func(mc *minimock.Controller) repository.EmailVerificationRepository {
+				mock := repositoryMocks.NewEmailVerificationRepositoryMock(mc)
+				mock.UseMock.Return(verification, nil)
+				return mock
+			},
+			transactorMock: transactorRollbackMock,
+		},
+		{
+			name: "confirm email error case",
+			err:  ErrEmailVerificationFailed,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.ConfirmEmailMock.Return(errors.New("db error"))
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return repositoryMocks.NewAuditRepositoryMock(mc)
+			},
+			verificationRepositoryMock: func(mc *minimock.Controller) repository.EmailVerificationRepository {
+				mock := repositoryMocks.NewEmailVerificationRepositoryMock(mc)
+				mock.UseMock.Return(verification, nil)
+				return mock
+			},
+			transactorMock: transactorRollbackMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newTestService(
+				tt.userRepositoryMock(mc),
+				tt.auditRepositoryMock(mc),
+				repositoryMocks.NewTokenRepositoryMock(mc),
+				tt.verificationRepositoryMock(mc),
+				nil,
+				serviceMocks.NewNotificationServiceMock(mc),
+				transaction.NewTransactionManager(tt.transactorMock(mc)),
+				adminConfig,
+				verificationConfig,
+				retentionConfig,
+			)
+
+			err := srv.VerifyEmail(ctx, verificationToken)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestUpdateEmail(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		user      = &model.User{ID: id, Name: name, Email: email, EmailVerified: true}
+		otherUser = &model.User{ID: "other_uuid", Email: newEmail}
+	)
+
+	tests := []struct {
+		name                       string
+		err                        error
+		userRepositoryMock         userRepositoryMockFunc
+		auditRepositoryMock        auditRepositoryMockFunc
+		tokenRepositoryMock        tokenRepositoryMockFunc
+		verificationRepositoryMock verificationRepositoryMockFunc
+		notificationServiceMock    notificationServiceMockFunc
+		transactorMock             transactorMockFunc
+	}{
+		{
+			name: "pending email case",
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(user, nil)
+				mock.FindByEmailMock.Expect(minimock.AnyContext, newEmail).Return(nil, nil)
+				mock.UpdateMock.Set(func(_ context.Context, update *model.UserUpdate) error {
+					// The current email stays until the new one is verified.
+					require.Nil(mc, update.Email)
+					require.Equal(mc, newEmail, *update.PendingEmail)
+					return nil
+				})
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				mock := repositoryMocks.NewAuditRepositoryMock(mc)
+				mock.RecordMock.Return(nil)
+				return mock
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				mock := repositoryMocks.NewTokenRepositoryMock(mc)
+				mock.SetTokenVersionMock.Expect(ctx, id, 1).Return(nil)
+				return mock
+			},
+			verificationRepositoryMock: func(mc *minimock.Controller) repository.EmailVerificationRepository {
+				mock := repositoryMocks.NewEmailVerificationRepositoryMock(mc)
+				mock.CreateMock.Set(func(_ context.Context, verification *model.EmailVerification) error {
+					require.Equal(mc, newEmail, verification.Email)
+					return nil
+				})
+				return mock
+			},
+			notificationServiceMock: func(mc *minimock.Controller) service.NotificationService {
+				mock := serviceMocks.NewNotificationServiceMock(mc)
+				mock.NotifyMock.Set(func(_ context.Context, recipient, _, _ string, _ any) error {
+					require.Equal(mc, newEmail, recipient)
+					return nil
+				})
+				return mock
+			},
+			transactorMock: transactorCommitMock,
+		},
+		{
+			name: "email taken case",
+			err:  ErrUserEmailExists,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(user, nil)
+				mock.FindByEmailMock.Expect(minimock.AnyContext, newEmail).Return(otherUser, nil)
+				return mock
+			},
+			auditRepositoryMock: func(mc *minimock.Controller) repository.AuditRepository {
+				return repositoryMocks.NewAuditRepositoryMock(mc)
+			},
+			tokenRepositoryMock: func(mc *minimock.Controller) repository.TokenRepository {
+				return repositoryMocks.NewTokenRepositoryMock(mc)
+			},
+			verificationRepositoryMock: emptyVerificationRepositoryMock,
+			notificationServiceMock:    emptyNotificationServiceMock,
+			transactorMock:             transactorRollbackMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newTestService(
+				tt.userRepositoryMock(mc),
+				tt.auditRepositoryMock(mc),
+				tt.tokenRepositoryMock(mc),
+				tt.verificationRepositoryMock(mc),
+				nil,
+				tt.notificationServiceMock(mc),
+				transaction.NewTransactionManager(tt.transactorMock(mc)),
+				adminConfig,
+				verificationConfig,
+				retentionConfig,
+			)
+
+			err := srv.Update(ctx, &model.UserUpdate{ID: id, Email: &newEmail})
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
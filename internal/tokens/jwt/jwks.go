@@ -0,0 +1,96 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+)
+
+const jwkUseSignature = "sig"
+
+// toJWK converts a public key to its JSON Web Key representation.
+func toJWK(publicKey crypto.PublicKey) (model.JWK, error) {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		return model.JWK{
+			KeyType: "RSA",
+			N:       encodeBase64URL(key.N.Bytes()),
+			E:       encodeBase64URL(big.NewInt(int64(key.E)).Bytes()),
+		}, nil
+	case *ecdsa.PublicKey:
+		size := (key.Curve.Params().BitSize + 7) / 8
+		// ecdh conversion gives the uncompressed point 0x04 || X || Y with fixed-size coordinates.
+		ecdhKey, err := key.ECDH()
+		if err != nil {
+			return model.JWK{}, fmt.Errorf("could not encode EC public key: %w", err)
+		}
+		point := ecdhKey.Bytes()
+
+		return model.JWK{
+			KeyType: "EC",
+			Curve:   key.Curve.Params().Name,
+			X:       encodeBase64URL(point[1 : 1+size]),
+			Y:       encodeBase64URL(point[1+size:]),
+		}, nil
+	case ed25519.PublicKey:
+		return model.JWK{
+			KeyType: "OKP",
+			Curve:   "Ed25519",
+			X:       encodeBase64URL(key),
+		}, nil
+	default:
+		return model.JWK{}, fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+}
+
+// jwkThumbprint computes the RFC 7638 SHA-256 thumbprint of a public key.
+func jwkThumbprint(publicKey crypto.PublicKey) (string, error) {
+	jwk, err := toJWK(publicKey)
+	if err != nil {
+		return "", err
+	}
+
+	// Required members only, in lexicographic order.
+	var members any
+	switch jwk.KeyType {
+	case "RSA":
+		members = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{jwk.E, jwk.KeyType, jwk.N}
+	case "EC":
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		}{jwk.Curve, jwk.KeyType, jwk.X, jwk.Y}
+	default:
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{jwk.Curve, jwk.KeyType, jwk.X}
+	}
+
+	data, err := json.Marshal(members)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+
+	return encodeBase64URL(sum[:]), nil
+}
+
+func encodeBase64URL(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
@@ -3,19 +3,25 @@ package jwt
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 
 	"github.com/8thgencore/microservice-auth/internal/model"
 	"github.com/8thgencore/microservice-auth/internal/repository"
 	"github.com/8thgencore/microservice-auth/internal/tokens"
 )
 
+// mfaAudience marks MFA challenge tokens, so that they are never accepted as access or refresh tokens.
+const mfaAudience = "mfa"
+
 type tokenOperations struct {
-	secretKey       []byte
+	keyring         *tokens.Keyring
 	accessTokenTTL  time.Duration
 	refreshTokenTTL time.Duration
+	mfaTokenTTL     time.Duration
 	tokenRepository repository.TokenRepository
 }
 
@@ -23,33 +29,36 @@ var _ tokens.TokenOperations = (*tokenOperations)(nil)
 
 // NewTokenOperations creates a new object for using token functions.
 func NewTokenOperations(
-	secretKey []byte,
+	keyring *tokens.Keyring,
 	accessTokenTTL time.Duration,
 	refreshTokenTTL time.Duration,
+	mfaTokenTTL time.Duration,
 	tokenRepository repository.TokenRepository,
 ) tokens.TokenOperations {
 	return &tokenOperations{
-		secretKey:       secretKey,
+		keyring:         keyring,
 		accessTokenTTL:  accessTokenTTL,
 		refreshTokenTTL: refreshTokenTTL,
+		mfaTokenTTL:     mfaTokenTTL,
 		tokenRepository: tokenRepository,
 	}
 }
 
 // GenerateAccessToken creates JWT access token for the user.
-func (t *tokenOperations) GenerateAccessToken(user model.User) (string, error) {
+func (t *tokenOperations) GenerateAccessToken(user model.User, sessionID string) (string, error) {
 	claims := model.UserClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   user.ID,
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(t.accessTokenTTL)),
 		},
-		Username: user.Name,
-		Role:     user.Role,
-		Version:  user.Version,
+		Username:    user.Name,
+		Roles:       user.Roles,
+		Permissions: user.Permissions,
+		Version:     user.Version,
+		SessionID:   sessionID,
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	signedToken, err := token.SignedString(t.secretKey)
+	signedToken, err := t.sign(claims)
 	if err != nil {
 		return "", fmt.Errorf("could not sign access token: %w", err)
 	}
@@ -58,16 +67,17 @@ func (t *tokenOperations) GenerateAccessToken(user model.User) (string, error) {
 }
 
 // GenerateRefreshToken creates JWT refresh token with minimal claims.
-func (t *tokenOperations) GenerateRefreshToken(userID string) (string, error) {
+func (t *tokenOperations) GenerateRefreshToken(userID, familyID, tokenID string) (string, error) {
 	claims := model.RefreshClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenID,
 			Subject:   userID,
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(t.refreshTokenTTL)),
 		},
+		FamilyID: familyID,
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	signedToken, err := token.SignedString(t.secretKey)
+	signedToken, err := t.sign(claims)
 	if err != nil {
 		return "", fmt.Errorf("could not sign refresh token: %w", err)
 	}
@@ -75,21 +85,39 @@ func (t *tokenOperations) GenerateRefreshToken(userID string) (string, error) {
 	return signedToken, nil
 }
 
+// GenerateMfaToken creates the JWT challenge token of a login awaiting the second factor.
+func (t *tokenOperations) GenerateMfaToken(userID string) (string, error) {
+	tokenID, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf("could not generate mfa token id: %w", err)
+	}
+
+	claims := model.MfaClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenID.String(),
+			Subject:   userID,
+			Audience:  jwt.ClaimStrings{mfaAudience},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(t.mfaTokenTTL)),
+		},
+	}
+
+	signedToken, err := t.sign(claims)
+	if err != nil {
+		return "", fmt.Errorf("could not sign mfa token: %w", err)
+	}
+
+	return signedToken, nil
+}
+
 // VerifyAccessToken checks the validity of an access token.
 func (t *tokenOperations) VerifyAccessToken(tokenStr string) (*model.UserClaims, error) {
 	token, err := jwt.ParseWithClaims(
 		tokenStr,
 		&model.UserClaims{},
-		func(token *jwt.Token) (any, error) {
-			if token.Method.Alg() != jwt.SigningMethodHS256.Alg() {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Method.Alg())
-			}
-
-			return t.secretKey, nil
-		},
+		t.keyFunc,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("%s", err.Error())
+		return nil, fmt.Errorf("invalid access token: %w", err)
 	}
 
 	if !token.Valid {
@@ -97,7 +125,7 @@ func (t *tokenOperations) VerifyAccessToken(tokenStr string) (*model.UserClaims,
 	}
 
 	claims, ok := token.Claims.(*model.UserClaims)
-	if !ok {
+	if !ok || slices.Contains(claims.Audience, mfaAudience) {
 		return nil, errors.New("invalid access token claims")
 	}
 
@@ -109,13 +137,7 @@ func (t *tokenOperations) VerifyRefreshToken(tokenStr string) (*model.RefreshCla
 	token, err := jwt.ParseWithClaims(
 		tokenStr,
 		&model.RefreshClaims{},
-		func(token *jwt.Token) (any, error) {
-			if token.Method.Alg() != jwt.SigningMethodHS256.Alg() {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Method.Alg())
-			}
-
-			return t.secretKey, nil
-		},
+		t.keyFunc,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("invalid refresh token: %w", err)
@@ -126,9 +148,96 @@ func (t *tokenOperations) VerifyRefreshToken(tokenStr string) (*model.RefreshCla
 	}
 
 	claims, ok := token.Claims.(*model.RefreshClaims)
-	if !ok {
+	if !ok || slices.Contains(claims.Audience, mfaAudience) {
 		return nil, errors.New("invalid refresh token claims")
 	}
 
 	return claims, nil
 }
+
+// VerifyMfaToken checks the validity of an MFA challenge token.
+func (t *tokenOperations) VerifyMfaToken(tokenStr string) (*model.MfaClaims, error) {
+	token, err := jwt.ParseWithClaims(
+		tokenStr,
+		&model.MfaClaims{},
+		t.keyFunc,
+		jwt.WithAudience(mfaAudience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mfa token: %w", err)
+	}
+
+	if !token.Valid {
+		return nil, errors.New("invalid mfa token: token is not valid")
+	}
+
+	claims, ok := token.Claims.(*model.MfaClaims)
+	if !ok {
+		return nil, errors.New("invalid mfa token claims")
+	}
+
+	return claims, nil
+}
+
+// JWKS returns the public keys that can be used to verify issued tokens.
+// Symmetric keys are never published, so HS256 keys are left out of the set.
+func (t *tokenOperations) JWKS() *model.JWKSet {
+	set := &model.JWKSet{Keys: []model.JWK{}}
+
+	for _, key := range t.keyring.VerificationKeys() {
+		if key.PublicKey == nil {
+			continue
+		}
+
+		jwk, err := toJWK(key.PublicKey)
+		if err != nil {
+			continue
+		}
+		jwk.Use = jwkUseSignature
+		jwk.Algorithm = key.Algorithm
+		jwk.KeyID = key.ID
+		set.Keys = append(set.Keys, jwk)
+	}
+
+	return set
+}
+
+// sign signs the claims with the active key and references it in the "kid" header.
+func (t *tokenOperations) sign(claims jwt.Claims) (string, error) {
+	key := t.keyring.SigningKey()
+
+	method, err := signingMethod(key.Algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = key.ID
+
+	return token.SignedString(key.SignKey)
+}
+
+// keyFunc picks the verification key by the "kid" header. Tokens issued before key IDs
+// were introduced carry no "kid" and are checked against the active key.
+func (t *tokenOperations) keyFunc(token *jwt.Token) (any, error) {
+	key := t.keyring.SigningKey()
+	if kid, ok := token.Header["kid"]; ok {
+		id, ok := kid.(string)
+		if !ok {
+			return nil, errors.New("invalid kid header")
+		}
+
+		var err error
+		key, err = t.keyring.VerificationKey(id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if token.Method.Alg() != key.Algorithm {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Method.Alg())
+	}
+
+	return key.VerifyKey, nil
+}
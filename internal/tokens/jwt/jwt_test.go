@@ -0,0 +1,332 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/8thgencore/microservice-auth/internal/model"
+	"github.com/8thgencore/microservice-auth/internal/tokens"
+)
+
+var (
+	user = model.User{
+		ID:      "uuid",
+		Name:    "username",
+		Roles:   []string{"USER"},
+		Version: 1,
+	}
+
+	accessTTL  = 15 * time.Minute
+	refreshTTL = time.Hour
+	mfaTTL     = 5 * time.Minute
+)
+
+func generateKeys(t *testing.T) map[string]crypto.Signer {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	return map[string]crypto.Signer{
+		AlgorithmRS256: rsaKey,
+		AlgorithmES256: ecKey,
+		AlgorithmEdDSA: edKey,
+	}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	t.Parallel()
+
+	hmacKey, err := NewHMACKey("hmac", []byte("secret"))
+	require.NoError(t, err)
+
+	signingKeys := map[string]*tokens.Key{AlgorithmHS256: hmacKey}
+	for alg, privateKey := range generateKeys(t) {
+		key, err := NewAsymmetricKey("", alg, privateKey)
+		require.NoError(t, err)
+		signingKeys[alg] = key
+	}
+
+	for alg, key := range signingKeys {
+		t.Run(alg, func(t *testing.T) {
+			t.Parallel()
+
+			ops := NewTokenOperations(tokens.NewKeyring(key, refreshTTL), accessTTL, refreshTTL, mfaTTL, nil)
+
+			accessToken, err := ops.GenerateAccessToken(user, "family")
+			require.NoError(t, err)
+			claims, err := ops.VerifyAccessToken(accessToken)
+			require.NoError(t, err)
+			require.Equal(t, user.ID, claims.Subject)
+			require.Equal(t, user.Roles, []string(claims.Roles))
+			require.Equal(t, "family", claims.SessionID)
+
+			refreshToken, err := ops.GenerateRefreshToken(user.ID, "family", "token")
+			require.NoError(t, err)
+			refreshClaims, err := ops.VerifyRefreshToken(refreshToken)
+			require.NoError(t, err)
+			require.Equal(t, user.ID, refreshClaims.Subject)
+			require.Equal(t, "family", refreshClaims.FamilyID)
+			require.Equal(t, "token", refreshClaims.ID)
+
+			jwks := ops.JWKS()
+			if alg == AlgorithmHS256 {
+				require.Empty(t, jwks.Keys)
+			} else {
+				require.Len(t, jwks.Keys, 1)
+				require.Equal(t, alg, jwks.Keys[0].Algorithm)
+				require.Equal(t, jwkUseSignature, jwks.Keys[0].Use)
+				require.Equal(t, key.ID, jwks.Keys[0].KeyID)
+			}
+		})
+	}
+}
+
+func TestVerifySingleRoleToken(t *testing.T) {
+	t.Parallel()
+
+	key, err := NewHMACKey("kid", []byte("secret"))
+	require.NoError(t, err)
+	ops := newTokenOperations(key)
+
+	// Tokens issued while users held a single role carry it as a string.
+	token, err := ops.(*tokenOperations).sign(jwt.MapClaims{
+		"sub":  user.ID,
+		"role": "ADMIN",
+		"ver":  1,
+		"exp":  time.Now().Add(accessTTL).Unix(),
+	})
+	require.NoError(t, err)
+
+	claims, err := ops.VerifyAccessToken(token)
+	require.NoError(t, err)
+	require.Equal(t, model.ClaimRoles{"ADMIN"}, claims.Roles)
+}
+
+func TestPermissionsClaim(t *testing.T) {
+	t.Parallel()
+
+	key, err := NewHMACKey("kid", []byte("secret"))
+	require.NoError(t, err)
+	ops := newTokenOperations(key)
+
+	withPermissions := user
+	withPermissions.Permissions = []string{"chat.delete", "chat.moderate"}
+
+	token, err := ops.GenerateAccessToken(withPermissions, "")
+	require.NoError(t, err)
+	claims, err := ops.VerifyAccessToken(token)
+	require.NoError(t, err)
+	require.Equal(t, withPermissions.Permissions, claims.Permissions)
+
+	// Tokens are issued without the claim unless the permissions are embedded.
+	token, err = ops.GenerateAccessToken(user, "")
+	require.NoError(t, err)
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, jwt.MapClaims{})
+	require.NoError(t, err)
+	require.NotContains(t, parsed.Claims, "permissions")
+}
+
+func TestVerifyRejectsOtherKey(t *testing.T) {
+	t.Parallel()
+
+	keys := generateKeys(t)
+	signKey, err := NewAsymmetricKey("kid", AlgorithmES256, keys[AlgorithmES256])
+	require.NoError(t, err)
+	otherKey, err := NewAsymmetricKey("other", AlgorithmEdDSA, keys[AlgorithmEdDSA])
+	require.NoError(t, err)
+	sameIDKey, err := NewHMACKey("kid", []byte("secret"))
+	require.NoError(t, err)
+
+	token, err := newTokenOperations(signKey).GenerateAccessToken(user, "")
+	require.NoError(t, err)
+
+	_, err = newTokenOperations(otherKey).VerifyAccessToken(token)
+	require.ErrorIs(t, err, tokens.ErrUnknownKey)
+	_, err = newTokenOperations(sameIDKey).VerifyAccessToken(token)
+	require.Error(t, err)
+}
+
+func TestKeyRotation(t *testing.T) {
+	t.Parallel()
+
+	keys := generateKeys(t)
+	oldKey, err := NewAsymmetricKey("old", AlgorithmES256, keys[AlgorithmES256])
+	require.NoError(t, err)
+	newKey, err := NewAsymmetricKey("new", AlgorithmEdDSA, keys[AlgorithmEdDSA])
+	require.NoError(t, err)
+
+	keyring := tokens.NewKeyring(oldKey, refreshTTL)
+	ops := NewTokenOperations(keyring, accessTTL, refreshTTL, mfaTTL, nil)
+
+	oldToken, err := ops.GenerateRefreshToken(user.ID, "family", "token")
+	require.NoError(t, err)
+
+	keyring.Rotate(newKey)
+
+	newToken, err := ops.GenerateRefreshToken(user.ID, "family", "token")
+	require.NoError(t, err)
+
+	// Tokens of the retired key keep verifying during the retention window.
+	_, err = ops.VerifyRefreshToken(oldToken)
+	require.NoError(t, err)
+	_, err = ops.VerifyRefreshToken(newToken)
+	require.NoError(t, err)
+
+	require.Len(t, ops.JWKS().Keys, 2)
+	require.Equal(t, "new", ops.JWKS().Keys[0].KeyID)
+}
+
+func TestMfaToken(t *testing.T) {
+	t.Parallel()
+
+	key, err := NewHMACKey("hmac", []byte("secret"))
+	require.NoError(t, err)
+	ops := newTokenOperations(key)
+
+	mfaToken, err := ops.GenerateMfaToken(user.ID)
+	require.NoError(t, err)
+	claims, err := ops.VerifyMfaToken(mfaToken)
+	require.NoError(t, err)
+	require.Equal(t, user.ID, claims.Subject)
+	require.NotEmpty(t, claims.ID)
+
+	// A challenge token is no substitute for the tokens it is exchanged for, nor the other way round.
+	_, err = ops.VerifyAccessToken(mfaToken)
+	require.Error(t, err)
+	_, err = ops.VerifyRefreshToken(mfaToken)
+	require.Error(t, err)
+
+	refreshToken, err := ops.GenerateRefreshToken(user.ID, "family", "token")
+	require.NoError(t, err)
+	_, err = ops.VerifyMfaToken(refreshToken)
+	require.Error(t, err)
+}
+
+func newTokenOperations(key *tokens.Key) tokens.TokenOperations {
+	return NewTokenOperations(tokens.NewKeyring(key, refreshTTL), accessTTL, refreshTTL, mfaTTL, nil)
+}
+
+func TestLoadSigningKey(t *testing.T) {
+	t.Parallel()
+
+	keys := generateKeys(t)
+	dir := t.TempDir()
+
+	writeKey := func(name string, key crypto.Signer) string {
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		require.NoError(t, err)
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+
+		return path
+	}
+
+	rsaPath := writeKey("rsa.pem", keys[AlgorithmRS256])
+	ecPath := writeKey("ec.pem", keys[AlgorithmES256])
+	edPath := writeKey("ed.pem", keys[AlgorithmEdDSA])
+
+	tests := []struct {
+		name      string
+		algorithm string
+		secret    string
+		path      string
+		err       error
+	}{
+		{name: "hs256 success case", algorithm: AlgorithmHS256, secret: "secret"},
+		{name: "hs256 missing secret error case", algorithm: AlgorithmHS256, err: ErrMissingSecretKey},
+		{name: "rs256 success case", algorithm: AlgorithmRS256, path: rsaPath},
+		{name: "es256 success case", algorithm: AlgorithmES256, path: ecPath},
+		{name: "eddsa success case", algorithm: AlgorithmEdDSA, path: edPath},
+		{name: "missing path error case", algorithm: AlgorithmRS256, err: ErrMissingPrivateKey},
+		{name: "key mismatch error case", algorithm: AlgorithmES256, path: rsaPath, err: ErrInvalidPrivateKey},
+		{name: "unsupported algorithm error case", algorithm: "none", path: edPath, err: ErrUnsupportedAlgorithm},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			key, err := LoadSigningKey("", tt.algorithm, tt.secret, tt.path)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.algorithm, key.Algorithm)
+		})
+	}
+}
+
+func TestLoadKeyring(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	write := func(content string) string {
+		path := filepath.Join(dir, t.Name()+".json")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+		return path
+	}
+
+	t.Run("success case", func(t *testing.T) {
+		keyring, err := LoadKeyring(write(`{"keys": [
+			{"id": "next", "algorithm": "HS256", "secret": "next-secret", "status": "next"},
+			{"id": "current", "algorithm": "HS256", "secret": "current-secret", "status": "active"},
+			{"id": "old", "algorithm": "HS256", "secret": "old-secret", "status": "retired",
+			 "retired_at": "`+time.Now().Format(time.RFC3339)+`"},
+			{"id": "expired", "algorithm": "HS256", "secret": "expired-secret", "status": "retired",
+			 "retired_at": "2020-01-01T00:00:00Z"}
+		]}`), refreshTTL)
+		require.NoError(t, err)
+		require.Equal(t, "current", keyring.SigningKey().ID)
+
+		var ids []string
+		for _, key := range keyring.VerificationKeys() {
+			ids = append(ids, key.ID)
+		}
+		require.Equal(t, []string{"current", "next", "old"}, ids)
+	})
+
+	t.Run("no active key error case", func(t *testing.T) {
+		_, err := LoadKeyring(write(`{"keys": [
+			{"id": "next", "algorithm": "HS256", "secret": "next-secret", "status": "next"}
+		]}`), refreshTTL)
+		require.Error(t, err)
+	})
+
+	t.Run("duplicate key id error case", func(t *testing.T) {
+		_, err := LoadKeyring(write(`{"keys": [
+			{"id": "current", "algorithm": "HS256", "secret": "secret", "status": "active"},
+			{"id": "current", "algorithm": "HS256", "secret": "secret", "status": "retired",
+			 "retired_at": "2025-01-01T00:00:00Z"}
+		]}`), refreshTTL)
+		require.Error(t, err)
+	})
+
+	t.Run("retired without retired_at error case", func(t *testing.T) {
+		_, err := LoadKeyring(write(`{"keys": [
+			{"id": "current", "algorithm": "HS256", "secret": "current-secret", "status": "active"},
+			{"id": "old", "algorithm": "HS256", "secret": "old-secret", "status": "retired"}
+		]}`), refreshTTL)
+		require.ErrorContains(t, err, "retired_at")
+	})
+}
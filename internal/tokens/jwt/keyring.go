@@ -0,0 +1,107 @@
+package jwt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/8thgencore/microservice-auth/internal/tokens"
+)
+
+// Keyring file key statuses.
+const (
+	KeyStatusActive  = "active"
+	KeyStatusNext    = "next"
+	KeyStatusRetired = "retired"
+)
+
+// keyringFile is the JSON document describing the signing keys, e.g.:
+//
+//	{"keys": [
+//	  {"id": "2025-02", "algorithm": "EdDSA", "private_key_path": "tls/jwt-2025-02.key", "status": "next"},
+//	  {"id": "2025-01", "algorithm": "EdDSA", "private_key_path": "tls/jwt-2025-01.key", "status": "active"},
+//	  {"id": "2024-12", "algorithm": "EdDSA", "private_key_path": "tls/jwt-2024-12.key",
+//	   "status": "retired", "retired_at": "2025-01-01T00:00:00Z"}
+//	]}
+type keyringFile struct {
+	Keys []keyringEntry `json:"keys"`
+}
+
+type keyringEntry struct {
+	ID             string     `json:"id"`
+	Algorithm      string     `json:"algorithm"`
+	Secret         string     `json:"secret,omitempty"`
+	PrivateKeyPath string     `json:"private_key_path,omitempty"`
+	Status         string     `json:"status"`
+	RetiredAt      *time.Time `json:"retired_at,omitempty"`
+}
+
+// LoadKeyring reads the keyring file. Exactly one key must be active; "next" keys are
+// staged for verification only and retired keys are kept until the retention has passed.
+func LoadKeyring(path string, retention time.Duration) (*tokens.Keyring, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path comes from service configuration
+	if err != nil {
+		return nil, fmt.Errorf("could not read keyring: %w", err)
+	}
+
+	var file keyringFile
+	if err = json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("could not parse keyring: %w", err)
+	}
+
+	var (
+		active  *tokens.Key
+		staged  []*tokens.Key
+		retired []keyringEntry
+		keys    = make(map[string]*tokens.Key, len(file.Keys))
+	)
+
+	for _, entry := range file.Keys {
+		if entry.ID == "" {
+			return nil, errors.New("keyring key id is required")
+		}
+		if _, ok := keys[entry.ID]; ok {
+			return nil, fmt.Errorf("duplicate keyring key id %q", entry.ID)
+		}
+
+		key, err := LoadSigningKey(entry.ID, entry.Algorithm, entry.Secret, entry.PrivateKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("keyring key %q: %w", entry.ID, err)
+		}
+		keys[entry.ID] = key
+
+		switch entry.Status {
+		case KeyStatusActive:
+			if active != nil {
+				return nil, errors.New("keyring must have exactly one active key")
+			}
+			active = key
+		case KeyStatusNext:
+			staged = append(staged, key)
+		case KeyStatusRetired:
+			// Without the time of retirement the end of the retention is unknown.
+			if entry.RetiredAt == nil {
+				return nil, fmt.Errorf("keyring key %q is retired without retired_at", entry.ID)
+			}
+			retired = append(retired, entry)
+		default:
+			return nil, fmt.Errorf("keyring key %q has unknown status %q", entry.ID, entry.Status)
+		}
+	}
+
+	if active == nil {
+		return nil, errors.New("keyring must have exactly one active key")
+	}
+
+	keyring := tokens.NewKeyring(active, retention)
+	for _, key := range staged {
+		keyring.Stage(key)
+	}
+	for _, entry := range retired {
+		keyring.Retire(keys[entry.ID], *entry.RetiredAt)
+	}
+
+	return keyring, nil
+}
@@ -0,0 +1,174 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+
+	"github.com/8thgencore/microservice-auth/internal/tokens"
+)
+
+// Supported signing algorithms.
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmES256 = "ES256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+const (
+	minRSAKeyBits = 2048
+
+	defaultHMACKeyID = "default"
+)
+
+var (
+	// ErrUnsupportedAlgorithm occurs when the configured signing algorithm is not supported.
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+	// ErrMissingSecretKey occurs when HS256 is configured without a secret key.
+	ErrMissingSecretKey = errors.New("secret key is required for HS256")
+	// ErrMissingPrivateKey occurs when an asymmetric algorithm is configured without a private key.
+	ErrMissingPrivateKey = errors.New("private key path is required for asymmetric algorithms")
+	// ErrInvalidPrivateKey occurs when the private key does not match the configured algorithm.
+	ErrInvalidPrivateKey = errors.New("private key does not match signing algorithm")
+)
+
+// NewHMACKey creates a symmetric HS256 signing key from a shared secret.
+func NewHMACKey(id string, secret []byte) (*tokens.Key, error) {
+	if len(secret) == 0 {
+		return nil, ErrMissingSecretKey
+	}
+
+	return &tokens.Key{
+		ID:        id,
+		Algorithm: AlgorithmHS256,
+		SignKey:   secret,
+		VerifyKey: secret,
+	}, nil
+}
+
+// NewAsymmetricKey creates a signing key for the algorithm from a parsed private key.
+// When id is empty the RFC 7638 thumbprint of the public key is used as the key ID.
+func NewAsymmetricKey(id string, algorithm string, privateKey crypto.Signer) (*tokens.Key, error) {
+	switch algorithm {
+	case AlgorithmRS256:
+		key, ok := privateKey.(*rsa.PrivateKey)
+		if !ok || key.N.BitLen() < minRSAKeyBits {
+			return nil, fmt.Errorf("%w: %s requires an RSA key of at least %d bits",
+				ErrInvalidPrivateKey, algorithm, minRSAKeyBits)
+		}
+	case AlgorithmES256:
+		key, ok := privateKey.(*ecdsa.PrivateKey)
+		if !ok || key.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("%w: %s requires a P-256 EC key", ErrInvalidPrivateKey, algorithm)
+		}
+	case AlgorithmEdDSA:
+		if _, ok := privateKey.(ed25519.PrivateKey); !ok {
+			return nil, fmt.Errorf("%w: %s requires an Ed25519 key", ErrInvalidPrivateKey, algorithm)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, algorithm)
+	}
+
+	publicKey := privateKey.Public()
+	if id == "" {
+		thumbprint, err := jwkThumbprint(publicKey)
+		if err != nil {
+			return nil, err
+		}
+		id = thumbprint
+	}
+
+	return &tokens.Key{
+		ID:        id,
+		Algorithm: algorithm,
+		SignKey:   privateKey,
+		VerifyKey: publicKey,
+		PublicKey: publicKey,
+	}, nil
+}
+
+// LoadSigningKey creates a signing key for the configured algorithm.
+// HS256 uses the shared secret, all other algorithms read a PEM encoded private key from disk.
+func LoadSigningKey(id string, algorithm string, secret string, privateKeyPath string) (*tokens.Key, error) {
+	if algorithm == AlgorithmHS256 {
+		if id == "" {
+			id = defaultHMACKeyID
+		}
+
+		return NewHMACKey(id, []byte(secret))
+	}
+
+	if privateKeyPath == "" {
+		return nil, ErrMissingPrivateKey
+	}
+
+	data, err := os.ReadFile(privateKeyPath) // #nosec G304 -- path comes from service configuration
+	if err != nil {
+		return nil, fmt.Errorf("could not read private key: %w", err)
+	}
+
+	privateKey, err := ParsePrivateKeyPEM(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAsymmetricKey(id, algorithm, privateKey)
+}
+
+// ParsePrivateKeyPEM parses a PKCS#8, PKCS#1 (RSA) or SEC 1 (EC) PEM encoded private key.
+func ParsePrivateKeyPEM(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("could not decode private key PEM")
+	}
+
+	var (
+		key any
+		err error
+	)
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not parse private key: %w", err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+
+	return signer, nil
+}
+
+// signingMethod returns the JWS signing method of the algorithm.
+func signingMethod(algorithm string) (jwt.SigningMethod, error) {
+	switch algorithm {
+	case AlgorithmHS256:
+		return jwt.SigningMethodHS256, nil
+	case AlgorithmRS256:
+		return jwt.SigningMethodRS256, nil
+	case AlgorithmES256:
+		return jwt.SigningMethodES256, nil
+	case AlgorithmEdDSA:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, algorithm)
+	}
+}
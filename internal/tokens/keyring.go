@@ -0,0 +1,185 @@
+package tokens
+
+import (
+	"crypto"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrUnknownKey occurs when a token references a key that is not in the keyring.
+var ErrUnknownKey = errors.New("unknown signing key")
+
+// Key is a token signing key identified by its key ID (the JWT "kid" header).
+type Key struct {
+	ID        string
+	Algorithm string
+	// SignKey is the private key or shared secret used to sign tokens.
+	SignKey any
+	// VerifyKey is the public key or shared secret used to verify tokens.
+	VerifyKey any
+	// PublicKey is the key published in the JWKS. It is nil for symmetric keys.
+	PublicKey crypto.PublicKey
+}
+
+type retiredKey struct {
+	key       *Key
+	retiredAt time.Time
+}
+
+// Keyring holds the active signing key together with keys that may only verify tokens:
+// staged keys that are about to become active and retired keys that are kept until every
+// token they signed has expired.
+type Keyring struct {
+	mu        sync.RWMutex
+	active    *Key
+	staged    map[string]*Key
+	retired   map[string]retiredKey
+	retention time.Duration
+	now       func() time.Time
+}
+
+// NewKeyring creates a keyring with the active key.
+// Retired keys are pruned once the retention (the longest token TTL) has passed.
+func NewKeyring(active *Key, retention time.Duration) *Keyring {
+	return &Keyring{
+		active:    active,
+		staged:    make(map[string]*Key),
+		retired:   make(map[string]retiredKey),
+		retention: retention,
+		now:       time.Now,
+	}
+}
+
+// Stage adds a verification-only key. Staging the next key on every replica before
+// activating it lets tokens signed by the new key be verified everywhere during a rollout.
+func (k *Keyring) Stage(key *Key) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.staged[key.ID] = key
+}
+
+// Retire adds a verification-only key that was retired at the given time.
+func (k *Keyring) Retire(key *Key, retiredAt time.Time) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.retired[key.ID] = retiredKey{key: key, retiredAt: retiredAt}
+	k.prune()
+}
+
+// Rotate makes the key active and retires the previously active key.
+func (k *Keyring) Rotate(next *Key) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.active != nil && k.active.ID != next.ID {
+		k.retired[k.active.ID] = retiredKey{key: k.active, retiredAt: k.now()}
+	}
+	delete(k.staged, next.ID)
+	delete(k.retired, next.ID)
+	k.active = next
+	k.prune()
+}
+
+// Replace swaps the keyring contents with the contents of another keyring, e.g. after reloading configuration.
+func (k *Keyring) Replace(other *Keyring) {
+	other.mu.RLock()
+	active, staged, retired := other.active, other.staged, other.retired
+	other.mu.RUnlock()
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	// Keep verifying tokens of the key that was active until now.
+	if k.active != nil && k.active.ID != active.ID {
+		if _, ok := staged[k.active.ID]; !ok {
+			if _, ok := retired[k.active.ID]; !ok {
+				retired[k.active.ID] = retiredKey{key: k.active, retiredAt: k.now()}
+			}
+		}
+	}
+
+	k.active = active
+	k.staged = staged
+	k.retired = retired
+	k.prune()
+}
+
+// SigningKey returns the active key.
+func (k *Keyring) SigningKey() *Key {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	return k.active
+}
+
+// VerificationKey returns the key with the given ID if it can still verify tokens.
+func (k *Keyring) VerificationKey(id string) (*Key, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	if k.active.ID == id {
+		return k.active, nil
+	}
+	if key, ok := k.staged[id]; ok {
+		return key, nil
+	}
+	if r, ok := k.retired[id]; ok && !k.expired(r) {
+		return r.key, nil
+	}
+
+	return nil, ErrUnknownKey
+}
+
+// VerificationKeys returns every key that can still verify tokens, active key first.
+func (k *Keyring) VerificationKeys() []*Key {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	keys := []*Key{k.active}
+	for _, key := range sortedKeys(k.staged) {
+		keys = append(keys, key)
+	}
+
+	retired := make(map[string]*Key, len(k.retired))
+	for id, r := range k.retired {
+		if !k.expired(r) {
+			retired[id] = r.key
+		}
+	}
+
+	return append(keys, sortedKeys(retired)...)
+}
+
+// Prune removes retired keys whose retention has passed.
+func (k *Keyring) Prune() {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.prune()
+}
+
+func (k *Keyring) prune() {
+	for id, r := range k.retired {
+		if k.expired(r) {
+			delete(k.retired, id)
+		}
+	}
+}
+
+func (k *Keyring) expired(r retiredKey) bool {
+	return k.now().After(r.retiredAt.Add(k.retention))
+}
+
+func sortedKeys(keys map[string]*Key) []*Key {
+	res := make([]*Key, 0, len(keys))
+	for _, key := range keys {
+		res = append(res, key)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+
+	return res
+}
@@ -0,0 +1,51 @@
+package tokens
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyring(t *testing.T) {
+	t.Parallel()
+
+	var (
+		retention = time.Hour
+		now       = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		first  = &Key{ID: "first", Algorithm: "HS256"}
+		second = &Key{ID: "second", Algorithm: "HS256"}
+		third  = &Key{ID: "third", Algorithm: "HS256"}
+	)
+
+	keyring := NewKeyring(first, retention)
+	keyring.now = func() time.Time { return now }
+
+	keyring.Stage(second)
+	key, err := keyring.VerificationKey(second.ID)
+	require.NoError(t, err)
+	require.Equal(t, second, key)
+	require.Equal(t, first, keyring.SigningKey())
+
+	keyring.Rotate(second)
+	require.Equal(t, second, keyring.SigningKey())
+	require.Equal(t, []*Key{second, first}, keyring.VerificationKeys())
+
+	// The retired key verifies until the retention has passed.
+	now = now.Add(retention)
+	_, err = keyring.VerificationKey(first.ID)
+	require.NoError(t, err)
+
+	now = now.Add(time.Second)
+	_, err = keyring.VerificationKey(first.ID)
+	require.ErrorIs(t, err, ErrUnknownKey)
+	require.Equal(t, []*Key{second}, keyring.VerificationKeys())
+
+	reloaded := NewKeyring(third, retention)
+	keyring.Replace(reloaded)
+	require.Equal(t, third, keyring.SigningKey())
+	key, err = keyring.VerificationKey(second.ID)
+	require.NoError(t, err)
+	require.Equal(t, second, key)
+}
@@ -16,20 +16,34 @@ type TokenOperationsMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcGenerateAccessToken          func(user model.User) (s1 string, err error)
+	funcGenerateAccessToken          func(user model.User, sessionID string) (s1 string, err error)
 	funcGenerateAccessTokenOrigin    string
-	inspectFuncGenerateAccessToken   func(user model.User)
+	inspectFuncGenerateAccessToken   func(user model.User, sessionID string)
 	afterGenerateAccessTokenCounter  uint64
 	beforeGenerateAccessTokenCounter uint64
 	GenerateAccessTokenMock          mTokenOperationsMockGenerateAccessToken
 
-	funcGenerateRefreshToken          func(userID string) (s1 string, err error)
+	funcGenerateMfaToken          func(userID string) (s1 string, err error)
+	funcGenerateMfaTokenOrigin    string
+	inspectFuncGenerateMfaToken   func(userID string)
+	afterGenerateMfaTokenCounter  uint64
+	beforeGenerateMfaTokenCounter uint64
+	GenerateMfaTokenMock          mTokenOperationsMockGenerateMfaToken
+
+	funcGenerateRefreshToken          func(userID string, familyID string, tokenID string) (s1 string, err error)
 	funcGenerateRefreshTokenOrigin    string
-	inspectFuncGenerateRefreshToken   func(userID string)
+	inspectFuncGenerateRefreshToken   func(userID string, familyID string, tokenID string)
 	afterGenerateRefreshTokenCounter  uint64
 	beforeGenerateRefreshTokenCounter uint64
 	GenerateRefreshTokenMock          mTokenOperationsMockGenerateRefreshToken
 
+	funcJWKS          func() (jp1 *model.JWKSet)
+	funcJWKSOrigin    string
+	inspectFuncJWKS   func()
+	afterJWKSCounter  uint64
+	beforeJWKSCounter uint64
+	JWKSMock          mTokenOperationsMockJWKS
+
 	funcVerifyAccessToken          func(tokenStr string) (up1 *model.UserClaims, err error)
 	funcVerifyAccessTokenOrigin    string
 	inspectFuncVerifyAccessToken   func(tokenStr string)
@@ -37,6 +51,13 @@ type TokenOperationsMock struct {
 	beforeVerifyAccessTokenCounter uint64
 	VerifyAccessTokenMock          mTokenOperationsMockVerifyAccessToken
 
+	funcVerifyMfaToken          func(tokenStr string) (mp1 *model.MfaClaims, err error)
+	funcVerifyMfaTokenOrigin    string
+	inspectFuncVerifyMfaToken   func(tokenStr string)
+	afterVerifyMfaTokenCounter  uint64
+	beforeVerifyMfaTokenCounter uint64
+	VerifyMfaTokenMock          mTokenOperationsMockVerifyMfaToken
+
 	funcVerifyRefreshToken          func(tokenStr string) (rp1 *model.RefreshClaims, err error)
 	funcVerifyRefreshTokenOrigin    string
 	inspectFuncVerifyRefreshToken   func(tokenStr string)
@@ -56,12 +77,20 @@ func NewTokenOperationsMock(t minimock.Tester) *TokenOperationsMock {
 	m.GenerateAccessTokenMock = mTokenOperationsMockGenerateAccessToken{mock: m}
 	m.GenerateAccessTokenMock.callArgs = []*TokenOperationsMockGenerateAccessTokenParams{}
 
+	m.GenerateMfaTokenMock = mTokenOperationsMockGenerateMfaToken{mock: m}
+	m.GenerateMfaTokenMock.callArgs = []*TokenOperationsMockGenerateMfaTokenParams{}
+
 	m.GenerateRefreshTokenMock = mTokenOperationsMockGenerateRefreshToken{mock: m}
 	m.GenerateRefreshTokenMock.callArgs = []*TokenOperationsMockGenerateRefreshTokenParams{}
 
+	m.JWKSMock = mTokenOperationsMockJWKS{mock: m}
+
 	m.VerifyAccessTokenMock = mTokenOperationsMockVerifyAccessToken{mock: m}
 	m.VerifyAccessTokenMock.callArgs = []*TokenOperationsMockVerifyAccessTokenParams{}
 
+	m.VerifyMfaTokenMock = mTokenOperationsMockVerifyMfaToken{mock: m}
+	m.VerifyMfaTokenMock.callArgs = []*TokenOperationsMockVerifyMfaTokenParams{}
+
 	m.VerifyRefreshTokenMock = mTokenOperationsMockVerifyRefreshToken{mock: m}
 	m.VerifyRefreshTokenMock.callArgs = []*TokenOperationsMockVerifyRefreshTokenParams{}
 
@@ -96,12 +125,14 @@ type TokenOperationsMockGenerateAccessTokenExpectation struct {
 
 // TokenOperationsMockGenerateAccessTokenParams contains parameters of the TokenOperations.GenerateAccessToken
 type TokenOperationsMockGenerateAccessTokenParams struct {
-	user model.User
+	user      model.User
+	sessionID string
 }
 
 // TokenOperationsMockGenerateAccessTokenParamPtrs contains pointers to parameters of the TokenOperations.GenerateAccessToken
 type TokenOperationsMockGenerateAccessTokenParamPtrs struct {
-	user *model.User
+	user      *model.User
+	sessionID *string
 }
 
 // TokenOperationsMockGenerateAccessTokenResults contains results of the TokenOperations.GenerateAccessToken
@@ -112,8 +143,9 @@ type TokenOperationsMockGenerateAccessTokenResults struct {
 
 // TokenOperationsMockGenerateAccessTokenOrigins contains origins of expectations of the TokenOperations.GenerateAccessToken
 type TokenOperationsMockGenerateAccessTokenExpectationOrigins struct {
-	origin     string
-	originUser string
+	origin          string
+	originUser      string
+	originSessionID string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -127,7 +159,7 @@ func (mmGenerateAccessToken *mTokenOperationsMockGenerateAccessToken) Optional()
 }
 
 // Expect sets up expected params for TokenOperations.GenerateAccessToken
-func (mmGenerateAccessToken *mTokenOperationsMockGenerateAccessToken) Expect(user model.User) *mTokenOperationsMockGenerateAccessToken {
+func (mmGenerateAccessToken *mTokenOperationsMockGenerateAccessToken) Expect(user model.User, sessionID string) *mTokenOperationsMockGenerateAccessToken {
 	if mmGenerateAccessToken.mock.funcGenerateAccessToken != nil {
 		mmGenerateAccessToken.mock.t.Fatalf("TokenOperationsMock.GenerateAccessToken mock is already set by Set")
 	}
@@ -140,7 +172,7 @@ func (mmGenerateAccessToken *mTokenOperationsMockGenerateAccessToken) Expect(use
 		mmGenerateAccessToken.mock.t.Fatalf("TokenOperationsMock.GenerateAccessToken mock is already set by ExpectParams functions")
 	}
 
-	mmGenerateAccessToken.defaultExpectation.params = &TokenOperationsMockGenerateAccessTokenParams{user}
+	mmGenerateAccessToken.defaultExpectation.params = &TokenOperationsMockGenerateAccessTokenParams{user, sessionID}
 	mmGenerateAccessToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmGenerateAccessToken.expectations {
 		if minimock.Equal(e.params, mmGenerateAccessToken.defaultExpectation.params) {
@@ -174,8 +206,31 @@ func (mmGenerateAccessToken *mTokenOperationsMockGenerateAccessToken) ExpectUser
 	return mmGenerateAccessToken
 }
 
+// ExpectSessionIDParam2 sets up expected param sessionID for TokenOperations.GenerateAccessToken
+func (mmGenerateAccessToken *mTokenOperationsMockGenerateAccessToken) ExpectSessionIDParam2(sessionID string) *mTokenOperationsMockGenerateAccessToken {
+	if mmGenerateAccessToken.mock.funcGenerateAccessToken != nil {
+		mmGenerateAccessToken.mock.t.Fatalf("TokenOperationsMock.GenerateAccessToken mock is already set by Set")
+	}
+
+	if mmGenerateAccessToken.defaultExpectation == nil {
+		mmGenerateAccessToken.defaultExpectation = &TokenOperationsMockGenerateAccessTokenExpectation{}
+	}
+
+	if mmGenerateAccessToken.defaultExpectation.params != nil {
+		mmGenerateAccessToken.mock.t.Fatalf("TokenOperationsMock.GenerateAccessToken mock is already set by Expect")
+	}
+
+	if mmGenerateAccessToken.defaultExpectation.paramPtrs == nil {
+		mmGenerateAccessToken.defaultExpectation.paramPtrs = &TokenOperationsMockGenerateAccessTokenParamPtrs{}
+	}
+	mmGenerateAccessToken.defaultExpectation.paramPtrs.sessionID = &sessionID
+	mmGenerateAccessToken.defaultExpectation.expectationOrigins.originSessionID = minimock.CallerInfo(1)
+
+	return mmGenerateAccessToken
+}
+
 // Inspect accepts an inspector function that has same arguments as the TokenOperations.GenerateAccessToken
-func (mmGenerateAccessToken *mTokenOperationsMockGenerateAccessToken) Inspect(f func(user model.User)) *mTokenOperationsMockGenerateAccessToken {
+func (mmGenerateAccessToken *mTokenOperationsMockGenerateAccessToken) Inspect(f func(user model.User, sessionID string)) *mTokenOperationsMockGenerateAccessToken {
 	if mmGenerateAccessToken.mock.inspectFuncGenerateAccessToken != nil {
 		mmGenerateAccessToken.mock.t.Fatalf("Inspect function is already set for TokenOperationsMock.GenerateAccessToken")
 	}
@@ -200,7 +255,7 @@ func (mmGenerateAccessToken *mTokenOperationsMockGenerateAccessToken) Return(s1
 }
 
 // Set uses given function f to mock the TokenOperations.GenerateAccessToken method
-func (mmGenerateAccessToken *mTokenOperationsMockGenerateAccessToken) Set(f func(user model.User) (s1 string, err error)) *TokenOperationsMock {
+func (mmGenerateAccessToken *mTokenOperationsMockGenerateAccessToken) Set(f func(user model.User, sessionID string) (s1 string, err error)) *TokenOperationsMock {
 	if mmGenerateAccessToken.defaultExpectation != nil {
 		mmGenerateAccessToken.mock.t.Fatalf("Default expectation is already set for the TokenOperations.GenerateAccessToken method")
 	}
@@ -216,14 +271,14 @@ func (mmGenerateAccessToken *mTokenOperationsMockGenerateAccessToken) Set(f func
 
 // When sets expectation for the TokenOperations.GenerateAccessToken which will trigger the result defined by the following
 // Then helper
-func (mmGenerateAccessToken *mTokenOperationsMockGenerateAccessToken) When(user model.User) *TokenOperationsMockGenerateAccessTokenExpectation {
+func (mmGenerateAccessToken *mTokenOperationsMockGenerateAccessToken) When(user model.User, sessionID string) *TokenOperationsMockGenerateAccessTokenExpectation {
 	if mmGenerateAccessToken.mock.funcGenerateAccessToken != nil {
 		mmGenerateAccessToken.mock.t.Fatalf("TokenOperationsMock.GenerateAccessToken mock is already set by Set")
 	}
 
 	expectation := &TokenOperationsMockGenerateAccessTokenExpectation{
 		mock:               mmGenerateAccessToken.mock,
-		params:             &TokenOperationsMockGenerateAccessTokenParams{user},
+		params:             &TokenOperationsMockGenerateAccessTokenParams{user, sessionID},
 		expectationOrigins: TokenOperationsMockGenerateAccessTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmGenerateAccessToken.expectations = append(mmGenerateAccessToken.expectations, expectation)
@@ -258,17 +313,17 @@ func (mmGenerateAccessToken *mTokenOperationsMockGenerateAccessToken) invocation
 }
 
 // GenerateAccessToken implements mm_tokens.TokenOperations
-func (mmGenerateAccessToken *TokenOperationsMock) GenerateAccessToken(user model.User) (s1 string, err error) {
+func (mmGenerateAccessToken *TokenOperationsMock) GenerateAccessToken(user model.User, sessionID string) (s1 string, err error) {
 	mm_atomic.AddUint64(&mmGenerateAccessToken.beforeGenerateAccessTokenCounter, 1)
 	defer mm_atomic.AddUint64(&mmGenerateAccessToken.afterGenerateAccessTokenCounter, 1)
 
 	mmGenerateAccessToken.t.Helper()
 
 	if mmGenerateAccessToken.inspectFuncGenerateAccessToken != nil {
-		mmGenerateAccessToken.inspectFuncGenerateAccessToken(user)
+		mmGenerateAccessToken.inspectFuncGenerateAccessToken(user, sessionID)
 	}
 
-	mm_params := TokenOperationsMockGenerateAccessTokenParams{user}
+	mm_params := TokenOperationsMockGenerateAccessTokenParams{user, sessionID}
 
 	// Record call args
 	mmGenerateAccessToken.GenerateAccessTokenMock.mutex.Lock()
@@ -287,7 +342,7 @@ func (mmGenerateAccessToken *TokenOperationsMock) GenerateAccessToken(user model
 		mm_want := mmGenerateAccessToken.GenerateAccessTokenMock.defaultExpectation.params
 		mm_want_ptrs := mmGenerateAccessToken.GenerateAccessTokenMock.defaultExpectation.paramPtrs
 
-		mm_got := TokenOperationsMockGenerateAccessTokenParams{user}
+		mm_got := TokenOperationsMockGenerateAccessTokenParams{user, sessionID}
 
 		if mm_want_ptrs != nil {
 
@@ -296,6 +351,11 @@ func (mmGenerateAccessToken *TokenOperationsMock) GenerateAccessToken(user model
 					mmGenerateAccessToken.GenerateAccessTokenMock.defaultExpectation.expectationOrigins.originUser, *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
 			}
 
+			if mm_want_ptrs.sessionID != nil && !minimock.Equal(*mm_want_ptrs.sessionID, mm_got.sessionID) {
+				mmGenerateAccessToken.t.Errorf("TokenOperationsMock.GenerateAccessToken got unexpected parameter sessionID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGenerateAccessToken.GenerateAccessTokenMock.defaultExpectation.expectationOrigins.originSessionID, *mm_want_ptrs.sessionID, mm_got.sessionID, minimock.Diff(*mm_want_ptrs.sessionID, mm_got.sessionID))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmGenerateAccessToken.t.Errorf("TokenOperationsMock.GenerateAccessToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmGenerateAccessToken.GenerateAccessTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -308,9 +368,9 @@ func (mmGenerateAccessToken *TokenOperationsMock) GenerateAccessToken(user model
 		return (*mm_results).s1, (*mm_results).err
 	}
 	if mmGenerateAccessToken.funcGenerateAccessToken != nil {
-		return mmGenerateAccessToken.funcGenerateAccessToken(user)
+		return mmGenerateAccessToken.funcGenerateAccessToken(user, sessionID)
 	}
-	mmGenerateAccessToken.t.Fatalf("Unexpected call to TokenOperationsMock.GenerateAccessToken. %v", user)
+	mmGenerateAccessToken.t.Fatalf("Unexpected call to TokenOperationsMock.GenerateAccessToken. %v %v", user, sessionID)
 	return
 }
 
@@ -382,6 +442,318 @@ func (m *TokenOperationsMock) MinimockGenerateAccessTokenInspect() {
 	}
 }
 
+type mTokenOperationsMockGenerateMfaToken struct {
+	optional           bool
+	mock               *TokenOperationsMock
+	defaultExpectation *TokenOperationsMockGenerateMfaTokenExpectation
+	expectations       []*TokenOperationsMockGenerateMfaTokenExpectation
+
+	callArgs []*TokenOperationsMockGenerateMfaTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TokenOperationsMockGenerateMfaTokenExpectation specifies expectation struct of the TokenOperations.GenerateMfaToken
+type TokenOperationsMockGenerateMfaTokenExpectation struct {
+	mock               *TokenOperationsMock
+	params             *TokenOperationsMockGenerateMfaTokenParams
+	paramPtrs          *TokenOperationsMockGenerateMfaTokenParamPtrs
+	expectationOrigins TokenOperationsMockGenerateMfaTokenExpectationOrigins
+	results            *TokenOperationsMockGenerateMfaTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TokenOperationsMockGenerateMfaTokenParams contains parameters of the TokenOperations.GenerateMfaToken
+type TokenOperationsMockGenerateMfaTokenParams struct {
+	userID string
+}
+
+// TokenOperationsMockGenerateMfaTokenParamPtrs contains pointers to parameters of the TokenOperations.GenerateMfaToken
+type TokenOperationsMockGenerateMfaTokenParamPtrs struct {
+	userID *string
+}
+
+// TokenOperationsMockGenerateMfaTokenResults contains results of the TokenOperations.GenerateMfaToken
+type TokenOperationsMockGenerateMfaTokenResults struct {
+	s1  string
+	err error
+}
+
+// TokenOperationsMockGenerateMfaTokenOrigins contains origins of expectations of the TokenOperations.GenerateMfaToken
+type TokenOperationsMockGenerateMfaTokenExpectationOrigins struct {
+	origin       string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGenerateMfaToken *mTokenOperationsMockGenerateMfaToken) Optional() *mTokenOperationsMockGenerateMfaToken {
+	mmGenerateMfaToken.optional = true
+	return mmGenerateMfaToken
+}
+
+// Expect sets up expected params for TokenOperations.GenerateMfaToken
+func (mmGenerateMfaToken *mTokenOperationsMockGenerateMfaToken) Expect(userID string) *mTokenOperationsMockGenerateMfaToken {
+	if mmGenerateMfaToken.mock.funcGenerateMfaToken != nil {
+		mmGenerateMfaToken.mock.t.Fatalf("TokenOperationsMock.GenerateMfaToken mock is already set by Set")
+	}
+
+	if mmGenerateMfaToken.defaultExpectation == nil {
+		mmGenerateMfaToken.defaultExpectation = &TokenOperationsMockGenerateMfaTokenExpectation{}
+	}
+
+	if mmGenerateMfaToken.defaultExpectation.paramPtrs != nil {
+		mmGenerateMfaToken.mock.t.Fatalf("TokenOperationsMock.GenerateMfaToken mock is already set by ExpectParams functions")
+	}
+
+	mmGenerateMfaToken.defaultExpectation.params = &TokenOperationsMockGenerateMfaTokenParams{userID}
+	mmGenerateMfaToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGenerateMfaToken.expectations {
+		if minimock.Equal(e.params, mmGenerateMfaToken.defaultExpectation.params) {
+			mmGenerateMfaToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGenerateMfaToken.defaultExpectation.params)
+		}
+	}
+
+	return mmGenerateMfaToken
+}
+
+// ExpectUserIDParam1 sets up expected param userID for TokenOperations.GenerateMfaToken
+func (mmGenerateMfaToken *mTokenOperationsMockGenerateMfaToken) ExpectUserIDParam1(userID string) *mTokenOperationsMockGenerateMfaToken {
+	if mmGenerateMfaToken.mock.funcGenerateMfaToken != nil {
+		mmGenerateMfaToken.mock.t.Fatalf("TokenOperationsMock.GenerateMfaToken mock is already set by Set")
+	}
+
+	if mmGenerateMfaToken.defaultExpectation == nil {
+		mmGenerateMfaToken.defaultExpectation = &TokenOperationsMockGenerateMfaTokenExpectation{}
+	}
+
+	if mmGenerateMfaToken.defaultExpectation.params != nil {
+		mmGenerateMfaToken.mock.t.Fatalf("TokenOperationsMock.GenerateMfaToken mock is already set by Expect")
+	}
+
+	if mmGenerateMfaToken.defaultExpectation.paramPtrs == nil {
+		mmGenerateMfaToken.defaultExpectation.paramPtrs = &TokenOperationsMockGenerateMfaTokenParamPtrs{}
+	}
+	mmGenerateMfaToken.defaultExpectation.paramPtrs.userID = &userID
+	mmGenerateMfaToken.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGenerateMfaToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenOperations.GenerateMfaToken
+func (mmGenerateMfaToken *mTokenOperationsMockGenerateMfaToken) Inspect(f func(userID string)) *mTokenOperationsMockGenerateMfaToken {
+	if mmGenerateMfaToken.mock.inspectFuncGenerateMfaToken != nil {
+		mmGenerateMfaToken.mock.t.Fatalf("Inspect function is already set for TokenOperationsMock.GenerateMfaToken")
+	}
+
+	mmGenerateMfaToken.mock.inspectFuncGenerateMfaToken = f
+
+	return mmGenerateMfaToken
+}
+
+// Return sets up results that will be returned by TokenOperations.GenerateMfaToken
+func (mmGenerateMfaToken *mTokenOperationsMockGenerateMfaToken) Return(s1 string, err error) *TokenOperationsMock {
+	if mmGenerateMfaToken.mock.funcGenerateMfaToken != nil {
+		mmGenerateMfaToken.mock.t.Fatalf("TokenOperationsMock.GenerateMfaToken mock is already set by Set")
+	}
+
+	if mmGenerateMfaToken.defaultExpectation == nil {
+		mmGenerateMfaToken.defaultExpectation = &TokenOperationsMockGenerateMfaTokenExpectation{mock: mmGenerateMfaToken.mock}
+	}
+	mmGenerateMfaToken.defaultExpectation.results = &TokenOperationsMockGenerateMfaTokenResults{s1, err}
+	mmGenerateMfaToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGenerateMfaToken.mock
+}
+
+// Set uses given function f to mock the TokenOperations.GenerateMfaToken method
+func (mmGenerateMfaToken *mTokenOperationsMockGenerateMfaToken) Set(f func(userID string) (s1 string, err error)) *TokenOperationsMock {
+	if mmGenerateMfaToken.defaultExpectation != nil {
+		mmGenerateMfaToken.mock.t.Fatalf("Default expectation is already set for the TokenOperations.GenerateMfaToken method")
+	}
+
+	if len(mmGenerateMfaToken.expectations) > 0 {
+		mmGenerateMfaToken.mock.t.Fatalf("Some expectations are already set for the TokenOperations.GenerateMfaToken method")
+	}
+
+	mmGenerateMfaToken.mock.funcGenerateMfaToken = f
+	mmGenerateMfaToken.mock.funcGenerateMfaTokenOrigin = minimock.CallerInfo(1)
+	return mmGenerateMfaToken.mock
+}
+
+// When sets expectation for the TokenOperations.GenerateMfaToken which will trigger the result defined by the following
+// Then helper
+func (mmGenerateMfaToken *mTokenOperationsMockGenerateMfaToken) When(userID string) *TokenOperationsMockGenerateMfaTokenExpectation {
+	if mmGenerateMfaToken.mock.funcGenerateMfaToken != nil {
+		mmGenerateMfaToken.mock.t.Fatalf("TokenOperationsMock.GenerateMfaToken mock is already set by Set")
+	}
+
+	expectation := &TokenOperationsMockGenerateMfaTokenExpectation{
+		mock:               mmGenerateMfaToken.mock,
+		params:             &TokenOperationsMockGenerateMfaTokenParams{userID},
+		expectationOrigins: TokenOperationsMockGenerateMfaTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGenerateMfaToken.expectations = append(mmGenerateMfaToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TokenOperations.GenerateMfaToken return parameters for the expectation previously defined by the When method
+func (e *TokenOperationsMockGenerateMfaTokenExpectation) Then(s1 string, err error) *TokenOperationsMock {
+	e.results = &TokenOperationsMockGenerateMfaTokenResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times TokenOperations.GenerateMfaToken should be invoked
+func (mmGenerateMfaToken *mTokenOperationsMockGenerateMfaToken) Times(n uint64) *mTokenOperationsMockGenerateMfaToken {
+	if n == 0 {
+		mmGenerateMfaToken.mock.t.Fatalf("Times of TokenOperationsMock.GenerateMfaToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGenerateMfaToken.expectedInvocations, n)
+	mmGenerateMfaToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGenerateMfaToken
+}
+
+func (mmGenerateMfaToken *mTokenOperationsMockGenerateMfaToken) invocationsDone() bool {
+	if len(mmGenerateMfaToken.expectations) == 0 && mmGenerateMfaToken.defaultExpectation == nil && mmGenerateMfaToken.mock.funcGenerateMfaToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGenerateMfaToken.mock.afterGenerateMfaTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGenerateMfaToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GenerateMfaToken implements mm_tokens.TokenOperations
+func (mmGenerateMfaToken *TokenOperationsMock) GenerateMfaToken(userID string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmGenerateMfaToken.beforeGenerateMfaTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmGenerateMfaToken.afterGenerateMfaTokenCounter, 1)
+
+	mmGenerateMfaToken.t.Helper()
+
+	if mmGenerateMfaToken.inspectFuncGenerateMfaToken != nil {
+		mmGenerateMfaToken.inspectFuncGenerateMfaToken(userID)
+	}
+
+	mm_params := TokenOperationsMockGenerateMfaTokenParams{userID}
+
+	// Record call args
+	mmGenerateMfaToken.GenerateMfaTokenMock.mutex.Lock()
+	mmGenerateMfaToken.GenerateMfaTokenMock.callArgs = append(mmGenerateMfaToken.GenerateMfaTokenMock.callArgs, &mm_params)
+	mmGenerateMfaToken.GenerateMfaTokenMock.mutex.Unlock()
+
+	for _, e := range mmGenerateMfaToken.GenerateMfaTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGenerateMfaToken.GenerateMfaTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGenerateMfaToken.GenerateMfaTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmGenerateMfaToken.GenerateMfaTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmGenerateMfaToken.GenerateMfaTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := TokenOperationsMockGenerateMfaTokenParams{userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGenerateMfaToken.t.Errorf("TokenOperationsMock.GenerateMfaToken got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGenerateMfaToken.GenerateMfaTokenMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGenerateMfaToken.t.Errorf("TokenOperationsMock.GenerateMfaToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGenerateMfaToken.GenerateMfaTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGenerateMfaToken.GenerateMfaTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGenerateMfaToken.t.Fatal("No results are set for the TokenOperationsMock.GenerateMfaToken")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGenerateMfaToken.funcGenerateMfaToken != nil {
+		return mmGenerateMfaToken.funcGenerateMfaToken(userID)
+	}
+	mmGenerateMfaToken.t.Fatalf("Unexpected call to TokenOperationsMock.GenerateMfaToken. %v", userID)
+	return
+}
+
+// GenerateMfaTokenAfterCounter returns a count of finished TokenOperationsMock.GenerateMfaToken invocations
+func (mmGenerateMfaToken *TokenOperationsMock) GenerateMfaTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGenerateMfaToken.afterGenerateMfaTokenCounter)
+}
+
+// GenerateMfaTokenBeforeCounter returns a count of TokenOperationsMock.GenerateMfaToken invocations
+func (mmGenerateMfaToken *TokenOperationsMock) GenerateMfaTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGenerateMfaToken.beforeGenerateMfaTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to TokenOperationsMock.GenerateMfaToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGenerateMfaToken *mTokenOperationsMockGenerateMfaToken) Calls() []*TokenOperationsMockGenerateMfaTokenParams {
+	mmGenerateMfaToken.mutex.RLock()
+
+	argCopy := make([]*TokenOperationsMockGenerateMfaTokenParams, len(mmGenerateMfaToken.callArgs))
+	copy(argCopy, mmGenerateMfaToken.callArgs)
+
+	mmGenerateMfaToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGenerateMfaTokenDone returns true if the count of the GenerateMfaToken invocations corresponds
+// the number of defined expectations
+func (m *TokenOperationsMock) MinimockGenerateMfaTokenDone() bool {
+	if m.GenerateMfaTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GenerateMfaTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GenerateMfaTokenMock.invocationsDone()
+}
+
+// MinimockGenerateMfaTokenInspect logs each unmet expectation
+func (m *TokenOperationsMock) MinimockGenerateMfaTokenInspect() {
+	for _, e := range m.GenerateMfaTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TokenOperationsMock.GenerateMfaToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGenerateMfaTokenCounter := mm_atomic.LoadUint64(&m.afterGenerateMfaTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GenerateMfaTokenMock.defaultExpectation != nil && afterGenerateMfaTokenCounter < 1 {
+		if m.GenerateMfaTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TokenOperationsMock.GenerateMfaToken at\n%s", m.GenerateMfaTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TokenOperationsMock.GenerateMfaToken at\n%s with params: %#v", m.GenerateMfaTokenMock.defaultExpectation.expectationOrigins.origin, *m.GenerateMfaTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGenerateMfaToken != nil && afterGenerateMfaTokenCounter < 1 {
+		m.t.Errorf("Expected call to TokenOperationsMock.GenerateMfaToken at\n%s", m.funcGenerateMfaTokenOrigin)
+	}
+
+	if !m.GenerateMfaTokenMock.invocationsDone() && afterGenerateMfaTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenOperationsMock.GenerateMfaToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GenerateMfaTokenMock.expectedInvocations), m.GenerateMfaTokenMock.expectedInvocationsOrigin, afterGenerateMfaTokenCounter)
+	}
+}
+
 type mTokenOperationsMockGenerateRefreshToken struct {
 	optional           bool
 	mock               *TokenOperationsMock
@@ -408,12 +780,16 @@ type TokenOperationsMockGenerateRefreshTokenExpectation struct {
 
 // TokenOperationsMockGenerateRefreshTokenParams contains parameters of the TokenOperations.GenerateRefreshToken
 type TokenOperationsMockGenerateRefreshTokenParams struct {
-	userID string
+	userID   string
+	familyID string
+	tokenID  string
 }
 
 // TokenOperationsMockGenerateRefreshTokenParamPtrs contains pointers to parameters of the TokenOperations.GenerateRefreshToken
 type TokenOperationsMockGenerateRefreshTokenParamPtrs struct {
-	userID *string
+	userID   *string
+	familyID *string
+	tokenID  *string
 }
 
 // TokenOperationsMockGenerateRefreshTokenResults contains results of the TokenOperations.GenerateRefreshToken
@@ -424,8 +800,10 @@ type TokenOperationsMockGenerateRefreshTokenResults struct {
 
 // TokenOperationsMockGenerateRefreshTokenOrigins contains origins of expectations of the TokenOperations.GenerateRefreshToken
 type TokenOperationsMockGenerateRefreshTokenExpectationOrigins struct {
-	origin       string
-	originUserID string
+	origin         string
+	originUserID   string
+	originFamilyID string
+	originTokenID  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -439,7 +817,7 @@ func (mmGenerateRefreshToken *mTokenOperationsMockGenerateRefreshToken) Optional
 }
 
 // Expect sets up expected params for TokenOperations.GenerateRefreshToken
-func (mmGenerateRefreshToken *mTokenOperationsMockGenerateRefreshToken) Expect(userID string) *mTokenOperationsMockGenerateRefreshToken {
+func (mmGenerateRefreshToken *mTokenOperationsMockGenerateRefreshToken) Expect(userID string, familyID string, tokenID string) *mTokenOperationsMockGenerateRefreshToken {
 	if mmGenerateRefreshToken.mock.funcGenerateRefreshToken != nil {
 		mmGenerateRefreshToken.mock.t.Fatalf("TokenOperationsMock.GenerateRefreshToken mock is already set by Set")
 	}
@@ -452,7 +830,7 @@ func (mmGenerateRefreshToken *mTokenOperationsMockGenerateRefreshToken) Expect(u
 		mmGenerateRefreshToken.mock.t.Fatalf("TokenOperationsMock.GenerateRefreshToken mock is already set by ExpectParams functions")
 	}
 
-	mmGenerateRefreshToken.defaultExpectation.params = &TokenOperationsMockGenerateRefreshTokenParams{userID}
+	mmGenerateRefreshToken.defaultExpectation.params = &TokenOperationsMockGenerateRefreshTokenParams{userID, familyID, tokenID}
 	mmGenerateRefreshToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmGenerateRefreshToken.expectations {
 		if minimock.Equal(e.params, mmGenerateRefreshToken.defaultExpectation.params) {
@@ -486,8 +864,54 @@ func (mmGenerateRefreshToken *mTokenOperationsMockGenerateRefreshToken) ExpectUs
 	return mmGenerateRefreshToken
 }
 
+// ExpectFamilyIDParam2 sets up expected param familyID for TokenOperations.GenerateRefreshToken
+func (mmGenerateRefreshToken *mTokenOperationsMockGenerateRefreshToken) ExpectFamilyIDParam2(familyID string) *mTokenOperationsMockGenerateRefreshToken {
+	if mmGenerateRefreshToken.mock.funcGenerateRefreshToken != nil {
+		mmGenerateRefreshToken.mock.t.Fatalf("TokenOperationsMock.GenerateRefreshToken mock is already set by Set")
+	}
+
+	if mmGenerateRefreshToken.defaultExpectation == nil {
+		mmGenerateRefreshToken.defaultExpectation = &TokenOperationsMockGenerateRefreshTokenExpectation{}
+	}
+
+	if mmGenerateRefreshToken.defaultExpectation.params != nil {
+		mmGenerateRefreshToken.mock.t.Fatalf("TokenOperationsMock.GenerateRefreshToken mock is already set by Expect")
+	}
+
+	if mmGenerateRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmGenerateRefreshToken.defaultExpectation.paramPtrs = &TokenOperationsMockGenerateRefreshTokenParamPtrs{}
+	}
+	mmGenerateRefreshToken.defaultExpectation.paramPtrs.familyID = &familyID
+	mmGenerateRefreshToken.defaultExpectation.expectationOrigins.originFamilyID = minimock.CallerInfo(1)
+
+	return mmGenerateRefreshToken
+}
+
+// ExpectTokenIDParam3 sets up expected param tokenID for TokenOperations.GenerateRefreshToken
+func (mmGenerateRefreshToken *mTokenOperationsMockGenerateRefreshToken) ExpectTokenIDParam3(tokenID string) *mTokenOperationsMockGenerateRefreshToken {
+	if mmGenerateRefreshToken.mock.funcGenerateRefreshToken != nil {
+		mmGenerateRefreshToken.mock.t.Fatalf("TokenOperationsMock.GenerateRefreshToken mock is already set by Set")
+	}
+
+	if mmGenerateRefreshToken.defaultExpectation == nil {
+		mmGenerateRefreshToken.defaultExpectation = &TokenOperationsMockGenerateRefreshTokenExpectation{}
+	}
+
+	if mmGenerateRefreshToken.defaultExpectation.params != nil {
+		mmGenerateRefreshToken.mock.t.Fatalf("TokenOperationsMock.GenerateRefreshToken mock is already set by Expect")
+	}
+
+	if mmGenerateRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmGenerateRefreshToken.defaultExpectation.paramPtrs = &TokenOperationsMockGenerateRefreshTokenParamPtrs{}
+	}
+	mmGenerateRefreshToken.defaultExpectation.paramPtrs.tokenID = &tokenID
+	mmGenerateRefreshToken.defaultExpectation.expectationOrigins.originTokenID = minimock.CallerInfo(1)
+
+	return mmGenerateRefreshToken
+}
+
 // Inspect accepts an inspector function that has same arguments as the TokenOperations.GenerateRefreshToken
-func (mmGenerateRefreshToken *mTokenOperationsMockGenerateRefreshToken) Inspect(f func(userID string)) *mTokenOperationsMockGenerateRefreshToken {
+func (mmGenerateRefreshToken *mTokenOperationsMockGenerateRefreshToken) Inspect(f func(userID string, familyID string, tokenID string)) *mTokenOperationsMockGenerateRefreshToken {
 	if mmGenerateRefreshToken.mock.inspectFuncGenerateRefreshToken != nil {
 		mmGenerateRefreshToken.mock.t.Fatalf("Inspect function is already set for TokenOperationsMock.GenerateRefreshToken")
 	}
@@ -512,7 +936,7 @@ func (mmGenerateRefreshToken *mTokenOperationsMockGenerateRefreshToken) Return(s
 }
 
 // Set uses given function f to mock the TokenOperations.GenerateRefreshToken method
-func (mmGenerateRefreshToken *mTokenOperationsMockGenerateRefreshToken) Set(f func(userID string) (s1 string, err error)) *TokenOperationsMock {
+func (mmGenerateRefreshToken *mTokenOperationsMockGenerateRefreshToken) Set(f func(userID string, familyID string, tokenID string) (s1 string, err error)) *TokenOperationsMock {
 	if mmGenerateRefreshToken.defaultExpectation != nil {
 		mmGenerateRefreshToken.mock.t.Fatalf("Default expectation is already set for the TokenOperations.GenerateRefreshToken method")
 	}
@@ -528,14 +952,14 @@ func (mmGenerateRefreshToken *mTokenOperationsMockGenerateRefreshToken) Set(f fu
 
 // When sets expectation for the TokenOperations.GenerateRefreshToken which will trigger the result defined by the following
 // Then helper
-func (mmGenerateRefreshToken *mTokenOperationsMockGenerateRefreshToken) When(userID string) *TokenOperationsMockGenerateRefreshTokenExpectation {
+func (mmGenerateRefreshToken *mTokenOperationsMockGenerateRefreshToken) When(userID string, familyID string, tokenID string) *TokenOperationsMockGenerateRefreshTokenExpectation {
 	if mmGenerateRefreshToken.mock.funcGenerateRefreshToken != nil {
 		mmGenerateRefreshToken.mock.t.Fatalf("TokenOperationsMock.GenerateRefreshToken mock is already set by Set")
 	}
 
 	expectation := &TokenOperationsMockGenerateRefreshTokenExpectation{
 		mock:               mmGenerateRefreshToken.mock,
-		params:             &TokenOperationsMockGenerateRefreshTokenParams{userID},
+		params:             &TokenOperationsMockGenerateRefreshTokenParams{userID, familyID, tokenID},
 		expectationOrigins: TokenOperationsMockGenerateRefreshTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmGenerateRefreshToken.expectations = append(mmGenerateRefreshToken.expectations, expectation)
@@ -570,17 +994,17 @@ func (mmGenerateRefreshToken *mTokenOperationsMockGenerateRefreshToken) invocati
 }
 
 // GenerateRefreshToken implements mm_tokens.TokenOperations
-func (mmGenerateRefreshToken *TokenOperationsMock) GenerateRefreshToken(userID string) (s1 string, err error) {
+func (mmGenerateRefreshToken *TokenOperationsMock) GenerateRefreshToken(userID string, familyID string, tokenID string) (s1 string, err error) {
 	mm_atomic.AddUint64(&mmGenerateRefreshToken.beforeGenerateRefreshTokenCounter, 1)
 	defer mm_atomic.AddUint64(&mmGenerateRefreshToken.afterGenerateRefreshTokenCounter, 1)
 
 	mmGenerateRefreshToken.t.Helper()
 
 	if mmGenerateRefreshToken.inspectFuncGenerateRefreshToken != nil {
-		mmGenerateRefreshToken.inspectFuncGenerateRefreshToken(userID)
+		mmGenerateRefreshToken.inspectFuncGenerateRefreshToken(userID, familyID, tokenID)
 	}
 
-	mm_params := TokenOperationsMockGenerateRefreshTokenParams{userID}
+	mm_params := TokenOperationsMockGenerateRefreshTokenParams{userID, familyID, tokenID}
 
 	// Record call args
 	mmGenerateRefreshToken.GenerateRefreshTokenMock.mutex.Lock()
@@ -599,7 +1023,7 @@ func (mmGenerateRefreshToken *TokenOperationsMock) GenerateRefreshToken(userID s
 		mm_want := mmGenerateRefreshToken.GenerateRefreshTokenMock.defaultExpectation.params
 		mm_want_ptrs := mmGenerateRefreshToken.GenerateRefreshTokenMock.defaultExpectation.paramPtrs
 
-		mm_got := TokenOperationsMockGenerateRefreshTokenParams{userID}
+		mm_got := TokenOperationsMockGenerateRefreshTokenParams{userID, familyID, tokenID}
 
 		if mm_want_ptrs != nil {
 
@@ -608,6 +1032,16 @@ func (mmGenerateRefreshToken *TokenOperationsMock) GenerateRefreshToken(userID s
 					mmGenerateRefreshToken.GenerateRefreshTokenMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
 			}
 
+			if mm_want_ptrs.familyID != nil && !minimock.Equal(*mm_want_ptrs.familyID, mm_got.familyID) {
+				mmGenerateRefreshToken.t.Errorf("TokenOperationsMock.GenerateRefreshToken got unexpected parameter familyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGenerateRefreshToken.GenerateRefreshTokenMock.defaultExpectation.expectationOrigins.originFamilyID, *mm_want_ptrs.familyID, mm_got.familyID, minimock.Diff(*mm_want_ptrs.familyID, mm_got.familyID))
+			}
+
+			if mm_want_ptrs.tokenID != nil && !minimock.Equal(*mm_want_ptrs.tokenID, mm_got.tokenID) {
+				mmGenerateRefreshToken.t.Errorf("TokenOperationsMock.GenerateRefreshToken got unexpected parameter tokenID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGenerateRefreshToken.GenerateRefreshTokenMock.defaultExpectation.expectationOrigins.originTokenID, *mm_want_ptrs.tokenID, mm_got.tokenID, minimock.Diff(*mm_want_ptrs.tokenID, mm_got.tokenID))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmGenerateRefreshToken.t.Errorf("TokenOperationsMock.GenerateRefreshToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmGenerateRefreshToken.GenerateRefreshTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -620,9 +1054,9 @@ func (mmGenerateRefreshToken *TokenOperationsMock) GenerateRefreshToken(userID s
 		return (*mm_results).s1, (*mm_results).err
 	}
 	if mmGenerateRefreshToken.funcGenerateRefreshToken != nil {
-		return mmGenerateRefreshToken.funcGenerateRefreshToken(userID)
+		return mmGenerateRefreshToken.funcGenerateRefreshToken(userID, familyID, tokenID)
 	}
-	mmGenerateRefreshToken.t.Fatalf("Unexpected call to TokenOperationsMock.GenerateRefreshToken. %v", userID)
+	mmGenerateRefreshToken.t.Fatalf("Unexpected call to TokenOperationsMock.GenerateRefreshToken. %v %v %v", userID, familyID, tokenID)
 	return
 }
 
@@ -694,6 +1128,192 @@ func (m *TokenOperationsMock) MinimockGenerateRefreshTokenInspect() {
 	}
 }
 
+type mTokenOperationsMockJWKS struct {
+	optional           bool
+	mock               *TokenOperationsMock
+	defaultExpectation *TokenOperationsMockJWKSExpectation
+	expectations       []*TokenOperationsMockJWKSExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TokenOperationsMockJWKSExpectation specifies expectation struct of the TokenOperations.JWKS
+type TokenOperationsMockJWKSExpectation struct {
+	mock *TokenOperationsMock
+
+	results      *TokenOperationsMockJWKSResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// TokenOperationsMockJWKSResults contains results of the TokenOperations.JWKS
+type TokenOperationsMockJWKSResults struct {
+	jp1 *model.JWKSet
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmJWKS *mTokenOperationsMockJWKS) Optional() *mTokenOperationsMockJWKS {
+	mmJWKS.optional = true
+	return mmJWKS
+}
+
+// Expect sets up expected params for TokenOperations.JWKS
+func (mmJWKS *mTokenOperationsMockJWKS) Expect() *mTokenOperationsMockJWKS {
+	if mmJWKS.mock.funcJWKS != nil {
+		mmJWKS.mock.t.Fatalf("TokenOperationsMock.JWKS mock is already set by Set")
+	}
+
+	if mmJWKS.defaultExpectation == nil {
+		mmJWKS.defaultExpectation = &TokenOperationsMockJWKSExpectation{}
+	}
+
+	return mmJWKS
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenOperations.JWKS
+func (mmJWKS *mTokenOperationsMockJWKS) Inspect(f func()) *mTokenOperationsMockJWKS {
+	if mmJWKS.mock.inspectFuncJWKS != nil {
+		mmJWKS.mock.t.Fatalf("Inspect function is already set for TokenOperationsMock.JWKS")
+	}
+
+	mmJWKS.mock.inspectFuncJWKS = f
+
+	return mmJWKS
+}
+
+// Return sets up results that will be returned by TokenOperations.JWKS
+func (mmJWKS *mTokenOperationsMockJWKS) Return(jp1 *model.JWKSet) *TokenOperationsMock {
+	if mmJWKS.mock.funcJWKS != nil {
+		mmJWKS.mock.t.Fatalf("TokenOperationsMock.JWKS mock is already set by Set")
+	}
+
+	if mmJWKS.defaultExpectation == nil {
+		mmJWKS.defaultExpectation = &TokenOperationsMockJWKSExpectation{mock: mmJWKS.mock}
+	}
+	mmJWKS.defaultExpectation.results = &TokenOperationsMockJWKSResults{jp1}
+	mmJWKS.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmJWKS.mock
+}
+
+// Set uses given function f to mock the TokenOperations.JWKS method
+func (mmJWKS *mTokenOperationsMockJWKS) Set(f func() (jp1 *model.JWKSet)) *TokenOperationsMock {
+	if mmJWKS.defaultExpectation != nil {
+		mmJWKS.mock.t.Fatalf("Default expectation is already set for the TokenOperations.JWKS method")
+	}
+
+	if len(mmJWKS.expectations) > 0 {
+		mmJWKS.mock.t.Fatalf("Some expectations are already set for the TokenOperations.JWKS method")
+	}
+
+	mmJWKS.mock.funcJWKS = f
+	mmJWKS.mock.funcJWKSOrigin = minimock.CallerInfo(1)
+	return mmJWKS.mock
+}
+
+// Times sets number of times TokenOperations.JWKS should be invoked
+func (mmJWKS *mTokenOperationsMockJWKS) Times(n uint64) *mTokenOperationsMockJWKS {
+	if n == 0 {
+		mmJWKS.mock.t.Fatalf("Times of TokenOperationsMock.JWKS mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmJWKS.expectedInvocations, n)
+	mmJWKS.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmJWKS
+}
+
+func (mmJWKS *mTokenOperationsMockJWKS) invocationsDone() bool {
+	if len(mmJWKS.expectations) == 0 && mmJWKS.defaultExpectation == nil && mmJWKS.mock.funcJWKS == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmJWKS.mock.afterJWKSCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmJWKS.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// JWKS implements mm_tokens.TokenOperations
+func (mmJWKS *TokenOperationsMock) JWKS() (jp1 *model.JWKSet) {
+	mm_atomic.AddUint64(&mmJWKS.beforeJWKSCounter, 1)
+	defer mm_atomic.AddUint64(&mmJWKS.afterJWKSCounter, 1)
+
+	mmJWKS.t.Helper()
+
+	if mmJWKS.inspectFuncJWKS != nil {
+		mmJWKS.inspectFuncJWKS()
+	}
+
+	if mmJWKS.JWKSMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmJWKS.JWKSMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmJWKS.JWKSMock.defaultExpectation.results
+		if mm_results == nil {
+			mmJWKS.t.Fatal("No results are set for the TokenOperationsMock.JWKS")
+		}
+		return (*mm_results).jp1
+	}
+	if mmJWKS.funcJWKS != nil {
+		return mmJWKS.funcJWKS()
+	}
+	mmJWKS.t.Fatalf("Unexpected call to TokenOperationsMock.JWKS.")
+	return
+}
+
+// JWKSAfterCounter returns a count of finished TokenOperationsMock.JWKS invocations
+func (mmJWKS *TokenOperationsMock) JWKSAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmJWKS.afterJWKSCounter)
+}
+
+// JWKSBeforeCounter returns a count of TokenOperationsMock.JWKS invocations
+func (mmJWKS *TokenOperationsMock) JWKSBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmJWKS.beforeJWKSCounter)
+}
+
+// MinimockJWKSDone returns true if the count of the JWKS invocations corresponds
+// the number of defined expectations
+func (m *TokenOperationsMock) MinimockJWKSDone() bool {
+	if m.JWKSMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.JWKSMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.JWKSMock.invocationsDone()
+}
+
+// MinimockJWKSInspect logs each unmet expectation
+func (m *TokenOperationsMock) MinimockJWKSInspect() {
+	for _, e := range m.JWKSMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to TokenOperationsMock.JWKS")
+		}
+	}
+
+	afterJWKSCounter := mm_atomic.LoadUint64(&m.afterJWKSCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.JWKSMock.defaultExpectation != nil && afterJWKSCounter < 1 {
+		m.t.Errorf("Expected call to TokenOperationsMock.JWKS at\n%s", m.JWKSMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcJWKS != nil && afterJWKSCounter < 1 {
+		m.t.Errorf("Expected call to TokenOperationsMock.JWKS at\n%s", m.funcJWKSOrigin)
+	}
+
+	if !m.JWKSMock.invocationsDone() && afterJWKSCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenOperationsMock.JWKS at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.JWKSMock.expectedInvocations), m.JWKSMock.expectedInvocationsOrigin, afterJWKSCounter)
+	}
+}
+
 type mTokenOperationsMockVerifyAccessToken struct {
 	optional           bool
 	mock               *TokenOperationsMock
@@ -1006,6 +1626,318 @@ func (m *TokenOperationsMock) MinimockVerifyAccessTokenInspect() {
 	}
 }
 
+type mTokenOperationsMockVerifyMfaToken struct {
+	optional           bool
+	mock               *TokenOperationsMock
+	defaultExpectation *TokenOperationsMockVerifyMfaTokenExpectation
+	expectations       []*TokenOperationsMockVerifyMfaTokenExpectation
+
+	callArgs []*TokenOperationsMockVerifyMfaTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TokenOperationsMockVerifyMfaTokenExpectation specifies expectation struct of the TokenOperations.VerifyMfaToken
+type TokenOperationsMockVerifyMfaTokenExpectation struct {
+	mock               *TokenOperationsMock
+	params             *TokenOperationsMockVerifyMfaTokenParams
+	paramPtrs          *TokenOperationsMockVerifyMfaTokenParamPtrs
+	expectationOrigins TokenOperationsMockVerifyMfaTokenExpectationOrigins
+	results            *TokenOperationsMockVerifyMfaTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TokenOperationsMockVerifyMfaTokenParams contains parameters of the TokenOperations.VerifyMfaToken
+type TokenOperationsMockVerifyMfaTokenParams struct {
+	tokenStr string
+}
+
+// TokenOperationsMockVerifyMfaTokenParamPtrs contains pointers to parameters of the TokenOperations.VerifyMfaToken
+type TokenOperationsMockVerifyMfaTokenParamPtrs struct {
+	tokenStr *string
+}
+
+// TokenOperationsMockVerifyMfaTokenResults contains results of the TokenOperations.VerifyMfaToken
+type TokenOperationsMockVerifyMfaTokenResults struct {
+	mp1 *model.MfaClaims
+	err error
+}
+
+// TokenOperationsMockVerifyMfaTokenOrigins contains origins of expectations of the TokenOperations.VerifyMfaToken
+type TokenOperationsMockVerifyMfaTokenExpectationOrigins struct {
+	origin         string
+	originTokenStr string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmVerifyMfaToken *mTokenOperationsMockVerifyMfaToken) Optional() *mTokenOperationsMockVerifyMfaToken {
+	mmVerifyMfaToken.optional = true
+	return mmVerifyMfaToken
+}
+
+// Expect sets up expected params for TokenOperations.VerifyMfaToken
+func (mmVerifyMfaToken *mTokenOperationsMockVerifyMfaToken) Expect(tokenStr string) *mTokenOperationsMockVerifyMfaToken {
+	if mmVerifyMfaToken.mock.funcVerifyMfaToken != nil {
+		mmVerifyMfaToken.mock.t.Fatalf("TokenOperationsMock.VerifyMfaToken mock is already set by Set")
+	}
+
+	if mmVerifyMfaToken.defaultExpectation == nil {
+		mmVerifyMfaToken.defaultExpectation = &TokenOperationsMockVerifyMfaTokenExpectation{}
+	}
+
+	if mmVerifyMfaToken.defaultExpectation.paramPtrs != nil {
+		mmVerifyMfaToken.mock.t.Fatalf("TokenOperationsMock.VerifyMfaToken mock is already set by ExpectParams functions")
+	}
+
+	mmVerifyMfaToken.defaultExpectation.params = &TokenOperationsMockVerifyMfaTokenParams{tokenStr}
+	mmVerifyMfaToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmVerifyMfaToken.expectations {
+		if minimock.Equal(e.params, mmVerifyMfaToken.defaultExpectation.params) {
+			mmVerifyMfaToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmVerifyMfaToken.defaultExpectation.params)
+		}
+	}
+
+	return mmVerifyMfaToken
+}
+
+// ExpectTokenStrParam1 sets up expected param tokenStr for TokenOperations.VerifyMfaToken
+func (mmVerifyMfaToken *mTokenOperationsMockVerifyMfaToken) ExpectTokenStrParam1(tokenStr string) *mTokenOperationsMockVerifyMfaToken {
+	if mmVerifyMfaToken.mock.funcVerifyMfaToken != nil {
+		mmVerifyMfaToken.mock.t.Fatalf("TokenOperationsMock.VerifyMfaToken mock is already set by Set")
+	}
+
+	if mmVerifyMfaToken.defaultExpectation == nil {
+		mmVerifyMfaToken.defaultExpectation = &TokenOperationsMockVerifyMfaTokenExpectation{}
+	}
+
+	if mmVerifyMfaToken.defaultExpectation.params != nil {
+		mmVerifyMfaToken.mock.t.Fatalf("TokenOperationsMock.VerifyMfaToken mock is already set by Expect")
+	}
+
+	if mmVerifyMfaToken.defaultExpectation.paramPtrs == nil {
+		mmVerifyMfaToken.defaultExpectation.paramPtrs = &TokenOperationsMockVerifyMfaTokenParamPtrs{}
+	}
+	mmVerifyMfaToken.defaultExpectation.paramPtrs.tokenStr = &tokenStr
+	mmVerifyMfaToken.defaultExpectation.expectationOrigins.originTokenStr = minimock.CallerInfo(1)
+
+	return mmVerifyMfaToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenOperations.VerifyMfaToken
+func (mmVerifyMfaToken *mTokenOperationsMockVerifyMfaToken) Inspect(f func(tokenStr string)) *mTokenOperationsMockVerifyMfaToken {
+	if mmVerifyMfaToken.mock.inspectFuncVerifyMfaToken != nil {
+		mmVerifyMfaToken.mock.t.Fatalf("Inspect function is already set for TokenOperationsMock.VerifyMfaToken")
+	}
+
+	mmVerifyMfaToken.mock.inspectFuncVerifyMfaToken = f
+
+	return mmVerifyMfaToken
+}
+
+// Return sets up results that will be returned by TokenOperations.VerifyMfaToken
+func (mmVerifyMfaToken *mTokenOperationsMockVerifyMfaToken) Return(mp1 *model.MfaClaims, err error) *TokenOperationsMock {
+	if mmVerifyMfaToken.mock.funcVerifyMfaToken != nil {
+		mmVerifyMfaToken.mock.t.Fatalf("TokenOperationsMock.VerifyMfaToken mock is already set by Set")
+	}
+
+	if mmVerifyMfaToken.defaultExpectation == nil {
+		mmVerifyMfaToken.defaultExpectation = &TokenOperationsMockVerifyMfaTokenExpectation{mock: mmVerifyMfaToken.mock}
+	}
+	mmVerifyMfaToken.defaultExpectation.results = &TokenOperationsMockVerifyMfaTokenResults{mp1, err}
+	mmVerifyMfaToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmVerifyMfaToken.mock
+}
+
+// Set uses given function f to mock the TokenOperations.VerifyMfaToken method
+func (mmVerifyMfaToken *mTokenOperationsMockVerifyMfaToken) Set(f func(tokenStr string) (mp1 *model.MfaClaims, err error)) *TokenOperationsMock {
+	if mmVerifyMfaToken.defaultExpectation != nil {
+		mmVerifyMfaToken.mock.t.Fatalf("Default expectation is already set for the TokenOperations.VerifyMfaToken method")
+	}
+
+	if len(mmVerifyMfaToken.expectations) > 0 {
+		mmVerifyMfaToken.mock.t.Fatalf("Some expectations are already set for the TokenOperations.VerifyMfaToken method")
+	}
+
+	mmVerifyMfaToken.mock.funcVerifyMfaToken = f
+	mmVerifyMfaToken.mock.funcVerifyMfaTokenOrigin = minimock.CallerInfo(1)
+	return mmVerifyMfaToken.mock
+}
+
+// When sets expectation for the TokenOperations.VerifyMfaToken which will trigger the result defined by the following
+// Then helper
+func (mmVerifyMfaToken *mTokenOperationsMockVerifyMfaToken) When(tokenStr string) *TokenOperationsMockVerifyMfaTokenExpectation {
+	if mmVerifyMfaToken.mock.funcVerifyMfaToken != nil {
+		mmVerifyMfaToken.mock.t.Fatalf("TokenOperationsMock.VerifyMfaToken mock is already set by Set")
+	}
+
+	expectation := &TokenOperationsMockVerifyMfaTokenExpectation{
+		mock:               mmVerifyMfaToken.mock,
+		params:             &TokenOperationsMockVerifyMfaTokenParams{tokenStr},
+		expectationOrigins: TokenOperationsMockVerifyMfaTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmVerifyMfaToken.expectations = append(mmVerifyMfaToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TokenOperations.VerifyMfaToken return parameters for the expectation previously defined by the When method
+func (e *TokenOperationsMockVerifyMfaTokenExpectation) Then(mp1 *model.MfaClaims, err error) *TokenOperationsMock {
+	e.results = &TokenOperationsMockVerifyMfaTokenResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times TokenOperations.VerifyMfaToken should be invoked
+func (mmVerifyMfaToken *mTokenOperationsMockVerifyMfaToken) Times(n uint64) *mTokenOperationsMockVerifyMfaToken {
+	if n == 0 {
+		mmVerifyMfaToken.mock.t.Fatalf("Times of TokenOperationsMock.VerifyMfaToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmVerifyMfaToken.expectedInvocations, n)
+	mmVerifyMfaToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmVerifyMfaToken
+}
+
+func (mmVerifyMfaToken *mTokenOperationsMockVerifyMfaToken) invocationsDone() bool {
+	if len(mmVerifyMfaToken.expectations) == 0 && mmVerifyMfaToken.defaultExpectation == nil && mmVerifyMfaToken.mock.funcVerifyMfaToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmVerifyMfaToken.mock.afterVerifyMfaTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmVerifyMfaToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// VerifyMfaToken implements mm_tokens.TokenOperations
+func (mmVerifyMfaToken *TokenOperationsMock) VerifyMfaToken(tokenStr string) (mp1 *model.MfaClaims, err error) {
+	mm_atomic.AddUint64(&mmVerifyMfaToken.beforeVerifyMfaTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmVerifyMfaToken.afterVerifyMfaTokenCounter, 1)
+
+	mmVerifyMfaToken.t.Helper()
+
+	if mmVerifyMfaToken.inspectFuncVerifyMfaToken != nil {
+		mmVerifyMfaToken.inspectFuncVerifyMfaToken(tokenStr)
+	}
+
+	mm_params := TokenOperationsMockVerifyMfaTokenParams{tokenStr}
+
+	// Record call args
+	mmVerifyMfaToken.VerifyMfaTokenMock.mutex.Lock()
+	mmVerifyMfaToken.VerifyMfaTokenMock.callArgs = append(mmVerifyMfaToken.VerifyMfaTokenMock.callArgs, &mm_params)
+	mmVerifyMfaToken.VerifyMfaTokenMock.mutex.Unlock()
+
+	for _, e := range mmVerifyMfaToken.VerifyMfaTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmVerifyMfaToken.VerifyMfaTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmVerifyMfaToken.VerifyMfaTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmVerifyMfaToken.VerifyMfaTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmVerifyMfaToken.VerifyMfaTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := TokenOperationsMockVerifyMfaTokenParams{tokenStr}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.tokenStr != nil && !minimock.Equal(*mm_want_ptrs.tokenStr, mm_got.tokenStr) {
+				mmVerifyMfaToken.t.Errorf("TokenOperationsMock.VerifyMfaToken got unexpected parameter tokenStr, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVerifyMfaToken.VerifyMfaTokenMock.defaultExpectation.expectationOrigins.originTokenStr, *mm_want_ptrs.tokenStr, mm_got.tokenStr, minimock.Diff(*mm_want_ptrs.tokenStr, mm_got.tokenStr))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmVerifyMfaToken.t.Errorf("TokenOperationsMock.VerifyMfaToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmVerifyMfaToken.VerifyMfaTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmVerifyMfaToken.VerifyMfaTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmVerifyMfaToken.t.Fatal("No results are set for the TokenOperationsMock.VerifyMfaToken")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmVerifyMfaToken.funcVerifyMfaToken != nil {
+		return mmVerifyMfaToken.funcVerifyMfaToken(tokenStr)
+	}
+	mmVerifyMfaToken.t.Fatalf("Unexpected call to TokenOperationsMock.VerifyMfaToken. %v", tokenStr)
+	return
+}
+
+// VerifyMfaTokenAfterCounter returns a count of finished TokenOperationsMock.VerifyMfaToken invocations
+func (mmVerifyMfaToken *TokenOperationsMock) VerifyMfaTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerifyMfaToken.afterVerifyMfaTokenCounter)
+}
+
+// VerifyMfaTokenBeforeCounter returns a count of TokenOperationsMock.VerifyMfaToken invocations
+func (mmVerifyMfaToken *TokenOperationsMock) VerifyMfaTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerifyMfaToken.beforeVerifyMfaTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to TokenOperationsMock.VerifyMfaToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmVerifyMfaToken *mTokenOperationsMockVerifyMfaToken) Calls() []*TokenOperationsMockVerifyMfaTokenParams {
+	mmVerifyMfaToken.mutex.RLock()
+
+	argCopy := make([]*TokenOperationsMockVerifyMfaTokenParams, len(mmVerifyMfaToken.callArgs))
+	copy(argCopy, mmVerifyMfaToken.callArgs)
+
+	mmVerifyMfaToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockVerifyMfaTokenDone returns true if the count of the VerifyMfaToken invocations corresponds
+// the number of defined expectations
+func (m *TokenOperationsMock) MinimockVerifyMfaTokenDone() bool {
+	if m.VerifyMfaTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.VerifyMfaTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.VerifyMfaTokenMock.invocationsDone()
+}
+
+// MinimockVerifyMfaTokenInspect logs each unmet expectation
+func (m *TokenOperationsMock) MinimockVerifyMfaTokenInspect() {
+	for _, e := range m.VerifyMfaTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TokenOperationsMock.VerifyMfaToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterVerifyMfaTokenCounter := mm_atomic.LoadUint64(&m.afterVerifyMfaTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.VerifyMfaTokenMock.defaultExpectation != nil && afterVerifyMfaTokenCounter < 1 {
+		if m.VerifyMfaTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TokenOperationsMock.VerifyMfaToken at\n%s", m.VerifyMfaTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TokenOperationsMock.VerifyMfaToken at\n%s with params: %#v", m.VerifyMfaTokenMock.defaultExpectation.expectationOrigins.origin, *m.VerifyMfaTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcVerifyMfaToken != nil && afterVerifyMfaTokenCounter < 1 {
+		m.t.Errorf("Expected call to TokenOperationsMock.VerifyMfaToken at\n%s", m.funcVerifyMfaTokenOrigin)
+	}
+
+	if !m.VerifyMfaTokenMock.invocationsDone() && afterVerifyMfaTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenOperationsMock.VerifyMfaToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.VerifyMfaTokenMock.expectedInvocations), m.VerifyMfaTokenMock.expectedInvocationsOrigin, afterVerifyMfaTokenCounter)
+	}
+}
+
 type mTokenOperationsMockVerifyRefreshToken struct {
 	optional           bool
 	mock               *TokenOperationsMock
@@ -1324,10 +2256,16 @@ func (m *TokenOperationsMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockGenerateAccessTokenInspect()
 
+			m.MinimockGenerateMfaTokenInspect()
+
 			m.MinimockGenerateRefreshTokenInspect()
 
+			m.MinimockJWKSInspect()
+
 			m.MinimockVerifyAccessTokenInspect()
 
+			m.MinimockVerifyMfaTokenInspect()
+
 			m.MinimockVerifyRefreshTokenInspect()
 		}
 	})
@@ -1353,7 +2291,10 @@ func (m *TokenOperationsMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockGenerateAccessTokenDone() &&
+		m.MinimockGenerateMfaTokenDone() &&
 		m.MinimockGenerateRefreshTokenDone() &&
+		m.MinimockJWKSDone() &&
 		m.MinimockVerifyAccessTokenDone() &&
+		m.MinimockVerifyMfaTokenDone() &&
 		m.MinimockVerifyRefreshTokenDone()
 }
@@ -0,0 +1,29 @@
+package tokens
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+const opaqueTokenBytes = 32
+
+// GenerateOpaqueToken returns a random single-use token to hand to the user and its hash to store.
+func GenerateOpaqueToken() (string, string, error) {
+	raw := make([]byte, opaqueTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", err
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	return token, HashOpaqueToken(token), nil
+}
+
+// HashOpaqueToken hashes an opaque token. Tokens carry 256 random bits, so a fast unsalted hash is enough.
+func HashOpaqueToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
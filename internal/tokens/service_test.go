@@ -0,0 +1,47 @@
+package tokens
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceKeys(t *testing.T) {
+	t.Parallel()
+
+	key, hash, err := GenerateOpaqueToken()
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "service-keys.json")
+	require.NoError(t, os.WriteFile(path,
+		[]byte(`{"services": [{"name": "gateway", "key_hash": "`+hash+`"}]}`), 0o600))
+
+	keys, err := LoadServiceKeys(path)
+	require.NoError(t, err)
+
+	name, ok := keys.Authenticate(key)
+	require.True(t, ok)
+	require.Equal(t, "gateway", name)
+
+	_, ok = keys.Authenticate(hash)
+	require.False(t, ok)
+	_, ok = keys.Authenticate("")
+	require.False(t, ok)
+
+	keys, err = LoadServiceKeys("")
+	require.NoError(t, err)
+	_, ok = keys.Authenticate(key)
+	require.False(t, ok)
+
+	_, err = NewServiceKeys(map[string]string{"gateway": "not a hash"})
+	require.ErrorContains(t, err, "key hash must be a hex encoded SHA-256 hash")
+	_, err = NewServiceKeys(map[string]string{"gateway": hash, "proxy": hash})
+	require.ErrorContains(t, err, "have the same key")
+
+	ctx := WithService(context.Background(), "gateway")
+	require.Equal(t, "gateway", Service(ctx))
+	require.Empty(t, Service(context.Background()))
+}
@@ -4,12 +4,18 @@ import "github.com/8thgencore/microservice-auth/internal/model"
 
 // TokenOperations is the interface for token functions.
 type TokenOperations interface {
-	// GenerateAccessToken creates JWT access token for the user.
-	GenerateAccessToken(user model.User) (string, error)
-	// GenerateRefreshToken creates JWT refresh token with minimal claims (e.g., only username).
-	GenerateRefreshToken(userID string) (string, error)
+	// GenerateAccessToken creates JWT access token for the user within the session.
+	GenerateAccessToken(user model.User, sessionID string) (string, error)
+	// GenerateRefreshToken creates JWT refresh token with minimal claims: the user, the token family and the token ID.
+	GenerateRefreshToken(userID, familyID, tokenID string) (string, error)
+	// GenerateMfaToken creates the short-lived JWT challenge token of a login awaiting the second factor.
+	GenerateMfaToken(userID string) (string, error)
 	// VerifyAccessToken checks the validity of an access token.
 	VerifyAccessToken(tokenStr string) (*model.UserClaims, error)
 	// VerifyRefreshToken checks the validity of a refresh token.
 	VerifyRefreshToken(tokenStr string) (*model.RefreshClaims, error)
+	// VerifyMfaToken checks the validity of an MFA challenge token.
+	VerifyMfaToken(tokenStr string) (*model.MfaClaims, error)
+	// JWKS returns the public keys that can be used to verify issued tokens.
+	JWKS() *model.JWKSet
 }
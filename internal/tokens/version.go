@@ -0,0 +1,53 @@
+package tokens
+
+import (
+	"context"
+	"errors"
+
+	"github.com/8thgencore/microservice-auth/internal/repository"
+)
+
+// CurrentVersion returns the current token version of the user, the tokens of older versions are revoked.
+// The cache is consulted first, on a miss the version is read from the database and cached again.
+func CurrentVersion(
+	ctx context.Context,
+	tokenRepository repository.TokenRepository,
+	userRepository repository.UserRepository,
+	userID string,
+) (int, error) {
+	version, err := tokenRepository.GetTokenVersion(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	if version != 0 {
+		return version, nil
+	}
+
+	version, err = userRepository.GetVersion(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tokenRepository.SetTokenVersion(ctx, userID, version); err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+// StoreVersion caches the new token version of the user, which revokes the tokens of older versions as
+// soon as it can be read. The cached version is trusted by CurrentVersion, so when the new one cannot be
+// cached the old one is removed and the next read falls back to the database. An error means the old version
+// may still be read.
+func StoreVersion(ctx context.Context, tokenRepository repository.TokenRepository, userID string, version int) error {
+	err := tokenRepository.SetTokenVersion(ctx, userID, version)
+	if err == nil {
+		return nil
+	}
+
+	if errDel := tokenRepository.DeleteTokenVersion(ctx, userID); errDel != nil {
+		return errors.Join(err, errDel)
+	}
+
+	return nil
+}
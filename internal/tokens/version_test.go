@@ -0,0 +1,100 @@
+package tokens
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	repositoryMocks "github.com/8thgencore/microservice-auth/internal/repository/mocks"
+)
+
+func TestCurrentVersion(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		userID = "user-id"
+	)
+
+	t.Run("cached case", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		tokenRepositoryMock := repositoryMocks.NewTokenRepositoryMock(mc)
+		tokenRepositoryMock.GetTokenVersionMock.Expect(ctx, userID).Return(3, nil)
+
+		version, err := CurrentVersion(ctx, tokenRepositoryMock, repositoryMocks.NewUserRepositoryMock(mc), userID)
+		require.NoError(t, err)
+		require.Equal(t, 3, version)
+	})
+
+	t.Run("cache miss case", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		tokenRepositoryMock := repositoryMocks.NewTokenRepositoryMock(mc)
+		tokenRepositoryMock.GetTokenVersionMock.Expect(ctx, userID).Return(0, nil)
+		tokenRepositoryMock.SetTokenVersionMock.Expect(ctx, userID, 2).Return(nil)
+		userRepositoryMock := repositoryMocks.NewUserRepositoryMock(mc)
+		userRepositoryMock.GetVersionMock.Expect(ctx, userID).Return(2, nil)
+
+		version, err := CurrentVersion(ctx, tokenRepositoryMock, userRepositoryMock, userID)
+		require.NoError(t, err)
+		require.Equal(t, 2, version)
+	})
+
+	t.Run("cache error case", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		tokenRepositoryMock := repositoryMocks.NewTokenRepositoryMock(mc)
+		tokenRepositoryMock.GetTokenVersionMock.Expect(ctx, userID).Return(0, errors.New("cache error"))
+
+		_, err := CurrentVersion(ctx, tokenRepositoryMock, repositoryMocks.NewUserRepositoryMock(mc), userID)
+		require.Error(t, err)
+	})
+}
+
+func TestStoreVersion(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = context.Background()
+		userID   = "user-id"
+		cacheErr = errors.New("cache error")
+	)
+
+	tests := []struct {
+		name      string
+		setErr    error
+		deleteErr error
+		err       bool
+	}{
+		{name: "cached case"},
+		{name: "stale version removed case", setErr: cacheErr},
+		{name: "stale version kept case", setErr: cacheErr, deleteErr: cacheErr, err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			tokenRepositoryMock := repositoryMocks.NewTokenRepositoryMock(mc)
+			tokenRepositoryMock.SetTokenVersionMock.Expect(ctx, userID, 2).Return(tt.setErr)
+			if tt.setErr != nil {
+				tokenRepositoryMock.DeleteTokenVersionMock.Expect(ctx, userID).Return(tt.deleteErr)
+			}
+
+			err := StoreVersion(ctx, tokenRepositoryMock, userID, 2)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    refresh_token_families (
+        id uuid primary key,
+        user_id uuid not null references users (id) on delete cascade,
+        current_token_id uuid not null,
+        revoked_at timestamp,
+        created_at timestamp not null default now (),
+        updated_at timestamp
+    );
+
+CREATE INDEX refresh_token_families_user_id_idx ON refresh_token_families (user_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS refresh_token_families;
+
+-- +goose StatementEnd
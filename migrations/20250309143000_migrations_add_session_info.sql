@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE refresh_token_families
+ADD COLUMN ip_address text NOT NULL DEFAULT '',
+ADD COLUMN user_agent text NOT NULL DEFAULT '',
+ADD COLUMN last_refreshed_at timestamp;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE refresh_token_families
+DROP COLUMN ip_address,
+DROP COLUMN user_agent,
+DROP COLUMN last_refreshed_at;
+
+-- +goose StatementEnd
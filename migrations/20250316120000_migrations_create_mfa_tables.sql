@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    user_totp (
+        user_id uuid primary key references users (id) on delete cascade,
+        secret text not null,
+        confirmed_at timestamp,
+        last_used_step bigint not null default 0,
+        created_at timestamp not null default now (),
+        updated_at timestamp
+    );
+
+CREATE TABLE
+    user_recovery_codes (
+        id uuid primary key default gen_random_uuid (),
+        user_id uuid not null references users (id) on delete cascade,
+        code_hash text not null,
+        used_at timestamp,
+        created_at timestamp not null default now (),
+        unique (user_id, code_hash)
+    );
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_recovery_codes;
+
+DROP TABLE IF EXISTS user_totp;
+
+-- +goose StatementEnd
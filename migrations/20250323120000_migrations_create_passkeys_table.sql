@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    passkeys (
+        id bytea primary key,
+        user_id uuid not null references users (id) on delete cascade,
+        public_key bytea not null,
+        attestation_type text not null,
+        transports text[] not null default '{}',
+        aaguid bytea,
+        sign_count bigint not null default 0,
+        backup_eligible boolean not null default false,
+        backup_state boolean not null default false,
+        created_at timestamp not null default now (),
+        last_used_at timestamp
+    );
+
+CREATE INDEX passkeys_user_id_idx ON passkeys (user_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS passkeys;
+
+-- +goose StatementEnd
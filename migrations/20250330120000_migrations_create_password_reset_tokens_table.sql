@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    password_reset_tokens (
+        id uuid primary key default gen_random_uuid (),
+        user_id uuid not null references users (id) on delete cascade,
+        token_hash text not null unique,
+        expires_at timestamp not null,
+        used_at timestamp,
+        created_at timestamp not null default now ()
+    );
+
+CREATE INDEX password_reset_tokens_user_id_idx ON password_reset_tokens (user_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS password_reset_tokens;
+
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    notification_outbox (
+        id uuid primary key default gen_random_uuid (),
+        recipient text not null,
+        subject text not null,
+        body text not null,
+        attempts int not null default 0,
+        next_attempt_at timestamp not null default now (),
+        last_error text,
+        sent_at timestamp,
+        created_at timestamp not null default now ()
+    );
+
+CREATE INDEX notification_outbox_pending_idx ON notification_outbox (next_attempt_at)
+WHERE
+    sent_at IS NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS notification_outbox;
+
+-- +goose StatementEnd
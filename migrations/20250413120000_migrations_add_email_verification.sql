@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+ADD COLUMN email_verified boolean not null default false,
+ADD COLUMN pending_email text;
+
+-- Users registered before verification existed keep signing in.
+UPDATE users SET email_verified = true;
+
+CREATE TABLE
+    email_verification_tokens (
+        id uuid primary key default gen_random_uuid (),
+        user_id uuid not null references users (id) on delete cascade,
+        email text not null,
+        token_hash text not null unique,
+        expires_at timestamp not null,
+        used_at timestamp,
+        created_at timestamp not null default now ()
+    );
+
+CREATE INDEX email_verification_tokens_user_id_idx ON email_verification_tokens (user_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS email_verification_tokens;
+
+ALTER TABLE users
+DROP COLUMN IF EXISTS email_verified,
+DROP COLUMN IF EXISTS pending_email;
+
+-- +goose StatementEnd
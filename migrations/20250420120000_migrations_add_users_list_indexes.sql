@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX users_created_at_id_idx ON users (created_at, id);
+
+CREATE INDEX users_lower_name_idx ON users (LOWER(name) text_pattern_ops);
+
+CREATE INDEX users_lower_email_idx ON users (LOWER(email) text_pattern_ops);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS users_lower_email_idx;
+
+DROP INDEX IF EXISTS users_lower_name_idx;
+
+DROP INDEX IF EXISTS users_created_at_id_idx;
+
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE user_status AS ENUM ('ACTIVE', 'SUSPENDED', 'DEACTIVATED', 'DELETED');
+
+ALTER TABLE users
+ADD COLUMN status user_status not null default 'ACTIVE',
+ADD COLUMN deleted_at timestamp,
+ADD COLUMN suspended_until timestamp;
+
+CREATE INDEX users_deleted_at_idx ON users (deleted_at)
+WHERE
+    deleted_at IS NOT NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS users_deleted_at_idx;
+
+ALTER TABLE users
+DROP COLUMN IF EXISTS status,
+DROP COLUMN IF EXISTS deleted_at,
+DROP COLUMN IF EXISTS suspended_until;
+
+DROP TYPE IF EXISTS user_status;
+
+-- +goose StatementEnd
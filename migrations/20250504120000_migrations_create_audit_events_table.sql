@@ -0,0 +1,62 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    audit_events (
+        id uuid primary key,
+        occurred_at timestamp not null default now (),
+        actor_id text,
+        action text not null,
+        target_type text not null,
+        target_id text not null,
+        changes jsonb not null default '{}',
+        metadata jsonb not null default '{}',
+        ip_address text,
+        user_agent text,
+        trace_id text
+    );
+
+CREATE INDEX audit_events_occurred_at_id_idx ON audit_events (occurred_at DESC, id DESC);
+
+CREATE INDEX audit_events_actor_idx ON audit_events (actor_id, occurred_at DESC, id DESC);
+
+CREATE INDEX audit_events_target_idx ON audit_events (target_type, target_id, occurred_at DESC, id DESC);
+
+CREATE INDEX audit_events_action_idx ON audit_events (action, occurred_at DESC, id DESC);
+
+-- The free-text entries are kept as legacy events of the user whose ID they mention.
+INSERT INTO
+    audit_events (id, occurred_at, action, target_type, target_id, metadata)
+SELECT
+    id,
+    timestamp,
+    'legacy',
+    'user',
+    COALESCE(substring(log FROM 'id: ([0-9a-f-]{36})'), ''),
+    jsonb_build_object('log', log)
+FROM
+    transaction_log;
+
+DROP TABLE IF EXISTS transaction_log;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+CREATE TABLE
+    transaction_log (
+        id uuid primary key,
+        timestamp timestamp not null default now (),
+        log text not null
+    );
+
+INSERT INTO
+    transaction_log (id, timestamp, log)
+SELECT
+    id,
+    occurred_at,
+    COALESCE(metadata ->> 'log', action || ' of ' || target_type || ' with id: ' || target_id)
+FROM
+    audit_events;
+
+DROP TABLE IF EXISTS audit_events;
+
+-- +goose StatementEnd
@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE audit_events
+ADD COLUMN seq bigint,
+ADD COLUMN prev_hash text not null default '',
+ADD COLUMN hash text not null default '';
+
+-- The events recorded so far precede the chain and are left without a hash.
+UPDATE audit_events
+SET
+    seq = ordered.seq
+FROM
+    (
+        SELECT
+            id,
+            row_number() OVER (ORDER BY occurred_at, id) AS seq
+        FROM
+            audit_events
+    ) AS ordered
+WHERE
+    audit_events.id = ordered.id;
+
+CREATE UNIQUE INDEX audit_events_seq_idx ON audit_events (seq);
+
+-- The events are recorded without a sequence number and linked to the chain afterwards, in this order.
+CREATE INDEX audit_events_unsealed_idx ON audit_events (occurred_at, id)
+WHERE
+    seq IS NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS audit_events_unsealed_idx;
+
+DROP INDEX IF EXISTS audit_events_seq_idx;
+
+ALTER TABLE audit_events
+DROP COLUMN IF EXISTS seq,
+DROP COLUMN IF EXISTS prev_hash,
+DROP COLUMN IF EXISTS hash;
+
+-- +goose StatementEnd
@@ -0,0 +1,113 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    roles (
+        name text primary key,
+        description text not null default '',
+        created_at timestamp not null default now (),
+        updated_at timestamp
+    );
+
+-- A role inherits everything allowed to its parent roles.
+CREATE TABLE
+    role_parents (
+        role text not null references roles (name) on delete cascade,
+        parent text not null references roles (name) on delete cascade,
+        primary key (role, parent),
+        check (role <> parent)
+    );
+
+CREATE INDEX role_parents_parent_idx ON role_parents (parent);
+
+INSERT INTO
+    roles (name, description)
+VALUES
+    ('USER', 'Regular user'),
+    ('ADMIN', 'Administrator');
+
+INSERT INTO
+    role_parents (role, parent)
+VALUES
+    ('ADMIN', 'USER');
+
+CREATE TABLE
+    user_roles (
+        user_id uuid not null references users (id) on delete cascade,
+        role text not null references roles (name) on delete cascade,
+        primary key (user_id, role)
+    );
+
+CREATE INDEX user_roles_role_idx ON user_roles (role, user_id);
+
+INSERT INTO
+    user_roles (user_id, role)
+SELECT
+    id,
+    role::text
+FROM
+    users
+WHERE
+    role <> 'UNKNOWN';
+
+ALTER TABLE users
+DROP COLUMN role;
+
+ALTER TABLE policies
+ALTER COLUMN allowed_roles TYPE text[] USING allowed_roles::text[];
+
+DROP TYPE IF EXISTS role;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+CREATE TYPE role AS ENUM ('UNKNOWN', 'USER', 'ADMIN');
+
+-- Roles that are not part of the enum are lost.
+ALTER TABLE policies
+ALTER COLUMN allowed_roles TYPE role[] USING ARRAY (
+    SELECT
+        allowed_role::role
+    FROM
+        unnest (allowed_roles) AS allowed_role
+    WHERE
+        allowed_role IN ('UNKNOWN', 'USER', 'ADMIN')
+);
+
+ALTER TABLE users
+ADD COLUMN role role not null default 'UNKNOWN';
+
+UPDATE users
+SET
+    role = CASE
+        WHEN EXISTS (
+            SELECT
+                1
+            FROM
+                user_roles
+            WHERE
+                user_roles.user_id = users.id
+                AND user_roles.role = 'ADMIN'
+        ) THEN 'ADMIN'::role
+        WHEN EXISTS (
+            SELECT
+                1
+            FROM
+                user_roles
+            WHERE
+                user_roles.user_id = users.id
+                AND user_roles.role = 'USER'
+        ) THEN 'USER'::role
+        ELSE 'UNKNOWN'::role
+    END;
+
+ALTER TABLE users
+ALTER COLUMN role
+DROP DEFAULT;
+
+DROP TABLE IF EXISTS user_roles;
+
+DROP TABLE IF EXISTS role_parents;
+
+DROP TABLE IF EXISTS roles;
+
+-- +goose StatementEnd
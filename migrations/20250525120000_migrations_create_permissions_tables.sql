@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    permissions (
+        name text primary key,
+        description text not null default '',
+        created_at timestamp not null default now (),
+        updated_at timestamp
+    );
+
+-- A permission grants access to every endpoint it is mapped to.
+CREATE TABLE
+    permission_endpoints (
+        permission text not null references permissions (name) on delete cascade,
+        endpoint text not null,
+        primary key (permission, endpoint)
+    );
+
+CREATE INDEX permission_endpoints_endpoint_idx ON permission_endpoints (endpoint);
+
+-- The roles a permission is granted to, the roles inheriting them hold it too.
+CREATE TABLE
+    role_permissions (
+        role text not null references roles (name) on delete cascade,
+        permission text not null references permissions (name) on delete cascade,
+        primary key (role, permission)
+    );
+
+CREATE INDEX role_permissions_permission_idx ON role_permissions (permission);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS role_permissions;
+
+DROP TABLE IF EXISTS permission_endpoints;
+
+DROP TABLE IF EXISTS permissions;
+
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A deny rule overrides the policies allowing access to the endpoints it matches.
+-- A rule without roles and users denies everyone but the callers with an excepted role.
+CREATE TABLE
+    deny_rules (
+        name text primary key,
+        endpoint text not null,
+        roles text[] not null default '{}',
+        user_ids text[] not null default '{}',
+        except_roles text[] not null default '{}',
+        reason text not null default '',
+        created_at timestamp not null default now ()
+    );
+
+INSERT INTO
+    policies (id, endpoint, allowed_roles)
+VALUES
+    (gen_random_uuid (), '/access_v1.AccessV1/AddDenyRule', ARRAY['ADMIN']),
+    (gen_random_uuid (), '/access_v1.AccessV1/DeleteDenyRule', ARRAY['ADMIN']),
+    (gen_random_uuid (), '/access_v1.AccessV1/GetDenyRules', ARRAY['ADMIN'])
+ON CONFLICT (endpoint) DO NOTHING;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM policies
+WHERE
+    endpoint IN (
+        '/access_v1.AccessV1/AddDenyRule',
+        '/access_v1.AccessV1/DeleteDenyRule',
+        '/access_v1.AccessV1/GetDenyRules'
+    );
+
+DROP TABLE IF EXISTS deny_rules;
+
+-- +goose StatementEnd
@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Every write or delete of relation tuples takes the next revision, which consistency tokens carry.
+CREATE SEQUENCE relation_tuples_revision_seq;
+
+-- A relation tuple object_namespace:object_id#relation@subject_namespace:subject_id#subject_relation
+-- relates a subject to an object, the subject relation is empty for a subject which is not a userset.
+CREATE TABLE
+    relation_tuples (
+        object_namespace text not null,
+        object_id text not null,
+        relation text not null,
+        subject_namespace text not null,
+        subject_id text not null,
+        subject_relation text not null default '',
+        revision bigint not null,
+        primary key (
+            object_namespace,
+            object_id,
+            relation,
+            subject_namespace,
+            subject_id,
+            subject_relation
+        )
+    );
+
+INSERT INTO
+    policies (id, endpoint, allowed_roles)
+VALUES
+    (gen_random_uuid (), '/access_v1.AccessV1/WriteTuples', ARRAY['ADMIN']),
+    (gen_random_uuid (), '/access_v1.AccessV1/DeleteTuples', ARRAY['ADMIN']),
+    (gen_random_uuid (), '/access_v1.AccessV1/CheckRelation', ARRAY['ADMIN']),
+    (gen_random_uuid (), '/access_v1.AccessV1/ListObjects', ARRAY['ADMIN'])
+ON CONFLICT (endpoint) DO NOTHING;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM policies
+WHERE
+    endpoint IN (
+        '/access_v1.AccessV1/WriteTuples',
+        '/access_v1.AccessV1/DeleteTuples',
+        '/access_v1.AccessV1/CheckRelation',
+        '/access_v1.AccessV1/ListObjects'
+    );
+
+DROP TABLE IF EXISTS relation_tuples;
+
+DROP SEQUENCE IF EXISTS relation_tuples_revision_seq;
+
+-- +goose StatementEnd
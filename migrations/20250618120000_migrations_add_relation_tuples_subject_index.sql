@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Listing the objects of a subject reads the tuples of the subject, of its usersets and of the objects
+-- related to it, walking the relation graph backwards.
+CREATE INDEX relation_tuples_subject_idx ON relation_tuples (subject_namespace, subject_id, subject_relation);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS relation_tuples_subject_idx;
+
+-- +goose StatementEnd
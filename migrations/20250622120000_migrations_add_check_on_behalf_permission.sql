@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The permission to check the access of other users with BatchCheck.
+INSERT INTO
+    permissions (name, description)
+VALUES
+    ('access:check_on_behalf', 'Check the access of other users')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO
+    role_permissions (role, permission)
+VALUES
+    ('ADMIN', 'access:check_on_behalf')
+ON CONFLICT DO NOTHING;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM permissions
+WHERE
+    name = 'access:check_on_behalf';
+
+-- +goose StatementEnd
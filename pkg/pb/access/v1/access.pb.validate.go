@@ -39,6 +39,9 @@ var (
 	_ = user_v1.Role(0)
 )
 
+// define the regex for a UUID once up-front
+var _access_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
 // Validate checks the field values on CheckRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -61,21 +64,51 @@ func (m *CheckRequest) validate(all bool) error {
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetEndpoint()); l < 1 || l > 255 {
-		err := CheckRequestValidationError{
-			field:  "Endpoint",
-			reason: "value length must be between 1 and 255 runes, inclusive",
+	if m.GetEndpoint() != "" {
+
+		if l := utf8.RuneCountInString(m.GetEndpoint()); l < 1 || l > 255 {
+			err := CheckRequestValidationError{
+				field:  "Endpoint",
+				reason: "value length must be between 1 and 255 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		if !all {
-			return err
+
+		if !_CheckRequest_Endpoint_Pattern.MatchString(m.GetEndpoint()) {
+			err := CheckRequestValidationError{
+				field:  "Endpoint",
+				reason: "value does not match regex pattern \"^[a-zA-Z0-9_/.-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
+	}
+
+	if m.GetPermission() != "" {
+
+		if !_CheckRequest_Permission_Pattern.MatchString(m.GetPermission()) {
+			err := CheckRequestValidationError{
+				field:  "Permission",
+				reason: "value does not match regex pattern \"^[A-Za-z0-9_.:-]{1,64}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
 	}
 
-	if !_CheckRequest_Endpoint_Pattern.MatchString(m.GetEndpoint()) {
+	if len(m.GetAttributes()) > 32 {
 		err := CheckRequestValidationError{
-			field:  "Endpoint",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9_/.-]+$\"",
+			field:  "Attributes",
+			reason: "value must contain no more than 32 pair(s)",
 		}
 		if !all {
 			return err
@@ -83,6 +116,112 @@ func (m *CheckRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	{
+		sorted_keys := make([]string, len(m.GetAttributes()))
+		i := 0
+		for key := range m.GetAttributes() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAttributes()[key]
+			_ = val
+
+			if !_CheckRequest_Attributes_Pattern.MatchString(key) {
+				err := CheckRequestValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", key),
+					reason: "value does not match regex pattern \"^[A-Za-z0-9_]{1,64}$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if utf8.RuneCountInString(val) > 1024 {
+				err := CheckRequestValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", key),
+					reason: "value length must be at most 1024 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	switch v := m.Principal.(type) {
+	case *CheckRequest_AccessToken:
+		if v == nil {
+			err := CheckRequestValidationError{
+				field:  "Principal",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if l := utf8.RuneCountInString(m.GetAccessToken()); l < 1 || l > 4096 {
+			err := CheckRequestValidationError{
+				field:  "AccessToken",
+				reason: "value length must be between 1 and 4096 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *CheckRequest_Subject:
+		if v == nil {
+			err := CheckRequestValidationError{
+				field:  "Principal",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSubject()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckRequestValidationError{
+						field:  "Subject",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckRequestValidationError{
+						field:  "Subject",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckRequestValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
 	if len(errors) > 0 {
 		return CheckRequestMultiError(errors)
 	}
@@ -162,22 +301,189 @@ var _ interface {
 
 var _CheckRequest_Endpoint_Pattern = regexp.MustCompile("^[a-zA-Z0-9_/.-]+$")
 
-// Validate checks the field values on AddRoleEndpointRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddRoleEndpointRequest) Validate() error {
+var _CheckRequest_Permission_Pattern = regexp.MustCompile("^[A-Za-z0-9_.:-]{1,64}$")
+
+var _CheckRequest_Attributes_Pattern = regexp.MustCompile("^[A-Za-z0-9_]{1,64}$")
+
+// Validate checks the field values on CheckSubject with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CheckSubject) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddRoleEndpointRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AddRoleEndpointRequestMultiError, or nil if none found.
-func (m *AddRoleEndpointRequest) ValidateAll() error {
+// ValidateAll checks the field values on CheckSubject with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CheckSubjectMultiError, or
+// nil if none found.
+func (m *CheckSubject) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddRoleEndpointRequest) validate(all bool) error {
+func (m *CheckSubject) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = CheckSubjectValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRoles()) > 32 {
+		err := CheckSubjectValidationError{
+			field:  "Roles",
+			reason: "value must contain no more than 32 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_CheckSubject_Roles_Unique := make(map[string]struct{}, len(m.GetRoles()))
+
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
+
+		if _, exists := _CheckSubject_Roles_Unique[item]; exists {
+			err := CheckSubjectValidationError{
+				field:  fmt.Sprintf("Roles[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CheckSubject_Roles_Unique[item] = struct{}{}
+		}
+
+		if !_CheckSubject_Roles_Pattern.MatchString(item) {
+			err := CheckSubjectValidationError{
+				field:  fmt.Sprintf("Roles[%v]", idx),
+				reason: "value does not match regex pattern \"^[A-Za-z0-9_.:-]{1,64}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CheckSubjectMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CheckSubject) _validateUuid(uuid string) error {
+	if matched := _access_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CheckSubjectMultiError is an error wrapping multiple validation errors
+// returned by CheckSubject.ValidateAll() if the designated constraints aren't met.
+type CheckSubjectMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckSubjectMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckSubjectMultiError) AllErrors() []error { return m }
+
+// CheckSubjectValidationError is the validation error returned by
+// CheckSubject.Validate if the designated constraints aren't met.
+type CheckSubjectValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckSubjectValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckSubjectValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckSubjectValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckSubjectValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckSubjectValidationError) ErrorName() string { return "CheckSubjectValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CheckSubjectValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckSubject.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckSubjectValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckSubjectValidationError{}
+
+var _CheckSubject_Roles_Pattern = regexp.MustCompile("^[A-Za-z0-9_.:-]{1,64}$")
+
+// Validate checks the field values on AccessCheck with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AccessCheck) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessCheck with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AccessCheckMultiError, or
+// nil if none found.
+func (m *AccessCheck) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessCheck) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -185,7 +491,7 @@ func (m *AddRoleEndpointRequest) validate(all bool) error {
 	var errors []error
 
 	if l := utf8.RuneCountInString(m.GetEndpoint()); l < 1 || l > 255 {
-		err := AddRoleEndpointRequestValidationError{
+		err := AccessCheckValidationError{
 			field:  "Endpoint",
 			reason: "value length must be between 1 and 255 runes, inclusive",
 		}
@@ -195,8 +501,8 @@ func (m *AddRoleEndpointRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_AddRoleEndpointRequest_Endpoint_Pattern.MatchString(m.GetEndpoint()) {
-		err := AddRoleEndpointRequestValidationError{
+	if !_AccessCheck_Endpoint_Pattern.MatchString(m.GetEndpoint()) {
+		err := AccessCheckValidationError{
 			field:  "Endpoint",
 			reason: "value does not match regex pattern \"^[a-zA-Z0-9_/.-]+$\"",
 		}
@@ -206,10 +512,10 @@ func (m *AddRoleEndpointRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(m.GetAllowedRoles()) < 1 {
-		err := AddRoleEndpointRequestValidationError{
-			field:  "AllowedRoles",
-			reason: "value must contain at least 1 item(s)",
+	if len(m.GetAttributes()) > 32 {
+		err := AccessCheckValidationError{
+			field:  "Attributes",
+			reason: "value must contain no more than 32 pair(s)",
 		}
 		if !all {
 			return err
@@ -217,20 +523,56 @@ func (m *AddRoleEndpointRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	{
+		sorted_keys := make([]string, len(m.GetAttributes()))
+		i := 0
+		for key := range m.GetAttributes() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAttributes()[key]
+			_ = val
+
+			if !_AccessCheck_Attributes_Pattern.MatchString(key) {
+				err := AccessCheckValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", key),
+					reason: "value does not match regex pattern \"^[A-Za-z0-9_]{1,64}$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if utf8.RuneCountInString(val) > 1024 {
+				err := AccessCheckValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", key),
+					reason: "value length must be at most 1024 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
 	if len(errors) > 0 {
-		return AddRoleEndpointRequestMultiError(errors)
+		return AccessCheckMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddRoleEndpointRequestMultiError is an error wrapping multiple validation
-// errors returned by AddRoleEndpointRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AddRoleEndpointRequestMultiError []error
+// AccessCheckMultiError is an error wrapping multiple validation errors
+// returned by AccessCheck.ValidateAll() if the designated constraints aren't met.
+type AccessCheckMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddRoleEndpointRequestMultiError) Error() string {
+func (m AccessCheckMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -239,11 +581,11 @@ func (m AddRoleEndpointRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddRoleEndpointRequestMultiError) AllErrors() []error { return m }
+func (m AccessCheckMultiError) AllErrors() []error { return m }
 
-// AddRoleEndpointRequestValidationError is the validation error returned by
-// AddRoleEndpointRequest.Validate if the designated constraints aren't met.
-type AddRoleEndpointRequestValidationError struct {
+// AccessCheckValidationError is the validation error returned by
+// AccessCheck.Validate if the designated constraints aren't met.
+type AccessCheckValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -251,24 +593,2873 @@ type AddRoleEndpointRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddRoleEndpointRequestValidationError) Field() string { return e.field }
+func (e AccessCheckValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddRoleEndpointRequestValidationError) Reason() string { return e.reason }
+func (e AccessCheckValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddRoleEndpointRequestValidationError) Cause() error { return e.cause }
+func (e AccessCheckValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddRoleEndpointRequestValidationError) Key() bool { return e.key }
+func (e AccessCheckValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddRoleEndpointRequestValidationError) ErrorName() string {
-	return "AddRoleEndpointRequestValidationError"
+func (e AccessCheckValidationError) ErrorName() string { return "AccessCheckValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccessCheckValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessCheck.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessCheckValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessCheckValidationError{}
+
+var _AccessCheck_Endpoint_Pattern = regexp.MustCompile("^[a-zA-Z0-9_/.-]+$")
+
+var _AccessCheck_Attributes_Pattern = regexp.MustCompile("^[A-Za-z0-9_]{1,64}$")
+
+// Validate checks the field values on BatchCheckRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BatchCheckRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCheckRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchCheckRequestMultiError, or nil if none found.
+func (m *BatchCheckRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCheckRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetChecks()); l < 1 || l > 100 {
+		err := BatchCheckRequestValidationError{
+			field:  "Checks",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetChecks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchCheckRequestValidationError{
+						field:  fmt.Sprintf("Checks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchCheckRequestValidationError{
+						field:  fmt.Sprintf("Checks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchCheckRequestValidationError{
+					field:  fmt.Sprintf("Checks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Explain
+
+	if m.GetUserId() != "" {
+
+		if err := m._validateUuid(m.GetUserId()); err != nil {
+			err = BatchCheckRequestValidationError{
+				field:  "UserId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchCheckRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *BatchCheckRequest) _validateUuid(uuid string) error {
+	if matched := _access_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// BatchCheckRequestMultiError is an error wrapping multiple validation errors
+// returned by BatchCheckRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BatchCheckRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchCheckRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchCheckRequestMultiError) AllErrors() []error { return m }
+
+// BatchCheckRequestValidationError is the validation error returned by
+// BatchCheckRequest.Validate if the designated constraints aren't met.
+type BatchCheckRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchCheckRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchCheckRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchCheckRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchCheckRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchCheckRequestValidationError) ErrorName() string {
+	return "BatchCheckRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCheckRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCheckRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchCheckRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchCheckRequestValidationError{}
+
+// Validate checks the field values on BatchCheckResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchCheckResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCheckResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchCheckResponseMultiError, or nil if none found.
+func (m *BatchCheckResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCheckResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDecisions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchCheckResponseValidationError{
+						field:  fmt.Sprintf("Decisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchCheckResponseValidationError{
+						field:  fmt.Sprintf("Decisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchCheckResponseValidationError{
+					field:  fmt.Sprintf("Decisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchCheckResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchCheckResponseMultiError is an error wrapping multiple validation errors
+// returned by BatchCheckResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BatchCheckResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchCheckResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchCheckResponseMultiError) AllErrors() []error { return m }
+
+// BatchCheckResponseValidationError is the validation error returned by
+// BatchCheckResponse.Validate if the designated constraints aren't met.
+type BatchCheckResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchCheckResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchCheckResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchCheckResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchCheckResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchCheckResponseValidationError) ErrorName() string {
+	return "BatchCheckResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCheckResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCheckResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchCheckResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchCheckResponseValidationError{}
+
+// Validate checks the field values on AccessDecision with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AccessDecision) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessDecision with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AccessDecisionMultiError,
+// or nil if none found.
+func (m *AccessDecision) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessDecision) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Endpoint
+
+	// no validation rules for Allowed
+
+	if all {
+		switch v := interface{}(m.GetExplanation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessDecisionValidationError{
+					field:  "Explanation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessDecisionValidationError{
+					field:  "Explanation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExplanation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessDecisionValidationError{
+				field:  "Explanation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AccessDecisionMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessDecisionMultiError is an error wrapping multiple validation errors
+// returned by AccessDecision.ValidateAll() if the designated constraints
+// aren't met.
+type AccessDecisionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessDecisionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessDecisionMultiError) AllErrors() []error { return m }
+
+// AccessDecisionValidationError is the validation error returned by
+// AccessDecision.Validate if the designated constraints aren't met.
+type AccessDecisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessDecisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessDecisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessDecisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessDecisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessDecisionValidationError) ErrorName() string { return "AccessDecisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccessDecisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessDecision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessDecisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessDecisionValidationError{}
+
+// Validate checks the field values on AccessExplanation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AccessExplanation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessExplanation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccessExplanationMultiError, or nil if none found.
+func (m *AccessExplanation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessExplanation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Reason
+
+	// no validation rules for Policy
+
+	// no validation rules for Condition
+
+	// no validation rules for DenyRule
+
+	if len(errors) > 0 {
+		return AccessExplanationMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessExplanationMultiError is an error wrapping multiple validation errors
+// returned by AccessExplanation.ValidateAll() if the designated constraints
+// aren't met.
+type AccessExplanationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessExplanationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessExplanationMultiError) AllErrors() []error { return m }
+
+// AccessExplanationValidationError is the validation error returned by
+// AccessExplanation.Validate if the designated constraints aren't met.
+type AccessExplanationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessExplanationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessExplanationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessExplanationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessExplanationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessExplanationValidationError) ErrorName() string {
+	return "AccessExplanationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccessExplanationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessExplanation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessExplanationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessExplanationValidationError{}
+
+// Validate checks the field values on AddRoleEndpointRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddRoleEndpointRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddRoleEndpointRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddRoleEndpointRequestMultiError, or nil if none found.
+func (m *AddRoleEndpointRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddRoleEndpointRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetEndpoint()); l < 1 || l > 255 {
+		err := AddRoleEndpointRequestValidationError{
+			field:  "Endpoint",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AddRoleEndpointRequest_Endpoint_Pattern.MatchString(m.GetEndpoint()) {
+		err := AddRoleEndpointRequestValidationError{
+			field:  "Endpoint",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9_/.*?-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetAllowedRoles() {
+		_, _ = idx, item
+
+		if _, ok := user_v1.Role_name[int32(item)]; !ok {
+			err := AddRoleEndpointRequestValidationError{
+				field:  fmt.Sprintf("AllowedRoles[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetRoles()) > 32 {
+		err := AddRoleEndpointRequestValidationError{
+			field:  "Roles",
+			reason: "value must contain no more than 32 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_AddRoleEndpointRequest_Roles_Unique := make(map[string]struct{}, len(m.GetRoles()))
+
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
+
+		if _, exists := _AddRoleEndpointRequest_Roles_Unique[item]; exists {
+			err := AddRoleEndpointRequestValidationError{
+				field:  fmt.Sprintf("Roles[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_AddRoleEndpointRequest_Roles_Unique[item] = struct{}{}
+		}
+
+		if !_AddRoleEndpointRequest_Roles_Pattern.MatchString(item) {
+			err := AddRoleEndpointRequestValidationError{
+				field:  fmt.Sprintf("Roles[%v]", idx),
+				reason: "value does not match regex pattern \"^[A-Za-z0-9_.:-]{1,64}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetCondition()) > 1024 {
+		err := AddRoleEndpointRequestValidationError{
+			field:  "Condition",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddRoleEndpointRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddRoleEndpointRequestMultiError is an error wrapping multiple validation
+// errors returned by AddRoleEndpointRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddRoleEndpointRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddRoleEndpointRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddRoleEndpointRequestMultiError) AllErrors() []error { return m }
+
+// AddRoleEndpointRequestValidationError is the validation error returned by
+// AddRoleEndpointRequest.Validate if the designated constraints aren't met.
+type AddRoleEndpointRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddRoleEndpointRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddRoleEndpointRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddRoleEndpointRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddRoleEndpointRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddRoleEndpointRequestValidationError) ErrorName() string {
+	return "AddRoleEndpointRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddRoleEndpointRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddRoleEndpointRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddRoleEndpointRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddRoleEndpointRequestValidationError{}
+
+var _AddRoleEndpointRequest_Endpoint_Pattern = regexp.MustCompile("^[a-zA-Z0-9_/.*?-]+$")
+
+var _AddRoleEndpointRequest_Roles_Pattern = regexp.MustCompile("^[A-Za-z0-9_.:-]{1,64}$")
+
+// Validate checks the field values on UpdateRoleEndpointRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRoleEndpointRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRoleEndpointRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRoleEndpointRequestMultiError, or nil if none found.
+func (m *UpdateRoleEndpointRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRoleEndpointRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetEndpoint()); l < 1 || l > 255 {
+		err := UpdateRoleEndpointRequestValidationError{
+			field:  "Endpoint",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UpdateRoleEndpointRequest_Endpoint_Pattern.MatchString(m.GetEndpoint()) {
+		err := UpdateRoleEndpointRequestValidationError{
+			field:  "Endpoint",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9_/.*?-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetAllowedRoles() {
+		_, _ = idx, item
+
+		if _, ok := user_v1.Role_name[int32(item)]; !ok {
+			err := UpdateRoleEndpointRequestValidationError{
+				field:  fmt.Sprintf("AllowedRoles[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetRoles()) > 32 {
+		err := UpdateRoleEndpointRequestValidationError{
+			field:  "Roles",
+			reason: "value must contain no more than 32 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_UpdateRoleEndpointRequest_Roles_Unique := make(map[string]struct{}, len(m.GetRoles()))
+
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
+
+		if _, exists := _UpdateRoleEndpointRequest_Roles_Unique[item]; exists {
+			err := UpdateRoleEndpointRequestValidationError{
+				field:  fmt.Sprintf("Roles[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_UpdateRoleEndpointRequest_Roles_Unique[item] = struct{}{}
+		}
+
+		if !_UpdateRoleEndpointRequest_Roles_Pattern.MatchString(item) {
+			err := UpdateRoleEndpointRequestValidationError{
+				field:  fmt.Sprintf("Roles[%v]", idx),
+				reason: "value does not match regex pattern \"^[A-Za-z0-9_.:-]{1,64}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if wrapper := m.GetCondition(); wrapper != nil {
+
+		if utf8.RuneCountInString(wrapper.GetValue()) > 1024 {
+			err := UpdateRoleEndpointRequestValidationError{
+				field:  "Condition",
+				reason: "value length must be at most 1024 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateRoleEndpointRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRoleEndpointRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateRoleEndpointRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateRoleEndpointRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRoleEndpointRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRoleEndpointRequestMultiError) AllErrors() []error { return m }
+
+// UpdateRoleEndpointRequestValidationError is the validation error returned by
+// UpdateRoleEndpointRequest.Validate if the designated constraints aren't met.
+type UpdateRoleEndpointRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRoleEndpointRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRoleEndpointRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRoleEndpointRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRoleEndpointRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRoleEndpointRequestValidationError) ErrorName() string {
+	return "UpdateRoleEndpointRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRoleEndpointRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRoleEndpointRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRoleEndpointRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRoleEndpointRequestValidationError{}
+
+var _UpdateRoleEndpointRequest_Endpoint_Pattern = regexp.MustCompile("^[a-zA-Z0-9_/.*?-]+$")
+
+var _UpdateRoleEndpointRequest_Roles_Pattern = regexp.MustCompile("^[A-Za-z0-9_.:-]{1,64}$")
+
+// Validate checks the field values on DeleteRoleEndpointRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRoleEndpointRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRoleEndpointRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRoleEndpointRequestMultiError, or nil if none found.
+func (m *DeleteRoleEndpointRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRoleEndpointRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetEndpoint()); l < 1 || l > 255 {
+		err := DeleteRoleEndpointRequestValidationError{
+			field:  "Endpoint",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DeleteRoleEndpointRequest_Endpoint_Pattern.MatchString(m.GetEndpoint()) {
+		err := DeleteRoleEndpointRequestValidationError{
+			field:  "Endpoint",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9_/.*?-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteRoleEndpointRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRoleEndpointRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteRoleEndpointRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteRoleEndpointRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRoleEndpointRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRoleEndpointRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRoleEndpointRequestValidationError is the validation error returned by
+// DeleteRoleEndpointRequest.Validate if the designated constraints aren't met.
+type DeleteRoleEndpointRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRoleEndpointRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRoleEndpointRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRoleEndpointRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRoleEndpointRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRoleEndpointRequestValidationError) ErrorName() string {
+	return "DeleteRoleEndpointRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRoleEndpointRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRoleEndpointRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRoleEndpointRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRoleEndpointRequestValidationError{}
+
+var _DeleteRoleEndpointRequest_Endpoint_Pattern = regexp.MustCompile("^[a-zA-Z0-9_/.*?-]+$")
+
+// Validate checks the field values on GetRoleEndpointsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRoleEndpointsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRoleEndpointsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRoleEndpointsRequestMultiError, or nil if none found.
+func (m *GetRoleEndpointsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRoleEndpointsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetEndpoint() != "" {
+
+		if utf8.RuneCountInString(m.GetEndpoint()) > 255 {
+			err := GetRoleEndpointsRequestValidationError{
+				field:  "Endpoint",
+				reason: "value length must be at most 255 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_GetRoleEndpointsRequest_Endpoint_Pattern.MatchString(m.GetEndpoint()) {
+			err := GetRoleEndpointsRequestValidationError{
+				field:  "Endpoint",
+				reason: "value does not match regex pattern \"^[a-zA-Z0-9_/.-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetRoleEndpointsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRoleEndpointsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRoleEndpointsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRoleEndpointsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRoleEndpointsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRoleEndpointsRequestMultiError) AllErrors() []error { return m }
+
+// GetRoleEndpointsRequestValidationError is the validation error returned by
+// GetRoleEndpointsRequest.Validate if the designated constraints aren't met.
+type GetRoleEndpointsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRoleEndpointsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRoleEndpointsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRoleEndpointsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRoleEndpointsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRoleEndpointsRequestValidationError) ErrorName() string {
+	return "GetRoleEndpointsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRoleEndpointsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRoleEndpointsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRoleEndpointsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRoleEndpointsRequestValidationError{}
+
+var _GetRoleEndpointsRequest_Endpoint_Pattern = regexp.MustCompile("^[a-zA-Z0-9_/.-]+$")
+
+// Validate checks the field values on GetRoleEndpointsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRoleEndpointsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRoleEndpointsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRoleEndpointsResponseMultiError, or nil if none found.
+func (m *GetRoleEndpointsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRoleEndpointsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEndpointPermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRoleEndpointsResponseValidationError{
+						field:  fmt.Sprintf("EndpointPermissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRoleEndpointsResponseValidationError{
+						field:  fmt.Sprintf("EndpointPermissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRoleEndpointsResponseValidationError{
+					field:  fmt.Sprintf("EndpointPermissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Matched
+
+	if len(errors) > 0 {
+		return GetRoleEndpointsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRoleEndpointsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRoleEndpointsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetRoleEndpointsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRoleEndpointsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRoleEndpointsResponseMultiError) AllErrors() []error { return m }
+
+// GetRoleEndpointsResponseValidationError is the validation error returned by
+// GetRoleEndpointsResponse.Validate if the designated constraints aren't met.
+type GetRoleEndpointsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRoleEndpointsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRoleEndpointsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRoleEndpointsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRoleEndpointsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRoleEndpointsResponseValidationError) ErrorName() string {
+	return "GetRoleEndpointsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRoleEndpointsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRoleEndpointsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRoleEndpointsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRoleEndpointsResponseValidationError{}
+
+// Validate checks the field values on EndpointPermissions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EndpointPermissions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EndpointPermissions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EndpointPermissionsMultiError, or nil if none found.
+func (m *EndpointPermissions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EndpointPermissions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetEndpoint()); l < 1 || l > 255 {
+		err := EndpointPermissionsValidationError{
+			field:  "Endpoint",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_EndpointPermissions_Endpoint_Pattern.MatchString(m.GetEndpoint()) {
+		err := EndpointPermissionsValidationError{
+			field:  "Endpoint",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9_/.*?-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetAllowedRoles() {
+		_, _ = idx, item
+
+		if _, ok := user_v1.Role_name[int32(item)]; !ok {
+			err := EndpointPermissionsValidationError{
+				field:  fmt.Sprintf("AllowedRoles[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetRoles()) > 32 {
+		err := EndpointPermissionsValidationError{
+			field:  "Roles",
+			reason: "value must contain no more than 32 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_EndpointPermissions_Roles_Unique := make(map[string]struct{}, len(m.GetRoles()))
+
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
+
+		if _, exists := _EndpointPermissions_Roles_Unique[item]; exists {
+			err := EndpointPermissionsValidationError{
+				field:  fmt.Sprintf("Roles[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_EndpointPermissions_Roles_Unique[item] = struct{}{}
+		}
+
+		if !_EndpointPermissions_Roles_Pattern.MatchString(item) {
+			err := EndpointPermissionsValidationError{
+				field:  fmt.Sprintf("Roles[%v]", idx),
+				reason: "value does not match regex pattern \"^[A-Za-z0-9_.:-]{1,64}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Condition
+
+	if len(errors) > 0 {
+		return EndpointPermissionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// EndpointPermissionsMultiError is an error wrapping multiple validation
+// errors returned by EndpointPermissions.ValidateAll() if the designated
+// constraints aren't met.
+type EndpointPermissionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EndpointPermissionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EndpointPermissionsMultiError) AllErrors() []error { return m }
+
+// EndpointPermissionsValidationError is the validation error returned by
+// EndpointPermissions.Validate if the designated constraints aren't met.
+type EndpointPermissionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndpointPermissionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndpointPermissionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndpointPermissionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndpointPermissionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndpointPermissionsValidationError) ErrorName() string {
+	return "EndpointPermissionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndpointPermissionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndpointPermissions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndpointPermissionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndpointPermissionsValidationError{}
+
+var _EndpointPermissions_Endpoint_Pattern = regexp.MustCompile("^[a-zA-Z0-9_/.*?-]+$")
+
+var _EndpointPermissions_Roles_Pattern = regexp.MustCompile("^[A-Za-z0-9_.:-]{1,64}$")
+
+// Validate checks the field values on DenyRule with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DenyRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DenyRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DenyRuleMultiError, or nil
+// if none found.
+func (m *DenyRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DenyRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Endpoint
+
+	// no validation rules for Reason
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DenyRuleValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DenyRuleValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DenyRuleValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DenyRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// DenyRuleMultiError is an error wrapping multiple validation errors returned
+// by DenyRule.ValidateAll() if the designated constraints aren't met.
+type DenyRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DenyRuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DenyRuleMultiError) AllErrors() []error { return m }
+
+// DenyRuleValidationError is the validation error returned by
+// DenyRule.Validate if the designated constraints aren't met.
+type DenyRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DenyRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DenyRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DenyRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DenyRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DenyRuleValidationError) ErrorName() string { return "DenyRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DenyRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDenyRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DenyRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DenyRuleValidationError{}
+
+// Validate checks the field values on AddDenyRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddDenyRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddDenyRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddDenyRuleRequestMultiError, or nil if none found.
+func (m *AddDenyRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddDenyRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_AddDenyRuleRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := AddDenyRuleRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_.:-]{1,64}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetEndpoint()); l < 1 || l > 255 {
+		err := AddDenyRuleRequestValidationError{
+			field:  "Endpoint",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AddDenyRuleRequest_Endpoint_Pattern.MatchString(m.GetEndpoint()) {
+		err := AddDenyRuleRequestValidationError{
+			field:  "Endpoint",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9_/.*?-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRoles()) > 32 {
+		err := AddDenyRuleRequestValidationError{
+			field:  "Roles",
+			reason: "value must contain no more than 32 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_AddDenyRuleRequest_Roles_Unique := make(map[string]struct{}, len(m.GetRoles()))
+
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
+
+		if _, exists := _AddDenyRuleRequest_Roles_Unique[item]; exists {
+			err := AddDenyRuleRequestValidationError{
+				field:  fmt.Sprintf("Roles[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_AddDenyRuleRequest_Roles_Unique[item] = struct{}{}
+		}
+
+		if !_AddDenyRuleRequest_Roles_Pattern.MatchString(item) {
+			err := AddDenyRuleRequestValidationError{
+				field:  fmt.Sprintf("Roles[%v]", idx),
+				reason: "value does not match regex pattern \"^[A-Za-z0-9_.:-]{1,64}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetUserIds()) > 100 {
+		err := AddDenyRuleRequestValidationError{
+			field:  "UserIds",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_AddDenyRuleRequest_UserIds_Unique := make(map[string]struct{}, len(m.GetUserIds()))
+
+	for idx, item := range m.GetUserIds() {
+		_, _ = idx, item
+
+		if _, exists := _AddDenyRuleRequest_UserIds_Unique[item]; exists {
+			err := AddDenyRuleRequestValidationError{
+				field:  fmt.Sprintf("UserIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_AddDenyRuleRequest_UserIds_Unique[item] = struct{}{}
+		}
+
+		if err := m._validateUuid(item); err != nil {
+			err = AddDenyRuleRequestValidationError{
+				field:  fmt.Sprintf("UserIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetExceptRoles()) > 32 {
+		err := AddDenyRuleRequestValidationError{
+			field:  "ExceptRoles",
+			reason: "value must contain no more than 32 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_AddDenyRuleRequest_ExceptRoles_Unique := make(map[string]struct{}, len(m.GetExceptRoles()))
+
+	for idx, item := range m.GetExceptRoles() {
+		_, _ = idx, item
+
+		if _, exists := _AddDenyRuleRequest_ExceptRoles_Unique[item]; exists {
+			err := AddDenyRuleRequestValidationError{
+				field:  fmt.Sprintf("ExceptRoles[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_AddDenyRuleRequest_ExceptRoles_Unique[item] = struct{}{}
+		}
+
+		if !_AddDenyRuleRequest_ExceptRoles_Pattern.MatchString(item) {
+			err := AddDenyRuleRequestValidationError{
+				field:  fmt.Sprintf("ExceptRoles[%v]", idx),
+				reason: "value does not match regex pattern \"^[A-Za-z0-9_.:-]{1,64}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 255 {
+		err := AddDenyRuleRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddDenyRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AddDenyRuleRequest) _validateUuid(uuid string) error {
+	if matched := _access_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AddDenyRuleRequestMultiError is an error wrapping multiple validation errors
+// returned by AddDenyRuleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddDenyRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddDenyRuleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddDenyRuleRequestMultiError) AllErrors() []error { return m }
+
+// AddDenyRuleRequestValidationError is the validation error returned by
+// AddDenyRuleRequest.Validate if the designated constraints aren't met.
+type AddDenyRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddDenyRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddDenyRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddDenyRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddDenyRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddDenyRuleRequestValidationError) ErrorName() string {
+	return "AddDenyRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddDenyRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddDenyRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddDenyRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddDenyRuleRequestValidationError{}
+
+var _AddDenyRuleRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_.:-]{1,64}$")
+
+var _AddDenyRuleRequest_Endpoint_Pattern = regexp.MustCompile("^[a-zA-Z0-9_/.*?-]+$")
+
+var _AddDenyRuleRequest_Roles_Pattern = regexp.MustCompile("^[A-Za-z0-9_.:-]{1,64}$")
+
+var _AddDenyRuleRequest_ExceptRoles_Pattern = regexp.MustCompile("^[A-Za-z0-9_.:-]{1,64}$")
+
+// Validate checks the field values on DeleteDenyRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteDenyRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteDenyRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteDenyRuleRequestMultiError, or nil if none found.
+func (m *DeleteDenyRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteDenyRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_DeleteDenyRuleRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := DeleteDenyRuleRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_.:-]{1,64}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteDenyRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteDenyRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteDenyRuleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteDenyRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteDenyRuleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteDenyRuleRequestMultiError) AllErrors() []error { return m }
+
+// DeleteDenyRuleRequestValidationError is the validation error returned by
+// DeleteDenyRuleRequest.Validate if the designated constraints aren't met.
+type DeleteDenyRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteDenyRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteDenyRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteDenyRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteDenyRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteDenyRuleRequestValidationError) ErrorName() string {
+	return "DeleteDenyRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteDenyRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteDenyRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteDenyRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteDenyRuleRequestValidationError{}
+
+var _DeleteDenyRuleRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_.:-]{1,64}$")
+
+// Validate checks the field values on GetDenyRulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDenyRulesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDenyRulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDenyRulesResponseMultiError, or nil if none found.
+func (m *GetDenyRulesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDenyRulesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDenyRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDenyRulesResponseValidationError{
+						field:  fmt.Sprintf("DenyRules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDenyRulesResponseValidationError{
+						field:  fmt.Sprintf("DenyRules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDenyRulesResponseValidationError{
+					field:  fmt.Sprintf("DenyRules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetDenyRulesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDenyRulesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetDenyRulesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetDenyRulesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDenyRulesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDenyRulesResponseMultiError) AllErrors() []error { return m }
+
+// GetDenyRulesResponseValidationError is the validation error returned by
+// GetDenyRulesResponse.Validate if the designated constraints aren't met.
+type GetDenyRulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDenyRulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDenyRulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDenyRulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDenyRulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDenyRulesResponseValidationError) ErrorName() string {
+	return "GetDenyRulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDenyRulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDenyRulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDenyRulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDenyRulesResponseValidationError{}
+
+// Validate checks the field values on RelationTuple with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RelationTuple) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationTuple with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RelationTupleMultiError, or
+// nil if none found.
+func (m *RelationTuple) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationTuple) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_RelationTuple_Object_Pattern.MatchString(m.GetObject()) {
+		err := RelationTupleValidationError{
+			field:  "Object",
+			reason: "value does not match regex pattern \"^[a-z][a-z0-9_]{0,63}:[A-Za-z0-9_.@|-]{1,128}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationTuple_Relation_Pattern.MatchString(m.GetRelation()) {
+		err := RelationTupleValidationError{
+			field:  "Relation",
+			reason: "value does not match regex pattern \"^[a-z][a-z0-9_]{0,63}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationTuple_Subject_Pattern.MatchString(m.GetSubject()) {
+		err := RelationTupleValidationError{
+			field:  "Subject",
+			reason: "value does not match regex pattern \"^[a-z][a-z0-9_]{0,63}:[A-Za-z0-9_.@|-]{1,128}(#[a-z][a-z0-9_]{0,63})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RelationTupleMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationTupleMultiError is an error wrapping multiple validation errors
+// returned by RelationTuple.ValidateAll() if the designated constraints
+// aren't met.
+type RelationTupleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationTupleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationTupleMultiError) AllErrors() []error { return m }
+
+// RelationTupleValidationError is the validation error returned by
+// RelationTuple.Validate if the designated constraints aren't met.
+type RelationTupleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationTupleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationTupleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationTupleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationTupleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationTupleValidationError) ErrorName() string { return "RelationTupleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RelationTupleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationTuple.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationTupleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationTupleValidationError{}
+
+var _RelationTuple_Object_Pattern = regexp.MustCompile("^[a-z][a-z0-9_]{0,63}:[A-Za-z0-9_.@|-]{1,128}$")
+
+var _RelationTuple_Relation_Pattern = regexp.MustCompile("^[a-z][a-z0-9_]{0,63}$")
+
+var _RelationTuple_Subject_Pattern = regexp.MustCompile("^[a-z][a-z0-9_]{0,63}:[A-Za-z0-9_.@|-]{1,128}(#[a-z][a-z0-9_]{0,63})?$")
+
+// Validate checks the field values on WriteTuplesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WriteTuplesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WriteTuplesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WriteTuplesRequestMultiError, or nil if none found.
+func (m *WriteTuplesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WriteTuplesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetTuples()); l < 1 || l > 100 {
+		err := WriteTuplesRequestValidationError{
+			field:  "Tuples",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTuples() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WriteTuplesRequestValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WriteTuplesRequestValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WriteTuplesRequestValidationError{
+					field:  fmt.Sprintf("Tuples[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WriteTuplesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WriteTuplesRequestMultiError is an error wrapping multiple validation errors
+// returned by WriteTuplesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WriteTuplesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WriteTuplesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WriteTuplesRequestMultiError) AllErrors() []error { return m }
+
+// WriteTuplesRequestValidationError is the validation error returned by
+// WriteTuplesRequest.Validate if the designated constraints aren't met.
+type WriteTuplesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WriteTuplesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WriteTuplesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WriteTuplesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WriteTuplesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WriteTuplesRequestValidationError) ErrorName() string {
+	return "WriteTuplesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WriteTuplesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWriteTuplesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WriteTuplesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WriteTuplesRequestValidationError{}
+
+// Validate checks the field values on WriteTuplesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WriteTuplesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WriteTuplesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WriteTuplesResponseMultiError, or nil if none found.
+func (m *WriteTuplesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WriteTuplesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConsistencyToken
+
+	if len(errors) > 0 {
+		return WriteTuplesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WriteTuplesResponseMultiError is an error wrapping multiple validation
+// errors returned by WriteTuplesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WriteTuplesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WriteTuplesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WriteTuplesResponseMultiError) AllErrors() []error { return m }
+
+// WriteTuplesResponseValidationError is the validation error returned by
+// WriteTuplesResponse.Validate if the designated constraints aren't met.
+type WriteTuplesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WriteTuplesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WriteTuplesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WriteTuplesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WriteTuplesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WriteTuplesResponseValidationError) ErrorName() string {
+	return "WriteTuplesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WriteTuplesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWriteTuplesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WriteTuplesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WriteTuplesResponseValidationError{}
+
+// Validate checks the field values on DeleteTuplesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTuplesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTuplesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTuplesRequestMultiError, or nil if none found.
+func (m *DeleteTuplesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTuplesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetTuples()); l < 1 || l > 100 {
+		err := DeleteTuplesRequestValidationError{
+			field:  "Tuples",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTuples() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeleteTuplesRequestValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeleteTuplesRequestValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeleteTuplesRequestValidationError{
+					field:  fmt.Sprintf("Tuples[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DeleteTuplesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTuplesRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteTuplesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTuplesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTuplesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTuplesRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTuplesRequestValidationError is the validation error returned by
+// DeleteTuplesRequest.Validate if the designated constraints aren't met.
+type DeleteTuplesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTuplesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTuplesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTuplesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTuplesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTuplesRequestValidationError) ErrorName() string {
+	return "DeleteTuplesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTuplesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTuplesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTuplesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTuplesRequestValidationError{}
+
+// Validate checks the field values on DeleteTuplesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTuplesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTuplesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTuplesResponseMultiError, or nil if none found.
+func (m *DeleteTuplesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTuplesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConsistencyToken
+
+	if len(errors) > 0 {
+		return DeleteTuplesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTuplesResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteTuplesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTuplesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTuplesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTuplesResponseMultiError) AllErrors() []error { return m }
+
+// DeleteTuplesResponseValidationError is the validation error returned by
+// DeleteTuplesResponse.Validate if the designated constraints aren't met.
+type DeleteTuplesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTuplesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTuplesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTuplesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTuplesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTuplesResponseValidationError) ErrorName() string {
+	return "DeleteTuplesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddRoleEndpointRequestValidationError) Error() string {
+func (e DeleteTuplesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -280,14 +3471,14 @@ func (e AddRoleEndpointRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddRoleEndpointRequest.%s: %s%s",
+		"invalid %sDeleteTuplesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddRoleEndpointRequestValidationError{}
+var _ error = DeleteTuplesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -295,36 +3486,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddRoleEndpointRequestValidationError{}
+} = DeleteTuplesResponseValidationError{}
 
-var _AddRoleEndpointRequest_Endpoint_Pattern = regexp.MustCompile("^[a-zA-Z0-9_/.-]+$")
-
-// Validate checks the field values on UpdateRoleEndpointRequest with the rules
+// Validate checks the field values on CheckRelationRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateRoleEndpointRequest) Validate() error {
+func (m *CheckRelationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateRoleEndpointRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CheckRelationRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateRoleEndpointRequestMultiError, or nil if none found.
-func (m *UpdateRoleEndpointRequest) ValidateAll() error {
+// CheckRelationRequestMultiError, or nil if none found.
+func (m *CheckRelationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateRoleEndpointRequest) validate(all bool) error {
+func (m *CheckRelationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetEndpoint()); l < 1 || l > 255 {
-		err := UpdateRoleEndpointRequestValidationError{
-			field:  "Endpoint",
-			reason: "value length must be between 1 and 255 runes, inclusive",
+	if m.GetTuple() == nil {
+		err := CheckRelationRequestValidationError{
+			field:  "Tuple",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -332,21 +3521,39 @@ func (m *UpdateRoleEndpointRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_UpdateRoleEndpointRequest_Endpoint_Pattern.MatchString(m.GetEndpoint()) {
-		err := UpdateRoleEndpointRequestValidationError{
-			field:  "Endpoint",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9_/.-]+$\"",
+	if all {
+		switch v := interface{}(m.GetTuple()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckRelationRequestValidationError{
+					field:  "Tuple",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckRelationRequestValidationError{
+					field:  "Tuple",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetTuple()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckRelationRequestValidationError{
+				field:  "Tuple",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	if len(m.GetAllowedRoles()) < 1 {
-		err := UpdateRoleEndpointRequestValidationError{
-			field:  "AllowedRoles",
-			reason: "value must contain at least 1 item(s)",
+	if utf8.RuneCountInString(m.GetConsistencyToken()) > 128 {
+		err := CheckRelationRequestValidationError{
+			field:  "ConsistencyToken",
+			reason: "value length must be at most 128 runes",
 		}
 		if !all {
 			return err
@@ -355,19 +3562,19 @@ func (m *UpdateRoleEndpointRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateRoleEndpointRequestMultiError(errors)
+		return CheckRelationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateRoleEndpointRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateRoleEndpointRequest.ValidateAll() if the
-// designated constraints aren't met.
-type UpdateRoleEndpointRequestMultiError []error
+// CheckRelationRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckRelationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckRelationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateRoleEndpointRequestMultiError) Error() string {
+func (m CheckRelationRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -376,11 +3583,11 @@ func (m UpdateRoleEndpointRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateRoleEndpointRequestMultiError) AllErrors() []error { return m }
+func (m CheckRelationRequestMultiError) AllErrors() []error { return m }
 
-// UpdateRoleEndpointRequestValidationError is the validation error returned by
-// UpdateRoleEndpointRequest.Validate if the designated constraints aren't met.
-type UpdateRoleEndpointRequestValidationError struct {
+// CheckRelationRequestValidationError is the validation error returned by
+// CheckRelationRequest.Validate if the designated constraints aren't met.
+type CheckRelationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -388,24 +3595,24 @@ type UpdateRoleEndpointRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateRoleEndpointRequestValidationError) Field() string { return e.field }
+func (e CheckRelationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateRoleEndpointRequestValidationError) Reason() string { return e.reason }
+func (e CheckRelationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateRoleEndpointRequestValidationError) Cause() error { return e.cause }
+func (e CheckRelationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateRoleEndpointRequestValidationError) Key() bool { return e.key }
+func (e CheckRelationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateRoleEndpointRequestValidationError) ErrorName() string {
-	return "UpdateRoleEndpointRequestValidationError"
+func (e CheckRelationRequestValidationError) ErrorName() string {
+	return "CheckRelationRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateRoleEndpointRequestValidationError) Error() string {
+func (e CheckRelationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -417,14 +3624,14 @@ func (e UpdateRoleEndpointRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateRoleEndpointRequest.%s: %s%s",
+		"invalid %sCheckRelationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateRoleEndpointRequestValidationError{}
+var _ error = CheckRelationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -432,68 +3639,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateRoleEndpointRequestValidationError{}
+} = CheckRelationRequestValidationError{}
 
-var _UpdateRoleEndpointRequest_Endpoint_Pattern = regexp.MustCompile("^[a-zA-Z0-9_/.-]+$")
-
-// Validate checks the field values on DeleteRoleEndpointRequest with the rules
+// Validate checks the field values on CheckRelationResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteRoleEndpointRequest) Validate() error {
+func (m *CheckRelationResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteRoleEndpointRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CheckRelationResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteRoleEndpointRequestMultiError, or nil if none found.
-func (m *DeleteRoleEndpointRequest) ValidateAll() error {
+// CheckRelationResponseMultiError, or nil if none found.
+func (m *CheckRelationResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteRoleEndpointRequest) validate(all bool) error {
+func (m *CheckRelationResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetEndpoint()); l < 1 || l > 255 {
-		err := DeleteRoleEndpointRequestValidationError{
-			field:  "Endpoint",
-			reason: "value length must be between 1 and 255 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Allowed
 
-	if !_DeleteRoleEndpointRequest_Endpoint_Pattern.MatchString(m.GetEndpoint()) {
-		err := DeleteRoleEndpointRequestValidationError{
-			field:  "Endpoint",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9_/.-]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for ConsistencyToken
 
 	if len(errors) > 0 {
-		return DeleteRoleEndpointRequestMultiError(errors)
+		return CheckRelationResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteRoleEndpointRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteRoleEndpointRequest.ValidateAll() if the
-// designated constraints aren't met.
-type DeleteRoleEndpointRequestMultiError []error
+// CheckRelationResponseMultiError is an error wrapping multiple validation
+// errors returned by CheckRelationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CheckRelationResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteRoleEndpointRequestMultiError) Error() string {
+func (m CheckRelationResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -502,11 +3689,11 @@ func (m DeleteRoleEndpointRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteRoleEndpointRequestMultiError) AllErrors() []error { return m }
+func (m CheckRelationResponseMultiError) AllErrors() []error { return m }
 
-// DeleteRoleEndpointRequestValidationError is the validation error returned by
-// DeleteRoleEndpointRequest.Validate if the designated constraints aren't met.
-type DeleteRoleEndpointRequestValidationError struct {
+// CheckRelationResponseValidationError is the validation error returned by
+// CheckRelationResponse.Validate if the designated constraints aren't met.
+type CheckRelationResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -514,24 +3701,24 @@ type DeleteRoleEndpointRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteRoleEndpointRequestValidationError) Field() string { return e.field }
+func (e CheckRelationResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteRoleEndpointRequestValidationError) Reason() string { return e.reason }
+func (e CheckRelationResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteRoleEndpointRequestValidationError) Cause() error { return e.cause }
+func (e CheckRelationResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteRoleEndpointRequestValidationError) Key() bool { return e.key }
+func (e CheckRelationResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteRoleEndpointRequestValidationError) ErrorName() string {
-	return "DeleteRoleEndpointRequestValidationError"
+func (e CheckRelationResponseValidationError) ErrorName() string {
+	return "CheckRelationResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteRoleEndpointRequestValidationError) Error() string {
+func (e CheckRelationResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -543,14 +3730,14 @@ func (e DeleteRoleEndpointRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteRoleEndpointRequest.%s: %s%s",
+		"invalid %sCheckRelationResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteRoleEndpointRequestValidationError{}
+var _ error = CheckRelationResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -558,80 +3745,110 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteRoleEndpointRequestValidationError{}
+} = CheckRelationResponseValidationError{}
 
-var _DeleteRoleEndpointRequest_Endpoint_Pattern = regexp.MustCompile("^[a-zA-Z0-9_/.-]+$")
-
-// Validate checks the field values on GetRoleEndpointsResponse with the rules
+// Validate checks the field values on ListObjectsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRoleEndpointsResponse) Validate() error {
+func (m *ListObjectsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRoleEndpointsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListObjectsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetRoleEndpointsResponseMultiError, or nil if none found.
-func (m *GetRoleEndpointsResponse) ValidateAll() error {
+// ListObjectsRequestMultiError, or nil if none found.
+func (m *ListObjectsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRoleEndpointsResponse) validate(all bool) error {
+func (m *ListObjectsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetEndpointPermissions() {
-		_, _ = idx, item
+	if !_ListObjectsRequest_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := ListObjectsRequestValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[a-z][a-z0-9_]{0,63}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetRoleEndpointsResponseValidationError{
-						field:  fmt.Sprintf("EndpointPermissions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetRoleEndpointsResponseValidationError{
-						field:  fmt.Sprintf("EndpointPermissions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetRoleEndpointsResponseValidationError{
-					field:  fmt.Sprintf("EndpointPermissions[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if !_ListObjectsRequest_Relation_Pattern.MatchString(m.GetRelation()) {
+		err := ListObjectsRequestValidationError{
+			field:  "Relation",
+			reason: "value does not match regex pattern \"^[a-z][a-z0-9_]{0,63}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ListObjectsRequest_Subject_Pattern.MatchString(m.GetSubject()) {
+		err := ListObjectsRequestValidationError{
+			field:  "Subject",
+			reason: "value does not match regex pattern \"^[a-z][a-z0-9_]{0,63}:[A-Za-z0-9_.@|-]{1,128}(#[a-z][a-z0-9_]{0,63})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetConsistencyToken()) > 128 {
+		err := ListObjectsRequestValidationError{
+			field:  "ConsistencyToken",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 500 {
+		err := ListObjectsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 500]",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
+	if utf8.RuneCountInString(m.GetPageToken()) > 1024 {
+		err := ListObjectsRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetRoleEndpointsResponseMultiError(errors)
+		return ListObjectsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRoleEndpointsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetRoleEndpointsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetRoleEndpointsResponseMultiError []error
+// ListObjectsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListObjectsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListObjectsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRoleEndpointsResponseMultiError) Error() string {
+func (m ListObjectsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -640,11 +3857,11 @@ func (m GetRoleEndpointsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRoleEndpointsResponseMultiError) AllErrors() []error { return m }
+func (m ListObjectsRequestMultiError) AllErrors() []error { return m }
 
-// GetRoleEndpointsResponseValidationError is the validation error returned by
-// GetRoleEndpointsResponse.Validate if the designated constraints aren't met.
-type GetRoleEndpointsResponseValidationError struct {
+// ListObjectsRequestValidationError is the validation error returned by
+// ListObjectsRequest.Validate if the designated constraints aren't met.
+type ListObjectsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -652,24 +3869,24 @@ type GetRoleEndpointsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRoleEndpointsResponseValidationError) Field() string { return e.field }
+func (e ListObjectsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRoleEndpointsResponseValidationError) Reason() string { return e.reason }
+func (e ListObjectsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRoleEndpointsResponseValidationError) Cause() error { return e.cause }
+func (e ListObjectsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRoleEndpointsResponseValidationError) Key() bool { return e.key }
+func (e ListObjectsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRoleEndpointsResponseValidationError) ErrorName() string {
-	return "GetRoleEndpointsResponseValidationError"
+func (e ListObjectsRequestValidationError) ErrorName() string {
+	return "ListObjectsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRoleEndpointsResponseValidationError) Error() string {
+func (e ListObjectsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -681,14 +3898,14 @@ func (e GetRoleEndpointsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRoleEndpointsResponse.%s: %s%s",
+		"invalid %sListObjectsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRoleEndpointsResponseValidationError{}
+var _ error = ListObjectsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -696,77 +3913,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRoleEndpointsResponseValidationError{}
+} = ListObjectsRequestValidationError{}
 
-// Validate checks the field values on EndpointPermissions with the rules
+var _ListObjectsRequest_Namespace_Pattern = regexp.MustCompile("^[a-z][a-z0-9_]{0,63}$")
+
+var _ListObjectsRequest_Relation_Pattern = regexp.MustCompile("^[a-z][a-z0-9_]{0,63}$")
+
+var _ListObjectsRequest_Subject_Pattern = regexp.MustCompile("^[a-z][a-z0-9_]{0,63}:[A-Za-z0-9_.@|-]{1,128}(#[a-z][a-z0-9_]{0,63})?$")
+
+// Validate checks the field values on ListObjectsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EndpointPermissions) Validate() error {
+func (m *ListObjectsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EndpointPermissions with the rules
+// ValidateAll checks the field values on ListObjectsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// EndpointPermissionsMultiError, or nil if none found.
-func (m *EndpointPermissions) ValidateAll() error {
+// ListObjectsResponseMultiError, or nil if none found.
+func (m *ListObjectsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EndpointPermissions) validate(all bool) error {
+func (m *ListObjectsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetEndpoint()); l < 1 || l > 255 {
-		err := EndpointPermissionsValidationError{
-			field:  "Endpoint",
-			reason: "value length must be between 1 and 255 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_EndpointPermissions_Endpoint_Pattern.MatchString(m.GetEndpoint()) {
-		err := EndpointPermissionsValidationError{
-			field:  "Endpoint",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9_/.-]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for ConsistencyToken
 
-	if len(m.GetAllowedRoles()) < 1 {
-		err := EndpointPermissionsValidationError{
-			field:  "AllowedRoles",
-			reason: "value must contain at least 1 item(s)",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for NextPageToken
 
 	if len(errors) > 0 {
-		return EndpointPermissionsMultiError(errors)
+		return ListObjectsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// EndpointPermissionsMultiError is an error wrapping multiple validation
-// errors returned by EndpointPermissions.ValidateAll() if the designated
+// ListObjectsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListObjectsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type EndpointPermissionsMultiError []error
+type ListObjectsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EndpointPermissionsMultiError) Error() string {
+func (m ListObjectsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -775,11 +3969,11 @@ func (m EndpointPermissionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EndpointPermissionsMultiError) AllErrors() []error { return m }
+func (m ListObjectsResponseMultiError) AllErrors() []error { return m }
 
-// EndpointPermissionsValidationError is the validation error returned by
-// EndpointPermissions.Validate if the designated constraints aren't met.
-type EndpointPermissionsValidationError struct {
+// ListObjectsResponseValidationError is the validation error returned by
+// ListObjectsResponse.Validate if the designated constraints aren't met.
+type ListObjectsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -787,24 +3981,24 @@ type EndpointPermissionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e EndpointPermissionsValidationError) Field() string { return e.field }
+func (e ListObjectsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EndpointPermissionsValidationError) Reason() string { return e.reason }
+func (e ListObjectsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EndpointPermissionsValidationError) Cause() error { return e.cause }
+func (e ListObjectsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EndpointPermissionsValidationError) Key() bool { return e.key }
+func (e ListObjectsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EndpointPermissionsValidationError) ErrorName() string {
-	return "EndpointPermissionsValidationError"
+func (e ListObjectsResponseValidationError) ErrorName() string {
+	return "ListObjectsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EndpointPermissionsValidationError) Error() string {
+func (e ListObjectsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -816,14 +4010,14 @@ func (e EndpointPermissionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEndpointPermissions.%s: %s%s",
+		"invalid %sListObjectsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EndpointPermissionsValidationError{}
+var _ error = ListObjectsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -831,6 +4025,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EndpointPermissionsValidationError{}
-
-var _EndpointPermissions_Endpoint_Pattern = regexp.MustCompile("^[a-zA-Z0-9_/.-]+$")
+} = ListObjectsResponseValidationError{}
@@ -25,10 +25,18 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	AccessV1_Check_FullMethodName              = "/access_v1.AccessV1/Check"
+	AccessV1_BatchCheck_FullMethodName         = "/access_v1.AccessV1/BatchCheck"
 	AccessV1_AddRoleEndpoint_FullMethodName    = "/access_v1.AccessV1/AddRoleEndpoint"
 	AccessV1_UpdateRoleEndpoint_FullMethodName = "/access_v1.AccessV1/UpdateRoleEndpoint"
 	AccessV1_DeleteRoleEndpoint_FullMethodName = "/access_v1.AccessV1/DeleteRoleEndpoint"
 	AccessV1_GetRoleEndpoints_FullMethodName   = "/access_v1.AccessV1/GetRoleEndpoints"
+	AccessV1_AddDenyRule_FullMethodName        = "/access_v1.AccessV1/AddDenyRule"
+	AccessV1_DeleteDenyRule_FullMethodName     = "/access_v1.AccessV1/DeleteDenyRule"
+	AccessV1_GetDenyRules_FullMethodName       = "/access_v1.AccessV1/GetDenyRules"
+	AccessV1_WriteTuples_FullMethodName        = "/access_v1.AccessV1/WriteTuples"
+	AccessV1_DeleteTuples_FullMethodName       = "/access_v1.AccessV1/DeleteTuples"
+	AccessV1_CheckRelation_FullMethodName      = "/access_v1.AccessV1/CheckRelation"
+	AccessV1_ListObjects_FullMethodName        = "/access_v1.AccessV1/ListObjects"
 )
 
 // AccessV1Client is the client API for AccessV1 service.
@@ -37,16 +45,38 @@ const (
 //
 // AccessV1 defines the service for managing access permissions for endpoints based on user roles.
 type AccessV1Client interface {
-	// Check executes user authorization for an endpoint.
+	// Check executes user authorization for an endpoint or a named permission. Services authenticated by
+	// the x-service-key header may check the authorization of an explicit access token or subject instead.
+	// Check runs on every request a gateway forwards, so it only answers with the status of the decision:
+	// BatchCheck with a single endpoint explains a decision or checks the access of another user.
 	Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// BatchCheck decides on access to many endpoints at once, explaining the decisions on request.
+	// Checking the access of another user requires the access:check_on_behalf permission.
+	BatchCheck(ctx context.Context, in *BatchCheckRequest, opts ...grpc.CallOption) (*BatchCheckResponse, error)
 	// AddRoleEndpoint adds a new endpoint permission with roles.
 	AddRoleEndpoint(ctx context.Context, in *AddRoleEndpointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// UpdateRoleEndpoint updates an existing endpoint permission.
 	UpdateRoleEndpoint(ctx context.Context, in *UpdateRoleEndpointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// DeleteRoleEndpoint removes an existing endpoint permission.
 	DeleteRoleEndpoint(ctx context.Context, in *DeleteRoleEndpointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// GetRoleEndpoints lists all endpoints and their allowed roles.
-	GetRoleEndpoints(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetRoleEndpointsResponse, error)
+	// GetRoleEndpoints lists all endpoints and their allowed roles, or the policies matching an endpoint.
+	GetRoleEndpoints(ctx context.Context, in *GetRoleEndpointsRequest, opts ...grpc.CallOption) (*GetRoleEndpointsResponse, error)
+	// AddDenyRule adds a rule denying access to an endpoint, which overrides the policies allowing it.
+	AddDenyRule(ctx context.Context, in *AddDenyRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DeleteDenyRule removes an existing deny rule.
+	DeleteDenyRule(ctx context.Context, in *DeleteDenyRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetDenyRules lists all deny rules.
+	GetDenyRules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetDenyRulesResponse, error)
+	// WriteTuples writes relation tuples relating subjects to objects, the tuples already written are kept.
+	WriteTuples(ctx context.Context, in *WriteTuplesRequest, opts ...grpc.CallOption) (*WriteTuplesResponse, error)
+	// DeleteTuples deletes relation tuples.
+	DeleteTuples(ctx context.Context, in *DeleteTuplesRequest, opts ...grpc.CallOption) (*DeleteTuplesResponse, error)
+	// CheckRelation checks whether a subject has a relation to an object, directly or through the relations
+	// of the schema.
+	CheckRelation(ctx context.Context, in *CheckRelationRequest, opts ...grpc.CallOption) (*CheckRelationResponse, error)
+	// ListObjects lists a page of the objects of a namespace a subject has a relation to. The next page
+	// is requested with the returned page token and the same namespace, relation and subject.
+	ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (*ListObjectsResponse, error)
 }
 
 type accessV1Client struct {
@@ -67,6 +97,16 @@ func (c *accessV1Client) Check(ctx context.Context, in *CheckRequest, opts ...gr
 	return out, nil
 }
 
+func (c *accessV1Client) BatchCheck(ctx context.Context, in *BatchCheckRequest, opts ...grpc.CallOption) (*BatchCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCheckResponse)
+	err := c.cc.Invoke(ctx, AccessV1_BatchCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accessV1Client) AddRoleEndpoint(ctx context.Context, in *AddRoleEndpointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -97,7 +137,7 @@ func (c *accessV1Client) DeleteRoleEndpoint(ctx context.Context, in *DeleteRoleE
 	return out, nil
 }
 
-func (c *accessV1Client) GetRoleEndpoints(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetRoleEndpointsResponse, error) {
+func (c *accessV1Client) GetRoleEndpoints(ctx context.Context, in *GetRoleEndpointsRequest, opts ...grpc.CallOption) (*GetRoleEndpointsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetRoleEndpointsResponse)
 	err := c.cc.Invoke(ctx, AccessV1_GetRoleEndpoints_FullMethodName, in, out, cOpts...)
@@ -107,22 +147,114 @@ func (c *accessV1Client) GetRoleEndpoints(ctx context.Context, in *emptypb.Empty
 	return out, nil
 }
 
+func (c *accessV1Client) AddDenyRule(ctx context.Context, in *AddDenyRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AccessV1_AddDenyRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessV1Client) DeleteDenyRule(ctx context.Context, in *DeleteDenyRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AccessV1_DeleteDenyRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessV1Client) GetDenyRules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetDenyRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDenyRulesResponse)
+	err := c.cc.Invoke(ctx, AccessV1_GetDenyRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessV1Client) WriteTuples(ctx context.Context, in *WriteTuplesRequest, opts ...grpc.CallOption) (*WriteTuplesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WriteTuplesResponse)
+	err := c.cc.Invoke(ctx, AccessV1_WriteTuples_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessV1Client) DeleteTuples(ctx context.Context, in *DeleteTuplesRequest, opts ...grpc.CallOption) (*DeleteTuplesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTuplesResponse)
+	err := c.cc.Invoke(ctx, AccessV1_DeleteTuples_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessV1Client) CheckRelation(ctx context.Context, in *CheckRelationRequest, opts ...grpc.CallOption) (*CheckRelationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckRelationResponse)
+	err := c.cc.Invoke(ctx, AccessV1_CheckRelation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessV1Client) ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (*ListObjectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListObjectsResponse)
+	err := c.cc.Invoke(ctx, AccessV1_ListObjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccessV1Server is the server API for AccessV1 service.
 // All implementations must embed UnimplementedAccessV1Server
 // for forward compatibility.
 //
 // AccessV1 defines the service for managing access permissions for endpoints based on user roles.
 type AccessV1Server interface {
-	// Check executes user authorization for an endpoint.
+	// Check executes user authorization for an endpoint or a named permission. Services authenticated by
+	// the x-service-key header may check the authorization of an explicit access token or subject instead.
+	// Check runs on every request a gateway forwards, so it only answers with the status of the decision:
+	// BatchCheck with a single endpoint explains a decision or checks the access of another user.
 	Check(context.Context, *CheckRequest) (*emptypb.Empty, error)
+	// BatchCheck decides on access to many endpoints at once, explaining the decisions on request.
+	// Checking the access of another user requires the access:check_on_behalf permission.
+	BatchCheck(context.Context, *BatchCheckRequest) (*BatchCheckResponse, error)
 	// AddRoleEndpoint adds a new endpoint permission with roles.
 	AddRoleEndpoint(context.Context, *AddRoleEndpointRequest) (*emptypb.Empty, error)
 	// UpdateRoleEndpoint updates an existing endpoint permission.
 	UpdateRoleEndpoint(context.Context, *UpdateRoleEndpointRequest) (*emptypb.Empty, error)
 	// DeleteRoleEndpoint removes an existing endpoint permission.
 	DeleteRoleEndpoint(context.Context, *DeleteRoleEndpointRequest) (*emptypb.Empty, error)
-	// GetRoleEndpoints lists all endpoints and their allowed roles.
-	GetRoleEndpoints(context.Context, *emptypb.Empty) (*GetRoleEndpointsResponse, error)
+	// GetRoleEndpoints lists all endpoints and their allowed roles, or the policies matching an endpoint.
+	GetRoleEndpoints(context.Context, *GetRoleEndpointsRequest) (*GetRoleEndpointsResponse, error)
+	// AddDenyRule adds a rule denying access to an endpoint, which overrides the policies allowing it.
+	AddDenyRule(context.Context, *AddDenyRuleRequest) (*emptypb.Empty, error)
+	// DeleteDenyRule removes an existing deny rule.
+	DeleteDenyRule(context.Context, *DeleteDenyRuleRequest) (*emptypb.Empty, error)
+	// GetDenyRules lists all deny rules.
+	GetDenyRules(context.Context, *emptypb.Empty) (*GetDenyRulesResponse, error)
+	// WriteTuples writes relation tuples relating subjects to objects, the tuples already written are kept.
+	WriteTuples(context.Context, *WriteTuplesRequest) (*WriteTuplesResponse, error)
+	// DeleteTuples deletes relation tuples.
+	DeleteTuples(context.Context, *DeleteTuplesRequest) (*DeleteTuplesResponse, error)
+	// CheckRelation checks whether a subject has a relation to an object, directly or through the relations
+	// of the schema.
+	CheckRelation(context.Context, *CheckRelationRequest) (*CheckRelationResponse, error)
+	// ListObjects lists a page of the objects of a namespace a subject has a relation to. The next page
+	// is requested with the returned page token and the same namespace, relation and subject.
+	ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error)
 	mustEmbedUnimplementedAccessV1Server()
 }
 
@@ -136,6 +268,9 @@ type UnimplementedAccessV1Server struct{}
 func (UnimplementedAccessV1Server) Check(context.Context, *CheckRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Check not implemented")
 }
+func (UnimplementedAccessV1Server) BatchCheck(context.Context, *BatchCheckRequest) (*BatchCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCheck not implemented")
+}
 func (UnimplementedAccessV1Server) AddRoleEndpoint(context.Context, *AddRoleEndpointRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddRoleEndpoint not implemented")
 }
@@ -145,9 +280,30 @@ func (UnimplementedAccessV1Server) UpdateRoleEndpoint(context.Context, *UpdateRo
 func (UnimplementedAccessV1Server) DeleteRoleEndpoint(context.Context, *DeleteRoleEndpointRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoleEndpoint not implemented")
 }
-func (UnimplementedAccessV1Server) GetRoleEndpoints(context.Context, *emptypb.Empty) (*GetRoleEndpointsResponse, error) {
+func (UnimplementedAccessV1Server) GetRoleEndpoints(context.Context, *GetRoleEndpointsRequest) (*GetRoleEndpointsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRoleEndpoints not implemented")
 }
+func (UnimplementedAccessV1Server) AddDenyRule(context.Context, *AddDenyRuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDenyRule not implemented")
+}
+func (UnimplementedAccessV1Server) DeleteDenyRule(context.Context, *DeleteDenyRuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDenyRule not implemented")
+}
+func (UnimplementedAccessV1Server) GetDenyRules(context.Context, *emptypb.Empty) (*GetDenyRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDenyRules not implemented")
+}
+func (UnimplementedAccessV1Server) WriteTuples(context.Context, *WriteTuplesRequest) (*WriteTuplesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteTuples not implemented")
+}
+func (UnimplementedAccessV1Server) DeleteTuples(context.Context, *DeleteTuplesRequest) (*DeleteTuplesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTuples not implemented")
+}
+func (UnimplementedAccessV1Server) CheckRelation(context.Context, *CheckRelationRequest) (*CheckRelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckRelation not implemented")
+}
+func (UnimplementedAccessV1Server) ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListObjects not implemented")
+}
 func (UnimplementedAccessV1Server) mustEmbedUnimplementedAccessV1Server() {}
 func (UnimplementedAccessV1Server) testEmbeddedByValue()                  {}
 
@@ -187,6 +343,24 @@ func _AccessV1_Check_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccessV1_BatchCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessV1Server).BatchCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessV1_BatchCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessV1Server).BatchCheck(ctx, req.(*BatchCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AccessV1_AddRoleEndpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddRoleEndpointRequest)
 	if err := dec(in); err != nil {
@@ -242,7 +416,7 @@ func _AccessV1_DeleteRoleEndpoint_Handler(srv interface{}, ctx context.Context,
 }
 
 func _AccessV1_GetRoleEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(GetRoleEndpointsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -254,7 +428,133 @@ func _AccessV1_GetRoleEndpoints_Handler(srv interface{}, ctx context.Context, de
 		FullMethod: AccessV1_GetRoleEndpoints_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccessV1Server).GetRoleEndpoints(ctx, req.(*emptypb.Empty))
+		return srv.(AccessV1Server).GetRoleEndpoints(ctx, req.(*GetRoleEndpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessV1_AddDenyRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDenyRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessV1Server).AddDenyRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessV1_AddDenyRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessV1Server).AddDenyRule(ctx, req.(*AddDenyRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessV1_DeleteDenyRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDenyRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessV1Server).DeleteDenyRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessV1_DeleteDenyRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessV1Server).DeleteDenyRule(ctx, req.(*DeleteDenyRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessV1_GetDenyRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessV1Server).GetDenyRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessV1_GetDenyRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessV1Server).GetDenyRules(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessV1_WriteTuples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteTuplesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessV1Server).WriteTuples(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessV1_WriteTuples_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessV1Server).WriteTuples(ctx, req.(*WriteTuplesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessV1_DeleteTuples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTuplesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessV1Server).DeleteTuples(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessV1_DeleteTuples_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessV1Server).DeleteTuples(ctx, req.(*DeleteTuplesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessV1_CheckRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessV1Server).CheckRelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessV1_CheckRelation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessV1Server).CheckRelation(ctx, req.(*CheckRelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessV1_ListObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessV1Server).ListObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessV1_ListObjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessV1Server).ListObjects(ctx, req.(*ListObjectsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -270,6 +570,10 @@ var AccessV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Check",
 			Handler:    _AccessV1_Check_Handler,
 		},
+		{
+			MethodName: "BatchCheck",
+			Handler:    _AccessV1_BatchCheck_Handler,
+		},
 		{
 			MethodName: "AddRoleEndpoint",
 			Handler:    _AccessV1_AddRoleEndpoint_Handler,
@@ -286,6 +590,34 @@ var AccessV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRoleEndpoints",
 			Handler:    _AccessV1_GetRoleEndpoints_Handler,
 		},
+		{
+			MethodName: "AddDenyRule",
+			Handler:    _AccessV1_AddDenyRule_Handler,
+		},
+		{
+			MethodName: "DeleteDenyRule",
+			Handler:    _AccessV1_DeleteDenyRule_Handler,
+		},
+		{
+			MethodName: "GetDenyRules",
+			Handler:    _AccessV1_GetDenyRules_Handler,
+		},
+		{
+			MethodName: "WriteTuples",
+			Handler:    _AccessV1_WriteTuples_Handler,
+		},
+		{
+			MethodName: "DeleteTuples",
+			Handler:    _AccessV1_DeleteTuples_Handler,
+		},
+		{
+			MethodName: "CheckRelation",
+			Handler:    _AccessV1_CheckRelation_Handler,
+		},
+		{
+			MethodName: "ListObjects",
+			Handler:    _AccessV1_ListObjects_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "access.proto",
@@ -35,6 +35,9 @@ var (
 	_ = sort.Sort
 )
 
+// define the regex for a UUID once up-front
+var _auth_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
 // Validate checks the field values on LoginRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -329,27 +332,11 @@ func (m *LoginResponse) validate(all bool) error {
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetRefreshToken()) < 10 {
-		err := LoginResponseValidationError{
-			field:  "RefreshToken",
-			reason: "value length must be at least 10 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for RefreshToken
 
-	if utf8.RuneCountInString(m.GetAccessToken()) < 10 {
-		err := LoginResponseValidationError{
-			field:  "AccessToken",
-			reason: "value length must be at least 10 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for AccessToken
+
+	// no validation rules for MfaToken
 
 	if len(errors) > 0 {
 		return LoginResponseMultiError(errors)
@@ -429,31 +416,31 @@ var _ interface {
 	ErrorName() string
 } = LoginResponseValidationError{}
 
-// Validate checks the field values on RefreshTokensRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RefreshTokensRequest) Validate() error {
+// Validate checks the field values on VerifyMfaRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VerifyMfaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RefreshTokensRequest with the rules
+// ValidateAll checks the field values on VerifyMfaRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RefreshTokensRequestMultiError, or nil if none found.
-func (m *RefreshTokensRequest) ValidateAll() error {
+// VerifyMfaRequestMultiError, or nil if none found.
+func (m *VerifyMfaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RefreshTokensRequest) validate(all bool) error {
+func (m *VerifyMfaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetRefreshToken()) < 10 {
-		err := RefreshTokensRequestValidationError{
-			field:  "RefreshToken",
+	if utf8.RuneCountInString(m.GetMfaToken()) < 10 {
+		err := VerifyMfaRequestValidationError{
+			field:  "MfaToken",
 			reason: "value length must be at least 10 runes",
 		}
 		if !all {
@@ -462,20 +449,31 @@ func (m *RefreshTokensRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if l := utf8.RuneCountInString(m.GetCode()); l < 6 || l > 32 {
+		err := VerifyMfaRequestValidationError{
+			field:  "Code",
+			reason: "value length must be between 6 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return RefreshTokensRequestMultiError(errors)
+		return VerifyMfaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RefreshTokensRequestMultiError is an error wrapping multiple validation
-// errors returned by RefreshTokensRequest.ValidateAll() if the designated
-// constraints aren't met.
-type RefreshTokensRequestMultiError []error
+// VerifyMfaRequestMultiError is an error wrapping multiple validation errors
+// returned by VerifyMfaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type VerifyMfaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RefreshTokensRequestMultiError) Error() string {
+func (m VerifyMfaRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -484,11 +482,11 @@ func (m RefreshTokensRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RefreshTokensRequestMultiError) AllErrors() []error { return m }
+func (m VerifyMfaRequestMultiError) AllErrors() []error { return m }
 
-// RefreshTokensRequestValidationError is the validation error returned by
-// RefreshTokensRequest.Validate if the designated constraints aren't met.
-type RefreshTokensRequestValidationError struct {
+// VerifyMfaRequestValidationError is the validation error returned by
+// VerifyMfaRequest.Validate if the designated constraints aren't met.
+type VerifyMfaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -496,24 +494,22 @@ type RefreshTokensRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RefreshTokensRequestValidationError) Field() string { return e.field }
+func (e VerifyMfaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RefreshTokensRequestValidationError) Reason() string { return e.reason }
+func (e VerifyMfaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RefreshTokensRequestValidationError) Cause() error { return e.cause }
+func (e VerifyMfaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RefreshTokensRequestValidationError) Key() bool { return e.key }
+func (e VerifyMfaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RefreshTokensRequestValidationError) ErrorName() string {
-	return "RefreshTokensRequestValidationError"
-}
+func (e VerifyMfaRequestValidationError) ErrorName() string { return "VerifyMfaRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RefreshTokensRequestValidationError) Error() string {
+func (e VerifyMfaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -525,14 +521,14 @@ func (e RefreshTokensRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRefreshTokensRequest.%s: %s%s",
+		"invalid %sVerifyMfaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RefreshTokensRequestValidationError{}
+var _ error = VerifyMfaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -540,24 +536,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RefreshTokensRequestValidationError{}
+} = VerifyMfaRequestValidationError{}
 
-// Validate checks the field values on RefreshTokensResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RefreshTokensResponse) Validate() error {
+// Validate checks the field values on VerifyMfaResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VerifyMfaResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RefreshTokensResponse with the rules
+// ValidateAll checks the field values on VerifyMfaResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RefreshTokensResponseMultiError, or nil if none found.
-func (m *RefreshTokensResponse) ValidateAll() error {
+// VerifyMfaResponseMultiError, or nil if none found.
+func (m *VerifyMfaResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RefreshTokensResponse) validate(all bool) error {
+func (m *VerifyMfaResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -565,7 +561,7 @@ func (m *RefreshTokensResponse) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetRefreshToken()) < 10 {
-		err := RefreshTokensResponseValidationError{
+		err := VerifyMfaResponseValidationError{
 			field:  "RefreshToken",
 			reason: "value length must be at least 10 runes",
 		}
@@ -576,7 +572,7 @@ func (m *RefreshTokensResponse) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetAccessToken()) < 10 {
-		err := RefreshTokensResponseValidationError{
+		err := VerifyMfaResponseValidationError{
 			field:  "AccessToken",
 			reason: "value length must be at least 10 runes",
 		}
@@ -587,19 +583,19 @@ func (m *RefreshTokensResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return RefreshTokensResponseMultiError(errors)
+		return VerifyMfaResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RefreshTokensResponseMultiError is an error wrapping multiple validation
-// errors returned by RefreshTokensResponse.ValidateAll() if the designated
-// constraints aren't met.
-type RefreshTokensResponseMultiError []error
+// VerifyMfaResponseMultiError is an error wrapping multiple validation errors
+// returned by VerifyMfaResponse.ValidateAll() if the designated constraints
+// aren't met.
+type VerifyMfaResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RefreshTokensResponseMultiError) Error() string {
+func (m VerifyMfaResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -608,11 +604,11 @@ func (m RefreshTokensResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RefreshTokensResponseMultiError) AllErrors() []error { return m }
+func (m VerifyMfaResponseMultiError) AllErrors() []error { return m }
 
-// RefreshTokensResponseValidationError is the validation error returned by
-// RefreshTokensResponse.Validate if the designated constraints aren't met.
-type RefreshTokensResponseValidationError struct {
+// VerifyMfaResponseValidationError is the validation error returned by
+// VerifyMfaResponse.Validate if the designated constraints aren't met.
+type VerifyMfaResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -620,24 +616,24 @@ type RefreshTokensResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RefreshTokensResponseValidationError) Field() string { return e.field }
+func (e VerifyMfaResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RefreshTokensResponseValidationError) Reason() string { return e.reason }
+func (e VerifyMfaResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RefreshTokensResponseValidationError) Cause() error { return e.cause }
+func (e VerifyMfaResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RefreshTokensResponseValidationError) Key() bool { return e.key }
+func (e VerifyMfaResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RefreshTokensResponseValidationError) ErrorName() string {
-	return "RefreshTokensResponseValidationError"
+func (e VerifyMfaResponseValidationError) ErrorName() string {
+	return "VerifyMfaResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RefreshTokensResponseValidationError) Error() string {
+func (e VerifyMfaResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -649,14 +645,14 @@ func (e RefreshTokensResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRefreshTokensResponse.%s: %s%s",
+		"invalid %sVerifyMfaResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RefreshTokensResponseValidationError{}
+var _ error = VerifyMfaResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -664,34 +660,141 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RefreshTokensResponseValidationError{}
+} = VerifyMfaResponseValidationError{}
 
-// Validate checks the field values on LogoutRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *LogoutRequest) Validate() error {
+// Validate checks the field values on BeginTotpEnrollmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BeginTotpEnrollmentResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LogoutRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in LogoutRequestMultiError, or
-// nil if none found.
-func (m *LogoutRequest) ValidateAll() error {
+// ValidateAll checks the field values on BeginTotpEnrollmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BeginTotpEnrollmentResponseMultiError, or nil if none found.
+func (m *BeginTotpEnrollmentResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LogoutRequest) validate(all bool) error {
+func (m *BeginTotpEnrollmentResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetRefreshToken()) < 10 {
-		err := LogoutRequestValidationError{
-			field:  "RefreshToken",
-			reason: "value length must be at least 10 runes",
+	// no validation rules for Secret
+
+	// no validation rules for OtpauthUri
+
+	if len(errors) > 0 {
+		return BeginTotpEnrollmentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BeginTotpEnrollmentResponseMultiError is an error wrapping multiple
+// validation errors returned by BeginTotpEnrollmentResponse.ValidateAll() if
+// the designated constraints aren't met.
+type BeginTotpEnrollmentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BeginTotpEnrollmentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BeginTotpEnrollmentResponseMultiError) AllErrors() []error { return m }
+
+// BeginTotpEnrollmentResponseValidationError is the validation error returned
+// by BeginTotpEnrollmentResponse.Validate if the designated constraints
+// aren't met.
+type BeginTotpEnrollmentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BeginTotpEnrollmentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BeginTotpEnrollmentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BeginTotpEnrollmentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BeginTotpEnrollmentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BeginTotpEnrollmentResponseValidationError) ErrorName() string {
+	return "BeginTotpEnrollmentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BeginTotpEnrollmentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBeginTotpEnrollmentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BeginTotpEnrollmentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BeginTotpEnrollmentResponseValidationError{}
+
+// Validate checks the field values on ConfirmTotpEnrollmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmTotpEnrollmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmTotpEnrollmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmTotpEnrollmentRequestMultiError, or nil if none found.
+func (m *ConfirmTotpEnrollmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmTotpEnrollmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ConfirmTotpEnrollmentRequest_Code_Pattern.MatchString(m.GetCode()) {
+		err := ConfirmTotpEnrollmentRequestValidationError{
+			field:  "Code",
+			reason: "value does not match regex pattern \"^[0-9]{6}$\"",
 		}
 		if !all {
 			return err
@@ -700,19 +803,19 @@ func (m *LogoutRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return LogoutRequestMultiError(errors)
+		return ConfirmTotpEnrollmentRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// LogoutRequestMultiError is an error wrapping multiple validation errors
-// returned by LogoutRequest.ValidateAll() if the designated constraints
-// aren't met.
-type LogoutRequestMultiError []error
+// ConfirmTotpEnrollmentRequestMultiError is an error wrapping multiple
+// validation errors returned by ConfirmTotpEnrollmentRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ConfirmTotpEnrollmentRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LogoutRequestMultiError) Error() string {
+func (m ConfirmTotpEnrollmentRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -721,11 +824,12 @@ func (m LogoutRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LogoutRequestMultiError) AllErrors() []error { return m }
+func (m ConfirmTotpEnrollmentRequestMultiError) AllErrors() []error { return m }
 
-// LogoutRequestValidationError is the validation error returned by
-// LogoutRequest.Validate if the designated constraints aren't met.
-type LogoutRequestValidationError struct {
+// ConfirmTotpEnrollmentRequestValidationError is the validation error returned
+// by ConfirmTotpEnrollmentRequest.Validate if the designated constraints
+// aren't met.
+type ConfirmTotpEnrollmentRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -733,22 +837,24 @@ type LogoutRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e LogoutRequestValidationError) Field() string { return e.field }
+func (e ConfirmTotpEnrollmentRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LogoutRequestValidationError) Reason() string { return e.reason }
+func (e ConfirmTotpEnrollmentRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LogoutRequestValidationError) Cause() error { return e.cause }
+func (e ConfirmTotpEnrollmentRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LogoutRequestValidationError) Key() bool { return e.key }
+func (e ConfirmTotpEnrollmentRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LogoutRequestValidationError) ErrorName() string { return "LogoutRequestValidationError" }
+func (e ConfirmTotpEnrollmentRequestValidationError) ErrorName() string {
+	return "ConfirmTotpEnrollmentRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e LogoutRequestValidationError) Error() string {
+func (e ConfirmTotpEnrollmentRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -760,14 +866,14 @@ func (e LogoutRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLogoutRequest.%s: %s%s",
+		"invalid %sConfirmTotpEnrollmentRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LogoutRequestValidationError{}
+var _ error = ConfirmTotpEnrollmentRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -775,4 +881,2512 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LogoutRequestValidationError{}
+} = ConfirmTotpEnrollmentRequestValidationError{}
+
+var _ConfirmTotpEnrollmentRequest_Code_Pattern = regexp.MustCompile("^[0-9]{6}$")
+
+// Validate checks the field values on ConfirmTotpEnrollmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmTotpEnrollmentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmTotpEnrollmentResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ConfirmTotpEnrollmentResponseMultiError, or nil if none found.
+func (m *ConfirmTotpEnrollmentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmTotpEnrollmentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ConfirmTotpEnrollmentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmTotpEnrollmentResponseMultiError is an error wrapping multiple
+// validation errors returned by ConfirmTotpEnrollmentResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ConfirmTotpEnrollmentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmTotpEnrollmentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmTotpEnrollmentResponseMultiError) AllErrors() []error { return m }
+
+// ConfirmTotpEnrollmentResponseValidationError is the validation error
+// returned by ConfirmTotpEnrollmentResponse.Validate if the designated
+// constraints aren't met.
+type ConfirmTotpEnrollmentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmTotpEnrollmentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmTotpEnrollmentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmTotpEnrollmentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmTotpEnrollmentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmTotpEnrollmentResponseValidationError) ErrorName() string {
+	return "ConfirmTotpEnrollmentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmTotpEnrollmentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmTotpEnrollmentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmTotpEnrollmentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmTotpEnrollmentResponseValidationError{}
+
+// Validate checks the field values on DisableTotpRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableTotpRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableTotpRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableTotpRequestMultiError, or nil if none found.
+func (m *DisableTotpRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableTotpRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetCode()); l < 6 || l > 32 {
+		err := DisableTotpRequestValidationError{
+			field:  "Code",
+			reason: "value length must be between 6 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DisableTotpRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableTotpRequestMultiError is an error wrapping multiple validation errors
+// returned by DisableTotpRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DisableTotpRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableTotpRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableTotpRequestMultiError) AllErrors() []error { return m }
+
+// DisableTotpRequestValidationError is the validation error returned by
+// DisableTotpRequest.Validate if the designated constraints aren't met.
+type DisableTotpRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableTotpRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableTotpRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableTotpRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableTotpRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableTotpRequestValidationError) ErrorName() string {
+	return "DisableTotpRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableTotpRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableTotpRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableTotpRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableTotpRequestValidationError{}
+
+// Validate checks the field values on BeginPasskeyRegistrationResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *BeginPasskeyRegistrationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BeginPasskeyRegistrationResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BeginPasskeyRegistrationResponseMultiError, or nil if none found.
+func (m *BeginPasskeyRegistrationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BeginPasskeyRegistrationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CeremonyId
+
+	// no validation rules for Options
+
+	if len(errors) > 0 {
+		return BeginPasskeyRegistrationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BeginPasskeyRegistrationResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// BeginPasskeyRegistrationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BeginPasskeyRegistrationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BeginPasskeyRegistrationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BeginPasskeyRegistrationResponseMultiError) AllErrors() []error { return m }
+
+// BeginPasskeyRegistrationResponseValidationError is the validation error
+// returned by BeginPasskeyRegistrationResponse.Validate if the designated
+// constraints aren't met.
+type BeginPasskeyRegistrationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BeginPasskeyRegistrationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BeginPasskeyRegistrationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BeginPasskeyRegistrationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BeginPasskeyRegistrationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BeginPasskeyRegistrationResponseValidationError) ErrorName() string {
+	return "BeginPasskeyRegistrationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BeginPasskeyRegistrationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBeginPasskeyRegistrationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BeginPasskeyRegistrationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BeginPasskeyRegistrationResponseValidationError{}
+
+// Validate checks the field values on FinishPasskeyRegistrationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *FinishPasskeyRegistrationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FinishPasskeyRegistrationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// FinishPasskeyRegistrationRequestMultiError, or nil if none found.
+func (m *FinishPasskeyRegistrationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FinishPasskeyRegistrationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetCeremonyId()); err != nil {
+		err = FinishPasskeyRegistrationRequestValidationError{
+			field:  "CeremonyId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCredential()); l < 1 || l > 65536 {
+		err := FinishPasskeyRegistrationRequestValidationError{
+			field:  "Credential",
+			reason: "value length must be between 1 and 65536 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FinishPasskeyRegistrationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *FinishPasskeyRegistrationRequest) _validateUuid(uuid string) error {
+	if matched := _auth_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// FinishPasskeyRegistrationRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// FinishPasskeyRegistrationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FinishPasskeyRegistrationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinishPasskeyRegistrationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinishPasskeyRegistrationRequestMultiError) AllErrors() []error { return m }
+
+// FinishPasskeyRegistrationRequestValidationError is the validation error
+// returned by FinishPasskeyRegistrationRequest.Validate if the designated
+// constraints aren't met.
+type FinishPasskeyRegistrationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinishPasskeyRegistrationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinishPasskeyRegistrationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinishPasskeyRegistrationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinishPasskeyRegistrationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinishPasskeyRegistrationRequestValidationError) ErrorName() string {
+	return "FinishPasskeyRegistrationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinishPasskeyRegistrationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinishPasskeyRegistrationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinishPasskeyRegistrationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinishPasskeyRegistrationRequestValidationError{}
+
+// Validate checks the field values on BeginPasskeyLoginResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BeginPasskeyLoginResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BeginPasskeyLoginResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BeginPasskeyLoginResponseMultiError, or nil if none found.
+func (m *BeginPasskeyLoginResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BeginPasskeyLoginResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CeremonyId
+
+	// no validation rules for Options
+
+	if len(errors) > 0 {
+		return BeginPasskeyLoginResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BeginPasskeyLoginResponseMultiError is an error wrapping multiple validation
+// errors returned by BeginPasskeyLoginResponse.ValidateAll() if the
+// designated constraints aren't met.
+type BeginPasskeyLoginResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BeginPasskeyLoginResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BeginPasskeyLoginResponseMultiError) AllErrors() []error { return m }
+
+// BeginPasskeyLoginResponseValidationError is the validation error returned by
+// BeginPasskeyLoginResponse.Validate if the designated constraints aren't met.
+type BeginPasskeyLoginResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BeginPasskeyLoginResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BeginPasskeyLoginResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BeginPasskeyLoginResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BeginPasskeyLoginResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BeginPasskeyLoginResponseValidationError) ErrorName() string {
+	return "BeginPasskeyLoginResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BeginPasskeyLoginResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBeginPasskeyLoginResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BeginPasskeyLoginResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BeginPasskeyLoginResponseValidationError{}
+
+// Validate checks the field values on FinishPasskeyLoginRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FinishPasskeyLoginRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FinishPasskeyLoginRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FinishPasskeyLoginRequestMultiError, or nil if none found.
+func (m *FinishPasskeyLoginRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FinishPasskeyLoginRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetCeremonyId()); err != nil {
+		err = FinishPasskeyLoginRequestValidationError{
+			field:  "CeremonyId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCredential()); l < 1 || l > 65536 {
+		err := FinishPasskeyLoginRequestValidationError{
+			field:  "Credential",
+			reason: "value length must be between 1 and 65536 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FinishPasskeyLoginRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *FinishPasskeyLoginRequest) _validateUuid(uuid string) error {
+	if matched := _auth_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// FinishPasskeyLoginRequestMultiError is an error wrapping multiple validation
+// errors returned by FinishPasskeyLoginRequest.ValidateAll() if the
+// designated constraints aren't met.
+type FinishPasskeyLoginRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinishPasskeyLoginRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinishPasskeyLoginRequestMultiError) AllErrors() []error { return m }
+
+// FinishPasskeyLoginRequestValidationError is the validation error returned by
+// FinishPasskeyLoginRequest.Validate if the designated constraints aren't met.
+type FinishPasskeyLoginRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinishPasskeyLoginRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinishPasskeyLoginRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinishPasskeyLoginRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinishPasskeyLoginRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinishPasskeyLoginRequestValidationError) ErrorName() string {
+	return "FinishPasskeyLoginRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinishPasskeyLoginRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinishPasskeyLoginRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinishPasskeyLoginRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinishPasskeyLoginRequestValidationError{}
+
+// Validate checks the field values on FinishPasskeyLoginResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FinishPasskeyLoginResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FinishPasskeyLoginResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FinishPasskeyLoginResponseMultiError, or nil if none found.
+func (m *FinishPasskeyLoginResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FinishPasskeyLoginResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRefreshToken()) < 10 {
+		err := FinishPasskeyLoginResponseValidationError{
+			field:  "RefreshToken",
+			reason: "value length must be at least 10 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 10 {
+		err := FinishPasskeyLoginResponseValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 10 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FinishPasskeyLoginResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FinishPasskeyLoginResponseMultiError is an error wrapping multiple
+// validation errors returned by FinishPasskeyLoginResponse.ValidateAll() if
+// the designated constraints aren't met.
+type FinishPasskeyLoginResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinishPasskeyLoginResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinishPasskeyLoginResponseMultiError) AllErrors() []error { return m }
+
+// FinishPasskeyLoginResponseValidationError is the validation error returned
+// by FinishPasskeyLoginResponse.Validate if the designated constraints aren't met.
+type FinishPasskeyLoginResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinishPasskeyLoginResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinishPasskeyLoginResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinishPasskeyLoginResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinishPasskeyLoginResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinishPasskeyLoginResponseValidationError) ErrorName() string {
+	return "FinishPasskeyLoginResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinishPasskeyLoginResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinishPasskeyLoginResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinishPasskeyLoginResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinishPasskeyLoginResponseValidationError{}
+
+// Validate checks the field values on RequestPasswordResetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestPasswordResetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestPasswordResetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestPasswordResetRequestMultiError, or nil if none found.
+func (m *RequestPasswordResetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestPasswordResetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = RequestPasswordResetRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RequestPasswordResetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RequestPasswordResetRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *RequestPasswordResetRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// RequestPasswordResetRequestMultiError is an error wrapping multiple
+// validation errors returned by RequestPasswordResetRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RequestPasswordResetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestPasswordResetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestPasswordResetRequestMultiError) AllErrors() []error { return m }
+
+// RequestPasswordResetRequestValidationError is the validation error returned
+// by RequestPasswordResetRequest.Validate if the designated constraints
+// aren't met.
+type RequestPasswordResetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestPasswordResetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestPasswordResetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestPasswordResetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestPasswordResetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestPasswordResetRequestValidationError) ErrorName() string {
+	return "RequestPasswordResetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestPasswordResetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestPasswordResetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestPasswordResetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestPasswordResetRequestValidationError{}
+
+// Validate checks the field values on ResetPasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetPasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetPasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetPasswordRequestMultiError, or nil if none found.
+func (m *ResetPasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetPasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetToken()); l < 10 || l > 256 {
+		err := ResetPasswordRequestValidationError{
+			field:  "Token",
+			reason: "value length must be between 10 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetNewPassword()); l < 8 || l > 256 {
+		err := ResetPasswordRequestValidationError{
+			field:  "NewPassword",
+			reason: "value length must be between 8 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResetPasswordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetPasswordRequestMultiError is an error wrapping multiple validation
+// errors returned by ResetPasswordRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResetPasswordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetPasswordRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetPasswordRequestMultiError) AllErrors() []error { return m }
+
+// ResetPasswordRequestValidationError is the validation error returned by
+// ResetPasswordRequest.Validate if the designated constraints aren't met.
+type ResetPasswordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetPasswordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetPasswordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetPasswordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetPasswordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetPasswordRequestValidationError) ErrorName() string {
+	return "ResetPasswordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetPasswordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetPasswordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetPasswordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetPasswordRequestValidationError{}
+
+// Validate checks the field values on RefreshTokensRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshTokensRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshTokensRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshTokensRequestMultiError, or nil if none found.
+func (m *RefreshTokensRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshTokensRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRefreshToken()) < 10 {
+		err := RefreshTokensRequestValidationError{
+			field:  "RefreshToken",
+			reason: "value length must be at least 10 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RefreshTokensRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshTokensRequestMultiError is an error wrapping multiple validation
+// errors returned by RefreshTokensRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshTokensRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshTokensRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshTokensRequestMultiError) AllErrors() []error { return m }
+
+// RefreshTokensRequestValidationError is the validation error returned by
+// RefreshTokensRequest.Validate if the designated constraints aren't met.
+type RefreshTokensRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshTokensRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshTokensRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshTokensRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshTokensRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshTokensRequestValidationError) ErrorName() string {
+	return "RefreshTokensRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshTokensRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshTokensRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshTokensRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshTokensRequestValidationError{}
+
+// Validate checks the field values on RefreshTokensResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshTokensResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshTokensResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshTokensResponseMultiError, or nil if none found.
+func (m *RefreshTokensResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshTokensResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRefreshToken()) < 10 {
+		err := RefreshTokensResponseValidationError{
+			field:  "RefreshToken",
+			reason: "value length must be at least 10 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 10 {
+		err := RefreshTokensResponseValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 10 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RefreshTokensResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshTokensResponseMultiError is an error wrapping multiple validation
+// errors returned by RefreshTokensResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshTokensResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshTokensResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshTokensResponseMultiError) AllErrors() []error { return m }
+
+// RefreshTokensResponseValidationError is the validation error returned by
+// RefreshTokensResponse.Validate if the designated constraints aren't met.
+type RefreshTokensResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshTokensResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshTokensResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshTokensResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshTokensResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshTokensResponseValidationError) ErrorName() string {
+	return "RefreshTokensResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshTokensResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshTokensResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshTokensResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshTokensResponseValidationError{}
+
+// Validate checks the field values on LogoutRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LogoutRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogoutRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LogoutRequestMultiError, or
+// nil if none found.
+func (m *LogoutRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogoutRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRefreshToken()) < 10 {
+		err := LogoutRequestValidationError{
+			field:  "RefreshToken",
+			reason: "value length must be at least 10 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LogoutRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogoutRequestMultiError is an error wrapping multiple validation errors
+// returned by LogoutRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LogoutRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogoutRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogoutRequestMultiError) AllErrors() []error { return m }
+
+// LogoutRequestValidationError is the validation error returned by
+// LogoutRequest.Validate if the designated constraints aren't met.
+type LogoutRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogoutRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogoutRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogoutRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogoutRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogoutRequestValidationError) ErrorName() string { return "LogoutRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogoutRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogoutRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogoutRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogoutRequestValidationError{}
+
+// Validate checks the field values on ForceLogoutRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ForceLogoutRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForceLogoutRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForceLogoutRequestMultiError, or nil if none found.
+func (m *ForceLogoutRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForceLogoutRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ForceLogoutRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ForceLogoutRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ForceLogoutRequest) _validateUuid(uuid string) error {
+	if matched := _auth_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ForceLogoutRequestMultiError is an error wrapping multiple validation errors
+// returned by ForceLogoutRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ForceLogoutRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForceLogoutRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForceLogoutRequestMultiError) AllErrors() []error { return m }
+
+// ForceLogoutRequestValidationError is the validation error returned by
+// ForceLogoutRequest.Validate if the designated constraints aren't met.
+type ForceLogoutRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForceLogoutRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForceLogoutRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForceLogoutRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForceLogoutRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForceLogoutRequestValidationError) ErrorName() string {
+	return "ForceLogoutRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForceLogoutRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForceLogoutRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForceLogoutRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForceLogoutRequestValidationError{}
+
+// Validate checks the field values on UnlockUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UnlockUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlockUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnlockUserRequestMultiError, or nil if none found.
+func (m *UnlockUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlockUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = UnlockUserRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnlockUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UnlockUserRequest) _validateUuid(uuid string) error {
+	if matched := _auth_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UnlockUserRequestMultiError is an error wrapping multiple validation errors
+// returned by UnlockUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnlockUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlockUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlockUserRequestMultiError) AllErrors() []error { return m }
+
+// UnlockUserRequestValidationError is the validation error returned by
+// UnlockUserRequest.Validate if the designated constraints aren't met.
+type UnlockUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlockUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlockUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlockUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlockUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlockUserRequestValidationError) ErrorName() string {
+	return "UnlockUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnlockUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlockUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlockUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlockUserRequestValidationError{}
+
+// Validate checks the field values on Session with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Session) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Session with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SessionMultiError, or nil if none found.
+func (m *Session) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Session) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for IpAddress
+
+	// no validation rules for UserAgent
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastRefreshedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "LastRefreshedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "LastRefreshedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastRefreshedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionValidationError{
+				field:  "LastRefreshedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Current
+
+	if len(errors) > 0 {
+		return SessionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SessionMultiError is an error wrapping multiple validation errors returned
+// by Session.ValidateAll() if the designated constraints aren't met.
+type SessionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SessionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SessionMultiError) AllErrors() []error { return m }
+
+// SessionValidationError is the validation error returned by Session.Validate
+// if the designated constraints aren't met.
+type SessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SessionValidationError) ErrorName() string { return "SessionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSession.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SessionValidationError{}
+
+// Validate checks the field values on ListSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSessionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSessionsResponseMultiError, or nil if none found.
+func (m *ListSessionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSessionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSessions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSessionsResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSessionsResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSessionsResponseValidationError{
+					field:  fmt.Sprintf("Sessions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSessionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSessionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSessionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSessionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSessionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSessionsResponseMultiError) AllErrors() []error { return m }
+
+// ListSessionsResponseValidationError is the validation error returned by
+// ListSessionsResponse.Validate if the designated constraints aren't met.
+type ListSessionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSessionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSessionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSessionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSessionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSessionsResponseValidationError) ErrorName() string {
+	return "ListSessionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSessionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSessionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSessionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSessionsResponseValidationError{}
+
+// Validate checks the field values on RevokeSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeSessionRequestMultiError, or nil if none found.
+func (m *RevokeSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetSessionId()); err != nil {
+		err = RevokeSessionRequestValidationError{
+			field:  "SessionId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RevokeSessionRequest) _validateUuid(uuid string) error {
+	if matched := _auth_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RevokeSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSessionRequestMultiError) AllErrors() []error { return m }
+
+// RevokeSessionRequestValidationError is the validation error returned by
+// RevokeSessionRequest.Validate if the designated constraints aren't met.
+type RevokeSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSessionRequestValidationError) ErrorName() string {
+	return "RevokeSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeSessionRequestValidationError{}
+
+// Validate checks the field values on ListUserSessionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserSessionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserSessionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserSessionsRequestMultiError, or nil if none found.
+func (m *ListUserSessionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserSessionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ListUserSessionsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListUserSessionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListUserSessionsRequest) _validateUuid(uuid string) error {
+	if matched := _auth_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListUserSessionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListUserSessionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListUserSessionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserSessionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserSessionsRequestMultiError) AllErrors() []error { return m }
+
+// ListUserSessionsRequestValidationError is the validation error returned by
+// ListUserSessionsRequest.Validate if the designated constraints aren't met.
+type ListUserSessionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserSessionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserSessionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserSessionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserSessionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserSessionsRequestValidationError) ErrorName() string {
+	return "ListUserSessionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserSessionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserSessionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserSessionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserSessionsRequestValidationError{}
+
+// Validate checks the field values on RevokeUserSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeUserSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeUserSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeUserSessionRequestMultiError, or nil if none found.
+func (m *RevokeUserSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeUserSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RevokeUserSessionRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetSessionId()); err != nil {
+		err = RevokeUserSessionRequestValidationError{
+			field:  "SessionId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeUserSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RevokeUserSessionRequest) _validateUuid(uuid string) error {
+	if matched := _auth_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RevokeUserSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeUserSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeUserSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeUserSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeUserSessionRequestMultiError) AllErrors() []error { return m }
+
+// RevokeUserSessionRequestValidationError is the validation error returned by
+// RevokeUserSessionRequest.Validate if the designated constraints aren't met.
+type RevokeUserSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeUserSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeUserSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeUserSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeUserSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeUserSessionRequestValidationError) ErrorName() string {
+	return "RevokeUserSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeUserSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeUserSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeUserSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeUserSessionRequestValidationError{}
+
+// Validate checks the field values on RevokeAllUserSessionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAllUserSessionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAllUserSessionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeAllUserSessionsRequestMultiError, or nil if none found.
+func (m *RevokeAllUserSessionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAllUserSessionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RevokeAllUserSessionsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeAllUserSessionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RevokeAllUserSessionsRequest) _validateUuid(uuid string) error {
+	if matched := _auth_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RevokeAllUserSessionsRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeAllUserSessionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RevokeAllUserSessionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeAllUserSessionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeAllUserSessionsRequestMultiError) AllErrors() []error { return m }
+
+// RevokeAllUserSessionsRequestValidationError is the validation error returned
+// by RevokeAllUserSessionsRequest.Validate if the designated constraints
+// aren't met.
+type RevokeAllUserSessionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAllUserSessionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAllUserSessionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAllUserSessionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAllUserSessionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAllUserSessionsRequestValidationError) ErrorName() string {
+	return "RevokeAllUserSessionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeAllUserSessionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAllUserSessionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAllUserSessionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAllUserSessionsRequestValidationError{}
@@ -24,14 +24,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserV1_Create_FullMethodName         = "/user_v1.UserV1/Create"
-	UserV1_Get_FullMethodName            = "/user_v1.UserV1/Get"
-	UserV1_Update_FullMethodName         = "/user_v1.UserV1/Update"
-	UserV1_Delete_FullMethodName         = "/user_v1.UserV1/Delete"
-	UserV1_GetMe_FullMethodName          = "/user_v1.UserV1/GetMe"
-	UserV1_UpdateMe_FullMethodName       = "/user_v1.UserV1/UpdateMe"
-	UserV1_DeleteMe_FullMethodName       = "/user_v1.UserV1/DeleteMe"
-	UserV1_ChangePassword_FullMethodName = "/user_v1.UserV1/ChangePassword"
+	UserV1_Create_FullMethodName                = "/user_v1.UserV1/Create"
+	UserV1_Get_FullMethodName                   = "/user_v1.UserV1/Get"
+	UserV1_Update_FullMethodName                = "/user_v1.UserV1/Update"
+	UserV1_Delete_FullMethodName                = "/user_v1.UserV1/Delete"
+	UserV1_GetMe_FullMethodName                 = "/user_v1.UserV1/GetMe"
+	UserV1_UpdateMe_FullMethodName              = "/user_v1.UserV1/UpdateMe"
+	UserV1_DeleteMe_FullMethodName              = "/user_v1.UserV1/DeleteMe"
+	UserV1_ChangePassword_FullMethodName        = "/user_v1.UserV1/ChangePassword"
+	UserV1_SendVerificationEmail_FullMethodName = "/user_v1.UserV1/SendVerificationEmail"
+	UserV1_VerifyEmail_FullMethodName           = "/user_v1.UserV1/VerifyEmail"
+	UserV1_ListUsers_FullMethodName             = "/user_v1.UserV1/ListUsers"
+	UserV1_SuspendUser_FullMethodName           = "/user_v1.UserV1/SuspendUser"
+	UserV1_RestoreUser_FullMethodName           = "/user_v1.UserV1/RestoreUser"
+	UserV1_PurgeUser_FullMethodName             = "/user_v1.UserV1/PurgeUser"
 )
 
 // UserV1Client is the client API for UserV1 service.
@@ -47,16 +53,31 @@ type UserV1Client interface {
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
 	// Update is used for updating existing user info.
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// Delete is used for deleting a user by ID.
+	// Delete is used for deleting a user by ID. The user can be restored until they are purged
+	// after the retention period.
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// GetMe returns information about the currently authenticated user
 	GetMe(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetMeResponse, error)
 	// UpdateMe allows the currently authenticated user to update their own information
 	UpdateMe(ctx context.Context, in *UpdateMeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// DeleteMe allows the currently authenticated user to delete their account
+	// DeleteMe allows the currently authenticated user to delete their account.
+	// The account is deactivated and can be restored until it is purged after the retention period.
 	DeleteMe(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ChangePassword allows users to change their password securely
 	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SendVerificationEmail sends a new verification link to an email that is not verified yet.
+	SendVerificationEmail(ctx context.Context, in *SendVerificationEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// VerifyEmail confirms an email with the token from a verification link.
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListUsers returns a page of users matching a filter. The next page is requested
+	// with the returned page token and the same filter and order.
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	// SuspendUser prevents a user from signing in until a time or until they are restored.
+	SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RestoreUser makes a suspended, deactivated or deleted user active again.
+	RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PurgeUser permanently deletes a deleted or deactivated user before the end of the retention period.
+	PurgeUser(ctx context.Context, in *PurgeUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type userV1Client struct {
@@ -147,6 +168,66 @@ func (c *userV1Client) ChangePassword(ctx context.Context, in *ChangePasswordReq
 	return out, nil
 }
 
+func (c *userV1Client) SendVerificationEmail(ctx context.Context, in *SendVerificationEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserV1_SendVerificationEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userV1Client) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserV1_VerifyEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userV1Client) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, UserV1_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userV1Client) SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserV1_SuspendUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userV1Client) RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserV1_RestoreUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userV1Client) PurgeUser(ctx context.Context, in *PurgeUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserV1_PurgeUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserV1Server is the server API for UserV1 service.
 // All implementations must embed UnimplementedUserV1Server
 // for forward compatibility.
@@ -160,16 +241,31 @@ type UserV1Server interface {
 	Get(context.Context, *GetRequest) (*GetResponse, error)
 	// Update is used for updating existing user info.
 	Update(context.Context, *UpdateRequest) (*emptypb.Empty, error)
-	// Delete is used for deleting a user by ID.
+	// Delete is used for deleting a user by ID. The user can be restored until they are purged
+	// after the retention period.
 	Delete(context.Context, *DeleteRequest) (*emptypb.Empty, error)
 	// GetMe returns information about the currently authenticated user
 	GetMe(context.Context, *emptypb.Empty) (*GetMeResponse, error)
 	// UpdateMe allows the currently authenticated user to update their own information
 	UpdateMe(context.Context, *UpdateMeRequest) (*emptypb.Empty, error)
-	// DeleteMe allows the currently authenticated user to delete their account
+	// DeleteMe allows the currently authenticated user to delete their account.
+	// The account is deactivated and can be restored until it is purged after the retention period.
 	DeleteMe(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	// ChangePassword allows users to change their password securely
 	ChangePassword(context.Context, *ChangePasswordRequest) (*emptypb.Empty, error)
+	// SendVerificationEmail sends a new verification link to an email that is not verified yet.
+	SendVerificationEmail(context.Context, *SendVerificationEmailRequest) (*emptypb.Empty, error)
+	// VerifyEmail confirms an email with the token from a verification link.
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*emptypb.Empty, error)
+	// ListUsers returns a page of users matching a filter. The next page is requested
+	// with the returned page token and the same filter and order.
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	// SuspendUser prevents a user from signing in until a time or until they are restored.
+	SuspendUser(context.Context, *SuspendUserRequest) (*emptypb.Empty, error)
+	// RestoreUser makes a suspended, deactivated or deleted user active again.
+	RestoreUser(context.Context, *RestoreUserRequest) (*emptypb.Empty, error)
+	// PurgeUser permanently deletes a deleted or deactivated user before the end of the retention period.
+	PurgeUser(context.Context, *PurgeUserRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedUserV1Server()
 }
 
@@ -204,6 +300,24 @@ func (UnimplementedUserV1Server) DeleteMe(context.Context, *emptypb.Empty) (*emp
 func (UnimplementedUserV1Server) ChangePassword(context.Context, *ChangePasswordRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
 }
+func (UnimplementedUserV1Server) SendVerificationEmail(context.Context, *SendVerificationEmailRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendVerificationEmail not implemented")
+}
+func (UnimplementedUserV1Server) VerifyEmail(context.Context, *VerifyEmailRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedUserV1Server) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedUserV1Server) SuspendUser(context.Context, *SuspendUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendUser not implemented")
+}
+func (UnimplementedUserV1Server) RestoreUser(context.Context, *RestoreUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreUser not implemented")
+}
+func (UnimplementedUserV1Server) PurgeUser(context.Context, *PurgeUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeUser not implemented")
+}
 func (UnimplementedUserV1Server) mustEmbedUnimplementedUserV1Server() {}
 func (UnimplementedUserV1Server) testEmbeddedByValue()                {}
 
@@ -369,6 +483,114 @@ func _UserV1_ChangePassword_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserV1_SendVerificationEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendVerificationEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserV1Server).SendVerificationEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserV1_SendVerificationEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserV1Server).SendVerificationEmail(ctx, req.(*SendVerificationEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserV1_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserV1Server).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserV1_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserV1Server).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserV1_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserV1Server).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserV1_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserV1Server).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserV1_SuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserV1Server).SuspendUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserV1_SuspendUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserV1Server).SuspendUser(ctx, req.(*SuspendUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserV1_RestoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserV1Server).RestoreUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserV1_RestoreUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserV1Server).RestoreUser(ctx, req.(*RestoreUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserV1_PurgeUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserV1Server).PurgeUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserV1_PurgeUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserV1Server).PurgeUser(ctx, req.(*PurgeUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserV1_ServiceDesc is the grpc.ServiceDesc for UserV1 service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -408,6 +630,30 @@ var UserV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangePassword",
 			Handler:    _UserV1_ChangePassword_Handler,
 		},
+		{
+			MethodName: "SendVerificationEmail",
+			Handler:    _UserV1_SendVerificationEmail_Handler,
+		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _UserV1_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _UserV1_ListUsers_Handler,
+		},
+		{
+			MethodName: "SuspendUser",
+			Handler:    _UserV1_SuspendUser_Handler,
+		},
+		{
+			MethodName: "RestoreUser",
+			Handler:    _UserV1_RestoreUser_Handler,
+		},
+		{
+			MethodName: "PurgeUser",
+			Handler:    _UserV1_PurgeUser_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
@@ -0,0 +1,139 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	forwardedForMetadataHeader = "x-forwarded-for"
+	gatewayUserAgentHeader     = "grpcgateway-user-agent"
+	userAgentMetadataHeader    = "user-agent"
+)
+
+type clientIPKey struct{}
+
+// WithClientIP returns a copy of the context carrying the client IP address resolved by the server.
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, ip)
+}
+
+// ExtractClientIP extracts the client IP address from the context: the address resolved by the server
+// when there is one, else the address of the peer. The x-forwarded-for header is never read here as
+// clients can set it, see TrustedProxies.
+func ExtractClientIP(ctx context.Context) string {
+	if ip, ok := ctx.Value(clientIPKey{}).(string); ok {
+		return ip
+	}
+
+	return peerIP(ctx)
+}
+
+// TrustedProxies are the networks of the proxies, such as the HTTP gateway, whose x-forwarded-for header is
+// trusted to carry the address of the client.
+type TrustedProxies []netip.Prefix
+
+// ParseTrustedProxies parses the CIDRs or IP addresses of the trusted proxies.
+func ParseTrustedProxies(proxies []string) (TrustedProxies, error) {
+	res := make(TrustedProxies, 0, len(proxies))
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(proxy)
+		if err != nil {
+			addr, errAddr := netip.ParseAddr(proxy)
+			if errAddr != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		res = append(res, prefix.Masked())
+	}
+
+	return res, nil
+}
+
+// ClientIP resolves the client IP address of the request. It is the address of the peer unless the peer is
+// a trusted proxy, then it is the right-most address of x-forwarded-for which is not a trusted proxy, as the
+// addresses on its left are set by the client.
+func (p TrustedProxies) ClientIP(ctx context.Context) string {
+	ip := peerIP(ctx)
+	if !p.contains(ip) {
+		return ip
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ip
+	}
+	hops := strings.Split(strings.Join(md.Get(forwardedForMetadataHeader), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if _, err := netip.ParseAddr(hop); err != nil {
+			return ip
+		}
+		ip = hop
+		if !p.contains(hop) {
+			break
+		}
+	}
+
+	return ip
+}
+
+// contains reports whether the IP address is the address of a trusted proxy.
+func (p TrustedProxies) contains(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+
+	for _, prefix := range p {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// peerIP returns the IP address of the peer of the request.
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
+
+// ExtractUserAgent extracts the client user agent from the context.
+func ExtractUserAgent(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	for _, header := range []string{gatewayUserAgentHeader, userAgentMetadataHeader} {
+		if values := md.Get(header); len(values) > 0 {
+			return values[0]
+		}
+	}
+
+	return ""
+}
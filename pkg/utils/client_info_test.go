@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestTrustedProxiesClientIP(t *testing.T) {
+	t.Parallel()
+
+	proxies, err := ParseTrustedProxies([]string{"127.0.0.1/32", "::1", " 10.0.0.0/8 "})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		peer         string
+		forwardedFor []string
+		ip           string
+	}{
+		{
+			name:         "untrusted peer case",
+			peer:         "198.51.100.7",
+			forwardedFor: []string{"203.0.113.9"},
+			ip:           "198.51.100.7",
+		},
+		{
+			name: "trusted peer without header case",
+			peer: "127.0.0.1",
+			ip:   "127.0.0.1",
+		},
+		{
+			name:         "trusted peer case",
+			peer:         "127.0.0.1",
+			forwardedFor: []string{"203.0.113.9"},
+			ip:           "203.0.113.9",
+		},
+		{
+			name:         "spoofed hop case",
+			peer:         "::1",
+			forwardedFor: []string{"192.0.2.66, 203.0.113.9"},
+			ip:           "203.0.113.9",
+		},
+		{
+			name:         "trusted hops case",
+			peer:         "127.0.0.1",
+			forwardedFor: []string{"192.0.2.66, 203.0.113.9, 10.1.2.3"},
+			ip:           "203.0.113.9",
+		},
+		{
+			name:         "invalid hop case",
+			peer:         "127.0.0.1",
+			forwardedFor: []string{"unknown, 10.1.2.3"},
+			ip:           "10.1.2.3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := peer.NewContext(context.Background(), &peer.Peer{
+				Addr: &net.TCPAddr{IP: net.ParseIP(tt.peer), Port: 50000},
+			})
+			md := metadata.MD{}
+			for _, value := range tt.forwardedFor {
+				md.Append(forwardedForMetadataHeader, value)
+			}
+			ctx = metadata.NewIncomingContext(ctx, md)
+
+			require.Equal(t, tt.ip, proxies.ClientIP(ctx))
+			require.Equal(t, tt.peer, ExtractClientIP(ctx))
+			require.Equal(t, tt.ip, ExtractClientIP(WithClientIP(ctx, proxies.ClientIP(ctx))))
+		})
+	}
+}
+
+func TestParseTrustedProxiesInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseTrustedProxies([]string{"localhost"})
+	require.Error(t, err)
+}
@@ -9,8 +9,9 @@ import (
 )
 
 const (
-	authMetadataHeader = "authorization"
-	authPrefix         = "Bearer "
+	authMetadataHeader       = "authorization"
+	authPrefix               = "Bearer "
+	serviceKeyMetadataHeader = "x-service-key"
 )
 
 var (
@@ -20,6 +21,8 @@ var (
 	ErrAuthHeaderNotProvided = errors.New("authorization header is not provided")
 	// ErrInvalidAuthHeaderFormat occurs when the authorization header has an incorrect format.
 	ErrInvalidAuthHeaderFormat = errors.New("invalid authorization header format")
+	// ErrServiceKeyNotProvided occurs when the service key header is missing from the request.
+	ErrServiceKeyNotProvided = errors.New("service key header is not provided")
 )
 
 // ExtractToken extracts the token from the context.
@@ -40,3 +43,18 @@ func ExtractToken(ctx context.Context) (string, error) {
 
 	return strings.TrimPrefix(authHeader[0], authPrefix), nil
 }
+
+// ExtractServiceKey extracts the key a service authenticates with from the context.
+func ExtractServiceKey(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", ErrMetadataNotProvided
+	}
+
+	keyHeader := md[serviceKeyMetadataHeader]
+	if len(keyHeader) == 0 || keyHeader[0] == "" {
+		return "", ErrServiceKeyNotProvided
+	}
+
+	return keyHeader[0], nil
+}